@@ -0,0 +1,13 @@
+// Package autoscaleraction handles all operations related to the App
+// Autoscaler commands
+package autoscaleraction
+
+// Actor handles all App Autoscaler actions
+type Actor struct {
+	client AutoscalerClient
+}
+
+// NewActor returns an autoscaleraction Actor
+func NewActor(client AutoscalerClient) Actor {
+	return Actor{client: client}
+}
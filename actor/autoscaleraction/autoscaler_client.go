@@ -0,0 +1,11 @@
+package autoscaleraction
+
+import "code.cloudfoundry.org/cli/api/autoscaler"
+
+//go:generate counterfeiter . AutoscalerClient
+
+type AutoscalerClient interface {
+	AttachPolicy(appGUID string, policy []byte) error
+	GetAggregatedMetrics(appGUID string, metricType string, query autoscaler.HistoryQuery) ([]autoscaler.Metric, error)
+	GetScalingHistories(appGUID string, query autoscaler.HistoryQuery) ([]autoscaler.ScalingHistory, error)
+}
@@ -0,0 +1,13 @@
+package autoscaleraction_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestAutoscaleraction(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Autoscaler Action Suite")
+}
@@ -0,0 +1,240 @@
+// This file was generated by counterfeiter
+package autoscaleractionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/api/autoscaler"
+)
+
+type FakeAutoscalerClient struct {
+	AttachPolicyStub        func(appGUID string, policy []byte) error
+	attachPolicyMutex       sync.RWMutex
+	attachPolicyArgsForCall []struct {
+		appGUID string
+		policy  []byte
+	}
+	attachPolicyReturns struct {
+		result1 error
+	}
+	attachPolicyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAggregatedMetricsStub        func(appGUID string, metricType string, query autoscaler.HistoryQuery) ([]autoscaler.Metric, error)
+	getAggregatedMetricsMutex       sync.RWMutex
+	getAggregatedMetricsArgsForCall []struct {
+		appGUID    string
+		metricType string
+		query      autoscaler.HistoryQuery
+	}
+	getAggregatedMetricsReturns struct {
+		result1 []autoscaler.Metric
+		result2 error
+	}
+	getAggregatedMetricsReturnsOnCall map[int]struct {
+		result1 []autoscaler.Metric
+		result2 error
+	}
+	GetScalingHistoriesStub        func(appGUID string, query autoscaler.HistoryQuery) ([]autoscaler.ScalingHistory, error)
+	getScalingHistoriesMutex       sync.RWMutex
+	getScalingHistoriesArgsForCall []struct {
+		appGUID string
+		query   autoscaler.HistoryQuery
+	}
+	getScalingHistoriesReturns struct {
+		result1 []autoscaler.ScalingHistory
+		result2 error
+	}
+	getScalingHistoriesReturnsOnCall map[int]struct {
+		result1 []autoscaler.ScalingHistory
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAutoscalerClient) AttachPolicy(appGUID string, policy []byte) error {
+	var policyCopy []byte
+	if policy != nil {
+		policyCopy = make([]byte, len(policy))
+		copy(policyCopy, policy)
+	}
+	fake.attachPolicyMutex.Lock()
+	ret, specificReturn := fake.attachPolicyReturnsOnCall[len(fake.attachPolicyArgsForCall)]
+	fake.attachPolicyArgsForCall = append(fake.attachPolicyArgsForCall, struct {
+		appGUID string
+		policy  []byte
+	}{appGUID, policyCopy})
+	fake.recordInvocation("AttachPolicy", []interface{}{appGUID, policyCopy})
+	fake.attachPolicyMutex.Unlock()
+	if fake.AttachPolicyStub != nil {
+		return fake.AttachPolicyStub(appGUID, policy)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.attachPolicyReturns.result1
+}
+
+func (fake *FakeAutoscalerClient) AttachPolicyCallCount() int {
+	fake.attachPolicyMutex.RLock()
+	defer fake.attachPolicyMutex.RUnlock()
+	return len(fake.attachPolicyArgsForCall)
+}
+
+func (fake *FakeAutoscalerClient) AttachPolicyArgsForCall(i int) (string, []byte) {
+	fake.attachPolicyMutex.RLock()
+	defer fake.attachPolicyMutex.RUnlock()
+	return fake.attachPolicyArgsForCall[i].appGUID, fake.attachPolicyArgsForCall[i].policy
+}
+
+func (fake *FakeAutoscalerClient) AttachPolicyReturns(result1 error) {
+	fake.AttachPolicyStub = nil
+	fake.attachPolicyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAutoscalerClient) AttachPolicyReturnsOnCall(i int, result1 error) {
+	fake.AttachPolicyStub = nil
+	if fake.attachPolicyReturnsOnCall == nil {
+		fake.attachPolicyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.attachPolicyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAutoscalerClient) GetAggregatedMetrics(appGUID string, metricType string, query autoscaler.HistoryQuery) ([]autoscaler.Metric, error) {
+	fake.getAggregatedMetricsMutex.Lock()
+	ret, specificReturn := fake.getAggregatedMetricsReturnsOnCall[len(fake.getAggregatedMetricsArgsForCall)]
+	fake.getAggregatedMetricsArgsForCall = append(fake.getAggregatedMetricsArgsForCall, struct {
+		appGUID    string
+		metricType string
+		query      autoscaler.HistoryQuery
+	}{appGUID, metricType, query})
+	fake.recordInvocation("GetAggregatedMetrics", []interface{}{appGUID, metricType, query})
+	fake.getAggregatedMetricsMutex.Unlock()
+	if fake.GetAggregatedMetricsStub != nil {
+		return fake.GetAggregatedMetricsStub(appGUID, metricType, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAggregatedMetricsReturns.result1, fake.getAggregatedMetricsReturns.result2
+}
+
+func (fake *FakeAutoscalerClient) GetAggregatedMetricsCallCount() int {
+	fake.getAggregatedMetricsMutex.RLock()
+	defer fake.getAggregatedMetricsMutex.RUnlock()
+	return len(fake.getAggregatedMetricsArgsForCall)
+}
+
+func (fake *FakeAutoscalerClient) GetAggregatedMetricsArgsForCall(i int) (string, string, autoscaler.HistoryQuery) {
+	fake.getAggregatedMetricsMutex.RLock()
+	defer fake.getAggregatedMetricsMutex.RUnlock()
+	return fake.getAggregatedMetricsArgsForCall[i].appGUID, fake.getAggregatedMetricsArgsForCall[i].metricType, fake.getAggregatedMetricsArgsForCall[i].query
+}
+
+func (fake *FakeAutoscalerClient) GetAggregatedMetricsReturns(result1 []autoscaler.Metric, result2 error) {
+	fake.GetAggregatedMetricsStub = nil
+	fake.getAggregatedMetricsReturns = struct {
+		result1 []autoscaler.Metric
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAutoscalerClient) GetAggregatedMetricsReturnsOnCall(i int, result1 []autoscaler.Metric, result2 error) {
+	fake.GetAggregatedMetricsStub = nil
+	if fake.getAggregatedMetricsReturnsOnCall == nil {
+		fake.getAggregatedMetricsReturnsOnCall = make(map[int]struct {
+			result1 []autoscaler.Metric
+			result2 error
+		})
+	}
+	fake.getAggregatedMetricsReturnsOnCall[i] = struct {
+		result1 []autoscaler.Metric
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAutoscalerClient) GetScalingHistories(appGUID string, query autoscaler.HistoryQuery) ([]autoscaler.ScalingHistory, error) {
+	fake.getScalingHistoriesMutex.Lock()
+	ret, specificReturn := fake.getScalingHistoriesReturnsOnCall[len(fake.getScalingHistoriesArgsForCall)]
+	fake.getScalingHistoriesArgsForCall = append(fake.getScalingHistoriesArgsForCall, struct {
+		appGUID string
+		query   autoscaler.HistoryQuery
+	}{appGUID, query})
+	fake.recordInvocation("GetScalingHistories", []interface{}{appGUID, query})
+	fake.getScalingHistoriesMutex.Unlock()
+	if fake.GetScalingHistoriesStub != nil {
+		return fake.GetScalingHistoriesStub(appGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getScalingHistoriesReturns.result1, fake.getScalingHistoriesReturns.result2
+}
+
+func (fake *FakeAutoscalerClient) GetScalingHistoriesCallCount() int {
+	fake.getScalingHistoriesMutex.RLock()
+	defer fake.getScalingHistoriesMutex.RUnlock()
+	return len(fake.getScalingHistoriesArgsForCall)
+}
+
+func (fake *FakeAutoscalerClient) GetScalingHistoriesArgsForCall(i int) (string, autoscaler.HistoryQuery) {
+	fake.getScalingHistoriesMutex.RLock()
+	defer fake.getScalingHistoriesMutex.RUnlock()
+	return fake.getScalingHistoriesArgsForCall[i].appGUID, fake.getScalingHistoriesArgsForCall[i].query
+}
+
+func (fake *FakeAutoscalerClient) GetScalingHistoriesReturns(result1 []autoscaler.ScalingHistory, result2 error) {
+	fake.GetScalingHistoriesStub = nil
+	fake.getScalingHistoriesReturns = struct {
+		result1 []autoscaler.ScalingHistory
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAutoscalerClient) GetScalingHistoriesReturnsOnCall(i int, result1 []autoscaler.ScalingHistory, result2 error) {
+	fake.GetScalingHistoriesStub = nil
+	if fake.getScalingHistoriesReturnsOnCall == nil {
+		fake.getScalingHistoriesReturnsOnCall = make(map[int]struct {
+			result1 []autoscaler.ScalingHistory
+			result2 error
+		})
+	}
+	fake.getScalingHistoriesReturnsOnCall[i] = struct {
+		result1 []autoscaler.ScalingHistory
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAutoscalerClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.attachPolicyMutex.RLock()
+	defer fake.attachPolicyMutex.RUnlock()
+	fake.getAggregatedMetricsMutex.RLock()
+	defer fake.getAggregatedMetricsMutex.RUnlock()
+	fake.getScalingHistoriesMutex.RLock()
+	defer fake.getScalingHistoriesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAutoscalerClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ autoscaleraction.AutoscalerClient = new(FakeAutoscalerClient)
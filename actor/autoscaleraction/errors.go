@@ -0,0 +1,36 @@
+package autoscaleraction
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/autoscaler/autoscalererror"
+)
+
+// ApplicationNotAutoscaledError is returned when the App Autoscaler does not
+// know about the application, because it is not bound to an autoscaler
+// service instance.
+type ApplicationNotAutoscaledError struct {
+	AppGUID string
+}
+
+func (e ApplicationNotAutoscaledError) Error() string {
+	return fmt.Sprintf("Application %s is not bound to the App Autoscaler", e.AppGUID)
+}
+
+// InvalidPolicyError is returned when the App Autoscaler rejects a scaling
+// policy.
+type InvalidPolicyError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidPolicyError) Error() string {
+	return fmt.Sprintf("Policy %s is invalid: %s", e.Path, e.Message)
+}
+
+func handleNotFound(appGUID string, err error) error {
+	if _, ok := err.(autoscalererror.ResourceNotFoundError); ok {
+		return ApplicationNotAutoscaledError{AppGUID: appGUID}
+	}
+	return err
+}
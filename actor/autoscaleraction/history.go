@@ -0,0 +1,21 @@
+package autoscaleraction
+
+import "code.cloudfoundry.org/cli/api/autoscaler"
+
+// ScalingHistory represents a scaling event of an application.
+type ScalingHistory autoscaler.ScalingHistory
+
+// GetApplicationScalingHistory returns the scaling events of the
+// application, most recent first unless ascending is set.
+func (actor Actor) GetApplicationScalingHistory(appGUID string, ascending bool) ([]ScalingHistory, error) {
+	histories, err := actor.client.GetScalingHistories(appGUID, autoscaler.HistoryQuery{Ascending: ascending})
+	if err != nil {
+		return nil, handleNotFound(appGUID, err)
+	}
+
+	var scalingHistories []ScalingHistory
+	for _, history := range histories {
+		scalingHistories = append(scalingHistories, ScalingHistory(history))
+	}
+	return scalingHistories, nil
+}
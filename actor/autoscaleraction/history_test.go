@@ -0,0 +1,97 @@
+package autoscaleraction_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/autoscaleraction/autoscaleractionfakes"
+	"code.cloudfoundry.org/cli/api/autoscaler"
+	"code.cloudfoundry.org/cli/api/autoscaler/autoscalererror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("History Actions", func() {
+	var (
+		actor      Actor
+		fakeClient *autoscaleractionfakes.FakeAutoscalerClient
+		timestamp  time.Time
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(autoscaleractionfakes.FakeAutoscalerClient)
+		actor = NewActor(fakeClient)
+		timestamp = time.Unix(1500000000, 0).UTC()
+	})
+
+	Describe("GetApplicationScalingHistory", func() {
+		Context("when the application has been scaled", func() {
+			BeforeEach(func() {
+				fakeClient.GetScalingHistoriesReturns([]autoscaler.ScalingHistory{
+					{Timestamp: timestamp, OldInstances: 1, NewInstances: 2},
+				}, nil)
+			})
+
+			It("returns the scaling events in the requested order", func() {
+				histories, err := actor.GetApplicationScalingHistory("some-app-guid", true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(histories).To(Equal([]ScalingHistory{
+					{Timestamp: timestamp, OldInstances: 1, NewInstances: 2},
+				}))
+
+				appGUID, query := fakeClient.GetScalingHistoriesArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(autoscaler.HistoryQuery{Ascending: true}))
+			})
+		})
+
+		Context("when the application is not bound to the App Autoscaler", func() {
+			BeforeEach(func() {
+				fakeClient.GetScalingHistoriesReturns(nil, autoscalererror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotAutoscaledError", func() {
+				_, err := actor.GetApplicationScalingHistory("some-app-guid", false)
+				Expect(err).To(MatchError(ApplicationNotAutoscaledError{AppGUID: "some-app-guid"}))
+			})
+		})
+	})
+
+	Describe("GetApplicationMetrics", func() {
+		Context("when the metric has samples", func() {
+			BeforeEach(func() {
+				fakeClient.GetAggregatedMetricsReturns([]autoscaler.Metric{
+					{Name: "memoryused", Value: "250", Unit: "MB", Timestamp: timestamp},
+				}, nil)
+			})
+
+			It("returns the samples", func() {
+				metrics, err := actor.GetApplicationMetrics("some-app-guid", "memoryused", false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metrics).To(Equal([]Metric{
+					{Name: "memoryused", Value: "250", Unit: "MB", Timestamp: timestamp},
+				}))
+
+				appGUID, metricType, query := fakeClient.GetAggregatedMetricsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(metricType).To(Equal("memoryused"))
+				Expect(query).To(Equal(autoscaler.HistoryQuery{}))
+			})
+		})
+
+		Context("when getting the metrics fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("metrics error")
+				fakeClient.GetAggregatedMetricsReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetApplicationMetrics("some-app-guid", "memoryused", false)
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
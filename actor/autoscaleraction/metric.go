@@ -0,0 +1,22 @@
+package autoscaleraction
+
+import "code.cloudfoundry.org/cli/api/autoscaler"
+
+// Metric represents a sample of an aggregated application metric.
+type Metric autoscaler.Metric
+
+// GetApplicationMetrics returns the samples of the metric type aggregated
+// across all instances of the application, most recent first unless
+// ascending is set.
+func (actor Actor) GetApplicationMetrics(appGUID string, metricType string, ascending bool) ([]Metric, error) {
+	metrics, err := actor.client.GetAggregatedMetrics(appGUID, metricType, autoscaler.HistoryQuery{Ascending: ascending})
+	if err != nil {
+		return nil, handleNotFound(appGUID, err)
+	}
+
+	var appMetrics []Metric
+	for _, metric := range metrics {
+		appMetrics = append(appMetrics, Metric(metric))
+	}
+	return appMetrics, nil
+}
@@ -0,0 +1,28 @@
+package autoscaleraction
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/api/autoscaler/autoscalererror"
+)
+
+// AttachApplicationPolicy replaces the scaling policy of the application with
+// the JSON policy read from policyPath.
+func (actor Actor) AttachApplicationPolicy(appGUID string, policyPath string) error {
+	policy, err := ioutil.ReadFile(policyPath)
+	if err != nil {
+		return err
+	}
+
+	if !json.Valid(policy) {
+		return InvalidPolicyError{Path: policyPath, Message: "the file is not valid JSON"}
+	}
+
+	err = actor.client.AttachPolicy(appGUID, policy)
+	if e, ok := err.(autoscalererror.BadRequestError); ok {
+		return InvalidPolicyError{Path: policyPath, Message: e.Message}
+	}
+
+	return handleNotFound(appGUID, err)
+}
@@ -0,0 +1,107 @@
+package autoscaleraction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/autoscaleraction/autoscaleractionfakes"
+	"code.cloudfoundry.org/cli/api/autoscaler/autoscalererror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy Actions", func() {
+	var (
+		actor      Actor
+		fakeClient *autoscaleractionfakes.FakeAutoscalerClient
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(autoscaleractionfakes.FakeAutoscalerClient)
+		actor = NewActor(fakeClient)
+	})
+
+	Describe("AttachApplicationPolicy", func() {
+		var (
+			policyPath string
+			policy     string
+			err        error
+		)
+
+		BeforeEach(func() {
+			policy = `{"instance_min_count": 1, "instance_max_count": 4}`
+		})
+
+		JustBeforeEach(func() {
+			policyFile, tempErr := ioutil.TempFile("", "autoscaler-policy")
+			Expect(tempErr).ToNot(HaveOccurred())
+			_, tempErr = policyFile.WriteString(policy)
+			Expect(tempErr).ToNot(HaveOccurred())
+			Expect(policyFile.Close()).To(Succeed())
+			policyPath = policyFile.Name()
+
+			err = actor.AttachApplicationPolicy("some-app-guid", policyPath)
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(policyPath)).To(Succeed())
+		})
+
+		Context("when the policy is attached", func() {
+			It("sends the contents of the file", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.AttachPolicyCallCount()).To(Equal(1))
+				appGUID, sentPolicy := fakeClient.AttachPolicyArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(string(sentPolicy)).To(Equal(policy))
+			})
+		})
+
+		Context("when the file is not JSON", func() {
+			BeforeEach(func() {
+				policy = "instance_min_count: 1"
+			})
+
+			It("returns an InvalidPolicyError without sending it", func() {
+				Expect(err).To(MatchError(InvalidPolicyError{Path: policyPath, Message: "the file is not valid JSON"}))
+				Expect(fakeClient.AttachPolicyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the App Autoscaler rejects the policy", func() {
+			BeforeEach(func() {
+				fakeClient.AttachPolicyReturns(autoscalererror.BadRequestError{Message: "(root): scaling_rules is required"})
+			})
+
+			It("returns an InvalidPolicyError", func() {
+				Expect(err).To(MatchError(InvalidPolicyError{Path: policyPath, Message: "(root): scaling_rules is required"}))
+			})
+		})
+
+		Context("when the application is not bound to the App Autoscaler", func() {
+			BeforeEach(func() {
+				fakeClient.AttachPolicyReturns(autoscalererror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotAutoscaledError", func() {
+				Expect(err).To(MatchError(ApplicationNotAutoscaledError{AppGUID: "some-app-guid"}))
+			})
+		})
+
+		Context("when attaching the policy fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("attach error")
+				fakeClient.AttachPolicyReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
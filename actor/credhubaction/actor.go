@@ -0,0 +1,13 @@
+// Package credhubaction handles all operations related to reading service
+// credentials from CredHub
+package credhubaction
+
+// Actor handles all CredHub actions
+type Actor struct {
+	client CredHubClient
+}
+
+// NewActor returns a credhubaction Actor
+func NewActor(client CredHubClient) Actor {
+	return Actor{client: client}
+}
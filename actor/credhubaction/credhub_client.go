@@ -0,0 +1,9 @@
+package credhubaction
+
+import "code.cloudfoundry.org/cli/api/credhub"
+
+//go:generate counterfeiter . CredHubClient
+
+type CredHubClient interface {
+	GetCredentialByName(name string) (credhub.Credential, error)
+}
@@ -0,0 +1,13 @@
+package credhubaction_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestCredhubaction(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CredHub Action Suite")
+}
@@ -0,0 +1,100 @@
+// This file was generated by counterfeiter
+package credhubactionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/credhubaction"
+	"code.cloudfoundry.org/cli/api/credhub"
+)
+
+type FakeCredHubClient struct {
+	GetCredentialByNameStub        func(name string) (credhub.Credential, error)
+	getCredentialByNameMutex       sync.RWMutex
+	getCredentialByNameArgsForCall []struct {
+		name string
+	}
+	getCredentialByNameReturns struct {
+		result1 credhub.Credential
+		result2 error
+	}
+	getCredentialByNameReturnsOnCall map[int]struct {
+		result1 credhub.Credential
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCredHubClient) GetCredentialByName(name string) (credhub.Credential, error) {
+	fake.getCredentialByNameMutex.Lock()
+	ret, specificReturn := fake.getCredentialByNameReturnsOnCall[len(fake.getCredentialByNameArgsForCall)]
+	fake.getCredentialByNameArgsForCall = append(fake.getCredentialByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetCredentialByName", []interface{}{name})
+	fake.getCredentialByNameMutex.Unlock()
+	if fake.GetCredentialByNameStub != nil {
+		return fake.GetCredentialByNameStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getCredentialByNameReturns.result1, fake.getCredentialByNameReturns.result2
+}
+
+func (fake *FakeCredHubClient) GetCredentialByNameCallCount() int {
+	fake.getCredentialByNameMutex.RLock()
+	defer fake.getCredentialByNameMutex.RUnlock()
+	return len(fake.getCredentialByNameArgsForCall)
+}
+
+func (fake *FakeCredHubClient) GetCredentialByNameArgsForCall(i int) string {
+	fake.getCredentialByNameMutex.RLock()
+	defer fake.getCredentialByNameMutex.RUnlock()
+	return fake.getCredentialByNameArgsForCall[i].name
+}
+
+func (fake *FakeCredHubClient) GetCredentialByNameReturns(result1 credhub.Credential, result2 error) {
+	fake.GetCredentialByNameStub = nil
+	fake.getCredentialByNameReturns = struct {
+		result1 credhub.Credential
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCredHubClient) GetCredentialByNameReturnsOnCall(i int, result1 credhub.Credential, result2 error) {
+	fake.GetCredentialByNameStub = nil
+	if fake.getCredentialByNameReturnsOnCall == nil {
+		fake.getCredentialByNameReturnsOnCall = make(map[int]struct {
+			result1 credhub.Credential
+			result2 error
+		})
+	}
+	fake.getCredentialByNameReturnsOnCall[i] = struct {
+		result1 credhub.Credential
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCredHubClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getCredentialByNameMutex.RLock()
+	defer fake.getCredentialByNameMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCredHubClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ credhubaction.CredHubClient = new(FakeCredHubClient)
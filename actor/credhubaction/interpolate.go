@@ -0,0 +1,66 @@
+package credhubaction
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/credhub/credhuberror"
+)
+
+const credHubRefKey = "credhub-ref"
+
+// InterpolateServiceCredentials replaces the credhub-ref credentials of the
+// bindings in VCAP_SERVICES with the credentials stored in CredHub. The
+// references that the user may not read are left in place and returned, so
+// the remaining credentials are still shown.
+func (actor Actor) InterpolateServiceCredentials(vcapServices map[string]interface{}) (map[string]interface{}, []string, error) {
+	var unresolved []string
+
+	for _, bindings := range vcapServices {
+		bindingList, ok := bindings.([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, binding := range bindingList {
+			bindingMap, ok := binding.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			ref, ok := credHubRef(bindingMap["credentials"])
+			if !ok {
+				continue
+			}
+
+			credential, err := actor.client.GetCredentialByName(ref)
+			if _, ok := err.(credhuberror.CredentialNotFoundError); ok {
+				unresolved = append(unresolved, ref)
+				continue
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+
+			var credentials interface{}
+			err = json.Unmarshal(credential.Value, &credentials)
+			if err != nil {
+				return nil, nil, err
+			}
+			bindingMap["credentials"] = credentials
+		}
+	}
+
+	return vcapServices, unresolved, nil
+}
+
+// credHubRef returns the CredHub reference of binding credentials that were
+// replaced by one.
+func credHubRef(credentials interface{}) (string, bool) {
+	credentialsMap, ok := credentials.(map[string]interface{})
+	if !ok || len(credentialsMap) != 1 {
+		return "", false
+	}
+
+	ref, ok := credentialsMap[credHubRefKey].(string)
+	return ref, ok
+}
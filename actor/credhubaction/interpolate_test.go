@@ -0,0 +1,96 @@
+package credhubaction_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/credhubaction"
+	"code.cloudfoundry.org/cli/actor/credhubaction/credhubactionfakes"
+	"code.cloudfoundry.org/cli/api/credhub"
+	"code.cloudfoundry.org/cli/api/credhub/credhuberror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Interpolate Actions", func() {
+	var (
+		actor      Actor
+		fakeClient *credhubactionfakes.FakeCredHubClient
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(credhubactionfakes.FakeCredHubClient)
+		actor = NewActor(fakeClient)
+	})
+
+	Describe("InterpolateServiceCredentials", func() {
+		var (
+			vcapServices map[string]interface{}
+			interpolated map[string]interface{}
+			unresolved   []string
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			vcapServices = map[string]interface{}{
+				"some-service": []interface{}{
+					map[string]interface{}{
+						"name":        "readable-instance",
+						"credentials": map[string]interface{}{"credhub-ref": "/c/readable"},
+					},
+					map[string]interface{}{
+						"name":        "plain-instance",
+						"credentials": map[string]interface{}{"password": "plain-password"},
+					},
+				},
+				"other-service": []interface{}{
+					map[string]interface{}{
+						"name":        "hidden-instance",
+						"credentials": map[string]interface{}{"credhub-ref": "/c/hidden"},
+					},
+				},
+			}
+
+			fakeClient.GetCredentialByNameStub = func(name string) (credhub.Credential, error) {
+				if name == "/c/readable" {
+					return credhub.Credential{Name: name, Value: json.RawMessage(`{"password": "secret-password"}`)}, nil
+				}
+				return credhub.Credential{}, credhuberror.CredentialNotFoundError{}
+			}
+		})
+
+		JustBeforeEach(func() {
+			interpolated, unresolved, executeErr = actor.InterpolateServiceCredentials(vcapServices)
+		})
+
+		It("replaces the references the user may read", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeClient.GetCredentialByNameCallCount()).To(Equal(2))
+
+			bindings := interpolated["some-service"].([]interface{})
+			Expect(bindings[0].(map[string]interface{})["credentials"]).To(Equal(map[string]interface{}{"password": "secret-password"}))
+			Expect(bindings[1].(map[string]interface{})["credentials"]).To(Equal(map[string]interface{}{"password": "plain-password"}))
+		})
+
+		It("leaves the other references in place and returns them", func() {
+			Expect(unresolved).To(ConsistOf("/c/hidden"))
+
+			bindings := interpolated["other-service"].([]interface{})
+			Expect(bindings[0].(map[string]interface{})["credentials"]).To(Equal(map[string]interface{}{"credhub-ref": "/c/hidden"}))
+		})
+
+		Context("when reading a credential fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("credhub error")
+				fakeClient.GetCredentialByNameStub = nil
+				fakeClient.GetCredentialByNameReturns(credhub.Credential{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
@@ -0,0 +1,19 @@
+// Package diagnosticsaction contains the checks run by the doctor command to
+// diagnose problems with the connection to a Cloud Foundry foundation.
+package diagnosticsaction
+
+// Actor runs the diagnostic checks using the Cloud Controller and UAA clients.
+type Actor struct {
+	CloudControllerClient CloudControllerClient
+	UAAClient             UAAClient
+	NetworkClient         NetworkClient
+}
+
+// NewActor returns a new actor.
+func NewActor(ccClient CloudControllerClient, uaaClient UAAClient, networkClient NetworkClient) Actor {
+	return Actor{
+		CloudControllerClient: ccClient,
+		UAAClient:             uaaClient,
+		NetworkClient:         networkClient,
+	}
+}
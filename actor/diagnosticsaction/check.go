@@ -0,0 +1,298 @@
+package diagnosticsaction
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/version"
+	"github.com/blang/semver"
+)
+
+// CertificateExpiryWarningPeriod is how long before its expiry a certificate
+// is reported as expiring soon.
+const CertificateExpiryWarningPeriod = 30 * 24 * time.Hour
+
+// CheckStatus is the outcome of a diagnostic check.
+type CheckStatus string
+
+const (
+	// CheckPassed means no problem was found.
+	CheckPassed CheckStatus = "ok"
+	// CheckWarning means the check found a problem that does not prevent the
+	// CLI from working.
+	CheckWarning CheckStatus = "warning"
+	// CheckFailed means the check found a problem that prevents the CLI from
+	// working.
+	CheckFailed CheckStatus = "failed"
+	// CheckSkipped means the check could not run because an earlier check
+	// failed.
+	CheckSkipped CheckStatus = "skipped"
+)
+
+// Check is the result of a single diagnostic check. Advice describes how to
+// fix the problem when the check did not pass.
+type Check struct {
+	Name    string
+	Status  CheckStatus
+	Details string
+	Advice  string
+}
+
+// RunChecks checks API reachability, TLS validity, the UAA tokens, doppler
+// connectivity, CLI and Cloud Controller version compatibility, and the proxy
+// configuration, in that order. Checks that need the API are skipped when it
+// cannot be reached.
+func (actor Actor) RunChecks(config Config) []Check {
+	var checks []Check
+
+	info, apiCheck := actor.checkAPI(config)
+	checks = append(checks, apiCheck)
+
+	if apiCheck.Status == CheckFailed {
+		for _, name := range []string{"TLS certificate", "UAA token", "Doppler connectivity", "CLI version"} {
+			checks = append(checks, Check{Name: name, Status: CheckSkipped, Details: "The API could not be reached."})
+		}
+	} else {
+		checks = append(checks,
+			actor.checkTLS(config),
+			actor.checkToken(config),
+			actor.checkDoppler(info),
+			checkCLIVersion(config.BinaryVersion(), info),
+		)
+	}
+
+	checks = append(checks, actor.checkProxy(config))
+
+	return checks
+}
+
+func (actor Actor) checkAPI(config Config) (ccv2.APIInformation, Check) {
+	check := Check{Name: "API reachability"}
+
+	if config.Target() == "" {
+		check.Status = CheckFailed
+		check.Details = "No API endpoint set."
+		check.Advice = "Set the API endpoint with 'cf api URL'."
+		return ccv2.APIInformation{}, check
+	}
+
+	_, err := actor.CloudControllerClient.TargetCF(ccv2.TargetSettings{
+		URL:               config.Target(),
+		SkipSSLValidation: config.SkipSSLValidation(),
+		DialTimeout:       config.DialTimeout(),
+	})
+	var info ccv2.APIInformation
+	if err == nil {
+		info, _, err = actor.CloudControllerClient.Info()
+	}
+	if err != nil {
+		check.Status = CheckFailed
+		check.Details = fmt.Sprintf("Could not reach %s: %s", config.Target(), err)
+		check.Advice = "Check the API URL with 'cf api' and that this machine can reach it."
+		return ccv2.APIInformation{}, check
+	}
+
+	check.Status = CheckPassed
+	check.Details = fmt.Sprintf("%s is reachable, API version %s.", config.Target(), info.APIVersion)
+	return info, check
+}
+
+func (actor Actor) checkTLS(config Config) Check {
+	check := Check{Name: "TLS certificate"}
+
+	apiURL, err := url.Parse(config.Target())
+	if err != nil || apiURL.Scheme != "https" {
+		check.Status = CheckWarning
+		check.Details = "The API does not use HTTPS."
+		check.Advice = "Target the API with an https:// URL."
+		return check
+	}
+
+	if config.SkipSSLValidation() {
+		check.Status = CheckWarning
+		check.Details = "SSL validation is disabled for this API."
+		check.Advice = "Once the API has a trusted certificate, run 'cf api URL' without --skip-ssl-validation."
+		return check
+	}
+
+	certificates, err := actor.NetworkClient.PeerCertificates(hostPort(apiURL, "443"), apiURL.Hostname())
+	if err != nil {
+		check.Status = CheckFailed
+		check.Details = fmt.Sprintf("The certificate of %s could not be verified: %s", apiURL.Host, err)
+		switch err.(type) {
+		case x509.UnknownAuthorityError:
+			check.Advice = "Add the CA certificate of the foundation to the system trust store."
+		case x509.HostnameError:
+			check.Advice = "Target the API with a host name included in its certificate."
+		default:
+			check.Advice = "Ask the operator of the foundation to renew or fix the API certificate."
+		}
+		return check
+	}
+
+	expiry := certificates[0].NotAfter
+	if time.Until(expiry) < CertificateExpiryWarningPeriod {
+		check.Status = CheckWarning
+		check.Details = fmt.Sprintf("The certificate of %s expires on %s.", apiURL.Host, expiry.Format(time.RFC1123))
+		check.Advice = "Ask the operator of the foundation to renew the API certificate."
+		return check
+	}
+
+	check.Status = CheckPassed
+	check.Details = fmt.Sprintf("The certificate of %s is valid until %s.", apiURL.Host, expiry.Format(time.RFC1123))
+	return check
+}
+
+func (actor Actor) checkToken(config Config) Check {
+	check := Check{Name: "UAA token"}
+
+	if config.AccessToken() == "" {
+		check.Status = CheckFailed
+		check.Details = "Not logged in."
+		check.Advice = "Log in with 'cf login'."
+		return check
+	}
+
+	claims, err := v2action.Actor{}.DecodeAccessToken(config.AccessToken())
+	if err != nil {
+		check.Status = CheckFailed
+		check.Details = "The access token could not be decoded."
+		check.Advice = "Log in again with 'cf login'."
+		return check
+	}
+
+	if claims.ExpiresAt.After(time.Now()) {
+		check.Status = CheckPassed
+		check.Details = fmt.Sprintf("The access token is valid until %s.", claims.ExpiresAt.Format(time.RFC1123))
+		return check
+	}
+
+	token, err := actor.UAAClient.RefreshAccessToken(config.RefreshToken())
+	if err != nil {
+		check.Status = CheckFailed
+		check.Details = fmt.Sprintf("The access token expired and could not be refreshed: %s", err)
+		check.Advice = "Log in again with 'cf login'."
+		return check
+	}
+	config.SetAccessToken(token.AuthorizationToken())
+	config.SetRefreshToken(token.RefreshToken)
+
+	check.Status = CheckPassed
+	check.Details = "The access token expired and was refreshed."
+	return check
+}
+
+func (actor Actor) checkDoppler(info ccv2.APIInformation) Check {
+	check := Check{Name: "Doppler connectivity"}
+
+	dopplerURL, err := url.Parse(info.DopplerEndpoint)
+	if err != nil || dopplerURL.Host == "" {
+		check.Status = CheckWarning
+		check.Details = "The API does not advertise a doppler endpoint."
+		check.Advice = "Logs and app events cannot be streamed from this foundation."
+		return check
+	}
+
+	address := hostPort(dopplerURL, "443")
+	err = actor.NetworkClient.Dial(address)
+	if err != nil {
+		check.Status = CheckFailed
+		check.Details = fmt.Sprintf("Could not connect to %s: %s", address, err)
+		check.Advice = fmt.Sprintf("Allow outbound websocket connections to %s; 'cf logs' and staging output stream from it.", address)
+		return check
+	}
+
+	check.Status = CheckPassed
+	check.Details = fmt.Sprintf("%s is reachable.", address)
+	return check
+}
+
+func checkCLIVersion(cliVersion string, info ccv2.APIInformation) Check {
+	check := Check{Name: "CLI version"}
+
+	if cliVersion == version.DefaultVersion {
+		check.Status = CheckPassed
+		check.Details = "This is a development build of the CLI; the version was not checked."
+		return check
+	}
+
+	if isBelow(cliVersion, info.MinCLIVersion) {
+		check.Status = CheckFailed
+		check.Details = fmt.Sprintf("CLI version %s is older than %s, the minimum supported by this API.", cliVersion, info.MinCLIVersion)
+		check.Advice = "Upgrade the CLI: https://github.com/cloudfoundry/cli#downloads"
+		return check
+	}
+
+	if isBelow(cliVersion, info.MinimumRecommendedCLIVersion) {
+		check.Status = CheckWarning
+		check.Details = fmt.Sprintf("CLI version %s is older than %s, the minimum recommended by this API.", cliVersion, info.MinimumRecommendedCLIVersion)
+		check.Advice = "Upgrade the CLI: https://github.com/cloudfoundry/cli#downloads"
+		return check
+	}
+
+	check.Status = CheckPassed
+	check.Details = fmt.Sprintf("CLI version %s is compatible with API version %s.", cliVersion, info.APIVersion)
+	return check
+}
+
+func (actor Actor) checkProxy(config Config) Check {
+	check := Check{Name: "Proxy configuration"}
+
+	target := config.Target()
+	if target == "" {
+		target = "https://api.example.com"
+	}
+
+	proxyURL, err := actor.NetworkClient.ProxyURL(target)
+	if err != nil {
+		check.Status = CheckFailed
+		check.Details = fmt.Sprintf("The proxy configuration is invalid: %s", err)
+		check.Advice = "Fix the https_proxy environment variable."
+		return check
+	}
+
+	if proxyURL == nil {
+		check.Status = CheckPassed
+		check.Details = "No proxy is used for the API."
+		return check
+	}
+
+	address := hostPort(proxyURL, "80")
+	err = actor.NetworkClient.Dial(address)
+	if err != nil {
+		check.Status = CheckFailed
+		check.Details = fmt.Sprintf("The proxy %s could not be reached: %s", address, err)
+		check.Advice = "Fix the https_proxy environment variable, or add the API host to no_proxy."
+		return check
+	}
+
+	check.Status = CheckPassed
+	check.Details = fmt.Sprintf("Requests to the API go through the proxy %s.", address)
+	return check
+}
+
+// isBelow returns true when current is a lower version than minimum. Versions
+// that cannot be parsed are not compared.
+func isBelow(current string, minimum string) bool {
+	currentVersion, err := semver.Make(current)
+	if err != nil {
+		return false
+	}
+	minimumVersion, err := semver.Make(minimum)
+	if err != nil {
+		return false
+	}
+	return currentVersion.LT(minimumVersion)
+}
+
+func hostPort(u *url.URL, defaultPort string) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	return net.JoinHostPort(u.Hostname(), defaultPort)
+}
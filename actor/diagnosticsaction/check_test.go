@@ -0,0 +1,322 @@
+package diagnosticsaction_test
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/diagnosticsaction"
+	"code.cloudfoundry.org/cli/actor/diagnosticsaction/diagnosticsactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/version"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func accessToken(expiresAt time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"user_name":"some-user","exp":%d}`, expiresAt.Unix())))
+	return fmt.Sprintf("bearer %s.%s.c2lnbmF0dXJl", header, payload)
+}
+
+func findCheck(checks []Check, name string) Check {
+	for _, check := range checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	Fail("no check named " + name)
+	return Check{}
+}
+
+var _ = Describe("RunChecks", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *diagnosticsactionfakes.FakeCloudControllerClient
+		fakeUAAClient             *diagnosticsactionfakes.FakeUAAClient
+		fakeNetworkClient         *diagnosticsactionfakes.FakeNetworkClient
+		fakeConfig                *diagnosticsactionfakes.FakeConfig
+
+		checks []Check
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(diagnosticsactionfakes.FakeCloudControllerClient)
+		fakeUAAClient = new(diagnosticsactionfakes.FakeUAAClient)
+		fakeNetworkClient = new(diagnosticsactionfakes.FakeNetworkClient)
+		fakeConfig = new(diagnosticsactionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeUAAClient, fakeNetworkClient)
+
+		fakeConfig.TargetReturns("https://api.some-domain.com")
+		fakeConfig.DialTimeoutReturns(5 * time.Second)
+		fakeConfig.AccessTokenReturns(accessToken(time.Now().Add(time.Hour)))
+		fakeConfig.RefreshTokenReturns("some-refresh-token")
+		fakeConfig.BinaryVersionReturns("6.30.0")
+
+		fakeCloudControllerClient.InfoReturns(ccv2.APIInformation{
+			APIVersion:                   "2.90.0",
+			DopplerEndpoint:              "wss://doppler.some-domain.com:4443",
+			MinCLIVersion:                "6.22.0",
+			MinimumRecommendedCLIVersion: "6.26.0",
+		}, nil, nil)
+		fakeNetworkClient.PeerCertificatesReturns([]*x509.Certificate{
+			{NotAfter: time.Now().Add(365 * 24 * time.Hour)},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		checks = actor.RunChecks(fakeConfig)
+	})
+
+	Context("when everything is healthy", func() {
+		It("passes every check in order", func() {
+			var names []string
+			for _, check := range checks {
+				names = append(names, check.Name)
+				Expect(check.Status).To(Equal(CheckPassed), check.Name)
+			}
+			Expect(names).To(Equal([]string{
+				"API reachability",
+				"TLS certificate",
+				"UAA token",
+				"Doppler connectivity",
+				"CLI version",
+				"Proxy configuration",
+			}))
+		})
+
+		It("targets the API with the config settings", func() {
+			Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.TargetCFArgsForCall(0)).To(Equal(ccv2.TargetSettings{
+				URL:         "https://api.some-domain.com",
+				DialTimeout: 5 * time.Second,
+			}))
+		})
+
+		It("verifies the API certificate and dials doppler", func() {
+			address, serverName := fakeNetworkClient.PeerCertificatesArgsForCall(0)
+			Expect(address).To(Equal("api.some-domain.com:443"))
+			Expect(serverName).To(Equal("api.some-domain.com"))
+
+			Expect(fakeNetworkClient.DialCallCount()).To(Equal(1))
+			Expect(fakeNetworkClient.DialArgsForCall(0)).To(Equal("doppler.some-domain.com:4443"))
+		})
+	})
+
+	Context("when no API is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("")
+		})
+
+		It("fails the API check and skips the checks that need it", func() {
+			Expect(checks[0].Status).To(Equal(CheckFailed))
+			Expect(checks[0].Advice).To(ContainSubstring("cf api URL"))
+			for _, check := range checks[1:5] {
+				Expect(check.Status).To(Equal(CheckSkipped), check.Name)
+			}
+			Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(0))
+		})
+
+		It("still checks the proxy configuration", func() {
+			Expect(findCheck(checks, "Proxy configuration").Status).To(Equal(CheckPassed))
+			Expect(fakeNetworkClient.ProxyURLCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the API cannot be reached", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.InfoReturns(ccv2.APIInformation{}, nil, errors.New("connection refused"))
+		})
+
+		It("fails the API check with the error", func() {
+			Expect(checks[0].Status).To(Equal(CheckFailed))
+			Expect(checks[0].Details).To(ContainSubstring("connection refused"))
+			Expect(findCheck(checks, "UAA token").Status).To(Equal(CheckSkipped))
+		})
+	})
+
+	Describe("TLS certificate", func() {
+		Context("when SSL validation is skipped", func() {
+			BeforeEach(func() {
+				fakeConfig.SkipSSLValidationReturns(true)
+			})
+
+			It("warns without verifying the certificate", func() {
+				Expect(findCheck(checks, "TLS certificate").Status).To(Equal(CheckWarning))
+				Expect(fakeNetworkClient.PeerCertificatesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the certificate is signed by an unknown authority", func() {
+			BeforeEach(func() {
+				fakeNetworkClient.PeerCertificatesReturns(nil, x509.UnknownAuthorityError{})
+			})
+
+			It("fails with advice about the trust store", func() {
+				check := findCheck(checks, "TLS certificate")
+				Expect(check.Status).To(Equal(CheckFailed))
+				Expect(check.Advice).To(ContainSubstring("trust store"))
+			})
+		})
+
+		Context("when the certificate expires soon", func() {
+			BeforeEach(func() {
+				fakeNetworkClient.PeerCertificatesReturns([]*x509.Certificate{
+					{NotAfter: time.Now().Add(24 * time.Hour)},
+				}, nil)
+			})
+
+			It("warns", func() {
+				Expect(findCheck(checks, "TLS certificate").Status).To(Equal(CheckWarning))
+			})
+		})
+	})
+
+	Describe("UAA token", func() {
+		Context("when not logged in", func() {
+			BeforeEach(func() {
+				fakeConfig.AccessTokenReturns("")
+			})
+
+			It("fails with advice to log in", func() {
+				check := findCheck(checks, "UAA token")
+				Expect(check.Status).To(Equal(CheckFailed))
+				Expect(check.Advice).To(ContainSubstring("cf login"))
+			})
+		})
+
+		Context("when the access token has expired", func() {
+			BeforeEach(func() {
+				fakeConfig.AccessTokenReturns(accessToken(time.Now().Add(-time.Hour)))
+			})
+
+			Context("when it can be refreshed", func() {
+				BeforeEach(func() {
+					fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshToken{
+						AccessToken:  "new-access-token",
+						RefreshToken: "new-refresh-token",
+						Type:         "bearer",
+					}, nil)
+				})
+
+				It("passes and stores the new tokens", func() {
+					Expect(findCheck(checks, "UAA token").Status).To(Equal(CheckPassed))
+					Expect(fakeUAAClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+					Expect(fakeConfig.SetAccessTokenArgsForCall(0)).To(Equal("bearer new-access-token"))
+					Expect(fakeConfig.SetRefreshTokenArgsForCall(0)).To(Equal("new-refresh-token"))
+				})
+			})
+
+			Context("when it cannot be refreshed", func() {
+				BeforeEach(func() {
+					fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshToken{}, errors.New("invalid refresh token"))
+				})
+
+				It("fails with advice to log in again", func() {
+					check := findCheck(checks, "UAA token")
+					Expect(check.Status).To(Equal(CheckFailed))
+					Expect(check.Details).To(ContainSubstring("invalid refresh token"))
+					Expect(check.Advice).To(ContainSubstring("cf login"))
+					Expect(fakeConfig.SetAccessTokenCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	Describe("Doppler connectivity", func() {
+		Context("when doppler cannot be reached", func() {
+			BeforeEach(func() {
+				fakeNetworkClient.DialReturns(errors.New("i/o timeout"))
+			})
+
+			It("fails with the address", func() {
+				check := findCheck(checks, "Doppler connectivity")
+				Expect(check.Status).To(Equal(CheckFailed))
+				Expect(check.Details).To(ContainSubstring("doppler.some-domain.com:4443"))
+				Expect(check.Details).To(ContainSubstring("i/o timeout"))
+			})
+		})
+	})
+
+	Describe("CLI version", func() {
+		Context("when the CLI is older than the minimum version", func() {
+			BeforeEach(func() {
+				fakeConfig.BinaryVersionReturns("6.20.0")
+			})
+
+			It("fails", func() {
+				check := findCheck(checks, "CLI version")
+				Expect(check.Status).To(Equal(CheckFailed))
+				Expect(check.Details).To(ContainSubstring("6.22.0"))
+			})
+		})
+
+		Context("when the CLI is older than the recommended version", func() {
+			BeforeEach(func() {
+				fakeConfig.BinaryVersionReturns("6.24.0")
+			})
+
+			It("warns", func() {
+				check := findCheck(checks, "CLI version")
+				Expect(check.Status).To(Equal(CheckWarning))
+				Expect(check.Details).To(ContainSubstring("6.26.0"))
+			})
+		})
+
+		Context("when the CLI is a development build", func() {
+			BeforeEach(func() {
+				fakeConfig.BinaryVersionReturns(version.DefaultVersion)
+			})
+
+			It("passes", func() {
+				Expect(findCheck(checks, "CLI version").Status).To(Equal(CheckPassed))
+			})
+		})
+	})
+
+	Describe("Proxy configuration", func() {
+		Context("when a proxy is configured", func() {
+			BeforeEach(func() {
+				fakeNetworkClient.ProxyURLReturns(&url.URL{Scheme: "http", Host: "proxy.some-domain.com:3128"}, nil)
+			})
+
+			It("dials the proxy", func() {
+				Expect(findCheck(checks, "Proxy configuration").Status).To(Equal(CheckPassed))
+				Expect(fakeNetworkClient.ProxyURLArgsForCall(0)).To(Equal("https://api.some-domain.com"))
+				Expect(fakeNetworkClient.DialArgsForCall(1)).To(Equal("proxy.some-domain.com:3128"))
+			})
+
+			Context("when the proxy cannot be reached", func() {
+				BeforeEach(func() {
+					fakeNetworkClient.DialStub = func(address string) error {
+						if address == "proxy.some-domain.com:3128" {
+							return errors.New("connection refused")
+						}
+						return nil
+					}
+				})
+
+				It("fails with advice about the proxy settings", func() {
+					check := findCheck(checks, "Proxy configuration")
+					Expect(check.Status).To(Equal(CheckFailed))
+					Expect(check.Advice).To(ContainSubstring("https_proxy"))
+				})
+			})
+		})
+
+		Context("when the proxy configuration is invalid", func() {
+			BeforeEach(func() {
+				fakeNetworkClient.ProxyURLReturns(nil, errors.New("invalid proxy address"))
+			})
+
+			It("fails", func() {
+				Expect(findCheck(checks, "Proxy configuration").Status).To(Equal(CheckFailed))
+			})
+		})
+	})
+})
@@ -0,0 +1,11 @@
+package diagnosticsaction
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+//go:generate counterfeiter . CloudControllerClient
+
+// CloudControllerClient is a Cloud Controller V2 client.
+type CloudControllerClient interface {
+	Info() (ccv2.APIInformation, ccv2.Warnings, error)
+	TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error)
+}
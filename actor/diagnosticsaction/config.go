@@ -0,0 +1,17 @@
+package diagnosticsaction
+
+import "time"
+
+//go:generate counterfeiter . Config
+
+// Config is the CLI configuration the checks read the target and tokens from.
+type Config interface {
+	AccessToken() string
+	BinaryVersion() string
+	DialTimeout() time.Duration
+	RefreshToken() string
+	SetAccessToken(token string)
+	SetRefreshToken(token string)
+	SkipSSLValidation() bool
+	Target() string
+}
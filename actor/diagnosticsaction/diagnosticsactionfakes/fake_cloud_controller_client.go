@@ -0,0 +1,161 @@
+// This file was generated by counterfeiter
+package diagnosticsactionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/diagnosticsaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+type FakeCloudControllerClient struct {
+	InfoStub        func() (ccv2.APIInformation, ccv2.Warnings, error)
+	infoMutex       sync.RWMutex
+	infoArgsForCall []struct{}
+	infoReturns     struct {
+		result1 ccv2.APIInformation
+		result2 ccv2.Warnings
+		result3 error
+	}
+	infoReturnsOnCall map[int]struct {
+		result1 ccv2.APIInformation
+		result2 ccv2.Warnings
+		result3 error
+	}
+	TargetCFStub        func(settings ccv2.TargetSettings) (ccv2.Warnings, error)
+	targetCFMutex       sync.RWMutex
+	targetCFArgsForCall []struct {
+		settings ccv2.TargetSettings
+	}
+	targetCFReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	targetCFReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCloudControllerClient) Info() (ccv2.APIInformation, ccv2.Warnings, error) {
+	fake.infoMutex.Lock()
+	ret, specificReturn := fake.infoReturnsOnCall[len(fake.infoArgsForCall)]
+	fake.infoArgsForCall = append(fake.infoArgsForCall, struct{}{})
+	fake.recordInvocation("Info", []interface{}{})
+	fake.infoMutex.Unlock()
+	if fake.InfoStub != nil {
+		return fake.InfoStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.infoReturns.result1, fake.infoReturns.result2, fake.infoReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) InfoCallCount() int {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	return len(fake.infoArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) InfoReturns(result1 ccv2.APIInformation, result2 ccv2.Warnings, result3 error) {
+	fake.InfoStub = nil
+	fake.infoReturns = struct {
+		result1 ccv2.APIInformation
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) InfoReturnsOnCall(i int, result1 ccv2.APIInformation, result2 ccv2.Warnings, result3 error) {
+	fake.InfoStub = nil
+	if fake.infoReturnsOnCall == nil {
+		fake.infoReturnsOnCall = make(map[int]struct {
+			result1 ccv2.APIInformation
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.infoReturnsOnCall[i] = struct {
+		result1 ccv2.APIInformation
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error) {
+	fake.targetCFMutex.Lock()
+	ret, specificReturn := fake.targetCFReturnsOnCall[len(fake.targetCFArgsForCall)]
+	fake.targetCFArgsForCall = append(fake.targetCFArgsForCall, struct {
+		settings ccv2.TargetSettings
+	}{settings})
+	fake.recordInvocation("TargetCF", []interface{}{settings})
+	fake.targetCFMutex.Unlock()
+	if fake.TargetCFStub != nil {
+		return fake.TargetCFStub(settings)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.targetCFReturns.result1, fake.targetCFReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) TargetCFCallCount() int {
+	fake.targetCFMutex.RLock()
+	defer fake.targetCFMutex.RUnlock()
+	return len(fake.targetCFArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) TargetCFArgsForCall(i int) ccv2.TargetSettings {
+	fake.targetCFMutex.RLock()
+	defer fake.targetCFMutex.RUnlock()
+	return fake.targetCFArgsForCall[i].settings
+}
+
+func (fake *FakeCloudControllerClient) TargetCFReturns(result1 ccv2.Warnings, result2 error) {
+	fake.TargetCFStub = nil
+	fake.targetCFReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) TargetCFReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.TargetCFStub = nil
+	if fake.targetCFReturnsOnCall == nil {
+		fake.targetCFReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.targetCFReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	fake.targetCFMutex.RLock()
+	defer fake.targetCFMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCloudControllerClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ diagnosticsaction.CloudControllerClient = new(FakeCloudControllerClient)
@@ -0,0 +1,230 @@
+// This file was generated by counterfeiter
+package diagnosticsactionfakes
+
+import (
+	"crypto/x509"
+	"net/url"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/diagnosticsaction"
+)
+
+type FakeNetworkClient struct {
+	DialStub        func(address string) error
+	dialMutex       sync.RWMutex
+	dialArgsForCall []struct {
+		address string
+	}
+	dialReturns struct {
+		result1 error
+	}
+	dialReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PeerCertificatesStub        func(address string, serverName string) ([]*x509.Certificate, error)
+	peerCertificatesMutex       sync.RWMutex
+	peerCertificatesArgsForCall []struct {
+		address    string
+		serverName string
+	}
+	peerCertificatesReturns struct {
+		result1 []*x509.Certificate
+		result2 error
+	}
+	peerCertificatesReturnsOnCall map[int]struct {
+		result1 []*x509.Certificate
+		result2 error
+	}
+	ProxyURLStub        func(rawURL string) (*url.URL, error)
+	proxyURLMutex       sync.RWMutex
+	proxyURLArgsForCall []struct {
+		rawURL string
+	}
+	proxyURLReturns struct {
+		result1 *url.URL
+		result2 error
+	}
+	proxyURLReturnsOnCall map[int]struct {
+		result1 *url.URL
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNetworkClient) Dial(address string) error {
+	fake.dialMutex.Lock()
+	ret, specificReturn := fake.dialReturnsOnCall[len(fake.dialArgsForCall)]
+	fake.dialArgsForCall = append(fake.dialArgsForCall, struct {
+		address string
+	}{address})
+	fake.recordInvocation("Dial", []interface{}{address})
+	fake.dialMutex.Unlock()
+	if fake.DialStub != nil {
+		return fake.DialStub(address)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.dialReturns.result1
+}
+
+func (fake *FakeNetworkClient) DialCallCount() int {
+	fake.dialMutex.RLock()
+	defer fake.dialMutex.RUnlock()
+	return len(fake.dialArgsForCall)
+}
+
+func (fake *FakeNetworkClient) DialArgsForCall(i int) string {
+	fake.dialMutex.RLock()
+	defer fake.dialMutex.RUnlock()
+	return fake.dialArgsForCall[i].address
+}
+
+func (fake *FakeNetworkClient) DialReturns(result1 error) {
+	fake.DialStub = nil
+	fake.dialReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetworkClient) DialReturnsOnCall(i int, result1 error) {
+	fake.DialStub = nil
+	if fake.dialReturnsOnCall == nil {
+		fake.dialReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dialReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetworkClient) PeerCertificates(address string, serverName string) ([]*x509.Certificate, error) {
+	fake.peerCertificatesMutex.Lock()
+	ret, specificReturn := fake.peerCertificatesReturnsOnCall[len(fake.peerCertificatesArgsForCall)]
+	fake.peerCertificatesArgsForCall = append(fake.peerCertificatesArgsForCall, struct {
+		address    string
+		serverName string
+	}{address, serverName})
+	fake.recordInvocation("PeerCertificates", []interface{}{address, serverName})
+	fake.peerCertificatesMutex.Unlock()
+	if fake.PeerCertificatesStub != nil {
+		return fake.PeerCertificatesStub(address, serverName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.peerCertificatesReturns.result1, fake.peerCertificatesReturns.result2
+}
+
+func (fake *FakeNetworkClient) PeerCertificatesCallCount() int {
+	fake.peerCertificatesMutex.RLock()
+	defer fake.peerCertificatesMutex.RUnlock()
+	return len(fake.peerCertificatesArgsForCall)
+}
+
+func (fake *FakeNetworkClient) PeerCertificatesArgsForCall(i int) (string, string) {
+	fake.peerCertificatesMutex.RLock()
+	defer fake.peerCertificatesMutex.RUnlock()
+	return fake.peerCertificatesArgsForCall[i].address, fake.peerCertificatesArgsForCall[i].serverName
+}
+
+func (fake *FakeNetworkClient) PeerCertificatesReturns(result1 []*x509.Certificate, result2 error) {
+	fake.PeerCertificatesStub = nil
+	fake.peerCertificatesReturns = struct {
+		result1 []*x509.Certificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNetworkClient) PeerCertificatesReturnsOnCall(i int, result1 []*x509.Certificate, result2 error) {
+	fake.PeerCertificatesStub = nil
+	if fake.peerCertificatesReturnsOnCall == nil {
+		fake.peerCertificatesReturnsOnCall = make(map[int]struct {
+			result1 []*x509.Certificate
+			result2 error
+		})
+	}
+	fake.peerCertificatesReturnsOnCall[i] = struct {
+		result1 []*x509.Certificate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNetworkClient) ProxyURL(rawURL string) (*url.URL, error) {
+	fake.proxyURLMutex.Lock()
+	ret, specificReturn := fake.proxyURLReturnsOnCall[len(fake.proxyURLArgsForCall)]
+	fake.proxyURLArgsForCall = append(fake.proxyURLArgsForCall, struct {
+		rawURL string
+	}{rawURL})
+	fake.recordInvocation("ProxyURL", []interface{}{rawURL})
+	fake.proxyURLMutex.Unlock()
+	if fake.ProxyURLStub != nil {
+		return fake.ProxyURLStub(rawURL)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.proxyURLReturns.result1, fake.proxyURLReturns.result2
+}
+
+func (fake *FakeNetworkClient) ProxyURLCallCount() int {
+	fake.proxyURLMutex.RLock()
+	defer fake.proxyURLMutex.RUnlock()
+	return len(fake.proxyURLArgsForCall)
+}
+
+func (fake *FakeNetworkClient) ProxyURLArgsForCall(i int) string {
+	fake.proxyURLMutex.RLock()
+	defer fake.proxyURLMutex.RUnlock()
+	return fake.proxyURLArgsForCall[i].rawURL
+}
+
+func (fake *FakeNetworkClient) ProxyURLReturns(result1 *url.URL, result2 error) {
+	fake.ProxyURLStub = nil
+	fake.proxyURLReturns = struct {
+		result1 *url.URL
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNetworkClient) ProxyURLReturnsOnCall(i int, result1 *url.URL, result2 error) {
+	fake.ProxyURLStub = nil
+	if fake.proxyURLReturnsOnCall == nil {
+		fake.proxyURLReturnsOnCall = make(map[int]struct {
+			result1 *url.URL
+			result2 error
+		})
+	}
+	fake.proxyURLReturnsOnCall[i] = struct {
+		result1 *url.URL
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNetworkClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.dialMutex.RLock()
+	defer fake.dialMutex.RUnlock()
+	fake.peerCertificatesMutex.RLock()
+	defer fake.peerCertificatesMutex.RUnlock()
+	fake.proxyURLMutex.RLock()
+	defer fake.proxyURLMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeNetworkClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ diagnosticsaction.NetworkClient = new(FakeNetworkClient)
@@ -0,0 +1,62 @@
+package diagnosticsaction
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+//go:generate counterfeiter . NetworkClient
+
+// NetworkClient makes the raw connections used to check TLS, doppler and
+// proxy connectivity.
+type NetworkClient interface {
+	// Dial opens and closes a TCP connection to the address.
+	Dial(address string) error
+	// PeerCertificates returns the verified certificate chain presented by the
+	// TLS server at the address, using serverName for verification.
+	PeerCertificates(address string, serverName string) ([]*x509.Certificate, error)
+	// ProxyURL returns the proxy used for requests to the URL, or nil when no
+	// proxy is configured.
+	ProxyURL(rawURL string) (*url.URL, error)
+}
+
+type networkClient struct {
+	dialTimeout time.Duration
+}
+
+// NewNetworkClient returns a NetworkClient that connects with the provided
+// dial timeout and reads the proxy configuration from the environment.
+func NewNetworkClient(dialTimeout time.Duration) NetworkClient {
+	return networkClient{dialTimeout: dialTimeout}
+}
+
+func (client networkClient) Dial(address string) error {
+	conn, err := net.DialTimeout("tcp", address, client.dialTimeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (client networkClient) PeerCertificates(address string, serverName string) ([]*x509.Certificate, error) {
+	dialer := &net.Dialer{Timeout: client.dialTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{ServerName: serverName})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return conn.ConnectionState().PeerCertificates, nil
+}
+
+func (networkClient) ProxyURL(rawURL string) (*url.URL, error) {
+	request, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return http.ProxyFromEnvironment(request)
+}
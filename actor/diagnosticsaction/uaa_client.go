@@ -0,0 +1,10 @@
+package diagnosticsaction
+
+import "code.cloudfoundry.org/cli/api/uaa"
+
+//go:generate counterfeiter . UAAClient
+
+// UAAClient is a UAA client.
+type UAAClient interface {
+	RefreshAccessToken(refreshToken string) (uaa.RefreshToken, error)
+}
@@ -1,15 +1,23 @@
 package pluginaction
 
-import "code.cloudfoundry.org/cli/util/configv3"
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
 
 //go:generate counterfeiter . Config
 
 // Config is a way of getting basic CF configuration
 type Config interface {
+	AddPlugin(configv3.Plugin)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
+	LastPluginUpdateCheck() time.Time
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
+	PluginUpdateCheck() bool
 	Plugins() []configv3.Plugin
 	RemovePlugin(string)
+	SetLastPluginUpdateCheck(time.Time)
 	WritePluginConfig() error
 }
@@ -0,0 +1,334 @@
+package pluginaction
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/gofileutils/fileutils"
+)
+
+// PluginInfo contains the information needed to download a plugin binary
+// from a plugin repository.
+type PluginInfo struct {
+	Name     string
+	Version  string
+	URL      string
+	Checksum string
+}
+
+// PluginNotFoundInRepositoryError is returned when a plugin is not found in
+// a plugin repository.
+type PluginNotFoundInRepositoryError struct {
+	PluginName     string
+	RepositoryName string
+}
+
+func (e PluginNotFoundInRepositoryError) Error() string {
+	return fmt.Sprintf("Plugin %s not found in repository %s", e.PluginName, e.RepositoryName)
+}
+
+// NoCompatibleBinaryError is returned when a repository contains a specified
+// plugin but not for the specified platform.
+type NoCompatibleBinaryError struct {
+	Platform string
+}
+
+func (e NoCompatibleBinaryError) Error() string {
+	return fmt.Sprintf("Plugin requested has no binary available for platform %s", e.Platform)
+}
+
+// PluginInvalidError is returned with a plugin binary is invalid.
+type PluginInvalidError struct {
+	Err error
+}
+
+func (e PluginInvalidError) Error() string {
+	return "File is not a valid cf CLI plugin binary."
+}
+
+// PluginAlreadyInstalledError is returned when a plugin with the same name is
+// already installed.
+type PluginAlreadyInstalledError struct {
+	Name    string
+	Version string
+}
+
+func (e PluginAlreadyInstalledError) Error() string {
+	return fmt.Sprintf("Plugin %s %s is already installed", e.Name, e.Version)
+}
+
+// PluginCommandsConflictError is returned when a plugin command name or alias
+// conflicts with a native command or a command provided by an installed
+// plugin.
+type PluginCommandsConflictError struct {
+	PluginName     string
+	PluginVersion  string
+	CommandNames   []string
+	CommandAliases []string
+}
+
+func (e PluginCommandsConflictError) Error() string {
+	return fmt.Sprintf("Plugin %s %s has command names or aliases that are already in use", e.PluginName, e.PluginVersion)
+}
+
+// PluginBinaryConflictError is returned when the binary of a plugin would be
+// installed at the location of the binary of another installed plugin.
+type PluginBinaryConflictError struct {
+	PluginName            string
+	ConflictingPluginName string
+	Path                  string
+}
+
+func (e PluginBinaryConflictError) Error() string {
+	return fmt.Sprintf("Plugin %s cannot be installed at %s which is used by plugin %s", e.PluginName, e.Path, e.ConflictingPluginName)
+}
+
+//go:generate counterfeiter . PluginMetadata
+
+// PluginMetadata retrieves the metadata advertised by a plugin binary.
+type PluginMetadata interface {
+	GetMetadata(pluginPath string) (configv3.Plugin, error)
+}
+
+//go:generate counterfeiter . CommandList
+
+// CommandList reports whether a name is already used by a native command or
+// alias.
+type CommandList interface {
+	HasCommand(name string) bool
+}
+
+// FileExists returns true if the file exists. It returns false if the file
+// doesn't exist or there is an error checking.
+func (actor Actor) FileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// GetPlatformString returns the name used by plugin repositories for the
+// provided OS and architecture.
+func (actor Actor) GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string {
+	switch runtimeGOOS {
+	case "darwin":
+		return "osx"
+	case "linux":
+		if runtimeGOARCH == "386" {
+			return "linux32"
+		}
+		return "linux64"
+	case "windows":
+		if runtimeGOARCH == "386" {
+			return "win32"
+		}
+		return "win64"
+	default:
+		return fmt.Sprintf("%s-%s", runtimeGOOS, runtimeGOARCH)
+	}
+}
+
+// GetPluginInfoFromRepository returns the information needed to download the
+// binary of the plugin with the provided name for the provided platform from
+// the registered repository with the provided name.
+func (actor Actor) GetPluginInfoFromRepository(pluginName string, repositoryName string, platform string) (PluginInfo, error) {
+	repository, err := actor.GetPluginRepository(repositoryName)
+	if err != nil {
+		return PluginInfo{}, err
+	}
+
+	plugins, err := actor.GetRepositoryPlugins(repository)
+	if err != nil {
+		return PluginInfo{}, err
+	}
+
+	for _, plugin := range plugins {
+		if !strings.EqualFold(plugin.Name, pluginName) {
+			continue
+		}
+
+		for _, binary := range plugin.Binaries {
+			if binary.Platform == platform {
+				return PluginInfo{
+					Name:     plugin.Name,
+					Version:  plugin.Version,
+					URL:      binary.URL,
+					Checksum: binary.Checksum,
+				}, nil
+			}
+		}
+		return PluginInfo{}, NoCompatibleBinaryError{Platform: platform}
+	}
+
+	return PluginInfo{}, PluginNotFoundInRepositoryError{PluginName: pluginName, RepositoryName: repository.Name}
+}
+
+// DownloadExecutableBinaryFromURL downloads the plugin binary at the provided
+// URL into tempPluginDir. It returns the path to the downloaded binary and
+// the number of bytes downloaded.
+func (actor Actor) DownloadExecutableBinaryFromURL(pluginURL string, tempPluginDir string) (string, int64, error) {
+	parsedURL, err := url.Parse(pluginURL)
+	if err != nil {
+		return "", 0, err
+	}
+
+	fileName := path.Base(parsedURL.Path)
+	if fileName == "/" || fileName == "." {
+		fileName = "plugin"
+	}
+
+	binaryPath := filepath.Join(tempPluginDir, fileName)
+	size, err := actor.client.DownloadPlugin(pluginURL, binaryPath)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return binaryPath, size, os.Chmod(binaryPath, 0700)
+}
+
+// ValidateFileChecksum returns true if the file at the provided path matches
+// the provided checksum. SHA1 and SHA256 checksums are supported and are
+// distinguished by their length.
+func (actor Actor) ValidateFileChecksum(path string, checksum string) bool {
+	var hasher hash.Hash
+	switch len(checksum) {
+	case sha1.Size * 2:
+		hasher = sha1.New()
+	case sha256.Size * 2:
+		hasher = sha256.New()
+	default:
+		return false
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	_, err = io.Copy(hasher, file)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(hex.EncodeToString(hasher.Sum(nil)), checksum)
+}
+
+// GetAndValidatePlugin retrieves the metadata of the plugin binary at the
+// provided path and ensures that it can be installed alongside the native
+// commands and the currently installed plugins.
+func (actor Actor) GetAndValidatePlugin(metadata PluginMetadata, commands CommandList, path string) (configv3.Plugin, error) {
+	return actor.getAndValidatePlugin(metadata, commands, path, "")
+}
+
+// getAndValidatePlugin validates the plugin binary at the provided path. The
+// installed plugin named replacing, if any, is ignored when checking for
+// conflicts since it is about to be replaced.
+func (actor Actor) getAndValidatePlugin(metadata PluginMetadata, commands CommandList, path string, replacing string) (configv3.Plugin, error) {
+	plugin, err := metadata.GetMetadata(path)
+	if err != nil || !validPluginName(plugin.Name) || len(plugin.Commands) == 0 {
+		return configv3.Plugin{}, PluginInvalidError{Err: err}
+	}
+
+	if replacing == "" {
+		if installedPlugin, exist := actor.config.GetPlugin(plugin.Name); exist {
+			return configv3.Plugin{}, PluginAlreadyInstalledError{
+				Name:    installedPlugin.Name,
+				Version: installedPlugin.Version.String(),
+			}
+		}
+	} else if plugin.Name != replacing {
+		return configv3.Plugin{}, PluginInvalidError{}
+	}
+
+	installPath := actor.pluginInstallPath(plugin.Name)
+	usedNames := map[string]bool{}
+	for _, installedPlugin := range actor.config.Plugins() {
+		if installedPlugin.Name == replacing {
+			continue
+		}
+		if installedPlugin.Location == installPath {
+			return configv3.Plugin{}, PluginBinaryConflictError{
+				PluginName:            plugin.Name,
+				ConflictingPluginName: installedPlugin.Name,
+				Path:                  installPath,
+			}
+		}
+		for _, command := range installedPlugin.Commands {
+			usedNames[command.Name] = true
+			if command.Alias != "" {
+				usedNames[command.Alias] = true
+			}
+		}
+	}
+
+	var conflictingNames, conflictingAliases []string
+	for _, command := range plugin.Commands {
+		if commands.HasCommand(command.Name) || usedNames[command.Name] {
+			conflictingNames = append(conflictingNames, command.Name)
+		}
+		if command.Alias != "" && (commands.HasCommand(command.Alias) || usedNames[command.Alias]) {
+			conflictingAliases = append(conflictingAliases, command.Alias)
+		}
+	}
+
+	if len(conflictingNames) > 0 || len(conflictingAliases) > 0 {
+		sort.Strings(conflictingNames)
+		sort.Strings(conflictingAliases)
+		return configv3.Plugin{}, PluginCommandsConflictError{
+			PluginName:     plugin.Name,
+			PluginVersion:  plugin.Version.String(),
+			CommandNames:   conflictingNames,
+			CommandAliases: conflictingAliases,
+		}
+	}
+
+	return plugin, nil
+}
+
+// InstallPluginFromPath copies the plugin binary at the provided path into
+// the plugin home directory, naming it after the plugin, and adds the plugin
+// to the plugin config.
+func (actor Actor) InstallPluginFromPath(path string, plugin configv3.Plugin) error {
+	installPath := actor.pluginInstallPath(plugin.Name)
+	err := fileutils.CopyPathToPath(path, installPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(installPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	plugin.Location = installPath
+	actor.config.AddPlugin(plugin)
+
+	return actor.config.WritePluginConfig()
+}
+
+// pluginInstallPath returns the location of the binary of the plugin with the
+// provided name in the plugin home directory.
+func (actor Actor) pluginInstallPath(pluginName string) string {
+	fileName := pluginName
+	if runtime.GOOS == "windows" {
+		fileName += ".exe"
+	}
+	return filepath.Join(actor.config.PluginHome(), fileName)
+}
+
+// validPluginName returns true if the name can be used as the file name of
+// the plugin binary.
+func validPluginName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
@@ -0,0 +1,356 @@
+package pluginaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("install actions", func() {
+	var (
+		actor            Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+		tempDir          string
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "pluginaction-install-test")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("FileExists", func() {
+		It("returns true only when the file exists", func() {
+			path := filepath.Join(tempDir, "some-file")
+			Expect(actor.FileExists(path)).To(BeFalse())
+			Expect(ioutil.WriteFile(path, []byte("foo"), 0600)).To(Succeed())
+			Expect(actor.FileExists(path)).To(BeTrue())
+		})
+	})
+
+	DescribeTable("GetPlatformString",
+		func(goos string, goarch string, expected string) {
+			Expect(actor.GetPlatformString(goos, goarch)).To(Equal(expected))
+		},
+
+		Entry("darwin", "darwin", "amd64", "osx"),
+		Entry("linux 32 bit", "linux", "386", "linux32"),
+		Entry("linux 64 bit", "linux", "amd64", "linux64"),
+		Entry("windows 32 bit", "windows", "386", "win32"),
+		Entry("windows 64 bit", "windows", "amd64", "win64"),
+		Entry("unknown platforms", "freebsd", "arm", "freebsd-arm"),
+	)
+
+	Describe("GetPluginInfoFromRepository", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "some-repo", URL: "https://some-repo.com"},
+			})
+		})
+
+		Context("when the repository is not registered", func() {
+			It("returns a RepositoryNotRegisteredError", func() {
+				_, err := actor.GetPluginInfoFromRepository("some-plugin", "other-repo", "linux64")
+				Expect(err).To(MatchError(RepositoryNotRegisteredError{Name: "other-repo"}))
+			})
+		})
+
+		Context("when the repository is registered", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{
+							Name:    "some-plugin",
+							Version: "1.2.3",
+							Binaries: []plugin.PluginBinary{
+								{Platform: "osx", URL: "https://some-repo.com/osx", Checksum: "osx-checksum"},
+								{Platform: "linux64", URL: "https://some-repo.com/linux64", Checksum: "linux64-checksum"},
+							},
+						},
+					},
+				}, nil)
+			})
+
+			It("returns the binary for the requested platform", func() {
+				info, err := actor.GetPluginInfoFromRepository("Some-Plugin", "some-repo", "linux64")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info).To(Equal(PluginInfo{
+					Name:     "some-plugin",
+					Version:  "1.2.3",
+					URL:      "https://some-repo.com/linux64",
+					Checksum: "linux64-checksum",
+				}))
+
+				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://some-repo.com"))
+			})
+
+			Context("when there is no binary for the platform", func() {
+				It("returns a NoCompatibleBinaryError", func() {
+					_, err := actor.GetPluginInfoFromRepository("some-plugin", "some-repo", "win64")
+					Expect(err).To(MatchError(NoCompatibleBinaryError{Platform: "win64"}))
+				})
+			})
+
+			Context("when the plugin is not in the repository", func() {
+				It("returns a PluginNotFoundInRepositoryError", func() {
+					_, err := actor.GetPluginInfoFromRepository("other-plugin", "some-repo", "linux64")
+					Expect(err).To(MatchError(PluginNotFoundInRepositoryError{PluginName: "other-plugin", RepositoryName: "some-repo"}))
+				})
+			})
+		})
+	})
+
+	Describe("DownloadExecutableBinaryFromURL", func() {
+		Context("when the download succeeds", func() {
+			BeforeEach(func() {
+				fakePluginClient.DownloadPluginStub = func(_ string, path string) (int64, error) {
+					return 3, ioutil.WriteFile(path, []byte("foo"), 0600)
+				}
+			})
+
+			It("downloads the binary into the provided directory", func() {
+				path, size, err := actor.DownloadExecutableBinaryFromURL("https://some-repo.com/bin/some-plugin", tempDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(path).To(Equal(filepath.Join(tempDir, "some-plugin")))
+				Expect(size).To(BeEquivalentTo(3))
+
+				Expect(fakePluginClient.DownloadPluginCallCount()).To(Equal(1))
+				url, downloadPath := fakePluginClient.DownloadPluginArgsForCall(0)
+				Expect(url).To(Equal("https://some-repo.com/bin/some-plugin"))
+				Expect(downloadPath).To(Equal(path))
+			})
+		})
+
+		Context("when the download errors", func() {
+			BeforeEach(func() {
+				fakePluginClient.DownloadPluginReturns(0, errors.New("download-error"))
+			})
+
+			It("returns the error", func() {
+				_, _, err := actor.DownloadExecutableBinaryFromURL("https://some-repo.com/some-plugin", tempDir)
+				Expect(err).To(MatchError("download-error"))
+			})
+		})
+	})
+
+	Describe("ValidateFileChecksum", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(tempDir, "some-file")
+			Expect(ioutil.WriteFile(path, []byte("foo"), 0600)).To(Succeed())
+		})
+
+		It("supports SHA1 checksums", func() {
+			Expect(actor.ValidateFileChecksum(path, "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33")).To(BeTrue())
+			Expect(actor.ValidateFileChecksum(path, "0000000000000000000000000000000000000000")).To(BeFalse())
+		})
+
+		It("supports SHA256 checksums", func() {
+			Expect(actor.ValidateFileChecksum(path, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")).To(BeTrue())
+			Expect(actor.ValidateFileChecksum(path, "0000000000000000000000000000000000000000000000000000000000000000")).To(BeFalse())
+		})
+
+		It("rejects checksums of unknown length", func() {
+			Expect(actor.ValidateFileChecksum(path, "abc")).To(BeFalse())
+		})
+	})
+
+	Describe("GetAndValidatePlugin", func() {
+		var (
+			fakeMetadata    *pluginactionfakes.FakePluginMetadata
+			fakeCommandList *pluginactionfakes.FakeCommandList
+			newPlugin       configv3.Plugin
+		)
+
+		BeforeEach(func() {
+			fakeMetadata = new(pluginactionfakes.FakePluginMetadata)
+			fakeCommandList = new(pluginactionfakes.FakeCommandList)
+			newPlugin = configv3.Plugin{
+				Name:    "some-plugin",
+				Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+				Commands: []configv3.PluginCommand{
+					{Name: "some-command", Alias: "sc"},
+					{Name: "other-command"},
+				},
+			}
+		})
+
+		Context("when the metadata cannot be retrieved", func() {
+			BeforeEach(func() {
+				fakeMetadata.GetMetadataReturns(configv3.Plugin{}, errors.New("exec error"))
+			})
+
+			It("returns a PluginInvalidError", func() {
+				_, err := actor.GetAndValidatePlugin(fakeMetadata, fakeCommandList, "some-path")
+				Expect(err).To(MatchError(PluginInvalidError{Err: errors.New("exec error")}))
+				Expect(fakeMetadata.GetMetadataArgsForCall(0)).To(Equal("some-path"))
+			})
+		})
+
+		Context("when the plugin has no commands", func() {
+			BeforeEach(func() {
+				fakeMetadata.GetMetadataReturns(configv3.Plugin{Name: "some-plugin"}, nil)
+			})
+
+			It("returns a PluginInvalidError", func() {
+				_, err := actor.GetAndValidatePlugin(fakeMetadata, fakeCommandList, "some-path")
+				Expect(err).To(MatchError(PluginInvalidError{}))
+			})
+		})
+
+		DescribeTable("when the plugin name cannot be used as a file name",
+			func(name string) {
+				newPlugin.Name = name
+				fakeMetadata.GetMetadataReturns(newPlugin, nil)
+
+				_, err := actor.GetAndValidatePlugin(fakeMetadata, fakeCommandList, "some-path")
+				Expect(err).To(MatchError(PluginInvalidError{}))
+			},
+
+			Entry("empty", ""),
+			Entry("parent directory", ".."),
+			Entry("slash", "some/plugin"),
+			Entry("backslash", `some\plugin`),
+		)
+
+		Context("when the metadata is valid", func() {
+			BeforeEach(func() {
+				fakeMetadata.GetMetadataReturns(newPlugin, nil)
+				fakeConfig.PluginHomeReturns("some-plugin-home")
+			})
+
+			Context("when another installed plugin's binary is at the plugin's install path", func() {
+				var location string
+
+				BeforeEach(func() {
+					location = filepath.Join("some-plugin-home", "some-plugin")
+					if runtime.GOOS == "windows" {
+						location += ".exe"
+					}
+					fakeConfig.PluginsReturns([]configv3.Plugin{
+						{Name: "other-plugin", Location: location},
+					})
+				})
+
+				It("returns a PluginBinaryConflictError", func() {
+					_, err := actor.GetAndValidatePlugin(fakeMetadata, fakeCommandList, "some-path")
+					Expect(err).To(MatchError(PluginBinaryConflictError{
+						PluginName:            "some-plugin",
+						ConflictingPluginName: "other-plugin",
+						Path:                  location,
+					}))
+				})
+			})
+
+			Context("when there are no conflicts", func() {
+				It("returns the plugin", func() {
+					plugin, err := actor.GetAndValidatePlugin(fakeMetadata, fakeCommandList, "some-path")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(plugin).To(Equal(newPlugin))
+				})
+			})
+
+			Context("when the plugin is already installed", func() {
+				BeforeEach(func() {
+					fakeConfig.GetPluginReturns(configv3.Plugin{
+						Name:    "some-plugin",
+						Version: configv3.PluginVersion{Major: 1},
+					}, true)
+				})
+
+				It("returns a PluginAlreadyInstalledError", func() {
+					_, err := actor.GetAndValidatePlugin(fakeMetadata, fakeCommandList, "some-path")
+					Expect(err).To(MatchError(PluginAlreadyInstalledError{Name: "some-plugin", Version: "1.0.0"}))
+				})
+			})
+
+			Context("when commands conflict with native commands and installed plugins", func() {
+				BeforeEach(func() {
+					fakeCommandList.HasCommandStub = func(name string) bool {
+						return name == "some-command"
+					}
+					fakeConfig.PluginsReturns([]configv3.Plugin{
+						{
+							Name:     "installed-plugin",
+							Commands: []configv3.PluginCommand{{Name: "installed-command", Alias: "sc"}},
+						},
+					})
+				})
+
+				It("returns a PluginCommandsConflictError", func() {
+					_, err := actor.GetAndValidatePlugin(fakeMetadata, fakeCommandList, "some-path")
+					Expect(err).To(MatchError(PluginCommandsConflictError{
+						PluginName:     "some-plugin",
+						PluginVersion:  "1.2.3",
+						CommandNames:   []string{"some-command"},
+						CommandAliases: []string{"sc"},
+					}))
+				})
+			})
+		})
+	})
+
+	Describe("InstallPluginFromPath", func() {
+		var (
+			pluginHome string
+			pluginPath string
+		)
+
+		BeforeEach(func() {
+			pluginHome = filepath.Join(tempDir, "plugins")
+			fakeConfig.PluginHomeReturns(pluginHome)
+
+			pluginPath = filepath.Join(tempDir, "plugin")
+			Expect(ioutil.WriteFile(pluginPath, []byte("foo"), 0600)).To(Succeed())
+		})
+
+		It("copies the binary into the plugin home under the plugin's name and saves the plugin config", func() {
+			err := actor.InstallPluginFromPath(pluginPath, configv3.Plugin{Name: "some-plugin"})
+			Expect(err).ToNot(HaveOccurred())
+
+			installedPath := filepath.Join(pluginHome, "some-plugin")
+			if runtime.GOOS == "windows" {
+				installedPath += ".exe"
+			}
+			Expect(ioutil.ReadFile(installedPath)).To(Equal([]byte("foo")))
+
+			Expect(fakeConfig.AddPluginCallCount()).To(Equal(1))
+			Expect(fakeConfig.AddPluginArgsForCall(0)).To(Equal(configv3.Plugin{
+				Name:     "some-plugin",
+				Location: installedPath,
+			}))
+			Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(1))
+		})
+
+		Context("when writing the config fails", func() {
+			BeforeEach(func() {
+				fakeConfig.WritePluginConfigReturns(errors.New("write-error"))
+			})
+
+			It("returns the error", func() {
+				err := actor.InstallPluginFromPath(pluginPath, configv3.Plugin{Name: "some-plugin"})
+				Expect(err).To(MatchError("write-error"))
+			})
+		})
+	})
+})
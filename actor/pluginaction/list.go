@@ -6,10 +6,13 @@ import (
 	"github.com/blang/semver"
 )
 
+// OutdatedPlugin represents an installed plugin for which a plugin repository
+// provides a newer version.
 type OutdatedPlugin struct {
 	Name           string
 	CurrentVersion string
 	LatestVersion  string
+	RepositoryName string
 }
 
 // GettingPluginRepositoryError is returned when there's an error
@@ -26,7 +29,12 @@ func (e GettingPluginRepositoryError) Error() string {
 func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 	var outdatedPlugins []OutdatedPlugin
 
-	repoPlugins := map[string]string{}
+	type repoPlugin struct {
+		version        string
+		repositoryName string
+	}
+
+	repoPlugins := map[string]repoPlugin{}
 	for _, repo := range actor.config.PluginRepositories() {
 		repository, err := actor.client.GetPluginRepository(repo.URL)
 		if err != nil {
@@ -34,24 +42,21 @@ func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 		}
 
 		for _, plugin := range repository.Plugins {
-			existingVersion, exist := repoPlugins[plugin.Name]
-			if exist {
-				if lessThan(existingVersion, plugin.Version) {
-					repoPlugins[plugin.Name] = plugin.Version
-				}
-			} else {
-				repoPlugins[plugin.Name] = plugin.Version
+			existing, exist := repoPlugins[plugin.Name]
+			if !exist || lessThan(existing.version, plugin.Version) {
+				repoPlugins[plugin.Name] = repoPlugin{version: plugin.Version, repositoryName: repo.Name}
 			}
 		}
 	}
 
 	for _, installedPlugin := range actor.config.Plugins() {
-		repoVersion, exist := repoPlugins[installedPlugin.Name]
-		if exist && lessThan(installedPlugin.Version.String(), repoVersion) {
+		latest, exist := repoPlugins[installedPlugin.Name]
+		if exist && lessThan(installedPlugin.Version.String(), latest.version) {
 			outdatedPlugins = append(outdatedPlugins, OutdatedPlugin{
 				Name:           installedPlugin.Name,
 				CurrentVersion: installedPlugin.Version.String(),
-				LatestVersion:  repoVersion,
+				LatestVersion:  latest.version,
+				RepositoryName: latest.repositoryName,
 			})
 		}
 	}
@@ -59,13 +64,16 @@ func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 	return outdatedPlugins, nil
 }
 
+// lessThan returns true if version1 is lower than version2. Versions that are
+// missing a patch number or are prefixed with "v" are tolerated; versions that
+// cannot be parsed are never considered lower.
 func lessThan(version1 string, version2 string) bool {
-	v1, err := semver.Make(version1)
+	v1, err := semver.ParseTolerant(version1)
 	if err != nil {
 		return false
 	}
 
-	v2, err := semver.Make(version2)
+	v2, err := semver.ParseTolerant(version2)
 	if err != nil {
 		return false
 	}
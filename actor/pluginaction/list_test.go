@@ -84,8 +84,8 @@ var _ = Describe("Plugin actions", func() {
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(outdatedPlugins).To(Equal([]OutdatedPlugin{
-						{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
-						{Name: "plugin-2", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
+						{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "2.0.0", RepositoryName: "CF-Community"},
+						{Name: "plugin-2", CurrentVersion: "1.0.0", LatestVersion: "2.0.0", RepositoryName: "Coo Plugins"},
 					}))
 				})
 			})
@@ -5,5 +5,6 @@ import "code.cloudfoundry.org/cli/api/plugin"
 //go:generate counterfeiter . PluginClient
 
 type PluginClient interface {
+	DownloadPlugin(pluginURL string, path string) (int64, error)
 	GetPluginRepository(repositoryURL string) (plugin.PluginRepository, error)
 }
@@ -0,0 +1,94 @@
+// This file was generated by counterfeiter
+package pluginactionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+)
+
+type FakeCommandList struct {
+	HasCommandStub        func(name string) bool
+	hasCommandMutex       sync.RWMutex
+	hasCommandArgsForCall []struct {
+		name string
+	}
+	hasCommandReturns struct {
+		result1 bool
+	}
+	hasCommandReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCommandList) HasCommand(name string) bool {
+	fake.hasCommandMutex.Lock()
+	ret, specificReturn := fake.hasCommandReturnsOnCall[len(fake.hasCommandArgsForCall)]
+	fake.hasCommandArgsForCall = append(fake.hasCommandArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("HasCommand", []interface{}{name})
+	fake.hasCommandMutex.Unlock()
+	if fake.HasCommandStub != nil {
+		return fake.HasCommandStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.hasCommandReturns.result1
+}
+
+func (fake *FakeCommandList) HasCommandCallCount() int {
+	fake.hasCommandMutex.RLock()
+	defer fake.hasCommandMutex.RUnlock()
+	return len(fake.hasCommandArgsForCall)
+}
+
+func (fake *FakeCommandList) HasCommandArgsForCall(i int) string {
+	fake.hasCommandMutex.RLock()
+	defer fake.hasCommandMutex.RUnlock()
+	return fake.hasCommandArgsForCall[i].name
+}
+
+func (fake *FakeCommandList) HasCommandReturns(result1 bool) {
+	fake.HasCommandStub = nil
+	fake.hasCommandReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeCommandList) HasCommandReturnsOnCall(i int, result1 bool) {
+	fake.HasCommandStub = nil
+	if fake.hasCommandReturnsOnCall == nil {
+		fake.hasCommandReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.hasCommandReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeCommandList) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.hasCommandMutex.RLock()
+	defer fake.hasCommandMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCommandList) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pluginaction.CommandList = new(FakeCommandList)
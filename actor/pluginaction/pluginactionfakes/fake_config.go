@@ -3,12 +3,18 @@ package pluginactionfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type FakeConfig struct {
+	AddPluginStub        func(configv3.Plugin)
+	addPluginMutex       sync.RWMutex
+	addPluginArgsForCall []struct {
+		arg1 configv3.Plugin
+	}
 	GetPluginStub        func(pluginName string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -22,6 +28,15 @@ type FakeConfig struct {
 		result1 configv3.Plugin
 		result2 bool
 	}
+	LastPluginUpdateCheckStub        func() time.Time
+	lastPluginUpdateCheckMutex       sync.RWMutex
+	lastPluginUpdateCheckArgsForCall []struct{}
+	lastPluginUpdateCheckReturns     struct {
+		result1 time.Time
+	}
+	lastPluginUpdateCheckReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct{}
@@ -40,6 +55,15 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginUpdateCheckStub        func() bool
+	pluginUpdateCheckMutex       sync.RWMutex
+	pluginUpdateCheckArgsForCall []struct{}
+	pluginUpdateCheckReturns     struct {
+		result1 bool
+	}
+	pluginUpdateCheckReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct{}
@@ -54,6 +78,11 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	SetLastPluginUpdateCheckStub        func(time.Time)
+	setLastPluginUpdateCheckMutex       sync.RWMutex
+	setLastPluginUpdateCheckArgsForCall []struct {
+		arg1 time.Time
+	}
 	WritePluginConfigStub        func() error
 	writePluginConfigMutex       sync.RWMutex
 	writePluginConfigArgsForCall []struct{}
@@ -67,6 +96,30 @@ type FakeConfig struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeConfig) AddPlugin(arg1 configv3.Plugin) {
+	fake.addPluginMutex.Lock()
+	fake.addPluginArgsForCall = append(fake.addPluginArgsForCall, struct {
+		arg1 configv3.Plugin
+	}{arg1})
+	fake.recordInvocation("AddPlugin", []interface{}{arg1})
+	fake.addPluginMutex.Unlock()
+	if fake.AddPluginStub != nil {
+		fake.AddPluginStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) AddPluginCallCount() int {
+	fake.addPluginMutex.RLock()
+	defer fake.addPluginMutex.RUnlock()
+	return len(fake.addPluginArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginArgsForCall(i int) configv3.Plugin {
+	fake.addPluginMutex.RLock()
+	defer fake.addPluginMutex.RUnlock()
+	return fake.addPluginArgsForCall[i].arg1
+}
+
 func (fake *FakeConfig) GetPlugin(pluginName string) (configv3.Plugin, bool) {
 	fake.getPluginMutex.Lock()
 	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
@@ -118,6 +171,46 @@ func (fake *FakeConfig) GetPluginReturnsOnCall(i int, result1 configv3.Plugin, r
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) LastPluginUpdateCheck() time.Time {
+	fake.lastPluginUpdateCheckMutex.Lock()
+	ret, specificReturn := fake.lastPluginUpdateCheckReturnsOnCall[len(fake.lastPluginUpdateCheckArgsForCall)]
+	fake.lastPluginUpdateCheckArgsForCall = append(fake.lastPluginUpdateCheckArgsForCall, struct{}{})
+	fake.recordInvocation("LastPluginUpdateCheck", []interface{}{})
+	fake.lastPluginUpdateCheckMutex.Unlock()
+	if fake.LastPluginUpdateCheckStub != nil {
+		return fake.LastPluginUpdateCheckStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.lastPluginUpdateCheckReturns.result1
+}
+
+func (fake *FakeConfig) LastPluginUpdateCheckCallCount() int {
+	fake.lastPluginUpdateCheckMutex.RLock()
+	defer fake.lastPluginUpdateCheckMutex.RUnlock()
+	return len(fake.lastPluginUpdateCheckArgsForCall)
+}
+
+func (fake *FakeConfig) LastPluginUpdateCheckReturns(result1 time.Time) {
+	fake.LastPluginUpdateCheckStub = nil
+	fake.lastPluginUpdateCheckReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeConfig) LastPluginUpdateCheckReturnsOnCall(i int, result1 time.Time) {
+	fake.LastPluginUpdateCheckStub = nil
+	if fake.lastPluginUpdateCheckReturnsOnCall == nil {
+		fake.lastPluginUpdateCheckReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastPluginUpdateCheckReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginHome() string {
 	fake.pluginHomeMutex.Lock()
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
@@ -198,6 +291,46 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginUpdateCheck() bool {
+	fake.pluginUpdateCheckMutex.Lock()
+	ret, specificReturn := fake.pluginUpdateCheckReturnsOnCall[len(fake.pluginUpdateCheckArgsForCall)]
+	fake.pluginUpdateCheckArgsForCall = append(fake.pluginUpdateCheckArgsForCall, struct{}{})
+	fake.recordInvocation("PluginUpdateCheck", []interface{}{})
+	fake.pluginUpdateCheckMutex.Unlock()
+	if fake.PluginUpdateCheckStub != nil {
+		return fake.PluginUpdateCheckStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pluginUpdateCheckReturns.result1
+}
+
+func (fake *FakeConfig) PluginUpdateCheckCallCount() int {
+	fake.pluginUpdateCheckMutex.RLock()
+	defer fake.pluginUpdateCheckMutex.RUnlock()
+	return len(fake.pluginUpdateCheckArgsForCall)
+}
+
+func (fake *FakeConfig) PluginUpdateCheckReturns(result1 bool) {
+	fake.PluginUpdateCheckStub = nil
+	fake.pluginUpdateCheckReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginUpdateCheckReturnsOnCall(i int, result1 bool) {
+	fake.PluginUpdateCheckStub = nil
+	if fake.pluginUpdateCheckReturnsOnCall == nil {
+		fake.pluginUpdateCheckReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.pluginUpdateCheckReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -262,6 +395,30 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) SetLastPluginUpdateCheck(arg1 time.Time) {
+	fake.setLastPluginUpdateCheckMutex.Lock()
+	fake.setLastPluginUpdateCheckArgsForCall = append(fake.setLastPluginUpdateCheckArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("SetLastPluginUpdateCheck", []interface{}{arg1})
+	fake.setLastPluginUpdateCheckMutex.Unlock()
+	if fake.SetLastPluginUpdateCheckStub != nil {
+		fake.SetLastPluginUpdateCheckStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetLastPluginUpdateCheckCallCount() int {
+	fake.setLastPluginUpdateCheckMutex.RLock()
+	defer fake.setLastPluginUpdateCheckMutex.RUnlock()
+	return len(fake.setLastPluginUpdateCheckArgsForCall)
+}
+
+func (fake *FakeConfig) SetLastPluginUpdateCheckArgsForCall(i int) time.Time {
+	fake.setLastPluginUpdateCheckMutex.RLock()
+	defer fake.setLastPluginUpdateCheckMutex.RUnlock()
+	return fake.setLastPluginUpdateCheckArgsForCall[i].arg1
+}
+
 func (fake *FakeConfig) WritePluginConfig() error {
 	fake.writePluginConfigMutex.Lock()
 	ret, specificReturn := fake.writePluginConfigReturnsOnCall[len(fake.writePluginConfigArgsForCall)]
@@ -305,16 +462,24 @@ func (fake *FakeConfig) WritePluginConfigReturnsOnCall(i int, result1 error) {
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addPluginMutex.RLock()
+	defer fake.addPluginMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
+	fake.lastPluginUpdateCheckMutex.RLock()
+	defer fake.lastPluginUpdateCheckMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
+	fake.pluginUpdateCheckMutex.RLock()
+	defer fake.pluginUpdateCheckMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.setLastPluginUpdateCheckMutex.RLock()
+	defer fake.setLastPluginUpdateCheckMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
 	return fake.invocations
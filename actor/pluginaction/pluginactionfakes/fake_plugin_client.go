@@ -9,6 +9,20 @@ import (
 )
 
 type FakePluginClient struct {
+	DownloadPluginStub        func(pluginURL string, path string) (int64, error)
+	downloadPluginMutex       sync.RWMutex
+	downloadPluginArgsForCall []struct {
+		pluginURL string
+		path      string
+	}
+	downloadPluginReturns struct {
+		result1 int64
+		result2 error
+	}
+	downloadPluginReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
 	GetPluginRepositoryStub        func(repositoryURL string) (plugin.PluginRepository, error)
 	getPluginRepositoryMutex       sync.RWMutex
 	getPluginRepositoryArgsForCall []struct {
@@ -26,6 +40,58 @@ type FakePluginClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePluginClient) DownloadPlugin(pluginURL string, path string) (int64, error) {
+	fake.downloadPluginMutex.Lock()
+	ret, specificReturn := fake.downloadPluginReturnsOnCall[len(fake.downloadPluginArgsForCall)]
+	fake.downloadPluginArgsForCall = append(fake.downloadPluginArgsForCall, struct {
+		pluginURL string
+		path      string
+	}{pluginURL, path})
+	fake.recordInvocation("DownloadPlugin", []interface{}{pluginURL, path})
+	fake.downloadPluginMutex.Unlock()
+	if fake.DownloadPluginStub != nil {
+		return fake.DownloadPluginStub(pluginURL, path)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.downloadPluginReturns.result1, fake.downloadPluginReturns.result2
+}
+
+func (fake *FakePluginClient) DownloadPluginCallCount() int {
+	fake.downloadPluginMutex.RLock()
+	defer fake.downloadPluginMutex.RUnlock()
+	return len(fake.downloadPluginArgsForCall)
+}
+
+func (fake *FakePluginClient) DownloadPluginArgsForCall(i int) (string, string) {
+	fake.downloadPluginMutex.RLock()
+	defer fake.downloadPluginMutex.RUnlock()
+	return fake.downloadPluginArgsForCall[i].pluginURL, fake.downloadPluginArgsForCall[i].path
+}
+
+func (fake *FakePluginClient) DownloadPluginReturns(result1 int64, result2 error) {
+	fake.DownloadPluginStub = nil
+	fake.downloadPluginReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginClient) DownloadPluginReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.DownloadPluginStub = nil
+	if fake.downloadPluginReturnsOnCall == nil {
+		fake.downloadPluginReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.downloadPluginReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePluginClient) GetPluginRepository(repositoryURL string) (plugin.PluginRepository, error) {
 	fake.getPluginRepositoryMutex.Lock()
 	ret, specificReturn := fake.getPluginRepositoryReturnsOnCall[len(fake.getPluginRepositoryArgsForCall)]
@@ -80,6 +146,8 @@ func (fake *FakePluginClient) GetPluginRepositoryReturnsOnCall(i int, result1 pl
 func (fake *FakePluginClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.downloadPluginMutex.RLock()
+	defer fake.downloadPluginMutex.RUnlock()
 	fake.getPluginRepositoryMutex.RLock()
 	defer fake.getPluginRepositoryMutex.RUnlock()
 	return fake.invocations
@@ -0,0 +1,40 @@
+package pluginaction
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// RepositoryNotRegisteredError is returned when a repository name is not
+// registered in the config.
+type RepositoryNotRegisteredError struct {
+	Name string
+}
+
+func (e RepositoryNotRegisteredError) Error() string {
+	return fmt.Sprintf("Plugin repository %s not found", e.Name)
+}
+
+// GetPluginRepository returns the registered repository with the provided
+// name. The comparison is case-insensitive.
+func (actor Actor) GetPluginRepository(repositoryName string) (configv3.PluginRepository, error) {
+	for _, repository := range actor.config.PluginRepositories() {
+		if strings.EqualFold(repository.Name, repositoryName) {
+			return repository, nil
+		}
+	}
+	return configv3.PluginRepository{}, RepositoryNotRegisteredError{Name: repositoryName}
+}
+
+// GetRepositoryPlugins returns the plugins available in the provided
+// repository.
+func (actor Actor) GetRepositoryPlugins(repository configv3.PluginRepository) ([]plugin.Plugin, error) {
+	pluginRepository, err := actor.client.GetPluginRepository(repository.URL)
+	if err != nil {
+		return nil, GettingPluginRepositoryError{Name: repository.Name, Message: err.Error()}
+	}
+	return pluginRepository.Plugins, nil
+}
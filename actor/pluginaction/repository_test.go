@@ -0,0 +1,85 @@
+package pluginaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Repository Actions", func() {
+	var (
+		actor            Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+	})
+
+	Describe("GetPluginRepository", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"},
+			})
+		})
+
+		Context("when the repository is registered", func() {
+			It("returns the repository, ignoring case", func() {
+				repository, err := actor.GetPluginRepository("cf-community")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(repository).To(Equal(configv3.PluginRepository{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"}))
+			})
+		})
+
+		Context("when the repository is not registered", func() {
+			It("returns a RepositoryNotRegisteredError", func() {
+				_, err := actor.GetPluginRepository("some-repo")
+				Expect(err).To(MatchError(RepositoryNotRegisteredError{Name: "some-repo"}))
+			})
+		})
+	})
+
+	Describe("GetRepositoryPlugins", func() {
+		var repository configv3.PluginRepository
+
+		BeforeEach(func() {
+			repository = configv3.PluginRepository{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"}
+		})
+
+		Context("when getting the repository succeeds", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+					Plugins: []plugin.Plugin{{Name: "plugin-1", Version: "1.0.0"}},
+				}, nil)
+			})
+
+			It("returns the plugins in the repository", func() {
+				plugins, err := actor.GetRepositoryPlugins(repository)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plugins).To(ConsistOf(plugin.Plugin{Name: "plugin-1", Version: "1.0.0"}))
+
+				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(1))
+				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://plugins.cloudfoundry.org"))
+			})
+		})
+
+		Context("when getting the repository errors", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, errors.New("generic-error"))
+			})
+
+			It("returns a GettingPluginRepositoryError", func() {
+				_, err := actor.GetRepositoryPlugins(repository)
+				Expect(err).To(MatchError(GettingPluginRepositoryError{Name: "CF-Community", Message: "generic-error"}))
+			})
+		})
+	})
+})
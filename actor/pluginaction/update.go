@@ -0,0 +1,110 @@
+package pluginaction
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/gofileutils/fileutils"
+)
+
+// PluginUpdateCheckInterval is the minimum amount of time between two startup
+// checks for plugin updates.
+const PluginUpdateCheckInterval = 24 * time.Hour
+
+// ShouldCheckForPluginUpdates returns true if the startup check for plugin
+// updates is enabled, there are installed plugins and the last check happened
+// more than PluginUpdateCheckInterval before now.
+func (actor Actor) ShouldCheckForPluginUpdates(now time.Time) bool {
+	if !actor.config.PluginUpdateCheck() || len(actor.config.Plugins()) == 0 {
+		return false
+	}
+	return now.Sub(actor.config.LastPluginUpdateCheck()) >= PluginUpdateCheckInterval
+}
+
+// CheckForPluginUpdates records now as the time of the last check for plugin
+// updates and returns the installed plugins that have newer versions
+// available.
+func (actor Actor) CheckForPluginUpdates(now time.Time) ([]OutdatedPlugin, error) {
+	actor.config.SetLastPluginUpdateCheck(now)
+	return actor.GetOutdatedPlugins()
+}
+
+// GetAndValidatePluginUpdate retrieves the metadata of the plugin binary at
+// the provided path and ensures that it can replace the installed plugin named
+// pluginName.
+func (actor Actor) GetAndValidatePluginUpdate(metadata PluginMetadata, commands CommandList, path string, pluginName string) (configv3.Plugin, error) {
+	if _, exist := actor.config.GetPlugin(pluginName); !exist {
+		return configv3.Plugin{}, PluginNotFoundError{Name: pluginName}
+	}
+	return actor.getAndValidatePlugin(metadata, commands, path, pluginName)
+}
+
+// UpdatePluginFromPath replaces the installed version of the plugin with the
+// plugin binary at the provided path. The new binary is copied next to the
+// installed one before the installed version is uninstalled, and the
+// installed binary and config are restored if the swap fails.
+func (actor Actor) UpdatePluginFromPath(uninstaller PluginUninstaller, path string, plugin configv3.Plugin) error {
+	installedPlugin, exist := actor.config.GetPlugin(plugin.Name)
+	if !exist {
+		return PluginNotFoundError{Name: plugin.Name}
+	}
+
+	installPath := actor.pluginInstallPath(plugin.Name)
+	stagedPath := installPath + ".new"
+	err := fileutils.CopyPathToPath(path, stagedPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(stagedPath)
+
+	err = os.Chmod(stagedPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	err = uninstaller.Uninstall(installedPlugin.Location)
+	if err != nil {
+		return err
+	}
+
+	// No test for sleeping for 500 ms for parity with UninstallPlugin.
+	time.Sleep(500 * time.Millisecond)
+
+	backupPath := installedPlugin.Location + ".old"
+	err = os.Rename(installedPlugin.Location, backupPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	hasBackup := err == nil
+
+	restore := func() {
+		if hasBackup {
+			_ = os.Rename(backupPath, installedPlugin.Location)
+		}
+		actor.config.RemovePlugin(plugin.Name)
+		actor.config.AddPlugin(installedPlugin)
+	}
+
+	err = os.Rename(stagedPath, installPath)
+	if err != nil {
+		restore()
+		return err
+	}
+
+	plugin.Location = installPath
+	actor.config.RemovePlugin(installedPlugin.Name)
+	actor.config.AddPlugin(plugin)
+
+	err = actor.config.WritePluginConfig()
+	if err != nil {
+		_ = os.Remove(installPath)
+		restore()
+		return err
+	}
+
+	if hasBackup {
+		_ = os.Remove(backupPath)
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package pluginaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("update actions", func() {
+	var (
+		actor            Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+		now              time.Time
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+		now = time.Now()
+	})
+
+	Describe("ShouldCheckForPluginUpdates", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginUpdateCheckReturns(true)
+			fakeConfig.PluginsReturns([]configv3.Plugin{{Name: "some-plugin"}})
+		})
+
+		Context("when the last check was more than a day ago", func() {
+			BeforeEach(func() {
+				fakeConfig.LastPluginUpdateCheckReturns(now.Add(-25 * time.Hour))
+			})
+
+			It("returns true", func() {
+				Expect(actor.ShouldCheckForPluginUpdates(now)).To(BeTrue())
+			})
+
+			Context("when the check is disabled", func() {
+				BeforeEach(func() {
+					fakeConfig.PluginUpdateCheckReturns(false)
+				})
+
+				It("returns false", func() {
+					Expect(actor.ShouldCheckForPluginUpdates(now)).To(BeFalse())
+				})
+			})
+
+			Context("when no plugins are installed", func() {
+				BeforeEach(func() {
+					fakeConfig.PluginsReturns(nil)
+				})
+
+				It("returns false", func() {
+					Expect(actor.ShouldCheckForPluginUpdates(now)).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the last check was less than a day ago", func() {
+			BeforeEach(func() {
+				fakeConfig.LastPluginUpdateCheckReturns(now.Add(-23 * time.Hour))
+			})
+
+			It("returns false", func() {
+				Expect(actor.ShouldCheckForPluginUpdates(now)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("CheckForPluginUpdates", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{{Name: "some-repo", URL: "https://some-repo.com"}})
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{Name: "some-plugin", Version: configv3.PluginVersion{Major: 1}},
+			})
+			fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+				Plugins: []plugin.Plugin{{Name: "some-plugin", Version: "1.1"}},
+			}, nil)
+		})
+
+		It("records the check and returns the outdated plugins", func() {
+			outdatedPlugins, err := actor.CheckForPluginUpdates(now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outdatedPlugins).To(Equal([]OutdatedPlugin{
+				{Name: "some-plugin", CurrentVersion: "1.0.0", LatestVersion: "1.1", RepositoryName: "some-repo"},
+			}))
+
+			Expect(fakeConfig.SetLastPluginUpdateCheckCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetLastPluginUpdateCheckArgsForCall(0)).To(Equal(now))
+		})
+	})
+
+	Describe("GetAndValidatePluginUpdate", func() {
+		var (
+			fakeMetadata    *pluginactionfakes.FakePluginMetadata
+			fakeCommandList *pluginactionfakes.FakeCommandList
+			installedPlugin configv3.Plugin
+			newPlugin       configv3.Plugin
+		)
+
+		BeforeEach(func() {
+			fakeMetadata = new(pluginactionfakes.FakePluginMetadata)
+			fakeCommandList = new(pluginactionfakes.FakeCommandList)
+
+			installedPlugin = configv3.Plugin{
+				Name:     "some-plugin",
+				Version:  configv3.PluginVersion{Major: 1},
+				Commands: []configv3.PluginCommand{{Name: "some-command", Alias: "sc"}},
+			}
+			newPlugin = configv3.Plugin{
+				Name:     "some-plugin",
+				Version:  configv3.PluginVersion{Major: 2},
+				Commands: []configv3.PluginCommand{{Name: "some-command", Alias: "sc"}},
+			}
+			fakeConfig.GetPluginReturns(installedPlugin, true)
+			fakeConfig.PluginsReturns([]configv3.Plugin{installedPlugin})
+			fakeMetadata.GetMetadataReturns(newPlugin, nil)
+		})
+
+		It("ignores the commands of the plugin being replaced", func() {
+			plugin, err := actor.GetAndValidatePluginUpdate(fakeMetadata, fakeCommandList, "some-path", "some-plugin")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plugin).To(Equal(newPlugin))
+		})
+
+		Context("when the plugin is not installed", func() {
+			BeforeEach(func() {
+				fakeConfig.GetPluginReturns(configv3.Plugin{}, false)
+			})
+
+			It("returns a PluginNotFoundError", func() {
+				_, err := actor.GetAndValidatePluginUpdate(fakeMetadata, fakeCommandList, "some-path", "some-plugin")
+				Expect(err).To(MatchError(PluginNotFoundError{Name: "some-plugin"}))
+			})
+		})
+
+		Context("when the binary is a different plugin", func() {
+			BeforeEach(func() {
+				newPlugin.Name = "other-plugin"
+				fakeMetadata.GetMetadataReturns(newPlugin, nil)
+			})
+
+			It("returns a PluginInvalidError", func() {
+				_, err := actor.GetAndValidatePluginUpdate(fakeMetadata, fakeCommandList, "some-path", "some-plugin")
+				Expect(err).To(MatchError(PluginInvalidError{}))
+			})
+		})
+	})
+
+	Describe("UpdatePluginFromPath", func() {
+		var (
+			fakeUninstaller *pluginactionfakes.FakePluginUninstaller
+			tempDir         string
+			oldPath         string
+			newPath         string
+		)
+
+		BeforeEach(func() {
+			fakeUninstaller = new(pluginactionfakes.FakePluginUninstaller)
+
+			var err error
+			tempDir, err = ioutil.TempDir("", "pluginaction-update-test")
+			Expect(err).ToNot(HaveOccurred())
+
+			oldPath = filepath.Join(tempDir, "plugins", "some-plugin")
+			if runtime.GOOS == "windows" {
+				oldPath += ".exe"
+			}
+			Expect(os.MkdirAll(filepath.Dir(oldPath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(oldPath, []byte("old"), 0700)).To(Succeed())
+			newPath = filepath.Join(tempDir, "some-plugin")
+			Expect(ioutil.WriteFile(newPath, []byte("new"), 0700)).To(Succeed())
+
+			fakeConfig.PluginHomeReturns(filepath.Join(tempDir, "plugins"))
+			fakeConfig.GetPluginReturns(configv3.Plugin{Name: "some-plugin", Version: configv3.PluginVersion{Major: 1}, Location: oldPath}, true)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		It("uninstalls the old version and installs the new one", func() {
+			err := actor.UpdatePluginFromPath(fakeUninstaller, newPath, configv3.Plugin{Name: "some-plugin"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeUninstaller.UninstallArgsForCall(0)).To(Equal(oldPath))
+			Expect(fakeConfig.RemovePluginArgsForCall(0)).To(Equal("some-plugin"))
+			Expect(ioutil.ReadFile(oldPath)).To(Equal([]byte("new")))
+			Expect(fakeConfig.AddPluginArgsForCall(0)).To(Equal(configv3.Plugin{Name: "some-plugin", Location: oldPath}))
+			Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(1))
+
+			files, err := ioutil.ReadDir(filepath.Join(tempDir, "plugins"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+		})
+
+		Context("when the plugin is not installed", func() {
+			BeforeEach(func() {
+				fakeConfig.GetPluginReturns(configv3.Plugin{}, false)
+			})
+
+			It("returns a PluginNotFoundError", func() {
+				err := actor.UpdatePluginFromPath(fakeUninstaller, newPath, configv3.Plugin{Name: "some-plugin"})
+				Expect(err).To(MatchError(PluginNotFoundError{Name: "some-plugin"}))
+				Expect(fakeUninstaller.UninstallCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the new binary cannot be copied", func() {
+			It("returns the error and keeps the installed version", func() {
+				err := actor.UpdatePluginFromPath(fakeUninstaller, filepath.Join(tempDir, "does-not-exist"), configv3.Plugin{Name: "some-plugin"})
+				Expect(err).To(HaveOccurred())
+
+				Expect(fakeUninstaller.UninstallCallCount()).To(Equal(0))
+				Expect(ioutil.ReadFile(oldPath)).To(Equal([]byte("old")))
+				Expect(fakeConfig.RemovePluginCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when uninstalling the old version fails", func() {
+			BeforeEach(func() {
+				fakeUninstaller.UninstallReturns(errors.New("uninstall-error"))
+			})
+
+			It("returns the error and keeps the installed version", func() {
+				err := actor.UpdatePluginFromPath(fakeUninstaller, newPath, configv3.Plugin{Name: "some-plugin"})
+				Expect(err).To(MatchError("uninstall-error"))
+				Expect(fakeConfig.AddPluginCallCount()).To(Equal(0))
+
+				Expect(ioutil.ReadFile(oldPath)).To(Equal([]byte("old")))
+				files, err := ioutil.ReadDir(filepath.Join(tempDir, "plugins"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+			})
+		})
+
+		Context("when writing the plugin config fails", func() {
+			BeforeEach(func() {
+				fakeConfig.WritePluginConfigReturns(errors.New("write-error"))
+			})
+
+			It("restores the installed binary and plugin config", func() {
+				err := actor.UpdatePluginFromPath(fakeUninstaller, newPath, configv3.Plugin{Name: "some-plugin"})
+				Expect(err).To(MatchError("write-error"))
+
+				Expect(ioutil.ReadFile(oldPath)).To(Equal([]byte("old")))
+				Expect(fakeConfig.AddPluginCallCount()).To(Equal(2))
+				Expect(fakeConfig.AddPluginArgsForCall(1)).To(Equal(configv3.Plugin{Name: "some-plugin", Version: configv3.PluginVersion{Major: 1}, Location: oldPath}))
+			})
+		})
+	})
+})
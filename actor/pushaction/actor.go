@@ -8,11 +8,15 @@ type Warnings []string
 // Actor handles all business logic for Cloud Controller v2 operations.
 type Actor struct {
 	V2Actor V2Actor
+	// V3Actor is used for the process health checks, which the V2 API does
+	// not support. It is nil when the V3 API is not available.
+	V3Actor V3Actor
 }
 
-// NewActor returns a new actor.
-func NewActor(v2Actor V2Actor) *Actor {
+// NewActor returns a new actor. v3Actor may be nil.
+func NewActor(v2Actor V2Actor, v3Actor V3Actor) *Actor {
 	return &Actor{
 		V2Actor: v2Actor,
+		V3Actor: v3Actor,
 	}
 }
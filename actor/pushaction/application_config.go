@@ -1,6 +1,8 @@
 package pushaction
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	log "github.com/Sirupsen/logrus"
@@ -12,14 +14,60 @@ type ApplicationConfig struct {
 
 	CurrentRoutes []v2action.Route
 	DesiredRoutes []v2action.Route
+	// RouteAppPorts maps the string form of desired routes to the application
+	// port they are bound to. Routes that are not in it use the application's
+	// first port.
+	RouteAppPorts map[string]int
+	// ProcessHealthChecks are the readiness and non-web process health checks
+	// from the manifest. They are set through the V3 API.
+	ProcessHealthChecks []manifest.Process
+	// PruneRoutes unmaps the current routes of the application that are not
+	// desired. DeleteOrphanedRoutes also deletes the unmapped routes that are
+	// not mapped to any other application.
+	PruneRoutes          bool
+	DeleteOrphanedRoutes bool
+
+	// CurrentServiceBindings are the service bindings of the application, by
+	// the name of their service instance.
+	CurrentServiceBindings map[string]v2action.ServiceBinding
+	// DesiredServices are the service instances listed by the manifest.
+	DesiredServices []v2action.ServiceInstance
+	// PruneServices unbinds the service instances of the application that are
+	// not desired.
+	PruneServices bool
 
 	TargetedSpaceGUID string
 	Path              string
 }
 
-func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application) ([]ApplicationConfig, Warnings, error) {
+// ProcessHealthChecksNotSupportedError is returned when an application sets
+// readiness or non-web process health checks and the V3 API is not
+// available.
+type ProcessHealthChecksNotSupportedError struct {
+	AppName string
+}
+
+func (e ProcessHealthChecksNotSupportedError) Error() string {
+	return fmt.Sprintf("application %s sets process health checks, which require the V3 API", e.AppName)
+}
+
+// ConvertToApplicationConfig returns the config of each application. The
+// settings of an existing application are the base that is overridden by the
+// manifest application, which already includes the command line settings, so
+// the precedence is existing < manifest < flags. Settings that neither set are
+// kept, and existing environment variables are merged with the manifest ones.
+// Service bindings are only removed when PruneServices is set.
+//
+// With reset, the manifest replaces the environment variables, services and
+// scaling of an existing application instead: the environment variables that
+// the manifest does not set are removed, PruneServices is set so that the
+// services it does not list are unbound, and the number of instances is reset
+// to 1 when it does not set one. The memory and disk quotas are kept when the
+// manifest does not set them, since the defaults of the platform are not known.
+func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]ApplicationConfig, Warnings, error) {
 	var configs []ApplicationConfig
 	var warnings Warnings
+	var buildpacks []v2action.Buildpack
 
 	log.Infof("iterating through %d app configuration(s)", len(apps))
 	for _, app := range apps {
@@ -40,6 +88,12 @@ func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string,
 			log.Debugf("found app: %#v", foundApp)
 			config.CurrentApplication = foundApp
 			config.DesiredApplication = foundApp
+			if reset {
+				log.Debug("resetting environment variables, services and instances")
+				config.DesiredApplication.EnvironmentVariables = map[string]string{}
+				config.DesiredApplication.Instances = 1
+				config.PruneServices = true
+			}
 
 			log.Info("looking up application routes")
 			var routes []v2action.Route
@@ -57,13 +111,85 @@ func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string,
 			config.DesiredApplication.SpaceGUID = spaceGUID
 		}
 
-		defaultRoute, routeWarnings, err := actor.GetRouteWithDefaultDomain(app.Name, orgGUID, spaceGUID)
-		warnings = append(warnings, routeWarnings...)
+		config.DesiredApplication = applyManifestSettings(config.DesiredApplication, app)
+
+		if app.Stack != "" {
+			log.Infoln("looking up stack", app.Stack)
+			stack, stackWarnings, err := actor.V2Actor.GetStackByName(app.Stack)
+			warnings = append(warnings, stackWarnings...)
+			if err != nil {
+				log.Errorln("stack lookup:", err)
+				return nil, warnings, err
+			}
+			config.DesiredApplication.StackGUID = stack.GUID
+		}
+
+		// The buildpack is validated here since staging only fails after the
+		// application has been uploaded.
+		if isNamedBuildpack(app.Buildpack) {
+			if buildpacks == nil {
+				log.Info("looking up buildpacks")
+				var buildpackWarnings v2action.Warnings
+				buildpacks, buildpackWarnings, err = actor.V2Actor.GetBuildpacks()
+				warnings = append(warnings, buildpackWarnings...)
+				if err != nil {
+					log.Errorln("buildpacks lookup:", err)
+					return nil, warnings, err
+				}
+			}
+
+			stackName, stackWarnings, err := actor.applicationStackName(config.DesiredApplication, app)
+			warnings = append(warnings, stackWarnings...)
+			if err != nil {
+				log.Errorln("stack lookup:", err)
+				return nil, warnings, err
+			}
+
+			err = validateBuildpack(app.Name, app.Buildpack, stackName, buildpacks)
+			if err != nil {
+				log.Errorln("validating buildpack:", err)
+				return nil, warnings, err
+			}
+		}
+
+		var serviceWarnings Warnings
+		config.CurrentServiceBindings, config.DesiredServices, serviceWarnings, err = actor.getApplicationServices(config.CurrentApplication.GUID, app, spaceGUID)
+		warnings = append(warnings, serviceWarnings...)
 		if err != nil {
-			log.Errorln("getting default route:", err)
 			return nil, warnings, err
 		}
-		config.DesiredRoutes = []v2action.Route{defaultRoute}
+
+		config.ProcessHealthChecks = processHealthChecks(app)
+		if len(config.ProcessHealthChecks) > 0 && actor.V3Actor == nil {
+			log.Errorln("process health checks require the V3 API")
+			return nil, warnings, ProcessHealthChecksNotSupportedError{AppName: app.Name}
+		}
+
+		if len(app.Routes) == 0 {
+			defaultRoute, routeWarnings, err := actor.GetRouteWithDefaultDomain(app.Name, orgGUID, spaceGUID)
+			warnings = append(warnings, routeWarnings...)
+			if err != nil {
+				log.Errorln("getting default route:", err)
+				return nil, warnings, err
+			}
+			config.DesiredRoutes = []v2action.Route{defaultRoute}
+		} else {
+			for _, manifestRoute := range app.Routes {
+				route, routeWarnings, err := actor.GetRouteFromManifestRoute(manifestRoute, orgGUID, spaceGUID)
+				warnings = append(warnings, routeWarnings...)
+				if err != nil {
+					log.Errorln("getting manifest route:", err)
+					return nil, warnings, err
+				}
+				config.DesiredRoutes = append(config.DesiredRoutes, route)
+				if appPort, ok := app.RouteAppPorts[manifestRoute]; ok {
+					if config.RouteAppPorts == nil {
+						config.RouteAppPorts = map[string]int{}
+					}
+					config.RouteAppPorts[route.String()] = appPort
+				}
+			}
+		}
 
 		configs = append(configs, config)
 	}
@@ -71,6 +197,63 @@ func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string,
 	return configs, warnings, nil
 }
 
+// applyManifestSettings overrides the application's settings with the ones
+// provided by the manifest. Environment variables are merged, with manifest
+// values taking precedence.
+func applyManifestSettings(application v2action.Application, app manifest.Application) v2action.Application {
+	if app.Buildpack != "" {
+		application.Buildpack = app.Buildpack
+	}
+	if app.Instances.IsSet {
+		application.Instances = app.Instances.Value
+	}
+	if app.DiskQuota != 0 {
+		application.DiskQuota = app.DiskQuota
+	}
+	if app.Memory != 0 {
+		application.Memory = app.Memory
+	}
+	if len(app.Ports) > 0 {
+		application.Ports = app.Ports
+	}
+	if app.HealthCheck.Type != "" {
+		application.HealthCheckType = app.HealthCheck.Type
+		application.HealthCheckHTTPEndpoint = app.HealthCheck.HTTPEndpoint
+	}
+
+	if len(app.EnvironmentVariables) > 0 {
+		env := map[string]string{}
+		for name, value := range application.EnvironmentVariables {
+			env[name] = value
+		}
+		for name, value := range app.EnvironmentVariables {
+			env[name] = value
+		}
+		application.EnvironmentVariables = env
+	}
+
+	return application
+}
+
+// processHealthChecks returns the health checks of the manifest application
+// that cannot be set on the V2 application: the readiness health check of the
+// web process and the health checks of the other processes.
+func processHealthChecks(app manifest.Application) []manifest.Process {
+	var processes []manifest.Process
+	if app.ReadinessHealthCheck.Type != "" {
+		processes = append(processes, manifest.Process{
+			Type:                 "web",
+			ReadinessHealthCheck: app.ReadinessHealthCheck,
+		})
+	}
+	for _, process := range app.Processes {
+		if process.HealthCheck.Type != "" || process.ReadinessHealthCheck.Type != "" {
+			processes = append(processes, process)
+		}
+	}
+	return processes
+}
+
 func (actor Actor) FindOrReturnParialApp(appName string, spaceGUID string) (bool, v2action.Application, v2action.Warnings, error) {
 	foundApp, v2Warnings, err := actor.V2Actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if _, ok := err.(v2action.ApplicationNotFoundError); ok {
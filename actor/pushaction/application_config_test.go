@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -20,7 +21,7 @@ var _ = Describe("Application Config", func() {
 
 	BeforeEach(func() {
 		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
-		actor = NewActor(fakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
 	})
 
 	Describe("ConvertToApplicationConfig", func() {
@@ -30,6 +31,7 @@ var _ = Describe("Application Config", func() {
 			spaceGUID    string
 			domain       v2action.Domain
 			manifestApps []manifest.Application
+			reset        bool
 
 			configs    []ApplicationConfig
 			warnings   Warnings
@@ -46,6 +48,7 @@ var _ = Describe("Application Config", func() {
 				Name: appName,
 				Path: "some-path",
 			}}
+			reset = false
 
 			domain = v2action.Domain{
 				Name: "private-domain.com",
@@ -60,7 +63,7 @@ var _ = Describe("Application Config", func() {
 		})
 
 		JustBeforeEach(func() {
-			configs, warnings, executeErr = actor.ConvertToApplicationConfig(orgGUID, spaceGUID, manifestApps)
+			configs, warnings, executeErr = actor.ConvertToApplicationConfig(orgGUID, spaceGUID, manifestApps, reset)
 			if len(configs) > 0 {
 				firstConfig = configs[0]
 			}
@@ -196,5 +199,323 @@ var _ = Describe("Application Config", func() {
 				Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings"))
 			})
 		})
+
+		Context("when the manifest provides settings", func() {
+			BeforeEach(func() {
+				manifestApps[0].Buildpack = "go_buildpack"
+				manifestApps[0].Instances = types.NullInt{IsSet: true, Value: 3}
+				manifestApps[0].Memory = 512
+				manifestApps[0].DiskQuota = 2048
+				manifestApps[0].EnvironmentVariables = map[string]string{"SOME_VAR": "new-value"}
+				manifestApps[0].Ports = []int{8080, 9090}
+				manifestApps[0].Routes = []string{"some-host.private-domain.com"}
+				manifestApps[0].RouteAppPorts = map[string]int{"some-host.private-domain.com": 9090}
+				manifestApps[0].HealthCheck = manifest.HealthCheck{Type: "http", HTTPEndpoint: "/health"}
+
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{
+					Name:                 appName,
+					GUID:                 "some-app-guid",
+					Buildpack:            "ruby_buildpack",
+					Instances:            1,
+					EnvironmentVariables: map[string]string{"SOME_VAR": "old-value", "OTHER_VAR": "other-value"},
+				}, nil, nil)
+				fakeV2Actor.CheckRouteReturns(false, nil, nil)
+				fakeV2Actor.GetBuildpacksReturns([]v2action.Buildpack{{Name: "go_buildpack", Enabled: true}}, nil, nil)
+			})
+
+			It("applies them to the desired application and routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.DesiredApplication.Buildpack).To(Equal("go_buildpack"))
+				Expect(firstConfig.DesiredApplication.Instances).To(Equal(3))
+				Expect(firstConfig.DesiredApplication.Memory).To(Equal(512))
+				Expect(firstConfig.DesiredApplication.DiskQuota).To(Equal(2048))
+				Expect(firstConfig.DesiredApplication.Ports).To(Equal([]int{8080, 9090}))
+				Expect(firstConfig.DesiredApplication.HealthCheckType).To(Equal("http"))
+				Expect(firstConfig.DesiredApplication.HealthCheckHTTPEndpoint).To(Equal("/health"))
+				Expect(firstConfig.ProcessHealthChecks).To(BeEmpty())
+				Expect(firstConfig.DesiredApplication.EnvironmentVariables).To(Equal(map[string]string{
+					"SOME_VAR":  "new-value",
+					"OTHER_VAR": "other-value",
+				}))
+				Expect(firstConfig.DesiredRoutes).To(ConsistOf(v2action.Route{
+					Domain:    domain,
+					Host:      "some-host",
+					SpaceGUID: spaceGUID,
+				}))
+				Expect(firstConfig.RouteAppPorts).To(Equal(map[string]int{"some-host.private-domain.com": 9090}))
+
+				Expect(firstConfig.CurrentApplication.Buildpack).To(Equal("ruby_buildpack"))
+				Expect(firstConfig.CurrentApplication.EnvironmentVariables).To(HaveKeyWithValue("SOME_VAR", "old-value"))
+			})
+
+			Context("when the manifest does not provide some settings", func() {
+				BeforeEach(func() {
+					manifestApps[0].Instances = types.NullInt{}
+					manifestApps[0].EnvironmentVariables = nil
+				})
+
+				It("keeps the settings of the existing application", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredApplication.Buildpack).To(Equal("go_buildpack"))
+					Expect(firstConfig.DesiredApplication.Instances).To(Equal(1))
+					Expect(firstConfig.DesiredApplication.EnvironmentVariables).To(Equal(map[string]string{
+						"SOME_VAR":  "old-value",
+						"OTHER_VAR": "other-value",
+					}))
+				})
+			})
+
+			Context("when reset is requested", func() {
+				BeforeEach(func() {
+					reset = true
+				})
+
+				It("replaces the environment variables, services and scaling with the manifest ones", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredApplication.EnvironmentVariables).To(Equal(map[string]string{
+						"SOME_VAR": "new-value",
+					}))
+					Expect(firstConfig.CurrentApplication.EnvironmentVariables).To(HaveKeyWithValue("OTHER_VAR", "other-value"))
+					Expect(firstConfig.PruneServices).To(BeTrue())
+					Expect(firstConfig.DesiredApplication.Instances).To(Equal(3))
+					Expect(firstConfig.DesiredApplication.Memory).To(Equal(512))
+				})
+
+				Context("when the manifest does not provide the number of instances", func() {
+					BeforeEach(func() {
+						manifestApps[0].Instances = types.NullInt{}
+						fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{
+							Name:      appName,
+							GUID:      "some-app-guid",
+							Instances: 4,
+						}, nil, nil)
+					})
+
+					It("resets the number of instances to 1", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(firstConfig.DesiredApplication.Instances).To(Equal(1))
+					})
+				})
+
+				Context("when the manifest does not provide environment variables", func() {
+					BeforeEach(func() {
+						manifestApps[0].EnvironmentVariables = nil
+					})
+
+					It("removes the environment variables", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(firstConfig.DesiredApplication.EnvironmentVariables).To(Equal(map[string]string{}))
+					})
+				})
+			})
+		})
+
+		Context("when the manifest names a buildpack", func() {
+			BeforeEach(func() {
+				manifestApps[0].Buildpack = "ruby_buildpack"
+				fakeV2Actor.GetBuildpacksReturns([]v2action.Buildpack{
+					{Name: "ruby_buildpack", Stack: "cflinuxfs2", Enabled: true},
+					{Name: "rubi_buildpack", Stack: "cflinuxfs2", Enabled: true},
+					{Name: "go_buildpack", Enabled: true},
+					{Name: "ruby_buildpack_old", Stack: "cflinuxfs2"},
+					{Name: "ruby_buildpack", Stack: "windows2012R2", Enabled: true},
+				}, v2action.Warnings{"buildpacks-warning"}, nil)
+			})
+
+			Context("when the buildpack exists for the stack of the existing application", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{Name: appName, GUID: "some-app-guid", StackGUID: "some-stack-guid"}, nil, nil)
+					fakeV2Actor.GetStackReturns(v2action.Stack{Name: "cflinuxfs2"}, v2action.Warnings{"stack-warning"}, nil)
+				})
+
+				It("returns the config and warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredApplication.Buildpack).To(Equal("ruby_buildpack"))
+					Expect(warnings).To(ContainElement("buildpacks-warning"))
+					Expect(warnings).To(ContainElement("stack-warning"))
+					Expect(fakeV2Actor.GetStackArgsForCall(0)).To(Equal("some-stack-guid"))
+				})
+			})
+
+			Context("when the manifest sets the stack", func() {
+				BeforeEach(func() {
+					manifestApps[0].Stack = "windows2012R2"
+					fakeV2Actor.GetStackByNameReturns(v2action.Stack{Name: "windows2012R2", GUID: "windows-stack-guid"}, v2action.Warnings{"stack-warning"}, nil)
+				})
+
+				It("validates the buildpack for that stack and sets the stack", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredApplication.StackGUID).To(Equal("windows-stack-guid"))
+					Expect(fakeV2Actor.GetStackByNameArgsForCall(0)).To(Equal("windows2012R2"))
+					Expect(fakeV2Actor.GetStackCallCount()).To(Equal(0))
+				})
+
+				Context("when the stack does not exist", func() {
+					BeforeEach(func() {
+						fakeV2Actor.GetStackByNameReturns(v2action.Stack{}, v2action.Warnings{"stack-warning"}, v2action.StackNotFoundError{Name: "windows2012R2"})
+					})
+
+					It("returns the error and warnings", func() {
+						Expect(executeErr).To(MatchError(v2action.StackNotFoundError{Name: "windows2012R2"}))
+						Expect(warnings).To(ContainElement("stack-warning"))
+					})
+				})
+			})
+
+			Context("when the buildpack does not exist for the stack", func() {
+				BeforeEach(func() {
+					manifestApps[0].Buildpack = "rubby_buildpack"
+					manifestApps[0].Stack = "cflinuxfs2"
+				})
+
+				It("returns the close matches of the stack's enabled buildpacks", func() {
+					Expect(executeErr).To(MatchError(BuildpackNotFoundError{
+						AppName:     appName,
+						Buildpack:   "rubby_buildpack",
+						Stack:       "cflinuxfs2",
+						Suggestions: []string{"ruby_buildpack"},
+					}))
+					Expect(warnings).To(ContainElement("buildpacks-warning"))
+				})
+			})
+
+			Context("when the buildpack is part of the names of other buildpacks", func() {
+				BeforeEach(func() {
+					manifestApps[0].Buildpack = "ruby"
+				})
+
+				It("suggests those buildpacks", func() {
+					Expect(executeErr).To(MatchError(BuildpackNotFoundError{
+						AppName:     appName,
+						Buildpack:   "ruby",
+						Suggestions: []string{"ruby_buildpack"},
+					}))
+				})
+			})
+
+			Context("when the buildpack is a Git URL or a built-in keyword", func() {
+				BeforeEach(func() {
+					manifestApps = append(manifestApps,
+						manifest.Application{Name: "app-2", Buildpack: "https://github.com/cloudfoundry/ruby-buildpack.git"},
+						manifest.Application{Name: "app-3", Buildpack: "default"},
+					)
+					manifestApps[0].Buildpack = ""
+				})
+
+				It("does not look up the buildpacks", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeV2Actor.GetBuildpacksCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when several applications name buildpacks", func() {
+				BeforeEach(func() {
+					manifestApps = append(manifestApps, manifest.Application{Name: "app-2", Buildpack: "go_buildpack"})
+				})
+
+				It("looks up the buildpacks once", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeV2Actor.GetBuildpacksCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when looking up the buildpacks errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("buildpacks failed")
+					fakeV2Actor.GetBuildpacksReturns(nil, v2action.Warnings{"buildpacks-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ContainElement("buildpacks-warning"))
+				})
+			})
+		})
+
+		Context("when the manifest lists services", func() {
+			var binding v2action.ServiceBinding
+
+			BeforeEach(func() {
+				manifestApps[0].Services = []string{"service-2"}
+
+				binding = v2action.ServiceBinding{GUID: "binding-1-guid", ServiceInstanceGUID: "instance-1-guid"}
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{Name: appName, GUID: "some-app-guid"}, nil, nil)
+				fakeV2Actor.GetServiceBindingsByApplicationReturns([]v2action.ServiceBinding{binding}, v2action.Warnings{"bindings-warning"}, nil)
+				fakeV2Actor.GetServiceInstancesBySpaceReturns([]v2action.ServiceInstance{
+					{Name: "service-1", GUID: "instance-1-guid"},
+					{Name: "service-2", GUID: "instance-2-guid"},
+				}, v2action.Warnings{"instances-warning"}, nil)
+			})
+
+			It("sets the current service bindings and the desired services", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("bindings-warning"))
+				Expect(warnings).To(ContainElement("instances-warning"))
+				Expect(firstConfig.CurrentServiceBindings).To(Equal(map[string]v2action.ServiceBinding{"service-1": binding}))
+				Expect(firstConfig.DesiredServices).To(Equal([]v2action.ServiceInstance{{Name: "service-2", GUID: "instance-2-guid"}}))
+
+				Expect(fakeV2Actor.GetServiceBindingsByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeV2Actor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal(spaceGUID))
+			})
+
+			Context("when some of the service instances do not exist", func() {
+				BeforeEach(func() {
+					manifestApps[0].Services = []string{"missing-1", "service-2", "missing-2"}
+				})
+
+				It("returns all the missing service instances and warnings", func() {
+					Expect(executeErr).To(MatchError(ServiceInstancesNotFoundError{
+						AppName:              appName,
+						ServiceInstanceNames: []string{"missing-1", "missing-2"},
+					}))
+					Expect(warnings).To(ContainElement("instances-warning"))
+				})
+			})
+
+			Context("when the application has no bindings and the manifest lists none", func() {
+				BeforeEach(func() {
+					manifestApps[0].Services = nil
+					fakeV2Actor.GetServiceBindingsByApplicationReturns(nil, nil, nil)
+				})
+
+				It("does not look up the service instances", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.CurrentServiceBindings).To(BeNil())
+					Expect(fakeV2Actor.GetServiceInstancesBySpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the manifest provides process health checks", func() {
+			BeforeEach(func() {
+				manifestApps[0].ReadinessHealthCheck = manifest.HealthCheck{Type: "http", HTTPEndpoint: "/ready", Interval: 5}
+				manifestApps[0].Processes = []manifest.Process{
+					{Type: "worker", HealthCheck: manifest.HealthCheck{Type: "process"}},
+					{Type: "clock"},
+				}
+			})
+
+			Context("when the V3 API is available", func() {
+				BeforeEach(func() {
+					actor.V3Actor = new(pushactionfakes.FakeV3Actor)
+				})
+
+				It("returns the readiness and non-web process health checks", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.ProcessHealthChecks).To(Equal([]manifest.Process{
+						{Type: "web", ReadinessHealthCheck: manifest.HealthCheck{Type: "http", HTTPEndpoint: "/ready", Interval: 5}},
+						{Type: "worker", HealthCheck: manifest.HealthCheck{Type: "process"}},
+					}))
+				})
+			})
+
+			Context("when the V3 API is not available", func() {
+				It("returns a ProcessHealthChecksNotSupportedError", func() {
+					Expect(executeErr).To(MatchError(ProcessHealthChecksNotSupportedError{AppName: appName}))
+				})
+			})
+		})
 	})
 })
@@ -1,107 +1,383 @@
 package pushaction
 
 import (
+	"context"
+	"reflect"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	log "github.com/Sirupsen/logrus"
 )
 
-func (actor Actor) Apply(config ApplicationConfig) (<-chan Event, <-chan Warnings, <-chan error) {
-	eventStream := make(chan Event)
-	warningsStream := make(chan Warnings)
-	errorStream := make(chan error)
+// Apply creates or updates the application, its routes, its service bindings
+// and its process health checks. The error stream receives at most one error, after which all three
+// streams are closed. When ctx is done, the remaining steps are skipped and
+// its error is sent on the error stream; cancelling ctx is also how the caller
+// stops Apply when it is no longer reading the streams.
+func (actor Actor) Apply(ctx context.Context, config ApplicationConfig) (<-chan Event, <-chan Warnings, <-chan error) {
+	streams := applyStreams{
+		ctx:      ctx,
+		events:   make(chan Event),
+		warnings: make(chan Warnings),
+		errors:   make(chan error, 1),
+	}
 
 	go func() {
 		log.Debug("starting apply go routine")
-		defer close(eventStream)
-		defer close(warningsStream)
-		defer close(errorStream)
+		defer streams.close()
+
+		warnings, err := actor.checkSpaceQuota(config)
+		if len(warnings) > 0 && !streams.sendWarnings(warnings) {
+			return
+		}
+		if err != nil {
+			streams.sendError(err)
+			return
+		}
+
+		if streams.cancelled() {
+			return
+		}
 
 		if config.DesiredApplication.GUID != "" {
 			log.Debugf("updating application: %#v", config.DesiredApplication)
-			app, warnings, err := actor.V2Actor.UpdateApplication(config.DesiredApplication)
-			warningsStream <- Warnings(warnings)
+			app, warnings, err := actor.V2Actor.UpdateApplication(applicationRequest(config))
+			if !streams.sendWarnings(Warnings(warnings)) {
+				return
+			}
 			if err != nil {
 				log.Errorln("updating application:", err)
-				errorStream <- err
+				streams.sendError(err)
 				return
 			}
 			config.DesiredApplication = app
-			eventStream <- ApplicationUpdated
+			if !streams.sendEvent(Event{Type: ApplicationUpdated, AppGUID: app.GUID}) {
+				return
+			}
 		} else {
 			log.Debugf("creating application: %#v", config.DesiredApplication)
-			app, warnings, err := actor.V2Actor.CreateApplication(config.DesiredApplication)
-			warningsStream <- Warnings(warnings)
+			app, warnings, err := actor.V2Actor.CreateApplication(applicationRequest(config))
+			if !streams.sendWarnings(Warnings(warnings)) {
+				return
+			}
 			if err != nil {
 				log.Errorln("creating application:", err)
-				errorStream <- err
+				streams.sendError(err)
 				return
 			}
 			config.DesiredApplication = app
-			eventStream <- ApplicationCreated
+			if !streams.sendEvent(Event{Type: ApplicationCreated, AppGUID: app.GUID}) {
+				return
+			}
 		}
 		log.Debugf("desired application: %#v", config.DesiredApplication)
 
 		log.Info("creating routes")
 		var createdRoutes []v2action.Route
-		var createdRoutesMessage bool
 		for _, route := range config.DesiredRoutes {
+			if streams.cancelled() {
+				return
+			}
 			if route.GUID == "" {
 				log.Debugf("creating route: %#v", route)
 				createdRoute, warnings, err := actor.V2Actor.CreateRoute(route, false)
-				warningsStream <- Warnings(warnings)
+				if !streams.sendWarnings(Warnings(warnings)) {
+					return
+				}
 				if err != nil {
 					log.Errorln("creating route:", err)
-					errorStream <- err
+					if _, ok := err.(ccerror.ForbiddenError); ok && route.Host == WildcardHost {
+						err = WildcardRouteForbiddenError{Route: route.String()}
+					}
+					streams.sendError(err)
 					return
 				}
 				createdRoutes = append(createdRoutes, createdRoute)
-				createdRoutesMessage = true
+				if !streams.sendEvent(Event{Type: RouteCreated, Route: route.String()}) {
+					return
+				}
 			} else {
 				log.Debugf("route %s already exists, skipping creation", route)
 				createdRoutes = append(createdRoutes, route)
 			}
 		}
 		config.DesiredRoutes = createdRoutes
-
-		if createdRoutesMessage {
-			log.Debugf("updated desired routes: %#v", config.DesiredRoutes)
-			eventStream <- RouteCreated
-		}
+		log.Debugf("updated desired routes: %#v", config.DesiredRoutes)
 
 		log.Info("binding routes")
-		var boundRoutesMessage bool
 		for _, route := range config.DesiredRoutes {
+			if streams.cancelled() {
+				return
+			}
 			if !actor.routeInList(route, config.CurrentRoutes) {
 				log.Debugf("binding route: %#v", route)
-				warnings, err := actor.bindRouteToApp(route, config.DesiredApplication.GUID)
-				warningsStream <- Warnings(warnings)
+				warnings, err := actor.bindRouteToApp(route, config.DesiredApplication.GUID, config.RouteAppPorts[route.String()])
+				if !streams.sendWarnings(Warnings(warnings)) {
+					return
+				}
 				if err != nil {
 					log.Errorln("binding route:", err)
-					errorStream <- err
+					streams.sendError(err)
+					return
+				}
+				if !streams.sendEvent(Event{Type: RouteBound, Route: route.String()}) {
+					return
+				}
+			} else if appPort := config.RouteAppPorts[route.String()]; appPort != 0 {
+				log.Debugf("updating app port of route %s to %d", route, appPort)
+				updated, warnings, err := actor.V2Actor.UpdateRouteApplicationPort(route.GUID, config.DesiredApplication.GUID, appPort)
+				if !streams.sendWarnings(Warnings(warnings)) {
+					return
+				}
+				if err != nil {
+					log.Errorln("updating route app port:", err)
+					streams.sendError(err)
+					return
+				}
+				if updated && !streams.sendEvent(Event{Type: RouteBound, Route: route.String()}) {
 					return
 				}
-				boundRoutesMessage = true
 			} else {
 				log.Debugf("route %s already bound to app", route)
 			}
 		}
 		log.Debug("binding routes complete")
+
+		if config.PruneRoutes {
+			log.Info("pruning routes")
+			for _, route := range config.CurrentRoutes {
+				if streams.cancelled() {
+					return
+				}
+				if actor.routeInList(route, config.DesiredRoutes) {
+					continue
+				}
+
+				log.Debugf("unmapping route: %#v", route)
+				warnings, err := actor.V2Actor.UnbindRouteFromApplication(route.GUID, config.DesiredApplication.GUID)
+				if !streams.sendWarnings(Warnings(warnings)) {
+					return
+				}
+				if err != nil {
+					log.Errorln("unmapping route:", err)
+					streams.sendError(err)
+					return
+				}
+				if !streams.sendEvent(Event{Type: RouteUnmapped, Route: route.String()}) {
+					return
+				}
+
+				if !config.DeleteOrphanedRoutes {
+					continue
+				}
+				deleted, deleteWarnings, err := actor.deleteRouteIfOrphaned(route)
+				if !streams.sendWarnings(deleteWarnings) {
+					return
+				}
+				if err != nil {
+					log.Errorln("deleting orphaned route:", err)
+					streams.sendError(err)
+					return
+				}
+				if deleted && !streams.sendEvent(Event{Type: RouteDeleted, Route: route.String()}) {
+					return
+				}
+			}
+		}
 		config.CurrentRoutes = config.DesiredRoutes
 
-		if boundRoutesMessage {
-			eventStream <- RouteBound
+		log.Info("binding services")
+		for _, serviceInstance := range config.DesiredServices {
+			if streams.cancelled() {
+				return
+			}
+			if _, ok := config.CurrentServiceBindings[serviceInstance.Name]; ok {
+				log.Debugf("service instance %s already bound to app", serviceInstance.Name)
+				continue
+			}
+
+			log.Debugf("binding service instance: %#v", serviceInstance)
+			warnings, err := actor.V2Actor.BindServiceToApplication(config.DesiredApplication.GUID, serviceInstance.GUID)
+			if !streams.sendWarnings(Warnings(warnings)) {
+				return
+			}
+			if err != nil {
+				log.Errorln("binding service instance:", err)
+				streams.sendError(err)
+				return
+			}
+			if !streams.sendEvent(Event{Type: ServiceBound, ServiceInstance: serviceInstance.Name}) {
+				return
+			}
+		}
+
+		if config.PruneServices {
+			log.Info("pruning services")
+			for _, name := range sortedServiceInstanceNames(config.CurrentServiceBindings) {
+				if streams.cancelled() {
+					return
+				}
+				if serviceInstanceInList(name, config.DesiredServices) {
+					continue
+				}
+
+				log.Debugf("unbinding service instance: %s", name)
+				warnings, err := actor.V2Actor.DeleteServiceBinding(config.CurrentServiceBindings[name].GUID)
+				if !streams.sendWarnings(Warnings(warnings)) {
+					return
+				}
+				if err != nil {
+					log.Errorln("unbinding service instance:", err)
+					streams.sendError(err)
+					return
+				}
+				if !streams.sendEvent(Event{Type: ServiceUnbound, ServiceInstance: name}) {
+					return
+				}
+			}
+		}
+
+		if len(config.ProcessHealthChecks) > 0 {
+			if streams.cancelled() {
+				return
+			}
+			log.Info("updating process health checks")
+			warnings, err := actor.updateProcessHealthChecks(config)
+			if !streams.sendWarnings(warnings) {
+				return
+			}
+			if err != nil {
+				log.Errorln("updating process health checks:", err)
+				streams.sendError(err)
+				return
+			}
+			if !streams.sendEvent(Event{Type: HealthChecksUpdated}) {
+				return
+			}
 		}
 
 		log.Debug("completed apply")
-		eventStream <- Complete
+		streams.sendEvent(Event{Type: Complete, AppGUID: config.DesiredApplication.GUID})
 	}()
 
-	return eventStream, warningsStream, errorStream
+	return streams.events, streams.warnings, streams.errors
 }
-func (actor Actor) bindRouteToApp(route v2action.Route, appGUID string) (v2action.Warnings, error) {
-	warnings, err := actor.V2Actor.BindRouteToApplication(route.GUID, appGUID)
+
+// applyStreams are the streams of a single Apply. Events and warnings are
+// only sent while ctx is not done, so that Apply never blocks on a caller
+// that has stopped reading. The error stream is buffered since it receives at
+// most one error, which therefore never blocks.
+type applyStreams struct {
+	ctx      context.Context
+	events   chan Event
+	warnings chan Warnings
+	errors   chan error
+}
+
+// sendEvent returns false, after sending the error of ctx, when ctx is done
+// before the event is received.
+func (streams applyStreams) sendEvent(event Event) bool {
+	select {
+	case streams.events <- event:
+		return true
+	case <-streams.ctx.Done():
+		streams.sendError(streams.ctx.Err())
+		return false
+	}
+}
+
+// sendWarnings returns false, after sending the error of ctx, when ctx is
+// done before the warnings are received.
+func (streams applyStreams) sendWarnings(warnings Warnings) bool {
+	select {
+	case streams.warnings <- warnings:
+		return true
+	case <-streams.ctx.Done():
+		streams.sendError(streams.ctx.Err())
+		return false
+	}
+}
+
+func (streams applyStreams) sendError(err error) {
+	streams.errors <- err
+}
+
+// cancelled sends the error of ctx, and returns true, when ctx is done.
+func (streams applyStreams) cancelled() bool {
+	if err := streams.ctx.Err(); err != nil {
+		log.Errorln("apply cancelled:", err)
+		streams.sendError(err)
+		return true
+	}
+	return false
+}
+
+// close closes all three streams. An error that has not been received yet is
+// still received from the closed error stream.
+func (streams applyStreams) close() {
+	close(streams.events)
+	close(streams.warnings)
+	close(streams.errors)
+}
+
+// applicationRequest returns the application that Apply sends to create or
+// update the application. An existing application is sent without its
+// environment variables when they have not changed. The variables are read as
+// strings, so sending them back unchanged would turn the values that are not
+// strings into strings.
+func applicationRequest(config ApplicationConfig) v2action.Application {
+	application := config.DesiredApplication
+	if application.GUID != "" && reflect.DeepEqual(application.EnvironmentVariables, config.CurrentApplication.EnvironmentVariables) {
+		application.EnvironmentVariables = nil
+	}
+	return application
+}
+
+func (actor Actor) bindRouteToApp(route v2action.Route, appGUID string, appPort int) (v2action.Warnings, error) {
+	var (
+		warnings v2action.Warnings
+		err      error
+	)
+	if appPort != 0 {
+		warnings, err = actor.V2Actor.BindRouteToApplicationPort(route.GUID, appGUID, appPort)
+	} else {
+		warnings, err = actor.V2Actor.BindRouteToApplication(route.GUID, appGUID)
+	}
 	if _, ok := err.(v2action.RouteInDifferentSpaceError); ok {
 		return warnings, v2action.RouteInDifferentSpaceError{Route: route.String()}
 	}
 	return warnings, err
 }
+
+func (actor Actor) updateProcessHealthChecks(config ApplicationConfig) (Warnings, error) {
+	var allWarnings Warnings
+	for _, process := range config.ProcessHealthChecks {
+		if process.HealthCheck.Type != "" {
+			log.Debugf("updating health check of process %s: %#v", process.Type, process.HealthCheck)
+			_, warnings, err := actor.V3Actor.SetApplicationProcessHealthCheckByNameAndSpace(config.DesiredApplication.Name, config.TargetedSpaceGUID, process.Type, toV3HealthCheck(process.HealthCheck))
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+		if process.ReadinessHealthCheck.Type != "" {
+			log.Debugf("updating readiness health check of process %s: %#v", process.Type, process.ReadinessHealthCheck)
+			_, warnings, err := actor.V3Actor.SetApplicationProcessReadinessHealthCheckByNameAndSpace(config.DesiredApplication.Name, config.TargetedSpaceGUID, process.Type, toV3HealthCheck(process.ReadinessHealthCheck))
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+	}
+	return allWarnings, nil
+}
+
+func toV3HealthCheck(healthCheck manifest.HealthCheck) v3action.ProcessHealthCheck {
+	return v3action.ProcessHealthCheck{
+		Type:     healthCheck.Type,
+		Endpoint: healthCheck.HTTPEndpoint,
+		Interval: healthCheck.Interval,
+	}
+}
@@ -1,14 +1,19 @@
 package pushaction_test
 
 import (
+	"context"
 	"errors"
 
 	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 )
 
 var _ = Describe("Apply", func() {
@@ -20,13 +25,15 @@ var _ = Describe("Apply", func() {
 		warningsStream <-chan Warnings
 		errorStream    <-chan error
 
+		ctx    context.Context
 		config ApplicationConfig
 	)
 
 	BeforeEach(func() {
 		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
-		actor = NewActor(fakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
 
+		ctx = context.Background()
 		config = ApplicationConfig{
 			DesiredApplication: v2action.Application{
 				Name:      "some-app-name",
@@ -36,7 +43,7 @@ var _ = Describe("Apply", func() {
 	})
 
 	JustBeforeEach(func() {
-		eventStream, warningsStream, errorStream = actor.Apply(config)
+		eventStream, warningsStream, errorStream = actor.Apply(ctx, config)
 	})
 
 	AfterEach(func() {
@@ -45,6 +52,105 @@ var _ = Describe("Apply", func() {
 		Eventually(errorStream).Should(BeClosed())
 	})
 
+	Context("when the space has a space quota", func() {
+		BeforeEach(func() {
+			config.CurrentApplication = v2action.Application{GUID: "some-app-guid"}
+			config.DesiredApplication.GUID = "some-app-guid"
+			config.DesiredApplication.Memory = 512
+			config.DesiredApplication.Instances = 2
+			fakeV2Actor.UpdateApplicationReturns(v2action.Application{}, nil, nil)
+			fakeV2Actor.GetSpaceQuotaUsageReturns(v2action.SpaceQuotaUsage{
+				Quota:      v2action.SpaceQuota{GUID: "some-quota-guid", Name: "some-quota", MemoryLimit: 2048, AppInstanceLimit: 5},
+				MemoryUsed: 1024,
+				Instances:  3,
+			}, v2action.Warnings{"quota-warning"}, nil)
+		})
+
+		Context("when the desired application fits in the quota", func() {
+			It("applies the application", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("quota-warning")))
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationUpdated)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+				Expect(fakeV2Actor.GetSpaceQuotaUsageCallCount()).To(Equal(1))
+				spaceGUID, ignoredAppGUID := fakeV2Actor.GetSpaceQuotaUsageArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(ignoredAppGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the desired memory exceeds the quota", func() {
+			BeforeEach(func() {
+				config.DesiredApplication.Memory = 1024
+			})
+
+			It("returns a SpaceQuotaExceededError before changing anything", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("quota-warning")))
+				Eventually(errorStream).Should(Receive(MatchError(SpaceQuotaExceededError{
+					AppName:   "some-app-name",
+					QuotaName: "some-quota",
+					Resource:  SpaceQuotaMemory,
+					Requested: 2048,
+					Used:      1024,
+					Limit:     2048,
+				})))
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the desired instances exceed the quota", func() {
+			BeforeEach(func() {
+				config.DesiredApplication.Memory = 0
+				config.DesiredApplication.Instances = 3
+			})
+
+			It("returns a SpaceQuotaExceededError before changing anything", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("quota-warning")))
+				Eventually(errorStream).Should(Receive(MatchError(SpaceQuotaExceededError{
+					AppName:   "some-app-name",
+					QuotaName: "some-quota",
+					Resource:  SpaceQuotaInstances,
+					Requested: 3,
+					Used:      3,
+					Limit:     5,
+				})))
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the quota is unlimited", func() {
+			BeforeEach(func() {
+				config.DesiredApplication.Memory = 4096
+				config.DesiredApplication.Instances = 10
+				fakeV2Actor.GetSpaceQuotaUsageReturns(v2action.SpaceQuotaUsage{
+					Quota: v2action.SpaceQuota{GUID: "some-quota-guid", MemoryLimit: -1, AppInstanceLimit: -1},
+				}, nil, nil)
+			})
+
+			It("applies the application", func() {
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationUpdated)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+			})
+		})
+
+		Context("when getting the usage fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("usage-error")
+				fakeV2Actor.GetSpaceQuotaUsageReturns(v2action.SpaceQuotaUsage{}, v2action.Warnings{"quota-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("quota-warning")))
+				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Context("when the app exists", func() {
 		BeforeEach(func() {
 			config.CurrentApplication = v2action.Application{
@@ -68,8 +174,8 @@ var _ = Describe("Apply", func() {
 
 			It("updates the application", func() {
 				Eventually(warningsStream).Should(Receive(ConsistOf("update-warning")))
-				Eventually(eventStream).Should(Receive(Equal(ApplicationUpdated)))
-				Eventually(eventStream).Should(Receive(Equal(Complete)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationUpdated)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
 
 				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(1))
 				Expect(fakeV2Actor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
@@ -79,6 +185,36 @@ var _ = Describe("Apply", func() {
 					Buildpack: "ruby",
 				}))
 			})
+
+			Context("when the environment variables have not changed", func() {
+				BeforeEach(func() {
+					config.CurrentApplication.EnvironmentVariables = map[string]string{"PORT": "8080"}
+					config.DesiredApplication.EnvironmentVariables = map[string]string{"PORT": "8080"}
+				})
+
+				It("does not send them", func() {
+					Eventually(warningsStream).Should(Receive(ConsistOf("update-warning")))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+					Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.UpdateApplicationArgsForCall(0).EnvironmentVariables).To(BeNil())
+				})
+			})
+
+			Context("when the environment variables have changed", func() {
+				BeforeEach(func() {
+					config.CurrentApplication.EnvironmentVariables = map[string]string{"PORT": "8080"}
+					config.DesiredApplication.EnvironmentVariables = map[string]string{"PORT": "9090"}
+				})
+
+				It("sends them", func() {
+					Eventually(warningsStream).Should(Receive(ConsistOf("update-warning")))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+					Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.UpdateApplicationArgsForCall(0).EnvironmentVariables).To(Equal(map[string]string{"PORT": "9090"}))
+				})
+			})
 		})
 
 		Context("when the update errors", func() {
@@ -91,7 +227,39 @@ var _ = Describe("Apply", func() {
 			It("returns warnings and error and stops", func() {
 				Eventually(warningsStream).Should(Receive(ConsistOf("update-warning")))
 				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
-				Consistently(eventStream).ShouldNot(Receive(Equal(ApplicationUpdated)))
+				Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(ApplicationUpdated)))
+			})
+		})
+
+		Context("when the context is cancelled", func() {
+			BeforeEach(func() {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithCancel(context.Background())
+				cancel()
+			})
+
+			It("returns the context error without updating the application", func() {
+				Eventually(errorStream).Should(Receive(Equal(context.Canceled)))
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the context is cancelled while nothing reads the streams", func() {
+			var cancel context.CancelFunc
+
+			BeforeEach(func() {
+				ctx, cancel = context.WithCancel(context.Background())
+				fakeV2Actor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-warning"}, nil)
+			})
+
+			It("stops sending and closes the streams", func() {
+				Consistently(errorStream).ShouldNot(Receive())
+				cancel()
+
+				Eventually(errorStream).Should(Receive(Equal(context.Canceled)))
+				Eventually(eventStream).Should(BeClosed())
+				Eventually(warningsStream).Should(BeClosed())
+				Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(0))
 			})
 		})
 	})
@@ -104,8 +272,8 @@ var _ = Describe("Apply", func() {
 
 			It("creates the application", func() {
 				Eventually(warningsStream).Should(Receive(ConsistOf("create-warning")))
-				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
-				Eventually(eventStream).Should(Receive(Equal(Complete)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
 
 				Expect(fakeV2Actor.CreateApplicationCallCount()).To(Equal(1))
 				Expect(fakeV2Actor.CreateApplicationArgsForCall(0)).To(Equal(v2action.Application{
@@ -125,7 +293,7 @@ var _ = Describe("Apply", func() {
 			It("returns warnings and error and stops", func() {
 				Eventually(warningsStream).Should(Receive(ConsistOf("create-warning")))
 				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
-				Consistently(eventStream).ShouldNot(Receive(Equal(ApplicationCreated)))
+				Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(ApplicationCreated)))
 			})
 		})
 	})
@@ -162,12 +330,13 @@ var _ = Describe("Apply", func() {
 
 			It("only creates the routes that do not exist", func() {
 				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
-				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: ApplicationCreated, AppGUID: "some-app-guid"})))
 				Eventually(warningsStream).Should(Receive(ConsistOf("create-route-warning")))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteCreated, Route: "some-route-1."})))
 				Eventually(warningsStream).Should(Receive(ConsistOf("create-route-warning")))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteCreated, Route: "some-route-3."})))
 
-				Eventually(eventStream).Should(Receive(Equal(RouteCreated)))
-				Eventually(eventStream).Should(Receive(Equal(Complete)))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: Complete, AppGUID: "some-app-guid"})))
 
 				Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(2))
 				Expect(fakeV2Actor.CreateRouteArgsForCall(0)).To(Equal(v2action.Route{Host: "some-route-1"}))
@@ -188,11 +357,31 @@ var _ = Describe("Apply", func() {
 
 			It("returns warnings and error and stops", func() {
 				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
-				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
 				Eventually(warningsStream).Should(Receive(ConsistOf("create-route-warning")))
 
 				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
-				Consistently(eventStream).ShouldNot(Receive(Equal(RouteCreated)))
+				Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(RouteCreated)))
+			})
+		})
+
+		Context("when the user is not allowed to create a wildcard route", func() {
+			BeforeEach(func() {
+				config.DesiredRoutes = []v2action.Route{
+					{Host: "*", Domain: v2action.Domain{Name: "example.com"}},
+				}
+				fakeV2Actor.CreateRouteReturns(
+					v2action.Route{},
+					v2action.Warnings{"create-route-warning"},
+					ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"})
+			})
+
+			It("returns a WildcardRouteForbiddenError", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-route-warning")))
+
+				Eventually(errorStream).Should(Receive(MatchError(WildcardRouteForbiddenError{Route: "*.example.com"})))
 			})
 		})
 	})
@@ -207,8 +396,8 @@ var _ = Describe("Apply", func() {
 
 		It("returns warnings and error and stops", func() {
 			Eventually(warningsStream).Should(Receive())
-			Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
-			Consistently(eventStream).ShouldNot(Receive(Equal(RouteCreated)))
+			Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+			Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(RouteCreated)))
 		})
 	})
 
@@ -238,12 +427,13 @@ var _ = Describe("Apply", func() {
 
 			It("only creates the routes that do not exist", func() {
 				Eventually(warningsStream).Should(Receive())
-				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
 				Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteBound, Route: "some-route-1.some-domain.com"})))
 				Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteBound, Route: "some-route-3."})))
 
-				Eventually(eventStream).Should(Receive(Equal(RouteBound)))
-				Eventually(eventStream).Should(Receive(Equal(Complete)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
 
 				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(2))
 
@@ -257,6 +447,100 @@ var _ = Describe("Apply", func() {
 			})
 		})
 
+		Context("when a route is bound to an application port", func() {
+			BeforeEach(func() {
+				config.RouteAppPorts = map[string]int{"some-route-1.some-domain.com": 9090}
+				fakeV2Actor.BindRouteToApplicationReturns(v2action.Warnings{"bind-route-warning"}, nil)
+				fakeV2Actor.BindRouteToApplicationPortReturns(v2action.Warnings{"bind-route-port-warning"}, nil)
+			})
+
+			It("maps that route to the application port", func() {
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-port-warning")))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(RouteBound)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(RouteBound)))
+
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(1))
+				routeGUID, _ := fakeV2Actor.BindRouteToApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid-3"))
+
+				Expect(fakeV2Actor.BindRouteToApplicationPortCallCount()).To(Equal(1))
+				routeGUID, appGUID, appPort := fakeV2Actor.BindRouteToApplicationPortArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid-1"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(appPort).To(Equal(9090))
+			})
+		})
+
+		Context("when the app port of a bound route changes", func() {
+			BeforeEach(func() {
+				config.RouteAppPorts = map[string]int{"some-route-2.": 9090}
+				fakeV2Actor.BindRouteToApplicationReturns(v2action.Warnings{"bind-route-warning"}, nil)
+				fakeV2Actor.UpdateRouteApplicationPortReturns(true, v2action.Warnings{"update-route-port-warning"}, nil)
+			})
+
+			It("remaps that route to the new application port", func() {
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteBound, Route: "some-route-1.some-domain.com"})))
+				Eventually(warningsStream).Should(Receive(ConsistOf("update-route-port-warning")))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteBound, Route: "some-route-2."})))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteBound, Route: "some-route-3."})))
+
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(2))
+				Expect(fakeV2Actor.UpdateRouteApplicationPortCallCount()).To(Equal(1))
+				routeGUID, appGUID, appPort := fakeV2Actor.UpdateRouteApplicationPortArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid-2"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(appPort).To(Equal(9090))
+			})
+
+			Context("when the route is already mapped to that port", func() {
+				BeforeEach(func() {
+					fakeV2Actor.UpdateRouteApplicationPortReturns(false, v2action.Warnings{"update-route-port-warning"}, nil)
+				})
+
+				It("does not send a RouteBound event for it", func() {
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
+					Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteBound, Route: "some-route-1.some-domain.com"})))
+					Eventually(warningsStream).Should(Receive(ConsistOf("update-route-port-warning")))
+					Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
+					Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteBound, Route: "some-route-3."})))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+				})
+			})
+
+			Context("when updating the port errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("remap failed")
+					fakeV2Actor.UpdateRouteApplicationPortReturns(false, v2action.Warnings{"update-route-port-warning"}, expectedErr)
+				})
+
+				It("returns warnings and error and stops", func() {
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(RouteBound)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("update-route-port-warning")))
+
+					Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+					Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(Complete)))
+				})
+			})
+		})
+
 		Context("when the creation errors", func() {
 			Context("when the route is bound in another space", func() {
 				BeforeEach(func() {
@@ -265,13 +549,13 @@ var _ = Describe("Apply", func() {
 
 				It("stops and returns the RouteInDifferentSpaceError (with a guid set) and warnings", func() {
 					Eventually(warningsStream).Should(Receive())
-					Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
 					Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
 
 					Eventually(errorStream).Should(Receive(MatchError(
 						v2action.RouteInDifferentSpaceError{Route: "some-route-1.some-domain.com"},
 					)))
-					Consistently(eventStream).ShouldNot(Receive(Equal(RouteBound)))
+					Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(RouteBound)))
 				})
 			})
 			Context("generic error", func() {
@@ -284,11 +568,11 @@ var _ = Describe("Apply", func() {
 
 				It("returns warnings and error and stops", func() {
 					Eventually(warningsStream).Should(Receive())
-					Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
 					Eventually(warningsStream).Should(Receive(ConsistOf("bind-route-warning")))
 
 					Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
-					Consistently(eventStream).ShouldNot(Receive(Equal(RouteBound)))
+					Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(RouteBound)))
 				})
 			})
 		})
@@ -297,8 +581,267 @@ var _ = Describe("Apply", func() {
 	Context("when no routes need to be bound", func() {
 		It("returns warnings and error and stops", func() {
 			Eventually(warningsStream).Should(Receive())
-			Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
-			Consistently(eventStream).ShouldNot(Receive(Equal(RouteBound)))
+			Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+			Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(RouteBound)))
+		})
+	})
+
+	Context("when routes need to be pruned", func() {
+		BeforeEach(func() {
+			config.PruneRoutes = true
+			config.CurrentRoutes = []v2action.Route{
+				{GUID: "some-route-guid-1", Host: "some-route-1", Domain: v2action.Domain{Name: "some-domain.com"}},
+				{GUID: "some-stale-route-guid", Host: "stale", Domain: v2action.Domain{Name: "some-domain.com"}},
+			}
+			config.DesiredRoutes = []v2action.Route{
+				{GUID: "some-route-guid-1", Host: "some-route-1", Domain: v2action.Domain{Name: "some-domain.com"}},
+			}
+
+			fakeV2Actor.CreateApplicationReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+			fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warning"}, nil)
+		})
+
+		It("unmaps the routes that are not desired", func() {
+			Eventually(warningsStream).Should(Receive())
+			Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+			Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+			Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteUnmapped, Route: "stale.some-domain.com"})))
+			Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+			Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID := fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("some-stale-route-guid"))
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(fakeV2Actor.DeleteRouteCallCount()).To(Equal(0))
+		})
+
+		Context("when orphaned routes are deleted", func() {
+			BeforeEach(func() {
+				config.DeleteOrphanedRoutes = true
+				fakeV2Actor.DeleteRouteReturns(v2action.Warnings{"delete-route-warning"}, nil)
+			})
+
+			Context("when no other app is mapped to the route", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetRouteApplicationsReturns(nil, v2action.Warnings{"route-apps-warning"}, nil)
+				})
+
+				It("deletes the route", func() {
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(RouteUnmapped)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("route-apps-warning", "delete-route-warning")))
+					Eventually(eventStream).Should(Receive(Equal(Event{Type: RouteDeleted, Route: "stale.some-domain.com"})))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+					Expect(fakeV2Actor.DeleteRouteCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.DeleteRouteArgsForCall(0)).To(Equal("some-stale-route-guid"))
+				})
+			})
+
+			Context("when another app is still mapped to the route", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetRouteApplicationsReturns([]v2action.Application{{Name: "other-app"}}, nil, nil)
+				})
+
+				It("keeps the route", func() {
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(RouteUnmapped)))
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+					Expect(fakeV2Actor.DeleteRouteCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when unmapping a route errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unbind failed")
+				fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warning"}, expectedErr)
+			})
+
+			It("returns warnings and error and stops", func() {
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+				Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(RouteUnmapped)))
+			})
+		})
+
+		Context("when pruning is not requested", func() {
+			BeforeEach(func() {
+				config.PruneRoutes = false
+			})
+
+			It("keeps the current routes", func() {
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+				Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when services need to be bound", func() {
+		BeforeEach(func() {
+			config.CurrentServiceBindings = map[string]v2action.ServiceBinding{
+				"bound-service": {GUID: "bound-binding-guid", ServiceInstanceGUID: "bound-service-guid"},
+				"stale-service": {GUID: "stale-binding-guid", ServiceInstanceGUID: "stale-service-guid"},
+			}
+			config.DesiredServices = []v2action.ServiceInstance{
+				{Name: "bound-service", GUID: "bound-service-guid"},
+				{Name: "new-service", GUID: "new-service-guid"},
+			}
+
+			fakeV2Actor.CreateApplicationReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+			fakeV2Actor.BindServiceToApplicationReturns(v2action.Warnings{"bind-service-warning"}, nil)
+			fakeV2Actor.DeleteServiceBindingReturns(v2action.Warnings{"unbind-service-warning"}, nil)
+		})
+
+		It("binds the service instances that are not bound yet and keeps the others", func() {
+			Eventually(warningsStream).Should(Receive())
+			Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+			Eventually(warningsStream).Should(Receive(ConsistOf("bind-service-warning")))
+			Eventually(eventStream).Should(Receive(Equal(Event{Type: ServiceBound, ServiceInstance: "new-service"})))
+			Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+			Expect(fakeV2Actor.BindServiceToApplicationCallCount()).To(Equal(1))
+			appGUID, serviceInstanceGUID := fakeV2Actor.BindServiceToApplicationArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("new-service-guid"))
+			Expect(fakeV2Actor.DeleteServiceBindingCallCount()).To(Equal(0))
+		})
+
+		Context("when binding a service instance errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("bind failed")
+				fakeV2Actor.BindServiceToApplicationReturns(v2action.Warnings{"bind-service-warning"}, expectedErr)
+			})
+
+			It("returns warnings and error and stops", func() {
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-service-warning")))
+				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+				Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(ServiceBound)))
+			})
+		})
+
+		Context("when services need to be pruned", func() {
+			BeforeEach(func() {
+				config.PruneServices = true
+			})
+
+			It("unbinds the service instances that are not desired", func() {
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-service-warning")))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ServiceBound)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-service-warning")))
+				Eventually(eventStream).Should(Receive(Equal(Event{Type: ServiceUnbound, ServiceInstance: "stale-service"})))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+				Expect(fakeV2Actor.DeleteServiceBindingCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteServiceBindingArgsForCall(0)).To(Equal("stale-binding-guid"))
+			})
+
+			Context("when unbinding a service instance errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("unbind failed")
+					fakeV2Actor.DeleteServiceBindingReturns(v2action.Warnings{"unbind-service-warning"}, expectedErr)
+				})
+
+				It("returns warnings and error and stops", func() {
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("bind-service-warning")))
+					Eventually(eventStream).Should(Receive(BeAnEventOfType(ServiceBound)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("unbind-service-warning")))
+					Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+					Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(ServiceUnbound)))
+				})
+			})
+		})
+	})
+
+	Context("when process health checks need to be updated", func() {
+		var fakeV3Actor *pushactionfakes.FakeV3Actor
+
+		BeforeEach(func() {
+			fakeV3Actor = new(pushactionfakes.FakeV3Actor)
+			actor.V3Actor = fakeV3Actor
+
+			config.TargetedSpaceGUID = "some-space-guid"
+			config.ProcessHealthChecks = []manifest.Process{
+				{Type: "web", ReadinessHealthCheck: manifest.HealthCheck{Type: "http", HTTPEndpoint: "/ready", Interval: 5}},
+				{Type: "worker", HealthCheck: manifest.HealthCheck{Type: "process"}},
+			}
+
+			fakeV2Actor.CreateApplicationReturns(
+				v2action.Application{Name: "some-app-name", GUID: "some-app-guid"},
+				v2action.Warnings{"create-app-warning"},
+				nil)
+		})
+
+		Context("when the updates are successful", func() {
+			BeforeEach(func() {
+				fakeV3Actor.SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"readiness-warning"}, nil)
+				fakeV3Actor.SetApplicationProcessHealthCheckByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"health-check-warning"}, nil)
+			})
+
+			It("sets the health checks of each process", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("readiness-warning", "health-check-warning")))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(HealthChecksUpdated)))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(Complete)))
+
+				Expect(fakeV3Actor.SetApplicationProcessReadinessHealthCheckByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, processType, healthCheck := fakeV3Actor.SetApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app-name"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(processType).To(Equal("web"))
+				Expect(healthCheck).To(Equal(v3action.ProcessHealthCheck{Type: "http", Endpoint: "/ready", Interval: 5}))
+
+				Expect(fakeV3Actor.SetApplicationProcessHealthCheckByNameAndSpaceCallCount()).To(Equal(1))
+				_, _, processType, healthCheck = fakeV3Actor.SetApplicationProcessHealthCheckByNameAndSpaceArgsForCall(0)
+				Expect(processType).To(Equal("worker"))
+				Expect(healthCheck).To(Equal(v3action.ProcessHealthCheck{Type: "process"}))
+			})
+		})
+
+		Context("when an update errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeV3Actor.SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"readiness-warning"}, expectedErr)
+			})
+
+			It("returns warnings and error and stops", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(BeAnEventOfType(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("readiness-warning")))
+				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+				Consistently(eventStream).ShouldNot(Receive(BeAnEventOfType(HealthChecksUpdated)))
+				Expect(fakeV3Actor.SetApplicationProcessHealthCheckByNameAndSpaceCallCount()).To(Equal(0))
+			})
 		})
 	})
 })
+
+func BeAnEventOfType(eventType EventType) types.GomegaMatcher {
+	return WithTransform(func(event Event) EventType { return event.Type }, Equal(eventType))
+}
@@ -0,0 +1,95 @@
+package pushaction
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/util/spellcheck"
+)
+
+// BuildpackNotFoundError is returned when the buildpack of an application is
+// not an enabled buildpack of its stack. Suggestions are the names of the
+// buildpacks of the stack that are close to Buildpack.
+type BuildpackNotFoundError struct {
+	AppName     string
+	Buildpack   string
+	Stack       string
+	Suggestions []string
+}
+
+func (e BuildpackNotFoundError) Error() string {
+	if e.Stack == "" {
+		return fmt.Sprintf("buildpack %s of application %s not found", e.Buildpack, e.AppName)
+	}
+	return fmt.Sprintf("buildpack %s of application %s not found for stack %s", e.Buildpack, e.AppName, e.Stack)
+}
+
+// isNamedBuildpack returns false for the buildpacks that are not looked up by
+// name: the built-in buildpack keywords and Git URLs.
+func isNamedBuildpack(buildpack string) bool {
+	switch buildpack {
+	case "", "default", "null":
+		return false
+	}
+	return !strings.Contains(buildpack, "://") && !strings.HasPrefix(buildpack, "git@")
+}
+
+// validateBuildpack returns a BuildpackNotFoundError when the named buildpack
+// is not an enabled buildpack that runs on the stack. An empty stack matches
+// the buildpacks of every stack.
+func validateBuildpack(appName string, buildpack string, stack string, buildpacks []v2action.Buildpack) error {
+	var names []string
+	for _, candidate := range buildpacks {
+		if !candidate.Enabled || (stack != "" && candidate.Stack != "" && candidate.Stack != stack) {
+			continue
+		}
+		if candidate.Name == buildpack {
+			return nil
+		}
+		names = append(names, candidate.Name)
+	}
+
+	return BuildpackNotFoundError{
+		AppName:     appName,
+		Buildpack:   buildpack,
+		Stack:       stack,
+		Suggestions: buildpackSuggestions(buildpack, names),
+	}
+}
+
+// buildpackSuggestions returns the names that are a single edit away from the
+// buildpack or that contain it, such as ruby_buildpack for ruby.
+func buildpackSuggestions(buildpack string, names []string) []string {
+	suggested := map[string]bool{}
+	if len(names) > 0 {
+		for _, name := range spellcheck.NewCommandSuggester(names).Recommend(buildpack) {
+			suggested[name] = true
+		}
+	}
+	for _, name := range names {
+		if strings.Contains(name, buildpack) {
+			suggested[name] = true
+		}
+	}
+
+	var suggestions []string
+	for name := range suggested {
+		suggestions = append(suggestions, name)
+	}
+	sort.Strings(suggestions)
+	return suggestions
+}
+
+// applicationStackName returns the name of the stack the application will run
+// on, or an empty string when it runs on the default stack.
+func (actor Actor) applicationStackName(application v2action.Application, app manifest.Application) (string, Warnings, error) {
+	if app.Stack != "" || application.StackGUID == "" {
+		return app.Stack, nil, nil
+	}
+
+	stack, warnings, err := actor.V2Actor.GetStack(application.StackGUID)
+	return stack.Name, Warnings(warnings), err
+}
@@ -1,6 +1,18 @@
 package pushaction
 
+import "code.cloudfoundry.org/cli/types"
+
+// CommandLineSettings are the application settings provided by flags. The
+// settings that are set take precedence over the manifest.
 type CommandLineSettings struct {
 	Name string
 	Path string
+
+	Buildpack       string
+	HealthCheckType string
+	Stack           string
+	Instances       types.NullInt
+	// DiskQuota and Memory are in megabytes; 0 means they are not set.
+	DiskQuota int
+	Memory    int
 }
@@ -0,0 +1,115 @@
+package pushaction
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// ConfigChange is a setting that differs between the current and the desired
+// state of an application. Current is empty when the setting is being added.
+type ConfigChange struct {
+	Field   string
+	Current string
+	Desired string
+}
+
+// Changes returns the buildpack, scaling, port, environment variable and route
+// settings that applying the config would change, in that order. The changes
+// are computed from the application that Apply sends, so environment variables
+// that Apply would remove are listed with an empty Desired value. Environment
+// variables are ordered by name and routes by their string form. Routes that
+// are already bound are not listed, and the bound routes that Apply would
+// unmap are listed with an empty Desired value.
+func (config ApplicationConfig) Changes() []ConfigChange {
+	current := config.CurrentApplication
+	desired := applicationRequest(config)
+
+	var changes []ConfigChange
+	addChange := func(field string, currentValue string, desiredValue string) {
+		if currentValue != desiredValue {
+			changes = append(changes, ConfigChange{Field: field, Current: currentValue, Desired: desiredValue})
+		}
+	}
+
+	addChange("buildpack", current.Buildpack, desired.Buildpack)
+	addChange("instances", formatInstances(current.Instances), formatInstances(desired.Instances))
+	addChange("memory", formatMegabytes(current.Memory), formatMegabytes(desired.Memory))
+	addChange("disk quota", formatMegabytes(current.DiskQuota), formatMegabytes(desired.DiskQuota))
+	addChange("ports", formatPorts(current.Ports), formatPorts(desired.Ports))
+
+	// Environment variables that are not sent are left unchanged; the ones
+	// that are sent replace all of the current ones.
+	if desired.EnvironmentVariables != nil {
+		var envNames []string
+		for name := range desired.EnvironmentVariables {
+			envNames = append(envNames, name)
+		}
+		for name := range current.EnvironmentVariables {
+			if _, ok := desired.EnvironmentVariables[name]; !ok {
+				envNames = append(envNames, name)
+			}
+		}
+		sort.Strings(envNames)
+		for _, name := range envNames {
+			currentValue, exists := current.EnvironmentVariables[name]
+			desiredValue, kept := desired.EnvironmentVariables[name]
+			if !exists || !kept || currentValue != desiredValue {
+				changes = append(changes, ConfigChange{Field: "env " + name, Current: currentValue, Desired: desiredValue})
+			}
+		}
+	}
+
+	var routeChanges []ConfigChange
+	for _, route := range config.DesiredRoutes {
+		if !routeStringInList(route, config.CurrentRoutes) {
+			routeChanges = append(routeChanges, ConfigChange{Field: "route", Desired: route.String()})
+		}
+	}
+	if config.PruneRoutes {
+		for _, route := range config.CurrentRoutes {
+			if !routeStringInList(route, config.DesiredRoutes) {
+				routeChanges = append(routeChanges, ConfigChange{Field: "route", Current: route.String()})
+			}
+		}
+	}
+	sort.Slice(routeChanges, func(i int, j int) bool {
+		return routeChanges[i].Current+routeChanges[i].Desired < routeChanges[j].Current+routeChanges[j].Desired
+	})
+
+	return append(changes, routeChanges...)
+}
+
+func routeStringInList(route v2action.Route, routes []v2action.Route) bool {
+	for _, r := range routes {
+		if r.String() == route.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func formatPorts(ports []int) string {
+	var formatted []string
+	for _, port := range ports {
+		formatted = append(formatted, strconv.Itoa(port))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func formatInstances(instances int) string {
+	if instances == 0 {
+		return ""
+	}
+	return strconv.Itoa(instances)
+}
+
+func formatMegabytes(megabytes int) string {
+	if megabytes == 0 {
+		return ""
+	}
+	return bytefmt.ByteSize(uint64(megabytes) * bytefmt.MEGABYTE)
+}
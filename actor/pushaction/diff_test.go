@@ -0,0 +1,103 @@
+package pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Diff", func() {
+	Describe("Changes", func() {
+		var config ApplicationConfig
+
+		BeforeEach(func() {
+			domain := v2action.Domain{Name: "example.com"}
+			config = ApplicationConfig{
+				CurrentApplication: v2action.Application{
+					Buildpack:            "ruby_buildpack",
+					DiskQuota:            1024,
+					EnvironmentVariables: map[string]string{"UNCHANGED": "same", "CHANGED": "old-value"},
+					Instances:            2,
+					Memory:               256,
+					Ports:                []int{8080},
+				},
+				CurrentRoutes: []v2action.Route{{Host: "some-app", Domain: domain}},
+				DesiredRoutes: []v2action.Route{
+					{Host: "some-app", Domain: domain},
+					{Host: "some-other-app", Domain: domain},
+					{Host: "another-app", Domain: domain},
+				},
+			}
+			config.DesiredApplication = config.CurrentApplication
+		})
+
+		Context("when nothing changes", func() {
+			BeforeEach(func() {
+				config.DesiredRoutes = config.CurrentRoutes
+			})
+
+			It("returns no changes", func() {
+				Expect(config.Changes()).To(BeEmpty())
+			})
+		})
+
+		Context("when settings change", func() {
+			BeforeEach(func() {
+				config.DesiredApplication.Buildpack = "go_buildpack"
+				config.DesiredApplication.Instances = 4
+				config.DesiredApplication.Memory = 1024
+				config.DesiredApplication.Ports = []int{8080, 9090}
+				config.DesiredApplication.EnvironmentVariables = map[string]string{
+					"UNCHANGED": "same",
+					"CHANGED":   "new-value",
+					"ADDED":     "some-value",
+				}
+			})
+
+			It("returns every changed setting in order", func() {
+				Expect(config.Changes()).To(Equal([]ConfigChange{
+					{Field: "buildpack", Current: "ruby_buildpack", Desired: "go_buildpack"},
+					{Field: "instances", Current: "2", Desired: "4"},
+					{Field: "memory", Current: "256M", Desired: "1G"},
+					{Field: "ports", Current: "8080", Desired: "8080, 9090"},
+					{Field: "env ADDED", Current: "", Desired: "some-value"},
+					{Field: "env CHANGED", Current: "old-value", Desired: "new-value"},
+					{Field: "route", Desired: "another-app.example.com"},
+					{Field: "route", Desired: "some-other-app.example.com"},
+				}))
+			})
+		})
+
+		Context("when the environment variables that Apply sends remove a variable", func() {
+			BeforeEach(func() {
+				config.CurrentApplication.GUID = "some-app-guid"
+				config.DesiredApplication.GUID = "some-app-guid"
+				config.DesiredRoutes = config.CurrentRoutes
+				config.DesiredApplication.EnvironmentVariables = map[string]string{"UNCHANGED": "same"}
+			})
+
+			It("returns the removed variable without a desired value", func() {
+				Expect(config.Changes()).To(Equal([]ConfigChange{
+					{Field: "env CHANGED", Current: "old-value", Desired: ""},
+				}))
+			})
+		})
+
+		Context("when routes are pruned", func() {
+			BeforeEach(func() {
+				domain := v2action.Domain{Name: "example.com"}
+				config.PruneRoutes = true
+				config.DesiredRoutes = []v2action.Route{{Host: "some-other-app", Domain: domain}}
+			})
+
+			It("returns the unmapped routes without a desired value", func() {
+				Expect(config.Changes()).To(Equal([]ConfigChange{
+					{Field: "route", Current: "some-app.example.com"},
+					{Field: "route", Desired: "some-other-app.example.com"},
+				}))
+			})
+		})
+	})
+})
@@ -1,13 +1,35 @@
 package pushaction
 
-type Event string
+// EventType is the step of Apply that an Event reports.
+type EventType string
 
 const (
-	ApplicationCreated   Event = "application created"
-	ApplicationUpdated   Event = "application updated"
-	RouteCreated         Event = "route created"
-	RouteBound           Event = "route bound"
-	UploadingApplication Event = "uploading application"
-	UploadComplete       Event = "upload complete"
-	Complete             Event = "complete"
+	ApplicationCreated  EventType = "application created"
+	ApplicationUpdated  EventType = "application updated"
+	RouteCreated        EventType = "route created"
+	RouteBound          EventType = "route bound"
+	RouteUnmapped       EventType = "route unmapped"
+	RouteDeleted        EventType = "route deleted"
+	ServiceBound        EventType = "service bound"
+	ServiceUnbound      EventType = "service unbound"
+	HealthChecksUpdated EventType = "health checks updated"
+	Complete            EventType = "complete"
 )
+
+// Event is sent by Apply when a step has progressed. Only the fields of the
+// step are set. Apply does not upload the application bits, so there are no
+// upload events.
+type Event struct {
+	Type EventType
+
+	// AppGUID is set by ApplicationCreated, ApplicationUpdated and Complete.
+	AppGUID string
+
+	// Route is set by RouteCreated, RouteBound, RouteUnmapped and
+	// RouteDeleted, which are sent once for every route.
+	Route string
+
+	// ServiceInstance is set by ServiceBound and ServiceUnbound, which are
+	// sent once for every service instance.
+	ServiceInstance string
+}
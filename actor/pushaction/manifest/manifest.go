@@ -1,10 +1,262 @@
 package manifest
 
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/types"
+	"github.com/cloudfoundry/bytefmt"
+	yaml "gopkg.in/yaml.v2"
+)
+
 type Manifest struct {
 	Applications []Application
 }
 
 type Application struct {
-	Name string
-	Path string
+	Name      string
+	Path      string
+	DependsOn []string
+
+	Buildpack string
+	// Stack is the name of the stack the application runs on.
+	Stack                string
+	EnvironmentVariables map[string]string
+	Instances            types.NullInt
+	// DiskQuota and Memory are in megabytes; 0 means they are not set.
+	DiskQuota int
+	Memory    int
+	// Ports are the ports the application listens on.
+	Ports  []int
+	Routes []string
+	// RouteAppPorts maps routes to the application port their traffic is
+	// sent to. Routes that are not in it use the application's first port.
+	RouteAppPorts map[string]int
+	// Services are the names of the service instances bound to the
+	// application.
+	Services []string
+
+	// HealthCheck and ReadinessHealthCheck apply to the web process.
+	HealthCheck          HealthCheck
+	ReadinessHealthCheck HealthCheck
+	// Processes holds the health checks of other process types.
+	Processes []Process
+}
+
+// HealthCheck is the health check configuration of a process. An empty Type
+// means the health check is not set.
+type HealthCheck struct {
+	Type         string
+	HTTPEndpoint string
+	// Interval is the number of seconds between checks. It only applies to
+	// readiness health checks.
+	Interval int
+}
+
+// Process is the health check configuration of a process type.
+type Process struct {
+	Type                 string
+	HealthCheck          HealthCheck
+	ReadinessHealthCheck HealthCheck
+}
+
+// InvalidByteSizeError is returned when the memory or disk quota of an
+// application cannot be parsed.
+type InvalidByteSizeError struct {
+	AppName string
+	Field   string
+	Value   string
+}
+
+func (e InvalidByteSizeError) Error() string {
+	return fmt.Sprintf("invalid %s '%s' for application %s", e.Field, e.Value, e.AppName)
+}
+
+type rawManifest struct {
+	Applications []rawApplication `yaml:"applications"`
+}
+
+type rawApplication struct {
+	Name      string            `yaml:"name"`
+	Path      string            `yaml:"path,omitempty"`
+	DependsOn []string          `yaml:"depends_on,omitempty"`
+	Buildpack string            `yaml:"buildpack,omitempty"`
+	Stack     string            `yaml:"stack,omitempty"`
+	Env       map[string]string `yaml:"env,omitempty"`
+	Instances *int              `yaml:"instances,omitempty"`
+	DiskQuota string            `yaml:"disk_quota,omitempty"`
+	Memory    string            `yaml:"memory,omitempty"`
+	Ports     []int             `yaml:"ports,omitempty"`
+	Routes    []rawRoute        `yaml:"routes,omitempty"`
+	Services  []string          `yaml:"services,omitempty"`
+
+	rawHealthChecks `yaml:",inline"`
+
+	Processes []rawProcess `yaml:"processes,omitempty"`
+}
+
+type rawProcess struct {
+	Type string `yaml:"type"`
+
+	rawHealthChecks `yaml:",inline"`
+}
+
+type rawHealthChecks struct {
+	HealthCheckType                  string `yaml:"health_check_type,omitempty"`
+	HealthCheckHTTPEndpoint          string `yaml:"health_check_http_endpoint,omitempty"`
+	ReadinessHealthCheckType         string `yaml:"readiness_health_check_type,omitempty"`
+	ReadinessHealthCheckHTTPEndpoint string `yaml:"readiness_health_check_http_endpoint,omitempty"`
+	ReadinessHealthCheckInterval     int    `yaml:"readiness_health_check_interval,omitempty"`
+}
+
+func (raw rawHealthChecks) healthCheck() HealthCheck {
+	return HealthCheck{
+		Type:         raw.HealthCheckType,
+		HTTPEndpoint: raw.HealthCheckHTTPEndpoint,
+	}
+}
+
+func (raw rawHealthChecks) readinessHealthCheck() HealthCheck {
+	return HealthCheck{
+		Type:         raw.ReadinessHealthCheckType,
+		HTTPEndpoint: raw.ReadinessHealthCheckHTTPEndpoint,
+		Interval:     raw.ReadinessHealthCheckInterval,
+	}
+}
+
+func newRawHealthChecks(healthCheck HealthCheck, readinessHealthCheck HealthCheck) rawHealthChecks {
+	return rawHealthChecks{
+		HealthCheckType:                  healthCheck.Type,
+		HealthCheckHTTPEndpoint:          healthCheck.HTTPEndpoint,
+		ReadinessHealthCheckType:         readinessHealthCheck.Type,
+		ReadinessHealthCheckHTTPEndpoint: readinessHealthCheck.HTTPEndpoint,
+		ReadinessHealthCheckInterval:     readinessHealthCheck.Interval,
+	}
+}
+
+type rawRoute struct {
+	Route   string `yaml:"route"`
+	AppPort int    `yaml:"app_port,omitempty"`
+}
+
+func (raw rawApplication) toApplication() (Application, error) {
+	app := Application{
+		Name:                 raw.Name,
+		Path:                 raw.Path,
+		DependsOn:            raw.DependsOn,
+		Buildpack:            raw.Buildpack,
+		Stack:                raw.Stack,
+		EnvironmentVariables: raw.Env,
+		Ports:                raw.Ports,
+		Services:             raw.Services,
+		HealthCheck:          raw.healthCheck(),
+		ReadinessHealthCheck: raw.readinessHealthCheck(),
+	}
+
+	for _, process := range raw.Processes {
+		app.Processes = append(app.Processes, Process{
+			Type:                 process.Type,
+			HealthCheck:          process.healthCheck(),
+			ReadinessHealthCheck: process.readinessHealthCheck(),
+		})
+	}
+
+	if raw.Instances != nil {
+		app.Instances = types.NullInt{IsSet: true, Value: *raw.Instances}
+	}
+
+	var err error
+	app.DiskQuota, err = parseMegabytes(raw.Name, "disk_quota", raw.DiskQuota)
+	if err != nil {
+		return Application{}, err
+	}
+	app.Memory, err = parseMegabytes(raw.Name, "memory", raw.Memory)
+	if err != nil {
+		return Application{}, err
+	}
+
+	for _, route := range raw.Routes {
+		app.Routes = append(app.Routes, route.Route)
+		if route.AppPort != 0 {
+			if app.RouteAppPorts == nil {
+				app.RouteAppPorts = map[string]int{}
+			}
+			app.RouteAppPorts[route.Route] = route.AppPort
+		}
+	}
+
+	return app, nil
+}
+
+func parseMegabytes(appName string, field string, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	megabytes, err := bytefmt.ToMegabytes(value)
+	if err != nil {
+		return 0, InvalidByteSizeError{AppName: appName, Field: field, Value: value}
+	}
+	return int(megabytes), nil
+}
+
+// ReadManifest reads the applications from the manifest at pathToManifest.
+// Application paths are resolved relative to the manifest's directory, which
+// is also the path of applications that do not provide one.
+func ReadManifest(pathToManifest string) ([]Application, error) {
+	raw, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest rawManifest
+	err = yaml.Unmarshal(raw, &manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestDir := filepath.Dir(pathToManifest)
+	var apps []Application
+	for _, rawApp := range manifest.Applications {
+		app, err := rawApp.toApplication()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case app.Path == "":
+			app.Path = manifestDir
+		case !filepath.IsAbs(app.Path):
+			app.Path = filepath.Join(manifestDir, app.Path)
+		}
+		apps = append(apps, app)
+	}
+
+	return apps, nil
+}
+
+// FindManifests returns the paths of the YAML files in dir, ordered by name.
+// Subdirectories are not searched.
+func FindManifests(dir string) ([]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifestPaths []string
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		extension := strings.ToLower(filepath.Ext(file.Name()))
+		if extension == ".yml" || extension == ".yaml" {
+			manifestPaths = append(manifestPaths, filepath.Join(dir, file.Name()))
+		}
+	}
+	sort.Strings(manifestPaths)
+
+	return manifestPaths, nil
 }
@@ -0,0 +1,159 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "manifest-test")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("ReadManifest", func() {
+		var (
+			pathToManifest string
+			apps           []Application
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			pathToManifest = filepath.Join(dir, "manifest.yml")
+		})
+
+		JustBeforeEach(func() {
+			apps, executeErr = ReadManifest(pathToManifest)
+		})
+
+		Context("when the manifest is valid", func() {
+			BeforeEach(func() {
+				manifest := []byte(`---
+applications:
+- name: app-1
+  path: some-app
+  depends_on:
+  - app-2
+  - app-3
+- name: app-2
+  path: /some/absolute/path
+  buildpack: ruby_buildpack
+  stack: cflinuxfs2
+  instances: 3
+  memory: 1G
+  disk_quota: 512M
+  env:
+    SOME_VAR: some-value
+  ports:
+  - 8080
+  - 9090
+  routes:
+  - route: app-2.example.com
+  - route: app-2.example.com/some-path
+    app_port: 9090
+- name: app-3
+  health_check_type: port
+  readiness_health_check_type: http
+  readiness_health_check_http_endpoint: /ready
+  readiness_health_check_interval: 5
+  processes:
+  - type: worker
+    health_check_type: process
+    readiness_health_check_type: process
+`)
+				Expect(ioutil.WriteFile(pathToManifest, manifest, 0600)).To(Succeed())
+			})
+
+			It("returns the applications with paths relative to the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{
+					{Name: "app-1", Path: filepath.Join(dir, "some-app"), DependsOn: []string{"app-2", "app-3"}},
+					{
+						Name:                 "app-2",
+						Path:                 "/some/absolute/path",
+						Buildpack:            "ruby_buildpack",
+						Stack:                "cflinuxfs2",
+						EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+						Instances:            types.NullInt{IsSet: true, Value: 3},
+						DiskQuota:            512,
+						Memory:               1024,
+						Ports:                []int{8080, 9090},
+						Routes:               []string{"app-2.example.com", "app-2.example.com/some-path"},
+						RouteAppPorts:        map[string]int{"app-2.example.com/some-path": 9090},
+					},
+					{
+						Name:                 "app-3",
+						Path:                 dir,
+						HealthCheck:          HealthCheck{Type: "port"},
+						ReadinessHealthCheck: HealthCheck{Type: "http", HTTPEndpoint: "/ready", Interval: 5},
+						Processes: []Process{
+							{
+								Type:                 "worker",
+								HealthCheck:          HealthCheck{Type: "process"},
+								ReadinessHealthCheck: HealthCheck{Type: "process"},
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the memory of an application is not a valid size", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pathToManifest, []byte("applications:\n- name: app-1\n  memory: lots\n"), 0600)).To(Succeed())
+			})
+
+			It("returns an InvalidByteSizeError", func() {
+				Expect(executeErr).To(MatchError(InvalidByteSizeError{AppName: "app-1", Field: "memory", Value: "lots"}))
+			})
+		})
+
+		Context("when the manifest is not valid YAML", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pathToManifest, []byte("applications: [- name"), 0600)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+
+		Context("when the manifest does not exist", func() {
+			It("returns an error", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("FindManifests", func() {
+		BeforeEach(func() {
+			for _, name := range []string{"b.yml", "a.YAML", "notes.txt"} {
+				Expect(ioutil.WriteFile(filepath.Join(dir, name), nil, 0600)).To(Succeed())
+			}
+			Expect(os.Mkdir(filepath.Join(dir, "nested.yml"), 0700)).To(Succeed())
+		})
+
+		It("returns the YAML files in the directory ordered by name", func() {
+			manifestPaths, err := FindManifests(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifestPaths).To(Equal([]string{
+				filepath.Join(dir, "a.YAML"),
+				filepath.Join(dir, "b.yml"),
+			}))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+package manifest
+
+import (
+	"io/ioutil"
+
+	"github.com/cloudfoundry/bytefmt"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Space is the exported state of a space: its applications, in manifest
+// form, and the service instances they are bound to.
+type Space struct {
+	Applications []Application
+	Services     []Service
+}
+
+// Service is a service instance of a space. Service and Plan are empty for
+// user provided service instances.
+type Service struct {
+	Name         string
+	Service      string
+	Plan         string
+	UserProvided bool
+}
+
+type rawSpace struct {
+	Applications []rawApplication `yaml:"applications,omitempty"`
+	Services     []rawService     `yaml:"services,omitempty"`
+}
+
+type rawService struct {
+	Name         string `yaml:"name"`
+	Service      string `yaml:"service,omitempty"`
+	Plan         string `yaml:"plan,omitempty"`
+	UserProvided bool   `yaml:"user_provided,omitempty"`
+}
+
+// ReadSpace reads the space state written by WriteSpace from pathToSpace.
+func ReadSpace(pathToSpace string) (Space, error) {
+	raw, err := ioutil.ReadFile(pathToSpace)
+	if err != nil {
+		return Space{}, err
+	}
+
+	var space rawSpace
+	err = yaml.Unmarshal(raw, &space)
+	if err != nil {
+		return Space{}, err
+	}
+
+	var result Space
+	for _, rawApp := range space.Applications {
+		app, err := rawApp.toApplication()
+		if err != nil {
+			return Space{}, err
+		}
+		result.Applications = append(result.Applications, app)
+	}
+	for _, service := range space.Services {
+		result.Services = append(result.Services, Service(service))
+	}
+
+	return result, nil
+}
+
+// WriteSpace writes the space state to pathToSpace as YAML. Applications are
+// written in the same format as a manifest.
+func WriteSpace(pathToSpace string, space Space) error {
+	var raw rawSpace
+	for _, app := range space.Applications {
+		raw.Applications = append(raw.Applications, newRawApplication(app))
+	}
+	for _, service := range space.Services {
+		raw.Services = append(raw.Services, rawService(service))
+	}
+
+	body, err := yaml.Marshal(raw)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(pathToSpace, body, 0600)
+}
+
+func newRawApplication(app Application) rawApplication {
+	raw := rawApplication{
+		Name:      app.Name,
+		Path:      app.Path,
+		DependsOn: app.DependsOn,
+		Buildpack: app.Buildpack,
+		Env:       app.EnvironmentVariables,
+		Ports:     app.Ports,
+		Services:  app.Services,
+
+		rawHealthChecks: newRawHealthChecks(app.HealthCheck, app.ReadinessHealthCheck),
+	}
+
+	for _, process := range app.Processes {
+		raw.Processes = append(raw.Processes, rawProcess{
+			Type:            process.Type,
+			rawHealthChecks: newRawHealthChecks(process.HealthCheck, process.ReadinessHealthCheck),
+		})
+	}
+
+	if app.Instances.IsSet {
+		instances := app.Instances.Value
+		raw.Instances = &instances
+	}
+	if app.DiskQuota != 0 {
+		raw.DiskQuota = bytefmt.ByteSize(uint64(app.DiskQuota) * bytefmt.MEGABYTE)
+	}
+	if app.Memory != 0 {
+		raw.Memory = bytefmt.ByteSize(uint64(app.Memory) * bytefmt.MEGABYTE)
+	}
+
+	for _, route := range app.Routes {
+		raw.Routes = append(raw.Routes, rawRoute{Route: route, AppPort: app.RouteAppPorts[route]})
+	}
+
+	return raw
+}
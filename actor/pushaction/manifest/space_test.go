@@ -0,0 +1,152 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space", func() {
+	var (
+		dir         string
+		pathToSpace string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "space-test")
+		Expect(err).ToNot(HaveOccurred())
+		pathToSpace = filepath.Join(dir, "space.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("WriteSpace", func() {
+		It("writes the applications in manifest form followed by the services", func() {
+			err := WriteSpace(pathToSpace, Space{
+				Applications: []Application{
+					{
+						Name:                 "app-1",
+						Buildpack:            "ruby_buildpack",
+						EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+						Instances:            types.NullInt{IsSet: true, Value: 2},
+						DiskQuota:            1024,
+						Memory:               256,
+						Ports:                []int{8080, 9090},
+						Routes:               []string{"app-1.example.com", "admin.example.com"},
+						RouteAppPorts:        map[string]int{"admin.example.com": 9090},
+						Services:             []string{"some-db"},
+					},
+				},
+				Services: []Service{
+					{Name: "some-db", Service: "some-service", Plan: "some-plan"},
+					{Name: "some-ups", UserProvided: true},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			written, err := ioutil.ReadFile(pathToSpace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(written)).To(Equal(`applications:
+- name: app-1
+  buildpack: ruby_buildpack
+  env:
+    SOME_VAR: some-value
+  instances: 2
+  disk_quota: 1G
+  memory: 256M
+  ports:
+  - 8080
+  - 9090
+  routes:
+  - route: app-1.example.com
+  - route: admin.example.com
+    app_port: 9090
+  services:
+  - some-db
+services:
+- name: some-db
+  service: some-service
+  plan: some-plan
+- name: some-ups
+  user_provided: true
+`))
+		})
+
+		It("makes the file readable only by the user, since it contains the environment variables", func() {
+			if runtime.GOOS == "windows" {
+				Skip("file modes are not supported on Windows")
+			}
+
+			Expect(WriteSpace(pathToSpace, Space{})).To(Succeed())
+
+			info, err := os.Stat(pathToSpace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+
+	Describe("ReadSpace", func() {
+		Context("when the file was written by WriteSpace", func() {
+			var space Space
+
+			BeforeEach(func() {
+				space = Space{
+					Applications: []Application{
+						{
+							Name:      "app-1",
+							Instances: types.NullInt{IsSet: true, Value: 0},
+							Memory:    512,
+							Services:  []string{"some-ups"},
+						},
+						{
+							Name:          "app-2",
+							Ports:         []int{8080, 9090},
+							Routes:        []string{"app-2.example.com", "app-2.example.com/some-path"},
+							RouteAppPorts: map[string]int{"app-2.example.com/some-path": 9090},
+							HealthCheck:   HealthCheck{Type: "http", HTTPEndpoint: "/health"},
+							Processes: []Process{
+								{Type: "worker", ReadinessHealthCheck: HealthCheck{Type: "process", Interval: 10}},
+							},
+						},
+					},
+					Services: []Service{{Name: "some-ups", UserProvided: true}},
+				}
+				Expect(WriteSpace(pathToSpace, space)).To(Succeed())
+			})
+
+			It("returns the same space", func() {
+				readSpace, err := ReadSpace(pathToSpace)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(readSpace).To(Equal(space))
+			})
+		})
+
+		Context("when an application has an invalid memory", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pathToSpace, []byte("applications:\n- name: app-1\n  memory: lots\n"), 0600)).To(Succeed())
+			})
+
+			It("returns an InvalidByteSizeError", func() {
+				_, err := ReadSpace(pathToSpace)
+				Expect(err).To(MatchError(InvalidByteSizeError{AppName: "app-1", Field: "memory", Value: "lots"}))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns the error", func() {
+				_, err := ReadSpace(filepath.Join(dir, "missing.yml"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,49 @@
+package pushaction
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	log "github.com/Sirupsen/logrus"
+)
+
+// NoManifestsFoundError is returned when a directory does not contain any
+// manifest files.
+type NoManifestsFoundError struct {
+	Directory string
+}
+
+func (e NoManifestsFoundError) Error() string {
+	return fmt.Sprintf("no manifests found in %s", e.Directory)
+}
+
+// ReadManifestDirectory returns the applications from every manifest in dir.
+func (actor Actor) ReadManifestDirectory(dir string) ([]manifest.Application, error) {
+	manifestPaths, err := manifest.FindManifests(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(manifestPaths) == 0 {
+		return nil, NoManifestsFoundError{Directory: dir}
+	}
+
+	var apps []manifest.Application
+	for _, manifestPath := range manifestPaths {
+		log.Infoln("reading manifest:", manifestPath)
+		manifestApps, err := manifest.ReadManifest(manifestPath)
+		if err != nil {
+			log.Errorln("reading manifest:", err)
+			return nil, err
+		}
+		apps = append(apps, manifestApps...)
+	}
+
+	return apps, nil
+}
+
+// ReadManifest returns the applications from the manifest at pathToManifest.
+func (actor Actor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
+	log.Infoln("reading manifest:", pathToManifest)
+	return manifest.ReadManifest(pathToManifest)
+}
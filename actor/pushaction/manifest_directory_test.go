@@ -0,0 +1,58 @@
+package pushaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Directory", func() {
+	var (
+		actor *Actor
+		dir   string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(pushactionfakes.FakeV2Actor), nil)
+
+		var err error
+		dir, err = ioutil.TempDir("", "manifest-directory-test")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("ReadManifestDirectory", func() {
+		Context("when the directory contains manifests", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(dir, "b.yml"), []byte("applications:\n- name: app-2\n"), 0600)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "a.yml"), []byte("applications:\n- name: app-1\n  depends_on: [app-2]\n"), 0600)).To(Succeed())
+			})
+
+			It("returns the applications of every manifest", func() {
+				apps, err := actor.ReadManifestDirectory(dir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]manifest.Application{
+					{Name: "app-1", Path: dir, DependsOn: []string{"app-2"}},
+					{Name: "app-2", Path: dir},
+				}))
+			})
+		})
+
+		Context("when the directory does not contain manifests", func() {
+			It("returns a NoManifestsFoundError", func() {
+				_, err := actor.ReadManifestDirectory(dir)
+				Expect(err).To(MatchError(NoManifestsFoundError{Directory: dir}))
+			})
+		})
+	})
+})
@@ -1,23 +1,66 @@
 package pushaction
 
 import (
-	"errors"
-
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	log "github.com/Sirupsen/logrus"
 )
 
+// MergeAndValidateSettingsAndManifests returns the applications to push, with
+// the command line settings that are set overriding the manifest. Without
+// manifest applications, the application is described by the command line
+// settings alone; otherwise the settings other than the name and the path
+// apply to every manifest application. The applications must have a name and
+// valid health check types.
 func (actor Actor) MergeAndValidateSettingsAndManifests(cmdConfig CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
-	if len(apps) != 0 {
-		return nil, errors.New("functionality still pending")
+	manifests := apps
+	if len(manifests) == 0 {
+		manifests = []manifest.Application{{
+			Name: cmdConfig.Name,
+			Path: cmdConfig.Path,
+		}}
 	}
-	manifests := []manifest.Application{{
-		Name: cmdConfig.Name,
-		Path: cmdConfig.Path,
-	}}
 
-	//TODO Add validations
+	merged := make([]manifest.Application, 0, len(manifests))
+	for _, app := range manifests {
+		app = applyCommandLineSettings(app, cmdConfig)
+		err := validateApplication(app)
+		if err != nil {
+			log.Errorln("validating application:", err)
+			return nil, err
+		}
+		merged = append(merged, app)
+	}
 
-	log.Debugf("merged and validated manifests: %#v", manifests)
-	return manifests, nil
+	log.Debugf("merged and validated manifests: %#v", merged)
+	return merged, nil
+}
+
+func applyCommandLineSettings(app manifest.Application, cmdConfig CommandLineSettings) manifest.Application {
+	if cmdConfig.Buildpack != "" {
+		app.Buildpack = cmdConfig.Buildpack
+	}
+	if cmdConfig.Stack != "" {
+		app.Stack = cmdConfig.Stack
+	}
+	if cmdConfig.Instances.IsSet {
+		app.Instances = cmdConfig.Instances
+	}
+	if cmdConfig.DiskQuota != 0 {
+		app.DiskQuota = cmdConfig.DiskQuota
+	}
+	if cmdConfig.Memory != 0 {
+		app.Memory = cmdConfig.Memory
+	}
+	if cmdConfig.HealthCheckType != "" {
+		// The manifest endpoint is kept when the flag does not change the type,
+		// and 'http' implies the '/' endpoint otherwise.
+		if app.HealthCheck.Type != cmdConfig.HealthCheckType {
+			app.HealthCheck.HTTPEndpoint = ""
+			if cmdConfig.HealthCheckType == "http" {
+				app.HealthCheck.HTTPEndpoint = "/"
+			}
+		}
+		app.HealthCheck.Type = cmdConfig.HealthCheckType
+	}
+	return app
 }
@@ -5,6 +5,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -14,7 +15,7 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 	var actor *Actor
 
 	BeforeEach(func() {
-		actor = NewActor(nil)
+		actor = NewActor(nil, nil)
 	})
 
 	Context("when only passed command line settings", func() {
@@ -42,9 +43,73 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 				Path: pwd,
 			}}))
 		})
+
+		Context("when the command line settings include app settings", func() {
+			BeforeEach(func() {
+				cmdSettings.Buildpack = "some-buildpack"
+				cmdSettings.Instances = types.NullInt{IsSet: true, Value: 0}
+				cmdSettings.DiskQuota = 1024
+				cmdSettings.Memory = 256
+				cmdSettings.HealthCheckType = "http"
+				cmdSettings.Stack = "some-stack"
+			})
+
+			It("sets them on the manifest", func() {
+				manifests, err := actor.MergeAndValidateSettingsAndManifests(cmdSettings, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(manifests).To(Equal([]manifest.Application{{
+					Name:        "some-app",
+					Path:        pwd,
+					Buildpack:   "some-buildpack",
+					Stack:       "some-stack",
+					Instances:   types.NullInt{IsSet: true, Value: 0},
+					DiskQuota:   1024,
+					Memory:      256,
+					HealthCheck: manifest.HealthCheck{Type: "http", HTTPEndpoint: "/"},
+				}}))
+			})
+		})
 	})
 
 	Context("when passed command line settings and manifests", func() {
-		// fill in here
+		var apps []manifest.Application
+
+		BeforeEach(func() {
+			apps = []manifest.Application{
+				{Name: "app-1", Memory: 128},
+				{Name: "app-2", Instances: types.NullInt{IsSet: true, Value: 2}},
+			}
+		})
+
+		It("applies the command line settings to every manifest application", func() {
+			manifests, err := actor.MergeAndValidateSettingsAndManifests(CommandLineSettings{Memory: 256}, apps)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests).To(Equal([]manifest.Application{
+				{Name: "app-1", Memory: 256},
+				{Name: "app-2", Memory: 256, Instances: types.NullInt{IsSet: true, Value: 2}},
+			}))
+		})
+
+		Context("when a manifest application has no name", func() {
+			BeforeEach(func() {
+				apps = append(apps, manifest.Application{})
+			})
+
+			It("returns a MissingApplicationNameError", func() {
+				_, err := actor.MergeAndValidateSettingsAndManifests(CommandLineSettings{}, apps)
+				Expect(err).To(MatchError(MissingApplicationNameError{}))
+			})
+		})
+
+		Context("when a manifest application has an invalid health check type", func() {
+			BeforeEach(func() {
+				apps[1].HealthCheck.Type = "bogus"
+			})
+
+			It("returns an InvalidHealthCheckTypeError", func() {
+				_, err := actor.MergeAndValidateSettingsAndManifests(CommandLineSettings{}, apps)
+				Expect(err).To(MatchError(InvalidHealthCheckTypeError{AppName: "app-2", ProcessType: "web", HealthCheckType: "bogus"}))
+			})
+		})
 	})
 })
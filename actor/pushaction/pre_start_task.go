@@ -0,0 +1,56 @@
+package pushaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/v3action"
+	log "github.com/Sirupsen/logrus"
+)
+
+// PreStartTaskNotSupportedError is returned when a pre-start task is
+// requested and the V3 API, which stages droplets and runs tasks, is not
+// available.
+type PreStartTaskNotSupportedError struct{}
+
+func (PreStartTaskNotSupportedError) Error() string {
+	return "pre-start tasks require the V3 API"
+}
+
+// RunPreStartTask stages the newest package of the application and runs the
+// command as a task from the resulting droplet. The droplet only becomes the
+// application's current droplet when the task succeeds, so a failed task
+// leaves the droplet the application runs untouched.
+func (actor Actor) RunPreStartTask(config ApplicationConfig, command string) (Warnings, error) {
+	if actor.V3Actor == nil {
+		return nil, PreStartTaskNotSupportedError{}
+	}
+
+	// Apply does not return the created application, so it is looked up
+	// again for applications that did not exist before the push.
+	app, v2Warnings, err := actor.V2Actor.GetApplicationByNameAndSpace(config.DesiredApplication.Name, config.TargetedSpaceGUID)
+	warnings := Warnings(v2Warnings)
+	if err != nil {
+		return warnings, err
+	}
+
+	log.Infoln("staging latest package:", app.GUID)
+	droplet, v3Warnings, err := actor.V3Actor.StageLatestApplicationPackage(app.GUID)
+	warnings = append(warnings, v3Warnings...)
+	if err != nil {
+		log.Errorln("staging latest package:", err)
+		return warnings, err
+	}
+
+	log.Infoln("running pre-start task from droplet:", droplet.GUID)
+	_, v3Warnings, err = actor.V3Actor.RunTaskAndWait(app.GUID, v3action.Task{
+		Command:     command,
+		DropletGUID: droplet.GUID,
+	})
+	warnings = append(warnings, v3Warnings...)
+	if err != nil {
+		log.Errorln("running pre-start task:", err)
+		return warnings, err
+	}
+
+	v3Warnings, err = actor.V3Actor.SetApplicationDroplet(app.GUID, droplet.GUID)
+	warnings = append(warnings, v3Warnings...)
+	return warnings, err
+}
@@ -0,0 +1,131 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pre-start Task", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+		fakeV3Actor *pushactionfakes.FakeV3Actor
+		config      ApplicationConfig
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		fakeV3Actor = new(pushactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+
+		config = ApplicationConfig{
+			DesiredApplication: v2action.Application{Name: "some-app"},
+			TargetedSpaceGUID:  "some-space-guid",
+		}
+
+		fakeV2Actor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeV3Actor.StageLatestApplicationPackageReturns(
+			v3action.Droplet{GUID: "some-droplet-guid"},
+			v3action.Warnings{"stage-warning"},
+			nil,
+		)
+		fakeV3Actor.RunTaskAndWaitReturns(
+			v3action.Task{GUID: "some-task-guid"},
+			v3action.Warnings{"task-warning"},
+			nil,
+		)
+		fakeV3Actor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		warnings, executeErr = actor.RunPreStartTask(config, "rake db:migrate")
+	})
+
+	It("runs the task from the newly staged droplet and then makes it current", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf("get-app-warning", "stage-warning", "task-warning", "set-droplet-warning"))
+
+		appName, spaceGUID := fakeV2Actor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeV3Actor.StageLatestApplicationPackageArgsForCall(0)).To(Equal("some-app-guid"))
+
+		appGUID, task := fakeV3Actor.RunTaskAndWaitArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(task).To(Equal(v3action.Task{Command: "rake db:migrate", DropletGUID: "some-droplet-guid"}))
+
+		appGUID, dropletGUID := fakeV3Actor.SetApplicationDropletArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(dropletGUID).To(Equal("some-droplet-guid"))
+	})
+
+	Context("when staging fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = v3action.StagingFailedError{BuildGUID: "some-build-guid", Reason: "some reason"}
+			fakeV3Actor.StageLatestApplicationPackageReturns(v3action.Droplet{}, v3action.Warnings{"stage-warning"}, expectedErr)
+		})
+
+		It("returns the error without running the task", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("get-app-warning", "stage-warning"))
+			Expect(fakeV3Actor.RunTaskAndWaitCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the task fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = v3action.TaskFailedError{SequenceID: 1, Reason: "Exited with status 1"}
+			fakeV3Actor.RunTaskAndWaitReturns(v3action.Task{}, v3action.Warnings{"task-warning"}, expectedErr)
+		})
+
+		It("returns the error without changing the current droplet", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("get-app-warning", "stage-warning", "task-warning"))
+			Expect(fakeV3Actor.SetApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when looking up the application fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get app failed")
+			fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-app-warning"}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("get-app-warning"))
+			Expect(fakeV3Actor.StageLatestApplicationPackageCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the V3 API is not available", func() {
+		BeforeEach(func() {
+			actor = NewActor(fakeV2Actor, nil)
+		})
+
+		It("returns a PreStartTaskNotSupportedError", func() {
+			Expect(executeErr).To(MatchError(PreStartTaskNotSupportedError{}))
+		})
+	})
+})
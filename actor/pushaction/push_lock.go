@@ -0,0 +1,41 @@
+package pushaction
+
+import (
+	log "github.com/Sirupsen/logrus"
+)
+
+// PushLockNotSupportedError is returned when a push lock is requested and the
+// V3 API, which stores it, is not available.
+type PushLockNotSupportedError struct{}
+
+func (PushLockNotSupportedError) Error() string {
+	return "push locks require the V3 API"
+}
+
+// AcquirePushLock acquires the push lock of the application being updated, so
+// that other pushes acquiring it fail until it is released. Applications that
+// do not exist yet are not locked.
+func (actor Actor) AcquirePushLock(config ApplicationConfig, owner string, force bool) (Warnings, error) {
+	if actor.V3Actor == nil {
+		return nil, PushLockNotSupportedError{}
+	}
+	if config.CurrentApplication.GUID == "" {
+		log.Debugln("not locking new application:", config.DesiredApplication.Name)
+		return nil, nil
+	}
+
+	log.Infoln("acquiring push lock:", config.CurrentApplication.GUID)
+	warnings, err := actor.V3Actor.AcquireApplicationPushLock(config.CurrentApplication.GUID, owner, force)
+	return Warnings(warnings), err
+}
+
+// ReleasePushLock releases the push lock acquired by AcquirePushLock.
+func (actor Actor) ReleasePushLock(config ApplicationConfig, owner string) (Warnings, error) {
+	if actor.V3Actor == nil || config.CurrentApplication.GUID == "" {
+		return nil, nil
+	}
+
+	log.Infoln("releasing push lock:", config.CurrentApplication.GUID)
+	warnings, err := actor.V3Actor.ReleaseApplicationPushLock(config.CurrentApplication.GUID, owner)
+	return Warnings(warnings), err
+}
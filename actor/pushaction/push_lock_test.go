@@ -0,0 +1,102 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Push Lock", func() {
+	var (
+		actor       *Actor
+		fakeV3Actor *pushactionfakes.FakeV3Actor
+		config      ApplicationConfig
+	)
+
+	BeforeEach(func() {
+		fakeV3Actor = new(pushactionfakes.FakeV3Actor)
+		actor = NewActor(new(pushactionfakes.FakeV2Actor), fakeV3Actor)
+
+		config = ApplicationConfig{
+			CurrentApplication: v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			DesiredApplication: v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+		}
+	})
+
+	Describe("AcquirePushLock", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeV3Actor.AcquireApplicationPushLockReturns(v3action.Warnings{"lock-warning"}, nil)
+			})
+
+			It("acquires the lock of the application", func() {
+				warnings, err := actor.AcquirePushLock(config, "some-owner", true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("lock-warning"))
+
+				Expect(fakeV3Actor.AcquireApplicationPushLockCallCount()).To(Equal(1))
+				appGUID, owner, force := fakeV3Actor.AcquireApplicationPushLockArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(owner).To(Equal("some-owner"))
+				Expect(force).To(BeTrue())
+			})
+		})
+
+		Context("when the lock is held by another push", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = v3action.ApplicationPushLockedError{AppName: "some-app", Owner: "other-owner"}
+				fakeV3Actor.AcquireApplicationPushLockReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.AcquirePushLock(config, "some-owner", false)
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when the application is new", func() {
+			BeforeEach(func() {
+				config.CurrentApplication = v2action.Application{}
+			})
+
+			It("does not lock anything", func() {
+				_, err := actor.AcquirePushLock(config, "some-owner", false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeV3Actor.AcquireApplicationPushLockCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the V3 API is not available", func() {
+			BeforeEach(func() {
+				actor = NewActor(new(pushactionfakes.FakeV2Actor), nil)
+			})
+
+			It("returns a PushLockNotSupportedError", func() {
+				_, err := actor.AcquirePushLock(config, "some-owner", false)
+				Expect(err).To(MatchError(PushLockNotSupportedError{}))
+			})
+		})
+	})
+
+	Describe("ReleasePushLock", func() {
+		It("releases the lock of the application", func() {
+			fakeV3Actor.ReleaseApplicationPushLockReturns(v3action.Warnings{"unlock-warning"}, errors.New("unlock-error"))
+
+			warnings, err := actor.ReleasePushLock(config, "some-owner")
+			Expect(err).To(MatchError("unlock-error"))
+			Expect(warnings).To(ConsistOf("unlock-warning"))
+
+			appGUID, owner := fakeV3Actor.ReleaseApplicationPushLockArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(owner).To(Equal("some-owner"))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
 
 type FakeV2Actor struct {
@@ -23,6 +24,35 @@ type FakeV2Actor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	BindRouteToApplicationPortStub        func(routeGUID string, appGUID string, appPort int) (v2action.Warnings, error)
+	bindRouteToApplicationPortMutex       sync.RWMutex
+	bindRouteToApplicationPortArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+		appPort   int
+	}
+	bindRouteToApplicationPortReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	bindRouteToApplicationPortReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	BindServiceToApplicationStub        func(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
+	bindServiceToApplicationMutex       sync.RWMutex
+	bindServiceToApplicationArgsForCall []struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}
+	bindServiceToApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	bindServiceToApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	CheckRouteStub        func(route v2action.Route) (bool, v2action.Warnings, error)
 	checkRouteMutex       sync.RWMutex
 	checkRouteArgsForCall []struct {
@@ -38,6 +68,15 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CreateApplicationStub        func(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	createApplicationMutex       sync.RWMutex
 	createApplicationArgsForCall []struct {
@@ -69,6 +108,66 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	CreateServiceInstanceStub        func(spaceGUID string, serviceName string, servicePlanName string, serviceInstanceName string) (v2action.ServiceInstance, v2action.Warnings, error)
+	createServiceInstanceMutex       sync.RWMutex
+	createServiceInstanceArgsForCall []struct {
+		spaceGUID           string
+		serviceName         string
+		servicePlanName     string
+		serviceInstanceName string
+	}
+	createServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	createServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	CreateUserProvidedServiceInstanceStub        func(spaceGUID string, serviceInstanceName string) (v2action.ServiceInstance, v2action.Warnings, error)
+	createUserProvidedServiceInstanceMutex       sync.RWMutex
+	createUserProvidedServiceInstanceArgsForCall []struct {
+		spaceGUID           string
+		serviceInstanceName string
+	}
+	createUserProvidedServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	createUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	DeleteRouteStub        func(routeGUID string) (v2action.Warnings, error)
+	deleteRouteMutex       sync.RWMutex
+	deleteRouteArgsForCall []struct {
+		routeGUID string
+	}
+	deleteRouteReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteRouteReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	DeleteServiceBindingStub        func(serviceBindingGUID string) (v2action.Warnings, error)
+	deleteServiceBindingMutex       sync.RWMutex
+	deleteServiceBindingArgsForCall []struct {
+		serviceBindingGUID string
+	}
+	deleteServiceBindingReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteServiceBindingReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -100,6 +199,34 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetBuildpacksStub        func() ([]v2action.Buildpack, v2action.Warnings, error)
+	getBuildpacksMutex       sync.RWMutex
+	getBuildpacksArgsForCall []struct{}
+	getBuildpacksReturns     struct {
+		result1 []v2action.Buildpack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getBuildpacksReturnsOnCall map[int]struct {
+		result1 []v2action.Buildpack
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetOrganizationDomainsStub        func(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
 	getOrganizationDomainsMutex       sync.RWMutex
 	getOrganizationDomainsArgsForCall []struct {
@@ -115,6 +242,22 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetRouteApplicationsStub        func(routeGUID string, query []ccv2.Query) ([]v2action.Application, v2action.Warnings, error)
+	getRouteApplicationsMutex       sync.RWMutex
+	getRouteApplicationsArgsForCall []struct {
+		routeGUID string
+		query     []ccv2.Query
+	}
+	getRouteApplicationsReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRouteApplicationsReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetRouteByHostAndDomainStub        func(host string, domainGUID string) (v2action.Route, v2action.Warnings, error)
 	getRouteByHostAndDomainMutex       sync.RWMutex
 	getRouteByHostAndDomainArgsForCall []struct {
@@ -131,6 +274,128 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetServiceBindingsByApplicationStub        func(appGUID string) ([]v2action.ServiceBinding, v2action.Warnings, error)
+	getServiceBindingsByApplicationMutex       sync.RWMutex
+	getServiceBindingsByApplicationArgsForCall []struct {
+		appGUID string
+	}
+	getServiceBindingsByApplicationReturns struct {
+		result1 []v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceBindingsByApplicationReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceInstanceByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	getServiceInstanceByNameAndSpaceMutex       sync.RWMutex
+	getServiceInstanceByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getServiceInstanceByNameAndSpaceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceInstanceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceInstancesBySpaceStub        func(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	getServiceInstancesBySpaceMutex       sync.RWMutex
+	getServiceInstancesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getServiceInstancesBySpaceReturns struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceInstancesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceSummariesStub        func(orgGUID string, spaceGUID string) ([]v2action.ServiceSummary, v2action.Warnings, error)
+	getServiceSummariesMutex       sync.RWMutex
+	getServiceSummariesArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+	}
+	getServiceSummariesReturns struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceSummariesReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceQuotaUsageStub        func(spaceGUID string, ignoredAppGUID string) (v2action.SpaceQuotaUsage, v2action.Warnings, error)
+	getSpaceQuotaUsageMutex       sync.RWMutex
+	getSpaceQuotaUsageArgsForCall []struct {
+		spaceGUID      string
+		ignoredAppGUID string
+	}
+	getSpaceQuotaUsageReturns struct {
+		result1 v2action.SpaceQuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceQuotaUsageReturnsOnCall map[int]struct {
+		result1 v2action.SpaceQuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStackStub        func(guid string) (v2action.Stack, v2action.Warnings, error)
+	getStackMutex       sync.RWMutex
+	getStackArgsForCall []struct {
+		guid string
+	}
+	getStackReturns struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStackReturnsOnCall map[int]struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStackByNameStub        func(stackName string) (v2action.Stack, v2action.Warnings, error)
+	getStackByNameMutex       sync.RWMutex
+	getStackByNameArgsForCall []struct {
+		stackName string
+	}
+	getStackByNameReturns struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStackByNameReturnsOnCall map[int]struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	UnbindRouteFromApplicationStub        func(routeGUID string, appGUID string) (v2action.Warnings, error)
+	unbindRouteFromApplicationMutex       sync.RWMutex
+	unbindRouteFromApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	unbindRouteFromApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	unbindRouteFromApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -146,6 +411,23 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	UpdateRouteApplicationPortStub        func(routeGUID string, appGUID string, appPort int) (bool, v2action.Warnings, error)
+	updateRouteApplicationPortMutex       sync.RWMutex
+	updateRouteApplicationPortArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+		appPort   int
+	}
+	updateRouteApplicationPortReturns struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}
+	updateRouteApplicationPortReturnsOnCall map[int]struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -202,6 +484,111 @@ func (fake *FakeV2Actor) BindRouteToApplicationReturnsOnCall(i int, result1 v2ac
 	}{result1, result2}
 }
 
+func (fake *FakeV2Actor) BindRouteToApplicationPort(routeGUID string, appGUID string, appPort int) (v2action.Warnings, error) {
+	fake.bindRouteToApplicationPortMutex.Lock()
+	ret, specificReturn := fake.bindRouteToApplicationPortReturnsOnCall[len(fake.bindRouteToApplicationPortArgsForCall)]
+	fake.bindRouteToApplicationPortArgsForCall = append(fake.bindRouteToApplicationPortArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+		appPort   int
+	}{routeGUID, appGUID, appPort})
+	fake.recordInvocation("BindRouteToApplicationPort", []interface{}{routeGUID, appGUID, appPort})
+	fake.bindRouteToApplicationPortMutex.Unlock()
+	if fake.BindRouteToApplicationPortStub != nil {
+		return fake.BindRouteToApplicationPortStub(routeGUID, appGUID, appPort)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.bindRouteToApplicationPortReturns.result1, fake.bindRouteToApplicationPortReturns.result2
+}
+
+func (fake *FakeV2Actor) BindRouteToApplicationPortCallCount() int {
+	fake.bindRouteToApplicationPortMutex.RLock()
+	defer fake.bindRouteToApplicationPortMutex.RUnlock()
+	return len(fake.bindRouteToApplicationPortArgsForCall)
+}
+
+func (fake *FakeV2Actor) BindRouteToApplicationPortArgsForCall(i int) (string, string, int) {
+	fake.bindRouteToApplicationPortMutex.RLock()
+	defer fake.bindRouteToApplicationPortMutex.RUnlock()
+	return fake.bindRouteToApplicationPortArgsForCall[i].routeGUID, fake.bindRouteToApplicationPortArgsForCall[i].appGUID, fake.bindRouteToApplicationPortArgsForCall[i].appPort
+}
+
+func (fake *FakeV2Actor) BindRouteToApplicationPortReturns(result1 v2action.Warnings, result2 error) {
+	fake.BindRouteToApplicationPortStub = nil
+	fake.bindRouteToApplicationPortReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindRouteToApplicationPortReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.BindRouteToApplicationPortStub = nil
+	if fake.bindRouteToApplicationPortReturnsOnCall == nil {
+		fake.bindRouteToApplicationPortReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.bindRouteToApplicationPortReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindServiceToApplication(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error) {
+	fake.bindServiceToApplicationMutex.Lock()
+	ret, specificReturn := fake.bindServiceToApplicationReturnsOnCall[len(fake.bindServiceToApplicationArgsForCall)]
+	fake.bindServiceToApplicationArgsForCall = append(fake.bindServiceToApplicationArgsForCall, struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}{appGUID, serviceInstanceGUID})
+	fake.recordInvocation("BindServiceToApplication", []interface{}{appGUID, serviceInstanceGUID})
+	fake.bindServiceToApplicationMutex.Unlock()
+	if fake.BindServiceToApplicationStub != nil {
+		return fake.BindServiceToApplicationStub(appGUID, serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.bindServiceToApplicationReturns.result1, fake.bindServiceToApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) BindServiceToApplicationCallCount() int {
+	fake.bindServiceToApplicationMutex.RLock()
+	defer fake.bindServiceToApplicationMutex.RUnlock()
+	return len(fake.bindServiceToApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) BindServiceToApplicationArgsForCall(i int) (string, string) {
+	fake.bindServiceToApplicationMutex.RLock()
+	defer fake.bindServiceToApplicationMutex.RUnlock()
+	return fake.bindServiceToApplicationArgsForCall[i].appGUID, fake.bindServiceToApplicationArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeV2Actor) BindServiceToApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.BindServiceToApplicationStub = nil
+	fake.bindServiceToApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindServiceToApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.BindServiceToApplicationStub = nil
+	if fake.bindServiceToApplicationReturnsOnCall == nil {
+		fake.bindServiceToApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.bindServiceToApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2Actor) CheckRoute(route v2action.Route) (bool, v2action.Warnings, error) {
 	fake.checkRouteMutex.Lock()
 	ret, specificReturn := fake.checkRouteReturnsOnCall[len(fake.checkRouteArgsForCall)]
@@ -256,6 +643,46 @@ func (fake *FakeV2Actor) CheckRouteReturnsOnCall(i int, result1 bool, result2 v2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeV2Actor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeV2Actor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeV2Actor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeV2Actor) CreateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
 	fake.createApplicationMutex.Lock()
 	ret, specificReturn := fake.createApplicationReturnsOnCall[len(fake.createApplicationArgsForCall)]
@@ -365,91 +792,305 @@ func (fake *FakeV2Actor) CreateRouteReturnsOnCall(i int, result1 v2action.Route,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
-	fake.getApplicationByNameAndSpaceMutex.Lock()
-	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
-	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
-		name      string
-		spaceGUID string
-	}{name, spaceGUID})
-	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
-	fake.getApplicationByNameAndSpaceMutex.Unlock()
-	if fake.GetApplicationByNameAndSpaceStub != nil {
-		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+func (fake *FakeV2Actor) CreateServiceInstance(spaceGUID string, serviceName string, servicePlanName string, serviceInstanceName string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.createServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createServiceInstanceReturnsOnCall[len(fake.createServiceInstanceArgsForCall)]
+	fake.createServiceInstanceArgsForCall = append(fake.createServiceInstanceArgsForCall, struct {
+		spaceGUID           string
+		serviceName         string
+		servicePlanName     string
+		serviceInstanceName string
+	}{spaceGUID, serviceName, servicePlanName, serviceInstanceName})
+	fake.recordInvocation("CreateServiceInstance", []interface{}{spaceGUID, serviceName, servicePlanName, serviceInstanceName})
+	fake.createServiceInstanceMutex.Unlock()
+	if fake.CreateServiceInstanceStub != nil {
+		return fake.CreateServiceInstanceStub(spaceGUID, serviceName, servicePlanName, serviceInstanceName)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	return fake.createServiceInstanceReturns.result1, fake.createServiceInstanceReturns.result2, fake.createServiceInstanceReturns.result3
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpaceCallCount() int {
-	fake.getApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
-	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+func (fake *FakeV2Actor) CreateServiceInstanceCallCount() int {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return len(fake.createServiceInstanceArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
-	fake.getApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
-	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+func (fake *FakeV2Actor) CreateServiceInstanceArgsForCall(i int) (string, string, string, string) {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return fake.createServiceInstanceArgsForCall[i].spaceGUID, fake.createServiceInstanceArgsForCall[i].serviceName, fake.createServiceInstanceArgsForCall[i].servicePlanName, fake.createServiceInstanceArgsForCall[i].serviceInstanceName
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
-	fake.GetApplicationByNameAndSpaceStub = nil
-	fake.getApplicationByNameAndSpaceReturns = struct {
-		result1 v2action.Application
+func (fake *FakeV2Actor) CreateServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	fake.createServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
-	fake.GetApplicationByNameAndSpaceStub = nil
-	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
-		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 v2action.Application
+func (fake *FakeV2Actor) CreateServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	if fake.createServiceInstanceReturnsOnCall == nil {
+		fake.createServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
 			result2 v2action.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
-		result1 v2action.Application
+	fake.createServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutes(applicationGUID string) ([]v2action.Route, v2action.Warnings, error) {
-	fake.getApplicationRoutesMutex.Lock()
-	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
-	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
-		applicationGUID string
-	}{applicationGUID})
-	fake.recordInvocation("GetApplicationRoutes", []interface{}{applicationGUID})
-	fake.getApplicationRoutesMutex.Unlock()
-	if fake.GetApplicationRoutesStub != nil {
-		return fake.GetApplicationRoutesStub(applicationGUID)
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstance(spaceGUID string, serviceInstanceName string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.createUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createUserProvidedServiceInstanceReturnsOnCall[len(fake.createUserProvidedServiceInstanceArgsForCall)]
+	fake.createUserProvidedServiceInstanceArgsForCall = append(fake.createUserProvidedServiceInstanceArgsForCall, struct {
+		spaceGUID           string
+		serviceInstanceName string
+	}{spaceGUID, serviceInstanceName})
+	fake.recordInvocation("CreateUserProvidedServiceInstance", []interface{}{spaceGUID, serviceInstanceName})
+	fake.createUserProvidedServiceInstanceMutex.Unlock()
+	if fake.CreateUserProvidedServiceInstanceStub != nil {
+		return fake.CreateUserProvidedServiceInstanceStub(spaceGUID, serviceInstanceName)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+	return fake.createUserProvidedServiceInstanceReturns.result1, fake.createUserProvidedServiceInstanceReturns.result2, fake.createUserProvidedServiceInstanceReturns.result3
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesCallCount() int {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return len(fake.getApplicationRoutesArgsForCall)
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstanceCallCount() int {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.createUserProvidedServiceInstanceArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesArgsForCall(i int) string {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return fake.getApplicationRoutesArgsForCall[i].applicationGUID
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstanceArgsForCall(i int) (string, string) {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.createUserProvidedServiceInstanceArgsForCall[i].spaceGUID, fake.createUserProvidedServiceInstanceArgsForCall[i].serviceInstanceName
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesReturns(result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	fake.createUserProvidedServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	if fake.createUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.createUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) DeleteRoute(routeGUID string) (v2action.Warnings, error) {
+	fake.deleteRouteMutex.Lock()
+	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
+	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
+		routeGUID string
+	}{routeGUID})
+	fake.recordInvocation("DeleteRoute", []interface{}{routeGUID})
+	fake.deleteRouteMutex.Unlock()
+	if fake.DeleteRouteStub != nil {
+		return fake.DeleteRouteStub(routeGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteRouteReturns.result1, fake.deleteRouteReturns.result2
+}
+
+func (fake *FakeV2Actor) DeleteRouteCallCount() int {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return len(fake.deleteRouteArgsForCall)
+}
+
+func (fake *FakeV2Actor) DeleteRouteArgsForCall(i int) string {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return fake.deleteRouteArgsForCall[i].routeGUID
+}
+
+func (fake *FakeV2Actor) DeleteRouteReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	fake.deleteRouteReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteRouteReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	if fake.deleteRouteReturnsOnCall == nil {
+		fake.deleteRouteReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteRouteReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteServiceBinding(serviceBindingGUID string) (v2action.Warnings, error) {
+	fake.deleteServiceBindingMutex.Lock()
+	ret, specificReturn := fake.deleteServiceBindingReturnsOnCall[len(fake.deleteServiceBindingArgsForCall)]
+	fake.deleteServiceBindingArgsForCall = append(fake.deleteServiceBindingArgsForCall, struct {
+		serviceBindingGUID string
+	}{serviceBindingGUID})
+	fake.recordInvocation("DeleteServiceBinding", []interface{}{serviceBindingGUID})
+	fake.deleteServiceBindingMutex.Unlock()
+	if fake.DeleteServiceBindingStub != nil {
+		return fake.DeleteServiceBindingStub(serviceBindingGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceBindingReturns.result1, fake.deleteServiceBindingReturns.result2
+}
+
+func (fake *FakeV2Actor) DeleteServiceBindingCallCount() int {
+	fake.deleteServiceBindingMutex.RLock()
+	defer fake.deleteServiceBindingMutex.RUnlock()
+	return len(fake.deleteServiceBindingArgsForCall)
+}
+
+func (fake *FakeV2Actor) DeleteServiceBindingArgsForCall(i int) string {
+	fake.deleteServiceBindingMutex.RLock()
+	defer fake.deleteServiceBindingMutex.RUnlock()
+	return fake.deleteServiceBindingArgsForCall[i].serviceBindingGUID
+}
+
+func (fake *FakeV2Actor) DeleteServiceBindingReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceBindingStub = nil
+	fake.deleteServiceBindingReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteServiceBindingReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceBindingStub = nil
+	if fake.deleteServiceBindingReturnsOnCall == nil {
+		fake.deleteServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceBindingReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutes(applicationGUID string) ([]v2action.Route, v2action.Warnings, error) {
+	fake.getApplicationRoutesMutex.Lock()
+	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
+	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
+		applicationGUID string
+	}{applicationGUID})
+	fake.recordInvocation("GetApplicationRoutes", []interface{}{applicationGUID})
+	fake.getApplicationRoutesMutex.Unlock()
+	if fake.GetApplicationRoutesStub != nil {
+		return fake.GetApplicationRoutesStub(applicationGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesCallCount() int {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return len(fake.getApplicationRoutesArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesArgsForCall(i int) string {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return fake.getApplicationRoutesArgsForCall[i].applicationGUID
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesReturns(result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
 	fake.GetApplicationRoutesStub = nil
 	fake.getApplicationRoutesReturns = struct {
 		result1 []v2action.Route
@@ -474,6 +1115,106 @@ func (fake *FakeV2Actor) GetApplicationRoutesReturnsOnCall(i int, result1 []v2ac
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsBySpaceReturns.result1, fake.getApplicationsBySpaceReturns.result2, fake.getApplicationsBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetBuildpacks() ([]v2action.Buildpack, v2action.Warnings, error) {
+	fake.getBuildpacksMutex.Lock()
+	ret, specificReturn := fake.getBuildpacksReturnsOnCall[len(fake.getBuildpacksArgsForCall)]
+	fake.getBuildpacksArgsForCall = append(fake.getBuildpacksArgsForCall, struct{}{})
+	fake.recordInvocation("GetBuildpacks", []interface{}{})
+	fake.getBuildpacksMutex.Unlock()
+	if fake.GetBuildpacksStub != nil {
+		return fake.GetBuildpacksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildpacksReturns.result1, fake.getBuildpacksReturns.result2, fake.getBuildpacksReturns.result3
+}
+
+func (fake *FakeV2Actor) GetBuildpacksCallCount() int {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return len(fake.getBuildpacksArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetBuildpacksReturns(result1 []v2action.Buildpack, result2 v2action.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	fake.getBuildpacksReturns = struct {
+		result1 []v2action.Buildpack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetBuildpacksReturnsOnCall(i int, result1 []v2action.Buildpack, result2 v2action.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	if fake.getBuildpacksReturnsOnCall == nil {
+		fake.getBuildpacksReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Buildpack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpacksReturnsOnCall[i] = struct {
+		result1 []v2action.Buildpack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error) {
 	fake.getOrganizationDomainsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationDomainsReturnsOnCall[len(fake.getOrganizationDomainsArgsForCall)]
@@ -528,6 +1269,66 @@ func (fake *FakeV2Actor) GetOrganizationDomainsReturnsOnCall(i int, result1 []v2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetRouteApplications(routeGUID string, query []ccv2.Query) ([]v2action.Application, v2action.Warnings, error) {
+	var queryCopy []ccv2.Query
+	if query != nil {
+		queryCopy = make([]ccv2.Query, len(query))
+		copy(queryCopy, query)
+	}
+	fake.getRouteApplicationsMutex.Lock()
+	ret, specificReturn := fake.getRouteApplicationsReturnsOnCall[len(fake.getRouteApplicationsArgsForCall)]
+	fake.getRouteApplicationsArgsForCall = append(fake.getRouteApplicationsArgsForCall, struct {
+		routeGUID string
+		query     []ccv2.Query
+	}{routeGUID, queryCopy})
+	fake.recordInvocation("GetRouteApplications", []interface{}{routeGUID, queryCopy})
+	fake.getRouteApplicationsMutex.Unlock()
+	if fake.GetRouteApplicationsStub != nil {
+		return fake.GetRouteApplicationsStub(routeGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteApplicationsReturns.result1, fake.getRouteApplicationsReturns.result2, fake.getRouteApplicationsReturns.result3
+}
+
+func (fake *FakeV2Actor) GetRouteApplicationsCallCount() int {
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
+	return len(fake.getRouteApplicationsArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetRouteApplicationsArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
+	return fake.getRouteApplicationsArgsForCall[i].routeGUID, fake.getRouteApplicationsArgsForCall[i].query
+}
+
+func (fake *FakeV2Actor) GetRouteApplicationsReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteApplicationsStub = nil
+	fake.getRouteApplicationsReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetRouteApplicationsReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteApplicationsStub = nil
+	if fake.getRouteApplicationsReturnsOnCall == nil {
+		fake.getRouteApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteApplicationsReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetRouteByHostAndDomain(host string, domainGUID string) (v2action.Route, v2action.Warnings, error) {
 	fake.getRouteByHostAndDomainMutex.Lock()
 	ret, specificReturn := fake.getRouteByHostAndDomainReturnsOnCall[len(fake.getRouteByHostAndDomainArgsForCall)]
@@ -583,6 +1384,439 @@ func (fake *FakeV2Actor) GetRouteByHostAndDomainReturnsOnCall(i int, result1 v2a
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetServiceBindingsByApplication(appGUID string) ([]v2action.ServiceBinding, v2action.Warnings, error) {
+	fake.getServiceBindingsByApplicationMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingsByApplicationReturnsOnCall[len(fake.getServiceBindingsByApplicationArgsForCall)]
+	fake.getServiceBindingsByApplicationArgsForCall = append(fake.getServiceBindingsByApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetServiceBindingsByApplication", []interface{}{appGUID})
+	fake.getServiceBindingsByApplicationMutex.Unlock()
+	if fake.GetServiceBindingsByApplicationStub != nil {
+		return fake.GetServiceBindingsByApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingsByApplicationReturns.result1, fake.getServiceBindingsByApplicationReturns.result2, fake.getServiceBindingsByApplicationReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceBindingsByApplicationCallCount() int {
+	fake.getServiceBindingsByApplicationMutex.RLock()
+	defer fake.getServiceBindingsByApplicationMutex.RUnlock()
+	return len(fake.getServiceBindingsByApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceBindingsByApplicationArgsForCall(i int) string {
+	fake.getServiceBindingsByApplicationMutex.RLock()
+	defer fake.getServiceBindingsByApplicationMutex.RUnlock()
+	return fake.getServiceBindingsByApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) GetServiceBindingsByApplicationReturns(result1 []v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingsByApplicationStub = nil
+	fake.getServiceBindingsByApplicationReturns = struct {
+		result1 []v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceBindingsByApplicationReturnsOnCall(i int, result1 []v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingsByApplicationStub = nil
+	if fake.getServiceBindingsByApplicationReturnsOnCall == nil {
+		fake.getServiceBindingsByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceBinding
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingsByApplicationReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceByNameAndSpaceArgsForCall)]
+	fake.getServiceInstanceByNameAndSpaceArgsForCall = append(fake.getServiceInstanceByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetServiceInstanceByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getServiceInstanceByNameAndSpaceMutex.Unlock()
+	if fake.GetServiceInstanceByNameAndSpaceStub != nil {
+		return fake.GetServiceInstanceByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceByNameAndSpaceReturns.result1, fake.getServiceInstanceByNameAndSpaceReturns.result2, fake.getServiceInstanceByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceCallCount() int {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return len(fake.getServiceInstanceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return fake.getServiceInstanceByNameAndSpaceArgsForCall[i].name, fake.getServiceInstanceByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	fake.getServiceInstanceByNameAndSpaceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	if fake.getServiceInstanceByNameAndSpaceReturnsOnCall == nil {
+		fake.getServiceInstanceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.getServiceInstancesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesBySpaceReturnsOnCall[len(fake.getServiceInstancesBySpaceArgsForCall)]
+	fake.getServiceInstancesBySpaceArgsForCall = append(fake.getServiceInstancesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetServiceInstancesBySpace", []interface{}{spaceGUID})
+	fake.getServiceInstancesBySpaceMutex.Unlock()
+	if fake.GetServiceInstancesBySpaceStub != nil {
+		return fake.GetServiceInstancesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstancesBySpaceReturns.result1, fake.getServiceInstancesBySpaceReturns.result2, fake.getServiceInstancesBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceCallCount() int {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return len(fake.getServiceInstancesBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceArgsForCall(i int) string {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return fake.getServiceInstancesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceReturns(result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	fake.getServiceInstancesBySpaceReturns = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceReturnsOnCall(i int, result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	if fake.getServiceInstancesBySpaceReturnsOnCall == nil {
+		fake.getServiceInstancesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceSummaries(orgGUID string, spaceGUID string) ([]v2action.ServiceSummary, v2action.Warnings, error) {
+	fake.getServiceSummariesMutex.Lock()
+	ret, specificReturn := fake.getServiceSummariesReturnsOnCall[len(fake.getServiceSummariesArgsForCall)]
+	fake.getServiceSummariesArgsForCall = append(fake.getServiceSummariesArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+	}{orgGUID, spaceGUID})
+	fake.recordInvocation("GetServiceSummaries", []interface{}{orgGUID, spaceGUID})
+	fake.getServiceSummariesMutex.Unlock()
+	if fake.GetServiceSummariesStub != nil {
+		return fake.GetServiceSummariesStub(orgGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceSummariesReturns.result1, fake.getServiceSummariesReturns.result2, fake.getServiceSummariesReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceSummariesCallCount() int {
+	fake.getServiceSummariesMutex.RLock()
+	defer fake.getServiceSummariesMutex.RUnlock()
+	return len(fake.getServiceSummariesArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceSummariesArgsForCall(i int) (string, string) {
+	fake.getServiceSummariesMutex.RLock()
+	defer fake.getServiceSummariesMutex.RUnlock()
+	return fake.getServiceSummariesArgsForCall[i].orgGUID, fake.getServiceSummariesArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceSummariesReturns(result1 []v2action.ServiceSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceSummariesStub = nil
+	fake.getServiceSummariesReturns = struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceSummariesReturnsOnCall(i int, result1 []v2action.ServiceSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceSummariesStub = nil
+	if fake.getServiceSummariesReturnsOnCall == nil {
+		fake.getServiceSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceSummariesReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaUsage(spaceGUID string, ignoredAppGUID string) (v2action.SpaceQuotaUsage, v2action.Warnings, error) {
+	fake.getSpaceQuotaUsageMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotaUsageReturnsOnCall[len(fake.getSpaceQuotaUsageArgsForCall)]
+	fake.getSpaceQuotaUsageArgsForCall = append(fake.getSpaceQuotaUsageArgsForCall, struct {
+		spaceGUID      string
+		ignoredAppGUID string
+	}{spaceGUID, ignoredAppGUID})
+	fake.recordInvocation("GetSpaceQuotaUsage", []interface{}{spaceGUID, ignoredAppGUID})
+	fake.getSpaceQuotaUsageMutex.Unlock()
+	if fake.GetSpaceQuotaUsageStub != nil {
+		return fake.GetSpaceQuotaUsageStub(spaceGUID, ignoredAppGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceQuotaUsageReturns.result1, fake.getSpaceQuotaUsageReturns.result2, fake.getSpaceQuotaUsageReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaUsageCallCount() int {
+	fake.getSpaceQuotaUsageMutex.RLock()
+	defer fake.getSpaceQuotaUsageMutex.RUnlock()
+	return len(fake.getSpaceQuotaUsageArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaUsageArgsForCall(i int) (string, string) {
+	fake.getSpaceQuotaUsageMutex.RLock()
+	defer fake.getSpaceQuotaUsageMutex.RUnlock()
+	return fake.getSpaceQuotaUsageArgsForCall[i].spaceGUID, fake.getSpaceQuotaUsageArgsForCall[i].ignoredAppGUID
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaUsageReturns(result1 v2action.SpaceQuotaUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceQuotaUsageStub = nil
+	fake.getSpaceQuotaUsageReturns = struct {
+		result1 v2action.SpaceQuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaUsageReturnsOnCall(i int, result1 v2action.SpaceQuotaUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceQuotaUsageStub = nil
+	if fake.getSpaceQuotaUsageReturnsOnCall == nil {
+		fake.getSpaceQuotaUsageReturnsOnCall = make(map[int]struct {
+			result1 v2action.SpaceQuotaUsage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotaUsageReturnsOnCall[i] = struct {
+		result1 v2action.SpaceQuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetStack(guid string) (v2action.Stack, v2action.Warnings, error) {
+	fake.getStackMutex.Lock()
+	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
+	fake.getStackArgsForCall = append(fake.getStackArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetStack", []interface{}{guid})
+	fake.getStackMutex.Unlock()
+	if fake.GetStackStub != nil {
+		return fake.GetStackStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackReturns.result1, fake.getStackReturns.result2, fake.getStackReturns.result3
+}
+
+func (fake *FakeV2Actor) GetStackCallCount() int {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return len(fake.getStackArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetStackArgsForCall(i int) string {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return fake.getStackArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) GetStackReturns(result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	fake.getStackReturns = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetStackReturnsOnCall(i int, result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	if fake.getStackReturnsOnCall == nil {
+		fake.getStackReturnsOnCall = make(map[int]struct {
+			result1 v2action.Stack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackReturnsOnCall[i] = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetStackByName(stackName string) (v2action.Stack, v2action.Warnings, error) {
+	fake.getStackByNameMutex.Lock()
+	ret, specificReturn := fake.getStackByNameReturnsOnCall[len(fake.getStackByNameArgsForCall)]
+	fake.getStackByNameArgsForCall = append(fake.getStackByNameArgsForCall, struct {
+		stackName string
+	}{stackName})
+	fake.recordInvocation("GetStackByName", []interface{}{stackName})
+	fake.getStackByNameMutex.Unlock()
+	if fake.GetStackByNameStub != nil {
+		return fake.GetStackByNameStub(stackName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackByNameReturns.result1, fake.getStackByNameReturns.result2, fake.getStackByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetStackByNameCallCount() int {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return len(fake.getStackByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetStackByNameArgsForCall(i int) string {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return fake.getStackByNameArgsForCall[i].stackName
+}
+
+func (fake *FakeV2Actor) GetStackByNameReturns(result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	fake.getStackByNameReturns = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetStackByNameReturnsOnCall(i int, result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	if fake.getStackByNameReturnsOnCall == nil {
+		fake.getStackByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Stack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackByNameReturnsOnCall[i] = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error) {
+	fake.unbindRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unbindRouteFromApplicationReturnsOnCall[len(fake.unbindRouteFromApplicationArgsForCall)]
+	fake.unbindRouteFromApplicationArgsForCall = append(fake.unbindRouteFromApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UnbindRouteFromApplication", []interface{}{routeGUID, appGUID})
+	fake.unbindRouteFromApplicationMutex.Unlock()
+	if fake.UnbindRouteFromApplicationStub != nil {
+		return fake.UnbindRouteFromApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unbindRouteFromApplicationReturns.result1, fake.unbindRouteFromApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationCallCount() int {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return len(fake.unbindRouteFromApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationArgsForCall(i int) (string, string) {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return fake.unbindRouteFromApplicationArgsForCall[i].routeGUID, fake.unbindRouteFromApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	fake.unbindRouteFromApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	if fake.unbindRouteFromApplicationReturnsOnCall == nil {
+		fake.unbindRouteFromApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.unbindRouteFromApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2Actor) UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
 	fake.updateApplicationMutex.Lock()
 	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
@@ -637,27 +1871,121 @@ func (fake *FakeV2Actor) UpdateApplicationReturnsOnCall(i int, result1 v2action.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) UpdateRouteApplicationPort(routeGUID string, appGUID string, appPort int) (bool, v2action.Warnings, error) {
+	fake.updateRouteApplicationPortMutex.Lock()
+	ret, specificReturn := fake.updateRouteApplicationPortReturnsOnCall[len(fake.updateRouteApplicationPortArgsForCall)]
+	fake.updateRouteApplicationPortArgsForCall = append(fake.updateRouteApplicationPortArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+		appPort   int
+	}{routeGUID, appGUID, appPort})
+	fake.recordInvocation("UpdateRouteApplicationPort", []interface{}{routeGUID, appGUID, appPort})
+	fake.updateRouteApplicationPortMutex.Unlock()
+	if fake.UpdateRouteApplicationPortStub != nil {
+		return fake.UpdateRouteApplicationPortStub(routeGUID, appGUID, appPort)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateRouteApplicationPortReturns.result1, fake.updateRouteApplicationPortReturns.result2, fake.updateRouteApplicationPortReturns.result3
+}
+
+func (fake *FakeV2Actor) UpdateRouteApplicationPortCallCount() int {
+	fake.updateRouteApplicationPortMutex.RLock()
+	defer fake.updateRouteApplicationPortMutex.RUnlock()
+	return len(fake.updateRouteApplicationPortArgsForCall)
+}
+
+func (fake *FakeV2Actor) UpdateRouteApplicationPortArgsForCall(i int) (string, string, int) {
+	fake.updateRouteApplicationPortMutex.RLock()
+	defer fake.updateRouteApplicationPortMutex.RUnlock()
+	return fake.updateRouteApplicationPortArgsForCall[i].routeGUID, fake.updateRouteApplicationPortArgsForCall[i].appGUID, fake.updateRouteApplicationPortArgsForCall[i].appPort
+}
+
+func (fake *FakeV2Actor) UpdateRouteApplicationPortReturns(result1 bool, result2 v2action.Warnings, result3 error) {
+	fake.UpdateRouteApplicationPortStub = nil
+	fake.updateRouteApplicationPortReturns = struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UpdateRouteApplicationPortReturnsOnCall(i int, result1 bool, result2 v2action.Warnings, result3 error) {
+	fake.UpdateRouteApplicationPortStub = nil
+	if fake.updateRouteApplicationPortReturnsOnCall == nil {
+		fake.updateRouteApplicationPortReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.updateRouteApplicationPortReturnsOnCall[i] = struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.bindRouteToApplicationMutex.RLock()
 	defer fake.bindRouteToApplicationMutex.RUnlock()
+	fake.bindRouteToApplicationPortMutex.RLock()
+	defer fake.bindRouteToApplicationPortMutex.RUnlock()
+	fake.bindServiceToApplicationMutex.RLock()
+	defer fake.bindServiceToApplicationMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
 	defer fake.checkRouteMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	fake.deleteServiceBindingMutex.RLock()
+	defer fake.deleteServiceBindingMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
 	fake.getOrganizationDomainsMutex.RLock()
 	defer fake.getOrganizationDomainsMutex.RUnlock()
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
 	fake.getRouteByHostAndDomainMutex.RLock()
 	defer fake.getRouteByHostAndDomainMutex.RUnlock()
+	fake.getServiceBindingsByApplicationMutex.RLock()
+	defer fake.getServiceBindingsByApplicationMutex.RUnlock()
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	fake.getServiceSummariesMutex.RLock()
+	defer fake.getServiceSummariesMutex.RUnlock()
+	fake.getSpaceQuotaUsageMutex.RLock()
+	defer fake.getSpaceQuotaUsageMutex.RUnlock()
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateRouteApplicationPortMutex.RLock()
+	defer fake.updateRouteApplicationPortMutex.RUnlock()
 	return fake.invocations
 }
 
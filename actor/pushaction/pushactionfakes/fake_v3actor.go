@@ -0,0 +1,538 @@
+// This file was generated by counterfeiter
+package pushactionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+)
+
+type FakeV3Actor struct {
+	AcquireApplicationPushLockStub        func(appGUID string, owner string, force bool) (v3action.Warnings, error)
+	acquireApplicationPushLockMutex       sync.RWMutex
+	acquireApplicationPushLockArgsForCall []struct {
+		appGUID string
+		owner   string
+		force   bool
+	}
+	acquireApplicationPushLockReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	acquireApplicationPushLockReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	ReleaseApplicationPushLockStub        func(appGUID string, owner string) (v3action.Warnings, error)
+	releaseApplicationPushLockMutex       sync.RWMutex
+	releaseApplicationPushLockArgsForCall []struct {
+		appGUID string
+		owner   string
+	}
+	releaseApplicationPushLockReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	releaseApplicationPushLockReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	RunTaskAndWaitStub        func(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+	runTaskAndWaitMutex       sync.RWMutex
+	runTaskAndWaitArgsForCall []struct {
+		appGUID string
+		task    v3action.Task
+	}
+	runTaskAndWaitReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	runTaskAndWaitReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	SetApplicationDropletStub        func(appGUID string, dropletGUID string) (v3action.Warnings, error)
+	setApplicationDropletMutex       sync.RWMutex
+	setApplicationDropletArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	setApplicationDropletReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	setApplicationDropletReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	SetApplicationProcessHealthCheckByNameAndSpaceStub        func(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error)
+	setApplicationProcessHealthCheckByNameAndSpaceMutex       sync.RWMutex
+	setApplicationProcessHealthCheckByNameAndSpaceArgsForCall []struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		healthCheck v3action.ProcessHealthCheck
+	}
+	setApplicationProcessHealthCheckByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub        func(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error)
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex       sync.RWMutex
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall []struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		healthCheck v3action.ProcessHealthCheck
+	}
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	StageLatestApplicationPackageStub        func(appGUID string) (v3action.Droplet, v3action.Warnings, error)
+	stageLatestApplicationPackageMutex       sync.RWMutex
+	stageLatestApplicationPackageArgsForCall []struct {
+		appGUID string
+	}
+	stageLatestApplicationPackageReturns struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	stageLatestApplicationPackageReturnsOnCall map[int]struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3Actor) AcquireApplicationPushLock(appGUID string, owner string, force bool) (v3action.Warnings, error) {
+	fake.acquireApplicationPushLockMutex.Lock()
+	ret, specificReturn := fake.acquireApplicationPushLockReturnsOnCall[len(fake.acquireApplicationPushLockArgsForCall)]
+	fake.acquireApplicationPushLockArgsForCall = append(fake.acquireApplicationPushLockArgsForCall, struct {
+		appGUID string
+		owner   string
+		force   bool
+	}{appGUID, owner, force})
+	fake.recordInvocation("AcquireApplicationPushLock", []interface{}{appGUID, owner, force})
+	fake.acquireApplicationPushLockMutex.Unlock()
+	if fake.AcquireApplicationPushLockStub != nil {
+		return fake.AcquireApplicationPushLockStub(appGUID, owner, force)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.acquireApplicationPushLockReturns.result1, fake.acquireApplicationPushLockReturns.result2
+}
+
+func (fake *FakeV3Actor) AcquireApplicationPushLockCallCount() int {
+	fake.acquireApplicationPushLockMutex.RLock()
+	defer fake.acquireApplicationPushLockMutex.RUnlock()
+	return len(fake.acquireApplicationPushLockArgsForCall)
+}
+
+func (fake *FakeV3Actor) AcquireApplicationPushLockArgsForCall(i int) (string, string, bool) {
+	fake.acquireApplicationPushLockMutex.RLock()
+	defer fake.acquireApplicationPushLockMutex.RUnlock()
+	return fake.acquireApplicationPushLockArgsForCall[i].appGUID, fake.acquireApplicationPushLockArgsForCall[i].owner, fake.acquireApplicationPushLockArgsForCall[i].force
+}
+
+func (fake *FakeV3Actor) AcquireApplicationPushLockReturns(result1 v3action.Warnings, result2 error) {
+	fake.AcquireApplicationPushLockStub = nil
+	fake.acquireApplicationPushLockReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) AcquireApplicationPushLockReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.AcquireApplicationPushLockStub = nil
+	if fake.acquireApplicationPushLockReturnsOnCall == nil {
+		fake.acquireApplicationPushLockReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.acquireApplicationPushLockReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) ReleaseApplicationPushLock(appGUID string, owner string) (v3action.Warnings, error) {
+	fake.releaseApplicationPushLockMutex.Lock()
+	ret, specificReturn := fake.releaseApplicationPushLockReturnsOnCall[len(fake.releaseApplicationPushLockArgsForCall)]
+	fake.releaseApplicationPushLockArgsForCall = append(fake.releaseApplicationPushLockArgsForCall, struct {
+		appGUID string
+		owner   string
+	}{appGUID, owner})
+	fake.recordInvocation("ReleaseApplicationPushLock", []interface{}{appGUID, owner})
+	fake.releaseApplicationPushLockMutex.Unlock()
+	if fake.ReleaseApplicationPushLockStub != nil {
+		return fake.ReleaseApplicationPushLockStub(appGUID, owner)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.releaseApplicationPushLockReturns.result1, fake.releaseApplicationPushLockReturns.result2
+}
+
+func (fake *FakeV3Actor) ReleaseApplicationPushLockCallCount() int {
+	fake.releaseApplicationPushLockMutex.RLock()
+	defer fake.releaseApplicationPushLockMutex.RUnlock()
+	return len(fake.releaseApplicationPushLockArgsForCall)
+}
+
+func (fake *FakeV3Actor) ReleaseApplicationPushLockArgsForCall(i int) (string, string) {
+	fake.releaseApplicationPushLockMutex.RLock()
+	defer fake.releaseApplicationPushLockMutex.RUnlock()
+	return fake.releaseApplicationPushLockArgsForCall[i].appGUID, fake.releaseApplicationPushLockArgsForCall[i].owner
+}
+
+func (fake *FakeV3Actor) ReleaseApplicationPushLockReturns(result1 v3action.Warnings, result2 error) {
+	fake.ReleaseApplicationPushLockStub = nil
+	fake.releaseApplicationPushLockReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) ReleaseApplicationPushLockReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.ReleaseApplicationPushLockStub = nil
+	if fake.releaseApplicationPushLockReturnsOnCall == nil {
+		fake.releaseApplicationPushLockReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.releaseApplicationPushLockReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) RunTaskAndWait(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error) {
+	fake.runTaskAndWaitMutex.Lock()
+	ret, specificReturn := fake.runTaskAndWaitReturnsOnCall[len(fake.runTaskAndWaitArgsForCall)]
+	fake.runTaskAndWaitArgsForCall = append(fake.runTaskAndWaitArgsForCall, struct {
+		appGUID string
+		task    v3action.Task
+	}{appGUID, task})
+	fake.recordInvocation("RunTaskAndWait", []interface{}{appGUID, task})
+	fake.runTaskAndWaitMutex.Unlock()
+	if fake.RunTaskAndWaitStub != nil {
+		return fake.RunTaskAndWaitStub(appGUID, task)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.runTaskAndWaitReturns.result1, fake.runTaskAndWaitReturns.result2, fake.runTaskAndWaitReturns.result3
+}
+
+func (fake *FakeV3Actor) RunTaskAndWaitCallCount() int {
+	fake.runTaskAndWaitMutex.RLock()
+	defer fake.runTaskAndWaitMutex.RUnlock()
+	return len(fake.runTaskAndWaitArgsForCall)
+}
+
+func (fake *FakeV3Actor) RunTaskAndWaitArgsForCall(i int) (string, v3action.Task) {
+	fake.runTaskAndWaitMutex.RLock()
+	defer fake.runTaskAndWaitMutex.RUnlock()
+	return fake.runTaskAndWaitArgsForCall[i].appGUID, fake.runTaskAndWaitArgsForCall[i].task
+}
+
+func (fake *FakeV3Actor) RunTaskAndWaitReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskAndWaitStub = nil
+	fake.runTaskAndWaitReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) RunTaskAndWaitReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskAndWaitStub = nil
+	if fake.runTaskAndWaitReturnsOnCall == nil {
+		fake.runTaskAndWaitReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.runTaskAndWaitReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) SetApplicationDroplet(appGUID string, dropletGUID string) (v3action.Warnings, error) {
+	fake.setApplicationDropletMutex.Lock()
+	ret, specificReturn := fake.setApplicationDropletReturnsOnCall[len(fake.setApplicationDropletArgsForCall)]
+	fake.setApplicationDropletArgsForCall = append(fake.setApplicationDropletArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("SetApplicationDroplet", []interface{}{appGUID, dropletGUID})
+	fake.setApplicationDropletMutex.Unlock()
+	if fake.SetApplicationDropletStub != nil {
+		return fake.SetApplicationDropletStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setApplicationDropletReturns.result1, fake.setApplicationDropletReturns.result2
+}
+
+func (fake *FakeV3Actor) SetApplicationDropletCallCount() int {
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	return len(fake.setApplicationDropletArgsForCall)
+}
+
+func (fake *FakeV3Actor) SetApplicationDropletArgsForCall(i int) (string, string) {
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	return fake.setApplicationDropletArgsForCall[i].appGUID, fake.setApplicationDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeV3Actor) SetApplicationDropletReturns(result1 v3action.Warnings, result2 error) {
+	fake.SetApplicationDropletStub = nil
+	fake.setApplicationDropletReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) SetApplicationDropletReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.SetApplicationDropletStub = nil
+	if fake.setApplicationDropletReturnsOnCall == nil {
+		fake.setApplicationDropletReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.setApplicationDropletReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error) {
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall[len(fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall)]
+	fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall = append(fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall, struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		healthCheck v3action.ProcessHealthCheck
+	}{appName, spaceGUID, processType, healthCheck})
+	fake.recordInvocation("SetApplicationProcessHealthCheckByNameAndSpace", []interface{}{appName, spaceGUID, processType, healthCheck})
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.Unlock()
+	if fake.SetApplicationProcessHealthCheckByNameAndSpaceStub != nil {
+		return fake.SetApplicationProcessHealthCheckByNameAndSpaceStub(appName, spaceGUID, processType, healthCheck)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.setApplicationProcessHealthCheckByNameAndSpaceReturns.result1, fake.setApplicationProcessHealthCheckByNameAndSpaceReturns.result2, fake.setApplicationProcessHealthCheckByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessHealthCheckByNameAndSpaceCallCount() int {
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return len(fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessHealthCheckByNameAndSpaceArgsForCall(i int) (string, string, string, v3action.ProcessHealthCheck) {
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall[i].appName, fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall[i].spaceGUID, fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall[i].processType, fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall[i].healthCheck
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessHealthCheckByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.SetApplicationProcessHealthCheckByNameAndSpaceStub = nil
+	fake.setApplicationProcessHealthCheckByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.SetApplicationProcessHealthCheckByNameAndSpaceStub = nil
+	if fake.setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall == nil {
+		fake.setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessReadinessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error) {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall[len(fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall)]
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall = append(fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall, struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		healthCheck v3action.ProcessHealthCheck
+	}{appName, spaceGUID, processType, healthCheck})
+	fake.recordInvocation("SetApplicationProcessReadinessHealthCheckByNameAndSpace", []interface{}{appName, spaceGUID, processType, healthCheck})
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Unlock()
+	if fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub != nil {
+		return fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub(appName, spaceGUID, processType, healthCheck)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns.result1, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns.result2, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessReadinessHealthCheckByNameAndSpaceCallCount() int {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return len(fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall(i int) (string, string, string, v3action.ProcessHealthCheck) {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall[i].appName, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall[i].spaceGUID, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall[i].processType, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall[i].healthCheck
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub = nil
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub = nil
+	if fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall == nil {
+		fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) StageLatestApplicationPackage(appGUID string) (v3action.Droplet, v3action.Warnings, error) {
+	fake.stageLatestApplicationPackageMutex.Lock()
+	ret, specificReturn := fake.stageLatestApplicationPackageReturnsOnCall[len(fake.stageLatestApplicationPackageArgsForCall)]
+	fake.stageLatestApplicationPackageArgsForCall = append(fake.stageLatestApplicationPackageArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("StageLatestApplicationPackage", []interface{}{appGUID})
+	fake.stageLatestApplicationPackageMutex.Unlock()
+	if fake.StageLatestApplicationPackageStub != nil {
+		return fake.StageLatestApplicationPackageStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.stageLatestApplicationPackageReturns.result1, fake.stageLatestApplicationPackageReturns.result2, fake.stageLatestApplicationPackageReturns.result3
+}
+
+func (fake *FakeV3Actor) StageLatestApplicationPackageCallCount() int {
+	fake.stageLatestApplicationPackageMutex.RLock()
+	defer fake.stageLatestApplicationPackageMutex.RUnlock()
+	return len(fake.stageLatestApplicationPackageArgsForCall)
+}
+
+func (fake *FakeV3Actor) StageLatestApplicationPackageArgsForCall(i int) string {
+	fake.stageLatestApplicationPackageMutex.RLock()
+	defer fake.stageLatestApplicationPackageMutex.RUnlock()
+	return fake.stageLatestApplicationPackageArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3Actor) StageLatestApplicationPackageReturns(result1 v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.StageLatestApplicationPackageStub = nil
+	fake.stageLatestApplicationPackageReturns = struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) StageLatestApplicationPackageReturnsOnCall(i int, result1 v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.StageLatestApplicationPackageStub = nil
+	if fake.stageLatestApplicationPackageReturnsOnCall == nil {
+		fake.stageLatestApplicationPackageReturnsOnCall = make(map[int]struct {
+			result1 v3action.Droplet
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.stageLatestApplicationPackageReturnsOnCall[i] = struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.acquireApplicationPushLockMutex.RLock()
+	defer fake.acquireApplicationPushLockMutex.RUnlock()
+	fake.releaseApplicationPushLockMutex.RLock()
+	defer fake.releaseApplicationPushLockMutex.RUnlock()
+	fake.runTaskAndWaitMutex.RLock()
+	defer fake.runTaskAndWaitMutex.RUnlock()
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RUnlock()
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RUnlock()
+	fake.stageLatestApplicationPackageMutex.RLock()
+	defer fake.stageLatestApplicationPackageMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV3Actor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pushaction.V3Actor = new(FakeV3Actor)
@@ -1,10 +1,47 @@
 package pushaction
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"code.cloudfoundry.org/cli/actor/v2action"
 	log "github.com/Sirupsen/logrus"
 )
 
+// InvalidRouteError is returned when a manifest route does not belong to any
+// of the organization's domains.
+type InvalidRouteError struct {
+	Route string
+}
+
+func (e InvalidRouteError) Error() string {
+	return fmt.Sprintf("route %s does not match any domain of the organization", e.Route)
+}
+
+// WildcardHost is the host of a route matching every host of its domain.
+const WildcardHost = "*"
+
+// InvalidWildcardRouteError is returned when a manifest route uses a wildcard
+// anywhere other than as the whole host of an HTTP route.
+type InvalidWildcardRouteError struct {
+	Route string
+}
+
+func (e InvalidWildcardRouteError) Error() string {
+	return fmt.Sprintf("route %s has an invalid wildcard host", e.Route)
+}
+
+// WildcardRouteForbiddenError is returned when the user is not allowed to
+// create a wildcard route.
+type WildcardRouteForbiddenError struct {
+	Route string
+}
+
+func (e WildcardRouteForbiddenError) Error() string {
+	return fmt.Sprintf("not authorized to create wildcard route %s", e.Route)
+}
+
 // FindOrReturnPartialRoute finds the route with the given host and domain. If
 // it is unable to find the route, it will return back the partial route. When
 // the route exists in another space, RouteInDifferentSpaceError is returned.
@@ -66,6 +103,77 @@ func (actor Actor) GetRouteWithDefaultDomain(host string, orgGUID string, spaceG
 	return route, append(Warnings(warnings), routeWarnings...), err
 }
 
+// GetRouteFromManifestRoute parses a manifest route, such as
+// host.example.com/path or tcp.example.com:1234, against the organization's
+// domains and returns the matching route. This may be a partial route (ie no
+// GUID) if the route does not exist.
+func (actor Actor) GetRouteFromManifestRoute(manifestRoute string, orgGUID string, spaceGUID string) (v2action.Route, Warnings, error) {
+	log.Infoln("getting org domains for org GUID:", orgGUID)
+	domains, warnings, err := actor.V2Actor.GetOrganizationDomains(orgGUID)
+	if err != nil {
+		log.Errorln("searching for domains in org:", err)
+		return v2action.Route{}, Warnings(warnings), err
+	}
+
+	route := v2action.Route{SpaceGUID: spaceGUID}
+	hostAndDomain := manifestRoute
+	if index := strings.Index(hostAndDomain, "/"); index != -1 {
+		route.Path = hostAndDomain[index:]
+		hostAndDomain = hostAndDomain[:index]
+	}
+	if index := strings.LastIndex(hostAndDomain, ":"); index != -1 {
+		port, err := strconv.Atoi(hostAndDomain[index+1:])
+		if err != nil {
+			return v2action.Route{}, Warnings(warnings), InvalidRouteError{Route: manifestRoute}
+		}
+		route.Port = port
+		hostAndDomain = hostAndDomain[:index]
+	}
+
+	var matched bool
+	for _, domain := range domains {
+		if len(domain.Name) <= len(route.Domain.Name) {
+			continue
+		}
+		if hostAndDomain == domain.Name {
+			route.Domain = domain
+			route.Host = ""
+			matched = true
+		} else if strings.HasSuffix(hostAndDomain, "."+domain.Name) {
+			route.Domain = domain
+			route.Host = strings.TrimSuffix(hostAndDomain, "."+domain.Name)
+			matched = true
+		}
+	}
+	if !matched {
+		log.Errorln("no domain found for route:", manifestRoute)
+		return v2action.Route{}, Warnings(warnings), InvalidRouteError{Route: manifestRoute}
+	}
+
+	if strings.Contains(route.Host, WildcardHost) && (route.Host != WildcardHost || route.Port != 0) {
+		log.Errorln("invalid wildcard route:", manifestRoute)
+		return v2action.Route{}, Warnings(warnings), InvalidWildcardRouteError{Route: manifestRoute}
+	}
+
+	foundRoute, routeWarnings, err := actor.FindOrReturnPartialRoute(route)
+	return foundRoute, append(Warnings(warnings), routeWarnings...), err
+}
+
+// deleteRouteIfOrphaned deletes the route when no application is mapped to
+// it, and returns whether it was deleted.
+func (actor Actor) deleteRouteIfOrphaned(route v2action.Route) (bool, Warnings, error) {
+	apps, warnings, err := actor.V2Actor.GetRouteApplications(route.GUID, nil)
+	allWarnings := Warnings(warnings)
+	if err != nil || len(apps) > 0 {
+		return false, allWarnings, err
+	}
+
+	log.Debugf("deleting orphaned route: %#v", route)
+	warnings, err = actor.V2Actor.DeleteRoute(route.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return err == nil, allWarnings, err
+}
+
 func (actor Actor) routeInList(route v2action.Route, routes []v2action.Route) bool {
 	for _, r := range routes {
 		if r.GUID == route.GUID {
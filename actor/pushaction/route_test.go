@@ -19,7 +19,7 @@ var _ = Describe("Routes", func() {
 
 	BeforeEach(func() {
 		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
-		actor = NewActor(fakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
 	})
 
 	Describe("FindOrReturnEmptyRoute", func() {
@@ -228,4 +228,129 @@ var _ = Describe("Routes", func() {
 			})
 		})
 	})
+
+	Describe("GetRouteFromManifestRoute", func() {
+		var (
+			manifestRoute string
+
+			route      v2action.Route
+			warnings   Warnings
+			executeErr error
+
+			domain    v2action.Domain
+			subdomain v2action.Domain
+		)
+
+		BeforeEach(func() {
+			domain = v2action.Domain{Name: "example.com", GUID: "some-domain-guid"}
+			subdomain = v2action.Domain{Name: "apps.example.com", GUID: "some-subdomain-guid"}
+			fakeV2Actor.GetOrganizationDomainsReturns(
+				[]v2action.Domain{domain, subdomain},
+				v2action.Warnings{"domain-warnings"},
+				nil,
+			)
+			fakeV2Actor.CheckRouteReturns(false, v2action.Warnings{"check-route-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = actor.GetRouteFromManifestRoute(manifestRoute, "some-org-guid", "some-space-guid")
+		})
+
+		Context("when the route has a host and path", func() {
+			BeforeEach(func() {
+				manifestRoute = "some-app.apps.example.com/some-path"
+			})
+
+			It("matches the longest domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("domain-warnings", "check-route-warnings"))
+				Expect(route).To(Equal(v2action.Route{
+					Domain:    subdomain,
+					Host:      "some-app",
+					Path:      "/some-path",
+					SpaceGUID: "some-space-guid",
+				}))
+
+				Expect(fakeV2Actor.GetOrganizationDomainsArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the route is a domain with a port", func() {
+			BeforeEach(func() {
+				manifestRoute = "example.com:1234"
+			})
+
+			It("returns a route without a host", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(route).To(Equal(v2action.Route{
+					Domain:    domain,
+					Port:      1234,
+					SpaceGUID: "some-space-guid",
+				}))
+			})
+		})
+
+		Context("when the route has a wildcard host", func() {
+			BeforeEach(func() {
+				manifestRoute = "*.apps.example.com"
+			})
+
+			It("returns a wildcard route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(route).To(Equal(v2action.Route{
+					Domain:    subdomain,
+					Host:      "*",
+					SpaceGUID: "some-space-guid",
+				}))
+			})
+		})
+
+		Context("when the wildcard is only part of the host", func() {
+			BeforeEach(func() {
+				manifestRoute = "some-*.example.com"
+			})
+
+			It("returns an InvalidWildcardRouteError", func() {
+				Expect(executeErr).To(MatchError(InvalidWildcardRouteError{Route: "some-*.example.com"}))
+				Expect(warnings).To(ConsistOf("domain-warnings"))
+				Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the wildcard route has a port", func() {
+			BeforeEach(func() {
+				manifestRoute = "*.example.com:1234"
+			})
+
+			It("returns an InvalidWildcardRouteError", func() {
+				Expect(executeErr).To(MatchError(InvalidWildcardRouteError{Route: "*.example.com:1234"}))
+			})
+		})
+
+		Context("when the route does not match any domain", func() {
+			BeforeEach(func() {
+				manifestRoute = "some-app.unknown.com"
+			})
+
+			It("returns an InvalidRouteError", func() {
+				Expect(executeErr).To(MatchError(InvalidRouteError{Route: "some-app.unknown.com"}))
+				Expect(warnings).To(ConsistOf("domain-warnings"))
+			})
+		})
+
+		Context("when retrieving the domains errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				manifestRoute = "some-app.example.com"
+				expectedErr = errors.New("whoops")
+				fakeV2Actor.GetOrganizationDomainsReturns(nil, v2action.Warnings{"domain-warnings"}, expectedErr)
+			})
+
+			It("returns errors and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("domain-warnings"))
+			})
+		})
+	})
 })
@@ -0,0 +1,157 @@
+package pushaction
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	log "github.com/Sirupsen/logrus"
+)
+
+// MissingApplicationNameError is returned when an application does not have a
+// name.
+type MissingApplicationNameError struct{}
+
+func (MissingApplicationNameError) Error() string {
+	return "application name is missing"
+}
+
+// DuplicateApplicationError is returned when more than one application has
+// the same name.
+type DuplicateApplicationError struct {
+	Name string
+}
+
+func (e DuplicateApplicationError) Error() string {
+	return fmt.Sprintf("application %s is defined more than once", e.Name)
+}
+
+// UnknownDependencyError is returned when an application depends on an
+// application that is not being pushed.
+type UnknownDependencyError struct {
+	Name      string
+	DependsOn string
+}
+
+func (e UnknownDependencyError) Error() string {
+	return fmt.Sprintf("application %s depends on unknown application %s", e.Name, e.DependsOn)
+}
+
+// DependencyCycleError is returned when the dependencies of applications form
+// a cycle.
+type DependencyCycleError struct {
+	Names []string
+}
+
+func (e DependencyCycleError) Error() string {
+	return fmt.Sprintf("applications %s depend on each other", strings.Join(e.Names, ", "))
+}
+
+// ScheduleApplications validates the applications and orders them so that
+// every application comes after the applications listed in its depends_on.
+// Applications that do not depend on each other keep their original order.
+func (actor Actor) ScheduleApplications(apps []manifest.Application) ([]manifest.Application, error) {
+	appsByName := map[string]manifest.Application{}
+	for _, app := range apps {
+		if app.Name == "" {
+			return nil, MissingApplicationNameError{}
+		}
+		if _, ok := appsByName[app.Name]; ok {
+			return nil, DuplicateApplicationError{Name: app.Name}
+		}
+		appsByName[app.Name] = app
+	}
+
+	remainingDependencies := map[string]int{}
+	dependents := map[string][]string{}
+	for _, app := range apps {
+		for _, dependency := range app.DependsOn {
+			if _, ok := appsByName[dependency]; !ok {
+				return nil, UnknownDependencyError{Name: app.Name, DependsOn: dependency}
+			}
+			remainingDependencies[app.Name]++
+			dependents[dependency] = append(dependents[dependency], app.Name)
+		}
+	}
+
+	scheduled := map[string]bool{}
+	var ordered []manifest.Application
+	for len(ordered) < len(apps) {
+		var ready []string
+		for _, app := range apps {
+			if !scheduled[app.Name] && remainingDependencies[app.Name] == 0 {
+				ready = append(ready, app.Name)
+			}
+		}
+
+		if len(ready) == 0 {
+			return nil, DependencyCycleError{Names: dependencyCycle(apps, appsByName, dependents, scheduled)}
+		}
+
+		for _, name := range ready {
+			scheduled[name] = true
+			ordered = append(ordered, appsByName[name])
+			for _, dependent := range dependents[name] {
+				remainingDependencies[dependent]--
+			}
+		}
+	}
+
+	log.Debugf("scheduled applications: %#v", ordered)
+	return ordered, nil
+}
+
+// dependencyCycle returns the names of the applications that are in the same
+// cycle of dependencies, in their original order. Every application that is
+// not scheduled yet waits for another one, so following the dependencies of
+// any of them ends in a cycle; the applications that merely depend on the
+// cycle are left out.
+func dependencyCycle(apps []manifest.Application, appsByName map[string]manifest.Application, dependents map[string][]string, scheduled map[string]bool) []string {
+	var start string
+	for _, app := range apps {
+		if !scheduled[app.Name] {
+			start = app.Name
+			break
+		}
+	}
+
+	visited := map[string]bool{}
+	for !visited[start] {
+		visited[start] = true
+		for _, dependency := range appsByName[start].DependsOn {
+			if !scheduled[dependency] {
+				start = dependency
+				break
+			}
+		}
+	}
+
+	dependencies := reachable(start, func(name string) []string { return appsByName[name].DependsOn }, scheduled)
+	dependentsOfStart := reachable(start, func(name string) []string { return dependents[name] }, scheduled)
+
+	var cycle []string
+	for _, app := range apps {
+		if dependencies[app.Name] && dependentsOfStart[app.Name] {
+			cycle = append(cycle, app.Name)
+		}
+	}
+	return cycle
+}
+
+// reachable returns the applications that are not scheduled and that can be
+// reached from start by following next.
+func reachable(start string, next func(string) []string, scheduled map[string]bool) map[string]bool {
+	found := map[string]bool{start: true}
+	pending := []string{start}
+	for len(pending) > 0 {
+		name := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for _, other := range next(name) {
+			if !scheduled[other] && !found[other] {
+				found[other] = true
+				pending = append(pending, other)
+			}
+		}
+	}
+	return found
+}
@@ -0,0 +1,122 @@
+package pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scheduling", func() {
+	var (
+		actor      *Actor
+		apps       []manifest.Application
+		ordered    []manifest.Application
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(pushactionfakes.FakeV2Actor), nil)
+	})
+
+	JustBeforeEach(func() {
+		ordered, executeErr = actor.ScheduleApplications(apps)
+	})
+
+	Describe("ScheduleApplications", func() {
+		Context("when the applications have no dependencies", func() {
+			BeforeEach(func() {
+				apps = []manifest.Application{{Name: "app-1"}, {Name: "app-2"}}
+			})
+
+			It("keeps the original order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(ordered).To(Equal(apps))
+			})
+		})
+
+		Context("when applications depend on each other", func() {
+			BeforeEach(func() {
+				apps = []manifest.Application{
+					{Name: "frontend", DependsOn: []string{"api"}},
+					{Name: "api", DependsOn: []string{"database", "queue"}},
+					{Name: "database"},
+					{Name: "worker", DependsOn: []string{"queue"}},
+					{Name: "queue"},
+				}
+			})
+
+			It("orders every application after its dependencies", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var names []string
+				for _, app := range ordered {
+					names = append(names, app.Name)
+				}
+				Expect(names).To(Equal([]string{"database", "queue", "api", "worker", "frontend"}))
+			})
+		})
+
+		Context("when an application does not have a name", func() {
+			BeforeEach(func() {
+				apps = []manifest.Application{{Name: "app-1"}, {Path: "some-path"}}
+			})
+
+			It("returns a MissingApplicationNameError", func() {
+				Expect(executeErr).To(MatchError(MissingApplicationNameError{}))
+			})
+		})
+
+		Context("when an application is defined more than once", func() {
+			BeforeEach(func() {
+				apps = []manifest.Application{{Name: "app-1"}, {Name: "app-1"}}
+			})
+
+			It("returns a DuplicateApplicationError", func() {
+				Expect(executeErr).To(MatchError(DuplicateApplicationError{Name: "app-1"}))
+			})
+		})
+
+		Context("when an application depends on an unknown application", func() {
+			BeforeEach(func() {
+				apps = []manifest.Application{{Name: "app-1", DependsOn: []string{"app-2"}}}
+			})
+
+			It("returns an UnknownDependencyError", func() {
+				Expect(executeErr).To(MatchError(UnknownDependencyError{Name: "app-1", DependsOn: "app-2"}))
+			})
+		})
+
+		Context("when the dependencies form a cycle", func() {
+			BeforeEach(func() {
+				apps = []manifest.Application{
+					{Name: "app-1"},
+					{Name: "app-2", DependsOn: []string{"app-3"}},
+					{Name: "app-3", DependsOn: []string{"app-2"}},
+				}
+			})
+
+			It("returns a DependencyCycleError with the applications in the cycle", func() {
+				Expect(executeErr).To(MatchError(DependencyCycleError{Names: []string{"app-2", "app-3"}}))
+			})
+
+			Context("when other applications depend on the cycle", func() {
+				BeforeEach(func() {
+					apps = []manifest.Application{
+						{Name: "app-0", DependsOn: []string{"app-4"}},
+						{Name: "app-1", DependsOn: []string{"app-2"}},
+						{Name: "app-2", DependsOn: []string{"app-3"}},
+						{Name: "app-3", DependsOn: []string{"app-4"}},
+						{Name: "app-4", DependsOn: []string{"app-2"}},
+					}
+				})
+
+				It("only returns the applications in the cycle", func() {
+					Expect(executeErr).To(MatchError(DependencyCycleError{Names: []string{"app-2", "app-3", "app-4"}}))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,116 @@
+package pushaction
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	log "github.com/Sirupsen/logrus"
+)
+
+// ServiceInstancesNotFoundError is returned when service instances listed by
+// a manifest application do not exist in the space.
+type ServiceInstancesNotFoundError struct {
+	AppName              string
+	ServiceInstanceNames []string
+}
+
+func (e ServiceInstancesNotFoundError) Error() string {
+	return fmt.Sprintf("service instances %s of application %s not found", strings.Join(e.ServiceInstanceNames, ", "), e.AppName)
+}
+
+// getApplicationServices returns the service bindings of the application, by
+// the name of their service instance, and the service instances listed by the
+// manifest application. All the listed service instances that do not exist in
+// the space are returned in a single ServiceInstancesNotFoundError.
+func (actor Actor) getApplicationServices(appGUID string, app manifest.Application, spaceGUID string) (map[string]v2action.ServiceBinding, []v2action.ServiceInstance, Warnings, error) {
+	var (
+		allWarnings Warnings
+		bindings    []v2action.ServiceBinding
+	)
+
+	if appGUID != "" {
+		log.Info("looking up application service bindings")
+		var warnings v2action.Warnings
+		var err error
+		bindings, warnings, err = actor.V2Actor.GetServiceBindingsByApplication(appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("existing service bindings lookup:", err)
+			return nil, nil, allWarnings, err
+		}
+	}
+
+	if len(bindings) == 0 && len(app.Services) == 0 {
+		return nil, nil, allWarnings, nil
+	}
+
+	serviceInstances, warnings, err := actor.V2Actor.GetServiceInstancesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		log.Errorln("service instances lookup:", err)
+		return nil, nil, allWarnings, err
+	}
+
+	byName := map[string]v2action.ServiceInstance{}
+	namesByGUID := map[string]string{}
+	for _, serviceInstance := range serviceInstances {
+		byName[serviceInstance.Name] = serviceInstance
+		namesByGUID[serviceInstance.GUID] = serviceInstance.Name
+	}
+
+	var currentBindings map[string]v2action.ServiceBinding
+	for _, binding := range bindings {
+		if currentBindings == nil {
+			currentBindings = map[string]v2action.ServiceBinding{}
+		}
+		// Instances shared from other spaces are not listed, so they are
+		// known by their GUID.
+		name, ok := namesByGUID[binding.ServiceInstanceGUID]
+		if !ok {
+			name = binding.ServiceInstanceGUID
+		}
+		currentBindings[name] = binding
+	}
+
+	var (
+		desiredServices []v2action.ServiceInstance
+		missing         []string
+	)
+	for _, name := range app.Services {
+		serviceInstance, ok := byName[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		desiredServices = append(desiredServices, serviceInstance)
+	}
+	if len(missing) > 0 {
+		log.Errorln("service instances not found:", missing)
+		return nil, nil, allWarnings, ServiceInstancesNotFoundError{AppName: app.Name, ServiceInstanceNames: missing}
+	}
+
+	return currentBindings, desiredServices, allWarnings, nil
+}
+
+// sortedServiceInstanceNames returns the names of the bound service instances
+// in order, so that they are unbound in the same order on every push.
+func sortedServiceInstanceNames(bindings map[string]v2action.ServiceBinding) []string {
+	names := make([]string, 0, len(bindings))
+	for name := range bindings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func serviceInstanceInList(name string, serviceInstances []v2action.ServiceInstance) bool {
+	for _, serviceInstance := range serviceInstances {
+		if serviceInstance.Name == name {
+			return true
+		}
+	}
+	return false
+}
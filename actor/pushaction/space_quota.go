@@ -0,0 +1,76 @@
+package pushaction
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// SpaceQuotaResource is a resource limited by a space quota.
+type SpaceQuotaResource string
+
+const (
+	// SpaceQuotaMemory is the memory, in megabytes, of the started apps.
+	SpaceQuotaMemory SpaceQuotaResource = "memory"
+
+	// SpaceQuotaInstances is the number of instances of the started apps.
+	SpaceQuotaInstances SpaceQuotaResource = "app instances"
+)
+
+// SpaceQuotaExceededError is returned when the memory or app instances of an
+// application would exceed what is left of its space quota.
+type SpaceQuotaExceededError struct {
+	AppName   string
+	QuotaName string
+	Resource  SpaceQuotaResource
+	Requested int
+	Used      int
+	Limit     int
+}
+
+func (e SpaceQuotaExceededError) Error() string {
+	return fmt.Sprintf("app %s requests %d %s, but space quota %s has %d of %d in use", e.AppName, e.Requested, e.Resource, e.QuotaName, e.Used, e.Limit)
+}
+
+// checkSpaceQuota returns a SpaceQuotaExceededError when running the desired
+// application would exceed the space quota, so that the push fails before
+// anything is changed. The current usage of the application itself is not
+// counted because the desired settings replace it. Memory is only checked
+// when the desired application sets it, since the default is decided by the
+// Cloud Controller.
+func (actor Actor) checkSpaceQuota(config ApplicationConfig) (Warnings, error) {
+	log.Info("checking space quota")
+	usage, warnings, err := actor.V2Actor.GetSpaceQuotaUsage(config.DesiredApplication.SpaceGUID, config.CurrentApplication.GUID)
+	if err != nil || usage.Quota.GUID == "" {
+		return Warnings(warnings), err
+	}
+	log.Debugf("space quota usage: %#v", usage)
+
+	app := config.DesiredApplication
+	instances := app.Instances
+	if instances == 0 {
+		instances = 1
+	}
+
+	exceeded := SpaceQuotaExceededError{
+		AppName:   app.Name,
+		QuotaName: usage.Quota.Name,
+	}
+	switch {
+	case app.Memory > 0 && usage.Quota.MemoryLimit >= 0 && usage.MemoryUsed+app.Memory*instances > usage.Quota.MemoryLimit:
+		exceeded.Resource = SpaceQuotaMemory
+		exceeded.Requested = app.Memory * instances
+		exceeded.Used = usage.MemoryUsed
+		exceeded.Limit = usage.Quota.MemoryLimit
+	case usage.Quota.AppInstanceLimit >= 0 && usage.Instances+instances > usage.Quota.AppInstanceLimit:
+		exceeded.Resource = SpaceQuotaInstances
+		exceeded.Requested = instances
+		exceeded.Used = usage.Instances
+		exceeded.Limit = usage.Quota.AppInstanceLimit
+	default:
+		return Warnings(warnings), nil
+	}
+
+	log.Errorln("space quota exceeded:", exceeded)
+	return Warnings(warnings), exceeded
+}
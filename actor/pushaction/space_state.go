@@ -0,0 +1,200 @@
+package pushaction
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/types"
+	log "github.com/Sirupsen/logrus"
+)
+
+type servicePlanName struct {
+	Service string
+	Plan    string
+}
+
+// ExportSpace returns the applications of the space, in manifest form, along
+// with its service instances and the bindings between them. Application bits
+// and the credentials of user provided service instances are not exported.
+// Service instances whose plan is not visible in the marketplace are skipped
+// with a warning.
+func (actor Actor) ExportSpace(orgGUID string, spaceGUID string) (manifest.Space, Warnings, error) {
+	var allWarnings Warnings
+
+	log.Info("looking up service plans")
+	summaries, warnings, err := actor.V2Actor.GetServiceSummaries(orgGUID, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return manifest.Space{}, allWarnings, err
+	}
+
+	plans := map[string]servicePlanName{}
+	for _, summary := range summaries {
+		for _, plan := range summary.Plans {
+			plans[plan.GUID] = servicePlanName{Service: summary.Label, Plan: plan.Name}
+		}
+	}
+
+	log.Info("looking up service instances")
+	serviceInstances, warnings, err := actor.V2Actor.GetServiceInstancesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return manifest.Space{}, allWarnings, err
+	}
+
+	var space manifest.Space
+	serviceInstanceNames := map[string]string{}
+	for _, serviceInstance := range serviceInstances {
+		service := manifest.Service{Name: serviceInstance.Name}
+		if serviceInstance.UserProvided() {
+			service.UserProvided = true
+		} else {
+			plan, ok := plans[serviceInstance.ServicePlanGUID]
+			if !ok {
+				log.Warnf("plan %s of service instance %s not found", serviceInstance.ServicePlanGUID, serviceInstance.Name)
+				allWarnings = append(allWarnings, fmt.Sprintf("Service instance %s was not exported because its plan is not available in the marketplace.", serviceInstance.Name))
+				continue
+			}
+			service.Service = plan.Service
+			service.Plan = plan.Plan
+		}
+
+		serviceInstanceNames[serviceInstance.GUID] = serviceInstance.Name
+		space.Services = append(space.Services, service)
+	}
+
+	log.Info("looking up applications")
+	apps, warnings, err := actor.V2Actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return manifest.Space{}, allWarnings, err
+	}
+
+	for _, app := range apps {
+		log.Infoln("exporting application:", app.Name)
+		manifestApp := manifest.Application{
+			Name:                 app.Name,
+			Buildpack:            app.Buildpack,
+			EnvironmentVariables: app.EnvironmentVariables,
+			Instances:            types.NullInt{IsSet: true, Value: app.Instances},
+			DiskQuota:            app.DiskQuota,
+			Memory:               app.Memory,
+			Ports:                app.Ports,
+		}
+
+		routes, warnings, err := actor.V2Actor.GetApplicationRoutes(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return manifest.Space{}, allWarnings, err
+		}
+		for _, route := range routes {
+			manifestApp.Routes = append(manifestApp.Routes, route.String())
+		}
+
+		bindings, warnings, err := actor.V2Actor.GetServiceBindingsByApplication(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return manifest.Space{}, allWarnings, err
+		}
+		for _, binding := range bindings {
+			if name, ok := serviceInstanceNames[binding.ServiceInstanceGUID]; ok {
+				manifestApp.Services = append(manifestApp.Services, name)
+			}
+		}
+
+		space.Applications = append(space.Applications, manifestApp)
+	}
+
+	return space, allWarnings, nil
+}
+
+// CreateSpaceServices creates the service instances that do not exist in the
+// space yet. User provided service instances are created without credentials.
+func (actor Actor) CreateSpaceServices(spaceGUID string, services []manifest.Service) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, service := range services {
+		_, warnings, err := actor.V2Actor.GetServiceInstanceByNameAndSpace(service.Name, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err == nil {
+			log.Infoln("service instance already exists:", service.Name)
+			continue
+		}
+		if _, ok := err.(v2action.ServiceInstanceNotFoundError); !ok {
+			return allWarnings, err
+		}
+
+		log.Infoln("creating service instance:", service.Name)
+		if service.UserProvided {
+			_, warnings, err = actor.V2Actor.CreateUserProvidedServiceInstance(spaceGUID, service.Name)
+		} else {
+			_, warnings, err = actor.V2Actor.CreateServiceInstance(spaceGUID, service.Service, service.Plan, service.Name)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("creating service instance:", err)
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// BindServicesToApplication binds the named service instances to the
+// application, skipping the ones that are already bound.
+func (actor Actor) BindServicesToApplication(appName string, spaceGUID string, serviceInstanceNames []string) (Warnings, error) {
+	if len(serviceInstanceNames) == 0 {
+		return nil, nil
+	}
+
+	app, allWarnings, err := actor.V2Actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+
+	bindings, warnings, err := actor.V2Actor.GetServiceBindingsByApplication(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+
+	bound := map[string]bool{}
+	for _, binding := range bindings {
+		bound[binding.ServiceInstanceGUID] = true
+	}
+
+	for _, name := range serviceInstanceNames {
+		serviceInstance, warnings, err := actor.V2Actor.GetServiceInstanceByNameAndSpace(name, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Warnings(allWarnings), err
+		}
+
+		if bound[serviceInstance.GUID] {
+			log.Infof("service instance %s already bound to %s", name, appName)
+			continue
+		}
+
+		log.Infof("binding service instance %s to %s", name, appName)
+		warnings, err = actor.V2Actor.BindServiceToApplication(app.GUID, serviceInstance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Warnings(allWarnings), err
+		}
+	}
+
+	return Warnings(allWarnings), nil
+}
+
+// ReadSpaceFile returns the space state written by WriteSpaceFile.
+func (actor Actor) ReadSpaceFile(pathToSpace string) (manifest.Space, error) {
+	log.Infoln("reading space file:", pathToSpace)
+	return manifest.ReadSpace(pathToSpace)
+}
+
+// WriteSpaceFile writes the space state to pathToSpace.
+func (actor Actor) WriteSpaceFile(pathToSpace string, space manifest.Space) error {
+	log.Infoln("writing space file:", pathToSpace)
+	return manifest.WriteSpace(pathToSpace, space)
+}
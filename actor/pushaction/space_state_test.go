@@ -0,0 +1,282 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space State", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
+	})
+
+	Describe("ExportSpace", func() {
+		var (
+			space      manifest.Space
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			space, warnings, executeErr = actor.ExportSpace("some-org-guid", "some-space-guid")
+		})
+
+		Context("when the space has apps and services", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetServiceSummariesReturns(
+					[]v2action.ServiceSummary{{
+						Service: v2action.Service{Label: "some-service"},
+						Plans: []v2action.ServicePlanSummary{
+							{ServicePlan: v2action.ServicePlan{GUID: "some-plan-guid", Name: "some-plan"}},
+						},
+					}},
+					v2action.Warnings{"summaries-warning"},
+					nil,
+				)
+				fakeV2Actor.GetServiceInstancesBySpaceReturns(
+					[]v2action.ServiceInstance{
+						{GUID: "some-db-guid", Name: "some-db", Type: ccv2.ManagedService, ServicePlanGUID: "some-plan-guid"},
+						{GUID: "some-ups-guid", Name: "some-ups", Type: ccv2.UserProvidedService},
+						{GUID: "hidden-guid", Name: "hidden", Type: ccv2.ManagedService, ServicePlanGUID: "hidden-plan-guid"},
+					},
+					v2action.Warnings{"instances-warning"},
+					nil,
+				)
+				fakeV2Actor.GetApplicationsBySpaceReturns(
+					[]v2action.Application{{
+						GUID:                 "some-app-guid",
+						Name:                 "some-app",
+						Buildpack:            "ruby_buildpack",
+						EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+						Instances:            2,
+						DiskQuota:            1024,
+						Memory:               256,
+						Ports:                []int{8080},
+					}},
+					v2action.Warnings{"apps-warning"},
+					nil,
+				)
+				fakeV2Actor.GetApplicationRoutesReturns(
+					[]v2action.Route{{Host: "some-app", Domain: v2action.Domain{Name: "example.com"}}},
+					v2action.Warnings{"routes-warning"},
+					nil,
+				)
+				fakeV2Actor.GetServiceBindingsByApplicationReturns(
+					[]v2action.ServiceBinding{
+						{ServiceInstanceGUID: "some-db-guid"},
+						{ServiceInstanceGUID: "hidden-guid"},
+					},
+					v2action.Warnings{"bindings-warning"},
+					nil,
+				)
+			})
+
+			It("returns the apps and the services they are bound to", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(space).To(Equal(manifest.Space{
+					Applications: []manifest.Application{{
+						Name:                 "some-app",
+						Buildpack:            "ruby_buildpack",
+						EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+						Instances:            types.NullInt{IsSet: true, Value: 2},
+						DiskQuota:            1024,
+						Memory:               256,
+						Ports:                []int{8080},
+						Routes:               []string{"some-app.example.com"},
+						Services:             []string{"some-db"},
+					}},
+					Services: []manifest.Service{
+						{Name: "some-db", Service: "some-service", Plan: "some-plan"},
+						{Name: "some-ups", UserProvided: true},
+					},
+				}))
+				Expect(warnings).To(ConsistOf(
+					"summaries-warning",
+					"instances-warning",
+					"Service instance hidden was not exported because its plan is not available in the marketplace.",
+					"apps-warning",
+					"routes-warning",
+					"bindings-warning",
+				))
+
+				orgGUID, spaceGUID := fakeV2Actor.GetServiceSummariesArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(fakeV2Actor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeV2Actor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeV2Actor.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeV2Actor.GetServiceBindingsByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the applications fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"apps-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("apps-warning"))
+			})
+		})
+	})
+
+	Describe("CreateSpaceServices", func() {
+		var (
+			services   []manifest.Service
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			services = []manifest.Service{
+				{Name: "existing-db", Service: "some-service", Plan: "some-plan"},
+				{Name: "some-db", Service: "some-service", Plan: "some-plan"},
+				{Name: "some-ups", UserProvided: true},
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CreateSpaceServices("some-space-guid", services)
+		})
+
+		Context("when the services can be created", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceStub = func(name string, _ string) (v2action.ServiceInstance, v2action.Warnings, error) {
+					if name == "existing-db" {
+						return v2action.ServiceInstance{GUID: "existing-db-guid"}, v2action.Warnings{"get-warning"}, nil
+					}
+					return v2action.ServiceInstance{}, v2action.Warnings{"get-warning"}, v2action.ServiceInstanceNotFoundError{Name: name}
+				}
+				fakeV2Actor.CreateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"create-warning"}, nil)
+				fakeV2Actor.CreateUserProvidedServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"create-ups-warning"}, nil)
+			})
+
+			It("creates the missing service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "get-warning", "create-warning", "get-warning", "create-ups-warning"))
+
+				Expect(fakeV2Actor.CreateServiceInstanceCallCount()).To(Equal(1))
+				spaceGUID, serviceName, planName, instanceName := fakeV2Actor.CreateServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(serviceName).To(Equal("some-service"))
+				Expect(planName).To(Equal("some-plan"))
+				Expect(instanceName).To(Equal("some-db"))
+
+				Expect(fakeV2Actor.CreateUserProvidedServiceInstanceCallCount()).To(Equal(1))
+				spaceGUID, instanceName = fakeV2Actor.CreateUserProvidedServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(instanceName).To(Equal("some-ups"))
+			})
+		})
+
+		Context("when looking up a service instance fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"get-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeV2Actor.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating a service instance fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"get-warning"}, v2action.ServiceInstanceNotFoundError{})
+				fakeV2Actor.CreateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"create-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-warning", "create-warning"))
+				Expect(fakeV2Actor.CreateServiceInstanceCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("BindServicesToApplication", func() {
+		var (
+			serviceNames []string
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			serviceNames = []string{"bound-db", "some-db"}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.BindServicesToApplication("some-app", "some-space-guid", serviceNames)
+		})
+
+		Context("when no services are provided", func() {
+			BeforeEach(func() {
+				serviceNames = nil
+			})
+
+			It("does not look up the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV2Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app and services exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid"}, v2action.Warnings{"app-warning"}, nil)
+				fakeV2Actor.GetServiceBindingsByApplicationReturns(
+					[]v2action.ServiceBinding{{ServiceInstanceGUID: "bound-db-guid"}},
+					v2action.Warnings{"bindings-warning"},
+					nil,
+				)
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceStub = func(name string, _ string) (v2action.ServiceInstance, v2action.Warnings, error) {
+					return v2action.ServiceInstance{GUID: name + "-guid"}, v2action.Warnings{"instance-warning"}, nil
+				}
+				fakeV2Actor.BindServiceToApplicationReturns(v2action.Warnings{"bind-warning"}, nil)
+			})
+
+			It("binds the services that are not bound yet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-warning", "bindings-warning", "instance-warning", "instance-warning", "bind-warning"))
+
+				name, spaceGUID := fakeV2Actor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeV2Actor.BindServiceToApplicationCallCount()).To(Equal(1))
+				appGUID, serviceInstanceGUID := fakeV2Actor.BindServiceToApplicationArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(serviceInstanceGUID).To(Equal("some-db-guid"))
+			})
+		})
+
+		Context("when a service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"instance-warning"}, v2action.ServiceInstanceNotFoundError{Name: "bound-db"})
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(v2action.ServiceInstanceNotFoundError{Name: "bound-db"}))
+				Expect(warnings).To(ConsistOf("instance-warning"))
+				Expect(fakeV2Actor.BindServiceToApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,17 +1,39 @@
 package pushaction
 
-import "code.cloudfoundry.org/cli/actor/v2action"
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
 
 //go:generate counterfeiter . V2Actor
 
 type V2Actor interface {
 	BindRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
+	BindRouteToApplicationPort(routeGUID string, appGUID string, appPort int) (v2action.Warnings, error)
+	BindServiceToApplication(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
 	CheckRoute(route v2action.Route) (bool, v2action.Warnings, error)
+	CloudControllerAPIVersion() string
 	CreateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	CreateRoute(route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
+	CreateServiceInstance(spaceGUID string, serviceName string, servicePlanName string, serviceInstanceName string) (v2action.ServiceInstance, v2action.Warnings, error)
+	CreateUserProvidedServiceInstance(spaceGUID string, serviceInstanceName string) (v2action.ServiceInstance, v2action.Warnings, error)
+	DeleteRoute(routeGUID string) (v2action.Warnings, error)
+	DeleteServiceBinding(serviceBindingGUID string) (v2action.Warnings, error)
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
 	GetApplicationRoutes(applicationGUID string) ([]v2action.Route, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetBuildpacks() ([]v2action.Buildpack, v2action.Warnings, error)
 	GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
+	GetRouteApplications(routeGUID string, query []ccv2.Query) ([]v2action.Application, v2action.Warnings, error)
 	GetRouteByHostAndDomain(host string, domainGUID string) (v2action.Route, v2action.Warnings, error)
+	GetServiceBindingsByApplication(appGUID string) ([]v2action.ServiceBinding, v2action.Warnings, error)
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceSummaries(orgGUID string, spaceGUID string) ([]v2action.ServiceSummary, v2action.Warnings, error)
+	GetSpaceQuotaUsage(spaceGUID string, ignoredAppGUID string) (v2action.SpaceQuotaUsage, v2action.Warnings, error)
+	GetStack(guid string) (v2action.Stack, v2action.Warnings, error)
+	GetStackByName(stackName string) (v2action.Stack, v2action.Warnings, error)
+	UnbindRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
 	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+	UpdateRouteApplicationPort(routeGUID string, appGUID string, appPort int) (bool, v2action.Warnings, error)
 }
@@ -0,0 +1,15 @@
+package pushaction
+
+import "code.cloudfoundry.org/cli/actor/v3action"
+
+//go:generate counterfeiter . V3Actor
+
+type V3Actor interface {
+	AcquireApplicationPushLock(appGUID string, owner string, force bool) (v3action.Warnings, error)
+	ReleaseApplicationPushLock(appGUID string, owner string) (v3action.Warnings, error)
+	RunTaskAndWait(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+	SetApplicationDroplet(appGUID string, dropletGUID string) (v3action.Warnings, error)
+	SetApplicationProcessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error)
+	SetApplicationProcessReadinessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error)
+	StageLatestApplicationPackage(appGUID string) (v3action.Droplet, v3action.Warnings, error)
+}
@@ -0,0 +1,87 @@
+package pushaction
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	log "github.com/Sirupsen/logrus"
+)
+
+// validHealthCheckTypes are the health check types a manifest can set.
+var validHealthCheckTypes = map[string]bool{
+	"http":    true,
+	"none":    true,
+	"port":    true,
+	"process": true,
+}
+
+// InvalidHealthCheckTypeError is returned when a manifest sets a health check
+// type that Cloud Foundry does not support.
+type InvalidHealthCheckTypeError struct {
+	AppName         string
+	ProcessType     string
+	HealthCheckType string
+}
+
+func (e InvalidHealthCheckTypeError) Error() string {
+	return fmt.Sprintf("application %s sets invalid health check type %s for process %s", e.AppName, e.HealthCheckType, e.ProcessType)
+}
+
+// ValidateManifest reads the manifest at pathToManifest and checks the
+// problems that can be found without an API: invalid YAML and byte sizes,
+// missing or duplicate application names, unknown or cyclic dependencies and
+// invalid health check types. It does not use the V2 or V3 actors, so it
+// works without a target.
+func (actor Actor) ValidateManifest(pathToManifest string) ([]manifest.Application, error) {
+	apps, err := actor.ReadManifest(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range apps {
+		err = validateHealthChecksOfApplication(app)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = actor.ScheduleApplications(apps)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("validated %d application(s)", len(apps))
+	return apps, nil
+}
+
+// validateApplication checks the problems of a single application that push
+// would otherwise only find after changing the application.
+func validateApplication(app manifest.Application) error {
+	if app.Name == "" {
+		return MissingApplicationNameError{}
+	}
+	return validateHealthChecksOfApplication(app)
+}
+
+func validateHealthChecksOfApplication(app manifest.Application) error {
+	err := validateHealthChecks(app.Name, "web", app.HealthCheck, app.ReadinessHealthCheck)
+	if err != nil {
+		return err
+	}
+	for _, process := range app.Processes {
+		err = validateHealthChecks(app.Name, process.Type, process.HealthCheck, process.ReadinessHealthCheck)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateHealthChecks(appName string, processType string, healthChecks ...manifest.HealthCheck) error {
+	for _, healthCheck := range healthChecks {
+		if healthCheck.Type != "" && !validHealthCheckTypes[healthCheck.Type] {
+			return InvalidHealthCheckTypeError{AppName: appName, ProcessType: processType, HealthCheckType: healthCheck.Type}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package pushaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateManifest", func() {
+	var (
+		actor        *Actor
+		dir          string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil)
+
+		var err error
+		dir, err = ioutil.TempDir("", "validate-manifest-test")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(dir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	writeManifest := func(contents string) {
+		Expect(ioutil.WriteFile(manifestPath, []byte(contents), 0600)).To(Succeed())
+	}
+
+	Context("when the manifest is valid", func() {
+		BeforeEach(func() {
+			writeManifest("applications:\n- name: app-1\n  health_check_type: http\n  depends_on: [app-2]\n- name: app-2\n")
+		})
+
+		It("returns the applications in manifest order", func() {
+			apps, err := actor.ValidateManifest(manifestPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(Equal([]manifest.Application{
+				{Name: "app-1", Path: dir, DependsOn: []string{"app-2"}, HealthCheck: manifest.HealthCheck{Type: "http"}},
+				{Name: "app-2", Path: dir},
+			}))
+		})
+	})
+
+	Context("when the manifest does not exist", func() {
+		It("returns the error", func() {
+			_, err := actor.ValidateManifest(filepath.Join(dir, "missing.yml"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Context("when an application has an invalid health check type", func() {
+		BeforeEach(func() {
+			writeManifest("applications:\n- name: app-1\n  processes:\n  - type: worker\n    readiness_health_check_type: tcp\n")
+		})
+
+		It("returns an InvalidHealthCheckTypeError", func() {
+			_, err := actor.ValidateManifest(manifestPath)
+			Expect(err).To(MatchError(InvalidHealthCheckTypeError{AppName: "app-1", ProcessType: "worker", HealthCheckType: "tcp"}))
+		})
+	})
+
+	Context("when the applications depend on each other", func() {
+		BeforeEach(func() {
+			writeManifest("applications:\n- name: app-1\n  depends_on: [app-2]\n- name: app-2\n  depends_on: [app-1]\n")
+		})
+
+		It("returns a DependencyCycleError", func() {
+			_, err := actor.ValidateManifest(manifestPath)
+			Expect(err).To(BeAssignableToTypeOf(DependencyCycleError{}))
+		})
+	})
+
+	Context("when an application is missing a name", func() {
+		BeforeEach(func() {
+			writeManifest("applications:\n- path: some-path\n")
+		})
+
+		It("returns a MissingApplicationNameError", func() {
+			_, err := actor.ValidateManifest(manifestPath)
+			Expect(err).To(MatchError(MissingApplicationNameError{}))
+		})
+	})
+})
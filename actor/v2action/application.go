@@ -1,6 +1,7 @@
 package v2action
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -225,7 +226,55 @@ func (actor Actor) GetRouteApplications(routeGUID string, query []ccv2.Query) ([
 }
 
 // StartApplication starts a given application.
-func (actor Actor) StartApplication(app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+func (actor Actor) StartApplication(ctx context.Context, app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+	return actor.startApplication(ctx, app, client, config, func() (ccv2.Application, Warnings, error) {
+		return actor.updateApplicationState(app.GUID, ccv2.ApplicationStarted)
+	})
+}
+
+// RestartApplication stops the given application if it is running and then
+// starts it again.
+func (actor Actor) RestartApplication(ctx context.Context, app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+	return actor.startApplication(ctx, app, client, config, func() (ccv2.Application, Warnings, error) {
+		var allWarnings Warnings
+		if app.Started() {
+			_, warnings, err := actor.updateApplicationState(app.GUID, ccv2.ApplicationStopped)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return ccv2.Application{}, allWarnings, err
+			}
+		}
+
+		updatedApp, warnings, err := actor.updateApplicationState(app.GUID, ccv2.ApplicationStarted)
+		allWarnings = append(allWarnings, warnings...)
+		return updatedApp, allWarnings, err
+	})
+}
+
+// RestageApplication restages the given application and waits for it to
+// start.
+func (actor Actor) RestageApplication(ctx context.Context, app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+	return actor.startApplication(ctx, app, client, config, func() (ccv2.Application, Warnings, error) {
+		restagedApp, warnings, err := actor.CloudControllerClient.RestageApplication(ccv2.Application{
+			GUID: app.GUID,
+		})
+		return restagedApp, Warnings(warnings), err
+	})
+}
+
+func (actor Actor) updateApplicationState(appGUID string, state ccv2.ApplicationState) (ccv2.Application, Warnings, error) {
+	updatedApp, warnings, err := actor.CloudControllerClient.UpdateApplication(ccv2.Application{
+		GUID:  appGUID,
+		State: state,
+	})
+	return updatedApp, Warnings(warnings), err
+}
+
+// startApplication streams the application's logs while trigger is called,
+// then polls until the application has staged and, if it has any instances,
+// until one of them is running. Polling stops with the error of ctx when it is
+// done.
+func (actor Actor) startApplication(ctx context.Context, app Application, client NOAAClient, config Config, trigger func() (ccv2.Application, Warnings, error)) (<-chan *LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config)
 
 	appStarting := make(chan bool)
@@ -237,10 +286,7 @@ func (actor Actor) StartApplication(app Application, client NOAAClient, config C
 		defer close(errs)
 		defer client.Close()
 
-		updatedApp, warnings, err := actor.CloudControllerClient.UpdateApplication(ccv2.Application{
-			GUID:  app.GUID,
-			State: ccv2.ApplicationStarted,
-		})
+		updatedApp, warnings, err := trigger()
 
 		for _, warning := range warnings {
 			allWarnings <- warning
@@ -250,7 +296,7 @@ func (actor Actor) StartApplication(app Application, client NOAAClient, config C
 			return
 		}
 
-		err = actor.pollStaging(app, config, allWarnings)
+		err = actor.pollStaging(ctx, app, config, allWarnings)
 		if err != nil {
 			errs <- err
 			return
@@ -263,7 +309,7 @@ func (actor Actor) StartApplication(app Application, client NOAAClient, config C
 		client.Close()
 		appStarting <- true
 
-		err = actor.pollStartup(app, config, allWarnings)
+		err = actor.pollStartup(ctx, app, config, allWarnings)
 		if err != nil {
 			errs <- err
 		}
@@ -272,7 +318,7 @@ func (actor Actor) StartApplication(app Application, client NOAAClient, config C
 	return messages, logErrs, appStarting, allWarnings, errs
 }
 
-func (actor Actor) pollStaging(app Application, config Config, allWarnings chan<- string) error {
+func (actor Actor) pollStaging(ctx context.Context, app Application, config Config, allWarnings chan<- string) error {
 	timeout := time.Now().Add(config.StagingTimeout())
 	for time.Now().Before(timeout) {
 		currentApplication, warnings, err := actor.GetApplication(app.GUID)
@@ -291,12 +337,15 @@ func (actor Actor) pollStaging(app Application, config Config, allWarnings chan<
 			}
 			return StagingFailedError{Reason: currentApplication.StagingFailedMessage()}
 		}
-		time.Sleep(config.PollingInterval())
+		err = sleepUntilDone(ctx, config.PollingInterval())
+		if err != nil {
+			return err
+		}
 	}
 	return StagingTimeoutError{Name: app.Name, Timeout: config.StagingTimeout()}
 }
 
-func (actor Actor) pollStartup(app Application, config Config, allWarnings chan<- string) error {
+func (actor Actor) pollStartup(ctx context.Context, app Application, config Config, allWarnings chan<- string) error {
 	timeout := time.Now().Add(config.StartupTimeout())
 	for time.Now().Before(timeout) {
 		currentInstances, warnings, err := actor.GetApplicationInstancesByApplication(app.GUID)
@@ -317,7 +366,10 @@ func (actor Actor) pollStartup(app Application, config Config, allWarnings chan<
 				return ApplicationInstanceFlappingError{Name: app.Name}
 			}
 		}
-		time.Sleep(config.PollingInterval())
+		err = sleepUntilDone(ctx, config.PollingInterval())
+		if err != nil {
+			return err
+		}
 	}
 
 	return StartupTimeoutError{Name: app.Name}
@@ -364,3 +416,69 @@ func (actor Actor) UpdateApplication(application Application) (Application, Warn
 	app, warnings, err := actor.CloudControllerClient.UpdateApplication(ccv2.Application(application))
 	return Application(app), Warnings(warnings), err
 }
+
+// ApplicationScale is the set of scaling values to apply to an application.
+type ApplicationScale ccv2.ApplicationScale
+
+// ScaleApplication updates the instances, memory and disk quota of the
+// application with the given GUID. Values that are not provided are left
+// unchanged.
+func (actor Actor) ScaleApplication(appGUID string, scale ApplicationScale) (Application, Warnings, error) {
+	app, warnings, err := actor.CloudControllerClient.ScaleApplication(appGUID, ccv2.ApplicationScale(scale))
+	return Application(app), Warnings(warnings), err
+}
+
+// ApplicationNameTakenError is returned when renaming an application to a name
+// that is already in use in its space.
+type ApplicationNameTakenError struct {
+	Name string
+}
+
+func (e ApplicationNameTakenError) Error() string {
+	return fmt.Sprintf("Application '%s' already exists.", e.Name)
+}
+
+// RenameApplicationByNameAndSpace renames the application with the provided
+// name in the space. An ApplicationNameTakenError is returned, without
+// attempting the rename, when another application in the space already has
+// the new name.
+func (actor Actor) RenameApplicationByNameAndSpace(oldName string, newName string, spaceGUID string) (Application, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(oldName, spaceGUID)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	existingApp, warnings, err := actor.GetApplicationByNameAndSpace(newName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		if existingApp.GUID != app.GUID {
+			return Application{}, allWarnings, ApplicationNameTakenError{Name: newName}
+		}
+	case ApplicationNotFoundError:
+	default:
+		return Application{}, allWarnings, err
+	}
+
+	updatedApp, updateWarnings, err := actor.CloudControllerClient.UpdateApplication(ccv2.Application{
+		GUID: app.GUID,
+		Name: newName,
+	})
+	allWarnings = append(allWarnings, updateWarnings...)
+	if _, ok := err.(ccerror.ApplicationNameTakenError); ok {
+		return Application{}, allWarnings, ApplicationNameTakenError{Name: newName}
+	}
+
+	return Application(updatedApp), allWarnings, err
+}
+
+// sleepUntilDone waits for the duration, or returns the error of ctx when it
+// is done first.
+func sleepUntilDone(ctx context.Context, wait time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
@@ -37,3 +37,39 @@ func (actor Actor) GetApplicationInstancesByApplication(guid string) (map[int]Ap
 
 	return appInstances, Warnings(warnings), err
 }
+
+// ApplicationStartupProgress is the number of an application's instances in
+// each state while the application is starting.
+type ApplicationStartupProgress struct {
+	Total    int
+	Running  int
+	Starting int
+	Crashed  int
+	Down     int
+}
+
+// GetApplicationStartupProgress returns the number of the application's
+// instances that are running, starting, crashed or down. Flapping instances
+// are counted as crashed, and instances in an unknown state as down.
+func (actor Actor) GetApplicationStartupProgress(appGUID string) (ApplicationStartupProgress, Warnings, error) {
+	instances, warnings, err := actor.GetApplicationInstancesByApplication(appGUID)
+	if err != nil {
+		return ApplicationStartupProgress{}, warnings, err
+	}
+
+	progress := ApplicationStartupProgress{Total: len(instances)}
+	for _, instance := range instances {
+		switch instance.State {
+		case ccv2.ApplicationInstanceRunning:
+			progress.Running++
+		case ccv2.ApplicationInstanceStarting:
+			progress.Starting++
+		case ccv2.ApplicationInstanceCrashed, ccv2.ApplicationInstanceFlapping:
+			progress.Crashed++
+		default:
+			progress.Down++
+		}
+	}
+
+	return progress, warnings, nil
+}
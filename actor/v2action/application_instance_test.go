@@ -175,4 +175,56 @@ var _ = Describe("Application Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationStartupProgress", func() {
+		Context("when getting the instances succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+					map[int]ccv2.ApplicationInstance{
+						0: {ID: 0, State: ccv2.ApplicationInstanceRunning},
+						1: {ID: 1, State: ccv2.ApplicationInstanceStarting},
+						2: {ID: 2, State: ccv2.ApplicationInstanceCrashed},
+						3: {ID: 3, State: ccv2.ApplicationInstanceFlapping},
+						4: {ID: 4, State: ccv2.ApplicationInstanceDown},
+						5: {ID: 5, State: ccv2.ApplicationInstanceUnknown},
+					},
+					ccv2.Warnings{"instances-warning"},
+					nil)
+			})
+
+			It("counts the instances in each state", func() {
+				progress, warnings, err := actor.GetApplicationStartupProgress("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instances-warning"))
+				Expect(progress).To(Equal(ApplicationStartupProgress{
+					Total:    6,
+					Running:  1,
+					Starting: 1,
+					Crashed:  2,
+					Down:     2,
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("instances error")
+				fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+					nil,
+					ccv2.Warnings{"instances-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationStartupProgress("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("instances-warning"))
+			})
+		})
+	})
 })
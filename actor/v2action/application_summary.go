@@ -1,6 +1,13 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
 
 type ApplicationSummary struct {
 	Application
@@ -69,3 +76,104 @@ func (actor Actor) GetApplicationSummaryByNameAndSpace(name string, spaceGUID st
 
 	return applicationSummary, allWarnings, nil
 }
+
+// ApplicationSummaryFilter selects the applications returned by
+// GetApplicationSummariesBySpace. Empty fields match every application.
+type ApplicationSummaryFilter struct {
+	// State is "started", "stopped" or "crashed". Crashed applications are
+	// started applications with at least one crashed instance.
+	State string
+
+	// Buildpack is matched case insensitively against part of the buildpack
+	// set by the user or, when there is none, the detected buildpack.
+	Buildpack string
+
+	// NamePattern is matched against the application name.
+	NamePattern *regexp.Regexp
+}
+
+// GetApplicationSummariesBySpace returns the summaries, ordered by name, of
+// the applications in the space that match the filter. The Cloud Controller
+// only filters applications by space, so the filter is applied here; instances
+// are only fetched for started applications that match the other criteria.
+// Stacks are not included in the summaries.
+func (actor Actor) GetApplicationSummariesBySpace(spaceGUID string, filter ApplicationSummaryFilter) ([]ApplicationSummary, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sort.Slice(apps, func(i int, j int) bool {
+		return sorting.SortAlphabetic(apps[i].Name, apps[j].Name)
+	})
+
+	var summaries []ApplicationSummary
+	for _, app := range apps {
+		if !filter.matchesApplication(app) {
+			continue
+		}
+
+		summary := ApplicationSummary{Application: app}
+		if app.State == ccv2.ApplicationStarted {
+			instances, warnings, err := actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			switch err.(type) {
+			case nil:
+				summary.RunningInstances = instances
+			case ApplicationInstancesNotFoundError:
+				// don't set instances in summary
+			default:
+				return nil, allWarnings, err
+			}
+		}
+
+		if filter.State == "crashed" && !summary.hasCrashedInstance() {
+			continue
+		}
+
+		routes, warnings, err := actor.GetApplicationRoutes(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		summary.Routes = routes
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, allWarnings, nil
+}
+
+func (filter ApplicationSummaryFilter) matchesApplication(app Application) bool {
+	switch filter.State {
+	case "started", "crashed":
+		if app.State != ccv2.ApplicationStarted {
+			return false
+		}
+	case "stopped":
+		if app.State != ccv2.ApplicationStopped {
+			return false
+		}
+	}
+
+	if filter.Buildpack != "" {
+		buildpack := app.Buildpack
+		if buildpack == "" {
+			buildpack = app.DetectedBuildpack
+		}
+		if !strings.Contains(strings.ToLower(buildpack), strings.ToLower(filter.Buildpack)) {
+			return false
+		}
+	}
+
+	return filter.NamePattern == nil || filter.NamePattern.MatchString(app.Name)
+}
+
+func (app ApplicationSummary) hasCrashedInstance() bool {
+	for _, instance := range app.RunningInstances {
+		if instance.State == ApplicationInstanceState(ccv2.ApplicationInstanceCrashed) {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"regexp"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -238,4 +239,156 @@ var _ = Describe("Application Summary Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationSummariesBySpace", func() {
+		var (
+			actor                     Actor
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+			filter                    ApplicationSummaryFilter
+
+			summaries  []ApplicationSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil)
+			filter = ApplicationSummaryFilter{}
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{GUID: "web-guid", Name: "web", State: ccv2.ApplicationStarted, Buildpack: "ruby_buildpack"},
+					{GUID: "api-guid", Name: "api", State: ccv2.ApplicationStarted, DetectedBuildpack: "Java Buildpack"},
+					{GUID: "worker-guid", Name: "worker", State: ccv2.ApplicationStopped, Buildpack: "java_buildpack"},
+				},
+				ccv2.Warnings{"apps-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(
+				map[int]ccv2.ApplicationInstanceStatus{0: {ID: 0}},
+				ccv2.Warnings{"stats-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationInstancesByApplicationStub = func(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
+				state := ccv2.ApplicationInstanceRunning
+				if guid == "api-guid" {
+					state = ccv2.ApplicationInstanceCrashed
+				}
+				return map[int]ccv2.ApplicationInstance{0: {ID: 0, State: state}}, ccv2.Warnings{"instances-warning"}, nil
+			}
+			fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv2.Warnings{"routes-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetApplicationSummariesBySpace("some-space-guid", filter)
+		})
+
+		summaryNames := func() []string {
+			var names []string
+			for _, summary := range summaries {
+				names = append(names, summary.Name)
+			}
+			return names
+		}
+
+		Context("when no filter is provided", func() {
+			It("returns every application ordered by name, with instances for started ones", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaryNames()).To(Equal([]string{"api", "web", "worker"}))
+				Expect(summaries[0].RunningInstances).To(HaveLen(1))
+				Expect(summaries[2].RunningInstances).To(BeEmpty())
+				Expect(warnings).To(ConsistOf(
+					"apps-warning",
+					"stats-warning", "instances-warning", "routes-warning",
+					"stats-warning", "instances-warning", "routes-warning",
+					"routes-warning",
+				))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.SpaceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-space-guid",
+				}}))
+				Expect(fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when filtering by the stopped state", func() {
+			BeforeEach(func() {
+				filter.State = "stopped"
+			})
+
+			It("returns the stopped applications without looking up instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaryNames()).To(Equal([]string{"worker"}))
+				Expect(fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when filtering by the started state", func() {
+			BeforeEach(func() {
+				filter.State = "started"
+			})
+
+			It("returns the started applications", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaryNames()).To(Equal([]string{"api", "web"}))
+			})
+		})
+
+		Context("when filtering by the crashed state", func() {
+			BeforeEach(func() {
+				filter.State = "crashed"
+			})
+
+			It("returns the applications with crashed instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaryNames()).To(Equal([]string{"api"}))
+				Expect(fakeCloudControllerClient.GetApplicationRoutesCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when filtering by buildpack", func() {
+			BeforeEach(func() {
+				filter.Buildpack = "JAVA"
+			})
+
+			It("matches part of the set or detected buildpack case insensitively", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaryNames()).To(Equal([]string{"api", "worker"}))
+			})
+		})
+
+		Context("when filtering by name", func() {
+			BeforeEach(func() {
+				filter.NamePattern = regexp.MustCompile("^w")
+			})
+
+			It("returns the applications whose name matches", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaryNames()).To(Equal([]string{"web", "worker"}))
+			})
+		})
+
+		Context("when getting the applications fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("apps-warning"))
+			})
+		})
+
+		Context("when getting instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(nil, ccv2.Warnings{"stats-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("apps-warning", "stats-warning"))
+			})
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package v2action_test
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 
 	"github.com/cloudfoundry/sonde-go/events"
 	. "github.com/onsi/ginkgo"
@@ -262,6 +264,62 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("ScaleApplication", func() {
+		var (
+			scale ApplicationScale
+
+			app      Application
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			scale = ApplicationScale{
+				Instances: types.NullInt{IsSet: true, Value: 3},
+				Memory:    256,
+			}
+		})
+
+		JustBeforeEach(func() {
+			app, warnings, err = actor.ScaleApplication("some-app-guid", scale)
+		})
+
+		Context("when the scale is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ScaleApplicationReturns(
+					ccv2.Application{GUID: "some-app-guid", Instances: 3, Memory: 256},
+					ccv2.Warnings{"scale-warning"},
+					nil,
+				)
+			})
+
+			It("scales and returns the application", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("scale-warning"))
+				Expect(app).To(Equal(Application{GUID: "some-app-guid", Instances: 3, Memory: 256}))
+
+				Expect(fakeCloudControllerClient.ScaleApplicationCallCount()).To(Equal(1))
+				appGUID, passedScale := fakeCloudControllerClient.ScaleApplicationArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(passedScale).To(Equal(ccv2.ApplicationScale(scale)))
+			})
+		})
+
+		Context("when the client returns back an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some scale error")
+				fakeCloudControllerClient.ScaleApplicationReturns(ccv2.Application{}, ccv2.Warnings{"scale-warning"}, expectedErr)
+			})
+
+			It("returns warnings and the error", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("scale-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		Context("when the application exists", func() {
 			BeforeEach(func() {
@@ -587,7 +645,7 @@ var _ = Describe("Application Actions", func() {
 		})
 
 		It("starts and polls for an app instance", func() {
-			messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+			messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 			Eventually(warnings).Should(Receive(Equal("update-warning")))
 			Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
@@ -619,7 +677,7 @@ var _ = Describe("Application Actions", func() {
 			})
 
 			It("starts and only polls for staging to finish", func() {
-				messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+				messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 				Eventually(warnings).Should(Receive(Equal("update-warning")))
 				Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
@@ -648,7 +706,7 @@ var _ = Describe("Application Actions", func() {
 			})
 
 			It("sends the update error and never polls", func() {
-				messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+				messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 				Eventually(warnings).Should(Receive(Equal("update-warning")))
 				Eventually(errs).Should(Receive(MatchError(expectedErr)))
@@ -670,7 +728,7 @@ var _ = Describe("Application Actions", func() {
 				})
 
 				It("sends the error and stops polling", func() {
-					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 					Eventually(warnings).Should(Receive(Equal("update-warning")))
 					Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
@@ -697,7 +755,7 @@ var _ = Describe("Application Actions", func() {
 					})
 
 					It("sends a StagingFailedNoAppDetectedError and stops polling", func() {
-						messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+						messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 						Eventually(warnings).Should(Receive(Equal("update-warning")))
 						Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
@@ -724,7 +782,7 @@ var _ = Describe("Application Actions", func() {
 					})
 
 					It("sends a StagingFailedError and stops polling", func() {
-						messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+						messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 						Eventually(warnings).Should(Receive(Equal("update-warning")))
 						Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
@@ -745,7 +803,7 @@ var _ = Describe("Application Actions", func() {
 				})
 
 				It("sends a timeout error and stops polling", func() {
-					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 					Eventually(warnings).Should(Receive(Equal("update-warning")))
 					Eventually(errs).Should(Receive(MatchError(StagingTimeoutError{Name: "some-app", Timeout: 0})))
@@ -756,6 +814,22 @@ var _ = Describe("Application Actions", func() {
 					Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(0))
 				})
 			})
+
+			Context("when the context is cancelled while staging", func() {
+				It("sends the context error and stops polling", func() {
+					fakeConfig.PollingIntervalReturns(time.Hour)
+					ctx, cancel := context.WithCancel(context.Background())
+					cancel()
+					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(ctx, app, fakeNOAAClient, fakeConfig)
+
+					Eventually(warnings).Should(Receive(Equal("update-warning")))
+					Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
+					Eventually(errs).Should(Receive(Equal(context.Canceled)))
+
+					Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(0))
+				})
+			})
 		})
 
 		Context("starting issues", func() {
@@ -769,7 +843,7 @@ var _ = Describe("Application Actions", func() {
 				})
 
 				It("sends the error and stops polling", func() {
-					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 					Eventually(warnings).Should(Receive(Equal("update-warning")))
 					Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
@@ -789,7 +863,7 @@ var _ = Describe("Application Actions", func() {
 				})
 
 				It("sends a timeout error and stops polling", func() {
-					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 					Eventually(warnings).Should(Receive(Equal("update-warning")))
 					Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
@@ -813,7 +887,7 @@ var _ = Describe("Application Actions", func() {
 				})
 
 				It("returns an ApplicationInstanceCrashedError and stops polling", func() {
-					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 					Eventually(warnings).Should(Receive(Equal("update-warning")))
 					Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
@@ -838,7 +912,7 @@ var _ = Describe("Application Actions", func() {
 				})
 
 				It("returns an ApplicationInstanceFlappingError and stops polling", func() {
-					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(app, fakeNOAAClient, fakeConfig)
+					messages, logErrs, appStarting, warnings, errs = actor.StartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
 
 					Eventually(warnings).Should(Receive(Equal("update-warning")))
 					Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
@@ -855,6 +929,190 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("RestartApplication and RestageApplication", func() {
+		var (
+			app            Application
+			fakeNOAAClient *v2actionfakes.FakeNOAAClient
+			fakeConfig     *v2actionfakes.FakeConfig
+
+			messages    <-chan *LogMessage
+			logErrs     <-chan error
+			appStarting <-chan bool
+			warnings    <-chan string
+			errs        <-chan error
+
+			eventStream chan *events.LogMessage
+			errStream   chan error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.StagingTimeoutReturns(time.Minute)
+			fakeConfig.StartupTimeoutReturns(time.Minute)
+
+			app = Application{
+				GUID:      "some-app-guid",
+				Name:      "some-app",
+				Instances: 2,
+			}
+
+			fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+			fakeNOAAClient.TailingLogsStub = func(_ string, _ string) (<-chan *events.LogMessage, <-chan error) {
+				eventStream = make(chan *events.LogMessage)
+				errStream = make(chan error)
+				return eventStream, errStream
+			}
+
+			closed := false
+			fakeNOAAClient.CloseStub = func() error {
+				if !closed {
+					closed = true
+					close(errStream)
+					close(eventStream)
+				}
+				return nil
+			}
+
+			fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{
+				GUID:         "some-app-guid",
+				Name:         "some-app",
+				Instances:    2,
+				PackageState: ccv2.ApplicationPackageStaged,
+			}, ccv2.Warnings{"app-warnings"}, nil)
+
+			fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(map[int]ccv2.ApplicationInstance{
+				0: {State: ccv2.ApplicationInstanceRunning},
+			}, ccv2.Warnings{"app-instance-warnings"}, nil)
+		})
+
+		AfterEach(func() {
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+			Eventually(appStarting).Should(BeClosed())
+			Eventually(warnings).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		Describe("RestartApplication", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{
+					GUID:      "some-app-guid",
+					Instances: 2,
+					Name:      "some-app",
+				}, ccv2.Warnings{"update-warning"}, nil)
+			})
+
+			Context("when the app is started", func() {
+				BeforeEach(func() {
+					app.State = ccv2.ApplicationStarted
+				})
+
+				It("stops the app, starts it and polls for an app instance", func() {
+					messages, logErrs, appStarting, warnings, errs = actor.RestartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
+
+					Eventually(warnings).Should(Receive(Equal("update-warning")))
+					Eventually(warnings).Should(Receive(Equal("update-warning")))
+					Eventually(warnings).Should(Receive(Equal("app-warnings")))
+					Eventually(appStarting).Should(Receive(BeTrue()))
+					Eventually(warnings).Should(Receive(Equal("app-instance-warnings")))
+
+					Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+						GUID:  "some-app-guid",
+						State: ccv2.ApplicationStopped,
+					}))
+					Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(1)).To(Equal(ccv2.Application{
+						GUID:  "some-app-guid",
+						State: ccv2.ApplicationStarted,
+					}))
+				})
+
+				Context("when stopping the app fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("stop failed")
+						fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-warning"}, expectedErr)
+					})
+
+					It("sends the error and does not start the app", func() {
+						messages, logErrs, appStarting, warnings, errs = actor.RestartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
+
+						Eventually(warnings).Should(Receive(Equal("update-warning")))
+						Eventually(errs).Should(Receive(MatchError(expectedErr)))
+
+						Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the app is stopped", func() {
+				BeforeEach(func() {
+					app.State = ccv2.ApplicationStopped
+				})
+
+				It("only starts the app", func() {
+					messages, logErrs, appStarting, warnings, errs = actor.RestartApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
+
+					Eventually(warnings).Should(Receive(Equal("update-warning")))
+					Eventually(warnings).Should(Receive(Equal("app-warnings")))
+					Eventually(appStarting).Should(Receive(BeTrue()))
+					Eventually(warnings).Should(Receive(Equal("app-instance-warnings")))
+
+					Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+						GUID:  "some-app-guid",
+						State: ccv2.ApplicationStarted,
+					}))
+				})
+			})
+		})
+
+		Describe("RestageApplication", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.RestageApplicationReturns(ccv2.Application{
+					GUID:      "some-app-guid",
+					Instances: 2,
+					Name:      "some-app",
+				}, ccv2.Warnings{"restage-warning"}, nil)
+			})
+
+			It("restages the app and polls for an app instance", func() {
+				messages, logErrs, appStarting, warnings, errs = actor.RestageApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
+
+				Eventually(warnings).Should(Receive(Equal("restage-warning")))
+				Eventually(warnings).Should(Receive(Equal("app-warnings")))
+				Eventually(appStarting).Should(Receive(BeTrue()))
+				Eventually(warnings).Should(Receive(Equal("app-instance-warnings")))
+
+				Expect(fakeCloudControllerClient.RestageApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.RestageApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID: "some-app-guid",
+				}))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+
+			Context("when restaging fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("restage failed")
+					fakeCloudControllerClient.RestageApplicationReturns(ccv2.Application{}, ccv2.Warnings{"restage-warning"}, expectedErr)
+				})
+
+				It("sends the error and never polls", func() {
+					messages, logErrs, appStarting, warnings, errs = actor.RestageApplication(context.Background(), app, fakeNOAAClient, fakeConfig)
+
+					Eventually(warnings).Should(Receive(Equal("restage-warning")))
+					Eventually(errs).Should(Receive(MatchError(expectedErr)))
+
+					Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
 	Describe("SetApplicationHealthCheckTypeByNameAndSpace", func() {
 		Context("when setting an http endpoint with a health check that is not http", func() {
 			It("returns an http health check invalid error", func() {
@@ -1031,4 +1289,126 @@ var _ = Describe("Application Actions", func() {
 			})
 		})
 	})
+
+	Describe("RenameApplicationByNameAndSpace", func() {
+		var (
+			app      Application
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(0,
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv2.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+				[]ccv2.Application{},
+				ccv2.Warnings{"get-new-name-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateApplicationReturns(
+				ccv2.Application{GUID: "some-app-guid", Name: "new-app-name"},
+				ccv2.Warnings{"update-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			app, warnings, err = actor.RenameApplicationByNameAndSpace("some-app", "new-app-name", "some-space-guid")
+		})
+
+		Context("when the new name is free", func() {
+			It("renames the app and returns all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(app).To(Equal(Application{GUID: "some-app-guid", Name: "new-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-new-name-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(1)).To(ConsistOf(
+					ccv2.Query{Filter: ccv2.NameFilter, Operator: ccv2.EqualOperator, Value: "new-app-name"},
+					ccv2.Query{Filter: ccv2.SpaceGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-space-guid"},
+				))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID: "some-app-guid",
+					Name: "new-app-name",
+				}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []ccv2.Application{}, ccv2.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when another app already has the new name", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+					[]ccv2.Application{{GUID: "other-app-guid", Name: "new-app-name"}},
+					ccv2.Warnings{"get-new-name-warning"},
+					nil,
+				)
+			})
+
+			It("returns an ApplicationNameTakenError without renaming", func() {
+				Expect(err).To(MatchError(ApplicationNameTakenError{Name: "new-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-new-name-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the new name only matches the app itself", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+					[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("renames the app", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the Cloud Controller reports the name as taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationReturns(
+					ccv2.Application{},
+					ccv2.Warnings{"update-warning"},
+					ccerror.ApplicationNameTakenError{Message: "The app name is taken: new-app-name"},
+				)
+			})
+
+			It("returns an ApplicationNameTakenError", func() {
+				Expect(err).To(MatchError(ApplicationNameTakenError{Name: "new-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-new-name-warning", "update-warning"))
+			})
+		})
+
+		Context("when checking the new name fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, nil, ccv2.Warnings{"get-new-name-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-new-name-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
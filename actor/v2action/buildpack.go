@@ -0,0 +1,21 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// Buildpack represents a buildpack that applications can be staged with.
+type Buildpack ccv2.Buildpack
+
+// GetBuildpacks returns all the buildpacks.
+func (actor Actor) GetBuildpacks() ([]Buildpack, Warnings, error) {
+	ccv2Buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	buildpacks := make([]Buildpack, len(ccv2Buildpacks))
+	for i, ccv2Buildpack := range ccv2Buildpacks {
+		buildpacks[i] = Buildpack(ccv2Buildpack)
+	}
+
+	return buildpacks, Warnings(warnings), nil
+}
@@ -0,0 +1,67 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetBuildpacks", func() {
+		Context("when the buildpacks are retrieved", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(
+					[]ccv2.Buildpack{
+						{GUID: "buildpack-guid-1", Name: "ruby_buildpack", Stack: "cflinuxfs2", Enabled: true},
+						{GUID: "buildpack-guid-2", Name: "go_buildpack"},
+					},
+					ccv2.Warnings{"get-buildpacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns the buildpacks and warnings", func() {
+				buildpacks, warnings, err := actor.GetBuildpacks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buildpacks).To(Equal([]Buildpack{
+					{GUID: "buildpack-guid-1", Name: "ruby_buildpack", Stack: "cflinuxfs2", Enabled: true},
+					{GUID: "buildpack-guid-2", Name: "go_buildpack"},
+				}))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+
+				Expect(fakeCloudControllerClient.GetBuildpacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when retrieving the buildpacks errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv2.Warnings{"get-buildpacks-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetBuildpacks()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+			})
+		})
+	})
+})
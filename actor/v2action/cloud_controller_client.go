@@ -10,46 +10,94 @@ type CloudControllerClient interface {
 	BindRouteToApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
 	CheckRoute(route ccv2.Route) (bool, ccv2.Warnings, error)
 	CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	CreateOrganization(orgName string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
 	CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
+	CreateRouteMapping(appGUID string, routeGUID string, appPort int) (ccv2.RouteMapping, ccv2.Warnings, error)
+	CreateSecurityGroup(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	CreateServiceBinding(appGUID string, serviceInstanceGUID string) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	CreateServiceInstance(spaceGUID string, servicePlanGUID string, name string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	CreateSpace(spaceName string, orgGUID string, spaceQuotaGUID string) (ccv2.Space, ccv2.Warnings, error)
 	CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
+	CreateUserProvidedServiceInstance(spaceGUID string, name string) (ccv2.ServiceInstance, ccv2.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
-	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
+	DeleteRoute(routeGUID string) (ccv2.Job, ccv2.Warnings, error)
+	DeleteRouteMapping(routeMappingGUID string) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error)
+	DeleteSpace(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error)
 	GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error)
 	GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
 	GetApplicationRoutes(appGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	GetApplications(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
+	GetBuildpacks(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	GetConfigFeatureFlag(name string) (ccv2.FeatureFlag, ccv2.Warnings, error)
+	GetEvents(queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error)
+	GetOrganizationQuotas(queries []ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	GetRecentEvents(queries []ccv2.Query, limit int) ([]ccv2.Event, ccv2.Warnings, error)
 	GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error)
 	GetOrganizationPrivateDomains(orgGUID string, queries []ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
 	GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error)
 	GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
+	GetOrganizationsByPage(queries []ccv2.Query, handlePage func([]ccv2.Organization) error) (ccv2.Warnings, error)
 	GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetRouteApplications(routeGUID string, queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
+	GetRouteCount(queries []ccv2.Query) (int, ccv2.Warnings, error)
+	GetRouteMappings(queries []ccv2.Query) ([]ccv2.RouteMapping, ccv2.Warnings, error)
 	GetRoutes(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
+	GetSecurityGroupSpaces(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
+	GetSecurityGroupStagingSpaces(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
 	GetSecurityGroups(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetServiceBindings(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
+	GetServiceBrokers(queries []ccv2.Query) ([]ccv2.ServiceBroker, ccv2.Warnings, error)
+	GetService(serviceGUID string) (ccv2.Service, ccv2.Warnings, error)
+	GetServiceInstanceParameters(serviceInstanceGUID string) (map[string]interface{}, ccv2.Warnings, error)
+	GetServiceInstanceSharedFrom(serviceInstanceGUID string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error)
+	GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error)
 	GetServiceInstances(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
+	GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
+	GetServicePlans(queries []ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error)
+	GetServicePlanVisibilities(queries []ccv2.Query) ([]ccv2.ServicePlanVisibility, ccv2.Warnings, error)
+	GetServices(queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
 	GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetSharedDomains() ([]ccv2.Domain, ccv2.Warnings, error)
+	GetSpace(guid string) (ccv2.Space, ccv2.Warnings, error)
 	GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	GetSpaceQuotas(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
 	GetSpaceRoutes(spaceGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetSpaces(queries []ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error)
+	GetSpacesByPage(queries []ccv2.Query, handlePage func([]ccv2.Space) error) (ccv2.Warnings, error)
 	GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
+	GetSpaceServices(spaceGUID string, queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
 	GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error)
+	GetStacks(queries []ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error)
 	PollJob(job ccv2.Job) (ccv2.Warnings, error)
+	PurgeService(serviceGUID string) (ccv2.Warnings, error)
+	PurgeServiceInstance(serviceInstanceGUID string) (ccv2.Warnings, error)
 	RemoveSpaceFromSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
+	RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	ScaleApplication(appGUID string, scale ccv2.ApplicationScale) (ccv2.Application, ccv2.Warnings, error)
 	TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error)
+	UnbindRouteFromApplication(routeGUID string, appGUID string) (ccv2.Warnings, error)
 	UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	UpdateOrganizationName(orgGUID string, newName string) (ccv2.Organization, ccv2.Warnings, error)
+	UpdateOrganizationUserRoleByUsername(role ccv2.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error)
+	UpdateSecurityGroupRules(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	UpdateServiceInstanceName(serviceInstanceGUID string, newName string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	UpdateSpaceName(spaceGUID string, newName string) (ccv2.Space, ccv2.Warnings, error)
+	UpdateUserProvidedServiceInstanceName(serviceInstanceGUID string, newName string) (ccv2.ServiceInstance, ccv2.Warnings, error)
 
 	API() string
 	APIVersion() string
+	AppSSHEndpoint() string
+	AppSSHHostKeyFingerprint() string
+	AppSSHOAuthClient() string
 	AuthorizationEndpoint() string
 	DopplerEndpoint() string
 	MinCLIVersion() string
 	RoutingEndpoint() string
 	TokenEndpoint() string
+	UpdateSpaceUserRoleByUsername(role ccv2.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error)
 }
@@ -6,11 +6,16 @@ import "time"
 
 type Config interface {
 	PollingInterval() time.Duration
+	RefreshToken() string
+	SaveTargetSession()
+	SetAccessToken(token string)
+	SetRefreshToken(token string)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SkipSSLValidation() bool
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
+	TakeTargetSession(api string) (string, string)
 	Target() string
 	UnsetOrganizationInformation()
 	UnsetSpaceInformation()
@@ -0,0 +1,68 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ApplicationContainerMetrics is the resource usage of all the reporting
+// instances of an application.
+type ApplicationContainerMetrics struct {
+	Application
+
+	// CPU is the sum of the CPU utilization percentages of the instances.
+	CPU float64
+
+	// Disk is the sum of the disk usage of the instances in bytes.
+	Disk uint64
+
+	// Memory is the sum of the memory usage of the instances in bytes.
+	Memory uint64
+
+	// ReportingInstances is the number of instances that reported metrics.
+	ReportingInstances int
+}
+
+// GetContainerMetricsBySpace returns the aggregated container metrics of
+// every started application in the space, sorted by application name.
+// Applications that are not started are included without metrics.
+func (actor Actor) GetContainerMetricsBySpace(spaceGUID string, client NOAAClient) ([]ApplicationContainerMetrics, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	sort.Slice(apps, func(i int, j int) bool {
+		return apps[i].Name < apps[j].Name
+	})
+
+	var allMetrics []ApplicationContainerMetrics
+	for _, app := range apps {
+		appMetrics := ApplicationContainerMetrics{Application: app}
+
+		if app.State == ccv2.ApplicationStarted {
+			containerMetrics, err := client.ContainerMetrics(app.GUID, "")
+			if err != nil {
+				return nil, warnings, err
+			}
+
+			reported := map[int32]bool{}
+			for _, metric := range containerMetrics {
+				if reported[metric.GetInstanceIndex()] {
+					continue
+				}
+				reported[metric.GetInstanceIndex()] = true
+
+				appMetrics.CPU += metric.GetCpuPercentage()
+				appMetrics.Disk += metric.GetDiskBytes()
+				appMetrics.Memory += metric.GetMemoryBytes()
+				appMetrics.ReportingInstances++
+			}
+		}
+
+		allMetrics = append(allMetrics, appMetrics)
+	}
+
+	return allMetrics, warnings, nil
+}
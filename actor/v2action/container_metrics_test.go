@@ -0,0 +1,126 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"github.com/cloudfoundry/sonde-go/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Container Metrics Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeNOAAClient            *v2actionfakes.FakeNOAAClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetContainerMetricsBySpace", func() {
+		var (
+			metrics    []ApplicationContainerMetrics
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			metrics, warnings, executeErr = actor.GetContainerMetricsBySpace("some-space-guid", fakeNOAAClient)
+		})
+
+		Context("when getting the applications fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-apps-error")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, expectedErr)
+			})
+
+			It("returns the warnings and the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("apps-warning"))
+				Expect(fakeNOAAClient.ContainerMetricsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space has applications", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv2.Application{
+					{GUID: "web-guid", Name: "web", State: ccv2.ApplicationStarted},
+					{GUID: "api-guid", Name: "api", State: ccv2.ApplicationStarted},
+					{GUID: "worker-guid", Name: "worker", State: ccv2.ApplicationStopped},
+				}, ccv2.Warnings{"apps-warning"}, nil)
+			})
+
+			Context("when getting the container metrics fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-metrics-error")
+					fakeNOAAClient.ContainerMetricsReturns(nil, expectedErr)
+				})
+
+				It("returns the warnings and the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("apps-warning"))
+				})
+			})
+
+			Context("when the container metrics are retrieved", func() {
+				BeforeEach(func() {
+					fakeNOAAClient.ContainerMetricsStub = func(appGUID string, _ string) ([]*events.ContainerMetric, error) {
+						if appGUID == "api-guid" {
+							return []*events.ContainerMetric{
+								newContainerMetric(0, 10.5, 100, 1000),
+								newContainerMetric(1, 20, 200, 2000),
+								newContainerMetric(1, 99, 999, 9999),
+							}, nil
+						}
+						return []*events.ContainerMetric{newContainerMetric(0, 1, 10, 100)}, nil
+					}
+				})
+
+				It("aggregates the metrics of each started application's instances", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("apps-warning"))
+
+					Expect(metrics).To(HaveLen(3))
+					Expect(metrics[0].Name).To(Equal("api"))
+					Expect(metrics[0].CPU).To(Equal(30.5))
+					Expect(metrics[0].Memory).To(Equal(uint64(300)))
+					Expect(metrics[0].Disk).To(Equal(uint64(3000)))
+					Expect(metrics[0].ReportingInstances).To(Equal(2))
+
+					Expect(metrics[1].Name).To(Equal("web"))
+					Expect(metrics[1].CPU).To(Equal(float64(1)))
+					Expect(metrics[1].ReportingInstances).To(Equal(1))
+
+					Expect(metrics[2].Name).To(Equal("worker"))
+					Expect(metrics[2].ReportingInstances).To(Equal(0))
+
+					Expect(fakeNOAAClient.ContainerMetricsCallCount()).To(Equal(2))
+					appGUID, _ := fakeNOAAClient.ContainerMetricsArgsForCall(0)
+					Expect(appGUID).To(Equal("api-guid"))
+					appGUID, _ = fakeNOAAClient.ContainerMetricsArgsForCall(1)
+					Expect(appGUID).To(Equal("web-guid"))
+				})
+			})
+		})
+	})
+})
+
+func newContainerMetric(index int32, cpu float64, memory uint64, disk uint64) *events.ContainerMetric {
+	return &events.ContainerMetric{
+		InstanceIndex: &index,
+		CpuPercentage: &cpu,
+		MemoryBytes:   &memory,
+		DiskBytes:     &disk,
+	}
+}
@@ -0,0 +1,104 @@
+package v2action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"github.com/cloudfoundry/noaa"
+)
+
+const (
+	// CrashDiagnosticsMaxEvents is the number of most recent crash events
+	// included in CrashDiagnostics.
+	CrashDiagnosticsMaxEvents = 5
+
+	// CrashDiagnosticsMaxLogLines is the number of most recent log lines
+	// included in CrashDiagnostics.
+	CrashDiagnosticsMaxLogLines = 50
+)
+
+// CrashEvent is a single app.crash event recorded by the Cloud Controller.
+type CrashEvent struct {
+	Timestamp       time.Time
+	InstanceIndex   int
+	ExitDescription string
+	Reason          string
+}
+
+// CrashDiagnostics collects information that helps explain why an
+// application failed to start.
+type CrashDiagnostics struct {
+	// CrashEvents are the most recent crash events, newest first.
+	CrashEvents []CrashEvent
+
+	// RecentLogs are the most recent log lines, oldest first.
+	RecentLogs []LogMessage
+}
+
+// LastCrash returns the most recent crash event, if any.
+func (diagnostics CrashDiagnostics) LastCrash() (CrashEvent, bool) {
+	if len(diagnostics.CrashEvents) == 0 {
+		return CrashEvent{}, false
+	}
+	return diagnostics.CrashEvents[0], true
+}
+
+// GetApplicationCrashDiagnostics returns the recent crash events and log lines
+// of the application with the provided GUID.
+func (actor Actor) GetApplicationCrashDiagnostics(appGUID string, client NOAAClient) (CrashDiagnostics, Warnings, error) {
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents([]ccv2.Query{
+		{
+			Filter:   ccv2.ActeeFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    appGUID,
+		},
+		{
+			Filter:   ccv2.TypeFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    string(ccv2.ApplicationCrashEvent),
+		},
+	})
+	if err != nil {
+		return CrashDiagnostics{}, Warnings(warnings), err
+	}
+
+	sort.Slice(ccEvents, func(i int, j int) bool {
+		return ccEvents[i].Timestamp.After(ccEvents[j].Timestamp)
+	})
+	if len(ccEvents) > CrashDiagnosticsMaxEvents {
+		ccEvents = ccEvents[:CrashDiagnosticsMaxEvents]
+	}
+
+	var diagnostics CrashDiagnostics
+	for _, event := range ccEvents {
+		diagnostics.CrashEvents = append(diagnostics.CrashEvents, CrashEvent{
+			Timestamp:       event.Timestamp,
+			InstanceIndex:   event.InstanceIndex,
+			ExitDescription: event.ExitDescription,
+			Reason:          event.Reason,
+		})
+	}
+
+	noaaMessages, err := client.RecentLogs(appGUID, "")
+	if err != nil {
+		return diagnostics, Warnings(warnings), err
+	}
+
+	noaaMessages = noaa.SortRecent(noaaMessages)
+	if len(noaaMessages) > CrashDiagnosticsMaxLogLines {
+		noaaMessages = noaaMessages[len(noaaMessages)-CrashDiagnosticsMaxLogLines:]
+	}
+
+	for _, message := range noaaMessages {
+		diagnostics.RecentLogs = append(diagnostics.RecentLogs, LogMessage{
+			message:        string(message.GetMessage()),
+			messageType:    message.GetMessageType(),
+			timestamp:      time.Unix(0, message.GetTimestamp()),
+			sourceType:     message.GetSourceType(),
+			sourceInstance: message.GetSourceInstance(),
+		})
+	}
+
+	return diagnostics, Warnings(warnings), nil
+}
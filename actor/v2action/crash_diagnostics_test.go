@@ -0,0 +1,153 @@
+package v2action_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"github.com/cloudfoundry/sonde-go/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Crash Diagnostics Actions", func() {
+	var (
+		actor                     Actor
+		fakeNOAAClient            *v2actionfakes.FakeNOAAClient
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationCrashDiagnostics", func() {
+		var (
+			diagnostics CrashDiagnostics
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			diagnostics, warnings, executeErr = actor.GetApplicationCrashDiagnostics("some-app-guid", fakeNOAAClient)
+		})
+
+		Context("when the events and logs can be retrieved", func() {
+			BeforeEach(func() {
+				var ccEvents []ccv2.Event
+				for i := 0; i < CrashDiagnosticsMaxEvents+1; i++ {
+					ccEvents = append(ccEvents, ccv2.Event{
+						Type:            ccv2.ApplicationCrashEvent,
+						Timestamp:       time.Unix(int64(i), 0),
+						InstanceIndex:   i,
+						ExitDescription: fmt.Sprintf("exit-%d", i),
+						Reason:          "CRASHED",
+					})
+				}
+				fakeCloudControllerClient.GetEventsReturns(ccEvents, ccv2.Warnings{"events-warning"}, nil)
+
+				outMessage := events.LogMessage_OUT
+				sourceType := "APP/PROC/WEB"
+				sourceInstance := "0"
+				var messages []*events.LogMessage
+				for i := CrashDiagnosticsMaxLogLines + 9; i >= 0; i-- {
+					ts := int64(i)
+					messages = append(messages, &events.LogMessage{
+						Message:        []byte(fmt.Sprintf("message-%d", i)),
+						MessageType:    &outMessage,
+						Timestamp:      &ts,
+						SourceType:     &sourceType,
+						SourceInstance: &sourceInstance,
+					})
+				}
+				fakeNOAAClient.RecentLogsReturns(messages, nil)
+			})
+
+			It("queries the app's crash events", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("events-warning"))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(Equal([]ccv2.Query{
+					{
+						Filter:   ccv2.ActeeFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-app-guid",
+					},
+					{
+						Filter:   ccv2.TypeFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "app.crash",
+					},
+				}))
+
+				appGUID, _ := fakeNOAAClient.RecentLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+
+			It("returns the most recent crash events, newest first", func() {
+				Expect(diagnostics.CrashEvents).To(HaveLen(CrashDiagnosticsMaxEvents))
+				Expect(diagnostics.CrashEvents[0]).To(Equal(CrashEvent{
+					Timestamp:       time.Unix(int64(CrashDiagnosticsMaxEvents), 0),
+					InstanceIndex:   CrashDiagnosticsMaxEvents,
+					ExitDescription: fmt.Sprintf("exit-%d", CrashDiagnosticsMaxEvents),
+					Reason:          "CRASHED",
+				}))
+
+				lastCrash, ok := diagnostics.LastCrash()
+				Expect(ok).To(BeTrue())
+				Expect(lastCrash).To(Equal(diagnostics.CrashEvents[0]))
+			})
+
+			It("returns the last log lines, oldest first", func() {
+				Expect(diagnostics.RecentLogs).To(HaveLen(CrashDiagnosticsMaxLogLines))
+				Expect(diagnostics.RecentLogs[0].Message()).To(Equal("message-10"))
+				Expect(diagnostics.RecentLogs[CrashDiagnosticsMaxLogLines-1].Message()).To(Equal(fmt.Sprintf("message-%d", CrashDiagnosticsMaxLogLines+9)))
+			})
+		})
+
+		Context("when there are no crash events", func() {
+			It("returns no last crash", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, ok := diagnostics.LastCrash()
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		Context("when getting the events returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("events error")
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv2.Warnings{"events-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings without fetching logs", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("events-warning"))
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the recent logs returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns([]ccv2.Event{{InstanceIndex: 1}}, ccv2.Warnings{"events-warning"}, nil)
+				expectedErr = errors.New("logs error")
+				fakeNOAAClient.RecentLogsReturns(nil, expectedErr)
+			})
+
+			It("returns the crash events along with the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("events-warning"))
+				Expect(diagnostics.CrashEvents).To(HaveLen(1))
+			})
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package v2action
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
@@ -10,10 +12,15 @@ type Domain ccv2.Domain
 
 // DomainNotFoundError is an error wrapper that represents the case
 // when the domain is not found.
-type DomainNotFoundError struct{}
+type DomainNotFoundError struct {
+	Name string
+}
 
 // Error method to display the error message.
 func (e DomainNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Domain %s not found.", e.Name)
+	}
 	return "Domain not found."
 }
 
@@ -108,3 +115,68 @@ func (actor Actor) GetOrganizationDomains(orgGUID string) ([]Domain, Warnings, e
 
 	return allDomains, allWarnings, nil
 }
+
+// DomainType describes who can use a domain.
+type DomainType string
+
+const (
+	SharedDomainType   DomainType = "shared"
+	PrivateDomainType  DomainType = "private"
+	InternalDomainType DomainType = "internal"
+)
+
+// DomainSummary represents a domain along with its type and the number of
+// routes of the organization that use it.
+type DomainSummary struct {
+	Domain
+	Type   DomainType
+	Routes int
+}
+
+// GetOrganizationDomainSummaries returns the summaries of the shared and
+// private domains available to the organization. Shared domains are listed
+// before private ones. The routes of each domain are counted in parallel,
+// running at most FoundationTreeMaxParallelRequests domains at the same time.
+func (actor Actor) GetOrganizationDomainSummaries(orgGUID string) ([]DomainSummary, Warnings, error) {
+	var (
+		allWarnings Warnings
+		summaries   []DomainSummary
+	)
+
+	sharedDomains, warnings, err := actor.CloudControllerClient.GetSharedDomains()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	for _, domain := range sharedDomains {
+		summary := DomainSummary{Domain: Domain(domain), Type: SharedDomainType}
+		if domain.Internal {
+			summary.Type = InternalDomainType
+		}
+		summaries = append(summaries, summary)
+	}
+
+	privateDomains, warnings, err := actor.CloudControllerClient.GetOrganizationPrivateDomains(orgGUID, nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	for _, domain := range privateDomains {
+		summaries = append(summaries, DomainSummary{Domain: Domain(domain), Type: PrivateDomainType})
+	}
+
+	routeWarnings, err := parallelize(len(summaries), func(i int) (Warnings, error) {
+		count, warnings, err := actor.CloudControllerClient.GetRouteCount([]ccv2.Query{
+			{Filter: ccv2.OrganizationGUIDFilter, Operator: ccv2.EqualOperator, Value: orgGUID},
+			{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: summaries[i].GUID},
+		})
+		summaries[i].Routes = count
+		return Warnings(warnings), err
+	})
+	allWarnings = append(allWarnings, routeWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	return summaries, allWarnings, nil
+}
@@ -303,4 +303,109 @@ var _ = Describe("Domain Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationDomainSummaries", func() {
+		var (
+			summaries  []DomainSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSharedDomainsReturns(
+				[]ccv2.Domain{
+					{GUID: "shared-domain-guid", Name: "shared.com"},
+					{GUID: "tcp-domain-guid", Name: "tcp.shared.com", RouterGroupGUID: "router-group-guid", RouterGroupType: "tcp"},
+					{GUID: "internal-domain-guid", Name: "apps.internal", Internal: true},
+				},
+				ccv2.Warnings{"shared-domains-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(
+				[]ccv2.Domain{{GUID: "private-domain-guid", Name: "private.com"}},
+				ccv2.Warnings{"private-domains-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRouteCountStub = func(queries []ccv2.Query) (int, ccv2.Warnings, error) {
+				if queries[1].Value == "shared-domain-guid" {
+					return 2, ccv2.Warnings{"routes-warning"}, nil
+				}
+				return 0, ccv2.Warnings{"routes-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetOrganizationDomainSummaries("some-org-guid")
+		})
+
+		It("returns the shared domains followed by the private domains with their route counts", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"shared-domains-warning", "private-domains-warning",
+				"routes-warning", "routes-warning", "routes-warning", "routes-warning",
+			))
+			Expect(summaries).To(Equal([]DomainSummary{
+				{Domain: Domain{GUID: "shared-domain-guid", Name: "shared.com"}, Type: SharedDomainType, Routes: 2},
+				{Domain: Domain{GUID: "tcp-domain-guid", Name: "tcp.shared.com", RouterGroupGUID: "router-group-guid", RouterGroupType: "tcp"}, Type: SharedDomainType},
+				{Domain: Domain{GUID: "internal-domain-guid", Name: "apps.internal", Internal: true}, Type: InternalDomainType},
+				{Domain: Domain{GUID: "private-domain-guid", Name: "private.com"}, Type: PrivateDomainType},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationPrivateDomainsCallCount()).To(Equal(1))
+			orgGUID, _ := fakeCloudControllerClient.GetOrganizationPrivateDomainsArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			Expect(fakeCloudControllerClient.GetRouteCountCallCount()).To(Equal(4))
+			Expect(fakeCloudControllerClient.GetRouteCountArgsForCall(0)).To(ContainElement(ccv2.Query{
+				Filter:   ccv2.OrganizationGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "some-org-guid",
+			}))
+		})
+
+		Context("when getting the shared domains fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("shared-domains-error")
+				fakeCloudControllerClient.GetSharedDomainsReturns(nil, ccv2.Warnings{"shared-domains-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("shared-domains-warning"))
+				Expect(fakeCloudControllerClient.GetOrganizationPrivateDomainsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the private domains fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("private-domains-error")
+				fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(nil, ccv2.Warnings{"private-domains-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("shared-domains-warning", "private-domains-warning"))
+				Expect(fakeCloudControllerClient.GetRouteCountCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when counting the routes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("routes-error")
+				fakeCloudControllerClient.GetRouteCountStub = nil
+				fakeCloudControllerClient.GetRouteCountReturns(0, ccv2.Warnings{"routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("routes-warning"))
+			})
+		})
+	})
 })
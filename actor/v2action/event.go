@@ -0,0 +1,187 @@
+package v2action
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// RecentEventsLimit is the maximum number of events returned by
+// GetRecentApplicationEvents.
+const RecentEventsLimit = 50
+
+// describedMetadataKeys are the event metadata keys included, in this order,
+// in an event's description.
+var describedMetadataKeys = []string{
+	"index",
+	"reason",
+	"exit_description",
+	"exit_status",
+	"recursive",
+	"disk_quota",
+	"instances",
+	"memory",
+	"state",
+	"command",
+	"environment_json",
+}
+
+// Event represents an audit event of an application.
+type Event struct {
+	GUID      string
+	Type      string
+	Timestamp time.Time
+
+	// Actor is the name of the user or process that triggered the event, or
+	// its GUID when no name was recorded.
+	Actor string
+
+	// Description summarizes the metadata of the event.
+	Description string
+
+	// Metadata contains the event type specific details of the event.
+	Metadata map[string]interface{}
+}
+
+// GetRecentApplicationEvents returns, newest first, the most recent events of
+// the application with the provided GUID.
+func (actor Actor) GetRecentApplicationEvents(appGUID string) ([]Event, Warnings, error) {
+	ccEvents, warnings, err := actor.CloudControllerClient.GetRecentEvents([]ccv2.Query{
+		{
+			Filter:   ccv2.ActeeFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    appGUID,
+		},
+	}, RecentEventsLimit)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []Event
+	for _, ccEvent := range ccEvents {
+		events = append(events, newEvent(ccEvent))
+	}
+	return events, Warnings(warnings), nil
+}
+
+// GetStreamingApplicationEvents polls for events of the application with the
+// provided GUID that are newer than the provided events, and sends them
+// oldest first as they are recorded. When no events are provided, only
+// events recorded from now on are sent. Polling stops and all channels are
+// closed after the first error.
+func (actor Actor) GetStreamingApplicationEvents(appGUID string, previousEvents []Event, config Config) (<-chan Event, <-chan string, <-chan error) {
+	events := make(chan Event)
+	warnings := make(chan string)
+	errs := make(chan error)
+
+	// Timestamps are only precise to the second, so the events already sent
+	// at the cursor are tracked to avoid sending them twice.
+	cursor := time.Now().UTC().Truncate(time.Second)
+	sentAtCursor := map[string]bool{}
+	if len(previousEvents) > 0 {
+		cursor = previousEvents[0].Timestamp
+		for _, event := range previousEvents {
+			if event.Timestamp.After(cursor) {
+				cursor = event.Timestamp
+			}
+		}
+		for _, event := range previousEvents {
+			if event.Timestamp.Equal(cursor) {
+				sentAtCursor[event.GUID] = true
+			}
+		}
+	}
+
+	go func() {
+		defer close(events)
+		defer close(warnings)
+		defer close(errs)
+
+		for {
+			time.Sleep(config.PollingInterval())
+
+			ccEvents, ccWarnings, err := actor.CloudControllerClient.GetEvents([]ccv2.Query{
+				{
+					Filter:   ccv2.ActeeFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    appGUID,
+				},
+				{
+					Filter:   ccv2.TimestampFilter,
+					Operator: ccv2.GreaterThanOrEqualOperator,
+					Value:    cursor.UTC().Format(time.RFC3339),
+				},
+			})
+			for _, warning := range ccWarnings {
+				warnings <- warning
+			}
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			sort.SliceStable(ccEvents, func(i int, j int) bool {
+				return ccEvents[i].Timestamp.Before(ccEvents[j].Timestamp)
+			})
+
+			for _, ccEvent := range ccEvents {
+				if ccEvent.Timestamp.After(cursor) {
+					cursor = ccEvent.Timestamp
+					sentAtCursor = map[string]bool{}
+				}
+				if sentAtCursor[ccEvent.GUID] {
+					continue
+				}
+				sentAtCursor[ccEvent.GUID] = true
+				events <- newEvent(ccEvent)
+			}
+		}
+	}()
+
+	return events, warnings, errs
+}
+
+func newEvent(ccEvent ccv2.Event) Event {
+	eventActor := ccEvent.ActorName
+	if eventActor == "" {
+		eventActor = ccEvent.ActorGUID
+	}
+
+	metadata := ccEvent.Metadata
+	if request, ok := metadata["request"].(map[string]interface{}); ok {
+		metadata = request
+	}
+
+	var descriptionParts []string
+	for _, key := range describedMetadataKeys {
+		if value, ok := metadata[key]; ok && value != nil {
+			descriptionParts = append(descriptionParts, fmt.Sprintf("%s: %s", key, formatMetadataValue(value)))
+		}
+	}
+
+	return Event{
+		GUID:        ccEvent.GUID,
+		Type:        string(ccEvent.Type),
+		Timestamp:   ccEvent.Timestamp,
+		Actor:       eventActor,
+		Description: strings.Join(descriptionParts, ", "),
+		Metadata:    ccEvent.Metadata,
+	}
+}
+
+func formatMetadataValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,200 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetRecentApplicationEvents", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRecentEventsReturns(
+					[]ccv2.Event{
+						{
+							GUID:      "event-guid-2",
+							Type:      "audit.app.update",
+							ActorName: "some-user",
+							Timestamp: time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC),
+							Metadata: map[string]interface{}{
+								"request": map[string]interface{}{
+									"instances": float64(2),
+									"state":     "STARTED",
+									"unknown":   "ignored",
+								},
+							},
+						},
+						{
+							GUID:      "event-guid-1",
+							Type:      ccv2.ApplicationCrashEvent,
+							ActorGUID: "some-app-guid",
+							Timestamp: time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC),
+							Metadata: map[string]interface{}{
+								"index":            float64(0),
+								"exit_description": "out of memory",
+							},
+						},
+					},
+					ccv2.Warnings{"events-warning"},
+					nil,
+				)
+			})
+
+			It("returns the events with their descriptions and all warnings", func() {
+				events, warnings, err := actor.GetRecentApplicationEvents("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("events-warning"))
+				Expect(events).To(Equal([]Event{
+					{
+						GUID:        "event-guid-2",
+						Type:        "audit.app.update",
+						Actor:       "some-user",
+						Timestamp:   time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC),
+						Description: "instances: 2, state: STARTED",
+						Metadata: map[string]interface{}{
+							"request": map[string]interface{}{
+								"instances": float64(2),
+								"state":     "STARTED",
+								"unknown":   "ignored",
+							},
+						},
+					},
+					{
+						GUID:        "event-guid-1",
+						Type:        "app.crash",
+						Actor:       "some-app-guid",
+						Timestamp:   time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC),
+						Description: "index: 0, exit_description: out of memory",
+						Metadata: map[string]interface{}{
+							"index":            float64(0),
+							"exit_description": "out of memory",
+						},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetRecentEventsCallCount()).To(Equal(1))
+				queries, limit := fakeCloudControllerClient.GetRecentEventsArgsForCall(0)
+				Expect(queries).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.ActeeFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-app-guid",
+				}}))
+				Expect(limit).To(Equal(RecentEventsLimit))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("events-error")
+				fakeCloudControllerClient.GetRecentEventsReturns(nil, ccv2.Warnings{"events-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetRecentApplicationEvents("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("events-warning"))
+			})
+		})
+	})
+
+	Describe("GetStreamingApplicationEvents", func() {
+		var (
+			fakeConfig     *v2actionfakes.FakeConfig
+			previousEvents []Event
+			expectedErr    error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.PollingIntervalReturns(time.Millisecond)
+			expectedErr = errors.New("events-error")
+
+			previousEvents = []Event{
+				{GUID: "event-guid-2", Timestamp: time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC)},
+				{GUID: "event-guid-1", Timestamp: time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC)},
+			}
+
+			fakeCloudControllerClient.GetEventsStub = func(_ []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error) {
+				switch fakeCloudControllerClient.GetEventsCallCount() {
+				case 1:
+					return []ccv2.Event{
+						{GUID: "event-guid-4", Type: "audit.app.stop", Timestamp: time.Date(2017, 6, 1, 10, 7, 0, 0, time.UTC)},
+						{GUID: "event-guid-2", Timestamp: time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC)},
+						{GUID: "event-guid-3", Type: "audit.app.start", Timestamp: time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC)},
+					}, ccv2.Warnings{"events-warning-1"}, nil
+				case 2:
+					return []ccv2.Event{
+						{GUID: "event-guid-4", Type: "audit.app.stop", Timestamp: time.Date(2017, 6, 1, 10, 7, 0, 0, time.UTC)},
+					}, nil, nil
+				default:
+					return nil, ccv2.Warnings{"events-warning-2"}, expectedErr
+				}
+			}
+		})
+
+		It("sends new events oldest first, without repeats, until an error occurs", func() {
+			events, warnings, errs := actor.GetStreamingApplicationEvents("some-app-guid", previousEvents, fakeConfig)
+
+			var (
+				receivedEvents   []Event
+				receivedWarnings []string
+				receivedErrs     []error
+			)
+			for events != nil || warnings != nil || errs != nil {
+				select {
+				case event, ok := <-events:
+					if !ok {
+						events = nil
+						continue
+					}
+					receivedEvents = append(receivedEvents, event)
+				case warning, ok := <-warnings:
+					if !ok {
+						warnings = nil
+						continue
+					}
+					receivedWarnings = append(receivedWarnings, warning)
+				case err, ok := <-errs:
+					if !ok {
+						errs = nil
+						continue
+					}
+					receivedErrs = append(receivedErrs, err)
+				}
+			}
+
+			Expect(receivedEvents).To(Equal([]Event{
+				{GUID: "event-guid-3", Type: "audit.app.start", Timestamp: time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC)},
+				{GUID: "event-guid-4", Type: "audit.app.stop", Timestamp: time.Date(2017, 6, 1, 10, 7, 0, 0, time.UTC)},
+			}))
+			Expect(receivedWarnings).To(Equal([]string{"events-warning-1", "events-warning-2"}))
+			Expect(receivedErrs).To(Equal([]error{expectedErr}))
+
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(Equal([]ccv2.Query{
+				{Filter: ccv2.ActeeFilter, Operator: ccv2.EqualOperator, Value: "some-app-guid"},
+				{Filter: ccv2.TimestampFilter, Operator: ccv2.GreaterThanOrEqualOperator, Value: "2017-06-01T10:05:00Z"},
+			}))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(1)).To(ContainElement(
+				ccv2.Query{Filter: ccv2.TimestampFilter, Operator: ccv2.GreaterThanOrEqualOperator, Value: "2017-06-01T10:07:00Z"},
+			))
+		})
+	})
+})
@@ -0,0 +1,16 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// SetRolesByUsernameFeatureFlag is the feature flag that allows users to give
+// roles to other users by username.
+const SetRolesByUsernameFeatureFlag = "set_roles_by_username"
+
+// FeatureFlag represents a Cloud Controller feature flag.
+type FeatureFlag ccv2.FeatureFlag
+
+// GetFeatureFlag returns the feature flag with the given name.
+func (actor Actor) GetFeatureFlag(name string) (FeatureFlag, Warnings, error) {
+	featureFlag, warnings, err := actor.CloudControllerClient.GetConfigFeatureFlag(name)
+	return FeatureFlag(featureFlag), Warnings(warnings), err
+}
@@ -0,0 +1,60 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature Flag Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetFeatureFlag", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetConfigFeatureFlagReturns(
+					ccv2.FeatureFlag{Name: "some-flag", Enabled: true},
+					ccv2.Warnings{"get-flag-warning"},
+					nil,
+				)
+			})
+
+			It("returns the feature flag and all warnings", func() {
+				featureFlag, warnings, err := actor.GetFeatureFlag("some-flag")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(featureFlag).To(Equal(FeatureFlag{Name: "some-flag", Enabled: true}))
+				Expect(warnings).To(ConsistOf("get-flag-warning"))
+
+				Expect(fakeCloudControllerClient.GetConfigFeatureFlagCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetConfigFeatureFlagArgsForCall(0)).To(Equal("some-flag"))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-flag-error")
+				fakeCloudControllerClient.GetConfigFeatureFlagReturns(ccv2.FeatureFlag{}, ccv2.Warnings{"get-flag-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetFeatureFlag("some-flag")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-flag-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package v2action
+
+import (
+	noaaErrors "github.com/cloudfoundry/noaa/errors"
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+// FirehoseFilter selects the kind of envelopes read from the firehose.
+type FirehoseFilter string
+
+const (
+	// FirehoseFilterNone does not filter any envelopes.
+	FirehoseFilterNone FirehoseFilter = ""
+	// FirehoseFilterLogs only keeps log message envelopes.
+	FirehoseFilterLogs FirehoseFilter = "logs"
+	// FirehoseFilterMetrics only keeps value metric, counter event and
+	// container metric envelopes.
+	FirehoseFilterMetrics FirehoseFilter = "metrics"
+)
+
+func (filter FirehoseFilter) keep(envelope *events.Envelope) bool {
+	switch filter {
+	case FirehoseFilterLogs:
+		return envelope.GetEventType() == events.Envelope_LogMessage
+	case FirehoseFilterMetrics:
+		switch envelope.GetEventType() {
+		case events.Envelope_ValueMetric, events.Envelope_CounterEvent, events.Envelope_ContainerMetric:
+			return true
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// GetStreamingFirehoseEnvelopes streams the envelopes of the firehose that
+// match the filter. Connections sharing a subscription ID split the firehose
+// between them, so running several with the same ID shards the stream.
+func (actor Actor) GetStreamingFirehoseEnvelopes(subscriptionID string, filter FirehoseFilter, client NOAAClient) (<-chan *events.Envelope, <-chan error) {
+	// Do not pass in token because client should have a TokenRefresher set
+	envelopeStream, errStream := client.Firehose(subscriptionID, "")
+
+	envelopes := make(chan *events.Envelope)
+	errs := make(chan error)
+
+	go func() {
+		defer close(envelopes)
+		defer close(errs)
+
+	dance:
+		for {
+			select {
+			case envelope, ok := <-envelopeStream:
+				if !ok {
+					break dance
+				}
+
+				if filter.keep(envelope) {
+					envelopes <- envelope
+				}
+			case err, ok := <-errStream:
+				if !ok {
+					break dance
+				}
+
+				if _, ok := err.(noaaErrors.RetryError); ok {
+					break
+				}
+
+				if err != nil {
+					errs <- err
+				}
+			}
+		}
+	}()
+
+	return envelopes, errs
+}
@@ -0,0 +1,128 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	noaaErrors "github.com/cloudfoundry/noaa/errors"
+	"github.com/cloudfoundry/sonde-go/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Firehose Actions", func() {
+	var (
+		actor          Actor
+		fakeNOAAClient *v2actionfakes.FakeNOAAClient
+	)
+
+	BeforeEach(func() {
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+		actor = NewActor(nil, nil)
+	})
+
+	Describe("GetStreamingFirehoseEnvelopes", func() {
+		var (
+			filter         FirehoseFilter
+			envelopes      <-chan *events.Envelope
+			errs           <-chan error
+			envelopeStream chan *events.Envelope
+			errStream      chan error
+
+			logEnvelope    *events.Envelope
+			metricEnvelope *events.Envelope
+		)
+
+		newEnvelope := func(eventType events.Envelope_EventType) *events.Envelope {
+			origin := "some-origin"
+			return &events.Envelope{Origin: &origin, EventType: &eventType}
+		}
+
+		BeforeEach(func() {
+			filter = FirehoseFilterNone
+			envelopeStream = make(chan *events.Envelope)
+			errStream = make(chan error)
+
+			logEnvelope = newEnvelope(events.Envelope_LogMessage)
+			metricEnvelope = newEnvelope(events.Envelope_ValueMetric)
+
+			fakeNOAAClient.FirehoseStub = func(subscriptionID string, authToken string) (<-chan *events.Envelope, <-chan error) {
+				Expect(subscriptionID).To(Equal("some-subscription-id"))
+				Expect(authToken).To(BeEmpty())
+
+				go func() {
+					envelopeStream <- logEnvelope
+					envelopeStream <- newEnvelope(events.Envelope_HttpStartStop)
+					envelopeStream <- metricEnvelope
+				}()
+
+				return envelopeStream, errStream
+			}
+		})
+
+		// If tests panic due to this close, it is likely you have a failing
+		// expectation and the channels are being closed because the test has
+		// failed/short circuited and is going through teardown.
+		AfterEach(func() {
+			close(envelopeStream)
+			close(errStream)
+
+			Eventually(envelopes).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		JustBeforeEach(func() {
+			envelopes, errs = actor.GetStreamingFirehoseEnvelopes("some-subscription-id", filter, fakeNOAAClient)
+		})
+
+		Context("when no filter is provided", func() {
+			It("passes every envelope through", func() {
+				Eventually(envelopes).Should(Receive(Equal(logEnvelope)))
+				Eventually(envelopes).Should(Receive(Equal(newEnvelope(events.Envelope_HttpStartStop))))
+				Eventually(envelopes).Should(Receive(Equal(metricEnvelope)))
+			})
+		})
+
+		Context("when filtering by logs", func() {
+			BeforeEach(func() {
+				filter = FirehoseFilterLogs
+			})
+
+			It("only passes log envelopes through", func() {
+				Eventually(envelopes).Should(Receive(Equal(logEnvelope)))
+				Consistently(envelopes).ShouldNot(Receive())
+			})
+		})
+
+		Context("when filtering by metrics", func() {
+			BeforeEach(func() {
+				filter = FirehoseFilterMetrics
+			})
+
+			It("only passes metric envelopes through", func() {
+				Eventually(envelopes).Should(Receive(Equal(metricEnvelope)))
+			})
+		})
+
+		Context("when the firehose returns errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeNOAAClient.FirehoseStub = func(string, string) (<-chan *events.Envelope, <-chan error) {
+					go func() {
+						errStream <- noaaErrors.NewRetryError(errors.New("retry"))
+						errStream <- expectedErr
+					}()
+
+					return envelopeStream, errStream
+				}
+			})
+
+			It("skips retry errors and passes other errors through", func() {
+				Eventually(errs).Should(Receive(MatchError(expectedErr)))
+			})
+		})
+	})
+})
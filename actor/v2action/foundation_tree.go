@@ -0,0 +1,151 @@
+package v2action
+
+import (
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// FoundationTreeMaxParallelRequests is the maximum number of Cloud Controller
+// requests GetFoundationTree makes at the same time.
+const FoundationTreeMaxParallelRequests = 8
+
+// SpaceTree represents a space along with its applications and service
+// instances.
+type SpaceTree struct {
+	Space
+	Applications     []Application
+	ServiceInstances []ServiceInstance
+}
+
+// OrganizationTree represents an organization along with its spaces.
+type OrganizationTree struct {
+	Organization
+	Spaces []SpaceTree
+}
+
+// GetFoundationTree returns every organization visible to the user along with
+// their spaces, applications and service instances, all ordered by name. When
+// orgName is provided, only that organization is returned. The spaces of each
+// organization, and the applications and service instances of each space, are
+// retrieved in parallel.
+func (actor Actor) GetFoundationTree(orgName string) ([]OrganizationTree, Warnings, error) {
+	var (
+		orgs        []Organization
+		allWarnings Warnings
+	)
+
+	if orgName != "" {
+		org, warnings, err := actor.GetOrganizationByName(orgName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		orgs = []Organization{org}
+	} else {
+		ccv2Orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(nil)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, ccv2Org := range ccv2Orgs {
+			orgs = append(orgs, Organization(ccv2Org))
+		}
+		sort.Slice(orgs, func(i int, j int) bool {
+			return sorting.SortAlphabetic(orgs[i].Name, orgs[j].Name)
+		})
+	}
+
+	trees := make([]OrganizationTree, len(orgs))
+	for i, org := range orgs {
+		trees[i].Organization = org
+	}
+
+	warnings, err := parallelize(len(trees), func(index int) (Warnings, error) {
+		spaces, warnings, err := actor.GetOrganizationSpaces(trees[index].GUID)
+		sort.Slice(spaces, func(i int, j int) bool {
+			return sorting.SortAlphabetic(spaces[i].Name, spaces[j].Name)
+		})
+		for _, space := range spaces {
+			trees[index].Spaces = append(trees[index].Spaces, SpaceTree{Space: space})
+		}
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var spaceTrees []*SpaceTree
+	for i := range trees {
+		for j := range trees[i].Spaces {
+			spaceTrees = append(spaceTrees, &trees[i].Spaces[j])
+		}
+	}
+
+	warnings, err = parallelize(len(spaceTrees), func(index int) (Warnings, error) {
+		return actor.fillSpaceTree(spaceTrees[index])
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	return trees, allWarnings, nil
+}
+
+func (actor Actor) fillSpaceTree(spaceTree *SpaceTree) (Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceTree.GUID)
+	if err != nil {
+		return allWarnings, err
+	}
+	sort.Slice(apps, func(i int, j int) bool {
+		return sorting.SortAlphabetic(apps[i].Name, apps[j].Name)
+	})
+	spaceTree.Applications = apps
+
+	serviceInstances, warnings, err := actor.GetServiceInstancesBySpace(spaceTree.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	sort.Slice(serviceInstances, func(i int, j int) bool {
+		return sorting.SortAlphabetic(serviceInstances[i].Name, serviceInstances[j].Name)
+	})
+	spaceTree.ServiceInstances = serviceInstances
+
+	return allWarnings, nil
+}
+
+// parallelize calls work for every index from 0 to count - 1, running at most
+// FoundationTreeMaxParallelRequests calls at the same time. The warnings are
+// returned in index order, along with the error of the lowest failing index.
+func parallelize(count int, work func(i int) (Warnings, error)) (Warnings, error) {
+	warnings := make([]Warnings, count)
+	errs := make([]error, count)
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, FoundationTreeMaxParallelRequests)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			warnings[i], errs[i] = work(i)
+		}(i)
+	}
+	wg.Wait()
+
+	var allWarnings Warnings
+	for _, w := range warnings {
+		allWarnings = append(allWarnings, w...)
+	}
+	for _, err := range errs {
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
@@ -0,0 +1,203 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Foundation Tree Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetFoundationTree", func() {
+		var (
+			orgName string
+
+			trees    []OrganizationTree
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			orgName = ""
+
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{
+					{GUID: "org-guid-2", Name: "org-2"},
+					{GUID: "org-guid-1", Name: "org-1"},
+				},
+				ccv2.Warnings{"orgs-warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetSpacesStub = func(queries []ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error) {
+				switch queries[0].Value {
+				case "org-guid-1":
+					return []ccv2.Space{
+						{GUID: "space-guid-2", Name: "space-2"},
+						{GUID: "space-guid-1", Name: "space-1"},
+					}, ccv2.Warnings{"spaces-warning-1"}, nil
+				default:
+					return nil, ccv2.Warnings{"spaces-warning-2"}, nil
+				}
+			}
+
+			fakeCloudControllerClient.GetApplicationsStub = func(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+				if queries[0].Value == "space-guid-1" {
+					return []ccv2.Application{
+						{GUID: "app-guid-2", Name: "app-2"},
+						{GUID: "app-guid-1", Name: "app-1"},
+					}, nil, nil
+				}
+				return nil, nil, nil
+			}
+
+			fakeCloudControllerClient.GetSpaceServiceInstancesStub = func(spaceGUID string, includeUserProvidedServices bool, queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+				if spaceGUID == "space-guid-2" {
+					return []ccv2.ServiceInstance{
+						{GUID: "service-instance-guid-1", Name: "service-instance-1"},
+					}, nil, nil
+				}
+				return nil, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			trees, warnings, err = actor.GetFoundationTree(orgName)
+		})
+
+		Context("when no org name is provided", func() {
+			It("returns every org with its spaces, apps and service instances ordered by name", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(Equal(Warnings{"orgs-warning", "spaces-warning-1", "spaces-warning-2"}))
+
+				Expect(trees).To(Equal([]OrganizationTree{
+					{
+						Organization: Organization{GUID: "org-guid-1", Name: "org-1"},
+						Spaces: []SpaceTree{
+							{
+								Space: Space{GUID: "space-guid-1", Name: "space-1"},
+								Applications: []Application{
+									{GUID: "app-guid-1", Name: "app-1"},
+									{GUID: "app-guid-2", Name: "app-2"},
+								},
+								ServiceInstances: []ServiceInstance{},
+							},
+							{
+								Space:        Space{GUID: "space-guid-2", Name: "space-2"},
+								Applications: []Application{},
+								ServiceInstances: []ServiceInstance{
+									{GUID: "service-instance-guid-1", Name: "service-instance-1"},
+								},
+							},
+						},
+					},
+					{
+						Organization: Organization{GUID: "org-guid-2", Name: "org-2"},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(BeNil())
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when an org name is provided", func() {
+			BeforeEach(func() {
+				orgName = "org-1"
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "org-guid-1", Name: "org-1"}},
+					ccv2.Warnings{"org-warning"},
+					nil,
+				)
+			})
+
+			It("only returns that org", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(Equal(Warnings{"org-warning", "spaces-warning-1"}))
+				Expect(trees).To(HaveLen(1))
+				Expect(trees[0].Name).To(Equal("org-1"))
+				Expect(trees[0].Spaces).To(HaveLen(2))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.NameFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "org-1",
+				}))
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				orgName = "org-1"
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(OrganizationNotFoundError{Name: "org-1"}))
+				Expect(warnings).To(ConsistOf("org-warning"))
+			})
+		})
+
+		Context("when getting the orgs fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("orgs error")
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"orgs-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("orgs-warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the spaces of an org fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("spaces error")
+				fakeCloudControllerClient.GetSpacesStub = nil
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"spaces-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings of every request", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(Equal(Warnings{"orgs-warning", "spaces-warning", "spaces-warning"}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the service instances of a space fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("service instances error")
+				fakeCloudControllerClient.GetSpaceServiceInstancesStub = nil
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"service-instances-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("service-instances-warning"))
+			})
+		})
+	})
+})
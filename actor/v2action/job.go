@@ -0,0 +1,38 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// Job represents a Cloud Controller asynchronous job.
+type Job ccv2.Job
+
+// JobNotFoundError is returned when a job cannot be found.
+type JobNotFoundError struct {
+	GUID string
+}
+
+func (e JobNotFoundError) Error() string {
+	return fmt.Sprintf("Job with GUID '%s' not found.", e.GUID)
+}
+
+// GetJob returns the current state of the job associated with the provided
+// GUID.
+func (actor Actor) GetJob(guid string) (Job, Warnings, error) {
+	job, warnings, err := actor.CloudControllerClient.GetJob(guid)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Job{}, Warnings(warnings), JobNotFoundError{GUID: guid}
+	}
+
+	return Job(job), Warnings(warnings), err
+}
+
+// PollJob polls the provided job until it has finished, failed, or the
+// polling timeout has been reached.
+func (actor Actor) PollJob(job Job) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.PollJob(ccv2.Job(job))
+	return Warnings(warnings), err
+}
@@ -0,0 +1,105 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Job Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetJob", func() {
+		Context("when the job exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(
+					ccv2.Job{GUID: "some-job-guid", Status: ccv2.JobStatusRunning},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the job and all warnings", func() {
+				job, warnings, err := actor.GetJob("some-job-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(job).To(Equal(Job{GUID: "some-job-guid", Status: ccv2.JobStatusRunning}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(ccv2.Job{}, ccv2.Warnings{"warning-1"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a JobNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetJob("some-job-guid")
+				Expect(err).To(MatchError(JobNotFoundError{GUID: "some-job-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeCloudControllerClient.GetJobReturns(ccv2.Job{}, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetJob("some-job-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("PollJob", func() {
+		Context("when the job finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("polls the job and returns all warnings", func() {
+				warnings, err := actor.PollJob(Job{GUID: "some-job-guid"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+			})
+		})
+
+		Context("when polling fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "some message"}
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.PollJob(Job{GUID: "some-job-guid"})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
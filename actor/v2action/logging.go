@@ -1,8 +1,11 @@
 package v2action
 
 import (
+	"fmt"
+	"sort"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/logcache"
 	"github.com/cloudfoundry/noaa"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
@@ -134,6 +137,59 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 	return logMessages, allWarnings, nil
 }
 
+// RecentLogsWindow limits the recent logs of an application.
+type RecentLogsWindow struct {
+	// Lines is the maximum number of the most recent log lines to return. It
+	// is not limited when 0.
+	Lines int
+
+	// Since excludes logs emitted longer ago than it. It is not limited when 0.
+	Since time.Duration
+}
+
+// GetRecentLogsForApplicationByNameAndSpaceWithinWindow returns the recent
+// logs of the application that fall in the window. The Doppler recent log
+// buffer is combined with the logs held by Log Cache, when a client is
+// provided, since Log Cache keeps far more of them. If Log Cache cannot be
+// read, a warning is returned and only the Doppler logs are used.
+func (actor Actor) GetRecentLogsForApplicationByNameAndSpaceWithinWindow(appName string, spaceGUID string, window RecentLogsWindow, noaaClient NOAAClient, logCacheClient LogCacheClient, config Config) ([]LogMessage, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var startTime time.Time
+	if window.Since > 0 {
+		startTime = time.Now().Add(-window.Since)
+	}
+
+	noaaMessages, err := noaaClient.RecentLogs(app.GUID, "")
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var logMessages []LogMessage
+	for _, message := range noaaMessages {
+		logMessages = append(logMessages, LogMessage{
+			message:        string(message.GetMessage()),
+			messageType:    message.GetMessageType(),
+			timestamp:      time.Unix(0, message.GetTimestamp()),
+			sourceType:     message.GetSourceType(),
+			sourceInstance: message.GetSourceInstance(),
+		})
+	}
+
+	if logCacheClient != nil {
+		cachedMessages, err := actor.readLogCache(app.GUID, startTime, window.Lines, logCacheClient)
+		if err != nil {
+			allWarnings = append(allWarnings, fmt.Sprintf("Unable to read logs from Log Cache, only the Doppler recent log buffer is shown: %s", err))
+		}
+		logMessages = append(logMessages, cachedMessages...)
+	}
+
+	return windowLogMessages(logMessages, startTime, window.Lines), allWarnings, nil
+}
+
 func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
@@ -144,3 +200,92 @@ func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string,
 
 	return messages, logErrs, allWarnings, err
 }
+
+// readLogCache pages backwards through Log Cache from the newest log until
+// lines logs are read, startTime is reached or the cache is exhausted.
+func (Actor) readLogCache(appGUID string, startTime time.Time, lines int, client LogCacheClient) ([]LogMessage, error) {
+	var (
+		logMessages []LogMessage
+		endTime     time.Time
+	)
+
+	for lines == 0 || len(logMessages) < lines {
+		limit := logcache.MaxReadLimit
+		if lines > 0 && lines-len(logMessages) < limit {
+			limit = lines - len(logMessages)
+		}
+
+		envelopes, err := client.ReadLogs(appGUID, logcache.ReadOptions{
+			StartTime:  startTime,
+			EndTime:    endTime,
+			Limit:      limit,
+			Descending: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, envelope := range envelopes {
+			messageType := events.LogMessage_OUT
+			if envelope.MessageType == "ERR" {
+				messageType = events.LogMessage_ERR
+			}
+
+			logMessages = append(logMessages, LogMessage{
+				message:        envelope.Message,
+				messageType:    messageType,
+				timestamp:      envelope.Timestamp,
+				sourceType:     envelope.SourceType,
+				sourceInstance: envelope.InstanceID,
+			})
+		}
+
+		if len(envelopes) < limit {
+			break
+		}
+		// The end time is exclusive, so the next page starts right before
+		// the oldest log read so far.
+		endTime = envelopes[len(envelopes)-1].Timestamp
+	}
+
+	return logMessages, nil
+}
+
+// windowLogMessages drops duplicates and the logs outside the window, and
+// sorts the rest from oldest to newest.
+func windowLogMessages(logMessages []LogMessage, startTime time.Time, lines int) []LogMessage {
+	type logKey struct {
+		timestamp      int64
+		sourceInstance string
+		message        string
+	}
+
+	seen := map[logKey]bool{}
+	var windowed []LogMessage
+	for _, message := range logMessages {
+		if message.timestamp.Before(startTime) {
+			continue
+		}
+
+		key := logKey{
+			timestamp:      message.timestamp.UnixNano(),
+			sourceInstance: message.sourceInstance,
+			message:        message.message,
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		windowed = append(windowed, message)
+	}
+
+	sort.SliceStable(windowed, func(i int, j int) bool {
+		return windowed[i].timestamp.Before(windowed[j].timestamp)
+	})
+
+	if lines > 0 && len(windowed) > lines {
+		windowed = windowed[len(windowed)-lines:]
+	}
+
+	return windowed
+}
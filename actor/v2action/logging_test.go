@@ -2,11 +2,13 @@ package v2action_test
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/logcache"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 	. "github.com/onsi/ginkgo"
@@ -311,6 +313,192 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogsForApplicationByNameAndSpaceWithinWindow", func() {
+		var (
+			window             RecentLogsWindow
+			fakeLogCacheClient *v2actionfakes.FakeLogCacheClient
+			logCacheClient     LogCacheClient
+			now                time.Time
+
+			messages   []LogMessage
+			warnings   Warnings
+			executeErr error
+		)
+
+		newNOAAMessage := func(message string, timestamp time.Time) *events.LogMessage {
+			outMessage := events.LogMessage_OUT
+			ts := timestamp.UnixNano()
+			sourceType := "APP/PROC/WEB"
+			sourceInstance := "0"
+			return &events.LogMessage{
+				Message:        []byte(message),
+				MessageType:    &outMessage,
+				Timestamp:      &ts,
+				SourceType:     &sourceType,
+				SourceInstance: &sourceInstance,
+			}
+		}
+
+		newEnvelope := func(message string, timestamp time.Time) logcache.LogEnvelope {
+			return logcache.LogEnvelope{
+				Timestamp:   timestamp,
+				SourceType:  "APP/PROC/WEB",
+				InstanceID:  "0",
+				Message:     message,
+				MessageType: "OUT",
+			}
+		}
+
+		BeforeEach(func() {
+			now = time.Now()
+			window = RecentLogsWindow{}
+			fakeLogCacheClient = new(v2actionfakes.FakeLogCacheClient)
+			logCacheClient = fakeLogCacheClient
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{Name: "some-app", GUID: "some-app-guid"}},
+				ccv2.Warnings{"some-app-warnings"},
+				nil,
+			)
+			fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{
+				newNOAAMessage("message-4", now.Add(-1*time.Minute)),
+				newNOAAMessage("message-3", now.Add(-2*time.Minute)),
+			}, nil)
+			fakeLogCacheClient.ReadLogsReturns([]logcache.LogEnvelope{
+				newEnvelope("message-4", now.Add(-1*time.Minute)),
+				newEnvelope("message-3", now.Add(-2*time.Minute)),
+				newEnvelope("message-2", now.Add(-20*time.Minute)),
+				newEnvelope("message-1", now.Add(-30*time.Minute)),
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			messages, warnings, executeErr = actor.GetRecentLogsForApplicationByNameAndSpaceWithinWindow("some-app", "some-space-guid", window, fakeNOAAClient, logCacheClient, fakeConfig)
+		})
+
+		messageTexts := func() []string {
+			var texts []string
+			for _, message := range messages {
+				texts = append(texts, message.Message())
+			}
+			return texts
+		}
+
+		It("combines the Doppler and Log Cache logs without duplicates", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-app-warnings"))
+			Expect(messageTexts()).To(Equal([]string{"message-1", "message-2", "message-3", "message-4"}))
+			Expect(messages[0].Type()).To(Equal("OUT"))
+			Expect(messages[0].SourceType()).To(Equal("APP/PROC/WEB"))
+			Expect(messages[0].SourceInstance()).To(Equal("0"))
+
+			Expect(fakeLogCacheClient.ReadLogsCallCount()).To(Equal(1))
+			sourceID, options := fakeLogCacheClient.ReadLogsArgsForCall(0)
+			Expect(sourceID).To(Equal("some-app-guid"))
+			Expect(options).To(Equal(logcache.ReadOptions{Limit: logcache.MaxReadLimit, Descending: true}))
+		})
+
+		Context("when the number of lines is limited", func() {
+			BeforeEach(func() {
+				window.Lines = 3
+				fakeLogCacheClient.ReadLogsReturns([]logcache.LogEnvelope{
+					newEnvelope("message-4", now.Add(-1*time.Minute)),
+					newEnvelope("message-3", now.Add(-2*time.Minute)),
+					newEnvelope("message-2", now.Add(-20*time.Minute)),
+				}, nil)
+			})
+
+			It("returns the most recent lines", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(messageTexts()).To(Equal([]string{"message-2", "message-3", "message-4"}))
+
+				_, options := fakeLogCacheClient.ReadLogsArgsForCall(0)
+				Expect(options.Limit).To(Equal(3))
+			})
+		})
+
+		Context("when more lines are requested than Log Cache returns at once", func() {
+			BeforeEach(func() {
+				window.Lines = logcache.MaxReadLimit + 1
+				fakeNOAAClient.RecentLogsReturns(nil, nil)
+
+				var firstPage []logcache.LogEnvelope
+				for i := 0; i < logcache.MaxReadLimit; i++ {
+					firstPage = append(firstPage, newEnvelope(fmt.Sprintf("page-1-%d", i), now.Add(-time.Duration(i)*time.Millisecond)))
+				}
+				fakeLogCacheClient.ReadLogsReturnsOnCall(0, firstPage, nil)
+				fakeLogCacheClient.ReadLogsReturnsOnCall(1, []logcache.LogEnvelope{
+					newEnvelope("page-2", now.Add(-time.Hour)),
+				}, nil)
+			})
+
+			It("pages backwards from the oldest log read", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(messages).To(HaveLen(logcache.MaxReadLimit + 1))
+				Expect(messages[0].Message()).To(Equal("page-2"))
+
+				Expect(fakeLogCacheClient.ReadLogsCallCount()).To(Equal(2))
+				_, options := fakeLogCacheClient.ReadLogsArgsForCall(1)
+				Expect(options.Limit).To(Equal(1))
+				Expect(options.EndTime).To(Equal(now.Add(-time.Duration(logcache.MaxReadLimit-1) * time.Millisecond)))
+			})
+		})
+
+		Context("when a time window is provided", func() {
+			BeforeEach(func() {
+				window.Since = 10 * time.Minute
+			})
+
+			It("drops the older logs and reads Log Cache from the start of the window", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(messageTexts()).To(Equal([]string{"message-3", "message-4"}))
+
+				_, options := fakeLogCacheClient.ReadLogsArgsForCall(0)
+				Expect(options.StartTime).To(BeTemporally("~", now.Add(-10*time.Minute), time.Second))
+			})
+		})
+
+		Context("when Log Cache cannot be read", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadLogsReturns(nil, errors.New("log cache error"))
+			})
+
+			It("returns the Doppler logs and a warning", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(messageTexts()).To(Equal([]string{"message-3", "message-4"}))
+				Expect(warnings).To(ConsistOf(
+					"some-app-warnings",
+					"Unable to read logs from Log Cache, only the Doppler recent log buffer is shown: log cache error",
+				))
+			})
+		})
+
+		Context("when there is no Log Cache client", func() {
+			BeforeEach(func() {
+				logCacheClient = nil
+			})
+
+			It("returns the Doppler logs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(messageTexts()).To(Equal([]string{"message-3", "message-4"}))
+			})
+		})
+
+		Context("when NOAA errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("ZOMG")
+				fakeNOAAClient.RecentLogsReturns(nil, expectedErr)
+			})
+
+			It("returns error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+			})
+		})
+	})
+
 	Describe("GetStreamingLogsForApplicationByNameAndSpace", func() {
 		Context("when the application can be found", func() {
 			var (
@@ -4,9 +4,12 @@ import "github.com/cloudfoundry/sonde-go/events"
 
 //go:generate counterfeiter . NOAAClient
 
-// NOAAClient is a client for getting logs.
+// NOAAClient is a client for getting logs, container metrics and firehose
+// envelopes.
 type NOAAClient interface {
 	Close() error
+	ContainerMetrics(appGuid string, authToken string) ([]*events.ContainerMetric, error)
+	Firehose(subscriptionId string, authToken string) (<-chan *events.Envelope, <-chan error)
 	RecentLogs(appGuid string, authToken string) ([]*events.LogMessage, error)
 	TailingLogs(appGuid, authToken string) (<-chan *events.LogMessage, <-chan error)
 }
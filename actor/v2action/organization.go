@@ -35,6 +35,69 @@ func (e MultipleOrganizationsFoundError) Error() string {
 	return fmt.Sprintf("Organization name '%s' matches multiple GUIDs: %s", e.Name, guids)
 }
 
+// OrganizationNameTakenError is returned when creating or renaming an
+// organization with a name that is already in use.
+type OrganizationNameTakenError struct {
+	Name string
+}
+
+func (e OrganizationNameTakenError) Error() string {
+	return fmt.Sprintf("Organization '%s' already exists.", e.Name)
+}
+
+// CreateOrganizationWithRoles creates an organization with the provided name
+// and gives each seeded user their role in it. When quotaName is empty the
+// default quota is assigned. Seeding is all-or-nothing: if any role cannot be
+// given, the new organization is deleted and a RoleSeedingError is returned.
+// Should that deletion fail as well, its error is returned instead.
+func (actor Actor) CreateOrganizationWithRoles(orgName string, quotaName string, roleSeeds []RoleSeed) (Organization, Warnings, error) {
+	err := validateOrganizationRoleSeeds(roleSeeds)
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var allWarnings Warnings
+
+	var quotaGUID string
+	if quotaName != "" {
+		quota, warnings, quotaErr := actor.GetOrganizationQuotaByName(quotaName)
+		allWarnings = append(allWarnings, warnings...)
+		if quotaErr != nil {
+			return Organization{}, allWarnings, quotaErr
+		}
+		quotaGUID = quota.GUID
+	}
+
+	org, warnings, err := actor.CloudControllerClient.CreateOrganization(orgName, quotaGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.OrganizationNameTakenError); ok {
+		return Organization{}, allWarnings, OrganizationNameTakenError{Name: orgName}
+	}
+	if err != nil {
+		return Organization{}, allWarnings, err
+	}
+
+	seedWarnings, seedErr := actor.seedOrganizationRoles(org.GUID, roleSeeds)
+	allWarnings = append(allWarnings, seedWarnings...)
+	if seedErr != nil {
+		job, deleteWarnings, deleteErr := actor.CloudControllerClient.DeleteOrganization(org.GUID)
+		allWarnings = append(allWarnings, deleteWarnings...)
+		if deleteErr != nil {
+			return Organization{}, allWarnings, deleteErr
+		}
+
+		pollWarnings, pollErr := actor.PollJob(Job(job))
+		allWarnings = append(allWarnings, pollWarnings...)
+		if pollErr != nil {
+			return Organization{}, allWarnings, pollErr
+		}
+
+		return Organization{}, allWarnings, seedErr
+	}
+
+	return Organization(org), allWarnings, nil
+}
+
 // GetOrganization returns an Organization based on the provided guid.
 func (actor Actor) GetOrganization(guid string) (Organization, Warnings, error) {
 	org, warnings, err := actor.CloudControllerClient.GetOrganization(guid)
@@ -78,24 +141,61 @@ func (actor Actor) GetOrganizationByName(orgName string) (Organization, Warnings
 // GUID. Once the deletion request is sent, it polls the deletion job until
 // it's finished.
 func (actor Actor) DeleteOrganization(orgName string) (Warnings, error) {
+	job, allWarnings, err := actor.DeleteOrganizationAsync(orgName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.PollJob(job)
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+// DeleteOrganizationAsync requests the deletion of the Organization with the
+// provided name and returns the deletion job without waiting for it to
+// finish.
+func (actor Actor) DeleteOrganizationAsync(orgName string) (Job, Warnings, error) {
 	var allWarnings Warnings
 
 	org, warnings, err := actor.GetOrganizationByName(orgName)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return allWarnings, err
+		return Job{}, allWarnings, err
 	}
 
 	job, deleteWarnings, err := actor.CloudControllerClient.DeleteOrganization(org.GUID)
 	allWarnings = append(allWarnings, deleteWarnings...)
+
+	return Job(job), allWarnings, err
+}
+
+// RenameOrganization renames the organization with the provided name. An
+// OrganizationNameTakenError is returned, without attempting the rename, when
+// another organization already has the new name.
+func (actor Actor) RenameOrganization(oldName string, newName string) (Organization, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(oldName)
 	if err != nil {
-		return allWarnings, err
+		return Organization{}, allWarnings, err
 	}
 
-	ccWarnings, err := actor.CloudControllerClient.PollJob(job)
-	for _, warning := range ccWarnings {
-		allWarnings = append(allWarnings, warning)
+	existingOrg, warnings, err := actor.GetOrganizationByName(newName)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		if existingOrg.GUID != org.GUID {
+			return Organization{}, allWarnings, OrganizationNameTakenError{Name: newName}
+		}
+	case OrganizationNotFoundError:
+	default:
+		return Organization{}, allWarnings, err
 	}
 
-	return allWarnings, err
+	updatedOrg, updateWarnings, err := actor.CloudControllerClient.UpdateOrganizationName(org.GUID, newName)
+	allWarnings = append(allWarnings, updateWarnings...)
+	if _, ok := err.(ccerror.OrganizationNameTakenError); ok {
+		return Organization{}, allWarnings, OrganizationNameTakenError{Name: newName}
+	}
+
+	return Organization(updatedOrg), allWarnings, err
 }
@@ -22,6 +22,172 @@ var _ = Describe("Org Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, nil)
 	})
 
+	Describe("CreateOrganizationWithRoles", func() {
+		var (
+			quotaName string
+			roleSeeds []RoleSeed
+
+			org      Organization
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			quotaName = ""
+			roleSeeds = []RoleSeed{
+				{Username: "user-1", Role: "OrgManager"},
+				{Username: "user-2", Role: "OrgAuditor"},
+			}
+			fakeCloudControllerClient.CreateOrganizationReturns(
+				ccv2.Organization{GUID: "some-org-guid", Name: "some-org"},
+				ccv2.Warnings{"create-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameReturns(ccv2.Warnings{"role-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			org, warnings, err = actor.CreateOrganizationWithRoles("some-org", quotaName, roleSeeds)
+		})
+
+		Context("when every role is seeded", func() {
+			It("creates the org and makes each user an org user with their role", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("create-warning", "role-warning", "role-warning", "role-warning", "role-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasCallCount()).To(Equal(0))
+				orgName, quotaGUID := fakeCloudControllerClient.CreateOrganizationArgsForCall(0)
+				Expect(orgName).To(Equal("some-org"))
+				Expect(quotaGUID).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameCallCount()).To(Equal(4))
+				expectedCalls := []struct {
+					role     ccv2.OrganizationRole
+					username string
+				}{
+					{ccv2.OrgUserRole, "user-1"},
+					{ccv2.OrgManagerRole, "user-1"},
+					{ccv2.OrgUserRole, "user-2"},
+					{ccv2.OrgAuditorRole, "user-2"},
+				}
+				for i, expected := range expectedCalls {
+					role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameArgsForCall(i)
+					Expect(role).To(Equal(expected.role))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(username).To(Equal(expected.username))
+				}
+
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a quota is provided", func() {
+			BeforeEach(func() {
+				quotaName = "some-quota"
+			})
+
+			Context("when the quota exists", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationQuotasReturns(
+						[]ccv2.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+						ccv2.Warnings{"quota-warning"},
+						nil,
+					)
+				})
+
+				It("creates the org with the quota", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("quota-warning"))
+
+					Expect(fakeCloudControllerClient.GetOrganizationQuotasArgsForCall(0)).To(Equal([]ccv2.Query{{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-quota",
+					}}))
+					_, quotaGUID := fakeCloudControllerClient.CreateOrganizationArgsForCall(0)
+					Expect(quotaGUID).To(Equal("some-quota-guid"))
+				})
+			})
+
+			Context("when the quota does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"quota-warning"}, nil)
+				})
+
+				It("returns an OrganizationQuotaNotFoundError without creating the org", func() {
+					Expect(err).To(MatchError(OrganizationQuotaNotFoundError{Name: "some-quota"}))
+					Expect(warnings).To(ConsistOf("quota-warning"))
+					Expect(fakeCloudControllerClient.CreateOrganizationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when a role does not apply to orgs", func() {
+			BeforeEach(func() {
+				roleSeeds = []RoleSeed{{Username: "user-1", Role: "SpaceDeveloper"}}
+			})
+
+			It("returns an InvalidRoleError without creating the org", func() {
+				Expect(err).To(MatchError(InvalidRoleError{Role: "SpaceDeveloper"}))
+				Expect(fakeCloudControllerClient.CreateOrganizationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the org name is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateOrganizationReturns(
+					ccv2.Organization{},
+					ccv2.Warnings{"create-warning"},
+					ccerror.OrganizationNameTakenError{Message: "name taken"},
+				)
+			})
+
+			It("returns an OrganizationNameTakenError", func() {
+				Expect(err).To(MatchError(OrganizationNameTakenError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when seeding a role fails", func() {
+			var seedErr error
+
+			BeforeEach(func() {
+				seedErr = errors.New("user not found")
+				fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameReturnsOnCall(2, ccv2.Warnings{"role-warning"}, seedErr)
+				fakeCloudControllerClient.DeleteOrganizationReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, nil)
+			})
+
+			It("deletes the org and returns a RoleSeedingError", func() {
+				Expect(err).To(MatchError(RoleSeedingError{Username: "user-2", Role: "OrgAuditor", Err: seedErr}))
+				Expect(org).To(Equal(Organization{}))
+				Expect(warnings).To(ContainElement("delete-warning"))
+				Expect(warnings).To(ContainElement("poll-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.DeleteOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+			})
+
+			Context("when deleting the org fails", func() {
+				var deleteErr error
+
+				BeforeEach(func() {
+					deleteErr = errors.New("delete failed")
+					fakeCloudControllerClient.DeleteOrganizationReturns(ccv2.Job{}, ccv2.Warnings{"delete-warning"}, deleteErr)
+				})
+
+				It("returns the deletion error", func() {
+					Expect(err).To(MatchError(deleteErr))
+					Expect(warnings).To(ContainElement("delete-warning"))
+					Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
 	Describe("GetOrganization", func() {
 		var (
 			org      Organization
@@ -300,4 +466,154 @@ var _ = Describe("Org Actions", func() {
 			})
 		})
 	})
+
+	Describe("DeleteOrganizationAsync", func() {
+		var (
+			job          Job
+			warnings     Warnings
+			deleteOrgErr error
+		)
+
+		JustBeforeEach(func() {
+			job, warnings, deleteOrgErr = actor.DeleteOrganizationAsync("some-org")
+		})
+
+		Context("when the deletion is requested successfully", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{
+					{GUID: "some-org-guid"},
+				}, ccv2.Warnings{"get-org-warning"}, nil)
+
+				fakeCloudControllerClient.DeleteOrganizationReturns(
+					ccv2.Job{GUID: "some-job-guid", Status: ccv2.JobStatusQueued},
+					ccv2.Warnings{"delete-org-warning"},
+					nil,
+				)
+			})
+
+			It("returns the job and all warnings without polling the job", func() {
+				Expect(deleteOrgErr).ToNot(HaveOccurred())
+				Expect(job).To(Equal(Job{GUID: "some-job-guid", Status: ccv2.JobStatusQueued}))
+				Expect(warnings).To(ConsistOf("get-org-warning", "delete-org-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and all warnings", func() {
+				Expect(deleteOrgErr).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RenameOrganization", func() {
+		var (
+			org      Organization
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturnsOnCall(0,
+				[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+				ccv2.Warnings{"get-org-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationsReturnsOnCall(1,
+				[]ccv2.Organization{},
+				ccv2.Warnings{"get-new-name-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateOrganizationNameReturns(
+				ccv2.Organization{GUID: "some-org-guid", Name: "new-org-name"},
+				ccv2.Warnings{"update-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			org, warnings, err = actor.RenameOrganization("some-org", "new-org-name")
+		})
+
+		Context("when the new name is free", func() {
+			It("renames the org and returns all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "new-org-name"}))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-new-name-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(1)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.NameFilter, Operator: ccv2.EqualOperator, Value: "new-org-name"},
+				}))
+				Expect(fakeCloudControllerClient.UpdateOrganizationNameCallCount()).To(Equal(1))
+				orgGUID, newName := fakeCloudControllerClient.UpdateOrganizationNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(newName).To(Equal("new-org-name"))
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturnsOnCall(0, []ccv2.Organization{}, ccv2.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(err).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when another org already has the new name", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturnsOnCall(1,
+					[]ccv2.Organization{{GUID: "other-org-guid", Name: "new-org-name"}},
+					ccv2.Warnings{"get-new-name-warning"},
+					nil,
+				)
+			})
+
+			It("returns an OrganizationNameTakenError without renaming", func() {
+				Expect(err).To(MatchError(OrganizationNameTakenError{Name: "new-org-name"}))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-new-name-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the Cloud Controller reports the name as taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationNameReturns(
+					ccv2.Organization{},
+					ccv2.Warnings{"update-warning"},
+					ccerror.OrganizationNameTakenError{Message: "The organization name is taken: new-org-name"},
+				)
+			})
+
+			It("returns an OrganizationNameTakenError", func() {
+				Expect(err).To(MatchError(OrganizationNameTakenError{Name: "new-org-name"}))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-new-name-warning", "update-warning"))
+			})
+		})
+
+		Context("when renaming fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.UpdateOrganizationNameReturns(ccv2.Organization{}, ccv2.Warnings{"update-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-new-name-warning", "update-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,106 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// OrganizationUsage represents the resources an organization consumes along
+// with the quota that limits them.
+type OrganizationUsage struct {
+	Organization
+	Quota OrganizationQuota
+
+	// MemoryUsed is the memory, in megabytes, reserved by all instances of the
+	// started apps of the organization.
+	MemoryUsed int
+
+	// Instances is the number of instances of the started apps.
+	Instances int
+
+	Routes           int
+	ServiceInstances int
+}
+
+// GetOrganizationsUsage returns the usage of every organization, ordered by
+// name. The usage of the organizations is retrieved in parallel, running at
+// most FoundationTreeMaxParallelRequests organizations at the same time.
+func (actor Actor) GetOrganizationsUsage() ([]OrganizationUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	sort.Slice(orgs, func(i int, j int) bool { return orgs[i].Name < orgs[j].Name })
+
+	quotas := map[string]OrganizationQuota{}
+	for _, org := range orgs {
+		if _, ok := quotas[org.QuotaDefinitionGUID]; ok || org.QuotaDefinitionGUID == "" {
+			continue
+		}
+		quota, warnings, err := actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			if _, isNotFound := err.(OrganizationQuotaNotFoundError); !isNotFound {
+				return nil, allWarnings, err
+			}
+		}
+		quotas[org.QuotaDefinitionGUID] = quota
+	}
+
+	usages := make([]OrganizationUsage, len(orgs))
+	usageWarnings, err := parallelize(len(orgs), func(i int) (Warnings, error) {
+		usages[i] = OrganizationUsage{
+			Organization: Organization(orgs[i]),
+			Quota:        quotas[orgs[i].QuotaDefinitionGUID],
+		}
+		return actor.fillOrganizationUsage(&usages[i])
+	})
+	allWarnings = append(allWarnings, usageWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	return usages, allWarnings, nil
+}
+
+func (actor Actor) fillOrganizationUsage(usage *OrganizationUsage) (Warnings, error) {
+	var allWarnings Warnings
+	orgQuery := []ccv2.Query{{
+		Filter:   ccv2.OrganizationGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    usage.GUID,
+	}}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(orgQuery)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	for _, app := range apps {
+		if app.State != ccv2.ApplicationStarted {
+			continue
+		}
+		usage.MemoryUsed += app.Memory * app.Instances
+		usage.Instances += app.Instances
+	}
+
+	routes, warnings, err := actor.CloudControllerClient.GetRoutes(orgQuery)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	usage.Routes = len(routes)
+
+	serviceInstances, warnings, err := actor.CloudControllerClient.GetServiceInstances(orgQuery)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	usage.ServiceInstances = len(serviceInstances)
+
+	return allWarnings, nil
+}
@@ -0,0 +1,155 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Organization Usage Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetOrganizationsUsage", func() {
+		var (
+			usages   []OrganizationUsage
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{
+				{GUID: "org-guid-2", Name: "org-2", QuotaDefinitionGUID: "quota-guid-1"},
+				{GUID: "org-guid-1", Name: "org-1", QuotaDefinitionGUID: "quota-guid-1"},
+				{GUID: "org-guid-3", Name: "org-3", QuotaDefinitionGUID: "missing-quota-guid"},
+			}, ccv2.Warnings{"orgs-warning"}, nil)
+
+			fakeCloudControllerClient.GetOrganizationQuotaStub = func(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+				if guid == "missing-quota-guid" {
+					return ccv2.OrganizationQuota{}, ccv2.Warnings{"quota-warning"}, ccerror.ResourceNotFoundError{}
+				}
+				return ccv2.OrganizationQuota{GUID: guid, Name: "some-quota", MemoryLimit: 1024, AppInstanceLimit: -1, TotalRoutes: 10, TotalServices: 5}, ccv2.Warnings{"quota-warning"}, nil
+			}
+
+			fakeCloudControllerClient.GetApplicationsStub = func(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+				if queries[0].Value != "org-guid-1" {
+					return nil, ccv2.Warnings{"apps-warning-" + queries[0].Value}, nil
+				}
+				return []ccv2.Application{
+					{State: ccv2.ApplicationStarted, Memory: 256, Instances: 2},
+					{State: ccv2.ApplicationStarted, Memory: 128, Instances: 1},
+					{State: ccv2.ApplicationStopped, Memory: 1024, Instances: 4},
+				}, ccv2.Warnings{"apps-warning-org-guid-1"}, nil
+			}
+			fakeCloudControllerClient.GetRoutesStub = func(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+				if queries[0].Value != "org-guid-1" {
+					return nil, nil, nil
+				}
+				return []ccv2.Route{{GUID: "route-guid-1"}, {GUID: "route-guid-2"}}, nil, nil
+			}
+			fakeCloudControllerClient.GetServiceInstancesStub = func(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+				if queries[0].Value != "org-guid-1" {
+					return nil, nil, nil
+				}
+				return []ccv2.ServiceInstance{{GUID: "service-instance-guid"}}, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			usages, warnings, err = actor.GetOrganizationsUsage()
+		})
+
+		It("returns the usage of every org ordered by name", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{
+				"orgs-warning", "quota-warning", "quota-warning",
+				"apps-warning-org-guid-1", "apps-warning-org-guid-2", "apps-warning-org-guid-3",
+			}))
+
+			quota := OrganizationQuota{GUID: "quota-guid-1", Name: "some-quota", MemoryLimit: 1024, AppInstanceLimit: -1, TotalRoutes: 10, TotalServices: 5}
+			Expect(usages).To(Equal([]OrganizationUsage{
+				{
+					Organization:     Organization{GUID: "org-guid-1", Name: "org-1", QuotaDefinitionGUID: "quota-guid-1"},
+					Quota:            quota,
+					MemoryUsed:       640,
+					Instances:        3,
+					Routes:           2,
+					ServiceInstances: 1,
+				},
+				{
+					Organization: Organization{GUID: "org-guid-2", Name: "org-2", QuotaDefinitionGUID: "quota-guid-1"},
+					Quota:        quota,
+				},
+				{
+					Organization: Organization{GUID: "org-guid-3", Name: "org-3", QuotaDefinitionGUID: "missing-quota-guid"},
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationQuotaCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(3))
+			query := fakeCloudControllerClient.GetApplicationsArgsForCall(0)
+			Expect(query).To(HaveLen(1))
+			Expect(query[0].Filter).To(Equal(ccv2.OrganizationGUIDFilter))
+			Expect(query[0].Operator).To(Equal(ccv2.EqualOperator))
+		})
+
+		Context("when getting the orgs fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("orgs-error")
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"orgs-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("orgs-warning"))
+			})
+		})
+
+		Context("when getting a quota fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("quota-error")
+				fakeCloudControllerClient.GetOrganizationQuotaReturns(ccv2.OrganizationQuota{}, ccv2.Warnings{"quota-warning"}, expectedErr)
+				fakeCloudControllerClient.GetOrganizationQuotaStub = nil
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("orgs-warning", "quota-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the usage of an org fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("routes-error")
+				fakeCloudControllerClient.GetRoutesStub = nil
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(usages).To(BeNil())
+				Expect(warnings).To(ContainElement("routes-warning"))
+			})
+		})
+	})
+})
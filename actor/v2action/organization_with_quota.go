@@ -0,0 +1,47 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// OrganizationWithQuota represents an organization along with the name of the
+// quota assigned to it.
+type OrganizationWithQuota struct {
+	Organization
+	QuotaName string
+}
+
+// GetOrganizationsWithQuotas calls handlePage with each page of organizations,
+// ordered by name, as soon as the page and the quotas it references have been
+// retrieved. Quotas are only retrieved once regardless of how many
+// organizations reference them.
+func (actor Actor) GetOrganizationsWithQuotas(handlePage func([]OrganizationWithQuota)) (Warnings, error) {
+	var quotaWarnings Warnings
+	quotaNames := map[string]string{}
+
+	warnings, err := actor.CloudControllerClient.GetOrganizationsByPage(nil, func(ccv2Orgs []ccv2.Organization) error {
+		orgs := make([]OrganizationWithQuota, 0, len(ccv2Orgs))
+		for _, ccv2Org := range ccv2Orgs {
+			quotaName, ok := quotaNames[ccv2Org.QuotaDefinitionGUID]
+			if !ok && ccv2Org.QuotaDefinitionGUID != "" {
+				quota, warnings, err := actor.GetOrganizationQuota(ccv2Org.QuotaDefinitionGUID)
+				quotaWarnings = append(quotaWarnings, warnings...)
+				if err != nil {
+					if _, isNotFound := err.(OrganizationQuotaNotFoundError); !isNotFound {
+						return err
+					}
+				}
+				quotaName = quota.Name
+				quotaNames[ccv2Org.QuotaDefinitionGUID] = quotaName
+			}
+
+			orgs = append(orgs, OrganizationWithQuota{
+				Organization: Organization(ccv2Org),
+				QuotaName:    quotaName,
+			})
+		}
+
+		handlePage(orgs)
+		return nil
+	})
+
+	return append(Warnings(warnings), quotaWarnings...), err
+}
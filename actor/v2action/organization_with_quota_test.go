@@ -0,0 +1,134 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Organization With Quota Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetOrganizationsWithQuotas", func() {
+		var (
+			pages    [][]OrganizationWithQuota
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			pages = nil
+			fakeCloudControllerClient.GetOrganizationsByPageStub = func(_ []ccv2.Query, handlePage func([]ccv2.Organization) error) (ccv2.Warnings, error) {
+				err := handlePage([]ccv2.Organization{
+					{GUID: "org-guid-1", Name: "org-1", QuotaDefinitionGUID: "quota-guid-1"},
+					{GUID: "org-guid-2", Name: "org-2", QuotaDefinitionGUID: "quota-guid-1"},
+				})
+				if err != nil {
+					return ccv2.Warnings{"orgs-warning-1"}, err
+				}
+				err = handlePage([]ccv2.Organization{
+					{GUID: "org-guid-3", Name: "org-3", QuotaDefinitionGUID: "quota-guid-2"},
+				})
+				return ccv2.Warnings{"orgs-warning-1", "orgs-warning-2"}, err
+			}
+			fakeCloudControllerClient.GetOrganizationQuotaStub = func(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+				return ccv2.OrganizationQuota{GUID: guid, Name: "name-of-" + guid}, ccv2.Warnings{"quota-warning-" + guid}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.GetOrganizationsWithQuotas(func(orgs []OrganizationWithQuota) {
+				pages = append(pages, orgs)
+			})
+		})
+
+		Context("when no errors are encountered", func() {
+			It("calls the handler with each page of organizations joined with their quota names", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pages).To(Equal([][]OrganizationWithQuota{
+					{
+						{
+							Organization: Organization{GUID: "org-guid-1", Name: "org-1", QuotaDefinitionGUID: "quota-guid-1"},
+							QuotaName:    "name-of-quota-guid-1",
+						},
+						{
+							Organization: Organization{GUID: "org-guid-2", Name: "org-2", QuotaDefinitionGUID: "quota-guid-1"},
+							QuotaName:    "name-of-quota-guid-1",
+						},
+					},
+					{
+						{
+							Organization: Organization{GUID: "org-guid-3", Name: "org-3", QuotaDefinitionGUID: "quota-guid-2"},
+							QuotaName:    "name-of-quota-guid-2",
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("orgs-warning-1", "orgs-warning-2", "quota-warning-quota-guid-1", "quota-warning-quota-guid-2"))
+			})
+
+			It("only retrieves each quota once", func() {
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("quota-guid-1"))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(1)).To(Equal("quota-guid-2"))
+			})
+		})
+
+		Context("when a quota cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotaStub = nil
+				fakeCloudControllerClient.GetOrganizationQuotaReturns(ccv2.OrganizationQuota{}, ccv2.Warnings{"quota-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("leaves the quota name empty", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pages).To(HaveLen(2))
+				Expect(pages[0][0].QuotaName).To(BeEmpty())
+			})
+		})
+
+		Context("when getting a quota fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some quota error")
+				fakeCloudControllerClient.GetOrganizationQuotaStub = nil
+				fakeCloudControllerClient.GetOrganizationQuotaReturns(ccv2.OrganizationQuota{}, ccv2.Warnings{"quota-warning"}, expectedErr)
+			})
+
+			It("stops handling pages and returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(pages).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("orgs-warning-1", "quota-warning"))
+			})
+		})
+
+		Context("when getting the organizations fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some orgs error")
+				fakeCloudControllerClient.GetOrganizationsByPageStub = nil
+				fakeCloudControllerClient.GetOrganizationsByPageReturns(ccv2.Warnings{"orgs-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("orgs-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,137 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+var organizationRoles = map[string]ccv2.OrganizationRole{
+	"OrgManager":     ccv2.OrgManagerRole,
+	"BillingManager": ccv2.BillingManagerRole,
+	"OrgAuditor":     ccv2.OrgAuditorRole,
+}
+
+var spaceRoles = map[string]ccv2.SpaceRole{
+	"SpaceManager":   ccv2.SpaceManagerRole,
+	"SpaceDeveloper": ccv2.SpaceDeveloperRole,
+	"SpaceAuditor":   ccv2.SpaceAuditorRole,
+}
+
+// RoleSeed is a role to give a user, identified by username, in a newly
+// created organization or space. Role is one of OrgManager, BillingManager
+// and OrgAuditor for organizations, and one of SpaceManager, SpaceDeveloper
+// and SpaceAuditor for spaces.
+type RoleSeed struct {
+	Username string
+	Role     string
+}
+
+// InvalidRoleError is returned when a RoleSeed has a role that does not apply
+// to the organization or space being created.
+type InvalidRoleError struct {
+	Role string
+}
+
+func (e InvalidRoleError) Error() string {
+	return fmt.Sprintf("Role '%s' is not valid here.", e.Role)
+}
+
+// RoleSeedingError is returned when a role could not be given to a user while
+// seeding a newly created organization or space. By the time it is returned
+// the organization or space has been deleted again.
+type RoleSeedingError struct {
+	Username string
+	Role     string
+	Err      error
+}
+
+func (e RoleSeedingError) Error() string {
+	return fmt.Sprintf("Assigning role %s to user %s failed: %s", e.Role, e.Username, e.Err)
+}
+
+func validateOrganizationRoleSeeds(roleSeeds []RoleSeed) error {
+	for _, roleSeed := range roleSeeds {
+		if _, ok := organizationRoles[roleSeed.Role]; !ok {
+			return InvalidRoleError{Role: roleSeed.Role}
+		}
+	}
+	return nil
+}
+
+func validateSpaceRoleSeeds(roleSeeds []RoleSeed) error {
+	for _, roleSeed := range roleSeeds {
+		if _, ok := spaceRoles[roleSeed.Role]; !ok {
+			return InvalidRoleError{Role: roleSeed.Role}
+		}
+	}
+	return nil
+}
+
+// AssignOrganizationRoleByUsername makes the user a user of the organization
+// and then gives them the role, which is one of OrgManager, BillingManager and
+// OrgAuditor.
+func (actor Actor) AssignOrganizationRoleByUsername(orgGUID string, username string, role string) (Warnings, error) {
+	organizationRole, ok := organizationRoles[role]
+	if !ok {
+		return nil, InvalidRoleError{Role: role}
+	}
+
+	var allWarnings Warnings
+	for _, ccRole := range []ccv2.OrganizationRole{ccv2.OrgUserRole, organizationRole} {
+		warnings, err := actor.CloudControllerClient.UpdateOrganizationUserRoleByUsername(ccRole, orgGUID, username)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
+
+// AssignSpaceRoleByUsername makes the user a user of the space's organization,
+// which the Cloud Controller requires, and then gives them the space role,
+// which is one of SpaceManager, SpaceDeveloper and SpaceAuditor.
+func (actor Actor) AssignSpaceRoleByUsername(orgGUID string, spaceGUID string, username string, role string) (Warnings, error) {
+	spaceRole, ok := spaceRoles[role]
+	if !ok {
+		return nil, InvalidRoleError{Role: role}
+	}
+
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUserRoleByUsername(ccv2.OrgUserRole, orgGUID, username)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.UpdateSpaceUserRoleByUsername(spaceRole, spaceGUID, username)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// seedOrganizationRoles gives every seeded user their role in the
+// organization. It stops at the first failure.
+func (actor Actor) seedOrganizationRoles(orgGUID string, roleSeeds []RoleSeed) (Warnings, error) {
+	var allWarnings Warnings
+	for _, roleSeed := range roleSeeds {
+		warnings, err := actor.AssignOrganizationRoleByUsername(orgGUID, roleSeed.Username, roleSeed.Role)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, RoleSeedingError{Username: roleSeed.Username, Role: roleSeed.Role, Err: err}
+		}
+	}
+	return allWarnings, nil
+}
+
+// seedSpaceRoles gives every seeded user their role in the space. It stops at
+// the first failure.
+func (actor Actor) seedSpaceRoles(orgGUID string, spaceGUID string, roleSeeds []RoleSeed) (Warnings, error) {
+	var allWarnings Warnings
+	for _, roleSeed := range roleSeeds {
+		warnings, err := actor.AssignSpaceRoleByUsername(orgGUID, spaceGUID, roleSeed.Username, roleSeed.Role)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, RoleSeedingError{Username: roleSeed.Username, Role: roleSeed.Role, Err: err}
+		}
+	}
+	return allWarnings, nil
+}
@@ -0,0 +1,119 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Role Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("AssignOrganizationRoleByUsername", func() {
+		Context("when the role is assigned", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameReturns(ccv2.Warnings{"role-warning"}, nil)
+			})
+
+			It("makes the user a user of the org and gives them the role", func() {
+				warnings, err := actor.AssignOrganizationRoleByUsername("some-org-guid", "some-user", "OrgManager")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("role-warning", "role-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameCallCount()).To(Equal(2))
+				role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameArgsForCall(0)
+				Expect(role).To(Equal(ccv2.OrgUserRole))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(username).To(Equal("some-user"))
+				role, _, _ = fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameArgsForCall(1)
+				Expect(role).To(Equal(ccv2.OrgManagerRole))
+			})
+		})
+
+		Context("when the role is not an org role", func() {
+			It("returns an InvalidRoleError", func() {
+				_, err := actor.AssignOrganizationRoleByUsername("some-org-guid", "some-user", "SpaceDeveloper")
+				Expect(err).To(MatchError(InvalidRoleError{Role: "SpaceDeveloper"}))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when assigning the role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("role-error")
+				fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameReturns(ccv2.Warnings{"role-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.AssignOrganizationRoleByUsername("some-org-guid", "some-user", "OrgManager")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("role-warning"))
+			})
+		})
+	})
+
+	Describe("AssignSpaceRoleByUsername", func() {
+		Context("when the role is assigned", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameReturns(ccv2.Warnings{"org-role-warning"}, nil)
+				fakeCloudControllerClient.UpdateSpaceUserRoleByUsernameReturns(ccv2.Warnings{"space-role-warning"}, nil)
+			})
+
+			It("makes the user a user of the org and gives them the space role", func() {
+				warnings, err := actor.AssignSpaceRoleByUsername("some-org-guid", "some-space-guid", "some-user", "SpaceDeveloper")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-role-warning", "space-role-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameCallCount()).To(Equal(1))
+				role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameArgsForCall(0)
+				Expect(role).To(Equal(ccv2.OrgUserRole))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(username).To(Equal("some-user"))
+
+				Expect(fakeCloudControllerClient.UpdateSpaceUserRoleByUsernameCallCount()).To(Equal(1))
+				spaceRole, spaceGUID, username := fakeCloudControllerClient.UpdateSpaceUserRoleByUsernameArgsForCall(0)
+				Expect(spaceRole).To(Equal(ccv2.SpaceDeveloperRole))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(username).To(Equal("some-user"))
+			})
+		})
+
+		Context("when the role is not a space role", func() {
+			It("returns an InvalidRoleError", func() {
+				_, err := actor.AssignSpaceRoleByUsername("some-org-guid", "some-space-guid", "some-user", "OrgManager")
+				Expect(err).To(MatchError(InvalidRoleError{Role: "OrgManager"}))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when making the user a user of the org fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("org-role-error")
+				fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameReturns(ccv2.Warnings{"org-role-warning"}, expectedErr)
+			})
+
+			It("returns the error without assigning the space role", func() {
+				warnings, err := actor.AssignSpaceRoleByUsername("some-org-guid", "some-space-guid", "some-user", "SpaceDeveloper")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("org-role-warning"))
+				Expect(fakeCloudControllerClient.UpdateSpaceUserRoleByUsernameCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -37,6 +38,23 @@ func (r Route) String() string {
 	return routeString
 }
 
+// RouteSummaryBatchSize is the maximum number of GUIDs listed in one Cloud
+// Controller query when summarizing routes.
+const RouteSummaryBatchSize = 50
+
+// RouteSummary represents a route along with the name of its space and the
+// names of the applications mapped to it.
+type RouteSummary struct {
+	Route
+	SpaceName string
+	AppNames  []string
+}
+
+// Orphaned returns true when no application is mapped to the route.
+func (summary RouteSummary) Orphaned() bool {
+	return len(summary.AppNames) == 0
+}
+
 // OrphanedRoutesNotFoundError is an error wrapper that represents the case
 // when no orphaned routes are found.
 type OrphanedRoutesNotFoundError struct{}
@@ -66,6 +84,18 @@ func (e RouteInDifferentSpaceError) Error() string {
 	return fmt.Sprintf("route registered to another space")
 }
 
+// MoveRouteRollbackError is returned when moving a route fails and restoring
+// the route in its original space fails as well.
+type MoveRouteRollbackError struct {
+	Route       string
+	Err         error
+	RollbackErr error
+}
+
+func (e MoveRouteRollbackError) Error() string {
+	return fmt.Sprintf("Moving route %s failed: %s. Restoring the route also failed: %s", e.Route, e.Err, e.RollbackErr)
+}
+
 func (actor Actor) BindRouteToApplication(routeGUID string, appGUID string) (Warnings, error) {
 	_, warnings, err := actor.CloudControllerClient.BindRouteToApplication(routeGUID, appGUID)
 	if _, ok := err.(ccerror.InvalidRelationError); ok {
@@ -74,6 +104,67 @@ func (actor Actor) BindRouteToApplication(routeGUID string, appGUID string) (War
 	return Warnings(warnings), err
 }
 
+// BindRouteToApplicationPort binds the route to the provided port of the
+// application. An appPort of 0 uses the application's first port.
+func (actor Actor) BindRouteToApplicationPort(routeGUID string, appGUID string, appPort int) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.CreateRouteMapping(appGUID, routeGUID, appPort)
+	if _, ok := err.(ccerror.InvalidRelationError); ok {
+		return Warnings(warnings), RouteInDifferentSpaceError{}
+	}
+	return Warnings(warnings), err
+}
+
+// UpdateRouteApplicationPort moves the mapping of the route to the provided
+// port of the application. The route is mapped to the new port before the
+// mappings to other ports are removed, so it keeps serving traffic. It
+// returns false when the route is already mapped to appPort.
+func (actor Actor) UpdateRouteApplicationPort(routeGUID string, appGUID string, appPort int) (bool, Warnings, error) {
+	mappings, warnings, err := actor.CloudControllerClient.GetRouteMappings([]ccv2.Query{
+		{
+			Filter:   ccv2.RouteGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    routeGUID,
+		},
+		{
+			Filter:   ccv2.AppGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    appGUID,
+		},
+	})
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	for _, mapping := range mappings {
+		if mapping.AppPort == appPort {
+			return false, allWarnings, nil
+		}
+	}
+
+	bindWarnings, err := actor.BindRouteToApplicationPort(routeGUID, appGUID, appPort)
+	allWarnings = append(allWarnings, bindWarnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	for _, mapping := range mappings {
+		deleteWarnings, err := actor.CloudControllerClient.DeleteRouteMapping(mapping.GUID)
+		allWarnings = append(allWarnings, deleteWarnings...)
+		if err != nil {
+			return false, allWarnings, err
+		}
+	}
+	return true, allWarnings, nil
+}
+
+// UnbindRouteFromApplication unmaps the route from the application. The
+// route itself is not deleted.
+func (actor Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UnbindRouteFromApplication(routeGUID, appGUID)
+	return Warnings(warnings), err
+}
+
 func (actor Actor) CreateRoute(route Route, generatePort bool) (Route, Warnings, error) {
 	returnedRoute, warnings, err := actor.CloudControllerClient.CreateRoute(actorToCCRoute(route), generatePort)
 	return ccToActorRoute(returnedRoute, route.Domain), Warnings(warnings), err
@@ -112,6 +203,159 @@ func (actor Actor) GetOrphanedRoutesBySpace(spaceGUID string) ([]Route, Warnings
 	return orphanedRoutes, allWarnings, nil
 }
 
+// GetSpaceRouteSummaries returns the summaries of the routes of the provided
+// space.
+func (actor Actor) GetSpaceRouteSummaries(spaceGUID string) ([]RouteSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	space, warnings, err := actor.CloudControllerClient.GetSpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return nil, allWarnings, SpaceNotFoundError{GUID: spaceGUID}
+		}
+		return nil, allWarnings, err
+	}
+
+	ccv2Routes, warnings, err := actor.CloudControllerClient.GetSpaceRoutes(spaceGUID, nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	summaries, summaryWarnings, err := actor.summarizeRoutes(ccv2Routes, map[string]string{space.GUID: space.Name})
+	return summaries, append(allWarnings, summaryWarnings...), err
+}
+
+// GetOrganizationRouteSummaries returns the summaries of the routes of all
+// the spaces of the provided organization.
+func (actor Actor) GetOrganizationRouteSummaries(orgGUID string) ([]RouteSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	spaceNames := map[string]string{}
+	for _, space := range spaces {
+		spaceNames[space.GUID] = space.Name
+	}
+
+	ccv2Routes, routeWarnings, err := actor.CloudControllerClient.GetRoutes([]ccv2.Query{{
+		Filter:   ccv2.OrganizationGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    orgGUID,
+	}})
+	allWarnings = append(allWarnings, routeWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	summaries, summaryWarnings, err := actor.summarizeRoutes(ccv2Routes, spaceNames)
+	return summaries, append(allWarnings, summaryWarnings...), err
+}
+
+func (actor Actor) summarizeRoutes(ccv2Routes []ccv2.Route, spaceNames map[string]string) ([]RouteSummary, Warnings, error) {
+	routes, allWarnings, err := actor.applyDomain(ccv2Routes)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	appNames, warnings, err := actor.routeApplicationNames(routes)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var summaries []RouteSummary
+	for _, route := range routes {
+		summaries = append(summaries, RouteSummary{
+			Route:     route,
+			SpaceName: spaceNames[route.SpaceGUID],
+			AppNames:  appNames[route.GUID],
+		})
+	}
+
+	return summaries, allWarnings, nil
+}
+
+// routeApplicationNames returns the names of the applications mapped to the
+// routes, by route GUID. Instead of listing the applications of every route,
+// the route mappings are listed for RouteSummaryBatchSize routes at a time and
+// the applications for as many spaces at a time, since a route is only mapped
+// to applications of its own space.
+func (actor Actor) routeApplicationNames(routes []Route) (map[string][]string, Warnings, error) {
+	var (
+		allWarnings Warnings
+		routeGUIDs  []string
+		spaceGUIDs  []string
+	)
+	seenSpaces := map[string]bool{}
+	for _, route := range routes {
+		routeGUIDs = append(routeGUIDs, route.GUID)
+		if !seenSpaces[route.SpaceGUID] {
+			seenSpaces[route.SpaceGUID] = true
+			spaceGUIDs = append(spaceGUIDs, route.SpaceGUID)
+		}
+	}
+
+	var routeMappings []ccv2.RouteMapping
+	for _, batch := range batchGUIDs(routeGUIDs, RouteSummaryBatchSize) {
+		mappings, warnings, err := actor.CloudControllerClient.GetRouteMappings([]ccv2.Query{{
+			Filter:   ccv2.RouteGUIDFilter,
+			Operator: ccv2.InOperator,
+			Value:    strings.Join(batch, ","),
+		}})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		routeMappings = append(routeMappings, mappings...)
+	}
+	if len(routeMappings) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	names := map[string]string{}
+	for _, batch := range batchGUIDs(spaceGUIDs, RouteSummaryBatchSize) {
+		apps, warnings, err := actor.CloudControllerClient.GetApplications([]ccv2.Query{{
+			Filter:   ccv2.SpaceGUIDFilter,
+			Operator: ccv2.InOperator,
+			Value:    strings.Join(batch, ","),
+		}})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, app := range apps {
+			names[app.GUID] = app.Name
+		}
+	}
+
+	appNames := map[string][]string{}
+	for _, mapping := range routeMappings {
+		if name, ok := names[mapping.AppGUID]; ok {
+			appNames[mapping.RouteGUID] = append(appNames[mapping.RouteGUID], name)
+		}
+	}
+	return appNames, allWarnings, nil
+}
+
+// batchGUIDs splits the GUIDs into batches of at most size GUIDs, so that the
+// IN queries listing them stay within the URL length limits.
+func batchGUIDs(guids []string, size int) [][]string {
+	var batches [][]string
+	for len(guids) > size {
+		batches = append(batches, guids[:size])
+		guids = guids[size:]
+	}
+	if len(guids) > 0 {
+		batches = append(batches, guids)
+	}
+	return batches
+}
+
 // GetApplicationRoutes returns a list of routes associated with the provided
 // Application GUID.
 func (actor Actor) GetApplicationRoutes(applicationGUID string) ([]Route, Warnings, error) {
@@ -142,10 +386,83 @@ func (actor Actor) GetSpaceRoutes(spaceGUID string) ([]Route, Warnings, error) {
 	return routes, append(allWarnings, domainWarnings...), err
 }
 
-// DeleteRoute deletes the Route associated with the provided Route GUID.
+// DeleteRoute deletes the Route associated with the provided Route GUID. The
+// route is deleted asynchronously and DeleteRoute waits for the deletion job
+// to finish.
 func (actor Actor) DeleteRoute(routeGUID string) (Warnings, error) {
-	warnings, err := actor.CloudControllerClient.DeleteRoute(routeGUID)
-	return Warnings(warnings), err
+	job, allWarnings, err := actor.CloudControllerClient.DeleteRoute(routeGUID)
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+
+	warnings, err := actor.CloudControllerClient.PollJob(job)
+	allWarnings = append(allWarnings, warnings...)
+
+	return Warnings(allWarnings), err
+}
+
+// MoveRoute moves the route to the provided space by unmapping it from its
+// applications, deleting it and recreating it in the destination space. The
+// applications that were unmapped are returned. Applications can only be
+// mapped to routes in their own space, so they are not remapped to the moved
+// route. When any step fails, the route and its mappings are restored in the
+// original space; if restoring them fails too, a MoveRouteRollbackError is
+// returned.
+func (actor Actor) MoveRoute(route Route, spaceGUID string) (Route, []Application, Warnings, error) {
+	var allWarnings Warnings
+
+	apps, warnings, err := actor.GetRouteApplications(route.GUID, nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Route{}, nil, allWarnings, err
+	}
+
+	for i, app := range apps {
+		ccWarnings, unbindErr := actor.CloudControllerClient.UnbindRouteFromApplication(route.GUID, app.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if unbindErr != nil {
+			warnings, err = actor.rebindRoute(route, route.GUID, apps[:i], unbindErr)
+			return Route{}, nil, append(allWarnings, warnings...), err
+		}
+	}
+
+	warnings, err = actor.DeleteRoute(route.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		warnings, err = actor.rebindRoute(route, route.GUID, apps, err)
+		return Route{}, nil, append(allWarnings, warnings...), err
+	}
+
+	movedRoute := route
+	movedRoute.SpaceGUID = spaceGUID
+	movedRoute, warnings, err = actor.CreateRoute(movedRoute, false)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		restoredRoute, warnings, restoreErr := actor.CreateRoute(route, false)
+		allWarnings = append(allWarnings, warnings...)
+		if restoreErr != nil {
+			return Route{}, nil, allWarnings, MoveRouteRollbackError{Route: route.String(), Err: err, RollbackErr: restoreErr}
+		}
+		warnings, err = actor.rebindRoute(route, restoredRoute.GUID, apps, err)
+		return Route{}, nil, append(allWarnings, warnings...), err
+	}
+
+	return movedRoute, apps, allWarnings, nil
+}
+
+// rebindRoute maps the applications back to the route after moving it failed
+// with moveErr. It returns moveErr, or a MoveRouteRollbackError when an
+// application cannot be mapped again.
+func (actor Actor) rebindRoute(route Route, routeGUID string, apps []Application, moveErr error) (Warnings, error) {
+	var allWarnings Warnings
+	for _, app := range apps {
+		warnings, err := actor.BindRouteToApplication(routeGUID, app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, MoveRouteRollbackError{Route: route.String(), Err: moveErr, RollbackErr: err}
+		}
+	}
+	return allWarnings, moveErr
 }
 
 // GetRouteByHostAndDomain returns the HTTP route with the matching host and
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"fmt"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -80,6 +81,209 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("BindRouteToApplicationPort", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateRouteMappingReturns(
+					ccv2.RouteMapping{},
+					ccv2.Warnings{"mapping warning"},
+					nil)
+			})
+
+			It("maps the route to the application port and returns all warnings", func() {
+				warnings, err := actor.BindRouteToApplicationPort("some-route-guid", "some-app-guid", 9090)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("mapping warning"))
+
+				Expect(fakeCloudControllerClient.CreateRouteMappingCallCount()).To(Equal(1))
+				appGUID, routeGUID, appPort := fakeCloudControllerClient.CreateRouteMappingArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appPort).To(Equal(9090))
+			})
+		})
+
+		Context("when an InvalidRelationError is encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateRouteMappingReturns(
+					ccv2.RouteMapping{},
+					ccv2.Warnings{"mapping warning"},
+					ccerror.InvalidRelationError{})
+			})
+
+			It("returns a RouteInDifferentSpaceError", func() {
+				warnings, err := actor.BindRouteToApplicationPort("some-route-guid", "some-app-guid", 9090)
+				Expect(err).To(MatchError(RouteInDifferentSpaceError{}))
+				Expect(warnings).To(ConsistOf("mapping warning"))
+			})
+		})
+
+		Context("when a generic error is encountered", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("mapping failed")
+				fakeCloudControllerClient.CreateRouteMappingReturns(
+					ccv2.RouteMapping{},
+					ccv2.Warnings{"mapping warning"},
+					expectedErr)
+			})
+
+			It("returns the error", func() {
+				warnings, err := actor.BindRouteToApplicationPort("some-route-guid", "some-app-guid", 9090)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("mapping warning"))
+			})
+		})
+	})
+
+	Describe("UpdateRouteApplicationPort", func() {
+		var (
+			updated  bool
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			updated, warnings, err = actor.UpdateRouteApplicationPort("some-route-guid", "some-app-guid", 9090)
+		})
+
+		Context("when the route is already mapped to the port", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteMappingsReturns(
+					[]ccv2.RouteMapping{{GUID: "some-mapping-guid", AppGUID: "some-app-guid", AppPort: 9090, RouteGUID: "some-route-guid"}},
+					ccv2.Warnings{"get mappings warning"},
+					nil)
+			})
+
+			It("does not change the mappings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updated).To(BeFalse())
+				Expect(warnings).To(ConsistOf("get mappings warning"))
+
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{Filter: ccv2.RouteGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-route-guid"},
+					ccv2.Query{Filter: ccv2.AppGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-app-guid"},
+				))
+				Expect(fakeCloudControllerClient.CreateRouteMappingCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteRouteMappingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the route is mapped to a different port", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteMappingsReturns(
+					[]ccv2.RouteMapping{{GUID: "some-mapping-guid", AppGUID: "some-app-guid", AppPort: 8080, RouteGUID: "some-route-guid"}},
+					ccv2.Warnings{"get mappings warning"},
+					nil)
+				fakeCloudControllerClient.CreateRouteMappingReturns(
+					ccv2.RouteMapping{},
+					ccv2.Warnings{"mapping warning"},
+					nil)
+				fakeCloudControllerClient.DeleteRouteMappingReturns(ccv2.Warnings{"delete mapping warning"}, nil)
+			})
+
+			It("maps the route to the new port, removes the old mapping and returns all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updated).To(BeTrue())
+				Expect(warnings).To(ConsistOf("get mappings warning", "mapping warning", "delete mapping warning"))
+
+				Expect(fakeCloudControllerClient.CreateRouteMappingCallCount()).To(Equal(1))
+				appGUID, routeGUID, appPort := fakeCloudControllerClient.CreateRouteMappingArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appPort).To(Equal(9090))
+
+				Expect(fakeCloudControllerClient.DeleteRouteMappingCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteRouteMappingArgsForCall(0)).To(Equal("some-mapping-guid"))
+			})
+
+			Context("when mapping the new port fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("mapping failed")
+					fakeCloudControllerClient.CreateRouteMappingReturns(
+						ccv2.RouteMapping{},
+						ccv2.Warnings{"mapping warning"},
+						expectedErr)
+				})
+
+				It("keeps the old mapping and returns the error", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(updated).To(BeFalse())
+					Expect(warnings).To(ConsistOf("get mappings warning", "mapping warning"))
+					Expect(fakeCloudControllerClient.DeleteRouteMappingCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when removing the old mapping fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("delete failed")
+					fakeCloudControllerClient.DeleteRouteMappingReturns(ccv2.Warnings{"delete mapping warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(updated).To(BeFalse())
+					Expect(warnings).To(ConsistOf("get mappings warning", "mapping warning", "delete mapping warning"))
+				})
+			})
+		})
+
+		Context("when getting the route mappings fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get mappings failed")
+				fakeCloudControllerClient.GetRouteMappingsReturns(nil, ccv2.Warnings{"get mappings warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get mappings warning"))
+				Expect(fakeCloudControllerClient.CreateRouteMappingCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UnbindRouteFromApplication", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UnbindRouteFromApplicationReturns(ccv2.Warnings{"unbind warning"}, nil)
+			})
+
+			It("unmaps the route from the application and returns all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unbind warning"))
+
+				Expect(fakeCloudControllerClient.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID := fakeCloudControllerClient.UnbindRouteFromApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unbind failed")
+				fakeCloudControllerClient.UnbindRouteFromApplicationReturns(ccv2.Warnings{"unbind warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("unbind warning"))
+			})
+		})
+	})
+
 	Describe("CreateRoute", func() {
 		Context("when no errors are encountered", func() {
 			BeforeEach(func() {
@@ -335,15 +539,19 @@ var _ = Describe("Route Actions", func() {
 	Describe("DeleteRoute", func() {
 		Context("when the route exists", func() {
 			BeforeEach(func() {
-				fakeCloudControllerClient.DeleteRouteReturns(nil, nil)
+				fakeCloudControllerClient.DeleteRouteReturns(ccv2.Job{GUID: "job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, nil)
 			})
 
-			It("deletes the route", func() {
-				_, err := actor.DeleteRoute("some-route-guid")
+			It("deletes the route and waits for the deletion job", func() {
+				warnings, err := actor.DeleteRoute("some-route-guid")
 				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning", "poll-warning"))
 
 				Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(1))
 				Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("some-route-guid"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "job-guid"}))
 			})
 		})
 
@@ -352,14 +560,380 @@ var _ = Describe("Route Actions", func() {
 
 			BeforeEach(func() {
 				expectedErr = errors.New("bananahammock")
-				fakeCloudControllerClient.DeleteRouteReturns(ccv2.Warnings{"foo", "bar"}, expectedErr)
+				fakeCloudControllerClient.DeleteRouteReturns(ccv2.Job{}, ccv2.Warnings{"foo", "bar"}, expectedErr)
 			})
 
 			It("returns both the warnings and the error", func() {
 				warnings, err := actor.DeleteRoute("some-route-guid")
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("foo", "bar"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the deletion job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = ccerror.JobFailedError{JobGUID: "job-guid", Message: "oops"}
+				fakeCloudControllerClient.DeleteRouteReturns(ccv2.Job{GUID: "job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.DeleteRoute("some-route-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("delete-warning", "poll-warning"))
+			})
+		})
+	})
+
+	Describe("MoveRoute", func() {
+		var (
+			route      Route
+			movedRoute Route
+			apps       []Application
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			route = Route{
+				GUID:      "some-route-guid",
+				Host:      "some-host",
+				Path:      "/some-path",
+				Domain:    Domain{GUID: "some-domain-guid", Name: "example.com"},
+				SpaceGUID: "source-space-guid",
+			}
+
+			fakeCloudControllerClient.GetRouteApplicationsReturns(
+				[]ccv2.Application{{GUID: "app-guid-1", Name: "app-1"}, {GUID: "app-guid-2", Name: "app-2"}},
+				ccv2.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UnbindRouteFromApplicationReturns(ccv2.Warnings{"unbind-warning"}, nil)
+			fakeCloudControllerClient.DeleteRouteReturns(ccv2.Job{GUID: "job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, nil)
+			fakeCloudControllerClient.CreateRouteReturns(
+				ccv2.Route{GUID: "new-route-guid", Host: "some-host", Path: "/some-path", DomainGUID: "some-domain-guid", SpaceGUID: "dest-space-guid"},
+				ccv2.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			movedRoute, apps, warnings, executeErr = actor.MoveRoute(route, "dest-space-guid")
+		})
+
+		Context("when every step succeeds", func() {
+			It("unmaps the apps, deletes the route and recreates it in the destination space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning", "unbind-warning", "unbind-warning", "delete-warning", "poll-warning", "create-warning"))
+				Expect(movedRoute.GUID).To(Equal("new-route-guid"))
+				Expect(movedRoute.SpaceGUID).To(Equal("dest-space-guid"))
+				Expect(movedRoute.Domain).To(Equal(route.Domain))
+				Expect(apps).To(ConsistOf(
+					Application{GUID: "app-guid-1", Name: "app-1"},
+					Application{GUID: "app-guid-2", Name: "app-2"},
+				))
+
+				Expect(fakeCloudControllerClient.UnbindRouteFromApplicationCallCount()).To(Equal(2))
+				routeGUID, appGUID := fakeCloudControllerClient.UnbindRouteFromApplicationArgsForCall(1)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appGUID).To(Equal("app-guid-2"))
+
+				Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("some-route-guid"))
+
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+				createdRoute, generatePort := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+				Expect(createdRoute).To(Equal(ccv2.Route{
+					Host:       "some-host",
+					Path:       "/some-path",
+					DomainGUID: "some-domain-guid",
+					SpaceGUID:  "dest-space-guid",
+				}))
+				Expect(generatePort).To(BeFalse())
+
+				Expect(fakeCloudControllerClient.BindRouteToApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when unmapping an app fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unbind-error")
+				fakeCloudControllerClient.UnbindRouteFromApplicationStub = func(string, string) (ccv2.Warnings, error) {
+					if fakeCloudControllerClient.UnbindRouteFromApplicationCallCount() == 2 {
+						return ccv2.Warnings{"unbind-warning"}, expectedErr
+					}
+					return ccv2.Warnings{"unbind-warning"}, nil
+				}
+				fakeCloudControllerClient.BindRouteToApplicationReturns(ccv2.Route{}, ccv2.Warnings{"bind-warning"}, nil)
+			})
+
+			It("maps the already unmapped apps again and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "unbind-warning", "unbind-warning", "bind-warning"))
+
+				Expect(fakeCloudControllerClient.BindRouteToApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID := fakeCloudControllerClient.BindRouteToApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appGUID).To(Equal("app-guid-1"))
+
+				Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when deleting the route fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = ccerror.JobFailedError{JobGUID: "job-guid", Message: "oops"}
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, expectedErr)
+			})
+
+			Context("when mapping the apps again succeeds", func() {
+				It("maps all apps again and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(fakeCloudControllerClient.BindRouteToApplicationCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when mapping the apps again fails", func() {
+				var rollbackErr error
+
+				BeforeEach(func() {
+					rollbackErr = errors.New("bind-error")
+					fakeCloudControllerClient.BindRouteToApplicationReturns(ccv2.Route{}, nil, rollbackErr)
+				})
+
+				It("returns a MoveRouteRollbackError", func() {
+					Expect(executeErr).To(MatchError(MoveRouteRollbackError{
+						Route:       "some-host.example.com/some-path",
+						Err:         expectedErr,
+						RollbackErr: rollbackErr,
+					}))
+				})
+			})
+		})
+
+		Context("when creating the route in the destination space fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create-error")
+				fakeCloudControllerClient.CreateRouteStub = func(route ccv2.Route, _ bool) (ccv2.Route, ccv2.Warnings, error) {
+					if route.SpaceGUID == "dest-space-guid" {
+						return ccv2.Route{}, ccv2.Warnings{"create-warning"}, expectedErr
+					}
+					return ccv2.Route{GUID: "restored-route-guid"}, ccv2.Warnings{"restore-warning"}, nil
+				}
+			})
+
+			Context("when restoring the route succeeds", func() {
+				It("recreates the route in the original space, maps the apps again and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ContainElement("restore-warning"))
+
+					Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(2))
+					restoredRoute, _ := fakeCloudControllerClient.CreateRouteArgsForCall(1)
+					Expect(restoredRoute.SpaceGUID).To(Equal("source-space-guid"))
+
+					Expect(fakeCloudControllerClient.BindRouteToApplicationCallCount()).To(Equal(2))
+					routeGUID, _ := fakeCloudControllerClient.BindRouteToApplicationArgsForCall(0)
+					Expect(routeGUID).To(Equal("restored-route-guid"))
+				})
+			})
+
+			Context("when restoring the route fails", func() {
+				var rollbackErr error
+
+				BeforeEach(func() {
+					rollbackErr = errors.New("restore-error")
+					fakeCloudControllerClient.CreateRouteStub = func(route ccv2.Route, _ bool) (ccv2.Route, ccv2.Warnings, error) {
+						if route.SpaceGUID == "dest-space-guid" {
+							return ccv2.Route{}, nil, expectedErr
+						}
+						return ccv2.Route{}, nil, rollbackErr
+					}
+				})
+
+				It("returns a MoveRouteRollbackError", func() {
+					Expect(executeErr).To(MatchError(MoveRouteRollbackError{
+						Route:       "some-host.example.com/some-path",
+						Err:         expectedErr,
+						RollbackErr: rollbackErr,
+					}))
+					Expect(fakeCloudControllerClient.BindRouteToApplicationCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	Describe("GetSpaceRouteSummaries", func() {
+		var (
+			summaries  []RouteSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceReturns(ccv2.Space{GUID: "space-guid", Name: "some-space"}, ccv2.Warnings{"space-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceRoutesReturns([]ccv2.Route{
+				{GUID: "route-guid-1", Host: "api", DomainGUID: "domain-guid", SpaceGUID: "space-guid"},
+				{GUID: "route-guid-2", Host: "old", DomainGUID: "domain-guid", SpaceGUID: "space-guid"},
+			}, ccv2.Warnings{"routes-warning"}, nil)
+			fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "domain-guid", Name: "example.com"}, nil, nil)
+			fakeCloudControllerClient.GetRouteMappingsReturns([]ccv2.RouteMapping{
+				{AppGUID: "app-guid-1", RouteGUID: "route-guid-1"},
+				{AppGUID: "app-guid-2", RouteGUID: "route-guid-1"},
+			}, ccv2.Warnings{"route-mappings-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv2.Application{
+				{GUID: "app-guid-1", Name: "app-1"},
+				{GUID: "app-guid-2", Name: "app-2"},
+				{GUID: "app-guid-3", Name: "app-3"},
+			}, ccv2.Warnings{"apps-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetSpaceRouteSummaries("space-guid")
+		})
+
+		It("returns the routes with their space and app names", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("space-warning", "routes-warning", "route-mappings-warning", "apps-warning"))
+			Expect(summaries).To(Equal([]RouteSummary{
+				{
+					Route:     Route{GUID: "route-guid-1", Host: "api", Domain: Domain{GUID: "domain-guid", Name: "example.com"}, SpaceGUID: "space-guid"},
+					SpaceName: "some-space",
+					AppNames:  []string{"app-1", "app-2"},
+				},
+				{
+					Route:     Route{GUID: "route-guid-2", Host: "old", Domain: Domain{GUID: "domain-guid", Name: "example.com"}, SpaceGUID: "space-guid"},
+					SpaceName: "some-space",
+				},
+			}))
+			Expect(summaries[0].Orphaned()).To(BeFalse())
+			Expect(summaries[1].Orphaned()).To(BeTrue())
+
+			Expect(fakeCloudControllerClient.GetSpaceArgsForCall(0)).To(Equal("space-guid"))
+			spaceGUID, _ := fakeCloudControllerClient.GetSpaceRoutesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("space-guid"))
+
+			Expect(fakeCloudControllerClient.GetRouteApplicationsCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(0)).To(Equal([]ccv2.Query{{
+				Filter:   ccv2.RouteGUIDFilter,
+				Operator: ccv2.InOperator,
+				Value:    "route-guid-1,route-guid-2",
+			}}))
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{{
+				Filter:   ccv2.SpaceGUIDFilter,
+				Operator: ccv2.InOperator,
+				Value:    "space-guid",
+			}}))
+		})
+
+		Context("when there are more routes than fit in one query", func() {
+			BeforeEach(func() {
+				var routes []ccv2.Route
+				for i := 0; i < RouteSummaryBatchSize+1; i++ {
+					routes = append(routes, ccv2.Route{GUID: fmt.Sprintf("route-guid-%d", i), DomainGUID: "domain-guid", SpaceGUID: "space-guid"})
+				}
+				fakeCloudControllerClient.GetSpaceRoutesReturns(routes, nil, nil)
+			})
+
+			It("lists the route mappings in batches", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(summaries).To(HaveLen(RouteSummaryBatchSize + 1))
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(1)[0].Value).To(Equal(fmt.Sprintf("route-guid-%d", RouteSummaryBatchSize)))
+			})
+		})
+
+		Context("when no application is mapped to the routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteMappingsReturns(nil, nil, nil)
+			})
+
+			It("does not list the applications", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(summaries[0].Orphaned()).To(BeTrue())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceReturns(ccv2.Space{}, ccv2.Warnings{"space-warning"}, ccerror.ResourceNotFoundError{})
 			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(SpaceNotFoundError{GUID: "space-guid"}))
+				Expect(warnings).To(ConsistOf("space-warning"))
+			})
+		})
+
+		Context("when getting the route mappings fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("route-mappings-error")
+				fakeCloudControllerClient.GetRouteMappingsReturns(nil, ccv2.Warnings{"route-mappings-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("space-warning", "routes-warning", "route-mappings-warning"))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apps-error")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("space-warning", "routes-warning", "route-mappings-warning", "apps-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationRouteSummaries", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{
+				{GUID: "space-guid-1", Name: "space-1"},
+				{GUID: "space-guid-2", Name: "space-2"},
+			}, ccv2.Warnings{"spaces-warning"}, nil)
+			fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+				{GUID: "route-guid-1", Host: "api", DomainGUID: "domain-guid", SpaceGUID: "space-guid-2"},
+			}, ccv2.Warnings{"routes-warning"}, nil)
+			fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "domain-guid", Name: "example.com"}, nil, nil)
+		})
+
+		It("returns the routes of the organization with their space names", func() {
+			summaries, warnings, err := actor.GetOrganizationRouteSummaries("org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("spaces-warning", "routes-warning"))
+			Expect(summaries).To(Equal([]RouteSummary{
+				{
+					Route:     Route{GUID: "route-guid-1", Host: "api", Domain: Domain{GUID: "domain-guid", Name: "example.com"}, SpaceGUID: "space-guid-2"},
+					SpaceName: "space-2",
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{{
+				Filter:   ccv2.OrganizationGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "org-guid",
+			}}))
 		})
 	})
 
@@ -25,6 +25,26 @@ func (actor Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID
 	return Warnings(warnings), err
 }
 
+// SecurityGroupSpaceBinding is the outcome of binding a security group to a
+// single space.
+type SecurityGroupSpaceBinding struct {
+	Space    Space
+	Warnings Warnings
+	Err      error
+}
+
+// BindSecurityGroupToSpaces binds the security group to every space, making
+// the requests concurrently. The results are returned in the order of spaces.
+func (actor Actor) BindSecurityGroupToSpaces(securityGroupGUID string, spaces []Space) []SecurityGroupSpaceBinding {
+	bindings := make([]SecurityGroupSpaceBinding, len(spaces))
+	_, _ = parallelize(len(spaces), func(i int) (Warnings, error) {
+		warnings, err := actor.BindSecurityGroupToSpace(securityGroupGUID, spaces[i].GUID)
+		bindings[i] = SecurityGroupSpaceBinding{Space: spaces[i], Warnings: warnings, Err: err}
+		return warnings, err
+	})
+	return bindings
+}
+
 func (actor Actor) GetSecurityGroupByName(securityGroupName string) (SecurityGroup, Warnings, error) {
 	securityGroups, warnings, err := actor.CloudControllerClient.GetSecurityGroups([]ccv2.Query{
 		{
@@ -43,8 +63,11 @@ func (actor Actor) GetSecurityGroupByName(securityGroupName string) (SecurityGro
 	}
 
 	securityGroup := SecurityGroup{
-		Name: securityGroups[0].Name,
-		GUID: securityGroups[0].GUID,
+		Name:           securityGroups[0].Name,
+		GUID:           securityGroups[0].GUID,
+		Rules:          securityGroups[0].Rules,
+		RunningDefault: securityGroups[0].RunningDefault,
+		StagingDefault: securityGroups[0].StagingDefault,
 	}
 	return securityGroup, Warnings(warnings), nil
 }
@@ -101,6 +124,55 @@ func (actor Actor) UnbindSecurityGroupByNameOrganizationNameAndSpaceName(securit
 	return allWarnings, err
 }
 
+// UnbindSecurityGroupFromAllSpaces removes the security group with the
+// provided name from every space it is bound to for running apps, and returns
+// the spaces it was removed from. It stops at the first failed removal, still
+// returning the spaces removed before it.
+func (actor Actor) UnbindSecurityGroupFromAllSpaces(securityGroupName string) ([]SecurityGroupSpace, Warnings, error) {
+	var allWarnings Warnings
+
+	securityGroup, warnings, err := actor.GetSecurityGroupByName(securityGroupName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaces, ccWarnings, err := actor.CloudControllerClient.GetSecurityGroupSpaces(securityGroup.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var removed []SecurityGroupSpace
+	orgNames := map[string]string{}
+	for _, space := range spaces {
+		orgName, ok := orgNames[space.OrganizationGUID]
+		if !ok {
+			org, warnings, err := actor.GetOrganization(space.OrganizationGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return removed, allWarnings, err
+			}
+			orgName = org.Name
+			orgNames[space.OrganizationGUID] = orgName
+		}
+
+		warnings, err = actor.unbindSecurityGroupAndSpace(securityGroup.GUID, space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return removed, allWarnings, err
+		}
+
+		removed = append(removed, SecurityGroupSpace{
+			OrganizationName: orgName,
+			SpaceName:        space.Name,
+			Lifecycle:        "running",
+		})
+	}
+
+	return removed, allWarnings, nil
+}
+
 func (actor Actor) unbindSecurityGroupAndSpace(securityGroupGUID string, spaceGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.RemoveSpaceFromSecurityGroup(securityGroupGUID, spaceGUID)
 	return Warnings(warnings), err
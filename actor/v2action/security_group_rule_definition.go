@@ -0,0 +1,335 @@
+package v2action
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// SecurityGroupRuleDefinition is a security group rule as described in a
+// rules file.
+type SecurityGroupRuleDefinition struct {
+	Protocol    string        `json:"protocol" yaml:"protocol"`
+	Destination string        `json:"destination" yaml:"destination"`
+	Ports       string        `json:"ports,omitempty" yaml:"ports,omitempty"`
+	Type        types.NullInt `json:"type" yaml:"type"`
+	Code        types.NullInt `json:"code" yaml:"code"`
+	Description string        `json:"description,omitempty" yaml:"description,omitempty"`
+	Log         bool          `json:"log,omitempty" yaml:"log,omitempty"`
+}
+
+// SecurityGroupRulesParser parses the contents of a rules file.
+type SecurityGroupRulesParser func(raw []byte) ([]SecurityGroupRuleDefinition, error)
+
+// SecurityGroupRulesParsers maps rules file extensions to the parser used for
+// them. Files with any other extension are parsed as JSON.
+var SecurityGroupRulesParsers = map[string]SecurityGroupRulesParser{
+	".csv":  ParseCSVSecurityGroupRules,
+	".json": ParseJSONSecurityGroupRules,
+	".yaml": ParseYAMLSecurityGroupRules,
+	".yml":  ParseYAMLSecurityGroupRules,
+}
+
+// InvalidSecurityGroupRulesFileError is returned when a rules file cannot be
+// parsed.
+type InvalidSecurityGroupRulesFileError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidSecurityGroupRulesFileError) Error() string {
+	return fmt.Sprintf("Incorrect format in security group rules file %s: %s", e.Path, e.Err)
+}
+
+// InvalidSecurityGroupRuleError is returned when a security group rule would
+// be rejected by the Cloud Controller. RuleNumber starts at 1.
+type InvalidSecurityGroupRuleError struct {
+	RuleNumber int
+	Reason     string
+}
+
+func (e InvalidSecurityGroupRuleError) Error() string {
+	return fmt.Sprintf("Security group rule %d is invalid: %s", e.RuleNumber, e.Reason)
+}
+
+// ReadSecurityGroupRulesFile parses the rules in the provided file, using the
+// parser registered for its extension, and validates them.
+func (actor Actor) ReadSecurityGroupRulesFile(path string) ([]SecurityGroupRuleDefinition, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	parse, ok := SecurityGroupRulesParsers[strings.ToLower(filepath.Ext(path))]
+	if !ok {
+		parse = ParseJSONSecurityGroupRules
+	}
+
+	rules, err := parse(raw)
+	if err != nil {
+		return nil, InvalidSecurityGroupRulesFileError{Path: path, Err: err}
+	}
+
+	return rules, ValidateSecurityGroupRules(rules)
+}
+
+// ParseJSONSecurityGroupRules parses a JSON array of rules.
+func ParseJSONSecurityGroupRules(raw []byte) ([]SecurityGroupRuleDefinition, error) {
+	var rules []SecurityGroupRuleDefinition
+	err := json.Unmarshal(raw, &rules)
+	return rules, err
+}
+
+// ParseYAMLSecurityGroupRules parses a YAML sequence of rules with the same
+// keys as the JSON format.
+func ParseYAMLSecurityGroupRules(raw []byte) ([]SecurityGroupRuleDefinition, error) {
+	var rules []SecurityGroupRuleDefinition
+	err := yaml.Unmarshal(raw, &rules)
+	return rules, err
+}
+
+// ParseCSVSecurityGroupRules parses CSV with one rule per row. The first row
+// names the columns, which are the keys of the JSON format in any order.
+// Empty cells are treated as missing values and lines starting with # are
+// ignored.
+func ParseCSVSecurityGroupRules(raw []byte) ([]SecurityGroupRuleDefinition, error) {
+	reader := csv.NewReader(bytes.NewReader(raw))
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("the header row is missing")
+	}
+
+	header := records[0]
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+		switch header[i] {
+		case "protocol", "destination", "ports", "type", "code", "description", "log":
+		default:
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+	}
+
+	var rules []SecurityGroupRuleDefinition
+	for i, record := range records[1:] {
+		var rule SecurityGroupRuleDefinition
+		for j, value := range record {
+			value = strings.TrimSpace(value)
+
+			switch header[j] {
+			case "protocol":
+				rule.Protocol = value
+			case "destination":
+				rule.Destination = value
+			case "ports":
+				rule.Ports = value
+			case "description":
+				rule.Description = value
+			case "type":
+				err = rule.Type.ParseStringValue(value)
+			case "code":
+				err = rule.Code.ParseStringValue(value)
+			case "log":
+				if value != "" {
+					rule.Log, err = strconv.ParseBool(value)
+				}
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %s %q is not valid", i+2, header[j], value)
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// SecurityGroupNameTakenError is returned when creating a security group with
+// a name that is already in use.
+type SecurityGroupNameTakenError struct {
+	Name string
+}
+
+func (e SecurityGroupNameTakenError) Error() string {
+	return fmt.Sprintf("Security group '%s' already exists.", e.Name)
+}
+
+// CreateSecurityGroup creates a security group with the provided rules.
+func (actor Actor) CreateSecurityGroup(name string, rules []SecurityGroupRuleDefinition) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.CreateSecurityGroup(name, newCCSecurityGroupRules(rules))
+	if _, ok := err.(ccerror.SecurityGroupNameTakenError); ok {
+		return Warnings(warnings), SecurityGroupNameTakenError{Name: name}
+	}
+	return Warnings(warnings), err
+}
+
+// UpdateSecurityGroupRules replaces the rules of the security group with the
+// provided name.
+func (actor Actor) UpdateSecurityGroupRules(name string, rules []SecurityGroupRuleDefinition) (Warnings, error) {
+	securityGroup, allWarnings, err := actor.GetSecurityGroupByName(name)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateSecurityGroupRules(securityGroup.GUID, newCCSecurityGroupRules(rules))
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// ValidateSecurityGroupRules returns an InvalidSecurityGroupRuleError for the
+// first rule that the Cloud Controller would reject.
+func ValidateSecurityGroupRules(rules []SecurityGroupRuleDefinition) error {
+	for i, rule := range rules {
+		if reason := rule.invalidReason(); reason != "" {
+			return InvalidSecurityGroupRuleError{RuleNumber: i + 1, Reason: reason}
+		}
+	}
+	return nil
+}
+
+func (rule SecurityGroupRuleDefinition) invalidReason() string {
+	switch rule.Protocol {
+	case "tcp", "udp", "icmp", "all":
+	case "":
+		return "protocol is required"
+	default:
+		return fmt.Sprintf("protocol %q must be one of tcp, udp, icmp or all", rule.Protocol)
+	}
+
+	if reason := invalidDestinationReason(rule.Destination); reason != "" {
+		return reason
+	}
+
+	switch rule.Protocol {
+	case "tcp", "udp":
+		if rule.Ports == "" {
+			return fmt.Sprintf("ports are required for %s rules", rule.Protocol)
+		}
+		if reason := invalidPortsReason(rule.Ports); reason != "" {
+			return reason
+		}
+	default:
+		if rule.Ports != "" {
+			return fmt.Sprintf("ports are not allowed for %s rules", rule.Protocol)
+		}
+	}
+
+	if rule.Protocol == "icmp" {
+		if !rule.Type.IsSet {
+			return "type is required for icmp rules"
+		}
+		if rule.Type.Value < -1 || rule.Type.Value > 255 {
+			return fmt.Sprintf("type %d must be between -1 and 255", rule.Type.Value)
+		}
+		if !rule.Code.IsSet {
+			return "code is required for icmp rules"
+		}
+		if rule.Code.Value < -1 || rule.Code.Value > 255 {
+			return fmt.Sprintf("code %d must be between -1 and 255", rule.Code.Value)
+		}
+	} else if rule.Type.IsSet || rule.Code.IsSet {
+		return "type and code are only allowed for icmp rules"
+	}
+
+	if rule.Log && rule.Protocol != "tcp" {
+		return "log is only allowed for tcp rules"
+	}
+
+	return ""
+}
+
+func invalidDestinationReason(destination string) string {
+	if destination == "" {
+		return "destination is required"
+	}
+
+	if strings.Contains(destination, "/") {
+		ip, _, err := net.ParseCIDR(destination)
+		if err != nil || ip.To4() == nil {
+			return fmt.Sprintf("destination %q is not a valid IPv4 CIDR block", destination)
+		}
+		return ""
+	}
+
+	if strings.Contains(destination, "-") {
+		bounds := strings.SplitN(destination, "-", 2)
+		start, end := parseIPv4(bounds[0]), parseIPv4(bounds[1])
+		if start == nil || end == nil {
+			return fmt.Sprintf("destination %q is not a valid IPv4 address range", destination)
+		}
+		if bytes.Compare(start, end) > 0 {
+			return fmt.Sprintf("destination %q must start at or before the end of the range", destination)
+		}
+		return ""
+	}
+
+	if parseIPv4(destination) == nil {
+		return fmt.Sprintf("destination %q must be an IPv4 address, CIDR block or address range", destination)
+	}
+	return ""
+}
+
+func invalidPortsReason(ports string) string {
+	for _, portRange := range strings.Split(ports, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(portRange), "-", 2)
+
+		var values []int
+		for _, bound := range bounds {
+			port, err := strconv.Atoi(bound)
+			if err != nil {
+				return fmt.Sprintf("ports %q must be a port, a comma separated list of ports or a range such as 8000-9000", ports)
+			}
+			if port < 1 || port > 65535 {
+				return fmt.Sprintf("port %d must be between 1 and 65535", port)
+			}
+			values = append(values, port)
+		}
+
+		if len(values) == 2 && values[0] > values[1] {
+			return fmt.Sprintf("port range %q must start at or before its end", strings.TrimSpace(portRange))
+		}
+	}
+	return ""
+}
+
+func parseIPv4(address string) net.IP {
+	ip := net.ParseIP(strings.TrimSpace(address))
+	if ip == nil {
+		return nil
+	}
+	return ip.To4()
+}
+
+func newCCSecurityGroupRules(rules []SecurityGroupRuleDefinition) []ccv2.SecurityGroupRule {
+	ccRules := make([]ccv2.SecurityGroupRule, len(rules))
+	for i, rule := range rules {
+		ccRules[i] = ccv2.SecurityGroupRule{
+			Code:        rule.Code,
+			Description: rule.Description,
+			Destination: rule.Destination,
+			Log:         rule.Log,
+			Ports:       rule.Ports,
+			Protocol:    rule.Protocol,
+			Type:        rule.Type,
+		}
+	}
+	return ccRules
+}
@@ -0,0 +1,344 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Rule Definition Actions", func() {
+	Describe("ReadSecurityGroupRulesFile", func() {
+		var (
+			actor     Actor
+			rulesDir  string
+			rulesPath string
+			rules     []SecurityGroupRuleDefinition
+			readErr   error
+		)
+
+		BeforeEach(func() {
+			actor = NewActor(nil, nil)
+
+			var err error
+			rulesDir, err = ioutil.TempDir("", "security-group-rules")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(rulesDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			rules, readErr = actor.ReadSecurityGroupRulesFile(rulesPath)
+		})
+
+		writeRules := func(name string, contents string) {
+			rulesPath = filepath.Join(rulesDir, name)
+			Expect(ioutil.WriteFile(rulesPath, []byte(contents), 0600)).To(Succeed())
+		}
+
+		expectedRules := []SecurityGroupRuleDefinition{
+			{Protocol: "tcp", Destination: "10.0.11.0/24", Ports: "80,443", Description: "web", Log: true},
+			{
+				Protocol:    "icmp",
+				Destination: "0.0.0.0-9.255.255.255",
+				Type:        types.NullInt{IsSet: true, Value: 0},
+				Code:        types.NullInt{IsSet: true, Value: -1},
+			},
+		}
+
+		Context("when the file contains valid JSON rules", func() {
+			BeforeEach(func() {
+				writeRules("rules.json", `[
+					{"protocol": "tcp", "destination": "10.0.11.0/24", "ports": "80,443", "description": "web", "log": true},
+					{"protocol": "icmp", "destination": "0.0.0.0-9.255.255.255", "type": 0, "code": -1}
+				]`)
+			})
+
+			It("returns the rules", func() {
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(rules).To(Equal(expectedRules))
+			})
+		})
+
+		Context("when the file has an unknown extension", func() {
+			BeforeEach(func() {
+				writeRules("rules.txt", `[{"protocol": "all", "destination": "10.0.0.1"}]`)
+			})
+
+			It("parses the file as JSON", func() {
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(rules).To(Equal([]SecurityGroupRuleDefinition{{Protocol: "all", Destination: "10.0.0.1"}}))
+			})
+		})
+
+		Context("when the file contains valid YAML rules", func() {
+			BeforeEach(func() {
+				writeRules("rules.YML", `---
+- protocol: tcp
+  destination: 10.0.11.0/24
+  ports: 80,443
+  description: web
+  log: true
+- protocol: icmp
+  destination: 0.0.0.0-9.255.255.255
+  type: 0
+  code: -1
+`)
+			})
+
+			It("returns the rules", func() {
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(rules).To(Equal(expectedRules))
+			})
+		})
+
+		Context("when the file contains valid CSV rules", func() {
+			BeforeEach(func() {
+				writeRules("rules.csv", `protocol,destination,ports,type,code,description,log
+# web traffic
+tcp, 10.0.11.0/24, "80,443",,,web,true
+icmp,0.0.0.0-9.255.255.255,,0,-1,,
+`)
+			})
+
+			It("returns the rules", func() {
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(rules).To(Equal(expectedRules))
+			})
+		})
+
+		Context("when a CSV file has an unknown column", func() {
+			BeforeEach(func() {
+				writeRules("rules.csv", "protocol,destination,port\ntcp,10.0.0.1,80\n")
+			})
+
+			It("returns an InvalidSecurityGroupRulesFileError", func() {
+				Expect(readErr).To(MatchError(InvalidSecurityGroupRulesFileError{
+					Path: rulesPath,
+					Err:  errors.New(`unknown column "port"`),
+				}))
+			})
+		})
+
+		Context("when a CSV file has a cell that cannot be parsed", func() {
+			BeforeEach(func() {
+				writeRules("rules.csv", "protocol,destination,type,code\nicmp,10.0.0.1,echo,0\n")
+			})
+
+			It("returns an InvalidSecurityGroupRulesFileError", func() {
+				Expect(readErr).To(MatchError(InvalidSecurityGroupRulesFileError{
+					Path: rulesPath,
+					Err:  errors.New(`row 2: type "echo" is not valid`),
+				}))
+			})
+		})
+
+		Context("when the file is not a JSON array of rules", func() {
+			BeforeEach(func() {
+				writeRules("rules.json", `{"protocol": "tcp"}`)
+			})
+
+			It("returns an InvalidSecurityGroupRulesFileError", func() {
+				Expect(readErr).To(BeAssignableToTypeOf(InvalidSecurityGroupRulesFileError{}))
+				Expect(readErr.(InvalidSecurityGroupRulesFileError).Path).To(Equal(rulesPath))
+			})
+		})
+
+		Context("when a rule is invalid", func() {
+			BeforeEach(func() {
+				writeRules("rules.json", `[
+					{"protocol": "tcp", "destination": "10.0.11.0/24", "ports": "80"},
+					{"protocol": "tcp", "destination": "10.0.11.0/24"}
+				]`)
+			})
+
+			It("returns an InvalidSecurityGroupRuleError", func() {
+				Expect(readErr).To(MatchError(InvalidSecurityGroupRuleError{
+					RuleNumber: 2,
+					Reason:     "ports are required for tcp rules",
+				}))
+			})
+		})
+	})
+
+	Describe("CreateSecurityGroup", func() {
+		var (
+			actor                     Actor
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+			warnings                  Warnings
+			createErr                 error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, createErr = actor.CreateSecurityGroup("some-security-group", []SecurityGroupRuleDefinition{
+				{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{IsSet: true, Value: 8}, Code: types.NullInt{IsSet: true, Value: 0}},
+			})
+		})
+
+		Context("when the security group is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"create-warning"}, nil)
+			})
+
+			It("creates the security group with the rules", func() {
+				Expect(createErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+
+				Expect(fakeCloudControllerClient.CreateSecurityGroupCallCount()).To(Equal(1))
+				name, rules := fakeCloudControllerClient.CreateSecurityGroupArgsForCall(0)
+				Expect(name).To(Equal("some-security-group"))
+				Expect(rules).To(Equal([]ccv2.SecurityGroupRule{
+					{Protocol: "icmp", Destination: "10.0.0.1", Type: types.NullInt{IsSet: true, Value: 8}, Code: types.NullInt{IsSet: true, Value: 0}},
+				}))
+			})
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"create-warning"}, ccerror.SecurityGroupNameTakenError{})
+			})
+
+			It("returns a SecurityGroupNameTakenError and all warnings", func() {
+				Expect(createErr).To(MatchError(SecurityGroupNameTakenError{Name: "some-security-group"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateSecurityGroupRules", func() {
+		var (
+			actor                     Actor
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+			warnings                  Warnings
+			updateErr                 error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, updateErr = actor.UpdateSecurityGroupRules("some-security-group", []SecurityGroupRuleDefinition{
+				{Protocol: "all", Destination: "10.0.0.1"},
+			})
+		})
+
+		Context("when the security group does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a SecurityGroupNotFoundError and all warnings", func() {
+				Expect(updateErr).To(MatchError(SecurityGroupNotFoundError{Name: "some-security-group"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the security group exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns([]ccv2.SecurityGroup{
+					{GUID: "some-security-group-guid", Name: "some-security-group"},
+				}, ccv2.Warnings{"get-warning"}, nil)
+				fakeCloudControllerClient.UpdateSecurityGroupRulesReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"update-warning"}, nil)
+			})
+
+			It("replaces the rules of the security group", func() {
+				Expect(updateErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupRulesCallCount()).To(Equal(1))
+				guid, rules := fakeCloudControllerClient.UpdateSecurityGroupRulesArgsForCall(0)
+				Expect(guid).To(Equal("some-security-group-guid"))
+				Expect(rules).To(Equal([]ccv2.SecurityGroupRule{{Protocol: "all", Destination: "10.0.0.1"}}))
+			})
+		})
+	})
+
+	Describe("ValidateSecurityGroupRules", func() {
+		DescribeTable("accepts valid rules",
+			func(rule SecurityGroupRuleDefinition) {
+				Expect(ValidateSecurityGroupRules([]SecurityGroupRuleDefinition{rule})).To(Succeed())
+			},
+			Entry("tcp with a single port", SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.1", Ports: "443"}),
+			Entry("udp with a port list and range", SecurityGroupRuleDefinition{Protocol: "udp", Destination: "10.0.0.0/8", Ports: "53, 8000-9000"}),
+			Entry("all to an address range", SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.1-10.0.0.9"}),
+			Entry("icmp with every type and code", SecurityGroupRuleDefinition{
+				Protocol:    "icmp",
+				Destination: "0.0.0.0/0",
+				Type:        types.NullInt{IsSet: true, Value: -1},
+				Code:        types.NullInt{IsSet: true, Value: -1},
+			}),
+		)
+
+		DescribeTable("rejects invalid rules",
+			func(rule SecurityGroupRuleDefinition, reason string) {
+				Expect(ValidateSecurityGroupRules([]SecurityGroupRuleDefinition{rule})).To(MatchError(InvalidSecurityGroupRuleError{
+					RuleNumber: 1,
+					Reason:     reason,
+				}))
+			},
+			Entry("missing protocol", SecurityGroupRuleDefinition{Destination: "10.0.0.1"},
+				"protocol is required"),
+			Entry("unknown protocol", SecurityGroupRuleDefinition{Protocol: "tcpp", Destination: "10.0.0.1", Ports: "80"},
+				`protocol "tcpp" must be one of tcp, udp, icmp or all`),
+			Entry("missing destination", SecurityGroupRuleDefinition{Protocol: "all"},
+				"destination is required"),
+			Entry("invalid address", SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.300"},
+				`destination "10.0.0.300" must be an IPv4 address, CIDR block or address range`),
+			Entry("invalid CIDR block", SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.0/33"},
+				`destination "10.0.0.0/33" is not a valid IPv4 CIDR block`),
+			Entry("invalid address range", SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.1-banana"},
+				`destination "10.0.0.1-banana" is not a valid IPv4 address range`),
+			Entry("backwards address range", SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.9-10.0.0.1"},
+				`destination "10.0.0.9-10.0.0.1" must start at or before the end of the range`),
+			Entry("tcp without ports", SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.1"},
+				"ports are required for tcp rules"),
+			Entry("malformed ports", SecurityGroupRuleDefinition{Protocol: "udp", Destination: "10.0.0.1", Ports: "80;443"},
+				`ports "80;443" must be a port, a comma separated list of ports or a range such as 8000-9000`),
+			Entry("port out of range", SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.1", Ports: "0"},
+				"port 0 must be between 1 and 65535"),
+			Entry("backwards port range", SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.0.1", Ports: "80,9000-8000"},
+				`port range "9000-8000" must start at or before its end`),
+			Entry("ports on an all rule", SecurityGroupRuleDefinition{Protocol: "all", Destination: "10.0.0.1", Ports: "80"},
+				"ports are not allowed for all rules"),
+			Entry("icmp without a type", SecurityGroupRuleDefinition{Protocol: "icmp", Destination: "10.0.0.1"},
+				"type is required for icmp rules"),
+			Entry("icmp type out of range", SecurityGroupRuleDefinition{
+				Protocol:    "icmp",
+				Destination: "10.0.0.1",
+				Type:        types.NullInt{IsSet: true, Value: 256},
+			}, "type 256 must be between -1 and 255"),
+			Entry("icmp without a code", SecurityGroupRuleDefinition{
+				Protocol:    "icmp",
+				Destination: "10.0.0.1",
+				Type:        types.NullInt{IsSet: true, Value: 8},
+			}, "code is required for icmp rules"),
+			Entry("type on a tcp rule", SecurityGroupRuleDefinition{
+				Protocol:    "tcp",
+				Destination: "10.0.0.1",
+				Ports:       "80",
+				Code:        types.NullInt{IsSet: true, Value: 0},
+			}, "type and code are only allowed for icmp rules"),
+			Entry("log on a udp rule", SecurityGroupRuleDefinition{Protocol: "udp", Destination: "10.0.0.1", Ports: "53", Log: true},
+				"log is only allowed for tcp rules"),
+		)
+	})
+})
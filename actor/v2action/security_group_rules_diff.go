@@ -0,0 +1,77 @@
+package v2action
+
+// SecurityGroupRuleChange is a rule whose protocol and destination are
+// unchanged but whose other fields differ.
+type SecurityGroupRuleChange struct {
+	From SecurityGroupRuleDefinition
+	To   SecurityGroupRuleDefinition
+}
+
+// SecurityGroupRulesDiff describes how replacing the rules of a security
+// group would change them.
+type SecurityGroupRulesDiff struct {
+	Added   []SecurityGroupRuleDefinition
+	Changed []SecurityGroupRuleChange
+	Removed []SecurityGroupRuleDefinition
+}
+
+// Empty returns true if the rules would not change.
+func (diff SecurityGroupRulesDiff) Empty() bool {
+	return len(diff.Added) == 0 && len(diff.Changed) == 0 && len(diff.Removed) == 0
+}
+
+// GetSecurityGroupRulesDiff compares the provided rules to the current rules
+// of the security group with the provided name.
+func (actor Actor) GetSecurityGroupRulesDiff(name string, rules []SecurityGroupRuleDefinition) (SecurityGroupRulesDiff, Warnings, error) {
+	securityGroup, warnings, err := actor.GetSecurityGroupByName(name)
+	if err != nil {
+		return SecurityGroupRulesDiff{}, warnings, err
+	}
+
+	var remaining []SecurityGroupRuleDefinition
+	for _, rule := range securityGroup.Rules {
+		remaining = append(remaining, SecurityGroupRuleDefinition{
+			Code:        rule.Code,
+			Description: rule.Description,
+			Destination: rule.Destination,
+			Log:         rule.Log,
+			Ports:       rule.Ports,
+			Protocol:    rule.Protocol,
+			Type:        rule.Type,
+		})
+	}
+
+	var unmatched []SecurityGroupRuleDefinition
+	for _, rule := range rules {
+		if i := indexOfRule(remaining, func(current SecurityGroupRuleDefinition) bool { return current == rule }); i >= 0 {
+			remaining = append(remaining[:i], remaining[i+1:]...)
+		} else {
+			unmatched = append(unmatched, rule)
+		}
+	}
+
+	var diff SecurityGroupRulesDiff
+	for _, rule := range unmatched {
+		i := indexOfRule(remaining, func(current SecurityGroupRuleDefinition) bool {
+			return current.Protocol == rule.Protocol && current.Destination == rule.Destination
+		})
+		if i >= 0 {
+			diff.Changed = append(diff.Changed, SecurityGroupRuleChange{From: remaining[i], To: rule})
+			remaining = append(remaining[:i], remaining[i+1:]...)
+		} else {
+			diff.Added = append(diff.Added, rule)
+		}
+	}
+	diff.Removed = remaining
+
+	return diff, warnings, nil
+}
+
+func indexOfRule(rules []SecurityGroupRuleDefinition, matches func(SecurityGroupRuleDefinition) bool) int {
+	for i, rule := range rules {
+		if matches(rule) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,125 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Rules Diff Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetSecurityGroupRulesDiff", func() {
+		var (
+			rules      []SecurityGroupRuleDefinition
+			diff       SecurityGroupRulesDiff
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			diff, warnings, executeErr = actor.GetSecurityGroupRulesDiff("some-security-group", rules)
+		})
+
+		Context("when getting the security group fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"get-warning"}, expectedErr)
+			})
+
+			It("returns the warnings and the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+
+		Context("when the security group exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns([]ccv2.SecurityGroup{
+					{
+						GUID: "some-security-group-guid",
+						Name: "some-security-group",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+							{Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80"},
+							{
+								Protocol:    "icmp",
+								Destination: "0.0.0.0/0",
+								Type:        types.NullInt{IsSet: true, Value: -1},
+								Code:        types.NullInt{IsSet: true, Value: -1},
+							},
+						},
+					},
+				}, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			Context("when the rules are the same", func() {
+				BeforeEach(func() {
+					rules = []SecurityGroupRuleDefinition{
+						{
+							Protocol:    "icmp",
+							Destination: "0.0.0.0/0",
+							Type:        types.NullInt{IsSet: true, Value: -1},
+							Code:        types.NullInt{IsSet: true, Value: -1},
+						},
+						{Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80"},
+						{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+					}
+				})
+
+				It("returns an empty diff", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-warning"))
+					Expect(diff.Empty()).To(BeTrue())
+				})
+			})
+
+			Context("when the rules differ", func() {
+				BeforeEach(func() {
+					rules = []SecurityGroupRuleDefinition{
+						{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+						{Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80,8080"},
+						{Protocol: "udp", Destination: "10.0.2.1", Ports: "53"},
+					}
+				})
+
+				It("returns the added, changed and removed rules", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(diff.Empty()).To(BeFalse())
+					Expect(diff.Added).To(Equal([]SecurityGroupRuleDefinition{
+						{Protocol: "udp", Destination: "10.0.2.1", Ports: "53"},
+					}))
+					Expect(diff.Changed).To(Equal([]SecurityGroupRuleChange{
+						{
+							From: SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80"},
+							To:   SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80,8080"},
+						},
+					}))
+					Expect(diff.Removed).To(Equal([]SecurityGroupRuleDefinition{
+						{
+							Protocol:    "icmp",
+							Destination: "0.0.0.0/0",
+							Type:        types.NullInt{IsSet: true, Value: -1},
+							Code:        types.NullInt{IsSet: true, Value: -1},
+						},
+					}))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// SecurityGroupSpace is a space that a security group is bound to, along with
+// the lifecycle phase the binding applies to.
+type SecurityGroupSpace struct {
+	OrganizationName string
+	SpaceName        string
+	Lifecycle        string
+}
+
+// SecurityGroupSummary represents a security group along with the spaces it
+// is bound to.
+type SecurityGroupSummary struct {
+	SecurityGroup
+	Spaces []SecurityGroupSpace
+}
+
+// GetSecurityGroupSummary returns the security group with the provided name
+// along with the spaces it is bound to for both running and staging.
+func (actor Actor) GetSecurityGroupSummary(securityGroupName string) (SecurityGroupSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	securityGroup, warnings, err := actor.GetSecurityGroupByName(securityGroupName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SecurityGroupSummary{}, allWarnings, err
+	}
+
+	summary := SecurityGroupSummary{SecurityGroup: securityGroup}
+	orgNames := map[string]string{}
+
+	lifecycles := []struct {
+		name      string
+		getSpaces func(string) ([]ccv2.Space, ccv2.Warnings, error)
+	}{
+		{name: "running", getSpaces: actor.CloudControllerClient.GetSecurityGroupSpaces},
+		{name: "staging", getSpaces: actor.CloudControllerClient.GetSecurityGroupStagingSpaces},
+	}
+
+	for _, lifecycle := range lifecycles {
+		spaces, warnings, err := lifecycle.getSpaces(securityGroup.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SecurityGroupSummary{}, allWarnings, err
+		}
+
+		for _, space := range spaces {
+			orgName, ok := orgNames[space.OrganizationGUID]
+			if !ok {
+				org, warnings, err := actor.GetOrganization(space.OrganizationGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return SecurityGroupSummary{}, allWarnings, err
+				}
+				orgName = org.Name
+				orgNames[space.OrganizationGUID] = orgName
+			}
+
+			summary.Spaces = append(summary.Spaces, SecurityGroupSpace{
+				OrganizationName: orgName,
+				SpaceName:        space.Name,
+				Lifecycle:        lifecycle.name,
+			})
+		}
+	}
+
+	sort.SliceStable(summary.Spaces, func(i int, j int) bool {
+		if summary.Spaces[i].OrganizationName != summary.Spaces[j].OrganizationName {
+			return summary.Spaces[i].OrganizationName < summary.Spaces[j].OrganizationName
+		}
+		return summary.Spaces[i].SpaceName < summary.Spaces[j].SpaceName
+	})
+
+	return summary, allWarnings, nil
+}
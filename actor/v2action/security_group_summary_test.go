@@ -0,0 +1,132 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Summary Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetSecurityGroupSummary", func() {
+		var (
+			summary    SecurityGroupSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetSecurityGroupSummary("some-security-group")
+		})
+
+		Context("when the security group does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a SecurityGroupNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(SecurityGroupNotFoundError{Name: "some-security-group"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+
+		Context("when the security group exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns([]ccv2.SecurityGroup{
+					{
+						GUID:           "some-security-group-guid",
+						Name:           "some-security-group",
+						StagingDefault: true,
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+						},
+					},
+				}, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			Context("when getting the running spaces fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-error")
+					fakeCloudControllerClient.GetSecurityGroupSpacesReturns(nil, ccv2.Warnings{"running-warning"}, expectedErr)
+				})
+
+				It("returns the warnings and the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-warning", "running-warning"))
+					Expect(fakeCloudControllerClient.GetSecurityGroupStagingSpacesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting an organization fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-error")
+					fakeCloudControllerClient.GetSecurityGroupSpacesReturns([]ccv2.Space{
+						{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+					}, ccv2.Warnings{"running-warning"}, nil)
+					fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{}, ccv2.Warnings{"org-warning"}, expectedErr)
+				})
+
+				It("returns the warnings and the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-warning", "running-warning", "org-warning"))
+				})
+			})
+
+			Context("when the spaces and organizations are found", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSecurityGroupSpacesReturns([]ccv2.Space{
+						{GUID: "space-guid-2", Name: "space-2", OrganizationGUID: "org-guid-2"},
+						{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+					}, ccv2.Warnings{"running-warning"}, nil)
+					fakeCloudControllerClient.GetSecurityGroupStagingSpacesReturns([]ccv2.Space{
+						{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+					}, ccv2.Warnings{"staging-warning"}, nil)
+					fakeCloudControllerClient.GetOrganizationStub = func(guid string) (ccv2.Organization, ccv2.Warnings, error) {
+						switch guid {
+						case "org-guid-1":
+							return ccv2.Organization{GUID: guid, Name: "org-b"}, ccv2.Warnings{"org-warning-1"}, nil
+						default:
+							return ccv2.Organization{GUID: guid, Name: "org-a"}, ccv2.Warnings{"org-warning-2"}, nil
+						}
+					}
+				})
+
+				It("returns the security group with its spaces sorted by organization and space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-warning", "running-warning", "org-warning-2", "org-warning-1", "staging-warning"))
+
+					Expect(summary.Name).To(Equal("some-security-group"))
+					Expect(summary.StagingDefault).To(BeTrue())
+					Expect(summary.RunningDefault).To(BeFalse())
+					Expect(summary.Rules).To(HaveLen(1))
+					Expect(summary.Spaces).To(Equal([]SecurityGroupSpace{
+						{OrganizationName: "org-a", SpaceName: "space-2", Lifecycle: "running"},
+						{OrganizationName: "org-b", SpaceName: "space-1", Lifecycle: "running"},
+						{OrganizationName: "org-b", SpaceName: "space-1", Lifecycle: "staging"},
+					}))
+
+					Expect(fakeCloudControllerClient.GetSecurityGroupSpacesArgsForCall(0)).To(Equal("some-security-group-guid"))
+					Expect(fakeCloudControllerClient.GetSecurityGroupStagingSpacesArgsForCall(0)).To(Equal("some-security-group-guid"))
+					Expect(fakeCloudControllerClient.GetOrganizationCallCount()).To(Equal(2))
+				})
+			})
+		})
+	})
+})
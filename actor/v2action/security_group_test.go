@@ -138,6 +138,46 @@ var _ = Describe("Security Group Actions", func() {
 		})
 	})
 
+	Describe("BindSecurityGroupToSpaces", func() {
+		var bindings []SecurityGroupSpaceBinding
+
+		JustBeforeEach(func() {
+			bindings = actor.BindSecurityGroupToSpaces("some-security-group-guid", []Space{
+				{GUID: "space-guid-1", Name: "space-1"},
+				{GUID: "space-guid-2", Name: "space-2"},
+				{GUID: "space-guid-3", Name: "space-3"},
+			})
+		})
+
+		Context("when some of the bindings fail", func() {
+			var returnedError error
+
+			BeforeEach(func() {
+				returnedError = errors.New("associate-space-error")
+				fakeCloudControllerClient.AssociateSpaceWithSecurityGroupStub = func(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
+					if spaceGUID == "space-guid-2" {
+						return ccv2.Warnings{"warning-2"}, returnedError
+					}
+					return ccv2.Warnings{"warning-" + spaceGUID}, nil
+				}
+			})
+
+			It("binds every space and returns the results in space order", func() {
+				Expect(fakeCloudControllerClient.AssociateSpaceWithSecurityGroupCallCount()).To(Equal(3))
+				for i := 0; i < 3; i++ {
+					securityGroupGUID, _ := fakeCloudControllerClient.AssociateSpaceWithSecurityGroupArgsForCall(i)
+					Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
+				}
+
+				Expect(bindings).To(Equal([]SecurityGroupSpaceBinding{
+					{Space: Space{GUID: "space-guid-1", Name: "space-1"}, Warnings: Warnings{"warning-space-guid-1"}},
+					{Space: Space{GUID: "space-guid-2", Name: "space-2"}, Warnings: Warnings{"warning-2"}, Err: returnedError},
+					{Space: Space{GUID: "space-guid-3", Name: "space-3"}, Warnings: Warnings{"warning-space-guid-3"}},
+				}))
+			})
+		})
+	})
+
 	Describe("GetSpaceRunningSecurityGroupsBySpace", func() {
 		Context("when the space exists and there are no errors", func() {
 			BeforeEach(func() {
@@ -495,4 +535,109 @@ var _ = Describe("Security Group Actions", func() {
 			})
 		})
 	})
+
+	Describe("UnbindSecurityGroupFromAllSpaces", func() {
+		var (
+			removed  []SecurityGroupSpace
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{{
+					Name: "some-security-group",
+					GUID: "some-security-group-guid",
+				}},
+				ccv2.Warnings{"security-group-warning"},
+				nil)
+			fakeCloudControllerClient.GetSecurityGroupSpacesReturns(
+				[]ccv2.Space{
+					{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+					{GUID: "space-guid-2", Name: "space-2", OrganizationGUID: "org-guid-1"},
+					{GUID: "space-guid-3", Name: "space-3", OrganizationGUID: "org-guid-2"},
+				},
+				ccv2.Warnings{"spaces-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationStub = func(guid string) (ccv2.Organization, ccv2.Warnings, error) {
+				return ccv2.Organization{GUID: guid, Name: "name-of-" + guid}, ccv2.Warnings{"org-warning"}, nil
+			}
+			fakeCloudControllerClient.RemoveSpaceFromSecurityGroupReturns(ccv2.Warnings{"remove-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			removed, warnings, err = actor.UnbindSecurityGroupFromAllSpaces("some-security-group")
+		})
+
+		It("removes the security group from every bound space", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal([]SecurityGroupSpace{
+				{OrganizationName: "name-of-org-guid-1", SpaceName: "space-1", Lifecycle: "running"},
+				{OrganizationName: "name-of-org-guid-1", SpaceName: "space-2", Lifecycle: "running"},
+				{OrganizationName: "name-of-org-guid-2", SpaceName: "space-3", Lifecycle: "running"},
+			}))
+			Expect(warnings).To(Equal(Warnings{
+				"security-group-warning", "spaces-warning",
+				"org-warning", "remove-warning",
+				"remove-warning",
+				"org-warning", "remove-warning",
+			}))
+
+			Expect(fakeCloudControllerClient.GetSecurityGroupSpacesArgsForCall(0)).To(Equal("some-security-group-guid"))
+			Expect(fakeCloudControllerClient.GetOrganizationCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.RemoveSpaceFromSecurityGroupCallCount()).To(Equal(3))
+			securityGroupGUID, spaceGUID := fakeCloudControllerClient.RemoveSpaceFromSecurityGroupArgsForCall(2)
+			Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
+			Expect(spaceGUID).To(Equal("space-guid-3"))
+		})
+
+		Context("when the security group does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv2.Warnings{"security-group-warning"}, nil)
+			})
+
+			It("returns a SecurityGroupNotFoundError", func() {
+				Expect(err).To(MatchError(SecurityGroupNotFoundError{"some-security-group"}))
+				Expect(warnings).To(ConsistOf("security-group-warning"))
+				Expect(fakeCloudControllerClient.RemoveSpaceFromSecurityGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the bound spaces fails", func() {
+			var returnedError error
+
+			BeforeEach(func() {
+				returnedError = errors.New("get-spaces-error")
+				fakeCloudControllerClient.GetSecurityGroupSpacesReturns(nil, ccv2.Warnings{"spaces-warning"}, returnedError)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(returnedError))
+				Expect(warnings).To(ConsistOf("security-group-warning", "spaces-warning"))
+				Expect(fakeCloudControllerClient.RemoveSpaceFromSecurityGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when removing a binding fails", func() {
+			var returnedError error
+
+			BeforeEach(func() {
+				returnedError = errors.New("remove-error")
+				fakeCloudControllerClient.RemoveSpaceFromSecurityGroupStub = func(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
+					if spaceGUID == "space-guid-2" {
+						return ccv2.Warnings{"remove-warning"}, returnedError
+					}
+					return ccv2.Warnings{"remove-warning"}, nil
+				}
+			})
+
+			It("stops and returns the spaces removed so far", func() {
+				Expect(err).To(MatchError(returnedError))
+				Expect(removed).To(Equal([]SecurityGroupSpace{
+					{OrganizationName: "name-of-org-guid-1", SpaceName: "space-1", Lifecycle: "running"},
+				}))
+				Expect(fakeCloudControllerClient.RemoveSpaceFromSecurityGroupCallCount()).To(Equal(2))
+			})
+		})
+	})
 })
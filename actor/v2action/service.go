@@ -0,0 +1,69 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// Service represents a service offering.
+type Service ccv2.Service
+
+// ServiceNotFoundError is returned when a requested service offering is not
+// found.
+type ServiceNotFoundError struct {
+	Name     string
+	Provider string
+}
+
+func (e ServiceNotFoundError) Error() string {
+	if e.Provider == "" {
+		return fmt.Sprintf("Service '%s' not found.", e.Name)
+	}
+	return fmt.Sprintf("Service '%s' with provider '%s' not found.", e.Name, e.Provider)
+}
+
+// GetServiceByNameAndProvider returns the first service offering matching the
+// provided name. If provider is not empty, it is included in the lookup.
+func (actor Actor) GetServiceByNameAndProvider(name string, provider string) (Service, Warnings, error) {
+	queries := []ccv2.Query{{
+		Filter:   ccv2.LabelFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    name,
+	}}
+	if provider != "" {
+		queries = append(queries, ccv2.Query{
+			Filter:   ccv2.ProviderFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    provider,
+		})
+	}
+
+	services, warnings, err := actor.CloudControllerClient.GetServices(queries)
+	if err != nil {
+		return Service{}, Warnings(warnings), err
+	}
+
+	if len(services) == 0 {
+		return Service{}, Warnings(warnings), ServiceNotFoundError{
+			Name:     name,
+			Provider: provider,
+		}
+	}
+
+	return Service(services[0]), Warnings(warnings), nil
+}
+
+// PurgeServiceOffering removes the service offering matching the provided
+// name and provider from the Cloud Controller without contacting its service
+// broker.
+func (actor Actor) PurgeServiceOffering(name string, provider string) (Warnings, error) {
+	service, allWarnings, err := actor.GetServiceByNameAndProvider(name, provider)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.PurgeService(service.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
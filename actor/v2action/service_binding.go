@@ -21,6 +21,40 @@ func (e ServiceBindingNotFoundError) Error() string {
 	return fmt.Sprintf("Service binding for application GUID '%s', and service instance GUID '%s' not found.", e.AppGUID, e.ServiceInstanceGUID)
 }
 
+// BindServiceToApplication binds the service instance with the provided GUID
+// to the application with the provided GUID.
+func (actor Actor) BindServiceToApplication(appGUID string, serviceInstanceGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.CreateServiceBinding(appGUID, serviceInstanceGUID)
+	return Warnings(warnings), err
+}
+
+// DeleteServiceBinding deletes the service binding with the provided GUID,
+// unbinding its service instance from its application.
+func (actor Actor) DeleteServiceBinding(serviceBindingGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteServiceBinding(serviceBindingGUID)
+	return Warnings(warnings), err
+}
+
+// GetServiceBindingsByApplication returns all the service bindings of the
+// application with the provided GUID.
+func (actor Actor) GetServiceBindingsByApplication(appGUID string) ([]ServiceBinding, Warnings, error) {
+	ccv2ServiceBindings, warnings, err := actor.CloudControllerClient.GetServiceBindings([]ccv2.Query{{
+		Filter:   ccv2.AppGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    appGUID,
+	}})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	serviceBindings := make([]ServiceBinding, len(ccv2ServiceBindings))
+	for i, ccv2ServiceBinding := range ccv2ServiceBindings {
+		serviceBindings[i] = ServiceBinding(ccv2ServiceBinding)
+	}
+
+	return serviceBindings, Warnings(warnings), nil
+}
+
 // GetServiceBindingByApplicationAndServiceInstance returns a service binding
 // given an application GUID and and service instance GUID.
 func (actor Actor) GetServiceBindingByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (ServiceBinding, Warnings, error) {
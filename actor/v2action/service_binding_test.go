@@ -22,6 +22,88 @@ var _ = Describe("Service Binding Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, nil)
 	})
 
+	Describe("BindServiceToApplication", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateServiceBindingReturns(
+				ccv2.ServiceBinding{GUID: "some-service-binding-guid"},
+				ccv2.Warnings{"create-warning"},
+				errors.New("some-error"),
+			)
+		})
+
+		It("creates the binding and returns warnings and error", func() {
+			warnings, err := actor.BindServiceToApplication("some-app-guid", "some-service-instance-guid")
+			Expect(err).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("create-warning"))
+
+			Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+			appGUID, serviceInstanceGUID := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+		})
+	})
+
+	Describe("DeleteServiceBinding", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceBindingReturns(
+				ccv2.Warnings{"delete-warning"},
+				errors.New("some-error"),
+			)
+		})
+
+		It("deletes the binding and returns warnings and error", func() {
+			warnings, err := actor.DeleteServiceBinding("some-service-binding-guid")
+			Expect(err).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("delete-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteServiceBindingCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeleteServiceBindingArgsForCall(0)).To(Equal("some-service-binding-guid"))
+		})
+	})
+
+	Describe("GetServiceBindingsByApplication", func() {
+		Context("when the client succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{
+						{GUID: "some-service-binding-guid-1", ServiceInstanceGUID: "some-service-instance-guid-1"},
+						{GUID: "some-service-binding-guid-2", ServiceInstanceGUID: "some-service-instance-guid-2"},
+					},
+					ccv2.Warnings{"bindings-warning"},
+					nil,
+				)
+			})
+
+			It("returns the bindings of the app and warnings", func() {
+				serviceBindings, warnings, err := actor.GetServiceBindingsByApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceBindings).To(Equal([]ServiceBinding{
+					{GUID: "some-service-binding-guid-1", ServiceInstanceGUID: "some-service-instance-guid-1"},
+					{GUID: "some-service-binding-guid-2", ServiceInstanceGUID: "some-service-instance-guid-2"},
+				}))
+				Expect(warnings).To(ConsistOf("bindings-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.AppGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-app-guid",
+				}}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"bindings-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetServiceBindingsByApplication("some-app-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("bindings-warning"))
+			})
+		})
+	})
+
 	Describe("GetServiceBindingByApplicationAndServiceInstance", func() {
 		Context("when the service binding exists", func() {
 			BeforeEach(func() {
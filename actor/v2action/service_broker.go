@@ -0,0 +1,21 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// ServiceBroker represents a service broker.
+type ServiceBroker ccv2.ServiceBroker
+
+// GetServiceBrokers returns all the service brokers visible to the user.
+func (actor Actor) GetServiceBrokers() ([]ServiceBroker, Warnings, error) {
+	ccv2Brokers, warnings, err := actor.CloudControllerClient.GetServiceBrokers(nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	brokers := make([]ServiceBroker, len(ccv2Brokers))
+	for i, ccv2Broker := range ccv2Brokers {
+		brokers[i] = ServiceBroker(ccv2Broker)
+	}
+
+	return brokers, Warnings(warnings), nil
+}
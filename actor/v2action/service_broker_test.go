@@ -0,0 +1,64 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Broker Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetServiceBrokers", func() {
+		Context("when the cloud controller returns brokers", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBrokersReturns(
+					[]ccv2.ServiceBroker{
+						{GUID: "some-broker-guid-1", Name: "some-broker-1"},
+						{GUID: "some-broker-guid-2", Name: "some-broker-2"},
+					},
+					ccv2.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("returns the brokers and all warnings", func() {
+				brokers, warnings, err := actor.GetServiceBrokers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(brokers).To(ConsistOf(
+					ServiceBroker{GUID: "some-broker-guid-1", Name: "some-broker-1"},
+					ServiceBroker{GUID: "some-broker-guid-2", Name: "some-broker-2"},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeCloudControllerClient.GetServiceBrokersReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetServiceBrokers()
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -3,12 +3,33 @@ package v2action
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
 
 // ServiceInstance represents an instance of a service.
 type ServiceInstance ccv2.ServiceInstance
 
+// UserProvided returns true if the service instance is a user provided
+// service instance.
+func (instance ServiceInstance) UserProvided() bool {
+	return ccv2.ServiceInstance(instance).UserProvided()
+}
+
+// ServiceInstanceParameters are the parameters a service instance was
+// provisioned or updated with.
+type ServiceInstanceParameters map[string]interface{}
+
+// ServiceInstanceParametersFetchNotSupportedError is returned when the service
+// broker of a service instance does not support retrieving its parameters.
+type ServiceInstanceParametersFetchNotSupportedError struct {
+	Message string
+}
+
+func (e ServiceInstanceParametersFetchNotSupportedError) Error() string {
+	return e.Message
+}
+
 type ServiceInstanceNotFoundError struct {
 	Name string
 }
@@ -17,6 +38,41 @@ func (e ServiceInstanceNotFoundError) Error() string {
 	return fmt.Sprintf("Service instance '%s' not found.", e.Name)
 }
 
+// CreateServiceInstance creates a service instance of the plan with the
+// provided name of the service offering with the provided name.
+func (actor Actor) CreateServiceInstance(spaceGUID string, serviceName string, servicePlanName string, serviceInstanceName string) (ServiceInstance, Warnings, error) {
+	service, allWarnings, err := actor.GetServiceByNameAndProvider(serviceName, "")
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	plans, warnings, err := actor.GetServicePlansForService(service.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	for _, plan := range plans {
+		if plan.Name == servicePlanName {
+			serviceInstance, ccWarnings, err := actor.CloudControllerClient.CreateServiceInstance(spaceGUID, plan.GUID, serviceInstanceName)
+			allWarnings = append(allWarnings, ccWarnings...)
+			return ServiceInstance(serviceInstance), allWarnings, err
+		}
+	}
+
+	return ServiceInstance{}, allWarnings, ServicePlanNotFoundError{
+		PlanName:    servicePlanName,
+		ServiceName: serviceName,
+	}
+}
+
+// CreateUserProvidedServiceInstance creates a user provided service instance
+// without any credentials.
+func (actor Actor) CreateUserProvidedServiceInstance(spaceGUID string, serviceInstanceName string) (ServiceInstance, Warnings, error) {
+	serviceInstance, warnings, err := actor.CloudControllerClient.CreateUserProvidedServiceInstance(spaceGUID, serviceInstanceName)
+	return ServiceInstance(serviceInstance), Warnings(warnings), err
+}
+
 func (actor Actor) GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (ServiceInstance, Warnings, error) {
 	serviceInstances, warnings, err := actor.CloudControllerClient.GetSpaceServiceInstances(
 		spaceGUID,
@@ -57,3 +113,80 @@ func (actor Actor) GetServiceInstancesBySpace(spaceGUID string) ([]ServiceInstan
 
 	return serviceInstances, Warnings(warnings), nil
 }
+
+// GetServiceInstanceParameters returns the parameters of the service instance
+// associated with the provided GUID, as reported by its service broker.
+func (actor Actor) GetServiceInstanceParameters(serviceInstanceGUID string) (ServiceInstanceParameters, Warnings, error) {
+	parameters, warnings, err := actor.CloudControllerClient.GetServiceInstanceParameters(serviceInstanceGUID)
+	if err, ok := err.(ccerror.ServiceInstanceParametersFetchNotSupportedError); ok {
+		return nil, Warnings(warnings), ServiceInstanceParametersFetchNotSupportedError{Message: err.Message}
+	}
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	return ServiceInstanceParameters(parameters), Warnings(warnings), nil
+}
+
+// PurgeServiceInstanceByNameAndSpace removes the service instance matching the
+// provided name in the provided space from the Cloud Controller without
+// contacting its service broker.
+func (actor Actor) PurgeServiceInstanceByNameAndSpace(name string, spaceGUID string) (Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(name, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.PurgeServiceInstance(serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// ServiceInstanceNameTakenError is returned when renaming a service instance
+// to a name that is already in use in its space.
+type ServiceInstanceNameTakenError struct {
+	Name string
+}
+
+func (e ServiceInstanceNameTakenError) Error() string {
+	return fmt.Sprintf("Service instance '%s' already exists.", e.Name)
+}
+
+// RenameServiceInstanceByNameAndSpace renames the managed or user provided
+// service instance with the provided name in the space. A
+// ServiceInstanceNameTakenError is returned, without attempting the rename,
+// when another service instance in the space already has the new name.
+func (actor Actor) RenameServiceInstanceByNameAndSpace(oldName string, newName string, spaceGUID string) (ServiceInstance, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(oldName, spaceGUID)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	existingServiceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(newName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		if existingServiceInstance.GUID != serviceInstance.GUID {
+			return ServiceInstance{}, allWarnings, ServiceInstanceNameTakenError{Name: newName}
+		}
+	case ServiceInstanceNotFoundError:
+	default:
+		return ServiceInstance{}, allWarnings, err
+	}
+
+	var (
+		updatedServiceInstance ccv2.ServiceInstance
+		updateWarnings         ccv2.Warnings
+	)
+	if serviceInstance.UserProvided() {
+		updatedServiceInstance, updateWarnings, err = actor.CloudControllerClient.UpdateUserProvidedServiceInstanceName(serviceInstance.GUID, newName)
+	} else {
+		updatedServiceInstance, updateWarnings, err = actor.CloudControllerClient.UpdateServiceInstanceName(serviceInstance.GUID, newName)
+	}
+	allWarnings = append(allWarnings, updateWarnings...)
+	if _, ok := err.(ccerror.ServiceInstanceNameTakenError); ok {
+		return ServiceInstance{}, allWarnings, ServiceInstanceNameTakenError{Name: newName}
+	}
+
+	return ServiceInstance(updatedServiceInstance), allWarnings, err
+}
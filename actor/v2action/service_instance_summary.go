@@ -0,0 +1,200 @@
+package v2action
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// ServiceInstanceShareType describes whether a service instance has been
+// shared with other spaces, or into the current space from another one.
+type ServiceInstanceShareType string
+
+const (
+	ServiceInstanceIsSharedFrom ServiceInstanceShareType = "SharedFrom"
+	ServiceInstanceIsSharedTo   ServiceInstanceShareType = "SharedTo"
+	ServiceInstanceIsNotShared  ServiceInstanceShareType = "NotShared"
+)
+
+// ServiceInstanceSharedFrom is the space a service instance was shared from.
+type ServiceInstanceSharedFrom ccv2.ServiceInstanceSharedFrom
+
+// ServiceInstanceSharedTo is a space a service instance has been shared to.
+type ServiceInstanceSharedTo ccv2.ServiceInstanceSharedTo
+
+// BoundApplication is an application bound to a service instance.
+type BoundApplication struct {
+	AppName            string
+	ServiceBindingName string
+}
+
+// ServiceInstanceSummary represents a service instance along with its plan,
+// service offering, sharing information and bound applications. The plan,
+// service offering and sharing information are only set for managed service
+// instances.
+type ServiceInstanceSummary struct {
+	ServiceInstance
+
+	ServicePlan               ServicePlan
+	Service                   Service
+	ServiceInstanceShareType  ServiceInstanceShareType
+	ServiceInstanceSharedFrom ServiceInstanceSharedFrom
+	ServiceInstanceSharedTos  []ServiceInstanceSharedTo
+	BoundApplications         []BoundApplication
+}
+
+// UpgradeAvailable returns true when the plan of the service instance
+// advertises a newer version than the one the instance is running.
+func (summary ServiceInstanceSummary) UpgradeAvailable() bool {
+	planVersion := summary.ServicePlan.MaintenanceInfo.Version
+	return planVersion != "" && planVersion != summary.MaintenanceInfo.Version
+}
+
+// GetServiceInstanceSummaryByNameAndSpace returns a summary of the service
+// instance with the provided name in the provided space.
+func (actor Actor) GetServiceInstanceSummaryByNameAndSpace(name string, spaceGUID string) (ServiceInstanceSummary, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(name, spaceGUID)
+	if err != nil {
+		return ServiceInstanceSummary{}, allWarnings, err
+	}
+
+	summary := ServiceInstanceSummary{
+		ServiceInstance:          serviceInstance,
+		ServiceInstanceShareType: ServiceInstanceIsNotShared,
+	}
+
+	if ccv2.ServiceInstance(serviceInstance).Managed() {
+		warnings, err := actor.getServiceOfferingForSummary(&summary)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstanceSummary{}, allWarnings, err
+		}
+
+		warnings, err = actor.getSharingInfoForSummary(&summary)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstanceSummary{}, allWarnings, err
+		}
+	}
+
+	boundApps, warnings, err := actor.getBoundApplications(summary, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstanceSummary{}, allWarnings, err
+	}
+	summary.BoundApplications = boundApps
+
+	return summary, allWarnings, nil
+}
+
+func (actor Actor) getServiceOfferingForSummary(summary *ServiceInstanceSummary) (Warnings, error) {
+	plan, allWarnings, err := actor.CloudControllerClient.GetServicePlan(summary.ServicePlanGUID)
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+	summary.ServicePlan = ServicePlan(plan)
+
+	service, warnings, err := actor.CloudControllerClient.GetService(plan.ServiceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+	summary.Service = Service(service)
+
+	return Warnings(allWarnings), nil
+}
+
+// getSharingInfoForSummary fills in the spaces the service instance has been
+// shared from or to. Cloud Controllers that predate service instance sharing
+// do not have the sharing endpoints, in which case the instance is reported
+// as not shared.
+func (actor Actor) getSharingInfoForSummary(summary *ServiceInstanceSummary) (Warnings, error) {
+	sharedFrom, allWarnings, err := actor.CloudControllerClient.GetServiceInstanceSharedFrom(summary.GUID)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Warnings(allWarnings), nil
+	}
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+
+	if sharedFrom.SpaceGUID != "" {
+		summary.ServiceInstanceShareType = ServiceInstanceIsSharedFrom
+		summary.ServiceInstanceSharedFrom = ServiceInstanceSharedFrom(sharedFrom)
+		return Warnings(allWarnings), nil
+	}
+
+	sharedTos, warnings, err := actor.CloudControllerClient.GetServiceInstanceSharedTos(summary.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+
+	if len(sharedTos) > 0 {
+		summary.ServiceInstanceShareType = ServiceInstanceIsSharedTo
+		for _, sharedTo := range sharedTos {
+			summary.ServiceInstanceSharedTos = append(summary.ServiceInstanceSharedTos, ServiceInstanceSharedTo(sharedTo))
+		}
+	}
+
+	return Warnings(allWarnings), nil
+}
+
+// getBoundApplications returns the applications bound to the service instance
+// of the summary, sorted by name. The applications are listed by the spaces
+// they can be in: the provided space and the spaces the instance has been
+// shared from or to.
+func (actor Actor) getBoundApplications(summary ServiceInstanceSummary, spaceGUID string) ([]BoundApplication, Warnings, error) {
+	bindings, allWarnings, err := actor.CloudControllerClient.GetServiceBindings([]ccv2.Query{{
+		Filter:   ccv2.ServiceInstanceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    summary.GUID,
+	}})
+	if err != nil {
+		return nil, Warnings(allWarnings), err
+	}
+	if len(bindings) == 0 {
+		return nil, Warnings(allWarnings), nil
+	}
+
+	spaceGUIDs := []string{spaceGUID}
+	if summary.ServiceInstanceSharedFrom.SpaceGUID != "" {
+		spaceGUIDs = append(spaceGUIDs, summary.ServiceInstanceSharedFrom.SpaceGUID)
+	}
+	for _, sharedTo := range summary.ServiceInstanceSharedTos {
+		spaceGUIDs = append(spaceGUIDs, sharedTo.SpaceGUID)
+	}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications([]ccv2.Query{{
+		Filter:   ccv2.SpaceGUIDFilter,
+		Operator: ccv2.InOperator,
+		Value:    strings.Join(spaceGUIDs, ","),
+	}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, Warnings(allWarnings), err
+	}
+
+	appNames := map[string]string{}
+	for _, app := range apps {
+		appNames[app.GUID] = app.Name
+	}
+
+	var boundApps []BoundApplication
+	for _, binding := range bindings {
+		if appName, ok := appNames[binding.AppGUID]; ok {
+			boundApps = append(boundApps, BoundApplication{
+				AppName:            appName,
+				ServiceBindingName: binding.Name,
+			})
+		}
+	}
+
+	sort.Slice(boundApps, func(i int, j int) bool {
+		return sorting.SortAlphabetic(boundApps[i].AppName, boundApps[j].AppName)
+	})
+
+	return boundApps, Warnings(allWarnings), nil
+}
@@ -0,0 +1,308 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Summary Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("ServiceInstanceSummary", func() {
+		Describe("UpgradeAvailable", func() {
+			var summary ServiceInstanceSummary
+
+			BeforeEach(func() {
+				summary = ServiceInstanceSummary{}
+				summary.MaintenanceInfo = ccv2.MaintenanceInfo{Version: "1.0.0"}
+			})
+
+			Context("when the plan has a newer version", func() {
+				It("returns true", func() {
+					summary.ServicePlan.MaintenanceInfo = ccv2.MaintenanceInfo{Version: "2.0.0"}
+					Expect(summary.UpgradeAvailable()).To(BeTrue())
+				})
+			})
+
+			Context("when the plan has the same version", func() {
+				It("returns false", func() {
+					summary.ServicePlan.MaintenanceInfo = ccv2.MaintenanceInfo{Version: "1.0.0"}
+					Expect(summary.UpgradeAvailable()).To(BeFalse())
+				})
+			})
+
+			Context("when the plan does not advertise a version", func() {
+				It("returns false", func() {
+					Expect(summary.UpgradeAvailable()).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceSummaryByNameAndSpace", func() {
+		var (
+			summary    ServiceInstanceSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetServiceInstanceSummaryByNameAndSpace("some-service-instance", "some-space-guid")
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"instance-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("instance-warning"))
+			})
+		})
+
+		Context("when the service instance is managed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{
+						GUID:            "some-service-instance-guid",
+						Name:            "some-service-instance",
+						Type:            ccv2.ManagedService,
+						ServicePlanGUID: "some-plan-guid",
+					}},
+					ccv2.Warnings{"instance-warning"},
+					nil)
+				fakeCloudControllerClient.GetServicePlanReturns(
+					ccv2.ServicePlan{GUID: "some-plan-guid", Name: "some-plan", ServiceGUID: "some-service-guid"},
+					ccv2.Warnings{"plan-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceReturns(
+					ccv2.Service{GUID: "some-service-guid", Label: "some-service"},
+					ccv2.Warnings{"service-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceInstanceSharedFromReturns(
+					ccv2.ServiceInstanceSharedFrom{},
+					ccv2.Warnings{"shared-from-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(
+					nil,
+					ccv2.Warnings{"shared-to-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{
+						{Name: "binding-2", AppGUID: "app-guid-2"},
+						{Name: "binding-1", AppGUID: "app-guid-1"},
+					},
+					ccv2.Warnings{"bindings-warning"},
+					nil)
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{GUID: "app-guid-1", Name: "app-1"},
+						{GUID: "app-guid-2", Name: "app-2"},
+						{GUID: "unbound-app-guid", Name: "unbound-app"},
+					},
+					ccv2.Warnings{"apps-warning"},
+					nil)
+			})
+
+			It("returns the plan, service offering and bound apps sorted by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"instance-warning",
+					"plan-warning",
+					"service-warning",
+					"shared-from-warning",
+					"shared-to-warning",
+					"bindings-warning",
+					"apps-warning",
+				))
+
+				Expect(summary.GUID).To(Equal("some-service-instance-guid"))
+				Expect(summary.ServicePlan.Name).To(Equal("some-plan"))
+				Expect(summary.Service.Label).To(Equal("some-service"))
+				Expect(summary.ServiceInstanceShareType).To(Equal(ServiceInstanceIsNotShared))
+				Expect(summary.BoundApplications).To(Equal([]BoundApplication{
+					{AppName: "app-1", ServiceBindingName: "binding-1"},
+					{AppName: "app-2", ServiceBindingName: "binding-2"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetServicePlanArgsForCall(0)).To(Equal("some-plan-guid"))
+				Expect(fakeCloudControllerClient.GetServiceArgsForCall(0)).To(Equal("some-service-guid"))
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedFromArgsForCall(0)).To(Equal("some-service-instance-guid"))
+				Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.ServiceInstanceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-service-instance-guid",
+				}}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.SpaceGUIDFilter,
+					Operator: ccv2.InOperator,
+					Value:    "some-space-guid",
+				}}))
+				Expect(fakeCloudControllerClient.GetApplicationCallCount()).To(Equal(0))
+			})
+
+			Context("when the service instance was shared from another space", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceSharedFromReturns(
+						ccv2.ServiceInstanceSharedFrom{SpaceGUID: "other-space-guid", SpaceName: "other-space", OrganizationName: "other-org"},
+						ccv2.Warnings{"shared-from-warning"},
+						nil)
+				})
+
+				It("returns the space it was shared from without looking up shared to spaces", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(summary.ServiceInstanceShareType).To(Equal(ServiceInstanceIsSharedFrom))
+					Expect(summary.ServiceInstanceSharedFrom).To(Equal(ServiceInstanceSharedFrom{
+						SpaceGUID:        "other-space-guid",
+						SpaceName:        "other-space",
+						OrganizationName: "other-org",
+					}))
+					Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosCallCount()).To(Equal(0))
+				})
+
+				It("also lists the apps of the space it was shared from", func() {
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{{
+						Filter:   ccv2.SpaceGUIDFilter,
+						Operator: ccv2.InOperator,
+						Value:    "some-space-guid,other-space-guid",
+					}}))
+				})
+			})
+
+			Context("when the service instance has been shared to other spaces", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(
+						[]ccv2.ServiceInstanceSharedTo{
+							{SpaceGUID: "other-space-guid-1", SpaceName: "other-space-1", OrganizationName: "other-org", BoundAppCount: 3},
+							{SpaceGUID: "other-space-guid-2", SpaceName: "other-space-2", OrganizationName: "other-org", BoundAppCount: 0},
+						},
+						ccv2.Warnings{"shared-to-warning"},
+						nil)
+				})
+
+				It("returns the spaces it was shared to", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(summary.ServiceInstanceShareType).To(Equal(ServiceInstanceIsSharedTo))
+					Expect(summary.ServiceInstanceSharedTos).To(Equal([]ServiceInstanceSharedTo{
+						{SpaceGUID: "other-space-guid-1", SpaceName: "other-space-1", OrganizationName: "other-org", BoundAppCount: 3},
+						{SpaceGUID: "other-space-guid-2", SpaceName: "other-space-2", OrganizationName: "other-org", BoundAppCount: 0},
+					}))
+				})
+
+				It("lists the apps of all those spaces at once", func() {
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{{
+						Filter:   ccv2.SpaceGUIDFilter,
+						Operator: ccv2.InOperator,
+						Value:    "some-space-guid,other-space-guid-1,other-space-guid-2",
+					}}))
+				})
+			})
+
+			Context("when the Cloud Controller does not support sharing", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceSharedFromReturns(
+						ccv2.ServiceInstanceSharedFrom{},
+						ccv2.Warnings{"shared-from-warning"},
+						ccerror.ResourceNotFoundError{})
+				})
+
+				It("reports the service instance as not shared", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(summary.ServiceInstanceShareType).To(Equal(ServiceInstanceIsNotShared))
+					Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the plan fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("plan error")
+					fakeCloudControllerClient.GetServicePlanReturns(ccv2.ServicePlan{}, ccv2.Warnings{"plan-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("instance-warning", "plan-warning"))
+				})
+			})
+
+			Context("when getting the bound apps fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("app error")
+					fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ContainElement("apps-warning"))
+				})
+			})
+
+			Context("when there are no bindings", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"bindings-warning"}, nil)
+				})
+
+				It("does not list any apps", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(summary.BoundApplications).To(BeEmpty())
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{
+						GUID: "some-service-instance-guid",
+						Name: "some-service-instance",
+						Type: ccv2.UserProvidedService,
+					}},
+					ccv2.Warnings{"instance-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{{Name: "binding-1", AppGUID: "app-guid-1"}},
+					ccv2.Warnings{"bindings-warning"},
+					nil)
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{GUID: "app-guid-1", Name: "app-1"}},
+					ccv2.Warnings{"apps-warning"},
+					nil)
+			})
+
+			It("only returns the bound apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instance-warning", "bindings-warning", "apps-warning"))
+				Expect(summary.BoundApplications).To(Equal([]BoundApplication{
+					{AppName: "app-1", ServiceBindingName: "binding-1"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetServicePlanCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetServiceCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedFromCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 
 	. "github.com/onsi/ginkgo"
@@ -22,6 +23,113 @@ var _ = Describe("Service Instance Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, nil)
 	})
 
+	Describe("CreateServiceInstance", func() {
+		Context("when the service has the requested plan", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(
+					[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+					ccv2.Warnings{"services-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]ccv2.ServicePlan{
+						{GUID: "some-other-plan-guid", Name: "some-other-plan"},
+						{GUID: "some-plan-guid", Name: "some-plan"},
+					},
+					ccv2.Warnings{"plans-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.CreateServiceInstanceReturns(
+					ccv2.ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"},
+					ccv2.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("creates an instance of the plan and returns all warnings", func() {
+				serviceInstance, warnings, err := actor.CreateServiceInstance("some-space-guid", "some-service", "some-plan", "some-service-instance")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("services-warning", "plans-warning", "create-warning"))
+
+				Expect(fakeCloudControllerClient.GetServicesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.LabelFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-service",
+				}}))
+
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.ServiceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-service-guid",
+				}}))
+
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(1))
+				spaceGUID, planGUID, name := fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(planGUID).To(Equal("some-plan-guid"))
+				Expect(name).To(Equal("some-service-instance"))
+			})
+		})
+
+		Context("when the service does not have the requested plan", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(
+					[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+					ccv2.Warnings{"services-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]ccv2.ServicePlan{{GUID: "some-other-plan-guid", Name: "some-other-plan"}},
+					ccv2.Warnings{"plans-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServicePlanNotFoundError and all warnings", func() {
+				_, warnings, err := actor.CreateServiceInstance("some-space-guid", "some-service", "some-plan", "some-service-instance")
+				Expect(err).To(MatchError(ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}))
+				Expect(warnings).To(ConsistOf("services-warning", "plans-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"services-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError and all warnings", func() {
+				_, warnings, err := actor.CreateServiceInstance("some-space-guid", "some-service", "some-plan", "some-service-instance")
+				Expect(err).To(MatchError(ServiceNotFoundError{Name: "some-service"}))
+				Expect(warnings).To(ConsistOf("services-warning"))
+			})
+		})
+	})
+
+	Describe("CreateUserProvidedServiceInstance", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateUserProvidedServiceInstanceReturns(
+				ccv2.ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance", Type: ccv2.UserProvidedService},
+				ccv2.Warnings{"create-warning"},
+				errors.New("some-error"),
+			)
+		})
+
+		It("returns the created instance, warnings and error", func() {
+			serviceInstance, warnings, err := actor.CreateUserProvidedServiceInstance("some-space-guid", "some-service-instance")
+			Expect(err).To(MatchError("some-error"))
+			Expect(serviceInstance).To(Equal(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance", Type: ccv2.UserProvidedService}))
+			Expect(warnings).To(ConsistOf("create-warning"))
+
+			spaceGUID, name := fakeCloudControllerClient.CreateUserProvidedServiceInstanceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(name).To(Equal("some-service-instance"))
+		})
+	})
+
 	Describe("GetServiceInstancesBySpace", func() {
 		Context("when there are service instances", func() {
 			BeforeEach(func() {
@@ -148,4 +256,239 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceInstanceParameters", func() {
+		Context("when the service broker returns the parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+					map[string]interface{}{"some-key": "some-value"},
+					ccv2.Warnings{"parameters-warning"},
+					nil)
+			})
+
+			It("returns the parameters and all warnings", func() {
+				parameters, warnings, err := actor.GetServiceInstanceParameters("some-service-instance-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parameters).To(Equal(ServiceInstanceParameters{"some-key": "some-value"}))
+				Expect(warnings).To(ConsistOf("parameters-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceParametersArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		Context("when the service broker does not support fetching parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+					nil,
+					ccv2.Warnings{"parameters-warning"},
+					ccerror.ServiceInstanceParametersFetchNotSupportedError{Message: "not supported"})
+			})
+
+			It("returns a ServiceInstanceParametersFetchNotSupportedError and all warnings", func() {
+				_, warnings, err := actor.GetServiceInstanceParameters("some-service-instance-guid")
+				Expect(err).To(MatchError(ServiceInstanceParametersFetchNotSupportedError{Message: "not supported"}))
+				Expect(warnings).To(ConsistOf("parameters-warning"))
+			})
+		})
+
+		Context("when getting the parameters fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("parameters error")
+				fakeCloudControllerClient.GetServiceInstanceParametersReturns(nil, ccv2.Warnings{"parameters-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetServiceInstanceParameters("some-service-instance-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("parameters-warning"))
+			})
+		})
+	})
+
+	Describe("PurgeServiceInstanceByNameAndSpace", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = actor.PurgeServiceInstanceByNameAndSpace("some-service-instance", "some-space-guid")
+		})
+
+		Context("when the service instance exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{
+						{
+							GUID: "some-service-instance-guid",
+							Name: "some-service-instance",
+						},
+					},
+					ccv2.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			Context("when the purge succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.PurgeServiceInstanceReturns(ccv2.Warnings{"purge-warning"}, nil)
+				})
+
+				It("purges the service instance and returns all warnings", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-warning", "purge-warning"))
+
+					Expect(fakeCloudControllerClient.PurgeServiceInstanceCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.PurgeServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+				})
+			})
+
+			Context("when the purge fails", func() {
+				var expectedError error
+
+				BeforeEach(func() {
+					expectedError = errors.New("purge failed")
+					fakeCloudControllerClient.PurgeServiceInstanceReturns(ccv2.Warnings{"purge-warning"}, expectedError)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedError))
+					Expect(warnings).To(ConsistOf("get-warning", "purge-warning"))
+				})
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns([]ccv2.ServiceInstance{}, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError and does not purge", func() {
+				Expect(err).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.PurgeServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RenameServiceInstanceByNameAndSpace", func() {
+		var (
+			serviceInstance ServiceInstance
+			warnings        Warnings
+			err             error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturnsOnCall(0,
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance", Type: ccv2.ManagedService}},
+				ccv2.Warnings{"get-service-instance-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturnsOnCall(1,
+				[]ccv2.ServiceInstance{},
+				ccv2.Warnings{"get-new-name-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateServiceInstanceNameReturns(
+				ccv2.ServiceInstance{GUID: "some-service-instance-guid", Name: "new-name", Type: ccv2.ManagedService},
+				ccv2.Warnings{"update-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			serviceInstance, warnings, err = actor.RenameServiceInstanceByNameAndSpace("some-service-instance", "new-name", "some-space-guid")
+		})
+
+		Context("when the service instance is managed", func() {
+			It("renames the managed service instance and returns all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{GUID: "some-service-instance-guid", Name: "new-name", Type: ccv2.ManagedService}))
+				Expect(warnings).To(ConsistOf("get-service-instance-warning", "get-new-name-warning", "update-warning"))
+
+				spaceGUID, includeUserProvided, queries := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(1)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(includeUserProvided).To(BeTrue())
+				Expect(queries).To(Equal([]ccv2.Query{
+					{Filter: ccv2.NameFilter, Operator: ccv2.EqualOperator, Value: "new-name"},
+				}))
+
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceNameCallCount()).To(Equal(1))
+				serviceInstanceGUID, newName := fakeCloudControllerClient.UpdateServiceInstanceNameArgsForCall(0)
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(newName).To(Equal("new-name"))
+				Expect(fakeCloudControllerClient.UpdateUserProvidedServiceInstanceNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturnsOnCall(0,
+					[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance", Type: ccv2.UserProvidedService}},
+					ccv2.Warnings{"get-service-instance-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateUserProvidedServiceInstanceNameReturns(
+					ccv2.ServiceInstance{GUID: "some-service-instance-guid", Name: "new-name", Type: ccv2.UserProvidedService},
+					ccv2.Warnings{"update-warning"},
+					nil,
+				)
+			})
+
+			It("renames the user provided service instance", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-service-instance-warning", "get-new-name-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateUserProvidedServiceInstanceNameCallCount()).To(Equal(1))
+				serviceInstanceGUID, newName := fakeCloudControllerClient.UpdateUserProvidedServiceInstanceNameArgsForCall(0)
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(newName).To(Equal("new-name"))
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturnsOnCall(0, []ccv2.ServiceInstance{}, ccv2.Warnings{"get-service-instance-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(err).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("get-service-instance-warning"))
+			})
+		})
+
+		Context("when another service instance already has the new name", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturnsOnCall(1,
+					[]ccv2.ServiceInstance{{GUID: "other-service-instance-guid", Name: "new-name"}},
+					ccv2.Warnings{"get-new-name-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceInstanceNameTakenError without renaming", func() {
+				Expect(err).To(MatchError(ServiceInstanceNameTakenError{Name: "new-name"}))
+				Expect(warnings).To(ConsistOf("get-service-instance-warning", "get-new-name-warning"))
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the Cloud Controller reports the name as taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateServiceInstanceNameReturns(
+					ccv2.ServiceInstance{},
+					ccv2.Warnings{"update-warning"},
+					ccerror.ServiceInstanceNameTakenError{Message: "The service instance name is taken: new-name"},
+				)
+			})
+
+			It("returns a ServiceInstanceNameTakenError", func() {
+				Expect(err).To(MatchError(ServiceInstanceNameTakenError{Name: "new-name"}))
+				Expect(warnings).To(ConsistOf("get-service-instance-warning", "get-new-name-warning", "update-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,41 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ServicePlan represents a plan of a service offering.
+type ServicePlan ccv2.ServicePlan
+
+// ServicePlanNotFoundError is returned when a service offering does not have
+// a plan with the requested name.
+type ServicePlanNotFoundError struct {
+	PlanName    string
+	ServiceName string
+}
+
+func (e ServicePlanNotFoundError) Error() string {
+	return fmt.Sprintf("Service plan '%s' of service '%s' not found.", e.PlanName, e.ServiceName)
+}
+
+// GetServicePlansForService returns all the plans of the service offering
+// associated with the provided GUID.
+func (actor Actor) GetServicePlansForService(serviceGUID string) ([]ServicePlan, Warnings, error) {
+	ccv2Plans, warnings, err := actor.CloudControllerClient.GetServicePlans([]ccv2.Query{{
+		Filter:   ccv2.ServiceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    serviceGUID,
+	}})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	plans := make([]ServicePlan, len(ccv2Plans))
+	for i, ccv2Plan := range ccv2Plans {
+		plans[i] = ServicePlan(ccv2Plan)
+	}
+
+	return plans, Warnings(warnings), nil
+}
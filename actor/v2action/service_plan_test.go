@@ -0,0 +1,71 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Plan Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetServicePlansForService", func() {
+		Context("when the cloud controller returns plans", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]ccv2.ServicePlan{
+						{GUID: "some-plan-guid-1", Name: "some-plan-1"},
+						{GUID: "some-plan-guid-2", Name: "some-plan-2"},
+					},
+					ccv2.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("returns the plans and all warnings", func() {
+				plans, warnings, err := actor.GetServicePlansForService("some-service-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plans).To(ConsistOf(
+					ServicePlan{GUID: "some-plan-guid-1", Name: "some-plan-1"},
+					ServicePlan{GUID: "some-plan-guid-2", Name: "some-plan-2"},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.ServiceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-service-guid",
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetServicePlansForService("some-service-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -0,0 +1,251 @@
+package v2action
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// ServicePlanCost is a single cost entry advertised in a plan's broker catalog
+// metadata. Amount maps a currency code (for example "usd") to the price.
+type ServicePlanCost struct {
+	Amount map[string]float64 `json:"amount"`
+	Unit   string             `json:"unit"`
+}
+
+// ServicePlanSummary represents a service plan along with the metadata parsed
+// from its broker catalog.
+type ServicePlanSummary struct {
+	ServicePlan
+	DisplayName string
+	Bullets     []string
+	Costs       []ServicePlanCost
+}
+
+// ServiceSummary represents a service offering along with its broker, its
+// plans, and the metadata parsed from its broker catalog.
+type ServiceSummary struct {
+	Service
+	BrokerName       string
+	DisplayName      string
+	LongDescription  string
+	DocumentationURL string
+	SupportURL       string
+	Plans            []ServicePlanSummary
+}
+
+// GetServiceSummaries returns a summary for every service offering in the
+// marketplace. When spaceGUID is provided, only the services visible in that
+// space are returned, and when orgGUID is provided, non-public plans are only
+// included if they have been made visible to that organization.
+func (actor Actor) GetServiceSummaries(orgGUID string, spaceGUID string) ([]ServiceSummary, Warnings, error) {
+	return actor.getServiceSummaries(orgGUID, spaceGUID, nil)
+}
+
+// GetServiceSummaryByName returns the summary of the service offering with
+// the provided name. See GetServiceSummaries for how orgGUID and spaceGUID are
+// used.
+func (actor Actor) GetServiceSummaryByName(name string, orgGUID string, spaceGUID string) (ServiceSummary, Warnings, error) {
+	summaries, warnings, err := actor.getServiceSummaries(orgGUID, spaceGUID, []ccv2.Query{{
+		Filter:   ccv2.LabelFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    name,
+	}})
+	if err != nil {
+		return ServiceSummary{}, warnings, err
+	}
+
+	if len(summaries) == 0 {
+		return ServiceSummary{}, warnings, ServiceNotFoundError{Name: name}
+	}
+
+	return summaries[0], warnings, nil
+}
+
+func (actor Actor) getServiceSummaries(orgGUID string, spaceGUID string, queries []ccv2.Query) ([]ServiceSummary, Warnings, error) {
+	var (
+		allWarnings Warnings
+		services    []ccv2.Service
+		warnings    ccv2.Warnings
+		err         error
+	)
+
+	if spaceGUID != "" {
+		services, warnings, err = actor.CloudControllerClient.GetSpaceServices(spaceGUID, queries)
+	} else {
+		services, warnings, err = actor.CloudControllerClient.GetServices(queries)
+	}
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(services) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	brokerNames, brokerWarnings, err := actor.getServiceBrokerNames()
+	allWarnings = append(allWarnings, brokerWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var visiblePlans map[string]bool
+	if orgGUID != "" {
+		var visibilityWarnings Warnings
+		visiblePlans, visibilityWarnings, err = actor.getVisiblePlanGUIDs(orgGUID)
+		allWarnings = append(allWarnings, visibilityWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	plansByService, planWarnings, err := actor.getServicePlansByService(services)
+	allWarnings = append(allWarnings, planWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var summaries []ServiceSummary
+	for _, service := range services {
+		plans := plansByService[service.GUID]
+
+		summary := ServiceSummary{
+			Service:    Service(service),
+			BrokerName: brokerNames[service.ServiceBrokerGUID],
+		}
+		summary.parseExtra()
+
+		for _, plan := range plans {
+			if visiblePlans != nil && !plan.Public && !visiblePlans[plan.GUID] {
+				continue
+			}
+			planSummary := ServicePlanSummary{ServicePlan: plan}
+			planSummary.parseExtra()
+			summary.Plans = append(summary.Plans, planSummary)
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i int, j int) bool {
+		return sorting.SortAlphabetic(summaries[i].Label, summaries[j].Label)
+	})
+
+	return summaries, allWarnings, nil
+}
+
+// getServicePlansByService returns the plans of the provided services, keyed
+// by service GUID, using a single plans listing.
+func (actor Actor) getServicePlansByService(services []ccv2.Service) (map[string][]ServicePlan, Warnings, error) {
+	serviceGUIDs := make([]string, len(services))
+	for i, service := range services {
+		serviceGUIDs[i] = service.GUID
+	}
+
+	ccv2Plans, warnings, err := actor.CloudControllerClient.GetServicePlans([]ccv2.Query{{
+		Filter:   ccv2.ServiceGUIDFilter,
+		Operator: ccv2.InOperator,
+		Value:    strings.Join(serviceGUIDs, ","),
+	}})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	plans := map[string][]ServicePlan{}
+	for _, ccv2Plan := range ccv2Plans {
+		plans[ccv2Plan.ServiceGUID] = append(plans[ccv2Plan.ServiceGUID], ServicePlan(ccv2Plan))
+	}
+	return plans, Warnings(warnings), nil
+}
+
+// getServiceBrokerNames returns a map of broker GUIDs to broker names. Users
+// that are not allowed to list service brokers get an empty map.
+func (actor Actor) getServiceBrokerNames() (map[string]string, Warnings, error) {
+	brokers, warnings, err := actor.GetServiceBrokers()
+	switch err.(type) {
+	case nil:
+	case ccerror.ForbiddenError, ccerror.UnauthorizedError:
+		return map[string]string{}, warnings, nil
+	default:
+		return nil, warnings, err
+	}
+
+	names := map[string]string{}
+	for _, broker := range brokers {
+		names[broker.GUID] = broker.Name
+	}
+	return names, warnings, nil
+}
+
+// getVisiblePlanGUIDs returns the set of non-public plan GUIDs that have been
+// made visible to the provided organization. A nil set is returned when the
+// user is not allowed to list visibilities, which disables filtering.
+func (actor Actor) getVisiblePlanGUIDs(orgGUID string) (map[string]bool, Warnings, error) {
+	visibilities, warnings, err := actor.CloudControllerClient.GetServicePlanVisibilities([]ccv2.Query{{
+		Filter:   ccv2.OrganizationGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    orgGUID,
+	}})
+	switch err.(type) {
+	case nil:
+	case ccerror.ForbiddenError, ccerror.UnauthorizedError:
+		return nil, Warnings(warnings), nil
+	default:
+		return nil, Warnings(warnings), err
+	}
+
+	visible := map[string]bool{}
+	for _, visibility := range visibilities {
+		visible[visibility.ServicePlanGUID] = true
+	}
+	return visible, Warnings(warnings), nil
+}
+
+// parseExtra fills in the broker catalog metadata of the service. Malformed
+// metadata is ignored.
+func (summary *ServiceSummary) parseExtra() {
+	if summary.Extra == "" {
+		return
+	}
+
+	var extra struct {
+		DisplayName      string `json:"displayName"`
+		LongDescription  string `json:"longDescription"`
+		DocumentationURL string `json:"documentationUrl"`
+		SupportURL       string `json:"supportUrl"`
+	}
+	if err := json.Unmarshal([]byte(summary.Extra), &extra); err != nil {
+		return
+	}
+
+	summary.DisplayName = extra.DisplayName
+	summary.LongDescription = extra.LongDescription
+	summary.DocumentationURL = extra.DocumentationURL
+	summary.SupportURL = extra.SupportURL
+}
+
+// parseExtra fills in the broker catalog metadata of the plan. Malformed
+// metadata is ignored.
+func (summary *ServicePlanSummary) parseExtra() {
+	if summary.Extra == "" {
+		return
+	}
+
+	var extra struct {
+		DisplayName string            `json:"displayName"`
+		Bullets     []string          `json:"bullets"`
+		Costs       []ServicePlanCost `json:"costs"`
+	}
+	if err := json.Unmarshal([]byte(summary.Extra), &extra); err != nil {
+		return
+	}
+
+	summary.DisplayName = extra.DisplayName
+	summary.Bullets = extra.Bullets
+	summary.Costs = extra.Costs
+}
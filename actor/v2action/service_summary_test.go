@@ -0,0 +1,240 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Summary Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetServiceSummaries", func() {
+		var (
+			orgGUID   string
+			spaceGUID string
+			summaries []ServiceSummary
+			warnings  Warnings
+			err       error
+		)
+
+		BeforeEach(func() {
+			orgGUID = ""
+			spaceGUID = ""
+
+			services := []ccv2.Service{
+				{
+					GUID:              "service-guid-b",
+					Label:             "service-b",
+					ServiceBrokerGUID: "broker-guid",
+					Extra:             `{"displayName":"Service B","documentationUrl":"https://docs.example.com","supportUrl":"https://support.example.com","longDescription":"a long description"}`,
+				},
+				{
+					GUID:  "service-guid-a",
+					Label: "service-a",
+					Extra: "not-json",
+				},
+			}
+			fakeCloudControllerClient.GetServicesReturns(services, ccv2.Warnings{"services-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceServicesReturns(services, ccv2.Warnings{"space-services-warning"}, nil)
+			fakeCloudControllerClient.GetServiceBrokersReturns(
+				[]ccv2.ServiceBroker{{GUID: "broker-guid", Name: "some-broker"}},
+				ccv2.Warnings{"brokers-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]ccv2.ServicePlan{
+					{GUID: "plan-guid-public", Name: "public-plan", ServiceGUID: "service-guid-b", Public: true, Free: true},
+					{GUID: "plan-guid-a", Name: "plan-a", ServiceGUID: "service-guid-a", Public: true, Free: true},
+					{GUID: "plan-guid-visible", Name: "visible-plan", ServiceGUID: "service-guid-b", Extra: `{"costs":[{"amount":{"usd":10.5},"unit":"MONTHLY"}],"bullets":["bullet 1"],"displayName":"Visible"}`},
+					{GUID: "plan-guid-hidden", Name: "hidden-plan", ServiceGUID: "service-guid-b"},
+				},
+				ccv2.Warnings{"plans-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlanVisibilitiesReturns(
+				[]ccv2.ServicePlanVisibility{{ServicePlanGUID: "plan-guid-visible", OrganizationGUID: "some-org-guid"}},
+				ccv2.Warnings{"visibilities-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, err = actor.GetServiceSummaries(orgGUID, spaceGUID)
+		})
+
+		Context("when no org or space is provided", func() {
+			It("returns summaries for all services sorted by label, with broker and catalog metadata", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("services-warning", "brokers-warning", "plans-warning"))
+
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.ServiceGUIDFilter,
+					Operator: ccv2.InOperator,
+					Value:    "service-guid-b,service-guid-a",
+				}))
+
+				Expect(fakeCloudControllerClient.GetServicesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpaceServicesCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetServicePlanVisibilitiesCallCount()).To(Equal(0))
+
+				Expect(summaries).To(HaveLen(2))
+				Expect(summaries[0].Label).To(Equal("service-a"))
+				Expect(summaries[0].BrokerName).To(BeEmpty())
+				Expect(summaries[0].DisplayName).To(BeEmpty())
+				Expect(summaries[0].Plans).To(HaveLen(1))
+
+				Expect(summaries[1].Label).To(Equal("service-b"))
+				Expect(summaries[1].BrokerName).To(Equal("some-broker"))
+				Expect(summaries[1].DisplayName).To(Equal("Service B"))
+				Expect(summaries[1].LongDescription).To(Equal("a long description"))
+				Expect(summaries[1].DocumentationURL).To(Equal("https://docs.example.com"))
+				Expect(summaries[1].SupportURL).To(Equal("https://support.example.com"))
+				Expect(summaries[1].Plans).To(HaveLen(3))
+
+				visiblePlan := summaries[1].Plans[1]
+				Expect(visiblePlan.Name).To(Equal("visible-plan"))
+				Expect(visiblePlan.DisplayName).To(Equal("Visible"))
+				Expect(visiblePlan.Bullets).To(ConsistOf("bullet 1"))
+				Expect(visiblePlan.Costs).To(ConsistOf(ServicePlanCost{
+					Amount: map[string]float64{"usd": 10.5},
+					Unit:   "MONTHLY",
+				}))
+			})
+		})
+
+		Context("when an org and space are provided", func() {
+			BeforeEach(func() {
+				orgGUID = "some-org-guid"
+				spaceGUID = "some-space-guid"
+			})
+
+			It("returns services visible in the space and filters out plans not visible to the org", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("space-services-warning", "brokers-warning", "visibilities-warning", "plans-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpaceServicesCallCount()).To(Equal(1))
+				passedSpaceGUID, _ := fakeCloudControllerClient.GetSpaceServicesArgsForCall(0)
+				Expect(passedSpaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeCloudControllerClient.GetServicePlanVisibilitiesArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.OrganizationGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-org-guid",
+				}))
+
+				Expect(summaries[1].Plans).To(HaveLen(2))
+				Expect(summaries[1].Plans[0].Name).To(Equal("public-plan"))
+				Expect(summaries[1].Plans[1].Name).To(Equal("visible-plan"))
+			})
+
+			Context("when the user is not allowed to list visibilities", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlanVisibilitiesReturns(nil, ccv2.Warnings{"visibilities-warning"}, ccerror.ForbiddenError{})
+				})
+
+				It("does not filter the plans", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(summaries[1].Plans).To(HaveLen(3))
+				})
+			})
+		})
+
+		Context("when the user is not allowed to list service brokers", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBrokersReturns(nil, ccv2.Warnings{"brokers-warning"}, ccerror.UnauthorizedError{})
+			})
+
+			It("returns the summaries without broker names", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(summaries[1].BrokerName).To(BeEmpty())
+			})
+		})
+
+		Context("when getting services fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"services-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("services-warning"))
+			})
+		})
+
+		Context("when getting plans fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"plans-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("services-warning", "brokers-warning", "plans-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceSummaryByName", func() {
+		Context("when the service exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(
+					[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+					ccv2.Warnings{"services-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]ccv2.ServicePlan{{GUID: "some-plan-guid", Name: "some-plan", ServiceGUID: "some-service-guid"}},
+					ccv2.Warnings{"plans-warning"},
+					nil,
+				)
+			})
+
+			It("filters the services by label and returns the summary", func() {
+				summary, warnings, err := actor.GetServiceSummaryByName("some-service", "", "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("services-warning", "plans-warning"))
+				Expect(summary.Label).To(Equal("some-service"))
+				Expect(summary.Plans).To(HaveLen(1))
+
+				Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.LabelFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-service",
+				}))
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServicesReturns(nil, ccv2.Warnings{"services-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError", func() {
+				_, warnings, err := actor.GetServiceSummaryByName("some-service", "some-org-guid", "some-space-guid")
+				Expect(err).To(MatchError(ServiceNotFoundError{Name: "some-service"}))
+				Expect(warnings).To(ConsistOf("services-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,176 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetServiceByNameAndProvider", func() {
+		var (
+			provider string
+			service  Service
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			provider = ""
+		})
+
+		JustBeforeEach(func() {
+			service, warnings, err = actor.GetServiceByNameAndProvider("some-service", provider)
+		})
+
+		Context("when services are returned", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(
+					[]ccv2.Service{
+						{GUID: "some-service-guid-1", Label: "some-service"},
+						{GUID: "some-service-guid-2", Label: "some-service"},
+					},
+					ccv2.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("returns the first service and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(service).To(Equal(Service{GUID: "some-service-guid-1", Label: "some-service"}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetServicesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.LabelFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-service",
+				}))
+			})
+
+			Context("when a provider is given", func() {
+				BeforeEach(func() {
+					provider = "some-provider"
+				})
+
+				It("filters by the provider", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetServicesArgsForCall(0)).To(ConsistOf(
+						ccv2.Query{
+							Filter:   ccv2.LabelFilter,
+							Operator: ccv2.EqualOperator,
+							Value:    "some-service",
+						},
+						ccv2.Query{
+							Filter:   ccv2.ProviderFilter,
+							Operator: ccv2.EqualOperator,
+							Value:    "some-provider",
+						},
+					))
+				})
+			})
+		})
+
+		Context("when no services are returned", func() {
+			BeforeEach(func() {
+				provider = "some-provider"
+				fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError and all warnings", func() {
+				Expect(err).To(MatchError(ServiceNotFoundError{Name: "some-service", Provider: "some-provider"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"warning-1"}, expectedError)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedError))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("PurgeServiceOffering", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = actor.PurgeServiceOffering("some-service", "")
+		})
+
+		Context("when the service exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(
+					[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+					ccv2.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			Context("when the purge succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.PurgeServiceReturns(ccv2.Warnings{"purge-warning"}, nil)
+				})
+
+				It("purges the service and returns all warnings", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-warning", "purge-warning"))
+
+					Expect(fakeCloudControllerClient.PurgeServiceCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.PurgeServiceArgsForCall(0)).To(Equal("some-service-guid"))
+				})
+			})
+
+			Context("when the purge fails", func() {
+				var expectedError error
+
+				BeforeEach(func() {
+					expectedError = errors.New("purge failed")
+					fakeCloudControllerClient.PurgeServiceReturns(ccv2.Warnings{"purge-warning"}, expectedError)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedError))
+					Expect(warnings).To(ConsistOf("get-warning", "purge-warning"))
+				})
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicesReturns(nil, ccv2.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError and does not purge", func() {
+				Expect(err).To(MatchError(ServiceNotFoundError{Name: "some-service"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.PurgeServiceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v2action
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
 
@@ -90,3 +91,122 @@ func (actor Actor) GetSpaceByOrganizationAndName(orgGUID string, spaceName strin
 
 	return Space(ccv2Spaces[0]), Warnings(warnings), nil
 }
+
+// SpaceNameTakenError is returned when creating or renaming a space with a
+// name that is already in use in its organization.
+type SpaceNameTakenError struct {
+	Name string
+}
+
+func (e SpaceNameTakenError) Error() string {
+	return fmt.Sprintf("Space '%s' already exists.", e.Name)
+}
+
+// CreateSpaceWithRoles creates a space with the provided name in the
+// organization with the provided GUID and gives each seeded user their role in
+// it. The space quota is only assigned when spaceQuotaName is not empty.
+// Seeding is all-or-nothing: if any role cannot be given, the new space is
+// deleted and a RoleSeedingError is returned. Should that deletion fail as
+// well, its error is returned instead.
+func (actor Actor) CreateSpaceWithRoles(spaceName string, orgGUID string, spaceQuotaName string, roleSeeds []RoleSeed) (Space, Warnings, error) {
+	err := validateSpaceRoleSeeds(roleSeeds)
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var allWarnings Warnings
+
+	var spaceQuotaGUID string
+	if spaceQuotaName != "" {
+		spaceQuota, warnings, quotaErr := actor.GetSpaceQuotaByName(spaceQuotaName, orgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if quotaErr != nil {
+			return Space{}, allWarnings, quotaErr
+		}
+		spaceQuotaGUID = spaceQuota.GUID
+	}
+
+	space, warnings, err := actor.CloudControllerClient.CreateSpace(spaceName, orgGUID, spaceQuotaGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.SpaceNameTakenError); ok {
+		return Space{}, allWarnings, SpaceNameTakenError{Name: spaceName}
+	}
+	if err != nil {
+		return Space{}, allWarnings, err
+	}
+
+	seedWarnings, seedErr := actor.seedSpaceRoles(orgGUID, space.GUID, roleSeeds)
+	allWarnings = append(allWarnings, seedWarnings...)
+	if seedErr != nil {
+		job, deleteWarnings, deleteErr := actor.CloudControllerClient.DeleteSpace(space.GUID)
+		allWarnings = append(allWarnings, deleteWarnings...)
+		if deleteErr != nil {
+			return Space{}, allWarnings, deleteErr
+		}
+
+		pollWarnings, pollErr := actor.PollJob(Job(job))
+		allWarnings = append(allWarnings, pollWarnings...)
+		if pollErr != nil {
+			return Space{}, allWarnings, pollErr
+		}
+
+		return Space{}, allWarnings, seedErr
+	}
+
+	return Space(space), allWarnings, nil
+}
+
+// DeleteSpaceByNameAndOrganizationName requests the deletion of the space
+// with the provided name in the organization with the provided name, and
+// returns the deletion job without waiting for it to finish.
+func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (Job, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Job{}, allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Job{}, allWarnings, err
+	}
+
+	job, deleteWarnings, err := actor.CloudControllerClient.DeleteSpace(space.GUID)
+	allWarnings = append(allWarnings, deleteWarnings...)
+
+	return Job(job), allWarnings, err
+}
+
+// RenameSpaceByOrganizationAndName renames the space with the provided name
+// in the organization with the provided GUID. A SpaceNameTakenError is
+// returned, without attempting the rename, when another space in the
+// organization already has the new name.
+func (actor Actor) RenameSpaceByOrganizationAndName(orgGUID string, oldName string, newName string) (Space, Warnings, error) {
+	space, allWarnings, err := actor.GetSpaceByOrganizationAndName(orgGUID, oldName)
+	if err != nil {
+		return Space{}, allWarnings, err
+	}
+
+	existingSpace, warnings, err := actor.GetSpaceByOrganizationAndName(orgGUID, newName)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		if existingSpace.GUID != space.GUID {
+			return Space{}, allWarnings, SpaceNameTakenError{Name: newName}
+		}
+	case SpaceNotFoundError:
+	default:
+		return Space{}, allWarnings, err
+	}
+
+	updatedSpace, updateWarnings, err := actor.CloudControllerClient.UpdateSpaceName(space.GUID, newName)
+	allWarnings = append(allWarnings, updateWarnings...)
+	if _, ok := err.(ccerror.SpaceNameTakenError); ok {
+		return Space{}, allWarnings, SpaceNameTakenError{Name: newName}
+	}
+
+	return Space(updatedSpace), allWarnings, err
+}
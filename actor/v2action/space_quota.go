@@ -11,9 +11,13 @@ type SpaceQuota ccv2.SpaceQuota
 
 type SpaceQuotaNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e SpaceQuotaNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Space quota '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Space quota with GUID '%s' not found.", e.GUID)
 }
 
@@ -26,3 +30,79 @@ func (actor Actor) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 
 	return SpaceQuota(spaceQuota), Warnings(warnings), err
 }
+
+// GetSpaceQuotaByName returns the space quota with the provided name that is
+// defined in the organization with the provided GUID.
+func (actor Actor) GetSpaceQuotaByName(quotaName string, orgGUID string) (SpaceQuota, Warnings, error) {
+	spaceQuotas, warnings, err := actor.CloudControllerClient.GetSpaceQuotas(orgGUID)
+	if err != nil {
+		return SpaceQuota{}, Warnings(warnings), err
+	}
+
+	for _, spaceQuota := range spaceQuotas {
+		if spaceQuota.Name == quotaName {
+			return SpaceQuota(spaceQuota), Warnings(warnings), nil
+		}
+	}
+
+	return SpaceQuota{}, Warnings(warnings), SpaceQuotaNotFoundError{Name: quotaName}
+}
+
+// SpaceQuotaUsage represents the memory and app instances used by the started
+// apps of a space, along with the space quota that limits them.
+type SpaceQuotaUsage struct {
+	// Quota has no GUID when the space has no space quota.
+	Quota SpaceQuota
+
+	// MemoryUsed is in megabytes.
+	MemoryUsed int
+	Instances  int
+}
+
+// GetSpaceQuotaUsage returns the usage of the space with the provided GUID.
+// The app with ignoredAppGUID is not counted, so that the usage can be
+// compared with the app's new settings.
+func (actor Actor) GetSpaceQuotaUsage(spaceGUID string, ignoredAppGUID string) (SpaceQuotaUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	space, warnings, err := actor.CloudControllerClient.GetSpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+			return SpaceQuotaUsage{}, allWarnings, SpaceNotFoundError{GUID: spaceGUID}
+		}
+		return SpaceQuotaUsage{}, allWarnings, err
+	}
+
+	var usage SpaceQuotaUsage
+	if space.SpaceQuotaDefinitionGUID == "" {
+		return usage, allWarnings, nil
+	}
+
+	quota, quotaWarnings, err := actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
+	allWarnings = append(allWarnings, quotaWarnings...)
+	if err != nil {
+		return SpaceQuotaUsage{}, allWarnings, err
+	}
+	usage.Quota = quota
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications([]ccv2.Query{{
+		Filter:   ccv2.SpaceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    spaceGUID,
+	}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceQuotaUsage{}, allWarnings, err
+	}
+
+	for _, app := range apps {
+		if app.GUID == ignoredAppGUID || app.State != ccv2.ApplicationStarted {
+			continue
+		}
+		usage.MemoryUsed += app.Memory * app.Instances
+		usage.Instances += app.Instances
+	}
+
+	return usage, allWarnings, nil
+}
@@ -76,4 +76,91 @@ var _ = Describe("SpaceQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceQuotaUsage", func() {
+		var (
+			usage    SpaceQuotaUsage
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceReturns(
+				ccv2.Space{GUID: "some-space-guid", SpaceQuotaDefinitionGUID: "some-quota-guid"},
+				ccv2.Warnings{"space-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceQuotaReturns(
+				ccv2.SpaceQuota{GUID: "some-quota-guid", Name: "some-quota", MemoryLimit: 2048, AppInstanceLimit: 10},
+				ccv2.Warnings{"quota-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{GUID: "app-guid-1", State: ccv2.ApplicationStarted, Memory: 256, Instances: 2},
+					{GUID: "app-guid-2", State: ccv2.ApplicationStopped, Memory: 1024, Instances: 1},
+					{GUID: "ignored-app-guid", State: ccv2.ApplicationStarted, Memory: 512, Instances: 3},
+				},
+				ccv2.Warnings{"apps-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			usage, warnings, err = actor.GetSpaceQuotaUsage("some-space-guid", "ignored-app-guid")
+		})
+
+		It("returns the usage of the other started apps and the quota", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"space-warning", "quota-warning", "apps-warning"}))
+			Expect(usage).To(Equal(SpaceQuotaUsage{
+				Quota:      SpaceQuota{GUID: "some-quota-guid", Name: "some-quota", MemoryLimit: 2048, AppInstanceLimit: 10},
+				MemoryUsed: 512,
+				Instances:  2,
+			}))
+
+			Expect(fakeCloudControllerClient.GetSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeCloudControllerClient.GetSpaceQuotaArgsForCall(0)).To(Equal("some-quota-guid"))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{{
+				Filter:   ccv2.SpaceGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "some-space-guid",
+			}}))
+		})
+
+		Context("when the space has no space quota", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceReturns(ccv2.Space{GUID: "some-space-guid"}, ccv2.Warnings{"space-warning"}, nil)
+			})
+
+			It("returns an empty usage without looking up the apps", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usage).To(Equal(SpaceQuotaUsage{}))
+				Expect(warnings).To(ConsistOf("space-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceReturns(ccv2.Space{}, ccv2.Warnings{"space-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(err).To(MatchError(SpaceNotFoundError{GUID: "some-space-guid"}))
+				Expect(warnings).To(ConsistOf("space-warning"))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apps-error")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("space-warning", "quota-warning", "apps-warning"))
+			})
+		})
+	})
 })
@@ -5,6 +5,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -49,6 +50,148 @@ var _ = Describe("Space", func() {
 			actor = NewActor(fakeCloudControllerClient, nil)
 		})
 
+		Describe("CreateSpaceWithRoles", func() {
+			var (
+				spaceQuotaName string
+				roleSeeds      []RoleSeed
+
+				space    Space
+				warnings Warnings
+				err      error
+			)
+
+			BeforeEach(func() {
+				spaceQuotaName = ""
+				roleSeeds = []RoleSeed{{Username: "user-1", Role: "SpaceDeveloper"}}
+				fakeCloudControllerClient.CreateSpaceReturns(
+					ccv2.Space{GUID: "some-space-guid", Name: "some-space", OrganizationGUID: "some-org-guid"},
+					ccv2.Warnings{"create-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameReturns(ccv2.Warnings{"org-role-warning"}, nil)
+				fakeCloudControllerClient.UpdateSpaceUserRoleByUsernameReturns(ccv2.Warnings{"space-role-warning"}, nil)
+			})
+
+			JustBeforeEach(func() {
+				space, warnings, err = actor.CreateSpaceWithRoles("some-space", "some-org-guid", spaceQuotaName, roleSeeds)
+			})
+
+			Context("when every role is seeded", func() {
+				It("creates the space and gives each user their role", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space", OrganizationGUID: "some-org-guid"}))
+					Expect(warnings).To(ConsistOf("create-warning", "org-role-warning", "space-role-warning"))
+
+					spaceName, orgGUID, spaceQuotaGUID := fakeCloudControllerClient.CreateSpaceArgsForCall(0)
+					Expect(spaceName).To(Equal("some-space"))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceQuotaGUID).To(BeEmpty())
+
+					orgRole, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserRoleByUsernameArgsForCall(0)
+					Expect(orgRole).To(Equal(ccv2.OrgUserRole))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(username).To(Equal("user-1"))
+
+					spaceRole, spaceGUID, username := fakeCloudControllerClient.UpdateSpaceUserRoleByUsernameArgsForCall(0)
+					Expect(spaceRole).To(Equal(ccv2.SpaceDeveloperRole))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(username).To(Equal("user-1"))
+				})
+			})
+
+			Context("when a space quota is provided", func() {
+				BeforeEach(func() {
+					spaceQuotaName = "some-space-quota"
+					fakeCloudControllerClient.GetSpaceQuotasReturns(
+						[]ccv2.SpaceQuota{
+							{GUID: "other-space-quota-guid", Name: "other-space-quota"},
+							{GUID: "some-space-quota-guid", Name: "some-space-quota"},
+						},
+						ccv2.Warnings{"quota-warning"},
+						nil,
+					)
+				})
+
+				It("creates the space with the space quota", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("quota-warning"))
+					Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(Equal("some-org-guid"))
+					_, _, spaceQuotaGUID := fakeCloudControllerClient.CreateSpaceArgsForCall(0)
+					Expect(spaceQuotaGUID).To(Equal("some-space-quota-guid"))
+				})
+
+				Context("when the space quota does not exist", func() {
+					BeforeEach(func() {
+						spaceQuotaName = "missing-space-quota"
+					})
+
+					It("returns a SpaceQuotaNotFoundError without creating the space", func() {
+						Expect(err).To(MatchError(SpaceQuotaNotFoundError{Name: "missing-space-quota"}))
+						Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when a role does not apply to spaces", func() {
+				BeforeEach(func() {
+					roleSeeds = []RoleSeed{{Username: "user-1", Role: "OrgManager"}}
+				})
+
+				It("returns an InvalidRoleError without creating the space", func() {
+					Expect(err).To(MatchError(InvalidRoleError{Role: "OrgManager"}))
+					Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the space name is taken", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateSpaceReturns(
+						ccv2.Space{},
+						ccv2.Warnings{"create-warning"},
+						ccerror.SpaceNameTakenError{Message: "name taken"},
+					)
+				})
+
+				It("returns a SpaceNameTakenError", func() {
+					Expect(err).To(MatchError(SpaceNameTakenError{Name: "some-space"}))
+					Expect(warnings).To(ConsistOf("create-warning"))
+				})
+			})
+
+			Context("when seeding a role fails", func() {
+				var seedErr error
+
+				BeforeEach(func() {
+					seedErr = errors.New("user not found")
+					fakeCloudControllerClient.UpdateSpaceUserRoleByUsernameReturns(ccv2.Warnings{"space-role-warning"}, seedErr)
+					fakeCloudControllerClient.DeleteSpaceReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+					fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, nil)
+				})
+
+				It("deletes the space and returns a RoleSeedingError", func() {
+					Expect(err).To(MatchError(RoleSeedingError{Username: "user-1", Role: "SpaceDeveloper", Err: seedErr}))
+					Expect(space).To(Equal(Space{}))
+					Expect(warnings).To(ConsistOf("create-warning", "org-role-warning", "space-role-warning", "delete-warning", "poll-warning"))
+
+					Expect(fakeCloudControllerClient.DeleteSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+					Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+				})
+
+				Context("when waiting for the deletion fails", func() {
+					var pollErr error
+
+					BeforeEach(func() {
+						pollErr = errors.New("poll failed")
+						fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, pollErr)
+					})
+
+					It("returns the polling error", func() {
+						Expect(err).To(MatchError(pollErr))
+					})
+				})
+			})
+		})
+
 		Describe("GetOrganizationSpaces", func() {
 			Context("when there are spaces in the org", func() {
 				BeforeEach(func() {
@@ -236,5 +379,197 @@ var _ = Describe("Space", func() {
 				})
 			})
 		})
+
+		Describe("DeleteSpaceByNameAndOrganizationName", func() {
+			var (
+				job      Job
+				warnings Warnings
+				err      error
+			)
+
+			JustBeforeEach(func() {
+				job, warnings, err = actor.DeleteSpaceByNameAndOrganizationName("some-space", "some-org")
+			})
+
+			Context("when the org and space exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns(
+						[]ccv2.Organization{{GUID: "some-org-guid"}},
+						ccv2.Warnings{"get-org-warning"},
+						nil,
+					)
+					fakeCloudControllerClient.GetSpacesReturns(
+						[]ccv2.Space{{GUID: "some-space-guid"}},
+						ccv2.Warnings{"get-space-warning"},
+						nil,
+					)
+					fakeCloudControllerClient.DeleteSpaceReturns(
+						ccv2.Job{GUID: "some-job-guid", Status: ccv2.JobStatusQueued},
+						ccv2.Warnings{"delete-space-warning"},
+						nil,
+					)
+				})
+
+				It("requests the deletion and returns the job and all warnings", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(job).To(Equal(Job{GUID: "some-job-guid", Status: ccv2.JobStatusQueued}))
+					Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning", "delete-space-warning"))
+
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+						ccv2.Query{
+							Filter:   ccv2.NameFilter,
+							Operator: ccv2.EqualOperator,
+							Value:    "some-space",
+						},
+						ccv2.Query{
+							Filter:   ccv2.OrganizationGUIDFilter,
+							Operator: ccv2.EqualOperator,
+							Value:    "some-org-guid",
+						},
+					))
+					Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.DeleteSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when the org does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, nil)
+				})
+
+				It("returns an OrganizationNotFoundError and all warnings", func() {
+					Expect(err).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+					Expect(warnings).To(ConsistOf("get-org-warning"))
+					Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the space does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns(
+						[]ccv2.Organization{{GUID: "some-org-guid"}},
+						ccv2.Warnings{"get-org-warning"},
+						nil,
+					)
+					fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"get-space-warning"}, nil)
+				})
+
+				It("returns a SpaceNotFoundError and all warnings", func() {
+					Expect(err).To(MatchError(SpaceNotFoundError{Name: "some-space"}))
+					Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning"))
+					Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the delete fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some delete error")
+					fakeCloudControllerClient.GetOrganizationsReturns(
+						[]ccv2.Organization{{GUID: "some-org-guid"}},
+						nil,
+						nil,
+					)
+					fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "some-space-guid"}}, nil, nil)
+					fakeCloudControllerClient.DeleteSpaceReturns(ccv2.Job{}, ccv2.Warnings{"delete-space-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("delete-space-warning"))
+				})
+			})
+		})
+
+		Describe("RenameSpaceByOrganizationAndName", func() {
+			var (
+				space    Space
+				warnings Warnings
+				err      error
+			)
+
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturnsOnCall(0,
+					[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}},
+					ccv2.Warnings{"get-space-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturnsOnCall(1,
+					[]ccv2.Space{},
+					ccv2.Warnings{"get-new-name-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateSpaceNameReturns(
+					ccv2.Space{GUID: "some-space-guid", Name: "new-space-name"},
+					ccv2.Warnings{"update-warning"},
+					nil,
+				)
+			})
+
+			JustBeforeEach(func() {
+				space, warnings, err = actor.RenameSpaceByOrganizationAndName("some-org-guid", "some-space", "new-space-name")
+			})
+
+			Context("when the new name is free", func() {
+				It("renames the space and returns all warnings", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "new-space-name"}))
+					Expect(warnings).To(ConsistOf("get-space-warning", "get-new-name-warning", "update-warning"))
+
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(1)).To(ConsistOf(
+						ccv2.Query{Filter: ccv2.NameFilter, Operator: ccv2.EqualOperator, Value: "new-space-name"},
+						ccv2.Query{Filter: ccv2.OrganizationGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-org-guid"},
+					))
+					Expect(fakeCloudControllerClient.UpdateSpaceNameCallCount()).To(Equal(1))
+					spaceGUID, newName := fakeCloudControllerClient.UpdateSpaceNameArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(newName).To(Equal("new-space-name"))
+				})
+			})
+
+			Context("when the space does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpacesReturnsOnCall(0, []ccv2.Space{}, ccv2.Warnings{"get-space-warning"}, nil)
+				})
+
+				It("returns a SpaceNotFoundError", func() {
+					Expect(err).To(MatchError(SpaceNotFoundError{Name: "some-space"}))
+					Expect(warnings).To(ConsistOf("get-space-warning"))
+					Expect(fakeCloudControllerClient.UpdateSpaceNameCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when another space already has the new name", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpacesReturnsOnCall(1,
+						[]ccv2.Space{{GUID: "other-space-guid", Name: "new-space-name"}},
+						ccv2.Warnings{"get-new-name-warning"},
+						nil,
+					)
+				})
+
+				It("returns a SpaceNameTakenError without renaming", func() {
+					Expect(err).To(MatchError(SpaceNameTakenError{Name: "new-space-name"}))
+					Expect(warnings).To(ConsistOf("get-space-warning", "get-new-name-warning"))
+					Expect(fakeCloudControllerClient.UpdateSpaceNameCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the Cloud Controller reports the name as taken", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateSpaceNameReturns(
+						ccv2.Space{},
+						ccv2.Warnings{"update-warning"},
+						ccerror.SpaceNameTakenError{Message: "The app space name is taken: new-space-name"},
+					)
+				})
+
+				It("returns a SpaceNameTakenError", func() {
+					Expect(err).To(MatchError(SpaceNameTakenError{Name: "new-space-name"}))
+					Expect(warnings).To(ConsistOf("get-space-warning", "get-new-name-warning", "update-warning"))
+				})
+			})
+		})
 	})
 })
@@ -0,0 +1,53 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// SpaceWithQuota represents a space along with the name of the space quota
+// assigned to it. Spaces without a space quota have an empty QuotaName.
+type SpaceWithQuota struct {
+	Space
+	QuotaName string
+}
+
+// GetOrganizationSpacesWithQuotas calls handlePage with each page of spaces in
+// the provided organization, ordered by name, as soon as the page and the
+// space quotas it references have been retrieved. Space quotas are only
+// retrieved once regardless of how many spaces reference them.
+func (actor Actor) GetOrganizationSpacesWithQuotas(orgGUID string, handlePage func([]SpaceWithQuota)) (Warnings, error) {
+	var quotaWarnings Warnings
+	quotaNames := map[string]string{}
+
+	query := []ccv2.Query{{
+		Filter:   ccv2.OrganizationGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    orgGUID,
+	}}
+
+	warnings, err := actor.CloudControllerClient.GetSpacesByPage(query, func(ccv2Spaces []ccv2.Space) error {
+		spaces := make([]SpaceWithQuota, 0, len(ccv2Spaces))
+		for _, ccv2Space := range ccv2Spaces {
+			quotaName, ok := quotaNames[ccv2Space.SpaceQuotaDefinitionGUID]
+			if !ok && ccv2Space.SpaceQuotaDefinitionGUID != "" {
+				quota, warnings, err := actor.GetSpaceQuota(ccv2Space.SpaceQuotaDefinitionGUID)
+				quotaWarnings = append(quotaWarnings, warnings...)
+				if err != nil {
+					if _, isNotFound := err.(SpaceQuotaNotFoundError); !isNotFound {
+						return err
+					}
+				}
+				quotaName = quota.Name
+				quotaNames[ccv2Space.SpaceQuotaDefinitionGUID] = quotaName
+			}
+
+			spaces = append(spaces, SpaceWithQuota{
+				Space:     Space(ccv2Space),
+				QuotaName: quotaName,
+			})
+		}
+
+		handlePage(spaces)
+		return nil
+	})
+
+	return append(Warnings(warnings), quotaWarnings...), err
+}
@@ -0,0 +1,117 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space With Quota Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetOrganizationSpacesWithQuotas", func() {
+		var (
+			pages    [][]SpaceWithQuota
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			pages = nil
+			fakeCloudControllerClient.GetSpacesByPageStub = func(_ []ccv2.Query, handlePage func([]ccv2.Space) error) (ccv2.Warnings, error) {
+				err := handlePage([]ccv2.Space{
+					{GUID: "space-guid-1", Name: "space-1", SpaceQuotaDefinitionGUID: "quota-guid-1"},
+					{GUID: "space-guid-2", Name: "space-2"},
+				})
+				if err != nil {
+					return ccv2.Warnings{"spaces-warning-1"}, err
+				}
+				err = handlePage([]ccv2.Space{
+					{GUID: "space-guid-3", Name: "space-3", SpaceQuotaDefinitionGUID: "quota-guid-1"},
+				})
+				return ccv2.Warnings{"spaces-warning-1", "spaces-warning-2"}, err
+			}
+			fakeCloudControllerClient.GetSpaceQuotaReturns(ccv2.SpaceQuota{GUID: "quota-guid-1", Name: "quota-1"}, ccv2.Warnings{"quota-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.GetOrganizationSpacesWithQuotas("some-org-guid", func(spaces []SpaceWithQuota) {
+				pages = append(pages, spaces)
+			})
+		})
+
+		Context("when no errors are encountered", func() {
+			It("filters by organization and calls the handler with each page of spaces joined with their quota names", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pages).To(Equal([][]SpaceWithQuota{
+					{
+						{
+							Space:     Space{GUID: "space-guid-1", Name: "space-1", SpaceQuotaDefinitionGUID: "quota-guid-1"},
+							QuotaName: "quota-1",
+						},
+						{
+							Space: Space{GUID: "space-guid-2", Name: "space-2"},
+						},
+					},
+					{
+						{
+							Space:     Space{GUID: "space-guid-3", Name: "space-3", SpaceQuotaDefinitionGUID: "quota-guid-1"},
+							QuotaName: "quota-1",
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("spaces-warning-1", "spaces-warning-2", "quota-warning"))
+
+				queries, _ := fakeCloudControllerClient.GetSpacesByPageArgsForCall(0)
+				Expect(queries).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.OrganizationGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-org-guid",
+				}))
+
+				Expect(fakeCloudControllerClient.GetSpaceQuotaCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpaceQuotaArgsForCall(0)).To(Equal("quota-guid-1"))
+			})
+		})
+
+		Context("when a space quota cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotaReturns(ccv2.SpaceQuota{}, ccv2.Warnings{"quota-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("leaves the quota name empty", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pages[0][0].QuotaName).To(BeEmpty())
+			})
+		})
+
+		Context("when getting a space quota fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some quota error")
+				fakeCloudControllerClient.GetSpaceQuotaReturns(ccv2.SpaceQuota{}, ccv2.Warnings{"quota-warning"}, expectedErr)
+			})
+
+			It("stops handling pages and returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(pages).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("spaces-warning-1", "quota-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package v2action
+
+import "fmt"
+
+// SSHAuthentication contains everything needed to open an SSH session with an
+// application instance through the SSH proxy.
+type SSHAuthentication struct {
+	Endpoint           string
+	HostKeyFingerprint string
+	Username           string
+	Passcode           string
+}
+
+// ApplicationNotStartedError is returned when an operation requires the
+// application to be started.
+type ApplicationNotStartedError struct {
+	Name string
+}
+
+func (e ApplicationNotStartedError) Error() string {
+	return fmt.Sprintf("Application '%s' is not in the STARTED state", e.Name)
+}
+
+// ApplicationInstanceNotFoundError is returned when the requested instance
+// index does not exist for an application.
+type ApplicationInstanceNotFoundError struct {
+	Name          string
+	InstanceIndex int
+}
+
+func (e ApplicationInstanceNotFoundError) Error() string {
+	return fmt.Sprintf("Instance %d of application '%s' not found", e.InstanceIndex, e.Name)
+}
+
+// GetSSHAuthenticationForApplicationInstance returns the SSH proxy endpoint
+// and the credentials for the provided instance of the application. The
+// passcode is a one time code obtained from UAA with the provided access
+// token.
+func (actor Actor) GetSSHAuthenticationForApplicationInstance(app Application, instanceIndex int, accessToken string) (SSHAuthentication, Warnings, error) {
+	if !app.Started() {
+		return SSHAuthentication{}, nil, ApplicationNotStartedError{Name: app.Name}
+	}
+
+	if instanceIndex < 0 || instanceIndex >= app.Instances {
+		return SSHAuthentication{}, nil, ApplicationInstanceNotFoundError{Name: app.Name, InstanceIndex: instanceIndex}
+	}
+
+	passcode, err := actor.UAAClient.GetSSHPasscode(accessToken, actor.CloudControllerClient.AppSSHOAuthClient())
+	if err != nil {
+		return SSHAuthentication{}, nil, err
+	}
+
+	return SSHAuthentication{
+		Endpoint:           actor.CloudControllerClient.AppSSHEndpoint(),
+		HostKeyFingerprint: actor.CloudControllerClient.AppSSHHostKeyFingerprint(),
+		Username:           fmt.Sprintf("cf:%s/%d", app.GUID, instanceIndex),
+		Passcode:           passcode,
+	}, nil, nil
+}
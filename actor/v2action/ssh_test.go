@@ -0,0 +1,103 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSH Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeUAAClient             *v2actionfakes.FakeUAAClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		actor = NewActor(fakeCloudControllerClient, fakeUAAClient)
+	})
+
+	Describe("GetSSHAuthenticationForApplicationInstance", func() {
+		var (
+			app            Application
+			instanceIndex  int
+			authentication SSHAuthentication
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			app = Application{
+				GUID:      "some-app-guid",
+				Name:      "some-app",
+				Instances: 2,
+				State:     ccv2.ApplicationStarted,
+			}
+			instanceIndex = 1
+
+			fakeCloudControllerClient.AppSSHEndpointReturns("ssh.example.com:2222")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-fingerprint")
+			fakeCloudControllerClient.AppSSHOAuthClientReturns("ssh-proxy")
+			fakeUAAClient.GetSSHPasscodeReturns("some-passcode", nil)
+		})
+
+		JustBeforeEach(func() {
+			authentication, _, executeErr = actor.GetSSHAuthenticationForApplicationInstance(app, instanceIndex, "bearer some-access-token")
+		})
+
+		It("returns the endpoint and the credentials for the instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(authentication).To(Equal(SSHAuthentication{
+				Endpoint:           "ssh.example.com:2222",
+				HostKeyFingerprint: "some-fingerprint",
+				Username:           "cf:some-app-guid/1",
+				Passcode:           "some-passcode",
+			}))
+
+			Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(1))
+			accessToken, sshOAuthClient := fakeUAAClient.GetSSHPasscodeArgsForCall(0)
+			Expect(accessToken).To(Equal("bearer some-access-token"))
+			Expect(sshOAuthClient).To(Equal("ssh-proxy"))
+		})
+
+		Context("when the application is not started", func() {
+			BeforeEach(func() {
+				app.State = ccv2.ApplicationStopped
+			})
+
+			It("returns an ApplicationNotStartedError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotStartedError{Name: "some-app"}))
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the instance index is out of range", func() {
+			BeforeEach(func() {
+				instanceIndex = 2
+			})
+
+			It("returns an ApplicationInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationInstanceNotFoundError{Name: "some-app", InstanceIndex: 2}))
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the passcode fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("passcode error")
+				fakeUAAClient.GetSSHPasscodeReturns("", expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
@@ -12,9 +12,13 @@ type Stack ccv2.Stack
 // StackNotFoundError is returned when a requested stack is not found.
 type StackNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e StackNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Stack '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Stack with GUID '%s' not found.", e.GUID)
 }
 
@@ -28,3 +32,23 @@ func (actor Actor) GetStack(guid string) (Stack, Warnings, error) {
 
 	return Stack(stack), Warnings(warnings), err
 }
+
+// GetStackByName returns the stack with the provided name.
+func (actor Actor) GetStackByName(stackName string) (Stack, Warnings, error) {
+	stacks, warnings, err := actor.CloudControllerClient.GetStacks([]ccv2.Query{
+		{
+			Filter:   ccv2.NameFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    stackName,
+		},
+	})
+	if err != nil {
+		return Stack{}, Warnings(warnings), err
+	}
+
+	if len(stacks) == 0 {
+		return Stack{}, Warnings(warnings), StackNotFoundError{Name: stackName}
+	}
+
+	return Stack(stacks[0]), Warnings(warnings), nil
+}
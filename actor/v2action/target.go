@@ -5,7 +5,9 @@ import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 type TargetSettings ccv2.TargetSettings
 
 // SetTarget targets the Cloud Controller using the client and sets target
-// information in the actor based on the response.
+// information in the actor based on the response. The tokens of the previous
+// target are kept in the config and the tokens previously stored for the new
+// target, if any, are restored.
 func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings, error) {
 	if config.Target() == settings.URL && config.SkipSSLValidation() == settings.SkipSSLValidation {
 		return nil, nil
@@ -16,6 +18,7 @@ func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings,
 		return Warnings(warnings), err
 	}
 
+	config.SaveTargetSession()
 	config.SetTargetInformation(
 		actor.CloudControllerClient.API(),
 		actor.CloudControllerClient.APIVersion(),
@@ -26,7 +29,8 @@ func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings,
 		actor.CloudControllerClient.RoutingEndpoint(),
 		settings.SkipSSLValidation,
 	)
-	config.SetTokenInformation("", "", "")
+	accessToken, refreshToken := config.TakeTargetSession(actor.CloudControllerClient.API())
+	config.SetTokenInformation(accessToken, refreshToken, "")
 
 	return Warnings(warnings), nil
 }
@@ -78,16 +78,46 @@ var _ = Describe("Targeting", func() {
 			Expect(sslDisabled).To(Equal(skipSSLValidation))
 		})
 
-		It("clears all the token information", func() {
+		It("saves the tokens of the previous target", func() {
 			_, err := actor.SetTarget(fakeConfig, settings)
 			Expect(err).ToNot(HaveOccurred())
 
-			Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
-			accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+			Expect(fakeConfig.SaveTargetSessionCallCount()).To(Equal(1))
+		})
 
-			Expect(accessToken).To(BeEmpty())
-			Expect(refreshToken).To(BeEmpty())
-			Expect(sshOAuthClient).To(BeEmpty())
+		Context("when no tokens are stored for the new target", func() {
+			It("clears all the token information", func() {
+				_, err := actor.SetTarget(fakeConfig, settings)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.TakeTargetSessionCallCount()).To(Equal(1))
+				Expect(fakeConfig.TakeTargetSessionArgsForCall(0)).To(Equal(expectedAPI))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+				Expect(sshOAuthClient).To(BeEmpty())
+			})
+		})
+
+		Context("when tokens are stored for the new target", func() {
+			BeforeEach(func() {
+				fakeConfig.TakeTargetSessionReturns("some-access-token", "some-refresh-token")
+			})
+
+			It("restores the stored tokens", func() {
+				_, err := actor.SetTarget(fakeConfig, settings)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+
+				Expect(accessToken).To(Equal("some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(sshOAuthClient).To(BeEmpty())
+			})
 		})
 
 		Context("when setting the same API and skip SSL configuration", func() {
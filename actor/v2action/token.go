@@ -0,0 +1,76 @@
+package v2action
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SermoDigital/jose/jws"
+)
+
+// AccessTokenClaims represents the claims encoded in a UAA access token.
+type AccessTokenClaims struct {
+	UserName  string
+	UserID    string
+	ClientID  string
+	Issuer    string
+	Scopes    []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// InvalidAccessTokenError is returned when an access token cannot be decoded.
+type InvalidAccessTokenError struct{}
+
+func (e InvalidAccessTokenError) Error() string {
+	return "The access token could not be decoded"
+}
+
+// RefreshAccessToken exchanges the refresh token in the config for a new
+// access token, stores both new tokens in the config and returns the access
+// token with its token type prefix.
+func (actor Actor) RefreshAccessToken(config Config) (string, error) {
+	token, err := actor.UAAClient.RefreshAccessToken(config.RefreshToken())
+	if err != nil {
+		return "", err
+	}
+
+	config.SetAccessToken(token.AuthorizationToken())
+	config.SetRefreshToken(token.RefreshToken)
+	return token.AuthorizationToken(), nil
+}
+
+// DecodeAccessToken returns the claims of the provided access token. The
+// token's signature is not verified. A token type prefix such as "bearer" is
+// ignored.
+func (actor Actor) DecodeAccessToken(accessToken string) (AccessTokenClaims, error) {
+	fields := strings.Fields(accessToken)
+	if len(fields) == 0 {
+		return AccessTokenClaims{}, InvalidAccessTokenError{}
+	}
+
+	token, err := jws.ParseJWT([]byte(fields[len(fields)-1]))
+	if err != nil {
+		return AccessTokenClaims{}, InvalidAccessTokenError{}
+	}
+
+	claims := token.Claims()
+	tokenClaims := AccessTokenClaims{}
+	tokenClaims.UserName, _ = claims.Get("user_name").(string)
+	tokenClaims.UserID, _ = claims.Get("user_id").(string)
+	tokenClaims.ClientID, _ = claims.Get("client_id").(string)
+	tokenClaims.Issuer, _ = claims.Issuer()
+	tokenClaims.IssuedAt, _ = claims.IssuedAt()
+	tokenClaims.ExpiresAt, _ = claims.Expiration()
+
+	if scopes, ok := claims.Get("scope").([]interface{}); ok {
+		for _, scope := range scopes {
+			if name, isString := scope.(string); isString {
+				tokenClaims.Scopes = append(tokenClaims.Scopes, name)
+			}
+		}
+		sort.Strings(tokenClaims.Scopes)
+	}
+
+	return tokenClaims, nil
+}
@@ -0,0 +1,115 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Token Actions", func() {
+	var (
+		actor         Actor
+		fakeUAAClient *v2actionfakes.FakeUAAClient
+		fakeConfig    *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(nil, fakeUAAClient)
+	})
+
+	Describe("RefreshAccessToken", func() {
+		BeforeEach(func() {
+			fakeConfig.RefreshTokenReturns("some-refresh-token")
+		})
+
+		Context("when the refresh succeeds", func() {
+			BeforeEach(func() {
+				fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshToken{
+					AccessToken:  "some-new-access-token",
+					RefreshToken: "some-new-refresh-token",
+					Type:         "bearer",
+				}, nil)
+			})
+
+			It("stores and returns the new access token", func() {
+				token, err := actor.RefreshAccessToken(fakeConfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal("bearer some-new-access-token"))
+
+				Expect(fakeUAAClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeUAAClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+
+				Expect(fakeConfig.SetAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetAccessTokenArgsForCall(0)).To(Equal("bearer some-new-access-token"))
+				Expect(fakeConfig.SetRefreshTokenCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetRefreshTokenArgsForCall(0)).To(Equal("some-new-refresh-token"))
+			})
+		})
+
+		Context("when the refresh fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("refresh error")
+				fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshToken{}, expectedErr)
+			})
+
+			It("returns the error without updating the config", func() {
+				_, err := actor.RefreshAccessToken(fakeConfig)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(fakeConfig.SetAccessTokenCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetRefreshTokenCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DecodeAccessToken", func() {
+		Context("when the token is valid", func() {
+			It("returns the token's claims", func() {
+				claims, err := actor.DecodeAccessToken("bearer eyJhbGciOiJSUzI1NiIsImtpZCI6ImxlZ2FjeS10b2tlbi1rZXkiLCJ0eXAiOiJKV1QifQ.eyJqdGkiOiI3YzZkMDA2MjA2OTI0NmViYWI0ZjBmZjY3NGQ3Zjk4OSIsInN1YiI6Ijk1MTliZTNlLTQ0ZDktNDBkMC1hYjlhLWY0YWNlMTFkZjE1OSIsInNjb3BlIjpbIm9wZW5pZCIsInJvdXRpbmcucm91dGVyX2dyb3Vwcy53cml0ZSIsInNjaW0ucmVhZCIsImNsb3VkX2NvbnRyb2xsZXIuYWRtaW4iLCJ1YWEudXNlciIsInJvdXRpbmcucm91dGVyX2dyb3Vwcy5yZWFkIiwiY2xvdWRfY29udHJvbGxlci5yZWFkIiwicGFzc3dvcmQud3JpdGUiLCJjbG91ZF9jb250cm9sbGVyLndyaXRlIiwiZG9wcGxlci5maXJlaG9zZSIsInNjaW0ud3JpdGUiXSwiY2xpZW50X2lkIjoiY2YiLCJjaWQiOiJjZiIsImF6cCI6ImNmIiwiZ3JhbnRfdHlwZSI6InBhc3N3b3JkIiwidXNlcl9pZCI6Ijk1MTliZTNlLTQ0ZDktNDBkMC1hYjlhLWY0YWNlMTFkZjE1OSIsIm9yaWdpbiI6InVhYSIsInVzZXJfbmFtZSI6ImFkbWluIiwiZW1haWwiOiJhZG1pbiIsImF1dGhfdGltZSI6MTQ3MzI4NDU3NywicmV2X3NpZyI6IjZiMjdkYTZjIiwiaWF0IjoxNDczMjg0NTc3LCJleHAiOjE0NzMyODUxNzcsImlzcyI6Imh0dHBzOi8vdWFhLmJvc2gtbGl0ZS5jb20vb2F1dGgvdG9rZW4iLCJ6aWQiOiJ1YWEiLCJhdWQiOlsiY2YiLCJvcGVuaWQiLCJyb3V0aW5nLnJvdXRlcl9ncm91cHMiLCJzY2ltIiwiY2xvdWRfY29udHJvbGxlciIsInVhYSIsInBhc3N3b3JkIiwiZG9wcGxlciJdfQ.OcH_w9yIKJkEcTZMThIs-qJAHk3G0JwNjG-aomVH9hKye4ciFO6IMQMLKmCBrrAQVc7ST1SZZwq7gv12Dq__6Jp-hai0a2_ADJK-Vc9YXyNZKgYTWIeVNGM1JGdHgFSrBR2Lz7IIrH9HqeN8plrKV5HzU8uI9LL4lyOCjbXJ9cM")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(claims.UserName).To(Equal("admin"))
+				Expect(claims.UserID).To(Equal("9519be3e-44d9-40d0-ab9a-f4ace11df159"))
+				Expect(claims.ClientID).To(Equal("cf"))
+				Expect(claims.Issuer).To(Equal("https://uaa.bosh-lite.com/oauth/token"))
+				Expect(claims.IssuedAt).To(Equal(time.Unix(1473284577, 0)))
+				Expect(claims.ExpiresAt).To(Equal(time.Unix(1473285177, 0)))
+				Expect(claims.Scopes).To(Equal([]string{
+					"cloud_controller.admin",
+					"cloud_controller.read",
+					"cloud_controller.write",
+					"doppler.firehose",
+					"openid",
+					"password.write",
+					"routing.router_groups.read",
+					"routing.router_groups.write",
+					"scim.read",
+					"scim.write",
+					"uaa.user",
+				}))
+			})
+		})
+
+		Context("when the token cannot be decoded", func() {
+			It("returns an InvalidAccessTokenError", func() {
+				_, err := actor.DecodeAccessToken("bearer not-a-token")
+				Expect(err).To(MatchError(InvalidAccessTokenError{}))
+			})
+		})
+
+		Context("when the token is empty", func() {
+			It("returns an InvalidAccessTokenError", func() {
+				_, err := actor.DecodeAccessToken("")
+				Expect(err).To(MatchError(InvalidAccessTokenError{}))
+			})
+		})
+	})
+})
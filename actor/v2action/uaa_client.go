@@ -5,5 +5,11 @@ import "code.cloudfoundry.org/cli/api/uaa"
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
+	AddGroupMember(groupID string, member uaa.GroupMember) error
 	CreateUser(username string, password string, origin string) (uaa.User, error)
+	GetGroups(displayName string) ([]uaa.Group, error)
+	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
+	GetUsersByUsername(username string) ([]uaa.User, error)
+	RefreshAccessToken(refreshToken string) (uaa.RefreshToken, error)
+	RemoveGroupMember(groupID string, memberID string) error
 }
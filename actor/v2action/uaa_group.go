@@ -0,0 +1,165 @@
+package v2action
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// UAAGroup represents a UAA group. Members of the group are granted the scope
+// named after it.
+type UAAGroup uaa.Group
+
+// UAAGroupNotFoundError is returned when a UAA group cannot be found.
+type UAAGroupNotFoundError struct {
+	Name string
+}
+
+func (e UAAGroupNotFoundError) Error() string {
+	return fmt.Sprintf("UAA group '%s' not found.", e.Name)
+}
+
+// UAAUserNotFoundError is returned when a UAA user cannot be found.
+type UAAUserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (e UAAUserNotFoundError) Error() string {
+	return fmt.Sprintf("UAA user '%s' not found.", e.Username)
+}
+
+// MultipleUAAUsersFoundError is returned when the username matches users from
+// several origins and no origin was provided.
+type MultipleUAAUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (e MultipleUAAUsersFoundError) Error() string {
+	return fmt.Sprintf("UAA username '%s' matches users from origins: %s", e.Username, strings.Join(e.Origins, ", "))
+}
+
+// UAAUserAlreadyInGroupError is returned when adding a user to a group it
+// already belongs to.
+type UAAUserAlreadyInGroupError struct {
+	Username  string
+	GroupName string
+}
+
+func (e UAAUserAlreadyInGroupError) Error() string {
+	return fmt.Sprintf("User '%s' is already a member of group '%s'.", e.Username, e.GroupName)
+}
+
+// UAAUserNotInGroupError is returned when removing a user from a group it does
+// not belong to.
+type UAAUserNotInGroupError struct {
+	Username  string
+	GroupName string
+}
+
+func (e UAAUserNotInGroupError) Error() string {
+	return fmt.Sprintf("User '%s' is not a member of group '%s'.", e.Username, e.GroupName)
+}
+
+// GetUAAGroups returns every UAA group sorted by name.
+func (actor Actor) GetUAAGroups() ([]UAAGroup, error) {
+	uaaGroups, err := actor.UAAClient.GetGroups("")
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []UAAGroup
+	for _, group := range uaaGroups {
+		groups = append(groups, UAAGroup(group))
+	}
+	sort.Slice(groups, func(i int, j int) bool {
+		return groups[i].DisplayName < groups[j].DisplayName
+	})
+
+	return groups, nil
+}
+
+// AddUserToUAAGroup adds the user to the UAA group. The origin is required
+// when users from several origins share the username.
+func (actor Actor) AddUserToUAAGroup(username string, origin string, groupName string) error {
+	group, user, err := actor.getUAAGroupAndUser(username, origin, groupName)
+	if err != nil {
+		return err
+	}
+
+	if group.hasMember(user.ID) {
+		return UAAUserAlreadyInGroupError{Username: username, GroupName: groupName}
+	}
+
+	err = actor.UAAClient.AddGroupMember(group.ID, uaa.GroupMember{
+		Value:  user.ID,
+		Type:   uaa.GroupMemberTypeUser,
+		Origin: user.Origin,
+	})
+	if _, ok := err.(uaa.ConflictError); ok {
+		return UAAUserAlreadyInGroupError{Username: username, GroupName: groupName}
+	}
+	return err
+}
+
+// RemoveUserFromUAAGroup removes the user from the UAA group. The origin is
+// required when users from several origins share the username.
+func (actor Actor) RemoveUserFromUAAGroup(username string, origin string, groupName string) error {
+	group, user, err := actor.getUAAGroupAndUser(username, origin, groupName)
+	if err != nil {
+		return err
+	}
+
+	if !group.hasMember(user.ID) {
+		return UAAUserNotInGroupError{Username: username, GroupName: groupName}
+	}
+
+	return actor.UAAClient.RemoveGroupMember(group.ID, user.ID)
+}
+
+func (actor Actor) getUAAGroupAndUser(username string, origin string, groupName string) (UAAGroup, uaa.User, error) {
+	groups, err := actor.UAAClient.GetGroups(groupName)
+	if err != nil {
+		return UAAGroup{}, uaa.User{}, err
+	}
+	if len(groups) == 0 {
+		return UAAGroup{}, uaa.User{}, UAAGroupNotFoundError{Name: groupName}
+	}
+
+	users, err := actor.UAAClient.GetUsersByUsername(username)
+	if err != nil {
+		return UAAGroup{}, uaa.User{}, err
+	}
+
+	var matches []uaa.User
+	for _, user := range users {
+		if origin == "" || user.Origin == origin {
+			matches = append(matches, user)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return UAAGroup{}, uaa.User{}, UAAUserNotFoundError{Username: username, Origin: origin}
+	case 1:
+		return UAAGroup(groups[0]), matches[0], nil
+	default:
+		var origins []string
+		for _, user := range matches {
+			origins = append(origins, user.Origin)
+		}
+		return UAAGroup{}, uaa.User{}, MultipleUAAUsersFoundError{Username: username, Origins: origins}
+	}
+}
+
+func (group UAAGroup) hasMember(memberID string) bool {
+	for _, member := range group.Members {
+		if member.Value == memberID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,237 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UAA Group Actions", func() {
+	var (
+		actor                     Actor
+		fakeUAAClient             *v2actionfakes.FakeUAAClient
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, fakeUAAClient)
+	})
+
+	Describe("GetUAAGroups", func() {
+		Context("when getting the groups succeeds", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetGroupsReturns([]uaa.Group{
+					{ID: "group-id-2", DisplayName: "scim.read"},
+					{ID: "group-id-1", DisplayName: "cloud_controller.admin"},
+				}, nil)
+			})
+
+			It("returns the groups sorted by name", func() {
+				groups, err := actor.GetUAAGroups()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(groups).To(Equal([]UAAGroup{
+					{ID: "group-id-1", DisplayName: "cloud_controller.admin"},
+					{ID: "group-id-2", DisplayName: "scim.read"},
+				}))
+
+				Expect(fakeUAAClient.GetGroupsArgsForCall(0)).To(BeEmpty())
+			})
+		})
+
+		Context("when getting the groups fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("groups-error")
+				fakeUAAClient.GetGroupsReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetUAAGroups()
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Describe("AddUserToUAAGroup", func() {
+		var (
+			origin     string
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			origin = ""
+			fakeUAAClient.GetGroupsReturns([]uaa.Group{
+				{
+					ID:          "some-group-id",
+					DisplayName: "cloud_controller.admin",
+					Members:     []uaa.GroupMember{{Value: "other-user-id"}},
+				},
+			}, nil)
+			fakeUAAClient.GetUsersByUsernameReturns([]uaa.User{
+				{ID: "some-user-id", Username: "some-user", Origin: "uaa"},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.AddUserToUAAGroup("some-user", origin, "cloud_controller.admin")
+		})
+
+		It("adds the user to the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeUAAClient.GetGroupsArgsForCall(0)).To(Equal("cloud_controller.admin"))
+			Expect(fakeUAAClient.GetUsersByUsernameArgsForCall(0)).To(Equal("some-user"))
+
+			groupID, member := fakeUAAClient.AddGroupMemberArgsForCall(0)
+			Expect(groupID).To(Equal("some-group-id"))
+			Expect(member).To(Equal(uaa.GroupMember{Value: "some-user-id", Type: uaa.GroupMemberTypeUser, Origin: "uaa"}))
+		})
+
+		Context("when the group does not exist", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetGroupsReturns(nil, nil)
+			})
+
+			It("returns a UAAGroupNotFoundError", func() {
+				Expect(executeErr).To(MatchError(UAAGroupNotFoundError{Name: "cloud_controller.admin"}))
+				Expect(fakeUAAClient.AddGroupMemberCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user does not exist", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetUsersByUsernameReturns(nil, nil)
+			})
+
+			It("returns a UAAUserNotFoundError", func() {
+				Expect(executeErr).To(MatchError(UAAUserNotFoundError{Username: "some-user"}))
+				Expect(fakeUAAClient.AddGroupMemberCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when users from several origins share the username", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetUsersByUsernameReturns([]uaa.User{
+					{ID: "some-user-id", Username: "some-user", Origin: "uaa"},
+					{ID: "ldap-user-id", Username: "some-user", Origin: "ldap"},
+				}, nil)
+			})
+
+			It("returns a MultipleUAAUsersFoundError", func() {
+				Expect(executeErr).To(MatchError(MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}}))
+				Expect(fakeUAAClient.AddGroupMemberCallCount()).To(Equal(0))
+			})
+
+			Context("when an origin is provided", func() {
+				BeforeEach(func() {
+					origin = "ldap"
+				})
+
+				It("adds the user from that origin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					_, member := fakeUAAClient.AddGroupMemberArgsForCall(0)
+					Expect(member).To(Equal(uaa.GroupMember{Value: "ldap-user-id", Type: uaa.GroupMemberTypeUser, Origin: "ldap"}))
+				})
+			})
+		})
+
+		Context("when the user is already a member of the group", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetUsersByUsernameReturns([]uaa.User{
+					{ID: "other-user-id", Username: "some-user", Origin: "uaa"},
+				}, nil)
+			})
+
+			It("returns a UAAUserAlreadyInGroupError", func() {
+				Expect(executeErr).To(MatchError(UAAUserAlreadyInGroupError{Username: "some-user", GroupName: "cloud_controller.admin"}))
+				Expect(fakeUAAClient.AddGroupMemberCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when UAA reports a conflict", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AddGroupMemberReturns(uaa.ConflictError{Message: "Member already exists"})
+			})
+
+			It("returns a UAAUserAlreadyInGroupError", func() {
+				Expect(executeErr).To(MatchError(UAAUserAlreadyInGroupError{Username: "some-user", GroupName: "cloud_controller.admin"}))
+			})
+		})
+
+		Context("when adding the member fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("add-member-error")
+				fakeUAAClient.AddGroupMemberReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Describe("RemoveUserFromUAAGroup", func() {
+		var executeErr error
+
+		BeforeEach(func() {
+			fakeUAAClient.GetGroupsReturns([]uaa.Group{
+				{
+					ID:          "some-group-id",
+					DisplayName: "cloud_controller.admin",
+					Members:     []uaa.GroupMember{{Value: "some-user-id"}},
+				},
+			}, nil)
+			fakeUAAClient.GetUsersByUsernameReturns([]uaa.User{
+				{ID: "some-user-id", Username: "some-user", Origin: "uaa"},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.RemoveUserFromUAAGroup("some-user", "", "cloud_controller.admin")
+		})
+
+		It("removes the user from the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			groupID, memberID := fakeUAAClient.RemoveGroupMemberArgsForCall(0)
+			Expect(groupID).To(Equal("some-group-id"))
+			Expect(memberID).To(Equal("some-user-id"))
+		})
+
+		Context("when the user is not a member of the group", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetGroupsReturns([]uaa.Group{
+					{ID: "some-group-id", DisplayName: "cloud_controller.admin"},
+				}, nil)
+			})
+
+			It("returns a UAAUserNotInGroupError", func() {
+				Expect(executeErr).To(MatchError(UAAUserNotInGroupError{Username: "some-user", GroupName: "cloud_controller.admin"}))
+				Expect(fakeUAAClient.RemoveGroupMemberCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when removing the member fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("remove-member-error")
+				fakeUAAClient.RemoveGroupMemberReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
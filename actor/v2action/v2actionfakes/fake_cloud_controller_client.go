@@ -69,6 +69,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateOrganizationStub        func(orgName string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		orgName   string
+		quotaGUID string
+	}
+	createOrganizationReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateRouteStub        func(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
@@ -85,6 +101,89 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateRouteMappingStub        func(appGUID string, routeGUID string, appPort int) (ccv2.RouteMapping, ccv2.Warnings, error)
+	createRouteMappingMutex       sync.RWMutex
+	createRouteMappingArgsForCall []struct {
+		appGUID   string
+		routeGUID string
+		appPort   int
+	}
+	createRouteMappingReturns struct {
+		result1 ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createRouteMappingReturnsOnCall map[int]struct {
+		result1 ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSecurityGroupStub        func(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	createSecurityGroupMutex       sync.RWMutex
+	createSecurityGroupArgsForCall []struct {
+		name  string
+		rules []ccv2.SecurityGroupRule
+	}
+	createSecurityGroupReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateServiceBindingStub        func(appGUID string, serviceInstanceGUID string) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	createServiceBindingMutex       sync.RWMutex
+	createServiceBindingArgsForCall []struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}
+	createServiceBindingReturns struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceBindingReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateServiceInstanceStub        func(spaceGUID string, servicePlanGUID string, name string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	createServiceInstanceMutex       sync.RWMutex
+	createServiceInstanceArgsForCall []struct {
+		spaceGUID       string
+		servicePlanGUID string
+		name            string
+	}
+	createServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSpaceStub        func(spaceName string, orgGUID string, spaceQuotaGUID string) (ccv2.Space, ccv2.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		spaceName      string
+		orgGUID        string
+		spaceQuotaGUID string
+	}
+	createSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -100,6 +199,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateUserProvidedServiceInstanceStub        func(spaceGUID string, name string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	createUserProvidedServiceInstanceMutex       sync.RWMutex
+	createUserProvidedServiceInstanceArgsForCall []struct {
+		spaceGUID string
+		name      string
+	}
+	createUserProvidedServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
 	DeleteOrganizationStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationMutex       sync.RWMutex
 	deleteOrganizationArgsForCall []struct {
@@ -115,16 +230,31 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
-	DeleteRouteStub        func(routeGUID string) (ccv2.Warnings, error)
+	DeleteRouteStub        func(routeGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteRouteMutex       sync.RWMutex
 	deleteRouteArgsForCall []struct {
 		routeGUID string
 	}
 	deleteRouteReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	deleteRouteReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	DeleteRouteMappingStub        func(routeMappingGUID string) (ccv2.Warnings, error)
+	deleteRouteMappingMutex       sync.RWMutex
+	deleteRouteMappingArgsForCall []struct {
+		routeMappingGUID string
+	}
+	deleteRouteMappingReturns struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
-	deleteRouteReturnsOnCall map[int]struct {
+	deleteRouteMappingReturnsOnCall map[int]struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
@@ -141,6 +271,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	DeleteSpaceStub        func(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
+	deleteSpaceMutex       sync.RWMutex
+	deleteSpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	deleteSpaceReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	deleteSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetApplicationStub        func(guid string) (ccv2.Application, ccv2.Warnings, error)
 	getApplicationMutex       sync.RWMutex
 	getApplicationArgsForCall []struct {
@@ -217,6 +362,82 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetBuildpacksStub        func(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	getBuildpacksMutex       sync.RWMutex
+	getBuildpacksArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getBuildpacksReturns struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getBuildpacksReturnsOnCall map[int]struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetConfigFeatureFlagStub        func(name string) (ccv2.FeatureFlag, ccv2.Warnings, error)
+	getConfigFeatureFlagMutex       sync.RWMutex
+	getConfigFeatureFlagArgsForCall []struct {
+		name string
+	}
+	getConfigFeatureFlagReturns struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getConfigFeatureFlagReturnsOnCall map[int]struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetEventsStub        func(queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetOrganizationQuotasStub        func(queries []ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	getOrganizationQuotasMutex       sync.RWMutex
+	getOrganizationQuotasArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getOrganizationQuotasReturns struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetRecentEventsStub        func(queries []ccv2.Query, limit int) ([]ccv2.Event, ccv2.Warnings, error)
+	getRecentEventsMutex       sync.RWMutex
+	getRecentEventsArgsForCall []struct {
+		queries []ccv2.Query
+		limit   int
+	}
+	getRecentEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRecentEventsReturnsOnCall map[int]struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -293,6 +514,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationsByPageStub        func(queries []ccv2.Query, handlePage func([]ccv2.Organization) error) (ccv2.Warnings, error)
+	getOrganizationsByPageMutex       sync.RWMutex
+	getOrganizationsByPageArgsForCall []struct {
+		queries    []ccv2.Query
+		handlePage func([]ccv2.Organization) error
+	}
+	getOrganizationsByPageReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	getOrganizationsByPageReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetPrivateDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getPrivateDomainMutex       sync.RWMutex
 	getPrivateDomainArgsForCall []struct {
@@ -324,6 +559,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRouteCountStub        func(queries []ccv2.Query) (int, ccv2.Warnings, error)
+	getRouteCountMutex       sync.RWMutex
+	getRouteCountArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getRouteCountReturns struct {
+		result1 int
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRouteCountReturnsOnCall map[int]struct {
+		result1 int
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetRouteMappingsStub        func(queries []ccv2.Query) ([]ccv2.RouteMapping, ccv2.Warnings, error)
+	getRouteMappingsMutex       sync.RWMutex
+	getRouteMappingsArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getRouteMappingsReturns struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRouteMappingsReturnsOnCall map[int]struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetRoutesStub        func(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	getRoutesMutex       sync.RWMutex
 	getRoutesArgsForCall []struct {
@@ -339,6 +604,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSecurityGroupSpacesStub        func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
+	getSecurityGroupSpacesMutex       sync.RWMutex
+	getSecurityGroupSpacesArgsForCall []struct {
+		securityGroupGUID string
+	}
+	getSecurityGroupSpacesReturns struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSecurityGroupSpacesReturnsOnCall map[int]struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetSecurityGroupStagingSpacesStub        func(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
+	getSecurityGroupStagingSpacesMutex       sync.RWMutex
+	getSecurityGroupStagingSpacesArgsForCall []struct {
+		securityGroupGUID string
+	}
+	getSecurityGroupStagingSpacesReturns struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSecurityGroupStagingSpacesReturnsOnCall map[int]struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSecurityGroupsStub        func(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	getSecurityGroupsMutex       sync.RWMutex
 	getSecurityGroupsArgsForCall []struct {
@@ -369,6 +664,81 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceBrokersStub        func(queries []ccv2.Query) ([]ccv2.ServiceBroker, ccv2.Warnings, error)
+	getServiceBrokersMutex       sync.RWMutex
+	getServiceBrokersArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServiceBrokersReturns struct {
+		result1 []ccv2.ServiceBroker
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceBrokersReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceBroker
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceStub        func(serviceGUID string) (ccv2.Service, ccv2.Warnings, error)
+	getServiceMutex       sync.RWMutex
+	getServiceArgsForCall []struct {
+		serviceGUID string
+	}
+	getServiceReturns struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceReturnsOnCall map[int]struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceInstanceParametersStub        func(serviceInstanceGUID string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceInstanceParametersMutex       sync.RWMutex
+	getServiceInstanceParametersArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceInstanceParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceInstanceSharedFromStub        func(serviceInstanceGUID string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error)
+	getServiceInstanceSharedFromMutex       sync.RWMutex
+	getServiceInstanceSharedFromArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceSharedFromReturns struct {
+		result1 ccv2.ServiceInstanceSharedFrom
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceInstanceSharedFromReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstanceSharedFrom
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceInstanceSharedTosStub        func(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error)
+	getServiceInstanceSharedTosMutex       sync.RWMutex
+	getServiceInstanceSharedTosArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceSharedTosReturns struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceInstanceSharedTosReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceInstancesStub        func(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
 	getServiceInstancesMutex       sync.RWMutex
 	getServiceInstancesArgsForCall []struct {
@@ -384,6 +754,66 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServicePlanStub        func(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlanMutex       sync.RWMutex
+	getServicePlanArgsForCall []struct {
+		servicePlanGUID string
+	}
+	getServicePlanReturns struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlanReturnsOnCall map[int]struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlansStub        func(queries []ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlansMutex       sync.RWMutex
+	getServicePlansArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServicePlansReturns struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlansReturnsOnCall map[int]struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlanVisibilitiesStub        func(queries []ccv2.Query) ([]ccv2.ServicePlanVisibility, ccv2.Warnings, error)
+	getServicePlanVisibilitiesMutex       sync.RWMutex
+	getServicePlanVisibilitiesArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServicePlanVisibilitiesReturns struct {
+		result1 []ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlanVisibilitiesReturnsOnCall map[int]struct {
+		result1 []ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicesStub        func(queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
+	getServicesMutex       sync.RWMutex
+	getServicesArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServicesReturns struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicesReturnsOnCall map[int]struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSharedDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getSharedDomainMutex       sync.RWMutex
 	getSharedDomainArgsForCall []struct {
@@ -412,6 +842,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceStub        func(guid string) (ccv2.Space, ccv2.Warnings, error)
+	getSpaceMutex       sync.RWMutex
+	getSpaceArgsForCall []struct {
+		guid string
+	}
+	getSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceQuotaStub        func(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error)
 	getSpaceQuotaMutex       sync.RWMutex
 	getSpaceQuotaArgsForCall []struct {
@@ -427,6 +872,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceQuotasStub        func(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
+	getSpaceQuotasMutex       sync.RWMutex
+	getSpaceQuotasArgsForCall []struct {
+		orgGUID string
+	}
+	getSpaceQuotasReturns struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceRoutesStub        func(spaceGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	getSpaceRoutesMutex       sync.RWMutex
 	getSpaceRoutesArgsForCall []struct {
@@ -473,6 +933,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpacesByPageStub        func(queries []ccv2.Query, handlePage func([]ccv2.Space) error) (ccv2.Warnings, error)
+	getSpacesByPageMutex       sync.RWMutex
+	getSpacesByPageArgsForCall []struct {
+		queries    []ccv2.Query
+		handlePage func([]ccv2.Space) error
+	}
+	getSpacesByPageReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	getSpacesByPageReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetSpaceServiceInstancesStub        func(spaceGUID string, includeUserProvidedServices bool, queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
 	getSpaceServiceInstancesMutex       sync.RWMutex
 	getSpaceServiceInstancesArgsForCall []struct {
@@ -490,6 +964,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceServicesStub        func(spaceGUID string, queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error)
+	getSpaceServicesMutex       sync.RWMutex
+	getSpaceServicesArgsForCall []struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}
+	getSpaceServicesReturns struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceServicesReturnsOnCall map[int]struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpaceStagingSecurityGroupsBySpaceStub        func(spaceGUID string) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	getSpaceStagingSecurityGroupsBySpaceMutex       sync.RWMutex
 	getSpaceStagingSecurityGroupsBySpaceArgsForCall []struct {
@@ -520,6 +1010,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetStacksStub        func(queries []ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error)
+	getStacksMutex       sync.RWMutex
+	getStacksArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getStacksReturns struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getStacksReturnsOnCall map[int]struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}
 	PollJobStub        func(job ccv2.Job) (ccv2.Warnings, error)
 	pollJobMutex       sync.RWMutex
 	pollJobArgsForCall []struct {
@@ -533,6 +1038,32 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	PurgeServiceStub        func(serviceGUID string) (ccv2.Warnings, error)
+	purgeServiceMutex       sync.RWMutex
+	purgeServiceArgsForCall []struct {
+		serviceGUID string
+	}
+	purgeServiceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	purgeServiceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	PurgeServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.Warnings, error)
+	purgeServiceInstanceMutex       sync.RWMutex
+	purgeServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	purgeServiceInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	purgeServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	RemoveSpaceFromSecurityGroupStub        func(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	removeSpaceFromSecurityGroupMutex       sync.RWMutex
 	removeSpaceFromSecurityGroupArgsForCall []struct {
@@ -547,6 +1078,37 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	RestageApplicationStub        func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	restageApplicationMutex       sync.RWMutex
+	restageApplicationArgsForCall []struct {
+		app ccv2.Application
+	}
+	restageApplicationReturns struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}
+	restageApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}
+	ScaleApplicationStub        func(appGUID string, scale ccv2.ApplicationScale) (ccv2.Application, ccv2.Warnings, error)
+	scaleApplicationMutex       sync.RWMutex
+	scaleApplicationArgsForCall []struct {
+		appGUID string
+		scale   ccv2.ApplicationScale
+	}
+	scaleApplicationReturns struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}
+	scaleApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}
 	TargetCFStub        func(settings ccv2.TargetSettings) (ccv2.Warnings, error)
 	targetCFMutex       sync.RWMutex
 	targetCFArgsForCall []struct {
@@ -560,6 +1122,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	UnbindRouteFromApplicationStub        func(routeGUID string, appGUID string) (ccv2.Warnings, error)
+	unbindRouteFromApplicationMutex       sync.RWMutex
+	unbindRouteFromApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	unbindRouteFromApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	unbindRouteFromApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -575,6 +1151,101 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateOrganizationNameStub        func(orgGUID string, newName string) (ccv2.Organization, ccv2.Warnings, error)
+	updateOrganizationNameMutex       sync.RWMutex
+	updateOrganizationNameArgsForCall []struct {
+		orgGUID string
+		newName string
+	}
+	updateOrganizationNameReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateOrganizationNameReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateOrganizationUserRoleByUsernameStub        func(role ccv2.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationUserRoleByUsernameMutex       sync.RWMutex
+	updateOrganizationUserRoleByUsernameArgsForCall []struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		username string
+	}
+	updateOrganizationUserRoleByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserRoleByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSecurityGroupRulesStub        func(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	updateSecurityGroupRulesMutex       sync.RWMutex
+	updateSecurityGroupRulesArgsForCall []struct {
+		securityGroupGUID string
+		rules             []ccv2.SecurityGroupRule
+	}
+	updateSecurityGroupRulesReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSecurityGroupRulesReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateServiceInstanceNameStub        func(serviceInstanceGUID string, newName string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	updateServiceInstanceNameMutex       sync.RWMutex
+	updateServiceInstanceNameArgsForCall []struct {
+		serviceInstanceGUID string
+		newName             string
+	}
+	updateServiceInstanceNameReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateServiceInstanceNameReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateSpaceNameStub        func(spaceGUID string, newName string) (ccv2.Space, ccv2.Warnings, error)
+	updateSpaceNameMutex       sync.RWMutex
+	updateSpaceNameArgsForCall []struct {
+		spaceGUID string
+		newName   string
+	}
+	updateSpaceNameReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSpaceNameReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateUserProvidedServiceInstanceNameStub        func(serviceInstanceGUID string, newName string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	updateUserProvidedServiceInstanceNameMutex       sync.RWMutex
+	updateUserProvidedServiceInstanceNameArgsForCall []struct {
+		serviceInstanceGUID string
+		newName             string
+	}
+	updateUserProvidedServiceInstanceNameReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateUserProvidedServiceInstanceNameReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
 	APIStub        func() string
 	aPIMutex       sync.RWMutex
 	aPIArgsForCall []struct{}
@@ -593,6 +1264,33 @@ type FakeCloudControllerClient struct {
 	aPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AppSSHEndpointStub        func() string
+	appSSHEndpointMutex       sync.RWMutex
+	appSSHEndpointArgsForCall []struct{}
+	appSSHEndpointReturns     struct {
+		result1 string
+	}
+	appSSHEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AppSSHHostKeyFingerprintStub        func() string
+	appSSHHostKeyFingerprintMutex       sync.RWMutex
+	appSSHHostKeyFingerprintArgsForCall []struct{}
+	appSSHHostKeyFingerprintReturns     struct {
+		result1 string
+	}
+	appSSHHostKeyFingerprintReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AppSSHOAuthClientStub        func() string
+	appSSHOAuthClientMutex       sync.RWMutex
+	appSSHOAuthClientArgsForCall []struct{}
+	appSSHOAuthClientReturns     struct {
+		result1 string
+	}
+	appSSHOAuthClientReturnsOnCall map[int]struct {
+		result1 string
+	}
 	AuthorizationEndpointStub        func() string
 	authorizationEndpointMutex       sync.RWMutex
 	authorizationEndpointArgsForCall []struct{}
@@ -638,6 +1336,21 @@ type FakeCloudControllerClient struct {
 	tokenEndpointReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UpdateSpaceUserRoleByUsernameStub        func(role ccv2.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error)
+	updateSpaceUserRoleByUsernameMutex       sync.RWMutex
+	updateSpaceUserRoleByUsernameArgsForCall []struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		username  string
+	}
+	updateSpaceUserRoleByUsernameReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceUserRoleByUsernameReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -857,6 +1570,61 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateOrganization(orgName string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		orgName   string
+		quotaGUID string
+	}{orgName, quotaGUID})
+	fake.recordInvocation("CreateOrganization", []interface{}{orgName, quotaGUID})
+	fake.createOrganizationMutex.Unlock()
+	if fake.CreateOrganizationStub != nil {
+		return fake.CreateOrganizationStub(orgName, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationReturns.result1, fake.createOrganizationReturns.result2, fake.createOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationArgsForCall(i int) (string, string) {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return fake.createOrganizationArgsForCall[i].orgName, fake.createOrganizationArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error) {
 	fake.createRouteMutex.Lock()
 	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
@@ -912,1827 +1680,4233 @@ func (fake *FakeCloudControllerClient) CreateRouteReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
-	fake.createUserMutex.Lock()
-	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
-	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
-		uaaUserID string
-	}{uaaUserID})
-	fake.recordInvocation("CreateUser", []interface{}{uaaUserID})
-	fake.createUserMutex.Unlock()
-	if fake.CreateUserStub != nil {
-		return fake.CreateUserStub(uaaUserID)
+func (fake *FakeCloudControllerClient) CreateRouteMapping(appGUID string, routeGUID string, appPort int) (ccv2.RouteMapping, ccv2.Warnings, error) {
+	fake.createRouteMappingMutex.Lock()
+	ret, specificReturn := fake.createRouteMappingReturnsOnCall[len(fake.createRouteMappingArgsForCall)]
+	fake.createRouteMappingArgsForCall = append(fake.createRouteMappingArgsForCall, struct {
+		appGUID   string
+		routeGUID string
+		appPort   int
+	}{appGUID, routeGUID, appPort})
+	fake.recordInvocation("CreateRouteMapping", []interface{}{appGUID, routeGUID, appPort})
+	fake.createRouteMappingMutex.Unlock()
+	if fake.CreateRouteMappingStub != nil {
+		return fake.CreateRouteMappingStub(appGUID, routeGUID, appPort)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.createUserReturns.result1, fake.createUserReturns.result2, fake.createUserReturns.result3
+	return fake.createRouteMappingReturns.result1, fake.createRouteMappingReturns.result2, fake.createRouteMappingReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) CreateUserCallCount() int {
-	fake.createUserMutex.RLock()
-	defer fake.createUserMutex.RUnlock()
-	return len(fake.createUserArgsForCall)
+func (fake *FakeCloudControllerClient) CreateRouteMappingCallCount() int {
+	fake.createRouteMappingMutex.RLock()
+	defer fake.createRouteMappingMutex.RUnlock()
+	return len(fake.createRouteMappingArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreateUserArgsForCall(i int) string {
-	fake.createUserMutex.RLock()
-	defer fake.createUserMutex.RUnlock()
-	return fake.createUserArgsForCall[i].uaaUserID
+func (fake *FakeCloudControllerClient) CreateRouteMappingArgsForCall(i int) (string, string, int) {
+	fake.createRouteMappingMutex.RLock()
+	defer fake.createRouteMappingMutex.RUnlock()
+	return fake.createRouteMappingArgsForCall[i].appGUID, fake.createRouteMappingArgsForCall[i].routeGUID, fake.createRouteMappingArgsForCall[i].appPort
 }
 
-func (fake *FakeCloudControllerClient) CreateUserReturns(result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
-	fake.CreateUserStub = nil
-	fake.createUserReturns = struct {
-		result1 ccv2.User
+func (fake *FakeCloudControllerClient) CreateRouteMappingReturns(result1 ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.CreateRouteMappingStub = nil
+	fake.createRouteMappingReturns = struct {
+		result1 ccv2.RouteMapping
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
-	fake.CreateUserStub = nil
-	if fake.createUserReturnsOnCall == nil {
-		fake.createUserReturnsOnCall = make(map[int]struct {
-			result1 ccv2.User
+func (fake *FakeCloudControllerClient) CreateRouteMappingReturnsOnCall(i int, result1 ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.CreateRouteMappingStub = nil
+	if fake.createRouteMappingReturnsOnCall == nil {
+		fake.createRouteMappingReturnsOnCall = make(map[int]struct {
+			result1 ccv2.RouteMapping
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.createUserReturnsOnCall[i] = struct {
-		result1 ccv2.User
+	fake.createRouteMappingReturnsOnCall[i] = struct {
+		result1 ccv2.RouteMapping
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.deleteOrganizationMutex.Lock()
-	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
-	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
-		orgGUID string
-	}{orgGUID})
-	fake.recordInvocation("DeleteOrganization", []interface{}{orgGUID})
-	fake.deleteOrganizationMutex.Unlock()
-	if fake.DeleteOrganizationStub != nil {
-		return fake.DeleteOrganizationStub(orgGUID)
+func (fake *FakeCloudControllerClient) CreateSecurityGroup(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	var rulesCopy []ccv2.SecurityGroupRule
+	if rules != nil {
+		rulesCopy = make([]ccv2.SecurityGroupRule, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.createSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.createSecurityGroupReturnsOnCall[len(fake.createSecurityGroupArgsForCall)]
+	fake.createSecurityGroupArgsForCall = append(fake.createSecurityGroupArgsForCall, struct {
+		name  string
+		rules []ccv2.SecurityGroupRule
+	}{name, rulesCopy})
+	fake.recordInvocation("CreateSecurityGroup", []interface{}{name, rulesCopy})
+	fake.createSecurityGroupMutex.Unlock()
+	if fake.CreateSecurityGroupStub != nil {
+		return fake.CreateSecurityGroupStub(name, rules)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2, fake.deleteOrganizationReturns.result3
+	return fake.createSecurityGroupReturns.result1, fake.createSecurityGroupReturns.result2, fake.createSecurityGroupReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationCallCount() int {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return len(fake.deleteOrganizationArgsForCall)
+func (fake *FakeCloudControllerClient) CreateSecurityGroupCallCount() int {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return len(fake.createSecurityGroupArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationArgsForCall(i int) string {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return fake.deleteOrganizationArgsForCall[i].orgGUID
+func (fake *FakeCloudControllerClient) CreateSecurityGroupArgsForCall(i int) (string, []ccv2.SecurityGroupRule) {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return fake.createSecurityGroupArgsForCall[i].name, fake.createSecurityGroupArgsForCall[i].rules
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.DeleteOrganizationStub = nil
-	fake.deleteOrganizationReturns = struct {
-		result1 ccv2.Job
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	fake.createSecurityGroupReturns = struct {
+		result1 ccv2.SecurityGroup
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganizationReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.DeleteOrganizationStub = nil
-	if fake.deleteOrganizationReturnsOnCall == nil {
-		fake.deleteOrganizationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Job
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	if fake.createSecurityGroupReturnsOnCall == nil {
+		fake.createSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.deleteOrganizationReturnsOnCall[i] = struct {
-		result1 ccv2.Job
+	fake.createSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteRoute(routeGUID string) (ccv2.Warnings, error) {
-	fake.deleteRouteMutex.Lock()
-	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
-	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
-		routeGUID string
-	}{routeGUID})
-	fake.recordInvocation("DeleteRoute", []interface{}{routeGUID})
-	fake.deleteRouteMutex.Unlock()
-	if fake.DeleteRouteStub != nil {
-		return fake.DeleteRouteStub(routeGUID)
+func (fake *FakeCloudControllerClient) CreateServiceBinding(appGUID string, serviceInstanceGUID string) (ccv2.ServiceBinding, ccv2.Warnings, error) {
+	fake.createServiceBindingMutex.Lock()
+	ret, specificReturn := fake.createServiceBindingReturnsOnCall[len(fake.createServiceBindingArgsForCall)]
+	fake.createServiceBindingArgsForCall = append(fake.createServiceBindingArgsForCall, struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}{appGUID, serviceInstanceGUID})
+	fake.recordInvocation("CreateServiceBinding", []interface{}{appGUID, serviceInstanceGUID})
+	fake.createServiceBindingMutex.Unlock()
+	if fake.CreateServiceBindingStub != nil {
+		return fake.CreateServiceBindingStub(appGUID, serviceInstanceGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.deleteRouteReturns.result1, fake.deleteRouteReturns.result2
+	return fake.createServiceBindingReturns.result1, fake.createServiceBindingReturns.result2, fake.createServiceBindingReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) DeleteRouteCallCount() int {
-	fake.deleteRouteMutex.RLock()
-	defer fake.deleteRouteMutex.RUnlock()
-	return len(fake.deleteRouteArgsForCall)
+func (fake *FakeCloudControllerClient) CreateServiceBindingCallCount() int {
+	fake.createServiceBindingMutex.RLock()
+	defer fake.createServiceBindingMutex.RUnlock()
+	return len(fake.createServiceBindingArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DeleteRouteArgsForCall(i int) string {
-	fake.deleteRouteMutex.RLock()
-	defer fake.deleteRouteMutex.RUnlock()
-	return fake.deleteRouteArgsForCall[i].routeGUID
+func (fake *FakeCloudControllerClient) CreateServiceBindingArgsForCall(i int) (string, string) {
+	fake.createServiceBindingMutex.RLock()
+	defer fake.createServiceBindingMutex.RUnlock()
+	return fake.createServiceBindingArgsForCall[i].appGUID, fake.createServiceBindingArgsForCall[i].serviceInstanceGUID
 }
 
-func (fake *FakeCloudControllerClient) DeleteRouteReturns(result1 ccv2.Warnings, result2 error) {
-	fake.DeleteRouteStub = nil
-	fake.deleteRouteReturns = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+func (fake *FakeCloudControllerClient) CreateServiceBindingReturns(result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceBindingStub = nil
+	fake.createServiceBindingReturns = struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteRouteReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.DeleteRouteStub = nil
-	if fake.deleteRouteReturnsOnCall == nil {
-		fake.deleteRouteReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Warnings
-			result2 error
+func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int, result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceBindingStub = nil
+	if fake.createServiceBindingReturnsOnCall == nil {
+		fake.createServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceBinding
+			result2 ccv2.Warnings
+			result3 error
 		})
 	}
-	fake.deleteRouteReturnsOnCall[i] = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+	fake.createServiceBindingReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error) {
-	fake.deleteServiceBindingMutex.Lock()
-	ret, specificReturn := fake.deleteServiceBindingReturnsOnCall[len(fake.deleteServiceBindingArgsForCall)]
-	fake.deleteServiceBindingArgsForCall = append(fake.deleteServiceBindingArgsForCall, struct {
-		serviceBindingGUID string
-	}{serviceBindingGUID})
-	fake.recordInvocation("DeleteServiceBinding", []interface{}{serviceBindingGUID})
-	fake.deleteServiceBindingMutex.Unlock()
-	if fake.DeleteServiceBindingStub != nil {
-		return fake.DeleteServiceBindingStub(serviceBindingGUID)
+func (fake *FakeCloudControllerClient) CreateServiceInstance(spaceGUID string, servicePlanGUID string, name string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.createServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createServiceInstanceReturnsOnCall[len(fake.createServiceInstanceArgsForCall)]
+	fake.createServiceInstanceArgsForCall = append(fake.createServiceInstanceArgsForCall, struct {
+		spaceGUID       string
+		servicePlanGUID string
+		name            string
+	}{spaceGUID, servicePlanGUID, name})
+	fake.recordInvocation("CreateServiceInstance", []interface{}{spaceGUID, servicePlanGUID, name})
+	fake.createServiceInstanceMutex.Unlock()
+	if fake.CreateServiceInstanceStub != nil {
+		return fake.CreateServiceInstanceStub(spaceGUID, servicePlanGUID, name)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.deleteServiceBindingReturns.result1, fake.deleteServiceBindingReturns.result2
+	return fake.createServiceInstanceReturns.result1, fake.createServiceInstanceReturns.result2, fake.createServiceInstanceReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBindingCallCount() int {
-	fake.deleteServiceBindingMutex.RLock()
-	defer fake.deleteServiceBindingMutex.RUnlock()
-	return len(fake.deleteServiceBindingArgsForCall)
+func (fake *FakeCloudControllerClient) CreateServiceInstanceCallCount() int {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return len(fake.createServiceInstanceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBindingArgsForCall(i int) string {
-	fake.deleteServiceBindingMutex.RLock()
-	defer fake.deleteServiceBindingMutex.RUnlock()
-	return fake.deleteServiceBindingArgsForCall[i].serviceBindingGUID
+func (fake *FakeCloudControllerClient) CreateServiceInstanceArgsForCall(i int) (string, string, string) {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return fake.createServiceInstanceArgsForCall[i].spaceGUID, fake.createServiceInstanceArgsForCall[i].servicePlanGUID, fake.createServiceInstanceArgsForCall[i].name
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBindingReturns(result1 ccv2.Warnings, result2 error) {
-	fake.DeleteServiceBindingStub = nil
-	fake.deleteServiceBindingReturns = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	fake.createServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.DeleteServiceBindingStub = nil
-	if fake.deleteServiceBindingReturnsOnCall == nil {
-		fake.deleteServiceBindingReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Warnings
-			result2 error
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	if fake.createServiceInstanceReturnsOnCall == nil {
+		fake.createServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
 		})
 	}
-	fake.deleteServiceBindingReturnsOnCall[i] = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+	fake.createServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error) {
-	fake.getApplicationMutex.Lock()
-	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
-	fake.getApplicationArgsForCall = append(fake.getApplicationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetApplication", []interface{}{guid})
-	fake.getApplicationMutex.Unlock()
-	if fake.GetApplicationStub != nil {
-		return fake.GetApplicationStub(guid)
+func (fake *FakeCloudControllerClient) CreateSpace(spaceName string, orgGUID string, spaceQuotaGUID string) (ccv2.Space, ccv2.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		spaceName      string
+		orgGUID        string
+		spaceQuotaGUID string
+	}{spaceName, orgGUID, spaceQuotaGUID})
+	fake.recordInvocation("CreateSpace", []interface{}{spaceName, orgGUID, spaceQuotaGUID})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(spaceName, orgGUID, spaceQuotaGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationReturns.result1, fake.getApplicationReturns.result2, fake.getApplicationReturns.result3
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationCallCount() int {
-	fake.getApplicationMutex.RLock()
-	defer fake.getApplicationMutex.RUnlock()
-	return len(fake.getApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationArgsForCall(i int) string {
-	fake.getApplicationMutex.RLock()
-	defer fake.getApplicationMutex.RUnlock()
-	return fake.getApplicationArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) CreateSpaceArgsForCall(i int) (string, string, string) {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].spaceName, fake.createSpaceArgsForCall[i].orgGUID, fake.createSpaceArgsForCall[i].spaceQuotaGUID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationStub = nil
-	fake.getApplicationReturns = struct {
-		result1 ccv2.Application
+func (fake *FakeCloudControllerClient) CreateSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 ccv2.Space
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationStub = nil
-	if fake.getApplicationReturnsOnCall == nil {
-		fake.getApplicationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Application
+func (fake *FakeCloudControllerClient) CreateSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationReturnsOnCall[i] = struct {
-		result1 ccv2.Application
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
-	fake.getApplicationInstancesByApplicationMutex.Lock()
-	ret, specificReturn := fake.getApplicationInstancesByApplicationReturnsOnCall[len(fake.getApplicationInstancesByApplicationArgsForCall)]
-	fake.getApplicationInstancesByApplicationArgsForCall = append(fake.getApplicationInstancesByApplicationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetApplicationInstancesByApplication", []interface{}{guid})
-	fake.getApplicationInstancesByApplicationMutex.Unlock()
-	if fake.GetApplicationInstancesByApplicationStub != nil {
-		return fake.GetApplicationInstancesByApplicationStub(guid)
+func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
+	fake.createUserMutex.Lock()
+	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
+	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
+		uaaUserID string
+	}{uaaUserID})
+	fake.recordInvocation("CreateUser", []interface{}{uaaUserID})
+	fake.createUserMutex.Unlock()
+	if fake.CreateUserStub != nil {
+		return fake.CreateUserStub(uaaUserID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationInstancesByApplicationReturns.result1, fake.getApplicationInstancesByApplicationReturns.result2, fake.getApplicationInstancesByApplicationReturns.result3
+	return fake.createUserReturns.result1, fake.createUserReturns.result2, fake.createUserReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationCallCount() int {
-	fake.getApplicationInstancesByApplicationMutex.RLock()
-	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
-	return len(fake.getApplicationInstancesByApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) CreateUserCallCount() int {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return len(fake.createUserArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationArgsForCall(i int) string {
-	fake.getApplicationInstancesByApplicationMutex.RLock()
-	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
-	return fake.getApplicationInstancesByApplicationArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) CreateUserArgsForCall(i int) string {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return fake.createUserArgsForCall[i].uaaUserID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationReturns(result1 map[int]ccv2.ApplicationInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationInstancesByApplicationStub = nil
-	fake.getApplicationInstancesByApplicationReturns = struct {
-		result1 map[int]ccv2.ApplicationInstance
+func (fake *FakeCloudControllerClient) CreateUserReturns(result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserStub = nil
+	fake.createUserReturns = struct {
+		result1 ccv2.User
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationReturnsOnCall(i int, result1 map[int]ccv2.ApplicationInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationInstancesByApplicationStub = nil
-	if fake.getApplicationInstancesByApplicationReturnsOnCall == nil {
-		fake.getApplicationInstancesByApplicationReturnsOnCall = make(map[int]struct {
-			result1 map[int]ccv2.ApplicationInstance
+func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserStub = nil
+	if fake.createUserReturnsOnCall == nil {
+		fake.createUserReturnsOnCall = make(map[int]struct {
+			result1 ccv2.User
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationInstancesByApplicationReturnsOnCall[i] = struct {
-		result1 map[int]ccv2.ApplicationInstance
+	fake.createUserReturnsOnCall[i] = struct {
+		result1 ccv2.User
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error) {
-	fake.getApplicationInstanceStatusesByApplicationMutex.Lock()
-	ret, specificReturn := fake.getApplicationInstanceStatusesByApplicationReturnsOnCall[len(fake.getApplicationInstanceStatusesByApplicationArgsForCall)]
-	fake.getApplicationInstanceStatusesByApplicationArgsForCall = append(fake.getApplicationInstanceStatusesByApplicationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetApplicationInstanceStatusesByApplication", []interface{}{guid})
-	fake.getApplicationInstanceStatusesByApplicationMutex.Unlock()
-	if fake.GetApplicationInstanceStatusesByApplicationStub != nil {
-		return fake.GetApplicationInstanceStatusesByApplicationStub(guid)
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstance(spaceGUID string, name string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.createUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createUserProvidedServiceInstanceReturnsOnCall[len(fake.createUserProvidedServiceInstanceArgsForCall)]
+	fake.createUserProvidedServiceInstanceArgsForCall = append(fake.createUserProvidedServiceInstanceArgsForCall, struct {
+		spaceGUID string
+		name      string
+	}{spaceGUID, name})
+	fake.recordInvocation("CreateUserProvidedServiceInstance", []interface{}{spaceGUID, name})
+	fake.createUserProvidedServiceInstanceMutex.Unlock()
+	if fake.CreateUserProvidedServiceInstanceStub != nil {
+		return fake.CreateUserProvidedServiceInstanceStub(spaceGUID, name)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationInstanceStatusesByApplicationReturns.result1, fake.getApplicationInstanceStatusesByApplicationReturns.result2, fake.getApplicationInstanceStatusesByApplicationReturns.result3
+	return fake.createUserProvidedServiceInstanceReturns.result1, fake.createUserProvidedServiceInstanceReturns.result2, fake.createUserProvidedServiceInstanceReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationCallCount() int {
-	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
-	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
-	return len(fake.getApplicationInstanceStatusesByApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceCallCount() int {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.createUserProvidedServiceInstanceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationArgsForCall(i int) string {
-	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
-	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
-	return fake.getApplicationInstanceStatusesByApplicationArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceArgsForCall(i int) (string, string) {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.createUserProvidedServiceInstanceArgsForCall[i].spaceGUID, fake.createUserProvidedServiceInstanceArgsForCall[i].name
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationReturns(result1 map[int]ccv2.ApplicationInstanceStatus, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationInstanceStatusesByApplicationStub = nil
-	fake.getApplicationInstanceStatusesByApplicationReturns = struct {
-		result1 map[int]ccv2.ApplicationInstanceStatus
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	fake.createUserProvidedServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationReturnsOnCall(i int, result1 map[int]ccv2.ApplicationInstanceStatus, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationInstanceStatusesByApplicationStub = nil
-	if fake.getApplicationInstanceStatusesByApplicationReturnsOnCall == nil {
-		fake.getApplicationInstanceStatusesByApplicationReturnsOnCall = make(map[int]struct {
-			result1 map[int]ccv2.ApplicationInstanceStatus
+func (fake *FakeCloudControllerClient) CreateUserProvidedServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	if fake.createUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.createUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationInstanceStatusesByApplicationReturnsOnCall[i] = struct {
-		result1 map[int]ccv2.ApplicationInstanceStatus
+	fake.createUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutes(appGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
-	var queriesCopy []ccv2.Query
-	if queries != nil {
-		queriesCopy = make([]ccv2.Query, len(queries))
-		copy(queriesCopy, queries)
-	}
-	fake.getApplicationRoutesMutex.Lock()
-	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
-	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
-		appGUID string
-		queries []ccv2.Query
-	}{appGUID, queriesCopy})
-	fake.recordInvocation("GetApplicationRoutes", []interface{}{appGUID, queriesCopy})
-	fake.getApplicationRoutesMutex.Unlock()
-	if fake.GetApplicationRoutesStub != nil {
-		return fake.GetApplicationRoutesStub(appGUID, queries)
+func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteOrganizationMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
+	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("DeleteOrganization", []interface{}{orgGUID})
+	fake.deleteOrganizationMutex.Unlock()
+	if fake.DeleteOrganizationStub != nil {
+		return fake.DeleteOrganizationStub(orgGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2, fake.deleteOrganizationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutesCallCount() int {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return len(fake.getApplicationRoutesArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteOrganizationCallCount() int {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return len(fake.deleteOrganizationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutesArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return fake.getApplicationRoutesArgsForCall[i].appGUID, fake.getApplicationRoutesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteOrganizationArgsForCall(i int) string {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return fake.deleteOrganizationArgsForCall[i].orgGUID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationRoutesStub = nil
-	fake.getApplicationRoutesReturns = struct {
-		result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) DeleteOrganizationReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteOrganizationStub = nil
+	fake.deleteOrganizationReturns = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationRoutesStub = nil
-	if fake.getApplicationRoutesReturnsOnCall == nil {
-		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) DeleteOrganizationReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteOrganizationStub = nil
+	if fake.deleteOrganizationReturnsOnCall == nil {
+		fake.deleteOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationRoutesReturnsOnCall[i] = struct {
-		result1 []ccv2.Route
+	fake.deleteOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplications(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
-	var queriesCopy []ccv2.Query
-	if queries != nil {
-		queriesCopy = make([]ccv2.Query, len(queries))
-		copy(queriesCopy, queries)
-	}
-	fake.getApplicationsMutex.Lock()
-	ret, specificReturn := fake.getApplicationsReturnsOnCall[len(fake.getApplicationsArgsForCall)]
-	fake.getApplicationsArgsForCall = append(fake.getApplicationsArgsForCall, struct {
-		queries []ccv2.Query
-	}{queriesCopy})
-	fake.recordInvocation("GetApplications", []interface{}{queriesCopy})
-	fake.getApplicationsMutex.Unlock()
-	if fake.GetApplicationsStub != nil {
-		return fake.GetApplicationsStub(queries)
+func (fake *FakeCloudControllerClient) DeleteRoute(routeGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteRouteMutex.Lock()
+	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
+	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
+		routeGUID string
+	}{routeGUID})
+	fake.recordInvocation("DeleteRoute", []interface{}{routeGUID})
+	fake.deleteRouteMutex.Unlock()
+	if fake.DeleteRouteStub != nil {
+		return fake.DeleteRouteStub(routeGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationsReturns.result1, fake.getApplicationsReturns.result2, fake.getApplicationsReturns.result3
-}
-
-func (fake *FakeCloudControllerClient) GetApplicationsCallCount() int {
-	fake.getApplicationsMutex.RLock()
-	defer fake.getApplicationsMutex.RUnlock()
-	return len(fake.getApplicationsArgsForCall)
+	return fake.deleteRouteReturns.result1, fake.deleteRouteReturns.result2, fake.deleteRouteReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsArgsForCall(i int) []ccv2.Query {
-	fake.getApplicationsMutex.RLock()
-	defer fake.getApplicationsMutex.RUnlock()
-	return fake.getApplicationsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteRouteCallCount() int {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return len(fake.deleteRouteArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsReturns(result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationsStub = nil
-	fake.getApplicationsReturns = struct {
-		result1 []ccv2.Application
+func (fake *FakeCloudControllerClient) DeleteRouteArgsForCall(i int) string {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return fake.deleteRouteArgsForCall[i].routeGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteRouteStub = nil
+	fake.deleteRouteReturns = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetApplicationsStub = nil
-	if fake.getApplicationsReturnsOnCall == nil {
-		fake.getApplicationsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Application
+func (fake *FakeCloudControllerClient) DeleteRouteReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteRouteStub = nil
+	if fake.deleteRouteReturnsOnCall == nil {
+		fake.deleteRouteReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationsReturnsOnCall[i] = struct {
-		result1 []ccv2.Application
+	fake.deleteRouteReturnsOnCall[i] = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.getJobMutex.Lock()
-	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
-	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
-		jobGUID string
-	}{jobGUID})
-	fake.recordInvocation("GetJob", []interface{}{jobGUID})
-	fake.getJobMutex.Unlock()
-	if fake.GetJobStub != nil {
-		return fake.GetJobStub(jobGUID)
+func (fake *FakeCloudControllerClient) DeleteRouteMapping(routeMappingGUID string) (ccv2.Warnings, error) {
+	fake.deleteRouteMappingMutex.Lock()
+	ret, specificReturn := fake.deleteRouteMappingReturnsOnCall[len(fake.deleteRouteMappingArgsForCall)]
+	fake.deleteRouteMappingArgsForCall = append(fake.deleteRouteMappingArgsForCall, struct {
+		routeMappingGUID string
+	}{routeMappingGUID})
+	fake.recordInvocation("DeleteRouteMapping", []interface{}{routeMappingGUID})
+	fake.deleteRouteMappingMutex.Unlock()
+	if fake.DeleteRouteMappingStub != nil {
+		return fake.DeleteRouteMappingStub(routeMappingGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getJobReturns.result1, fake.getJobReturns.result2, fake.getJobReturns.result3
+	return fake.deleteRouteMappingReturns.result1, fake.deleteRouteMappingReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetJobCallCount() int {
-	fake.getJobMutex.RLock()
-	defer fake.getJobMutex.RUnlock()
-	return len(fake.getJobArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteRouteMappingCallCount() int {
+	fake.deleteRouteMappingMutex.RLock()
+	defer fake.deleteRouteMappingMutex.RUnlock()
+	return len(fake.deleteRouteMappingArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetJobArgsForCall(i int) string {
-	fake.getJobMutex.RLock()
-	defer fake.getJobMutex.RUnlock()
-	return fake.getJobArgsForCall[i].jobGUID
+func (fake *FakeCloudControllerClient) DeleteRouteMappingArgsForCall(i int) string {
+	fake.deleteRouteMappingMutex.RLock()
+	defer fake.deleteRouteMappingMutex.RUnlock()
+	return fake.deleteRouteMappingArgsForCall[i].routeMappingGUID
 }
 
-func (fake *FakeCloudControllerClient) GetJobReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.GetJobStub = nil
-	fake.getJobReturns = struct {
-		result1 ccv2.Job
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteRouteMappingReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteRouteMappingStub = nil
+	fake.deleteRouteMappingReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetJobReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
-	fake.GetJobStub = nil
-	if fake.getJobReturnsOnCall == nil {
-		fake.getJobReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Job
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteRouteMappingReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteRouteMappingStub = nil
+	if fake.deleteRouteMappingReturnsOnCall == nil {
+		fake.deleteRouteMappingReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getJobReturnsOnCall[i] = struct {
-		result1 ccv2.Job
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteRouteMappingReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error) {
-	fake.getOrganizationMutex.Lock()
-	ret, specificReturn := fake.getOrganizationReturnsOnCall[len(fake.getOrganizationArgsForCall)]
-	fake.getOrganizationArgsForCall = append(fake.getOrganizationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetOrganization", []interface{}{guid})
-	fake.getOrganizationMutex.Unlock()
-	if fake.GetOrganizationStub != nil {
-		return fake.GetOrganizationStub(guid)
+func (fake *FakeCloudControllerClient) DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error) {
+	fake.deleteServiceBindingMutex.Lock()
+	ret, specificReturn := fake.deleteServiceBindingReturnsOnCall[len(fake.deleteServiceBindingArgsForCall)]
+	fake.deleteServiceBindingArgsForCall = append(fake.deleteServiceBindingArgsForCall, struct {
+		serviceBindingGUID string
+	}{serviceBindingGUID})
+	fake.recordInvocation("DeleteServiceBinding", []interface{}{serviceBindingGUID})
+	fake.deleteServiceBindingMutex.Unlock()
+	if fake.DeleteServiceBindingStub != nil {
+		return fake.DeleteServiceBindingStub(serviceBindingGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getOrganizationReturns.result1, fake.getOrganizationReturns.result2, fake.getOrganizationReturns.result3
+	return fake.deleteServiceBindingReturns.result1, fake.deleteServiceBindingReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationCallCount() int {
-	fake.getOrganizationMutex.RLock()
-	defer fake.getOrganizationMutex.RUnlock()
-	return len(fake.getOrganizationArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteServiceBindingCallCount() int {
+	fake.deleteServiceBindingMutex.RLock()
+	defer fake.deleteServiceBindingMutex.RUnlock()
+	return len(fake.deleteServiceBindingArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationArgsForCall(i int) string {
-	fake.getOrganizationMutex.RLock()
-	defer fake.getOrganizationMutex.RUnlock()
-	return fake.getOrganizationArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) DeleteServiceBindingArgsForCall(i int) string {
+	fake.deleteServiceBindingMutex.RLock()
+	defer fake.deleteServiceBindingMutex.RUnlock()
+	return fake.deleteServiceBindingArgsForCall[i].serviceBindingGUID
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationStub = nil
-	fake.getOrganizationReturns = struct {
-		result1 ccv2.Organization
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DeleteServiceBindingReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceBindingStub = nil
+	fake.deleteServiceBindingReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationStub = nil
-	if fake.getOrganizationReturnsOnCall == nil {
-		fake.getOrganizationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Organization
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceBindingStub = nil
+	if fake.deleteServiceBindingReturnsOnCall == nil {
+		fake.deleteServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getOrganizationReturnsOnCall[i] = struct {
-		result1 ccv2.Organization
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteServiceBindingReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomains(orgGUID string, queries []ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error) {
-	var queriesCopy []ccv2.Query
-	if queries != nil {
-		queriesCopy = make([]ccv2.Query, len(queries))
-		copy(queriesCopy, queries)
-	}
-	fake.getOrganizationPrivateDomainsMutex.Lock()
-	ret, specificReturn := fake.getOrganizationPrivateDomainsReturnsOnCall[len(fake.getOrganizationPrivateDomainsArgsForCall)]
-	fake.getOrganizationPrivateDomainsArgsForCall = append(fake.getOrganizationPrivateDomainsArgsForCall, struct {
-		orgGUID string
-		queries []ccv2.Query
-	}{orgGUID, queriesCopy})
-	fake.recordInvocation("GetOrganizationPrivateDomains", []interface{}{orgGUID, queriesCopy})
-	fake.getOrganizationPrivateDomainsMutex.Unlock()
-	if fake.GetOrganizationPrivateDomainsStub != nil {
-		return fake.GetOrganizationPrivateDomainsStub(orgGUID, queries)
+func (fake *FakeCloudControllerClient) DeleteSpace(spaceGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteSpaceMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceReturnsOnCall[len(fake.deleteSpaceArgsForCall)]
+	fake.deleteSpaceArgsForCall = append(fake.deleteSpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("DeleteSpace", []interface{}{spaceGUID})
+	fake.deleteSpaceMutex.Unlock()
+	if fake.DeleteSpaceStub != nil {
+		return fake.DeleteSpaceStub(spaceGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getOrganizationPrivateDomainsReturns.result1, fake.getOrganizationPrivateDomainsReturns.result2, fake.getOrganizationPrivateDomainsReturns.result3
+	return fake.deleteSpaceReturns.result1, fake.deleteSpaceReturns.result2, fake.deleteSpaceReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsCallCount() int {
-	fake.getOrganizationPrivateDomainsMutex.RLock()
-	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
-	return len(fake.getOrganizationPrivateDomainsArgsForCall)
+func (fake *FakeCloudControllerClient) DeleteSpaceCallCount() int {
+	fake.deleteSpaceMutex.RLock()
+	defer fake.deleteSpaceMutex.RUnlock()
+	return len(fake.deleteSpaceArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getOrganizationPrivateDomainsMutex.RLock()
-	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
-	return fake.getOrganizationPrivateDomainsArgsForCall[i].orgGUID, fake.getOrganizationPrivateDomainsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) DeleteSpaceArgsForCall(i int) string {
+	fake.deleteSpaceMutex.RLock()
+	defer fake.deleteSpaceMutex.RUnlock()
+	return fake.deleteSpaceArgsForCall[i].spaceGUID
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsReturns(result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationPrivateDomainsStub = nil
-	fake.getOrganizationPrivateDomainsReturns = struct {
-		result1 []ccv2.Domain
+func (fake *FakeCloudControllerClient) DeleteSpaceReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteSpaceStub = nil
+	fake.deleteSpaceReturns = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsReturnsOnCall(i int, result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationPrivateDomainsStub = nil
-	if fake.getOrganizationPrivateDomainsReturnsOnCall == nil {
-		fake.getOrganizationPrivateDomainsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Domain
+func (fake *FakeCloudControllerClient) DeleteSpaceReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteSpaceStub = nil
+	if fake.deleteSpaceReturnsOnCall == nil {
+		fake.deleteSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getOrganizationPrivateDomainsReturnsOnCall[i] = struct {
-		result1 []ccv2.Domain
+	fake.deleteSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
-	fake.getOrganizationQuotaMutex.Lock()
-	ret, specificReturn := fake.getOrganizationQuotaReturnsOnCall[len(fake.getOrganizationQuotaArgsForCall)]
-	fake.getOrganizationQuotaArgsForCall = append(fake.getOrganizationQuotaArgsForCall, struct {
+func (fake *FakeCloudControllerClient) GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error) {
+	fake.getApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
+	fake.getApplicationArgsForCall = append(fake.getApplicationArgsForCall, struct {
 		guid string
 	}{guid})
-	fake.recordInvocation("GetOrganizationQuota", []interface{}{guid})
-	fake.getOrganizationQuotaMutex.Unlock()
-	if fake.GetOrganizationQuotaStub != nil {
-		return fake.GetOrganizationQuotaStub(guid)
+	fake.recordInvocation("GetApplication", []interface{}{guid})
+	fake.getApplicationMutex.Unlock()
+	if fake.GetApplicationStub != nil {
+		return fake.GetApplicationStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getOrganizationQuotaReturns.result1, fake.getOrganizationQuotaReturns.result2, fake.getOrganizationQuotaReturns.result3
+	return fake.getApplicationReturns.result1, fake.getApplicationReturns.result2, fake.getApplicationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuotaCallCount() int {
-	fake.getOrganizationQuotaMutex.RLock()
-	defer fake.getOrganizationQuotaMutex.RUnlock()
-	return len(fake.getOrganizationQuotaArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationCallCount() int {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return len(fake.getApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuotaArgsForCall(i int) string {
-	fake.getOrganizationQuotaMutex.RLock()
-	defer fake.getOrganizationQuotaMutex.RUnlock()
-	return fake.getOrganizationQuotaArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) GetApplicationArgsForCall(i int) string {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return fake.getApplicationArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationQuotaStub = nil
-	fake.getOrganizationQuotaReturns = struct {
-		result1 ccv2.OrganizationQuota
+func (fake *FakeCloudControllerClient) GetApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	fake.getApplicationReturns = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationQuotaStub = nil
-	if fake.getOrganizationQuotaReturnsOnCall == nil {
-		fake.getOrganizationQuotaReturnsOnCall = make(map[int]struct {
-			result1 ccv2.OrganizationQuota
+func (fake *FakeCloudControllerClient) GetApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	if fake.getApplicationReturnsOnCall == nil {
+		fake.getApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Application
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getOrganizationQuotaReturnsOnCall[i] = struct {
-		result1 ccv2.OrganizationQuota
+	fake.getApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error) {
-	var queriesCopy []ccv2.Query
-	if queries != nil {
-		queriesCopy = make([]ccv2.Query, len(queries))
-		copy(queriesCopy, queries)
-	}
-	fake.getOrganizationsMutex.Lock()
-	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
-	fake.getOrganizationsArgsForCall = append(fake.getOrganizationsArgsForCall, struct {
-		queries []ccv2.Query
-	}{queriesCopy})
-	fake.recordInvocation("GetOrganizations", []interface{}{queriesCopy})
-	fake.getOrganizationsMutex.Unlock()
-	if fake.GetOrganizationsStub != nil {
-		return fake.GetOrganizationsStub(queries)
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
+	fake.getApplicationInstancesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstancesByApplicationReturnsOnCall[len(fake.getApplicationInstancesByApplicationArgsForCall)]
+	fake.getApplicationInstancesByApplicationArgsForCall = append(fake.getApplicationInstancesByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstancesByApplication", []interface{}{guid})
+	fake.getApplicationInstancesByApplicationMutex.Unlock()
+	if fake.GetApplicationInstancesByApplicationStub != nil {
+		return fake.GetApplicationInstancesByApplicationStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getOrganizationsReturns.result1, fake.getOrganizationsReturns.result2, fake.getOrganizationsReturns.result3
+	return fake.getApplicationInstancesByApplicationReturns.result1, fake.getApplicationInstancesByApplicationReturns.result2, fake.getApplicationInstancesByApplicationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsCallCount() int {
-	fake.getOrganizationsMutex.RLock()
-	defer fake.getOrganizationsMutex.RUnlock()
-	return len(fake.getOrganizationsArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationCallCount() int {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstancesByApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsArgsForCall(i int) []ccv2.Query {
-	fake.getOrganizationsMutex.RLock()
-	defer fake.getOrganizationsMutex.RUnlock()
-	return fake.getOrganizationsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return fake.getApplicationInstancesByApplicationArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsReturns(result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationsStub = nil
-	fake.getOrganizationsReturns = struct {
-		result1 []ccv2.Organization
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationReturns(result1 map[int]ccv2.ApplicationInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstancesByApplicationStub = nil
+	fake.getApplicationInstancesByApplicationReturns = struct {
+		result1 map[int]ccv2.ApplicationInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
-	fake.GetOrganizationsStub = nil
-	if fake.getOrganizationsReturnsOnCall == nil {
-		fake.getOrganizationsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Organization
+func (fake *FakeCloudControllerClient) GetApplicationInstancesByApplicationReturnsOnCall(i int, result1 map[int]ccv2.ApplicationInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstancesByApplicationStub = nil
+	if fake.getApplicationInstancesByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstancesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 map[int]ccv2.ApplicationInstance
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getOrganizationsReturnsOnCall[i] = struct {
-		result1 []ccv2.Organization
+	fake.getApplicationInstancesByApplicationReturnsOnCall[i] = struct {
+		result1 map[int]ccv2.ApplicationInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
-	fake.getPrivateDomainMutex.Lock()
-	ret, specificReturn := fake.getPrivateDomainReturnsOnCall[len(fake.getPrivateDomainArgsForCall)]
-	fake.getPrivateDomainArgsForCall = append(fake.getPrivateDomainArgsForCall, struct {
-		domainGUID string
-	}{domainGUID})
-	fake.recordInvocation("GetPrivateDomain", []interface{}{domainGUID})
-	fake.getPrivateDomainMutex.Unlock()
-	if fake.GetPrivateDomainStub != nil {
-		return fake.GetPrivateDomainStub(domainGUID)
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error) {
+	fake.getApplicationInstanceStatusesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstanceStatusesByApplicationReturnsOnCall[len(fake.getApplicationInstanceStatusesByApplicationArgsForCall)]
+	fake.getApplicationInstanceStatusesByApplicationArgsForCall = append(fake.getApplicationInstanceStatusesByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstanceStatusesByApplication", []interface{}{guid})
+	fake.getApplicationInstanceStatusesByApplicationMutex.Unlock()
+	if fake.GetApplicationInstanceStatusesByApplicationStub != nil {
+		return fake.GetApplicationInstanceStatusesByApplicationStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getPrivateDomainReturns.result1, fake.getPrivateDomainReturns.result2, fake.getPrivateDomainReturns.result3
+	return fake.getApplicationInstanceStatusesByApplicationReturns.result1, fake.getApplicationInstanceStatusesByApplicationReturns.result2, fake.getApplicationInstanceStatusesByApplicationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomainCallCount() int {
-	fake.getPrivateDomainMutex.RLock()
-	defer fake.getPrivateDomainMutex.RUnlock()
-	return len(fake.getPrivateDomainArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationCallCount() int {
+	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstanceStatusesByApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomainArgsForCall(i int) string {
-	fake.getPrivateDomainMutex.RLock()
-	defer fake.getPrivateDomainMutex.RUnlock()
-	return fake.getPrivateDomainArgsForCall[i].domainGUID
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
+	return fake.getApplicationInstanceStatusesByApplicationArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetPrivateDomainStub = nil
-	fake.getPrivateDomainReturns = struct {
-		result1 ccv2.Domain
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationReturns(result1 map[int]ccv2.ApplicationInstanceStatus, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatusesByApplicationStub = nil
+	fake.getApplicationInstanceStatusesByApplicationReturns = struct {
+		result1 map[int]ccv2.ApplicationInstanceStatus
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetPrivateDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetPrivateDomainStub = nil
-	if fake.getPrivateDomainReturnsOnCall == nil {
-		fake.getPrivateDomainReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Domain
+func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplicationReturnsOnCall(i int, result1 map[int]ccv2.ApplicationInstanceStatus, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatusesByApplicationStub = nil
+	if fake.getApplicationInstanceStatusesByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstanceStatusesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 map[int]ccv2.ApplicationInstanceStatus
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getPrivateDomainReturnsOnCall[i] = struct {
-		result1 ccv2.Domain
+	fake.getApplicationInstanceStatusesByApplicationReturnsOnCall[i] = struct {
+		result1 map[int]ccv2.ApplicationInstanceStatus
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplications(routeGUID string, queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetApplicationRoutes(appGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
 		queriesCopy = make([]ccv2.Query, len(queries))
 		copy(queriesCopy, queries)
 	}
-	fake.getRouteApplicationsMutex.Lock()
-	ret, specificReturn := fake.getRouteApplicationsReturnsOnCall[len(fake.getRouteApplicationsArgsForCall)]
-	fake.getRouteApplicationsArgsForCall = append(fake.getRouteApplicationsArgsForCall, struct {
-		routeGUID string
-		queries   []ccv2.Query
-	}{routeGUID, queriesCopy})
-	fake.recordInvocation("GetRouteApplications", []interface{}{routeGUID, queriesCopy})
-	fake.getRouteApplicationsMutex.Unlock()
-	if fake.GetRouteApplicationsStub != nil {
-		return fake.GetRouteApplicationsStub(routeGUID, queries)
+	fake.getApplicationRoutesMutex.Lock()
+	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
+	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
+		appGUID string
+		queries []ccv2.Query
+	}{appGUID, queriesCopy})
+	fake.recordInvocation("GetApplicationRoutes", []interface{}{appGUID, queriesCopy})
+	fake.getApplicationRoutesMutex.Unlock()
+	if fake.GetApplicationRoutesStub != nil {
+		return fake.GetApplicationRoutesStub(appGUID, queries)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getRouteApplicationsReturns.result1, fake.getRouteApplicationsReturns.result2, fake.getRouteApplicationsReturns.result3
+	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplicationsCallCount() int {
-	fake.getRouteApplicationsMutex.RLock()
-	defer fake.getRouteApplicationsMutex.RUnlock()
-	return len(fake.getRouteApplicationsArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationRoutesCallCount() int {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return len(fake.getApplicationRoutesArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplicationsArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getRouteApplicationsMutex.RLock()
-	defer fake.getRouteApplicationsMutex.RUnlock()
-	return fake.getRouteApplicationsArgsForCall[i].routeGUID, fake.getRouteApplicationsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetApplicationRoutesArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return fake.getApplicationRoutesArgsForCall[i].appGUID, fake.getApplicationRoutesArgsForCall[i].queries
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplicationsReturns(result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetRouteApplicationsStub = nil
-	fake.getRouteApplicationsReturns = struct {
-		result1 []ccv2.Application
+func (fake *FakeCloudControllerClient) GetApplicationRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationRoutesStub = nil
+	fake.getApplicationRoutesReturns = struct {
+		result1 []ccv2.Route
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetRouteApplicationsReturnsOnCall(i int, result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.GetRouteApplicationsStub = nil
-	if fake.getRouteApplicationsReturnsOnCall == nil {
-		fake.getRouteApplicationsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Application
+func (fake *FakeCloudControllerClient) GetApplicationRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationRoutesStub = nil
+	if fake.getApplicationRoutesReturnsOnCall == nil {
+		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Route
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getRouteApplicationsReturnsOnCall[i] = struct {
-		result1 []ccv2.Application
+	fake.getApplicationRoutesReturnsOnCall[i] = struct {
+		result1 []ccv2.Route
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetRoutes(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetApplications(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
 		queriesCopy = make([]ccv2.Query, len(queries))
 		copy(queriesCopy, queries)
 	}
-	fake.getRoutesMutex.Lock()
-	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
-	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
+	fake.getApplicationsMutex.Lock()
+	ret, specificReturn := fake.getApplicationsReturnsOnCall[len(fake.getApplicationsArgsForCall)]
+	fake.getApplicationsArgsForCall = append(fake.getApplicationsArgsForCall, struct {
 		queries []ccv2.Query
 	}{queriesCopy})
-	fake.recordInvocation("GetRoutes", []interface{}{queriesCopy})
-	fake.getRoutesMutex.Unlock()
-	if fake.GetRoutesStub != nil {
-		return fake.GetRoutesStub(queries)
+	fake.recordInvocation("GetApplications", []interface{}{queriesCopy})
+	fake.getApplicationsMutex.Unlock()
+	if fake.GetApplicationsStub != nil {
+		return fake.GetApplicationsStub(queries)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getRoutesReturns.result1, fake.getRoutesReturns.result2, fake.getRoutesReturns.result3
+	return fake.getApplicationsReturns.result1, fake.getApplicationsReturns.result2, fake.getApplicationsReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetRoutesCallCount() int {
-	fake.getRoutesMutex.RLock()
-	defer fake.getRoutesMutex.RUnlock()
-	return len(fake.getRoutesArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationsCallCount() int {
+	fake.getApplicationsMutex.RLock()
+	defer fake.getApplicationsMutex.RUnlock()
+	return len(fake.getApplicationsArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetRoutesArgsForCall(i int) []ccv2.Query {
-	fake.getRoutesMutex.RLock()
-	defer fake.getRoutesMutex.RUnlock()
-	return fake.getRoutesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetApplicationsArgsForCall(i int) []ccv2.Query {
+	fake.getApplicationsMutex.RLock()
+	defer fake.getApplicationsMutex.RUnlock()
+	return fake.getApplicationsArgsForCall[i].queries
 }
 
-func (fake *FakeCloudControllerClient) GetRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetRoutesStub = nil
-	fake.getRoutesReturns = struct {
-		result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) GetApplicationsReturns(result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationsStub = nil
+	fake.getApplicationsReturns = struct {
+		result1 []ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetRoutesStub = nil
-	if fake.getRoutesReturnsOnCall == nil {
-		fake.getRoutesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationsStub = nil
+	if fake.getApplicationsReturnsOnCall == nil {
+		fake.getApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Application
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getRoutesReturnsOnCall[i] = struct {
-		result1 []ccv2.Route
+	fake.getApplicationsReturnsOnCall[i] = struct {
+		result1 []ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroups(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetBuildpacks(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
 		queriesCopy = make([]ccv2.Query, len(queries))
 		copy(queriesCopy, queries)
 	}
-	fake.getSecurityGroupsMutex.Lock()
-	ret, specificReturn := fake.getSecurityGroupsReturnsOnCall[len(fake.getSecurityGroupsArgsForCall)]
-	fake.getSecurityGroupsArgsForCall = append(fake.getSecurityGroupsArgsForCall, struct {
+	fake.getBuildpacksMutex.Lock()
+	ret, specificReturn := fake.getBuildpacksReturnsOnCall[len(fake.getBuildpacksArgsForCall)]
+	fake.getBuildpacksArgsForCall = append(fake.getBuildpacksArgsForCall, struct {
 		queries []ccv2.Query
 	}{queriesCopy})
-	fake.recordInvocation("GetSecurityGroups", []interface{}{queriesCopy})
-	fake.getSecurityGroupsMutex.Unlock()
-	if fake.GetSecurityGroupsStub != nil {
-		return fake.GetSecurityGroupsStub(queries)
+	fake.recordInvocation("GetBuildpacks", []interface{}{queriesCopy})
+	fake.getBuildpacksMutex.Unlock()
+	if fake.GetBuildpacksStub != nil {
+		return fake.GetBuildpacksStub(queries)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSecurityGroupsReturns.result1, fake.getSecurityGroupsReturns.result2, fake.getSecurityGroupsReturns.result3
+	return fake.getBuildpacksReturns.result1, fake.getBuildpacksReturns.result2, fake.getBuildpacksReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroupsCallCount() int {
-	fake.getSecurityGroupsMutex.RLock()
-	defer fake.getSecurityGroupsMutex.RUnlock()
-	return len(fake.getSecurityGroupsArgsForCall)
+func (fake *FakeCloudControllerClient) GetBuildpacksCallCount() int {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return len(fake.getBuildpacksArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroupsArgsForCall(i int) []ccv2.Query {
-	fake.getSecurityGroupsMutex.RLock()
-	defer fake.getSecurityGroupsMutex.RUnlock()
-	return fake.getSecurityGroupsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetBuildpacksArgsForCall(i int) []ccv2.Query {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return fake.getBuildpacksArgsForCall[i].queries
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroupsReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSecurityGroupsStub = nil
-	fake.getSecurityGroupsReturns = struct {
-		result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) GetBuildpacksReturns(result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	fake.getBuildpacksReturns = struct {
+		result1 []ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSecurityGroupsReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSecurityGroupsStub = nil
-	if fake.getSecurityGroupsReturnsOnCall == nil {
-		fake.getSecurityGroupsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) GetBuildpacksReturnsOnCall(i int, result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	if fake.getBuildpacksReturnsOnCall == nil {
+		fake.getBuildpacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Buildpack
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSecurityGroupsReturnsOnCall[i] = struct {
-		result1 []ccv2.SecurityGroup
+	fake.getBuildpacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Buildpack
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindings(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetConfigFeatureFlag(name string) (ccv2.FeatureFlag, ccv2.Warnings, error) {
+	fake.getConfigFeatureFlagMutex.Lock()
+	ret, specificReturn := fake.getConfigFeatureFlagReturnsOnCall[len(fake.getConfigFeatureFlagArgsForCall)]
+	fake.getConfigFeatureFlagArgsForCall = append(fake.getConfigFeatureFlagArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetConfigFeatureFlag", []interface{}{name})
+	fake.getConfigFeatureFlagMutex.Unlock()
+	if fake.GetConfigFeatureFlagStub != nil {
+		return fake.GetConfigFeatureFlagStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getConfigFeatureFlagReturns.result1, fake.getConfigFeatureFlagReturns.result2, fake.getConfigFeatureFlagReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetConfigFeatureFlagCallCount() int {
+	fake.getConfigFeatureFlagMutex.RLock()
+	defer fake.getConfigFeatureFlagMutex.RUnlock()
+	return len(fake.getConfigFeatureFlagArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetConfigFeatureFlagArgsForCall(i int) string {
+	fake.getConfigFeatureFlagMutex.RLock()
+	defer fake.getConfigFeatureFlagMutex.RUnlock()
+	return fake.getConfigFeatureFlagArgsForCall[i].name
+}
+
+func (fake *FakeCloudControllerClient) GetConfigFeatureFlagReturns(result1 ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.GetConfigFeatureFlagStub = nil
+	fake.getConfigFeatureFlagReturns = struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetConfigFeatureFlagReturnsOnCall(i int, result1 ccv2.FeatureFlag, result2 ccv2.Warnings, result3 error) {
+	fake.GetConfigFeatureFlagStub = nil
+	if fake.getConfigFeatureFlagReturnsOnCall == nil {
+		fake.getConfigFeatureFlagReturnsOnCall = make(map[int]struct {
+			result1 ccv2.FeatureFlag
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getConfigFeatureFlagReturnsOnCall[i] = struct {
+		result1 ccv2.FeatureFlag
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetEvents(queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
 		queriesCopy = make([]ccv2.Query, len(queries))
 		copy(queriesCopy, queries)
 	}
-	fake.getServiceBindingsMutex.Lock()
-	ret, specificReturn := fake.getServiceBindingsReturnsOnCall[len(fake.getServiceBindingsArgsForCall)]
-	fake.getServiceBindingsArgsForCall = append(fake.getServiceBindingsArgsForCall, struct {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
 		queries []ccv2.Query
 	}{queriesCopy})
-	fake.recordInvocation("GetServiceBindings", []interface{}{queriesCopy})
-	fake.getServiceBindingsMutex.Unlock()
-	if fake.GetServiceBindingsStub != nil {
-		return fake.GetServiceBindingsStub(queries)
+	fake.recordInvocation("GetEvents", []interface{}{queriesCopy})
+	fake.getEventsMutex.Unlock()
+	if fake.GetEventsStub != nil {
+		return fake.GetEventsStub(queries)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getServiceBindingsReturns.result1, fake.getServiceBindingsReturns.result2, fake.getServiceBindingsReturns.result3
+	return fake.getEventsReturns.result1, fake.getEventsReturns.result2, fake.getEventsReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindingsCallCount() int {
-	fake.getServiceBindingsMutex.RLock()
-	defer fake.getServiceBindingsMutex.RUnlock()
-	return len(fake.getServiceBindingsArgsForCall)
+func (fake *FakeCloudControllerClient) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindingsArgsForCall(i int) []ccv2.Query {
-	fake.getServiceBindingsMutex.RLock()
-	defer fake.getServiceBindingsMutex.RUnlock()
-	return fake.getServiceBindingsArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetEventsArgsForCall(i int) []ccv2.Query {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return fake.getEventsArgsForCall[i].queries
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindingsReturns(result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceBindingsStub = nil
-	fake.getServiceBindingsReturns = struct {
-		result1 []ccv2.ServiceBinding
+func (fake *FakeCloudControllerClient) GetEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []ccv2.Event
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceBindingsReturnsOnCall(i int, result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceBindingsStub = nil
-	if fake.getServiceBindingsReturnsOnCall == nil {
-		fake.getServiceBindingsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.ServiceBinding
+func (fake *FakeCloudControllerClient) GetEventsReturnsOnCall(i int, result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Event
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getServiceBindingsReturnsOnCall[i] = struct {
-		result1 []ccv2.ServiceBinding
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []ccv2.Event
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstances(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetOrganizationQuotas(queries []ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
 		queriesCopy = make([]ccv2.Query, len(queries))
 		copy(queriesCopy, queries)
 	}
-	fake.getServiceInstancesMutex.Lock()
-	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
-	fake.getServiceInstancesArgsForCall = append(fake.getServiceInstancesArgsForCall, struct {
+	fake.getOrganizationQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
+	fake.getOrganizationQuotasArgsForCall = append(fake.getOrganizationQuotasArgsForCall, struct {
 		queries []ccv2.Query
 	}{queriesCopy})
-	fake.recordInvocation("GetServiceInstances", []interface{}{queriesCopy})
-	fake.getServiceInstancesMutex.Unlock()
-	if fake.GetServiceInstancesStub != nil {
-		return fake.GetServiceInstancesStub(queries)
+	fake.recordInvocation("GetOrganizationQuotas", []interface{}{queriesCopy})
+	fake.getOrganizationQuotasMutex.Unlock()
+	if fake.GetOrganizationQuotasStub != nil {
+		return fake.GetOrganizationQuotasStub(queries)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getServiceInstancesReturns.result1, fake.getServiceInstancesReturns.result2, fake.getServiceInstancesReturns.result3
+	return fake.getOrganizationQuotasReturns.result1, fake.getOrganizationQuotasReturns.result2, fake.getOrganizationQuotasReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
-	fake.getServiceInstancesMutex.RLock()
-	defer fake.getServiceInstancesMutex.RUnlock()
-	return len(fake.getServiceInstancesArgsForCall)
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCallCount() int {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return len(fake.getOrganizationQuotasArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) []ccv2.Query {
-	fake.getServiceInstancesMutex.RLock()
-	defer fake.getServiceInstancesMutex.RUnlock()
-	return fake.getServiceInstancesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasArgsForCall(i int) []ccv2.Query {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return fake.getOrganizationQuotasArgsForCall[i].queries
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceInstancesStub = nil
-	fake.getServiceInstancesReturns = struct {
-		result1 []ccv2.ServiceInstance
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturns(result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	fake.getOrganizationQuotasReturns = struct {
+		result1 []ccv2.OrganizationQuota
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceInstancesStub = nil
-	if fake.getServiceInstancesReturnsOnCall == nil {
-		fake.getServiceInstancesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.ServiceInstance
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int, result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	if fake.getOrganizationQuotasReturnsOnCall == nil {
+		fake.getOrganizationQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.OrganizationQuota
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getServiceInstancesReturnsOnCall[i] = struct {
-		result1 []ccv2.ServiceInstance
+	fake.getOrganizationQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.OrganizationQuota
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
-	fake.getSharedDomainMutex.Lock()
-	ret, specificReturn := fake.getSharedDomainReturnsOnCall[len(fake.getSharedDomainArgsForCall)]
-	fake.getSharedDomainArgsForCall = append(fake.getSharedDomainArgsForCall, struct {
-		domainGUID string
-	}{domainGUID})
-	fake.recordInvocation("GetSharedDomain", []interface{}{domainGUID})
-	fake.getSharedDomainMutex.Unlock()
-	if fake.GetSharedDomainStub != nil {
-		return fake.GetSharedDomainStub(domainGUID)
+func (fake *FakeCloudControllerClient) GetRecentEvents(queries []ccv2.Query, limit int) ([]ccv2.Event, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getRecentEventsMutex.Lock()
+	ret, specificReturn := fake.getRecentEventsReturnsOnCall[len(fake.getRecentEventsArgsForCall)]
+	fake.getRecentEventsArgsForCall = append(fake.getRecentEventsArgsForCall, struct {
+		queries []ccv2.Query
+		limit   int
+	}{queriesCopy, limit})
+	fake.recordInvocation("GetRecentEvents", []interface{}{queriesCopy, limit})
+	fake.getRecentEventsMutex.Unlock()
+	if fake.GetRecentEventsStub != nil {
+		return fake.GetRecentEventsStub(queries, limit)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSharedDomainReturns.result1, fake.getSharedDomainReturns.result2, fake.getSharedDomainReturns.result3
+	return fake.getRecentEventsReturns.result1, fake.getRecentEventsReturns.result2, fake.getRecentEventsReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainCallCount() int {
-	fake.getSharedDomainMutex.RLock()
-	defer fake.getSharedDomainMutex.RUnlock()
-	return len(fake.getSharedDomainArgsForCall)
+func (fake *FakeCloudControllerClient) GetRecentEventsCallCount() int {
+	fake.getRecentEventsMutex.RLock()
+	defer fake.getRecentEventsMutex.RUnlock()
+	return len(fake.getRecentEventsArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainArgsForCall(i int) string {
-	fake.getSharedDomainMutex.RLock()
-	defer fake.getSharedDomainMutex.RUnlock()
-	return fake.getSharedDomainArgsForCall[i].domainGUID
+func (fake *FakeCloudControllerClient) GetRecentEventsArgsForCall(i int) ([]ccv2.Query, int) {
+	fake.getRecentEventsMutex.RLock()
+	defer fake.getRecentEventsMutex.RUnlock()
+	return fake.getRecentEventsArgsForCall[i].queries, fake.getRecentEventsArgsForCall[i].limit
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainStub = nil
-	fake.getSharedDomainReturns = struct {
-		result1 ccv2.Domain
+func (fake *FakeCloudControllerClient) GetRecentEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetRecentEventsStub = nil
+	fake.getRecentEventsReturns = struct {
+		result1 []ccv2.Event
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainStub = nil
-	if fake.getSharedDomainReturnsOnCall == nil {
-		fake.getSharedDomainReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Domain
+func (fake *FakeCloudControllerClient) GetRecentEventsReturnsOnCall(i int, result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetRecentEventsStub = nil
+	if fake.getRecentEventsReturnsOnCall == nil {
+		fake.getRecentEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Event
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSharedDomainReturnsOnCall[i] = struct {
-		result1 ccv2.Domain
+	fake.getRecentEventsReturnsOnCall[i] = struct {
+		result1 []ccv2.Event
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomains() ([]ccv2.Domain, ccv2.Warnings, error) {
-	fake.getSharedDomainsMutex.Lock()
-	ret, specificReturn := fake.getSharedDomainsReturnsOnCall[len(fake.getSharedDomainsArgsForCall)]
-	fake.getSharedDomainsArgsForCall = append(fake.getSharedDomainsArgsForCall, struct{}{})
-	fake.recordInvocation("GetSharedDomains", []interface{}{})
-	fake.getSharedDomainsMutex.Unlock()
-	if fake.GetSharedDomainsStub != nil {
-		return fake.GetSharedDomainsStub()
+func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		jobGUID string
+	}{jobGUID})
+	fake.recordInvocation("GetJob", []interface{}{jobGUID})
+	fake.getJobMutex.Unlock()
+	if fake.GetJobStub != nil {
+		return fake.GetJobStub(jobGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSharedDomainsReturns.result1, fake.getSharedDomainsReturns.result2, fake.getSharedDomainsReturns.result3
+	return fake.getJobReturns.result1, fake.getJobReturns.result2, fake.getJobReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainsCallCount() int {
-	fake.getSharedDomainsMutex.RLock()
-	defer fake.getSharedDomainsMutex.RUnlock()
-	return len(fake.getSharedDomainsArgsForCall)
+func (fake *FakeCloudControllerClient) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainsReturns(result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainsStub = nil
-	fake.getSharedDomainsReturns = struct {
-		result1 []ccv2.Domain
+func (fake *FakeCloudControllerClient) GetJobArgsForCall(i int) string {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return fake.getJobArgsForCall[i].jobGUID
+}
+
+func (fake *FakeCloudControllerClient) GetJobReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSharedDomainsReturnsOnCall(i int, result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
-	fake.GetSharedDomainsStub = nil
-	if fake.getSharedDomainsReturnsOnCall == nil {
-		fake.getSharedDomainsReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Domain
+func (fake *FakeCloudControllerClient) GetJobReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSharedDomainsReturnsOnCall[i] = struct {
-		result1 []ccv2.Domain
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 ccv2.Job
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error) {
-	fake.getSpaceQuotaMutex.Lock()
-	ret, specificReturn := fake.getSpaceQuotaReturnsOnCall[len(fake.getSpaceQuotaArgsForCall)]
-	fake.getSpaceQuotaArgsForCall = append(fake.getSpaceQuotaArgsForCall, struct {
+func (fake *FakeCloudControllerClient) GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.getOrganizationMutex.Lock()
+	ret, specificReturn := fake.getOrganizationReturnsOnCall[len(fake.getOrganizationArgsForCall)]
+	fake.getOrganizationArgsForCall = append(fake.getOrganizationArgsForCall, struct {
 		guid string
 	}{guid})
-	fake.recordInvocation("GetSpaceQuota", []interface{}{guid})
-	fake.getSpaceQuotaMutex.Unlock()
-	if fake.GetSpaceQuotaStub != nil {
-		return fake.GetSpaceQuotaStub(guid)
+	fake.recordInvocation("GetOrganization", []interface{}{guid})
+	fake.getOrganizationMutex.Unlock()
+	if fake.GetOrganizationStub != nil {
+		return fake.GetOrganizationStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpaceQuotaReturns.result1, fake.getSpaceQuotaReturns.result2, fake.getSpaceQuotaReturns.result3
+	return fake.getOrganizationReturns.result1, fake.getOrganizationReturns.result2, fake.getOrganizationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuotaCallCount() int {
-	fake.getSpaceQuotaMutex.RLock()
-	defer fake.getSpaceQuotaMutex.RUnlock()
-	return len(fake.getSpaceQuotaArgsForCall)
+func (fake *FakeCloudControllerClient) GetOrganizationCallCount() int {
+	fake.getOrganizationMutex.RLock()
+	defer fake.getOrganizationMutex.RUnlock()
+	return len(fake.getOrganizationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuotaArgsForCall(i int) string {
-	fake.getSpaceQuotaMutex.RLock()
-	defer fake.getSpaceQuotaMutex.RUnlock()
-	return fake.getSpaceQuotaArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) GetOrganizationArgsForCall(i int) string {
+	fake.getOrganizationMutex.RLock()
+	defer fake.getOrganizationMutex.RUnlock()
+	return fake.getOrganizationArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuotaReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceQuotaStub = nil
-	fake.getSpaceQuotaReturns = struct {
-		result1 ccv2.SpaceQuota
+func (fake *FakeCloudControllerClient) GetOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationStub = nil
+	fake.getOrganizationReturns = struct {
+		result1 ccv2.Organization
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceQuotaReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceQuotaStub = nil
-	if fake.getSpaceQuotaReturnsOnCall == nil {
-		fake.getSpaceQuotaReturnsOnCall = make(map[int]struct {
-			result1 ccv2.SpaceQuota
+func (fake *FakeCloudControllerClient) GetOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationStub = nil
+	if fake.getOrganizationReturnsOnCall == nil {
+		fake.getOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceQuotaReturnsOnCall[i] = struct {
-		result1 ccv2.SpaceQuota
+	fake.getOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutes(spaceGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomains(orgGUID string, queries []ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
 		queriesCopy = make([]ccv2.Query, len(queries))
 		copy(queriesCopy, queries)
 	}
-	fake.getSpaceRoutesMutex.Lock()
-	ret, specificReturn := fake.getSpaceRoutesReturnsOnCall[len(fake.getSpaceRoutesArgsForCall)]
-	fake.getSpaceRoutesArgsForCall = append(fake.getSpaceRoutesArgsForCall, struct {
-		spaceGUID string
-		queries   []ccv2.Query
-	}{spaceGUID, queriesCopy})
-	fake.recordInvocation("GetSpaceRoutes", []interface{}{spaceGUID, queriesCopy})
-	fake.getSpaceRoutesMutex.Unlock()
-	if fake.GetSpaceRoutesStub != nil {
-		return fake.GetSpaceRoutesStub(spaceGUID, queries)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+	fake.getOrganizationPrivateDomainsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationPrivateDomainsReturnsOnCall[len(fake.getOrganizationPrivateDomainsArgsForCall)]
+	fake.getOrganizationPrivateDomainsArgsForCall = append(fake.getOrganizationPrivateDomainsArgsForCall, struct {
+		orgGUID string
+		queries []ccv2.Query
+	}{orgGUID, queriesCopy})
+	fake.recordInvocation("GetOrganizationPrivateDomains", []interface{}{orgGUID, queriesCopy})
+	fake.getOrganizationPrivateDomainsMutex.Unlock()
+	if fake.GetOrganizationPrivateDomainsStub != nil {
+		return fake.GetOrganizationPrivateDomainsStub(orgGUID, queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationPrivateDomainsReturns.result1, fake.getOrganizationPrivateDomainsReturns.result2, fake.getOrganizationPrivateDomainsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsCallCount() int {
+	fake.getOrganizationPrivateDomainsMutex.RLock()
+	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
+	return len(fake.getOrganizationPrivateDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getOrganizationPrivateDomainsMutex.RLock()
+	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
+	return fake.getOrganizationPrivateDomainsArgsForCall[i].orgGUID, fake.getOrganizationPrivateDomainsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsReturns(result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationPrivateDomainsStub = nil
+	fake.getOrganizationPrivateDomainsReturns = struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationPrivateDomainsReturnsOnCall(i int, result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationPrivateDomainsStub = nil
+	if fake.getOrganizationPrivateDomainsReturnsOnCall == nil {
+		fake.getOrganizationPrivateDomainsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationPrivateDomainsReturnsOnCall[i] = struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.getOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotaReturnsOnCall[len(fake.getOrganizationQuotaArgsForCall)]
+	fake.getOrganizationQuotaArgsForCall = append(fake.getOrganizationQuotaArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetOrganizationQuota", []interface{}{guid})
+	fake.getOrganizationQuotaMutex.Unlock()
+	if fake.GetOrganizationQuotaStub != nil {
+		return fake.GetOrganizationQuotaStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotaReturns.result1, fake.getOrganizationQuotaReturns.result2, fake.getOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotaCallCount() int {
+	fake.getOrganizationQuotaMutex.RLock()
+	defer fake.getOrganizationQuotaMutex.RUnlock()
+	return len(fake.getOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotaArgsForCall(i int) string {
+	fake.getOrganizationQuotaMutex.RLock()
+	defer fake.getOrganizationQuotaMutex.RUnlock()
+	return fake.getOrganizationQuotaArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotaStub = nil
+	fake.getOrganizationQuotaReturns = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotaStub = nil
+	if fake.getOrganizationQuotaReturnsOnCall == nil {
+		fake.getOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getOrganizationsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
+	fake.getOrganizationsArgsForCall = append(fake.getOrganizationsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetOrganizations", []interface{}{queriesCopy})
+	fake.getOrganizationsMutex.Unlock()
+	if fake.GetOrganizationsStub != nil {
+		return fake.GetOrganizationsStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationsReturns.result1, fake.getOrganizationsReturns.result2, fake.getOrganizationsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsCallCount() int {
+	fake.getOrganizationsMutex.RLock()
+	defer fake.getOrganizationsMutex.RUnlock()
+	return len(fake.getOrganizationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsArgsForCall(i int) []ccv2.Query {
+	fake.getOrganizationsMutex.RLock()
+	defer fake.getOrganizationsMutex.RUnlock()
+	return fake.getOrganizationsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsReturns(result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationsStub = nil
+	fake.getOrganizationsReturns = struct {
+		result1 []ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, result1 []ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationsStub = nil
+	if fake.getOrganizationsReturnsOnCall == nil {
+		fake.getOrganizationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationsReturnsOnCall[i] = struct {
+		result1 []ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsByPage(queries []ccv2.Query, handlePage func([]ccv2.Organization) error) (ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getOrganizationsByPageMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsByPageReturnsOnCall[len(fake.getOrganizationsByPageArgsForCall)]
+	fake.getOrganizationsByPageArgsForCall = append(fake.getOrganizationsByPageArgsForCall, struct {
+		queries    []ccv2.Query
+		handlePage func([]ccv2.Organization) error
+	}{queriesCopy, handlePage})
+	fake.recordInvocation("GetOrganizationsByPage", []interface{}{queriesCopy, handlePage})
+	fake.getOrganizationsByPageMutex.Unlock()
+	if fake.GetOrganizationsByPageStub != nil {
+		return fake.GetOrganizationsByPageStub(queries, handlePage)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrganizationsByPageReturns.result1, fake.getOrganizationsByPageReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsByPageCallCount() int {
+	fake.getOrganizationsByPageMutex.RLock()
+	defer fake.getOrganizationsByPageMutex.RUnlock()
+	return len(fake.getOrganizationsByPageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsByPageArgsForCall(i int) ([]ccv2.Query, func([]ccv2.Organization) error) {
+	fake.getOrganizationsByPageMutex.RLock()
+	defer fake.getOrganizationsByPageMutex.RUnlock()
+	return fake.getOrganizationsByPageArgsForCall[i].queries, fake.getOrganizationsByPageArgsForCall[i].handlePage
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsByPageReturns(result1 ccv2.Warnings, result2 error) {
+	fake.GetOrganizationsByPageStub = nil
+	fake.getOrganizationsByPageReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsByPageReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.GetOrganizationsByPageStub = nil
+	if fake.getOrganizationsByPageReturnsOnCall == nil {
+		fake.getOrganizationsByPageReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.getOrganizationsByPageReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+	fake.getPrivateDomainMutex.Lock()
+	ret, specificReturn := fake.getPrivateDomainReturnsOnCall[len(fake.getPrivateDomainArgsForCall)]
+	fake.getPrivateDomainArgsForCall = append(fake.getPrivateDomainArgsForCall, struct {
+		domainGUID string
+	}{domainGUID})
+	fake.recordInvocation("GetPrivateDomain", []interface{}{domainGUID})
+	fake.getPrivateDomainMutex.Unlock()
+	if fake.GetPrivateDomainStub != nil {
+		return fake.GetPrivateDomainStub(domainGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getPrivateDomainReturns.result1, fake.getPrivateDomainReturns.result2, fake.getPrivateDomainReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomainCallCount() int {
+	fake.getPrivateDomainMutex.RLock()
+	defer fake.getPrivateDomainMutex.RUnlock()
+	return len(fake.getPrivateDomainArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomainArgsForCall(i int) string {
+	fake.getPrivateDomainMutex.RLock()
+	defer fake.getPrivateDomainMutex.RUnlock()
+	return fake.getPrivateDomainArgsForCall[i].domainGUID
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetPrivateDomainStub = nil
+	fake.getPrivateDomainReturns = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetPrivateDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetPrivateDomainStub = nil
+	if fake.getPrivateDomainReturnsOnCall == nil {
+		fake.getPrivateDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getPrivateDomainReturnsOnCall[i] = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplications(routeGUID string, queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getRouteApplicationsMutex.Lock()
+	ret, specificReturn := fake.getRouteApplicationsReturnsOnCall[len(fake.getRouteApplicationsArgsForCall)]
+	fake.getRouteApplicationsArgsForCall = append(fake.getRouteApplicationsArgsForCall, struct {
+		routeGUID string
+		queries   []ccv2.Query
+	}{routeGUID, queriesCopy})
+	fake.recordInvocation("GetRouteApplications", []interface{}{routeGUID, queriesCopy})
+	fake.getRouteApplicationsMutex.Unlock()
+	if fake.GetRouteApplicationsStub != nil {
+		return fake.GetRouteApplicationsStub(routeGUID, queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteApplicationsReturns.result1, fake.getRouteApplicationsReturns.result2, fake.getRouteApplicationsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplicationsCallCount() int {
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
+	return len(fake.getRouteApplicationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplicationsArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
+	return fake.getRouteApplicationsArgsForCall[i].routeGUID, fake.getRouteApplicationsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplicationsReturns(result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteApplicationsStub = nil
+	fake.getRouteApplicationsReturns = struct {
+		result1 []ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteApplicationsReturnsOnCall(i int, result1 []ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteApplicationsStub = nil
+	if fake.getRouteApplicationsReturnsOnCall == nil {
+		fake.getRouteApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Application
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteApplicationsReturnsOnCall[i] = struct {
+		result1 []ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteCount(queries []ccv2.Query) (int, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getRouteCountMutex.Lock()
+	ret, specificReturn := fake.getRouteCountReturnsOnCall[len(fake.getRouteCountArgsForCall)]
+	fake.getRouteCountArgsForCall = append(fake.getRouteCountArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetRouteCount", []interface{}{queriesCopy})
+	fake.getRouteCountMutex.Unlock()
+	if fake.GetRouteCountStub != nil {
+		return fake.GetRouteCountStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteCountReturns.result1, fake.getRouteCountReturns.result2, fake.getRouteCountReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteCountCallCount() int {
+	fake.getRouteCountMutex.RLock()
+	defer fake.getRouteCountMutex.RUnlock()
+	return len(fake.getRouteCountArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteCountArgsForCall(i int) []ccv2.Query {
+	fake.getRouteCountMutex.RLock()
+	defer fake.getRouteCountMutex.RUnlock()
+	return fake.getRouteCountArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetRouteCountReturns(result1 int, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteCountStub = nil
+	fake.getRouteCountReturns = struct {
+		result1 int
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteCountReturnsOnCall(i int, result1 int, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteCountStub = nil
+	if fake.getRouteCountReturnsOnCall == nil {
+		fake.getRouteCountReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteCountReturnsOnCall[i] = struct {
+		result1 int
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappings(queries []ccv2.Query) ([]ccv2.RouteMapping, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.getRouteMappingsReturnsOnCall[len(fake.getRouteMappingsArgsForCall)]
+	fake.getRouteMappingsArgsForCall = append(fake.getRouteMappingsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetRouteMappings", []interface{}{queriesCopy})
+	fake.getRouteMappingsMutex.Unlock()
+	if fake.GetRouteMappingsStub != nil {
+		return fake.GetRouteMappingsStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteMappingsReturns.result1, fake.getRouteMappingsReturns.result2, fake.getRouteMappingsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsCallCount() int {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	return len(fake.getRouteMappingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsArgsForCall(i int) []ccv2.Query {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	return fake.getRouteMappingsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturns(result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteMappingsStub = nil
+	fake.getRouteMappingsReturns = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturnsOnCall(i int, result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteMappingsStub = nil
+	if fake.getRouteMappingsReturnsOnCall == nil {
+		fake.getRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.RouteMapping
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteMappingsReturnsOnCall[i] = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutes(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getRoutesMutex.Lock()
+	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
+	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetRoutes", []interface{}{queriesCopy})
+	fake.getRoutesMutex.Unlock()
+	if fake.GetRoutesStub != nil {
+		return fake.GetRoutesStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRoutesReturns.result1, fake.getRoutesReturns.result2, fake.getRoutesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesCallCount() int {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return len(fake.getRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesArgsForCall(i int) []ccv2.Query {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return fake.getRoutesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetRoutesStub = nil
+	fake.getRoutesReturns = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetRoutesStub = nil
+	if fake.getRoutesReturnsOnCall == nil {
+		fake.getRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Route
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRoutesReturnsOnCall[i] = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupSpaces(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+	fake.getSecurityGroupSpacesMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupSpacesReturnsOnCall[len(fake.getSecurityGroupSpacesArgsForCall)]
+	fake.getSecurityGroupSpacesArgsForCall = append(fake.getSecurityGroupSpacesArgsForCall, struct {
+		securityGroupGUID string
+	}{securityGroupGUID})
+	fake.recordInvocation("GetSecurityGroupSpaces", []interface{}{securityGroupGUID})
+	fake.getSecurityGroupSpacesMutex.Unlock()
+	if fake.GetSecurityGroupSpacesStub != nil {
+		return fake.GetSecurityGroupSpacesStub(securityGroupGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecurityGroupSpacesReturns.result1, fake.getSecurityGroupSpacesReturns.result2, fake.getSecurityGroupSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupSpacesCallCount() int {
+	fake.getSecurityGroupSpacesMutex.RLock()
+	defer fake.getSecurityGroupSpacesMutex.RUnlock()
+	return len(fake.getSecurityGroupSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupSpacesArgsForCall(i int) string {
+	fake.getSecurityGroupSpacesMutex.RLock()
+	defer fake.getSecurityGroupSpacesMutex.RUnlock()
+	return fake.getSecurityGroupSpacesArgsForCall[i].securityGroupGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupSpacesReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSecurityGroupSpacesStub = nil
+	fake.getSecurityGroupSpacesReturns = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupSpacesReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSecurityGroupSpacesStub = nil
+	if fake.getSecurityGroupSpacesReturnsOnCall == nil {
+		fake.getSecurityGroupSpacesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSecurityGroupSpacesReturnsOnCall[i] = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupStagingSpaces(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error) {
+	fake.getSecurityGroupStagingSpacesMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupStagingSpacesReturnsOnCall[len(fake.getSecurityGroupStagingSpacesArgsForCall)]
+	fake.getSecurityGroupStagingSpacesArgsForCall = append(fake.getSecurityGroupStagingSpacesArgsForCall, struct {
+		securityGroupGUID string
+	}{securityGroupGUID})
+	fake.recordInvocation("GetSecurityGroupStagingSpaces", []interface{}{securityGroupGUID})
+	fake.getSecurityGroupStagingSpacesMutex.Unlock()
+	if fake.GetSecurityGroupStagingSpacesStub != nil {
+		return fake.GetSecurityGroupStagingSpacesStub(securityGroupGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecurityGroupStagingSpacesReturns.result1, fake.getSecurityGroupStagingSpacesReturns.result2, fake.getSecurityGroupStagingSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupStagingSpacesCallCount() int {
+	fake.getSecurityGroupStagingSpacesMutex.RLock()
+	defer fake.getSecurityGroupStagingSpacesMutex.RUnlock()
+	return len(fake.getSecurityGroupStagingSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupStagingSpacesArgsForCall(i int) string {
+	fake.getSecurityGroupStagingSpacesMutex.RLock()
+	defer fake.getSecurityGroupStagingSpacesMutex.RUnlock()
+	return fake.getSecurityGroupStagingSpacesArgsForCall[i].securityGroupGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupStagingSpacesReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSecurityGroupStagingSpacesStub = nil
+	fake.getSecurityGroupStagingSpacesReturns = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupStagingSpacesReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSecurityGroupStagingSpacesStub = nil
+	if fake.getSecurityGroupStagingSpacesReturnsOnCall == nil {
+		fake.getSecurityGroupStagingSpacesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSecurityGroupStagingSpacesReturnsOnCall[i] = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroups(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupsReturnsOnCall[len(fake.getSecurityGroupsArgsForCall)]
+	fake.getSecurityGroupsArgsForCall = append(fake.getSecurityGroupsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetSecurityGroups", []interface{}{queriesCopy})
+	fake.getSecurityGroupsMutex.Unlock()
+	if fake.GetSecurityGroupsStub != nil {
+		return fake.GetSecurityGroupsStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecurityGroupsReturns.result1, fake.getSecurityGroupsReturns.result2, fake.getSecurityGroupsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsCallCount() int {
+	fake.getSecurityGroupsMutex.RLock()
+	defer fake.getSecurityGroupsMutex.RUnlock()
+	return len(fake.getSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsArgsForCall(i int) []ccv2.Query {
+	fake.getSecurityGroupsMutex.RLock()
+	defer fake.getSecurityGroupsMutex.RUnlock()
+	return fake.getSecurityGroupsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSecurityGroupsStub = nil
+	fake.getSecurityGroupsReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSecurityGroupsReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSecurityGroupsStub = nil
+	if fake.getSecurityGroupsReturnsOnCall == nil {
+		fake.getSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSecurityGroupsReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindings(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServiceBindingsMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingsReturnsOnCall[len(fake.getServiceBindingsArgsForCall)]
+	fake.getServiceBindingsArgsForCall = append(fake.getServiceBindingsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServiceBindings", []interface{}{queriesCopy})
+	fake.getServiceBindingsMutex.Unlock()
+	if fake.GetServiceBindingsStub != nil {
+		return fake.GetServiceBindingsStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingsReturns.result1, fake.getServiceBindingsReturns.result2, fake.getServiceBindingsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingsCallCount() int {
+	fake.getServiceBindingsMutex.RLock()
+	defer fake.getServiceBindingsMutex.RUnlock()
+	return len(fake.getServiceBindingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingsArgsForCall(i int) []ccv2.Query {
+	fake.getServiceBindingsMutex.RLock()
+	defer fake.getServiceBindingsMutex.RUnlock()
+	return fake.getServiceBindingsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingsReturns(result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingsStub = nil
+	fake.getServiceBindingsReturns = struct {
+		result1 []ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingsReturnsOnCall(i int, result1 []ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingsStub = nil
+	if fake.getServiceBindingsReturnsOnCall == nil {
+		fake.getServiceBindingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceBinding
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingsReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokers(queries []ccv2.Query) ([]ccv2.ServiceBroker, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServiceBrokersMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokersReturnsOnCall[len(fake.getServiceBrokersArgsForCall)]
+	fake.getServiceBrokersArgsForCall = append(fake.getServiceBrokersArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServiceBrokers", []interface{}{queriesCopy})
+	fake.getServiceBrokersMutex.Unlock()
+	if fake.GetServiceBrokersStub != nil {
+		return fake.GetServiceBrokersStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBrokersReturns.result1, fake.getServiceBrokersReturns.result2, fake.getServiceBrokersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersCallCount() int {
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
+	return len(fake.getServiceBrokersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersArgsForCall(i int) []ccv2.Query {
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
+	return fake.getServiceBrokersArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersReturns(result1 []ccv2.ServiceBroker, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBrokersStub = nil
+	fake.getServiceBrokersReturns = struct {
+		result1 []ccv2.ServiceBroker
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersReturnsOnCall(i int, result1 []ccv2.ServiceBroker, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBrokersStub = nil
+	if fake.getServiceBrokersReturnsOnCall == nil {
+		fake.getServiceBrokersReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceBroker
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBrokersReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceBroker
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetService(serviceGUID string) (ccv2.Service, ccv2.Warnings, error) {
+	fake.getServiceMutex.Lock()
+	ret, specificReturn := fake.getServiceReturnsOnCall[len(fake.getServiceArgsForCall)]
+	fake.getServiceArgsForCall = append(fake.getServiceArgsForCall, struct {
+		serviceGUID string
+	}{serviceGUID})
+	fake.recordInvocation("GetService", []interface{}{serviceGUID})
+	fake.getServiceMutex.Unlock()
+	if fake.GetServiceStub != nil {
+		return fake.GetServiceStub(serviceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceReturns.result1, fake.getServiceReturns.result2, fake.getServiceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceCallCount() int {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	return len(fake.getServiceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceArgsForCall(i int) string {
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	return fake.getServiceArgsForCall[i].serviceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceReturns(result1 ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceStub = nil
+	fake.getServiceReturns = struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceReturnsOnCall(i int, result1 ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceStub = nil
+	if fake.getServiceReturnsOnCall == nil {
+		fake.getServiceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceReturnsOnCall[i] = struct {
+		result1 ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceParameters(serviceInstanceGUID string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceInstanceParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceParametersReturnsOnCall[len(fake.getServiceInstanceParametersArgsForCall)]
+	fake.getServiceInstanceParametersArgsForCall = append(fake.getServiceInstanceParametersArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceParameters", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceParametersMutex.Unlock()
+	if fake.GetServiceInstanceParametersStub != nil {
+		return fake.GetServiceInstanceParametersStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceParametersReturns.result1, fake.getServiceInstanceParametersReturns.result2, fake.getServiceInstanceParametersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceParametersCallCount() int {
+	fake.getServiceInstanceParametersMutex.RLock()
+	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	return len(fake.getServiceInstanceParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceParametersArgsForCall(i int) string {
+	fake.getServiceInstanceParametersMutex.RLock()
+	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	return fake.getServiceInstanceParametersArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceParametersStub = nil
+	fake.getServiceInstanceParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceParametersStub = nil
+	if fake.getServiceInstanceParametersReturnsOnCall == nil {
+		fake.getServiceInstanceParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFrom(serviceInstanceGUID string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error) {
+	fake.getServiceInstanceSharedFromMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSharedFromReturnsOnCall[len(fake.getServiceInstanceSharedFromArgsForCall)]
+	fake.getServiceInstanceSharedFromArgsForCall = append(fake.getServiceInstanceSharedFromArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceSharedFrom", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceSharedFromMutex.Unlock()
+	if fake.GetServiceInstanceSharedFromStub != nil {
+		return fake.GetServiceInstanceSharedFromStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceSharedFromReturns.result1, fake.getServiceInstanceSharedFromReturns.result2, fake.getServiceInstanceSharedFromReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFromCallCount() int {
+	fake.getServiceInstanceSharedFromMutex.RLock()
+	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedFromArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFromArgsForCall(i int) string {
+	fake.getServiceInstanceSharedFromMutex.RLock()
+	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
+	return fake.getServiceInstanceSharedFromArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFromReturns(result1 ccv2.ServiceInstanceSharedFrom, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedFromStub = nil
+	fake.getServiceInstanceSharedFromReturns = struct {
+		result1 ccv2.ServiceInstanceSharedFrom
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFromReturnsOnCall(i int, result1 ccv2.ServiceInstanceSharedFrom, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedFromStub = nil
+	if fake.getServiceInstanceSharedFromReturnsOnCall == nil {
+		fake.getServiceInstanceSharedFromReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstanceSharedFrom
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSharedFromReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstanceSharedFrom
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error) {
+	fake.getServiceInstanceSharedTosMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSharedTosReturnsOnCall[len(fake.getServiceInstanceSharedTosArgsForCall)]
+	fake.getServiceInstanceSharedTosArgsForCall = append(fake.getServiceInstanceSharedTosArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceSharedTos", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceSharedTosMutex.Unlock()
+	if fake.GetServiceInstanceSharedTosStub != nil {
+		return fake.GetServiceInstanceSharedTosStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceSharedTosReturns.result1, fake.getServiceInstanceSharedTosReturns.result2, fake.getServiceInstanceSharedTosReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosCallCount() int {
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedTosArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosArgsForCall(i int) string {
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	return fake.getServiceInstanceSharedTosArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosReturns(result1 []ccv2.ServiceInstanceSharedTo, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedTosStub = nil
+	fake.getServiceInstanceSharedTosReturns = struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosReturnsOnCall(i int, result1 []ccv2.ServiceInstanceSharedTo, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedTosStub = nil
+	if fake.getServiceInstanceSharedTosReturnsOnCall == nil {
+		fake.getServiceInstanceSharedTosReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceInstanceSharedTo
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSharedTosReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstances(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServiceInstancesMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
+	fake.getServiceInstancesArgsForCall = append(fake.getServiceInstancesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServiceInstances", []interface{}{queriesCopy})
+	fake.getServiceInstancesMutex.Unlock()
+	if fake.GetServiceInstancesStub != nil {
+		return fake.GetServiceInstancesStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstancesReturns.result1, fake.getServiceInstancesReturns.result2, fake.getServiceInstancesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return len(fake.getServiceInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) []ccv2.Query {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return fake.getServiceInstancesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	fake.getServiceInstancesReturns = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	if fake.getServiceInstancesReturnsOnCall == nil {
+		fake.getServiceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error) {
+	fake.getServicePlanMutex.Lock()
+	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
+	fake.getServicePlanArgsForCall = append(fake.getServicePlanArgsForCall, struct {
+		servicePlanGUID string
+	}{servicePlanGUID})
+	fake.recordInvocation("GetServicePlan", []interface{}{servicePlanGUID})
+	fake.getServicePlanMutex.Unlock()
+	if fake.GetServicePlanStub != nil {
+		return fake.GetServicePlanStub(servicePlanGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanReturns.result1, fake.getServicePlanReturns.result2, fake.getServicePlanReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanCallCount() int {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return len(fake.getServicePlanArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanArgsForCall(i int) string {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return fake.getServicePlanArgsForCall[i].servicePlanGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturns(result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	fake.getServicePlanReturns = struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturnsOnCall(i int, result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	if fake.getServicePlanReturnsOnCall == nil {
+		fake.getServicePlanReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServicePlan
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanReturnsOnCall[i] = struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlans(queries []ccv2.Query) ([]ccv2.ServicePlan, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServicePlansMutex.Lock()
+	ret, specificReturn := fake.getServicePlansReturnsOnCall[len(fake.getServicePlansArgsForCall)]
+	fake.getServicePlansArgsForCall = append(fake.getServicePlansArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServicePlans", []interface{}{queriesCopy})
+	fake.getServicePlansMutex.Unlock()
+	if fake.GetServicePlansStub != nil {
+		return fake.GetServicePlansStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlansReturns.result1, fake.getServicePlansReturns.result2, fake.getServicePlansReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansCallCount() int {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return len(fake.getServicePlansArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansArgsForCall(i int) []ccv2.Query {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return fake.getServicePlansArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturns(result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	fake.getServicePlansReturns = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturnsOnCall(i int, result1 []ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlansStub = nil
+	if fake.getServicePlansReturnsOnCall == nil {
+		fake.getServicePlansReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServicePlan
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlansReturnsOnCall[i] = struct {
+		result1 []ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilities(queries []ccv2.Query) ([]ccv2.ServicePlanVisibility, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServicePlanVisibilitiesMutex.Lock()
+	ret, specificReturn := fake.getServicePlanVisibilitiesReturnsOnCall[len(fake.getServicePlanVisibilitiesArgsForCall)]
+	fake.getServicePlanVisibilitiesArgsForCall = append(fake.getServicePlanVisibilitiesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServicePlanVisibilities", []interface{}{queriesCopy})
+	fake.getServicePlanVisibilitiesMutex.Unlock()
+	if fake.GetServicePlanVisibilitiesStub != nil {
+		return fake.GetServicePlanVisibilitiesStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanVisibilitiesReturns.result1, fake.getServicePlanVisibilitiesReturns.result2, fake.getServicePlanVisibilitiesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilitiesCallCount() int {
+	fake.getServicePlanVisibilitiesMutex.RLock()
+	defer fake.getServicePlanVisibilitiesMutex.RUnlock()
+	return len(fake.getServicePlanVisibilitiesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilitiesArgsForCall(i int) []ccv2.Query {
+	fake.getServicePlanVisibilitiesMutex.RLock()
+	defer fake.getServicePlanVisibilitiesMutex.RUnlock()
+	return fake.getServicePlanVisibilitiesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilitiesReturns(result1 []ccv2.ServicePlanVisibility, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanVisibilitiesStub = nil
+	fake.getServicePlanVisibilitiesReturns = struct {
+		result1 []ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilitiesReturnsOnCall(i int, result1 []ccv2.ServicePlanVisibility, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanVisibilitiesStub = nil
+	if fake.getServicePlanVisibilitiesReturnsOnCall == nil {
+		fake.getServicePlanVisibilitiesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServicePlanVisibility
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanVisibilitiesReturnsOnCall[i] = struct {
+		result1 []ccv2.ServicePlanVisibility
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServices(queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServicesMutex.Lock()
+	ret, specificReturn := fake.getServicesReturnsOnCall[len(fake.getServicesArgsForCall)]
+	fake.getServicesArgsForCall = append(fake.getServicesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServices", []interface{}{queriesCopy})
+	fake.getServicesMutex.Unlock()
+	if fake.GetServicesStub != nil {
+		return fake.GetServicesStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicesReturns.result1, fake.getServicesReturns.result2, fake.getServicesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicesCallCount() int {
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	return len(fake.getServicesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicesArgsForCall(i int) []ccv2.Query {
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
+	return fake.getServicesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServicesReturns(result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicesStub = nil
+	fake.getServicesReturns = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicesReturnsOnCall(i int, result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicesStub = nil
+	if fake.getServicesReturnsOnCall == nil {
+		fake.getServicesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServicesReturnsOnCall[i] = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+	fake.getSharedDomainMutex.Lock()
+	ret, specificReturn := fake.getSharedDomainReturnsOnCall[len(fake.getSharedDomainArgsForCall)]
+	fake.getSharedDomainArgsForCall = append(fake.getSharedDomainArgsForCall, struct {
+		domainGUID string
+	}{domainGUID})
+	fake.recordInvocation("GetSharedDomain", []interface{}{domainGUID})
+	fake.getSharedDomainMutex.Unlock()
+	if fake.GetSharedDomainStub != nil {
+		return fake.GetSharedDomainStub(domainGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSharedDomainReturns.result1, fake.getSharedDomainReturns.result2, fake.getSharedDomainReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainCallCount() int {
+	fake.getSharedDomainMutex.RLock()
+	defer fake.getSharedDomainMutex.RUnlock()
+	return len(fake.getSharedDomainArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainArgsForCall(i int) string {
+	fake.getSharedDomainMutex.RLock()
+	defer fake.getSharedDomainMutex.RUnlock()
+	return fake.getSharedDomainArgsForCall[i].domainGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainStub = nil
+	fake.getSharedDomainReturns = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainStub = nil
+	if fake.getSharedDomainReturnsOnCall == nil {
+		fake.getSharedDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSharedDomainReturnsOnCall[i] = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomains() ([]ccv2.Domain, ccv2.Warnings, error) {
+	fake.getSharedDomainsMutex.Lock()
+	ret, specificReturn := fake.getSharedDomainsReturnsOnCall[len(fake.getSharedDomainsArgsForCall)]
+	fake.getSharedDomainsArgsForCall = append(fake.getSharedDomainsArgsForCall, struct{}{})
+	fake.recordInvocation("GetSharedDomains", []interface{}{})
+	fake.getSharedDomainsMutex.Unlock()
+	if fake.GetSharedDomainsStub != nil {
+		return fake.GetSharedDomainsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSharedDomainsReturns.result1, fake.getSharedDomainsReturns.result2, fake.getSharedDomainsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainsCallCount() int {
+	fake.getSharedDomainsMutex.RLock()
+	defer fake.getSharedDomainsMutex.RUnlock()
+	return len(fake.getSharedDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainsReturns(result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainsStub = nil
+	fake.getSharedDomainsReturns = struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSharedDomainsReturnsOnCall(i int, result1 []ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.GetSharedDomainsStub = nil
+	if fake.getSharedDomainsReturnsOnCall == nil {
+		fake.getSharedDomainsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSharedDomainsReturnsOnCall[i] = struct {
+		result1 []ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpace(guid string) (ccv2.Space, ccv2.Warnings, error) {
+	fake.getSpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceReturnsOnCall[len(fake.getSpaceArgsForCall)]
+	fake.getSpaceArgsForCall = append(fake.getSpaceArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetSpace", []interface{}{guid})
+	fake.getSpaceMutex.Unlock()
+	if fake.GetSpaceStub != nil {
+		return fake.GetSpaceStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceReturns.result1, fake.getSpaceReturns.result2, fake.getSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceCallCount() int {
+	fake.getSpaceMutex.RLock()
+	defer fake.getSpaceMutex.RUnlock()
+	return len(fake.getSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceArgsForCall(i int) string {
+	fake.getSpaceMutex.RLock()
+	defer fake.getSpaceMutex.RUnlock()
+	return fake.getSpaceArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceStub = nil
+	fake.getSpaceReturns = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceStub = nil
+	if fake.getSpaceReturnsOnCall == nil {
+		fake.getSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.getSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotaReturnsOnCall[len(fake.getSpaceQuotaArgsForCall)]
+	fake.getSpaceQuotaArgsForCall = append(fake.getSpaceQuotaArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetSpaceQuota", []interface{}{guid})
+	fake.getSpaceQuotaMutex.Unlock()
+	if fake.GetSpaceQuotaStub != nil {
+		return fake.GetSpaceQuotaStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceQuotaReturns.result1, fake.getSpaceQuotaReturns.result2, fake.getSpaceQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotaCallCount() int {
+	fake.getSpaceQuotaMutex.RLock()
+	defer fake.getSpaceQuotaMutex.RUnlock()
+	return len(fake.getSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotaArgsForCall(i int) string {
+	fake.getSpaceQuotaMutex.RLock()
+	defer fake.getSpaceQuotaMutex.RUnlock()
+	return fake.getSpaceQuotaArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotaReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotaStub = nil
+	fake.getSpaceQuotaReturns = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotaReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotaStub = nil
+	if fake.getSpaceQuotaReturnsOnCall == nil {
+		fake.getSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotas(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.getSpaceQuotasMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotasReturnsOnCall[len(fake.getSpaceQuotasArgsForCall)]
+	fake.getSpaceQuotasArgsForCall = append(fake.getSpaceQuotasArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetSpaceQuotas", []interface{}{orgGUID})
+	fake.getSpaceQuotasMutex.Unlock()
+	if fake.GetSpaceQuotasStub != nil {
+		return fake.GetSpaceQuotasStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceQuotasReturns.result1, fake.getSpaceQuotasReturns.result2, fake.getSpaceQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasCallCount() int {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return len(fake.getSpaceQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasArgsForCall(i int) string {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return fake.getSpaceQuotasArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturns(result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotasStub = nil
+	fake.getSpaceQuotasReturns = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturnsOnCall(i int, result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceQuotasStub = nil
+	if fake.getSpaceQuotasReturnsOnCall == nil {
+		fake.getSpaceQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutes(spaceGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getSpaceRoutesMutex.Lock()
+	ret, specificReturn := fake.getSpaceRoutesReturnsOnCall[len(fake.getSpaceRoutesArgsForCall)]
+	fake.getSpaceRoutesArgsForCall = append(fake.getSpaceRoutesArgsForCall, struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}{spaceGUID, queriesCopy})
+	fake.recordInvocation("GetSpaceRoutes", []interface{}{spaceGUID, queriesCopy})
+	fake.getSpaceRoutesMutex.Unlock()
+	if fake.GetSpaceRoutesStub != nil {
+		return fake.GetSpaceRoutesStub(spaceGUID, queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceRoutesReturns.result1, fake.getSpaceRoutesReturns.result2, fake.getSpaceRoutesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesCallCount() int {
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	return len(fake.getSpaceRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	return fake.getSpaceRoutesArgsForCall[i].spaceGUID, fake.getSpaceRoutesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceRoutesStub = nil
+	fake.getSpaceRoutesReturns = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceRoutesStub = nil
+	if fake.getSpaceRoutesReturnsOnCall == nil {
+		fake.getSpaceRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Route
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRoutesReturnsOnCall[i] = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)]
+	fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceRunningSecurityGroupsBySpace", []interface{}{spaceGUID})
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Unlock()
+	if fake.GetSpaceRunningSecurityGroupsBySpaceStub != nil {
+		return fake.GetSpaceRunningSecurityGroupsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceRunningSecurityGroupsBySpaceReturns.result1, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result2, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceCallCount() int {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceArgsForCall(i int) string {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	fake.getSpaceRunningSecurityGroupsBySpaceReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	if fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall == nil {
+		fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaces(queries []ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getSpacesMutex.Lock()
+	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
+	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetSpaces", []interface{}{queriesCopy})
+	fake.getSpacesMutex.Unlock()
+	if fake.GetSpacesStub != nil {
+		return fake.GetSpacesStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2, fake.getSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesCallCount() int {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return len(fake.getSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesArgsForCall(i int) []ccv2.Query {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return fake.getSpacesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	fake.getSpacesReturns = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	if fake.getSpacesReturnsOnCall == nil {
+		fake.getSpacesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpacesReturnsOnCall[i] = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesByPage(queries []ccv2.Query, handlePage func([]ccv2.Space) error) (ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getSpacesByPageMutex.Lock()
+	ret, specificReturn := fake.getSpacesByPageReturnsOnCall[len(fake.getSpacesByPageArgsForCall)]
+	fake.getSpacesByPageArgsForCall = append(fake.getSpacesByPageArgsForCall, struct {
+		queries    []ccv2.Query
+		handlePage func([]ccv2.Space) error
+	}{queriesCopy, handlePage})
+	fake.recordInvocation("GetSpacesByPage", []interface{}{queriesCopy, handlePage})
+	fake.getSpacesByPageMutex.Unlock()
+	if fake.GetSpacesByPageStub != nil {
+		return fake.GetSpacesByPageStub(queries, handlePage)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSpacesByPageReturns.result1, fake.getSpacesByPageReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesByPageCallCount() int {
+	fake.getSpacesByPageMutex.RLock()
+	defer fake.getSpacesByPageMutex.RUnlock()
+	return len(fake.getSpacesByPageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesByPageArgsForCall(i int) ([]ccv2.Query, func([]ccv2.Space) error) {
+	fake.getSpacesByPageMutex.RLock()
+	defer fake.getSpacesByPageMutex.RUnlock()
+	return fake.getSpacesByPageArgsForCall[i].queries, fake.getSpacesByPageArgsForCall[i].handlePage
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesByPageReturns(result1 ccv2.Warnings, result2 error) {
+	fake.GetSpacesByPageStub = nil
+	fake.getSpacesByPageReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesByPageReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.GetSpacesByPageStub = nil
+	if fake.getSpacesByPageReturnsOnCall == nil {
+		fake.getSpacesByPageReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.getSpacesByPageReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getSpaceServiceInstancesMutex.Lock()
+	ret, specificReturn := fake.getSpaceServiceInstancesReturnsOnCall[len(fake.getSpaceServiceInstancesArgsForCall)]
+	fake.getSpaceServiceInstancesArgsForCall = append(fake.getSpaceServiceInstancesArgsForCall, struct {
+		spaceGUID                   string
+		includeUserProvidedServices bool
+		queries                     []ccv2.Query
+	}{spaceGUID, includeUserProvidedServices, queriesCopy})
+	fake.recordInvocation("GetSpaceServiceInstances", []interface{}{spaceGUID, includeUserProvidedServices, queriesCopy})
+	fake.getSpaceServiceInstancesMutex.Unlock()
+	if fake.GetSpaceServiceInstancesStub != nil {
+		return fake.GetSpaceServiceInstancesStub(spaceGUID, includeUserProvidedServices, queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceServiceInstancesReturns.result1, fake.getSpaceServiceInstancesReturns.result2, fake.getSpaceServiceInstancesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesCallCount() int {
+	fake.getSpaceServiceInstancesMutex.RLock()
+	defer fake.getSpaceServiceInstancesMutex.RUnlock()
+	return len(fake.getSpaceServiceInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesArgsForCall(i int) (string, bool, []ccv2.Query) {
+	fake.getSpaceServiceInstancesMutex.RLock()
+	defer fake.getSpaceServiceInstancesMutex.RUnlock()
+	return fake.getSpaceServiceInstancesArgsForCall[i].spaceGUID, fake.getSpaceServiceInstancesArgsForCall[i].includeUserProvidedServices, fake.getSpaceServiceInstancesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServiceInstancesStub = nil
+	fake.getSpaceServiceInstancesReturns = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServiceInstancesStub = nil
+	if fake.getSpaceServiceInstancesReturnsOnCall == nil {
+		fake.getSpaceServiceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceServiceInstancesReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServices(spaceGUID string, queries []ccv2.Query) ([]ccv2.Service, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getSpaceServicesMutex.Lock()
+	ret, specificReturn := fake.getSpaceServicesReturnsOnCall[len(fake.getSpaceServicesArgsForCall)]
+	fake.getSpaceServicesArgsForCall = append(fake.getSpaceServicesArgsForCall, struct {
+		spaceGUID string
+		queries   []ccv2.Query
+	}{spaceGUID, queriesCopy})
+	fake.recordInvocation("GetSpaceServices", []interface{}{spaceGUID, queriesCopy})
+	fake.getSpaceServicesMutex.Unlock()
+	if fake.GetSpaceServicesStub != nil {
+		return fake.GetSpaceServicesStub(spaceGUID, queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceServicesReturns.result1, fake.getSpaceServicesReturns.result2, fake.getSpaceServicesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesCallCount() int {
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
+	return len(fake.getSpaceServicesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
+	return fake.getSpaceServicesArgsForCall[i].spaceGUID, fake.getSpaceServicesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesReturns(result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServicesStub = nil
+	fake.getSpaceServicesReturns = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceServicesReturnsOnCall(i int, result1 []ccv2.Service, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceServicesStub = nil
+	if fake.getSpaceServicesReturnsOnCall == nil {
+		fake.getSpaceServicesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Service
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceServicesReturnsOnCall[i] = struct {
+		result1 []ccv2.Service
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)]
+	fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceStagingSecurityGroupsBySpace", []interface{}{spaceGUID})
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Unlock()
+	if fake.GetSpaceStagingSecurityGroupsBySpaceStub != nil {
+		return fake.GetSpaceStagingSecurityGroupsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceStagingSecurityGroupsBySpaceReturns.result1, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result2, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceCallCount() int {
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
+	return len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceArgsForCall(i int) string {
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
+	return fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
+	fake.getSpaceStagingSecurityGroupsBySpaceReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
+	if fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall == nil {
+		fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error) {
+	fake.getStackMutex.Lock()
+	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
+	fake.getStackArgsForCall = append(fake.getStackArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetStack", []interface{}{guid})
+	fake.getStackMutex.Unlock()
+	if fake.GetStackStub != nil {
+		return fake.GetStackStub(guid)
 	}
-	return fake.getSpaceRoutesReturns.result1, fake.getSpaceRoutesReturns.result2, fake.getSpaceRoutesReturns.result3
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackReturns.result1, fake.getStackReturns.result2, fake.getStackReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutesCallCount() int {
-	fake.getSpaceRoutesMutex.RLock()
-	defer fake.getSpaceRoutesMutex.RUnlock()
-	return len(fake.getSpaceRoutesArgsForCall)
+func (fake *FakeCloudControllerClient) GetStackCallCount() int {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return len(fake.getStackArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutesArgsForCall(i int) (string, []ccv2.Query) {
-	fake.getSpaceRoutesMutex.RLock()
-	defer fake.getSpaceRoutesMutex.RUnlock()
-	return fake.getSpaceRoutesArgsForCall[i].spaceGUID, fake.getSpaceRoutesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetStackArgsForCall(i int) string {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return fake.getStackArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceRoutesStub = nil
-	fake.getSpaceRoutesReturns = struct {
-		result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) GetStackReturns(result1 ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	fake.getStackReturns = struct {
+		result1 ccv2.Stack
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceRoutesStub = nil
-	if fake.getSpaceRoutesReturnsOnCall == nil {
-		fake.getSpaceRoutesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Route
+func (fake *FakeCloudControllerClient) GetStackReturnsOnCall(i int, result1 ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	if fake.getStackReturnsOnCall == nil {
+		fake.getStackReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Stack
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceRoutesReturnsOnCall[i] = struct {
-		result1 []ccv2.Route
+	fake.getStackReturnsOnCall[i] = struct {
+		result1 ccv2.Stack
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
-	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Lock()
-	ret, specificReturn := fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)]
-	fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall, struct {
-		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("GetSpaceRunningSecurityGroupsBySpace", []interface{}{spaceGUID})
-	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Unlock()
-	if fake.GetSpaceRunningSecurityGroupsBySpaceStub != nil {
-		return fake.GetSpaceRunningSecurityGroupsBySpaceStub(spaceGUID)
+func (fake *FakeCloudControllerClient) GetStacks(queries []ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getStacksMutex.Lock()
+	ret, specificReturn := fake.getStacksReturnsOnCall[len(fake.getStacksArgsForCall)]
+	fake.getStacksArgsForCall = append(fake.getStacksArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetStacks", []interface{}{queriesCopy})
+	fake.getStacksMutex.Unlock()
+	if fake.GetStacksStub != nil {
+		return fake.GetStacksStub(queries)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpaceRunningSecurityGroupsBySpaceReturns.result1, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result2, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result3
+	return fake.getStacksReturns.result1, fake.getStacksReturns.result2, fake.getStacksReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceCallCount() int {
-	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
-	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
-	return len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)
+func (fake *FakeCloudControllerClient) GetStacksCallCount() int {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return len(fake.getStacksArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceArgsForCall(i int) string {
-	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
-	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
-	return fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall[i].spaceGUID
+func (fake *FakeCloudControllerClient) GetStacksArgsForCall(i int) []ccv2.Query {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return fake.getStacksArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturns(result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	fake.getStacksReturns = struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturnsOnCall(i int, result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	if fake.getStacksReturnsOnCall == nil {
+		fake.getStacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Stack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getStacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		job ccv2.Job
+	}{job})
+	fake.recordInvocation("PollJob", []interface{}{job})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(job)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pollJobReturns.result1, fake.pollJobReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) PollJobArgsForCall(i int) ccv2.Job {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return fake.pollJobArgsForCall[i].job
+}
+
+func (fake *FakeCloudControllerClient) PollJobReturns(result1 ccv2.Warnings, result2 error) {
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PollJobReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PurgeService(serviceGUID string) (ccv2.Warnings, error) {
+	fake.purgeServiceMutex.Lock()
+	ret, specificReturn := fake.purgeServiceReturnsOnCall[len(fake.purgeServiceArgsForCall)]
+	fake.purgeServiceArgsForCall = append(fake.purgeServiceArgsForCall, struct {
+		serviceGUID string
+	}{serviceGUID})
+	fake.recordInvocation("PurgeService", []interface{}{serviceGUID})
+	fake.purgeServiceMutex.Unlock()
+	if fake.PurgeServiceStub != nil {
+		return fake.PurgeServiceStub(serviceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.purgeServiceReturns.result1, fake.purgeServiceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceCallCount() int {
+	fake.purgeServiceMutex.RLock()
+	defer fake.purgeServiceMutex.RUnlock()
+	return len(fake.purgeServiceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceArgsForCall(i int) string {
+	fake.purgeServiceMutex.RLock()
+	defer fake.purgeServiceMutex.RUnlock()
+	return fake.purgeServiceArgsForCall[i].serviceGUID
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.PurgeServiceStub = nil
+	fake.purgeServiceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.PurgeServiceStub = nil
+	if fake.purgeServiceReturnsOnCall == nil {
+		fake.purgeServiceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.purgeServiceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceInstance(serviceInstanceGUID string) (ccv2.Warnings, error) {
+	fake.purgeServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.purgeServiceInstanceReturnsOnCall[len(fake.purgeServiceInstanceArgsForCall)]
+	fake.purgeServiceInstanceArgsForCall = append(fake.purgeServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("PurgeServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.purgeServiceInstanceMutex.Unlock()
+	if fake.PurgeServiceInstanceStub != nil {
+		return fake.PurgeServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.purgeServiceInstanceReturns.result1, fake.purgeServiceInstanceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceInstanceCallCount() int {
+	fake.purgeServiceInstanceMutex.RLock()
+	defer fake.purgeServiceInstanceMutex.RUnlock()
+	return len(fake.purgeServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceInstanceArgsForCall(i int) string {
+	fake.purgeServiceInstanceMutex.RLock()
+	defer fake.purgeServiceInstanceMutex.RUnlock()
+	return fake.purgeServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.PurgeServiceInstanceStub = nil
+	fake.purgeServiceInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) PurgeServiceInstanceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.PurgeServiceInstanceStub = nil
+	if fake.purgeServiceInstanceReturnsOnCall == nil {
+		fake.purgeServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.purgeServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
+	fake.removeSpaceFromSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.removeSpaceFromSecurityGroupReturnsOnCall[len(fake.removeSpaceFromSecurityGroupArgsForCall)]
+	fake.removeSpaceFromSecurityGroupArgsForCall = append(fake.removeSpaceFromSecurityGroupArgsForCall, struct {
+		securityGroupGUID string
+		spaceGUID         string
+	}{securityGroupGUID, spaceGUID})
+	fake.recordInvocation("RemoveSpaceFromSecurityGroup", []interface{}{securityGroupGUID, spaceGUID})
+	fake.removeSpaceFromSecurityGroupMutex.Unlock()
+	if fake.RemoveSpaceFromSecurityGroupStub != nil {
+		return fake.RemoveSpaceFromSecurityGroupStub(securityGroupGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.removeSpaceFromSecurityGroupReturns.result1, fake.removeSpaceFromSecurityGroupReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroupCallCount() int {
+	fake.removeSpaceFromSecurityGroupMutex.RLock()
+	defer fake.removeSpaceFromSecurityGroupMutex.RUnlock()
+	return len(fake.removeSpaceFromSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroupArgsForCall(i int) (string, string) {
+	fake.removeSpaceFromSecurityGroupMutex.RLock()
+	defer fake.removeSpaceFromSecurityGroupMutex.RUnlock()
+	return fake.removeSpaceFromSecurityGroupArgsForCall[i].securityGroupGUID, fake.removeSpaceFromSecurityGroupArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroupReturns(result1 ccv2.Warnings, result2 error) {
+	fake.RemoveSpaceFromSecurityGroupStub = nil
+	fake.removeSpaceFromSecurityGroupReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroupReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.RemoveSpaceFromSecurityGroupStub = nil
+	if fake.removeSpaceFromSecurityGroupReturnsOnCall == nil {
+		fake.removeSpaceFromSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.removeSpaceFromSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
+	fake.restageApplicationMutex.Lock()
+	ret, specificReturn := fake.restageApplicationReturnsOnCall[len(fake.restageApplicationArgsForCall)]
+	fake.restageApplicationArgsForCall = append(fake.restageApplicationArgsForCall, struct {
+		app ccv2.Application
+	}{app})
+	fake.recordInvocation("RestageApplication", []interface{}{app})
+	fake.restageApplicationMutex.Unlock()
+	if fake.RestageApplicationStub != nil {
+		return fake.RestageApplicationStub(app)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.restageApplicationReturns.result1, fake.restageApplicationReturns.result2, fake.restageApplicationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) RestageApplicationCallCount() int {
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	return len(fake.restageApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
-	fake.getSpaceRunningSecurityGroupsBySpaceReturns = struct {
-		result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) RestageApplicationArgsForCall(i int) ccv2.Application {
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	return fake.restageApplicationArgsForCall[i].app
+}
+
+func (fake *FakeCloudControllerClient) RestageApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.RestageApplicationStub = nil
+	fake.restageApplicationReturns = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceRunningSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
-	if fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall == nil {
-		fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) RestageApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.RestageApplicationStub = nil
+	if fake.restageApplicationReturnsOnCall == nil {
+		fake.restageApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Application
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[i] = struct {
-		result1 []ccv2.SecurityGroup
+	fake.restageApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaces(queries []ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error) {
-	var queriesCopy []ccv2.Query
-	if queries != nil {
-		queriesCopy = make([]ccv2.Query, len(queries))
-		copy(queriesCopy, queries)
-	}
-	fake.getSpacesMutex.Lock()
-	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
-	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct {
-		queries []ccv2.Query
-	}{queriesCopy})
-	fake.recordInvocation("GetSpaces", []interface{}{queriesCopy})
-	fake.getSpacesMutex.Unlock()
-	if fake.GetSpacesStub != nil {
-		return fake.GetSpacesStub(queries)
+func (fake *FakeCloudControllerClient) ScaleApplication(appGUID string, scale ccv2.ApplicationScale) (ccv2.Application, ccv2.Warnings, error) {
+	fake.scaleApplicationMutex.Lock()
+	ret, specificReturn := fake.scaleApplicationReturnsOnCall[len(fake.scaleApplicationArgsForCall)]
+	fake.scaleApplicationArgsForCall = append(fake.scaleApplicationArgsForCall, struct {
+		appGUID string
+		scale   ccv2.ApplicationScale
+	}{appGUID, scale})
+	fake.recordInvocation("ScaleApplication", []interface{}{appGUID, scale})
+	fake.scaleApplicationMutex.Unlock()
+	if fake.ScaleApplicationStub != nil {
+		return fake.ScaleApplicationStub(appGUID, scale)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2, fake.getSpacesReturns.result3
+	return fake.scaleApplicationReturns.result1, fake.scaleApplicationReturns.result2, fake.scaleApplicationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpacesCallCount() int {
-	fake.getSpacesMutex.RLock()
-	defer fake.getSpacesMutex.RUnlock()
-	return len(fake.getSpacesArgsForCall)
+func (fake *FakeCloudControllerClient) ScaleApplicationCallCount() int {
+	fake.scaleApplicationMutex.RLock()
+	defer fake.scaleApplicationMutex.RUnlock()
+	return len(fake.scaleApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpacesArgsForCall(i int) []ccv2.Query {
-	fake.getSpacesMutex.RLock()
-	defer fake.getSpacesMutex.RUnlock()
-	return fake.getSpacesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) ScaleApplicationArgsForCall(i int) (string, ccv2.ApplicationScale) {
+	fake.scaleApplicationMutex.RLock()
+	defer fake.scaleApplicationMutex.RUnlock()
+	return fake.scaleApplicationArgsForCall[i].appGUID, fake.scaleApplicationArgsForCall[i].scale
 }
 
-func (fake *FakeCloudControllerClient) GetSpacesReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpacesStub = nil
-	fake.getSpacesReturns = struct {
-		result1 []ccv2.Space
+func (fake *FakeCloudControllerClient) ScaleApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.ScaleApplicationStub = nil
+	fake.scaleApplicationReturns = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpacesStub = nil
-	if fake.getSpacesReturnsOnCall == nil {
-		fake.getSpacesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.Space
+func (fake *FakeCloudControllerClient) ScaleApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.ScaleApplicationStub = nil
+	if fake.scaleApplicationReturnsOnCall == nil {
+		fake.scaleApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Application
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpacesReturnsOnCall[i] = struct {
-		result1 []ccv2.Space
+	fake.scaleApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
-	var queriesCopy []ccv2.Query
-	if queries != nil {
-		queriesCopy = make([]ccv2.Query, len(queries))
-		copy(queriesCopy, queries)
+func (fake *FakeCloudControllerClient) TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error) {
+	fake.targetCFMutex.Lock()
+	ret, specificReturn := fake.targetCFReturnsOnCall[len(fake.targetCFArgsForCall)]
+	fake.targetCFArgsForCall = append(fake.targetCFArgsForCall, struct {
+		settings ccv2.TargetSettings
+	}{settings})
+	fake.recordInvocation("TargetCF", []interface{}{settings})
+	fake.targetCFMutex.Unlock()
+	if fake.TargetCFStub != nil {
+		return fake.TargetCFStub(settings)
 	}
-	fake.getSpaceServiceInstancesMutex.Lock()
-	ret, specificReturn := fake.getSpaceServiceInstancesReturnsOnCall[len(fake.getSpaceServiceInstancesArgsForCall)]
-	fake.getSpaceServiceInstancesArgsForCall = append(fake.getSpaceServiceInstancesArgsForCall, struct {
-		spaceGUID                   string
-		includeUserProvidedServices bool
-		queries                     []ccv2.Query
-	}{spaceGUID, includeUserProvidedServices, queriesCopy})
-	fake.recordInvocation("GetSpaceServiceInstances", []interface{}{spaceGUID, includeUserProvidedServices, queriesCopy})
-	fake.getSpaceServiceInstancesMutex.Unlock()
-	if fake.GetSpaceServiceInstancesStub != nil {
-		return fake.GetSpaceServiceInstancesStub(spaceGUID, includeUserProvidedServices, queries)
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.targetCFReturns.result1, fake.targetCFReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) TargetCFCallCount() int {
+	fake.targetCFMutex.RLock()
+	defer fake.targetCFMutex.RUnlock()
+	return len(fake.targetCFArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) TargetCFArgsForCall(i int) ccv2.TargetSettings {
+	fake.targetCFMutex.RLock()
+	defer fake.targetCFMutex.RUnlock()
+	return fake.targetCFArgsForCall[i].settings
+}
+
+func (fake *FakeCloudControllerClient) TargetCFReturns(result1 ccv2.Warnings, result2 error) {
+	fake.TargetCFStub = nil
+	fake.targetCFReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) TargetCFReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.TargetCFStub = nil
+	if fake.targetCFReturnsOnCall == nil {
+		fake.targetCFReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.targetCFReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplication(routeGUID string, appGUID string) (ccv2.Warnings, error) {
+	fake.unbindRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unbindRouteFromApplicationReturnsOnCall[len(fake.unbindRouteFromApplicationArgsForCall)]
+	fake.unbindRouteFromApplicationArgsForCall = append(fake.unbindRouteFromApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UnbindRouteFromApplication", []interface{}{routeGUID, appGUID})
+	fake.unbindRouteFromApplicationMutex.Unlock()
+	if fake.UnbindRouteFromApplicationStub != nil {
+		return fake.UnbindRouteFromApplicationStub(routeGUID, appGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getSpaceServiceInstancesReturns.result1, fake.getSpaceServiceInstancesReturns.result2, fake.getSpaceServiceInstancesReturns.result3
+	return fake.unbindRouteFromApplicationReturns.result1, fake.unbindRouteFromApplicationReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesCallCount() int {
-	fake.getSpaceServiceInstancesMutex.RLock()
-	defer fake.getSpaceServiceInstancesMutex.RUnlock()
-	return len(fake.getSpaceServiceInstancesArgsForCall)
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationCallCount() int {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return len(fake.unbindRouteFromApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesArgsForCall(i int) (string, bool, []ccv2.Query) {
-	fake.getSpaceServiceInstancesMutex.RLock()
-	defer fake.getSpaceServiceInstancesMutex.RUnlock()
-	return fake.getSpaceServiceInstancesArgsForCall[i].spaceGUID, fake.getSpaceServiceInstancesArgsForCall[i].includeUserProvidedServices, fake.getSpaceServiceInstancesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationArgsForCall(i int) (string, string) {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return fake.unbindRouteFromApplicationArgsForCall[i].routeGUID, fake.unbindRouteFromApplicationArgsForCall[i].appGUID
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceServiceInstancesStub = nil
-	fake.getSpaceServiceInstancesReturns = struct {
-		result1 []ccv2.ServiceInstance
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	fake.unbindRouteFromApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceServiceInstancesStub = nil
-	if fake.getSpaceServiceInstancesReturnsOnCall == nil {
-		fake.getSpaceServiceInstancesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.ServiceInstance
-			result2 ccv2.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	if fake.unbindRouteFromApplicationReturnsOnCall == nil {
+		fake.unbindRouteFromApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
 		})
 	}
-	fake.getSpaceServiceInstancesReturnsOnCall[i] = struct {
-		result1 []ccv2.ServiceInstance
-		result2 ccv2.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.unbindRouteFromApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
-	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Lock()
-	ret, specificReturn := fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)]
-	fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall, struct {
-		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("GetSpaceStagingSecurityGroupsBySpace", []interface{}{spaceGUID})
-	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Unlock()
-	if fake.GetSpaceStagingSecurityGroupsBySpaceStub != nil {
-		return fake.GetSpaceStagingSecurityGroupsBySpaceStub(spaceGUID)
+func (fake *FakeCloudControllerClient) UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
+	fake.updateApplicationMutex.Lock()
+	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
+	fake.updateApplicationArgsForCall = append(fake.updateApplicationArgsForCall, struct {
+		app ccv2.Application
+	}{app})
+	fake.recordInvocation("UpdateApplication", []interface{}{app})
+	fake.updateApplicationMutex.Unlock()
+	if fake.UpdateApplicationStub != nil {
+		return fake.UpdateApplicationStub(app)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpaceStagingSecurityGroupsBySpaceReturns.result1, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result2, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result3
+	return fake.updateApplicationReturns.result1, fake.updateApplicationReturns.result2, fake.updateApplicationReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceCallCount() int {
-	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
-	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
-	return len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateApplicationCallCount() int {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return len(fake.updateApplicationArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceArgsForCall(i int) string {
-	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
-	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
-	return fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall[i].spaceGUID
+func (fake *FakeCloudControllerClient) UpdateApplicationArgsForCall(i int) ccv2.Application {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return fake.updateApplicationArgsForCall[i].app
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
-	fake.getSpaceStagingSecurityGroupsBySpaceReturns = struct {
-		result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) UpdateApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	fake.updateApplicationReturns = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
-	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
-	if fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall == nil {
-		fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.SecurityGroup
+func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	if fake.updateApplicationReturnsOnCall == nil {
+		fake.updateApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Application
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[i] = struct {
-		result1 []ccv2.SecurityGroup
+	fake.updateApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Application
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error) {
-	fake.getStackMutex.Lock()
-	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
-	fake.getStackArgsForCall = append(fake.getStackArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetStack", []interface{}{guid})
-	fake.getStackMutex.Unlock()
-	if fake.GetStackStub != nil {
-		return fake.GetStackStub(guid)
+func (fake *FakeCloudControllerClient) UpdateOrganizationName(orgGUID string, newName string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.updateOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationNameReturnsOnCall[len(fake.updateOrganizationNameArgsForCall)]
+	fake.updateOrganizationNameArgsForCall = append(fake.updateOrganizationNameArgsForCall, struct {
+		orgGUID string
+		newName string
+	}{orgGUID, newName})
+	fake.recordInvocation("UpdateOrganizationName", []interface{}{orgGUID, newName})
+	fake.updateOrganizationNameMutex.Unlock()
+	if fake.UpdateOrganizationNameStub != nil {
+		return fake.UpdateOrganizationNameStub(orgGUID, newName)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getStackReturns.result1, fake.getStackReturns.result2, fake.getStackReturns.result3
+	return fake.updateOrganizationNameReturns.result1, fake.updateOrganizationNameReturns.result2, fake.updateOrganizationNameReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetStackCallCount() int {
-	fake.getStackMutex.RLock()
-	defer fake.getStackMutex.RUnlock()
-	return len(fake.getStackArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateOrganizationNameCallCount() int {
+	fake.updateOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationNameMutex.RUnlock()
+	return len(fake.updateOrganizationNameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetStackArgsForCall(i int) string {
-	fake.getStackMutex.RLock()
-	defer fake.getStackMutex.RUnlock()
-	return fake.getStackArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) UpdateOrganizationNameArgsForCall(i int) (string, string) {
+	fake.updateOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationNameMutex.RUnlock()
+	return fake.updateOrganizationNameArgsForCall[i].orgGUID, fake.updateOrganizationNameArgsForCall[i].newName
 }
 
-func (fake *FakeCloudControllerClient) GetStackReturns(result1 ccv2.Stack, result2 ccv2.Warnings, result3 error) {
-	fake.GetStackStub = nil
-	fake.getStackReturns = struct {
-		result1 ccv2.Stack
+func (fake *FakeCloudControllerClient) UpdateOrganizationNameReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationNameStub = nil
+	fake.updateOrganizationNameReturns = struct {
+		result1 ccv2.Organization
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetStackReturnsOnCall(i int, result1 ccv2.Stack, result2 ccv2.Warnings, result3 error) {
-	fake.GetStackStub = nil
-	if fake.getStackReturnsOnCall == nil {
-		fake.getStackReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Stack
+func (fake *FakeCloudControllerClient) UpdateOrganizationNameReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationNameStub = nil
+	if fake.updateOrganizationNameReturnsOnCall == nil {
+		fake.updateOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getStackReturnsOnCall[i] = struct {
-		result1 ccv2.Stack
+	fake.updateOrganizationNameReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
-	fake.pollJobMutex.Lock()
-	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
-	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
-		job ccv2.Job
-	}{job})
-	fake.recordInvocation("PollJob", []interface{}{job})
-	fake.pollJobMutex.Unlock()
-	if fake.PollJobStub != nil {
-		return fake.PollJobStub(job)
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserRoleByUsername(role ccv2.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserRoleByUsernameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserRoleByUsernameReturnsOnCall[len(fake.updateOrganizationUserRoleByUsernameArgsForCall)]
+	fake.updateOrganizationUserRoleByUsernameArgsForCall = append(fake.updateOrganizationUserRoleByUsernameArgsForCall, struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationUserRoleByUsername", []interface{}{role, orgGUID, username})
+	fake.updateOrganizationUserRoleByUsernameMutex.Unlock()
+	if fake.UpdateOrganizationUserRoleByUsernameStub != nil {
+		return fake.UpdateOrganizationUserRoleByUsernameStub(role, orgGUID, username)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.pollJobReturns.result1, fake.pollJobReturns.result2
+	return fake.updateOrganizationUserRoleByUsernameReturns.result1, fake.updateOrganizationUserRoleByUsernameReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) PollJobCallCount() int {
-	fake.pollJobMutex.RLock()
-	defer fake.pollJobMutex.RUnlock()
-	return len(fake.pollJobArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserRoleByUsernameCallCount() int {
+	fake.updateOrganizationUserRoleByUsernameMutex.RLock()
+	defer fake.updateOrganizationUserRoleByUsernameMutex.RUnlock()
+	return len(fake.updateOrganizationUserRoleByUsernameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) PollJobArgsForCall(i int) ccv2.Job {
-	fake.pollJobMutex.RLock()
-	defer fake.pollJobMutex.RUnlock()
-	return fake.pollJobArgsForCall[i].job
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserRoleByUsernameArgsForCall(i int) (ccv2.OrganizationRole, string, string) {
+	fake.updateOrganizationUserRoleByUsernameMutex.RLock()
+	defer fake.updateOrganizationUserRoleByUsernameMutex.RUnlock()
+	return fake.updateOrganizationUserRoleByUsernameArgsForCall[i].role, fake.updateOrganizationUserRoleByUsernameArgsForCall[i].orgGUID, fake.updateOrganizationUserRoleByUsernameArgsForCall[i].username
 }
 
-func (fake *FakeCloudControllerClient) PollJobReturns(result1 ccv2.Warnings, result2 error) {
-	fake.PollJobStub = nil
-	fake.pollJobReturns = struct {
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserRoleByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserRoleByUsernameStub = nil
+	fake.updateOrganizationUserRoleByUsernameReturns = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) PollJobReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.PollJobStub = nil
-	if fake.pollJobReturnsOnCall == nil {
-		fake.pollJobReturnsOnCall = make(map[int]struct {
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserRoleByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserRoleByUsernameStub = nil
+	if fake.updateOrganizationUserRoleByUsernameReturnsOnCall == nil {
+		fake.updateOrganizationUserRoleByUsernameReturnsOnCall = make(map[int]struct {
 			result1 ccv2.Warnings
 			result2 error
 		})
 	}
-	fake.pollJobReturnsOnCall[i] = struct {
+	fake.updateOrganizationUserRoleByUsernameReturnsOnCall[i] = struct {
 		result1 ccv2.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
-	fake.removeSpaceFromSecurityGroupMutex.Lock()
-	ret, specificReturn := fake.removeSpaceFromSecurityGroupReturnsOnCall[len(fake.removeSpaceFromSecurityGroupArgsForCall)]
-	fake.removeSpaceFromSecurityGroupArgsForCall = append(fake.removeSpaceFromSecurityGroupArgsForCall, struct {
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupRules(securityGroupGUID string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	var rulesCopy []ccv2.SecurityGroupRule
+	if rules != nil {
+		rulesCopy = make([]ccv2.SecurityGroupRule, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.updateSecurityGroupRulesMutex.Lock()
+	ret, specificReturn := fake.updateSecurityGroupRulesReturnsOnCall[len(fake.updateSecurityGroupRulesArgsForCall)]
+	fake.updateSecurityGroupRulesArgsForCall = append(fake.updateSecurityGroupRulesArgsForCall, struct {
 		securityGroupGUID string
-		spaceGUID         string
-	}{securityGroupGUID, spaceGUID})
-	fake.recordInvocation("RemoveSpaceFromSecurityGroup", []interface{}{securityGroupGUID, spaceGUID})
-	fake.removeSpaceFromSecurityGroupMutex.Unlock()
-	if fake.RemoveSpaceFromSecurityGroupStub != nil {
-		return fake.RemoveSpaceFromSecurityGroupStub(securityGroupGUID, spaceGUID)
+		rules             []ccv2.SecurityGroupRule
+	}{securityGroupGUID, rulesCopy})
+	fake.recordInvocation("UpdateSecurityGroupRules", []interface{}{securityGroupGUID, rulesCopy})
+	fake.updateSecurityGroupRulesMutex.Unlock()
+	if fake.UpdateSecurityGroupRulesStub != nil {
+		return fake.UpdateSecurityGroupRulesStub(securityGroupGUID, rules)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.removeSpaceFromSecurityGroupReturns.result1, fake.removeSpaceFromSecurityGroupReturns.result2
+	return fake.updateSecurityGroupRulesReturns.result1, fake.updateSecurityGroupRulesReturns.result2, fake.updateSecurityGroupRulesReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroupCallCount() int {
-	fake.removeSpaceFromSecurityGroupMutex.RLock()
-	defer fake.removeSpaceFromSecurityGroupMutex.RUnlock()
-	return len(fake.removeSpaceFromSecurityGroupArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupRulesCallCount() int {
+	fake.updateSecurityGroupRulesMutex.RLock()
+	defer fake.updateSecurityGroupRulesMutex.RUnlock()
+	return len(fake.updateSecurityGroupRulesArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroupArgsForCall(i int) (string, string) {
-	fake.removeSpaceFromSecurityGroupMutex.RLock()
-	defer fake.removeSpaceFromSecurityGroupMutex.RUnlock()
-	return fake.removeSpaceFromSecurityGroupArgsForCall[i].securityGroupGUID, fake.removeSpaceFromSecurityGroupArgsForCall[i].spaceGUID
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupRulesArgsForCall(i int) (string, []ccv2.SecurityGroupRule) {
+	fake.updateSecurityGroupRulesMutex.RLock()
+	defer fake.updateSecurityGroupRulesMutex.RUnlock()
+	return fake.updateSecurityGroupRulesArgsForCall[i].securityGroupGUID, fake.updateSecurityGroupRulesArgsForCall[i].rules
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroupReturns(result1 ccv2.Warnings, result2 error) {
-	fake.RemoveSpaceFromSecurityGroupStub = nil
-	fake.removeSpaceFromSecurityGroupReturns = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupRulesReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupRulesStub = nil
+	fake.updateSecurityGroupRulesReturns = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) RemoveSpaceFromSecurityGroupReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.RemoveSpaceFromSecurityGroupStub = nil
-	if fake.removeSpaceFromSecurityGroupReturnsOnCall == nil {
-		fake.removeSpaceFromSecurityGroupReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Warnings
-			result2 error
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupRulesReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupRulesStub = nil
+	if fake.updateSecurityGroupRulesReturnsOnCall == nil {
+		fake.updateSecurityGroupRulesReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
 		})
 	}
-	fake.removeSpaceFromSecurityGroupReturnsOnCall[i] = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+	fake.updateSecurityGroupRulesReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error) {
-	fake.targetCFMutex.Lock()
-	ret, specificReturn := fake.targetCFReturnsOnCall[len(fake.targetCFArgsForCall)]
-	fake.targetCFArgsForCall = append(fake.targetCFArgsForCall, struct {
-		settings ccv2.TargetSettings
-	}{settings})
-	fake.recordInvocation("TargetCF", []interface{}{settings})
-	fake.targetCFMutex.Unlock()
-	if fake.TargetCFStub != nil {
-		return fake.TargetCFStub(settings)
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceName(serviceInstanceGUID string, newName string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.updateServiceInstanceNameMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceNameReturnsOnCall[len(fake.updateServiceInstanceNameArgsForCall)]
+	fake.updateServiceInstanceNameArgsForCall = append(fake.updateServiceInstanceNameArgsForCall, struct {
+		serviceInstanceGUID string
+		newName             string
+	}{serviceInstanceGUID, newName})
+	fake.recordInvocation("UpdateServiceInstanceName", []interface{}{serviceInstanceGUID, newName})
+	fake.updateServiceInstanceNameMutex.Unlock()
+	if fake.UpdateServiceInstanceNameStub != nil {
+		return fake.UpdateServiceInstanceNameStub(serviceInstanceGUID, newName)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.targetCFReturns.result1, fake.targetCFReturns.result2
+	return fake.updateServiceInstanceNameReturns.result1, fake.updateServiceInstanceNameReturns.result2, fake.updateServiceInstanceNameReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) TargetCFCallCount() int {
-	fake.targetCFMutex.RLock()
-	defer fake.targetCFMutex.RUnlock()
-	return len(fake.targetCFArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceNameCallCount() int {
+	fake.updateServiceInstanceNameMutex.RLock()
+	defer fake.updateServiceInstanceNameMutex.RUnlock()
+	return len(fake.updateServiceInstanceNameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) TargetCFArgsForCall(i int) ccv2.TargetSettings {
-	fake.targetCFMutex.RLock()
-	defer fake.targetCFMutex.RUnlock()
-	return fake.targetCFArgsForCall[i].settings
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceNameArgsForCall(i int) (string, string) {
+	fake.updateServiceInstanceNameMutex.RLock()
+	defer fake.updateServiceInstanceNameMutex.RUnlock()
+	return fake.updateServiceInstanceNameArgsForCall[i].serviceInstanceGUID, fake.updateServiceInstanceNameArgsForCall[i].newName
 }
 
-func (fake *FakeCloudControllerClient) TargetCFReturns(result1 ccv2.Warnings, result2 error) {
-	fake.TargetCFStub = nil
-	fake.targetCFReturns = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceNameReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateServiceInstanceNameStub = nil
+	fake.updateServiceInstanceNameReturns = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) TargetCFReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
-	fake.TargetCFStub = nil
-	if fake.targetCFReturnsOnCall == nil {
-		fake.targetCFReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Warnings
-			result2 error
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceNameReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateServiceInstanceNameStub = nil
+	if fake.updateServiceInstanceNameReturnsOnCall == nil {
+		fake.updateServiceInstanceNameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
 		})
 	}
-	fake.targetCFReturnsOnCall[i] = struct {
-		result1 ccv2.Warnings
-		result2 error
-	}{result1, result2}
+	fake.updateServiceInstanceNameReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
-	fake.updateApplicationMutex.Lock()
-	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
-	fake.updateApplicationArgsForCall = append(fake.updateApplicationArgsForCall, struct {
-		app ccv2.Application
-	}{app})
-	fake.recordInvocation("UpdateApplication", []interface{}{app})
-	fake.updateApplicationMutex.Unlock()
-	if fake.UpdateApplicationStub != nil {
-		return fake.UpdateApplicationStub(app)
+func (fake *FakeCloudControllerClient) UpdateSpaceName(spaceGUID string, newName string) (ccv2.Space, ccv2.Warnings, error) {
+	fake.updateSpaceNameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceNameReturnsOnCall[len(fake.updateSpaceNameArgsForCall)]
+	fake.updateSpaceNameArgsForCall = append(fake.updateSpaceNameArgsForCall, struct {
+		spaceGUID string
+		newName   string
+	}{spaceGUID, newName})
+	fake.recordInvocation("UpdateSpaceName", []interface{}{spaceGUID, newName})
+	fake.updateSpaceNameMutex.Unlock()
+	if fake.UpdateSpaceNameStub != nil {
+		return fake.UpdateSpaceNameStub(spaceGUID, newName)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.updateApplicationReturns.result1, fake.updateApplicationReturns.result2, fake.updateApplicationReturns.result3
+	return fake.updateSpaceNameReturns.result1, fake.updateSpaceNameReturns.result2, fake.updateSpaceNameReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplicationCallCount() int {
-	fake.updateApplicationMutex.RLock()
-	defer fake.updateApplicationMutex.RUnlock()
-	return len(fake.updateApplicationArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateSpaceNameCallCount() int {
+	fake.updateSpaceNameMutex.RLock()
+	defer fake.updateSpaceNameMutex.RUnlock()
+	return len(fake.updateSpaceNameArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplicationArgsForCall(i int) ccv2.Application {
-	fake.updateApplicationMutex.RLock()
-	defer fake.updateApplicationMutex.RUnlock()
-	return fake.updateApplicationArgsForCall[i].app
+func (fake *FakeCloudControllerClient) UpdateSpaceNameArgsForCall(i int) (string, string) {
+	fake.updateSpaceNameMutex.RLock()
+	defer fake.updateSpaceNameMutex.RUnlock()
+	return fake.updateSpaceNameArgsForCall[i].spaceGUID, fake.updateSpaceNameArgsForCall[i].newName
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.UpdateApplicationStub = nil
-	fake.updateApplicationReturns = struct {
-		result1 ccv2.Application
+func (fake *FakeCloudControllerClient) UpdateSpaceNameReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceNameStub = nil
+	fake.updateSpaceNameReturns = struct {
+		result1 ccv2.Space
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
-	fake.UpdateApplicationStub = nil
-	if fake.updateApplicationReturnsOnCall == nil {
-		fake.updateApplicationReturnsOnCall = make(map[int]struct {
-			result1 ccv2.Application
+func (fake *FakeCloudControllerClient) UpdateSpaceNameReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceNameStub = nil
+	if fake.updateSpaceNameReturnsOnCall == nil {
+		fake.updateSpaceNameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.updateApplicationReturnsOnCall[i] = struct {
-		result1 ccv2.Application
+	fake.updateSpaceNameReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateUserProvidedServiceInstanceName(serviceInstanceGUID string, newName string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.updateUserProvidedServiceInstanceNameMutex.Lock()
+	ret, specificReturn := fake.updateUserProvidedServiceInstanceNameReturnsOnCall[len(fake.updateUserProvidedServiceInstanceNameArgsForCall)]
+	fake.updateUserProvidedServiceInstanceNameArgsForCall = append(fake.updateUserProvidedServiceInstanceNameArgsForCall, struct {
+		serviceInstanceGUID string
+		newName             string
+	}{serviceInstanceGUID, newName})
+	fake.recordInvocation("UpdateUserProvidedServiceInstanceName", []interface{}{serviceInstanceGUID, newName})
+	fake.updateUserProvidedServiceInstanceNameMutex.Unlock()
+	if fake.UpdateUserProvidedServiceInstanceNameStub != nil {
+		return fake.UpdateUserProvidedServiceInstanceNameStub(serviceInstanceGUID, newName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateUserProvidedServiceInstanceNameReturns.result1, fake.updateUserProvidedServiceInstanceNameReturns.result2, fake.updateUserProvidedServiceInstanceNameReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateUserProvidedServiceInstanceNameCallCount() int {
+	fake.updateUserProvidedServiceInstanceNameMutex.RLock()
+	defer fake.updateUserProvidedServiceInstanceNameMutex.RUnlock()
+	return len(fake.updateUserProvidedServiceInstanceNameArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateUserProvidedServiceInstanceNameArgsForCall(i int) (string, string) {
+	fake.updateUserProvidedServiceInstanceNameMutex.RLock()
+	defer fake.updateUserProvidedServiceInstanceNameMutex.RUnlock()
+	return fake.updateUserProvidedServiceInstanceNameArgsForCall[i].serviceInstanceGUID, fake.updateUserProvidedServiceInstanceNameArgsForCall[i].newName
+}
+
+func (fake *FakeCloudControllerClient) UpdateUserProvidedServiceInstanceNameReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateUserProvidedServiceInstanceNameStub = nil
+	fake.updateUserProvidedServiceInstanceNameReturns = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateUserProvidedServiceInstanceNameReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateUserProvidedServiceInstanceNameStub = nil
+	if fake.updateUserProvidedServiceInstanceNameReturnsOnCall == nil {
+		fake.updateUserProvidedServiceInstanceNameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateUserProvidedServiceInstanceNameReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -2818,6 +5992,126 @@ func (fake *FakeCloudControllerClient) APIVersionReturnsOnCall(i int, result1 st
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) AppSSHEndpoint() string {
+	fake.appSSHEndpointMutex.Lock()
+	ret, specificReturn := fake.appSSHEndpointReturnsOnCall[len(fake.appSSHEndpointArgsForCall)]
+	fake.appSSHEndpointArgsForCall = append(fake.appSSHEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHEndpoint", []interface{}{})
+	fake.appSSHEndpointMutex.Unlock()
+	if fake.AppSSHEndpointStub != nil {
+		return fake.AppSSHEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHEndpointReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointCallCount() int {
+	fake.appSSHEndpointMutex.RLock()
+	defer fake.appSSHEndpointMutex.RUnlock()
+	return len(fake.appSSHEndpointArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointReturns(result1 string) {
+	fake.AppSSHEndpointStub = nil
+	fake.appSSHEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHEndpointReturnsOnCall(i int, result1 string) {
+	fake.AppSSHEndpointStub = nil
+	if fake.appSSHEndpointReturnsOnCall == nil {
+		fake.appSSHEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprint() string {
+	fake.appSSHHostKeyFingerprintMutex.Lock()
+	ret, specificReturn := fake.appSSHHostKeyFingerprintReturnsOnCall[len(fake.appSSHHostKeyFingerprintArgsForCall)]
+	fake.appSSHHostKeyFingerprintArgsForCall = append(fake.appSSHHostKeyFingerprintArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHHostKeyFingerprint", []interface{}{})
+	fake.appSSHHostKeyFingerprintMutex.Unlock()
+	if fake.AppSSHHostKeyFingerprintStub != nil {
+		return fake.AppSSHHostKeyFingerprintStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHHostKeyFingerprintReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintCallCount() int {
+	fake.appSSHHostKeyFingerprintMutex.RLock()
+	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
+	return len(fake.appSSHHostKeyFingerprintArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturns(result1 string) {
+	fake.AppSSHHostKeyFingerprintStub = nil
+	fake.appSSHHostKeyFingerprintReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturnsOnCall(i int, result1 string) {
+	fake.AppSSHHostKeyFingerprintStub = nil
+	if fake.appSSHHostKeyFingerprintReturnsOnCall == nil {
+		fake.appSSHHostKeyFingerprintReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHHostKeyFingerprintReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHOAuthClient() string {
+	fake.appSSHOAuthClientMutex.Lock()
+	ret, specificReturn := fake.appSSHOAuthClientReturnsOnCall[len(fake.appSSHOAuthClientArgsForCall)]
+	fake.appSSHOAuthClientArgsForCall = append(fake.appSSHOAuthClientArgsForCall, struct{}{})
+	fake.recordInvocation("AppSSHOAuthClient", []interface{}{})
+	fake.appSSHOAuthClientMutex.Unlock()
+	if fake.AppSSHOAuthClientStub != nil {
+		return fake.AppSSHOAuthClientStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appSSHOAuthClientReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) AppSSHOAuthClientCallCount() int {
+	fake.appSSHOAuthClientMutex.RLock()
+	defer fake.appSSHOAuthClientMutex.RUnlock()
+	return len(fake.appSSHOAuthClientArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AppSSHOAuthClientReturns(result1 string) {
+	fake.AppSSHOAuthClientStub = nil
+	fake.appSSHOAuthClientReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) AppSSHOAuthClientReturnsOnCall(i int, result1 string) {
+	fake.AppSSHOAuthClientStub = nil
+	if fake.appSSHOAuthClientReturnsOnCall == nil {
+		fake.appSSHOAuthClientReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appSSHOAuthClientReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeCloudControllerClient) AuthorizationEndpoint() string {
 	fake.authorizationEndpointMutex.Lock()
 	ret, specificReturn := fake.authorizationEndpointReturnsOnCall[len(fake.authorizationEndpointArgsForCall)]
@@ -3018,6 +6312,59 @@ func (fake *FakeCloudControllerClient) TokenEndpointReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSpaceUserRoleByUsername(role ccv2.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceUserRoleByUsernameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceUserRoleByUsernameReturnsOnCall[len(fake.updateSpaceUserRoleByUsernameArgsForCall)]
+	fake.updateSpaceUserRoleByUsernameArgsForCall = append(fake.updateSpaceUserRoleByUsernameArgsForCall, struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		username  string
+	}{role, spaceGUID, username})
+	fake.recordInvocation("UpdateSpaceUserRoleByUsername", []interface{}{role, spaceGUID, username})
+	fake.updateSpaceUserRoleByUsernameMutex.Unlock()
+	if fake.UpdateSpaceUserRoleByUsernameStub != nil {
+		return fake.UpdateSpaceUserRoleByUsernameStub(role, spaceGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceUserRoleByUsernameReturns.result1, fake.updateSpaceUserRoleByUsernameReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserRoleByUsernameCallCount() int {
+	fake.updateSpaceUserRoleByUsernameMutex.RLock()
+	defer fake.updateSpaceUserRoleByUsernameMutex.RUnlock()
+	return len(fake.updateSpaceUserRoleByUsernameArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserRoleByUsernameArgsForCall(i int) (ccv2.SpaceRole, string, string) {
+	fake.updateSpaceUserRoleByUsernameMutex.RLock()
+	defer fake.updateSpaceUserRoleByUsernameMutex.RUnlock()
+	return fake.updateSpaceUserRoleByUsernameArgsForCall[i].role, fake.updateSpaceUserRoleByUsernameArgsForCall[i].spaceGUID, fake.updateSpaceUserRoleByUsernameArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserRoleByUsernameReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserRoleByUsernameStub = nil
+	fake.updateSpaceUserRoleByUsernameReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserRoleByUsernameReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserRoleByUsernameStub = nil
+	if fake.updateSpaceUserRoleByUsernameReturnsOnCall == nil {
+		fake.updateSpaceUserRoleByUsernameReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceUserRoleByUsernameReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -3029,16 +6376,34 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.checkRouteMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createRouteMappingMutex.RLock()
+	defer fake.createRouteMappingMutex.RUnlock()
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	fake.createServiceBindingMutex.RLock()
+	defer fake.createServiceBindingMutex.RUnlock()
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
+	fake.deleteRouteMappingMutex.RLock()
+	defer fake.deleteRouteMappingMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.deleteSpaceMutex.RLock()
+	defer fake.deleteSpaceMutex.RUnlock()
 	fake.getApplicationMutex.RLock()
 	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationInstancesByApplicationMutex.RLock()
@@ -3049,6 +6414,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	fake.getConfigFeatureFlagMutex.RLock()
+	defer fake.getConfigFeatureFlagMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	fake.getRecentEventsMutex.RLock()
+	defer fake.getRecentEventsMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
@@ -3059,48 +6434,114 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationQuotaMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
+	fake.getOrganizationsByPageMutex.RLock()
+	defer fake.getOrganizationsByPageMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
 	defer fake.getPrivateDomainMutex.RUnlock()
 	fake.getRouteApplicationsMutex.RLock()
 	defer fake.getRouteApplicationsMutex.RUnlock()
+	fake.getRouteCountMutex.RLock()
+	defer fake.getRouteCountMutex.RUnlock()
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
 	fake.getRoutesMutex.RLock()
 	defer fake.getRoutesMutex.RUnlock()
+	fake.getSecurityGroupSpacesMutex.RLock()
+	defer fake.getSecurityGroupSpacesMutex.RUnlock()
+	fake.getSecurityGroupStagingSpacesMutex.RLock()
+	defer fake.getSecurityGroupStagingSpacesMutex.RUnlock()
 	fake.getSecurityGroupsMutex.RLock()
 	defer fake.getSecurityGroupsMutex.RUnlock()
 	fake.getServiceBindingsMutex.RLock()
 	defer fake.getServiceBindingsMutex.RUnlock()
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
+	fake.getServiceMutex.RLock()
+	defer fake.getServiceMutex.RUnlock()
+	fake.getServiceInstanceParametersMutex.RLock()
+	defer fake.getServiceInstanceParametersMutex.RUnlock()
+	fake.getServiceInstanceSharedFromMutex.RLock()
+	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	fake.getServicePlanVisibilitiesMutex.RLock()
+	defer fake.getServicePlanVisibilitiesMutex.RUnlock()
+	fake.getServicesMutex.RLock()
+	defer fake.getServicesMutex.RUnlock()
 	fake.getSharedDomainMutex.RLock()
 	defer fake.getSharedDomainMutex.RUnlock()
 	fake.getSharedDomainsMutex.RLock()
 	defer fake.getSharedDomainsMutex.RUnlock()
+	fake.getSpaceMutex.RLock()
+	defer fake.getSpaceMutex.RUnlock()
 	fake.getSpaceQuotaMutex.RLock()
 	defer fake.getSpaceQuotaMutex.RUnlock()
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
 	fake.getSpaceRoutesMutex.RLock()
 	defer fake.getSpaceRoutesMutex.RUnlock()
 	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
 	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
+	fake.getSpacesByPageMutex.RLock()
+	defer fake.getSpacesByPageMutex.RUnlock()
 	fake.getSpaceServiceInstancesMutex.RLock()
 	defer fake.getSpaceServiceInstancesMutex.RUnlock()
+	fake.getSpaceServicesMutex.RLock()
+	defer fake.getSpaceServicesMutex.RUnlock()
 	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
 	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
 	fake.getStackMutex.RLock()
 	defer fake.getStackMutex.RUnlock()
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
+	fake.purgeServiceMutex.RLock()
+	defer fake.purgeServiceMutex.RUnlock()
+	fake.purgeServiceInstanceMutex.RLock()
+	defer fake.purgeServiceInstanceMutex.RUnlock()
 	fake.removeSpaceFromSecurityGroupMutex.RLock()
 	defer fake.removeSpaceFromSecurityGroupMutex.RUnlock()
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	fake.scaleApplicationMutex.RLock()
+	defer fake.scaleApplicationMutex.RUnlock()
 	fake.targetCFMutex.RLock()
 	defer fake.targetCFMutex.RUnlock()
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationNameMutex.RUnlock()
+	fake.updateOrganizationUserRoleByUsernameMutex.RLock()
+	defer fake.updateOrganizationUserRoleByUsernameMutex.RUnlock()
+	fake.updateSecurityGroupRulesMutex.RLock()
+	defer fake.updateSecurityGroupRulesMutex.RUnlock()
+	fake.updateServiceInstanceNameMutex.RLock()
+	defer fake.updateServiceInstanceNameMutex.RUnlock()
+	fake.updateSpaceNameMutex.RLock()
+	defer fake.updateSpaceNameMutex.RUnlock()
+	fake.updateUserProvidedServiceInstanceNameMutex.RLock()
+	defer fake.updateUserProvidedServiceInstanceNameMutex.RUnlock()
 	fake.aPIMutex.RLock()
 	defer fake.aPIMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
+	fake.appSSHEndpointMutex.RLock()
+	defer fake.appSSHEndpointMutex.RUnlock()
+	fake.appSSHHostKeyFingerprintMutex.RLock()
+	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
+	fake.appSSHOAuthClientMutex.RLock()
+	defer fake.appSSHOAuthClientMutex.RUnlock()
 	fake.authorizationEndpointMutex.RLock()
 	defer fake.authorizationEndpointMutex.RUnlock()
 	fake.dopplerEndpointMutex.RLock()
@@ -3111,6 +6552,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.routingEndpointMutex.RUnlock()
 	fake.tokenEndpointMutex.RLock()
 	defer fake.tokenEndpointMutex.RUnlock()
+	fake.updateSpaceUserRoleByUsernameMutex.RLock()
+	defer fake.updateSpaceUserRoleByUsernameMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -18,6 +18,28 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	RefreshTokenStub        func() string
+	refreshTokenMutex       sync.RWMutex
+	refreshTokenArgsForCall []struct{}
+	refreshTokenReturns     struct {
+		result1 string
+	}
+	refreshTokenReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SaveTargetSessionStub        func()
+	saveTargetSessionMutex       sync.RWMutex
+	saveTargetSessionArgsForCall []struct{}
+	SetAccessTokenStub           func(token string)
+	setAccessTokenMutex          sync.RWMutex
+	setAccessTokenArgsForCall    []struct {
+		token string
+	}
+	SetRefreshTokenStub        func(token string)
+	setRefreshTokenMutex       sync.RWMutex
+	setRefreshTokenArgsForCall []struct {
+		token string
+	}
 	SetTargetInformationStub        func(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool)
 	setTargetInformationMutex       sync.RWMutex
 	setTargetInformationArgsForCall []struct {
@@ -64,6 +86,19 @@ type FakeConfig struct {
 	startupTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	TakeTargetSessionStub        func(api string) (string, string)
+	takeTargetSessionMutex       sync.RWMutex
+	takeTargetSessionArgsForCall []struct {
+		api string
+	}
+	takeTargetSessionReturns struct {
+		result1 string
+		result2 string
+	}
+	takeTargetSessionReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+	}
 	TargetStub        func() string
 	targetMutex       sync.RWMutex
 	targetArgsForCall []struct{}
@@ -123,6 +158,110 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) RefreshToken() string {
+	fake.refreshTokenMutex.Lock()
+	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
+	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct{}{})
+	fake.recordInvocation("RefreshToken", []interface{}{})
+	fake.refreshTokenMutex.Unlock()
+	if fake.RefreshTokenStub != nil {
+		return fake.RefreshTokenStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.refreshTokenReturns.result1
+}
+
+func (fake *FakeConfig) RefreshTokenCallCount() int {
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	return len(fake.refreshTokenArgsForCall)
+}
+
+func (fake *FakeConfig) RefreshTokenReturns(result1 string) {
+	fake.RefreshTokenStub = nil
+	fake.refreshTokenReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
+	fake.RefreshTokenStub = nil
+	if fake.refreshTokenReturnsOnCall == nil {
+		fake.refreshTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.refreshTokenReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SaveTargetSession() {
+	fake.saveTargetSessionMutex.Lock()
+	fake.saveTargetSessionArgsForCall = append(fake.saveTargetSessionArgsForCall, struct{}{})
+	fake.recordInvocation("SaveTargetSession", []interface{}{})
+	fake.saveTargetSessionMutex.Unlock()
+	if fake.SaveTargetSessionStub != nil {
+		fake.SaveTargetSessionStub()
+	}
+}
+
+func (fake *FakeConfig) SaveTargetSessionCallCount() int {
+	fake.saveTargetSessionMutex.RLock()
+	defer fake.saveTargetSessionMutex.RUnlock()
+	return len(fake.saveTargetSessionArgsForCall)
+}
+
+func (fake *FakeConfig) SetAccessToken(token string) {
+	fake.setAccessTokenMutex.Lock()
+	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
+		token string
+	}{token})
+	fake.recordInvocation("SetAccessToken", []interface{}{token})
+	fake.setAccessTokenMutex.Unlock()
+	if fake.SetAccessTokenStub != nil {
+		fake.SetAccessTokenStub(token)
+	}
+}
+
+func (fake *FakeConfig) SetAccessTokenCallCount() int {
+	fake.setAccessTokenMutex.RLock()
+	defer fake.setAccessTokenMutex.RUnlock()
+	return len(fake.setAccessTokenArgsForCall)
+}
+
+func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
+	fake.setAccessTokenMutex.RLock()
+	defer fake.setAccessTokenMutex.RUnlock()
+	return fake.setAccessTokenArgsForCall[i].token
+}
+
+func (fake *FakeConfig) SetRefreshToken(token string) {
+	fake.setRefreshTokenMutex.Lock()
+	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
+		token string
+	}{token})
+	fake.recordInvocation("SetRefreshToken", []interface{}{token})
+	fake.setRefreshTokenMutex.Unlock()
+	if fake.SetRefreshTokenStub != nil {
+		fake.SetRefreshTokenStub(token)
+	}
+}
+
+func (fake *FakeConfig) SetRefreshTokenCallCount() int {
+	fake.setRefreshTokenMutex.RLock()
+	defer fake.setRefreshTokenMutex.RUnlock()
+	return len(fake.setRefreshTokenArgsForCall)
+}
+
+func (fake *FakeConfig) SetRefreshTokenArgsForCall(i int) string {
+	fake.setRefreshTokenMutex.RLock()
+	defer fake.setRefreshTokenMutex.RUnlock()
+	return fake.setRefreshTokenArgsForCall[i].token
+}
+
 func (fake *FakeConfig) SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool) {
 	fake.setTargetInformationMutex.Lock()
 	fake.setTargetInformationArgsForCall = append(fake.setTargetInformationArgsForCall, struct {
@@ -300,6 +439,57 @@ func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration
 	}{result1}
 }
 
+func (fake *FakeConfig) TakeTargetSession(api string) (string, string) {
+	fake.takeTargetSessionMutex.Lock()
+	ret, specificReturn := fake.takeTargetSessionReturnsOnCall[len(fake.takeTargetSessionArgsForCall)]
+	fake.takeTargetSessionArgsForCall = append(fake.takeTargetSessionArgsForCall, struct {
+		api string
+	}{api})
+	fake.recordInvocation("TakeTargetSession", []interface{}{api})
+	fake.takeTargetSessionMutex.Unlock()
+	if fake.TakeTargetSessionStub != nil {
+		return fake.TakeTargetSessionStub(api)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.takeTargetSessionReturns.result1, fake.takeTargetSessionReturns.result2
+}
+
+func (fake *FakeConfig) TakeTargetSessionCallCount() int {
+	fake.takeTargetSessionMutex.RLock()
+	defer fake.takeTargetSessionMutex.RUnlock()
+	return len(fake.takeTargetSessionArgsForCall)
+}
+
+func (fake *FakeConfig) TakeTargetSessionArgsForCall(i int) string {
+	fake.takeTargetSessionMutex.RLock()
+	defer fake.takeTargetSessionMutex.RUnlock()
+	return fake.takeTargetSessionArgsForCall[i].api
+}
+
+func (fake *FakeConfig) TakeTargetSessionReturns(result1 string, result2 string) {
+	fake.TakeTargetSessionStub = nil
+	fake.takeTargetSessionReturns = struct {
+		result1 string
+		result2 string
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) TakeTargetSessionReturnsOnCall(i int, result1 string, result2 string) {
+	fake.TakeTargetSessionStub = nil
+	if fake.takeTargetSessionReturnsOnCall == nil {
+		fake.takeTargetSessionReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+		})
+	}
+	fake.takeTargetSessionReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) Target() string {
 	fake.targetMutex.Lock()
 	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
@@ -377,6 +567,14 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	fake.saveTargetSessionMutex.RLock()
+	defer fake.saveTargetSessionMutex.RUnlock()
+	fake.setAccessTokenMutex.RLock()
+	defer fake.setAccessTokenMutex.RUnlock()
+	fake.setRefreshTokenMutex.RLock()
+	defer fake.setRefreshTokenMutex.RUnlock()
 	fake.setTargetInformationMutex.RLock()
 	defer fake.setTargetInformationMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
@@ -387,6 +585,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
 	defer fake.startupTimeoutMutex.RUnlock()
+	fake.takeTargetSessionMutex.RLock()
+	defer fake.takeTargetSessionMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
 	fake.unsetOrganizationInformationMutex.RLock()
@@ -0,0 +1,102 @@
+// This file was generated by counterfeiter
+package v2actionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/logcache"
+)
+
+type FakeLogCacheClient struct {
+	ReadLogsStub        func(sourceID string, options logcache.ReadOptions) ([]logcache.LogEnvelope, error)
+	readLogsMutex       sync.RWMutex
+	readLogsArgsForCall []struct {
+		sourceID string
+		options  logcache.ReadOptions
+	}
+	readLogsReturns struct {
+		result1 []logcache.LogEnvelope
+		result2 error
+	}
+	readLogsReturnsOnCall map[int]struct {
+		result1 []logcache.LogEnvelope
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLogCacheClient) ReadLogs(sourceID string, options logcache.ReadOptions) ([]logcache.LogEnvelope, error) {
+	fake.readLogsMutex.Lock()
+	ret, specificReturn := fake.readLogsReturnsOnCall[len(fake.readLogsArgsForCall)]
+	fake.readLogsArgsForCall = append(fake.readLogsArgsForCall, struct {
+		sourceID string
+		options  logcache.ReadOptions
+	}{sourceID, options})
+	fake.recordInvocation("ReadLogs", []interface{}{sourceID, options})
+	fake.readLogsMutex.Unlock()
+	if fake.ReadLogsStub != nil {
+		return fake.ReadLogsStub(sourceID, options)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readLogsReturns.result1, fake.readLogsReturns.result2
+}
+
+func (fake *FakeLogCacheClient) ReadLogsCallCount() int {
+	fake.readLogsMutex.RLock()
+	defer fake.readLogsMutex.RUnlock()
+	return len(fake.readLogsArgsForCall)
+}
+
+func (fake *FakeLogCacheClient) ReadLogsArgsForCall(i int) (string, logcache.ReadOptions) {
+	fake.readLogsMutex.RLock()
+	defer fake.readLogsMutex.RUnlock()
+	return fake.readLogsArgsForCall[i].sourceID, fake.readLogsArgsForCall[i].options
+}
+
+func (fake *FakeLogCacheClient) ReadLogsReturns(result1 []logcache.LogEnvelope, result2 error) {
+	fake.ReadLogsStub = nil
+	fake.readLogsReturns = struct {
+		result1 []logcache.LogEnvelope
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogCacheClient) ReadLogsReturnsOnCall(i int, result1 []logcache.LogEnvelope, result2 error) {
+	fake.ReadLogsStub = nil
+	if fake.readLogsReturnsOnCall == nil {
+		fake.readLogsReturnsOnCall = make(map[int]struct {
+			result1 []logcache.LogEnvelope
+			result2 error
+		})
+	}
+	fake.readLogsReturnsOnCall[i] = struct {
+		result1 []logcache.LogEnvelope
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogCacheClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.readLogsMutex.RLock()
+	defer fake.readLogsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeLogCacheClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2action.LogCacheClient = new(FakeLogCacheClient)
@@ -18,6 +18,34 @@ type FakeNOAAClient struct {
 	closeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ContainerMetricsStub        func(appGuid string, authToken string) ([]*events.ContainerMetric, error)
+	containerMetricsMutex       sync.RWMutex
+	containerMetricsArgsForCall []struct {
+		appGuid   string
+		authToken string
+	}
+	containerMetricsReturns struct {
+		result1 []*events.ContainerMetric
+		result2 error
+	}
+	containerMetricsReturnsOnCall map[int]struct {
+		result1 []*events.ContainerMetric
+		result2 error
+	}
+	FirehoseStub        func(subscriptionId string, authToken string) (<-chan *events.Envelope, <-chan error)
+	firehoseMutex       sync.RWMutex
+	firehoseArgsForCall []struct {
+		subscriptionId string
+		authToken      string
+	}
+	firehoseReturns struct {
+		result1 <-chan *events.Envelope
+		result2 <-chan error
+	}
+	firehoseReturnsOnCall map[int]struct {
+		result1 <-chan *events.Envelope
+		result2 <-chan error
+	}
 	RecentLogsStub        func(appGuid string, authToken string) ([]*events.LogMessage, error)
 	recentLogsMutex       sync.RWMutex
 	recentLogsArgsForCall []struct {
@@ -32,7 +60,7 @@ type FakeNOAAClient struct {
 		result1 []*events.LogMessage
 		result2 error
 	}
-	TailingLogsStub        func(appGuid, authToken string) (<-chan *events.LogMessage, <-chan error)
+	TailingLogsStub        func(appGuid string, authToken string) (<-chan *events.LogMessage, <-chan error)
 	tailingLogsMutex       sync.RWMutex
 	tailingLogsArgsForCall []struct {
 		appGuid   string
@@ -90,6 +118,110 @@ func (fake *FakeNOAAClient) CloseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNOAAClient) ContainerMetrics(appGuid string, authToken string) ([]*events.ContainerMetric, error) {
+	fake.containerMetricsMutex.Lock()
+	ret, specificReturn := fake.containerMetricsReturnsOnCall[len(fake.containerMetricsArgsForCall)]
+	fake.containerMetricsArgsForCall = append(fake.containerMetricsArgsForCall, struct {
+		appGuid   string
+		authToken string
+	}{appGuid, authToken})
+	fake.recordInvocation("ContainerMetrics", []interface{}{appGuid, authToken})
+	fake.containerMetricsMutex.Unlock()
+	if fake.ContainerMetricsStub != nil {
+		return fake.ContainerMetricsStub(appGuid, authToken)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.containerMetricsReturns.result1, fake.containerMetricsReturns.result2
+}
+
+func (fake *FakeNOAAClient) ContainerMetricsCallCount() int {
+	fake.containerMetricsMutex.RLock()
+	defer fake.containerMetricsMutex.RUnlock()
+	return len(fake.containerMetricsArgsForCall)
+}
+
+func (fake *FakeNOAAClient) ContainerMetricsArgsForCall(i int) (string, string) {
+	fake.containerMetricsMutex.RLock()
+	defer fake.containerMetricsMutex.RUnlock()
+	return fake.containerMetricsArgsForCall[i].appGuid, fake.containerMetricsArgsForCall[i].authToken
+}
+
+func (fake *FakeNOAAClient) ContainerMetricsReturns(result1 []*events.ContainerMetric, result2 error) {
+	fake.ContainerMetricsStub = nil
+	fake.containerMetricsReturns = struct {
+		result1 []*events.ContainerMetric
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNOAAClient) ContainerMetricsReturnsOnCall(i int, result1 []*events.ContainerMetric, result2 error) {
+	fake.ContainerMetricsStub = nil
+	if fake.containerMetricsReturnsOnCall == nil {
+		fake.containerMetricsReturnsOnCall = make(map[int]struct {
+			result1 []*events.ContainerMetric
+			result2 error
+		})
+	}
+	fake.containerMetricsReturnsOnCall[i] = struct {
+		result1 []*events.ContainerMetric
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNOAAClient) Firehose(subscriptionId string, authToken string) (<-chan *events.Envelope, <-chan error) {
+	fake.firehoseMutex.Lock()
+	ret, specificReturn := fake.firehoseReturnsOnCall[len(fake.firehoseArgsForCall)]
+	fake.firehoseArgsForCall = append(fake.firehoseArgsForCall, struct {
+		subscriptionId string
+		authToken      string
+	}{subscriptionId, authToken})
+	fake.recordInvocation("Firehose", []interface{}{subscriptionId, authToken})
+	fake.firehoseMutex.Unlock()
+	if fake.FirehoseStub != nil {
+		return fake.FirehoseStub(subscriptionId, authToken)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.firehoseReturns.result1, fake.firehoseReturns.result2
+}
+
+func (fake *FakeNOAAClient) FirehoseCallCount() int {
+	fake.firehoseMutex.RLock()
+	defer fake.firehoseMutex.RUnlock()
+	return len(fake.firehoseArgsForCall)
+}
+
+func (fake *FakeNOAAClient) FirehoseArgsForCall(i int) (string, string) {
+	fake.firehoseMutex.RLock()
+	defer fake.firehoseMutex.RUnlock()
+	return fake.firehoseArgsForCall[i].subscriptionId, fake.firehoseArgsForCall[i].authToken
+}
+
+func (fake *FakeNOAAClient) FirehoseReturns(result1 <-chan *events.Envelope, result2 <-chan error) {
+	fake.FirehoseStub = nil
+	fake.firehoseReturns = struct {
+		result1 <-chan *events.Envelope
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeNOAAClient) FirehoseReturnsOnCall(i int, result1 <-chan *events.Envelope, result2 <-chan error) {
+	fake.FirehoseStub = nil
+	if fake.firehoseReturnsOnCall == nil {
+		fake.firehoseReturnsOnCall = make(map[int]struct {
+			result1 <-chan *events.Envelope
+			result2 <-chan error
+		})
+	}
+	fake.firehoseReturnsOnCall[i] = struct {
+		result1 <-chan *events.Envelope
+		result2 <-chan error
+	}{result1, result2}
+}
+
 func (fake *FakeNOAAClient) RecentLogs(appGuid string, authToken string) ([]*events.LogMessage, error) {
 	fake.recentLogsMutex.Lock()
 	ret, specificReturn := fake.recentLogsReturnsOnCall[len(fake.recentLogsArgsForCall)]
@@ -199,6 +331,10 @@ func (fake *FakeNOAAClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.containerMetricsMutex.RLock()
+	defer fake.containerMetricsMutex.RUnlock()
+	fake.firehoseMutex.RLock()
+	defer fake.firehoseMutex.RUnlock()
 	fake.recentLogsMutex.RLock()
 	defer fake.recentLogsMutex.RUnlock()
 	fake.tailingLogsMutex.RLock()
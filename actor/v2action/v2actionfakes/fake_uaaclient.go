@@ -9,6 +9,18 @@ import (
 )
 
 type FakeUAAClient struct {
+	AddGroupMemberStub        func(groupID string, member uaa.GroupMember) error
+	addGroupMemberMutex       sync.RWMutex
+	addGroupMemberArgsForCall []struct {
+		groupID string
+		member  uaa.GroupMember
+	}
+	addGroupMemberReturns struct {
+		result1 error
+	}
+	addGroupMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateUserStub        func(username string, password string, origin string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -24,10 +36,124 @@ type FakeUAAClient struct {
 		result1 uaa.User
 		result2 error
 	}
+	GetGroupsStub        func(displayName string) ([]uaa.Group, error)
+	getGroupsMutex       sync.RWMutex
+	getGroupsArgsForCall []struct {
+		displayName string
+	}
+	getGroupsReturns struct {
+		result1 []uaa.Group
+		result2 error
+	}
+	getGroupsReturnsOnCall map[int]struct {
+		result1 []uaa.Group
+		result2 error
+	}
+	GetSSHPasscodeStub        func(accessToken string, sshOAuthClient string) (string, error)
+	getSSHPasscodeMutex       sync.RWMutex
+	getSSHPasscodeArgsForCall []struct {
+		accessToken    string
+		sshOAuthClient string
+	}
+	getSSHPasscodeReturns struct {
+		result1 string
+		result2 error
+	}
+	getSSHPasscodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetUsersByUsernameStub        func(username string) ([]uaa.User, error)
+	getUsersByUsernameMutex       sync.RWMutex
+	getUsersByUsernameArgsForCall []struct {
+		username string
+	}
+	getUsersByUsernameReturns struct {
+		result1 []uaa.User
+		result2 error
+	}
+	getUsersByUsernameReturnsOnCall map[int]struct {
+		result1 []uaa.User
+		result2 error
+	}
+	RefreshAccessTokenStub        func(refreshToken string) (uaa.RefreshToken, error)
+	refreshAccessTokenMutex       sync.RWMutex
+	refreshAccessTokenArgsForCall []struct {
+		refreshToken string
+	}
+	refreshAccessTokenReturns struct {
+		result1 uaa.RefreshToken
+		result2 error
+	}
+	refreshAccessTokenReturnsOnCall map[int]struct {
+		result1 uaa.RefreshToken
+		result2 error
+	}
+	RemoveGroupMemberStub        func(groupID string, memberID string) error
+	removeGroupMemberMutex       sync.RWMutex
+	removeGroupMemberArgsForCall []struct {
+		groupID  string
+		memberID string
+	}
+	removeGroupMemberReturns struct {
+		result1 error
+	}
+	removeGroupMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeUAAClient) AddGroupMember(groupID string, member uaa.GroupMember) error {
+	fake.addGroupMemberMutex.Lock()
+	ret, specificReturn := fake.addGroupMemberReturnsOnCall[len(fake.addGroupMemberArgsForCall)]
+	fake.addGroupMemberArgsForCall = append(fake.addGroupMemberArgsForCall, struct {
+		groupID string
+		member  uaa.GroupMember
+	}{groupID, member})
+	fake.recordInvocation("AddGroupMember", []interface{}{groupID, member})
+	fake.addGroupMemberMutex.Unlock()
+	if fake.AddGroupMemberStub != nil {
+		return fake.AddGroupMemberStub(groupID, member)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.addGroupMemberReturns.result1
+}
+
+func (fake *FakeUAAClient) AddGroupMemberCallCount() int {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return len(fake.addGroupMemberArgsForCall)
+}
+
+func (fake *FakeUAAClient) AddGroupMemberArgsForCall(i int) (string, uaa.GroupMember) {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return fake.addGroupMemberArgsForCall[i].groupID, fake.addGroupMemberArgsForCall[i].member
+}
+
+func (fake *FakeUAAClient) AddGroupMemberReturns(result1 error) {
+	fake.AddGroupMemberStub = nil
+	fake.addGroupMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) AddGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.AddGroupMemberStub = nil
+	if fake.addGroupMemberReturnsOnCall == nil {
+		fake.addGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addGroupMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUAAClient) CreateUser(username string, password string, origin string) (uaa.User, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -81,11 +207,277 @@ func (fake *FakeUAAClient) CreateUserReturnsOnCall(i int, result1 uaa.User, resu
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) GetGroups(displayName string) ([]uaa.Group, error) {
+	fake.getGroupsMutex.Lock()
+	ret, specificReturn := fake.getGroupsReturnsOnCall[len(fake.getGroupsArgsForCall)]
+	fake.getGroupsArgsForCall = append(fake.getGroupsArgsForCall, struct {
+		displayName string
+	}{displayName})
+	fake.recordInvocation("GetGroups", []interface{}{displayName})
+	fake.getGroupsMutex.Unlock()
+	if fake.GetGroupsStub != nil {
+		return fake.GetGroupsStub(displayName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getGroupsReturns.result1, fake.getGroupsReturns.result2
+}
+
+func (fake *FakeUAAClient) GetGroupsCallCount() int {
+	fake.getGroupsMutex.RLock()
+	defer fake.getGroupsMutex.RUnlock()
+	return len(fake.getGroupsArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetGroupsArgsForCall(i int) string {
+	fake.getGroupsMutex.RLock()
+	defer fake.getGroupsMutex.RUnlock()
+	return fake.getGroupsArgsForCall[i].displayName
+}
+
+func (fake *FakeUAAClient) GetGroupsReturns(result1 []uaa.Group, result2 error) {
+	fake.GetGroupsStub = nil
+	fake.getGroupsReturns = struct {
+		result1 []uaa.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetGroupsReturnsOnCall(i int, result1 []uaa.Group, result2 error) {
+	fake.GetGroupsStub = nil
+	if fake.getGroupsReturnsOnCall == nil {
+		fake.getGroupsReturnsOnCall = make(map[int]struct {
+			result1 []uaa.Group
+			result2 error
+		})
+	}
+	fake.getGroupsReturnsOnCall[i] = struct {
+		result1 []uaa.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error) {
+	fake.getSSHPasscodeMutex.Lock()
+	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
+	fake.getSSHPasscodeArgsForCall = append(fake.getSSHPasscodeArgsForCall, struct {
+		accessToken    string
+		sshOAuthClient string
+	}{accessToken, sshOAuthClient})
+	fake.recordInvocation("GetSSHPasscode", []interface{}{accessToken, sshOAuthClient})
+	fake.getSSHPasscodeMutex.Unlock()
+	if fake.GetSSHPasscodeStub != nil {
+		return fake.GetSSHPasscodeStub(accessToken, sshOAuthClient)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSSHPasscodeReturns.result1, fake.getSSHPasscodeReturns.result2
+}
+
+func (fake *FakeUAAClient) GetSSHPasscodeCallCount() int {
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	return len(fake.getSSHPasscodeArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetSSHPasscodeArgsForCall(i int) (string, string) {
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	return fake.getSSHPasscodeArgsForCall[i].accessToken, fake.getSSHPasscodeArgsForCall[i].sshOAuthClient
+}
+
+func (fake *FakeUAAClient) GetSSHPasscodeReturns(result1 string, result2 error) {
+	fake.GetSSHPasscodeStub = nil
+	fake.getSSHPasscodeReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetSSHPasscodeReturnsOnCall(i int, result1 string, result2 error) {
+	fake.GetSSHPasscodeStub = nil
+	if fake.getSSHPasscodeReturnsOnCall == nil {
+		fake.getSSHPasscodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getSSHPasscodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetUsersByUsername(username string) ([]uaa.User, error) {
+	fake.getUsersByUsernameMutex.Lock()
+	ret, specificReturn := fake.getUsersByUsernameReturnsOnCall[len(fake.getUsersByUsernameArgsForCall)]
+	fake.getUsersByUsernameArgsForCall = append(fake.getUsersByUsernameArgsForCall, struct {
+		username string
+	}{username})
+	fake.recordInvocation("GetUsersByUsername", []interface{}{username})
+	fake.getUsersByUsernameMutex.Unlock()
+	if fake.GetUsersByUsernameStub != nil {
+		return fake.GetUsersByUsernameStub(username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getUsersByUsernameReturns.result1, fake.getUsersByUsernameReturns.result2
+}
+
+func (fake *FakeUAAClient) GetUsersByUsernameCallCount() int {
+	fake.getUsersByUsernameMutex.RLock()
+	defer fake.getUsersByUsernameMutex.RUnlock()
+	return len(fake.getUsersByUsernameArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetUsersByUsernameArgsForCall(i int) string {
+	fake.getUsersByUsernameMutex.RLock()
+	defer fake.getUsersByUsernameMutex.RUnlock()
+	return fake.getUsersByUsernameArgsForCall[i].username
+}
+
+func (fake *FakeUAAClient) GetUsersByUsernameReturns(result1 []uaa.User, result2 error) {
+	fake.GetUsersByUsernameStub = nil
+	fake.getUsersByUsernameReturns = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetUsersByUsernameReturnsOnCall(i int, result1 []uaa.User, result2 error) {
+	fake.GetUsersByUsernameStub = nil
+	if fake.getUsersByUsernameReturnsOnCall == nil {
+		fake.getUsersByUsernameReturnsOnCall = make(map[int]struct {
+			result1 []uaa.User
+			result2 error
+		})
+	}
+	fake.getUsersByUsernameReturnsOnCall[i] = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RefreshAccessToken(refreshToken string) (uaa.RefreshToken, error) {
+	fake.refreshAccessTokenMutex.Lock()
+	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
+	fake.refreshAccessTokenArgsForCall = append(fake.refreshAccessTokenArgsForCall, struct {
+		refreshToken string
+	}{refreshToken})
+	fake.recordInvocation("RefreshAccessToken", []interface{}{refreshToken})
+	fake.refreshAccessTokenMutex.Unlock()
+	if fake.RefreshAccessTokenStub != nil {
+		return fake.RefreshAccessTokenStub(refreshToken)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.refreshAccessTokenReturns.result1, fake.refreshAccessTokenReturns.result2
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenCallCount() int {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return len(fake.refreshAccessTokenArgsForCall)
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenArgsForCall(i int) string {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return fake.refreshAccessTokenArgsForCall[i].refreshToken
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenReturns(result1 uaa.RefreshToken, result2 error) {
+	fake.RefreshAccessTokenStub = nil
+	fake.refreshAccessTokenReturns = struct {
+		result1 uaa.RefreshToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenReturnsOnCall(i int, result1 uaa.RefreshToken, result2 error) {
+	fake.RefreshAccessTokenStub = nil
+	if fake.refreshAccessTokenReturnsOnCall == nil {
+		fake.refreshAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 uaa.RefreshToken
+			result2 error
+		})
+	}
+	fake.refreshAccessTokenReturnsOnCall[i] = struct {
+		result1 uaa.RefreshToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RemoveGroupMember(groupID string, memberID string) error {
+	fake.removeGroupMemberMutex.Lock()
+	ret, specificReturn := fake.removeGroupMemberReturnsOnCall[len(fake.removeGroupMemberArgsForCall)]
+	fake.removeGroupMemberArgsForCall = append(fake.removeGroupMemberArgsForCall, struct {
+		groupID  string
+		memberID string
+	}{groupID, memberID})
+	fake.recordInvocation("RemoveGroupMember", []interface{}{groupID, memberID})
+	fake.removeGroupMemberMutex.Unlock()
+	if fake.RemoveGroupMemberStub != nil {
+		return fake.RemoveGroupMemberStub(groupID, memberID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removeGroupMemberReturns.result1
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberCallCount() int {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return len(fake.removeGroupMemberArgsForCall)
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberArgsForCall(i int) (string, string) {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return fake.removeGroupMemberArgsForCall[i].groupID, fake.removeGroupMemberArgsForCall[i].memberID
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberReturns(result1 error) {
+	fake.RemoveGroupMemberStub = nil
+	fake.removeGroupMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.RemoveGroupMemberStub = nil
+	if fake.removeGroupMemberReturnsOnCall == nil {
+		fake.removeGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeGroupMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.getGroupsMutex.RLock()
+	defer fake.getGroupsMutex.RUnlock()
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	fake.getUsersByUsernameMutex.RLock()
+	defer fake.getUsersByUsernameMutex.RUnlock()
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
 	return fake.invocations
 }
 
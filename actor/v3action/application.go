@@ -11,6 +11,11 @@ import (
 // Application represents a V3 actor application.
 type Application ccv3.Application
 
+// Started returns true when the application is started.
+func (app Application) Started() bool {
+	return app.State == "STARTED"
+}
+
 // ApplicationNotFoundError represents the error that occurs when the
 // application is not found.
 type ApplicationNotFoundError struct {
@@ -0,0 +1,90 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Build represents a V3 actor Build.
+type Build ccv3.Build
+
+// PackageNotFoundError is returned when an application has no package that is
+// ready to be staged.
+type PackageNotFoundError struct {
+	AppGUID string
+}
+
+func (e PackageNotFoundError) Error() string {
+	return fmt.Sprintf("Application %s has no package ready to stage", e.AppGUID)
+}
+
+// StagingFailedError is returned when a build finishes without producing a
+// droplet.
+type StagingFailedError struct {
+	BuildGUID string
+	Reason    string
+}
+
+func (e StagingFailedError) Error() string {
+	return fmt.Sprintf("Build %s failed to stage: %s", e.BuildGUID, e.Reason)
+}
+
+// StagingTimeoutError is returned when a build is still staging after the
+// overall polling timeout.
+type StagingTimeoutError struct {
+	BuildGUID string
+	Timeout   time.Duration
+}
+
+func (e StagingTimeoutError) Error() string {
+	return fmt.Sprintf("Build %s did not finish staging within %s", e.BuildGUID, e.Timeout)
+}
+
+// StageLatestApplicationPackage stages the newest ready package of the
+// application and waits for the droplet. The droplet does not become the
+// application's current droplet.
+func (actor Actor) StageLatestApplicationPackage(appGUID string) (Droplet, Warnings, error) {
+	packages, warnings, err := actor.CloudControllerClient.GetApplicationPackages(appGUID, url.Values{
+		"states":   []string{string(ccv3.PackageStateReady)},
+		"order_by": []string{"-created_at"},
+	})
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+	if len(packages) == 0 {
+		return Droplet{}, allWarnings, PackageNotFoundError{AppGUID: appGUID}
+	}
+
+	build, warnings, err := actor.CloudControllerClient.CreateBuild(ccv3.Build{PackageGUID: packages[0].GUID})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, allWarnings, err
+	}
+
+	startTime := time.Now()
+	for time.Now().Sub(startTime) < actor.Config.OverallPollingTimeout() {
+		build, warnings, err = actor.CloudControllerClient.GetBuild(build.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Droplet{}, allWarnings, err
+		}
+
+		switch build.State {
+		case ccv3.BuildStateStaged:
+			return Droplet{GUID: build.DropletGUID, State: ccv3.DropletStateStaged}, allWarnings, nil
+		case ccv3.BuildStateFailed:
+			return Droplet{}, allWarnings, StagingFailedError{BuildGUID: build.GUID, Reason: build.Error}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return Droplet{}, allWarnings, StagingTimeoutError{
+		BuildGUID: build.GUID,
+		Timeout:   actor.Config.OverallPollingTimeout(),
+	}
+}
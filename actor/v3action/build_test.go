@@ -0,0 +1,152 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+	})
+
+	Describe("StageLatestApplicationPackage", func() {
+		var (
+			droplet    Droplet
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationPackagesReturns(
+				[]ccv3.Package{{GUID: "newest-package-guid"}, {GUID: "older-package-guid"}},
+				ccv3.Warnings{"get-packages-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateBuildReturns(
+				ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateStaging},
+				ccv3.Warnings{"create-build-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetBuildReturns(
+				ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateStaging},
+				ccv3.Warnings{"get-build-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetBuildReturnsOnCall(1,
+				ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateStaged, DropletGUID: "some-droplet-guid"},
+				ccv3.Warnings{"get-build-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			droplet, warnings, executeErr = actor.StageLatestApplicationPackage("some-app-guid")
+		})
+
+		It("stages the newest ready package and returns the droplet", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(droplet).To(Equal(Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateStaged}))
+			Expect(warnings).To(ConsistOf("get-packages-warning", "create-build-warning", "get-build-warning", "get-build-warning"))
+
+			appGUID, query := fakeCloudControllerClient.GetApplicationPackagesArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(query).To(Equal(url.Values{
+				"states":   []string{"READY"},
+				"order_by": []string{"-created_at"},
+			}))
+
+			Expect(fakeCloudControllerClient.CreateBuildArgsForCall(0)).To(Equal(ccv3.Build{PackageGUID: "newest-package-guid"}))
+			Expect(fakeCloudControllerClient.GetBuildCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetBuildArgsForCall(0)).To(Equal("some-build-guid"))
+			Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(1))
+		})
+
+		Context("when the application has no ready package", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, nil)
+			})
+
+			It("returns a PackageNotFoundError", func() {
+				Expect(executeErr).To(MatchError(PackageNotFoundError{AppGUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+				Expect(fakeCloudControllerClient.CreateBuildCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the build fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create build failed")
+				fakeCloudControllerClient.CreateBuildReturns(ccv3.Build{}, ccv3.Warnings{"create-build-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-packages-warning", "create-build-warning"))
+			})
+		})
+
+		Context("when staging fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildReturnsOnCall(1,
+					ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateFailed, Error: "some staging error"},
+					ccv3.Warnings{"get-build-warning"},
+					nil,
+				)
+			})
+
+			It("returns a StagingFailedError", func() {
+				Expect(executeErr).To(MatchError(StagingFailedError{BuildGUID: "some-build-guid", Reason: "some staging error"}))
+			})
+		})
+
+		Context("when staging does not finish before the timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a StagingTimeoutError", func() {
+				Expect(executeErr).To(MatchError(StagingTimeoutError{BuildGUID: "some-build-guid"}))
+				Expect(fakeCloudControllerClient.GetBuildCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("SetApplicationDroplet", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.SetApplicationDropletReturns(
+				ccv3.Relationship{GUID: "some-droplet-guid"},
+				ccv3.Warnings{"set-droplet-warning"},
+				nil,
+			)
+		})
+
+		It("sets the current droplet of the application", func() {
+			warnings, err := actor.SetApplicationDroplet("some-app-guid", "some-droplet-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("set-droplet-warning"))
+
+			appGUID, dropletGUID := fakeCloudControllerClient.SetApplicationDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+		})
+	})
+})
@@ -13,21 +13,48 @@ type CloudControllerClient interface {
 	AssignSpaceToIsolationSegment(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	CloudControllerAPIVersion() string
 	CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
+	ContinueDeployment(guid string) (ccv3.Warnings, error)
+	CreateApplicationDeployment(deployment ccv3.Deployment) (ccv3.Deployment, ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
+	CreateBuild(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error)
 	CreateIsolationSegment(isolationSegment ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	CreatePackage(pkg ccv3.Package) (ccv3.Package, ccv3.Warnings, error)
+	DeleteDroplet(guid string) (ccv3.Warnings, error)
 	DeleteIsolationSegment(guid string) (ccv3.Warnings, error)
+	DeletePackage(guid string) (ccv3.Warnings, error)
 	EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	GetApplicationCurrentDroplet(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	GetApplicationEnvironment(appGUID string) (ccv3.Environment, ccv3.Warnings, error)
+	GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
+	GetApplicationPackages(appGUID string, query url.Values) ([]ccv3.Package, ccv3.Warnings, error)
+	GetApplicationProcesses(appGUID string) ([]ccv3.Process, ccv3.Warnings, error)
+	GetApplicationRevisions(appGUID string, query url.Values) ([]ccv3.Revision, ccv3.Warnings, error)
 	GetApplications(query url.Values) ([]ccv3.Application, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
+	GetBuild(guid string) (ccv3.Build, ccv3.Warnings, error)
+	GetDeployment(guid string) (ccv3.Deployment, ccv3.Warnings, error)
+	GetDeployments(query url.Values) ([]ccv3.Deployment, ccv3.Warnings, error)
 	GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	GetIsolationSegmentOrganizationsByIsolationSegment(isolationSegmentGUID string) ([]ccv3.Organization, ccv3.Warnings, error)
 	GetIsolationSegments(query url.Values) ([]ccv3.IsolationSegment, ccv3.Warnings, error)
+	GetJob(guid string) (ccv3.Job, ccv3.Warnings, error)
 	GetOrganizationDefaultIsolationSegment(orgGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	GetOrganizations(query url.Values) ([]ccv3.Organization, ccv3.Warnings, error)
 	GetPackage(guid string) (ccv3.Package, ccv3.Warnings, error)
+	GetRevisionEnvironmentVariables(revision ccv3.Revision) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	GetServiceInstances(query url.Values) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
+	GetServicePlan(guid string) (ccv3.ServicePlan, ccv3.Warnings, error)
 	GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	GetSpaceManifestDiff(spaceGUID string, rawManifest []byte) (ccv3.ManifestDiff, ccv3.Warnings, error)
+	GetTask(guid string) (ccv3.Task, ccv3.Warnings, error)
 	RevokeIsolationSegmentFromOrganization(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error)
+	SetApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	UpdateApplicationEnvironmentVariables(appGUID string, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	UpdateApplicationMetadata(appGUID string, metadata ccv3.Metadata) (ccv3.Application, ccv3.Warnings, error)
+	UpdateProcess(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
+	UpdateRouteSpaceRelationship(routeGUID string, spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	UpdateServiceInstanceMaintenanceInfo(guid string, maintenanceInfo ccv3.MaintenanceInfo) (ccv3.Job, ccv3.Warnings, error)
+	UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (ccv3.Job, ccv3.Warnings, error)
 	UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
 }
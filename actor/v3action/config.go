@@ -5,5 +5,6 @@ import "time"
 //go:generate counterfeiter . Config
 
 type Config interface {
+	OverallPollingTimeout() time.Duration
 	PollingInterval() time.Duration
 }
@@ -0,0 +1,175 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Deployment represents a V3 actor deployment.
+type Deployment ccv3.Deployment
+
+// Paused returns true when a canary deployment is waiting to be continued.
+func (deployment Deployment) Paused() bool {
+	return deployment.StatusValue == ccv3.DeploymentStatusValueActive &&
+		deployment.StatusReason == ccv3.DeploymentStatusReasonPaused
+}
+
+// ApplicationNotStagedError is returned when the application has no current
+// droplet to deploy.
+type ApplicationNotStagedError struct {
+	AppName string
+}
+
+func (e ApplicationNotStagedError) Error() string {
+	return fmt.Sprintf("Application %s has no current droplet", e.AppName)
+}
+
+// DeploymentNotDeployedError is returned when a deployment finished without
+// replacing all instances, because it was canceled or superseded by another
+// deployment.
+type DeploymentNotDeployedError struct {
+	DeploymentGUID string
+	Reason         string
+}
+
+func (e DeploymentNotDeployedError) Error() string {
+	return fmt.Sprintf("Deployment %s finished as %s", e.DeploymentGUID, e.Reason)
+}
+
+// DeploymentNotFoundError is returned when the application has no active
+// deployment.
+type DeploymentNotFoundError struct {
+	AppName string
+}
+
+func (e DeploymentNotFoundError) Error() string {
+	return fmt.Sprintf("Application %s has no active deployment", e.AppName)
+}
+
+// DeploymentNotPausedError is returned when continuing a deployment that is
+// not waiting to be continued.
+type DeploymentNotPausedError struct {
+	AppName string
+}
+
+func (e DeploymentNotPausedError) Error() string {
+	return fmt.Sprintf("The deployment of application %s is not paused", e.AppName)
+}
+
+// DeploymentTimeoutError is returned when a deployment is still active after
+// the overall polling timeout.
+type DeploymentTimeoutError struct {
+	DeploymentGUID string
+	Timeout        time.Duration
+}
+
+func (e DeploymentTimeoutError) Error() string {
+	return fmt.Sprintf("Deployment %s did not finish within %s", e.DeploymentGUID, e.Timeout)
+}
+
+// CreateDeploymentByApplicationNameAndSpace starts a deployment of the current
+// droplet of the application with the given strategy, replacing its instances
+// maxInFlight at a time. A maxInFlight of zero uses the Cloud Controller
+// default.
+func (actor Actor) CreateDeploymentByApplicationNameAndSpace(appName string, spaceGUID string, strategy string, maxInFlight int) (Deployment, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Deployment{}, allWarnings, err
+	}
+
+	droplet, warnings, err := actor.CloudControllerClient.GetApplicationCurrentDroplet(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Deployment{}, allWarnings, ApplicationNotStagedError{AppName: appName}
+	}
+	if err != nil {
+		return Deployment{}, allWarnings, err
+	}
+
+	deployment, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(ccv3.Deployment{
+		AppGUID:     app.GUID,
+		DropletGUID: droplet.GUID,
+		Strategy:    ccv3.DeploymentStrategy(strategy),
+		MaxInFlight: maxInFlight,
+	})
+	allWarnings = append(allWarnings, warnings...)
+
+	return Deployment(deployment), allWarnings, err
+}
+
+// ContinueDeploymentByApplicationNameAndSpace continues the paused canary
+// deployment of the application, so the remaining instances are replaced.
+func (actor Actor) ContinueDeploymentByApplicationNameAndSpace(appName string, spaceGUID string) (Deployment, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Deployment{}, allWarnings, err
+	}
+
+	deployments, warnings, err := actor.CloudControllerClient.GetDeployments(url.Values{
+		ccv3.AppGUIDFilter:     []string{app.GUID},
+		ccv3.StatusValueFilter: []string{string(ccv3.DeploymentStatusValueActive)},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Deployment{}, allWarnings, err
+	}
+
+	if len(deployments) == 0 {
+		return Deployment{}, allWarnings, DeploymentNotFoundError{AppName: appName}
+	}
+
+	deployment := Deployment(deployments[0])
+	if !deployment.Paused() {
+		return Deployment{}, allWarnings, DeploymentNotPausedError{AppName: appName}
+	}
+
+	warnings, err = actor.CloudControllerClient.ContinueDeployment(deployment.GUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return deployment, allWarnings, err
+}
+
+// PollDeployment polls the provided deployment until it has finished, it is
+// paused waiting to be continued, or the overall polling timeout has been
+// reached. The last state of the deployment is returned. An error is returned
+// when the deployment finished without replacing all instances.
+func (actor Actor) PollDeployment(deployment Deployment) (Deployment, Warnings, error) {
+	var allWarnings Warnings
+	guid := deployment.GUID
+
+	startTime := time.Now()
+	for time.Now().Sub(startTime) < actor.Config.OverallPollingTimeout() {
+		ccDeployment, warnings, err := actor.CloudControllerClient.GetDeployment(guid)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return deployment, allWarnings, err
+		}
+		deployment = Deployment(ccDeployment)
+
+		if deployment.Paused() {
+			return deployment, allWarnings, nil
+		}
+
+		if deployment.StatusValue == ccv3.DeploymentStatusValueFinalized {
+			if deployment.StatusReason != ccv3.DeploymentStatusReasonDeployed {
+				return deployment, allWarnings, DeploymentNotDeployedError{
+					DeploymentGUID: guid,
+					Reason:         strings.ToLower(string(deployment.StatusReason)),
+				}
+			}
+			return deployment, allWarnings, nil
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return deployment, allWarnings, DeploymentTimeoutError{
+		DeploymentGUID: guid,
+		Timeout:        actor.Config.OverallPollingTimeout(),
+	}
+}
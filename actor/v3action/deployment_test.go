@@ -0,0 +1,342 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+	})
+
+	Describe("CreateDeploymentByApplicationNameAndSpace", func() {
+		var (
+			deployment Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.CreateDeploymentByApplicationNameAndSpace("some-app-name", "some-space-guid", "canary", 2)
+		})
+
+		Context("when the app has a current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationCurrentDropletReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid"},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+					ccv3.Deployment{GUID: "some-deployment-guid"},
+					ccv3.Warnings{"create-deployment-warning"},
+					nil,
+				)
+			})
+
+			It("deploys the current droplet with the strategy", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-droplet-warning", "create-deployment-warning"))
+				Expect(deployment).To(Equal(Deployment{GUID: "some-deployment-guid"}))
+
+				Expect(fakeCloudControllerClient.GetApplicationCurrentDropletArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)).To(Equal(ccv3.Deployment{
+					AppGUID:     "some-app-guid",
+					DropletGUID: "some-droplet-guid",
+					Strategy:    ccv3.DeploymentStrategyCanary,
+					MaxInFlight: 2,
+				}))
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationCurrentDropletReturns(
+					ccv3.Droplet{},
+					ccv3.Warnings{"get-droplet-warning"},
+					ccerror.ResourceNotFoundError{},
+				)
+			})
+
+			It("returns an ApplicationNotStagedError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotStagedError{AppName: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-droplet-warning"))
+				Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					nil,
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+
+		Context("when creating the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create deployment error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-app-guid"}},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+					ccv3.Deployment{},
+					ccv3.Warnings{"create-deployment-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-deployment-warning"))
+			})
+		})
+	})
+
+	Describe("ContinueDeploymentByApplicationNameAndSpace", func() {
+		var (
+			deployment Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.ContinueDeploymentByApplicationNameAndSpace("some-app-name", "some-space-guid")
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+		})
+
+		Context("when the app has a paused deployment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(
+					[]ccv3.Deployment{{
+						GUID:         "some-deployment-guid",
+						StatusValue:  ccv3.DeploymentStatusValueActive,
+						StatusReason: ccv3.DeploymentStatusReasonPaused,
+					}},
+					ccv3.Warnings{"get-deployments-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.ContinueDeploymentReturns(ccv3.Warnings{"continue-deployment-warning"}, nil)
+			})
+
+			It("continues the deployment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-deployments-warning", "continue-deployment-warning"))
+				Expect(deployment.GUID).To(Equal("some-deployment-guid"))
+
+				Expect(fakeCloudControllerClient.GetDeploymentsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetDeploymentsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.AppGUIDFilter:     []string{"some-app-guid"},
+					ccv3.StatusValueFilter: []string{"ACTIVE"},
+				}))
+
+				Expect(fakeCloudControllerClient.ContinueDeploymentCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.ContinueDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when the app has no active deployment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"get-deployments-warning"}, nil)
+			})
+
+			It("returns a DeploymentNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(DeploymentNotFoundError{AppName: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-deployments-warning"))
+				Expect(fakeCloudControllerClient.ContinueDeploymentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the active deployment is not paused", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(
+					[]ccv3.Deployment{{
+						GUID:         "some-deployment-guid",
+						StatusValue:  ccv3.DeploymentStatusValueActive,
+						StatusReason: ccv3.DeploymentStatusReasonDeploying,
+					}},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a DeploymentNotPausedError", func() {
+				Expect(executeErr).To(MatchError(DeploymentNotPausedError{AppName: "some-app-name"}))
+				Expect(fakeCloudControllerClient.ContinueDeploymentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when continuing the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("continue deployment error")
+				fakeCloudControllerClient.GetDeploymentsReturns(
+					[]ccv3.Deployment{{
+						StatusValue:  ccv3.DeploymentStatusValueActive,
+						StatusReason: ccv3.DeploymentStatusReasonPaused,
+					}},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.ContinueDeploymentReturns(ccv3.Warnings{"continue-deployment-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "continue-deployment-warning"))
+			})
+		})
+	})
+
+	Describe("PollDeployment", func() {
+		var (
+			deployment Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.PollDeployment(Deployment{GUID: "some-deployment-guid"})
+		})
+
+		Context("when the deployment finishes with all instances replaced", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0,
+					ccv3.Deployment{StatusValue: ccv3.DeploymentStatusValueActive, StatusReason: ccv3.DeploymentStatusReasonDeploying},
+					ccv3.Warnings{"get-deployment-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1,
+					ccv3.Deployment{StatusValue: ccv3.DeploymentStatusValueFinalized, StatusReason: ccv3.DeploymentStatusReasonDeployed},
+					ccv3.Warnings{"get-deployment-warning-2"},
+					nil,
+				)
+			})
+
+			It("polls until the deployment is finalized", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(deployment.StatusReason).To(Equal(ccv3.DeploymentStatusReasonDeployed))
+				Expect(warnings).To(ConsistOf("get-deployment-warning-1", "get-deployment-warning-2"))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when a canary deployment is paused", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{
+						GUID:         "some-deployment-guid",
+						StatusValue:  ccv3.DeploymentStatusValueActive,
+						StatusReason: ccv3.DeploymentStatusReasonPaused,
+					},
+					ccv3.Warnings{"get-deployment-warning"},
+					nil,
+				)
+			})
+
+			It("stops polling and returns the paused deployment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-deployment-warning"))
+				Expect(deployment.Paused()).To(BeTrue())
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{StatusValue: ccv3.DeploymentStatusValueFinalized, StatusReason: ccv3.DeploymentStatusReasonCanceled},
+					ccv3.Warnings{"get-deployment-warning"},
+					nil,
+				)
+			})
+
+			It("returns a DeploymentNotDeployedError and all warnings", func() {
+				Expect(executeErr).To(MatchError(DeploymentNotDeployedError{
+					DeploymentGUID: "some-deployment-guid",
+					Reason:         "canceled",
+				}))
+				Expect(warnings).To(ConsistOf("get-deployment-warning"))
+			})
+		})
+
+		Context("when getting the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get deployment error")
+				fakeCloudControllerClient.GetDeploymentReturns(
+					ccv3.Deployment{},
+					ccv3.Warnings{"get-deployment-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-deployment-warning"))
+			})
+		})
+
+		Context("when the deployment does not finish before the timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a DeploymentTimeoutError", func() {
+				Expect(executeErr).To(MatchError(DeploymentTimeoutError{DeploymentGUID: "some-deployment-guid"}))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
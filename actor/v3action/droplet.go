@@ -0,0 +1,13 @@
+package v3action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+// Droplet represents a V3 actor droplet.
+type Droplet ccv3.Droplet
+
+// SetApplicationDroplet makes the droplet the one the application runs the
+// next time it is started.
+func (actor Actor) SetApplicationDroplet(appGUID string, dropletGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.SetApplicationDroplet(appGUID, dropletGUID)
+	return Warnings(warnings), err
+}
@@ -0,0 +1,42 @@
+package v3action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+// EnvironmentVariablePair represents an environment variable and its value.
+type EnvironmentVariablePair struct {
+	Key   string
+	Value string
+}
+
+// SetEnvironmentVariableByApplicationNameAndSpace sets the provided
+// environment variable on the application. Only that variable is sent, so
+// concurrent changes to the application's other variables are not lost.
+func (actor Actor) SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair EnvironmentVariablePair) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	value := envPair.Value
+	_, apiWarnings, err := actor.CloudControllerClient.UpdateApplicationEnvironmentVariables(app.GUID, ccv3.EnvironmentVariables{
+		envPair.Key: &value,
+	})
+	warnings = append(warnings, apiWarnings...)
+	return warnings, err
+}
+
+// Environment represents the full environment of an application.
+type Environment ccv3.Environment
+
+// GetEnvironmentByApplicationNameAndSpace returns the full environment of the
+// application, including the system-provided VCAP_SERVICES.
+func (actor Actor) GetEnvironmentByApplicationNameAndSpace(appName string, spaceGUID string) (Environment, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Environment{}, warnings, err
+	}
+
+	environment, apiWarnings, err := actor.CloudControllerClient.GetApplicationEnvironment(app.GUID)
+	warnings = append(warnings, apiWarnings...)
+	return Environment(environment), warnings, err
+}
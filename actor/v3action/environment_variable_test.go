@@ -0,0 +1,124 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variable Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("SetEnvironmentVariableByApplicationNameAndSpace", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.SetEnvironmentVariableByApplicationNameAndSpace("some-app", "some-space-guid", EnvironmentVariablePair{
+				Key:   "some-key",
+				Value: "some-value",
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+				fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"set-env-warning"}, nil)
+			})
+
+			It("sends only the provided variable", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "set-env-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+				appGUID, envVars := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(envVars).To(HaveLen(1))
+				Expect(*envVars["some-key"]).To(Equal("some-value"))
+			})
+
+			Context("when updating the variables fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("set-env-error")
+					fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"set-env-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-app-warning", "set-env-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("GetEnvironmentByApplicationNameAndSpace", func() {
+		var (
+			environment Environment
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			environment, warnings, executeErr = actor.GetEnvironmentByApplicationNameAndSpace("some-app", "some-space-guid")
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+					ccv3.Environment{EnvironmentVariables: map[string]interface{}{"SOME_VAR": "some-value"}},
+					ccv3.Warnings{"get-env-warning"},
+					nil,
+				)
+			})
+
+			It("returns the environment and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-env-warning"))
+				Expect(environment).To(Equal(Environment{EnvironmentVariables: map[string]interface{}{"SOME_VAR": "some-value"}}))
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+	})
+})
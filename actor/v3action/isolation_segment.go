@@ -16,6 +16,16 @@ type IsolationSegmentSummary struct {
 // IsolationSegment represents a V3 actor IsolationSegment.
 type IsolationSegment ccv3.IsolationSegment
 
+// EffectiveIsolationSegment is the isolation segment that a space's apps run
+// in.
+type EffectiveIsolationSegment struct {
+	IsolationSegment
+
+	// Inherited is true when the space has no isolation segment of its own and
+	// the organization's default isolation segment is used instead.
+	Inherited bool
+}
+
 // IsolationSegmentNotFoundError represents the error that occurs when the
 // isolation segment is not found.
 type IsolationSegmentNotFoundError struct {
@@ -42,33 +52,61 @@ func (e IsolationSegmentAlreadyExistsError) Error() string {
 // If the space has its own isolation segment, that will be returned.
 //
 // If the space does not have one, the organization's default isolation segment
-// (GUID passed in) will be returned.
+// (GUID passed in) will be returned and marked as inherited.
 //
 // If the space does not have one and the passed in organization default
 // isolation segment GUID is empty, a NoRelationshipError will be returned.
-func (actor Actor) GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (IsolationSegment, Warnings, error) {
+func (actor Actor) GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (EffectiveIsolationSegment, Warnings, error) {
 	relationship, warnings, err := actor.CloudControllerClient.GetSpaceIsolationSegment(spaceGUID)
 	allWarnings := append(Warnings{}, warnings...)
 	if err != nil {
-		return IsolationSegment{}, allWarnings, err
+		return EffectiveIsolationSegment{}, allWarnings, err
 	}
 
 	effectiveGUID := relationship.GUID
+	inherited := false
 	if effectiveGUID == "" {
 		if orgDefaultIsolationSegmentGUID != "" {
 			effectiveGUID = orgDefaultIsolationSegmentGUID
+			inherited = true
 		} else {
-			return IsolationSegment{}, allWarnings, NoRelationshipError{}
+			return EffectiveIsolationSegment{}, allWarnings, NoRelationshipError{}
 		}
 	}
 
 	isolationSegment, warnings, err := actor.CloudControllerClient.GetIsolationSegment(effectiveGUID)
 	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return EffectiveIsolationSegment{}, allWarnings, err
+	}
+
+	return EffectiveIsolationSegment{
+		IsolationSegment: IsolationSegment(isolationSegment),
+		Inherited:        inherited,
+	}, allWarnings, err
+}
+
+// GetOrganizationDefaultIsolationSegment returns the default isolation segment
+// of the organization. If the organization does not have one, a
+// NoRelationshipError will be returned.
+func (actor Actor) GetOrganizationDefaultIsolationSegment(orgGUID string) (IsolationSegment, Warnings, error) {
+	relationship, warnings, err := actor.CloudControllerClient.GetOrganizationDefaultIsolationSegment(orgGUID)
+	allWarnings := append(Warnings{}, warnings...)
+	if err != nil {
+		return IsolationSegment{}, allWarnings, err
+	}
+
+	if relationship.GUID == "" {
+		return IsolationSegment{}, allWarnings, NoRelationshipError{}
+	}
+
+	isolationSegment, warnings, err := actor.CloudControllerClient.GetIsolationSegment(relationship.GUID)
+	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
 		return IsolationSegment{}, allWarnings, err
 	}
 
-	return IsolationSegment(isolationSegment), allWarnings, err
+	return IsolationSegment(isolationSegment), allWarnings, nil
 }
 
 // CreateIsolationSegmentByName creates a given isolation segment.
@@ -323,7 +323,10 @@ var _ = Describe("Isolation Segment Actions", func() {
 					isolationSegment, warnings, err := actor.GetEffectiveIsolationSegmentBySpace("some-space-guid", "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(warnings).To(ConsistOf("I r warnings", "I are two warnings", "iso-warnings-1", "iso-warnings-2"))
-					Expect(isolationSegment).To(Equal(IsolationSegment{Name: "some-iso"}))
+					Expect(isolationSegment).To(Equal(EffectiveIsolationSegment{
+						IsolationSegment: IsolationSegment{Name: "some-iso"},
+						Inherited:        false,
+					}))
 
 					Expect(fakeCloudControllerClient.GetSpaceIsolationSegmentCallCount()).To(Equal(1))
 					Expect(fakeCloudControllerClient.GetSpaceIsolationSegmentArgsForCall(0)).To(Equal("some-space-guid"))
@@ -377,11 +380,14 @@ var _ = Describe("Isolation Segment Actions", func() {
 								nil)
 						})
 
-						It("returns the org's default isolation segment", func() {
+						It("returns the org's default isolation segment as inherited", func() {
 							isolationSegment, warnings, err := actor.GetEffectiveIsolationSegmentBySpace("some-space-guid", "some-org-default-isolation-segment-guid")
-							Expect(isolationSegment).To(Equal(IsolationSegment{
-								Name: "some-iso-segment",
-								GUID: "some-org-default-isolation-segment-guid",
+							Expect(isolationSegment).To(Equal(EffectiveIsolationSegment{
+								IsolationSegment: IsolationSegment{
+									Name: "some-iso-segment",
+									GUID: "some-org-default-isolation-segment-guid",
+								},
+								Inherited: true,
 							}))
 							Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3", "warning-4"))
 							Expect(err).ToNot(HaveOccurred())
@@ -409,6 +415,78 @@ var _ = Describe("Isolation Segment Actions", func() {
 		})
 	})
 
+	Describe("GetOrganizationDefaultIsolationSegment", func() {
+		Context("when the organization has a default isolation segment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentReturns(ccv3.Relationship{
+					GUID: "some-iso-guid",
+				}, ccv3.Warnings{"warning-1", "warning-2"}, nil)
+			})
+
+			Context("when retrieving the isolation segment succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetIsolationSegmentReturns(ccv3.IsolationSegment{
+						GUID: "some-iso-guid",
+						Name: "some-iso",
+					}, ccv3.Warnings{"warning-3"}, nil)
+				})
+
+				It("returns the isolation segment and warnings", func() {
+					isolationSegment, warnings, err := actor.GetOrganizationDefaultIsolationSegment("some-org-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+					Expect(isolationSegment).To(Equal(IsolationSegment{GUID: "some-iso-guid", Name: "some-iso"}))
+
+					Expect(fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentArgsForCall(0)).To(Equal("some-org-guid"))
+					Expect(fakeCloudControllerClient.GetIsolationSegmentArgsForCall(0)).To(Equal("some-iso-guid"))
+				})
+			})
+
+			Context("when retrieving the isolation segment errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("foo bar")
+					fakeCloudControllerClient.GetIsolationSegmentReturns(ccv3.IsolationSegment{}, ccv3.Warnings{"warning-3"}, expectedErr)
+				})
+
+				It("returns the warnings and error", func() {
+					_, warnings, err := actor.GetOrganizationDefaultIsolationSegment("some-org-guid")
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+				})
+			})
+		})
+
+		Context("when the organization does not have a default isolation segment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentReturns(ccv3.Relationship{}, ccv3.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns a NoRelationshipError", func() {
+				_, warnings, err := actor.GetOrganizationDefaultIsolationSegment("some-org-guid")
+				Expect(err).To(MatchError(NoRelationshipError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeCloudControllerClient.GetIsolationSegmentCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when retrieving the relationship errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("foo bar")
+				fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentReturns(ccv3.Relationship{}, ccv3.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the warnings and error", func() {
+				_, warnings, err := actor.GetOrganizationDefaultIsolationSegment("some-org-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetIsolationSegmentByName", func() {
 		Context("when the isolation segment exists", func() {
 			BeforeEach(func() {
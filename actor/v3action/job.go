@@ -0,0 +1,77 @@
+package v3action
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Job represents a V3 actor Job.
+type Job ccv3.Job
+
+// JobNotFoundError is returned when a job cannot be found.
+type JobNotFoundError struct {
+	GUID string
+}
+
+func (e JobNotFoundError) Error() string {
+	return fmt.Sprintf("Job with GUID '%s' not found.", e.GUID)
+}
+
+// ErrorMessage returns the details of all the errors reported by the job.
+func (job Job) ErrorMessage() string {
+	var details []string
+	for _, jobErr := range job.Errors {
+		details = append(details, jobErr.Detail)
+	}
+	return strings.Join(details, "; ")
+}
+
+// GetJob returns the current state of the job associated with the provided
+// GUID.
+func (actor Actor) GetJob(guid string) (Job, Warnings, error) {
+	job, warnings, err := actor.CloudControllerClient.GetJob(guid)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Job{}, Warnings(warnings), JobNotFoundError{GUID: guid}
+	}
+
+	return Job(job), Warnings(warnings), err
+}
+
+// PollJob polls the provided job until it has completed, failed, or the
+// overall polling timeout has been reached. The final state of the job is
+// returned.
+func (actor Actor) PollJob(job Job) (Job, Warnings, error) {
+	var allWarnings Warnings
+
+	startTime := time.Now()
+	for time.Now().Sub(startTime) < actor.Config.OverallPollingTimeout() {
+		ccJob, warnings, err := actor.CloudControllerClient.GetJob(job.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Job{}, allWarnings, err
+		}
+		job = Job(ccJob)
+
+		if ccJob.Failed() {
+			return job, allWarnings, ccerror.JobFailedError{
+				JobGUID: job.GUID,
+				Message: job.ErrorMessage(),
+			}
+		}
+
+		if ccJob.Complete() {
+			return job, allWarnings, nil
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return job, allWarnings, ccerror.JobTimeoutError{
+		JobGUID: job.GUID,
+		Timeout: actor.Config.OverallPollingTimeout(),
+	}
+}
@@ -0,0 +1,151 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Job Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+	})
+
+	Describe("GetJob", func() {
+		Context("when the job exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(
+					ccv3.Job{GUID: "some-job-guid", State: ccv3.JobStateProcessing},
+					ccv3.Warnings{"get-job-warning"},
+					nil,
+				)
+			})
+
+			It("returns the job and all warnings", func() {
+				job, warnings, err := actor.GetJob("some-job-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(job).To(Equal(Job{GUID: "some-job-guid", State: ccv3.JobStateProcessing}))
+				Expect(warnings).To(ConsistOf("get-job-warning"))
+
+				Expect(fakeCloudControllerClient.GetJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(
+					ccv3.Job{},
+					ccv3.Warnings{"get-job-warning"},
+					ccerror.ResourceNotFoundError{},
+				)
+			})
+
+			It("returns a JobNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetJob("some-job-guid")
+				Expect(err).To(MatchError(JobNotFoundError{GUID: "some-job-guid"}))
+				Expect(warnings).To(ConsistOf("get-job-warning"))
+			})
+		})
+	})
+
+	Describe("PollJob", func() {
+		Context("when the job completes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturnsOnCall(0,
+					ccv3.Job{GUID: "some-job-guid", State: ccv3.JobStateProcessing},
+					ccv3.Warnings{"get-job-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetJobReturnsOnCall(1,
+					ccv3.Job{GUID: "some-job-guid", State: ccv3.JobStateComplete},
+					ccv3.Warnings{"get-job-warning-2"},
+					nil,
+				)
+			})
+
+			It("polls until the job is complete and returns all warnings", func() {
+				job, warnings, err := actor.PollJob(Job{GUID: "some-job-guid"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(job).To(Equal(Job{GUID: "some-job-guid", State: ccv3.JobStateComplete}))
+				Expect(warnings).To(ConsistOf("get-job-warning-1", "get-job-warning-2"))
+
+				Expect(fakeCloudControllerClient.GetJobCallCount()).To(Equal(2))
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(
+					ccv3.Job{
+						GUID:  "some-job-guid",
+						State: ccv3.JobStateFailed,
+						Errors: []ccv3.JobError{
+							{Detail: "some-error"},
+							{Detail: "some-other-error"},
+						},
+					},
+					ccv3.Warnings{"get-job-warning"},
+					nil,
+				)
+			})
+
+			It("returns a JobFailedError and all warnings", func() {
+				_, warnings, err := actor.PollJob(Job{GUID: "some-job-guid"})
+				Expect(err).To(MatchError(ccerror.JobFailedError{
+					JobGUID: "some-job-guid",
+					Message: "some-error; some-other-error",
+				}))
+				Expect(warnings).To(ConsistOf("get-job-warning"))
+			})
+		})
+
+		Context("when the polling timeout is reached", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a JobTimeoutError", func() {
+				_, _, err := actor.PollJob(Job{GUID: "some-job-guid"})
+				Expect(err).To(MatchError(ccerror.JobTimeoutError{JobGUID: "some-job-guid"}))
+				Expect(fakeCloudControllerClient.GetJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetJobReturns(
+					ccv3.Job{},
+					ccv3.Warnings{"get-job-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.PollJob(Job{GUID: "some-job-guid"})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-job-warning"))
+			})
+		})
+	})
+})
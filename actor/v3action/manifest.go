@@ -0,0 +1,130 @@
+package v3action
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ManifestChange is a single change applying a manifest would make to an
+// application. Field is the path of the changed field relative to the
+// application, such as "instances" or "env/DATABASE_URL".
+type ManifestChange struct {
+	Op    ccv3.ManifestDiffOperation
+	Field string
+	Was   string
+	Value string
+}
+
+// ApplicationManifestDiff is the list of changes applying a manifest would
+// make to a single application. AppName is empty for changes that are not
+// specific to an application.
+type ApplicationManifestDiff struct {
+	AppName string
+	Changes []ManifestChange
+}
+
+// GetSpaceManifestDiff returns the changes applying the raw manifest to the
+// space would make, grouped by application in manifest order.
+func (actor Actor) GetSpaceManifestDiff(spaceGUID string, rawManifest []byte) ([]ApplicationManifestDiff, Warnings, error) {
+	var manifest struct {
+		Applications []struct {
+			Name string `yaml:"name"`
+		} `yaml:"applications"`
+	}
+	err := yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diff, warnings, err := actor.CloudControllerClient.GetSpaceManifestDiff(spaceGUID, rawManifest)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var appIndexes []int
+	changesByApp := map[int][]ManifestChange{}
+	for _, entry := range diff.Diff {
+		appIndex, field := splitManifestDiffPath(entry.Path)
+		if _, ok := changesByApp[appIndex]; !ok {
+			appIndexes = append(appIndexes, appIndex)
+		}
+
+		changesByApp[appIndex] = append(changesByApp[appIndex], ManifestChange{
+			Op:    entry.Op,
+			Field: field,
+			Was:   formatManifestValue(entry.Was),
+			Value: formatManifestValue(entry.Value),
+		})
+	}
+
+	// Changes that are not specific to an application (index -1) come first.
+	sort.Ints(appIndexes)
+
+	var appDiffs []ApplicationManifestDiff
+	for _, appIndex := range appIndexes {
+		var appName string
+		if appIndex >= 0 && appIndex < len(manifest.Applications) {
+			appName = manifest.Applications[appIndex].Name
+		}
+		appDiffs = append(appDiffs, ApplicationManifestDiff{
+			AppName: appName,
+			Changes: changesByApp[appIndex],
+		})
+	}
+
+	return appDiffs, Warnings(warnings), nil
+}
+
+// ApplyApplicationManifest applies the raw manifest to the apps in the space
+// and waits for the Cloud Controller to finish applying it.
+func (actor Actor) ApplyApplicationManifest(spaceGUID string, rawManifest []byte) (Warnings, error) {
+	job, warnings, err := actor.CloudControllerClient.UpdateSpaceApplyManifest(spaceGUID, rawManifest)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, pollWarnings, err := actor.PollJob(Job(job))
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
+// splitManifestDiffPath splits a JSON pointer such as
+// "/applications/0/env/FOO" into the application index and the field path
+// within the application. Paths outside of an application have index -1.
+func splitManifestDiffPath(path string) (int, string) {
+	var parts []string
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		parts = append(parts, strings.NewReplacer("~1", "/", "~0", "~").Replace(part))
+	}
+
+	if len(parts) >= 2 && parts[0] == "applications" {
+		if index, err := strconv.Atoi(parts[1]); err == nil {
+			return index, strings.Join(parts[2:], "/")
+		}
+	}
+	return -1, strings.Join(parts, "/")
+}
+
+func formatManifestValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(raw)
+	}
+}
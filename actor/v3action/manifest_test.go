@@ -0,0 +1,182 @@
+package v3action_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+		rawManifest               []byte
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+		rawManifest = []byte("applications:\n- name: app-1\n- name: app-2\n")
+	})
+
+	Describe("GetSpaceManifestDiff", func() {
+		var (
+			appDiffs   []ApplicationManifestDiff
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			appDiffs, warnings, executeErr = actor.GetSpaceManifestDiff("some-space-guid", rawManifest)
+		})
+
+		Context("when the diff is returned", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceManifestDiffReturns(
+					ccv3.ManifestDiff{Diff: []ccv3.ManifestDiffEntry{
+						{Op: ccv3.ManifestDiffReplace, Path: "/applications/1/instances", Was: json.Number("1"), Value: json.Number("3")},
+						{Op: ccv3.ManifestDiffAdd, Path: "/applications/0/env/FOO", Value: "bar"},
+						{Op: ccv3.ManifestDiffRemove, Path: "/applications/1/routes", Was: []interface{}{map[string]interface{}{"route": "app-2.example.com"}}},
+						{Op: ccv3.ManifestDiffReplace, Path: "/version", Was: json.Number("1"), Value: json.Number("2")},
+						{Op: ccv3.ManifestDiffAdd, Path: "/applications/0/metadata/labels/team~1name", Value: "a~team"},
+					}},
+					ccv3.Warnings{"diff-warning"},
+					nil,
+				)
+			})
+
+			It("groups the changes by application in manifest order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("diff-warning"))
+				Expect(appDiffs).To(Equal([]ApplicationManifestDiff{
+					{
+						Changes: []ManifestChange{
+							{Op: ccv3.ManifestDiffReplace, Field: "version", Was: "1", Value: "2"},
+						},
+					},
+					{
+						AppName: "app-1",
+						Changes: []ManifestChange{
+							{Op: ccv3.ManifestDiffAdd, Field: "env/FOO", Value: "bar"},
+							{Op: ccv3.ManifestDiffAdd, Field: "metadata/labels/team/name", Value: "a~team"},
+						},
+					},
+					{
+						AppName: "app-2",
+						Changes: []ManifestChange{
+							{Op: ccv3.ManifestDiffReplace, Field: "instances", Was: "1", Value: "3"},
+							{Op: ccv3.ManifestDiffRemove, Field: "routes", Was: `[{"route":"app-2.example.com"}]`},
+						},
+					},
+				}))
+
+				spaceGUID, manifest := fakeCloudControllerClient.GetSpaceManifestDiffArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(manifest).To(Equal(rawManifest))
+			})
+		})
+
+		Context("when the manifest is not valid YAML", func() {
+			BeforeEach(func() {
+				rawManifest = []byte("applications: [")
+			})
+
+			It("returns the error without requesting the diff", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetSpaceManifestDiffCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the diff fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("diff failed")
+				fakeCloudControllerClient.GetSpaceManifestDiffReturns(ccv3.ManifestDiff{}, ccv3.Warnings{"diff-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("diff-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyApplicationManifest", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyApplicationManifest("some-space-guid", rawManifest)
+		})
+
+		Context("when the manifest is applied", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateSpaceApplyManifestReturns(
+					ccv3.Job{GUID: "some-job-guid"},
+					ccv3.Warnings{"apply-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetJobReturns(
+					ccv3.Job{GUID: "some-job-guid", State: ccv3.JobStateComplete},
+					ccv3.Warnings{"job-warning"},
+					nil,
+				)
+			})
+
+			It("waits for the job to complete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("apply-warning", "job-warning"))
+
+				spaceGUID, manifest := fakeCloudControllerClient.UpdateSpaceApplyManifestArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(manifest).To(Equal(rawManifest))
+				Expect(fakeCloudControllerClient.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+			})
+		})
+
+		Context("when the job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateSpaceApplyManifestReturns(ccv3.Job{GUID: "some-job-guid"}, nil, nil)
+				fakeCloudControllerClient.GetJobReturns(
+					ccv3.Job{GUID: "some-job-guid", State: ccv3.JobStateFailed, Errors: []ccv3.JobError{{Detail: "bad route"}}},
+					ccv3.Warnings{"job-warning"},
+					nil,
+				)
+			})
+
+			It("returns a JobFailedError", func() {
+				Expect(executeErr).To(MatchError(ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "bad route"}))
+				Expect(warnings).To(ConsistOf("job-warning"))
+			})
+		})
+
+		Context("when applying the manifest fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apply failed")
+				fakeCloudControllerClient.UpdateSpaceApplyManifestReturns(ccv3.Job{}, ccv3.Warnings{"apply-warning"}, expectedErr)
+			})
+
+			It("returns the error without polling", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("apply-warning"))
+				Expect(fakeCloudControllerClient.GetJobCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
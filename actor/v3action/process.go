@@ -0,0 +1,128 @@
+package v3action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Process represents a V3 actor process.
+type Process ccv3.Process
+
+// ProcessHealthCheck represents the health check configuration of a process.
+type ProcessHealthCheck ccv3.ProcessHealthCheck
+
+// ProcessNotFoundError is returned when the application has no process of
+// the given type.
+type ProcessNotFoundError struct {
+	ProcessType string
+}
+
+func (e ProcessNotFoundError) Error() string {
+	return fmt.Sprintf("Process %s not found", e.ProcessType)
+}
+
+// HTTPHealthCheckInvalidError is returned when an HTTP endpoint is used with a
+// health check type that is not HTTP.
+type HTTPHealthCheckInvalidError struct{}
+
+func (HTTPHealthCheckInvalidError) Error() string {
+	return "Health check type must be 'http' to set a health check HTTP endpoint"
+}
+
+// InvocationTimeoutInvalidError is returned when an invocation timeout is
+// used with the process health check type, which does not make requests.
+type InvocationTimeoutInvalidError struct{}
+
+func (InvocationTimeoutInvalidError) Error() string {
+	return "Health check type must be 'http' or 'port' to set an invocation timeout"
+}
+
+// GetApplicationProcessesByNameAndSpace returns the processes of the
+// application with the given name in the given space.
+func (actor Actor) GetApplicationProcessesByNameAndSpace(appName string, spaceGUID string) ([]Process, Warnings, error) {
+	_, processes, warnings, err := actor.getApplicationAndProcesses(appName, spaceGUID)
+	return processes, warnings, err
+}
+
+func (actor Actor) getApplicationAndProcesses(appName string, spaceGUID string) (Application, []Process, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Application{}, nil, allWarnings, err
+	}
+
+	ccProcesses, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Application{}, nil, allWarnings, err
+	}
+
+	var processes []Process
+	for _, ccProcess := range ccProcesses {
+		processes = append(processes, Process(ccProcess))
+	}
+
+	return app, processes, allWarnings, nil
+}
+
+// SetApplicationProcessHealthCheckByNameAndSpace sets the liveness health
+// check of the given process type of the application and returns the
+// application.
+func (actor Actor) SetApplicationProcessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck ProcessHealthCheck) (Application, Warnings, error) {
+	err := validateHealthCheck(healthCheck)
+	if err != nil {
+		return Application{}, nil, err
+	}
+	if healthCheck.InvocationTimeout != 0 && healthCheck.Type == "process" {
+		return Application{}, nil, InvocationTimeoutInvalidError{}
+	}
+
+	return actor.updateApplicationProcess(appName, spaceGUID, processType, func(process *ccv3.Process) {
+		process.HealthCheck = ccv3.ProcessHealthCheck(healthCheck)
+	})
+}
+
+// SetApplicationProcessReadinessHealthCheckByNameAndSpace sets the readiness
+// health check of the given process type of the application and returns the
+// application.
+func (actor Actor) SetApplicationProcessReadinessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck ProcessHealthCheck) (Application, Warnings, error) {
+	err := validateHealthCheck(healthCheck)
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	return actor.updateApplicationProcess(appName, spaceGUID, processType, func(process *ccv3.Process) {
+		process.ReadinessHealthCheck = ccv3.ProcessHealthCheck(healthCheck)
+	})
+}
+
+func validateHealthCheck(healthCheck ProcessHealthCheck) error {
+	if healthCheck.Endpoint != "" && healthCheck.Type != "http" {
+		return HTTPHealthCheckInvalidError{}
+	}
+	return nil
+}
+
+func (actor Actor) updateApplicationProcess(appName string, spaceGUID string, processType string, setHealthCheck func(*ccv3.Process)) (Application, Warnings, error) {
+	app, processes, allWarnings, err := actor.getApplicationAndProcesses(appName, spaceGUID)
+	if err != nil {
+		return Application{}, allWarnings, err
+	}
+
+	for _, process := range processes {
+		if process.Type != processType {
+			continue
+		}
+
+		processUpdate := ccv3.Process{GUID: process.GUID}
+		setHealthCheck(&processUpdate)
+		_, warnings, err := actor.CloudControllerClient.UpdateProcess(processUpdate)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Application{}, allWarnings, err
+		}
+		return app, allWarnings, nil
+	}
+
+	return Application{}, allWarnings, ProcessNotFoundError{ProcessType: processType}
+}
@@ -0,0 +1,170 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Process Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationProcessesByNameAndSpace", func() {
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				_, warnings, err := actor.GetApplicationProcessesByNameAndSpace("some-app", "some-space-guid")
+				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			Context("when getting the processes succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{
+						{GUID: "process-guid", Type: "web", HealthCheck: ccv3.ProcessHealthCheck{Type: "port"}},
+					}, ccv3.Warnings{"get-processes-warning"}, nil)
+				})
+
+				It("returns the processes and all warnings", func() {
+					processes, warnings, err := actor.GetApplicationProcessesByNameAndSpace("some-app", "some-space-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-processes-warning"))
+					Expect(processes).To(ConsistOf(Process{GUID: "process-guid", Type: "web", HealthCheck: ccv3.ProcessHealthCheck{Type: "port"}}))
+
+					Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+				})
+			})
+
+			Context("when getting the processes fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-error")
+					fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					_, warnings, err := actor.GetApplicationProcessesByNameAndSpace("some-app", "some-space-guid")
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-processes-warning"))
+				})
+			})
+		})
+	})
+
+	Describe("SetApplicationProcessHealthCheckByNameAndSpace", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid", State: "STARTED"}}, ccv3.Warnings{"get-app-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{
+				{GUID: "web-guid", Type: "web"},
+				{GUID: "worker-guid", Type: "worker"},
+			}, ccv3.Warnings{"get-processes-warning"}, nil)
+		})
+
+		Context("when the process type exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateProcessReturns(ccv3.Process{
+					GUID:        "worker-guid",
+					Type:        "worker",
+					HealthCheck: ccv3.ProcessHealthCheck{Type: "process"},
+				}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates only the liveness health check of that process and returns the application", func() {
+				app, warnings, err := actor.SetApplicationProcessHealthCheckByNameAndSpace("some-app", "some-space-guid", "worker", ProcessHealthCheck{Type: "process"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-processes-warning", "update-warning"))
+				Expect(app).To(Equal(Application{GUID: "some-app-guid", State: "STARTED"}))
+				Expect(app.Started()).To(BeTrue())
+
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateProcessArgsForCall(0)).To(Equal(ccv3.Process{
+					GUID:        "worker-guid",
+					HealthCheck: ccv3.ProcessHealthCheck{Type: "process"},
+				}))
+			})
+		})
+
+		Context("when an endpoint is provided for a health check type other than http", func() {
+			It("returns an HTTPHealthCheckInvalidError", func() {
+				_, _, err := actor.SetApplicationProcessHealthCheckByNameAndSpace("some-app", "some-space-guid", "web", ProcessHealthCheck{Type: "port", Endpoint: "/health"})
+				Expect(err).To(MatchError(HTTPHealthCheckInvalidError{}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an invocation timeout is provided for the process health check type", func() {
+			It("returns an InvocationTimeoutInvalidError", func() {
+				_, _, err := actor.SetApplicationProcessHealthCheckByNameAndSpace("some-app", "some-space-guid", "web", ProcessHealthCheck{Type: "process", InvocationTimeout: 5})
+				Expect(err).To(MatchError(InvocationTimeoutInvalidError{}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the process type does not exist", func() {
+			It("returns a ProcessNotFoundError and the warnings", func() {
+				_, warnings, err := actor.SetApplicationProcessHealthCheckByNameAndSpace("some-app", "some-space-guid", "clock", ProcessHealthCheck{Type: "port"})
+				Expect(err).To(MatchError(ProcessNotFoundError{ProcessType: "clock"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-processes-warning"))
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when updating the process fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.UpdateProcessReturns(ccv3.Process{}, ccv3.Warnings{"update-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.SetApplicationProcessHealthCheckByNameAndSpace("some-app", "some-space-guid", "web", ProcessHealthCheck{Type: "port"})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-processes-warning", "update-warning"))
+			})
+		})
+	})
+
+	Describe("SetApplicationProcessReadinessHealthCheckByNameAndSpace", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, nil, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{GUID: "web-guid", Type: "web"}}, nil, nil)
+			fakeCloudControllerClient.UpdateProcessReturns(ccv3.Process{GUID: "web-guid"}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates only the readiness health check of the process", func() {
+			_, warnings, err := actor.SetApplicationProcessReadinessHealthCheckByNameAndSpace("some-app", "some-space-guid", "web", ProcessHealthCheck{Type: "http", Endpoint: "/ready", Interval: 5})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("update-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateProcessArgsForCall(0)).To(Equal(ccv3.Process{
+				GUID:                 "web-guid",
+				ReadinessHealthCheck: ccv3.ProcessHealthCheck{Type: "http", Endpoint: "/ready", Interval: 5},
+			}))
+		})
+	})
+})
@@ -0,0 +1,144 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+const (
+	// PushLockOwnerLabel is the label that records who holds the push lock of
+	// an application.
+	PushLockOwnerLabel = "cli.cloudfoundry.org/push-lock-owner"
+	// PushLockTimeLabel is the label that records when the push lock was
+	// acquired, as a Unix timestamp.
+	PushLockTimeLabel = "cli.cloudfoundry.org/push-lock-time"
+
+	maxLabelValueLength = 63
+)
+
+var invalidLabelValueCharacters = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// ApplicationPushLockedError is returned when another owner holds the push
+// lock of the application.
+type ApplicationPushLockedError struct {
+	AppName    string
+	Owner      string
+	AcquiredAt time.Time
+}
+
+func (e ApplicationPushLockedError) Error() string {
+	return fmt.Sprintf("Application '%s' is locked by %s since %s", e.AppName, e.Owner, e.AcquiredAt)
+}
+
+// PushLockOwner returns a label value identifying the provided user and host.
+// Characters that are not allowed in label values are replaced.
+func PushLockOwner(username string, hostname string) string {
+	owner := invalidLabelValueCharacters.ReplaceAllString(username+"."+hostname, "_")
+	if len(owner) > maxLabelValueLength {
+		owner = owner[:maxLabelValueLength]
+	}
+	return strings.Trim(owner, "._-")
+}
+
+// AcquireApplicationPushLock labels the application with the provided owner
+// and the current time. When another owner holds the lock, an
+// ApplicationPushLockedError is returned unless force is set, in which case the
+// lock is taken over. The lock is advisory: it only protects against other
+// pushes that acquire it.
+func (actor Actor) AcquireApplicationPushLock(appGUID string, owner string, force bool) (Warnings, error) {
+	app, warnings, err := actor.getApplicationByGUID(appGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	if lock, locked := pushLock(app); locked && lock.Owner != owner && !force {
+		return warnings, lock
+	}
+
+	acquiredAt := strconv.FormatInt(time.Now().Unix(), 10)
+	_, apiWarnings, err := actor.CloudControllerClient.UpdateApplicationMetadata(appGUID, ccv3.Metadata{
+		Labels: map[string]*string{
+			PushLockOwnerLabel: &owner,
+			PushLockTimeLabel:  &acquiredAt,
+		},
+	})
+	warnings = append(warnings, apiWarnings...)
+	if err != nil {
+		return warnings, err
+	}
+
+	// Another push may have written its labels between the read and the
+	// update; the last write wins and every other push backs off.
+	app, getWarnings, err := actor.getApplicationByGUID(appGUID)
+	warnings = append(warnings, getWarnings...)
+	if err != nil {
+		return warnings, err
+	}
+	if lock, locked := pushLock(app); locked && lock.Owner != owner {
+		return warnings, lock
+	}
+
+	return warnings, nil
+}
+
+// ReleaseApplicationPushLock removes the push lock labels from the
+// application, unless the lock has been taken over by another owner.
+func (actor Actor) ReleaseApplicationPushLock(appGUID string, owner string) (Warnings, error) {
+	app, warnings, err := actor.getApplicationByGUID(appGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	if lock, locked := pushLock(app); !locked || lock.Owner != owner {
+		return warnings, nil
+	}
+
+	_, apiWarnings, err := actor.CloudControllerClient.UpdateApplicationMetadata(appGUID, ccv3.Metadata{
+		Labels: map[string]*string{
+			PushLockOwnerLabel: nil,
+			PushLockTimeLabel:  nil,
+		},
+	})
+	warnings = append(warnings, apiWarnings...)
+	return warnings, err
+}
+
+func (actor Actor) getApplicationByGUID(appGUID string) (ccv3.Application, Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+		"guids": []string{appGUID},
+	})
+	if err != nil {
+		return ccv3.Application{}, Warnings(warnings), err
+	}
+	if len(apps) == 0 {
+		return ccv3.Application{}, Warnings(warnings), ApplicationNotFoundError{Name: appGUID}
+	}
+	return apps[0], Warnings(warnings), nil
+}
+
+// pushLock returns the push lock recorded in the labels of the application.
+func pushLock(app ccv3.Application) (ApplicationPushLockedError, bool) {
+	if app.Metadata == nil {
+		return ApplicationPushLockedError{}, false
+	}
+
+	owner := app.Metadata.Labels[PushLockOwnerLabel]
+	if owner == nil || *owner == "" {
+		return ApplicationPushLockedError{}, false
+	}
+
+	lock := ApplicationPushLockedError{AppName: app.Name, Owner: *owner}
+	if acquiredAt := app.Metadata.Labels[PushLockTimeLabel]; acquiredAt != nil {
+		seconds, err := strconv.ParseInt(*acquiredAt, 10, 64)
+		if err == nil {
+			lock.AcquiredAt = time.Unix(seconds, 0)
+		}
+	}
+	return lock, true
+}
@@ -0,0 +1,180 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func lockedApplication(owner string, acquiredAt string) ccv3.Application {
+	return ccv3.Application{
+		GUID: "some-app-guid",
+		Name: "some-app",
+		Metadata: &ccv3.Metadata{
+			Labels: map[string]*string{
+				PushLockOwnerLabel: &owner,
+				PushLockTimeLabel:  &acquiredAt,
+			},
+		},
+	}
+}
+
+var _ = Describe("Push Lock Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("PushLockOwner", func() {
+		It("replaces characters that are not allowed in labels", func() {
+			Expect(PushLockOwner("admin@example.com", "ci-worker-1")).To(Equal("admin_example.com.ci-worker-1"))
+		})
+
+		It("truncates long owners", func() {
+			Expect(len(PushLockOwner("some-user", string(make([]byte, 100))))).To(BeNumerically("<=", 63))
+		})
+	})
+
+	Describe("AcquireApplicationPushLock", func() {
+		var (
+			force      bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			force = false
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.AcquireApplicationPushLock("some-app-guid", "some-owner", force)
+		})
+
+		Context("when the application is not locked", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []ccv3.Application{{GUID: "some-app-guid", Name: "some-app"}}, ccv3.Warnings{"get-app-warning"}, nil)
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, []ccv3.Application{lockedApplication("some-owner", "1500000000")}, ccv3.Warnings{"verify-warning"}, nil)
+				fakeCloudControllerClient.UpdateApplicationMetadataReturns(ccv3.Application{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("labels the application with the owner and the time", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "update-warning", "verify-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{"guids": []string{"some-app-guid"}}))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationMetadataCallCount()).To(Equal(1))
+				appGUID, metadata := fakeCloudControllerClient.UpdateApplicationMetadataArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(*metadata.Labels[PushLockOwnerLabel]).To(Equal("some-owner"))
+				Expect(*metadata.Labels[PushLockTimeLabel]).To(MatchRegexp(`^\d+$`))
+			})
+		})
+
+		Context("when another owner holds the lock", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{lockedApplication("other-owner", "1500000000")}, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationPushLockedError", func() {
+				Expect(executeErr).To(MatchError(ApplicationPushLockedError{
+					AppName:    "some-app",
+					Owner:      "other-owner",
+					AcquiredAt: time.Unix(1500000000, 0),
+				}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationMetadataCallCount()).To(Equal(0))
+			})
+
+			Context("when the lock is forced", func() {
+				BeforeEach(func() {
+					force = true
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, []ccv3.Application{lockedApplication("some-owner", "1500000001")}, nil, nil)
+				})
+
+				It("takes over the lock", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.UpdateApplicationMetadataCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when another push acquires the lock at the same time", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []ccv3.Application{{GUID: "some-app-guid", Name: "some-app"}}, nil, nil)
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, []ccv3.Application{lockedApplication("other-owner", "1500000000")}, nil, nil)
+			})
+
+			It("returns an ApplicationPushLockedError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(ApplicationPushLockedError{}))
+			})
+		})
+
+		Context("when updating the labels fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update-error")
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, nil, nil)
+				fakeCloudControllerClient.UpdateApplicationMetadataReturns(ccv3.Application{}, ccv3.Warnings{"update-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("update-warning"))
+			})
+		})
+	})
+
+	Describe("ReleaseApplicationPushLock", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ReleaseApplicationPushLock("some-app-guid", "some-owner")
+		})
+
+		Context("when the owner holds the lock", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{lockedApplication("some-owner", "1500000000")}, ccv3.Warnings{"get-app-warning"}, nil)
+				fakeCloudControllerClient.UpdateApplicationMetadataReturns(ccv3.Application{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("removes the labels", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "update-warning"))
+
+				_, metadata := fakeCloudControllerClient.UpdateApplicationMetadataArgsForCall(0)
+				Expect(metadata.Labels).To(HaveKey(PushLockOwnerLabel))
+				Expect(metadata.Labels[PushLockOwnerLabel]).To(BeNil())
+				Expect(metadata.Labels).To(HaveKey(PushLockTimeLabel))
+				Expect(metadata.Labels[PushLockTimeLabel]).To(BeNil())
+			})
+		})
+
+		Context("when another owner has taken over the lock", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{lockedApplication("other-owner", "1500000000")}, nil, nil)
+			})
+
+			It("leaves the lock in place", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateApplicationMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Revision represents a V3 actor Revision.
+type Revision ccv3.Revision
+
+// RevisionNotFoundError is returned when an application has no revision with
+// the requested version.
+type RevisionNotFoundError struct {
+	AppName string
+	Version int
+}
+
+func (e RevisionNotFoundError) Error() string {
+	return fmt.Sprintf("Revision %d of application '%s' not found.", e.Version, e.AppName)
+}
+
+// GetApplicationRevisionByVersion returns the revision of the application
+// with the provided version.
+func (actor Actor) GetApplicationRevisionByVersion(appName string, spaceGUID string, version int) (Revision, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return Revision{}, allWarnings, err
+	}
+
+	revisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(app.GUID, url.Values{
+		ccv3.RevisionVersionFilter: []string{strconv.Itoa(version)},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Revision{}, allWarnings, err
+	}
+
+	if len(revisions) == 0 {
+		return Revision{}, allWarnings, RevisionNotFoundError{AppName: appName, Version: version}
+	}
+
+	return Revision(revisions[0]), allWarnings, nil
+}
+
+// GetRevisionEnvironmentVariables returns the user-provided environment
+// variables captured in the revision.
+func (actor Actor) GetRevisionEnvironmentVariables(revision Revision) (map[string]string, Warnings, error) {
+	envVars, warnings, err := actor.CloudControllerClient.GetRevisionEnvironmentVariables(ccv3.Revision(revision))
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	variables := map[string]string{}
+	for name, value := range envVars {
+		if value != nil {
+			variables[name] = *value
+		}
+	}
+	return variables, Warnings(warnings), nil
+}
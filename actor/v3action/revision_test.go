@@ -0,0 +1,107 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Revision Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationRevisionByVersion", func() {
+		var (
+			revision   Revision
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationRevisionsReturns(
+				[]ccv3.Revision{{GUID: "some-revision-guid", Version: 3, DropletGUID: "some-droplet-guid"}},
+				ccv3.Warnings{"get-revisions-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			revision, warnings, executeErr = actor.GetApplicationRevisionByVersion("some-app", "some-space-guid", 3)
+		})
+
+		It("returns the revision with the version", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(revision).To(Equal(Revision{GUID: "some-revision-guid", Version: 3, DropletGUID: "some-droplet-guid"}))
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-revisions-warning"))
+
+			appGUID, query := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(query).To(Equal(url.Values{"versions": []string{"3"}}))
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"get-revisions-warning"}, nil)
+			})
+
+			It("returns a RevisionNotFoundError", func() {
+				Expect(executeErr).To(MatchError(RevisionNotFoundError{AppName: "some-app", Version: 3}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-revisions-warning"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeCloudControllerClient.GetApplicationRevisionsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetRevisionEnvironmentVariables", func() {
+		It("returns the environment variables of the revision", func() {
+			value := "postgres://db"
+			fakeCloudControllerClient.GetRevisionEnvironmentVariablesReturns(
+				ccv3.EnvironmentVariables{"DATABASE_URL": &value, "REMOVED": nil},
+				ccv3.Warnings{"env-warning"},
+				nil,
+			)
+
+			envVars, warnings, err := actor.GetRevisionEnvironmentVariables(Revision{GUID: "some-revision-guid"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("env-warning"))
+			Expect(envVars).To(Equal(map[string]string{"DATABASE_URL": "postgres://db"}))
+			Expect(fakeCloudControllerClient.GetRevisionEnvironmentVariablesArgsForCall(0)).To(Equal(ccv3.Revision{GUID: "some-revision-guid"}))
+		})
+
+		It("returns the error and warnings when the request fails", func() {
+			expectedErr := errors.New("env failed")
+			fakeCloudControllerClient.GetRevisionEnvironmentVariablesReturns(nil, ccv3.Warnings{"env-warning"}, expectedErr)
+
+			_, warnings, err := actor.GetRevisionEnvironmentVariables(Revision{GUID: "some-revision-guid"})
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("env-warning"))
+		})
+	})
+})
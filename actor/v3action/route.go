@@ -0,0 +1,8 @@
+package v3action
+
+// TransferRouteOwnership moves the route to the provided space. The apps
+// mapped to the route keep their mappings.
+func (actor Actor) TransferRouteOwnership(routeGUID string, spaceGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateRouteSpaceRelationship(routeGUID, spaceGUID)
+	return Warnings(warnings), err
+}
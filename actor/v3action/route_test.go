@@ -0,0 +1,72 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("TransferRouteOwnership", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.TransferRouteOwnership("some-route-guid", "some-space-guid")
+		})
+
+		Context("when the transfer is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateRouteSpaceRelationshipReturns(
+					ccv3.Relationship{GUID: "some-space-guid"},
+					ccv3.Warnings{"transfer-warning"},
+					nil,
+				)
+			})
+
+			It("transfers the route to the space and returns the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("transfer-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateRouteSpaceRelationshipCallCount()).To(Equal(1))
+				routeGUID, spaceGUID := fakeCloudControllerClient.UpdateRouteSpaceRelationshipArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the transfer fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("transfer-error")
+				fakeCloudControllerClient.UpdateRouteSpaceRelationshipReturns(
+					ccv3.Relationship{},
+					ccv3.Warnings{"transfer-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("transfer-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,87 @@
+package v3action
+
+import (
+	"fmt"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// ServiceInstance represents a V3 actor service instance.
+type ServiceInstance ccv3.ServiceInstance
+
+// ServiceInstanceNotFoundError is returned when a service instance cannot be
+// found.
+type ServiceInstanceNotFoundError struct {
+	Name string
+}
+
+func (e ServiceInstanceNotFoundError) Error() string {
+	return fmt.Sprintf("Service instance '%s' not found.", e.Name)
+}
+
+// ServiceInstanceUpToDateError is returned when upgrading a service instance
+// that is already running the latest version of its plan.
+type ServiceInstanceUpToDateError struct {
+	Name string
+}
+
+func (e ServiceInstanceUpToDateError) Error() string {
+	return fmt.Sprintf("Service instance '%s' has no upgrade available.", e.Name)
+}
+
+// GetServiceInstanceByNameAndSpace returns the service instance with the
+// provided name in the provided space.
+func (actor Actor) GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (ServiceInstance, Warnings, error) {
+	instances, warnings, err := actor.CloudControllerClient.GetServiceInstances(url.Values{
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+		ccv3.NameFilter:      []string{name},
+	})
+	if err != nil {
+		return ServiceInstance{}, Warnings(warnings), err
+	}
+
+	if len(instances) == 0 {
+		return ServiceInstance{}, Warnings(warnings), ServiceInstanceNotFoundError{Name: name}
+	}
+
+	return ServiceInstance(instances[0]), Warnings(warnings), nil
+}
+
+// GetServiceInstancesBySpace returns all the service instances in the provided
+// space.
+func (actor Actor) GetServiceInstancesBySpace(spaceGUID string) ([]ServiceInstance, Warnings, error) {
+	ccInstances, warnings, err := actor.CloudControllerClient.GetServiceInstances(url.Values{
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var instances []ServiceInstance
+	for _, ccInstance := range ccInstances {
+		instances = append(instances, ServiceInstance(ccInstance))
+	}
+
+	return instances, Warnings(warnings), nil
+}
+
+// UpgradeServiceInstance asks the service broker to upgrade the service
+// instance to the latest version of its plan. The returned job tracks the
+// upgrade and can be passed to PollJob; it is empty when the Cloud Controller
+// completed the upgrade without one.
+func (actor Actor) UpgradeServiceInstance(serviceInstance ServiceInstance) (Job, Warnings, error) {
+	if !serviceInstance.UpgradeAvailable {
+		return Job{}, nil, ServiceInstanceUpToDateError{Name: serviceInstance.Name}
+	}
+
+	plan, allWarnings, err := actor.CloudControllerClient.GetServicePlan(serviceInstance.ServicePlanGUID)
+	if err != nil {
+		return Job{}, Warnings(allWarnings), err
+	}
+
+	job, warnings, err := actor.CloudControllerClient.UpdateServiceInstanceMaintenanceInfo(serviceInstance.GUID, plan.MaintenanceInfo)
+	allWarnings = append(allWarnings, warnings...)
+
+	return Job(job), Warnings(allWarnings), err
+}
@@ -0,0 +1,146 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetServiceInstanceByNameAndSpace", func() {
+		Context("when the service instance exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv3.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+					ccv3.Warnings{"some-warning"},
+					nil)
+			})
+
+			It("returns the service instance and all warnings", func() {
+				instance, warnings, err := actor.GetServiceInstanceByNameAndSpace("some-service-instance", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(instance).To(Equal(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"}))
+
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+					ccv3.NameFilter:      []string{"some-service-instance"},
+				}))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetServiceInstanceByNameAndSpace("some-service-instance", "some-space-guid")
+				Expect(err).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceInstancesBySpace", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv3.ServiceInstance{{Name: "instance-1"}, {Name: "instance-2"}},
+				ccv3.Warnings{"some-warning"},
+				nil)
+		})
+
+		It("returns the service instances in the space and all warnings", func() {
+			instances, warnings, err := actor.GetServiceInstancesBySpace("some-space-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-warning"))
+			Expect(instances).To(Equal([]ServiceInstance{{Name: "instance-1"}, {Name: "instance-2"}}))
+
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(Equal(url.Values{
+				ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+			}))
+		})
+	})
+
+	Describe("UpgradeServiceInstance", func() {
+		var serviceInstance ServiceInstance
+
+		BeforeEach(func() {
+			serviceInstance = ServiceInstance{
+				GUID:             "some-service-instance-guid",
+				Name:             "some-service-instance",
+				ServicePlanGUID:  "some-plan-guid",
+				UpgradeAvailable: true,
+			}
+		})
+
+		Context("when an upgrade is available", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlanReturns(
+					ccv3.ServicePlan{MaintenanceInfo: ccv3.MaintenanceInfo{Version: "2.0.0"}},
+					ccv3.Warnings{"plan-warning"},
+					nil)
+				fakeCloudControllerClient.UpdateServiceInstanceMaintenanceInfoReturns(
+					ccv3.Job{GUID: "some-job-guid"},
+					ccv3.Warnings{"update-warning"},
+					nil)
+			})
+
+			It("upgrades the instance to the latest version of its plan", func() {
+				job, warnings, err := actor.UpgradeServiceInstance(serviceInstance)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("plan-warning", "update-warning"))
+				Expect(job).To(Equal(Job{GUID: "some-job-guid"}))
+
+				Expect(fakeCloudControllerClient.GetServicePlanArgsForCall(0)).To(Equal("some-plan-guid"))
+				guid, maintenanceInfo := fakeCloudControllerClient.UpdateServiceInstanceMaintenanceInfoArgsForCall(0)
+				Expect(guid).To(Equal("some-service-instance-guid"))
+				Expect(maintenanceInfo).To(Equal(ccv3.MaintenanceInfo{Version: "2.0.0"}))
+			})
+		})
+
+		Context("when no upgrade is available", func() {
+			BeforeEach(func() {
+				serviceInstance.UpgradeAvailable = false
+			})
+
+			It("returns a ServiceInstanceUpToDateError", func() {
+				_, _, err := actor.UpgradeServiceInstance(serviceInstance)
+				Expect(err).To(MatchError(ServiceInstanceUpToDateError{Name: "some-service-instance"}))
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceMaintenanceInfoCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the plan fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("plan error")
+				fakeCloudControllerClient.GetServicePlanReturns(ccv3.ServicePlan{}, ccv3.Warnings{"plan-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.UpgradeServiceInstance(serviceInstance)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("plan-warning"))
+				Expect(fakeCloudControllerClient.UpdateServiceInstanceMaintenanceInfoCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package v3action
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// StaleArtifacts are the packages and droplets of an application that are
+// older than the ones being kept.
+type StaleArtifacts struct {
+	Application Application
+	Packages    []Package
+	Droplets    []Droplet
+}
+
+// GetStaleArtifactsBySpace returns, for every application in the space, the
+// packages and droplets other than the newest keep of each. The droplet an
+// application is currently running is never returned. Applications without
+// stale artifacts are omitted.
+func (actor Actor) GetStaleArtifactsBySpace(spaceGUID string, keep int) ([]StaleArtifacts, Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+	})
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	newestFirst := url.Values{"order_by": []string{"-created_at"}}
+
+	var allStaleArtifacts []StaleArtifacts
+	for _, app := range apps {
+		packages, warnings, err := actor.CloudControllerClient.GetApplicationPackages(app.GUID, newestFirst)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		droplets, warnings, err := actor.CloudControllerClient.GetApplicationDroplets(app.GUID, newestFirst)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		currentDroplet, warnings, err := actor.CloudControllerClient.GetApplicationCurrentDroplet(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(ccerror.ResourceNotFoundError); !ok && err != nil {
+			return nil, allWarnings, err
+		}
+
+		staleArtifacts := StaleArtifacts{Application: Application(app)}
+		for i, pkg := range packages {
+			if i >= keep {
+				staleArtifacts.Packages = append(staleArtifacts.Packages, Package(pkg))
+			}
+		}
+		for i, droplet := range droplets {
+			if i >= keep && droplet.GUID != currentDroplet.GUID {
+				staleArtifacts.Droplets = append(staleArtifacts.Droplets, Droplet(droplet))
+			}
+		}
+
+		if len(staleArtifacts.Packages) > 0 || len(staleArtifacts.Droplets) > 0 {
+			allStaleArtifacts = append(allStaleArtifacts, staleArtifacts)
+		}
+	}
+
+	return allStaleArtifacts, allWarnings, nil
+}
+
+// DeleteStaleArtifacts deletes the provided droplets and packages.
+func (actor Actor) DeleteStaleArtifacts(staleArtifacts StaleArtifacts) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, droplet := range staleArtifacts.Droplets {
+		warnings, err := actor.CloudControllerClient.DeleteDroplet(droplet.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, pkg := range staleArtifacts.Packages {
+		warnings, err := actor.CloudControllerClient.DeletePackage(pkg.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
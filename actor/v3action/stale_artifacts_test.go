@@ -0,0 +1,165 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stale Artifacts Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetStaleArtifactsBySpace", func() {
+		var (
+			staleArtifacts []StaleArtifacts
+			warnings       Warnings
+			executeErr     error
+		)
+
+		JustBeforeEach(func() {
+			staleArtifacts, warnings, executeErr = actor.GetStaleArtifactsBySpace("some-space-guid", 2)
+		})
+
+		Context("when the space has applications", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "app-guid-1", Name: "app-1"}, {GUID: "app-guid-2", Name: "app-2"}},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationPackagesStub = func(appGUID string, _ url.Values) ([]ccv3.Package, ccv3.Warnings, error) {
+					if appGUID == "app-guid-1" {
+						return []ccv3.Package{{GUID: "package-3"}, {GUID: "package-2"}, {GUID: "package-1"}}, ccv3.Warnings{"get-packages-warning"}, nil
+					}
+					return []ccv3.Package{{GUID: "package-4"}}, nil, nil
+				}
+				fakeCloudControllerClient.GetApplicationDropletsStub = func(appGUID string, _ url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
+					if appGUID == "app-guid-1" {
+						return []ccv3.Droplet{{GUID: "droplet-4"}, {GUID: "droplet-3"}, {GUID: "droplet-2"}, {GUID: "droplet-1"}}, ccv3.Warnings{"get-droplets-warning"}, nil
+					}
+					return nil, nil, nil
+				}
+				fakeCloudControllerClient.GetApplicationCurrentDropletStub = func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+					if appGUID == "app-guid-1" {
+						return ccv3.Droplet{GUID: "droplet-2"}, ccv3.Warnings{"get-current-droplet-warning"}, nil
+					}
+					return ccv3.Droplet{}, nil, ccerror.ResourceNotFoundError{}
+				}
+			})
+
+			It("returns all but the newest packages and droplets, keeping the current droplet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-packages-warning", "get-droplets-warning", "get-current-droplet-warning"))
+				Expect(staleArtifacts).To(Equal([]StaleArtifacts{
+					{
+						Application: Application{GUID: "app-guid-1", Name: "app-1"},
+						Packages:    []Package{{GUID: "package-1"}},
+						Droplets:    []Droplet{{GUID: "droplet-1"}},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+				}))
+				appGUID, query := fakeCloudControllerClient.GetApplicationPackagesArgsForCall(0)
+				Expect(appGUID).To(Equal("app-guid-1"))
+				Expect(query).To(Equal(url.Values{"order_by": []string{"-created_at"}}))
+				appGUID, query = fakeCloudControllerClient.GetApplicationDropletsArgsForCall(0)
+				Expect(appGUID).To(Equal("app-guid-1"))
+				Expect(query).To(Equal(url.Values{"order_by": []string{"-created_at"}}))
+			})
+		})
+
+		Context("when getting the current droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "app-guid-1"}}, nil, nil)
+				fakeCloudControllerClient.GetApplicationCurrentDropletReturns(ccv3.Droplet{}, ccv3.Warnings{"get-current-droplet-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-current-droplet-warning"))
+			})
+		})
+
+		Context("when getting the applications fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationPackagesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteStaleArtifacts", func() {
+		var staleArtifacts StaleArtifacts
+
+		BeforeEach(func() {
+			staleArtifacts = StaleArtifacts{
+				Packages: []Package{{GUID: "package-1"}},
+				Droplets: []Droplet{{GUID: "droplet-1"}, {GUID: "droplet-2"}},
+			}
+		})
+
+		Context("when the deletes succeed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteDropletReturns(ccv3.Warnings{"delete-droplet-warning"}, nil)
+				fakeCloudControllerClient.DeletePackageReturns(ccv3.Warnings{"delete-package-warning"}, nil)
+			})
+
+			It("deletes the droplets and packages and returns all warnings", func() {
+				warnings, err := actor.DeleteStaleArtifacts(staleArtifacts)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-droplet-warning", "delete-droplet-warning", "delete-package-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("droplet-1"))
+				Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(1)).To(Equal("droplet-2"))
+				Expect(fakeCloudControllerClient.DeletePackageCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("package-1"))
+			})
+		})
+
+		Context("when deleting a droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.DeleteDropletReturns(ccv3.Warnings{"delete-droplet-warning"}, expectedErr)
+			})
+
+			It("stops and returns the error and all warnings", func() {
+				warnings, err := actor.DeleteStaleArtifacts(staleArtifacts)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("delete-droplet-warning"))
+				Expect(fakeCloudControllerClient.DeleteDropletCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeletePackageCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 
 	"sort"
 
@@ -33,6 +34,27 @@ func (e TaskNotFoundError) Error() string {
 	return fmt.Sprintf("Task sequence ID %d not found.", e.SequenceID)
 }
 
+// TaskFailedError is returned when a task finishes without succeeding.
+type TaskFailedError struct {
+	SequenceID int
+	Reason     string
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task %d failed: %s", e.SequenceID, e.Reason)
+}
+
+// TaskTimeoutError is returned when a task is still running after the overall
+// polling timeout.
+type TaskTimeoutError struct {
+	SequenceID int
+	Timeout    time.Duration
+}
+
+func (e TaskTimeoutError) Error() string {
+	return fmt.Sprintf("Task %d did not finish within %s", e.SequenceID, e.Timeout)
+}
+
 // RunTask runs the provided command in the application environment associated
 // with the provided application GUID.
 func (actor Actor) RunTask(appGUID string, task Task) (Task, Warnings, error) {
@@ -46,6 +68,44 @@ func (actor Actor) RunTask(appGUID string, task Task) (Task, Warnings, error) {
 	return Task(createdTask), Warnings(warnings), err
 }
 
+// RunTaskAndWait runs the task like RunTask and polls it until it has
+// finished or the overall polling timeout has been reached. A TaskFailedError
+// is returned when the task does not succeed.
+func (actor Actor) RunTaskAndWait(appGUID string, task Task) (Task, Warnings, error) {
+	task, allWarnings, err := actor.RunTask(appGUID, task)
+	if err != nil {
+		return Task{}, allWarnings, err
+	}
+
+	startTime := time.Now()
+	for time.Now().Sub(startTime) < actor.Config.OverallPollingTimeout() {
+		ccTask, warnings, err := actor.CloudControllerClient.GetTask(task.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Task{}, allWarnings, err
+		}
+		task = Task(ccTask)
+
+		switch task.State {
+		case ccv3.TaskStateSucceeded:
+			return task, allWarnings, nil
+		case ccv3.TaskStateFailed:
+			var reason string
+			if task.Result != nil {
+				reason = task.Result.FailureReason
+			}
+			return task, allWarnings, TaskFailedError{SequenceID: task.SequenceID, Reason: reason}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return task, allWarnings, TaskTimeoutError{
+		SequenceID: task.SequenceID,
+		Timeout:    actor.Config.OverallPollingTimeout(),
+	}
+}
+
 // GetApplicationTasks returns a list of tasks associated with the provided
 // appplication GUID.
 func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder) ([]Task, Warnings, error) {
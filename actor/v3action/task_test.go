@@ -3,6 +3,7 @@ package v3action_test
 import (
 	"errors"
 	"net/url"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -102,6 +103,98 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("RunTaskAndWait", func() {
+		var (
+			fakeConfig *v3actionfakes.FakeConfig
+			task       Task
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v3actionfakes.FakeConfig)
+			fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig)
+
+			fakeCloudControllerClient.CreateApplicationTaskReturns(
+				ccv3.Task{GUID: "some-task-guid", SequenceID: 3, State: "PENDING"},
+				ccv3.Warnings{"create-task-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetTaskReturns(
+				ccv3.Task{GUID: "some-task-guid", SequenceID: 3, State: "RUNNING"},
+				ccv3.Warnings{"get-task-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetTaskReturnsOnCall(1,
+				ccv3.Task{GUID: "some-task-guid", SequenceID: 3, State: ccv3.TaskStateSucceeded},
+				ccv3.Warnings{"get-task-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, executeErr = actor.RunTaskAndWait("some-app-guid", Task{Command: "some-command", DropletGUID: "some-droplet-guid"})
+		})
+
+		It("runs the task and waits for it to succeed", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(task).To(Equal(Task{GUID: "some-task-guid", SequenceID: 3, State: ccv3.TaskStateSucceeded}))
+			Expect(warnings).To(ConsistOf("create-task-warning", "get-task-warning", "get-task-warning"))
+
+			appGUID, createdTask := fakeCloudControllerClient.CreateApplicationTaskArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(createdTask).To(Equal(ccv3.Task{Command: "some-command", DropletGUID: "some-droplet-guid"}))
+			Expect(fakeCloudControllerClient.GetTaskCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetTaskArgsForCall(0)).To(Equal("some-task-guid"))
+		})
+
+		Context("when the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskReturnsOnCall(1,
+					ccv3.Task{
+						GUID:       "some-task-guid",
+						SequenceID: 3,
+						State:      ccv3.TaskStateFailed,
+						Result:     &ccv3.TaskResult{FailureReason: "Exited with status 1"},
+					},
+					ccv3.Warnings{"get-task-warning"},
+					nil,
+				)
+			})
+
+			It("returns a TaskFailedError", func() {
+				Expect(executeErr).To(MatchError(TaskFailedError{SequenceID: 3, Reason: "Exited with status 1"}))
+				Expect(warnings).To(ConsistOf("create-task-warning", "get-task-warning", "get-task-warning"))
+			})
+		})
+
+		Context("when creating the task fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create task failed")
+				fakeCloudControllerClient.CreateApplicationTaskReturns(ccv3.Task{}, ccv3.Warnings{"create-task-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-task-warning"))
+				Expect(fakeCloudControllerClient.GetTaskCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the task does not finish before the timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a TaskTimeoutError", func() {
+				Expect(executeErr).To(MatchError(TaskTimeoutError{SequenceID: 3}))
+			})
+		})
+	})
+
 	Describe("GetApplicationTasks", func() {
 		Context("when the application exists", func() {
 			Context("when there are associated tasks", func() {
@@ -50,6 +50,34 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	ContinueDeploymentStub        func(guid string) (ccv3.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		guid string
+	}
+	continueDeploymentReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	CreateApplicationDeploymentStub        func(deployment ccv3.Deployment) (ccv3.Deployment, ccv3.Warnings, error)
+	createApplicationDeploymentMutex       sync.RWMutex
+	createApplicationDeploymentArgsForCall []struct {
+		deployment ccv3.Deployment
+	}
+	createApplicationDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationTaskStub        func(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	createApplicationTaskMutex       sync.RWMutex
 	createApplicationTaskArgsForCall []struct {
@@ -66,6 +94,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateBuildStub        func(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error)
+	createBuildMutex       sync.RWMutex
+	createBuildArgsForCall []struct {
+		build ccv3.Build
+	}
+	createBuildReturns struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createBuildReturnsOnCall map[int]struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateIsolationSegmentStub        func(isolationSegment ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	createIsolationSegmentMutex       sync.RWMutex
 	createIsolationSegmentArgsForCall []struct {
@@ -96,6 +139,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	DeleteDropletStub        func(guid string) (ccv3.Warnings, error)
+	deleteDropletMutex       sync.RWMutex
+	deleteDropletArgsForCall []struct {
+		guid string
+	}
+	deleteDropletReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	deleteDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	DeleteIsolationSegmentStub        func(guid string) (ccv3.Warnings, error)
 	deleteIsolationSegmentMutex       sync.RWMutex
 	deleteIsolationSegmentArgsForCall []struct {
@@ -109,6 +165,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeletePackageStub        func(guid string) (ccv3.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		guid string
+	}
+	deletePackageReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	EntitleIsolationSegmentToOrganizationsStub        func(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	entitleIsolationSegmentToOrganizationsMutex       sync.RWMutex
 	entitleIsolationSegmentToOrganizationsArgsForCall []struct {
@@ -125,6 +194,99 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationCurrentDropletStub        func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	getApplicationCurrentDropletMutex       sync.RWMutex
+	getApplicationCurrentDropletArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationCurrentDropletReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationCurrentDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetApplicationEnvironmentStub        func(appGUID string) (ccv3.Environment, ccv3.Warnings, error)
+	getApplicationEnvironmentMutex       sync.RWMutex
+	getApplicationEnvironmentArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationEnvironmentReturns struct {
+		result1 ccv3.Environment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationEnvironmentReturnsOnCall map[int]struct {
+		result1 ccv3.Environment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetApplicationDropletsStub        func(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
+	getApplicationDropletsMutex       sync.RWMutex
+	getApplicationDropletsArgsForCall []struct {
+		appGUID string
+		query   url.Values
+	}
+	getApplicationDropletsReturns struct {
+		result1 []ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationDropletsReturnsOnCall map[int]struct {
+		result1 []ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetApplicationPackagesStub        func(appGUID string, query url.Values) ([]ccv3.Package, ccv3.Warnings, error)
+	getApplicationPackagesMutex       sync.RWMutex
+	getApplicationPackagesArgsForCall []struct {
+		appGUID string
+		query   url.Values
+	}
+	getApplicationPackagesReturns struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationPackagesReturnsOnCall map[int]struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetApplicationProcessesStub        func(appGUID string) ([]ccv3.Process, ccv3.Warnings, error)
+	getApplicationProcessesMutex       sync.RWMutex
+	getApplicationProcessesArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationProcessesReturns struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationProcessesReturnsOnCall map[int]struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetApplicationRevisionsStub        func(appGUID string, query url.Values) ([]ccv3.Revision, ccv3.Warnings, error)
+	getApplicationRevisionsMutex       sync.RWMutex
+	getApplicationRevisionsArgsForCall []struct {
+		appGUID string
+		query   url.Values
+	}
+	getApplicationRevisionsReturns struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationRevisionsReturnsOnCall map[int]struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationsStub        func(query url.Values) ([]ccv3.Application, ccv3.Warnings, error)
 	getApplicationsMutex       sync.RWMutex
 	getApplicationsArgsForCall []struct {
@@ -156,6 +318,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetBuildStub        func(guid string) (ccv3.Build, ccv3.Warnings, error)
+	getBuildMutex       sync.RWMutex
+	getBuildArgsForCall []struct {
+		guid string
+	}
+	getBuildReturns struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getBuildReturnsOnCall map[int]struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetDeploymentStub        func(guid string) (ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		guid string
+	}
+	getDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetDeploymentsStub        func(query url.Values) ([]ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentsMutex       sync.RWMutex
+	getDeploymentsArgsForCall []struct {
+		query url.Values
+	}
+	getDeploymentsReturns struct {
+		result1 []ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentsReturnsOnCall map[int]struct {
+		result1 []ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetIsolationSegmentStub        func(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	getIsolationSegmentMutex       sync.RWMutex
 	getIsolationSegmentArgsForCall []struct {
@@ -201,6 +408,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetJobStub        func(guid string) (ccv3.Job, ccv3.Warnings, error)
+	getJobMutex       sync.RWMutex
+	getJobArgsForCall []struct {
+		guid string
+	}
+	getJobReturns struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getJobReturnsOnCall map[int]struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetOrganizationDefaultIsolationSegmentStub        func(orgGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	getOrganizationDefaultIsolationSegmentMutex       sync.RWMutex
 	getOrganizationDefaultIsolationSegmentArgsForCall []struct {
@@ -246,6 +468,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetRevisionEnvironmentVariablesStub        func(revision ccv3.Revision) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	getRevisionEnvironmentVariablesMutex       sync.RWMutex
+	getRevisionEnvironmentVariablesArgsForCall []struct {
+		revision ccv3.Revision
+	}
+	getRevisionEnvironmentVariablesReturns struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRevisionEnvironmentVariablesReturnsOnCall map[int]struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetServiceInstancesStub        func(query url.Values) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
+	getServiceInstancesMutex       sync.RWMutex
+	getServiceInstancesArgsForCall []struct {
+		query url.Values
+	}
+	getServiceInstancesReturns struct {
+		result1 []ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceInstancesReturnsOnCall map[int]struct {
+		result1 []ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetServicePlanStub        func(guid string) (ccv3.ServicePlan, ccv3.Warnings, error)
+	getServicePlanMutex       sync.RWMutex
+	getServicePlanArgsForCall []struct {
+		guid string
+	}
+	getServicePlanReturns struct {
+		result1 ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServicePlanReturnsOnCall map[int]struct {
+		result1 ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpaceIsolationSegmentStub        func(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	getSpaceIsolationSegmentMutex       sync.RWMutex
 	getSpaceIsolationSegmentArgsForCall []struct {
@@ -261,6 +528,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetSpaceManifestDiffStub        func(spaceGUID string, rawManifest []byte) (ccv3.ManifestDiff, ccv3.Warnings, error)
+	getSpaceManifestDiffMutex       sync.RWMutex
+	getSpaceManifestDiffArgsForCall []struct {
+		spaceGUID   string
+		rawManifest []byte
+	}
+	getSpaceManifestDiffReturns struct {
+		result1 ccv3.ManifestDiff
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getSpaceManifestDiffReturnsOnCall map[int]struct {
+		result1 ccv3.ManifestDiff
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetTaskStub        func(guid string) (ccv3.Task, ccv3.Warnings, error)
+	getTaskMutex       sync.RWMutex
+	getTaskArgsForCall []struct {
+		guid string
+	}
+	getTaskReturns struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getTaskReturnsOnCall map[int]struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
 	RevokeIsolationSegmentFromOrganizationStub        func(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error)
 	revokeIsolationSegmentFromOrganizationMutex       sync.RWMutex
 	revokeIsolationSegmentFromOrganizationArgsForCall []struct {
@@ -275,6 +573,117 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	SetApplicationDropletStub        func(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	setApplicationDropletMutex       sync.RWMutex
+	setApplicationDropletArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	setApplicationDropletReturns struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}
+	setApplicationDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateApplicationEnvironmentVariablesStub        func(appGUID string, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	updateApplicationEnvironmentVariablesMutex       sync.RWMutex
+	updateApplicationEnvironmentVariablesArgsForCall []struct {
+		appGUID string
+		envVars ccv3.EnvironmentVariables
+	}
+	updateApplicationEnvironmentVariablesReturns struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateApplicationEnvironmentVariablesReturnsOnCall map[int]struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateApplicationMetadataStub        func(appGUID string, metadata ccv3.Metadata) (ccv3.Application, ccv3.Warnings, error)
+	updateApplicationMetadataMutex       sync.RWMutex
+	updateApplicationMetadataArgsForCall []struct {
+		appGUID  string
+		metadata ccv3.Metadata
+	}
+	updateApplicationMetadataReturns struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateApplicationMetadataReturnsOnCall map[int]struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateProcessStub        func(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
+	updateProcessMutex       sync.RWMutex
+	updateProcessArgsForCall []struct {
+		process ccv3.Process
+	}
+	updateProcessReturns struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateProcessReturnsOnCall map[int]struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateRouteSpaceRelationshipStub        func(routeGUID string, spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	updateRouteSpaceRelationshipMutex       sync.RWMutex
+	updateRouteSpaceRelationshipArgsForCall []struct {
+		routeGUID string
+		spaceGUID string
+	}
+	updateRouteSpaceRelationshipReturns struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateRouteSpaceRelationshipReturnsOnCall map[int]struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateServiceInstanceMaintenanceInfoStub        func(guid string, maintenanceInfo ccv3.MaintenanceInfo) (ccv3.Job, ccv3.Warnings, error)
+	updateServiceInstanceMaintenanceInfoMutex       sync.RWMutex
+	updateServiceInstanceMaintenanceInfoArgsForCall []struct {
+		guid            string
+		maintenanceInfo ccv3.MaintenanceInfo
+	}
+	updateServiceInstanceMaintenanceInfoReturns struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateServiceInstanceMaintenanceInfoReturnsOnCall map[int]struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateSpaceApplyManifestStub        func(spaceGUID string, rawManifest []byte) (ccv3.Job, ccv3.Warnings, error)
+	updateSpaceApplyManifestMutex       sync.RWMutex
+	updateSpaceApplyManifestArgsForCall []struct {
+		spaceGUID   string
+		rawManifest []byte
+	}
+	updateSpaceApplyManifestReturns struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSpaceApplyManifestReturnsOnCall map[int]struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	updateTaskMutex       sync.RWMutex
 	updateTaskArgsForCall []struct {
@@ -459,49 +868,154 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error) {
-	fake.createApplicationTaskMutex.Lock()
-	ret, specificReturn := fake.createApplicationTaskReturnsOnCall[len(fake.createApplicationTaskArgsForCall)]
-	fake.createApplicationTaskArgsForCall = append(fake.createApplicationTaskArgsForCall, struct {
-		appGUID string
-		task    ccv3.Task
-	}{appGUID, task})
-	fake.recordInvocation("CreateApplicationTask", []interface{}{appGUID, task})
-	fake.createApplicationTaskMutex.Unlock()
-	if fake.CreateApplicationTaskStub != nil {
-		return fake.CreateApplicationTaskStub(appGUID, task)
+func (fake *FakeCloudControllerClient) ContinueDeployment(guid string) (ccv3.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("ContinueDeployment", []interface{}{guid})
+	fake.continueDeploymentMutex.Unlock()
+	if fake.ContinueDeploymentStub != nil {
+		return fake.ContinueDeploymentStub(guid)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.createApplicationTaskReturns.result1, fake.createApplicationTaskReturns.result2, fake.createApplicationTaskReturns.result3
+	return fake.continueDeploymentReturns.result1, fake.continueDeploymentReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationTaskCallCount() int {
-	fake.createApplicationTaskMutex.RLock()
-	defer fake.createApplicationTaskMutex.RUnlock()
-	return len(fake.createApplicationTaskArgsForCall)
+func (fake *FakeCloudControllerClient) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationTaskArgsForCall(i int) (string, ccv3.Task) {
-	fake.createApplicationTaskMutex.RLock()
-	defer fake.createApplicationTaskMutex.RUnlock()
-	return fake.createApplicationTaskArgsForCall[i].appGUID, fake.createApplicationTaskArgsForCall[i].task
+func (fake *FakeCloudControllerClient) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return fake.continueDeploymentArgsForCall[i].guid
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationTaskReturns(result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
-	fake.CreateApplicationTaskStub = nil
-	fake.createApplicationTaskReturns = struct {
-		result1 ccv3.Task
-		result2 ccv3.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) ContinueDeploymentReturns(result1 ccv3.Warnings, result2 error) {
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationTaskReturnsOnCall(i int, result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
-	fake.CreateApplicationTaskStub = nil
-	if fake.createApplicationTaskReturnsOnCall == nil {
-		fake.createApplicationTaskReturnsOnCall = make(map[int]struct {
+func (fake *FakeCloudControllerClient) ContinueDeploymentReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(deployment ccv3.Deployment) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
+	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
+		deployment ccv3.Deployment
+	}{deployment})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{deployment})
+	fake.createApplicationDeploymentMutex.Unlock()
+	if fake.CreateApplicationDeploymentStub != nil {
+		return fake.CreateApplicationDeploymentStub(deployment)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDeploymentReturns.result1, fake.createApplicationDeploymentReturns.result2, fake.createApplicationDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() int {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return len(fake.createApplicationDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) ccv3.Deployment {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return fake.createApplicationDeploymentArgsForCall[i].deployment
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	fake.createApplicationDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	if fake.createApplicationDeploymentReturnsOnCall == nil {
+		fake.createApplicationDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error) {
+	fake.createApplicationTaskMutex.Lock()
+	ret, specificReturn := fake.createApplicationTaskReturnsOnCall[len(fake.createApplicationTaskArgsForCall)]
+	fake.createApplicationTaskArgsForCall = append(fake.createApplicationTaskArgsForCall, struct {
+		appGUID string
+		task    ccv3.Task
+	}{appGUID, task})
+	fake.recordInvocation("CreateApplicationTask", []interface{}{appGUID, task})
+	fake.createApplicationTaskMutex.Unlock()
+	if fake.CreateApplicationTaskStub != nil {
+		return fake.CreateApplicationTaskStub(appGUID, task)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationTaskReturns.result1, fake.createApplicationTaskReturns.result2, fake.createApplicationTaskReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationTaskCallCount() int {
+	fake.createApplicationTaskMutex.RLock()
+	defer fake.createApplicationTaskMutex.RUnlock()
+	return len(fake.createApplicationTaskArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationTaskArgsForCall(i int) (string, ccv3.Task) {
+	fake.createApplicationTaskMutex.RLock()
+	defer fake.createApplicationTaskMutex.RUnlock()
+	return fake.createApplicationTaskArgsForCall[i].appGUID, fake.createApplicationTaskArgsForCall[i].task
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationTaskReturns(result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationTaskStub = nil
+	fake.createApplicationTaskReturns = struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationTaskReturnsOnCall(i int, result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationTaskStub = nil
+	if fake.createApplicationTaskReturnsOnCall == nil {
+		fake.createApplicationTaskReturnsOnCall = make(map[int]struct {
 			result1 ccv3.Task
 			result2 ccv3.Warnings
 			result3 error
@@ -514,6 +1028,60 @@ func (fake *FakeCloudControllerClient) CreateApplicationTaskReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateBuild(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error) {
+	fake.createBuildMutex.Lock()
+	ret, specificReturn := fake.createBuildReturnsOnCall[len(fake.createBuildArgsForCall)]
+	fake.createBuildArgsForCall = append(fake.createBuildArgsForCall, struct {
+		build ccv3.Build
+	}{build})
+	fake.recordInvocation("CreateBuild", []interface{}{build})
+	fake.createBuildMutex.Unlock()
+	if fake.CreateBuildStub != nil {
+		return fake.CreateBuildStub(build)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createBuildReturns.result1, fake.createBuildReturns.result2, fake.createBuildReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildCallCount() int {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	return len(fake.createBuildArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildArgsForCall(i int) ccv3.Build {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	return fake.createBuildArgsForCall[i].build
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildReturns(result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.CreateBuildStub = nil
+	fake.createBuildReturns = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildReturnsOnCall(i int, result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.CreateBuildStub = nil
+	if fake.createBuildReturnsOnCall == nil {
+		fake.createBuildReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Build
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createBuildReturnsOnCall[i] = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateIsolationSegment(isolationSegment ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.createIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.createIsolationSegmentReturnsOnCall[len(fake.createIsolationSegmentArgsForCall)]
@@ -622,6 +1190,57 @@ func (fake *FakeCloudControllerClient) CreatePackageReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteDroplet(guid string) (ccv3.Warnings, error) {
+	fake.deleteDropletMutex.Lock()
+	ret, specificReturn := fake.deleteDropletReturnsOnCall[len(fake.deleteDropletArgsForCall)]
+	fake.deleteDropletArgsForCall = append(fake.deleteDropletArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteDroplet", []interface{}{guid})
+	fake.deleteDropletMutex.Unlock()
+	if fake.DeleteDropletStub != nil {
+		return fake.DeleteDropletStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteDropletReturns.result1, fake.deleteDropletReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCallCount() int {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return len(fake.deleteDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletArgsForCall(i int) string {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return fake.deleteDropletArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturns(result1 ccv3.Warnings, result2 error) {
+	fake.DeleteDropletStub = nil
+	fake.deleteDropletReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.DeleteDropletStub = nil
+	if fake.deleteDropletReturnsOnCall == nil {
+		fake.deleteDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.deleteDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteIsolationSegment(guid string) (ccv3.Warnings, error) {
 	fake.deleteIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.deleteIsolationSegmentReturnsOnCall[len(fake.deleteIsolationSegmentArgsForCall)]
@@ -673,6 +1292,57 @@ func (fake *FakeCloudControllerClient) DeleteIsolationSegmentReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeletePackage(guid string) (ccv3.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeletePackage", []interface{}{guid})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deletePackageReturns.result1, fake.deletePackageReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return fake.deletePackageArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturns(result1 ccv3.Warnings, result2 error) {
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
 	var orgGUIDsCopy []string
 	if orgGUIDs != nil {
@@ -733,543 +1403,1751 @@ func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizationsRet
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplications(query url.Values) ([]ccv3.Application, ccv3.Warnings, error) {
-	fake.getApplicationsMutex.Lock()
-	ret, specificReturn := fake.getApplicationsReturnsOnCall[len(fake.getApplicationsArgsForCall)]
-	fake.getApplicationsArgsForCall = append(fake.getApplicationsArgsForCall, struct {
-		query url.Values
-	}{query})
-	fake.recordInvocation("GetApplications", []interface{}{query})
-	fake.getApplicationsMutex.Unlock()
-	if fake.GetApplicationsStub != nil {
-		return fake.GetApplicationsStub(query)
+func (fake *FakeCloudControllerClient) GetApplicationCurrentDroplet(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.getApplicationCurrentDropletMutex.Lock()
+	ret, specificReturn := fake.getApplicationCurrentDropletReturnsOnCall[len(fake.getApplicationCurrentDropletArgsForCall)]
+	fake.getApplicationCurrentDropletArgsForCall = append(fake.getApplicationCurrentDropletArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationCurrentDroplet", []interface{}{appGUID})
+	fake.getApplicationCurrentDropletMutex.Unlock()
+	if fake.GetApplicationCurrentDropletStub != nil {
+		return fake.GetApplicationCurrentDropletStub(appGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationsReturns.result1, fake.getApplicationsReturns.result2, fake.getApplicationsReturns.result3
+	return fake.getApplicationCurrentDropletReturns.result1, fake.getApplicationCurrentDropletReturns.result2, fake.getApplicationCurrentDropletReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsCallCount() int {
-	fake.getApplicationsMutex.RLock()
-	defer fake.getApplicationsMutex.RUnlock()
-	return len(fake.getApplicationsArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationCurrentDropletCallCount() int {
+	fake.getApplicationCurrentDropletMutex.RLock()
+	defer fake.getApplicationCurrentDropletMutex.RUnlock()
+	return len(fake.getApplicationCurrentDropletArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsArgsForCall(i int) url.Values {
-	fake.getApplicationsMutex.RLock()
-	defer fake.getApplicationsMutex.RUnlock()
-	return fake.getApplicationsArgsForCall[i].query
+func (fake *FakeCloudControllerClient) GetApplicationCurrentDropletArgsForCall(i int) string {
+	fake.getApplicationCurrentDropletMutex.RLock()
+	defer fake.getApplicationCurrentDropletMutex.RUnlock()
+	return fake.getApplicationCurrentDropletArgsForCall[i].appGUID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsReturns(result1 []ccv3.Application, result2 ccv3.Warnings, result3 error) {
-	fake.GetApplicationsStub = nil
-	fake.getApplicationsReturns = struct {
-		result1 []ccv3.Application
+func (fake *FakeCloudControllerClient) GetApplicationCurrentDropletReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationCurrentDropletStub = nil
+	fake.getApplicationCurrentDropletReturns = struct {
+		result1 ccv3.Droplet
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, result1 []ccv3.Application, result2 ccv3.Warnings, result3 error) {
-	fake.GetApplicationsStub = nil
-	if fake.getApplicationsReturnsOnCall == nil {
-		fake.getApplicationsReturnsOnCall = make(map[int]struct {
-			result1 []ccv3.Application
+func (fake *FakeCloudControllerClient) GetApplicationCurrentDropletReturnsOnCall(i int, result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationCurrentDropletStub = nil
+	if fake.getApplicationCurrentDropletReturnsOnCall == nil {
+		fake.getApplicationCurrentDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Droplet
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationsReturnsOnCall[i] = struct {
-		result1 []ccv3.Application
+	fake.getApplicationCurrentDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Droplet
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
-	fake.getApplicationTasksMutex.Lock()
-	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
-	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
+func (fake *FakeCloudControllerClient) GetApplicationEnvironment(appGUID string) (ccv3.Environment, ccv3.Warnings, error) {
+	fake.getApplicationEnvironmentMutex.Lock()
+	ret, specificReturn := fake.getApplicationEnvironmentReturnsOnCall[len(fake.getApplicationEnvironmentArgsForCall)]
+	fake.getApplicationEnvironmentArgsForCall = append(fake.getApplicationEnvironmentArgsForCall, struct {
 		appGUID string
-		query   url.Values
-	}{appGUID, query})
-	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, query})
-	fake.getApplicationTasksMutex.Unlock()
-	if fake.GetApplicationTasksStub != nil {
-		return fake.GetApplicationTasksStub(appGUID, query)
+	}{appGUID})
+	fake.recordInvocation("GetApplicationEnvironment", []interface{}{appGUID})
+	fake.getApplicationEnvironmentMutex.Unlock()
+	if fake.GetApplicationEnvironmentStub != nil {
+		return fake.GetApplicationEnvironmentStub(appGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationTasksReturns.result1, fake.getApplicationTasksReturns.result2, fake.getApplicationTasksReturns.result3
+	return fake.getApplicationEnvironmentReturns.result1, fake.getApplicationEnvironmentReturns.result2, fake.getApplicationEnvironmentReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationTasksCallCount() int {
-	fake.getApplicationTasksMutex.RLock()
-	defer fake.getApplicationTasksMutex.RUnlock()
-	return len(fake.getApplicationTasksArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentCallCount() int {
+	fake.getApplicationEnvironmentMutex.RLock()
+	defer fake.getApplicationEnvironmentMutex.RUnlock()
+	return len(fake.getApplicationEnvironmentArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationTasksArgsForCall(i int) (string, url.Values) {
-	fake.getApplicationTasksMutex.RLock()
-	defer fake.getApplicationTasksMutex.RUnlock()
-	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].query
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentArgsForCall(i int) string {
+	fake.getApplicationEnvironmentMutex.RLock()
+	defer fake.getApplicationEnvironmentMutex.RUnlock()
+	return fake.getApplicationEnvironmentArgsForCall[i].appGUID
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationTasksReturns(result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
-	fake.GetApplicationTasksStub = nil
-	fake.getApplicationTasksReturns = struct {
-		result1 []ccv3.Task
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentReturns(result1 ccv3.Environment, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationEnvironmentStub = nil
+	fake.getApplicationEnvironmentReturns = struct {
+		result1 ccv3.Environment
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationTasksReturnsOnCall(i int, result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
-	fake.GetApplicationTasksStub = nil
-	if fake.getApplicationTasksReturnsOnCall == nil {
-		fake.getApplicationTasksReturnsOnCall = make(map[int]struct {
-			result1 []ccv3.Task
+func (fake *FakeCloudControllerClient) GetApplicationEnvironmentReturnsOnCall(i int, result1 ccv3.Environment, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationEnvironmentStub = nil
+	if fake.getApplicationEnvironmentReturnsOnCall == nil {
+		fake.getApplicationEnvironmentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Environment
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationTasksReturnsOnCall[i] = struct {
-		result1 []ccv3.Task
+	fake.getApplicationEnvironmentReturnsOnCall[i] = struct {
+		result1 ccv3.Environment
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error) {
-	fake.getIsolationSegmentMutex.Lock()
-	ret, specificReturn := fake.getIsolationSegmentReturnsOnCall[len(fake.getIsolationSegmentArgsForCall)]
-	fake.getIsolationSegmentArgsForCall = append(fake.getIsolationSegmentArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetIsolationSegment", []interface{}{guid})
-	fake.getIsolationSegmentMutex.Unlock()
-	if fake.GetIsolationSegmentStub != nil {
-		return fake.GetIsolationSegmentStub(guid)
+func (fake *FakeCloudControllerClient) GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
+	fake.getApplicationDropletsMutex.Lock()
+	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
+	fake.getApplicationDropletsArgsForCall = append(fake.getApplicationDropletsArgsForCall, struct {
+		appGUID string
+		query   url.Values
+	}{appGUID, query})
+	fake.recordInvocation("GetApplicationDroplets", []interface{}{appGUID, query})
+	fake.getApplicationDropletsMutex.Unlock()
+	if fake.GetApplicationDropletsStub != nil {
+		return fake.GetApplicationDropletsStub(appGUID, query)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getIsolationSegmentReturns.result1, fake.getIsolationSegmentReturns.result2, fake.getIsolationSegmentReturns.result3
+	return fake.getApplicationDropletsReturns.result1, fake.getApplicationDropletsReturns.result2, fake.getApplicationDropletsReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentCallCount() int {
-	fake.getIsolationSegmentMutex.RLock()
-	defer fake.getIsolationSegmentMutex.RUnlock()
-	return len(fake.getIsolationSegmentArgsForCall)
+func (fake *FakeCloudControllerClient) GetApplicationDropletsCallCount() int {
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	return len(fake.getApplicationDropletsArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentArgsForCall(i int) string {
-	fake.getIsolationSegmentMutex.RLock()
-	defer fake.getIsolationSegmentMutex.RUnlock()
-	return fake.getIsolationSegmentArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) GetApplicationDropletsArgsForCall(i int) (string, url.Values) {
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	return fake.getApplicationDropletsArgsForCall[i].appGUID, fake.getApplicationDropletsArgsForCall[i].query
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentReturns(result1 ccv3.IsolationSegment, result2 ccv3.Warnings, result3 error) {
-	fake.GetIsolationSegmentStub = nil
-	fake.getIsolationSegmentReturns = struct {
-		result1 ccv3.IsolationSegment
+func (fake *FakeCloudControllerClient) GetApplicationDropletsReturns(result1 []ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationDropletsStub = nil
+	fake.getApplicationDropletsReturns = struct {
+		result1 []ccv3.Droplet
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentReturnsOnCall(i int, result1 ccv3.IsolationSegment, result2 ccv3.Warnings, result3 error) {
-	fake.GetIsolationSegmentStub = nil
-	if fake.getIsolationSegmentReturnsOnCall == nil {
-		fake.getIsolationSegmentReturnsOnCall = make(map[int]struct {
-			result1 ccv3.IsolationSegment
+func (fake *FakeCloudControllerClient) GetApplicationDropletsReturnsOnCall(i int, result1 []ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationDropletsStub = nil
+	if fake.getApplicationDropletsReturnsOnCall == nil {
+		fake.getApplicationDropletsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Droplet
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getIsolationSegmentReturnsOnCall[i] = struct {
-		result1 ccv3.IsolationSegment
+	fake.getApplicationDropletsReturnsOnCall[i] = struct {
+		result1 []ccv3.Droplet
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegment(isolationSegmentGUID string) ([]ccv3.Organization, ccv3.Warnings, error) {
-	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.Lock()
-	ret, specificReturn := fake.getIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall[len(fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall)]
-	fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall = append(fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall, struct {
-		isolationSegmentGUID string
-	}{isolationSegmentGUID})
-	fake.recordInvocation("GetIsolationSegmentOrganizationsByIsolationSegment", []interface{}{isolationSegmentGUID})
-	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.Unlock()
-	if fake.GetIsolationSegmentOrganizationsByIsolationSegmentStub != nil {
-		return fake.GetIsolationSegmentOrganizationsByIsolationSegmentStub(isolationSegmentGUID)
+func (fake *FakeCloudControllerClient) GetApplicationPackages(appGUID string, query url.Values) ([]ccv3.Package, ccv3.Warnings, error) {
+	fake.getApplicationPackagesMutex.Lock()
+	ret, specificReturn := fake.getApplicationPackagesReturnsOnCall[len(fake.getApplicationPackagesArgsForCall)]
+	fake.getApplicationPackagesArgsForCall = append(fake.getApplicationPackagesArgsForCall, struct {
+		appGUID string
+		query   url.Values
+	}{appGUID, query})
+	fake.recordInvocation("GetApplicationPackages", []interface{}{appGUID, query})
+	fake.getApplicationPackagesMutex.Unlock()
+	if fake.GetApplicationPackagesStub != nil {
+		return fake.GetApplicationPackagesStub(appGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationPackagesReturns.result1, fake.getApplicationPackagesReturns.result2, fake.getApplicationPackagesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationPackagesCallCount() int {
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	return len(fake.getApplicationPackagesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationPackagesArgsForCall(i int) (string, url.Values) {
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	return fake.getApplicationPackagesArgsForCall[i].appGUID, fake.getApplicationPackagesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationPackagesReturns(result1 []ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationPackagesStub = nil
+	fake.getApplicationPackagesReturns = struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationPackagesReturnsOnCall(i int, result1 []ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationPackagesStub = nil
+	if fake.getApplicationPackagesReturnsOnCall == nil {
+		fake.getApplicationPackagesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Package
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationPackagesReturnsOnCall[i] = struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcesses(appGUID string) ([]ccv3.Process, ccv3.Warnings, error) {
+	fake.getApplicationProcessesMutex.Lock()
+	ret, specificReturn := fake.getApplicationProcessesReturnsOnCall[len(fake.getApplicationProcessesArgsForCall)]
+	fake.getApplicationProcessesArgsForCall = append(fake.getApplicationProcessesArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationProcesses", []interface{}{appGUID})
+	fake.getApplicationProcessesMutex.Unlock()
+	if fake.GetApplicationProcessesStub != nil {
+		return fake.GetApplicationProcessesStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationProcessesReturns.result1, fake.getApplicationProcessesReturns.result2, fake.getApplicationProcessesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesCallCount() int {
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	return len(fake.getApplicationProcessesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesArgsForCall(i int) string {
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	return fake.getApplicationProcessesArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesReturns(result1 []ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationProcessesStub = nil
+	fake.getApplicationProcessesReturns = struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesReturnsOnCall(i int, result1 []ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationProcessesStub = nil
+	if fake.getApplicationProcessesReturnsOnCall == nil {
+		fake.getApplicationProcessesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Process
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationProcessesReturnsOnCall[i] = struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisions(appGUID string, query url.Values) ([]ccv3.Revision, ccv3.Warnings, error) {
+	fake.getApplicationRevisionsMutex.Lock()
+	ret, specificReturn := fake.getApplicationRevisionsReturnsOnCall[len(fake.getApplicationRevisionsArgsForCall)]
+	fake.getApplicationRevisionsArgsForCall = append(fake.getApplicationRevisionsArgsForCall, struct {
+		appGUID string
+		query   url.Values
+	}{appGUID, query})
+	fake.recordInvocation("GetApplicationRevisions", []interface{}{appGUID, query})
+	fake.getApplicationRevisionsMutex.Unlock()
+	if fake.GetApplicationRevisionsStub != nil {
+		return fake.GetApplicationRevisionsStub(appGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationRevisionsReturns.result1, fake.getApplicationRevisionsReturns.result2, fake.getApplicationRevisionsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsCallCount() int {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	return len(fake.getApplicationRevisionsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsArgsForCall(i int) (string, url.Values) {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	return fake.getApplicationRevisionsArgsForCall[i].appGUID, fake.getApplicationRevisionsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturns(result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationRevisionsStub = nil
+	fake.getApplicationRevisionsReturns = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturnsOnCall(i int, result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationRevisionsStub = nil
+	if fake.getApplicationRevisionsReturnsOnCall == nil {
+		fake.getApplicationRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Revision
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRevisionsReturnsOnCall[i] = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplications(query url.Values) ([]ccv3.Application, ccv3.Warnings, error) {
+	fake.getApplicationsMutex.Lock()
+	ret, specificReturn := fake.getApplicationsReturnsOnCall[len(fake.getApplicationsArgsForCall)]
+	fake.getApplicationsArgsForCall = append(fake.getApplicationsArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetApplications", []interface{}{query})
+	fake.getApplicationsMutex.Unlock()
+	if fake.GetApplicationsStub != nil {
+		return fake.GetApplicationsStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsReturns.result1, fake.getApplicationsReturns.result2, fake.getApplicationsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsCallCount() int {
+	fake.getApplicationsMutex.RLock()
+	defer fake.getApplicationsMutex.RUnlock()
+	return len(fake.getApplicationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsArgsForCall(i int) url.Values {
+	fake.getApplicationsMutex.RLock()
+	defer fake.getApplicationsMutex.RUnlock()
+	return fake.getApplicationsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsReturns(result1 []ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationsStub = nil
+	fake.getApplicationsReturns = struct {
+		result1 []ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, result1 []ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationsStub = nil
+	if fake.getApplicationsReturnsOnCall == nil {
+		fake.getApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Application
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsReturnsOnCall[i] = struct {
+		result1 []ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
+	fake.getApplicationTasksMutex.Lock()
+	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
+	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
+		appGUID string
+		query   url.Values
+	}{appGUID, query})
+	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, query})
+	fake.getApplicationTasksMutex.Unlock()
+	if fake.GetApplicationTasksStub != nil {
+		return fake.GetApplicationTasksStub(appGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationTasksReturns.result1, fake.getApplicationTasksReturns.result2, fake.getApplicationTasksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasksCallCount() int {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return len(fake.getApplicationTasksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasksArgsForCall(i int) (string, url.Values) {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasksReturns(result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationTasksStub = nil
+	fake.getApplicationTasksReturns = struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasksReturnsOnCall(i int, result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationTasksStub = nil
+	if fake.getApplicationTasksReturnsOnCall == nil {
+		fake.getApplicationTasksReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Task
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationTasksReturnsOnCall[i] = struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuild(guid string) (ccv3.Build, ccv3.Warnings, error) {
+	fake.getBuildMutex.Lock()
+	ret, specificReturn := fake.getBuildReturnsOnCall[len(fake.getBuildArgsForCall)]
+	fake.getBuildArgsForCall = append(fake.getBuildArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetBuild", []interface{}{guid})
+	fake.getBuildMutex.Unlock()
+	if fake.GetBuildStub != nil {
+		return fake.GetBuildStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildReturns.result1, fake.getBuildReturns.result2, fake.getBuildReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetBuildCallCount() int {
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	return len(fake.getBuildArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetBuildArgsForCall(i int) string {
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	return fake.getBuildArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetBuildReturns(result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.GetBuildStub = nil
+	fake.getBuildReturns = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.GetBuildStub = nil
+	if fake.getBuildReturnsOnCall == nil {
+		fake.getBuildReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Build
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildReturnsOnCall[i] = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeployment(guid string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetDeployment", []interface{}{guid})
+	fake.getDeploymentMutex.Unlock()
+	if fake.GetDeploymentStub != nil {
+		return fake.GetDeploymentStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDeploymentReturns.result1, fake.getDeploymentReturns.result2, fake.getDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return fake.getDeploymentArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeployments(query url.Values) ([]ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentsMutex.Lock()
+	ret, specificReturn := fake.getDeploymentsReturnsOnCall[len(fake.getDeploymentsArgsForCall)]
+	fake.getDeploymentsArgsForCall = append(fake.getDeploymentsArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetDeployments", []interface{}{query})
+	fake.getDeploymentsMutex.Unlock()
+	if fake.GetDeploymentsStub != nil {
+		return fake.GetDeploymentsStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDeploymentsReturns.result1, fake.getDeploymentsReturns.result2, fake.getDeploymentsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentsCallCount() int {
+	fake.getDeploymentsMutex.RLock()
+	defer fake.getDeploymentsMutex.RUnlock()
+	return len(fake.getDeploymentsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentsArgsForCall(i int) url.Values {
+	fake.getDeploymentsMutex.RLock()
+	defer fake.getDeploymentsMutex.RUnlock()
+	return fake.getDeploymentsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentsReturns(result1 []ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentsStub = nil
+	fake.getDeploymentsReturns = struct {
+		result1 []ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentsReturnsOnCall(i int, result1 []ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentsStub = nil
+	if fake.getDeploymentsReturnsOnCall == nil {
+		fake.getDeploymentsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentsReturnsOnCall[i] = struct {
+		result1 []ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error) {
+	fake.getIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentReturnsOnCall[len(fake.getIsolationSegmentArgsForCall)]
+	fake.getIsolationSegmentArgsForCall = append(fake.getIsolationSegmentArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetIsolationSegment", []interface{}{guid})
+	fake.getIsolationSegmentMutex.Unlock()
+	if fake.GetIsolationSegmentStub != nil {
+		return fake.GetIsolationSegmentStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentReturns.result1, fake.getIsolationSegmentReturns.result2, fake.getIsolationSegmentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentCallCount() int {
+	fake.getIsolationSegmentMutex.RLock()
+	defer fake.getIsolationSegmentMutex.RUnlock()
+	return len(fake.getIsolationSegmentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentArgsForCall(i int) string {
+	fake.getIsolationSegmentMutex.RLock()
+	defer fake.getIsolationSegmentMutex.RUnlock()
+	return fake.getIsolationSegmentArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentReturns(result1 ccv3.IsolationSegment, result2 ccv3.Warnings, result3 error) {
+	fake.GetIsolationSegmentStub = nil
+	fake.getIsolationSegmentReturns = struct {
+		result1 ccv3.IsolationSegment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentReturnsOnCall(i int, result1 ccv3.IsolationSegment, result2 ccv3.Warnings, result3 error) {
+	fake.GetIsolationSegmentStub = nil
+	if fake.getIsolationSegmentReturnsOnCall == nil {
+		fake.getIsolationSegmentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.IsolationSegment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentReturnsOnCall[i] = struct {
+		result1 ccv3.IsolationSegment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegment(isolationSegmentGUID string) ([]ccv3.Organization, ccv3.Warnings, error) {
+	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall[len(fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall)]
+	fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall = append(fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall, struct {
+		isolationSegmentGUID string
+	}{isolationSegmentGUID})
+	fake.recordInvocation("GetIsolationSegmentOrganizationsByIsolationSegment", []interface{}{isolationSegmentGUID})
+	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.Unlock()
+	if fake.GetIsolationSegmentOrganizationsByIsolationSegmentStub != nil {
+		return fake.GetIsolationSegmentOrganizationsByIsolationSegmentStub(isolationSegmentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentOrganizationsByIsolationSegmentReturns.result1, fake.getIsolationSegmentOrganizationsByIsolationSegmentReturns.result2, fake.getIsolationSegmentOrganizationsByIsolationSegmentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegmentCallCount() int {
+	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RLock()
+	defer fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RUnlock()
+	return len(fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegmentArgsForCall(i int) string {
+	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RLock()
+	defer fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RUnlock()
+	return fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall[i].isolationSegmentGUID
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegmentReturns(result1 []ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.GetIsolationSegmentOrganizationsByIsolationSegmentStub = nil
+	fake.getIsolationSegmentOrganizationsByIsolationSegmentReturns = struct {
+		result1 []ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall(i int, result1 []ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.GetIsolationSegmentOrganizationsByIsolationSegmentStub = nil
+	if fake.getIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall == nil {
+		fake.getIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Organization
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall[i] = struct {
+		result1 []ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegments(query url.Values) ([]ccv3.IsolationSegment, ccv3.Warnings, error) {
+	fake.getIsolationSegmentsMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentsReturnsOnCall[len(fake.getIsolationSegmentsArgsForCall)]
+	fake.getIsolationSegmentsArgsForCall = append(fake.getIsolationSegmentsArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetIsolationSegments", []interface{}{query})
+	fake.getIsolationSegmentsMutex.Unlock()
+	if fake.GetIsolationSegmentsStub != nil {
+		return fake.GetIsolationSegmentsStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentsReturns.result1, fake.getIsolationSegmentsReturns.result2, fake.getIsolationSegmentsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentsCallCount() int {
+	fake.getIsolationSegmentsMutex.RLock()
+	defer fake.getIsolationSegmentsMutex.RUnlock()
+	return len(fake.getIsolationSegmentsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentsArgsForCall(i int) url.Values {
+	fake.getIsolationSegmentsMutex.RLock()
+	defer fake.getIsolationSegmentsMutex.RUnlock()
+	return fake.getIsolationSegmentsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentsReturns(result1 []ccv3.IsolationSegment, result2 ccv3.Warnings, result3 error) {
+	fake.GetIsolationSegmentsStub = nil
+	fake.getIsolationSegmentsReturns = struct {
+		result1 []ccv3.IsolationSegment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentsReturnsOnCall(i int, result1 []ccv3.IsolationSegment, result2 ccv3.Warnings, result3 error) {
+	fake.GetIsolationSegmentsStub = nil
+	if fake.getIsolationSegmentsReturnsOnCall == nil {
+		fake.getIsolationSegmentsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.IsolationSegment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentsReturnsOnCall[i] = struct {
+		result1 []ccv3.IsolationSegment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJob(guid string) (ccv3.Job, ccv3.Warnings, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetJob", []interface{}{guid})
+	fake.getJobMutex.Unlock()
+	if fake.GetJobStub != nil {
+		return fake.GetJobStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getJobReturns.result1, fake.getJobReturns.result2, fake.getJobReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetJobArgsForCall(i int) string {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return fake.getJobArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetJobReturns(result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJobReturnsOnCall(i int, result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Job
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegment(orgGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+	fake.getOrganizationDefaultIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.getOrganizationDefaultIsolationSegmentReturnsOnCall[len(fake.getOrganizationDefaultIsolationSegmentArgsForCall)]
+	fake.getOrganizationDefaultIsolationSegmentArgsForCall = append(fake.getOrganizationDefaultIsolationSegmentArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationDefaultIsolationSegment", []interface{}{orgGUID})
+	fake.getOrganizationDefaultIsolationSegmentMutex.Unlock()
+	if fake.GetOrganizationDefaultIsolationSegmentStub != nil {
+		return fake.GetOrganizationDefaultIsolationSegmentStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationDefaultIsolationSegmentReturns.result1, fake.getOrganizationDefaultIsolationSegmentReturns.result2, fake.getOrganizationDefaultIsolationSegmentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentCallCount() int {
+	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	return len(fake.getOrganizationDefaultIsolationSegmentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentArgsForCall(i int) string {
+	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	return fake.getOrganizationDefaultIsolationSegmentArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentReturns(result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.GetOrganizationDefaultIsolationSegmentStub = nil
+	fake.getOrganizationDefaultIsolationSegmentReturns = struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentReturnsOnCall(i int, result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.GetOrganizationDefaultIsolationSegmentStub = nil
+	if fake.getOrganizationDefaultIsolationSegmentReturnsOnCall == nil {
+		fake.getOrganizationDefaultIsolationSegmentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Relationship
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationDefaultIsolationSegmentReturnsOnCall[i] = struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizations(query url.Values) ([]ccv3.Organization, ccv3.Warnings, error) {
+	fake.getOrganizationsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
+	fake.getOrganizationsArgsForCall = append(fake.getOrganizationsArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetOrganizations", []interface{}{query})
+	fake.getOrganizationsMutex.Unlock()
+	if fake.GetOrganizationsStub != nil {
+		return fake.GetOrganizationsStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationsReturns.result1, fake.getOrganizationsReturns.result2, fake.getOrganizationsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsCallCount() int {
+	fake.getOrganizationsMutex.RLock()
+	defer fake.getOrganizationsMutex.RUnlock()
+	return len(fake.getOrganizationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsArgsForCall(i int) url.Values {
+	fake.getOrganizationsMutex.RLock()
+	defer fake.getOrganizationsMutex.RUnlock()
+	return fake.getOrganizationsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsReturns(result1 []ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.GetOrganizationsStub = nil
+	fake.getOrganizationsReturns = struct {
+		result1 []ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, result1 []ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.GetOrganizationsStub = nil
+	if fake.getOrganizationsReturnsOnCall == nil {
+		fake.getOrganizationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Organization
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationsReturnsOnCall[i] = struct {
+		result1 []ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetPackage(guid string) (ccv3.Package, ccv3.Warnings, error) {
+	fake.getPackageMutex.Lock()
+	ret, specificReturn := fake.getPackageReturnsOnCall[len(fake.getPackageArgsForCall)]
+	fake.getPackageArgsForCall = append(fake.getPackageArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetPackage", []interface{}{guid})
+	fake.getPackageMutex.Unlock()
+	if fake.GetPackageStub != nil {
+		return fake.GetPackageStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getPackageReturns.result1, fake.getPackageReturns.result2, fake.getPackageReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetPackageCallCount() int {
+	fake.getPackageMutex.RLock()
+	defer fake.getPackageMutex.RUnlock()
+	return len(fake.getPackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetPackageArgsForCall(i int) string {
+	fake.getPackageMutex.RLock()
+	defer fake.getPackageMutex.RUnlock()
+	return fake.getPackageArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetPackageReturns(result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.GetPackageStub = nil
+	fake.getPackageReturns = struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetPackageReturnsOnCall(i int, result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.GetPackageStub = nil
+	if fake.getPackageReturnsOnCall == nil {
+		fake.getPackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Package
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getPackageReturnsOnCall[i] = struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariables(revision ccv3.Revision) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	ret, specificReturn := fake.getRevisionEnvironmentVariablesReturnsOnCall[len(fake.getRevisionEnvironmentVariablesArgsForCall)]
+	fake.getRevisionEnvironmentVariablesArgsForCall = append(fake.getRevisionEnvironmentVariablesArgsForCall, struct {
+		revision ccv3.Revision
+	}{revision})
+	fake.recordInvocation("GetRevisionEnvironmentVariables", []interface{}{revision})
+	fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	if fake.GetRevisionEnvironmentVariablesStub != nil {
+		return fake.GetRevisionEnvironmentVariablesStub(revision)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRevisionEnvironmentVariablesReturns.result1, fake.getRevisionEnvironmentVariablesReturns.result2, fake.getRevisionEnvironmentVariablesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesCallCount() int {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	return len(fake.getRevisionEnvironmentVariablesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesArgsForCall(i int) ccv3.Revision {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	return fake.getRevisionEnvironmentVariablesArgsForCall[i].revision
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesReturns(result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	fake.getRevisionEnvironmentVariablesReturns = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesReturnsOnCall(i int, result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	if fake.getRevisionEnvironmentVariablesReturnsOnCall == nil {
+		fake.getRevisionEnvironmentVariablesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.EnvironmentVariables
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRevisionEnvironmentVariablesReturnsOnCall[i] = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstances(query url.Values) ([]ccv3.ServiceInstance, ccv3.Warnings, error) {
+	fake.getServiceInstancesMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
+	fake.getServiceInstancesArgsForCall = append(fake.getServiceInstancesArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetServiceInstances", []interface{}{query})
+	fake.getServiceInstancesMutex.Unlock()
+	if fake.GetServiceInstancesStub != nil {
+		return fake.GetServiceInstancesStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstancesReturns.result1, fake.getServiceInstancesReturns.result2, fake.getServiceInstancesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return len(fake.getServiceInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) url.Values {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return fake.getServiceInstancesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	fake.getServiceInstancesReturns = struct {
+		result1 []ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, result1 []ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	if fake.getServiceInstancesReturnsOnCall == nil {
+		fake.getServiceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ServiceInstance
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesReturnsOnCall[i] = struct {
+		result1 []ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlan(guid string) (ccv3.ServicePlan, ccv3.Warnings, error) {
+	fake.getServicePlanMutex.Lock()
+	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
+	fake.getServicePlanArgsForCall = append(fake.getServicePlanArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetServicePlan", []interface{}{guid})
+	fake.getServicePlanMutex.Unlock()
+	if fake.GetServicePlanStub != nil {
+		return fake.GetServicePlanStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanReturns.result1, fake.getServicePlanReturns.result2, fake.getServicePlanReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanCallCount() int {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return len(fake.getServicePlanArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanArgsForCall(i int) string {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return fake.getServicePlanArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturns(result1 ccv3.ServicePlan, result2 ccv3.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	fake.getServicePlanReturns = struct {
+		result1 ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanReturnsOnCall(i int, result1 ccv3.ServicePlan, result2 ccv3.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	if fake.getServicePlanReturnsOnCall == nil {
+		fake.getServicePlanReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ServicePlan
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanReturnsOnCall[i] = struct {
+		result1 ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+	fake.getSpaceIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
+	fake.getSpaceIsolationSegmentArgsForCall = append(fake.getSpaceIsolationSegmentArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceIsolationSegment", []interface{}{spaceGUID})
+	fake.getSpaceIsolationSegmentMutex.Unlock()
+	if fake.GetSpaceIsolationSegmentStub != nil {
+		return fake.GetSpaceIsolationSegmentStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceIsolationSegmentReturns.result1, fake.getSpaceIsolationSegmentReturns.result2, fake.getSpaceIsolationSegmentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentCallCount() int {
+	fake.getSpaceIsolationSegmentMutex.RLock()
+	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	return len(fake.getSpaceIsolationSegmentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentArgsForCall(i int) string {
+	fake.getSpaceIsolationSegmentMutex.RLock()
+	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	return fake.getSpaceIsolationSegmentArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturns(result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpaceIsolationSegmentStub = nil
+	fake.getSpaceIsolationSegmentReturns = struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturnsOnCall(i int, result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpaceIsolationSegmentStub = nil
+	if fake.getSpaceIsolationSegmentReturnsOnCall == nil {
+		fake.getSpaceIsolationSegmentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Relationship
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceIsolationSegmentReturnsOnCall[i] = struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManifestDiff(spaceGUID string, rawManifest []byte) (ccv3.ManifestDiff, ccv3.Warnings, error) {
+	var rawManifestCopy []byte
+	if rawManifest != nil {
+		rawManifestCopy = make([]byte, len(rawManifest))
+		copy(rawManifestCopy, rawManifest)
+	}
+	fake.getSpaceManifestDiffMutex.Lock()
+	ret, specificReturn := fake.getSpaceManifestDiffReturnsOnCall[len(fake.getSpaceManifestDiffArgsForCall)]
+	fake.getSpaceManifestDiffArgsForCall = append(fake.getSpaceManifestDiffArgsForCall, struct {
+		spaceGUID   string
+		rawManifest []byte
+	}{spaceGUID, rawManifestCopy})
+	fake.recordInvocation("GetSpaceManifestDiff", []interface{}{spaceGUID, rawManifestCopy})
+	fake.getSpaceManifestDiffMutex.Unlock()
+	if fake.GetSpaceManifestDiffStub != nil {
+		return fake.GetSpaceManifestDiffStub(spaceGUID, rawManifest)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceManifestDiffReturns.result1, fake.getSpaceManifestDiffReturns.result2, fake.getSpaceManifestDiffReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManifestDiffCallCount() int {
+	fake.getSpaceManifestDiffMutex.RLock()
+	defer fake.getSpaceManifestDiffMutex.RUnlock()
+	return len(fake.getSpaceManifestDiffArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManifestDiffArgsForCall(i int) (string, []byte) {
+	fake.getSpaceManifestDiffMutex.RLock()
+	defer fake.getSpaceManifestDiffMutex.RUnlock()
+	return fake.getSpaceManifestDiffArgsForCall[i].spaceGUID, fake.getSpaceManifestDiffArgsForCall[i].rawManifest
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManifestDiffReturns(result1 ccv3.ManifestDiff, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpaceManifestDiffStub = nil
+	fake.getSpaceManifestDiffReturns = struct {
+		result1 ccv3.ManifestDiff
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceManifestDiffReturnsOnCall(i int, result1 ccv3.ManifestDiff, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpaceManifestDiffStub = nil
+	if fake.getSpaceManifestDiffReturnsOnCall == nil {
+		fake.getSpaceManifestDiffReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ManifestDiff
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceManifestDiffReturnsOnCall[i] = struct {
+		result1 ccv3.ManifestDiff
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetTask(guid string) (ccv3.Task, ccv3.Warnings, error) {
+	fake.getTaskMutex.Lock()
+	ret, specificReturn := fake.getTaskReturnsOnCall[len(fake.getTaskArgsForCall)]
+	fake.getTaskArgsForCall = append(fake.getTaskArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetTask", []interface{}{guid})
+	fake.getTaskMutex.Unlock()
+	if fake.GetTaskStub != nil {
+		return fake.GetTaskStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getTaskReturns.result1, fake.getTaskReturns.result2, fake.getTaskReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetTaskCallCount() int {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return len(fake.getTaskArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetTaskArgsForCall(i int) string {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return fake.getTaskArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetTaskReturns(result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTaskStub = nil
+	fake.getTaskReturns = struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetTaskReturnsOnCall(i int, result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTaskStub = nil
+	if fake.getTaskReturnsOnCall == nil {
+		fake.getTaskReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Task
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getTaskReturnsOnCall[i] = struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganization(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error) {
+	fake.revokeIsolationSegmentFromOrganizationMutex.Lock()
+	ret, specificReturn := fake.revokeIsolationSegmentFromOrganizationReturnsOnCall[len(fake.revokeIsolationSegmentFromOrganizationArgsForCall)]
+	fake.revokeIsolationSegmentFromOrganizationArgsForCall = append(fake.revokeIsolationSegmentFromOrganizationArgsForCall, struct {
+		isolationSegmentGUID string
+		organizationGUID     string
+	}{isolationSegmentGUID, organizationGUID})
+	fake.recordInvocation("RevokeIsolationSegmentFromOrganization", []interface{}{isolationSegmentGUID, organizationGUID})
+	fake.revokeIsolationSegmentFromOrganizationMutex.Unlock()
+	if fake.RevokeIsolationSegmentFromOrganizationStub != nil {
+		return fake.RevokeIsolationSegmentFromOrganizationStub(isolationSegmentGUID, organizationGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.revokeIsolationSegmentFromOrganizationReturns.result1, fake.revokeIsolationSegmentFromOrganizationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationCallCount() int {
+	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
+	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
+	return len(fake.revokeIsolationSegmentFromOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationArgsForCall(i int) (string, string) {
+	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
+	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
+	return fake.revokeIsolationSegmentFromOrganizationArgsForCall[i].isolationSegmentGUID, fake.revokeIsolationSegmentFromOrganizationArgsForCall[i].organizationGUID
+}
+
+func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationReturns(result1 ccv3.Warnings, result2 error) {
+	fake.RevokeIsolationSegmentFromOrganizationStub = nil
+	fake.revokeIsolationSegmentFromOrganizationReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.RevokeIsolationSegmentFromOrganizationStub = nil
+	if fake.revokeIsolationSegmentFromOrganizationReturnsOnCall == nil {
+		fake.revokeIsolationSegmentFromOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.revokeIsolationSegmentFromOrganizationReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) SetApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+	fake.setApplicationDropletMutex.Lock()
+	ret, specificReturn := fake.setApplicationDropletReturnsOnCall[len(fake.setApplicationDropletArgsForCall)]
+	fake.setApplicationDropletArgsForCall = append(fake.setApplicationDropletArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("SetApplicationDroplet", []interface{}{appGUID, dropletGUID})
+	fake.setApplicationDropletMutex.Unlock()
+	if fake.SetApplicationDropletStub != nil {
+		return fake.SetApplicationDropletStub(appGUID, dropletGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getIsolationSegmentOrganizationsByIsolationSegmentReturns.result1, fake.getIsolationSegmentOrganizationsByIsolationSegmentReturns.result2, fake.getIsolationSegmentOrganizationsByIsolationSegmentReturns.result3
+	return fake.setApplicationDropletReturns.result1, fake.setApplicationDropletReturns.result2, fake.setApplicationDropletReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegmentCallCount() int {
-	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RLock()
-	defer fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RUnlock()
-	return len(fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall)
+func (fake *FakeCloudControllerClient) SetApplicationDropletCallCount() int {
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	return len(fake.setApplicationDropletArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegmentArgsForCall(i int) string {
-	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RLock()
-	defer fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RUnlock()
-	return fake.getIsolationSegmentOrganizationsByIsolationSegmentArgsForCall[i].isolationSegmentGUID
+func (fake *FakeCloudControllerClient) SetApplicationDropletArgsForCall(i int) (string, string) {
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	return fake.setApplicationDropletArgsForCall[i].appGUID, fake.setApplicationDropletArgsForCall[i].dropletGUID
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegmentReturns(result1 []ccv3.Organization, result2 ccv3.Warnings, result3 error) {
-	fake.GetIsolationSegmentOrganizationsByIsolationSegmentStub = nil
-	fake.getIsolationSegmentOrganizationsByIsolationSegmentReturns = struct {
-		result1 []ccv3.Organization
+func (fake *FakeCloudControllerClient) SetApplicationDropletReturns(result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.SetApplicationDropletStub = nil
+	fake.setApplicationDropletReturns = struct {
+		result1 ccv3.Relationship
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall(i int, result1 []ccv3.Organization, result2 ccv3.Warnings, result3 error) {
-	fake.GetIsolationSegmentOrganizationsByIsolationSegmentStub = nil
-	if fake.getIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall == nil {
-		fake.getIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall = make(map[int]struct {
-			result1 []ccv3.Organization
+func (fake *FakeCloudControllerClient) SetApplicationDropletReturnsOnCall(i int, result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.SetApplicationDropletStub = nil
+	if fake.setApplicationDropletReturnsOnCall == nil {
+		fake.setApplicationDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Relationship
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getIsolationSegmentOrganizationsByIsolationSegmentReturnsOnCall[i] = struct {
-		result1 []ccv3.Organization
+	fake.setApplicationDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Relationship
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegments(query url.Values) ([]ccv3.IsolationSegment, ccv3.Warnings, error) {
-	fake.getIsolationSegmentsMutex.Lock()
-	ret, specificReturn := fake.getIsolationSegmentsReturnsOnCall[len(fake.getIsolationSegmentsArgsForCall)]
-	fake.getIsolationSegmentsArgsForCall = append(fake.getIsolationSegmentsArgsForCall, struct {
-		query url.Values
-	}{query})
-	fake.recordInvocation("GetIsolationSegments", []interface{}{query})
-	fake.getIsolationSegmentsMutex.Unlock()
-	if fake.GetIsolationSegmentsStub != nil {
-		return fake.GetIsolationSegmentsStub(query)
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariables(appGUID string, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
+	fake.updateApplicationEnvironmentVariablesMutex.Lock()
+	ret, specificReturn := fake.updateApplicationEnvironmentVariablesReturnsOnCall[len(fake.updateApplicationEnvironmentVariablesArgsForCall)]
+	fake.updateApplicationEnvironmentVariablesArgsForCall = append(fake.updateApplicationEnvironmentVariablesArgsForCall, struct {
+		appGUID string
+		envVars ccv3.EnvironmentVariables
+	}{appGUID, envVars})
+	fake.recordInvocation("UpdateApplicationEnvironmentVariables", []interface{}{appGUID, envVars})
+	fake.updateApplicationEnvironmentVariablesMutex.Unlock()
+	if fake.UpdateApplicationEnvironmentVariablesStub != nil {
+		return fake.UpdateApplicationEnvironmentVariablesStub(appGUID, envVars)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getIsolationSegmentsReturns.result1, fake.getIsolationSegmentsReturns.result2, fake.getIsolationSegmentsReturns.result3
+	return fake.updateApplicationEnvironmentVariablesReturns.result1, fake.updateApplicationEnvironmentVariablesReturns.result2, fake.updateApplicationEnvironmentVariablesReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentsCallCount() int {
-	fake.getIsolationSegmentsMutex.RLock()
-	defer fake.getIsolationSegmentsMutex.RUnlock()
-	return len(fake.getIsolationSegmentsArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariablesCallCount() int {
+	fake.updateApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.updateApplicationEnvironmentVariablesMutex.RUnlock()
+	return len(fake.updateApplicationEnvironmentVariablesArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentsArgsForCall(i int) url.Values {
-	fake.getIsolationSegmentsMutex.RLock()
-	defer fake.getIsolationSegmentsMutex.RUnlock()
-	return fake.getIsolationSegmentsArgsForCall[i].query
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariablesArgsForCall(i int) (string, ccv3.EnvironmentVariables) {
+	fake.updateApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.updateApplicationEnvironmentVariablesMutex.RUnlock()
+	return fake.updateApplicationEnvironmentVariablesArgsForCall[i].appGUID, fake.updateApplicationEnvironmentVariablesArgsForCall[i].envVars
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentsReturns(result1 []ccv3.IsolationSegment, result2 ccv3.Warnings, result3 error) {
-	fake.GetIsolationSegmentsStub = nil
-	fake.getIsolationSegmentsReturns = struct {
-		result1 []ccv3.IsolationSegment
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariablesReturns(result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationEnvironmentVariablesStub = nil
+	fake.updateApplicationEnvironmentVariablesReturns = struct {
+		result1 ccv3.EnvironmentVariables
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetIsolationSegmentsReturnsOnCall(i int, result1 []ccv3.IsolationSegment, result2 ccv3.Warnings, result3 error) {
-	fake.GetIsolationSegmentsStub = nil
-	if fake.getIsolationSegmentsReturnsOnCall == nil {
-		fake.getIsolationSegmentsReturnsOnCall = make(map[int]struct {
-			result1 []ccv3.IsolationSegment
+func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariablesReturnsOnCall(i int, result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationEnvironmentVariablesStub = nil
+	if fake.updateApplicationEnvironmentVariablesReturnsOnCall == nil {
+		fake.updateApplicationEnvironmentVariablesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.EnvironmentVariables
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getIsolationSegmentsReturnsOnCall[i] = struct {
-		result1 []ccv3.IsolationSegment
+	fake.updateApplicationEnvironmentVariablesReturnsOnCall[i] = struct {
+		result1 ccv3.EnvironmentVariables
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegment(orgGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
-	fake.getOrganizationDefaultIsolationSegmentMutex.Lock()
-	ret, specificReturn := fake.getOrganizationDefaultIsolationSegmentReturnsOnCall[len(fake.getOrganizationDefaultIsolationSegmentArgsForCall)]
-	fake.getOrganizationDefaultIsolationSegmentArgsForCall = append(fake.getOrganizationDefaultIsolationSegmentArgsForCall, struct {
-		orgGUID string
-	}{orgGUID})
-	fake.recordInvocation("GetOrganizationDefaultIsolationSegment", []interface{}{orgGUID})
-	fake.getOrganizationDefaultIsolationSegmentMutex.Unlock()
-	if fake.GetOrganizationDefaultIsolationSegmentStub != nil {
-		return fake.GetOrganizationDefaultIsolationSegmentStub(orgGUID)
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadata(appGUID string, metadata ccv3.Metadata) (ccv3.Application, ccv3.Warnings, error) {
+	fake.updateApplicationMetadataMutex.Lock()
+	ret, specificReturn := fake.updateApplicationMetadataReturnsOnCall[len(fake.updateApplicationMetadataArgsForCall)]
+	fake.updateApplicationMetadataArgsForCall = append(fake.updateApplicationMetadataArgsForCall, struct {
+		appGUID  string
+		metadata ccv3.Metadata
+	}{appGUID, metadata})
+	fake.recordInvocation("UpdateApplicationMetadata", []interface{}{appGUID, metadata})
+	fake.updateApplicationMetadataMutex.Unlock()
+	if fake.UpdateApplicationMetadataStub != nil {
+		return fake.UpdateApplicationMetadataStub(appGUID, metadata)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getOrganizationDefaultIsolationSegmentReturns.result1, fake.getOrganizationDefaultIsolationSegmentReturns.result2, fake.getOrganizationDefaultIsolationSegmentReturns.result3
+	return fake.updateApplicationMetadataReturns.result1, fake.updateApplicationMetadataReturns.result2, fake.updateApplicationMetadataReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentCallCount() int {
-	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
-	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
-	return len(fake.getOrganizationDefaultIsolationSegmentArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadataCallCount() int {
+	fake.updateApplicationMetadataMutex.RLock()
+	defer fake.updateApplicationMetadataMutex.RUnlock()
+	return len(fake.updateApplicationMetadataArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentArgsForCall(i int) string {
-	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
-	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
-	return fake.getOrganizationDefaultIsolationSegmentArgsForCall[i].orgGUID
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadataArgsForCall(i int) (string, ccv3.Metadata) {
+	fake.updateApplicationMetadataMutex.RLock()
+	defer fake.updateApplicationMetadataMutex.RUnlock()
+	return fake.updateApplicationMetadataArgsForCall[i].appGUID, fake.updateApplicationMetadataArgsForCall[i].metadata
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentReturns(result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
-	fake.GetOrganizationDefaultIsolationSegmentStub = nil
-	fake.getOrganizationDefaultIsolationSegmentReturns = struct {
-		result1 ccv3.Relationship
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadataReturns(result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationMetadataStub = nil
+	fake.updateApplicationMetadataReturns = struct {
+		result1 ccv3.Application
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentReturnsOnCall(i int, result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
-	fake.GetOrganizationDefaultIsolationSegmentStub = nil
-	if fake.getOrganizationDefaultIsolationSegmentReturnsOnCall == nil {
-		fake.getOrganizationDefaultIsolationSegmentReturnsOnCall = make(map[int]struct {
-			result1 ccv3.Relationship
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadataReturnsOnCall(i int, result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationMetadataStub = nil
+	if fake.updateApplicationMetadataReturnsOnCall == nil {
+		fake.updateApplicationMetadataReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Application
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getOrganizationDefaultIsolationSegmentReturnsOnCall[i] = struct {
-		result1 ccv3.Relationship
+	fake.updateApplicationMetadataReturnsOnCall[i] = struct {
+		result1 ccv3.Application
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizations(query url.Values) ([]ccv3.Organization, ccv3.Warnings, error) {
-	fake.getOrganizationsMutex.Lock()
-	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
-	fake.getOrganizationsArgsForCall = append(fake.getOrganizationsArgsForCall, struct {
-		query url.Values
-	}{query})
-	fake.recordInvocation("GetOrganizations", []interface{}{query})
-	fake.getOrganizationsMutex.Unlock()
-	if fake.GetOrganizationsStub != nil {
-		return fake.GetOrganizationsStub(query)
+func (fake *FakeCloudControllerClient) UpdateProcess(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
+	fake.updateProcessMutex.Lock()
+	ret, specificReturn := fake.updateProcessReturnsOnCall[len(fake.updateProcessArgsForCall)]
+	fake.updateProcessArgsForCall = append(fake.updateProcessArgsForCall, struct {
+		process ccv3.Process
+	}{process})
+	fake.recordInvocation("UpdateProcess", []interface{}{process})
+	fake.updateProcessMutex.Unlock()
+	if fake.UpdateProcessStub != nil {
+		return fake.UpdateProcessStub(process)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getOrganizationsReturns.result1, fake.getOrganizationsReturns.result2, fake.getOrganizationsReturns.result3
+	return fake.updateProcessReturns.result1, fake.updateProcessReturns.result2, fake.updateProcessReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsCallCount() int {
-	fake.getOrganizationsMutex.RLock()
-	defer fake.getOrganizationsMutex.RUnlock()
-	return len(fake.getOrganizationsArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateProcessCallCount() int {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return len(fake.updateProcessArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsArgsForCall(i int) url.Values {
-	fake.getOrganizationsMutex.RLock()
-	defer fake.getOrganizationsMutex.RUnlock()
-	return fake.getOrganizationsArgsForCall[i].query
+func (fake *FakeCloudControllerClient) UpdateProcessArgsForCall(i int) ccv3.Process {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return fake.updateProcessArgsForCall[i].process
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsReturns(result1 []ccv3.Organization, result2 ccv3.Warnings, result3 error) {
-	fake.GetOrganizationsStub = nil
-	fake.getOrganizationsReturns = struct {
-		result1 []ccv3.Organization
+func (fake *FakeCloudControllerClient) UpdateProcessReturns(result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateProcessStub = nil
+	fake.updateProcessReturns = struct {
+		result1 ccv3.Process
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, result1 []ccv3.Organization, result2 ccv3.Warnings, result3 error) {
-	fake.GetOrganizationsStub = nil
-	if fake.getOrganizationsReturnsOnCall == nil {
-		fake.getOrganizationsReturnsOnCall = make(map[int]struct {
-			result1 []ccv3.Organization
+func (fake *FakeCloudControllerClient) UpdateProcessReturnsOnCall(i int, result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateProcessStub = nil
+	if fake.updateProcessReturnsOnCall == nil {
+		fake.updateProcessReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Process
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getOrganizationsReturnsOnCall[i] = struct {
-		result1 []ccv3.Organization
+	fake.updateProcessReturnsOnCall[i] = struct {
+		result1 ccv3.Process
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetPackage(guid string) (ccv3.Package, ccv3.Warnings, error) {
-	fake.getPackageMutex.Lock()
-	ret, specificReturn := fake.getPackageReturnsOnCall[len(fake.getPackageArgsForCall)]
-	fake.getPackageArgsForCall = append(fake.getPackageArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetPackage", []interface{}{guid})
-	fake.getPackageMutex.Unlock()
-	if fake.GetPackageStub != nil {
-		return fake.GetPackageStub(guid)
+func (fake *FakeCloudControllerClient) UpdateRouteSpaceRelationship(routeGUID string, spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+	fake.updateRouteSpaceRelationshipMutex.Lock()
+	ret, specificReturn := fake.updateRouteSpaceRelationshipReturnsOnCall[len(fake.updateRouteSpaceRelationshipArgsForCall)]
+	fake.updateRouteSpaceRelationshipArgsForCall = append(fake.updateRouteSpaceRelationshipArgsForCall, struct {
+		routeGUID string
+		spaceGUID string
+	}{routeGUID, spaceGUID})
+	fake.recordInvocation("UpdateRouteSpaceRelationship", []interface{}{routeGUID, spaceGUID})
+	fake.updateRouteSpaceRelationshipMutex.Unlock()
+	if fake.UpdateRouteSpaceRelationshipStub != nil {
+		return fake.UpdateRouteSpaceRelationshipStub(routeGUID, spaceGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getPackageReturns.result1, fake.getPackageReturns.result2, fake.getPackageReturns.result3
+	return fake.updateRouteSpaceRelationshipReturns.result1, fake.updateRouteSpaceRelationshipReturns.result2, fake.updateRouteSpaceRelationshipReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetPackageCallCount() int {
-	fake.getPackageMutex.RLock()
-	defer fake.getPackageMutex.RUnlock()
-	return len(fake.getPackageArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateRouteSpaceRelationshipCallCount() int {
+	fake.updateRouteSpaceRelationshipMutex.RLock()
+	defer fake.updateRouteSpaceRelationshipMutex.RUnlock()
+	return len(fake.updateRouteSpaceRelationshipArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetPackageArgsForCall(i int) string {
-	fake.getPackageMutex.RLock()
-	defer fake.getPackageMutex.RUnlock()
-	return fake.getPackageArgsForCall[i].guid
+func (fake *FakeCloudControllerClient) UpdateRouteSpaceRelationshipArgsForCall(i int) (string, string) {
+	fake.updateRouteSpaceRelationshipMutex.RLock()
+	defer fake.updateRouteSpaceRelationshipMutex.RUnlock()
+	return fake.updateRouteSpaceRelationshipArgsForCall[i].routeGUID, fake.updateRouteSpaceRelationshipArgsForCall[i].spaceGUID
 }
 
-func (fake *FakeCloudControllerClient) GetPackageReturns(result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
-	fake.GetPackageStub = nil
-	fake.getPackageReturns = struct {
-		result1 ccv3.Package
+func (fake *FakeCloudControllerClient) UpdateRouteSpaceRelationshipReturns(result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateRouteSpaceRelationshipStub = nil
+	fake.updateRouteSpaceRelationshipReturns = struct {
+		result1 ccv3.Relationship
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetPackageReturnsOnCall(i int, result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
-	fake.GetPackageStub = nil
-	if fake.getPackageReturnsOnCall == nil {
-		fake.getPackageReturnsOnCall = make(map[int]struct {
-			result1 ccv3.Package
+func (fake *FakeCloudControllerClient) UpdateRouteSpaceRelationshipReturnsOnCall(i int, result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateRouteSpaceRelationshipStub = nil
+	if fake.updateRouteSpaceRelationshipReturnsOnCall == nil {
+		fake.updateRouteSpaceRelationshipReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Relationship
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getPackageReturnsOnCall[i] = struct {
-		result1 ccv3.Package
+	fake.updateRouteSpaceRelationshipReturnsOnCall[i] = struct {
+		result1 ccv3.Relationship
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
-	fake.getSpaceIsolationSegmentMutex.Lock()
-	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
-	fake.getSpaceIsolationSegmentArgsForCall = append(fake.getSpaceIsolationSegmentArgsForCall, struct {
-		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("GetSpaceIsolationSegment", []interface{}{spaceGUID})
-	fake.getSpaceIsolationSegmentMutex.Unlock()
-	if fake.GetSpaceIsolationSegmentStub != nil {
-		return fake.GetSpaceIsolationSegmentStub(spaceGUID)
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceMaintenanceInfo(guid string, maintenanceInfo ccv3.MaintenanceInfo) (ccv3.Job, ccv3.Warnings, error) {
+	fake.updateServiceInstanceMaintenanceInfoMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceMaintenanceInfoReturnsOnCall[len(fake.updateServiceInstanceMaintenanceInfoArgsForCall)]
+	fake.updateServiceInstanceMaintenanceInfoArgsForCall = append(fake.updateServiceInstanceMaintenanceInfoArgsForCall, struct {
+		guid            string
+		maintenanceInfo ccv3.MaintenanceInfo
+	}{guid, maintenanceInfo})
+	fake.recordInvocation("UpdateServiceInstanceMaintenanceInfo", []interface{}{guid, maintenanceInfo})
+	fake.updateServiceInstanceMaintenanceInfoMutex.Unlock()
+	if fake.UpdateServiceInstanceMaintenanceInfoStub != nil {
+		return fake.UpdateServiceInstanceMaintenanceInfoStub(guid, maintenanceInfo)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getSpaceIsolationSegmentReturns.result1, fake.getSpaceIsolationSegmentReturns.result2, fake.getSpaceIsolationSegmentReturns.result3
+	return fake.updateServiceInstanceMaintenanceInfoReturns.result1, fake.updateServiceInstanceMaintenanceInfoReturns.result2, fake.updateServiceInstanceMaintenanceInfoReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentCallCount() int {
-	fake.getSpaceIsolationSegmentMutex.RLock()
-	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
-	return len(fake.getSpaceIsolationSegmentArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceMaintenanceInfoCallCount() int {
+	fake.updateServiceInstanceMaintenanceInfoMutex.RLock()
+	defer fake.updateServiceInstanceMaintenanceInfoMutex.RUnlock()
+	return len(fake.updateServiceInstanceMaintenanceInfoArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentArgsForCall(i int) string {
-	fake.getSpaceIsolationSegmentMutex.RLock()
-	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
-	return fake.getSpaceIsolationSegmentArgsForCall[i].spaceGUID
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceMaintenanceInfoArgsForCall(i int) (string, ccv3.MaintenanceInfo) {
+	fake.updateServiceInstanceMaintenanceInfoMutex.RLock()
+	defer fake.updateServiceInstanceMaintenanceInfoMutex.RUnlock()
+	return fake.updateServiceInstanceMaintenanceInfoArgsForCall[i].guid, fake.updateServiceInstanceMaintenanceInfoArgsForCall[i].maintenanceInfo
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturns(result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
-	fake.GetSpaceIsolationSegmentStub = nil
-	fake.getSpaceIsolationSegmentReturns = struct {
-		result1 ccv3.Relationship
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceMaintenanceInfoReturns(result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateServiceInstanceMaintenanceInfoStub = nil
+	fake.updateServiceInstanceMaintenanceInfoReturns = struct {
+		result1 ccv3.Job
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturnsOnCall(i int, result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
-	fake.GetSpaceIsolationSegmentStub = nil
-	if fake.getSpaceIsolationSegmentReturnsOnCall == nil {
-		fake.getSpaceIsolationSegmentReturnsOnCall = make(map[int]struct {
-			result1 ccv3.Relationship
+func (fake *FakeCloudControllerClient) UpdateServiceInstanceMaintenanceInfoReturnsOnCall(i int, result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateServiceInstanceMaintenanceInfoStub = nil
+	if fake.updateServiceInstanceMaintenanceInfoReturnsOnCall == nil {
+		fake.updateServiceInstanceMaintenanceInfoReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Job
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceIsolationSegmentReturnsOnCall[i] = struct {
-		result1 ccv3.Relationship
+	fake.updateServiceInstanceMaintenanceInfoReturnsOnCall[i] = struct {
+		result1 ccv3.Job
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganization(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error) {
-	fake.revokeIsolationSegmentFromOrganizationMutex.Lock()
-	ret, specificReturn := fake.revokeIsolationSegmentFromOrganizationReturnsOnCall[len(fake.revokeIsolationSegmentFromOrganizationArgsForCall)]
-	fake.revokeIsolationSegmentFromOrganizationArgsForCall = append(fake.revokeIsolationSegmentFromOrganizationArgsForCall, struct {
-		isolationSegmentGUID string
-		organizationGUID     string
-	}{isolationSegmentGUID, organizationGUID})
-	fake.recordInvocation("RevokeIsolationSegmentFromOrganization", []interface{}{isolationSegmentGUID, organizationGUID})
-	fake.revokeIsolationSegmentFromOrganizationMutex.Unlock()
-	if fake.RevokeIsolationSegmentFromOrganizationStub != nil {
-		return fake.RevokeIsolationSegmentFromOrganizationStub(isolationSegmentGUID, organizationGUID)
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (ccv3.Job, ccv3.Warnings, error) {
+	var rawManifestCopy []byte
+	if rawManifest != nil {
+		rawManifestCopy = make([]byte, len(rawManifest))
+		copy(rawManifestCopy, rawManifest)
+	}
+	fake.updateSpaceApplyManifestMutex.Lock()
+	ret, specificReturn := fake.updateSpaceApplyManifestReturnsOnCall[len(fake.updateSpaceApplyManifestArgsForCall)]
+	fake.updateSpaceApplyManifestArgsForCall = append(fake.updateSpaceApplyManifestArgsForCall, struct {
+		spaceGUID   string
+		rawManifest []byte
+	}{spaceGUID, rawManifestCopy})
+	fake.recordInvocation("UpdateSpaceApplyManifest", []interface{}{spaceGUID, rawManifestCopy})
+	fake.updateSpaceApplyManifestMutex.Unlock()
+	if fake.UpdateSpaceApplyManifestStub != nil {
+		return fake.UpdateSpaceApplyManifestStub(spaceGUID, rawManifest)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.revokeIsolationSegmentFromOrganizationReturns.result1, fake.revokeIsolationSegmentFromOrganizationReturns.result2
+	return fake.updateSpaceApplyManifestReturns.result1, fake.updateSpaceApplyManifestReturns.result2, fake.updateSpaceApplyManifestReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationCallCount() int {
-	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
-	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
-	return len(fake.revokeIsolationSegmentFromOrganizationArgsForCall)
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestCallCount() int {
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
+	return len(fake.updateSpaceApplyManifestArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationArgsForCall(i int) (string, string) {
-	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
-	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
-	return fake.revokeIsolationSegmentFromOrganizationArgsForCall[i].isolationSegmentGUID, fake.revokeIsolationSegmentFromOrganizationArgsForCall[i].organizationGUID
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestArgsForCall(i int) (string, []byte) {
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
+	return fake.updateSpaceApplyManifestArgsForCall[i].spaceGUID, fake.updateSpaceApplyManifestArgsForCall[i].rawManifest
 }
 
-func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationReturns(result1 ccv3.Warnings, result2 error) {
-	fake.RevokeIsolationSegmentFromOrganizationStub = nil
-	fake.revokeIsolationSegmentFromOrganizationReturns = struct {
-		result1 ccv3.Warnings
-		result2 error
-	}{result1, result2}
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestReturns(result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceApplyManifestStub = nil
+	fake.updateSpaceApplyManifestReturns = struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
-	fake.RevokeIsolationSegmentFromOrganizationStub = nil
-	if fake.revokeIsolationSegmentFromOrganizationReturnsOnCall == nil {
-		fake.revokeIsolationSegmentFromOrganizationReturnsOnCall = make(map[int]struct {
-			result1 ccv3.Warnings
-			result2 error
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestReturnsOnCall(i int, result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceApplyManifestStub = nil
+	if fake.updateSpaceApplyManifestReturnsOnCall == nil {
+		fake.updateSpaceApplyManifestReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Job
+			result2 ccv3.Warnings
+			result3 error
 		})
 	}
-	fake.revokeIsolationSegmentFromOrganizationReturnsOnCall[i] = struct {
-		result1 ccv3.Warnings
-		result2 error
-	}{result1, result2}
+	fake.updateSpaceApplyManifestReturnsOnCall[i] = struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeCloudControllerClient) UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
@@ -1390,36 +3268,90 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
 	defer fake.createApplicationTaskMutex.RUnlock()
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
 	fake.createIsolationSegmentMutex.RLock()
 	defer fake.createIsolationSegmentMutex.RUnlock()
 	fake.createPackageMutex.RLock()
 	defer fake.createPackageMutex.RUnlock()
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
+	fake.getApplicationCurrentDropletMutex.RLock()
+	defer fake.getApplicationCurrentDropletMutex.RUnlock()
+	fake.getApplicationEnvironmentMutex.RLock()
+	defer fake.getApplicationEnvironmentMutex.RUnlock()
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	fake.getDeploymentsMutex.RLock()
+	defer fake.getDeploymentsMutex.RUnlock()
 	fake.getIsolationSegmentMutex.RLock()
 	defer fake.getIsolationSegmentMutex.RUnlock()
 	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RLock()
 	defer fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RUnlock()
 	fake.getIsolationSegmentsMutex.RLock()
 	defer fake.getIsolationSegmentsMutex.RUnlock()
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPackageMutex.RLock()
 	defer fake.getPackageMutex.RUnlock()
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	fake.getSpaceManifestDiffMutex.RLock()
+	defer fake.getSpaceManifestDiffMutex.RUnlock()
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
 	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
 	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	fake.updateApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.updateApplicationEnvironmentVariablesMutex.RUnlock()
+	fake.updateApplicationMetadataMutex.RLock()
+	defer fake.updateApplicationMetadataMutex.RUnlock()
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	fake.updateRouteSpaceRelationshipMutex.RLock()
+	defer fake.updateRouteSpaceRelationshipMutex.RUnlock()
+	fake.updateServiceInstanceMaintenanceInfoMutex.RLock()
+	defer fake.updateServiceInstanceMaintenanceInfoMutex.RUnlock()
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
@@ -0,0 +1,58 @@
+package autoscaler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/autoscaler/autoscalererror"
+	"code.cloudfoundry.org/cli/api/httpclient"
+	"code.cloudfoundry.org/cli/api/httpclient/httperror"
+)
+
+// NewConnection returns a new connection to the App Autoscaler.
+func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *httpclient.JSONConnection {
+	return httpclient.NewConnection(skipSSLValidation, dialTimeout, handleStatusErrors)
+}
+
+func handleStatusErrors(statusCode int, rawResponse []byte) error {
+	message := errorMessage(rawResponse)
+	switch statusCode {
+	case http.StatusBadRequest:
+		return autoscalererror.BadRequestError{Message: message}
+	case http.StatusUnauthorized:
+		return httperror.InvalidAuthTokenError{Message: message}
+	case http.StatusNotFound:
+		return autoscalererror.ResourceNotFoundError{Message: message}
+	default:
+		return nil
+	}
+}
+
+// errorMessage extracts the message from an App Autoscaler error body, which
+// is either an object with an 'error' field or, for policy validation
+// failures, a list of the schema violations.
+func errorMessage(rawResponse []byte) string {
+	var errorResponse struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(rawResponse, &errorResponse); err == nil && errorResponse.Error != "" {
+		return errorResponse.Error
+	}
+
+	var violations []struct {
+		Context     string `json:"context"`
+		Description string `json:"description"`
+	}
+	if err := json.Unmarshal(rawResponse, &violations); err == nil && len(violations) > 0 {
+		messages := make([]string, 0, len(violations))
+		for _, violation := range violations {
+			messages = append(messages, fmt.Sprintf("%s: %s", violation.Context, violation.Description))
+		}
+		return strings.Join(messages, "\n")
+	}
+
+	return string(rawResponse)
+}
@@ -0,0 +1,41 @@
+package autoscaler_test
+
+import (
+	"bytes"
+	"log"
+
+	. "code.cloudfoundry.org/cli/api/autoscaler"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+
+	"testing"
+)
+
+func TestAutoscaler(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Autoscaler Suite")
+}
+
+var server *Server
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	return []byte{}
+}, func(data []byte) {
+	server = NewTLSServer()
+
+	// Suppresses ginkgo server logs
+	server.HTTPTestServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+})
+
+var _ = SynchronizedAfterSuite(func() {
+	server.Close()
+}, func() {})
+
+var _ = BeforeEach(func() {
+	server.Reset()
+})
+
+func NewTestClient() *Client {
+	return NewClient(Config{SkipSSLValidation: true, AppName: "CF CLI API Autoscaler Test", AppVersion: "Unknown", URL: server.URL()})
+}
@@ -0,0 +1,11 @@
+package autoscalererror
+
+// BadRequestError is returned when the App Autoscaler responds with a 400,
+// for example when a scaling policy fails schema validation.
+type BadRequestError struct {
+	Message string
+}
+
+func (e BadRequestError) Error() string {
+	return e.Message
+}
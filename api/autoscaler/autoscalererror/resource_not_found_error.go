@@ -0,0 +1,11 @@
+package autoscalererror
+
+// ResourceNotFoundError is returned when the App Autoscaler responds with a
+// 404, for example when the application has no policy attached.
+type ResourceNotFoundError struct {
+	Message string
+}
+
+func (e ResourceNotFoundError) Error() string {
+	return e.Message
+}
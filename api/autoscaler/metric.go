@@ -0,0 +1,73 @@
+package autoscaler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/httpclient"
+)
+
+// Metric is a single sample of an aggregated metric of an application.
+type Metric struct {
+	Name      string
+	Value     string
+	Unit      string
+	Timestamp time.Time
+}
+
+// UnmarshalJSON helps unmarshal an App Autoscaler metric, whose timestamp is
+// in nanoseconds since the epoch.
+func (metric *Metric) UnmarshalJSON(data []byte) error {
+	var ccMetric struct {
+		Name      string `json:"name"`
+		Value     string `json:"value"`
+		Unit      string `json:"unit"`
+		Timestamp int64  `json:"timestamp"`
+	}
+	err := json.Unmarshal(data, &ccMetric)
+	if err != nil {
+		return err
+	}
+
+	metric.Name = ccMetric.Name
+	metric.Value = ccMetric.Value
+	metric.Unit = ccMetric.Unit
+	metric.Timestamp = time.Unix(0, ccMetric.Timestamp).UTC()
+	return nil
+}
+
+// GetAggregatedMetrics returns the samples of the metric type, such as
+// memoryused or throughput, aggregated across all instances of the
+// application, going through all pages of the results.
+func (client *Client) GetAggregatedMetrics(appGUID string, metricType string, query HistoryQuery) ([]Metric, error) {
+	request, err := client.newHTTPRequest(
+		http.MethodGet,
+		"/v1/apps/"+appGUID+"/aggregated_metric_histories/"+metricType,
+		query.values(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []Metric
+	for request != nil {
+		var page struct {
+			NextURL   string   `json:"next_url"`
+			Resources []Metric `json:"resources"`
+		}
+		err = client.connection.Make(request, &httpclient.Response{Result: &page})
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, page.Resources...)
+
+		request, err = client.nextPageRequest(page.NextURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return metrics, nil
+}
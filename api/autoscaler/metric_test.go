@@ -0,0 +1,67 @@
+package autoscaler_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/autoscaler"
+	"code.cloudfoundry.org/cli/api/autoscaler/autoscalererror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Metric", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetAggregatedMetrics", func() {
+		Context("when the metric has samples", func() {
+			BeforeEach(func() {
+				response := `{
+					"total_results": 2,
+					"resources": [
+						{"name": "memoryused", "value": "250", "unit": "MB", "timestamp": 1500000060000000000},
+						{"name": "memoryused", "value": "200", "unit": "MB", "timestamp": 1500000000000000000}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v1/apps/some-app-guid/aggregated_metric_histories/memoryused", "end-time=1500000060000000000&order-direction=desc"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the samples", func() {
+				metrics, err := client.GetAggregatedMetrics("some-app-guid", "memoryused", HistoryQuery{
+					End: time.Unix(1500000060, 0),
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metrics).To(Equal([]Metric{
+					{Name: "memoryused", Value: "250", Unit: "MB", Timestamp: time.Unix(1500000060, 0).UTC()},
+					{Name: "memoryused", Value: "200", Unit: "MB", Timestamp: time.Unix(1500000000, 0).UTC()},
+				}))
+			})
+		})
+
+		Context("when the metric type is invalid", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v1/apps/some-app-guid/aggregated_metric_histories/bogus"),
+						RespondWith(http.StatusBadRequest, `{"error": "Metric type is not supported"}`),
+					),
+				)
+			})
+
+			It("returns a BadRequestError", func() {
+				_, err := client.GetAggregatedMetrics("some-app-guid", "bogus", HistoryQuery{})
+				Expect(err).To(MatchError(autoscalererror.BadRequestError{Message: "Metric type is not supported"}))
+			})
+		})
+	})
+})
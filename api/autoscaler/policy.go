@@ -0,0 +1,25 @@
+package autoscaler
+
+import (
+	"bytes"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/httpclient"
+)
+
+// AttachPolicy replaces the scaling policy of the application with the
+// provided policy document. The App Autoscaler validates the document and
+// returns a BadRequestError listing the violations when it is invalid.
+func (client *Client) AttachPolicy(appGUID string, policy []byte) error {
+	request, err := client.newHTTPRequest(
+		http.MethodPut,
+		"/v1/apps/"+appGUID+"/policy",
+		nil,
+		bytes.NewReader(policy),
+	)
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &httpclient.Response{})
+}
@@ -0,0 +1,102 @@
+package autoscaler_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/autoscaler"
+	"code.cloudfoundry.org/cli/api/autoscaler/autoscalererror"
+	"code.cloudfoundry.org/cli/api/httpclient/httperror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Policy", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("AttachPolicy", func() {
+		var policy string
+
+		BeforeEach(func() {
+			policy = `{"instance_min_count": 1, "instance_max_count": 4}`
+		})
+
+		Context("when the policy is attached", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v1/apps/some-app-guid/policy"),
+						VerifyJSON(policy),
+						RespondWith(http.StatusOK, policy),
+					),
+				)
+			})
+
+			It("does not return an error", func() {
+				err := client.AttachPolicy("some-app-guid", []byte(policy))
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the policy is invalid", func() {
+			BeforeEach(func() {
+				response := `[
+					{"context": "(root).instance_min_count", "description": "Must be greater than or equal to 1"},
+					{"context": "(root)", "description": "scaling_rules is required"}
+				]`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v1/apps/some-app-guid/policy"),
+						RespondWith(http.StatusBadRequest, response),
+					),
+				)
+			})
+
+			It("returns a BadRequestError listing the violations", func() {
+				err := client.AttachPolicy("some-app-guid", []byte(policy))
+				Expect(err).To(MatchError(autoscalererror.BadRequestError{
+					Message: "(root).instance_min_count: Must be greater than or equal to 1\n(root): scaling_rules is required",
+				}))
+			})
+		})
+
+		Context("when the token is rejected", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v1/apps/some-app-guid/policy"),
+						RespondWith(http.StatusUnauthorized, `{"error": "The token is invalid"}`),
+					),
+				)
+			})
+
+			It("returns an InvalidAuthTokenError", func() {
+				err := client.AttachPolicy("some-app-guid", []byte(policy))
+				Expect(err).To(MatchError(httperror.InvalidAuthTokenError{Message: "The token is invalid"}))
+			})
+		})
+
+		Context("when the App Autoscaler returns an unexpected status", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v1/apps/some-app-guid/policy"),
+						RespondWith(http.StatusInternalServerError, `{"error": "Internal Server Error"}`),
+					),
+				)
+			})
+
+			It("returns a RawHTTPStatusError", func() {
+				err := client.AttachPolicy("some-app-guid", []byte(policy))
+				Expect(err).To(MatchError(httperror.RawHTTPStatusError{
+					StatusCode:  http.StatusInternalServerError,
+					RawResponse: []byte(`{"error": "Internal Server Error"}`),
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package autoscaler
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// newHTTPRequest returns a constructed HTTP.Request for the given path on the
+// App Autoscaler with some defaults.
+func (client *Client) newHTTPRequest(method string, path string, query url.Values, body io.Reader) (*http.Request, error) {
+	requestURL := client.url + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	request, err := http.NewRequest(method, requestURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header = http.Header{}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", client.userAgent)
+
+	return request, nil
+}
+
+// nextPageRequest returns the request for the page the App Autoscaler linked
+// to as the next one, or nil on the last page. The link is relative to the
+// App Autoscaler API.
+func (client *Client) nextPageRequest(nextURL string) (*http.Request, error) {
+	if nextURL == "" {
+		return nil, nil
+	}
+
+	parsedURL, err := url.Parse(nextURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.newHTTPRequest(http.MethodGet, parsedURL.Path, parsedURL.Query(), nil)
+}
@@ -0,0 +1,127 @@
+package autoscaler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/httpclient"
+)
+
+// ScalingType is what triggered a scaling event.
+type ScalingType int
+
+const (
+	ScalingTypeDynamic ScalingType = iota
+	ScalingTypeSchedule
+)
+
+// ScalingStatus is the outcome of a scaling event.
+type ScalingStatus int
+
+const (
+	ScalingStatusSucceeded ScalingStatus = iota
+	ScalingStatusFailed
+	ScalingStatusIgnored
+)
+
+// ScalingHistory is a single scaling event of an application.
+type ScalingHistory struct {
+	Timestamp    time.Time
+	ScalingType  ScalingType
+	Status       ScalingStatus
+	OldInstances int
+	NewInstances int
+	Reason       string
+	Message      string
+	Error        string
+}
+
+// UnmarshalJSON helps unmarshal an App Autoscaler scaling history, whose
+// timestamp is in nanoseconds since the epoch.
+func (history *ScalingHistory) UnmarshalJSON(data []byte) error {
+	var ccHistory struct {
+		Timestamp    int64         `json:"timestamp"`
+		ScalingType  ScalingType   `json:"scaling_type"`
+		Status       ScalingStatus `json:"status"`
+		OldInstances int           `json:"old_instances"`
+		NewInstances int           `json:"new_instances"`
+		Reason       string        `json:"reason"`
+		Message      string        `json:"message"`
+		Error        string        `json:"error"`
+	}
+	err := json.Unmarshal(data, &ccHistory)
+	if err != nil {
+		return err
+	}
+
+	history.Timestamp = time.Unix(0, ccHistory.Timestamp).UTC()
+	history.ScalingType = ccHistory.ScalingType
+	history.Status = ccHistory.Status
+	history.OldInstances = ccHistory.OldInstances
+	history.NewInstances = ccHistory.NewInstances
+	history.Reason = ccHistory.Reason
+	history.Message = ccHistory.Message
+	history.Error = ccHistory.Error
+	return nil
+}
+
+// HistoryQuery narrows down the scaling histories or metrics listed. Zero
+// times are not sent, so the App Autoscaler defaults apply.
+type HistoryQuery struct {
+	Start     time.Time
+	End       time.Time
+	Ascending bool
+}
+
+func (query HistoryQuery) values() url.Values {
+	values := url.Values{}
+	if !query.Start.IsZero() {
+		values.Set("start-time", strconv.FormatInt(query.Start.UnixNano(), 10))
+	}
+	if !query.End.IsZero() {
+		values.Set("end-time", strconv.FormatInt(query.End.UnixNano(), 10))
+	}
+	if query.Ascending {
+		values.Set("order-direction", "asc")
+	} else {
+		values.Set("order-direction", "desc")
+	}
+	return values
+}
+
+// GetScalingHistories returns the scaling events of the application, going
+// through all pages of the results.
+func (client *Client) GetScalingHistories(appGUID string, query HistoryQuery) ([]ScalingHistory, error) {
+	request, err := client.newHTTPRequest(
+		http.MethodGet,
+		"/v1/apps/"+appGUID+"/scaling_histories",
+		query.values(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var histories []ScalingHistory
+	for request != nil {
+		var page struct {
+			NextURL   string           `json:"next_url"`
+			Resources []ScalingHistory `json:"resources"`
+		}
+		err = client.connection.Make(request, &httpclient.Response{Result: &page})
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, page.Resources...)
+
+		request, err = client.nextPageRequest(page.NextURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return histories, nil
+}
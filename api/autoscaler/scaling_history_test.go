@@ -0,0 +1,109 @@
+package autoscaler_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/autoscaler"
+	"code.cloudfoundry.org/cli/api/autoscaler/autoscalererror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Scaling History", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetScalingHistories", func() {
+		Context("when the application has been scaled", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"total_results": 2,
+					"next_url": "/v1/apps/some-app-guid/scaling_histories?order-direction=asc&page=2&start-time=1500000000000000000",
+					"resources": [
+						{
+							"timestamp": 1500000000000000000,
+							"scaling_type": 0,
+							"status": 0,
+							"old_instances": 1,
+							"new_instances": 2,
+							"reason": "+1 instance(s) because memoryused > 500MB for 120 seconds"
+						}
+					]
+				}`
+				response2 := `{
+					"total_results": 2,
+					"next_url": "",
+					"resources": [
+						{
+							"timestamp": 1500000060000000000,
+							"scaling_type": 1,
+							"status": 1,
+							"old_instances": 2,
+							"new_instances": 4,
+							"reason": "schedule starts",
+							"error": "app not found"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v1/apps/some-app-guid/scaling_histories", "order-direction=asc&start-time=1500000000000000000"),
+						RespondWith(http.StatusOK, response1),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v1/apps/some-app-guid/scaling_histories", "order-direction=asc&page=2&start-time=1500000000000000000"),
+						RespondWith(http.StatusOK, response2),
+					),
+				)
+			})
+
+			It("returns the scaling histories from all pages", func() {
+				histories, err := client.GetScalingHistories("some-app-guid", HistoryQuery{
+					Start:     time.Unix(1500000000, 0),
+					Ascending: true,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(histories).To(Equal([]ScalingHistory{
+					{
+						Timestamp:    time.Unix(1500000000, 0).UTC(),
+						ScalingType:  ScalingTypeDynamic,
+						Status:       ScalingStatusSucceeded,
+						OldInstances: 1,
+						NewInstances: 2,
+						Reason:       "+1 instance(s) because memoryused > 500MB for 120 seconds",
+					},
+					{
+						Timestamp:    time.Unix(1500000060, 0).UTC(),
+						ScalingType:  ScalingTypeSchedule,
+						Status:       ScalingStatusFailed,
+						OldInstances: 2,
+						NewInstances: 4,
+						Reason:       "schedule starts",
+						Error:        "app not found",
+					},
+				}))
+			})
+		})
+
+		Context("when the application is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v1/apps/some-app-guid/scaling_histories", "order-direction=desc"),
+						RespondWith(http.StatusNotFound, `{"error": "App not found"}`),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				_, err := client.GetScalingHistories("some-app-guid", HistoryQuery{})
+				Expect(err).To(MatchError(autoscalererror.ResourceNotFoundError{Message: "App not found"}))
+			})
+		})
+	})
+})
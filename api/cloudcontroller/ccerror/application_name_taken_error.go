@@ -0,0 +1,11 @@
+package ccerror
+
+// ApplicationNameTakenError is returned when creating or renaming an
+// application to a name that is already in use in the space.
+type ApplicationNameTakenError struct {
+	Message string
+}
+
+func (e ApplicationNameTakenError) Error() string {
+	return e.Message
+}
@@ -2,9 +2,10 @@ package ccerror
 
 // BadRequestError is returned when the server says the request was bad.
 type BadRequestError struct {
-	Message string
+	Message    string
+	RequestIDs []string
 }
 
 func (e BadRequestError) Error() string {
-	return e.Message
+	return appendRequestIDs(e.Message, e.RequestIDs)
 }
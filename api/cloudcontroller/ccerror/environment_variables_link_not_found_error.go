@@ -0,0 +1,13 @@
+package ccerror
+
+import "fmt"
+
+// EnvironmentVariablesLinkNotFoundError is returned when a revision does not
+// link to its environment variables.
+type EnvironmentVariablesLinkNotFoundError struct {
+	RevisionGUID string
+}
+
+func (e EnvironmentVariablesLinkNotFoundError) Error() string {
+	return fmt.Sprintf("Environment variables link not found for revision with GUID %s", e.RevisionGUID)
+}
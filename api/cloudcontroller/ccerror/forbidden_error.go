@@ -3,9 +3,10 @@ package ccerror
 // ForbiddenError is returned when the client is forbidden from executing the
 // request.
 type ForbiddenError struct {
-	Message string
+	Message    string
+	RequestIDs []string
 }
 
 func (e ForbiddenError) Error() string {
-	return e.Message
+	return appendRequestIDs(e.Message, e.RequestIDs)
 }
@@ -0,0 +1,28 @@
+package ccerror_test
+
+import (
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ForbiddenError", func() {
+	Context("when there are no request IDs", func() {
+		It("returns the message", func() {
+			err := ForbiddenError{Message: "You are not authorized to perform the requested action"}
+			Expect(err.Error()).To(Equal("You are not authorized to perform the requested action"))
+		})
+	})
+
+	Context("when there are request IDs", func() {
+		It("appends the request IDs to the message", func() {
+			err := ForbiddenError{
+				Message:    "You are not authorized to perform the requested action",
+				RequestIDs: []string{"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95"},
+			}
+			Expect(err.Error()).To(Equal(`You are not authorized to perform the requested action
+Request ID: 6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95`))
+		})
+	})
+})
@@ -0,0 +1,11 @@
+package ccerror
+
+// OrganizationNameTakenError is returned when creating an organization with a
+// name that is already in use.
+type OrganizationNameTakenError struct {
+	Message string
+}
+
+func (e OrganizationNameTakenError) Error() string {
+	return e.Message
+}
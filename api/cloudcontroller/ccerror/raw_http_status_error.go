@@ -10,5 +10,6 @@ type RawHTTPStatusError struct {
 }
 
 func (r RawHTTPStatusError) Error() string {
-	return fmt.Sprintf("Error Code: %d\nRaw Response: %s", r.StatusCode, r.RawResponse)
+	message := fmt.Sprintf("Error Code: %d\nRaw Response: %s", r.StatusCode, r.RawResponse)
+	return appendRequestIDs(message, r.RequestIDs)
 }
@@ -0,0 +1,25 @@
+package ccerror_test
+
+import (
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RawHTTPStatusError", func() {
+	It("formats the error", func() {
+		err := RawHTTPStatusError{
+			StatusCode:  418,
+			RawResponse: []byte("I'm a teapot"),
+			RequestIDs: []string{
+				"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+				"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+			},
+		}
+		Expect(err.Error()).To(Equal(`Error Code: 418
+Raw Response: I'm a teapot
+Request ID: 6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95
+Request ID: 6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f`))
+	})
+})
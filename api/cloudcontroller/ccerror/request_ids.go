@@ -0,0 +1,13 @@
+package ccerror
+
+import "fmt"
+
+// appendRequestIDs adds the Cloud Controller request IDs to the end of the
+// message so they can be handed to an operator to find the request in the
+// platform logs.
+func appendRequestIDs(message string, requestIDs []string) string {
+	for _, id := range requestIDs {
+		message = fmt.Sprintf("%s\nRequest ID: %s", message, id)
+	}
+	return message
+}
@@ -0,0 +1,11 @@
+package ccerror
+
+// ServiceInstanceNameTakenError is returned when creating or renaming a
+// service instance to a name that is already in use in the space.
+type ServiceInstanceNameTakenError struct {
+	Message string
+}
+
+func (e ServiceInstanceNameTakenError) Error() string {
+	return e.Message
+}
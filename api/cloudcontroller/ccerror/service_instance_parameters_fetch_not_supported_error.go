@@ -0,0 +1,11 @@
+package ccerror
+
+// ServiceInstanceParametersFetchNotSupportedError is returned when the service
+// broker of a service instance does not support retrieving its parameters.
+type ServiceInstanceParametersFetchNotSupportedError struct {
+	Message string
+}
+
+func (e ServiceInstanceParametersFetchNotSupportedError) Error() string {
+	return e.Message
+}
@@ -2,9 +2,10 @@ package ccerror
 
 // ServiceUnavailableError wraps a http 503 error.
 type ServiceUnavailableError struct {
-	Message string
+	Message    string
+	RequestIDs []string
 }
 
 func (e ServiceUnavailableError) Error() string {
-	return e.Message
+	return appendRequestIDs(e.Message, e.RequestIDs)
 }
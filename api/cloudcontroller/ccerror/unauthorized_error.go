@@ -3,9 +3,10 @@ package ccerror
 // UnauthorizedError is returned when the client does not have the correct
 // permissions to execute the request.
 type UnauthorizedError struct {
-	Message string
+	Message    string
+	RequestIDs []string
 }
 
 func (e UnauthorizedError) Error() string {
-	return e.Message
+	return appendRequestIDs(e.Message, e.RequestIDs)
 }
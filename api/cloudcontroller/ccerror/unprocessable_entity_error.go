@@ -3,9 +3,10 @@ package ccerror
 // UnprocessableEntityError is returned when the request cannot be processed by
 // the cloud controller.
 type UnprocessableEntityError struct {
-	Message string
+	Message    string
+	RequestIDs []string
 }
 
 func (e UnprocessableEntityError) Error() string {
-	return e.Message
+	return appendRequestIDs(e.Message, e.RequestIDs)
 }
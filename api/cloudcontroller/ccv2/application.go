@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // ApplicationState is the running state of an application.
@@ -33,6 +34,9 @@ type Application struct {
 	// Buildpack is the buildpack set by the user.
 	Buildpack string `json:"buildpack,omitempty"`
 
+	// CreatedAt is the time the application was created.
+	CreatedAt time.Time `json:"-"`
+
 	// DetectedBuildpack is the buildpack automatically detected.
 	DetectedBuildpack string `json:"-"`
 
@@ -40,7 +44,11 @@ type Application struct {
 	DetectedStartCommand string `json:"-"`
 
 	// DiskQuota is the disk given to each instance, in megabytes.
-	DiskQuota int `json:"-"`
+	DiskQuota int `json:"disk_quota,omitempty"`
+
+	// EnvironmentVariables are the user provided environment variables of the
+	// application. Values that are not strings are kept as JSON.
+	EnvironmentVariables map[string]string `json:"environment_json,omitempty"`
 
 	// GUID is the unique application identifier.
 	GUID string `json:"guid,omitempty"`
@@ -52,10 +60,10 @@ type Application struct {
 	HealthCheckHTTPEndpoint string `json:"health_check_http_endpoint,omitempty"`
 
 	// Instances is the total number of app instances.
-	Instances int `json:"-"`
+	Instances int `json:"instances,omitempty"`
 
 	// Memory is the memory given to each instance, in megabytes.
-	Memory int `json:"-"`
+	Memory int `json:"memory,omitempty"`
 
 	// Name is the name given to the application.
 	Name string `json:"name,omitempty"`
@@ -66,11 +74,15 @@ type Application struct {
 	// PackageUpdatedAt is the last time the app bits were updated. In RFC3339.
 	PackageUpdatedAt time.Time `json:"-"`
 
+	// Ports are the ports the application listens on. Only Diego applications
+	// can listen on more than one port.
+	Ports []int `json:"ports,omitempty"`
+
 	// SpaceGUID is the GUID of the app's space.
 	SpaceGUID string `json:"space_guid,omitempty"`
 
 	// StackGUID is the GUID for the Stack the application is running on.
-	StackGUID string `json:"-"`
+	StackGUID string `json:"stack_guid,omitempty"`
 
 	// StagingFailedDescription is the verbose description of why the package
 	// failed to stage.
@@ -81,6 +93,27 @@ type Application struct {
 
 	// State is the desired state of the application.
 	State ApplicationState `json:"state,omitempty"`
+
+	// UpdatedAt is the last time the application was updated. It is zero when
+	// the application was never updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// MarshalJSON converts an Application into a Cloud Controller Application
+// request. Non-nil empty EnvironmentVariables are sent, so that the
+// environment variables of the application are removed.
+func (application Application) MarshalJSON() ([]byte, error) {
+	type ccApplication Application
+	ccApp := struct {
+		ccApplication
+		EnvironmentJSON *map[string]string `json:"environment_json,omitempty"`
+	}{
+		ccApplication: ccApplication(application),
+	}
+	if application.EnvironmentVariables != nil {
+		ccApp.EnvironmentJSON = &application.EnvironmentVariables
+	}
+	return json.Marshal(ccApp)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Application response.
@@ -88,21 +121,23 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	var ccApp struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Buildpack                string     `json:"buildpack"`
-			DetectedBuildpack        string     `json:"detected_buildpack"`
-			DetectedStartCommand     string     `json:"detected_start_command"`
-			DiskQuota                int        `json:"disk_quota"`
-			HealthCheckType          string     `json:"health_check_type"`
-			HealthCheckHTTPEndpoint  string     `json:"health_check_http_endpoint"`
-			Instances                int        `json:"instances"`
-			Memory                   int        `json:"memory"`
-			Name                     string     `json:"name"`
-			PackageState             string     `json:"package_state"`
-			PackageUpdatedAt         *time.Time `json:"package_updated_at"`
-			StackGUID                string     `json:"stack_guid"`
-			StagingFailedDescription string     `json:"staging_failed_description"`
-			StagingFailedReason      string     `json:"staging_failed_reason"`
-			State                    string     `json:"state"`
+			Buildpack                string                     `json:"buildpack"`
+			DetectedBuildpack        string                     `json:"detected_buildpack"`
+			DetectedStartCommand     string                     `json:"detected_start_command"`
+			DiskQuota                int                        `json:"disk_quota"`
+			EnvironmentJSON          map[string]json.RawMessage `json:"environment_json"`
+			HealthCheckType          string                     `json:"health_check_type"`
+			HealthCheckHTTPEndpoint  string                     `json:"health_check_http_endpoint"`
+			Instances                int                        `json:"instances"`
+			Memory                   int                        `json:"memory"`
+			Name                     string                     `json:"name"`
+			PackageState             string                     `json:"package_state"`
+			PackageUpdatedAt         *time.Time                 `json:"package_updated_at"`
+			Ports                    []int                      `json:"ports"`
+			StackGUID                string                     `json:"stack_guid"`
+			StagingFailedDescription string                     `json:"staging_failed_description"`
+			StagingFailedReason      string                     `json:"staging_failed_reason"`
+			State                    string                     `json:"state"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccApp); err != nil {
@@ -110,16 +145,31 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	}
 
 	application.GUID = ccApp.Metadata.GUID
+	application.CreatedAt = ccApp.Metadata.CreatedAt
+	if ccApp.Metadata.UpdatedAt != nil {
+		application.UpdatedAt = *ccApp.Metadata.UpdatedAt
+	}
 	application.Buildpack = ccApp.Entity.Buildpack
 	application.DetectedBuildpack = ccApp.Entity.DetectedBuildpack
 	application.DetectedStartCommand = ccApp.Entity.DetectedStartCommand
 	application.DiskQuota = ccApp.Entity.DiskQuota
+	if ccApp.Entity.EnvironmentJSON != nil {
+		application.EnvironmentVariables = map[string]string{}
+		for name, rawValue := range ccApp.Entity.EnvironmentJSON {
+			var value string
+			if err := json.Unmarshal(rawValue, &value); err != nil {
+				value = string(rawValue)
+			}
+			application.EnvironmentVariables[name] = value
+		}
+	}
 	application.HealthCheckType = ccApp.Entity.HealthCheckType
 	application.HealthCheckHTTPEndpoint = ccApp.Entity.HealthCheckHTTPEndpoint
 	application.Instances = ccApp.Entity.Instances
 	application.Memory = ccApp.Entity.Memory
 	application.Name = ccApp.Entity.Name
 	application.PackageState = ApplicationPackageState(ccApp.Entity.PackageState)
+	application.Ports = ccApp.Entity.Ports
 	application.StackGUID = ccApp.Entity.StackGUID
 	application.StagingFailedDescription = ccApp.Entity.StagingFailedDescription
 	application.StagingFailedReason = ccApp.Entity.StagingFailedReason
@@ -230,6 +280,75 @@ func (client *Client) UpdateApplication(app Application) (Application, Warnings,
 	return updatedApp, response.Warnings, err
 }
 
+// ApplicationScale is the set of scaling values to apply to an application.
+// Memory and DiskQuota are in megabytes and are only sent when non-zero.
+type ApplicationScale struct {
+	Instances types.NullInt
+	Memory    int
+	DiskQuota int
+}
+
+// MarshalJSON converts an ApplicationScale into a Cloud Controller
+// Application update request, containing only the values that were provided.
+func (scale ApplicationScale) MarshalJSON() ([]byte, error) {
+	ccScale := map[string]int{}
+	if scale.Instances.IsSet {
+		ccScale["instances"] = scale.Instances.Value
+	}
+	if scale.Memory != 0 {
+		ccScale["memory"] = scale.Memory
+	}
+	if scale.DiskQuota != 0 {
+		ccScale["disk_quota"] = scale.DiskQuota
+	}
+	return json.Marshal(ccScale)
+}
+
+// ScaleApplication updates the instances, memory and disk quota of the
+// application with the given GUID.
+func (client *Client) ScaleApplication(appGUID string, scale ApplicationScale) (Application, Warnings, error) {
+	body, err := json.Marshal(scale)
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutAppRequest,
+		URIParams:   Params{"app_guid": appGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	var scaledApp Application
+	response := cloudcontroller.Response{
+		Result: &scaledApp,
+	}
+
+	err = client.connection.Make(request, &response)
+	return scaledApp, response.Warnings, err
+}
+
+// RestageApplication restages the application with the given GUID.
+func (client *Client) RestageApplication(app Application) (Application, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostAppRestageRequest,
+		URIParams:   Params{"app_guid": app.GUID},
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	var restagedApp Application
+	response := cloudcontroller.Response{
+		Result: &restagedApp,
+	}
+
+	err = client.connection.Make(request, &response)
+	return restagedApp, response.Warnings, err
+}
+
 // GetRouteApplications returns a list of Applications associated with a route
 // GUID, filtered by provided queries.
 func (client *Client) GetRouteApplications(routeGUID string, queryParams []Query) ([]Application, Warnings, error) {
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -91,12 +92,17 @@ var _ = Describe("Application", func() {
 			response := `{
 						"metadata": {
 							"guid": "app-guid-1",
-							"updated_at": null
+							"created_at": "2015-01-10T23:11:54Z",
+							"updated_at": "2015-02-10T23:11:54Z"
 						},
 						"entity": {
 							"buildpack": "ruby 1.6.29",
 							"detected_start_command": "echo 'I am a banana'",
 							"disk_quota": 586,
+							"environment_json": {
+								"SOME_VAR": "some-value",
+								"SOME_NUMBER": 42
+							},
 							"detected_buildpack": null,
 							"health_check_type": "port",
 							"health_check_http_endpoint": "/",
@@ -105,6 +111,7 @@ var _ = Describe("Application", func() {
 							"name": "app-name-1",
 							"package_state": "FAILED",
 							"package_updated_at": "2015-03-10T23:11:54Z",
+					"ports": [8080, 9090],
 							"stack_guid": "some-stack-guid",
 							"staging_failed_description": "some-staging-failed-description",
 							"staging_failed_reason": "some-reason",
@@ -126,12 +133,21 @@ var _ = Describe("Application", func() {
 
 				updatedAt, err := time.Parse(time.RFC3339, "2015-03-10T23:11:54Z")
 				Expect(err).NotTo(HaveOccurred())
+				appCreatedAt, err := time.Parse(time.RFC3339, "2015-01-10T23:11:54Z")
+				Expect(err).NotTo(HaveOccurred())
+				appUpdatedAt, err := time.Parse(time.RFC3339, "2015-02-10T23:11:54Z")
+				Expect(err).NotTo(HaveOccurred())
 
 				Expect(app).To(Equal(Application{
-					Buildpack:                "ruby 1.6.29",
-					DetectedBuildpack:        "",
-					DetectedStartCommand:     "echo 'I am a banana'",
-					DiskQuota:                586,
+					Buildpack:            "ruby 1.6.29",
+					CreatedAt:            appCreatedAt,
+					DetectedBuildpack:    "",
+					DetectedStartCommand: "echo 'I am a banana'",
+					DiskQuota:            586,
+					EnvironmentVariables: map[string]string{
+						"SOME_VAR":    "some-value",
+						"SOME_NUMBER": "42",
+					},
 					GUID:                     "app-guid-1",
 					HealthCheckType:          "port",
 					HealthCheckHTTPEndpoint:  "/",
@@ -140,10 +156,12 @@ var _ = Describe("Application", func() {
 					Name:                     "app-name-1",
 					PackageState:             ApplicationPackageFailed,
 					PackageUpdatedAt:         updatedAt,
+					Ports:                    []int{8080, 9090},
 					StackGUID:                "some-stack-guid",
 					StagingFailedDescription: "some-staging-failed-description",
 					StagingFailedReason:      "some-reason",
 					State:                    ApplicationStopped,
+					UpdatedAt:                appUpdatedAt,
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 			})
@@ -266,6 +284,155 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("ScaleApplication", func() {
+		Context("when the scale is successful", func() {
+			Context("when all values are provided", func() {
+				BeforeEach(func() {
+					response := `{
+						"metadata": {
+							"guid": "some-app-guid"
+						},
+						"entity": {
+							"name": "some-app-name",
+							"instances": 0,
+							"memory": 256,
+							"disk_quota": 512,
+							"state": "STARTED"
+						}
+					}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyJSONRepresenting(map[string]int{
+								"instances":  0,
+								"memory":     256,
+								"disk_quota": 512,
+							}),
+							RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("sends every value and returns the scaled app and warnings", func() {
+					app, warnings, err := client.ScaleApplication("some-app-guid", ApplicationScale{
+						Instances: types.NullInt{IsSet: true, Value: 0},
+						Memory:    256,
+						DiskQuota: 512,
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(app.GUID).To(Equal("some-app-guid"))
+					Expect(app.Instances).To(Equal(0))
+					Expect(app.Memory).To(Equal(256))
+					Expect(app.DiskQuota).To(Equal(512))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
+			})
+
+			Context("when only some values are provided", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyJSONRepresenting(map[string]int{
+								"memory": 256,
+							}),
+							RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-app-guid"}}`, nil),
+						),
+					)
+				})
+
+				It("only sends the provided values", func() {
+					_, _, err := client.ScaleApplication("some-app-guid", ApplicationScale{Memory: 256})
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+		})
+
+		Context("when the scale returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100007,
+					"description": "You have exceeded the memory limit for your organization's quota.",
+					"error_code": "CF-AppMemoryQuotaExceeded"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.ScaleApplication("some-app-guid", ApplicationScale{Memory: 256})
+				Expect(err).To(MatchError(ccerror.BadRequestError{
+					Message: "You have exceeded the memory limit for your organization's quota.",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("RestageApplication", func() {
+		Context("when the restage is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-app-guid"
+					},
+					"entity": {
+						"name": "some-app-name",
+						"instances": 2,
+						"package_state": "PENDING",
+						"state": "STARTED"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/apps/some-app-guid/restage"),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the restaged app and warnings", func() {
+				app, warnings, err := client.RestageApplication(Application{GUID: "some-app-guid"})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(app.GUID).To(Equal("some-app-guid"))
+				Expect(app.Name).To(Equal("some-app-name"))
+				Expect(app.Instances).To(Equal(2))
+				Expect(app.State).To(Equal(ApplicationStarted))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the restage returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 210002,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/apps/some-app-guid/restage"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.RestageApplication(Application{GUID: "some-app-guid"})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("UpdateApplication", func() {
 		Context("when the update is successful", func() {
 			Context("when updating all fields", func() { //are we encoding everything correctly?
@@ -293,6 +460,7 @@ var _ = Describe("Application", func() {
 					expectedBody := map[string]string{
 						"health_check_http_endpoint": "/anything",
 						"health_check_type":          "some-health-check-type",
+						"stack_guid":                 "some-stack-guid",
 						"state":                      "STARTED",
 					}
 
@@ -310,7 +478,8 @@ var _ = Describe("Application", func() {
 						GUID:                    "some-app-guid",
 						HealthCheckType:         "some-health-check-type",
 						HealthCheckHTTPEndpoint: "/anything",
-						State: ApplicationStarted,
+						StackGUID:               "some-stack-guid",
+						State:                   ApplicationStarted,
 					})
 					Expect(err).NotTo(HaveOccurred())
 
@@ -336,6 +505,81 @@ var _ = Describe("Application", func() {
 				})
 			})
 
+			Context("when updating the scaling settings and environment variables", func() {
+				BeforeEach(func() {
+					response1 := `{
+				"metadata": {
+					"guid": "some-app-guid"
+				},
+				"entity": {
+					"disk_quota": 2048,
+					"environment_json": {"SOME_VAR": "some-value"},
+					"instances": 3,
+					"memory": 512,
+					"name": "app-name-1"
+				}
+			}`
+					expectedBody := map[string]interface{}{
+						"disk_quota":       2048,
+						"environment_json": map[string]string{"SOME_VAR": "some-value"},
+						"instances":        3,
+						"memory":           512,
+					}
+
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyJSONRepresenting(expectedBody),
+							RespondWith(http.StatusCreated, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("sends the scaling settings and environment variables", func() {
+					app, warnings, err := client.UpdateApplication(Application{
+						GUID:                 "some-app-guid",
+						DiskQuota:            2048,
+						EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+						Instances:            3,
+						Memory:               512,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(app.EnvironmentVariables).To(Equal(map[string]string{"SOME_VAR": "some-value"}))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
+			})
+
+			Context("when removing all the environment variables", func() {
+				BeforeEach(func() {
+					response1 := `{
+				"metadata": {
+					"guid": "some-app-guid"
+				},
+				"entity": {
+					"environment_json": {},
+					"name": "app-name-1"
+				}
+			}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyBody([]byte(`{"environment_json":{}}`)),
+							RespondWith(http.StatusCreated, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("sends the empty environment variables", func() {
+					app, warnings, err := client.UpdateApplication(Application{
+						GUID:                 "some-app-guid",
+						EnvironmentVariables: map[string]string{},
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(app.EnvironmentVariables).To(BeEmpty())
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
+			})
+
 			Context("when only updating one field", func() { // are we **only** encoding the things we want
 				BeforeEach(func() {
 					response1 := `{
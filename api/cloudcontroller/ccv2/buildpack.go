@@ -0,0 +1,69 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Buildpack represents a Cloud Controller Buildpack.
+type Buildpack struct {
+	GUID string
+	Name string
+	// Stack is the name of the stack the buildpack runs on. It is empty when
+	// the buildpack runs on any stack.
+	Stack    string
+	Enabled  bool
+	Position int
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Buildpack response.
+func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
+	var ccBuildpack struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name     string `json:"name"`
+			Stack    string `json:"stack"`
+			Enabled  bool   `json:"enabled"`
+			Position int    `json:"position"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccBuildpack); err != nil {
+		return err
+	}
+
+	buildpack.GUID = ccBuildpack.Metadata.GUID
+	buildpack.Name = ccBuildpack.Entity.Name
+	buildpack.Stack = ccBuildpack.Entity.Stack
+	buildpack.Enabled = ccBuildpack.Entity.Enabled
+	buildpack.Position = ccBuildpack.Entity.Position
+	return nil
+}
+
+// GetBuildpacks returns a list of Buildpacks based off of the provided
+// queries.
+func (client *Client) GetBuildpacks(queries []Query) ([]Buildpack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildpacksRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBuildpacksList []Buildpack
+	warnings, err := client.paginate(request, Buildpack{}, func(item interface{}) error {
+		if buildpack, ok := item.(Buildpack); ok {
+			fullBuildpacksList = append(fullBuildpacksList, buildpack)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Buildpack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBuildpacksList, warnings, err
+}
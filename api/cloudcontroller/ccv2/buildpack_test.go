@@ -0,0 +1,106 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Buildpack", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetBuildpacks", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/buildpacks?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-buildpack-guid-1"
+							},
+							"entity": {
+								"name": "ruby_buildpack",
+								"stack": "cflinuxfs2",
+								"enabled": true,
+								"position": 1
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-buildpack-guid-2"
+							},
+							"entity": {
+								"name": "go_buildpack",
+								"stack": null,
+								"enabled": false,
+								"position": 2
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					))
+			})
+
+			It("returns paginated results and all warnings", func() {
+				buildpacks, warnings, err := client.GetBuildpacks(nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buildpacks).To(Equal([]Buildpack{
+					{GUID: "some-buildpack-guid-1", Name: "ruby_buildpack", Stack: "cflinuxfs2", Enabled: true, Position: 1},
+					{GUID: "some-buildpack-guid-2", Name: "go_buildpack", Position: 2},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.GetBuildpacks(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+})
@@ -68,6 +68,7 @@
 package ccv2
 
 import (
+	"context"
 	"fmt"
 	"runtime"
 	"time"
@@ -83,6 +84,9 @@ type Warnings []string
 // Client is a client that can be used to talk to a Cloud Controller's V2
 // Endpoints.
 type Client struct {
+	appSSHEndpoint            string
+	appSSHHostKeyFingerprint  string
+	appSSHOAuthClient         string
 	authorizationEndpoint     string
 	cloudControllerAPIVersion string
 	cloudControllerURL        string
@@ -94,6 +98,7 @@ type Client struct {
 	jobPollingInterval time.Duration
 	jobPollingTimeout  time.Duration
 
+	ctx        context.Context
 	connection cloudcontroller.Connection
 	router     *rata.RequestGenerator
 	userAgent  string
@@ -116,15 +121,25 @@ type Config struct {
 
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
+
+	// Context cancels the requests and job polls of the client when it is
+	// done. Defaults to a context that is never done.
+	Context context.Context
 }
 
 // NewClient returns a new Cloud Controller Client.
 func NewClient(config Config) *Client {
+	ctx := config.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
 	return &Client{
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
 		jobPollingTimeout:  config.JobPollingTimeout,
+		ctx:                ctx,
 		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
@@ -12,6 +12,15 @@ import (
 type Domain struct {
 	GUID string
 	Name string
+
+	// RouterGroupGUID and RouterGroupType are only set for shared domains
+	// that belong to a router group, such as TCP domains.
+	RouterGroupGUID string
+	RouterGroupType string
+
+	// Internal is true for shared domains that are only reachable from
+	// within the platform.
+	Internal bool
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Domain response.
@@ -19,7 +28,10 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 	var ccDomain struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name            string `json:"name"`
+			RouterGroupGUID string `json:"router_group_guid"`
+			RouterGroupType string `json:"router_group_type"`
+			Internal        bool   `json:"internal"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccDomain); err != nil {
@@ -28,6 +40,9 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 
 	domain.GUID = ccDomain.Metadata.GUID
 	domain.Name = ccDomain.Entity.Name
+	domain.RouterGroupGUID = ccDomain.Entity.RouterGroupGUID
+	domain.RouterGroupType = ccDomain.Entity.RouterGroupType
+	domain.Internal = ccDomain.Entity.Internal
 	return nil
 }
 
@@ -155,7 +155,9 @@ var _ = Describe("Domain", func() {
 							"guid": "domain-guid-3"
 						},
 						"entity": {
-							"name": "domain-name-3"
+							"name": "domain-name-3",
+							"router_group_guid": "some-router-group-guid",
+							"router_group_type": "tcp"
 						}
 					},
 					{
@@ -163,7 +165,8 @@ var _ = Describe("Domain", func() {
 							"guid": "domain-guid-4"
 						},
 						"entity": {
-							"name": "domain-name-4"
+							"name": "domain-name-4",
+							"internal": true
 						}
 					}
 				]
@@ -195,12 +198,15 @@ var _ = Describe("Domain", func() {
 						Name: "domain-name-2",
 					},
 					{
-						GUID: "domain-guid-3",
-						Name: "domain-name-3",
+						GUID:            "domain-guid-3",
+						Name:            "domain-name-3",
+						RouterGroupGUID: "some-router-group-guid",
+						RouterGroupType: "tcp",
 					},
 					{
-						GUID: "domain-guid-4",
-						Name: "domain-name-4",
+						GUID:     "domain-guid-4",
+						Name:     "domain-name-4",
+						Internal: true,
 					},
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
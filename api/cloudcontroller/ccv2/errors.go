@@ -49,15 +49,15 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 
 	switch rawHTTPStatusErr.StatusCode {
 	case http.StatusBadRequest: // 400
-		return handleBadRequest(errorResponse)
+		return handleBadRequest(errorResponse, rawHTTPStatusErr.RequestIDs)
 	case http.StatusUnauthorized: // 401
-		return handleUnauthorized(errorResponse)
+		return handleUnauthorized(errorResponse, rawHTTPStatusErr.RequestIDs)
 	case http.StatusForbidden: // 403
-		return ccerror.ForbiddenError{Message: errorResponse.Description}
+		return ccerror.ForbiddenError{Message: errorResponse.Description, RequestIDs: rawHTTPStatusErr.RequestIDs}
 	case http.StatusNotFound: // 404
 		return ccerror.ResourceNotFoundError{Message: errorResponse.Description}
 	case http.StatusUnprocessableEntity: // 422
-		return ccerror.UnprocessableEntityError{Message: errorResponse.Description}
+		return ccerror.UnprocessableEntityError{Message: errorResponse.Description, RequestIDs: rawHTTPStatusErr.RequestIDs}
 	default:
 		return ccerror.V2UnexpectedResponseError{
 			V2ErrorResponse: errorResponse,
@@ -68,8 +68,10 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 	return nil
 }
 
-func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
+func handleBadRequest(errorResponse ccerror.V2ErrorResponse, requestIDs []string) error {
 	switch errorResponse.ErrorCode {
+	case "CF-AppNameTaken":
+		return ccerror.ApplicationNameTakenError{Message: errorResponse.Description}
 	case "CF-AppStoppedStatsError":
 		return ccerror.ApplicationStoppedStatsError{Message: errorResponse.Description}
 	case "CF-InstancesError":
@@ -78,15 +80,25 @@ func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
 		return ccerror.InvalidRelationError{Message: errorResponse.Description}
 	case "CF-NotStaged":
 		return ccerror.NotStagedError{Message: errorResponse.Description}
+	case "CF-OrganizationNameTaken":
+		return ccerror.OrganizationNameTakenError{Message: errorResponse.Description}
+	case "CF-SecurityGroupNameTaken":
+		return ccerror.SecurityGroupNameTakenError{Message: errorResponse.Description}
+	case "CF-ServiceFetchInstanceParametersNotSupported":
+		return ccerror.ServiceInstanceParametersFetchNotSupportedError{Message: errorResponse.Description}
+	case "CF-ServiceInstanceNameTaken":
+		return ccerror.ServiceInstanceNameTakenError{Message: errorResponse.Description}
+	case "CF-SpaceNameTaken":
+		return ccerror.SpaceNameTakenError{Message: errorResponse.Description}
 	default:
-		return ccerror.BadRequestError{Message: errorResponse.Description}
+		return ccerror.BadRequestError{Message: errorResponse.Description, RequestIDs: requestIDs}
 	}
 }
 
-func handleUnauthorized(errorResponse ccerror.V2ErrorResponse) error {
+func handleUnauthorized(errorResponse ccerror.V2ErrorResponse, requestIDs []string) error {
 	if errorResponse.ErrorCode == "CF-InvalidAuthToken" {
 		return ccerror.InvalidAuthTokenError{Message: errorResponse.Description}
 	}
 
-	return ccerror.UnauthorizedError{Message: errorResponse.Description}
+	return ccerror.UnauthorizedError{Message: errorResponse.Description, RequestIDs: requestIDs}
 }
@@ -82,6 +82,10 @@ var _ = Describe("Error Wrapper", func() {
 						_, _, err := client.GetApplications(nil)
 						Expect(err).To(MatchError(ccerror.BadRequestError{
 							Message: "bad request",
+							RequestIDs: []string{
+								"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+								"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+							},
 						}))
 					})
 				})
@@ -102,6 +106,91 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when an organization name taken error is encountered", func() {
+					BeforeEach(func() {
+						response = `{
+								"code": 30002,
+								"description": "The organization name is taken: some-org",
+								"error_code": "CF-OrganizationNameTaken"
+							}`
+					})
+
+					It("returns an OrganizationNameTakenError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.OrganizationNameTakenError{
+							Message: "The organization name is taken: some-org",
+						}))
+					})
+				})
+
+				Context("when an app name taken error is encountered", func() {
+					BeforeEach(func() {
+						response = `{
+								"code": 100002,
+								"description": "The app name is taken: some-app",
+								"error_code": "CF-AppNameTaken"
+							}`
+					})
+
+					It("returns an ApplicationNameTakenError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.ApplicationNameTakenError{
+							Message: "The app name is taken: some-app",
+						}))
+					})
+				})
+
+				Context("when a service instance name taken error is encountered", func() {
+					BeforeEach(func() {
+						response = `{
+								"code": 60002,
+								"description": "The service instance name is taken: some-service",
+								"error_code": "CF-ServiceInstanceNameTaken"
+							}`
+					})
+
+					It("returns a ServiceInstanceNameTakenError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.ServiceInstanceNameTakenError{
+							Message: "The service instance name is taken: some-service",
+						}))
+					})
+				})
+
+				Context("when a space name taken error is encountered", func() {
+					BeforeEach(func() {
+						response = `{
+								"code": 40002,
+								"description": "The app space name is taken: some-space",
+								"error_code": "CF-SpaceNameTaken"
+							}`
+					})
+
+					It("returns a SpaceNameTakenError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.SpaceNameTakenError{
+							Message: "The app space name is taken: some-space",
+						}))
+					})
+				})
+
+				Context("when fetching service instance parameters is not supported", func() {
+					BeforeEach(func() {
+						response = `{
+								"code": 120004,
+								"description": "This service does not support fetching service instance parameters.",
+								"error_code": "CF-ServiceFetchInstanceParametersNotSupported"
+							}`
+					})
+
+					It("returns a ServiceInstanceParametersFetchNotSupportedError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.ServiceInstanceParametersFetchNotSupportedError{
+							Message: "This service does not support fetching service instance parameters.",
+						}))
+					})
+				})
+
 				Context("when an instances error is encountered", func() {
 					BeforeEach(func() {
 						response = `{
@@ -135,6 +224,23 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when creating a security group with a name that is taken", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 300005,
+							"description": "The security group name is taken: some-security-group",
+							"error_code": "CF-SecurityGroupNameTaken"
+						}`
+					})
+
+					It("returns a SecurityGroupNameTakenError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.SecurityGroupNameTakenError{
+							Message: "The security group name is taken: some-security-group",
+						}))
+					})
+				})
+
 				Context("getting stats for a stopped app", func() {
 					BeforeEach(func() {
 						response = `{
@@ -161,7 +267,13 @@ var _ = Describe("Error Wrapper", func() {
 				Context("generic 401", func() {
 					It("returns a UnauthorizedError", func() {
 						_, _, err := client.GetApplications(nil)
-						Expect(err).To(MatchError(ccerror.UnauthorizedError{Message: "SomeCC Error Message"}))
+						Expect(err).To(MatchError(ccerror.UnauthorizedError{
+							Message: "SomeCC Error Message",
+							RequestIDs: []string{
+								"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+								"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+							},
+						}))
 					})
 				})
 
@@ -188,7 +300,13 @@ var _ = Describe("Error Wrapper", func() {
 
 				It("returns a ForbiddenError", func() {
 					_, _, err := client.GetApplications(nil)
-					Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "SomeCC Error Message"}))
+					Expect(err).To(MatchError(ccerror.ForbiddenError{
+						Message: "SomeCC Error Message",
+						RequestIDs: []string{
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+						},
+					}))
 				})
 			})
 
@@ -223,7 +341,13 @@ var _ = Describe("Error Wrapper", func() {
 
 				It("returns a UnprocessableEntityError", func() {
 					_, _, err := client.GetApplications(nil)
-					Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "SomeCC Error Message"}))
+					Expect(err).To(MatchError(ccerror.UnprocessableEntityError{
+						Message: "SomeCC Error Message",
+						RequestIDs: []string{
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+						},
+					}))
 				})
 			})
 
@@ -0,0 +1,144 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// EventType is the type of a Cloud Controller audit event.
+type EventType string
+
+const (
+	// ApplicationCrashEvent is recorded every time an app instance crashes.
+	ApplicationCrashEvent EventType = "app.crash"
+)
+
+// Event represents a Cloud Controller audit event.
+type Event struct {
+	GUID      string
+	Type      EventType
+	ActeeGUID string
+	ActorGUID string
+	ActorName string
+	Timestamp time.Time
+
+	// Metadata contains the event type specific details of the event, such as
+	// the requested changes of an app.update event.
+	Metadata map[string]interface{}
+
+	// InstanceIndex, ExitDescription and Reason are only provided for
+	// app.crash events.
+	InstanceIndex   int
+	ExitDescription string
+	Reason          string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Event response.
+func (event *Event) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Type      string          `json:"type"`
+			Actee     string          `json:"actee"`
+			Actor     string          `json:"actor"`
+			ActorName string          `json:"actor_name"`
+			Timestamp time.Time       `json:"timestamp"`
+			Metadata  json.RawMessage `json:"metadata"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccEvent); err != nil {
+		return err
+	}
+
+	var crashMetadata struct {
+		Index           int    `json:"index"`
+		ExitDescription string `json:"exit_description"`
+		Reason          string `json:"reason"`
+	}
+	if len(ccEvent.Entity.Metadata) > 0 {
+		if err := json.Unmarshal(ccEvent.Entity.Metadata, &crashMetadata); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(ccEvent.Entity.Metadata, &event.Metadata); err != nil {
+			return err
+		}
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.Type = EventType(ccEvent.Entity.Type)
+	event.ActeeGUID = ccEvent.Entity.Actee
+	event.ActorGUID = ccEvent.Entity.Actor
+	event.ActorName = ccEvent.Entity.ActorName
+	event.Timestamp = ccEvent.Entity.Timestamp
+	event.InstanceIndex = crashMetadata.Index
+	event.ExitDescription = crashMetadata.ExitDescription
+	event.Reason = crashMetadata.Reason
+	return nil
+}
+
+// GetEvents returns back a list of Events based off of the provided queries.
+func (client *Client) GetEvents(queries []Query) ([]Event, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetEventsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullEventsList []Event
+	warnings, err := client.paginate(request, Event{}, func(item interface{}) error {
+		if event, ok := item.(Event); ok {
+			fullEventsList = append(fullEventsList, event)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Event{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullEventsList, warnings, err
+}
+
+// GetRecentEvents returns back, newest first, up to limit Events matching the
+// provided queries.
+func (client *Client) GetRecentEvents(queries []Query, limit int) ([]Event, Warnings, error) {
+	query := FormatQueryParameters(queries)
+	query.Set("order-direction", "desc")
+	query.Set("results-per-page", strconv.Itoa(limit))
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetEventsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wrapper := NewPaginatedResources(Event{})
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	list, err := wrapper.Resources()
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	events := make([]Event, 0, len(list))
+	for _, item := range list {
+		events = append(events, item.(Event))
+	}
+	return events, response.Warnings, nil
+}
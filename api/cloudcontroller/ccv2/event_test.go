@@ -0,0 +1,268 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEvents", func() {
+		Context("when the cloud controller does not return an error", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/events?q=actee:some-app-guid&q=type:app.crash&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-event-guid-1"
+							},
+							"entity": {
+								"type": "app.crash",
+								"actee": "some-app-guid",
+								"timestamp": "2017-06-01T10:00:00Z",
+								"metadata": {
+									"index": 1,
+									"exit_description": "APP/PROC/WEB: Exited with status 1",
+									"reason": "CRASHED"
+								}
+							}
+						}
+					]
+				}`
+
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-event-guid-2"
+							},
+							"entity": {
+								"type": "app.crash",
+								"actee": "some-app-guid",
+								"timestamp": "2017-06-01T10:05:00Z",
+								"metadata": {
+									"index": 0,
+									"exit_description": "out of memory",
+									"reason": "CRASHED"
+								}
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "q=actee:some-app-guid&q=type:app.crash"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "q=actee:some-app-guid&q=type:app.crash&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns all the queried events and all warnings", func() {
+				events, warnings, err := client.GetEvents([]Query{
+					{
+						Filter:   ActeeFilter,
+						Operator: EqualOperator,
+						Value:    "some-app-guid",
+					},
+					{
+						Filter:   TypeFilter,
+						Operator: EqualOperator,
+						Value:    string(ApplicationCrashEvent),
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(events).To(ConsistOf([]Event{
+					{
+						GUID:            "some-event-guid-1",
+						Type:            ApplicationCrashEvent,
+						ActeeGUID:       "some-app-guid",
+						Timestamp:       time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC),
+						InstanceIndex:   1,
+						ExitDescription: "APP/PROC/WEB: Exited with status 1",
+						Reason:          "CRASHED",
+						Metadata: map[string]interface{}{
+							"index":            float64(1),
+							"exit_description": "APP/PROC/WEB: Exited with status 1",
+							"reason":           "CRASHED",
+						},
+					},
+					{
+						GUID:            "some-event-guid-2",
+						Type:            ApplicationCrashEvent,
+						ActeeGUID:       "some-app-guid",
+						Timestamp:       time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC),
+						InstanceIndex:   0,
+						ExitDescription: "out of memory",
+						Reason:          "CRASHED",
+						Metadata: map[string]interface{}{
+							"index":            float64(0),
+							"exit_description": "out of memory",
+							"reason":           "CRASHED",
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetEvents(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetRecentEvents", func() {
+		Context("when the cloud controller does not return an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": "/v2/events?q=actee:some-app-guid&order-direction=desc&results-per-page=2&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-event-guid-2"
+							},
+							"entity": {
+								"type": "audit.app.update",
+								"actee": "some-app-guid",
+								"actor": "some-user-guid",
+								"actor_name": "some-user",
+								"timestamp": "2017-06-01T10:05:00Z",
+								"metadata": {
+									"request": {
+										"instances": 2
+									}
+								}
+							}
+						},
+						{
+							"metadata": {
+								"guid": "some-event-guid-1"
+							},
+							"entity": {
+								"type": "audit.app.create",
+								"actee": "some-app-guid",
+								"actor": "some-user-guid",
+								"actor_name": "some-user",
+								"timestamp": "2017-06-01T10:00:00Z"
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "q=actee:some-app-guid&order-direction=desc&results-per-page=2"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns only the first page of events and all warnings", func() {
+				events, warnings, err := client.GetRecentEvents([]Query{{
+					Filter:   ActeeFilter,
+					Operator: EqualOperator,
+					Value:    "some-app-guid",
+				}}, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(events).To(Equal([]Event{
+					{
+						GUID:      "some-event-guid-2",
+						Type:      "audit.app.update",
+						ActeeGUID: "some-app-guid",
+						ActorGUID: "some-user-guid",
+						ActorName: "some-user",
+						Timestamp: time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC),
+						Metadata: map[string]interface{}{
+							"request": map[string]interface{}{"instances": float64(2)},
+						},
+					},
+					{
+						GUID:      "some-event-guid-1",
+						Type:      "audit.app.create",
+						ActeeGUID: "some-app-guid",
+						ActorGUID: "some-user-guid",
+						ActorName: "some-user",
+						Timestamp: time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC),
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetRecentEvents(nil, 50)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package ccv2
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// FeatureFlag represents a Cloud Controller feature flag.
+type FeatureFlag struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// GetConfigFeatureFlag returns the feature flag with the given name.
+func (client *Client) GetConfigFeatureFlag(name string) (FeatureFlag, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetConfigFeatureFlagRequest,
+		URIParams:   Params{"name": name},
+	})
+	if err != nil {
+		return FeatureFlag{}, nil, err
+	}
+
+	var featureFlag FeatureFlag
+	response := cloudcontroller.Response{
+		Result: &featureFlag,
+	}
+
+	err = client.connection.Make(request, &response)
+	return featureFlag, response.Warnings, err
+}
@@ -0,0 +1,73 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Feature Flag", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetConfigFeatureFlag", func() {
+		Context("when the feature flag exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"name": "set_roles_by_username",
+					"enabled": true,
+					"error_message": null,
+					"url": "/v2/config/feature_flags/set_roles_by_username"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/feature_flags/set_roles_by_username"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the feature flag and warnings", func() {
+				featureFlag, warnings, err := client.GetConfigFeatureFlag("set_roles_by_username")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(featureFlag).To(Equal(FeatureFlag{
+					Name:    "set_roles_by_username",
+					Enabled: true,
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 330000,
+					"description": "The feature flag could not be found: some-flag",
+					"error_code": "CF-FeatureFlagNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/feature_flags/some-flag"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetConfigFeatureFlag("some-flag")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The feature flag could not be found: some-flag",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -9,6 +9,9 @@ import (
 // APIInformation represents the information returned back from /v2/info
 type APIInformation struct {
 	APIVersion                   string `json:"api_version"`
+	AppSSHEndpoint               string `json:"app_ssh_endpoint"`
+	AppSSHHostKeyFingerprint     string `json:"app_ssh_host_key_fingerprint"`
+	AppSSHOAuthClient            string `json:"app_ssh_oauth_client"`
 	AuthorizationEndpoint        string `json:"authorization_endpoint"`
 	DopplerEndpoint              string `json:"doppler_logging_endpoint"`
 	MinCLIVersion                string `json:"min_cli_version"`
@@ -29,6 +32,24 @@ func (client *Client) APIVersion() string {
 	return client.cloudControllerAPIVersion
 }
 
+// AppSSHEndpoint returns the SSH proxy endpoint for the targeted Cloud
+// Controller.
+func (client *Client) AppSSHEndpoint() string {
+	return client.appSSHEndpoint
+}
+
+// AppSSHHostKeyFingerprint returns the fingerprint of the SSH proxy's host key
+// for the targeted Cloud Controller.
+func (client *Client) AppSSHHostKeyFingerprint() string {
+	return client.appSSHHostKeyFingerprint
+}
+
+// AppSSHOAuthClient returns the UAA client used to authorize SSH sessions for
+// the targeted Cloud Controller.
+func (client *Client) AppSSHOAuthClient() string {
+	return client.appSSHOAuthClient
+}
+
 // AuthorizationEndpoint returns the authorization endpoint for the targeted
 // Cloud Controller.
 func (client *Client) AuthorizationEndpoint() string {
@@ -15,44 +15,89 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
-	DeleteSecurityGroupSpaceRequest       = "DeleteSecurityGroupSpace"
-	DeleteOrganizationRequest             = "DeleteOrganization"
-	DeleteRouteRequest                    = "DeleteRoute"
-	DeleteServiceBindingRequest           = "DeleteServiceBinding"
-	GetAppInstancesRequest                = "GetAppInstances"
-	GetAppRequest                         = "GetApp"
-	GetAppRoutesRequest                   = "GetAppRoutes"
-	GetAppsRequest                        = "GetApps"
-	GetAppStatsRequest                    = "GetAppStats"
-	GetInfoRequest                        = "GetInfo"
-	GetJobRequest                         = "GetJob"
-	GetOrganizationPrivateDomainsRequest  = "GetOrganizationPrivateDomains"
-	GetOrganizationQuotaDefinitionRequest = "GetOrganizationQuotaDefinition"
-	GetOrganizationRequest                = "GetOrganization"
-	GetOrganizationsRequest               = "GetOrganizations"
-	GetPrivateDomainRequest               = "GetPrivateDomain"
-	GetRouteAppsRequest                   = "GetRouteApps"
-	GetRouteReservedRequest               = "GetRouteReserved"
-	GetRouteRouteMappingsRequest          = "GetRouteRouteMappings"
-	GetRoutesRequest                      = "GetRoutes"
-	GetSecurityGroupsRequest              = "GetSecurityGroups"
-	GetServiceBindingsRequest             = "GetServiceBindings"
-	GetServiceInstancesRequest            = "GetServiceInstances"
-	GetSharedDomainRequest                = "GetSharedDomain"
-	GetSharedDomainsRequest               = "GetSharedDomains"
-	GetSpaceQuotaDefinitionRequest        = "GetSpaceQuotaDefinition"
-	GetSpaceRoutesRequest                 = "GetSpaceRoutes"
-	GetSpaceRunningSecurityGroupsRequest  = "GetSpaceRunningSecurityGroups"
-	GetSpaceServiceInstancesRequest       = "GetSpaceServiceInstances"
-	GetSpacesRequest                      = "GetSpaces"
-	GetSpaceStagingSecurityGroupsRequest  = "GetSpaceStagingSecurityGroups"
-	GetStackRequest                       = "GetStack"
-	GetUsersRequest                       = "GetUsers"
-	PostAppRequest                        = "PostApp"
-	PostRouteRequest                      = "PostRoute"
-	PutAppRequest                         = "PutApp"
-	PutBindRouteAppRequest                = "PutBindRouteApp"
-	PutSecurityGroupSpaceRequest          = "PutSecurityGroupSpace"
+	DeleteSecurityGroupSpaceRequest             = "DeleteSecurityGroupSpace"
+	DeleteOrganizationRequest                   = "DeleteOrganization"
+	DeleteRouteRequest                          = "DeleteRoute"
+	DeleteRouteAppRequest                       = "DeleteRouteApp"
+	DeleteRouteMappingRequest                   = "DeleteRouteMapping"
+	DeleteServiceBindingRequest                 = "DeleteServiceBinding"
+	DeleteServiceInstanceRequest                = "DeleteServiceInstance"
+	DeleteServiceRequest                        = "DeleteService"
+	DeleteSpaceRequest                          = "DeleteSpace"
+	GetAppInstancesRequest                      = "GetAppInstances"
+	GetAppRequest                               = "GetApp"
+	GetAppRoutesRequest                         = "GetAppRoutes"
+	GetAppsRequest                              = "GetApps"
+	GetAppStatsRequest                          = "GetAppStats"
+	GetBuildpacksRequest                        = "GetBuildpacks"
+	GetConfigFeatureFlagRequest                 = "GetConfigFeatureFlag"
+	GetEventsRequest                            = "GetEvents"
+	GetInfoRequest                              = "GetInfo"
+	GetJobRequest                               = "GetJob"
+	GetOrganizationPrivateDomainsRequest        = "GetOrganizationPrivateDomains"
+	GetOrganizationQuotaDefinitionRequest       = "GetOrganizationQuotaDefinition"
+	GetOrganizationQuotaDefinitionsRequest      = "GetOrganizationQuotaDefinitions"
+	GetOrganizationRequest                      = "GetOrganization"
+	GetOrganizationSpaceQuotaDefinitionsRequest = "GetOrganizationSpaceQuotaDefinitions"
+	GetOrganizationsRequest                     = "GetOrganizations"
+	GetPrivateDomainRequest                     = "GetPrivateDomain"
+	GetRouteAppsRequest                         = "GetRouteApps"
+	GetRouteMappingsRequest                     = "GetRouteMappings"
+	GetRouteReservedRequest                     = "GetRouteReserved"
+	GetRouteRouteMappingsRequest                = "GetRouteRouteMappings"
+	GetRoutesRequest                            = "GetRoutes"
+	GetSecurityGroupSpacesRequest               = "GetSecurityGroupSpaces"
+	GetSecurityGroupStagingSpacesRequest        = "GetSecurityGroupStagingSpaces"
+	GetSecurityGroupsRequest                    = "GetSecurityGroups"
+	GetServiceBindingsRequest                   = "GetServiceBindings"
+	GetServiceBrokersRequest                    = "GetServiceBrokers"
+	GetServiceInstanceParametersRequest         = "GetServiceInstanceParameters"
+	GetServiceInstanceSharedFromRequest         = "GetServiceInstanceSharedFrom"
+	GetServiceInstanceSharedToRequest           = "GetServiceInstanceSharedTo"
+	GetServiceInstancesRequest                  = "GetServiceInstances"
+	GetServicePlanRequest                       = "GetServicePlan"
+	GetServicePlansRequest                      = "GetServicePlans"
+	GetServicePlanVisibilitiesRequest           = "GetServicePlanVisibilities"
+	GetServiceRequest                           = "GetService"
+	GetServicesRequest                          = "GetServices"
+	GetSharedDomainRequest                      = "GetSharedDomain"
+	GetSharedDomainsRequest                     = "GetSharedDomains"
+	GetSpaceQuotaDefinitionRequest              = "GetSpaceQuotaDefinition"
+	GetSpaceRequest                             = "GetSpace"
+	GetSpaceRoutesRequest                       = "GetSpaceRoutes"
+	GetSpaceRunningSecurityGroupsRequest        = "GetSpaceRunningSecurityGroups"
+	GetSpaceServiceInstancesRequest             = "GetSpaceServiceInstances"
+	GetSpaceServicesRequest                     = "GetSpaceServices"
+	GetSpacesRequest                            = "GetSpaces"
+	GetSpaceStagingSecurityGroupsRequest        = "GetSpaceStagingSecurityGroups"
+	GetStackRequest                             = "GetStack"
+	GetStacksRequest                            = "GetStacks"
+	GetUsersRequest                             = "GetUsers"
+	PostAppRequest                              = "PostApp"
+	PostAppRestageRequest                       = "PostAppRestage"
+	PostOrganizationRequest                     = "PostOrganization"
+	PostRouteMappingRequest                     = "PostRouteMapping"
+	PostRouteRequest                            = "PostRoute"
+	PostSecurityGroupRequest                    = "PostSecurityGroup"
+	PostServiceBindingRequest                   = "PostServiceBinding"
+	PostServiceInstanceRequest                  = "PostServiceInstance"
+	PostSpaceRequest                            = "PostSpace"
+	PostUserProvidedServiceInstanceRequest      = "PostUserProvidedServiceInstance"
+	PutAppRequest                               = "PutApp"
+	PutBindRouteAppRequest                      = "PutBindRouteApp"
+	PutOrganizationAuditorsRequest              = "PutOrganizationAuditors"
+	PutOrganizationBillingManagersRequest       = "PutOrganizationBillingManagers"
+	PutOrganizationManagersRequest              = "PutOrganizationManagers"
+	PutOrganizationRequest                      = "PutOrganization"
+	PutOrganizationUsersRequest                 = "PutOrganizationUsers"
+	PutSecurityGroupRequest                     = "PutSecurityGroup"
+	PutSecurityGroupSpaceRequest                = "PutSecurityGroupSpace"
+	PutServiceInstanceRequest                   = "PutServiceInstance"
+	PutSpaceAuditorsRequest                     = "PutSpaceAuditors"
+	PutSpaceDevelopersRequest                   = "PutSpaceDevelopers"
+	PutSpaceManagersRequest                     = "PutSpaceManagers"
+	PutSpaceRequest                             = "PutSpace"
+	PutUserProvidedServiceInstanceRequest       = "PutUserProvidedServiceInstance"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
@@ -63,37 +108,82 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: GetAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: PutAppRequest},
 	{Path: "/v2/apps/:app_guid/instances", Method: http.MethodGet, Name: GetAppInstancesRequest},
+	{Path: "/v2/apps/:app_guid/restage", Method: http.MethodPost, Name: PostAppRestageRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
+	{Path: "/v2/buildpacks", Method: http.MethodGet, Name: GetBuildpacksRequest},
+	{Path: "/v2/config/feature_flags/:name", Method: http.MethodGet, Name: GetConfigFeatureFlagRequest},
+	{Path: "/v2/events", Method: http.MethodGet, Name: GetEventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
+	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid", Method: http.MethodPut, Name: PutOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/space_quota_definitions", Method: http.MethodGet, Name: GetOrganizationSpaceQuotaDefinitionsRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodPut, Name: PutOrganizationAuditorsRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodPut, Name: PutOrganizationBillingManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodPut, Name: PutOrganizationManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/users", Method: http.MethodPut, Name: PutOrganizationUsersRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
+	{Path: "/v2/route_mappings", Method: http.MethodGet, Name: GetRouteMappingsRequest},
+	{Path: "/v2/route_mappings", Method: http.MethodPost, Name: PostRouteMappingRequest},
+	{Path: "/v2/route_mappings/:route_mapping_guid", Method: http.MethodDelete, Name: DeleteRouteMappingRequest},
 	{Path: "/v2/routes", Method: http.MethodGet, Name: GetRoutesRequest},
 	{Path: "/v2/routes", Method: http.MethodPost, Name: PostRouteRequest},
 	{Path: "/v2/routes/:route_guid", Method: http.MethodDelete, Name: DeleteRouteRequest},
 	{Path: "/v2/routes/:route_guid/apps", Method: http.MethodGet, Name: GetRouteAppsRequest},
+	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodDelete, Name: DeleteRouteAppRequest},
 	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodPut, Name: PutBindRouteAppRequest},
 	{Path: "/v2/routes/:route_guid/route_mappings", Method: http.MethodGet, Name: GetRouteRouteMappingsRequest},
 	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
 	{Path: "/v2/security_groups", Method: http.MethodGet, Name: GetSecurityGroupsRequest},
+	{Path: "/v2/security_groups", Method: http.MethodPost, Name: PostSecurityGroupRequest},
+	{Path: "/v2/security_groups/:security_group_guid", Method: http.MethodPut, Name: PutSecurityGroupRequest},
+	{Path: "/v2/security_groups/:security_group_guid/spaces", Method: http.MethodGet, Name: GetSecurityGroupSpacesRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSecurityGroupSpaceRequest},
+	{Path: "/v2/security_groups/:security_group_guid/staging_spaces", Method: http.MethodGet, Name: GetSecurityGroupStagingSpacesRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSecurityGroupSpaceRequest},
 	{Path: "/v2/service_bindings", Method: http.MethodGet, Name: GetServiceBindingsRequest},
+	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
+	{Path: "/v2/service_brokers", Method: http.MethodGet, Name: GetServiceBrokersRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
+	{Path: "/v2/service_instances", Method: http.MethodPost, Name: PostServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodPut, Name: PutServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid/parameters", Method: http.MethodGet, Name: GetServiceInstanceParametersRequest},
+	{Path: "/v2/service_instances/:service_instance_guid/shared_from", Method: http.MethodGet, Name: GetServiceInstanceSharedFromRequest},
+	{Path: "/v2/service_instances/:service_instance_guid/shared_to", Method: http.MethodGet, Name: GetServiceInstanceSharedToRequest},
+	{Path: "/v2/service_plan_visibilities", Method: http.MethodGet, Name: GetServicePlanVisibilitiesRequest},
+	{Path: "/v2/service_plans", Method: http.MethodGet, Name: GetServicePlansRequest},
+	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodGet, Name: GetServicePlanRequest},
+	{Path: "/v2/services", Method: http.MethodGet, Name: GetServicesRequest},
+	{Path: "/v2/services/:service_guid", Method: http.MethodGet, Name: GetServiceRequest},
+	{Path: "/v2/services/:service_guid", Method: http.MethodDelete, Name: DeleteServiceRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
+	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
+	{Path: "/v2/spaces/:space_guid", Method: http.MethodGet, Name: GetSpaceRequest},
+	{Path: "/v2/spaces/:space_guid", Method: http.MethodPut, Name: PutSpaceRequest},
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
+	{Path: "/v2/spaces/:space_guid/services", Method: http.MethodGet, Name: GetSpaceServicesRequest},
+	{Path: "/v2/spaces/:space_guid/auditors", Method: http.MethodPut, Name: PutSpaceAuditorsRequest},
+	{Path: "/v2/spaces/:space_guid/developers", Method: http.MethodPut, Name: PutSpaceDevelopersRequest},
+	{Path: "/v2/spaces/:space_guid/managers", Method: http.MethodPut, Name: PutSpaceManagersRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceRunningSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
+	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
+	{Path: "/v2/user_provided_service_instances", Method: http.MethodPost, Name: PostUserProvidedServiceInstanceRequest},
+	{Path: "/v2/user_provided_service_instances/:user_provided_service_instance_guid", Method: http.MethodPut, Name: PutUserProvidedServiceInstanceRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: GetUsersRequest},
 }
@@ -114,7 +114,11 @@ func (client *Client) PollJob(job Job) (Warnings, error) {
 			return allWarnings, nil
 		}
 
-		time.Sleep(client.jobPollingInterval)
+		select {
+		case <-client.ctx.Done():
+			return allWarnings, client.ctx.Err()
+		case <-time.After(client.jobPollingInterval):
+		}
 	}
 
 	return allWarnings, ccerror.JobTimeoutError{
@@ -147,3 +151,28 @@ func (client *Client) DeleteOrganization(orgGUID string) (Job, Warnings, error)
 	err = client.connection.Make(request, &response)
 	return job, response.Warnings, err
 }
+
+// DeleteSpace deletes the Space associated with the provided GUID, along with
+// all of its contents. It will return the Cloud Controller job that is
+// assigned to the space deletion.
+func (client *Client) DeleteSpace(spaceGUID string) (Job, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteSpaceRequest,
+		URIParams:   map[string]string{"space_guid": spaceGUID},
+		Query: url.Values{
+			"recursive": {"true"},
+			"async":     {"true"},
+		},
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	var job Job
+	response := cloudcontroller.Response{
+		Result: &job,
+	}
+
+	err = client.connection.Make(request, &response)
+	return job, response.Warnings, err
+}
@@ -1,6 +1,7 @@
 package ccv2_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -273,6 +274,43 @@ var _ = Describe("Job", func() {
 				})
 			})
 		})
+
+		Context("when the context is cancelled while polling", func() {
+			var cancel context.CancelFunc
+
+			BeforeEach(func() {
+				var ctx context.Context
+				ctx, cancel = context.WithCancel(context.Background())
+				client = NewTestClient(Config{
+					Context:            ctx,
+					JobPollingTimeout:  time.Minute,
+					JobPollingInterval: time.Minute,
+				})
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/jobs/some-job-guid"),
+						RespondWith(http.StatusAccepted, `{
+							"metadata": {
+								"guid": "some-job-guid",
+								"created_at": "2016-06-08T16:41:27Z",
+								"url": "/v2/jobs/some-job-guid"
+							},
+							"entity": {
+								"guid": "some-job-guid",
+								"status": "queued"
+							}
+						}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("stops polling and returns the context error and warnings", func() {
+				time.AfterFunc(100*time.Millisecond, cancel)
+				warnings, err := client.PollJob(Job{GUID: "some-job-guid"})
+				Expect(err).To(Equal(context.Canceled))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
 	})
 
 	Describe("GetJob", func() {
@@ -405,4 +443,65 @@ var _ = Describe("Job", func() {
 			})
 		})
 	})
+
+	Describe("DeleteSpace", func() {
+		BeforeEach(func() {
+			client = NewTestClient()
+		})
+
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				jsonResponse := `{
+					"metadata": {
+						"guid": "job-guid",
+						"created_at": "2016-06-08T16:41:27Z",
+						"url": "/v2/jobs/job-guid"
+					},
+					"entity": {
+						"guid": "job-guid",
+						"status": "queued"
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/spaces/some-space-guid", "recursive=true&async=true"),
+						RespondWith(http.StatusAccepted, jsonResponse, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("deletes the space and returns all warnings", func() {
+				job, warnings, err := client.DeleteSpace("some-space-guid")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1", "warning-2"}))
+				Expect(job.GUID).To(Equal("job-guid"))
+				Expect(job.Status).To(Equal(JobStatusQueued))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 40004,
+  "description": "The app space could not be found: some-space-guid",
+  "error_code": "CF-SpaceNotFound"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/spaces/some-space-guid", "recursive=true&async=true"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.DeleteSpace("some-space-guid")
+
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The app space could not be found: some-space-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1", "warning-2"}))
+			})
+		})
+	})
 })
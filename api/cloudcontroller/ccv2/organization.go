@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -37,6 +38,37 @@ func (org *Organization) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// CreateOrganization creates an Organization with the provided name. When
+// quotaGUID is empty the Cloud Controller assigns the default quota.
+func (client *Client) CreateOrganization(orgName string, quotaGUID string) (Organization, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name                string `json:"name"`
+		QuotaDefinitionGUID string `json:"quota_definition_guid,omitempty"`
+	}{
+		Name:                orgName,
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
+
 // GetOrganization returns an Organization associated with the provided guid.
 func (client *Client) GetOrganization(guid string) (Organization, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -83,3 +115,64 @@ func (client *Client) GetOrganizations(queries []Query) ([]Organization, Warning
 
 	return fullOrgsList, warnings, err
 }
+
+// GetOrganizationsByPage calls handlePage with each page of Organizations,
+// ordered by name and based off of the provided queries, as soon as the page
+// is retrieved.
+func (client *Client) GetOrganizationsByPage(queries []Query, handlePage func([]Organization) error) (Warnings, error) {
+	query := FormatQueryParameters(queries)
+	query.Set("order-by", "name")
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.paginateByPage(request, Organization{}, func(list []interface{}) error {
+		orgs := make([]Organization, 0, len(list))
+		for _, item := range list {
+			org, ok := item.(Organization)
+			if !ok {
+				return ccerror.UnknownObjectInListError{
+					Expected:   Organization{},
+					Unexpected: item,
+				}
+			}
+			orgs = append(orgs, org)
+		}
+		return handlePage(orgs)
+	})
+}
+
+// UpdateOrganizationName renames the Organization associated with the
+// provided GUID.
+func (client *Client) UpdateOrganizationName(orgGUID string, newName string) (Organization, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name string `json:"name"`
+	}{
+		Name: newName,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
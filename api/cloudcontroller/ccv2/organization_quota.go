@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -11,6 +12,15 @@ import (
 type OrganizationQuota struct {
 	GUID string
 	Name string
+
+	// MemoryLimit is the total memory, in megabytes, that the started apps of
+	// the organization may use.
+	MemoryLimit int
+
+	// AppInstanceLimit, TotalRoutes and TotalServices are -1 when unlimited.
+	AppInstanceLimit int
+	TotalRoutes      int
+	TotalServices    int
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
@@ -18,7 +28,11 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name             string `json:"name"`
+			MemoryLimit      int    `json:"memory_limit"`
+			AppInstanceLimit int    `json:"app_instance_limit"`
+			TotalRoutes      int    `json:"total_routes"`
+			TotalServices    int    `json:"total_services"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccOrgQuota); err != nil {
@@ -27,6 +41,10 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 
 	application.GUID = ccOrgQuota.Metadata.GUID
 	application.Name = ccOrgQuota.Entity.Name
+	application.MemoryLimit = ccOrgQuota.Entity.MemoryLimit
+	application.AppInstanceLimit = ccOrgQuota.Entity.AppInstanceLimit
+	application.TotalRoutes = ccOrgQuota.Entity.TotalRoutes
+	application.TotalServices = ccOrgQuota.Entity.TotalServices
 
 	return nil
 }
@@ -49,3 +67,30 @@ func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warn
 	err = client.connection.Make(request, &response)
 	return orgQuota, response.Warnings, err
 }
+
+// GetOrganizationQuotas returns back a list of organization quotas (quota
+// definitions) based off of the provided queries.
+func (client *Client) GetOrganizationQuotas(queries []Query) ([]OrganizationQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationQuotaDefinitionsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullOrgQuotasList []OrganizationQuota
+	warnings, err := client.paginate(request, OrganizationQuota{}, func(item interface{}) error {
+		if orgQuota, ok := item.(OrganizationQuota); ok {
+			fullOrgQuotasList = append(fullOrgQuotasList, orgQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   OrganizationQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullOrgQuotasList, warnings, err
+}
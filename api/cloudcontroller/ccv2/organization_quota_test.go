@@ -26,7 +26,11 @@ var _ = Describe("OrganizationQuota", func() {
 					"guid": "some-org-quota-guid"
 				},
 				"entity": {
-					"name": "some-org-quota"
+					"name": "some-org-quota",
+					"memory_limit": 10240,
+					"app_instance_limit": -1,
+					"total_routes": 100,
+					"total_services": 20
 				}
 			}`
 				server.AppendHandlers(
@@ -42,8 +46,12 @@ var _ = Describe("OrganizationQuota", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(Equal(Warnings{"warning-1"}))
 				Expect(orgQuota).To(Equal(OrganizationQuota{
-					GUID: "some-org-quota-guid",
-					Name: "some-org-quota",
+					GUID:             "some-org-quota-guid",
+					Name:             "some-org-quota",
+					MemoryLimit:      10240,
+					AppInstanceLimit: -1,
+					TotalRoutes:      100,
+					TotalServices:    20,
 				}))
 			})
 		})
@@ -73,4 +81,90 @@ var _ = Describe("OrganizationQuota", func() {
 		})
 
 	})
+
+	Describe("GetOrganizationQuotas", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/quota_definitions?q=name:some-quota&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-quota-guid-1"
+							},
+							"entity": {
+								"name": "some-quota"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-quota-guid-2"
+							},
+							"entity": {
+								"name": "some-quota"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-quota"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-quota&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the organization quotas and all warnings", func() {
+				orgQuotas, warnings, err := client.GetOrganizationQuotas([]Query{{
+					Filter:   NameFilter,
+					Operator: EqualOperator,
+					Value:    "some-quota",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1", "warning-2"}))
+				Expect(orgQuotas).To(ConsistOf(
+					OrganizationQuota{GUID: "some-quota-guid-1", Name: "some-quota"},
+					OrganizationQuota{GUID: "some-quota-guid-2", Name: "some-quota"},
+				))
+			})
+		})
+
+		Context("when the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/quota_definitions"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetOrganizationQuotas(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1"}))
+			})
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package ccv2_test
 
 import (
+	"errors"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -17,6 +18,84 @@ var _ = Describe("Organization", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CreateOrganization", func() {
+		Context("when the organization is created successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-guid"
+					},
+					"entity": {
+						"name": "some-org",
+						"quota_definition_guid": "some-quota-guid"
+					}
+				}`
+				requestBody := map[string]interface{}{
+					"name":                  "some-org",
+					"quota_definition_guid": "some-quota-guid",
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created organization and warnings", func() {
+				org, warnings, err := client.CreateOrganization("some-org", "some-quota-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				Expect(org).To(Equal(Organization{
+					GUID:                "some-org-guid",
+					Name:                "some-org",
+					QuotaDefinitionGUID: "some-quota-guid",
+				}))
+			})
+		})
+
+		Context("when no quota is provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						VerifyJSONRepresenting(map[string]interface{}{"name": "some-org"}),
+						RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-org-guid"}, "entity": {"name": "some-org"}}`),
+					),
+				)
+			})
+
+			It("leaves the quota to the Cloud Controller", func() {
+				org, _, err := client.CreateOrganization("some-org", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(org.GUID).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the name is already taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30002,
+					"description": "The organization name is taken: some-org",
+					"error_code": "CF-OrganizationNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an OrganizationNameTakenError and warnings", func() {
+				_, warnings, err := client.CreateOrganization("some-org", "")
+				Expect(err).To(MatchError(ccerror.OrganizationNameTakenError{Message: "The organization name is taken: some-org"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetOrganization", func() {
 		Context("when the organization exists", func() {
 			BeforeEach(func() {
@@ -212,4 +291,197 @@ var _ = Describe("Organization", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationsByPage", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/organizations?order-by=name&q=some-query:some-value&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "org-guid-1"
+							},
+							"entity": {
+								"name": "org-1",
+								"quota_definition_guid": "some-quota-guid"
+							}
+						},
+						{
+							"metadata": {
+								"guid": "org-guid-2"
+							},
+							"entity": {
+								"name": "org-2"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "org-guid-3"
+							},
+							"entity": {
+								"name": "org-3"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations", "order-by=name&q=some-query:some-value"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations", "order-by=name&q=some-query:some-value&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					))
+			})
+
+			It("calls the handler once per page and returns all warnings", func() {
+				var pages [][]string
+				warnings, err := client.GetOrganizationsByPage([]Query{{
+					Filter:   "some-query",
+					Operator: EqualOperator,
+					Value:    "some-value",
+				}}, func(page []Organization) error {
+					var names []string
+					for _, item := range page {
+						names = append(names, item.Name)
+					}
+					pages = append(pages, names)
+					return nil
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pages).To(Equal([][]string{
+					{"org-1", "org-2"},
+					{"org-3"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the handler returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": "/v2/organizations?order-by=name&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "org-guid-1"
+							},
+							"entity": {
+								"name": "org-1"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations", "order-by=name"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("stops paginating and returns the error and all warnings", func() {
+				expectedErr := errors.New("some handler error")
+				warnings, err := client.GetOrganizationsByPage(nil, func([]Organization) error {
+					return expectedErr
+				})
+
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				warnings, err := client.GetOrganizationsByPage(nil, func([]Organization) error {
+					return nil
+				})
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationName", func() {
+		Context("when the organization is renamed successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-guid"
+					},
+					"entity": {
+						"name": "new-org-name"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+						VerifyJSONRepresenting(map[string]interface{}{"name": "new-org-name"}),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the renamed organization and warnings", func() {
+				org, warnings, err := client.UpdateOrganizationName("some-org-guid", "new-org-name")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				Expect(org).To(Equal(Organization{
+					GUID: "some-org-guid",
+					Name: "new-org-name",
+				}))
+			})
+		})
+
+		Context("when the name is already taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30002,
+					"description": "The organization name is taken: new-org-name",
+					"error_code": "CF-OrganizationNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an OrganizationNameTakenError and warnings", func() {
+				_, warnings, err := client.UpdateOrganizationName("some-org-guid", "new-org-name")
+				Expect(err).To(MatchError(ccerror.OrganizationNameTakenError{Message: "The organization name is taken: new-org-name"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
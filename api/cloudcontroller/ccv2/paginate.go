@@ -7,6 +7,21 @@ import (
 )
 
 func (client Client) paginate(request *http.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	return client.paginateByPage(request, obj, func(list []interface{}) error {
+		for _, item := range list {
+			err := appendToExternalList(item)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// paginateByPage calls handlePage with the resources of each page as soon as
+// the page is retrieved, allowing callers to process results before all pages
+// have been requested.
+func (client Client) paginateByPage(request *http.Request, obj interface{}, handlePage func([]interface{}) error) (Warnings, error) {
 	fullWarningsList := Warnings{}
 
 	for {
@@ -26,11 +41,9 @@ func (client Client) paginate(request *http.Request, obj interface{}, appendToEx
 			return fullWarningsList, err
 		}
 
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
-			}
+		err = handlePage(list)
+		if err != nil {
+			return fullWarningsList, err
 		}
 
 		if wrapper.NextURL == "" {
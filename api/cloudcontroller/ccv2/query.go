@@ -12,6 +12,8 @@ type QueryFilter string
 type QueryOperator string
 
 const (
+	// ActeeFilter is the name of the 'actee' filter.
+	ActeeFilter QueryFilter = "actee"
 	// AppGUIDFilter is the name of the 'app_guid' filter.
 	AppGUIDFilter QueryFilter = "app_guid"
 	// DomainGUIDFilter is the name of the 'domain_guid' filter.
@@ -20,6 +22,8 @@ const (
 	OrganizationGUIDFilter QueryFilter = "organization_guid"
 	// RouteGUIDFilter is the name of the 'route_guid' filter.
 	RouteGUIDFilter QueryFilter = "route_guid"
+	// ServiceGUIDFilter is the name of the 'service_guid' filter.
+	ServiceGUIDFilter QueryFilter = "service_guid"
 	// ServiceInstanceGUIDFilter is the name of the 'service_instance_guid' filter.
 	ServiceInstanceGUIDFilter QueryFilter = "service_instance_guid"
 	// SpaceGUIDFilter is the name of the 'space_guid' filter.
@@ -29,11 +33,24 @@ const (
 	NameFilter QueryFilter = "name"
 	// HostFilter is the name of the 'host' filter.
 	HostFilter QueryFilter = "host"
+	// LabelFilter is the name of the 'label' filter.
+	LabelFilter QueryFilter = "label"
+	// ProviderFilter is the name of the 'provider' filter.
+	ProviderFilter QueryFilter = "provider"
+	// TypeFilter is the name of the 'type' filter.
+	TypeFilter QueryFilter = "type"
+	// TimestampFilter is the name of the 'timestamp' filter.
+	TimestampFilter QueryFilter = "timestamp"
 )
 
 const (
 	// EqualOperator is the query equal operator.
 	EqualOperator QueryOperator = ":"
+	// GreaterThanOrEqualOperator is the query greater than or equal operator.
+	GreaterThanOrEqualOperator QueryOperator = ">="
+	// InOperator is the query in operator. Its value is a comma separated
+	// list.
+	InOperator QueryOperator = " IN "
 )
 
 // Query is a type of filter that can be passed to specific request to narrow
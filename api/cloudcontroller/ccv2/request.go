@@ -55,6 +55,7 @@ func (client Client) newHTTPRequest(passedRequest requestOptions) (*http.Request
 		return nil, err
 	}
 
+	request = request.WithContext(client.ctx)
 	request.Header = http.Header{}
 	request.Header.Set("Accept", "application/json")
 	request.Header.Set("Content-Type", "application/json")
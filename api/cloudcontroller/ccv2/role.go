@@ -0,0 +1,90 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// OrganizationRole is a role a user can be given in an Organization.
+type OrganizationRole string
+
+const (
+	OrgUserRole        OrganizationRole = "users"
+	OrgManagerRole     OrganizationRole = "managers"
+	BillingManagerRole OrganizationRole = "billing_managers"
+	OrgAuditorRole     OrganizationRole = "auditors"
+)
+
+// SpaceRole is a role a user can be given in a Space.
+type SpaceRole string
+
+const (
+	SpaceDeveloperRole SpaceRole = "developers"
+	SpaceManagerRole   SpaceRole = "managers"
+	SpaceAuditorRole   SpaceRole = "auditors"
+)
+
+var organizationRoleRequests = map[OrganizationRole]string{
+	OrgUserRole:        internal.PutOrganizationUsersRequest,
+	OrgManagerRole:     internal.PutOrganizationManagersRequest,
+	BillingManagerRole: internal.PutOrganizationBillingManagersRequest,
+	OrgAuditorRole:     internal.PutOrganizationAuditorsRequest,
+}
+
+var spaceRoleRequests = map[SpaceRole]string{
+	SpaceDeveloperRole: internal.PutSpaceDevelopersRequest,
+	SpaceManagerRole:   internal.PutSpaceManagersRequest,
+	SpaceAuditorRole:   internal.PutSpaceAuditorsRequest,
+}
+
+// UpdateOrganizationUserRoleByUsername gives the user with the provided
+// username the provided role in the Organization associated with the provided
+// GUID.
+func (client *Client) UpdateOrganizationUserRoleByUsername(role OrganizationRole, orgGUID string, username string) (Warnings, error) {
+	requestName, ok := organizationRoleRequests[role]
+	if !ok {
+		return nil, fmt.Errorf("unknown organization role '%s'", role)
+	}
+
+	return client.updateRoleByUsername(requestName, Params{"organization_guid": orgGUID}, username)
+}
+
+// UpdateSpaceUserRoleByUsername gives the user with the provided username the
+// provided role in the Space associated with the provided GUID. The user must
+// already be a user of the Space's Organization.
+func (client *Client) UpdateSpaceUserRoleByUsername(role SpaceRole, spaceGUID string, username string) (Warnings, error) {
+	requestName, ok := spaceRoleRequests[role]
+	if !ok {
+		return nil, fmt.Errorf("unknown space role '%s'", role)
+	}
+
+	return client.updateRoleByUsername(requestName, Params{"space_guid": spaceGUID}, username)
+}
+
+func (client *Client) updateRoleByUsername(requestName string, uriParams Params, username string) (Warnings, error) {
+	body, err := json.Marshal(struct {
+		Username string `json:"username"`
+	}{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -0,0 +1,99 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Role", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("UpdateOrganizationUserRoleByUsername", func() {
+		DescribeTable("gives the user the role in the organization",
+			func(role OrganizationRole, path string) {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, path),
+						VerifyJSONRepresenting(map[string]interface{}{"username": "some-user"}),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				warnings, err := client.UpdateOrganizationUserRoleByUsername(role, "some-org-guid", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			},
+			Entry("org user", OrgUserRole, "/v2/organizations/some-org-guid/users"),
+			Entry("org manager", OrgManagerRole, "/v2/organizations/some-org-guid/managers"),
+			Entry("billing manager", BillingManagerRole, "/v2/organizations/some-org-guid/billing_managers"),
+			Entry("org auditor", OrgAuditorRole, "/v2/organizations/some-org-guid/auditors"),
+		)
+
+		Context("when the user does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 20003,
+					"description": "The user could not be found: some-user",
+					"error_code": "CF-UserNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/managers"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UpdateOrganizationUserRoleByUsername(OrgManagerRole, "some-org-guid", "some-user")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The user could not be found: some-user"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the role is unknown", func() {
+			It("returns an error", func() {
+				_, err := client.UpdateOrganizationUserRoleByUsername(OrganizationRole("bananas"), "some-org-guid", "some-user")
+				Expect(err).To(MatchError("unknown organization role 'bananas'"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceUserRoleByUsername", func() {
+		DescribeTable("gives the user the role in the space",
+			func(role SpaceRole, path string) {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, path),
+						VerifyJSONRepresenting(map[string]interface{}{"username": "some-user"}),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				warnings, err := client.UpdateSpaceUserRoleByUsername(role, "some-space-guid", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			},
+			Entry("space developer", SpaceDeveloperRole, "/v2/spaces/some-space-guid/developers"),
+			Entry("space manager", SpaceManagerRole, "/v2/spaces/some-space-guid/managers"),
+			Entry("space auditor", SpaceAuditorRole, "/v2/spaces/some-space-guid/auditors"),
+		)
+
+		Context("when the role is unknown", func() {
+			It("returns an error", func() {
+				_, err := client.UpdateSpaceUserRoleByUsername(SpaceRole("bananas"), "some-space-guid", "some-user")
+				Expect(err).To(MatchError("unknown space role 'bananas'"))
+			})
+		})
+	})
+})
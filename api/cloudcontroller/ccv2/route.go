@@ -69,6 +69,26 @@ func (client *Client) BindRouteToApplication(routeGUID string, appGUID string) (
 	return route, response.Warnings, err
 }
 
+// UnbindRouteFromApplication removes the binding between the given route and
+// the given application.
+func (client *Client) UnbindRouteFromApplication(routeGUID string, appGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRouteAppRequest,
+		URIParams: map[string]string{
+			"app_guid":   appGUID,
+			"route_guid": routeGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
 // CreateRoute creates the route with the given properties; SpaceGUID and
 // DomainGUID are required. Set generatePort true to generate a random port on
 // the cloud controller. generatePort takes precedence over manually specified
@@ -185,19 +205,50 @@ func (client *Client) GetRoutes(queryParams []Query) ([]Route, Warnings, error)
 	return fullRoutesList, warnings, err
 }
 
-// DeleteRoute deletes the Route associated with the provided Route GUID.
-func (client *Client) DeleteRoute(routeGUID string) (Warnings, error) {
+// GetRouteCount returns the number of Routes matching the provided queries.
+// Only the first page, with a single route, is requested.
+func (client *Client) GetRouteCount(queryParams []Query) (int, Warnings, error) {
+	query := FormatQueryParameters(queryParams)
+	query.Set("results-per-page", "1")
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRoutesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	wrapper := NewPaginatedResources(Route{})
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+	err = client.connection.Make(request, &response)
+	return wrapper.TotalResults, response.Warnings, err
+}
+
+// DeleteRoute deletes the Route associated with the provided Route GUID. It
+// will return the Cloud Controller job that is assigned to the route
+// deletion.
+func (client *Client) DeleteRoute(routeGUID string) (Job, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.DeleteRouteRequest,
 		URIParams:   map[string]string{"route_guid": routeGUID},
+		Query: url.Values{
+			"async": {"true"},
+		},
 	})
 	if err != nil {
-		return nil, err
+		return Job{}, nil, err
+	}
+
+	var job Job
+	response := cloudcontroller.Response{
+		Result: &job,
 	}
 
-	var response cloudcontroller.Response
 	err = client.connection.Make(request, &response)
-	return response.Warnings, err
+	return job, response.Warnings, err
 }
 
 // CheckRoute returns true if the route exists in the CF instance. DomainGUID
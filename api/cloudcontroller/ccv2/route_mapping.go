@@ -0,0 +1,117 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// RouteMapping represents a Cloud Controller Route Mapping, which sends the
+// traffic of a route to one of the ports of an application.
+type RouteMapping struct {
+	GUID      string
+	AppGUID   string
+	AppPort   int
+	RouteGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Route Mapping response.
+func (routeMapping *RouteMapping) UnmarshalJSON(data []byte) error {
+	var ccRouteMapping struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			AppGUID   string `json:"app_guid"`
+			AppPort   int    `json:"app_port"`
+			RouteGUID string `json:"route_guid"`
+		} `json:"entity"`
+	}
+	err := json.Unmarshal(data, &ccRouteMapping)
+	if err != nil {
+		return err
+	}
+
+	routeMapping.GUID = ccRouteMapping.Metadata.GUID
+	routeMapping.AppGUID = ccRouteMapping.Entity.AppGUID
+	routeMapping.AppPort = ccRouteMapping.Entity.AppPort
+	routeMapping.RouteGUID = ccRouteMapping.Entity.RouteGUID
+	return nil
+}
+
+// CreateRouteMapping maps the provided route to the provided port of the
+// application. An appPort of 0 uses the application's first port.
+func (client *Client) CreateRouteMapping(appGUID string, routeGUID string, appPort int) (RouteMapping, Warnings, error) {
+	body, err := json.Marshal(struct {
+		AppGUID   string `json:"app_guid"`
+		AppPort   int    `json:"app_port,omitempty"`
+		RouteGUID string `json:"route_guid"`
+	}{
+		AppGUID:   appGUID,
+		AppPort:   appPort,
+		RouteGUID: routeGUID,
+	})
+	if err != nil {
+		return RouteMapping{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostRouteMappingRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return RouteMapping{}, nil, err
+	}
+
+	var routeMapping RouteMapping
+	response := cloudcontroller.Response{
+		Result: &routeMapping,
+	}
+
+	err = client.connection.Make(request, &response)
+	return routeMapping, response.Warnings, err
+}
+
+// GetRouteMappings returns back all the Route Mappings matching the provided
+// queries.
+func (client *Client) GetRouteMappings(queries []Query) ([]RouteMapping, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRouteMappingsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRouteMappingsList []RouteMapping
+	warnings, err := client.paginate(request, RouteMapping{}, func(item interface{}) error {
+		if routeMapping, ok := item.(RouteMapping); ok {
+			fullRouteMappingsList = append(fullRouteMappingsList, routeMapping)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   RouteMapping{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRouteMappingsList, warnings, err
+}
+
+// DeleteRouteMapping removes the Route Mapping with the provided GUID. The
+// route and the application are not deleted.
+func (client *Client) DeleteRouteMapping(routeMappingGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRouteMappingRequest,
+		URIParams:   map[string]string{"route_mapping_guid": routeMappingGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -0,0 +1,249 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Route Mapping", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateRouteMapping", func() {
+		Context("when an app port is provided", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-route-mapping-guid"
+					},
+					"entity": {
+						"app_guid": "some-app-guid",
+						"app_port": 9090,
+						"route_guid": "some-route-guid"
+					}
+				}`
+				requestBody := map[string]interface{}{
+					"app_guid":   "some-app-guid",
+					"app_port":   9090,
+					"route_guid": "some-route-guid",
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/route_mappings"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("maps the route to the app port", func() {
+				routeMapping, warnings, err := client.CreateRouteMapping("some-app-guid", "some-route-guid", 9090)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routeMapping).To(Equal(RouteMapping{
+					GUID:      "some-route-mapping-guid",
+					AppGUID:   "some-app-guid",
+					AppPort:   9090,
+					RouteGUID: "some-route-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when no app port is provided", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-route-mapping-guid"
+					},
+					"entity": {
+						"app_guid": "some-app-guid",
+						"app_port": 8080,
+						"route_guid": "some-route-guid"
+					}
+				}`
+				requestBody := map[string]interface{}{
+					"app_guid":   "some-app-guid",
+					"route_guid": "some-route-guid",
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/route_mappings"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, nil),
+					),
+				)
+			})
+
+			It("does not send the app port", func() {
+				routeMapping, _, err := client.CreateRouteMapping("some-app-guid", "some-route-guid", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routeMapping.AppPort).To(Equal(8080))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 1002,
+					"description": "The requested app relation is invalid: the app and route must belong to the same space",
+					"error_code": "CF-InvalidRelation"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/route_mappings"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateRouteMapping("some-app-guid", "some-route-guid", 9090)
+				Expect(err).To(MatchError(ccerror.InvalidRelationError{
+					Message: "The requested app relation is invalid: the app and route must belong to the same space",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetRouteMappings", func() {
+		Context("when the cloud controller returns route mappings", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/route_mappings?q=route_guid+IN+route-guid-1,route-guid-2&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "route-mapping-guid-1"
+							},
+							"entity": {
+								"app_port": 8080,
+								"app_guid": "app-guid-1",
+								"route_guid": "route-guid-1"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "route-mapping-guid-2"
+							},
+							"entity": {
+								"app_port": 9090,
+								"app_guid": "app-guid-2",
+								"route_guid": "route-guid-2"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/route_mappings", "q=route_guid+IN+route-guid-1,route-guid-2"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/route_mappings", "q=route_guid+IN+route-guid-1,route-guid-2&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns all the route mappings and all warnings", func() {
+				routeMappings, warnings, err := client.GetRouteMappings([]Query{{
+					Filter:   RouteGUIDFilter,
+					Operator: InOperator,
+					Value:    "route-guid-1,route-guid-2",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routeMappings).To(Equal([]RouteMapping{
+					{GUID: "route-mapping-guid-1", AppGUID: "app-guid-1", AppPort: 8080, RouteGUID: "route-guid-1"},
+					{GUID: "route-mapping-guid-2", AppGUID: "app-guid-2", AppPort: 9090, RouteGUID: "route-guid-2"},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/route_mappings"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetRouteMappings(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("DeleteRouteMapping", func() {
+		Context("when the route mapping exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/route_mappings/some-route-mapping-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the route mapping and returns all warnings", func() {
+				warnings, err := client.DeleteRouteMapping("some-route-mapping-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the route mapping does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 210007,
+					"description": "The route mapping could not be found: some-route-mapping-guid",
+					"error_code": "CF-RouteMappingNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/route_mappings/some-route-mapping-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a not found error and all warnings", func() {
+				warnings, err := client.DeleteRouteMapping("some-route-mapping-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The route mapping could not be found: some-route-mapping-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
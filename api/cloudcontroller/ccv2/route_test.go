@@ -87,6 +87,54 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("UnbindRouteFromApplication", func() {
+		Context("when the route is unbound successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid/apps/some-app-guid"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the warnings", func() {
+				warnings, err := client.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cc returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid/apps/some-app-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				warnings, err := client.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("CreateRoute", func() {
 		Context("when route creation is successful", func() {
 			Context("when generate route is true", func() {
@@ -267,6 +315,75 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("GetRouteCount", func() {
+		Context("when the cc returns a page of routes", func() {
+			BeforeEach(func() {
+				response := `{
+					"total_results": 42,
+					"next_url": "/v2/routes?q=domain_guid:some-domain-guid&results-per-page=1&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "route-guid-1"
+							},
+							"entity": {
+								"host": "host-1",
+								"domain_guid": "some-domain-guid"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/routes", "q=domain_guid:some-domain-guid&results-per-page=1"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the total number of routes without paging through them", func() {
+				count, warnings, err := client.GetRouteCount([]Query{{
+					Filter:   DomainGUIDFilter,
+					Operator: EqualOperator,
+					Value:    "some-domain-guid",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(42))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when the cc returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/routes"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetRouteCount(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("GetRoutes", func() {
 		Context("when there are routes", func() {
 			BeforeEach(func() {
@@ -763,17 +880,28 @@ var _ = Describe("Route", func() {
 	Describe("DeleteRoute", func() {
 		Context("when the route exists", func() {
 			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "job-guid",
+						"url": "/v2/jobs/job-guid"
+					},
+					"entity": {
+						"guid": "job-guid",
+						"status": "queued"
+					}
+				}`
 				server.AppendHandlers(
 					CombineHandlers(
-						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid"),
-						RespondWith(http.StatusNoContent, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid", "async=true"),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
 					),
 				)
 			})
 
-			It("deletes the route and returns all warnings", func() {
-				warnings, err := client.DeleteRoute("some-route-guid")
+			It("deletes the route asynchronously and returns the job and all warnings", func() {
+				job, warnings, err := client.DeleteRoute("some-route-guid")
 				Expect(err).NotTo(HaveOccurred())
+				Expect(job).To(Equal(Job{GUID: "job-guid", Status: JobStatusQueued}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 			})
 		})
@@ -787,14 +915,14 @@ var _ = Describe("Route", func() {
 			}`
 				server.AppendHandlers(
 					CombineHandlers(
-						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid"),
+						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid", "async=true"),
 						RespondWith(http.StatusNotFound, response),
 					),
 				)
 			})
 
 			It("returns an error", func() {
-				_, err := client.DeleteRoute("some-route-guid")
+				_, _, err := client.DeleteRoute("some-route-guid")
 				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
 					Message: "The route could not be found: some-route-guid",
 				}))
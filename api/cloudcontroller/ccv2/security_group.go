@@ -1,24 +1,31 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type SecurityGroupRule struct {
+	Code        types.NullInt
 	Description string
 	Destination string
+	Log         bool
 	Ports       string
 	Protocol    string
+	Type        types.NullInt
 }
 
 type SecurityGroup struct {
-	GUID  string
-	Name  string
-	Rules []SecurityGroupRule
+	GUID           string
+	Name           string
+	Rules          []SecurityGroupRule
+	RunningDefault bool
+	StagingDefault bool
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Security Group response
@@ -26,14 +33,11 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 	var ccSecurityGroup struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			GUID  string `json:"guid"`
-			Name  string `json:"name"`
-			Rules []struct {
-				Description string `json:"description"`
-				Destination string `json:"destination"`
-				Ports       string `json:"ports"`
-				Protocol    string `json:"protocol"`
-			} `json:"rules"`
+			GUID           string                `json:"guid"`
+			Name           string                `json:"name"`
+			Rules          []ccSecurityGroupRule `json:"rules"`
+			RunningDefault bool                  `json:"running_default"`
+			StagingDefault bool                  `json:"staging_default"`
 		} `json:"entity"`
 	}
 
@@ -43,16 +47,59 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 
 	securityGroup.GUID = ccSecurityGroup.Metadata.GUID
 	securityGroup.Name = ccSecurityGroup.Entity.Name
+	securityGroup.RunningDefault = ccSecurityGroup.Entity.RunningDefault
+	securityGroup.StagingDefault = ccSecurityGroup.Entity.StagingDefault
 	securityGroup.Rules = make([]SecurityGroupRule, len(ccSecurityGroup.Entity.Rules))
 	for i, ccRule := range ccSecurityGroup.Entity.Rules {
 		securityGroup.Rules[i].Description = ccRule.Description
 		securityGroup.Rules[i].Destination = ccRule.Destination
+		securityGroup.Rules[i].Log = ccRule.Log
 		securityGroup.Rules[i].Ports = ccRule.Ports
 		securityGroup.Rules[i].Protocol = ccRule.Protocol
+		if ccRule.Code != nil {
+			securityGroup.Rules[i].Code = types.NullInt{IsSet: true, Value: *ccRule.Code}
+		}
+		if ccRule.Type != nil {
+			securityGroup.Rules[i].Type = types.NullInt{IsSet: true, Value: *ccRule.Type}
+		}
 	}
 	return nil
 }
 
+// ccSecurityGroupRule is the representation of a rule in Cloud Controller
+// requests and responses.
+type ccSecurityGroupRule struct {
+	Code        *int   `json:"code,omitempty"`
+	Description string `json:"description,omitempty"`
+	Destination string `json:"destination"`
+	Log         bool   `json:"log,omitempty"`
+	Ports       string `json:"ports,omitempty"`
+	Protocol    string `json:"protocol"`
+	Type        *int   `json:"type,omitempty"`
+}
+
+func newCCSecurityGroupRules(rules []SecurityGroupRule) []ccSecurityGroupRule {
+	ccRules := make([]ccSecurityGroupRule, len(rules))
+	for i, rule := range rules {
+		ccRules[i] = ccSecurityGroupRule{
+			Description: rule.Description,
+			Destination: rule.Destination,
+			Log:         rule.Log,
+			Ports:       rule.Ports,
+			Protocol:    rule.Protocol,
+		}
+		if rule.Code.IsSet {
+			code := rule.Code.Value
+			ccRules[i].Code = &code
+		}
+		if rule.Type.IsSet {
+			ruleType := rule.Type.Value
+			ccRules[i].Type = &ruleType
+		}
+	}
+	return ccRules
+}
+
 func (client *Client) AssociateSpaceWithSecurityGroup(securityGroupGUID string, spaceGUID string) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.PutSecurityGroupSpaceRequest,
@@ -72,6 +119,37 @@ func (client *Client) AssociateSpaceWithSecurityGroup(securityGroupGUID string,
 	return response.Warnings, err
 }
 
+// CreateSecurityGroup creates a security group with the provided name and
+// rules.
+func (client *Client) CreateSecurityGroup(name string, rules []SecurityGroupRule) (SecurityGroup, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name  string                `json:"name"`
+		Rules []ccSecurityGroupRule `json:"rules"`
+	}{
+		Name:  name,
+		Rules: newCCSecurityGroupRules(rules),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSecurityGroupRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	var securityGroup SecurityGroup
+	response := cloudcontroller.Response{
+		Result: &securityGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return securityGroup, response.Warnings, err
+}
+
 func (client *Client) GetSecurityGroups(queries []Query) ([]SecurityGroup, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetSecurityGroupsRequest,
@@ -98,6 +176,43 @@ func (client *Client) GetSecurityGroups(queries []Query) ([]SecurityGroup, Warni
 	return securityGroupsList, warnings, err
 }
 
+// GetSecurityGroupSpaces returns the spaces whose running apps the security
+// group with the provided GUID applies to.
+func (client *Client) GetSecurityGroupSpaces(securityGroupGUID string) ([]Space, Warnings, error) {
+	return client.getSecurityGroupSpacesByLifecycle(securityGroupGUID, internal.GetSecurityGroupSpacesRequest)
+}
+
+// GetSecurityGroupStagingSpaces returns the spaces whose staging apps the
+// security group with the provided GUID applies to.
+func (client *Client) GetSecurityGroupStagingSpaces(securityGroupGUID string) ([]Space, Warnings, error) {
+	return client.getSecurityGroupSpacesByLifecycle(securityGroupGUID, internal.GetSecurityGroupStagingSpacesRequest)
+}
+
+func (client *Client) getSecurityGroupSpacesByLifecycle(securityGroupGUID string, lifecycle string) ([]Space, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: lifecycle,
+		URIParams:   map[string]string{"security_group_guid": securityGroupGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var spacesList []Space
+	warnings, err := client.paginate(request, Space{}, func(item interface{}) error {
+		if space, ok := item.(Space); ok {
+			spacesList = append(spacesList, space)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Space{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return spacesList, warnings, err
+}
+
 // GetSpaceRunningSecurityGroupsBySpace returns the running Security Groups
 // associated with the provided Space GUID.
 func (client *Client) GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]SecurityGroup, Warnings, error) {
@@ -135,6 +250,36 @@ func (client *Client) getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID string
 	return securityGroupsList, warnings, err
 }
 
+// UpdateSecurityGroupRules replaces the rules of the security group with the
+// provided GUID.
+func (client *Client) UpdateSecurityGroupRules(securityGroupGUID string, rules []SecurityGroupRule) (SecurityGroup, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Rules []ccSecurityGroupRule `json:"rules"`
+	}{
+		Rules: newCCSecurityGroupRules(rules),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSecurityGroupRequest,
+		URIParams:   Params{"security_group_guid": securityGroupGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	var securityGroup SecurityGroup
+	response := cloudcontroller.Response{
+		Result: &securityGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return securityGroup, response.Warnings, err
+}
+
 // RemoveSpaceFromSecurityGroup disassociates a security group, specified by
 // its GUID, from a space, which is also specified by its GUID.
 func (client *Client) RemoveSpaceFromSecurityGroup(securityGroupGUID string, spaceGUID string) (Warnings, error) {
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -66,6 +67,96 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("CreateSecurityGroup", func() {
+		var (
+			securityGroup SecurityGroup
+			warnings      Warnings
+			executeErr    error
+		)
+
+		JustBeforeEach(func() {
+			securityGroup, warnings, executeErr = client.CreateSecurityGroup("some-security-group", []SecurityGroupRule{
+				{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80,443", Description: "web", Log: true},
+				{
+					Protocol:    "icmp",
+					Destination: "0.0.0.0/0",
+					Type:        types.NullInt{IsSet: true, Value: 0},
+					Code:        types.NullInt{IsSet: true, Value: -1},
+				},
+			})
+		})
+
+		Context("when the security group is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-security-group-guid"
+					},
+					"entity": {
+						"name": "some-security-group",
+						"rules": [
+							{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "80,443", "description": "web", "log": true},
+							{"protocol": "icmp", "destination": "0.0.0.0/0", "type": 0, "code": -1}
+						]
+					}
+				}`
+				requestBody := map[string]interface{}{
+					"name": "some-security-group",
+					"rules": []map[string]interface{}{
+						{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "80,443", "description": "web", "log": true},
+						{"protocol": "icmp", "destination": "0.0.0.0/0", "type": 0, "code": -1},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the security group and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID: "some-security-group-guid",
+					Name: "some-security-group",
+					Rules: []SecurityGroupRule{
+						{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80,443", Description: "web", Log: true},
+						{
+							Protocol:    "icmp",
+							Destination: "0.0.0.0/0",
+							Type:        types.NullInt{IsSet: true, Value: 0},
+							Code:        types.NullInt{IsSet: true, Value: -1},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 300005,
+					"description": "The security group name is taken: some-security-group",
+					"error_code": "CF-SecurityGroupNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns a SecurityGroupNameTakenError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.SecurityGroupNameTakenError{
+					Message: "The security group name is taken: some-security-group",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetSecurityGroups", func() {
 		Context("when no errors are encountered", func() {
 			Context("when results are paginated", func() {
@@ -175,6 +266,122 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("GetSecurityGroupSpaces", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/security_groups/security-group-guid/spaces?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "space-guid-1"
+							},
+							"entity": {
+								"name": "space-1",
+								"organization_guid": "org-guid-1"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "space-guid-2"
+							},
+							"entity": {
+								"name": "space-2",
+								"organization_guid": "org-guid-2"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/security_groups/security-group-guid/spaces"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/security_groups/security-group-guid/spaces", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					))
+			})
+
+			It("returns the spaces and all warnings", func() {
+				spaces, warnings, err := client.GetSecurityGroupSpaces("security-group-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(spaces).To(Equal([]Space{
+					{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+					{GUID: "space-guid-2", Name: "space-2", OrganizationGUID: "org-guid-2"},
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/security_groups/security-group-guid/spaces"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.GetSecurityGroupSpaces("security-group-guid")
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetSecurityGroupStagingSpaces", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "space-guid-1"
+						},
+						"entity": {
+							"name": "space-1",
+							"organization_guid": "org-guid-1"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/security_groups/security-group-guid/staging_spaces"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				))
+		})
+
+		It("returns the staging spaces and all warnings", func() {
+			spaces, warnings, err := client.GetSecurityGroupStagingSpaces("security-group-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(spaces).To(Equal([]Space{
+				{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+			}))
+		})
+	})
+
 	Describe("GetSpaceRunningSecurityGroupsBySpace", func() {
 		Context("when the space exists", func() {
 			BeforeEach(func() {
@@ -545,6 +752,46 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("UpdateSecurityGroupRules", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-security-group-guid"
+					},
+					"entity": {
+						"name": "some-security-group",
+						"rules": [
+							{"protocol": "all", "destination": "10.0.0.1-10.0.0.9"}
+						]
+					}
+				}`
+				requestBody := map[string]interface{}{
+					"rules": []map[string]interface{}{
+						{"protocol": "all", "destination": "10.0.0.1-10.0.0.9"},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/security_groups/some-security-group-guid"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("replaces the rules and returns all warnings", func() {
+				securityGroup, warnings, err := client.UpdateSecurityGroupRules("some-security-group-guid", []SecurityGroupRule{
+					{Protocol: "all", Destination: "10.0.0.1-10.0.0.9"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(securityGroup.Rules).To(Equal([]SecurityGroupRule{
+					{Protocol: "all", Destination: "10.0.0.1-10.0.0.9"},
+				}))
+			})
+		})
+	})
+
 	Describe("RemoveSpaceFromSecurityGroup", func() {
 		var (
 			warnings Warnings
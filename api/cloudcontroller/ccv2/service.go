@@ -0,0 +1,142 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Service represents a Cloud Controller Service (also known as a service
+// offering).
+type Service struct {
+	GUID              string
+	Label             string
+	Provider          string
+	Description       string
+	ServiceBrokerGUID string
+
+	// Extra is the raw JSON metadata provided by the service broker catalog.
+	Extra string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service response.
+func (service *Service) UnmarshalJSON(data []byte) error {
+	var ccService struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Label             string `json:"label"`
+			Provider          string `json:"provider"`
+			Description       string `json:"description"`
+			ServiceBrokerGUID string `json:"service_broker_guid"`
+			Extra             string `json:"extra"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccService); err != nil {
+		return err
+	}
+
+	service.GUID = ccService.Metadata.GUID
+	service.Label = ccService.Entity.Label
+	service.Provider = ccService.Entity.Provider
+	service.Description = ccService.Entity.Description
+	service.ServiceBrokerGUID = ccService.Entity.ServiceBrokerGUID
+	service.Extra = ccService.Entity.Extra
+	return nil
+}
+
+// GetService returns the Service associated with the provided GUID.
+func (client *Client) GetService(serviceGUID string) (Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceRequest,
+		URIParams:   Params{"service_guid": serviceGUID},
+	})
+	if err != nil {
+		return Service{}, nil, err
+	}
+
+	var service Service
+	response := cloudcontroller.Response{
+		Result: &service,
+	}
+
+	err = client.connection.Make(request, &response)
+	return service, response.Warnings, err
+}
+
+// GetServices returns back a list of Services based off of the provided
+// queries.
+func (client *Client) GetServices(queries []Query) ([]Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicesRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicesList []Service
+	warnings, err := client.paginate(request, Service{}, func(item interface{}) error {
+		if service, ok := item.(Service); ok {
+			fullServicesList = append(fullServicesList, service)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Service{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicesList, warnings, err
+}
+
+// GetSpaceServices returns back a list of Services that are visible in the
+// provided space, based off of the provided queries.
+func (client *Client) GetSpaceServices(spaceGUID string, queries []Query) ([]Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceServicesRequest,
+		URIParams:   Params{"space_guid": spaceGUID},
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicesList []Service
+	warnings, err := client.paginate(request, Service{}, func(item interface{}) error {
+		if service, ok := item.(Service); ok {
+			fullServicesList = append(fullServicesList, service)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Service{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicesList, warnings, err
+}
+
+// PurgeService removes the Service associated with the provided GUID, along
+// with all of its plans, instances and bindings, from the Cloud Controller
+// database without contacting the service broker.
+func (client *Client) PurgeService(serviceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceRequest,
+		URIParams:   Params{"service_guid": serviceGUID},
+		Query: url.Values{
+			"purge": {"true"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -10,13 +11,21 @@ import (
 
 // ServiceBinding represents a Cloud Controller Service Binding.
 type ServiceBinding struct {
-	GUID string
+	GUID                string
+	Name                string
+	AppGUID             string
+	ServiceInstanceGUID string
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Binding response.
 func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	var ccServiceBinding struct {
 		Metadata internal.Metadata
+		Entity   struct {
+			Name                string `json:"name"`
+			AppGUID             string `json:"app_guid"`
+			ServiceInstanceGUID string `json:"service_instance_guid"`
+		}
 	}
 	err := json.Unmarshal(data, &ccServiceBinding)
 	if err != nil {
@@ -24,9 +33,43 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	}
 
 	serviceBinding.GUID = ccServiceBinding.Metadata.GUID
+	serviceBinding.Name = ccServiceBinding.Entity.Name
+	serviceBinding.AppGUID = ccServiceBinding.Entity.AppGUID
+	serviceBinding.ServiceInstanceGUID = ccServiceBinding.Entity.ServiceInstanceGUID
 	return nil
 }
 
+// CreateServiceBinding binds the provided Service Instance to the provided
+// Application.
+func (client *Client) CreateServiceBinding(appGUID string, serviceInstanceGUID string) (ServiceBinding, Warnings, error) {
+	body, err := json.Marshal(struct {
+		AppGUID             string `json:"app_guid"`
+		ServiceInstanceGUID string `json:"service_instance_guid"`
+	}{
+		AppGUID:             appGUID,
+		ServiceInstanceGUID: serviceInstanceGUID,
+	})
+	if err != nil {
+		return ServiceBinding{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceBindingRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return ServiceBinding{}, nil, err
+	}
+
+	var serviceBinding ServiceBinding
+	response := cloudcontroller.Response{
+		Result: &serviceBinding,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceBinding, response.Warnings, err
+}
+
 // GetServiceBindings returns back a list of Service Bindings based off of the
 // provided queries.
 func (client *Client) GetServiceBindings(queries []Query) ([]ServiceBinding, Warnings, error) {
@@ -17,6 +17,42 @@ var _ = Describe("Service Binding", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CreateServiceBinding", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-binding-guid"
+				},
+				"entity": {
+					"app_guid": "some-app-guid",
+					"service_instance_guid": "some-service-instance-guid"
+				}
+			}`
+			requestBody := map[string]interface{}{
+				"app_guid":              "some-app-guid",
+				"service_instance_guid": "some-service-instance-guid",
+			}
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/service_bindings"),
+					VerifyJSONRepresenting(requestBody),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("binds the service instance to the app", func() {
+			serviceBinding, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceBinding).To(Equal(ServiceBinding{
+				GUID:                "some-service-binding-guid",
+				AppGUID:             "some-app-guid",
+				ServiceInstanceGUID: "some-service-instance-guid",
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
 	Describe("GetServiceBindings", func() {
 		BeforeEach(func() {
 			response1 := `{
@@ -25,6 +61,11 @@ var _ = Describe("Service Binding", func() {
 					{
 						"metadata": {
 							"guid": "service-binding-guid-1"
+						},
+						"entity": {
+							"name": "some-binding-name",
+							"app_guid": "some-app-guid",
+							"service_instance_guid": "some-service-instance-guid"
 						}
 					},
 					{
@@ -72,7 +113,7 @@ var _ = Describe("Service Binding", func() {
 				}})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(serviceBindings).To(ConsistOf([]ServiceBinding{
-					{GUID: "service-binding-guid-1"},
+					{GUID: "service-binding-guid-1", Name: "some-binding-name", AppGUID: "some-app-guid", ServiceInstanceGUID: "some-service-instance-guid"},
 					{GUID: "service-binding-guid-2"},
 					{GUID: "service-binding-guid-3"},
 					{GUID: "service-binding-guid-4"},
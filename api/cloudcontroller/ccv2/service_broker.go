@@ -0,0 +1,64 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServiceBroker represents a Cloud Controller Service Broker.
+type ServiceBroker struct {
+	GUID      string
+	Name      string
+	BrokerURL string
+	SpaceGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Broker response.
+func (serviceBroker *ServiceBroker) UnmarshalJSON(data []byte) error {
+	var ccServiceBroker struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name      string `json:"name"`
+			BrokerURL string `json:"broker_url"`
+			SpaceGUID string `json:"space_guid"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccServiceBroker); err != nil {
+		return err
+	}
+
+	serviceBroker.GUID = ccServiceBroker.Metadata.GUID
+	serviceBroker.Name = ccServiceBroker.Entity.Name
+	serviceBroker.BrokerURL = ccServiceBroker.Entity.BrokerURL
+	serviceBroker.SpaceGUID = ccServiceBroker.Entity.SpaceGUID
+	return nil
+}
+
+// GetServiceBrokers returns back a list of Service Brokers based off of the
+// provided queries.
+func (client *Client) GetServiceBrokers(queries []Query) ([]ServiceBroker, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceBrokersRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBrokersList []ServiceBroker
+	warnings, err := client.paginate(request, ServiceBroker{}, func(item interface{}) error {
+		if broker, ok := item.(ServiceBroker); ok {
+			fullBrokersList = append(fullBrokersList, broker)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceBroker{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBrokersList, warnings, err
+}
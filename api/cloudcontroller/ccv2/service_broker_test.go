@@ -0,0 +1,66 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Broker", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServiceBrokers", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-broker-guid-1"
+						},
+						"entity": {
+							"name": "some-broker-1",
+							"broker_url": "https://broker-1.example.com",
+							"space_guid": null
+						}
+					},
+					{
+						"metadata": {
+							"guid": "some-broker-guid-2"
+						},
+						"entity": {
+							"name": "some-broker-2",
+							"broker_url": "https://broker-2.example.com",
+							"space_guid": "some-space-guid"
+						}
+					}
+				]
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_brokers"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns all the service brokers and all warnings", func() {
+			brokers, warnings, err := client.GetServiceBrokers(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(brokers).To(ConsistOf([]ServiceBroker{
+				{GUID: "some-broker-guid-1", Name: "some-broker-1", BrokerURL: "https://broker-1.example.com"},
+				{GUID: "some-broker-guid-2", Name: "some-broker-2", BrokerURL: "https://broker-2.example.com", SpaceGUID: "some-space-guid"},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+})
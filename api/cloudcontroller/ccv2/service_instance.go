@@ -1,8 +1,11 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
+	"net/url"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
@@ -18,11 +21,29 @@ const (
 	ManagedService ServiceInstanceType = "managed_service_instance"
 )
 
+// LastOperation is the status of the last operation requested on a Service
+// Instance.
+type LastOperation struct {
+	Type        string `json:"type"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
 // ServiceInstance represents a Cloud Controller Service Instance.
 type ServiceInstance struct {
-	GUID string
-	Name string
-	Type ServiceInstanceType
+	GUID            string
+	Name            string
+	Type            ServiceInstanceType
+	ServicePlanGUID string
+	DashboardURL    string
+	Tags            []string
+	LastOperation   LastOperation
+
+	// MaintenanceInfo is the version of the plan the instance was last
+	// provisioned or upgraded to.
+	MaintenanceInfo MaintenanceInfo
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
@@ -30,8 +51,13 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	var ccServiceInstance struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Name string
-			Type string
+			Name            string
+			Type            string
+			ServicePlanGUID string          `json:"service_plan_guid"`
+			DashboardURL    string          `json:"dashboard_url"`
+			Tags            []string        `json:"tags"`
+			LastOperation   LastOperation   `json:"last_operation"`
+			MaintenanceInfo MaintenanceInfo `json:"maintenance_info"`
 		}
 	}
 	err := json.Unmarshal(data, &ccServiceInstance)
@@ -42,6 +68,11 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	serviceInstance.GUID = ccServiceInstance.Metadata.GUID
 	serviceInstance.Name = ccServiceInstance.Entity.Name
 	serviceInstance.Type = ServiceInstanceType(ccServiceInstance.Entity.Type)
+	serviceInstance.ServicePlanGUID = ccServiceInstance.Entity.ServicePlanGUID
+	serviceInstance.DashboardURL = ccServiceInstance.Entity.DashboardURL
+	serviceInstance.Tags = ccServiceInstance.Entity.Tags
+	serviceInstance.LastOperation = ccServiceInstance.Entity.LastOperation
+	serviceInstance.MaintenanceInfo = ccServiceInstance.Entity.MaintenanceInfo
 	return nil
 }
 
@@ -56,6 +87,95 @@ func (serviceInstance ServiceInstance) Managed() bool {
 	return serviceInstance.Type == ManagedService
 }
 
+// CreateServiceInstance creates a managed Service Instance of the provided
+// Service Plan in the provided space. The broker is allowed to provision the
+// instance asynchronously.
+func (client *Client) CreateServiceInstance(spaceGUID string, servicePlanGUID string, name string) (ServiceInstance, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name            string `json:"name"`
+		SpaceGUID       string `json:"space_guid"`
+		ServicePlanGUID string `json:"service_plan_guid"`
+	}{
+		Name:            name,
+		SpaceGUID:       spaceGUID,
+		ServicePlanGUID: servicePlanGUID,
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceInstanceRequest,
+		Body:        bytes.NewBuffer(body),
+		Query: url.Values{
+			"accepts_incomplete": {"true"},
+		},
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var serviceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
+
+// CreateUserProvidedServiceInstance creates a User Provided Service Instance
+// without credentials in the provided space.
+func (client *Client) CreateUserProvidedServiceInstance(spaceGUID string, name string) (ServiceInstance, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name      string `json:"name"`
+		SpaceGUID string `json:"space_guid"`
+	}{
+		Name:      name,
+		SpaceGUID: spaceGUID,
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostUserProvidedServiceInstanceRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var serviceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
+
+// GetServiceInstanceParameters returns the parameters the Service Instance
+// associated with the provided GUID was provisioned or updated with, as
+// reported by its service broker.
+func (client *Client) GetServiceInstanceParameters(serviceInstanceGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceParametersRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parameters map[string]interface{}
+	response := cloudcontroller.Response{
+		Result: &parameters,
+	}
+
+	err = client.connection.Make(request, &response)
+	return parameters, response.Warnings, err
+}
+
 // GetServiceInstances returns back a list of *managed* Service Instances based
 // off of the provided queries.
 func (client *Client) GetServiceInstances(queries []Query) ([]ServiceInstance, Warnings, error) {
@@ -117,3 +237,87 @@ func (client *Client) GetSpaceServiceInstances(spaceGUID string, includeUserProv
 
 	return fullInstancesList, warnings, err
 }
+
+// PurgeServiceInstance removes the Service Instance associated with the
+// provided GUID, along with its bindings and keys, from the Cloud Controller
+// database without contacting the service broker.
+func (client *Client) PurgeServiceInstance(serviceInstanceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Query: url.Values{
+			"purge": {"true"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// UpdateServiceInstanceName renames the managed Service Instance associated
+// with the provided GUID. The broker is allowed to process the update
+// asynchronously.
+func (client *Client) UpdateServiceInstanceName(serviceInstanceGUID string, newName string) (ServiceInstance, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name string `json:"name"`
+	}{
+		Name: newName,
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Body:        bytes.NewBuffer(body),
+		Query: url.Values{
+			"accepts_incomplete": {"true"},
+		},
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var serviceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
+
+// UpdateUserProvidedServiceInstanceName renames the User Provided Service
+// Instance associated with the provided GUID.
+func (client *Client) UpdateUserProvidedServiceInstanceName(serviceInstanceGUID string, newName string) (ServiceInstance, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name string `json:"name"`
+	}{
+		Name: newName,
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutUserProvidedServiceInstanceRequest,
+		URIParams:   Params{"user_provided_service_instance_guid": serviceInstanceGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var serviceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
@@ -0,0 +1,79 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServiceInstanceSharedFrom is the space a Service Instance was shared from.
+type ServiceInstanceSharedFrom struct {
+	SpaceGUID        string `json:"space_guid"`
+	SpaceName        string `json:"space_name"`
+	OrganizationName string `json:"organization_name"`
+}
+
+// ServiceInstanceSharedTo is a space a Service Instance has been shared to.
+type ServiceInstanceSharedTo struct {
+	SpaceGUID        string `json:"space_guid"`
+	SpaceName        string `json:"space_name"`
+	OrganizationName string `json:"organization_name"`
+	BoundAppCount    int    `json:"bound_app_count"`
+}
+
+// GetServiceInstanceSharedFrom returns the space the Service Instance
+// associated with the provided GUID was shared from. An empty
+// ServiceInstanceSharedFrom is returned when the instance has not been shared
+// into the space it is viewed from.
+func (client *Client) GetServiceInstanceSharedFrom(serviceInstanceGUID string) (ServiceInstanceSharedFrom, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceSharedFromRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return ServiceInstanceSharedFrom{}, nil, err
+	}
+
+	// The Cloud Controller responds with 204 No Content when the instance was
+	// not shared, so the body is only decoded when there is one.
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return ServiceInstanceSharedFrom{}, response.Warnings, err
+	}
+
+	var sharedFrom ServiceInstanceSharedFrom
+	if len(response.RawResponse) > 0 {
+		err = json.Unmarshal(response.RawResponse, &sharedFrom)
+	}
+	return sharedFrom, response.Warnings, err
+}
+
+// GetServiceInstanceSharedTos returns the spaces the Service Instance
+// associated with the provided GUID has been shared to.
+func (client *Client) GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ServiceInstanceSharedTo, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceSharedToRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSharedToList []ServiceInstanceSharedTo
+	warnings, err := client.paginate(request, ServiceInstanceSharedTo{}, func(item interface{}) error {
+		if sharedTo, ok := item.(ServiceInstanceSharedTo); ok {
+			fullSharedToList = append(fullSharedToList, sharedTo)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceInstanceSharedTo{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSharedToList, warnings, err
+}
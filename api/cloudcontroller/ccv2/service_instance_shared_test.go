@@ -0,0 +1,148 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Instance Sharing", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServiceInstanceSharedFrom", func() {
+		Context("when the service instance has been shared from another space", func() {
+			BeforeEach(func() {
+				response := `{
+					"space_guid": "some-space-guid",
+					"space_name": "some-space",
+					"organization_name": "some-org"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/shared_from"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the space it was shared from and all warnings", func() {
+				sharedFrom, warnings, err := client.GetServiceInstanceSharedFrom("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sharedFrom).To(Equal(ServiceInstanceSharedFrom{
+					SpaceGUID:        "some-space-guid",
+					SpaceName:        "some-space",
+					OrganizationName: "some-org",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service instance has not been shared", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/shared_from"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an empty ServiceInstanceSharedFrom and all warnings", func() {
+				sharedFrom, warnings, err := client.GetServiceInstanceSharedFrom("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sharedFrom).To(Equal(ServiceInstanceSharedFrom{}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60004,
+					"description": "The service instance could not be found: some-service-instance-guid",
+					"error_code": "CF-ServiceInstanceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/shared_from"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetServiceInstanceSharedFrom("some-service-instance-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The service instance could not be found: some-service-instance-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceSharedTos", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_instances/some-service-instance-guid/shared_to?page=2",
+				"resources": [
+					{
+						"space_guid": "some-space-guid-1",
+						"space_name": "some-space-1",
+						"organization_name": "some-org-1",
+						"bound_app_count": 2
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"space_guid": "some-space-guid-2",
+						"space_name": "some-space-2",
+						"organization_name": "some-org-2",
+						"bound_app_count": 0
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/shared_to"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/shared_to", "page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the spaces the service instance is shared to and all warnings", func() {
+			sharedTos, warnings, err := client.GetServiceInstanceSharedTos("some-service-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sharedTos).To(ConsistOf(
+				ServiceInstanceSharedTo{
+					SpaceGUID:        "some-space-guid-1",
+					SpaceName:        "some-space-1",
+					OrganizationName: "some-org-1",
+					BoundAppCount:    2,
+				},
+				ServiceInstanceSharedTo{
+					SpaceGUID:        "some-space-guid-2",
+					SpaceName:        "some-space-2",
+					OrganizationName: "some-org-2",
+				},
+			))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package ccv2_test
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -50,6 +51,81 @@ var _ = Describe("Service Instance", func() {
 		})
 	})
 
+	Describe("CreateServiceInstance", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-instance-guid"
+				},
+				"entity": {
+					"name": "some-service-instance",
+					"type": "managed_service_instance",
+					"service_plan_guid": "some-service-plan-guid"
+				}
+			}`
+			requestBody := map[string]interface{}{
+				"name":              "some-service-instance",
+				"space_guid":        "some-space-guid",
+				"service_plan_guid": "some-service-plan-guid",
+			}
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/service_instances", "accepts_incomplete=true"),
+					VerifyJSONRepresenting(requestBody),
+					RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("creates the service instance", func() {
+			serviceInstance, warnings, err := client.CreateServiceInstance("some-space-guid", "some-service-plan-guid", "some-service-instance")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceInstance).To(Equal(ServiceInstance{
+				GUID:            "some-service-instance-guid",
+				Name:            "some-service-instance",
+				Type:            ManagedService,
+				ServicePlanGUID: "some-service-plan-guid",
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("CreateUserProvidedServiceInstance", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-instance-guid"
+				},
+				"entity": {
+					"name": "some-service-instance",
+					"type": "user_provided_service_instance"
+				}
+			}`
+			requestBody := map[string]interface{}{
+				"name":       "some-service-instance",
+				"space_guid": "some-space-guid",
+			}
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/user_provided_service_instances"),
+					VerifyJSONRepresenting(requestBody),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("creates the user provided service instance", func() {
+			serviceInstance, warnings, err := client.CreateUserProvidedServiceInstance("some-space-guid", "some-service-instance")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceInstance).To(Equal(ServiceInstance{
+				GUID: "some-service-instance-guid",
+				Name: "some-service-instance",
+				Type: UserProvidedService,
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
 	Describe("GetServiceInstances", func() {
 		BeforeEach(func() {
 			response1 := `{
@@ -61,7 +137,17 @@ var _ = Describe("Service Instance", func() {
 						},
 						"entity": {
 							"name": "some-service-name-1",
-							"type": "managed_service_instance"
+							"type": "managed_service_instance",
+							"dashboard_url": "http://some-dashboard",
+							"tags": ["tag-1", "tag-2"],
+							"last_operation": {
+								"type": "create",
+								"state": "succeeded",
+								"description": "service broker-provided description",
+								"created_at": "2018-01-01T00:00:00Z",
+								"updated_at": "2018-01-02T00:00:00Z"
+							},
+							"maintenance_info": {"version": "1.0.0"}
 						}
 					},
 					{
@@ -125,7 +211,21 @@ var _ = Describe("Service Instance", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(serviceInstances).To(ConsistOf([]ServiceInstance{
-					{Name: "some-service-name-1", GUID: "some-service-guid-1", Type: ManagedService},
+					{
+						Name:         "some-service-name-1",
+						GUID:         "some-service-guid-1",
+						Type:         ManagedService,
+						DashboardURL: "http://some-dashboard",
+						Tags:         []string{"tag-1", "tag-2"},
+						LastOperation: LastOperation{
+							Type:        "create",
+							State:       "succeeded",
+							Description: "service broker-provided description",
+							CreatedAt:   "2018-01-01T00:00:00Z",
+							UpdatedAt:   "2018-01-02T00:00:00Z",
+						},
+						MaintenanceInfo: MaintenanceInfo{Version: "1.0.0"},
+					},
 					{Name: "some-service-name-2", GUID: "some-service-guid-2", Type: ManagedService},
 					{Name: "some-service-name-3", GUID: "some-service-guid-3", Type: ManagedService},
 					{Name: "some-service-name-4", GUID: "some-service-guid-4", Type: ManagedService},
@@ -273,4 +373,155 @@ var _ = Describe("Service Instance", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceInstanceParameters", func() {
+		Context("when the service broker returns the parameters", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/parameters"),
+						RespondWith(http.StatusOK, `{"some-key": "some-value"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the parameters and all warnings", func() {
+				parameters, warnings, err := client.GetServiceInstanceParameters("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parameters).To(Equal(map[string]interface{}{"some-key": "some-value"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service broker does not support fetching parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 120004,
+					"description": "This service does not support fetching service instance parameters.",
+					"error_code": "CF-ServiceFetchInstanceParametersNotSupported"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/parameters"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetServiceInstanceParameters("some-service-instance-guid")
+				Expect(err).To(MatchError(ccerror.ServiceInstanceParametersFetchNotSupportedError{
+					Message: "This service does not support fetching service instance parameters.",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("PurgeServiceInstance", func() {
+		Context("when the service instance exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "purge=true"),
+						RespondWith(http.StatusNoContent, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("purges the service instance and returns all warnings", func() {
+				warnings, err := client.PurgeServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60004,
+					"description": "The service instance could not be found: some-service-instance-guid",
+					"error_code": "CF-ServiceInstanceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "purge=true"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.PurgeServiceInstance("some-service-instance-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The service instance could not be found: some-service-instance-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateServiceInstanceName", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-instance-guid"
+				},
+				"entity": {
+					"name": "new-service-instance-name",
+					"type": "managed_service_instance"
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+					VerifyJSONRepresenting(map[string]interface{}{"name": "new-service-instance-name"}),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("renames the service instance", func() {
+			serviceInstance, warnings, err := client.UpdateServiceInstanceName("some-service-instance-guid", "new-service-instance-name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceInstance).To(Equal(ServiceInstance{
+				GUID: "some-service-instance-guid",
+				Name: "new-service-instance-name",
+				Type: ManagedService,
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("UpdateUserProvidedServiceInstanceName", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-instance-guid"
+				},
+				"entity": {
+					"name": "new-service-instance-name",
+					"type": "user_provided_service_instance"
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/user_provided_service_instances/some-service-instance-guid"),
+					VerifyJSONRepresenting(map[string]interface{}{"name": "new-service-instance-name"}),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("renames the user provided service instance", func() {
+			serviceInstance, warnings, err := client.UpdateUserProvidedServiceInstanceName("some-service-instance-guid", "new-service-instance-name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceInstance).To(Equal(ServiceInstance{
+				GUID: "some-service-instance-guid",
+				Name: "new-service-instance-name",
+				Type: UserProvidedService,
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
 })
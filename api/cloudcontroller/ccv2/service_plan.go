@@ -0,0 +1,108 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// MaintenanceInfo is the version information a service broker advertises for
+// a Service Plan.
+type MaintenanceInfo struct {
+	Version     string `json:"version"`
+	Description string `json:"description"`
+}
+
+// ServicePlan represents a Cloud Controller Service Plan.
+type ServicePlan struct {
+	GUID        string
+	Name        string
+	Description string
+	Free        bool
+	Public      bool
+	ServiceGUID string
+
+	// Extra is the raw JSON metadata provided by the service broker catalog.
+	Extra string
+
+	// MaintenanceInfo is the latest version of the plan. It is empty when the
+	// service broker does not support upgrading instances.
+	MaintenanceInfo MaintenanceInfo
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Plan response.
+func (servicePlan *ServicePlan) UnmarshalJSON(data []byte) error {
+	var ccServicePlan struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name            string          `json:"name"`
+			Description     string          `json:"description"`
+			Free            bool            `json:"free"`
+			Public          bool            `json:"public"`
+			ServiceGUID     string          `json:"service_guid"`
+			Extra           string          `json:"extra"`
+			MaintenanceInfo MaintenanceInfo `json:"maintenance_info"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccServicePlan); err != nil {
+		return err
+	}
+
+	servicePlan.GUID = ccServicePlan.Metadata.GUID
+	servicePlan.Name = ccServicePlan.Entity.Name
+	servicePlan.Description = ccServicePlan.Entity.Description
+	servicePlan.Free = ccServicePlan.Entity.Free
+	servicePlan.Public = ccServicePlan.Entity.Public
+	servicePlan.ServiceGUID = ccServicePlan.Entity.ServiceGUID
+	servicePlan.Extra = ccServicePlan.Entity.Extra
+	servicePlan.MaintenanceInfo = ccServicePlan.Entity.MaintenanceInfo
+	return nil
+}
+
+// GetServicePlan returns the Service Plan associated with the provided GUID.
+func (client *Client) GetServicePlan(servicePlanGUID string) (ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlanRequest,
+		URIParams:   Params{"service_plan_guid": servicePlanGUID},
+	})
+	if err != nil {
+		return ServicePlan{}, nil, err
+	}
+
+	var servicePlan ServicePlan
+	response := cloudcontroller.Response{
+		Result: &servicePlan,
+	}
+
+	err = client.connection.Make(request, &response)
+	return servicePlan, response.Warnings, err
+}
+
+// GetServicePlans returns back a list of Service Plans based off of the
+// provided queries.
+func (client *Client) GetServicePlans(queries []Query) ([]ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlansRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullPlansList []ServicePlan
+	warnings, err := client.paginate(request, ServicePlan{}, func(item interface{}) error {
+		if plan, ok := item.(ServicePlan); ok {
+			fullPlansList = append(fullPlansList, plan)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServicePlan{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullPlansList, warnings, err
+}
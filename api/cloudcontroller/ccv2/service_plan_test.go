@@ -0,0 +1,196 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServicePlan", func() {
+		Context("when the service plan exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-plan-guid"
+					},
+					"entity": {
+						"name": "some-plan",
+						"description": "some description",
+						"free": true,
+						"public": true,
+						"service_guid": "some-service-guid",
+						"maintenance_info": {
+							"version": "2.0.0",
+							"description": "OS image upgrade"
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans/some-plan-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service plan and all warnings", func() {
+				plan, warnings, err := client.GetServicePlan("some-plan-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plan).To(Equal(ServicePlan{
+					GUID:        "some-plan-guid",
+					Name:        "some-plan",
+					Description: "some description",
+					Free:        true,
+					Public:      true,
+					ServiceGUID: "some-service-guid",
+					MaintenanceInfo: MaintenanceInfo{
+						Version:     "2.0.0",
+						Description: "OS image upgrade",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service plan does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 110003,
+					"description": "The service plan could not be found: some-plan-guid",
+					"error_code": "CF-ServicePlanNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans/some-plan-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetServicePlan("some-plan-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The service plan could not be found: some-plan-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServicePlans", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_plans?q=service_guid:some-service-guid&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-plan-guid-1"
+						},
+						"entity": {
+							"name": "some-plan-1",
+							"description": "some description",
+							"free": true,
+							"public": true,
+							"service_guid": "some-service-guid",
+							"extra": null
+						}
+					}
+				]
+			}`
+
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-plan-guid-2"
+						},
+						"entity": {
+							"name": "some-plan-2",
+							"description": "some other description",
+							"free": false,
+							"public": false,
+							"service_guid": "some-service-guid",
+							"extra": "{\"costs\":[{\"amount\":{\"usd\":10.0},\"unit\":\"MONTHLY\"}]}"
+						}
+					}
+				]
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried service plans and all warnings", func() {
+			plans, warnings, err := client.GetServicePlans([]Query{{
+				Filter:   ServiceGUIDFilter,
+				Operator: EqualOperator,
+				Value:    "some-service-guid",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plans).To(ConsistOf([]ServicePlan{
+				{
+					GUID:        "some-plan-guid-1",
+					Name:        "some-plan-1",
+					Description: "some description",
+					Free:        true,
+					Public:      true,
+					ServiceGUID: "some-service-guid",
+				},
+				{
+					GUID:        "some-plan-guid-2",
+					Name:        "some-plan-2",
+					Description: "some other description",
+					ServiceGUID: "some-service-guid",
+					Extra:       `{"costs":[{"amount":{"usd":10.0},"unit":"MONTHLY"}]}`,
+				},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+
+		Context("when filtering by several services", func() {
+			BeforeEach(func() {
+				server.Reset()
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid+IN+service-guid-1,service-guid-2"),
+						RespondWith(http.StatusOK, `{"next_url": null, "resources": []}`, nil),
+					),
+				)
+			})
+
+			It("sends the GUIDs in a single IN query", func() {
+				_, _, err := client.GetServicePlans([]Query{{
+					Filter:   ServiceGUIDFilter,
+					Operator: InOperator,
+					Value:    "service-guid-1,service-guid-2",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+})
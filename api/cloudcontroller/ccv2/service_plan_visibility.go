@@ -0,0 +1,63 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServicePlanVisibility represents a Cloud Controller Service Plan
+// Visibility, which makes a non-public plan available to an organization.
+type ServicePlanVisibility struct {
+	GUID             string
+	ServicePlanGUID  string
+	OrganizationGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Plan Visibility
+// response.
+func (visibility *ServicePlanVisibility) UnmarshalJSON(data []byte) error {
+	var ccVisibility struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			ServicePlanGUID  string `json:"service_plan_guid"`
+			OrganizationGUID string `json:"organization_guid"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccVisibility); err != nil {
+		return err
+	}
+
+	visibility.GUID = ccVisibility.Metadata.GUID
+	visibility.ServicePlanGUID = ccVisibility.Entity.ServicePlanGUID
+	visibility.OrganizationGUID = ccVisibility.Entity.OrganizationGUID
+	return nil
+}
+
+// GetServicePlanVisibilities returns back a list of Service Plan Visibilities
+// based off of the provided queries.
+func (client *Client) GetServicePlanVisibilities(queries []Query) ([]ServicePlanVisibility, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlanVisibilitiesRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullVisibilitiesList []ServicePlanVisibility
+	warnings, err := client.paginate(request, ServicePlanVisibility{}, func(item interface{}) error {
+		if visibility, ok := item.(ServicePlanVisibility); ok {
+			fullVisibilitiesList = append(fullVisibilitiesList, visibility)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServicePlanVisibility{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullVisibilitiesList, warnings, err
+}
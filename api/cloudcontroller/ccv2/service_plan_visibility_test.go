@@ -0,0 +1,60 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan Visibility", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServicePlanVisibilities", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-visibility-guid"
+						},
+						"entity": {
+							"service_plan_guid": "some-plan-guid",
+							"organization_guid": "some-org-guid"
+						}
+					}
+				]
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plan_visibilities", "q=organization_guid:some-org-guid"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried visibilities and all warnings", func() {
+			visibilities, warnings, err := client.GetServicePlanVisibilities([]Query{{
+				Filter:   OrganizationGUIDFilter,
+				Operator: EqualOperator,
+				Value:    "some-org-guid",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(visibilities).To(ConsistOf(ServicePlanVisibility{
+				GUID:             "some-visibility-guid",
+				ServicePlanGUID:  "some-plan-guid",
+				OrganizationGUID: "some-org-guid",
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+})
@@ -0,0 +1,263 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetService", func() {
+		Context("when the service exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-guid"
+					},
+					"entity": {
+						"label": "some-service",
+						"description": "some description",
+						"service_broker_guid": "some-broker-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services/some-service-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service and all warnings", func() {
+				service, warnings, err := client.GetService("some-service-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(service).To(Equal(Service{
+					GUID:              "some-service-guid",
+					Label:             "some-service",
+					Description:       "some description",
+					ServiceBrokerGUID: "some-broker-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 120003,
+					"description": "The service could not be found: some-service-guid",
+					"error_code": "CF-ServiceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services/some-service-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetService("some-service-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The service could not be found: some-service-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServices", func() {
+		Context("when the cloud controller does not return an error", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/services?q=label:some-service&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-service-guid-1"
+							},
+							"entity": {
+								"label": "some-service",
+								"provider": "some-provider-1",
+								"description": "some description",
+								"service_broker_guid": "some-broker-guid",
+								"extra": "{\"displayName\":\"Some Service\"}"
+							}
+						}
+					]
+				}`
+
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-service-guid-2"
+							},
+							"entity": {
+								"label": "some-service",
+								"provider": "some-provider-2",
+								"description": "some other description"
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services", "q=label:some-service"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services", "q=label:some-service&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns all the queried services and all warnings", func() {
+				services, warnings, err := client.GetServices([]Query{{
+					Filter:   LabelFilter,
+					Operator: EqualOperator,
+					Value:    "some-service",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(services).To(ConsistOf([]Service{
+					{GUID: "some-service-guid-1", Label: "some-service", Provider: "some-provider-1", Description: "some description", ServiceBrokerGUID: "some-broker-guid", Extra: `{"displayName":"Some Service"}`},
+					{GUID: "some-service-guid-2", Label: "some-service", Provider: "some-provider-2", Description: "some other description"},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/services"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetServices(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetSpaceServices", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-guid"
+						},
+						"entity": {
+							"label": "some-service",
+							"description": "some description",
+							"service_broker_guid": "some-broker-guid"
+						}
+					}
+				]
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/services", "q=label:some-service"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the services visible in the space and all warnings", func() {
+			services, warnings, err := client.GetSpaceServices("some-space-guid", []Query{{
+				Filter:   LabelFilter,
+				Operator: EqualOperator,
+				Value:    "some-service",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(services).To(ConsistOf(Service{
+				GUID:              "some-service-guid",
+				Label:             "some-service",
+				Description:       "some description",
+				ServiceBrokerGUID: "some-broker-guid",
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("PurgeService", func() {
+		Context("when the service exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/services/some-service-guid", "purge=true"),
+						RespondWith(http.StatusNoContent, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("purges the service and returns all warnings", func() {
+				warnings, err := client.PurgeService("some-service-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 120003,
+					"description": "The service could not be found: some-service-guid",
+					"error_code": "CF-ServiceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/services/some-service-guid", "purge=true"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.PurgeService("some-service-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The service could not be found: some-service-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
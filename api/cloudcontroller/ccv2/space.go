@@ -1,8 +1,10 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
@@ -10,6 +12,7 @@ import (
 // Space represents a Cloud Controller Space.
 type Space struct {
 	GUID                     string
+	OrganizationGUID         string
 	Name                     string
 	AllowSSH                 bool
 	SpaceQuotaDefinitionGUID string
@@ -21,6 +24,7 @@ func (space *Space) UnmarshalJSON(data []byte) error {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
 			Name                     string `json:"name"`
+			OrganizationGUID         string `json:"organization_guid"`
 			AllowSSH                 bool   `json:"allow_ssh"`
 			SpaceQuotaDefinitionGUID string `json:"space_quota_definition_guid"`
 		} `json:"entity"`
@@ -31,11 +35,65 @@ func (space *Space) UnmarshalJSON(data []byte) error {
 
 	space.GUID = ccSpace.Metadata.GUID
 	space.Name = ccSpace.Entity.Name
+	space.OrganizationGUID = ccSpace.Entity.OrganizationGUID
 	space.AllowSSH = ccSpace.Entity.AllowSSH
 	space.SpaceQuotaDefinitionGUID = ccSpace.Entity.SpaceQuotaDefinitionGUID
 	return nil
 }
 
+// CreateSpace creates a Space with the provided name in the Organization
+// associated with the provided GUID. The Space Quota is only assigned when
+// spaceQuotaGUID is not empty.
+func (client *Client) CreateSpace(spaceName string, orgGUID string, spaceQuotaGUID string) (Space, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name                     string `json:"name"`
+		OrganizationGUID         string `json:"organization_guid"`
+		SpaceQuotaDefinitionGUID string `json:"space_quota_definition_guid,omitempty"`
+	}{
+		Name:                     spaceName,
+		OrganizationGUID:         orgGUID,
+		SpaceQuotaDefinitionGUID: spaceQuotaGUID,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var space Space
+	response := cloudcontroller.Response{
+		Result: &space,
+	}
+
+	err = client.connection.Make(request, &response)
+	return space, response.Warnings, err
+}
+
+// GetSpace returns the Space associated with the provided GUID.
+func (client *Client) GetSpace(guid string) (Space, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceRequest,
+		URIParams:   Params{"space_guid": guid},
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var space Space
+	response := cloudcontroller.Response{
+		Result: &space,
+	}
+
+	err = client.connection.Make(request, &response)
+	return space, response.Warnings, err
+}
+
 // GetSpaces returns back a list of Spaces based off of the provided queries.
 func (client *Client) GetSpaces(queries []Query) ([]Space, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -61,3 +119,62 @@ func (client *Client) GetSpaces(queries []Query) ([]Space, Warnings, error) {
 
 	return fullSpacesList, warnings, err
 }
+
+// GetSpacesByPage calls handlePage with each page of Spaces, ordered by name
+// and based off of the provided queries, as soon as the page is retrieved.
+func (client *Client) GetSpacesByPage(queries []Query, handlePage func([]Space) error) (Warnings, error) {
+	query := FormatQueryParameters(queries)
+	query.Set("order-by", "name")
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpacesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.paginateByPage(request, Space{}, func(list []interface{}) error {
+		spaces := make([]Space, 0, len(list))
+		for _, item := range list {
+			space, ok := item.(Space)
+			if !ok {
+				return ccerror.UnknownObjectInListError{
+					Expected:   Space{},
+					Unexpected: item,
+				}
+			}
+			spaces = append(spaces, space)
+		}
+		return handlePage(spaces)
+	})
+}
+
+// UpdateSpaceName renames the Space associated with the provided GUID.
+func (client *Client) UpdateSpaceName(spaceGUID string, newName string) (Space, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name string `json:"name"`
+	}{
+		Name: newName,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceRequest,
+		URIParams:   Params{"space_guid": spaceGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var space Space
+	response := cloudcontroller.Response{
+		Result: &space,
+	}
+
+	err = client.connection.Make(request, &response)
+	return space, response.Warnings, err
+}
@@ -4,12 +4,19 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
 type SpaceQuota struct {
 	GUID string
 	Name string
+
+	// MemoryLimit is the total memory, in megabytes, that the started apps of
+	// the space may use. AppInstanceLimit is the total number of instances of
+	// those apps. Both are -1 when unlimited.
+	MemoryLimit      int
+	AppInstanceLimit int
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -17,7 +24,9 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name             string `json:"name"`
+			MemoryLimit      int    `json:"memory_limit"`
+			AppInstanceLimit int    `json:"app_instance_limit"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccSpaceQuota); err != nil {
@@ -26,6 +35,8 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.MemoryLimit = ccSpaceQuota.Entity.MemoryLimit
+	spaceQuota.AppInstanceLimit = ccSpaceQuota.Entity.AppInstanceLimit
 	return nil
 }
 
@@ -47,3 +58,30 @@ func (client *Client) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 	err = client.connection.Make(request, &response)
 	return spaceQuota, response.Warnings, err
 }
+
+// GetSpaceQuotas returns the Space Quotas defined in the Organization
+// associated with the provided GUID.
+func (client *Client) GetSpaceQuotas(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationSpaceQuotaDefinitionsRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpaceQuotasList []SpaceQuota
+	warnings, err := client.paginate(request, SpaceQuota{}, func(item interface{}) error {
+		if spaceQuota, ok := item.(SpaceQuota); ok {
+			fullSpaceQuotasList = append(fullSpaceQuotasList, spaceQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SpaceQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpaceQuotasList, warnings, err
+}
@@ -27,7 +27,9 @@ var _ = Describe("Space Quotas", func() {
 						"updated_at": null
 					},
 					"entity": {
-						"name": "space-quota"
+						"name": "space-quota",
+						"memory_limit": 2048,
+						"app_instance_limit": -1
 					}
 				}`
 				server.AppendHandlers(
@@ -43,8 +45,10 @@ var _ = Describe("Space Quotas", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				Expect(spaceQuota).To(Equal(SpaceQuota{
-					Name: "space-quota",
-					GUID: "space-quota-guid",
+					Name:             "space-quota",
+					GUID:             "space-quota-guid",
+					MemoryLimit:      2048,
+					AppInstanceLimit: -1,
 				}))
 			})
 		})
@@ -71,4 +75,72 @@ var _ = Describe("Space Quotas", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceQuotas", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "space-quota-guid-1"
+							},
+							"entity": {
+								"name": "space-quota-1"
+							}
+						},
+						{
+							"metadata": {
+								"guid": "space-quota-guid-2"
+							},
+							"entity": {
+								"name": "space-quota-2"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the Space Quotas of the organization", func() {
+				spaceQuotas, warnings, err := client.GetSpaceQuotas("some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				Expect(spaceQuotas).To(ConsistOf(
+					SpaceQuota{GUID: "space-quota-guid-1", Name: "space-quota-1"},
+					SpaceQuota{GUID: "space-quota-guid-2", Name: "space-quota-2"},
+				))
+			})
+		})
+
+		Context("when the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30003,
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetSpaceQuotas("some-org-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The organization could not be found: some-org-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package ccv2_test
 
 import (
+	"errors"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -17,6 +18,150 @@ var _ = Describe("Space", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CreateSpace", func() {
+		Context("when the space is created successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-guid"
+					},
+					"entity": {
+						"name": "some-space",
+						"organization_guid": "some-org-guid",
+						"space_quota_definition_guid": "some-space-quota-guid"
+					}
+				}`
+				requestBody := map[string]interface{}{
+					"name":                        "some-space",
+					"organization_guid":           "some-org-guid",
+					"space_quota_definition_guid": "some-space-quota-guid",
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created space and warnings", func() {
+				space, warnings, err := client.CreateSpace("some-space", "some-org-guid", "some-space-quota-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				Expect(space).To(Equal(Space{
+					GUID:                     "some-space-guid",
+					Name:                     "some-space",
+					OrganizationGUID:         "some-org-guid",
+					SpaceQuotaDefinitionGUID: "some-space-quota-guid",
+				}))
+			})
+		})
+
+		Context("when no space quota is provided", func() {
+			BeforeEach(func() {
+				requestBody := map[string]interface{}{
+					"name":              "some-space",
+					"organization_guid": "some-org-guid",
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-space-guid"}, "entity": {"name": "some-space"}}`),
+					),
+				)
+			})
+
+			It("does not send a space quota", func() {
+				space, _, err := client.CreateSpace("some-space", "some-org-guid", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(space.GUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the name is already taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40002,
+					"description": "The app space name is taken: some-space",
+					"error_code": "CF-SpaceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a SpaceNameTakenError and warnings", func() {
+				_, warnings, err := client.CreateSpace("some-space", "some-org-guid", "")
+				Expect(err).To(MatchError(ccerror.SpaceNameTakenError{Message: "The app space name is taken: some-space"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetSpace", func() {
+		Context("when the space exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-guid"
+					},
+					"entity": {
+						"name": "some-space",
+						"organization_guid": "some-org-guid",
+						"allow_ssh": true,
+						"space_quota_definition_guid": "some-space-quota-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the space and all warnings", func() {
+				space, warnings, err := client.GetSpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				Expect(space).To(Equal(Space{
+					GUID:                     "some-space-guid",
+					Name:                     "some-space",
+					OrganizationGUID:         "some-org-guid",
+					AllowSSH:                 true,
+					SpaceQuotaDefinitionGUID: "some-space-quota-guid",
+				}))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40004,
+					"description": "The app space could not be found: some-space-guid",
+					"error_code": "CF-SpaceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				_, warnings, err := client.GetSpace("some-space-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app space could not be found: some-space-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetSpaces", func() {
 		Context("when no errors are encountered", func() {
 			Context("when results are paginated", func() {
@@ -30,6 +175,7 @@ var _ = Describe("Space", func() {
 								},
 								"entity": {
 									"name": "space-1",
+									"organization_guid": "some-org-guid",
 									"allow_ssh": false,
 									"space_quota_definition_guid": "some-space-quota-guid-1"
 								}
@@ -94,6 +240,7 @@ var _ = Describe("Space", func() {
 					Expect(spaces).To(Equal([]Space{
 						{
 							GUID:                     "space-guid-1",
+							OrganizationGUID:         "some-org-guid",
 							Name:                     "space-1",
 							AllowSSH:                 false,
 							SpaceQuotaDefinitionGUID: "some-space-quota-guid-1",
@@ -151,4 +298,195 @@ var _ = Describe("Space", func() {
 			})
 		})
 	})
+
+	Describe("GetSpacesByPage", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/spaces?order-by=name&q=some-query:some-value&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "space-guid-1"
+							},
+							"entity": {
+								"name": "space-1"
+							}
+						},
+						{
+							"metadata": {
+								"guid": "space-guid-2"
+							},
+							"entity": {
+								"name": "space-2"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "space-guid-3"
+							},
+							"entity": {
+								"name": "space-3"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces", "order-by=name&q=some-query:some-value"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces", "order-by=name&q=some-query:some-value&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					))
+			})
+
+			It("calls the handler once per page and returns all warnings", func() {
+				var pages [][]string
+				warnings, err := client.GetSpacesByPage([]Query{{
+					Filter:   "some-query",
+					Operator: EqualOperator,
+					Value:    "some-value",
+				}}, func(page []Space) error {
+					var names []string
+					for _, item := range page {
+						names = append(names, item.Name)
+					}
+					pages = append(pages, names)
+					return nil
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pages).To(Equal([][]string{
+					{"space-1", "space-2"},
+					{"space-3"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the handler returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": "/v2/spaces?order-by=name&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "space-guid-1"
+							},
+							"entity": {
+								"name": "space-1"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces", "order-by=name"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("stops paginating and returns the error and all warnings", func() {
+				expectedErr := errors.New("some handler error")
+				warnings, err := client.GetSpacesByPage(nil, func([]Space) error {
+					return expectedErr
+				})
+
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "code": 10001,
+  "description": "Some Error",
+  "error_code": "CF-SomeError"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				warnings, err := client.GetSpacesByPage(nil, func([]Space) error {
+					return nil
+				})
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceName", func() {
+		Context("when the space is renamed successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-guid"
+					},
+					"entity": {
+						"name": "new-space-name",
+						"organization_guid": "some-org-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid"),
+						VerifyJSONRepresenting(map[string]interface{}{"name": "new-space-name"}),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the renamed space and warnings", func() {
+				space, warnings, err := client.UpdateSpaceName("some-space-guid", "new-space-name")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				Expect(space.GUID).To(Equal("some-space-guid"))
+				Expect(space.Name).To(Equal("new-space-name"))
+			})
+		})
+
+		Context("when the name is already taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40002,
+					"description": "The app space name is taken: new-space-name",
+					"error_code": "CF-SpaceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a SpaceNameTakenError and warnings", func() {
+				_, warnings, err := client.UpdateSpaceName("some-space-guid", "new-space-name")
+				Expect(err).To(MatchError(ccerror.SpaceNameTakenError{Message: "The app space name is taken: new-space-name"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
@@ -14,7 +14,9 @@ import (
 type Application struct {
 	Name          string                   `json:"name"`
 	GUID          string                   `json:"guid,omitempty"`
+	State         string                   `json:"state,omitempty"`
 	Relationships ApplicationRelationships `json:"relationships"`
+	Metadata      *Metadata                `json:"metadata,omitempty"`
 }
 
 type ApplicationRelationships struct {
@@ -67,3 +69,34 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 
 	return responseApp, response.Warnings, err
 }
+
+// UpdateApplicationMetadata updates the labels of the application with the
+// provided GUID. Labels that are not provided are left unchanged and labels
+// with a nil value are removed.
+func (client *Client) UpdateApplicationMetadata(appGUID string, metadata Metadata) (Application, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Metadata Metadata `json:"metadata"`
+	}{
+		Metadata: metadata,
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchApplicationRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	var responseApp Application
+	response := cloudcontroller.Response{
+		Result: &responseApp,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseApp, response.Warnings, err
+}
@@ -31,7 +31,8 @@ var _ = Describe("Application", func() {
   "resources": [
     {
       "name": "app-name-1",
-      "guid": "app-guid-1"
+      "guid": "app-guid-1",
+      "state": "STARTED"
     },
     {
       "name": "app-name-2",
@@ -72,7 +73,7 @@ var _ = Describe("Application", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(apps).To(ConsistOf(
-					Application{Name: "app-name-1", GUID: "app-guid-1"},
+					Application{Name: "app-name-1", GUID: "app-guid-1", State: "STARTED"},
 					Application{Name: "app-name-2", GUID: "app-guid-2"},
 					Application{Name: "app-name-3", GUID: "app-guid-3"},
 				))
@@ -220,4 +221,69 @@ var _ = Describe("Application", func() {
 			})
 		})
 	})
+
+	Describe("UpdateApplicationMetadata", func() {
+		Context("when the labels are updated", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-app-guid",
+					"name": "some-app",
+					"metadata": {
+						"labels": {
+							"some-label": "some-value"
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid"),
+						VerifyJSON(`{"metadata": {"labels": {"some-label": "some-value", "removed-label": null}}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends only the metadata and returns the updated application", func() {
+				value := "some-value"
+				app, warnings, err := client.UpdateApplicationMetadata("some-app-guid", Metadata{
+					Labels: map[string]*string{
+						"some-label":    &value,
+						"removed-label": nil,
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(app.GUID).To(Equal("some-app-guid"))
+				Expect(app.Metadata).ToNot(BeNil())
+				Expect(*app.Metadata.Labels["some-label"]).To(Equal("some-value"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateApplicationMetadata("some-app-guid", Metadata{})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
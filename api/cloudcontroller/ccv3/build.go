@@ -0,0 +1,111 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+type BuildState string
+
+const (
+	BuildStateStaging BuildState = "STAGING"
+	BuildStateStaged  BuildState = "STAGED"
+	BuildStateFailed  BuildState = "FAILED"
+)
+
+// Build represents a Cloud Controller V3 Build, which stages a package into a
+// droplet.
+type Build struct {
+	GUID        string
+	PackageGUID string
+	// DropletGUID is set once the build has staged.
+	DropletGUID string
+	State       BuildState
+	Error       string
+}
+
+// MarshalJSON converts a Build into a Cloud Controller Build.
+func (b Build) MarshalJSON() ([]byte, error) {
+	var ccBuild struct {
+		Package struct {
+			GUID string `json:"guid"`
+		} `json:"package"`
+	}
+
+	ccBuild.Package.GUID = b.PackageGUID
+	return json.Marshal(ccBuild)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Build response.
+func (b *Build) UnmarshalJSON(data []byte) error {
+	var ccBuild struct {
+		GUID    string     `json:"guid"`
+		State   BuildState `json:"state"`
+		Error   string     `json:"error"`
+		Package struct {
+			GUID string `json:"guid"`
+		} `json:"package"`
+		Droplet struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+	}
+
+	err := json.Unmarshal(data, &ccBuild)
+	if err != nil {
+		return err
+	}
+
+	b.GUID = ccBuild.GUID
+	b.PackageGUID = ccBuild.Package.GUID
+	b.DropletGUID = ccBuild.Droplet.GUID
+	b.State = ccBuild.State
+	b.Error = ccBuild.Error
+
+	return nil
+}
+
+// CreateBuild starts staging the package of the provided build.
+func (client *Client) CreateBuild(build Build) (Build, Warnings, error) {
+	bodyBytes, err := json.Marshal(build)
+	if err != nil {
+		return Build{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostBuildRequest,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Build{}, nil, err
+	}
+
+	var responseBuild Build
+	response := cloudcontroller.Response{
+		Result: &responseBuild,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseBuild, response.Warnings, err
+}
+
+// GetBuild returns the build with the given GUID.
+func (client *Client) GetBuild(guid string) (Build, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildRequest,
+		URIParams:   internal.Params{"guid": guid},
+	})
+	if err != nil {
+		return Build{}, nil, err
+	}
+
+	var responseBuild Build
+	response := cloudcontroller.Response{
+		Result: &responseBuild,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseBuild, response.Warnings, err
+}
@@ -0,0 +1,136 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Build", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateBuild", func() {
+		Context("when the build is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-build-guid",
+					"state": "STAGING",
+					"error": null,
+					"package": {"guid": "some-package-guid"},
+					"droplet": null
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/builds"),
+						VerifyJSON(`{"package": {"guid": "some-package-guid"}}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the build and all warnings", func() {
+				build, warnings, err := client.CreateBuild(Build{PackageGUID: "some-package-guid"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(build).To(Equal(Build{
+					GUID:        "some-build-guid",
+					PackageGUID: "some-package-guid",
+					State:       BuildStateStaging,
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Package not ready",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/builds"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateBuild(Build{PackageGUID: "some-package-guid"})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Package not ready"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetBuild", func() {
+		Context("when the build exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-build-guid",
+					"state": "FAILED",
+					"error": "StagingError - Staging error: some reason",
+					"package": {"guid": "some-package-guid"},
+					"droplet": {"guid": "some-droplet-guid"}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/builds/some-build-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the build and all warnings", func() {
+				build, warnings, err := client.GetBuild("some-build-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(build).To(Equal(Build{
+					GUID:        "some-build-guid",
+					PackageGUID: "some-package-guid",
+					DropletGUID: "some-droplet-guid",
+					State:       BuildStateFailed,
+					Error:       "StagingError - Staging error: some reason",
+				}))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Build not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/builds/some-build-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetBuild("some-build-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Build not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -91,6 +91,9 @@ func SetupV3Response() {
 			"apps": {
 				"href": "SERVER_URL/v3/apps"
 			},
+			"builds": {
+				"href": "SERVER_URL/v3/builds"
+			},
 			"tasks": {
 				"href": "SERVER_URL/v3/tasks"
 			},
@@ -105,6 +108,27 @@ func SetupV3Response() {
 			},
 			"packages": {
 				"href": "SERVER_URL/v3/packages"
+			},
+			"processes": {
+				"href": "SERVER_URL/v3/processes"
+			},
+			"routes": {
+				"href": "SERVER_URL/v3/routes"
+			},
+			"jobs": {
+				"href": "SERVER_URL/v3/jobs"
+			},
+			"droplets": {
+				"href": "SERVER_URL/v3/droplets"
+			},
+			"deployments": {
+				"href": "SERVER_URL/v3/deployments"
+			},
+			"service_instances": {
+				"href": "SERVER_URL/v3/service_instances"
+			},
+			"service_plans": {
+				"href": "SERVER_URL/v3/service_plans"
 			}
 		}
 	}`, "SERVER_URL", serverURL, -1)
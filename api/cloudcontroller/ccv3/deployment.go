@@ -0,0 +1,215 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// DeploymentStrategy is how a deployment replaces the instances of an
+// application.
+type DeploymentStrategy string
+
+const (
+	// DeploymentStrategyRolling replaces instances a few at a time, starting
+	// a new instance before stopping an old one.
+	DeploymentStrategyRolling DeploymentStrategy = "rolling"
+	// DeploymentStrategyCanary starts a single new instance and pauses until
+	// the deployment is continued, then replaces the remaining instances like
+	// a rolling deployment.
+	DeploymentStrategyCanary DeploymentStrategy = "canary"
+)
+
+// DeploymentStatusValue is whether a deployment is still in progress.
+type DeploymentStatusValue string
+
+const (
+	// DeploymentStatusValueActive is when instances are still being replaced.
+	DeploymentStatusValueActive DeploymentStatusValue = "ACTIVE"
+	// DeploymentStatusValueFinalized is when the deployment has finished.
+	DeploymentStatusValueFinalized DeploymentStatusValue = "FINALIZED"
+)
+
+// DeploymentStatusReason is why a deployment is in its current status.
+type DeploymentStatusReason string
+
+const (
+	DeploymentStatusReasonDeploying  DeploymentStatusReason = "DEPLOYING"
+	DeploymentStatusReasonPaused     DeploymentStatusReason = "PAUSED"
+	DeploymentStatusReasonDeployed   DeploymentStatusReason = "DEPLOYED"
+	DeploymentStatusReasonCanceled   DeploymentStatusReason = "CANCELED"
+	DeploymentStatusReasonSuperseded DeploymentStatusReason = "SUPERSEDED"
+)
+
+// Deployment represents a Cloud Controller V3 Deployment.
+type Deployment struct {
+	GUID        string
+	AppGUID     string
+	DropletGUID string
+	Strategy    DeploymentStrategy
+	// MaxInFlight is the number of instances replaced at the same time. Zero
+	// uses the Cloud Controller default.
+	MaxInFlight  int
+	StatusValue  DeploymentStatusValue
+	StatusReason DeploymentStatusReason
+}
+
+// MarshalJSON converts a Deployment into a Cloud Controller Deployment.
+func (d Deployment) MarshalJSON() ([]byte, error) {
+	type ccDroplet struct {
+		GUID string `json:"guid"`
+	}
+	type ccOptions struct {
+		MaxInFlight int `json:"max_in_flight,omitempty"`
+	}
+	type ccRelationships struct {
+		App Relationship `json:"app"`
+	}
+
+	ccDeployment := struct {
+		Strategy      DeploymentStrategy `json:"strategy,omitempty"`
+		Droplet       *ccDroplet         `json:"droplet,omitempty"`
+		Options       *ccOptions         `json:"options,omitempty"`
+		Relationships ccRelationships    `json:"relationships"`
+	}{
+		Strategy: d.Strategy,
+		Relationships: ccRelationships{
+			App: Relationship{GUID: d.AppGUID},
+		},
+	}
+
+	if d.DropletGUID != "" {
+		ccDeployment.Droplet = &ccDroplet{GUID: d.DropletGUID}
+	}
+	if d.MaxInFlight > 0 {
+		ccDeployment.Options = &ccOptions{MaxInFlight: d.MaxInFlight}
+	}
+
+	return json.Marshal(ccDeployment)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Deployment response.
+func (d *Deployment) UnmarshalJSON(data []byte) error {
+	var ccDeployment struct {
+		GUID     string             `json:"guid"`
+		Strategy DeploymentStrategy `json:"strategy"`
+		Droplet  struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		Options struct {
+			MaxInFlight int `json:"max_in_flight"`
+		} `json:"options"`
+		Status struct {
+			Value  DeploymentStatusValue  `json:"value"`
+			Reason DeploymentStatusReason `json:"reason"`
+		} `json:"status"`
+		Relationships struct {
+			App Relationship `json:"app"`
+		} `json:"relationships"`
+	}
+
+	err := json.Unmarshal(data, &ccDeployment)
+	if err != nil {
+		return err
+	}
+
+	d.GUID = ccDeployment.GUID
+	d.AppGUID = ccDeployment.Relationships.App.GUID
+	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.Strategy = ccDeployment.Strategy
+	d.MaxInFlight = ccDeployment.Options.MaxInFlight
+	d.StatusValue = ccDeployment.Status.Value
+	d.StatusReason = ccDeployment.Status.Reason
+
+	return nil
+}
+
+// CreateApplicationDeployment starts a deployment of the provided droplet to
+// the application.
+func (client *Client) CreateApplicationDeployment(deployment Deployment) (Deployment, Warnings, error) {
+	bodyBytes, err := json.Marshal(deployment)
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentRequest,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
+
+// GetDeployments lists deployments with optional filters.
+func (client *Client) GetDeployments(query url.Values) ([]Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullDeploymentsList []Deployment
+	warnings, err := client.paginate(request, Deployment{}, func(item interface{}) error {
+		if deployment, ok := item.(Deployment); ok {
+			fullDeploymentsList = append(fullDeploymentsList, deployment)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Deployment{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullDeploymentsList, warnings, err
+}
+
+// ContinueDeployment resumes a paused canary deployment, so the remaining
+// instances are replaced.
+func (client *Client) ContinueDeployment(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentActionContinueRequest,
+		URIParams:   internal.Params{"guid": guid},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetDeployment returns the deployment with the given GUID.
+func (client *Client) GetDeployment(guid string) (Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentRequest,
+		URIParams:   internal.Params{"guid": guid},
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
@@ -0,0 +1,304 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Deployment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateApplicationDeployment", func() {
+		Context("when the deployment is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"strategy": "rolling",
+					"droplet": {"guid": "some-droplet-guid"},
+					"options": {"max_in_flight": 2},
+					"status": {"value": "ACTIVE", "reason": "DEPLOYING"},
+					"relationships": {"app": {"data": {"guid": "some-app-guid"}}}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSON(`{
+							"strategy": "rolling",
+							"droplet": {"guid": "some-droplet-guid"},
+							"options": {"max_in_flight": 2},
+							"relationships": {"app": {"data": {"guid": "some-app-guid"}}}
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and all warnings", func() {
+				deployment, warnings, err := client.CreateApplicationDeployment(Deployment{
+					AppGUID:     "some-app-guid",
+					DropletGUID: "some-droplet-guid",
+					Strategy:    DeploymentStrategyRolling,
+					MaxInFlight: 2,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:         "some-deployment-guid",
+					AppGUID:      "some-app-guid",
+					DropletGUID:  "some-droplet-guid",
+					Strategy:     DeploymentStrategyRolling,
+					MaxInFlight:  2,
+					StatusValue:  DeploymentStatusValueActive,
+					StatusReason: DeploymentStatusReasonDeploying,
+				}))
+			})
+		})
+
+		Context("when no droplet or max in flight is provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSON(`{
+							"strategy": "rolling",
+							"relationships": {"app": {"data": {"guid": "some-app-guid"}}}
+						}`),
+						RespondWith(http.StatusCreated, `{"guid": "some-deployment-guid"}`),
+					),
+				)
+			})
+
+			It("leaves them out of the request", func() {
+				_, _, err := client.CreateApplicationDeployment(Deployment{
+					AppGUID:  "some-app-guid",
+					Strategy: DeploymentStrategyRolling,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Unable to assign current droplet. Ensure the droplet exists and belongs to this app.",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateApplicationDeployment(Deployment{AppGUID: "some-app-guid"})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Unable to assign current droplet. Ensure the droplet exists and belongs to this app.",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetDeployment", func() {
+		Context("when the deployment exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"status": {"value": "FINALIZED", "reason": "DEPLOYED"},
+					"relationships": {"app": {"data": {"guid": "some-app-guid"}}}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and all warnings", func() {
+				deployment, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:         "some-deployment-guid",
+					AppGUID:      "some-app-guid",
+					StatusValue:  DeploymentStatusValueFinalized,
+					StatusReason: DeploymentStatusReasonDeployed,
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Deployment not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Deployment not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetDeployments", func() {
+		Context("when there are deployments", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/deployments?app_guids=some-app-guid&status_values=ACTIVE&page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "deployment-guid-1",
+			"status": {"value": "ACTIVE", "reason": "PAUSED"}
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "deployment-guid-2",
+			"status": {"value": "ACTIVE", "reason": "DEPLOYING"}
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments", "app_guids=some-app-guid&status_values=ACTIVE"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments", "app_guids=some-app-guid&status_values=ACTIVE&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployments and all warnings", func() {
+				deployments, warnings, err := client.GetDeployments(url.Values{
+					AppGUIDFilter:     []string{"some-app-guid"},
+					StatusValueFilter: []string{string(DeploymentStatusValueActive)},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+				Expect(deployments).To(Equal([]Deployment{
+					{GUID: "deployment-guid-1", StatusValue: DeploymentStatusValueActive, StatusReason: DeploymentStatusReasonPaused},
+					{GUID: "deployment-guid-2", StatusValue: DeploymentStatusValueActive, StatusReason: DeploymentStatusReasonDeploying},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The query parameter is invalid",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetDeployments(nil)
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "The query parameter is invalid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("ContinueDeployment", func() {
+		Context("when the deployment is continued", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/continue"),
+						RespondWith(http.StatusOK, `{"guid": "some-deployment-guid"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.ContinueDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Cannot continue a deployment with status: ACTIVE and reason: DEPLOYING.",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/continue"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.ContinueDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Cannot continue a deployment with status: ACTIVE and reason: DEPLOYING.",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
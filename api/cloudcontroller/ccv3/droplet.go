@@ -0,0 +1,115 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+type DropletState string
+
+const (
+	DropletStateStaged  DropletState = "STAGED"
+	DropletStateFailed  DropletState = "FAILED"
+	DropletStateExpired DropletState = "EXPIRED"
+)
+
+// Droplet represents a Cloud Controller V3 Droplet.
+type Droplet struct {
+	CreatedAt string       `json:"created_at"`
+	GUID      string       `json:"guid"`
+	State     DropletState `json:"state"`
+}
+
+// GetApplicationDroplets lists the droplets of the provided application with
+// optional filters.
+func (client *Client) GetApplicationDroplets(appGUID string, query url.Values) ([]Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppDropletsRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullDropletsList []Droplet
+	warnings, err := client.paginate(request, Droplet{}, func(item interface{}) error {
+		if droplet, ok := item.(Droplet); ok {
+			fullDropletsList = append(fullDropletsList, droplet)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Droplet{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullDropletsList, warnings, err
+}
+
+// GetApplicationCurrentDroplet returns the droplet the provided application
+// is currently running.
+func (client *Client) GetApplicationCurrentDroplet(appGUID string) (Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppDropletCurrentRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var droplet Droplet
+	response := cloudcontroller.Response{
+		Result: &droplet,
+	}
+	err = client.connection.Make(request, &response)
+
+	return droplet, response.Warnings, err
+}
+
+// SetApplicationDroplet makes the droplet the one the application runs the
+// next time it is started.
+func (client *Client) SetApplicationDroplet(appGUID string, dropletGUID string) (Relationship, Warnings, error) {
+	body, err := json.Marshal(Relationship{GUID: dropletGUID})
+	if err != nil {
+		return Relationship{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchApplicationCurrentDropletRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Relationship{}, nil, err
+	}
+
+	var relationship Relationship
+	response := cloudcontroller.Response{
+		Result: &relationship,
+	}
+	err = client.connection.Make(request, &response)
+
+	return relationship, response.Warnings, err
+}
+
+// DeleteDroplet deletes the droplet with the given GUID.
+func (client *Client) DeleteDroplet(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteDropletRequest,
+		URIParams:   internal.Params{"guid": guid},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -0,0 +1,245 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Droplet", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationDroplets", func() {
+		Context("when the application has droplets", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/apps/some-app-guid/droplets?order_by=-created_at&page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "droplet-guid-1",
+			"state": "STAGED",
+			"created_at": "2017-08-02T19:00:00Z"
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "droplet-guid-2",
+			"state": "FAILED",
+			"created_at": "2017-08-01T19:00:00Z"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets", "order_by=-created_at"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets", "order_by=-created_at&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns the droplets and all warnings", func() {
+				droplets, warnings, err := client.GetApplicationDroplets("some-app-guid", url.Values{"order_by": []string{"-created_at"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+				Expect(droplets).To(Equal([]Droplet{
+					{GUID: "droplet-guid-1", State: DropletStateStaged, CreatedAt: "2017-08-02T19:00:00Z"},
+					{GUID: "droplet-guid-2", State: DropletStateFailed, CreatedAt: "2017-08-01T19:00:00Z"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationDroplets("some-app-guid", nil)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetApplicationCurrentDroplet", func() {
+		Context("when the application has a current droplet", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "droplet-guid",
+					"state": "STAGED",
+					"created_at": "2017-08-02T19:00:00Z"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the droplet and all warnings", func() {
+				droplet, warnings, err := client.GetApplicationCurrentDroplet("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(droplet).To(Equal(Droplet{GUID: "droplet-guid", State: DropletStateStaged, CreatedAt: "2017-08-02T19:00:00Z"}))
+			})
+		})
+
+		Context("when the application has no current droplet", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				_, warnings, err := client.GetApplicationCurrentDroplet("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Droplet not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("SetApplicationDroplet", func() {
+		Context("when the droplet is set", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid/relationships/current_droplet"),
+						VerifyJSON(`{"data": {"guid": "some-droplet-guid"}}`),
+						RespondWith(http.StatusOK, `{"data": {"guid": "some-droplet-guid"}}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the relationship and all warnings", func() {
+				relationship, warnings, err := client.SetApplicationDroplet("some-app-guid", "some-droplet-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationship).To(Equal(Relationship{GUID: "some-droplet-guid"}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Unable to assign current droplet",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid/relationships/current_droplet"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.SetApplicationDroplet("some-app-guid", "some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Unable to assign current droplet"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("DeleteDroplet", func() {
+		Context("when the delete is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the droplet and returns all warnings", func() {
+				warnings, err := client.DeleteDroplet("some-droplet-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.DeleteDroplet("some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Droplet not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// EnvironmentVariables are the user-provided environment variables of an
+// application. When updating, a nil value removes the variable.
+type EnvironmentVariables map[string]*string
+
+// MarshalJSON converts EnvironmentVariables into a Cloud Controller
+// environment variables request.
+func (variables EnvironmentVariables) MarshalJSON() ([]byte, error) {
+	ccEnvVars := struct {
+		Var map[string]*string `json:"var"`
+	}{
+		Var: variables,
+	}
+
+	return json.Marshal(ccEnvVars)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller environment variables
+// response.
+func (variables *EnvironmentVariables) UnmarshalJSON(data []byte) error {
+	var ccEnvVars struct {
+		Var map[string]*string `json:"var"`
+	}
+
+	err := json.Unmarshal(data, &ccEnvVars)
+	if err != nil {
+		return err
+	}
+
+	*variables = ccEnvVars.Var
+	return nil
+}
+
+// UpdateApplicationEnvironmentVariables merges the provided environment
+// variables into those of the application with the provided GUID and returns
+// the resulting variables. Variables that are not provided are left
+// unchanged, so the existing variables do not have to be read first.
+func (client *Client) UpdateApplicationEnvironmentVariables(appGUID string, envVars EnvironmentVariables) (EnvironmentVariables, Warnings, error) {
+	bodyBytes, err := json.Marshal(envVars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchApplicationEnvironmentVariablesRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var responseEnvVars EnvironmentVariables
+	response := cloudcontroller.Response{
+		Result: &responseEnvVars,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseEnvVars, response.Warnings, err
+}
+
+// Environment is the full environment of an application: the variables it
+// was given by the user, by the running and staging environment variable
+// groups, and by the system, such as VCAP_SERVICES.
+type Environment struct {
+	EnvironmentVariables map[string]interface{} `json:"environment_variables"`
+	StagingGroup         map[string]interface{} `json:"staging_env_json"`
+	RunningGroup         map[string]interface{} `json:"running_env_json"`
+	System               map[string]interface{} `json:"system_env_json"`
+	Application          map[string]interface{} `json:"application_env_json"`
+}
+
+// GetApplicationEnvironment returns the full environment of the application
+// with the provided GUID.
+func (client *Client) GetApplicationEnvironment(appGUID string) (Environment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppEnvRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+	})
+	if err != nil {
+		return Environment{}, nil, err
+	}
+
+	var responseEnvironment Environment
+	response := cloudcontroller.Response{
+		Result: &responseEnvironment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseEnvironment, response.Warnings, err
+}
@@ -0,0 +1,146 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Environment Variables", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("UpdateApplicationEnvironmentVariables", func() {
+		Context("when the variables are updated", func() {
+			BeforeEach(func() {
+				response := `{
+					"var": {
+						"EXISTING": "existing-value",
+						"NEW": "new-value"
+					},
+					"links": {
+						"self": {"href": "https://api.example.com/v3/apps/some-app-guid/environment_variables"}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid/environment_variables"),
+						VerifyJSON(`{"var": {"NEW": "new-value", "REMOVED": null}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends only the provided variables and returns the merged variables", func() {
+				newValue := "new-value"
+				envVars, warnings, err := client.UpdateApplicationEnvironmentVariables("some-app-guid", EnvironmentVariables{
+					"NEW":     &newValue,
+					"REMOVED": nil,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(envVars).To(HaveLen(2))
+				Expect(*envVars["EXISTING"]).To(Equal("existing-value"))
+				Expect(*envVars["NEW"]).To(Equal("new-value"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid/environment_variables"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateApplicationEnvironmentVariables("some-app-guid", EnvironmentVariables{})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetApplicationEnvironment", func() {
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"environment_variables": {"SOME_VAR": "some-value"},
+					"staging_env_json": {"STAGING_VAR": "staging-value"},
+					"running_env_json": {"RUNNING_VAR": "running-value"},
+					"system_env_json": {
+						"VCAP_SERVICES": {
+							"some-service": [{"name": "some-instance", "credentials": {"credhub-ref": "/c/some-ref"}}]
+						}
+					},
+					"application_env_json": {
+						"VCAP_APPLICATION": {"application_name": "some-app"}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/env"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the environment and all warnings", func() {
+				environment, warnings, err := client.GetApplicationEnvironment("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(environment.EnvironmentVariables).To(Equal(map[string]interface{}{"SOME_VAR": "some-value"}))
+				Expect(environment.StagingGroup).To(Equal(map[string]interface{}{"STAGING_VAR": "staging-value"}))
+				Expect(environment.RunningGroup).To(Equal(map[string]interface{}{"RUNNING_VAR": "running-value"}))
+				Expect(environment.System).To(HaveKey("VCAP_SERVICES"))
+				Expect(environment.Application).To(HaveKey("VCAP_APPLICATION"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/env"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationEnvironment("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
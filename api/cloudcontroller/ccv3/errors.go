@@ -53,6 +53,7 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 	if len(errors) == 0 {
 		return ccerror.V3UnexpectedResponseError{
 			ResponseCode:    rawHTTPStatusErr.StatusCode,
+			RequestIDs:      rawHTTPStatusErr.RequestIDs,
 			V3ErrorResponse: errorResponse,
 		}
 	}
@@ -66,18 +67,18 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 		if firstErr.Title == "CF-InvalidAuthToken" {
 			return ccerror.InvalidAuthTokenError{Message: firstErr.Detail}
 		}
-		return ccerror.UnauthorizedError{Message: firstErr.Detail}
+		return ccerror.UnauthorizedError{Message: firstErr.Detail, RequestIDs: rawHTTPStatusErr.RequestIDs}
 	case http.StatusForbidden: // 403
-		return ccerror.ForbiddenError{Message: firstErr.Detail}
+		return ccerror.ForbiddenError{Message: firstErr.Detail, RequestIDs: rawHTTPStatusErr.RequestIDs}
 	case http.StatusNotFound: // 404
 		return ccerror.ResourceNotFoundError{Message: firstErr.Detail}
 	case http.StatusUnprocessableEntity: // 422
-		return ccerror.UnprocessableEntityError{Message: firstErr.Detail}
+		return ccerror.UnprocessableEntityError{Message: firstErr.Detail, RequestIDs: rawHTTPStatusErr.RequestIDs}
 	case http.StatusServiceUnavailable: // 503
 		if firstErr.Title == "CF-TaskWorkersUnavailable" {
 			return ccerror.TaskWorkersUnavailableError{Message: firstErr.Detail}
 		}
-		return ccerror.ServiceUnavailableError{Message: firstErr.Detail}
+		return ccerror.ServiceUnavailableError{Message: firstErr.Detail, RequestIDs: rawHTTPStatusErr.RequestIDs}
 	default:
 		return ccerror.V3UnexpectedResponseError{
 			ResponseCode:    rawHTTPStatusErr.StatusCode,
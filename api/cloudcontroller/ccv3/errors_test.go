@@ -93,6 +93,10 @@ var _ = Describe("Error Wrapper", func() {
 					Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
 						ResponseCode:    http.StatusUnauthorized,
 						V3ErrorResponse: ccerror.V3ErrorResponse{Errors: []ccerror.V3Error{}},
+						RequestIDs: []string{
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+						},
 					}))
 				})
 			})
@@ -105,7 +109,13 @@ var _ = Describe("Error Wrapper", func() {
 				Context("generic 401", func() {
 					It("returns a UnauthorizedError", func() {
 						_, _, err := client.GetApplications(nil)
-						Expect(err).To(MatchError(ccerror.UnauthorizedError{Message: "SomeCC Error Message"}))
+						Expect(err).To(MatchError(ccerror.UnauthorizedError{
+							Message: "SomeCC Error Message",
+							RequestIDs: []string{
+								"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+								"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+							},
+						}))
 					})
 				})
 
@@ -136,7 +146,13 @@ var _ = Describe("Error Wrapper", func() {
 
 				It("returns a ForbiddenError", func() {
 					_, _, err := client.GetApplications(nil)
-					Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "SomeCC Error Message"}))
+					Expect(err).To(MatchError(ccerror.ForbiddenError{
+						Message: "SomeCC Error Message",
+						RequestIDs: []string{
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+						},
+					}))
 				})
 			})
 
@@ -159,7 +175,13 @@ var _ = Describe("Error Wrapper", func() {
 
 				It("returns a UnprocessableEntityError", func() {
 					_, _, err := client.GetApplications(nil)
-					Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "SomeCC Error Message"}))
+					Expect(err).To(MatchError(ccerror.UnprocessableEntityError{
+						Message: "SomeCC Error Message",
+						RequestIDs: []string{
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+						},
+					}))
 				})
 			})
 
@@ -170,7 +192,13 @@ var _ = Describe("Error Wrapper", func() {
 
 				It("returns a ServiceUnavailableError", func() {
 					_, _, err := client.GetApplications(nil)
-					Expect(err).To(MatchError(ccerror.ServiceUnavailableError{Message: "SomeCC Error Message"}))
+					Expect(err).To(MatchError(ccerror.ServiceUnavailableError{
+						Message: "SomeCC Error Message",
+						RequestIDs: []string{
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95",
+							"6e0b4379-f5f7-4b2b-56b0-9ab7e96eed95::7445d9db-c31e-410d-8dc5-9f79ec3fc26f",
+						},
+					}))
 				})
 
 				Context("when the title is 'CF-TaskWorkersUnavailable'", func() {
@@ -12,30 +12,65 @@ import "net/http"
 // The const name should always be the const value + Request.
 const (
 	DeleteIsolationSegmentRelationshipOrganizationRequest = "DeleteIsolationSegmentRelationshipOrganization"
+	DeleteDropletRequest                                  = "DeleteDroplet"
 	DeleteIsolationSegmentRequest                         = "DeleteIsolationSegment"
+	DeletePackageRequest                                  = "DeletePackage"
+	GetAppDropletCurrentRequest                           = "GetAppDropletCurrent"
+	GetAppDropletsRequest                                 = "GetAppDroplets"
+	GetAppEnvRequest                                      = "GetAppEnv"
+	GetAppPackagesRequest                                 = "GetAppPackages"
 	GetAppsRequest                                        = "GetApps"
+	GetAppProcessesRequest                                = "GetAppProcesses"
+	GetAppRevisionsRequest                                = "GetAppRevisions"
 	GetAppTasksRequest                                    = "GetAppTasks"
+	GetBuildRequest                                       = "GetBuild"
+	GetDeploymentRequest                                  = "GetDeployment"
+	GetDeploymentsRequest                                 = "GetDeployments"
 	GetIsolationSegmentOrganizationsRequest               = "GetIsolationSegmentRelationshipOrganizations"
 	GetIsolationSegmentRequest                            = "GetIsolationSegment"
 	GetIsolationSegmentsRequest                           = "GetIsolationSegments"
+	GetJobRequest                                         = "GetJob"
 	GetOrganizationDefaultIsolationSegmentRequest         = "GetOrganizationDefaultIsolationSegment"
 	GetOrgsRequest                                        = "GetOrgs"
 	GetPackageRequest                                     = "GetPackage"
+	GetServiceInstancesRequest                            = "GetServiceInstances"
+	GetServicePlanRequest                                 = "GetServicePlan"
 	GetSpaceRelationshipIsolationSegmentRequest           = "GetSpaceRelationshipIsolationSegmentRequest"
+	GetTaskRequest                                        = "GetTask"
+	PatchApplicationCurrentDropletRequest                 = "PatchApplicationCurrentDroplet"
+	PatchApplicationEnvironmentVariablesRequest           = "PatchApplicationEnvironmentVariables"
+	PatchApplicationRequest                               = "PatchApplication"
+	PatchOrganizationDefaultIsolationSegmentRequest       = "PatchOrganizationDefaultIsolationSegment"
+	PatchProcessRequest                                   = "PatchProcess"
+	PatchRouteRelationshipSpaceRequest                    = "PatchRouteRelationshipSpace"
+	PatchServiceInstanceRequest                           = "PatchServiceInstance"
 	PatchSpaceRelationshipIsolationSegmentRequest         = "PatchSpaceRelationshipIsolationSegmentRequest"
 	PostApplicationRequest                                = "PostApplicationRequest"
 	PostAppTasksRequest                                   = "PostAppTasks"
+	PostBuildRequest                                      = "PostBuild"
+	PostDeploymentActionContinueRequest                   = "PostDeploymentActionContinue"
+	PostDeploymentRequest                                 = "PostDeployment"
 	PostIsolationSegmentRelationshipOrganizationsRequest  = "PostIsolationSegmentRelationshipOrganizations"
 	PostIsolationSegmentsRequest                          = "PostIsolationSegments"
 	PostPackageRequest                                    = "PostPackageRequest"
+	PostSpaceActionApplyManifestRequest                   = "PostSpaceActionApplyManifest"
+	PostSpaceManifestDiffRequest                          = "PostSpaceManifestDiff"
 	PutTaskCancelRequest                                  = "PutTaskCancelRequest"
 )
 
 const (
 	AppsResource              = "apps"
+	BuildsResource            = "builds"
+	DeploymentsResource       = "deployments"
+	DropletsResource          = "droplets"
 	IsolationSegmentsResource = "isolation_segments"
+	JobsResource              = "jobs"
 	OrgsResource              = "organizations"
 	PackagesResource          = "packages"
+	ProcessesResource         = "processes"
+	RoutesResource            = "routes"
+	ServiceInstancesResource  = "service_instances"
+	ServicePlansResource      = "service_plans"
 	SpaceResource             = "spaces"
 	TasksResource             = "tasks"
 )
@@ -43,19 +78,46 @@ const (
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = []Route{
 	{Path: "/", Method: http.MethodGet, Name: GetAppsRequest, Resource: AppsResource},
+	{Path: "/", Method: http.MethodGet, Name: GetDeploymentsRequest, Resource: DeploymentsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetOrgsRequest, Resource: OrgsResource},
+	{Path: "/", Method: http.MethodGet, Name: GetServiceInstancesRequest, Resource: ServiceInstancesResource},
 	{Path: "/", Method: http.MethodPost, Name: PostApplicationRequest, Resource: AppsResource},
+	{Path: "/", Method: http.MethodPost, Name: PostBuildRequest, Resource: BuildsResource},
+	{Path: "/", Method: http.MethodPost, Name: PostDeploymentRequest, Resource: DeploymentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostPackageRequest, Resource: PackagesResource},
+	{Path: "/:guid", Method: http.MethodDelete, Name: DeleteDropletRequest, Resource: DropletsResource},
 	{Path: "/:guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRequest, Resource: IsolationSegmentsResource},
+	{Path: "/:guid", Method: http.MethodDelete, Name: DeletePackageRequest, Resource: PackagesResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetBuildRequest, Resource: BuildsResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetDeploymentRequest, Resource: DeploymentsResource},
 	{Path: "/:guid", Method: http.MethodGet, Name: GetIsolationSegmentRequest, Resource: IsolationSegmentsResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetJobRequest, Resource: JobsResource},
 	{Path: "/:guid", Method: http.MethodGet, Name: GetPackageRequest, Resource: PackagesResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetServicePlanRequest, Resource: ServicePlansResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetTaskRequest, Resource: TasksResource},
+	{Path: "/:guid", Method: http.MethodPatch, Name: PatchApplicationRequest, Resource: AppsResource},
+	{Path: "/:guid", Method: http.MethodPatch, Name: PatchProcessRequest, Resource: ProcessesResource},
+	{Path: "/:guid", Method: http.MethodPatch, Name: PatchServiceInstanceRequest, Resource: ServiceInstancesResource},
+	{Path: "/:guid/actions/continue", Method: http.MethodPost, Name: PostDeploymentActionContinueRequest, Resource: DeploymentsResource},
 	{Path: "/:guid/cancel", Method: http.MethodPut, Name: PutTaskCancelRequest, Resource: TasksResource},
+	{Path: "/:guid/droplets", Method: http.MethodGet, Name: GetAppDropletsRequest, Resource: AppsResource},
+	{Path: "/:guid/droplets/current", Method: http.MethodGet, Name: GetAppDropletCurrentRequest, Resource: AppsResource},
+	{Path: "/:guid/actions/apply_manifest", Method: http.MethodPost, Name: PostSpaceActionApplyManifestRequest, Resource: SpaceResource},
+	{Path: "/:guid/env", Method: http.MethodGet, Name: GetAppEnvRequest, Resource: AppsResource},
+	{Path: "/:guid/environment_variables", Method: http.MethodPatch, Name: PatchApplicationEnvironmentVariablesRequest, Resource: AppsResource},
+	{Path: "/:guid/manifest_diff", Method: http.MethodPost, Name: PostSpaceManifestDiffRequest, Resource: SpaceResource},
 	{Path: "/:guid/organizations", Method: http.MethodGet, Name: GetIsolationSegmentOrganizationsRequest, Resource: IsolationSegmentsResource},
+	{Path: "/:guid/packages", Method: http.MethodGet, Name: GetAppPackagesRequest, Resource: AppsResource},
+	{Path: "/:guid/processes", Method: http.MethodGet, Name: GetAppProcessesRequest, Resource: AppsResource},
+	{Path: "/:guid/revisions", Method: http.MethodGet, Name: GetAppRevisionsRequest, Resource: AppsResource},
+	{Path: "/:guid/relationships/current_droplet", Method: http.MethodPatch, Name: PatchApplicationCurrentDropletRequest, Resource: AppsResource},
 	{Path: "/:guid/relationships/default_isolation_segment", Method: http.MethodGet, Name: GetOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
+	{Path: "/:guid/relationships/default_isolation_segment", Method: http.MethodPatch, Name: PatchOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
 	{Path: "/:guid/relationships/isolation_segment", Method: http.MethodGet, Name: GetSpaceRelationshipIsolationSegmentRequest, Resource: SpaceResource},
 	{Path: "/:guid/relationships/isolation_segment", Method: http.MethodPatch, Name: PatchSpaceRelationshipIsolationSegmentRequest, Resource: SpaceResource},
+	{Path: "/:guid/relationships/space", Method: http.MethodPatch, Name: PatchRouteRelationshipSpaceRequest, Resource: RoutesResource},
 	{Path: "/:guid/relationships/organizations", Method: http.MethodPost, Name: PostIsolationSegmentRelationshipOrganizationsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:guid/relationships/organizations/:org_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRelationshipOrganizationRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:guid/tasks", Method: http.MethodGet, Name: GetAppTasksRequest, Resource: AppsResource},
@@ -0,0 +1,65 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// JobState is the current state of a job.
+type JobState string
+
+const (
+	// JobStateComplete is when the job is no longer running and it was
+	// successful.
+	JobStateComplete JobState = "COMPLETE"
+
+	// JobStateFailed is when the job is no longer running due to a failure.
+	JobStateFailed JobState = "FAILED"
+
+	// JobStateProcessing is when the job is waiting to be run or is running.
+	JobStateProcessing JobState = "PROCESSING"
+)
+
+// JobError is an error reported by a failed Cloud Controller V3 Job.
+type JobError struct {
+	Code   int    `json:"code"`
+	Detail string `json:"detail"`
+	Title  string `json:"title"`
+}
+
+// Job represents a Cloud Controller V3 Job.
+type Job struct {
+	GUID      string     `json:"guid"`
+	Operation string     `json:"operation"`
+	State     JobState   `json:"state"`
+	Errors    []JobError `json:"errors"`
+}
+
+// Complete returns true when the job has completed successfully.
+func (job Job) Complete() bool {
+	return job.State == JobStateComplete
+}
+
+// Failed returns true when the job has completed with an error/failure.
+func (job Job) Failed() bool {
+	return job.State == JobStateFailed
+}
+
+// GetJob returns the job with the given GUID.
+func (client *Client) GetJob(guid string) (Job, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetJobRequest,
+		URIParams:   internal.Params{"guid": guid},
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	var responseJob Job
+	response := cloudcontroller.Response{
+		Result: &responseJob,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseJob, response.Warnings, err
+}
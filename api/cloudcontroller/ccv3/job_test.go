@@ -0,0 +1,87 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Job", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetJob", func() {
+		Context("when the job exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-job-guid",
+					"operation": "app.delete",
+					"state": "FAILED",
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "something went wrong",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/jobs/some-job-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the job and all warnings", func() {
+				job, warnings, err := client.GetJob("some-job-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(job).To(Equal(Job{
+					GUID:      "some-job-guid",
+					Operation: "app.delete",
+					State:     JobStateFailed,
+					Errors: []JobError{
+						{Code: 10008, Detail: "something went wrong", Title: "CF-UnprocessableEntity"},
+					},
+				}))
+				Expect(job.Failed()).To(BeTrue())
+				Expect(job.Complete()).To(BeFalse())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Job not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/jobs/some-job-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				_, warnings, err := client.GetJob("some-job-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Job not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
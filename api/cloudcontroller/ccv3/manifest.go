@@ -0,0 +1,84 @@
+package ccv3
+
+import (
+	"bytes"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ManifestDiffOperation is the kind of change a manifest diff entry
+// describes.
+type ManifestDiffOperation string
+
+const (
+	// ManifestDiffAdd is a field that the manifest adds.
+	ManifestDiffAdd ManifestDiffOperation = "add"
+
+	// ManifestDiffRemove is a field that the manifest removes.
+	ManifestDiffRemove ManifestDiffOperation = "remove"
+
+	// ManifestDiffReplace is a field whose value the manifest changes.
+	ManifestDiffReplace ManifestDiffOperation = "replace"
+)
+
+// ManifestDiffEntry is a single change between the applications in a space
+// and a manifest. Path is a JSON pointer into the manifest, such as
+// "/applications/0/instances".
+type ManifestDiffEntry struct {
+	Op    ManifestDiffOperation `json:"op"`
+	Path  string                `json:"path"`
+	Was   interface{}           `json:"was"`
+	Value interface{}           `json:"value"`
+}
+
+// ManifestDiff represents the changes applying a manifest to a space would
+// make.
+type ManifestDiff struct {
+	Diff []ManifestDiffEntry `json:"diff"`
+}
+
+// GetSpaceManifestDiff returns the changes applying the raw YAML manifest to
+// the space would make.
+func (client *Client) GetSpaceManifestDiff(spaceGUID string, rawManifest []byte) (ManifestDiff, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceManifestDiffRequest,
+		URIParams:   internal.Params{"guid": spaceGUID},
+		Body:        bytes.NewReader(rawManifest),
+	})
+	if err != nil {
+		return ManifestDiff{}, nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-yaml")
+
+	var diff ManifestDiff
+	response := cloudcontroller.Response{
+		Result: &diff,
+	}
+	err = client.connection.Make(request, &response)
+
+	return diff, response.Warnings, err
+}
+
+// UpdateSpaceApplyManifest applies the raw YAML manifest to the apps in the
+// space. The manifest is applied asynchronously; the returned job tracks its
+// progress.
+func (client *Client) UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (Job, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceActionApplyManifestRequest,
+		URIParams:   internal.Params{"guid": spaceGUID},
+		Body:        bytes.NewReader(rawManifest),
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-yaml")
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return Job{}, response.Warnings, err
+	}
+
+	return jobFromLocation(response), response.Warnings, nil
+}
@@ -0,0 +1,134 @@
+package ccv3_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Manifest", func() {
+	var (
+		client      *Client
+		rawManifest []byte
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient()
+		rawManifest = []byte("applications:\n- name: some-app\n  instances: 3\n")
+	})
+
+	Describe("GetSpaceManifestDiff", func() {
+		Context("when the diff is returned", func() {
+			BeforeEach(func() {
+				response := `{
+	"diff": [
+		{"op": "replace", "path": "/applications/0/instances", "was": 1, "value": 3},
+		{"op": "add", "path": "/applications/0/env/FOO", "value": "bar"}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/spaces/some-space-guid/manifest_diff"),
+						VerifyContentType("application/x-yaml"),
+						VerifyBody(rawManifest),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the diff and all warnings", func() {
+				diff, warnings, err := client.GetSpaceManifestDiff("some-space-guid", rawManifest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(diff).To(Equal(ManifestDiff{
+					Diff: []ManifestDiffEntry{
+						{Op: ManifestDiffReplace, Path: "/applications/0/instances", Was: json.Number("1"), Value: json.Number("3")},
+						{Op: ManifestDiffAdd, Path: "/applications/0/env/FOO", Value: "bar"},
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "Instances must be greater than or equal to 0",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/spaces/some-space-guid/manifest_diff"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetSpaceManifestDiff("some-space-guid", rawManifest)
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Instances must be greater than or equal to 0"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceApplyManifest", func() {
+		Context("when the manifest is accepted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/spaces/some-space-guid/actions/apply_manifest"),
+						VerifyContentType("application/x-yaml"),
+						VerifyBody(rawManifest),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+							"Location":      {server.URL() + "/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job applying the manifest and all warnings", func() {
+				job, warnings, err := client.UpdateSpaceApplyManifest("some-space-guid", rawManifest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(job).To(Equal(Job{GUID: "some-job-guid"}))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "Space not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/spaces/some-space-guid/actions/apply_manifest"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateSpaceApplyManifest("some-space-guid", rawManifest)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Space not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,7 @@
+package ccv3
+
+// Metadata represents the labels of a Cloud Controller V3 resource. When
+// updating, a nil label value removes the label.
+type Metadata struct {
+	Labels map[string]*string `json:"labels,omitempty"`
+}
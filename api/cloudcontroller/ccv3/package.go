@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"mime/multipart"
+	"net/url"
 	"os"
 	"path/filepath"
 
@@ -32,6 +33,7 @@ const (
 )
 
 type Package struct {
+	CreatedAt     string               `json:"created_at,omitempty"`
 	GUID          string               `json:"guid,omitempty"`
 	Links         APILinks             `json:"links,omitempty"`
 	Relationships PackageRelationships `json:"relationships"`
@@ -62,6 +64,49 @@ func (client *Client) GetPackage(guid string) (Package, Warnings, error) {
 	return responsePackage, response.Warnings, err
 }
 
+// GetApplicationPackages lists the packages of the provided application with
+// optional filters.
+func (client *Client) GetApplicationPackages(appGUID string, query url.Values) ([]Package, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppPackagesRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullPackagesList []Package
+	warnings, err := client.paginate(request, Package{}, func(item interface{}) error {
+		if pkg, ok := item.(Package); ok {
+			fullPackagesList = append(fullPackagesList, pkg)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Package{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullPackagesList, warnings, err
+}
+
+// DeletePackage deletes the package with the given GUID.
+func (client *Client) DeletePackage(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeletePackageRequest,
+		URIParams:   internal.Params{"guid": guid},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // CreatePackage creates a package with the given settings, Type and the Space
 // must be set.
 func (client *Client) CreatePackage(pkg Package) (Package, Warnings, error) {
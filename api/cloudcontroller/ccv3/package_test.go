@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 
@@ -301,4 +302,132 @@ var _ = Describe("Package", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationPackages", func() {
+		Context("when the application has packages", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/apps/some-app-guid/packages?order_by=-created_at&page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "package-guid-1",
+			"type": "bits",
+			"state": "READY",
+			"created_at": "2017-08-02T19:00:00Z"
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "package-guid-2",
+			"type": "bits",
+			"state": "EXPIRED",
+			"created_at": "2017-08-01T19:00:00Z"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/packages", "order_by=-created_at"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/packages", "order_by=-created_at&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns the packages and all warnings", func() {
+				packages, warnings, err := client.GetApplicationPackages("some-app-guid", url.Values{"order_by": []string{"-created_at"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+				Expect(packages).To(Equal([]Package{
+					{GUID: "package-guid-1", Type: PackageTypeBits, State: PackageStateReady, CreatedAt: "2017-08-02T19:00:00Z"},
+					{GUID: "package-guid-2", Type: PackageTypeBits, State: PackageStateExpired, CreatedAt: "2017-08-01T19:00:00Z"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/packages"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationPackages("some-app-guid", nil)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("DeletePackage", func() {
+		Context("when the delete is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-pkg-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the package and returns all warnings", func() {
+				warnings, err := client.DeletePackage("some-pkg-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Package not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-pkg-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.DeletePackage("some-pkg-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Package not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,151 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Process represents a Cloud Controller V3 Process.
+type Process struct {
+	GUID string
+	Type string
+
+	// HealthCheck is the liveness health check of the process.
+	HealthCheck ProcessHealthCheck
+
+	// ReadinessHealthCheck is the health check used to determine whether the
+	// process can receive traffic.
+	ReadinessHealthCheck ProcessHealthCheck
+}
+
+// ProcessHealthCheck represents the health check configuration of a process.
+// An empty Type means the health check is left unchanged when updating the
+// process.
+type ProcessHealthCheck struct {
+	Type     string
+	Endpoint string
+	// InvocationTimeout is the number of seconds a single liveness check may
+	// take.
+	InvocationTimeout int
+	// Interval is the number of seconds between readiness checks.
+	Interval int
+}
+
+type ccProcessHealthCheck struct {
+	Type string `json:"type,omitempty"`
+	Data struct {
+		Endpoint          string `json:"endpoint,omitempty"`
+		InvocationTimeout int    `json:"invocation_timeout,omitempty"`
+		Interval          int    `json:"interval,omitempty"`
+	} `json:"data"`
+}
+
+func newCCProcessHealthCheck(healthCheck ProcessHealthCheck) *ccProcessHealthCheck {
+	if healthCheck.Type == "" {
+		return nil
+	}
+
+	ccHealthCheck := ccProcessHealthCheck{Type: healthCheck.Type}
+	ccHealthCheck.Data.Endpoint = healthCheck.Endpoint
+	ccHealthCheck.Data.InvocationTimeout = healthCheck.InvocationTimeout
+	ccHealthCheck.Data.Interval = healthCheck.Interval
+	return &ccHealthCheck
+}
+
+func (ccHealthCheck ccProcessHealthCheck) toProcessHealthCheck() ProcessHealthCheck {
+	return ProcessHealthCheck{
+		Type:              ccHealthCheck.Type,
+		Endpoint:          ccHealthCheck.Data.Endpoint,
+		InvocationTimeout: ccHealthCheck.Data.InvocationTimeout,
+		Interval:          ccHealthCheck.Data.Interval,
+	}
+}
+
+func (p Process) MarshalJSON() ([]byte, error) {
+	var ccProcess struct {
+		HealthCheck          *ccProcessHealthCheck `json:"health_check,omitempty"`
+		ReadinessHealthCheck *ccProcessHealthCheck `json:"readiness_health_check,omitempty"`
+	}
+
+	ccProcess.HealthCheck = newCCProcessHealthCheck(p.HealthCheck)
+	ccProcess.ReadinessHealthCheck = newCCProcessHealthCheck(p.ReadinessHealthCheck)
+
+	return json.Marshal(ccProcess)
+}
+
+func (p *Process) UnmarshalJSON(data []byte) error {
+	var ccProcess struct {
+		GUID                 string               `json:"guid"`
+		Type                 string               `json:"type"`
+		HealthCheck          ccProcessHealthCheck `json:"health_check"`
+		ReadinessHealthCheck ccProcessHealthCheck `json:"readiness_health_check"`
+	}
+
+	err := json.Unmarshal(data, &ccProcess)
+	if err != nil {
+		return err
+	}
+
+	p.GUID = ccProcess.GUID
+	p.Type = ccProcess.Type
+	p.HealthCheck = ccProcess.HealthCheck.toProcessHealthCheck()
+	p.ReadinessHealthCheck = ccProcess.ReadinessHealthCheck.toProcessHealthCheck()
+
+	return nil
+}
+
+// GetApplicationProcesses lists the processes of the provided application.
+func (client *Client) GetApplicationProcesses(appGUID string) ([]Process, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppProcessesRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullProcessesList []Process
+	warnings, err := client.paginate(request, Process{}, func(item interface{}) error {
+		if process, ok := item.(Process); ok {
+			fullProcessesList = append(fullProcessesList, process)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Process{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullProcessesList, warnings, err
+}
+
+// UpdateProcess updates the health checks of the process with the provided
+// GUID. Only the health checks with a type set are sent.
+func (client *Client) UpdateProcess(process Process) (Process, Warnings, error) {
+	bodyBytes, err := json.Marshal(process)
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchProcessRequest,
+		URIParams:   internal.Params{"guid": process.GUID},
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	var responseProcess Process
+	response := cloudcontroller.Response{
+		Result: &responseProcess,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseProcess, response.Warnings, err
+}
@@ -0,0 +1,226 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Process", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationProcesses", func() {
+		Context("when the application has processes", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/apps/some-app-guid/processes?page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "process-guid-1",
+			"type": "web",
+			"health_check": {
+				"type": "http",
+				"data": {
+					"timeout": null,
+					"invocation_timeout": 2,
+					"endpoint": "/health"
+				}
+			},
+			"readiness_health_check": {
+				"type": "http",
+				"data": {
+					"endpoint": "/ready",
+					"interval": 5
+				}
+			}
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "process-guid-2",
+			"type": "worker",
+			"health_check": {
+				"type": "process",
+				"data": {
+					"timeout": null
+				}
+			}
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns the processes and all warnings", func() {
+				processes, warnings, err := client.GetApplicationProcesses("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+
+				Expect(processes).To(ConsistOf(
+					Process{
+						GUID:                 "process-guid-1",
+						Type:                 "web",
+						HealthCheck:          ProcessHealthCheck{Type: "http", Endpoint: "/health", InvocationTimeout: 2},
+						ReadinessHealthCheck: ProcessHealthCheck{Type: "http", Endpoint: "/ready", Interval: 5},
+					},
+					Process{
+						GUID:        "process-guid-2",
+						Type:        "worker",
+						HealthCheck: ProcessHealthCheck{Type: "process"},
+					},
+				))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationProcesses("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateProcess", func() {
+		Context("when only the readiness health check is provided", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-process-guid",
+					"type": "web",
+					"health_check": {
+						"type": "port",
+						"data": {
+							"timeout": null
+						}
+					},
+					"readiness_health_check": {
+						"type": "http",
+						"data": {
+							"endpoint": "/ready",
+							"interval": 10
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(`{"readiness_health_check": {"type": "http", "data": {"endpoint": "/ready", "interval": 10}}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends only the readiness health check and returns the updated process", func() {
+				process, warnings, err := client.UpdateProcess(Process{
+					GUID:                 "some-process-guid",
+					ReadinessHealthCheck: ProcessHealthCheck{Type: "http", Endpoint: "/ready", Interval: 10},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(process).To(Equal(Process{
+					GUID:                 "some-process-guid",
+					Type:                 "web",
+					HealthCheck:          ProcessHealthCheck{Type: "port"},
+					ReadinessHealthCheck: ProcessHealthCheck{Type: "http", Endpoint: "/ready", Interval: 10},
+				}))
+			})
+		})
+
+		Context("when only the liveness health check is provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(`{"health_check": {"type": "port", "data": {"invocation_timeout": 3}}}`),
+						RespondWith(http.StatusOK, `{"guid": "some-process-guid"}`),
+					),
+				)
+			})
+
+			It("sends only the liveness health check", func() {
+				_, _, err := client.UpdateProcess(Process{
+					GUID:        "some-process-guid",
+					HealthCheck: ProcessHealthCheck{Type: "port", InvocationTimeout: 3},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Process not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateProcess(Process{
+					GUID:        "some-process-guid",
+					HealthCheck: ProcessHealthCheck{Type: "port"},
+				})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Process not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package ccv3
 
 const (
+	// AppGUIDFilter is a query paramater for listing objects by App GUID.
+	AppGUIDFilter = "app_guids"
 	// GUIDFilter is a query paramater for listing objects by GUID.
 	GUIDFilter = "guids"
 	// NameFilter is a query paramater for listing objects by name.
@@ -9,4 +11,7 @@ const (
 	OrganizationGUIDFilter = "organization_guids"
 	// SpaceGUIDFilter is a query paramater for listing objects by Space GUID.
 	SpaceGUIDFilter = "space_guids"
+	// StatusValueFilter is a query paramater for listing deployments by status
+	// value.
+	StatusValueFilter = "status_values"
 )
@@ -159,6 +159,9 @@ func (client *Client) RevokeIsolationSegmentFromOrganization(isolationSegmentGUI
 	return response.Warnings, err
 }
 
+// GetOrganizationDefaultIsolationSegment returns the relationship between the
+// organization and its default isolation segment. The GUID is empty when the
+// organization has no default.
 func (client *Client) GetOrganizationDefaultIsolationSegment(orgGUID string) (Relationship, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetOrganizationDefaultIsolationSegmentRequest,
@@ -176,3 +179,55 @@ func (client *Client) GetOrganizationDefaultIsolationSegment(orgGUID string) (Re
 	err = client.connection.Make(request, &response)
 	return relationship, response.Warnings, err
 }
+
+// PatchOrganizationDefaultIsolationSegment sets the default isolation segment
+// of the organization. An empty isolation segment GUID resets the default.
+func (client *Client) PatchOrganizationDefaultIsolationSegment(orgGUID string, isolationSegmentGUID string) (Relationship, Warnings, error) {
+	body, err := json.Marshal(Relationship{GUID: isolationSegmentGUID})
+	if err != nil {
+		return Relationship{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchOrganizationDefaultIsolationSegmentRequest,
+		URIParams:   internal.Params{"guid": orgGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Relationship{}, nil, err
+	}
+
+	var relationship Relationship
+	response := cloudcontroller.Response{
+		Result: &relationship,
+	}
+
+	err = client.connection.Make(request, &response)
+	return relationship, response.Warnings, err
+}
+
+// UpdateRouteSpaceRelationship transfers the ownership of the route to the
+// provided space. The apps mapped to the route keep their mappings.
+func (client *Client) UpdateRouteSpaceRelationship(routeGUID string, spaceGUID string) (Relationship, Warnings, error) {
+	body, err := json.Marshal(Relationship{GUID: spaceGUID})
+	if err != nil {
+		return Relationship{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchRouteRelationshipSpaceRequest,
+		URIParams:   internal.Params{"guid": routeGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Relationship{}, nil, err
+	}
+
+	var relationship Relationship
+	response := cloudcontroller.Response{
+		Result: &relationship,
+	}
+
+	err = client.connection.Make(request, &response)
+	return relationship, response.Warnings, err
+}
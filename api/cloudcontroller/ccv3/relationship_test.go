@@ -300,4 +300,142 @@ var _ = Describe("Relationship", func() {
 			})
 		})
 	})
+
+	Describe("PatchOrganizationDefaultIsolationSegment", func() {
+		Context("when setting the default isolation segment is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": {
+						"guid": "some-isolation-segment-guid"
+					}
+				}`
+
+				requestBody := map[string]map[string]string{
+					"data": {"guid": "some-isolation-segment-guid"},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/some-org-guid/relationships/default_isolation_segment"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the relationship and warnings", func() {
+				relationship, warnings, err := client.PatchOrganizationDefaultIsolationSegment("some-org-guid", "some-isolation-segment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationship).To(Equal(Relationship{
+					GUID: "some-isolation-segment-guid",
+				}))
+			})
+		})
+
+		Context("when resetting the default isolation segment", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": null
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/some-org-guid/relationships/default_isolation_segment"),
+						VerifyJSON(`{"data": {"guid": null}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends a null GUID and returns an empty relationship", func() {
+				relationship, warnings, err := client.PatchOrganizationDefaultIsolationSegment("some-org-guid", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationship).To(Equal(Relationship{}))
+			})
+		})
+
+		Context("when setting the default isolation segment fails with an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "Organization not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/some-org-guid/relationships/default_isolation_segment"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.PatchOrganizationDefaultIsolationSegment("some-org-guid", "some-isolation-segment-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "Organization not found",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateRouteSpaceRelationship", func() {
+		Context("when the transfer is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": {
+						"guid": "some-space-guid"
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/some-route-guid/relationships/space"),
+						VerifyJSON(`{"data": {"guid": "some-space-guid"}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the relationship and warnings", func() {
+				relationship, warnings, err := client.UpdateRouteSpaceRelationship("some-route-guid", "some-space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationship).To(Equal(Relationship{GUID: "some-space-guid"}))
+			})
+		})
+
+		Context("when the transfer fails with an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "Route not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/some-route-guid/relationships/space"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateRouteSpaceRelationship("some-route-guid", "some-space-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "Route not found",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,118 @@
+package ccv3
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// RevisionVersionFilter filters revisions by their version.
+const RevisionVersionFilter = "versions"
+
+// Revision represents a Cloud Controller V3 Revision, a snapshot of the
+// droplet, process commands and environment variables an application was
+// deployed with.
+type Revision struct {
+	GUID        string
+	Version     int
+	Description string
+	CreatedAt   string
+	DropletGUID string
+	// ProcessCommands maps process types to the command they run.
+	ProcessCommands map[string]string
+	Links           APILinks
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Revision response.
+func (r *Revision) UnmarshalJSON(data []byte) error {
+	var ccRevision struct {
+		GUID        string `json:"guid"`
+		Version     int    `json:"version"`
+		Description string `json:"description"`
+		CreatedAt   string `json:"created_at"`
+		Droplet     struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		Processes map[string]struct {
+			Command string `json:"command"`
+		} `json:"processes"`
+		Links APILinks `json:"links"`
+	}
+
+	err := json.Unmarshal(data, &ccRevision)
+	if err != nil {
+		return err
+	}
+
+	r.GUID = ccRevision.GUID
+	r.Version = ccRevision.Version
+	r.Description = ccRevision.Description
+	r.CreatedAt = ccRevision.CreatedAt
+	r.DropletGUID = ccRevision.Droplet.GUID
+	r.Links = ccRevision.Links
+	if len(ccRevision.Processes) > 0 {
+		r.ProcessCommands = map[string]string{}
+		for processType, process := range ccRevision.Processes {
+			r.ProcessCommands[processType] = process.Command
+		}
+	}
+
+	return nil
+}
+
+// GetApplicationRevisions lists the revisions of the provided application
+// with optional filters.
+func (client *Client) GetApplicationRevisions(appGUID string, query url.Values) ([]Revision, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppRevisionsRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRevisionsList []Revision
+	warnings, err := client.paginate(request, Revision{}, func(item interface{}) error {
+		if revision, ok := item.(Revision); ok {
+			fullRevisionsList = append(fullRevisionsList, revision)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Revision{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRevisionsList, warnings, err
+}
+
+// GetRevisionEnvironmentVariables returns the environment variables captured
+// in the revision.
+func (client *Client) GetRevisionEnvironmentVariables(revision Revision) (EnvironmentVariables, Warnings, error) {
+	link, ok := revision.Links["environment_variables"]
+	if !ok {
+		return nil, nil, ccerror.EnvironmentVariablesLinkNotFoundError{RevisionGUID: revision.GUID}
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		URL:    link.HREF,
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var responseEnvVars EnvironmentVariables
+	response := cloudcontroller.Response{
+		Result: &responseEnvVars,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseEnvVars, response.Warnings, err
+}
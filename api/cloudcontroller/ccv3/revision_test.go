@@ -0,0 +1,146 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Revision", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationRevisions", func() {
+		Context("when the application has revisions", func() {
+			BeforeEach(func() {
+				response := fmt.Sprintf(`{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "some-revision-guid",
+			"version": 3,
+			"description": "New droplet deployed.",
+			"created_at": "2017-08-02T19:00:00Z",
+			"droplet": {"guid": "some-droplet-guid"},
+			"processes": {
+				"web": {"command": "bundle exec rackup"},
+				"worker": {"command": "bundle exec sidekiq"}
+			},
+			"links": {
+				"environment_variables": {"href": "%s/v3/revisions/some-revision-guid/environment_variables"}
+			}
+		}
+	]
+}`, server.URL())
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions", "versions=3"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the revisions and all warnings", func() {
+				revisions, warnings, err := client.GetApplicationRevisions("some-app-guid", url.Values{RevisionVersionFilter: []string{"3"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(revisions).To(Equal([]Revision{
+					{
+						GUID:        "some-revision-guid",
+						Version:     3,
+						Description: "New droplet deployed.",
+						CreatedAt:   "2017-08-02T19:00:00Z",
+						DropletGUID: "some-droplet-guid",
+						ProcessCommands: map[string]string{
+							"web":    "bundle exec rackup",
+							"worker": "bundle exec sidekiq",
+						},
+						Links: APILinks{
+							"environment_variables": APILink{HREF: fmt.Sprintf("%s/v3/revisions/some-revision-guid/environment_variables", server.URL())},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationRevisions("some-app-guid", nil)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetRevisionEnvironmentVariables", func() {
+		var revision Revision
+
+		BeforeEach(func() {
+			revision = Revision{
+				GUID: "some-revision-guid",
+				Links: APILinks{
+					"environment_variables": APILink{HREF: fmt.Sprintf("%s/v3/revisions/some-revision-guid/environment_variables", server.URL())},
+				},
+			}
+		})
+
+		Context("when the revision links to its environment variables", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/revisions/some-revision-guid/environment_variables"),
+						RespondWith(http.StatusOK, `{"var": {"DATABASE_URL": "postgres://db"}}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the environment variables and all warnings", func() {
+				envVars, warnings, err := client.GetRevisionEnvironmentVariables(revision)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(envVars).To(HaveLen(1))
+				Expect(*envVars["DATABASE_URL"]).To(Equal("postgres://db"))
+			})
+		})
+
+		Context("when the revision does not link to its environment variables", func() {
+			BeforeEach(func() {
+				revision.Links = nil
+			})
+
+			It("returns an EnvironmentVariablesLinkNotFoundError", func() {
+				_, _, err := client.GetRevisionEnvironmentVariables(revision)
+				Expect(err).To(MatchError(ccerror.EnvironmentVariablesLinkNotFoundError{RevisionGUID: "some-revision-guid"}))
+			})
+		})
+	})
+})
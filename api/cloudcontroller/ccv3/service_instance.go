@@ -0,0 +1,139 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"path"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ServiceInstanceType is whether a service instance is managed by a service
+// broker or provided by a user.
+type ServiceInstanceType string
+
+const (
+	ManagedServiceInstance      ServiceInstanceType = "managed"
+	UserProvidedServiceInstance ServiceInstanceType = "user-provided"
+)
+
+// ServiceInstance represents a Cloud Controller V3 Service Instance.
+type ServiceInstance struct {
+	GUID            string
+	Name            string
+	Type            ServiceInstanceType
+	SpaceGUID       string
+	ServicePlanGUID string
+	// MaintenanceInfo is the version of the plan the instance was last
+	// provisioned or upgraded to.
+	MaintenanceInfo MaintenanceInfo
+	// UpgradeAvailable is true when the plan of the instance advertises a
+	// newer version than MaintenanceInfo.
+	UpgradeAvailable bool
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
+func (instance *ServiceInstance) UnmarshalJSON(data []byte) error {
+	var ccServiceInstance struct {
+		GUID             string              `json:"guid"`
+		Name             string              `json:"name"`
+		Type             ServiceInstanceType `json:"type"`
+		MaintenanceInfo  MaintenanceInfo     `json:"maintenance_info"`
+		UpgradeAvailable bool                `json:"upgrade_available"`
+		Relationships    struct {
+			Space       Relationship `json:"space"`
+			ServicePlan Relationship `json:"service_plan"`
+		} `json:"relationships"`
+	}
+
+	err := json.Unmarshal(data, &ccServiceInstance)
+	if err != nil {
+		return err
+	}
+
+	instance.GUID = ccServiceInstance.GUID
+	instance.Name = ccServiceInstance.Name
+	instance.Type = ccServiceInstance.Type
+	instance.SpaceGUID = ccServiceInstance.Relationships.Space.GUID
+	instance.ServicePlanGUID = ccServiceInstance.Relationships.ServicePlan.GUID
+	instance.MaintenanceInfo = ccServiceInstance.MaintenanceInfo
+	instance.UpgradeAvailable = ccServiceInstance.UpgradeAvailable
+
+	return nil
+}
+
+// GetServiceInstances lists service instances with optional filters.
+func (client *Client) GetServiceInstances(query url.Values) ([]ServiceInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstancesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServiceInstanceList []ServiceInstance
+	warnings, err := client.paginate(request, ServiceInstance{}, func(item interface{}) error {
+		if instance, ok := item.(ServiceInstance); ok {
+			fullServiceInstanceList = append(fullServiceInstanceList, instance)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceInstance{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServiceInstanceList, warnings, err
+}
+
+// UpdateServiceInstanceMaintenanceInfo asks the service broker to upgrade the
+// service instance to the provided version. The broker performs the upgrade
+// asynchronously; the returned job tracks its progress. An empty job is
+// returned when the Cloud Controller does not report one.
+func (client *Client) UpdateServiceInstanceMaintenanceInfo(guid string, maintenanceInfo MaintenanceInfo) (Job, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		MaintenanceInfo MaintenanceInfo `json:"maintenance_info"`
+	}{
+		MaintenanceInfo: MaintenanceInfo{Version: maintenanceInfo.Version},
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchServiceInstanceRequest,
+		URIParams:   internal.Params{"guid": guid},
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return Job{}, response.Warnings, err
+	}
+
+	return jobFromLocation(response), response.Warnings, nil
+}
+
+// jobFromLocation returns the job referenced by the Location header of an
+// asynchronous operation's response.
+func jobFromLocation(response cloudcontroller.Response) Job {
+	if response.HTTPResponse == nil {
+		return Job{}
+	}
+
+	location, err := url.Parse(response.HTTPResponse.Header.Get("Location"))
+	if err != nil || location.Path == "" {
+		return Job{}
+	}
+
+	return Job{GUID: path.Base(location.Path)}
+}
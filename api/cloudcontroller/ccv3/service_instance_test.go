@@ -0,0 +1,179 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Instance", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServiceInstances", func() {
+		Context("when there are service instances", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/service_instances?space_guids=some-space-guid&page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "service-instance-guid-1",
+			"name": "service-instance-1",
+			"type": "managed",
+			"maintenance_info": {"version": "1.0.0"},
+			"upgrade_available": true,
+			"relationships": {
+				"space": {"data": {"guid": "some-space-guid"}},
+				"service_plan": {"data": {"guid": "some-plan-guid"}}
+			}
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "service-instance-guid-2",
+			"name": "service-instance-2",
+			"type": "user-provided",
+			"relationships": {
+				"space": {"data": {"guid": "some-space-guid"}}
+			}
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_instances", "space_guids=some-space-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_instances", "space_guids=some-space-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns the service instances and all warnings", func() {
+				instances, warnings, err := client.GetServiceInstances(url.Values{
+					SpaceGUIDFilter: []string{"some-space-guid"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+				Expect(instances).To(Equal([]ServiceInstance{
+					{
+						GUID:             "service-instance-guid-1",
+						Name:             "service-instance-1",
+						Type:             ManagedServiceInstance,
+						SpaceGUID:        "some-space-guid",
+						ServicePlanGUID:  "some-plan-guid",
+						MaintenanceInfo:  MaintenanceInfo{Version: "1.0.0"},
+						UpgradeAvailable: true,
+					},
+					{
+						GUID:      "service-instance-guid-2",
+						Name:      "service-instance-2",
+						Type:      UserProvidedServiceInstance,
+						SpaceGUID: "some-space-guid",
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The query parameter is invalid",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_instances"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetServiceInstances(nil)
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "The query parameter is invalid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateServiceInstanceMaintenanceInfo", func() {
+		Context("when the upgrade is accepted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/service_instances/some-service-instance-guid"),
+						VerifyJSON(`{"maintenance_info": {"version": "2.0.0"}}`),
+						RespondWith(http.StatusAccepted, "", http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+							"Location":      {server.URL() + "/v3/jobs/some-job-guid"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job tracking the upgrade and all warnings", func() {
+				job, warnings, err := client.UpdateServiceInstanceMaintenanceInfo("some-service-instance-guid", MaintenanceInfo{
+					Version:     "2.0.0",
+					Description: "OS image upgrade",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(job).To(Equal(Job{GUID: "some-job-guid"}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "maintenance_info.version requested is invalid",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/service_instances/some-service-instance-guid"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateServiceInstanceMaintenanceInfo("some-service-instance-guid", MaintenanceInfo{Version: "2.0.0"})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "maintenance_info.version requested is invalid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package ccv3
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// MaintenanceInfo is the version information a service broker advertises for
+// a Service Plan, and the version a Service Instance is running.
+type MaintenanceInfo struct {
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// ServicePlan represents a Cloud Controller V3 Service Plan.
+type ServicePlan struct {
+	GUID string
+	Name string
+	// MaintenanceInfo is the latest version of the plan. It is empty when the
+	// service broker does not support upgrading instances.
+	MaintenanceInfo MaintenanceInfo
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Plan response.
+func (plan *ServicePlan) UnmarshalJSON(data []byte) error {
+	var ccServicePlan struct {
+		GUID            string          `json:"guid"`
+		Name            string          `json:"name"`
+		MaintenanceInfo MaintenanceInfo `json:"maintenance_info"`
+	}
+
+	err := json.Unmarshal(data, &ccServicePlan)
+	if err != nil {
+		return err
+	}
+
+	plan.GUID = ccServicePlan.GUID
+	plan.Name = ccServicePlan.Name
+	plan.MaintenanceInfo = ccServicePlan.MaintenanceInfo
+
+	return nil
+}
+
+// GetServicePlan returns the service plan with the given GUID.
+func (client *Client) GetServicePlan(guid string) (ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlanRequest,
+		URIParams:   internal.Params{"guid": guid},
+	})
+	if err != nil {
+		return ServicePlan{}, nil, err
+	}
+
+	var responsePlan ServicePlan
+	response := cloudcontroller.Response{
+		Result: &responsePlan,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responsePlan, response.Warnings, err
+}
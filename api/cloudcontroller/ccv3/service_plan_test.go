@@ -0,0 +1,80 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServicePlan", func() {
+		Context("when the service plan exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-plan-guid",
+					"name": "some-plan",
+					"maintenance_info": {
+						"version": "2.0.0",
+						"description": "OS image upgrade"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_plans/some-plan-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service plan and all warnings", func() {
+				plan, warnings, err := client.GetServicePlan("some-plan-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(plan).To(Equal(ServicePlan{
+					GUID: "some-plan-guid",
+					Name: "some-plan",
+					MaintenanceInfo: MaintenanceInfo{
+						Version:     "2.0.0",
+						Description: "OS image upgrade",
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Service plan not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_plans/some-plan-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetServicePlan("some-plan-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Service plan not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
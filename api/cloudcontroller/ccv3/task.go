@@ -10,6 +10,11 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
+const (
+	TaskStateSucceeded = "SUCCEEDED"
+	TaskStateFailed    = "FAILED"
+)
+
 // Task represents a Cloud Controller V3 Task.
 type Task struct {
 	GUID       string `json:"guid,omitempty"`
@@ -20,6 +25,15 @@ type Task struct {
 	CreatedAt  string `json:"created_at,omitempty"`
 	MemoryInMB uint64 `json:"memory_in_mb,omitempty"`
 	DiskInMB   uint64 `json:"disk_in_mb,omitempty"`
+	// DropletGUID runs the task from the given droplet instead of the
+	// application's current droplet.
+	DropletGUID string      `json:"droplet_guid,omitempty"`
+	Result      *TaskResult `json:"result,omitempty"`
+}
+
+// TaskResult is the outcome of a finished task.
+type TaskResult struct {
+	FailureReason string `json:"failure_reason"`
 }
 
 // CreateApplicationTask runs a command in the Application environment
@@ -81,6 +95,25 @@ func (client *Client) GetApplicationTasks(appGUID string, query url.Values) ([]T
 }
 
 // UpdateTask cancels a task.
+// GetTask returns the task with the given GUID.
+func (client *Client) GetTask(guid string) (Task, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetTaskRequest,
+		URIParams:   internal.Params{"guid": guid},
+	})
+	if err != nil {
+		return Task{}, nil, err
+	}
+
+	var task Task
+	response := cloudcontroller.Response{
+		Result: &task,
+	}
+	err = client.connection.Make(request, &response)
+
+	return task, response.Warnings, err
+}
+
 func (client *Client) UpdateTask(taskGUID string) (Task, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.PutTaskCancelRequest,
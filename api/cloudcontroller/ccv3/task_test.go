@@ -331,6 +331,67 @@ var _ = Describe("Task", func() {
 		})
 	})
 
+	Describe("GetTask", func() {
+		Context("when the task exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-task-guid",
+					"sequence_id": 3,
+					"command": "some-command",
+					"state": "FAILED",
+					"droplet_guid": "some-droplet-guid",
+					"result": {"failure_reason": "Exited with status 1"}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks/some-task-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the task and warnings", func() {
+				task, warnings, err := client.GetTask("some-task-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+				Expect(task).To(Equal(Task{
+					GUID:        "some-task-guid",
+					SequenceID:  3,
+					Command:     "some-command",
+					State:       TaskStateFailed,
+					DropletGUID: "some-droplet-guid",
+					Result:      &TaskResult{FailureReason: "Exited with status 1"},
+				}))
+			})
+		})
+
+		Context("when the task does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Task not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks/some-task-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetTask("some-task-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Task not found"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("UpdateTask", func() {
 		Context("when the request succeeds", func() {
 			BeforeEach(func() {
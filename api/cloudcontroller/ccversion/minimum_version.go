@@ -0,0 +1,16 @@
+// Package ccversion contains the minimum Cloud Controller API versions
+// required by commands and flags that depend on newer Cloud Controller
+// features.
+package ccversion
+
+const (
+	MinVersionHTTPEndpointHealthCheckV2    = "2.68.0"
+	MinVersionSpaceStagingSecurityGroupsV2 = "2.74.0"
+
+	MinVersionV3                 = "3.0.0"
+	MinVersionIsolationSegmentV3 = "3.11.0"
+	MinVersionApplyManifestV3    = "3.27.0"
+	MinVersionManifestDiffV3     = "3.76.0"
+
+	MinVersionTransferRouteOwnershipV3 = "3.117.0"
+)
@@ -56,6 +56,10 @@ func (connection *CloudControllerConnection) Make(request *http.Request, passedR
 
 	response, err := connection.HTTPClient.Do(request)
 	if err != nil {
+		// A cancelled request is not a connection problem.
+		if ctxErr := request.Context().Err(); ctxErr != nil {
+			return ctxErr
+		}
 		return connection.processRequestErrors(request, err)
 	}
 
@@ -0,0 +1,164 @@
+package wrapper
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// rateLimitInitialBackoff is the wait before the first retry of a rate limited
+// request that does not provide a Retry-After header. It doubles with every
+// retry.
+const rateLimitInitialBackoff = time.Second
+
+// RateLimit is a wrapper that paces requests when the Cloud Controller reports
+// that the rate limit has been used up, and retries requests that were
+// rejected with a 429 status code.
+type RateLimit struct {
+	maxRetries int
+	maxWait    time.Duration
+	connection cloudcontroller.Connection
+
+	resetLock sync.Mutex
+	resetAt   time.Time
+}
+
+// NewRateLimit returns a pointer to a RateLimit wrapper. No single wait is
+// longer than maxWait.
+func NewRateLimit(maxRetries int, maxWait time.Duration) *RateLimit {
+	return &RateLimit{
+		maxRetries: maxRetries,
+		maxWait:    maxWait,
+	}
+}
+
+// Wrap sets the connection in the RateLimit and returns itself.
+func (limit *RateLimit) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	limit.connection = innerconnection
+	return limit
+}
+
+// Make waits for the rate limit to reset if a previous response reported no
+// remaining requests, then makes the request. Requests that come back with a
+// 429 status code are retried after the Retry-After duration, or an
+// exponential backoff when it is not provided. A warning is added to the
+// response for every wait.
+func (limit *RateLimit) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
+	var err error
+	var rawRequestBody []byte
+
+	if request.Body != nil {
+		rawRequestBody, err = ioutil.ReadAll(request.Body)
+		defer request.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	var warnings []string
+	if wait := limit.waitForReset(); wait > 0 {
+		warnings = append(warnings, fmt.Sprintf("Cloud Controller rate limit reached, waiting %s before sending more requests...", wait))
+		err = sleepUntilDone(request, wait)
+		if err != nil {
+			return err
+		}
+	}
+
+	backoff := rateLimitInitialBackoff
+	for i := 0; ; i++ {
+		if rawRequestBody != nil {
+			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+		}
+		err = limit.connection.Make(request, passedResponse)
+		limit.recordRemaining(passedResponse.HTTPResponse)
+
+		if i >= limit.maxRetries ||
+			passedResponse.HTTPResponse == nil ||
+			passedResponse.HTTPResponse.StatusCode != http.StatusTooManyRequests {
+			break
+		}
+
+		wait := limit.capWait(backoff)
+		if retryAfter, ok := parseRetryAfter(passedResponse.HTTPResponse); ok {
+			wait = limit.capWait(retryAfter)
+		}
+		backoff *= 2
+
+		warnings = append(warnings, fmt.Sprintf("Cloud Controller rate limit reached, retrying in %s...", wait))
+		if sleepErr := sleepUntilDone(request, wait); sleepErr != nil {
+			return sleepErr
+		}
+	}
+
+	if len(warnings) > 0 {
+		passedResponse.Warnings = append(warnings, passedResponse.Warnings...)
+	}
+	return err
+}
+
+// recordRemaining remembers when the rate limit resets if the response
+// reports that no requests remain.
+func (limit *RateLimit) recordRemaining(response *http.Response) {
+	if response == nil || response.Header.Get("X-RateLimit-Remaining") != "0" {
+		return
+	}
+
+	reset, err := strconv.ParseInt(response.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	limit.resetLock.Lock()
+	defer limit.resetLock.Unlock()
+	limit.resetAt = time.Unix(reset, 0)
+}
+
+// waitForReset returns how long to wait before the rate limit resets, and
+// clears the recorded reset time.
+func (limit *RateLimit) waitForReset() time.Duration {
+	limit.resetLock.Lock()
+	defer limit.resetLock.Unlock()
+
+	if limit.resetAt.IsZero() {
+		return 0
+	}
+
+	wait := time.Until(limit.resetAt)
+	limit.resetAt = time.Time{}
+	if wait <= 0 {
+		return 0
+	}
+	return limit.capWait(wait.Round(time.Second))
+}
+
+func (limit *RateLimit) capWait(wait time.Duration) time.Duration {
+	if wait > limit.maxWait {
+		return limit.maxWait
+	}
+	return wait
+}
+
+// sleepUntilDone waits for the duration, or returns the error of the request
+// context when it is done first.
+func sleepUntilDone(request *http.Request, wait time.Duration) error {
+	select {
+	case <-request.Context().Done():
+		return request.Context().Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+func parseRetryAfter(response *http.Response) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(response.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
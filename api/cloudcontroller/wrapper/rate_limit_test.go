@@ -0,0 +1,139 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rate Limit", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		wrapper        cloudcontroller.Connection
+		request        *http.Request
+		response       *cloudcontroller.Response
+		rawRequestBody string
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		wrapper = NewRateLimit(2, time.Millisecond).Wrap(fakeConnection)
+
+		var err error
+		rawRequestBody = "banana pants"
+		request, err = http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", ioutil.NopCloser(strings.NewReader(rawRequestBody)))
+		Expect(err).NotTo(HaveOccurred())
+
+		response = &cloudcontroller.Response{}
+	})
+
+	respondWith := func(statusCode int, header http.Header) func(*http.Request, *cloudcontroller.Response) error {
+		return func(req *http.Request, passedResponse *cloudcontroller.Response) error {
+			defer req.Body.Close()
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal(rawRequestBody))
+
+			passedResponse.Warnings = []string{"some-cc-warning"}
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: statusCode,
+				Header:     header,
+			}
+			if statusCode >= 400 {
+				return ccerror.RawHTTPStatusError{StatusCode: statusCode}
+			}
+			return nil
+		}
+	}
+
+	Context("when the request is not rate limited", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = respondWith(http.StatusOK, http.Header{})
+		})
+
+		It("makes the request once without adding warnings", func() {
+			err := wrapper.Make(request, response)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			Expect(response.Warnings).To(ConsistOf("some-cc-warning"))
+		})
+	})
+
+	Context("when the request is rejected with a 429", func() {
+		Context("when a later attempt succeeds", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = func(req *http.Request, passedResponse *cloudcontroller.Response) error {
+					if fakeConnection.MakeCallCount() == 1 {
+						return respondWith(http.StatusTooManyRequests, http.Header{"Retry-After": {"30"}})(req, passedResponse)
+					}
+					return respondWith(http.StatusOK, http.Header{})(req, passedResponse)
+				}
+			})
+
+			It("retries the request and warns about the wait", func() {
+				err := wrapper.Make(request, response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(response.Warnings).To(Equal([]string{
+					"Cloud Controller rate limit reached, retrying in 1ms...",
+					"some-cc-warning",
+				}))
+			})
+		})
+
+		Context("when every attempt is rate limited", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = respondWith(http.StatusTooManyRequests, http.Header{})
+			})
+
+			It("gives up after maxRetries and returns the error", func() {
+				err := wrapper.Make(request, response)
+				Expect(err).To(MatchError(ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+				Expect(response.Warnings).To(HaveLen(3))
+			})
+		})
+	})
+
+	Context("when a response reports that no requests remain", func() {
+		BeforeEach(func() {
+			reset := strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *cloudcontroller.Response) error {
+				if fakeConnection.MakeCallCount() == 1 {
+					return respondWith(http.StatusOK, http.Header{
+						"X-Ratelimit-Remaining": {"0"},
+						"X-Ratelimit-Reset":     {reset},
+					})(req, passedResponse)
+				}
+				return respondWith(http.StatusOK, http.Header{})(req, passedResponse)
+			}
+		})
+
+		It("waits before sending the next request", func() {
+			err := wrapper.Make(request, response)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Warnings).To(ConsistOf("some-cc-warning"))
+
+			nextRequest, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", ioutil.NopCloser(strings.NewReader(rawRequestBody)))
+			Expect(err).NotTo(HaveOccurred())
+			nextResponse := &cloudcontroller.Response{}
+
+			err = wrapper.Make(nextRequest, nextResponse)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			Expect(nextResponse.Warnings).To(Equal([]string{
+				"Cloud Controller rate limit reached, waiting 1ms before sending more requests...",
+				"some-cc-warning",
+			}))
+		})
+	})
+})
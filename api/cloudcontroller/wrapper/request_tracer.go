@@ -0,0 +1,45 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/util/tracing"
+)
+
+// RequestTracer is the wrapper that records a tracing span for every request
+// made to the Cloud Controller server
+type RequestTracer struct {
+	connection cloudcontroller.Connection
+}
+
+// NewRequestTracer returns a pointer to a RequestTracer wrapper
+func NewRequestTracer() *RequestTracer {
+	return &RequestTracer{}
+}
+
+// Wrap sets the connection on the RequestTracer and returns itself
+func (tracer *RequestTracer) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	tracer.connection = innerconnection
+	return tracer
+}
+
+// Make records a span covering the request, including the status code of the
+// response and any error
+func (tracer *RequestTracer) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
+	span := tracing.StartClientSpan("HTTP " + request.Method)
+	defer span.End()
+
+	span.SetAttribute("peer.service", "cloud_controller")
+	span.SetAttribute("http.method", request.Method)
+	span.SetAttribute("http.url", request.URL.Scheme+"://"+request.URL.Host+request.URL.Path)
+
+	err := tracer.connection.Make(request, passedResponse)
+
+	if passedResponse.HTTPResponse != nil {
+		span.SetAttribute("http.status_code", passedResponse.HTTPResponse.StatusCode)
+	}
+	span.SetError(err)
+
+	return err
+}
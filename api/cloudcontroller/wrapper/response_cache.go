@@ -0,0 +1,154 @@
+package wrapper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// cachedPaths are the discovery documents of the Cloud Controller. They only
+// change when the Cloud Controller is upgraded.
+var cachedPaths = map[string]bool{
+	"":         true,
+	"/":        true,
+	"/v2/info": true,
+	"/v3":      true,
+	"/v3/":     true,
+}
+
+// cachedResponse is a discovery document stored in the cache directory.
+type cachedResponse struct {
+	URL      string    `json:"url"`
+	ETag     string    `json:"etag,omitempty"`
+	StoredAt time.Time `json:"stored_at"`
+	Body     []byte    `json:"body"`
+}
+
+// ResponseCache is a wrapper that stores the Cloud Controller discovery
+// documents (/, /v2/info and /v3) in a directory, so that every command does
+// not have to request them again. Stored documents are used without a request
+// until they are older than the TTL, after which they are revalidated with
+// their ETag.
+type ResponseCache struct {
+	dir        string
+	ttl        time.Duration
+	connection cloudcontroller.Connection
+}
+
+// NewResponseCache returns a pointer to a ResponseCache wrapper that stores
+// the documents in dir. An empty dir disables the cache.
+func NewResponseCache(dir string, ttl time.Duration) *ResponseCache {
+	return &ResponseCache{
+		dir: dir,
+		ttl: ttl,
+	}
+}
+
+// Wrap sets the connection in the ResponseCache and returns itself.
+func (cache *ResponseCache) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	cache.connection = innerconnection
+	return cache
+}
+
+// Make returns the stored document when it is fresh. Otherwise it makes the
+// request, with If-None-Match when a stale document is stored, and stores the
+// response. Failing to read or write the cache directory does not fail the
+// request.
+func (cache *ResponseCache) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
+	if cache.dir == "" || request.Method != http.MethodGet || !cachedPaths[request.URL.Path] {
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	url := request.URL.String()
+	stored, found := cache.load(url)
+	if found && time.Since(stored.StoredAt) < cache.ttl {
+		return populateFromBody(stored.Body, &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader(stored.Body)),
+		}, nil, passedResponse)
+	}
+
+	if found && stored.ETag != "" {
+		request.Header.Set("If-None-Match", stored.ETag)
+	}
+
+	// The result is decoded here since the body of a 304 response is empty.
+	response := cloudcontroller.Response{}
+	err := cache.connection.Make(request, &response)
+	if err != nil {
+		passedResponse.RawResponse = response.RawResponse
+		passedResponse.Warnings = response.Warnings
+		passedResponse.HTTPResponse = response.HTTPResponse
+		return err
+	}
+
+	switch {
+	case found && response.HTTPResponse.StatusCode == http.StatusNotModified:
+		stored.StoredAt = time.Now()
+		cache.store(stored)
+		return populateFromBody(stored.Body, response.HTTPResponse, response.Warnings, passedResponse)
+	case response.HTTPResponse.StatusCode == http.StatusOK:
+		cache.store(cachedResponse{
+			URL:      url,
+			ETag:     response.HTTPResponse.Header.Get("ETag"),
+			StoredAt: time.Now(),
+			Body:     response.RawResponse,
+		})
+	}
+	return populateFromBody(response.RawResponse, response.HTTPResponse, response.Warnings, passedResponse)
+}
+
+// populateFromBody fills in the response the same way that the Cloud
+// Controller connection does.
+func populateFromBody(body []byte, httpResponse *http.Response, warnings []string, passedResponse *cloudcontroller.Response) error {
+	passedResponse.RawResponse = body
+	passedResponse.Warnings = warnings
+	passedResponse.HTTPResponse = httpResponse
+
+	if passedResponse.Result != nil {
+		decoder := json.NewDecoder(bytes.NewBuffer(body))
+		decoder.UseNumber()
+		return decoder.Decode(passedResponse.Result)
+	}
+	return nil
+}
+
+func (cache *ResponseCache) load(url string) (cachedResponse, bool) {
+	contents, err := ioutil.ReadFile(cache.path(url))
+	if err != nil {
+		return cachedResponse{}, false
+	}
+
+	var stored cachedResponse
+	if json.Unmarshal(contents, &stored) != nil || stored.URL != url {
+		return cachedResponse{}, false
+	}
+	return stored, true
+}
+
+func (cache *ResponseCache) store(stored cachedResponse) {
+	contents, err := json.Marshal(stored)
+	if err != nil {
+		return
+	}
+	if os.MkdirAll(cache.dir, 0700) != nil {
+		return
+	}
+	_ = ioutil.WriteFile(cache.path(stored.URL), contents, 0600)
+}
+
+// path returns the file of the URL. The name is hashed because URLs contain
+// characters that are not allowed in file names.
+func (cache *ResponseCache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(cache.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,152 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Response Cache", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		cacheDir       string
+		ttl            time.Duration
+		wrapper        cloudcontroller.Connection
+		requestURL     string
+		info           map[string]interface{}
+	)
+
+	makeRequest := func() (*cloudcontroller.Response, error) {
+		request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		info = map[string]interface{}{}
+		response := &cloudcontroller.Response{Result: &info}
+		return response, wrapper.Make(request, response)
+	}
+
+	respondWith := func(statusCode int, body string) func(*http.Request, *cloudcontroller.Response) error {
+		return func(_ *http.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.RawResponse = []byte(body)
+			passedResponse.Warnings = []string{"some-warning"}
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: statusCode,
+				Header:     http.Header{"Etag": {`"some-etag"`}},
+			}
+			return nil
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "response-cache-test")
+		Expect(err).NotTo(HaveOccurred())
+		cacheDir = filepath.Join(cacheDir, "cache")
+
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = respondWith(http.StatusOK, `{"api_version": "2.100.0"}`)
+		ttl = time.Hour
+		requestURL = "https://api.example.com/v2/info"
+	})
+
+	JustBeforeEach(func() {
+		wrapper = NewResponseCache(cacheDir, ttl).Wrap(fakeConnection)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(filepath.Dir(cacheDir))
+	})
+
+	It("uses the stored document while it is fresh", func() {
+		response, err := makeRequest()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info).To(HaveKeyWithValue("api_version", "2.100.0"))
+		Expect(response.Warnings).To(ConsistOf("some-warning"))
+
+		response, err = makeRequest()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info).To(HaveKeyWithValue("api_version", "2.100.0"))
+		Expect(response.Warnings).To(BeEmpty())
+		Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+
+	Context("when the stored document is stale", func() {
+		BeforeEach(func() {
+			ttl = 0
+		})
+
+		It("revalidates it with its ETag", func() {
+			_, err := makeRequest()
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeConnection.MakeStub = respondWith(http.StatusNotModified, "")
+			_, err = makeRequest()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info).To(HaveKeyWithValue("api_version", "2.100.0"))
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			request, _ := fakeConnection.MakeArgsForCall(1)
+			Expect(request.Header.Get("If-None-Match")).To(Equal(`"some-etag"`))
+		})
+
+		It("replaces it when it has changed", func() {
+			_, err := makeRequest()
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeConnection.MakeStub = respondWith(http.StatusOK, `{"api_version": "2.101.0"}`)
+			_, err = makeRequest()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info).To(HaveKeyWithValue("api_version", "2.101.0"))
+		})
+	})
+
+	Context("when the request fails", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(ccerror.RequestError{})
+		})
+
+		It("returns the error and stores nothing", func() {
+			_, err := makeRequest()
+			Expect(err).To(MatchError(ccerror.RequestError{}))
+			Expect(cacheDir).NotTo(BeADirectory())
+		})
+	})
+
+	Context("when the request is not for a discovery document", func() {
+		BeforeEach(func() {
+			requestURL = "https://api.example.com/v2/apps"
+		})
+
+		It("does not cache it", func() {
+			_, err := makeRequest()
+			Expect(err).NotTo(HaveOccurred())
+			_, err = makeRequest()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when no directory is provided", func() {
+		BeforeEach(func() {
+			cacheDir = ""
+		})
+
+		It("does not cache anything", func() {
+			_, err := makeRequest()
+			Expect(err).NotTo(HaveOccurred())
+			_, err = makeRequest()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+	})
+})
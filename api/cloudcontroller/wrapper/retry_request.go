@@ -51,6 +51,7 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *cloudcont
 		}
 
 		if request.Method == http.MethodPost ||
+			request.Context().Err() != nil ||
 			passedResponse.HTTPResponse != nil &&
 				passedResponse.HTTPResponse.StatusCode != http.StatusInternalServerError &&
 				passedResponse.HTTPResponse.StatusCode != http.StatusBadGateway &&
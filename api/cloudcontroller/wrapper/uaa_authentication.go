@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -22,11 +23,17 @@ type UAAClient interface {
 // TokenCache is where the UAA token information is stored.
 type TokenCache interface {
 	AccessToken() string
+	AccessTokenExpiresAt() time.Time
 	RefreshToken() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
 }
 
+// accessTokenRefreshThreshold is how close to its expiry the access token is
+// refreshed before a request is made. Refreshing ahead of time prevents long
+// requests, such as uploads, from being rejected part way through.
+const accessTokenRefreshThreshold = 30 * time.Second
+
 // UAAAuthentication wraps connections and adds authentication headers to all
 // requests
 type UAAAuthentication struct {
@@ -56,8 +63,9 @@ func (t *UAAAuthentication) SetClient(client UAAClient) {
 }
 
 // Make adds authentication headers to the passed in request and then calls the
-// wrapped connection's Make. If the client is not set on the wrapper, it will
-// not add any header or handle any authentication errors.
+// wrapped connection's Make. The access token is refreshed first when it
+// expires within accessTokenRefreshThreshold. If the client is not set on the
+// wrapper, it will not add any header or handle any authentication errors.
 func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
 	if t.client == nil {
 		return t.connection.Make(request, passedResponse)
@@ -77,19 +85,22 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcon
 		request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 	}
 
+	if t.accessTokenExpiresSoon() {
+		err = t.refreshAccessToken()
+		if err != nil {
+			return err
+		}
+	}
+
 	request.Header.Set("Authorization", t.cache.AccessToken())
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(ccerror.InvalidAuthTokenError); ok {
-		var token uaa.RefreshToken
-		token, err = t.client.RefreshAccessToken(t.cache.RefreshToken())
+		err = t.refreshAccessToken()
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(token.AuthorizationToken())
-		t.cache.SetRefreshToken(token.RefreshToken)
-
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
@@ -99,3 +110,25 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcon
 
 	return err
 }
+
+// accessTokenExpiresSoon returns true when the cached access token has a
+// known expiry that is less than accessTokenRefreshThreshold away.
+func (t *UAAAuthentication) accessTokenExpiresSoon() bool {
+	if t.cache.RefreshToken() == "" {
+		return false
+	}
+
+	expiresAt := t.cache.AccessTokenExpiresAt()
+	return !expiresAt.IsZero() && time.Until(expiresAt) < accessTokenRefreshThreshold
+}
+
+func (t *UAAAuthentication) refreshAccessToken() error {
+	token, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return err
+	}
+
+	t.cache.SetAccessToken(token.AuthorizationToken())
+	t.cache.SetRefreshToken(token.RefreshToken)
+	return nil
+}
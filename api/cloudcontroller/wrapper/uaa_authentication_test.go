@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -152,5 +153,69 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
 		})
+
+		Context("when the access token is about to expire", func() {
+			var expectedBody string
+
+			BeforeEach(func() {
+				expectedBody = "this body content should be preserved"
+				request.Body = ioutil.NopCloser(strings.NewReader(expectedBody))
+
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+				inMemoryCache.SetAccessTokenExpiresAt(time.Now().Add(10 * time.Second))
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshToken{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token before making the request", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+				body, err := ioutil.ReadAll(authenticatedRequest.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(body)).To(Equal(expectedBody))
+			})
+
+			Context("when refreshing the token fails", func() {
+				It("returns the error without making the request", func() {
+					expectedErr := errors.New("refresh failed")
+					fakeClient.RefreshAccessTokenReturns(uaa.RefreshToken{}, expectedErr)
+
+					err := wrapper.Make(request, nil)
+					Expect(err).To(MatchError(expectedErr))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the access token is not about to expire", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+				inMemoryCache.SetAccessTokenExpiresAt(time.Now().Add(time.Hour))
+			})
+
+			It("does not refresh the token", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("a-ok"))
+			})
+		})
 	})
 })
@@ -1,20 +1,32 @@
 package util
 
+import "time"
+
 type InMemoryCache struct {
-	accessToken  string
-	refreshToken string
+	accessToken          string
+	accessTokenExpiresAt time.Time
+	refreshToken         string
 }
 
 func (c InMemoryCache) AccessToken() string {
 	return c.accessToken
 }
 
+func (c InMemoryCache) AccessTokenExpiresAt() time.Time {
+	return c.accessTokenExpiresAt
+}
+
 func (c InMemoryCache) RefreshToken() string {
 	return c.refreshToken
 }
 
 func (c *InMemoryCache) SetAccessToken(token string) {
 	c.accessToken = token
+	c.accessTokenExpiresAt = time.Time{}
+}
+
+func (c *InMemoryCache) SetAccessTokenExpiresAt(expiresAt time.Time) {
+	c.accessTokenExpiresAt = expiresAt
 }
 
 func (c *InMemoryCache) SetRefreshToken(token string) {
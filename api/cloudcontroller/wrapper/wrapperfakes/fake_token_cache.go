@@ -3,6 +3,7 @@ package wrapperfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 )
@@ -17,6 +18,15 @@ type FakeTokenCache struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AccessTokenExpiresAtStub        func() time.Time
+	accessTokenExpiresAtMutex       sync.RWMutex
+	accessTokenExpiresAtArgsForCall []struct{}
+	accessTokenExpiresAtReturns     struct {
+		result1 time.Time
+	}
+	accessTokenExpiresAtReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -80,6 +90,46 @@ func (fake *FakeTokenCache) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTokenCache) AccessTokenExpiresAt() time.Time {
+	fake.accessTokenExpiresAtMutex.Lock()
+	ret, specificReturn := fake.accessTokenExpiresAtReturnsOnCall[len(fake.accessTokenExpiresAtArgsForCall)]
+	fake.accessTokenExpiresAtArgsForCall = append(fake.accessTokenExpiresAtArgsForCall, struct{}{})
+	fake.recordInvocation("AccessTokenExpiresAt", []interface{}{})
+	fake.accessTokenExpiresAtMutex.Unlock()
+	if fake.AccessTokenExpiresAtStub != nil {
+		return fake.AccessTokenExpiresAtStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.accessTokenExpiresAtReturns.result1
+}
+
+func (fake *FakeTokenCache) AccessTokenExpiresAtCallCount() int {
+	fake.accessTokenExpiresAtMutex.RLock()
+	defer fake.accessTokenExpiresAtMutex.RUnlock()
+	return len(fake.accessTokenExpiresAtArgsForCall)
+}
+
+func (fake *FakeTokenCache) AccessTokenExpiresAtReturns(result1 time.Time) {
+	fake.AccessTokenExpiresAtStub = nil
+	fake.accessTokenExpiresAtReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeTokenCache) AccessTokenExpiresAtReturnsOnCall(i int, result1 time.Time) {
+	fake.AccessTokenExpiresAtStub = nil
+	if fake.accessTokenExpiresAtReturnsOnCall == nil {
+		fake.accessTokenExpiresAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.accessTokenExpiresAtReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeTokenCache) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -173,6 +223,8 @@ func (fake *FakeTokenCache) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
 	defer fake.accessTokenMutex.RUnlock()
+	fake.accessTokenExpiresAtMutex.RLock()
+	defer fake.accessTokenExpiresAtMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
@@ -0,0 +1,51 @@
+package credhub
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/credhub/credhuberror"
+	"code.cloudfoundry.org/cli/api/httpclient"
+)
+
+// Credential is the current version of a credential stored in CredHub.
+type Credential struct {
+	Name string
+	Type string
+	// Value is the JSON value of the credential; a string for most types and
+	// an object for json credentials.
+	Value json.RawMessage
+}
+
+// GetCredentialByName returns the current version of the credential with the
+// given name, such as the credhub-ref of a service binding.
+func (client *Client) GetCredentialByName(name string) (Credential, error) {
+	request, err := client.newHTTPRequest(
+		http.MethodGet,
+		"/api/v1/data",
+		url.Values{"name": {name}, "current": {"true"}},
+		nil,
+	)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	var credentials struct {
+		Data []struct {
+			Name  string          `json:"name"`
+			Type  string          `json:"type"`
+			Value json.RawMessage `json:"value"`
+		} `json:"data"`
+	}
+	err = client.connection.Make(request, &httpclient.Response{Result: &credentials})
+	if err != nil {
+		return Credential{}, err
+	}
+
+	if len(credentials.Data) == 0 {
+		return Credential{}, credhuberror.CredentialNotFoundError{Message: name}
+	}
+
+	return Credential(credentials.Data[0]), nil
+}
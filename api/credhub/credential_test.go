@@ -0,0 +1,106 @@
+package credhub_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/credhub"
+	"code.cloudfoundry.org/cli/api/credhub/credhuberror"
+	"code.cloudfoundry.org/cli/api/httpclient/httperror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Credential", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetCredentialByName", func() {
+		Context("when the credential exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": [
+						{
+							"type": "json",
+							"version_created_at": "2017-06-01T10:00:00Z",
+							"id": "some-id",
+							"name": "/c/some-broker/some-service/some-binding/credentials",
+							"value": {"password": "some-password"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/data", "current=true&name=%2Fc%2Fsome-broker%2Fsome-service%2Fsome-binding%2Fcredentials"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the current version of the credential", func() {
+				credential, err := client.GetCredentialByName("/c/some-broker/some-service/some-binding/credentials")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credential).To(Equal(Credential{
+					Name:  "/c/some-broker/some-service/some-binding/credentials",
+					Type:  "json",
+					Value: json.RawMessage(`{"password": "some-password"}`),
+				}))
+			})
+		})
+
+		Context("when the user may not read the credential", func() {
+			BeforeEach(func() {
+				response := `{"error": "The request could not be completed because the credential does not exist or you do not have sufficient authorization."}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/data"),
+						RespondWith(http.StatusForbidden, response),
+					),
+				)
+			})
+
+			It("returns a CredentialNotFoundError", func() {
+				_, err := client.GetCredentialByName("/c/some-credential")
+				Expect(err).To(MatchError(credhuberror.CredentialNotFoundError{
+					Message: "The request could not be completed because the credential does not exist or you do not have sufficient authorization.",
+				}))
+			})
+		})
+
+		Context("when the token is rejected", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/data"),
+						RespondWith(http.StatusUnauthorized, `{"error": "invalid_token", "error_description": "Access token expired"}`),
+					),
+				)
+			})
+
+			It("returns an InvalidAuthTokenError", func() {
+				_, err := client.GetCredentialByName("/c/some-credential")
+				Expect(err).To(MatchError(httperror.InvalidAuthTokenError{Message: "Access token expired"}))
+			})
+		})
+
+		Context("when CredHub returns no versions", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/data"),
+						RespondWith(http.StatusOK, `{"data": []}`),
+					),
+				)
+			})
+
+			It("returns a CredentialNotFoundError", func() {
+				_, err := client.GetCredentialByName("/c/some-credential")
+				Expect(err).To(MatchError(credhuberror.CredentialNotFoundError{Message: "/c/some-credential"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package credhub
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/credhub/credhuberror"
+	"code.cloudfoundry.org/cli/api/httpclient"
+	"code.cloudfoundry.org/cli/api/httpclient/httperror"
+)
+
+// NewConnection returns a new connection to CredHub.
+func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *httpclient.JSONConnection {
+	return httpclient.NewConnection(skipSSLValidation, dialTimeout, handleStatusErrors)
+}
+
+func handleStatusErrors(statusCode int, rawResponse []byte) error {
+	var errorResponse struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	_ = json.Unmarshal(rawResponse, &errorResponse)
+
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return httperror.InvalidAuthTokenError{Message: errorResponse.ErrorDescription}
+	case http.StatusForbidden, http.StatusNotFound:
+		// CredHub does not tell apart a missing credential from one the user
+		// may not read.
+		return credhuberror.CredentialNotFoundError{Message: errorResponse.Error}
+	default:
+		return nil
+	}
+}
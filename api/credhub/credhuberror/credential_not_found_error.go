@@ -0,0 +1,11 @@
+package credhuberror
+
+// CredentialNotFoundError is returned when CredHub responds with a 403 or a
+// 404, because the credential does not exist or the user may not read it.
+type CredentialNotFoundError struct {
+	Message string
+}
+
+func (e CredentialNotFoundError) Error() string {
+	return e.Message
+}
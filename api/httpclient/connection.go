@@ -0,0 +1,13 @@
+// Package httpclient holds the connection and connection wrappers shared by
+// the clients of the JSON APIs that authenticate with a UAA access token, such
+// as CredHub, Log Cache and the App Autoscaler.
+package httpclient
+
+import "net/http"
+
+//go:generate counterfeiter . Connection
+
+// Connection creates and executes http requests
+type Connection interface {
+	Make(request *http.Request, passedResponse *Response) error
+}
@@ -0,0 +1,11 @@
+package httperror
+
+// InvalidAuthTokenError is returned when the server rejects the access token
+// with a 401.
+type InvalidAuthTokenError struct {
+	Message string
+}
+
+func (e InvalidAuthTokenError) Error() string {
+	return e.Message
+}
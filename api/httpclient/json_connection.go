@@ -0,0 +1,126 @@
+package httpclient
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/httpclient/httperror"
+)
+
+// StatusErrorHandler converts a response with a 4xx or 5xx status code into
+// the error of the API it came from. It returns nil for the status codes the
+// API gives no meaning to, which are reported as a RawHTTPStatusError.
+type StatusErrorHandler func(statusCode int, rawResponse []byte) error
+
+// JSONConnection represents a connection to an API that responds with JSON.
+type JSONConnection struct {
+	HTTPClient         *http.Client
+	handleStatusErrors StatusErrorHandler
+}
+
+// NewConnection returns a new JSONConnection that reports the error statuses
+// through handleStatusErrors.
+func NewConnection(skipSSLValidation bool, dialTimeout time.Duration, handleStatusErrors StatusErrorHandler) *JSONConnection {
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: skipSSLValidation,
+		},
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: 30 * time.Second,
+			Timeout:   dialTimeout,
+		}).DialContext,
+	}
+
+	return &JSONConnection{
+		HTTPClient:         &http.Client{Transport: tr},
+		handleStatusErrors: handleStatusErrors,
+	}
+}
+
+// Make performs the request and parses the response.
+func (connection *JSONConnection) Make(request *http.Request, passedResponse *Response) error {
+	// In case this function is called from a retry, passedResponse may already
+	// be populated with a previous response. We reset in case there's an HTTP
+	// error and we don't repopulate it in populateResponse.
+	passedResponse.reset()
+
+	response, err := connection.HTTPClient.Do(request)
+	if err != nil {
+		return connection.processRequestErrors(request, err)
+	}
+
+	return connection.populateResponse(response, passedResponse)
+}
+
+// processRequestError handles errors that occur while making the request.
+func (connection *JSONConnection) processRequestErrors(request *http.Request, err error) error {
+	switch e := err.(type) {
+	case *url.Error:
+		switch urlErr := e.Err.(type) {
+		case x509.UnknownAuthorityError:
+			return httperror.UnverifiedServerError{
+				URL: request.URL.String(),
+			}
+		case x509.HostnameError:
+			return httperror.SSLValidationHostnameError{
+				Message: urlErr.Error(),
+			}
+		default:
+			return httperror.RequestError{Err: e}
+		}
+	default:
+		return err
+	}
+}
+
+func (connection *JSONConnection) populateResponse(response *http.Response, passedResponse *Response) error {
+	passedResponse.HTTPResponse = response
+
+	rawBytes, err := ioutil.ReadAll(response.Body)
+	defer response.Body.Close()
+	if err != nil {
+		return err
+	}
+	passedResponse.RawResponse = rawBytes
+
+	err = connection.handleStatusCodes(response, passedResponse)
+	if err != nil {
+		return err
+	}
+
+	if passedResponse.Result != nil {
+		decoder := json.NewDecoder(bytes.NewBuffer(passedResponse.RawResponse))
+		decoder.UseNumber()
+		err = decoder.Decode(passedResponse.Result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (connection *JSONConnection) handleStatusCodes(response *http.Response, passedResponse *Response) error {
+	if response.StatusCode < 400 {
+		return nil
+	}
+
+	if connection.handleStatusErrors != nil {
+		if err := connection.handleStatusErrors(response.StatusCode, passedResponse.RawResponse); err != nil {
+			return err
+		}
+	}
+
+	return httperror.RawHTTPStatusError{
+		StatusCode:  response.StatusCode,
+		RawResponse: passedResponse.RawResponse,
+	}
+}
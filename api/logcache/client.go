@@ -0,0 +1,67 @@
+// Package logcache is a client for the Log Cache API, used to read more of an
+// application's recent logs than the Doppler recent log buffer holds.
+package logcache
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/httpclient"
+)
+
+// Client is a client that can be used to talk to Log Cache.
+type Client struct {
+	connection httpclient.Connection
+	userAgent  string
+	url        string
+}
+
+// Config allows the Client to be configured
+type Config struct {
+	// AppName is the name of the application/process using the client.
+	AppName string
+
+	// AppVersion is the version of the application/process using the client.
+	AppVersion string
+
+	// DialTimeout is the DNS lookup timeout for the client. If not set, it is
+	// infinite.
+	DialTimeout time.Duration
+
+	// SkipSSLValidation controls whether a client verifies the server's
+	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
+	// any certificate presented by the server and any host name in that
+	// certificate for *all* client requests going forward.
+	//
+	// In this mode, TLS is susceptible to man-in-the-middle attacks. This should
+	// be used only for testing.
+	SkipSSLValidation bool
+
+	// URL is the location of the Log Cache API.
+	URL string
+}
+
+// NewClient returns a new Log Cache Client.
+func NewClient(config Config) *Client {
+	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)",
+		config.AppName,
+		config.AppVersion,
+		runtime.Version(),
+		runtime.GOARCH,
+		runtime.GOOS,
+	)
+	client := Client{
+		userAgent:  userAgent,
+		url:        strings.TrimSuffix(config.URL, "/"),
+		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
+	}
+
+	return &client
+}
+
+// WrapConnection wraps the current Client connection in the wrapper.
+func (client *Client) WrapConnection(wrapper httpclient.ConnectionWrapper) {
+	client.connection = wrapper.Wrap(client.connection)
+}
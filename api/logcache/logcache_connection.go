@@ -0,0 +1,38 @@
+package logcache
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/httpclient"
+	"code.cloudfoundry.org/cli/api/httpclient/httperror"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+)
+
+// NewConnection returns a new connection to Log Cache.
+func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *httpclient.JSONConnection {
+	return httpclient.NewConnection(skipSSLValidation, dialTimeout, handleStatusErrors)
+}
+
+func handleStatusErrors(statusCode int, rawResponse []byte) error {
+	var errorResponse struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(rawResponse, &errorResponse)
+
+	message := errorResponse.Message
+	if message == "" {
+		message = errorResponse.Error
+	}
+
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return httperror.InvalidAuthTokenError{Message: message}
+	case http.StatusNotFound:
+		return logcacheerror.ResourceNotFoundError{Message: message}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,11 @@
+package logcacheerror
+
+// ResourceNotFoundError is returned when Log Cache responds with a 404, for
+// example when Log Cache is not deployed behind the derived URL.
+type ResourceNotFoundError struct {
+	Message string
+}
+
+func (e ResourceNotFoundError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,111 @@
+package logcache
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/httpclient"
+)
+
+// MaxReadLimit is the largest number of envelopes Log Cache returns for a
+// single read.
+const MaxReadLimit = 1000
+
+// ReadOptions restricts the envelopes returned by a read.
+type ReadOptions struct {
+	// StartTime, when set, excludes envelopes emitted before it.
+	StartTime time.Time
+
+	// EndTime, when set, excludes envelopes emitted at or after it.
+	EndTime time.Time
+
+	// Limit is the maximum number of envelopes to return. Log Cache applies
+	// its own default when it is not set.
+	Limit int
+
+	// Descending returns the newest envelopes first.
+	Descending bool
+}
+
+// LogEnvelope is a log line read from Log Cache.
+type LogEnvelope struct {
+	Timestamp  time.Time
+	SourceType string
+	InstanceID string
+	Message    string
+	// MessageType is either OUT or ERR.
+	MessageType string
+}
+
+// ReadLogs returns the log envelopes that Log Cache holds for the source,
+// such as an application GUID.
+func (client *Client) ReadLogs(sourceID string, options ReadOptions) ([]LogEnvelope, error) {
+	query := url.Values{"envelope_types": {"LOG"}}
+	if !options.StartTime.IsZero() {
+		query.Set("start_time", strconv.FormatInt(options.StartTime.UnixNano(), 10))
+	}
+	if !options.EndTime.IsZero() {
+		query.Set("end_time", strconv.FormatInt(options.EndTime.UnixNano(), 10))
+	}
+	if options.Limit > 0 {
+		query.Set("limit", strconv.Itoa(options.Limit))
+	}
+	if options.Descending {
+		query.Set("descending", "true")
+	}
+
+	request, err := client.newHTTPRequest(http.MethodGet, "/api/v1/read/"+url.PathEscape(sourceID), query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var read struct {
+		Envelopes struct {
+			Batch []struct {
+				Timestamp  int64             `json:"timestamp,string"`
+				InstanceID string            `json:"instance_id"`
+				Tags       map[string]string `json:"tags"`
+				Log        *struct {
+					Payload string `json:"payload"`
+					Type    string `json:"type"`
+				} `json:"log"`
+			} `json:"batch"`
+		} `json:"envelopes"`
+	}
+	err = client.connection.Make(request, &httpclient.Response{Result: &read})
+	if err != nil {
+		return nil, err
+	}
+
+	var envelopes []LogEnvelope
+	for _, envelope := range read.Envelopes.Batch {
+		if envelope.Log == nil {
+			continue
+		}
+
+		payload, err := base64.StdEncoding.DecodeString(envelope.Log.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		// OUT is the zero value of the log type, which is omitted when
+		// marshalled.
+		messageType := "OUT"
+		if envelope.Log.Type == "ERR" {
+			messageType = "ERR"
+		}
+
+		envelopes = append(envelopes, LogEnvelope{
+			Timestamp:   time.Unix(0, envelope.Timestamp),
+			SourceType:  envelope.Tags["source_type"],
+			InstanceID:  envelope.InstanceID,
+			Message:     string(payload),
+			MessageType: messageType,
+		})
+	}
+
+	return envelopes, nil
+}
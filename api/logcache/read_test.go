@@ -0,0 +1,146 @@
+package logcache_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/httpclient/httperror"
+	. "code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Read", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("ReadLogs", func() {
+		var (
+			options    ReadOptions
+			envelopes  []LogEnvelope
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			options = ReadOptions{}
+		})
+
+		JustBeforeEach(func() {
+			envelopes, executeErr = client.ReadLogs("some-app-guid", options)
+		})
+
+		Context("when Log Cache returns envelopes", func() {
+			BeforeEach(func() {
+				options = ReadOptions{
+					StartTime:  time.Unix(0, 1000),
+					EndTime:    time.Unix(0, 2000),
+					Limit:      500,
+					Descending: true,
+				}
+
+				response := `{
+					"envelopes": {
+						"batch": [
+							{
+								"timestamp": "1500",
+								"source_id": "some-app-guid",
+								"instance_id": "1",
+								"tags": {"source_type": "APP/PROC/WEB"},
+								"log": {"payload": "c29tZS1lcnJvcg==", "type": "ERR"}
+							},
+							{
+								"timestamp": "1400",
+								"source_id": "some-app-guid",
+								"instance_id": "0",
+								"tags": {"source_type": "STG"},
+								"log": {"payload": "c29tZS1vdXRwdXQ="}
+							},
+							{
+								"timestamp": "1300",
+								"source_id": "some-app-guid",
+								"gauge": {"metrics": {}}
+							}
+						]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "descending=true&end_time=2000&envelope_types=LOG&limit=500&start_time=1000"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the log envelopes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envelopes).To(Equal([]LogEnvelope{
+					{
+						Timestamp:   time.Unix(0, 1500),
+						SourceType:  "APP/PROC/WEB",
+						InstanceID:  "1",
+						Message:     "some-error",
+						MessageType: "ERR",
+					},
+					{
+						Timestamp:   time.Unix(0, 1400),
+						SourceType:  "STG",
+						InstanceID:  "0",
+						Message:     "some-output",
+						MessageType: "OUT",
+					},
+				}))
+			})
+		})
+
+		Context("when no options are set", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "envelope_types=LOG"),
+						RespondWith(http.StatusOK, `{"envelopes": {"batch": []}}`),
+					),
+				)
+			})
+
+			It("only asks for logs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envelopes).To(BeEmpty())
+			})
+		})
+
+		Context("when Log Cache is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid"),
+						RespondWith(http.StatusNotFound, `{"message": "not found"}`),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(logcacheerror.ResourceNotFoundError{Message: "not found"}))
+			})
+		})
+
+		Context("when the token is rejected", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid"),
+						RespondWith(http.StatusUnauthorized, `{"error": "invalid_token"}`),
+					),
+				)
+			})
+
+			It("returns an InvalidAuthTokenError", func() {
+				Expect(executeErr).To(MatchError(httperror.InvalidAuthTokenError{Message: "invalid_token"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,28 @@
+package logcache
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// newHTTPRequest returns a constructed HTTP.Request for the given path on
+// Log Cache with some defaults.
+func (client *Client) newHTTPRequest(method string, path string, query url.Values, body io.Reader) (*http.Request, error) {
+	requestURL := client.url + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	request, err := http.NewRequest(method, requestURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header = http.Header{}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", client.userAgent)
+
+	return request, nil
+}
@@ -0,0 +1,26 @@
+package plugin
+
+import "io/ioutil"
+
+// DownloadPlugin downloads the plugin binary located at pluginURL and writes
+// it to path. It returns the number of bytes downloaded.
+func (client *Client) DownloadPlugin(pluginURL string, path string) (int64, error) {
+	request, err := client.newGETRequest(pluginURL)
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Accept", "application/octet-stream")
+
+	var response Response
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	err = ioutil.WriteFile(path, response.RawResponse, 0700)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(response.RawResponse)), nil
+}
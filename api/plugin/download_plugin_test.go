@@ -0,0 +1,76 @@
+package plugin_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("DownloadPlugin", func() {
+	var (
+		client  *Client
+		tempDir string
+		path    string
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient()
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "download-plugin-test")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(tempDir, "some-plugin")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	Context("when the download succeeds", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/some-plugin"),
+					VerifyHeaderKV("Accept", "application/octet-stream"),
+					RespondWith(http.StatusOK, "some-binary-contents"),
+				),
+			)
+		})
+
+		It("writes the binary to the path and returns the number of bytes downloaded", func() {
+			size, err := client.DownloadPlugin(server.URL()+"/some-plugin", path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(size).To(BeEquivalentTo(len("some-binary-contents")))
+
+			contents, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-binary-contents"))
+		})
+	})
+
+	Context("when the server returns an error", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/some-plugin"),
+					RespondWith(http.StatusNotFound, nil),
+				),
+			)
+		})
+
+		It("returns the error and does not write the file", func() {
+			_, err := client.DownloadPlugin(server.URL()+"/some-plugin", path)
+			Expect(err).To(MatchError(pluginerror.NotFoundError{}))
+
+			_, statErr := os.Stat(path)
+			Expect(os.IsNotExist(statErr)).To(BeTrue())
+		})
+	})
+})
@@ -7,10 +7,20 @@ type PluginRepository struct {
 	Plugins []Plugin `json:"plugins"`
 }
 
+// Plugin represents a plugin available in a plugin repository.
 type Plugin struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Version     string `json:"version"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Version     string         `json:"version"`
+	Binaries    []PluginBinary `json:"binaries"`
+}
+
+// PluginBinary represents a platform specific binary of a plugin. Checksum is
+// either the SHA1 or the SHA256 of the binary.
+type PluginBinary struct {
+	Platform string `json:"platform"`
+	URL      string `json:"url"`
+	Checksum string `json:"checksum"`
 }
 
 func (client *Client) GetPluginRepository(repositoryURL string) (PluginRepository, error) {
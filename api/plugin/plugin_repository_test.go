@@ -27,7 +27,19 @@ var _ = Describe("PluginRepository", func() {
 						{
 							"name": "plugin-1",
 							"description": "useful plugin for useful things",
-							"version": "1.0.0"
+							"version": "1.0.0",
+							"binaries": [
+								{
+									"platform": "osx",
+									"url": "http://some-url",
+									"checksum": "somechecksum"
+								},
+								{
+									"platform": "linux64",
+									"url": "http://another-url",
+									"checksum": "anotherchecksum"
+								}
+							]
 						},
 						{
 							"name": "plugin-2",
@@ -53,6 +65,10 @@ var _ = Describe("PluginRepository", func() {
 							Name:        "plugin-1",
 							Description: "useful plugin for useful things",
 							Version:     "1.0.0",
+							Binaries: []PluginBinary{
+								{Platform: "osx", URL: "http://some-url", Checksum: "somechecksum"},
+								{Platform: "linux64", URL: "http://another-url", Checksum: "anotherchecksum"},
+							},
 						},
 						{
 							Name:        "plugin-2",
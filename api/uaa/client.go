@@ -20,9 +20,10 @@ type Client struct {
 	id     string
 	secret string
 
-	connection Connection
-	router     *rata.RequestGenerator
-	userAgent  string
+	connection   Connection
+	router       *rata.RequestGenerator
+	userAgent    string
+	identityZone IdentityZone
 }
 
 // Config allows the Client to be configured
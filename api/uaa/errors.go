@@ -62,6 +62,16 @@ func (e InvalidAuthTokenError) Error() string {
 	return e.Message
 }
 
+// InvalidRefreshTokenError is returned when UAA rejects the refresh token
+// because it expired, was revoked or is unknown.
+type InvalidRefreshTokenError struct {
+	Message string
+}
+
+func (e InvalidRefreshTokenError) Error() string {
+	return e.Message
+}
+
 // InsufficientScopeError is returned when the client has insufficient scope
 type InsufficientScopeError struct {
 	Message string
@@ -79,3 +89,11 @@ type InvalidSCIMResourceError struct {
 func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
+
+// SSHPasscodeNotFoundError is returned when the authorization server does not
+// redirect with a one time code.
+type SSHPasscodeNotFoundError struct{}
+
+func (SSHPasscodeNotFoundError) Error() string {
+	return "Authorization server did not redirect with one time code"
+}
@@ -0,0 +1,87 @@
+package uaa
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// GroupMemberTypeUser is the type of group members that are users.
+const GroupMemberTypeUser = "USER"
+
+// Group represents a UAA group. The members of a group are granted the scope
+// named after the group, such as cloud_controller.admin.
+type Group struct {
+	ID          string        `json:"id"`
+	DisplayName string        `json:"displayName"`
+	Description string        `json:"description"`
+	Members     []GroupMember `json:"members"`
+}
+
+// GroupMember represents a member of a UAA group. Value is the ID of the
+// member.
+type GroupMember struct {
+	Value  string `json:"value"`
+	Type   string `json:"type"`
+	Origin string `json:"origin"`
+}
+
+// GetGroups returns every UAA group. When displayName is not empty, only the
+// group with that name is returned.
+func (client *Client) GetGroups(displayName string) ([]Group, error) {
+	var filter string
+	if displayName != "" {
+		filter = scimEqualFilter("displayName", displayName)
+	}
+
+	var groups []Group
+	err := client.listSCIMResources(internal.GetGroupsRequest, filter, func(resource json.RawMessage) error {
+		var group Group
+		if err := json.Unmarshal(resource, &group); err != nil {
+			return err
+		}
+		groups = append(groups, group)
+		return nil
+	})
+	return groups, err
+}
+
+// AddGroupMember adds the member to the UAA group.
+func (client *Client) AddGroupMember(groupID string, member GroupMember) error {
+	bodyBytes, err := json.Marshal(member)
+	if err != nil {
+		return err
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostGroupMemberRequest,
+		Params:      map[string]string{"group_id": groupID},
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+		Body:               bytes.NewBuffer(bodyBytes),
+		IdentityZoneScoped: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &Response{})
+}
+
+// RemoveGroupMember removes the member with the provided ID from the UAA
+// group.
+func (client *Client) RemoveGroupMember(groupID string, memberID string) error {
+	request, err := client.newRequest(requestOptions{
+		RequestName:        internal.DeleteGroupMemberRequest,
+		Params:             map[string]string{"group_id": groupID, "member_id": memberID},
+		IdentityZoneScoped: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &Response{})
+}
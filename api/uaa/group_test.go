@@ -0,0 +1,203 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Group", func() {
+	var (
+		client *Client
+	)
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore()
+	})
+
+	Describe("GetGroups", func() {
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"resources": [
+						{
+							"id": "group-id-1",
+							"displayName": "cloud_controller.admin",
+							"description": "Cloud Controller admins",
+							"members": [
+								{"value": "user-id-1", "type": "USER", "origin": "uaa"}
+							]
+						}
+					],
+					"startIndex": 1,
+					"itemsPerPage": 1,
+					"totalResults": 2
+				}`
+				response2 := `{
+					"resources": [
+						{
+							"id": "group-id-2",
+							"displayName": "scim.read"
+						}
+					],
+					"startIndex": 2,
+					"itemsPerPage": 1,
+					"totalResults": 2
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Groups", "startIndex=1&count=100"),
+						RespondWith(http.StatusOK, response1),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Groups", "startIndex=2&count=100"),
+						RespondWith(http.StatusOK, response2),
+					),
+				)
+			})
+
+			It("returns the groups from every page", func() {
+				groups, err := client.GetGroups("")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(groups).To(Equal([]Group{
+					{
+						ID:          "group-id-1",
+						DisplayName: "cloud_controller.admin",
+						Description: "Cloud Controller admins",
+						Members: []GroupMember{
+							{Value: "user-id-1", Type: "USER", Origin: "uaa"},
+						},
+					},
+					{
+						ID:          "group-id-2",
+						DisplayName: "scim.read",
+					},
+				}))
+			})
+		})
+
+		Context("when a display name is provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Groups", "startIndex=1&count=100&filter=displayName+eq+%22cloud_controller.admin%22"),
+						RespondWith(http.StatusOK, `{"resources": [], "startIndex": 1, "totalResults": 0}`),
+					),
+				)
+			})
+
+			It("filters the groups by display name", func() {
+				groups, err := client.GetGroups("cloud_controller.admin")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(groups).To(BeEmpty())
+			})
+		})
+
+		Context("when an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "some-error",
+					"error_description": "some-description"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Groups"),
+						RespondWith(http.StatusTeapot, response),
+					))
+			})
+
+			It("returns the error", func() {
+				_, err := client.GetGroups("")
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
+
+	Describe("AddGroupMember", func() {
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/Groups/some-group-id/members"),
+						VerifyHeaderKV("Content-Type", "application/json"),
+						VerifyBody([]byte(`{"value":"some-user-id","type":"USER","origin":"uaa"}`)),
+						RespondWith(http.StatusCreated, `{"value":"some-user-id","type":"USER","origin":"uaa"}`),
+					))
+			})
+
+			It("adds the member to the group", func() {
+				err := client.AddGroupMember("some-group-id", GroupMember{
+					Value:  "some-user-id",
+					Type:   GroupMemberTypeUser,
+					Origin: "uaa",
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the user is already a member", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/Groups/some-group-id/members"),
+						RespondWith(http.StatusConflict, `{"error": "member_already_exists", "error_description": "Member already exists"}`),
+					))
+			})
+
+			It("returns a ConflictError", func() {
+				err := client.AddGroupMember("some-group-id", GroupMember{Value: "some-user-id"})
+				Expect(err).To(MatchError(ConflictError{Message: "Member already exists"}))
+			})
+		})
+	})
+
+	Describe("RemoveGroupMember", func() {
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/Groups/some-group-id/members/some-user-id"),
+						RespondWith(http.StatusOK, `{"value":"some-user-id","type":"USER","origin":"uaa"}`),
+					))
+			})
+
+			It("removes the member from the group", func() {
+				err := client.RemoveGroupMember("some-group-id", "some-user-id")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "some-error",
+					"error_description": "some-description"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/Groups/some-group-id/members/some-user-id"),
+						RespondWith(http.StatusTeapot, response),
+					))
+			})
+
+			It("returns the error", func() {
+				err := client.RemoveGroupMember("some-group-id", "some-user-id")
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,15 @@
+package uaa
+
+// IdentityZone identifies a UAA identity zone. Either ID or Subdomain is used
+// to select it.
+type IdentityZone struct {
+	ID        string
+	Subdomain string
+}
+
+// TargetIdentityZone sends the SCIM user and group requests of the client to
+// the provided identity zone instead of the zone of the UAA URL. The access
+// token used must have the zones.<zone id>.admin scope or an equivalent one.
+func (client *Client) TargetIdentityZone(zone IdentityZone) {
+	client.identityZone = zone
+}
@@ -0,0 +1,78 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Identity Zone", func() {
+	var (
+		client *Client
+	)
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore()
+	})
+
+	Describe("TargetIdentityZone", func() {
+		Context("when a zone ID is targeted", func() {
+			BeforeEach(func() {
+				client.TargetIdentityZone(IdentityZone{ID: "some-zone-id"})
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Groups"),
+						VerifyHeaderKV("X-Identity-Zone-Id", "some-zone-id"),
+						func(_ http.ResponseWriter, request *http.Request) {
+							Expect(request.Header).ToNot(HaveKey("X-Identity-Zone-Subdomain"))
+						},
+						RespondWith(http.StatusOK, `{"resources": [], "startIndex": 1, "totalResults": 0}`),
+					))
+			})
+
+			It("sends the SCIM requests to the zone", func() {
+				_, err := client.GetGroups("")
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when a zone subdomain is targeted", func() {
+			BeforeEach(func() {
+				client.TargetIdentityZone(IdentityZone{Subdomain: "some-subdomain"})
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/Groups/some-group-id/members"),
+						VerifyHeaderKV("X-Identity-Zone-Subdomain", "some-subdomain"),
+						RespondWith(http.StatusCreated, `{}`),
+					))
+			})
+
+			It("sends the SCIM requests to the zone", func() {
+				err := client.AddGroupMember("some-group-id", GroupMember{Value: "some-user-id"})
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when refreshing the access token", func() {
+			BeforeEach(func() {
+				client.TargetIdentityZone(IdentityZone{ID: "some-zone-id"})
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						func(_ http.ResponseWriter, request *http.Request) {
+							Expect(request.Header).ToNot(HaveKey("X-Identity-Zone-Id"))
+						},
+						RespondWith(http.StatusOK, `{"access_token": "some-access-token", "token_type": "bearer", "refresh_token": "some-refresh-token"}`),
+					))
+			})
+
+			It("does not send the request to the zone", func() {
+				_, err := client.RefreshAccessToken("some-refresh-token")
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+})
@@ -7,13 +7,23 @@ import (
 )
 
 const (
-	PostUserRequest     = "CreateUser"
-	RefreshTokenRequest = "RefreshToken"
+	DeleteGroupMemberRequest = "DeleteGroupMember"
+	GetGroupsRequest         = "GetGroups"
+	GetSSHPasscodeRequest    = "GetSSHPasscode"
+	GetUsersRequest          = "GetUsers"
+	PostGroupMemberRequest   = "PostGroupMember"
+	PostUserRequest          = "CreateUser"
+	RefreshTokenRequest      = "RefreshToken"
 )
 
 // Routes is a list of routes used by the rata library to construct request
 // URLs.
 var Routes = rata.Routes{
+	{Path: "/Groups", Method: http.MethodGet, Name: GetGroupsRequest},
+	{Path: "/Groups/:group_id/members", Method: http.MethodPost, Name: PostGroupMemberRequest},
+	{Path: "/Groups/:group_id/members/:member_id", Method: http.MethodDelete, Name: DeleteGroupMemberRequest},
+	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetSSHPasscodeRequest},
+	{Path: "/Users", Method: http.MethodGet, Name: GetUsersRequest},
 	{Path: "/Users", Method: http.MethodPost, Name: PostUserRequest},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: RefreshTokenRequest},
 }
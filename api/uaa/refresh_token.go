@@ -1,6 +1,7 @@
 package uaa
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -21,7 +22,10 @@ func (refreshTokenResponse RefreshToken) AuthorizationToken() string {
 	return fmt.Sprintf("%s %s", refreshTokenResponse.Type, refreshTokenResponse.AccessToken)
 }
 
-// RefreshAccessToken refreshes the current access token.
+// RefreshAccessToken refreshes the current access token. When UAA does not
+// rotate the refresh token, the passed in refresh token is returned so that
+// callers can always persist the returned pair. If UAA rejects the refresh
+// token, an InvalidRefreshTokenError is returned.
 func (client *Client) RefreshAccessToken(refreshToken string) (RefreshToken, error) {
 	body := strings.NewReader(url.Values{
 		"client_id":     {client.id},
@@ -48,8 +52,32 @@ func (client *Client) RefreshAccessToken(refreshToken string) (RefreshToken, err
 
 	err = client.connection.Make(request, &response)
 	if err != nil {
-		return RefreshToken{}, err
+		return RefreshToken{}, convertRefreshTokenError(err)
+	}
+
+	if refreshResponse.RefreshToken == "" {
+		refreshResponse.RefreshToken = refreshToken
 	}
 
 	return refreshResponse, nil
 }
+
+// convertRefreshTokenError converts the errors UAA returns for an expired,
+// revoked or unknown refresh token into an InvalidRefreshTokenError.
+func convertRefreshTokenError(err error) error {
+	switch e := err.(type) {
+	case InvalidAuthTokenError:
+		return InvalidRefreshTokenError{Message: e.Message}
+	case RawHTTPStatusError:
+		if e.StatusCode != http.StatusBadRequest && e.StatusCode != http.StatusUnauthorized {
+			return err
+		}
+
+		var uaaErrorResponse UAAErrorResponse
+		if json.Unmarshal(e.RawResponse, &uaaErrorResponse) == nil && uaaErrorResponse.Type == "invalid_grant" {
+			return InvalidRefreshTokenError{Message: uaaErrorResponse.Description}
+		}
+	}
+
+	return err
+}
@@ -26,39 +26,129 @@ var _ = Describe("UAA Client", func() {
 			sentRefreshToken     string
 			returnedRefreshToken string
 		)
+
 		BeforeEach(func() {
 			returnedAccessToken = "I-ACCESS-TOKEN"
 			sentRefreshToken = "I-R-REFRESH-TOKEN"
 			returnedRefreshToken = "I-R-NEW-REFRESH-TOKEN"
-			response := fmt.Sprintf(`{
-				"access_token": "%s",
-				"token_type": "bearer",
-				"refresh_token": "%s",
-				"expires_in": 599,
-				"scope": "cloud_controller.read password.write cloud_controller.write openid uaa.user",
-				"jti": "4150c08afa2848278e5ad57201024e32"
-			}`, returnedAccessToken, returnedRefreshToken)
-
-			server.AppendHandlers(
-				CombineHandlers(
-					VerifyRequest(http.MethodPost, "/oauth/token"),
-					VerifyHeaderKV("Accept", "application/json"),
-					VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
-					VerifyBody([]byte(fmt.Sprintf("client_id=client-id&client_secret=client-secret&grant_type=refresh_token&refresh_token=%s", sentRefreshToken))),
-					RespondWith(http.StatusOK, response),
-				))
 		})
 
-		It("refreshes the tokens", func() {
-			token, err := client.RefreshAccessToken(sentRefreshToken)
-			Expect(err).ToNot(HaveOccurred())
-			Expect(token).To(Equal(RefreshToken{
-				AccessToken:  returnedAccessToken,
-				RefreshToken: returnedRefreshToken,
-				Type:         "bearer",
-			}))
+		Context("when the refresh token is rotated", func() {
+			BeforeEach(func() {
+				response := fmt.Sprintf(`{
+					"access_token": "%s",
+					"token_type": "bearer",
+					"refresh_token": "%s",
+					"expires_in": 599,
+					"scope": "cloud_controller.read password.write cloud_controller.write openid uaa.user",
+					"jti": "4150c08afa2848278e5ad57201024e32"
+				}`, returnedAccessToken, returnedRefreshToken)
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						VerifyHeaderKV("Accept", "application/json"),
+						VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+						VerifyBody([]byte(fmt.Sprintf("client_id=client-id&client_secret=client-secret&grant_type=refresh_token&refresh_token=%s", sentRefreshToken))),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("refreshes the tokens", func() {
+				token, err := client.RefreshAccessToken(sentRefreshToken)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal(RefreshToken{
+					AccessToken:  returnedAccessToken,
+					RefreshToken: returnedRefreshToken,
+					Type:         "bearer",
+				}))
+
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the response does not contain a refresh token", func() {
+			BeforeEach(func() {
+				response := fmt.Sprintf(`{
+					"access_token": "%s",
+					"token_type": "bearer",
+					"expires_in": 599
+				}`, returnedAccessToken)
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the sent refresh token", func() {
+				token, err := client.RefreshAccessToken(sentRefreshToken)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal(RefreshToken{
+					AccessToken:  returnedAccessToken,
+					RefreshToken: sentRefreshToken,
+					Type:         "bearer",
+				}))
+			})
+		})
+
+		Context("when the refresh token is invalid", func() {
+			BeforeEach(func() {
+				response := `{
+					"error": "invalid_grant",
+					"error_description": "Invalid refresh token (expired): I-R-REFRESH-TOKEN"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						RespondWith(http.StatusBadRequest, response),
+					))
+			})
+
+			It("returns an InvalidRefreshTokenError", func() {
+				_, err := client.RefreshAccessToken(sentRefreshToken)
+				Expect(err).To(MatchError(InvalidRefreshTokenError{Message: "Invalid refresh token (expired): I-R-REFRESH-TOKEN"}))
+			})
+		})
+
+		Context("when the refresh token has been revoked", func() {
+			BeforeEach(func() {
+				response := `{
+					"error": "invalid_token",
+					"error_description": "The token was revoked"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						RespondWith(http.StatusUnauthorized, response),
+					))
+			})
+
+			It("returns an InvalidRefreshTokenError", func() {
+				_, err := client.RefreshAccessToken(sentRefreshToken)
+				Expect(err).To(MatchError(InvalidRefreshTokenError{Message: "The token was revoked"}))
+			})
+		})
+
+		Context("when UAA returns any other error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						RespondWith(http.StatusBadRequest, `{"error": "invalid_request", "error_description": "bad"}`),
+					))
+			})
 
-			Expect(server.ReceivedRequests()).To(HaveLen(1))
+			It("returns the error", func() {
+				_, err := client.RefreshAccessToken(sentRefreshToken)
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusBadRequest,
+					RawResponse: []byte(`{"error": "invalid_request", "error_description": "bad"}`),
+				}))
+			})
 		})
 	})
 })
@@ -1,6 +1,7 @@
 package uaa
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/url"
@@ -24,8 +25,20 @@ type requestOptions struct {
 
 	// Body is the request body
 	Body io.Reader
+
+	// IdentityZoneScoped sends the request to the identity zone targeted by the
+	// client
+	IdentityZoneScoped bool
+
+	// DoNotFollowRedirects returns redirect responses to the caller instead of
+	// following them
+	DoNotFollowRedirects bool
 }
 
+// doNotFollowRedirectsKey marks the context of requests whose redirects are
+// not followed by the UAAConnection.
+type doNotFollowRedirectsKey struct{}
+
 // newRequest returns a constructed http.Request with some defaults. The
 // request will terminate the connection after it is sent (via a 'Connection:
 // close' header).
@@ -50,5 +63,18 @@ func (client *Client) newRequest(passedRequest requestOptions) (*http.Request, e
 	request.Header.Set("Connection", "close")
 	request.Header.Set("User-Agent", client.userAgent)
 
+	if passedRequest.IdentityZoneScoped {
+		if client.identityZone.ID != "" {
+			request.Header.Set("X-Identity-Zone-Id", client.identityZone.ID)
+		}
+		if client.identityZone.Subdomain != "" {
+			request.Header.Set("X-Identity-Zone-Subdomain", client.identityZone.Subdomain)
+		}
+	}
+
+	if passedRequest.DoNotFollowRedirects {
+		request = request.WithContext(context.WithValue(request.Context(), doNotFollowRedirectsKey{}, true))
+	}
+
 	return request, nil
 }
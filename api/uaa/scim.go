@@ -0,0 +1,69 @@
+package uaa
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// scimPageSize is the number of resources requested per page of a SCIM list.
+const scimPageSize = 100
+
+// scimListResponse represents a page of a SCIM list response.
+type scimListResponse struct {
+	Resources    []json.RawMessage `json:"resources"`
+	StartIndex   int               `json:"startIndex"`
+	TotalResults int               `json:"totalResults"`
+}
+
+// scimEqualFilter returns a SCIM filter matching resources whose attribute
+// equals value.
+func scimEqualFilter(attribute string, value string) string {
+	return fmt.Sprintf(`%s eq "%s"`, attribute, strings.Replace(value, `"`, `\"`, -1))
+}
+
+// listSCIMResources requests every page of the SCIM list and calls handle with
+// each resource in it.
+func (client *Client) listSCIMResources(requestName string, filter string, handle func(json.RawMessage) error) error {
+	startIndex := 1
+	for {
+		query := url.Values{
+			"startIndex": {strconv.Itoa(startIndex)},
+			"count":      {strconv.Itoa(scimPageSize)},
+		}
+		if filter != "" {
+			query.Set("filter", filter)
+		}
+
+		request, err := client.newRequest(requestOptions{
+			RequestName:        requestName,
+			Query:              query,
+			IdentityZoneScoped: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		var page scimListResponse
+		response := Response{
+			Result: &page,
+		}
+		err = client.connection.Make(request, &response)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range page.Resources {
+			if err := handle(resource); err != nil {
+				return err
+			}
+		}
+
+		startIndex += len(page.Resources)
+		if len(page.Resources) == 0 || startIndex > page.TotalResults {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// GetSSHPasscode requests a one time code that can be used as the password
+// when opening an SSH session on behalf of the user.
+func (client *Client) GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetSSHPasscodeRequest,
+		Header: http.Header{
+			"Authorization": {accessToken},
+		},
+		Query: url.Values{
+			"client_id":     {sshOAuthClient},
+			"grant_type":    {"authorization_code"},
+			"response_type": {"code"},
+		},
+		DoNotFollowRedirects: true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	response := Response{}
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return "", err
+	}
+
+	location, err := response.HTTPResponse.Location()
+	if err != nil {
+		return "", SSHPasscodeNotFoundError{}
+	}
+
+	code := location.Query().Get("code")
+	if code == "" {
+		return "", SSHPasscodeNotFoundError{}
+	}
+
+	return code, nil
+}
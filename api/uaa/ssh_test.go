@@ -0,0 +1,95 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("SSH", func() {
+	var (
+		client *Client
+	)
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore()
+	})
+
+	Describe("GetSSHPasscode", func() {
+		var (
+			passcode   string
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			passcode, executeErr = client.GetSSHPasscode("bearer some-access-token", "ssh-proxy")
+		})
+
+		Context("when the server redirects with a code", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize", "client_id=ssh-proxy&grant_type=authorization_code&response_type=code"),
+						VerifyHeaderKV("Authorization", "bearer some-access-token"),
+						RespondWith(http.StatusFound, nil, http.Header{
+							"Location": {"https://uaa.example.com/login?code=some-passcode"},
+						}),
+					))
+			})
+
+			It("returns the code without following the redirect", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(passcode).To(Equal("some-passcode"))
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the server does not redirect", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize"),
+						RespondWith(http.StatusOK, nil),
+					))
+			})
+
+			It("returns a SSHPasscodeNotFoundError", func() {
+				Expect(executeErr).To(MatchError(SSHPasscodeNotFoundError{}))
+			})
+		})
+
+		Context("when the redirect does not contain a code", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize"),
+						RespondWith(http.StatusFound, nil, http.Header{
+							"Location": {"https://uaa.example.com/login?error=access_denied"},
+						}),
+					))
+			})
+
+			It("returns a SSHPasscodeNotFoundError", func() {
+				Expect(executeErr).To(MatchError(SSHPasscodeNotFoundError{}))
+			})
+		})
+
+		Context("when the server returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize"),
+						RespondWith(http.StatusUnauthorized, `{"error":"invalid_token","error_description":"Invalid access token"}`),
+					))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(InvalidAuthTokenError{Message: "Invalid access token"}))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -31,8 +32,24 @@ func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *UAAConnec
 	}
 
 	return &UAAConnection{
-		HTTPClient: &http.Client{Transport: tr},
+		HTTPClient: &http.Client{
+			Transport:     tr,
+			CheckRedirect: checkRedirect,
+		},
+	}
+}
+
+// checkRedirect returns the redirect response of requests created with
+// DoNotFollowRedirects, such as the ones for the one time codes UAA hands out
+// via redirects, to the caller. Other redirects are followed as usual.
+func checkRedirect(request *http.Request, via []*http.Request) error {
+	if doNotFollow, _ := via[0].Context().Value(doNotFollowRedirectsKey{}).(bool); doNotFollow {
+		return http.ErrUseLastResponse
+	}
+	if len(via) >= 10 {
+		return errors.New("stopped after 10 redirects")
 	}
+	return nil
 }
 
 // Make takes a passedRequest, converts it into an HTTP request and then
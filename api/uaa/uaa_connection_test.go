@@ -69,6 +69,37 @@ var _ = Describe("UAA Connection", func() {
 			})
 		})
 
+		Describe("Redirects", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/foo", ""),
+						RespondWith(http.StatusFound, nil, http.Header{"Location": {"/v2/bar"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/bar", ""),
+						RespondWith(http.StatusOK, `{"val1":"redirected"}`),
+					),
+				)
+
+				var err error
+				request, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("follows them", func() {
+				var body DummyResponse
+				response := Response{
+					Result: &body,
+				}
+
+				err := connection.Make(request, &response)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body.Val1).To(Equal("redirected"))
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
 		Describe("HTTP Response", func() {
 			var request *http.Request
 
@@ -10,7 +10,9 @@ import (
 
 // User represents an UAA user account.
 type User struct {
-	ID string
+	ID       string
+	Username string
+	Origin   string
 }
 
 // newUserRequestBody represents the body of the request.
@@ -65,7 +67,8 @@ func (client *Client) CreateUser(user string, password string, origin string) (U
 		Header: http.Header{
 			"Content-Type": {"application/json"},
 		},
-		Body: bytes.NewBuffer(bodyBytes),
+		Body:               bytes.NewBuffer(bodyBytes),
+		IdentityZoneScoped: true,
 	})
 	if err != nil {
 		return User{}, err
@@ -83,3 +86,22 @@ func (client *Client) CreateUser(user string, password string, origin string) (U
 
 	return User{ID: userResponse.ID}, nil
 }
+
+// GetUsersByUsername returns the UAA user accounts with the provided username.
+// Users from different origins can share the same username.
+func (client *Client) GetUsersByUsername(username string) ([]User, error) {
+	var users []User
+	err := client.listSCIMResources(internal.GetUsersRequest, scimEqualFilter("userName", username), func(resource json.RawMessage) error {
+		var user struct {
+			ID       string `json:"id"`
+			Username string `json:"userName"`
+			Origin   string `json:"origin"`
+		}
+		if err := json.Unmarshal(resource, &user); err != nil {
+			return err
+		}
+		users = append(users, User{ID: user.ID, Username: user.Username, Origin: user.Origin})
+		return nil
+	})
+	return users, err
+}
@@ -92,4 +92,58 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetUsersByUsername", func() {
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				response := `{
+					"resources": [
+						{"id": "user-id-1", "userName": "some-user", "origin": "uaa"},
+						{"id": "user-id-2", "userName": "some-user", "origin": "ldap"}
+					],
+					"startIndex": 1,
+					"totalResults": 2
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Users", "startIndex=1&count=100&filter=userName+eq+%22some-user%22"),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the users with the username", func() {
+				users, err := client.GetUsersByUsername("some-user")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(users).To(Equal([]User{
+					{ID: "user-id-1", Username: "some-user", Origin: "uaa"},
+					{ID: "user-id-2", Username: "some-user", Origin: "ldap"},
+				}))
+			})
+		})
+
+		Context("when an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "some-error",
+					"error_description": "some-description"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Users"),
+						RespondWith(http.StatusTeapot, response),
+					))
+			})
+
+			It("returns the error", func() {
+				_, err := client.GetUsersByUsername("some-user")
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
 })
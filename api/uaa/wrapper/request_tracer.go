@@ -0,0 +1,45 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/tracing"
+)
+
+// RequestTracer is the wrapper that records a tracing span for every request
+// made to the UAA server
+type RequestTracer struct {
+	connection uaa.Connection
+}
+
+// NewRequestTracer returns a pointer to a RequestTracer wrapper
+func NewRequestTracer() *RequestTracer {
+	return &RequestTracer{}
+}
+
+// Wrap sets the connection on the RequestTracer and returns itself
+func (tracer *RequestTracer) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	tracer.connection = innerconnection
+	return tracer
+}
+
+// Make records a span covering the request, including the status code of the
+// response and any error
+func (tracer *RequestTracer) Make(request *http.Request, passedResponse *uaa.Response) error {
+	span := tracing.StartClientSpan("HTTP " + request.Method)
+	defer span.End()
+
+	span.SetAttribute("peer.service", "uaa")
+	span.SetAttribute("http.method", request.Method)
+	span.SetAttribute("http.url", request.URL.Scheme+"://"+request.URL.Host+request.URL.Path)
+
+	err := tracer.connection.Make(request, passedResponse)
+
+	if passedResponse.HTTPResponse != nil {
+		span.SetAttribute("http.status_code", passedResponse.HTTPResponse.StatusCode)
+	}
+	span.SetError(err)
+
+	return err
+}
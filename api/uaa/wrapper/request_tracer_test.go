@@ -0,0 +1,96 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/util/tracing"
+	"code.cloudfoundry.org/cli/util/tracing/tracingfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Tracer", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		fakeExporter   *tracingfakes.FakeExporter
+
+		wrapper  uaa.Connection
+		request  *http.Request
+		response *uaa.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeExporter = new(tracingfakes.FakeExporter)
+		tracing.Enable(fakeExporter)
+
+		wrapper = NewRequestTracer().Wrap(fakeConnection)
+
+		var err error
+		request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana?q=name:apple", nil)
+		Expect(err).NotTo(HaveOccurred())
+		response = &uaa.Response{}
+	})
+
+	AfterEach(func() {
+		tracing.Disable()
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	exportedSpan := func() tracing.Span {
+		Expect(tracing.Flush()).To(Succeed())
+		spans := fakeExporter.ExportArgsForCall(0)
+		Expect(spans).To(HaveLen(1))
+		return spans[0]
+	}
+
+	Context("when the request succeeds", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+				return nil
+			}
+		})
+
+		It("records a client span of the request", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			span := exportedSpan()
+			Expect(span.Name).To(Equal("HTTP GET"))
+			Expect(span.Kind).To(Equal(tracing.SpanKindClient))
+			Expect(span.Attributes).To(Equal(map[string]interface{}{
+				"peer.service":     "uaa",
+				"http.method":      "GET",
+				"http.url":         "https://foo.bar.com/banana",
+				"http.status_code": 200,
+			}))
+			Expect(span.Error).To(BeEmpty())
+		})
+	})
+
+	Context("when the request fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeConnection.MakeReturns(expectedErr)
+		})
+
+		It("records the error on the span and returns it", func() {
+			Expect(makeErr).To(MatchError(expectedErr))
+
+			span := exportedSpan()
+			Expect(span.Error).To(Equal("some-error"))
+			Expect(span.Attributes).ToNot(HaveKey("http.status_code"))
+		})
+	})
+})
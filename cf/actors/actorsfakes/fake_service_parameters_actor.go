@@ -0,0 +1,163 @@
+// This file was generated by counterfeiter
+package actorsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+type FakeServiceParametersActor struct {
+	GetPlanSchemasStub        func(planGUID string) (models.ServicePlanSchemas, error)
+	getPlanSchemasMutex       sync.RWMutex
+	getPlanSchemasArgsForCall []struct {
+		planGUID string
+	}
+	getPlanSchemasReturns struct {
+		result1 models.ServicePlanSchemas
+		result2 error
+	}
+	getPlanSchemasReturnsOnCall map[int]struct {
+		result1 models.ServicePlanSchemas
+		result2 error
+	}
+	ValidateParametersStub        func(schema map[string]interface{}, params map[string]interface{}) error
+	validateParametersMutex       sync.RWMutex
+	validateParametersArgsForCall []struct {
+		schema map[string]interface{}
+		params map[string]interface{}
+	}
+	validateParametersReturns struct {
+		result1 error
+	}
+	validateParametersReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServiceParametersActor) GetPlanSchemas(planGUID string) (models.ServicePlanSchemas, error) {
+	fake.getPlanSchemasMutex.Lock()
+	ret, specificReturn := fake.getPlanSchemasReturnsOnCall[len(fake.getPlanSchemasArgsForCall)]
+	fake.getPlanSchemasArgsForCall = append(fake.getPlanSchemasArgsForCall, struct {
+		planGUID string
+	}{planGUID})
+	fake.recordInvocation("GetPlanSchemas", []interface{}{planGUID})
+	fake.getPlanSchemasMutex.Unlock()
+	if fake.GetPlanSchemasStub != nil {
+		return fake.GetPlanSchemasStub(planGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getPlanSchemasReturns.result1, fake.getPlanSchemasReturns.result2
+}
+
+func (fake *FakeServiceParametersActor) GetPlanSchemasCallCount() int {
+	fake.getPlanSchemasMutex.RLock()
+	defer fake.getPlanSchemasMutex.RUnlock()
+	return len(fake.getPlanSchemasArgsForCall)
+}
+
+func (fake *FakeServiceParametersActor) GetPlanSchemasArgsForCall(i int) string {
+	fake.getPlanSchemasMutex.RLock()
+	defer fake.getPlanSchemasMutex.RUnlock()
+	return fake.getPlanSchemasArgsForCall[i].planGUID
+}
+
+func (fake *FakeServiceParametersActor) GetPlanSchemasReturns(result1 models.ServicePlanSchemas, result2 error) {
+	fake.GetPlanSchemasStub = nil
+	fake.getPlanSchemasReturns = struct {
+		result1 models.ServicePlanSchemas
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceParametersActor) GetPlanSchemasReturnsOnCall(i int, result1 models.ServicePlanSchemas, result2 error) {
+	fake.GetPlanSchemasStub = nil
+	if fake.getPlanSchemasReturnsOnCall == nil {
+		fake.getPlanSchemasReturnsOnCall = make(map[int]struct {
+			result1 models.ServicePlanSchemas
+			result2 error
+		})
+	}
+	fake.getPlanSchemasReturnsOnCall[i] = struct {
+		result1 models.ServicePlanSchemas
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceParametersActor) ValidateParameters(schema map[string]interface{}, params map[string]interface{}) error {
+	fake.validateParametersMutex.Lock()
+	ret, specificReturn := fake.validateParametersReturnsOnCall[len(fake.validateParametersArgsForCall)]
+	fake.validateParametersArgsForCall = append(fake.validateParametersArgsForCall, struct {
+		schema map[string]interface{}
+		params map[string]interface{}
+	}{schema, params})
+	fake.recordInvocation("ValidateParameters", []interface{}{schema, params})
+	fake.validateParametersMutex.Unlock()
+	if fake.ValidateParametersStub != nil {
+		return fake.ValidateParametersStub(schema, params)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.validateParametersReturns.result1
+}
+
+func (fake *FakeServiceParametersActor) ValidateParametersCallCount() int {
+	fake.validateParametersMutex.RLock()
+	defer fake.validateParametersMutex.RUnlock()
+	return len(fake.validateParametersArgsForCall)
+}
+
+func (fake *FakeServiceParametersActor) ValidateParametersArgsForCall(i int) (map[string]interface{}, map[string]interface{}) {
+	fake.validateParametersMutex.RLock()
+	defer fake.validateParametersMutex.RUnlock()
+	return fake.validateParametersArgsForCall[i].schema, fake.validateParametersArgsForCall[i].params
+}
+
+func (fake *FakeServiceParametersActor) ValidateParametersReturns(result1 error) {
+	fake.ValidateParametersStub = nil
+	fake.validateParametersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServiceParametersActor) ValidateParametersReturnsOnCall(i int, result1 error) {
+	fake.ValidateParametersStub = nil
+	if fake.validateParametersReturnsOnCall == nil {
+		fake.validateParametersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateParametersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServiceParametersActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getPlanSchemasMutex.RLock()
+	defer fake.getPlanSchemasMutex.RUnlock()
+	fake.validateParametersMutex.RLock()
+	defer fake.validateParametersMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeServiceParametersActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ actors.ServiceParametersActor = new(FakeServiceParametersActor)
@@ -22,7 +22,7 @@ const windowsPathPrefix = `\\?\`
 type PushActor interface {
 	UploadApp(appGUID string, zipFile *os.File, presentFiles []resources.AppFileResource) error
 	ProcessPath(dirOrZipFile string, f func(string) error) error
-	GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error)
+	GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool, preserveFileMode bool) ([]resources.AppFileResource, bool, error)
 	ValidateAppParams(apps []models.AppParams) []error
 	MapManifestRoute(routeName string, app models.Application, appParamsFromContext models.AppParams) error
 }
@@ -105,7 +105,7 @@ func (actor PushActorImpl) ProcessPath(dirOrZipFile string, f func(string) error
 	return nil
 }
 
-func (actor PushActorImpl) GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error) {
+func (actor PushActorImpl) GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool, preserveFileMode bool) ([]resources.AppFileResource, bool, error) {
 	appFileResource := []resources.AppFileResource{}
 	for _, file := range localFiles {
 		appFileResource = append(appFileResource, resources.AppFileResource{
@@ -159,15 +159,11 @@ func (actor PushActorImpl) GatherFiles(localFiles []models.AppFileFields, appDir
 		if runtime.GOOS == "windows" {
 			fullPath = windowsPathPrefix + fullPath
 		}
-		fileInfo, err := os.Lstat(fullPath)
+		fileInfo, err := os.Stat(fullPath)
 		if err != nil {
 			return []resources.AppFileResource{}, false, err
 		}
-		fileMode := fileInfo.Mode()
-
-		if runtime.GOOS == "windows" {
-			fileMode = fileMode | 0700
-		}
+		fileMode := appfiles.UploadFileMode(fileInfo.Mode(), preserveFileMode)
 
 		remoteFiles[i].Mode = fmt.Sprintf("%#o", fileMode)
 	}
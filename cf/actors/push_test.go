@@ -78,7 +78,7 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns an error if we cannot reach the cc", func() {
-				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, true, false)
 				Expect(err).To(HaveOccurred())
 				Expect(err).To(Equal(expectedErr))
 			})
@@ -93,7 +93,7 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns an error", func() {
-				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, true, false)
 				Expect(err).To(HaveOccurred())
 				Expect(err).To(Equal(expectedErr))
 			})
@@ -107,7 +107,7 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("copies the .cfignore file to the upload directory", func() {
-				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, true, false)
 				Expect(err).NotTo(HaveOccurred())
 
 				_, err = os.Stat(filepath.Join(tmpDir, ".cfignore"))
@@ -115,7 +115,29 @@ var _ = Describe("Push Actor", func() {
 			})
 		})
 
-		It("returns files to upload with file mode unchanged on non-Windows platforms", func() {
+		It("returns files to upload with normalized file modes", func() {
+			if runtime.GOOS == "windows" {
+				Skip("This does not run on windows")
+			}
+
+			err := os.Chmod(filepath.Join(fixturesDir, "example-app/ignore-me"), 0666)
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Chmod(filepath.Join(fixturesDir, "example-app/ignore-me"), 0664)
+
+			actualFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedFiles := []resources.AppFileResource{
+				{
+					Path: "example-app/ignore-me",
+					Mode: "0644",
+				},
+			}
+
+			Expect(actualFiles).To(Equal(expectedFiles))
+		})
+
+		It("returns files to upload with file mode unchanged on non-Windows platforms when preserving file modes", func() {
 			if runtime.GOOS == "windows" {
 				Skip("This does not run on windows")
 			}
@@ -125,7 +147,7 @@ var _ = Describe("Push Actor", func() {
 
 			expectedFileMode := fmt.Sprintf("%#o", info.Mode())
 
-			actualFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+			actualFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true, true)
 			Expect(err).NotTo(HaveOccurred())
 
 			expectedFiles := []resources.AppFileResource{
@@ -138,7 +160,7 @@ var _ = Describe("Push Actor", func() {
 			Expect(actualFiles).To(Equal(expectedFiles))
 		})
 
-		It("returns files to upload with file mode always being executable on Windows platforms", func() {
+		It("returns files to upload with file mode always being executable on Windows platforms when preserving file modes", func() {
 			if runtime.GOOS != "windows" {
 				Skip("This runs only on windows")
 			}
@@ -148,7 +170,7 @@ var _ = Describe("Push Actor", func() {
 
 			expectedFileMode := fmt.Sprintf("%#o", info.Mode()|0700)
 
-			actualFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+			actualFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true, true)
 			Expect(err).NotTo(HaveOccurred())
 
 			expectedFiles := []resources.AppFileResource{
@@ -167,7 +189,7 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns true for hasFileToUpload", func() {
-				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true, false)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(hasFileToUpload).To(BeTrue())
 			})
@@ -182,7 +204,7 @@ var _ = Describe("Push Actor", func() {
 					{Path: "example-app/ignore-me"},
 					{Path: "example-app/manifest.yml"},
 				}
-				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true, false)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(appFiles.CopyFilesCallCount()).To(Equal(1))
@@ -205,7 +227,7 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns true for hasFileToUpload", func() {
-				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true, false)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(hasFileToUpload).To(BeTrue())
 			})
@@ -219,7 +241,7 @@ var _ = Describe("Push Actor", func() {
 					{Path: "example-app/Gemfile.lock"},
 					{Path: "example-app/ignore-me"},
 				}
-				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true, false)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(appFiles.CopyFilesCallCount()).To(Equal(1))
@@ -246,13 +268,13 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns false for hasFileToUpload", func() {
-				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true, false)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(hasFileToUpload).To(BeFalse())
 			})
 
 			It("copies nothing to the upload dir", func() {
-				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true, false)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(appFiles.CopyFilesCallCount()).To(Equal(1))
@@ -265,7 +287,7 @@ var _ = Describe("Push Actor", func() {
 
 		Context("when told not to use the remote cache", func() {
 			It("does not use the remote cache", func() {
-				actor.GatherFiles(allFiles, fixturesDir, tmpDir, false)
+				actor.GatherFiles(allFiles, fixturesDir, tmpDir, false, false)
 				Expect(appBitsRepo.GetApplicationFilesCallCount()).To(Equal(0))
 			})
 		})
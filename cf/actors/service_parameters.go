@@ -0,0 +1,56 @@
+package actors
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/json"
+)
+
+//go:generate counterfeiter . ServiceParametersActor
+
+type ServiceParametersActor interface {
+	GetPlanSchemas(planGUID string) (models.ServicePlanSchemas, error)
+	ValidateParameters(schema map[string]interface{}, params map[string]interface{}) error
+}
+
+// InvalidServiceParametersError is returned when configuration parameters do
+// not match the schema published for a service plan.
+type InvalidServiceParametersError struct {
+	Errors []string
+}
+
+func (e InvalidServiceParametersError) Error() string {
+	return T("The configuration provided for -c flag does not match the parameters schema of the service plan:") +
+		"\n   " + strings.Join(e.Errors, "\n   ")
+}
+
+type ServiceParametersHandler struct {
+	servicePlanRepo api.ServicePlanRepository
+}
+
+func NewServiceParametersHandler(plan api.ServicePlanRepository) ServiceParametersHandler {
+	return ServiceParametersHandler{
+		servicePlanRepo: plan,
+	}
+}
+
+func (actor ServiceParametersHandler) GetPlanSchemas(planGUID string) (models.ServicePlanSchemas, error) {
+	return actor.servicePlanRepo.GetSchemas(planGUID)
+}
+
+// ValidateParameters validates params against schema. Nothing is validated
+// when the plan has no schema or no parameters are provided, leaving it to
+// the broker to apply its defaults.
+func (actor ServiceParametersHandler) ValidateParameters(schema map[string]interface{}, params map[string]interface{}) error {
+	if schema == nil || params == nil {
+		return nil
+	}
+
+	if errs := json.ValidateAgainstSchema(schema, params); len(errs) > 0 {
+		return InvalidServiceParametersError{Errors: errs}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package actors_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Parameters", func() {
+	var (
+		actor           actors.ServiceParametersActor
+		servicePlanRepo *apifakes.FakeServicePlanRepository
+	)
+
+	BeforeEach(func() {
+		servicePlanRepo = new(apifakes.FakeServicePlanRepository)
+		actor = actors.NewServiceParametersHandler(servicePlanRepo)
+	})
+
+	Describe("GetPlanSchemas", func() {
+		It("returns the schemas of the plan", func() {
+			schemas := models.ServicePlanSchemas{
+				CreateParameters: map[string]interface{}{"type": "object"},
+			}
+			servicePlanRepo.GetSchemasReturns(schemas, nil)
+
+			returnedSchemas, err := actor.GetPlanSchemas("some-plan-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(returnedSchemas).To(Equal(schemas))
+			Expect(servicePlanRepo.GetSchemasArgsForCall(0)).To(Equal("some-plan-guid"))
+		})
+
+		It("returns the error when fetching the schemas fails", func() {
+			servicePlanRepo.GetSchemasReturns(models.ServicePlanSchemas{}, errors.New("boom"))
+
+			_, err := actor.GetPlanSchemas("some-plan-guid")
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+
+	Describe("ValidateParameters", func() {
+		var schema map[string]interface{}
+
+		BeforeEach(func() {
+			schema = map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"size"},
+				"properties": map[string]interface{}{
+					"size": map[string]interface{}{"type": "integer"},
+				},
+			}
+		})
+
+		It("accepts parameters that match the schema", func() {
+			Expect(actor.ValidateParameters(schema, map[string]interface{}{"size": float64(2)})).To(Succeed())
+		})
+
+		It("returns an InvalidServiceParametersError when the parameters do not match", func() {
+			err := actor.ValidateParameters(schema, map[string]interface{}{"size": "large"})
+			Expect(err).To(Equal(actors.InvalidServiceParametersError{
+				Errors: []string{"size: expected integer, got string"},
+			}))
+			Expect(err.Error()).To(ContainSubstring("does not match the parameters schema"))
+			Expect(err.Error()).To(ContainSubstring("size: expected integer, got string"))
+		})
+
+		It("does not validate when no parameters are provided", func() {
+			Expect(actor.ValidateParameters(schema, nil)).To(Succeed())
+		})
+
+		It("does not validate when the plan has no schema", func() {
+			Expect(actor.ValidateParameters(nil, map[string]interface{}{"size": "large"})).To(Succeed())
+		})
+	})
+})
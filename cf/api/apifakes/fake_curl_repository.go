@@ -8,7 +8,7 @@ import (
 )
 
 type FakeCurlRepository struct {
-	RequestStub        func(method, path, header, body string) (resHeaders string, resBody string, apiErr error)
+	RequestStub        func(method string, path string, header string, body string) (string, string, error)
 	requestMutex       sync.RWMutex
 	requestArgsForCall []struct {
 		method string
@@ -21,12 +21,37 @@ type FakeCurlRepository struct {
 		result2 string
 		result3 error
 	}
+	requestReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	RequestTargetStub        func(target string, method string, path string, header string, body string) (string, string, error)
+	requestTargetMutex       sync.RWMutex
+	requestTargetArgsForCall []struct {
+		target string
+		method string
+		path   string
+		header string
+		body   string
+	}
+	requestTargetReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	requestTargetReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeCurlRepository) Request(method string, path string, header string, body string) (resHeaders string, resBody string, apiErr error) {
+func (fake *FakeCurlRepository) Request(method string, path string, header string, body string) (string, string, error) {
 	fake.requestMutex.Lock()
+	ret, specificReturn := fake.requestReturnsOnCall[len(fake.requestArgsForCall)]
 	fake.requestArgsForCall = append(fake.requestArgsForCall, struct {
 		method string
 		path   string
@@ -37,9 +62,11 @@ func (fake *FakeCurlRepository) Request(method string, path string, header strin
 	fake.requestMutex.Unlock()
 	if fake.RequestStub != nil {
 		return fake.RequestStub(method, path, header, body)
-	} else {
-		return fake.requestReturns.result1, fake.requestReturns.result2, fake.requestReturns.result3
 	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.requestReturns.result1, fake.requestReturns.result2, fake.requestReturns.result3
 }
 
 func (fake *FakeCurlRepository) RequestCallCount() int {
@@ -63,11 +90,87 @@ func (fake *FakeCurlRepository) RequestReturns(result1 string, result2 string, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCurlRepository) RequestReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.RequestStub = nil
+	if fake.requestReturnsOnCall == nil {
+		fake.requestReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.requestReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCurlRepository) RequestTarget(target string, method string, path string, header string, body string) (string, string, error) {
+	fake.requestTargetMutex.Lock()
+	ret, specificReturn := fake.requestTargetReturnsOnCall[len(fake.requestTargetArgsForCall)]
+	fake.requestTargetArgsForCall = append(fake.requestTargetArgsForCall, struct {
+		target string
+		method string
+		path   string
+		header string
+		body   string
+	}{target, method, path, header, body})
+	fake.recordInvocation("RequestTarget", []interface{}{target, method, path, header, body})
+	fake.requestTargetMutex.Unlock()
+	if fake.RequestTargetStub != nil {
+		return fake.RequestTargetStub(target, method, path, header, body)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.requestTargetReturns.result1, fake.requestTargetReturns.result2, fake.requestTargetReturns.result3
+}
+
+func (fake *FakeCurlRepository) RequestTargetCallCount() int {
+	fake.requestTargetMutex.RLock()
+	defer fake.requestTargetMutex.RUnlock()
+	return len(fake.requestTargetArgsForCall)
+}
+
+func (fake *FakeCurlRepository) RequestTargetArgsForCall(i int) (string, string, string, string, string) {
+	fake.requestTargetMutex.RLock()
+	defer fake.requestTargetMutex.RUnlock()
+	return fake.requestTargetArgsForCall[i].target, fake.requestTargetArgsForCall[i].method, fake.requestTargetArgsForCall[i].path, fake.requestTargetArgsForCall[i].header, fake.requestTargetArgsForCall[i].body
+}
+
+func (fake *FakeCurlRepository) RequestTargetReturns(result1 string, result2 string, result3 error) {
+	fake.RequestTargetStub = nil
+	fake.requestTargetReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCurlRepository) RequestTargetReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.RequestTargetStub = nil
+	if fake.requestTargetReturnsOnCall == nil {
+		fake.requestTargetReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.requestTargetReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCurlRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.requestMutex.RLock()
 	defer fake.requestMutex.RUnlock()
+	fake.requestTargetMutex.RLock()
+	defer fake.requestTargetMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -18,6 +18,10 @@ type FakeServicePlanRepository struct {
 		result1 []models.ServicePlanFields
 		result2 error
 	}
+	searchReturnsOnCall map[int]struct {
+		result1 []models.ServicePlanFields
+		result2 error
+	}
 	UpdateStub        func(models.ServicePlanFields, string, bool) error
 	updateMutex       sync.RWMutex
 	updateArgsForCall []struct {
@@ -28,6 +32,9 @@ type FakeServicePlanRepository struct {
 	updateReturns struct {
 		result1 error
 	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ListPlansFromManyServicesStub        func(serviceGUIDs []string) ([]models.ServicePlanFields, error)
 	listPlansFromManyServicesMutex       sync.RWMutex
 	listPlansFromManyServicesArgsForCall []struct {
@@ -37,12 +44,30 @@ type FakeServicePlanRepository struct {
 		result1 []models.ServicePlanFields
 		result2 error
 	}
+	listPlansFromManyServicesReturnsOnCall map[int]struct {
+		result1 []models.ServicePlanFields
+		result2 error
+	}
+	GetSchemasStub        func(planGUID string) (models.ServicePlanSchemas, error)
+	getSchemasMutex       sync.RWMutex
+	getSchemasArgsForCall []struct {
+		planGUID string
+	}
+	getSchemasReturns struct {
+		result1 models.ServicePlanSchemas
+		result2 error
+	}
+	getSchemasReturnsOnCall map[int]struct {
+		result1 models.ServicePlanSchemas
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakeServicePlanRepository) Search(searchParameters map[string]string) ([]models.ServicePlanFields, error) {
 	fake.searchMutex.Lock()
+	ret, specificReturn := fake.searchReturnsOnCall[len(fake.searchArgsForCall)]
 	fake.searchArgsForCall = append(fake.searchArgsForCall, struct {
 		searchParameters map[string]string
 	}{searchParameters})
@@ -50,9 +75,11 @@ func (fake *FakeServicePlanRepository) Search(searchParameters map[string]string
 	fake.searchMutex.Unlock()
 	if fake.SearchStub != nil {
 		return fake.SearchStub(searchParameters)
-	} else {
-		return fake.searchReturns.result1, fake.searchReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.searchReturns.result1, fake.searchReturns.result2
 }
 
 func (fake *FakeServicePlanRepository) SearchCallCount() int {
@@ -75,8 +102,23 @@ func (fake *FakeServicePlanRepository) SearchReturns(result1 []models.ServicePla
 	}{result1, result2}
 }
 
+func (fake *FakeServicePlanRepository) SearchReturnsOnCall(i int, result1 []models.ServicePlanFields, result2 error) {
+	fake.SearchStub = nil
+	if fake.searchReturnsOnCall == nil {
+		fake.searchReturnsOnCall = make(map[int]struct {
+			result1 []models.ServicePlanFields
+			result2 error
+		})
+	}
+	fake.searchReturnsOnCall[i] = struct {
+		result1 []models.ServicePlanFields
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServicePlanRepository) Update(arg1 models.ServicePlanFields, arg2 string, arg3 bool) error {
 	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
 	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
 		arg1 models.ServicePlanFields
 		arg2 string
@@ -86,9 +128,11 @@ func (fake *FakeServicePlanRepository) Update(arg1 models.ServicePlanFields, arg
 	fake.updateMutex.Unlock()
 	if fake.UpdateStub != nil {
 		return fake.UpdateStub(arg1, arg2, arg3)
-	} else {
-		return fake.updateReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateReturns.result1
 }
 
 func (fake *FakeServicePlanRepository) UpdateCallCount() int {
@@ -110,6 +154,18 @@ func (fake *FakeServicePlanRepository) UpdateReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeServicePlanRepository) UpdateReturnsOnCall(i int, result1 error) {
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeServicePlanRepository) ListPlansFromManyServices(serviceGUIDs []string) ([]models.ServicePlanFields, error) {
 	var serviceGUIDsCopy []string
 	if serviceGUIDs != nil {
@@ -117,6 +173,7 @@ func (fake *FakeServicePlanRepository) ListPlansFromManyServices(serviceGUIDs []
 		copy(serviceGUIDsCopy, serviceGUIDs)
 	}
 	fake.listPlansFromManyServicesMutex.Lock()
+	ret, specificReturn := fake.listPlansFromManyServicesReturnsOnCall[len(fake.listPlansFromManyServicesArgsForCall)]
 	fake.listPlansFromManyServicesArgsForCall = append(fake.listPlansFromManyServicesArgsForCall, struct {
 		serviceGUIDs []string
 	}{serviceGUIDsCopy})
@@ -124,9 +181,11 @@ func (fake *FakeServicePlanRepository) ListPlansFromManyServices(serviceGUIDs []
 	fake.listPlansFromManyServicesMutex.Unlock()
 	if fake.ListPlansFromManyServicesStub != nil {
 		return fake.ListPlansFromManyServicesStub(serviceGUIDs)
-	} else {
-		return fake.listPlansFromManyServicesReturns.result1, fake.listPlansFromManyServicesReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listPlansFromManyServicesReturns.result1, fake.listPlansFromManyServicesReturns.result2
 }
 
 func (fake *FakeServicePlanRepository) ListPlansFromManyServicesCallCount() int {
@@ -149,6 +208,71 @@ func (fake *FakeServicePlanRepository) ListPlansFromManyServicesReturns(result1
 	}{result1, result2}
 }
 
+func (fake *FakeServicePlanRepository) ListPlansFromManyServicesReturnsOnCall(i int, result1 []models.ServicePlanFields, result2 error) {
+	fake.ListPlansFromManyServicesStub = nil
+	if fake.listPlansFromManyServicesReturnsOnCall == nil {
+		fake.listPlansFromManyServicesReturnsOnCall = make(map[int]struct {
+			result1 []models.ServicePlanFields
+			result2 error
+		})
+	}
+	fake.listPlansFromManyServicesReturnsOnCall[i] = struct {
+		result1 []models.ServicePlanFields
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServicePlanRepository) GetSchemas(planGUID string) (models.ServicePlanSchemas, error) {
+	fake.getSchemasMutex.Lock()
+	ret, specificReturn := fake.getSchemasReturnsOnCall[len(fake.getSchemasArgsForCall)]
+	fake.getSchemasArgsForCall = append(fake.getSchemasArgsForCall, struct {
+		planGUID string
+	}{planGUID})
+	fake.recordInvocation("GetSchemas", []interface{}{planGUID})
+	fake.getSchemasMutex.Unlock()
+	if fake.GetSchemasStub != nil {
+		return fake.GetSchemasStub(planGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSchemasReturns.result1, fake.getSchemasReturns.result2
+}
+
+func (fake *FakeServicePlanRepository) GetSchemasCallCount() int {
+	fake.getSchemasMutex.RLock()
+	defer fake.getSchemasMutex.RUnlock()
+	return len(fake.getSchemasArgsForCall)
+}
+
+func (fake *FakeServicePlanRepository) GetSchemasArgsForCall(i int) string {
+	fake.getSchemasMutex.RLock()
+	defer fake.getSchemasMutex.RUnlock()
+	return fake.getSchemasArgsForCall[i].planGUID
+}
+
+func (fake *FakeServicePlanRepository) GetSchemasReturns(result1 models.ServicePlanSchemas, result2 error) {
+	fake.GetSchemasStub = nil
+	fake.getSchemasReturns = struct {
+		result1 models.ServicePlanSchemas
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServicePlanRepository) GetSchemasReturnsOnCall(i int, result1 models.ServicePlanSchemas, result2 error) {
+	fake.GetSchemasStub = nil
+	if fake.getSchemasReturnsOnCall == nil {
+		fake.getSchemasReturnsOnCall = make(map[int]struct {
+			result1 models.ServicePlanSchemas
+			result2 error
+		})
+	}
+	fake.getSchemasReturnsOnCall[i] = struct {
+		result1 models.ServicePlanSchemas
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServicePlanRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -158,6 +282,8 @@ func (fake *FakeServicePlanRepository) Invocations() map[string][][]interface{}
 	defer fake.updateMutex.RUnlock()
 	fake.listPlansFromManyServicesMutex.RLock()
 	defer fake.listPlansFromManyServicesMutex.RUnlock()
+	fake.getSchemasMutex.RLock()
+	defer fake.getSchemasMutex.RUnlock()
 	return fake.invocations
 }
 
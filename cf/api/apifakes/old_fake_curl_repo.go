@@ -1,6 +1,7 @@
 package apifakes
 
 type OldFakeCurlRepository struct {
+	Target         string
 	Method         string
 	Path           string
 	Header         string
@@ -21,3 +22,8 @@ func (repo *OldFakeCurlRepository) Request(method, path, header, body string) (r
 	apiErr = repo.Error
 	return
 }
+
+func (repo *OldFakeCurlRepository) RequestTarget(target, method, path, header, body string) (resHeaders, resBody string, apiErr error) {
+	repo.Target = target
+	return repo.Request(method, path, header, body)
+}
@@ -25,6 +25,13 @@ type OldFakeServicePlanRepo struct {
 
 	ListPlansFromManyServicesReturns []models.ServicePlanFields
 	ListPlansFromManyServicesError   error
+
+	GetSchemasReturns models.ServicePlanSchemas
+	GetSchemasError   error
+}
+
+func (fake *OldFakeServicePlanRepo) GetSchemas(planGUID string) (models.ServicePlanSchemas, error) {
+	return fake.GetSchemasReturns, fake.GetSchemasError
 }
 
 func (fake *OldFakeServicePlanRepo) ListPlansFromManyServices(serviceGUIDs []string) (plans []models.ServicePlanFields, err error) {
@@ -28,6 +28,7 @@ type Repository interface {
 
 	RefreshAuthToken() (updatedToken string, apiErr error)
 	Authenticate(credentials map[string]string) (apiErr error)
+	AuthenticateWithAssertion(assertion string) (apiErr error)
 	Authorize(token string) (string, error)
 	GetLoginPromptsAndSaveUAAServerURL() (map[string]coreconfig.AuthPrompt, error)
 }
@@ -40,6 +41,22 @@ type UAARepository struct {
 
 var ErrPreventRedirect = errors.New("prevent-redirect")
 
+// JWTBearerGrantType is the OAuth grant type that exchanges a JWT issued by an
+// identity provider trusted by UAA for an access token.
+const JWTBearerGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+
+// MFACodePromptKey is the login prompt and credential that UAA uses for the
+// multi-factor authentication code.
+const MFACodePromptKey = "mfaCode"
+
+// MFARequiredError is returned by Authenticate when UAA requires a
+// multi-factor authentication code that was not provided in the credentials.
+type MFARequiredError struct{}
+
+func (MFARequiredError) Error() string {
+	return T("A multi-factor authentication code is required.")
+}
+
 func NewUAARepository(gateway net.Gateway, config coreconfig.ReadWriter, dumper net.RequestDumper) UAARepository {
 	return UAARepository{
 		config:  config,
@@ -124,6 +141,8 @@ func (uaa UAARepository) Authenticate(credentials map[string]string) error {
 		httpError, ok := err.(errors.HTTPError)
 		if ok {
 			switch {
+			case isMFARequired(httpError):
+				return MFARequiredError{}
 			case httpError.StatusCode() == http.StatusUnauthorized:
 				return errors.New(T("Credentials were rejected, please try again."))
 			case httpError.StatusCode() >= http.StatusInternalServerError:
@@ -137,6 +156,43 @@ func (uaa UAARepository) Authenticate(credentials map[string]string) error {
 	return nil
 }
 
+// AuthenticateWithAssertion exchanges the JWT assertion, such as an OIDC token
+// issued to a CI job, for an access token.
+func (uaa UAARepository) AuthenticateWithAssertion(assertion string) error {
+	data := url.Values{
+		"grant_type": {JWTBearerGrantType},
+		"assertion":  {assertion},
+		"scope":      {""},
+	}
+
+	err := uaa.getAuthToken(data)
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *errors.InvalidTokenError:
+		return fmt.Errorf(T("The assertion was rejected: %s"), e.Error())
+	case errors.HTTPError:
+		switch {
+		case e.StatusCode() == http.StatusUnauthorized:
+			return errors.New(T("The assertion was rejected. Check that its issuer is configured as an identity provider in UAA."))
+		case e.StatusCode() >= http.StatusInternalServerError:
+			return errors.New(T("The targeted API endpoint could not be reached."))
+		}
+	}
+
+	return err
+}
+
+// isMFARequired returns true when UAA rejected the credentials because the
+// multi-factor authentication code is missing.
+func isMFARequired(httpError errors.HTTPError) bool {
+	if httpError.ErrorCode() == "mfa_required" {
+		return true
+	}
+	return httpError.StatusCode() == http.StatusUnauthorized &&
+		strings.Contains(strings.ToLower(httpError.Error()), "multi-factor authentication")
+}
+
 func (uaa UAARepository) DumpRequest(req *http.Request) {
 	uaa.dumper.DumpRequest(req)
 }
@@ -222,6 +278,9 @@ func (uaa UAARepository) getAuthToken(data url.Values) error {
 	case errors.HTTPError:
 		return err
 	case *errors.InvalidTokenError:
+		if data.Get("grant_type") == JWTBearerGrantType {
+			return err
+		}
 		return errors.New(T("Authentication has expired.  Please log back in to re-authenticate.\n\nTIP: Use `cf login -a <endpoint> -u <user> -o <org> -s <space>` to log back in and re-authenticate."))
 	default:
 		return fmt.Errorf("%s: %s", T("auth request failed"), err.Error())
@@ -123,6 +123,18 @@ var _ = Describe("AuthenticationRepository", func() {
 				})
 			})
 
+			Context("when UAA requires a multi-factor authentication code", func() {
+				BeforeEach(func() {
+					setupTestServer(mfaRequiredLoginRequest)
+				})
+
+				It("returns an MFARequiredError", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(err).To(MatchError(MFARequiredError{}))
+					Expect(config.AccessToken()).To(BeEmpty())
+				})
+			})
+
 			Describe("when the UAA server has an error but still returns a 200", func() {
 				BeforeEach(func() {
 					setupTestServer(errorMaskedAsSuccessLoginRequest)
@@ -137,6 +149,50 @@ var _ = Describe("AuthenticationRepository", func() {
 			})
 		})
 
+		Describe("authenticating with an assertion", func() {
+			var err error
+
+			JustBeforeEach(func() {
+				err = auth.AuthenticateWithAssertion("some-jwt")
+			})
+
+			Context("when UAA accepts the assertion", func() {
+				BeforeEach(func() {
+					setupTestServer(successfulAssertionRequest)
+				})
+
+				It("stores the access and refresh tokens in the config", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(config.AccessToken()).To(Equal("BEARER my_access_token"))
+					Expect(config.RefreshToken()).To(Equal("my_refresh_token"))
+				})
+			})
+
+			Context("when UAA rejects the assertion", func() {
+				BeforeEach(func() {
+					setupTestServer(unsuccessfulLoginRequest)
+				})
+
+				It("returns an error", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(err).To(MatchError("The assertion was rejected. Check that its issuer is configured as an identity provider in UAA."))
+					Expect(config.AccessToken()).To(BeEmpty())
+				})
+			})
+
+			Context("when UAA reports that the assertion is an invalid token", func() {
+				BeforeEach(func() {
+					setupTestServer(refreshTokenExpiredRequestError)
+				})
+
+				It("returns the reason instead of the reauthentication message", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(err).To(MatchError(ContainSubstring("The assertion was rejected: Invalid auth token: ")))
+				})
+			})
+		})
+
 		Describe("getting login info", func() {
 			var (
 				apiErr  error
@@ -361,6 +417,32 @@ var successfulLoginMatcher = func(request *http.Request) {
 	Expect(request.Form.Get("scope")).To(Equal(""))
 }
 
+var successfulAssertionRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: authHeaders,
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		if err != nil {
+			Fail(fmt.Sprintf("Failed to parse form: %s", err))
+			return
+		}
+
+		Expect(request.Form.Get("grant_type")).To(Equal("urn:ietf:params:oauth:grant-type:jwt-bearer"))
+		Expect(request.Form.Get("assertion")).To(Equal("some-jwt"))
+		Expect(request.Form).ToNot(HaveKey("password"))
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_access_token",
+  "token_type": "BEARER",
+  "refresh_token": "my_refresh_token",
+  "expires_in": 98765
+} `},
+}
+
 var unsuccessfulLoginRequest = testnet.TestRequest{
 	Method: "POST",
 	Path:   "/oauth/token",
@@ -368,6 +450,19 @@ var unsuccessfulLoginRequest = testnet.TestRequest{
 		Status: http.StatusUnauthorized,
 	},
 }
+var mfaRequiredLoginRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Response: testnet.TestResponse{
+		Status: http.StatusUnauthorized,
+		Body: `
+{
+	"error": "unauthorized",
+	"error_description": "A multi-factor authentication code is required to complete the request"
+}
+`},
+}
+
 var refreshTokenExpiredRequestError = testnet.TestRequest{
 	Method: "POST",
 	Path:   "/oauth/token",
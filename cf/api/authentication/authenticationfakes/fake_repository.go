@@ -35,6 +35,14 @@ type FakeRepository struct {
 	authenticateReturns struct {
 		result1 error
 	}
+	AuthenticateWithAssertionStub        func(assertion string) (apiErr error)
+	authenticateWithAssertionMutex       sync.RWMutex
+	authenticateWithAssertionArgsForCall []struct {
+		assertion string
+	}
+	authenticateWithAssertionReturns struct {
+		result1 error
+	}
 	AuthorizeStub        func(token string) (string, error)
 	authorizeMutex       sync.RWMutex
 	authorizeArgsForCall []struct {
@@ -162,6 +170,39 @@ func (fake *FakeRepository) AuthenticateReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRepository) AuthenticateWithAssertion(assertion string) (apiErr error) {
+	fake.authenticateWithAssertionMutex.Lock()
+	fake.authenticateWithAssertionArgsForCall = append(fake.authenticateWithAssertionArgsForCall, struct {
+		assertion string
+	}{assertion})
+	fake.recordInvocation("AuthenticateWithAssertion", []interface{}{assertion})
+	fake.authenticateWithAssertionMutex.Unlock()
+	if fake.AuthenticateWithAssertionStub != nil {
+		return fake.AuthenticateWithAssertionStub(assertion)
+	} else {
+		return fake.authenticateWithAssertionReturns.result1
+	}
+}
+
+func (fake *FakeRepository) AuthenticateWithAssertionCallCount() int {
+	fake.authenticateWithAssertionMutex.RLock()
+	defer fake.authenticateWithAssertionMutex.RUnlock()
+	return len(fake.authenticateWithAssertionArgsForCall)
+}
+
+func (fake *FakeRepository) AuthenticateWithAssertionArgsForCall(i int) string {
+	fake.authenticateWithAssertionMutex.RLock()
+	defer fake.authenticateWithAssertionMutex.RUnlock()
+	return fake.authenticateWithAssertionArgsForCall[i].assertion
+}
+
+func (fake *FakeRepository) AuthenticateWithAssertionReturns(result1 error) {
+	fake.AuthenticateWithAssertionStub = nil
+	fake.authenticateWithAssertionReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRepository) Authorize(token string) (string, error) {
 	fake.authorizeMutex.Lock()
 	fake.authorizeArgsForCall = append(fake.authorizeArgsForCall, struct {
@@ -233,6 +274,8 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.refreshAuthTokenMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithAssertionMutex.RLock()
+	defer fake.authenticateWithAssertionMutex.RUnlock()
 	fake.authorizeMutex.RLock()
 	defer fake.authorizeMutex.RUnlock()
 	fake.getLoginPromptsAndSaveUAAServerURLMutex.RLock()
@@ -89,7 +89,8 @@ func (repo CloudControllerBuildpackBitsRepository) CreateBuildpackZipFile(buildp
 
 		if stats.IsDir() {
 			buildpackFileName += ".zip" // FIXME: remove once #71167394 is fixed
-			err = repo.zipper.Zip(buildpackPath, zipFileToUpload)
+			// Buildpacks are uploaded with the file modes they are built with.
+			err = repo.zipper.Zip(buildpackPath, zipFileToUpload, true)
 			if err != nil {
 				return nil, "", zipErrorHelper(err)
 			}
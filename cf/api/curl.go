@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/textproto"
+	"net/url"
 	"strings"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -19,8 +20,18 @@ import (
 
 type CurlRepository interface {
 	Request(method, path, header, body string) (resHeaders string, resBody string, apiErr error)
+	RequestTarget(target, method, path, header, body string) (resHeaders string, resBody string, apiErr error)
 }
 
+// The platform APIs RequestTarget can send requests to.
+const (
+	CurlTargetCloudController = "cc"
+	CurlTargetUAA             = "uaa"
+	CurlTargetRouting         = "routing"
+	CurlTargetNetworking      = "networking"
+	CurlTargetLogCache        = "log-cache"
+)
+
 type CloudControllerCurlRepository struct {
 	config  coreconfig.Reader
 	gateway net.Gateway
@@ -33,7 +44,18 @@ func NewCloudControllerCurlRepository(config coreconfig.Reader, gateway net.Gate
 }
 
 func (repo CloudControllerCurlRepository) Request(method, path, headerString, body string) (resHeaders, resBody string, err error) {
-	url := fmt.Sprintf("%s/%s", repo.config.APIEndpoint(), strings.TrimLeft(path, "/"))
+	return repo.RequestTarget(CurlTargetCloudController, method, path, headerString, body)
+}
+
+// RequestTarget sends the request to the given platform API, authenticated
+// with the current access token. The base URLs of the networking and Log
+// Cache APIs are discovered from the Cloud Controller root endpoint.
+func (repo CloudControllerCurlRepository) RequestTarget(target, method, path, headerString, body string) (resHeaders, resBody string, err error) {
+	endpoint, err := repo.targetEndpoint(target)
+	if err != nil {
+		return
+	}
+	url := fmt.Sprintf("%s/%s", endpoint, strings.TrimLeft(path, "/"))
 
 	if method == "" && body != "" {
 		method = "POST"
@@ -73,6 +95,71 @@ func (repo CloudControllerCurlRepository) Request(method, path, headerString, bo
 	return
 }
 
+func (repo CloudControllerCurlRepository) targetEndpoint(target string) (string, error) {
+	var endpoint string
+	switch target {
+	case CurlTargetCloudController:
+		endpoint = repo.config.APIEndpoint()
+	case CurlTargetUAA:
+		endpoint = repo.config.UaaEndpoint()
+	case CurlTargetRouting:
+		endpoint = repo.config.RoutingAPIEndpoint()
+	case CurlTargetNetworking, CurlTargetLogCache:
+		links, err := repo.rootLinks()
+		if err != nil {
+			return "", err
+		}
+
+		if target == CurlTargetNetworking {
+			endpoint = links["network_policy_v1"]
+		} else {
+			endpoint = links["log_cache"]
+			if endpoint == "" {
+				endpoint = siblingEndpoint(repo.config.APIEndpoint(), "log-cache")
+			}
+		}
+	default:
+		return "", fmt.Errorf("%s: %s", T("Unknown curl target"), target)
+	}
+
+	if endpoint == "" && target != CurlTargetCloudController {
+		return "", fmt.Errorf("%s: %s", T("No endpoint found for curl target"), target)
+	}
+	return strings.TrimRight(endpoint, "/"), nil
+}
+
+// rootLinks returns the hrefs of the links advertised by the Cloud
+// Controller root endpoint, keyed by link name.
+func (repo CloudControllerCurlRepository) rootLinks() (map[string]string, error) {
+	var root struct {
+		Links map[string]struct {
+			Href string `json:"href"`
+		} `json:"links"`
+	}
+	err := repo.gateway.GetResource(repo.config.APIEndpoint()+"/", &root)
+	if err != nil {
+		return nil, err
+	}
+
+	links := map[string]string{}
+	for name, link := range root.Links {
+		links[name] = link.Href
+	}
+	return links, nil
+}
+
+// siblingEndpoint replaces the 'api.' host name prefix of apiEndpoint with
+// hostPrefix. Nothing is returned when the host does not start with 'api.'.
+func siblingEndpoint(apiEndpoint string, hostPrefix string) string {
+	endpoint, err := url.Parse(apiEndpoint)
+	if err != nil || !strings.HasPrefix(endpoint.Host, "api.") {
+		return ""
+	}
+	endpoint.Host = hostPrefix + "." + strings.TrimPrefix(endpoint.Host, "api.")
+	endpoint.Path = ""
+	return endpoint.String()
+}
+
 func mergeHeaders(destination http.Header, headerString string) (err error) {
 	headerString = strings.TrimSpace(headerString)
 	headerString += "\n\n"
@@ -158,6 +158,86 @@ var _ = Describe("CloudControllerCurlRepository ", func() {
 		})
 	})
 
+	Describe("RequestTarget", func() {
+		var (
+			server *ghttp.Server
+			deps   curlDependencies
+			repo   CloudControllerCurlRepository
+		)
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+			deps = newCurlDependencies()
+			deps.config.SetAPIEndpoint(server.URL())
+			repo = NewCloudControllerCurlRepository(deps.config, deps.gateway)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("sends uaa requests to the UAA endpoint", func() {
+			deps.config.SetUaaEndpoint(server.URL() + "/uaa")
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/uaa/Users"),
+					ghttp.VerifyHeaderKV("Authorization", "BEARER my_access_token"),
+				),
+			)
+
+			_, _, err := repo.RequestTarget("uaa", "GET", "/Users", "", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("sends routing requests to the routing API endpoint", func() {
+			deps.config.SetRoutingAPIEndpoint(server.URL() + "/routing")
+			server.AppendHandlers(ghttp.VerifyRequest("GET", "/routing/v1/router_groups"))
+
+			_, _, err := repo.RequestTarget("routing", "GET", "/v1/router_groups", "", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("discovers the networking endpoint from the Cloud Controller root", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/"),
+					ghttp.RespondWith(http.StatusOK, `{"links": {"network_policy_v1": {"href": "`+server.URL()+`/networking/v1/external"}}}`),
+				),
+				ghttp.VerifyRequest("GET", "/networking/v1/external/policies"),
+			)
+
+			_, _, err := repo.RequestTarget("networking", "GET", "/policies", "", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("discovers the Log Cache endpoint from the Cloud Controller root", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, `{"links": {"log_cache": {"href": "`+server.URL()+`/log-cache/"}}}`),
+				ghttp.VerifyRequest("GET", "/log-cache/api/v1/meta"),
+			)
+
+			_, _, err := repo.RequestTarget("log-cache", "GET", "/api/v1/meta", "", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("returns an error when the endpoint is not advertised", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{"links": {}}`))
+
+			_, _, err := repo.RequestTarget("networking", "GET", "/policies", "", "")
+			Expect(err).To(MatchError("No endpoint found for curl target: networking"))
+		})
+
+		It("returns an error for an unknown target", func() {
+			_, _, err := repo.RequestTarget("bbs", "GET", "/v1/ping", "", "")
+			Expect(err).To(MatchError("Unknown curl target: bbs"))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
 	It("uses POST as the default method when a body is provided", func() {
 		ccServer := ghttp.NewServer()
 		ccServer.AppendHandlers(
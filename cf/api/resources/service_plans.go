@@ -16,9 +16,21 @@ type ServicePlanEntity struct {
 	Free                bool
 	Public              bool
 	Active              bool
-	Description         string                  `json:"description"`
-	ServiceOfferingGUID string                  `json:"service_guid"`
-	ServiceOffering     ServiceOfferingResource `json:"service"`
+	Description         string                   `json:"description"`
+	ServiceOfferingGUID string                   `json:"service_guid"`
+	ServiceOffering     ServiceOfferingResource  `json:"service"`
+	Schemas             ServicePlanSchemasEntity `json:"schemas"`
+}
+
+type ServicePlanSchemasEntity struct {
+	ServiceInstance struct {
+		Create struct {
+			Parameters map[string]interface{} `json:"parameters"`
+		} `json:"create"`
+		Update struct {
+			Parameters map[string]interface{} `json:"parameters"`
+		} `json:"update"`
+	} `json:"service_instance"`
 }
 
 type ServicePlanDescription struct {
@@ -38,6 +50,23 @@ func (resource ServicePlanResource) ToFields() (fields models.ServicePlanFields)
 	return
 }
 
+func (resource ServicePlanResource) ToSchemas() models.ServicePlanSchemas {
+	instanceSchemas := resource.Entity.Schemas.ServiceInstance
+	return models.ServicePlanSchemas{
+		CreateParameters: emptySchemaToNil(instanceSchemas.Create.Parameters),
+		UpdateParameters: emptySchemaToNil(instanceSchemas.Update.Parameters),
+	}
+}
+
+// emptySchemaToNil treats the empty schema the Cloud Controller returns for
+// plans without schemas as no schema.
+func emptySchemaToNil(schema map[string]interface{}) map[string]interface{} {
+	if len(schema) == 0 {
+		return nil
+	}
+	return schema
+}
+
 func (planDesc ServicePlanDescription) String() string {
 	if planDesc.ServiceProvider == "" {
 		return fmt.Sprintf("%s %s", planDesc.ServiceLabel, planDesc.ServicePlanName) // v2 plan
@@ -17,6 +17,7 @@ type ServicePlanRepository interface {
 	Search(searchParameters map[string]string) ([]models.ServicePlanFields, error)
 	Update(models.ServicePlanFields, string, bool) error
 	ListPlansFromManyServices(serviceGUIDs []string) ([]models.ServicePlanFields, error)
+	GetSchemas(planGUID string) (models.ServicePlanSchemas, error)
 }
 
 type CloudControllerServicePlanRepository struct {
@@ -56,6 +57,18 @@ func (repo CloudControllerServicePlanRepository) ListPlansFromManyServices(servi
 	return plans, err
 }
 
+func (repo CloudControllerServicePlanRepository) GetSchemas(planGUID string) (models.ServicePlanSchemas, error) {
+	var resource resources.ServicePlanResource
+	err := repo.gateway.GetResource(
+		fmt.Sprintf("%s/v2/service_plans/%s", repo.config.APIEndpoint(), planGUID),
+		&resource,
+	)
+	if err != nil {
+		return models.ServicePlanSchemas{}, err
+	}
+	return resource.ToSchemas(), nil
+}
+
 func (repo CloudControllerServicePlanRepository) Search(queryParams map[string]string) (plans []models.ServicePlanFields, err error) {
 	err = repo.gateway.ListPaginatedResources(
 		repo.config.APIEndpoint(),
@@ -138,6 +138,61 @@ var _ = Describe("Service Plan Repository", func() {
 			Expect(servicePlansFields[1].GUID).To(Equal("plan2"))
 		})
 	})
+
+	Describe(".GetSchemas", func() {
+		Context("when the plan publishes schemas", func() {
+			BeforeEach(func() {
+				setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/service_plans/my-service-plan-guid",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body: `{
+							"metadata": {"guid": "my-service-plan-guid"},
+							"entity": {
+								"name": "my-service-plan",
+								"schemas": {
+									"service_instance": {
+										"create": {"parameters": {"type": "object", "required": ["size"]}},
+										"update": {"parameters": {}}
+									},
+									"service_binding": {"create": {"parameters": {}}}
+								}
+							}
+						}`,
+					},
+				}))
+			})
+
+			It("returns the service instance schemas", func() {
+				schemas, err := repo.GetSchemas("my-service-plan-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(testHandler).To(HaveAllRequestsCalled())
+
+				Expect(schemas).To(Equal(models.ServicePlanSchemas{
+					CreateParameters: map[string]interface{}{
+						"type":     "object",
+						"required": []interface{}{"size"},
+					},
+				}))
+			})
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "GET",
+					Path:     "/v2/service_plans/my-service-plan-guid",
+					Response: testnet.TestResponse{Status: http.StatusInternalServerError},
+				}))
+			})
+
+			It("returns the error", func() {
+				_, err := repo.GetSchemas("my-service-plan-guid")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
 
 var firstPlanRequest = apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
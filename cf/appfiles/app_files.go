@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/gofileutils/fileutils"
@@ -35,7 +36,7 @@ func (appfiles ApplicationFiles) AppFilesInDir(dir string) ([]models.AppFileFiel
 	}
 
 	toplevelErr = appfiles.WalkAppFiles(fullDirPath, func(fileName string, fullPath string) error {
-		fileInfo, err := os.Lstat(fullPath)
+		fileInfo, err := os.Stat(fullPath)
 		if err != nil {
 			return err
 		}
@@ -83,29 +84,21 @@ func (appfiles ApplicationFiles) shaFile(fullPath string) (string, error) {
 func (appfiles ApplicationFiles) CopyFiles(appFiles []models.AppFileFields, fromDir, toDir string) error {
 	for _, file := range appFiles {
 		err := func() error {
-			fromPath, err := filepath.Abs(filepath.Join(fromDir, file.Path))
+			fromPath, err := absLongPath(filepath.Join(fromDir, file.Path))
 			if err != nil {
 				return err
 			}
 
-			if runtime.GOOS == "windows" {
-				fromPath = windowsPathPrefix + fromPath
-			}
-
 			srcFileInfo, err := os.Stat(fromPath)
 			if err != nil {
 				return err
 			}
 
-			toPath, err := filepath.Abs(filepath.Join(toDir, file.Path))
+			toPath, err := absLongPath(filepath.Join(toDir, file.Path))
 			if err != nil {
 				return err
 			}
 
-			if runtime.GOOS == "windows" {
-				toPath = windowsPathPrefix + toPath
-			}
-
 			if srcFileInfo.IsDir() {
 				err = os.MkdirAll(toPath, srcFileInfo.Mode())
 				if err != nil {
@@ -163,23 +156,32 @@ func (appfiles ApplicationFiles) CountFiles(directory string) int64 {
 }
 
 func (appfiles ApplicationFiles) WalkAppFiles(dir string, onEachFile func(string, string) error) error {
-	cfIgnore := loadIgnoreFile(dir)
-	walkFunc := func(fullPath string, f os.FileInfo, err error) error {
-		fileRelativePath, _ := filepath.Rel(dir, fullPath)
-		fileRelativeUnixPath := filepath.ToSlash(fileRelativePath)
+	root, err := longPath(dir)
+	if err != nil {
+		return err
+	}
 
-		if err != nil && runtime.GOOS == "windows" {
-			f, err = os.Lstat(windowsPathPrefix + fullPath)
-			if err != nil {
-				return err
-			}
-			fullPath = windowsPathPrefix + fullPath
-		}
+	visited := map[string]bool{}
+	if resolvedRoot, err := filepath.EvalSymlinks(root); err == nil {
+		visited[strings.TrimPrefix(resolvedRoot, windowsPathPrefix)] = true
+	}
 
+	cfIgnore := loadIgnoreFile(root)
+	return appfiles.walkDir(root, "", cfIgnore, visited, onEachFile)
+}
+
+// walkDir calls onEachFile for every file of dir that is not ignored. prefix
+// is the path of dir relative to the application directory.
+func (appfiles ApplicationFiles) walkDir(dir string, prefix string, cfIgnore CfIgnore, visited map[string]bool, onEachFile func(string, string) error) error {
+	walkFunc := func(fullPath string, f os.FileInfo, err error) error {
 		if fullPath == dir {
 			return nil
 		}
 
+		fileRelativePath, _ := filepath.Rel(dir, fullPath)
+		fileRelativePath = filepath.Join(prefix, fileRelativePath)
+		fileRelativeUnixPath := filepath.ToSlash(fileRelativePath)
+
 		if cfIgnore.FileShouldBeIgnored(fileRelativeUnixPath) {
 			if err == nil && f.IsDir() {
 				return filepath.SkipDir
@@ -191,6 +193,10 @@ func (appfiles ApplicationFiles) WalkAppFiles(dir string, onEachFile func(string
 			return err
 		}
 
+		if f.Mode()&os.ModeSymlink != 0 && runtime.GOOS == "windows" {
+			return appfiles.walkLink(fullPath, fileRelativePath, cfIgnore, visited, onEachFile)
+		}
+
 		if !f.Mode().IsRegular() && !f.IsDir() {
 			return nil
 		}
@@ -201,6 +207,73 @@ func (appfiles ApplicationFiles) WalkAppFiles(dir string, onEachFile func(string
 	return filepath.Walk(dir, walkFunc)
 }
 
+// walkLink walks the target of a symbolic link or an NTFS junction as if it
+// were at the path of the link. Windows applications rely on junctions to
+// share directories, such as the packages of .NET solutions. A target is only
+// walked once, which breaks cycles, and links that do not resolve are skipped
+// like the other special files.
+func (appfiles ApplicationFiles) walkLink(linkPath string, relativePath string, cfIgnore CfIgnore, visited map[string]bool, onEachFile func(string, string) error) error {
+	target, err := filepath.EvalSymlinks(linkPath)
+	if err != nil {
+		return nil
+	}
+
+	targetInfo, err := os.Stat(target)
+	if err != nil {
+		return nil
+	}
+
+	if targetInfo.Mode().IsRegular() {
+		return onEachFile(relativePath, linkPath)
+	}
+
+	visitedKey := strings.TrimPrefix(target, windowsPathPrefix)
+	if !targetInfo.IsDir() || visited[visitedKey] {
+		return nil
+	}
+	visited[visitedKey] = true
+
+	err = onEachFile(relativePath, linkPath)
+	if err != nil {
+		return err
+	}
+
+	targetDir, err := longPath(target)
+	if err != nil {
+		return err
+	}
+	return appfiles.walkDir(targetDir, relativePath, cfIgnore, visited, onEachFile)
+}
+
+// longPath returns path in the \\?\ form on Windows, which lifts the limit of
+// 260 characters that the Windows file APIs otherwise put on paths. Other
+// platforms get the path back unchanged.
+func longPath(path string) (string, error) {
+	if runtime.GOOS != "windows" || strings.HasPrefix(path, windowsPathPrefix) {
+		return path, nil
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	// UNC paths take the \\?\UNC\server\share form.
+	if strings.HasPrefix(absPath, `\\`) {
+		return windowsPathPrefix + `UNC\` + absPath[2:], nil
+	}
+	return windowsPathPrefix + absPath, nil
+}
+
+// absLongPath returns the absolute path, in the \\?\ form on Windows.
+func absLongPath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return longPath(absPath)
+}
+
 func loadIgnoreFile(dir string) CfIgnore {
 	fileContents, err := ioutil.ReadFile(filepath.Join(dir, ".cfignore"))
 	if err != nil {
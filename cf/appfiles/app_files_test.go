@@ -3,6 +3,7 @@ package appfiles_test
 import (
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -257,5 +258,125 @@ var _ = Describe("AppFiles", func() {
 				})
 			})
 		})
+
+		Context("when the given dir contains links", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "links-test")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = os.MkdirAll(filepath.Join(tmpDir, "app", "lib"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+				err = ioutil.WriteFile(filepath.Join(tmpDir, "app", "lib", "app.dll"), []byte("some-contents"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = os.MkdirAll(filepath.Join(tmpDir, "packages", "some-package"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+				err = ioutil.WriteFile(filepath.Join(tmpDir, "packages", "some-package", "package.dll"), []byte("some-contents"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := os.RemoveAll(tmpDir)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("on Windows", func() {
+				BeforeEach(func() {
+					if runtime.GOOS != "windows" {
+						Skip("This test only runs on Windows")
+					}
+
+					err := exec.Command("cmd", "/c", "mklink", "/J", filepath.Join(tmpDir, "app", "packages"), filepath.Join(tmpDir, "packages")).Run()
+					Expect(err).NotTo(HaveOccurred())
+					err = exec.Command("cmd", "/c", "mklink", "/J", filepath.Join(tmpDir, "app", "lib", "app"), filepath.Join(tmpDir, "app")).Run()
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("walks the targets of NTFS junctions at the path of the junction", func() {
+					err := appFiles.WalkAppFiles(filepath.Join(tmpDir, "app"), cb)
+					Expect(err).NotTo(HaveOccurred())
+
+					var paths []string
+					for _, actual := range actualWalkAppFileArgs {
+						paths = append(paths, filepath.ToSlash(actual.RelativePath()))
+					}
+					Expect(paths).To(ConsistOf(
+						"lib",
+						"lib/app.dll",
+						"packages",
+						"packages/some-package",
+						"packages/some-package/package.dll",
+					))
+				})
+			})
+
+			Context("on other platforms", func() {
+				BeforeEach(func() {
+					if runtime.GOOS == "windows" {
+						Skip("This test is only for non-Windows platforms")
+					}
+
+					err := os.Symlink(filepath.Join(tmpDir, "packages"), filepath.Join(tmpDir, "app", "packages"))
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("does not follow symbolic links", func() {
+					err := appFiles.WalkAppFiles(filepath.Join(tmpDir, "app"), cb)
+					Expect(err).NotTo(HaveOccurred())
+
+					var paths []string
+					for _, actual := range actualWalkAppFileArgs {
+						paths = append(paths, filepath.ToSlash(actual.RelativePath()))
+					}
+					Expect(paths).To(ConsistOf("lib", "lib/app.dll"))
+				})
+			})
+		})
+
+		Context("when the given dir contains paths longer than 260 characters", func() {
+			var (
+				tmpDir      string
+				longDirPath string
+			)
+
+			BeforeEach(func() {
+				if runtime.GOOS != "windows" {
+					Skip("This test only runs on Windows")
+				}
+
+				var err error
+				tmpDir, err = ioutil.TempDir("", "long-path-test")
+				Expect(err).NotTo(HaveOccurred())
+
+				longDirPath = tmpDir
+				for i := 0; i < 6; i++ {
+					longDirPath = filepath.Join(longDirPath, strings.Repeat("a", 50))
+				}
+				err = os.MkdirAll(`\\?\`+longDirPath, os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+				err = ioutil.WriteFile(`\\?\`+filepath.Join(longDirPath, "file.txt"), []byte("some-contents"), os.ModePerm)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := os.RemoveAll(`\\?\` + tmpDir)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("calls the callback for the files in the long paths", func() {
+				err := appFiles.WalkAppFiles(tmpDir, cb)
+				Expect(err).NotTo(HaveOccurred())
+
+				relativeFilePath, err := filepath.Rel(tmpDir, filepath.Join(longDirPath, "file.txt"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualWalkAppFileArgs).To(ContainElement(WalkAppFileArgs{
+					relativePath: relativeFilePath,
+					absolutePath: `\\?\` + filepath.Join(longDirPath, "file.txt"),
+				}))
+			})
+		})
 	})
 })
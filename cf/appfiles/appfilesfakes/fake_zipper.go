@@ -9,15 +9,19 @@ import (
 )
 
 type FakeZipper struct {
-	ZipStub        func(dirToZip string, targetFile *os.File) (err error)
+	ZipStub        func(dirToZip string, targetFile *os.File, preserveFileMode bool) error
 	zipMutex       sync.RWMutex
 	zipArgsForCall []struct {
-		dirToZip   string
-		targetFile *os.File
+		dirToZip         string
+		targetFile       *os.File
+		preserveFileMode bool
 	}
 	zipReturns struct {
 		result1 error
 	}
+	zipReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IsZipFileStub        func(path string) bool
 	isZipFileMutex       sync.RWMutex
 	isZipFileArgsForCall []struct {
@@ -26,7 +30,10 @@ type FakeZipper struct {
 	isZipFileReturns struct {
 		result1 bool
 	}
-	UnzipStub        func(appDir string, destDir string) (err error)
+	isZipFileReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	UnzipStub        func(appDir string, destDir string) error
 	unzipMutex       sync.RWMutex
 	unzipArgsForCall []struct {
 		appDir  string
@@ -35,6 +42,9 @@ type FakeZipper struct {
 	unzipReturns struct {
 		result1 error
 	}
+	unzipReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetZipSizeStub        func(zipFile *os.File) (int64, error)
 	getZipSizeMutex       sync.RWMutex
 	getZipSizeArgsForCall []struct {
@@ -44,23 +54,31 @@ type FakeZipper struct {
 		result1 int64
 		result2 error
 	}
+	getZipSizeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeZipper) Zip(dirToZip string, targetFile *os.File) (err error) {
+func (fake *FakeZipper) Zip(dirToZip string, targetFile *os.File, preserveFileMode bool) error {
 	fake.zipMutex.Lock()
+	ret, specificReturn := fake.zipReturnsOnCall[len(fake.zipArgsForCall)]
 	fake.zipArgsForCall = append(fake.zipArgsForCall, struct {
-		dirToZip   string
-		targetFile *os.File
-	}{dirToZip, targetFile})
-	fake.recordInvocation("Zip", []interface{}{dirToZip, targetFile})
+		dirToZip         string
+		targetFile       *os.File
+		preserveFileMode bool
+	}{dirToZip, targetFile, preserveFileMode})
+	fake.recordInvocation("Zip", []interface{}{dirToZip, targetFile, preserveFileMode})
 	fake.zipMutex.Unlock()
 	if fake.ZipStub != nil {
-		return fake.ZipStub(dirToZip, targetFile)
-	} else {
-		return fake.zipReturns.result1
+		return fake.ZipStub(dirToZip, targetFile, preserveFileMode)
+	}
+	if specificReturn {
+		return ret.result1
 	}
+	return fake.zipReturns.result1
 }
 
 func (fake *FakeZipper) ZipCallCount() int {
@@ -69,10 +87,10 @@ func (fake *FakeZipper) ZipCallCount() int {
 	return len(fake.zipArgsForCall)
 }
 
-func (fake *FakeZipper) ZipArgsForCall(i int) (string, *os.File) {
+func (fake *FakeZipper) ZipArgsForCall(i int) (string, *os.File, bool) {
 	fake.zipMutex.RLock()
 	defer fake.zipMutex.RUnlock()
-	return fake.zipArgsForCall[i].dirToZip, fake.zipArgsForCall[i].targetFile
+	return fake.zipArgsForCall[i].dirToZip, fake.zipArgsForCall[i].targetFile, fake.zipArgsForCall[i].preserveFileMode
 }
 
 func (fake *FakeZipper) ZipReturns(result1 error) {
@@ -82,8 +100,21 @@ func (fake *FakeZipper) ZipReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeZipper) ZipReturnsOnCall(i int, result1 error) {
+	fake.ZipStub = nil
+	if fake.zipReturnsOnCall == nil {
+		fake.zipReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.zipReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeZipper) IsZipFile(path string) bool {
 	fake.isZipFileMutex.Lock()
+	ret, specificReturn := fake.isZipFileReturnsOnCall[len(fake.isZipFileArgsForCall)]
 	fake.isZipFileArgsForCall = append(fake.isZipFileArgsForCall, struct {
 		path string
 	}{path})
@@ -91,9 +122,11 @@ func (fake *FakeZipper) IsZipFile(path string) bool {
 	fake.isZipFileMutex.Unlock()
 	if fake.IsZipFileStub != nil {
 		return fake.IsZipFileStub(path)
-	} else {
-		return fake.isZipFileReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isZipFileReturns.result1
 }
 
 func (fake *FakeZipper) IsZipFileCallCount() int {
@@ -115,8 +148,21 @@ func (fake *FakeZipper) IsZipFileReturns(result1 bool) {
 	}{result1}
 }
 
-func (fake *FakeZipper) Unzip(appDir string, destDir string) (err error) {
+func (fake *FakeZipper) IsZipFileReturnsOnCall(i int, result1 bool) {
+	fake.IsZipFileStub = nil
+	if fake.isZipFileReturnsOnCall == nil {
+		fake.isZipFileReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isZipFileReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeZipper) Unzip(appDir string, destDir string) error {
 	fake.unzipMutex.Lock()
+	ret, specificReturn := fake.unzipReturnsOnCall[len(fake.unzipArgsForCall)]
 	fake.unzipArgsForCall = append(fake.unzipArgsForCall, struct {
 		appDir  string
 		destDir string
@@ -125,9 +171,11 @@ func (fake *FakeZipper) Unzip(appDir string, destDir string) (err error) {
 	fake.unzipMutex.Unlock()
 	if fake.UnzipStub != nil {
 		return fake.UnzipStub(appDir, destDir)
-	} else {
-		return fake.unzipReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.unzipReturns.result1
 }
 
 func (fake *FakeZipper) UnzipCallCount() int {
@@ -149,8 +197,21 @@ func (fake *FakeZipper) UnzipReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeZipper) UnzipReturnsOnCall(i int, result1 error) {
+	fake.UnzipStub = nil
+	if fake.unzipReturnsOnCall == nil {
+		fake.unzipReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unzipReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeZipper) GetZipSize(zipFile *os.File) (int64, error) {
 	fake.getZipSizeMutex.Lock()
+	ret, specificReturn := fake.getZipSizeReturnsOnCall[len(fake.getZipSizeArgsForCall)]
 	fake.getZipSizeArgsForCall = append(fake.getZipSizeArgsForCall, struct {
 		zipFile *os.File
 	}{zipFile})
@@ -158,9 +219,11 @@ func (fake *FakeZipper) GetZipSize(zipFile *os.File) (int64, error) {
 	fake.getZipSizeMutex.Unlock()
 	if fake.GetZipSizeStub != nil {
 		return fake.GetZipSizeStub(zipFile)
-	} else {
-		return fake.getZipSizeReturns.result1, fake.getZipSizeReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getZipSizeReturns.result1, fake.getZipSizeReturns.result2
 }
 
 func (fake *FakeZipper) GetZipSizeCallCount() int {
@@ -183,6 +246,20 @@ func (fake *FakeZipper) GetZipSizeReturns(result1 int64, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeZipper) GetZipSizeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.GetZipSizeStub = nil
+	if fake.getZipSizeReturnsOnCall == nil {
+		fake.getZipSizeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.getZipSizeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeZipper) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -0,0 +1,100 @@
+package appfiles
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+// BitsEntry is an app file, or a directory with the total size of the files
+// below it.
+type BitsEntry struct {
+	Path  string
+	Size  int64
+	IsDir bool
+}
+
+// ignorableDirectories are directories commonly pushed by accident that are
+// rebuilt by buildpacks or are only needed during development.
+var ignorableDirectories = map[string]bool{
+	".cache":           true,
+	".idea":            true,
+	".vscode":          true,
+	"__pycache__":      true,
+	"bower_components": true,
+	"coverage":         true,
+	"log":              true,
+	"logs":             true,
+	"node_modules":     true,
+	"tmp":              true,
+}
+
+// ignorableExtensions are file extensions of files rarely needed at runtime.
+var ignorableExtensions = map[string]bool{
+	".log": true,
+	".tmp": true,
+	".swp": true,
+}
+
+// LargestBitsEntries returns up to count of the largest files and directories
+// among the app files, largest first.
+func LargestBitsEntries(files []models.AppFileFields, count int) []BitsEntry {
+	var entries []BitsEntry
+	dirSizes := map[string]int64{}
+
+	for _, file := range files {
+		// Directories are listed with a "0" SHA and no size of their own.
+		if file.Sha1 == "0" {
+			continue
+		}
+
+		entries = append(entries, BitsEntry{Path: file.Path, Size: file.Size})
+		for dir := path.Dir(file.Path); dir != "." && dir != "/"; dir = path.Dir(dir) {
+			dirSizes[dir] += file.Size
+		}
+	}
+
+	for dir, size := range dirSizes {
+		entries = append(entries, BitsEntry{Path: dir + "/", Size: size, IsDir: true})
+	}
+
+	sort.Slice(entries, func(i int, j int) bool {
+		if entries[i].Size != entries[j].Size {
+			return entries[i].Size > entries[j].Size
+		}
+		return entries[i].Path < entries[j].Path
+	})
+
+	if len(entries) > count {
+		entries = entries[:count]
+	}
+	return entries
+}
+
+// SuggestIgnorePatterns returns .cfignore patterns for the entries that look
+// like development or build leftovers, in the order of the entries.
+func SuggestIgnorePatterns(entries []BitsEntry) []string {
+	var patterns []string
+	suggested := map[string]bool{}
+
+	for _, entry := range entries {
+		var pattern string
+		if entry.IsDir {
+			name := path.Base(strings.TrimSuffix(entry.Path, "/"))
+			if ignorableDirectories[name] {
+				pattern = name + "/"
+			}
+		} else if extension := path.Ext(entry.Path); ignorableExtensions[extension] {
+			pattern = "*" + extension
+		}
+
+		if pattern != "" && !suggested[pattern] {
+			suggested[pattern] = true
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return patterns
+}
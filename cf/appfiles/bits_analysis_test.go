@@ -0,0 +1,63 @@
+package appfiles_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/appfiles"
+	"code.cloudfoundry.org/cli/cf/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bits analysis", func() {
+	Describe("LargestBitsEntries", func() {
+		var files []models.AppFileFields
+
+		BeforeEach(func() {
+			files = []models.AppFileFields{
+				{Path: "app.js", Sha1: "sha-1", Size: 10},
+				{Path: "node_modules", Sha1: "0", Size: 0},
+				{Path: "node_modules/a/index.js", Sha1: "sha-2", Size: 40},
+				{Path: "node_modules/b.js", Sha1: "sha-3", Size: 30},
+				{Path: "debug.log", Sha1: "sha-4", Size: 30},
+			}
+		})
+
+		It("returns the files and directories largest first", func() {
+			Expect(appfiles.LargestBitsEntries(files, 20)).To(Equal([]appfiles.BitsEntry{
+				{Path: "node_modules/", Size: 70, IsDir: true},
+				{Path: "node_modules/a/", Size: 40, IsDir: true},
+				{Path: "node_modules/a/index.js", Size: 40},
+				{Path: "debug.log", Size: 30},
+				{Path: "node_modules/b.js", Size: 30},
+				{Path: "app.js", Size: 10},
+			}))
+		})
+
+		It("returns at most count entries", func() {
+			Expect(appfiles.LargestBitsEntries(files, 2)).To(Equal([]appfiles.BitsEntry{
+				{Path: "node_modules/", Size: 70, IsDir: true},
+				{Path: "node_modules/a/", Size: 40, IsDir: true},
+			}))
+		})
+	})
+
+	Describe("SuggestIgnorePatterns", func() {
+		It("suggests patterns for development leftovers once each", func() {
+			Expect(appfiles.SuggestIgnorePatterns([]appfiles.BitsEntry{
+				{Path: "client/node_modules/", Size: 70, IsDir: true},
+				{Path: "debug.log", Size: 30},
+				{Path: "node_modules/", Size: 20, IsDir: true},
+				{Path: "lib/", Size: 15, IsDir: true},
+				{Path: "app.js", Size: 10},
+				{Path: "logs/", Size: 5, IsDir: true},
+			})).To(Equal([]string{"node_modules/", "*.log", "logs/"}))
+		})
+
+		It("suggests nothing when no entry looks ignorable", func() {
+			Expect(appfiles.SuggestIgnorePatterns([]appfiles.BitsEntry{
+				{Path: "lib/", Size: 15, IsDir: true},
+				{Path: "app.js", Size: 10},
+			})).To(BeEmpty())
+		})
+	})
+})
@@ -2,6 +2,7 @@ package appfiles
 
 import (
 	"path"
+	"runtime"
 	"strings"
 
 	"code.cloudfoundry.org/cli/util/glob"
@@ -24,6 +25,10 @@ func NewCfIgnore(text string) CfIgnore {
 			continue
 		}
 
+		if ignoreCase() {
+			line = strings.ToLower(line)
+		}
+
 		ignore := true
 		if strings.HasPrefix(line, "!") {
 			line = line[1:]
@@ -41,6 +46,10 @@ func NewCfIgnore(text string) CfIgnore {
 func (ignore cfIgnore) FileShouldBeIgnored(path string) bool {
 	result := false
 
+	if ignoreCase() {
+		path = strings.ToLower(path)
+	}
+
 	for _, pattern := range ignore {
 		if strings.HasPrefix(pattern.glob.String(), "/") && !strings.HasPrefix(path, "/") {
 			path = "/" + path
@@ -54,6 +63,12 @@ func (ignore cfIgnore) FileShouldBeIgnored(path string) bool {
 	return result
 }
 
+// ignoreCase returns true on Windows, where file names are case-insensitive,
+// so that bin/ also ignores Bin/ and BIN/.
+func ignoreCase() bool {
+	return runtime.GOOS == "windows"
+}
+
 func globsForPattern(pattern string) (globs []glob.Glob) {
 	globs = append(globs, glob.MustCompileGlob(pattern))
 	globs = append(globs, glob.MustCompileGlob(path.Join(pattern, "*")))
@@ -1,6 +1,8 @@
 package appfiles_test
 
 import (
+	"runtime"
+
 	. "code.cloudfoundry.org/cli/cf/appfiles"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -84,4 +86,32 @@ stuff/exclude.c`)
 			Expect(ignore.FileShouldBeIgnored("public/assets/manifest.yml")).To(BeFalse())
 		})
 	})
+
+	Describe("the case of the patterns", func() {
+		var ignore CfIgnore
+
+		BeforeEach(func() {
+			ignore = NewCfIgnore("Bin\n*.PDB")
+		})
+
+		It("is ignored on Windows", func() {
+			if runtime.GOOS != "windows" {
+				Skip("This test only runs on Windows")
+			}
+
+			Expect(ignore.FileShouldBeIgnored("bin/app.dll")).To(BeTrue())
+			Expect(ignore.FileShouldBeIgnored("BIN/app.dll")).To(BeTrue())
+			Expect(ignore.FileShouldBeIgnored("obj/app.pdb")).To(BeTrue())
+		})
+
+		It("is matched on other platforms", func() {
+			if runtime.GOOS == "windows" {
+				Skip("This test is only for non-Windows platforms")
+			}
+
+			Expect(ignore.FileShouldBeIgnored("Bin/app.dll")).To(BeTrue())
+			Expect(ignore.FileShouldBeIgnored("bin/app.dll")).To(BeFalse())
+			Expect(ignore.FileShouldBeIgnored("obj/app.pdb")).To(BeFalse())
+		})
+	})
 })
@@ -16,7 +16,7 @@ import (
 //go:generate counterfeiter . Zipper
 
 type Zipper interface {
-	Zip(dirToZip string, targetFile *os.File) (err error)
+	Zip(dirToZip string, targetFile *os.File, preserveFileMode bool) (err error)
 	IsZipFile(path string) bool
 	Unzip(appDir string, destDir string) (err error)
 	GetZipSize(zipFile *os.File) (int64, error)
@@ -24,7 +24,9 @@ type Zipper interface {
 
 type ApplicationZipper struct{}
 
-func (zipper ApplicationZipper) Zip(dirOrZipFilePath string, targetFile *os.File) error {
+// Zip writes the zip of the directory to targetFile, with the file modes
+// normalized by UploadFileMode, or copies the zip file as it is.
+func (zipper ApplicationZipper) Zip(dirOrZipFilePath string, targetFile *os.File, preserveFileMode bool) error {
 	if zipper.IsZipFile(dirOrZipFilePath) {
 		zipFile, err := os.Open(dirOrZipFilePath)
 		if err != nil {
@@ -37,7 +39,7 @@ func (zipper ApplicationZipper) Zip(dirOrZipFilePath string, targetFile *os.File
 			return err
 		}
 	} else {
-		err := writeZipFile(dirOrZipFilePath, targetFile)
+		err := writeZipFile(dirOrZipFilePath, targetFile, preserveFileMode)
 		if err != nil {
 			return err
 		}
@@ -136,7 +138,27 @@ func (zipper ApplicationZipper) GetZipSize(zipFile *os.File) (int64, error) {
 	return zipFileSize, nil
 }
 
-func writeZipFile(dir string, targetFile *os.File) error {
+// UploadFileMode returns the mode that a file is uploaded with. Unless
+// preserveFileMode is set, directories and executables are uploaded as 0755
+// and the other files as 0644, so that executables keep their execute bits
+// without uploading the write bits of the group and the others. Windows files
+// have no execute bits, so they are all uploaded as executable by their owner.
+func UploadFileMode(mode os.FileMode, preserveFileMode bool) os.FileMode {
+	if runtime.GOOS == "windows" {
+		mode = mode | 0700
+	}
+
+	if preserveFileMode {
+		return mode
+	}
+
+	if mode.IsDir() || mode&0111 != 0 {
+		return mode&^os.ModePerm | 0755
+	}
+	return mode&^os.ModePerm | 0644
+}
+
+func writeZipFile(dir string, targetFile *os.File, preserveFileMode bool) error {
 	isEmpty, err := fileutils.IsDirEmpty(dir)
 	if err != nil {
 		return err
@@ -161,9 +183,7 @@ func writeZipFile(dir string, targetFile *os.File) error {
 			return err
 		}
 
-		if runtime.GOOS == "windows" {
-			header.SetMode(header.Mode() | 0700)
-		}
+		header.SetMode(UploadFileMode(fileInfo.Mode(), preserveFileMode))
 
 		header.Name = filepath.ToSlash(fileName)
 		header.Method = zip.Deflate
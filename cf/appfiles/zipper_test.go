@@ -130,7 +130,7 @@ var _ = Describe("Zipper", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			dir := filepath.Join(workingDir, "../../fixtures/zip/")
-			err = zipper.Zip(dir, zipFile)
+			err = zipper.Zip(dir, zipFile, false)
 			Expect(err).NotTo(HaveOccurred())
 
 			fileStat, err := zipFile.Stat()
@@ -150,7 +150,36 @@ var _ = Describe("Zipper", func() {
 			Expect(contents).To(Equal("This is a simple text file."))
 		})
 
-		It("creates a zip with the original file modes", func() {
+		It("creates a zip with normalized file modes", func() {
+			if runtime.GOOS == "windows" {
+				Skip("This test does not run on Windows")
+			}
+
+			workingDir, err := os.Getwd()
+			Expect(err).NotTo(HaveOccurred())
+
+			dir := filepath.Join(workingDir, "../../fixtures/zip/")
+			err = os.Chmod(filepath.Join(dir, "subDir/bar.txt"), 0666)
+			Expect(err).NotTo(HaveOccurred())
+			err = os.Chmod(filepath.Join(dir, "foo.txt"), 0775)
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Chmod(filepath.Join(dir, "foo.txt"), 0664)
+
+			err = zipper.Zip(dir, zipFile, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			fileStat, err := zipFile.Stat()
+			Expect(err).NotTo(HaveOccurred())
+
+			reader, err := zip.NewReader(zipFile, fileStat.Size())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reader.File[0].FileInfo().Mode()).To(Equal(os.FileMode(0755)))
+			Expect(reader.File[6].FileInfo().Mode()).To(Equal(os.ModeDir | 0755))
+			Expect(reader.File[7].FileInfo().Mode()).To(Equal(os.FileMode(0644)))
+		})
+
+		It("creates a zip with the original file modes when they are preserved", func() {
 			if runtime.GOOS == "windows" {
 				Skip("This test does not run on Windows")
 			}
@@ -162,7 +191,7 @@ var _ = Describe("Zipper", func() {
 			err = os.Chmod(filepath.Join(dir, "subDir/bar.txt"), 0666)
 			Expect(err).NotTo(HaveOccurred())
 
-			err = zipper.Zip(dir, zipFile)
+			err = zipper.Zip(dir, zipFile, true)
 			Expect(err).NotTo(HaveOccurred())
 
 			fileStat, err := zipFile.Stat()
@@ -187,7 +216,7 @@ var _ = Describe("Zipper", func() {
 			err = os.Chmod(filepath.Join(dir, "subDir/bar.txt"), 0666)
 			Expect(err).NotTo(HaveOccurred())
 
-			err = zipper.Zip(dir, zipFile)
+			err = zipper.Zip(dir, zipFile, true)
 			Expect(err).NotTo(HaveOccurred())
 
 			fileStat, err := zipFile.Stat()
@@ -206,7 +235,7 @@ var _ = Describe("Zipper", func() {
 
 			zipper := ApplicationZipper{}
 			fixture := filepath.Join(dir, "../../fixtures/applications/example-app.zip")
-			err = zipper.Zip(fixture, zipFile)
+			err = zipper.Zip(fixture, zipFile, false)
 			Expect(err).NotTo(HaveOccurred())
 
 			zippedFile, err := os.Open(fixture)
@@ -223,7 +252,7 @@ var _ = Describe("Zipper", func() {
 			Expect(err).NotTo(HaveOccurred())
 			originalFileSize := fileStat.Size()
 
-			err = zipper.Zip(dir, zipFile)
+			err = zipper.Zip(dir, zipFile, false)
 			Expect(err).NotTo(HaveOccurred())
 
 			fileStat, err = zipFile.Stat()
@@ -235,7 +264,7 @@ var _ = Describe("Zipper", func() {
 
 		It("returns an error when zipping fails", func() {
 			zipper := ApplicationZipper{}
-			err := zipper.Zip("/a/bogus/directory", zipFile)
+			err := zipper.Zip("/a/bogus/directory", zipFile, false)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("open /a/bogus/directory"))
 		})
@@ -243,7 +272,7 @@ var _ = Describe("Zipper", func() {
 		It("returns an error when the directory is empty", func() {
 			fileutils.TempDir("zip_test", func(emptyDir string, err error) {
 				zipper := ApplicationZipper{}
-				err = zipper.Zip(emptyDir, zipFile)
+				err = zipper.Zip(emptyDir, zipFile, false)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("is empty"))
 			})
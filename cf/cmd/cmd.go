@@ -21,7 +21,9 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
 	"code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/util/exitcode"
 	"code.cloudfoundry.org/cli/util/spellcheck"
+	"code.cloudfoundry.org/cli/util/tracing"
 
 	netrpc "net/rpc"
 )
@@ -45,7 +47,7 @@ func Main(traceEnv string, args []string) {
 	args = append([]string{args[0]}, handleHelp(args[1:])...)
 
 	newArgs, isVerbose := handleVerbose(args)
-	args = newArgs
+	args = handleTimings(newArgs)
 
 	errFunc := func(err error) {
 		if err != nil {
@@ -106,30 +108,30 @@ func Main(traceEnv string, args []string) {
 		requirementsFactory := requirements.NewFactory(deps.Config, deps.RepoLocator)
 		reqs, reqErr := cmd.Requirements(requirementsFactory, flagContext)
 		if reqErr != nil {
-			os.Exit(1)
+			exit(1)
 		}
 
 		for _, req := range reqs {
 			err = req.Execute()
 			if err != nil {
 				deps.UI.Failed(err.Error())
-				os.Exit(1)
+				exit(1)
 			}
 		}
 
 		err = cmd.Execute(flagContext)
 		if err != nil {
 			deps.UI.Failed(err.Error())
-			os.Exit(1)
+			exit(exitcode.Of(err))
 		}
 
 		err = warningsCollector.PrintWarnings()
 		if err != nil {
 			deps.UI.Failed(err.Error())
-			os.Exit(1)
+			exit(1)
 		}
 
-		os.Exit(0)
+		exit(0)
 	}
 
 	//non core command, try plugin command
@@ -158,6 +160,19 @@ func Main(traceEnv string, args []string) {
 	}
 }
 
+// exit exports the recorded traces before exiting, since os.Exit does not run
+// the deferred calls that would otherwise export them.
+func exit(code int) {
+	if code != 0 {
+		tracing.SetRootError(fmt.Errorf("exit status %d", code))
+	}
+	err := tracing.Flush()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error exporting traces: %s\n", err.Error())
+	}
+	os.Exit(code)
+}
+
 func suggestCommands(cmdName string, ui terminal.UI, cmdsList []string) {
 	cmdSuggester := spellcheck.NewCommandSuggester(cmdsList)
 	recommendedCmds := cmdSuggester.Recommend(cmdName)
@@ -203,6 +218,18 @@ func handleHelp(args []string) []string {
 	}
 }
 
+// handleTimings removes the --timings global flag, which is handled before a
+// legacy command runs.
+func handleTimings(args []string) []string {
+	newArgs := []string{}
+	for _, arg := range args {
+		if arg != "--timings" {
+			newArgs = append(newArgs, arg)
+		}
+	}
+	return newArgs
+}
+
 func handleVerbose(args []string) ([]string, bool) {
 	var verbose bool
 	idx := -1
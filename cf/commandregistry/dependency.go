@@ -44,6 +44,7 @@ type Dependency struct {
 	PlanBuilder        planbuilder.PlanBuilder
 	ServiceHandler     actors.ServiceActor
 	ServicePlanHandler actors.ServicePlanActor
+	ServiceParameters  actors.ServiceParametersActor
 	WordGenerator      generator.WordGenerator
 	AppZipper          appfiles.Zipper
 	AppFiles           appfiles.AppFiles
@@ -139,6 +140,10 @@ func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string
 		deps.ServiceBuilder,
 	)
 
+	deps.ServiceParameters = actors.NewServiceParametersHandler(
+		deps.RepoLocator.GetServicePlanRepository(),
+	)
+
 	deps.WordGenerator = generator.NewWordGenerator()
 
 	deps.AppZipper = appfiles.ApplicationZipper{}
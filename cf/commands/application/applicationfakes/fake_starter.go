@@ -45,6 +45,18 @@ type FakeStarter struct {
 	executeReturns struct {
 		result1 error
 	}
+	WatchStagingStub        func(app models.Application, orgName string, spaceName string, startCommand func(app models.Application) (models.Application, error)) (updatedApp models.Application, err error)
+	watchStagingMutex       sync.RWMutex
+	watchStagingArgsForCall []struct {
+		app          models.Application
+		orgName      string
+		spaceName    string
+		startCommand func(app models.Application) (models.Application, error)
+	}
+	watchStagingReturns struct {
+		result1 models.Application
+		result2 error
+	}
 	SetStartTimeoutInSecondsStub        func(timeout int)
 	setStartTimeoutInSecondsMutex       sync.RWMutex
 	setStartTimeoutInSecondsArgsForCall []struct {
@@ -192,6 +204,43 @@ func (fake *FakeStarter) ExecuteReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStarter) WatchStaging(app models.Application, orgName string, spaceName string, startCommand func(app models.Application) (models.Application, error)) (updatedApp models.Application, err error) {
+	fake.watchStagingMutex.Lock()
+	fake.watchStagingArgsForCall = append(fake.watchStagingArgsForCall, struct {
+		app          models.Application
+		orgName      string
+		spaceName    string
+		startCommand func(app models.Application) (models.Application, error)
+	}{app, orgName, spaceName, startCommand})
+	fake.recordInvocation("WatchStaging", []interface{}{app, orgName, spaceName, startCommand})
+	fake.watchStagingMutex.Unlock()
+	if fake.WatchStagingStub != nil {
+		return fake.WatchStagingStub(app, orgName, spaceName, startCommand)
+	} else {
+		return fake.watchStagingReturns.result1, fake.watchStagingReturns.result2
+	}
+}
+
+func (fake *FakeStarter) WatchStagingCallCount() int {
+	fake.watchStagingMutex.RLock()
+	defer fake.watchStagingMutex.RUnlock()
+	return len(fake.watchStagingArgsForCall)
+}
+
+func (fake *FakeStarter) WatchStagingArgsForCall(i int) (models.Application, string, string, func(app models.Application) (models.Application, error)) {
+	fake.watchStagingMutex.RLock()
+	defer fake.watchStagingMutex.RUnlock()
+	return fake.watchStagingArgsForCall[i].app, fake.watchStagingArgsForCall[i].orgName, fake.watchStagingArgsForCall[i].spaceName, fake.watchStagingArgsForCall[i].startCommand
+}
+
+func (fake *FakeStarter) WatchStagingReturns(result1 models.Application, result2 error) {
+	fake.WatchStagingStub = nil
+	fake.watchStagingReturns = struct {
+		result1 models.Application
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStarter) SetStartTimeoutInSeconds(timeout int) {
 	fake.setStartTimeoutInSecondsMutex.Lock()
 	fake.setStartTimeoutInSecondsArgsForCall = append(fake.setStartTimeoutInSecondsArgsForCall, struct {
@@ -263,6 +312,8 @@ func (fake *FakeStarter) Invocations() map[string][][]interface{} {
 	defer fake.requirementsMutex.RUnlock()
 	fake.executeMutex.RLock()
 	defer fake.executeMutex.RUnlock()
+	fake.watchStagingMutex.RLock()
+	defer fake.watchStagingMutex.RUnlock()
 	fake.setStartTimeoutInSecondsMutex.RLock()
 	defer fake.setStartTimeoutInSecondsMutex.RUnlock()
 	fake.applicationStartMutex.RLock()
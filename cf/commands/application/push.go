@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
@@ -16,6 +17,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/api/authentication"
+	"code.cloudfoundry.org/cli/cf/api/resources"
 	"code.cloudfoundry.org/cli/cf/api/stacks"
 	"code.cloudfoundry.org/cli/cf/appfiles"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -27,29 +29,43 @@ import (
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/exitcode"
+	"code.cloudfoundry.org/cli/util/tracing"
 	"code.cloudfoundry.org/cli/util/words/generator"
 )
 
 type Push struct {
-	ui            terminal.UI
-	config        coreconfig.Reader
-	manifestRepo  manifest.Repository
-	appStarter    Starter
-	appStopper    Stopper
-	serviceBinder service.Binder
-	appRepo       applications.Repository
-	domainRepo    api.DomainRepository
-	routeRepo     api.RouteRepository
-	serviceRepo   api.ServiceRepository
-	stackRepo     stacks.StackRepository
-	authRepo      authentication.Repository
-	wordGenerator generator.WordGenerator
-	actor         actors.PushActor
-	routeActor    actors.RouteActor
-	zipper        appfiles.Zipper
-	appfiles      appfiles.AppFiles
+	ui               terminal.UI
+	config           coreconfig.Reader
+	manifestRepo     manifest.Repository
+	appStarter       Starter
+	appStopper       Stopper
+	serviceBinder    service.Binder
+	appRepo          applications.Repository
+	domainRepo       api.DomainRepository
+	routeRepo        api.RouteRepository
+	serviceRepo      api.ServiceRepository
+	stackRepo        stacks.StackRepository
+	authRepo         authentication.Repository
+	wordGenerator    generator.WordGenerator
+	actor            actors.PushActor
+	routeActor       actors.RouteActor
+	zipper           appfiles.Zipper
+	appfiles         appfiles.AppFiles
+	analyzeBits      bool
+	retries          int
+	preserveFileMode bool
 }
 
+// StagingRetryBackoff is how long push waits before restaging an app whose
+// staging failed with a transient error. The wait doubles with every retry.
+var StagingRetryBackoff = 5 * time.Second
+
+// transientStagingFailure matches the staging failure reasons that are worth
+// retrying, such as a lack of resources on the cells or a buildpack download
+// timing out.
+var transientStagingFailure = regexp.MustCompile(`(?i)insufficient ?resources|no ?compatible ?cell|stager ?unavailable|download.*(timeout|timed out)|(timeout|timed out).*download`)
+
 func init() {
 	commandregistry.Register(&Push{})
 }
@@ -77,6 +93,9 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 	fs["route-path"] = &flags.StringFlag{Name: "route-path", Usage: T("Path for the route")}
 	// Hidden:true to hide app-ports for release #117189491
 	fs["app-ports"] = &flags.StringFlag{Name: "app-ports", Usage: T("Comma delimited list of ports the application may listen on"), Hidden: true}
+	fs["analyze-bits"] = &flags.BoolFlag{Name: "analyze-bits", Usage: T("Report the largest files and directories being uploaded and suggest .cfignore patterns")}
+	fs["preserve-file-mode"] = &flags.BoolFlag{Name: "preserve-file-mode", Usage: T("Upload the app files with their own file modes instead of 0755 for executables and directories and 0644 for the other files")}
+	fs["retries"] = &flags.IntFlag{Name: "retries", Usage: T("Number of times to restage the app when staging fails with a transient error, such as insufficient resources or a buildpack download timeout")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "push",
@@ -104,7 +123,8 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 			"\n   ",
 			// Commented to hide app-ports for release #117189491
 			// fmt.Sprintf("[--app-ports %s] ", T("APP_PORTS")),
-			"[--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n",
+			"[--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route] [--analyze-bits]\n   ",
+			fmt.Sprintf("[--preserve-file-mode] [--retries %s]\n", T("NUM_RETRIES")),
 			"\n   ",
 			T("Push multiple apps with a manifest"),
 			":\n   ",
@@ -178,9 +198,16 @@ func (cmd *Push) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 }
 
 func (cmd *Push) Execute(c flags.FlagContext) error {
+	cmd.analyzeBits = c.Bool("analyze-bits")
+	cmd.preserveFileMode = c.Bool("preserve-file-mode")
+	cmd.retries = c.Int("retries")
+	if cmd.retries < 0 {
+		return exitcode.Wrap(errors.New(T("Incorrect Usage: --retries must not be negative")), exitcode.ValidationFailed)
+	}
+
 	appsFromManifest, err := cmd.getAppParamsFromManifest(c)
 	if err != nil {
-		return err
+		return exitcode.Wrap(err, exitcode.ValidationFailed)
 	}
 
 	errs := cmd.actor.ValidateAppParams(appsFromManifest)
@@ -191,22 +218,22 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 			errStr = fmt.Sprintf("%s\n%s", errStr, e.Error())
 		}
 
-		return fmt.Errorf("%s", errStr)
+		return exitcode.Wrap(fmt.Errorf("%s", errStr), exitcode.ValidationFailed)
 	}
 
 	appFromContext, err := cmd.getAppParamsFromContext(c)
 	if err != nil {
-		return err
+		return exitcode.Wrap(err, exitcode.ValidationFailed)
 	}
 
 	err = cmd.ValidateContextAndAppParams(appsFromManifest, appFromContext)
 	if err != nil {
-		return err
+		return exitcode.Wrap(err, exitcode.ValidationFailed)
 	}
 
 	appSet, err := cmd.createAppSetFromContextAndManifest(appFromContext, appsFromManifest)
 	if err != nil {
-		return err
+		return exitcode.Wrap(err, exitcode.ValidationFailed)
 	}
 
 	_, err = cmd.authRepo.RefreshAuthToken()
@@ -216,7 +243,7 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 
 	for _, appParams := range appSet {
 		if appParams.Name == nil {
-			return errors.New(T("Error: No name found for app"))
+			return exitcode.Wrap(errors.New(T("Error: No name found for app")), exitcode.ValidationFailed)
 		}
 
 		err = cmd.fetchStackGUID(&appParams)
@@ -300,12 +327,12 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 		if c.String("docker-image") == "" {
 			err = cmd.actor.ProcessPath(*appParams.Path, cmd.processPathCallback(*appParams.Path, app))
 			if err != nil {
-				return errors.New(
+				return exitcode.Wrap(errors.New(
 					T("Error processing app files: {{.Error}}",
 						map[string]interface{}{
 							"Error": err.Error(),
 						}),
-				)
+				), exitcode.Of(err))
 			}
 		}
 
@@ -318,12 +345,12 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 
 		err = cmd.restart(app, appParams, c)
 		if err != nil {
-			return errors.New(
+			return exitcode.Wrap(errors.New(
 				T("Error restarting application: {{.Error}}",
 					map[string]interface{}{
 						"Error": err.Error(),
 					}),
-			)
+			), restartExitCode(err))
 		}
 	}
 	return nil
@@ -342,11 +369,11 @@ func (cmd *Push) processPathCallback(path string, app models.Application) func(s
 		}
 
 		if len(localFiles) == 0 {
-			return errors.New(
+			return exitcode.Wrap(errors.New(
 				T("No app files found in '{{.Path}}'",
 					map[string]interface{}{
 						"Path": path,
-					}))
+					})), exitcode.ValidationFailed)
 		}
 
 		cmd.ui.Say(T("Uploading {{.AppName}}...",
@@ -354,8 +381,8 @@ func (cmd *Push) processPathCallback(path string, app models.Application) func(s
 
 		err = cmd.uploadApp(app.GUID, appDir, path, localFiles)
 		if err != nil {
-			return errors.New(T("Error uploading application.\n{{.APIErr}}",
-				map[string]interface{}{"APIErr": err.Error()}))
+			return exitcode.Wrap(errors.New(T("Error uploading application.\n{{.APIErr}}",
+				map[string]interface{}{"APIErr": err.Error()})), exitcode.UploadFailed)
 		}
 		cmd.ui.Ok()
 		return nil
@@ -580,6 +607,17 @@ func (cmd *Push) restart(app models.Application, params models.AppParams, c flag
 	}
 
 	_, err := cmd.appStarter.ApplicationStart(app, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name)
+	for attempt := 1; attempt <= cmd.retries && isTransientStagingFailure(err); attempt++ {
+		backoff := StagingRetryBackoff * time.Duration(1<<uint(attempt-1))
+		cmd.ui.Say(fmt.Sprintf(T("Staging failed with a transient error. Restaging in %s (retry %d of %d)..."),
+			backoff, attempt, cmd.retries))
+		time.Sleep(backoff)
+
+		app.PackageState = ""
+		_, err = cmd.appStarter.WatchStaging(app, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name, func(app models.Application) (models.Application, error) {
+			return app, cmd.appRepo.CreateRestageRequest(app.GUID)
+		})
+	}
 	if err != nil {
 		return err
 	}
@@ -587,6 +625,23 @@ func (cmd *Push) restart(app models.Application, params models.AppParams, c flag
 	return nil
 }
 
+// restartExitCode returns the exit code of a failure to stage or start the
+// app.
+func restartExitCode(err error) int {
+	switch err.(type) {
+	case *errors.StagingFailedError, *errors.StagingTimeoutError:
+		return exitcode.StagingFailed
+	case *errors.StartupTimeoutError:
+		return exitcode.StartupTimeout
+	}
+	return exitcode.Failed
+}
+
+func isTransientStagingFailure(err error) bool {
+	stagingErr, ok := err.(*errors.StagingFailedError)
+	return ok && transientStagingFailure.MatchString(stagingErr.Reason)
+}
+
 func (cmd *Push) getAppParamsFromManifest(c flags.FlagContext) ([]models.AppParams, error) {
 	if c.Bool("no-manifest") {
 		return []models.AppParams{}, nil
@@ -833,17 +888,28 @@ func (cmd *Push) uploadApp(appGUID, appDir, appDirOrZipFile string, localFiles [
 		return err
 	}
 
-	remoteFiles, hasFileToUpload, err := cmd.actor.GatherFiles(localFiles, appDir, uploadDir, true)
+	collectSpan := tracing.StartSpan("collect bits")
+	collectSpan.SetAttribute("cf.app.guid", appGUID)
+	defer collectSpan.End()
+
+	remoteFiles, hasFileToUpload, err := cmd.actor.GatherFiles(localFiles, appDir, uploadDir, true, cmd.preserveFileMode)
 
 	if httpError, isHTTPError := err.(errors.HTTPError); isHTTPError && httpError.StatusCode() == 504 {
 		cmd.ui.Warn("Resource matching API timed out; pushing all app files.")
-		remoteFiles, hasFileToUpload, err = cmd.actor.GatherFiles(localFiles, appDir, uploadDir, false)
+		remoteFiles, hasFileToUpload, err = cmd.actor.GatherFiles(localFiles, appDir, uploadDir, false, cmd.preserveFileMode)
 	}
 
 	if err != nil {
 		return err
 	}
 
+	if cmd.analyzeBits {
+		err = cmd.displayBitsAnalysis(localFiles, remoteFiles)
+		if err != nil {
+			return err
+		}
+	}
+
 	zipFile, err := ioutil.TempFile("", "uploads")
 	if err != nil {
 		return err
@@ -854,7 +920,7 @@ func (cmd *Push) uploadApp(appGUID, appDir, appDirOrZipFile string, localFiles [
 	}()
 
 	if hasFileToUpload {
-		err = cmd.zipper.Zip(uploadDir, zipFile)
+		err = cmd.zipper.Zip(uploadDir, zipFile, cmd.preserveFileMode)
 		if err != nil {
 			if emptyDirErr, ok := err.(*errors.EmptyDirError); ok {
 				return emptyDirErr
@@ -882,6 +948,58 @@ func (cmd *Push) uploadApp(appGUID, appDir, appDirOrZipFile string, localFiles [
 	if err != nil {
 		return err
 	}
+	collectSpan.End()
+
+	uploadSpan := tracing.StartSpan("upload bits")
+	uploadSpan.SetAttribute("cf.app.guid", appGUID)
+	defer uploadSpan.End()
+
+	err = cmd.actor.UploadApp(appGUID, zipFile, remoteFiles)
+	uploadSpan.SetError(err)
+	return err
+}
+
+const largestBitsEntriesCount = 20
+
+// displayBitsAnalysis reports the largest of the local files that the
+// Cloud Controller does not already have cached.
+func (cmd *Push) displayBitsAnalysis(localFiles []models.AppFileFields, remoteFiles []resources.AppFileResource) error {
+	cachedFiles := map[string]bool{}
+	for _, file := range remoteFiles {
+		cachedFiles[file.Path] = true
+	}
+
+	var uploadedFiles []models.AppFileFields
+	for _, file := range localFiles {
+		if !cachedFiles[file.Path] {
+			uploadedFiles = append(uploadedFiles, file)
+		}
+	}
+
+	entries := appfiles.LargestBitsEntries(uploadedFiles, largestBitsEntriesCount)
+	if len(entries) == 0 {
+		return nil
+	}
+
+	cmd.ui.Say(T("Largest files and directories being uploaded:"))
+	table := cmd.ui.Table([]string{T("size"), T("path")})
+	for _, entry := range entries {
+		table.Add(formatters.ByteSize(entry.Size), entry.Path)
+	}
+	err := table.Print()
+	if err != nil {
+		return err
+	}
 
-	return cmd.actor.UploadApp(appGUID, zipFile, remoteFiles)
+	patterns := appfiles.SuggestIgnorePatterns(entries)
+	if len(patterns) > 0 {
+		cmd.ui.Say("")
+		cmd.ui.Say(T("TIP: Consider adding these patterns to .cfignore to skip uploading them:"))
+		for _, pattern := range patterns {
+			cmd.ui.Say("   " + pattern)
+		}
+	}
+	cmd.ui.Say("")
+
+	return nil
 }
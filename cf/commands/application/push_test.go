@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
@@ -27,6 +28,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/util/exitcode"
 	"code.cloudfoundry.org/cli/util/generic"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
@@ -336,6 +338,7 @@ var _ = Describe("Push Command", func() {
 				It("returns an properly formatted error", func() {
 					Expect(executeErr).To(HaveOccurred())
 					Expect(executeErr.Error()).To(MatchRegexp("Invalid application configuration:\nerror1\nerror2"))
+					Expect(exitcode.Of(executeErr)).To(Equal(exitcode.ValidationFailed))
 				})
 			})
 
@@ -408,7 +411,7 @@ var _ = Describe("Push Command", func() {
 				Context("when the CC returns 504 Gateway timeout", func() {
 					BeforeEach(func() {
 						var callCount int
-						actor.GatherFilesStub = func(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error) {
+						actor.GatherFilesStub = func(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool, preserveFileMode bool) ([]resources.AppFileResource, bool, error) {
 							callCount += 1
 							if callCount == 1 {
 								return []resources.AppFileResource{}, false, errors.NewHTTPError(504, "", "")
@@ -423,10 +426,10 @@ var _ = Describe("Push Command", func() {
 
 						Expect(actor.GatherFilesCallCount()).To(Equal(2))
 
-						localFiles, appDir, uploadDir, useCache := actor.GatherFilesArgsForCall(0)
+						localFiles, appDir, uploadDir, useCache, _ := actor.GatherFilesArgsForCall(0)
 						Expect(useCache).To(Equal(true))
 
-						localFilesRetry, appDirRetry, uploadDirRetry, useCacheRetry := actor.GatherFilesArgsForCall(1)
+						localFilesRetry, appDirRetry, uploadDirRetry, useCacheRetry, _ := actor.GatherFilesArgsForCall(1)
 						Expect(localFilesRetry).To(Equal(localFiles))
 						Expect(appDirRetry).To(Equal(appDir))
 						Expect(uploadDirRetry).To(Equal(uploadDir))
@@ -1045,7 +1048,7 @@ var _ = Describe("Push Command", func() {
 					It("includes the app files in dir", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
 
-						actualLocalFiles, _, _, _ := actor.GatherFilesArgsForCall(0)
+						actualLocalFiles, _, _, _, _ := actor.GatherFilesArgsForCall(0)
 						Expect(actualLocalFiles).To(Equal(expectedLocalFiles))
 					})
 				})
@@ -1084,7 +1087,7 @@ var _ = Describe("Push Command", func() {
 					It("pushes the contents of the app directory or zip file specified", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
 
-						_, appDir, _, _ := actor.GatherFilesArgsForCall(0)
+						_, appDir, _, _, _ := actor.GatherFilesArgsForCall(0)
 						Expect(appDir).To(Equal("../some/path-to/an-app/file.zip"))
 					})
 				})
@@ -1120,7 +1123,7 @@ var _ = Describe("Push Command", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
 
 						dir, _ := os.Getwd()
-						_, appDir, _, _ := actor.GatherFilesArgsForCall(0)
+						_, appDir, _, _, _ := actor.GatherFilesArgsForCall(0)
 						Expect(appDir).To(Equal(dir))
 					})
 				})
@@ -1807,6 +1810,156 @@ var _ = Describe("Push Command", func() {
 				})
 			})
 
+			Context("when --analyze-bits is provided", func() {
+				BeforeEach(func() {
+					appfiles.AppFilesInDirReturns([]models.AppFileFields{
+						{Path: "app.js", Sha1: "sha-1", Size: 2048},
+						{Path: "node_modules/dep/index.js", Sha1: "sha-2", Size: 4096},
+						{Path: "cached.js", Sha1: "sha-3", Size: 8192},
+					}, nil)
+					actor.GatherFilesReturns([]resources.AppFileResource{{Path: "cached.js"}}, true, nil)
+					args = []string{"--analyze-bits", "appName"}
+				})
+
+				It("reports the largest uploaded files and suggests ignore patterns", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					totalOutputs := terminal.Decolorize(string(output.Contents()))
+					Expect(totalOutputs).To(ContainSubstring("Largest files and directories being uploaded:"))
+					Expect(totalOutputs).To(MatchRegexp(`4K\s+node_modules/\n`))
+					Expect(totalOutputs).To(MatchRegexp(`4K\s+node_modules/dep/\n`))
+					Expect(totalOutputs).To(MatchRegexp(`2K\s+app.js\n`))
+					Expect(totalOutputs).NotTo(ContainSubstring("cached.js"))
+					Expect(totalOutputs).To(ContainSubstring("TIP: Consider adding these patterns to .cfignore to skip uploading them:\n   node_modules/\n"))
+				})
+			})
+
+			Context("file modes", func() {
+				BeforeEach(func() {
+					actor.GatherFilesReturns([]resources.AppFileResource{{Path: "path/to/app"}}, true, nil)
+					args = []string{"appName"}
+				})
+
+				It("normalizes the file modes by default", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					_, _, _, _, preserveFileMode := actor.GatherFilesArgsForCall(0)
+					Expect(preserveFileMode).To(BeFalse())
+					_, _, preserveFileMode = zipper.ZipArgsForCall(0)
+					Expect(preserveFileMode).To(BeFalse())
+				})
+
+				Context("when --preserve-file-mode is provided", func() {
+					BeforeEach(func() {
+						args = []string{"--preserve-file-mode", "appName"}
+					})
+
+					It("uploads the files with their own modes", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						_, _, _, _, preserveFileMode := actor.GatherFilesArgsForCall(0)
+						Expect(preserveFileMode).To(BeTrue())
+						_, _, preserveFileMode = zipper.ZipArgsForCall(0)
+						Expect(preserveFileMode).To(BeTrue())
+					})
+				})
+			})
+
+			Context("when --retries is provided", func() {
+				var originalBackoff time.Duration
+
+				BeforeEach(func() {
+					originalBackoff = application.StagingRetryBackoff
+					application.StagingRetryBackoff = time.Millisecond
+					stopper.ApplicationStopReturns(existingApp, nil)
+					args = []string{"--retries", "2", "existing-app"}
+				})
+
+				AfterEach(func() {
+					application.StagingRetryBackoff = originalBackoff
+				})
+
+				Context("when staging fails with a transient error", func() {
+					BeforeEach(func() {
+						starter.ApplicationStartReturns(models.Application{}, errors.NewStagingFailedError("InsufficientResources", "InsufficientResources"))
+					})
+
+					Context("when a restage succeeds", func() {
+						BeforeEach(func() {
+							starter.WatchStagingStub = func(app models.Application, _ string, _ string, _ func(models.Application) (models.Application, error)) (models.Application, error) {
+								if starter.WatchStagingCallCount() == 1 {
+									return models.Application{}, errors.NewStagingFailedError("InsufficientResources", "InsufficientResources")
+								}
+								return app, nil
+							}
+						})
+
+						It("restages the app until it stages", func() {
+							Expect(executeErr).NotTo(HaveOccurred())
+							Expect(starter.ApplicationStartCallCount()).To(Equal(1))
+							Expect(starter.WatchStagingCallCount()).To(Equal(2))
+
+							app, orgName, spaceName, restage := starter.WatchStagingArgsForCall(0)
+							Expect(app.GUID).To(Equal("existing-app-guid"))
+							Expect(orgName).To(Equal("my-org"))
+							Expect(spaceName).To(Equal("my-space"))
+							_, err := restage(app)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(appRepo.CreateRestageRequestArgsForCall(0)).To(Equal("existing-app-guid"))
+
+							totalOutput := terminal.Decolorize(string(output.Contents()))
+							Expect(totalOutput).To(ContainSubstring("Staging failed with a transient error. Restaging in 1ms (retry 1 of 2)..."))
+							Expect(totalOutput).To(ContainSubstring("Staging failed with a transient error. Restaging in 2ms (retry 2 of 2)..."))
+						})
+					})
+
+					Context("when every restage fails", func() {
+						BeforeEach(func() {
+							starter.WatchStagingReturns(models.Application{}, errors.NewStagingFailedError("InsufficientResources", "InsufficientResources"))
+						})
+
+						It("gives up after the retries and returns the staging error", func() {
+							Expect(executeErr).To(MatchError("Error restarting application: InsufficientResources"))
+							Expect(starter.WatchStagingCallCount()).To(Equal(2))
+						})
+					})
+				})
+
+				Context("when staging fails with another error", func() {
+					BeforeEach(func() {
+						starter.ApplicationStartReturns(models.Application{}, errors.NewStagingFailedError("BuildpackCompileFailed", "BuildpackCompileFailed"))
+					})
+
+					It("does not restage the app", func() {
+						Expect(executeErr).To(MatchError("Error restarting application: BuildpackCompileFailed"))
+						Expect(exitcode.Of(executeErr)).To(Equal(exitcode.StagingFailed))
+						Expect(starter.WatchStagingCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when no instance starts in time", func() {
+					BeforeEach(func() {
+						starter.ApplicationStartReturns(models.Application{}, errors.NewStartupTimeoutError("Start app timeout"))
+					})
+
+					It("returns the startup timeout exit code", func() {
+						Expect(executeErr).To(MatchError("Error restarting application: Start app timeout"))
+						Expect(exitcode.Of(executeErr)).To(Equal(exitcode.StartupTimeout))
+					})
+				})
+			})
+
+			Context("when --retries is negative", func() {
+				BeforeEach(func() {
+					args = []string{"--retries", "-1", "existing-app"}
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Incorrect Usage: --retries must not be negative"))
+					Expect(starter.ApplicationStartCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the app can't be uploaded", func() {
 				BeforeEach(func() {
 					actor.UploadAppReturns(errors.New("Boom!"))
@@ -1816,6 +1969,7 @@ var _ = Describe("Push Command", func() {
 				It("fails when the app can't be uploaded", func() {
 					Expect(executeErr).To(HaveOccurred())
 					Expect(executeErr.Error()).To(ContainSubstring("Error uploading application"))
+					Expect(exitcode.Of(executeErr)).To(Equal(exitcode.UploadFailed))
 				})
 			})
 
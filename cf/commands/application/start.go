@@ -1,7 +1,6 @@
 package application
 
 import (
-	"errors"
 	"fmt"
 	"os"
 	"sort"
@@ -14,16 +13,19 @@ import (
 	"sync/atomic"
 
 	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/api/appevents"
 	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/api/logs"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/tracing"
 )
 
 const (
@@ -34,6 +36,12 @@ const (
 
 const LogMessageTypeStaging = "STG"
 
+const (
+	crashDiagnosticsEventsToSearch = 50
+	crashDiagnosticsMaxEvents      = 5
+	crashDiagnosticsMaxLogLines    = 50
+)
+
 //go:generate counterfeiter . StagingWatcher
 
 type StagingWatcher interface {
@@ -44,6 +52,7 @@ type StagingWatcher interface {
 
 type Starter interface {
 	commandregistry.Command
+	StagingWatcher
 	SetStartTimeoutInSeconds(timeout int)
 	ApplicationStart(app models.Application, orgName string, spaceName string) (updatedApp models.Application, err error)
 }
@@ -56,6 +65,7 @@ type Start struct {
 	appRepo          applications.Repository
 	logRepo          logs.Repository
 	appInstancesRepo appinstances.Repository
+	appEventsRepo    appevents.Repository
 
 	LogServerConnectionTimeout time.Duration
 	StartupTimeout             time.Duration
@@ -101,6 +111,7 @@ func (cmd *Start) SetDependency(deps commandregistry.Dependency, pluginCall bool
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
 	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
 	cmd.logRepo = deps.RepoLocator.GetLogsRepository()
+	cmd.appEventsRepo = deps.RepoLocator.GetAppEventsRepository()
 	cmd.LogServerConnectionTimeout = 20 * time.Second
 	cmd.PingerThrottle = DefaultPingerThrottle
 
@@ -170,15 +181,22 @@ func (cmd *Start) WatchStaging(app models.Application, orgName, spaceName string
 
 	loggingStartedWait.Wait()
 
+	stageSpan := tracing.StartSpan("stage")
+	stageSpan.SetAttribute("cf.app.guid", app.GUID)
+	defer stageSpan.End()
+
 	updatedApp, err := start(app)
 	if err != nil {
+		stageSpan.SetError(err)
 		return models.Application{}, err
 	}
 
 	isStaged, err := cmd.waitForInstancesToStage(updatedApp)
 	if err != nil {
+		stageSpan.SetError(err)
 		return models.Application{}, err
 	}
+	stageSpan.End()
 
 	stopChan <- true
 
@@ -187,11 +205,15 @@ func (cmd *Start) WatchStaging(app models.Application, orgName, spaceName string
 	cmd.ui.Say("")
 
 	if !isStaged {
-		return models.Application{}, fmt.Errorf("%s failed to stage within %f minutes", app.Name, cmd.StagingTimeout.Minutes())
+		return models.Application{}, cferrors.NewStagingTimeoutError(fmt.Sprintf("%s failed to stage within %f minutes", app.Name, cmd.StagingTimeout.Minutes()))
 	}
 
 	if app.InstanceCount > 0 {
+		startSpan := tracing.StartSpan("start")
+		startSpan.SetAttribute("cf.app.guid", app.GUID)
 		err = cmd.waitForOneRunningInstance(updatedApp)
+		startSpan.SetError(err)
+		startSpan.End()
 		if err != nil {
 			return models.Application{}, err
 		}
@@ -330,7 +352,7 @@ func (cmd *Start) waitForInstancesToStage(app models.Application) (bool, error)
 	if app.PackageState == "FAILED" {
 		cmd.ui.Say("")
 		if app.StagingFailedReason == "NoAppDetectedError" {
-			return false, errors.New(T(`{{.Err}}
+			return false, cferrors.NewStagingFailedError(app.StagingFailedReason, T(`{{.Err}}
 			
 TIP: Buildpacks are detected when the "{{.PushCommand}}" is executed from within the directory that contains the app source code.
 
@@ -343,7 +365,7 @@ Use '{{.Command}}' for more in depth log information.`,
 					"BuildpackCommand": terminal.CommandColor(fmt.Sprintf("%s buildpacks", cf.Name)),
 					"Command":          terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))}))
 		}
-		return false, errors.New(T("{{.Err}}\n\nTIP: use '{{.Command}}' for more information",
+		return false, cferrors.NewStagingFailedError(app.StagingFailedReason, T("{{.Err}}\n\nTIP: use '{{.Command}}' for more information",
 			map[string]interface{}{
 				"Err":     app.StagingFailedReason,
 				"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))}))
@@ -365,7 +387,7 @@ func (cmd *Start) waitForOneRunningInstance(app models.Application) error {
 			tipMsg := T("Start app timeout\n\nTIP: Application must be listening on the right port. Instead of hard coding the port, use the $PORT environment variable.") + "\n\n"
 			tipMsg += T("Use '{{.Command}}' for more information", map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))})
 
-			return errors.New(tipMsg)
+			return cferrors.NewStartupTimeoutError(tipMsg)
 
 		default:
 			count, err := cmd.fetchInstanceCount(app.GUID)
@@ -382,6 +404,7 @@ func (cmd *Start) waitForOneRunningInstance(app models.Application) error {
 			}
 
 			if count.flapping > 0 || count.crashed > 0 {
+				cmd.displayCrashDiagnostics(app)
 				return fmt.Errorf(T("Start unsuccessful\n\nTIP: use '{{.Command}}' for more information",
 					map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))}))
 			}
@@ -391,6 +414,54 @@ func (cmd *Start) waitForOneRunningInstance(app models.Application) error {
 	}
 }
 
+// displayCrashDiagnostics displays the app's most recent crash events and
+// its last log lines. Failing to retrieve them only results in a warning, so
+// that the start error is still returned.
+func (cmd *Start) displayCrashDiagnostics(app models.Application) {
+	events, err := cmd.appEventsRepo.RecentEvents(app.GUID, crashDiagnosticsEventsToSearch)
+	if err != nil {
+		cmd.ui.Warn("Could not fetch crash events: %s", err.Error())
+	}
+
+	var crashes []models.EventFields
+	for _, event := range events {
+		if event.Name == "app.crash" && len(crashes) < crashDiagnosticsMaxEvents {
+			crashes = append(crashes, event)
+		}
+	}
+
+	if len(crashes) > 0 {
+		cmd.ui.Say("")
+		cmd.ui.Say(T("Recent crash events:"))
+		table := cmd.ui.Table([]string{T("time"), T("description")})
+		for _, event := range crashes {
+			table.Add(
+				event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"),
+				event.Description,
+			)
+		}
+		_ = table.Print()
+	}
+
+	messages, err := cmd.logRepo.RecentLogsFor(app.GUID)
+	if err != nil {
+		cmd.ui.Warn("Could not fetch recent logs: %s", err.Error())
+		return
+	}
+
+	if len(messages) > crashDiagnosticsMaxLogLines {
+		messages = messages[len(messages)-crashDiagnosticsMaxLogLines:]
+	}
+
+	if len(messages) > 0 {
+		cmd.ui.Say("")
+		cmd.ui.Say(T("Recent logs:"))
+		for _, message := range messages {
+			cmd.ui.Say("%s", message.ToLog(time.Local))
+		}
+	}
+}
+
 type instanceCount struct {
 	running         int
 	starting        int
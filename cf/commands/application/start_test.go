@@ -15,6 +15,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
 
+	"code.cloudfoundry.org/cli/cf/api/appevents/appeventsfakes"
 	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
 	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 	"code.cloudfoundry.org/cli/cf/api/logs"
@@ -45,6 +46,7 @@ var _ = Describe("start command", func() {
 		logRepo                   *logsfakes.FakeRepository
 
 		appInstancesRepo   *appinstancesfakes.FakeAppInstancesRepository
+		appEventsRepo      *appeventsfakes.FakeAppEventsRepository
 		appRepo            *applicationsfakes.FakeRepository
 		originalAppCommand commandregistry.Command
 		deps               commandregistry.Dependency
@@ -57,6 +59,7 @@ var _ = Describe("start command", func() {
 		deps.RepoLocator = deps.RepoLocator.SetLogsRepository(logsRepo)
 		deps.RepoLocator = deps.RepoLocator.SetApplicationRepository(appRepo)
 		deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
+		deps.RepoLocator = deps.RepoLocator.SetAppEventsRepository(appEventsRepo)
 
 		//inject fake 'Start' into registry
 		commandregistry.Register(displayApp)
@@ -95,6 +98,7 @@ var _ = Describe("start command", func() {
 		configRepo = testconfig.NewRepository()
 
 		appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
+		appEventsRepo = new(appeventsfakes.FakeAppEventsRepository)
 		appRepo = new(applicationsfakes.FakeRepository)
 
 		displayApp = new(applicationfakes.FakeAppDisplayer)
@@ -678,6 +682,70 @@ var _ = Describe("start command", func() {
 					[]string{"Start unsuccessful"},
 				))
 			})
+
+			Context("when the app has crash events and recent logs", func() {
+				BeforeEach(func() {
+					appInstance := models.AppInstanceFields{State: models.InstanceCrashed}
+					defaultInstanceResponses = [][]models.AppInstanceFields{
+						{appInstance, appInstance},
+					}
+					defaultInstanceErrorCodes = []string{""}
+
+					appEventsRepo.RecentEventsReturns([]models.EventFields{
+						{Name: "app.crash", Description: "index: 1, reason: CRASHED, exit_description: out of memory"},
+						{Name: "audit.app.update", Description: "state: STARTED"},
+						{Name: "app.crash", Description: "index: 0, reason: CRASHED, exit_description: exited with status 1"},
+					}, nil)
+
+					message := new(logsfakes.FakeLoggable)
+					message.ToLogReturns("some-log-line")
+					logRepo.RecentLogsForReturns([]logs.Loggable{message}, nil)
+				})
+
+				It("displays the crash events and the recent logs before failing", func() {
+					ui, _, _ := startAppWithInstancesAndErrors(defaultAppForStart, requirementsFactory)
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Recent crash events:"},
+						[]string{"out of memory"},
+						[]string{"exited with status 1"},
+						[]string{"Recent logs:"},
+						[]string{"some-log-line"},
+						[]string{"FAILED"},
+						[]string{"Start unsuccessful"},
+					))
+					Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"state: STARTED"}))
+
+					appGUID, limit := appEventsRepo.RecentEventsArgsForCall(0)
+					Expect(appGUID).To(Equal("my-app-guid"))
+					Expect(limit).To(Equal(int64(50)))
+					Expect(logRepo.RecentLogsForArgsForCall(0)).To(Equal("my-app-guid"))
+				})
+			})
+
+			Context("when the crash diagnostics cannot be retrieved", func() {
+				BeforeEach(func() {
+					appInstance := models.AppInstanceFields{State: models.InstanceCrashed}
+					defaultInstanceResponses = [][]models.AppInstanceFields{
+						{appInstance, appInstance},
+					}
+					defaultInstanceErrorCodes = []string{""}
+
+					appEventsRepo.RecentEventsReturns(nil, errors.New("events-error"))
+					logRepo.RecentLogsForReturns(nil, errors.New("logs-error"))
+				})
+
+				It("warns and still fails with the start error", func() {
+					ui, _, _ := startAppWithInstancesAndErrors(defaultAppForStart, requirementsFactory)
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Could not fetch crash events: events-error"},
+						[]string{"Could not fetch recent logs: logs-error"},
+						[]string{"FAILED"},
+						[]string{"Start unsuccessful"},
+					))
+				})
+			})
 		})
 
 		Context("when an app instance is starting", func() {
@@ -24,22 +24,32 @@ func init() {
 }
 
 func (cmd *Authenticate) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["assertion"] = &flags.StringFlag{Name: "assertion", Usage: T("JWT issued by an identity provider trusted by UAA, such as the OIDC token of a CI job")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "auth",
 		Description: T("Authenticate user non-interactively"),
 		Usage: []string{
-			T("CF_NAME auth USERNAME PASSWORD\n\n"),
+			T("CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth --assertion TOKEN\n\n"),
 			terminal.WarningColor(T("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history")),
 		},
 		Examples: []string{
 			T("CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)"),
 			T("CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"),
+			T("CF_NAME auth --assertion \"$CI_JOB_JWT\" (exchange a token issued by the CI system for a session)"),
 		},
+		Flags: fs,
 	}
 }
 
 func (cmd *Authenticate) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
-	if len(fc.Args()) != 2 {
+	if fc.IsSet("assertion") {
+		if len(fc.Args()) != 0 {
+			cmd.ui.Failed(T("Incorrect Usage. USERNAME and PASSWORD cannot be used with --assertion\n\n") + commandregistry.Commands.CommandUsage("auth"))
+			return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 0)
+		}
+	} else if len(fc.Args()) != 2 {
 		cmd.ui.Failed(T("Incorrect Usage. Requires 'username password' as arguments\n\n") + commandregistry.Commands.CommandUsage("auth"))
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 2)
 	}
@@ -66,7 +76,12 @@ func (cmd *Authenticate) Execute(c flags.FlagContext) error {
 		map[string]interface{}{"APIEndpoint": terminal.EntityNameColor(cmd.config.APIEndpoint())}))
 	cmd.ui.Say(T("Authenticating..."))
 
-	err := cmd.authenticator.Authenticate(map[string]string{"username": c.Args()[0], "password": c.Args()[1]})
+	var err error
+	if c.IsSet("assertion") {
+		err = cmd.authenticator.AuthenticateWithAssertion(c.String("assertion"))
+	} else {
+		err = cmd.authenticator.Authenticate(map[string]string{"username": c.Args()[0], "password": c.Args()[1]})
+	}
 	if err != nil {
 		return err
 	}
@@ -101,6 +101,38 @@ var _ = Describe("auth command", func() {
 			Expect(authRepo.GetLoginPromptsAndSaveUAAServerURLCallCount()).To(Equal(1))
 		})
 
+		Context("when the --assertion flag is provided", func() {
+			It("authenticates with the assertion", func() {
+				testcmd.RunCLICommand("auth", []string{"--assertion", "some-jwt"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Authenticating..."},
+					[]string{"OK"},
+				))
+				Expect(authRepo.AuthenticateWithAssertionArgsForCall(0)).To(Equal("some-jwt"))
+				Expect(authRepo.AuthenticateCallCount()).To(Equal(0))
+			})
+
+			It("fails with usage when a username and password are also provided", func() {
+				Expect(testcmd.RunCLICommand("auth", []string{"--assertion", "some-jwt", "username", "password"}, requirementsFactory, updateCommandDependency, false, ui)).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "cannot be used with --assertion"},
+				))
+				Expect(authRepo.AuthenticateWithAssertionCallCount()).To(Equal(0))
+			})
+
+			It("displays the error when the assertion is rejected", func() {
+				authRepo.AuthenticateWithAssertionReturns(errors.New("The assertion was rejected."))
+				testcmd.RunCLICommand("auth", []string{"--assertion", "some-jwt"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"The assertion was rejected."},
+				))
+			})
+		})
+
 		Describe("when authentication fails", func() {
 			BeforeEach(func() {
 				authRepo.AuthenticateReturns(errors.New("Error authenticating."))
@@ -40,23 +40,29 @@ func (cmd *Curl) MetaData() commandregistry.CommandMetadata {
 	fs["H"] = &flags.StringSliceFlag{ShortName: "H", Usage: T("Custom headers to include in the request, flag can be specified multiple times")}
 	fs["d"] = &flags.StringFlag{ShortName: "d", Usage: T("HTTP data to include in the request body, or '@' followed by a file name to read the data from")}
 	fs["output"] = &flags.StringFlag{Name: "output", Usage: T("Write curl body to FILE instead of stdout")}
+	fs["target"] = &flags.StringFlag{Name: "target", Usage: T("Platform API to send the request to: cc, uaa, routing, networking or log-cache (Default: cc)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "curl",
 		Description: T("Executes a request to the targeted API endpoint"),
 		Usage: []string{
-			T(`CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE]
+			T(`CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--target TARGET]
 
    By default 'CF_NAME curl' will perform a GET to the specified PATH. If data
    is provided via -d, a POST will be performed instead, and the Content-Type
    will be set to application/json. You may override headers with -H and the
    request method with -X.
 
+   PATH is relative to the Cloud Controller unless --target names another
+   platform API. The networking and Log Cache URLs are discovered from the
+   Cloud Controller.
+
    For API documentation, please visit http://apidocs.cloudfoundry.org.`),
 		},
 		Examples: []string{
 			`CF_NAME curl "/v2/apps" -X GET -H "Content-Type: application/x-www-form-urlencoded" -d 'q=name:myapp'`,
 			`CF_NAME curl "/v2/apps" -d @/path/to/file`,
+			`CF_NAME curl "/Users" --target uaa`,
 		},
 		Flags: fs,
 	}
@@ -68,6 +74,13 @@ func (cmd *Curl) Requirements(requirementsFactory requirements.Factory, fc flags
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	switch fc.String("target") {
+	case "", api.CurlTargetCloudController, api.CurlTargetUAA, api.CurlTargetRouting, api.CurlTargetNetworking, api.CurlTargetLogCache:
+	default:
+		cmd.ui.Failed(T("Incorrect Usage. --target must be one of cc, uaa, routing, networking or log-cache.\n\n") + commandregistry.Commands.CommandUsage("curl"))
+		return nil, fmt.Errorf("Incorrect usage: unknown target %s", fc.String("target"))
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewAPIEndpointRequirement(),
 	}
@@ -104,9 +117,14 @@ func (cmd *Curl) Execute(c flags.FlagContext) error {
 		method = c.String("X")
 	}
 
+	target := api.CurlTargetCloudController
+	if c.IsSet("target") {
+		target = c.String("target")
+	}
+
 	reqHeader := strings.Join(headers, "\n")
 
-	responseHeader, responseBody, apiErr := cmd.curlRepo.Request(method, path, reqHeader, body)
+	responseHeader, responseBody, apiErr := cmd.curlRepo.RequestTarget(target, method, path, reqHeader, body)
 	if apiErr != nil {
 		return errors.New(T("Error creating request:\n{{.Err}}", map[string]interface{}{"Err": apiErr.Error()}))
 	}
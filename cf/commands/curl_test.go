@@ -130,6 +130,29 @@ var _ = Describe("curl command", func() {
 		})
 	})
 
+	Context("when --target is provided", func() {
+		It("sends the request to the target", func() {
+			runCurlWithInputs([]string{"--target", "uaa", "/Users"})
+
+			Expect(curlRepo.Target).To(Equal("uaa"))
+			Expect(curlRepo.Path).To(Equal("/Users"))
+			Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
+		})
+
+		It("fails with usage when the target is unknown", func() {
+			Expect(runCurlWithInputs([]string{"--target", "bbs", "/foo"})).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "--target must be one of cc, uaa, routing, networking or log-cache"},
+			))
+		})
+	})
+
+	It("sends the request to the Cloud Controller by default", func() {
+		runCurlWithInputs([]string{"/foo"})
+
+		Expect(curlRepo.Target).To(Equal("cc"))
+	})
+
 	It("makes a post request given -X", func() {
 		runCurlWithInputs([]string{"-X", "post", "/foo"})
 
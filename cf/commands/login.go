@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api/organizations"
 	"code.cloudfoundry.org/cli/cf/api/spaces"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
@@ -42,14 +43,16 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Space")}
 	fs["sso"] = &flags.BoolFlag{Name: "sso", Usage: T("Prompt for a one-time passcode to login")}
 	fs["sso-passcode"] = &flags.StringFlag{Name: "sso-passcode", Usage: T("One-time passcode")}
+	fs["mfa-code"] = &flags.StringFlag{Name: "mfa-code", Usage: T("Multi-factor authentication code, prompted for when the identity provider requires one")}
 	fs["skip-ssl-validation"] = &flags.BoolFlag{Name: "skip-ssl-validation", Usage: T("Skip verification of the API endpoint. Not recommended!")}
+	fs["check"] = &flags.BoolFlag{Name: "check", Usage: T("Verify the current login and target without logging in again")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "login",
 		ShortName:   "l",
 		Description: T("Log user in"),
 		Usage: []string{
-			T("CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\n"),
+			T("CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--mfa-code CODE]\n   CF_NAME login --check\n\n"),
 			terminal.WarningColor(T("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history")),
 		},
 		Examples: []string{
@@ -58,6 +61,8 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 			T("CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)"),
 			T("CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)"),
 			T("CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"),
+			T("CF_NAME login -u name@example.com --mfa-code 123456 (provide the code from your authenticator app)"),
+			T("CF_NAME login --check (verify the stored login and target, e.g. before running commands in a pipeline)"),
 		},
 		Flags: fs,
 	}
@@ -79,6 +84,10 @@ func (cmd *Login) SetDependency(deps commandregistry.Dependency, pluginCall bool
 }
 
 func (cmd *Login) Execute(c flags.FlagContext) error {
+	if c.Bool("check") {
+		return cmd.checkLogin(c)
+	}
+
 	cmd.config.ClearSession()
 
 	endpoint, skipSSL := cmd.decideEndpoint(c)
@@ -111,6 +120,8 @@ func (cmd *Login) Execute(c flags.FlagContext) error {
 	switch {
 	case c.Bool("sso") && c.IsSet("sso-passcode"):
 		return errors.New(T("Incorrect usage: --sso-passcode flag cannot be used with --sso"))
+	case (c.Bool("sso") || c.IsSet("sso-passcode")) && c.IsSet("mfa-code"):
+		return errors.New(T("Incorrect usage: --mfa-code flag cannot be used with --sso or --sso-passcode"))
 	case c.Bool("sso") || c.IsSet("sso-passcode"):
 		err = cmd.authenticateSSO(c)
 		if err != nil {
@@ -138,6 +149,58 @@ func (cmd *Login) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+// checkLogin verifies that the stored tokens are still valid and that the
+// targeted org and space still exist, without changing the session. An
+// expired access token is refreshed by the gateway on the first request.
+func (cmd *Login) checkLogin(c flags.FlagContext) error {
+	for _, flagName := range []string{"a", "u", "p", "o", "s", "sso", "sso-passcode", "mfa-code", "skip-ssl-validation"} {
+		if c.IsSet(flagName) {
+			return errors.New(T("Incorrect usage: --check flag cannot be used with any other flags"))
+		}
+	}
+
+	cmd.ui.Say(T("Checking login..."))
+
+	if !cmd.config.IsLoggedIn() {
+		return errors.New(terminal.NotLoggedInText())
+	}
+
+	if !cmd.config.HasOrganization() {
+		_, err := cmd.orgRepo.ListOrgs(1)
+		if err != nil {
+			return err
+		}
+
+		cmd.ui.Ok()
+		return nil
+	}
+
+	orgFields := cmd.config.OrganizationFields()
+	org, err := cmd.orgRepo.FindByName(orgFields.Name)
+	if err == nil && org.GUID != orgFields.GUID {
+		err = cferrors.NewModelNotFoundError("Organization", orgFields.Name)
+	}
+	if err != nil {
+		return errors.New(T("Error finding org {{.OrgName}}\n{{.Err}}",
+			map[string]interface{}{"OrgName": terminal.EntityNameColor(orgFields.Name), "Err": err.Error()}))
+	}
+
+	if cmd.config.HasSpace() {
+		spaceFields := cmd.config.SpaceFields()
+		space, err := cmd.spaceRepo.FindByNameInOrg(spaceFields.Name, orgFields.GUID)
+		if err == nil && space.GUID != spaceFields.GUID {
+			err = cferrors.NewModelNotFoundError("Space", spaceFields.Name)
+		}
+		if err != nil {
+			return errors.New(T("Error finding space {{.SpaceName}}\n{{.Err}}",
+				map[string]interface{}{"SpaceName": terminal.EntityNameColor(spaceFields.Name), "Err": err.Error()}))
+		}
+	}
+
+	cmd.ui.Ok()
+	return nil
+}
+
 func (cmd Login) decideEndpoint(c flags.FlagContext) (string, bool) {
 	endpoint := c.String("a")
 	skipSSL := c.Bool("skip-ssl-validation")
@@ -192,6 +255,7 @@ func (cmd Login) authenticateSSO(c flags.FlagContext) error {
 func (cmd Login) authenticate(c flags.FlagContext) error {
 	usernameFlagValue := c.String("u")
 	passwordFlagValue := c.String("p")
+	mfaCodeFlagValue := c.String("mfa-code")
 
 	prompts, err := cmd.authenticator.GetLoginPromptsAndSaveUAAServerURL()
 	if err != nil {
@@ -210,7 +274,7 @@ func (cmd Login) authenticate(c flags.FlagContext) error {
 
 	for key, prompt := range prompts {
 		if prompt.Type == coreconfig.AuthPromptTypePassword {
-			if key == "passcode" {
+			if key == "passcode" || key == authentication.MFACodePromptKey {
 				continue
 			}
 
@@ -222,23 +286,45 @@ func (cmd Login) authenticate(c flags.FlagContext) error {
 		}
 	}
 
+	// The MFA code is always asked for last, after the password.
+	mfaPrompt, mfaPrompted := prompts[authentication.MFACodePromptKey]
+	if !mfaPrompted && mfaCodeFlagValue != "" {
+		mfaPrompt = defaultMFACodePrompt()
+		mfaPrompted = true
+	}
+	if mfaPrompted {
+		passwordKeys = append(passwordKeys, authentication.MFACodePromptKey)
+	}
+
 	for i := 0; i < maxLoginTries; i++ {
 		for _, key := range passwordKeys {
-			if key == "password" && passwordFlagValue != "" {
+			switch {
+			case key == "password" && passwordFlagValue != "":
 				credentials[key] = passwordFlagValue
 				passwordFlagValue = ""
-			} else {
+			case key == authentication.MFACodePromptKey && mfaCodeFlagValue != "":
+				credentials[key] = mfaCodeFlagValue
+				mfaCodeFlagValue = ""
+			case key == authentication.MFACodePromptKey:
+				credentials[key] = cmd.ui.AskForPassword(mfaPrompt.DisplayName)
+			default:
 				credentials[key] = cmd.ui.AskForPassword(prompts[key].DisplayName)
 			}
 		}
 
-		credentialsCopy := make(map[string]string, len(credentials))
-		for k, v := range credentials {
-			credentialsCopy[k] = v
-		}
+		err = cmd.attemptAuthentication(credentials)
 
-		cmd.ui.Say(T("Authenticating..."))
-		err = cmd.authenticator.Authenticate(credentialsCopy)
+		// UAA does not advertise the MFA prompt on every version, so the code
+		// is asked for once UAA reports that it is required.
+		if _, ok := err.(authentication.MFARequiredError); ok && !mfaPrompted {
+			cmd.ui.Say(err.Error())
+			mfaPrompt = defaultMFACodePrompt()
+			passwordKeys = append(passwordKeys, authentication.MFACodePromptKey)
+			mfaPrompted = true
+
+			credentials[authentication.MFACodePromptKey] = cmd.ui.AskForPassword(mfaPrompt.DisplayName)
+			err = cmd.attemptAuthentication(credentials)
+		}
 
 		if err == nil {
 			cmd.ui.Ok()
@@ -255,6 +341,23 @@ func (cmd Login) authenticate(c flags.FlagContext) error {
 	return nil
 }
 
+func (cmd Login) attemptAuthentication(credentials map[string]string) error {
+	credentialsCopy := make(map[string]string, len(credentials))
+	for k, v := range credentials {
+		credentialsCopy[k] = v
+	}
+
+	cmd.ui.Say(T("Authenticating..."))
+	return cmd.authenticator.Authenticate(credentialsCopy)
+}
+
+func defaultMFACodePrompt() coreconfig.AuthPrompt {
+	return coreconfig.AuthPrompt{
+		Type:        coreconfig.AuthPromptTypePassword,
+		DisplayName: T("MFA Code"),
+	}
+}
+
 func (cmd Login) setOrganization(c flags.FlagContext) (bool, error) {
 	orgName := c.String("o")
 
@@ -3,6 +3,7 @@ package commands_test
 import (
 	"strconv"
 
+	"code.cloudfoundry.org/cli/cf/api/authentication"
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
 	"code.cloudfoundry.org/cli/cf/api/organizations/organizationsfakes"
 	"code.cloudfoundry.org/cli/cf/api/spaces/spacesfakes"
@@ -542,6 +543,113 @@ var _ = Describe("Login Command", func() {
 
 				Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}))
 			})
+
+			Context("when UAA advertises an MFA code prompt", func() {
+				BeforeEach(func() {
+					authRepo.GetLoginPromptsAndSaveUAAServerURLReturns(map[string]coreconfig.AuthPrompt{
+						"username": {
+							DisplayName: "Username",
+							Type:        coreconfig.AuthPromptTypeText,
+						},
+						"password": {
+							DisplayName: "Your Password",
+							Type:        coreconfig.AuthPromptTypePassword,
+						},
+						"mfaCode": {
+							DisplayName: "MFA Code ( Register at https://login.example.com )",
+							Type:        coreconfig.AuthPromptTypePassword,
+						},
+					}, nil)
+				})
+
+				It("prompts for the MFA code after the password", func() {
+					ui.Inputs = []string{"api.example.com", "the-username", "the-password", "123456"}
+
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(ui.PasswordPrompts).To(ContainSubstrings(
+						[]string{"Your Password"},
+						[]string{"MFA Code ( Register at https://login.example.com )"},
+					))
+					Expect(authRepo.AuthenticateCallCount()).To(Equal(1))
+					Expect(authRepo.AuthenticateArgsForCall(0)).To(Equal(map[string]string{
+						"username": "the-username",
+						"password": "the-password",
+						"mfaCode":  "123456",
+					}))
+				})
+
+				It("takes the MFA code from the --mfa-code flag", func() {
+					Flags = []string{"-p", "the-password", "--mfa-code", "123456"}
+					ui.Inputs = []string{"api.example.com", "the-username"}
+
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(ui.PasswordPrompts).To(BeEmpty())
+					Expect(authRepo.AuthenticateArgsForCall(0)).To(Equal(map[string]string{
+						"username": "the-username",
+						"password": "the-password",
+						"mfaCode":  "123456",
+					}))
+				})
+
+				It("prompts for the MFA code again if the code given on the cmd line fails", func() {
+					authRepo.AuthenticateReturns(errors.New("Error authenticating."))
+					Flags = []string{"-p", "the-password-1", "--mfa-code", "111111"}
+					ui.Inputs = []string{"api.example.com", "the-username", "the-password-2", "222222", "the-password-3", "333333"}
+
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(authRepo.AuthenticateCallCount()).To(Equal(3))
+					Expect(authRepo.AuthenticateArgsForCall(1)).To(Equal(map[string]string{
+						"username": "the-username",
+						"password": "the-password-2",
+						"mfaCode":  "222222",
+					}))
+				})
+			})
+
+			Context("when UAA requires an MFA code without advertising the prompt", func() {
+				BeforeEach(func() {
+					authRepo.AuthenticateStub = func(credentials map[string]string) error {
+						if credentials["mfaCode"] == "" {
+							return authentication.MFARequiredError{}
+						}
+						Config.SetAccessToken("my_access_token")
+						Config.SetRefreshToken("my_refresh_token")
+						return nil
+					}
+				})
+
+				It("prompts for the MFA code and retries with the same credentials", func() {
+					Flags = []string{"-p", "the-password"}
+					ui.Inputs = []string{"api.example.com", "the-username", "the-account-number", "123456"}
+
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"A multi-factor authentication code is required."}))
+					Expect(ui.PasswordPrompts).To(ContainSubstrings([]string{"MFA Code"}))
+					Expect(authRepo.AuthenticateCallCount()).To(Equal(2))
+					Expect(authRepo.AuthenticateArgsForCall(1)).To(Equal(map[string]string{
+						"account_number": "the-account-number",
+						"username":       "the-username",
+						"password":       "the-password",
+						"mfaCode":        "123456",
+					}))
+					Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
+				})
+			})
+
+			Context("when the --mfa-code flag is provided with --sso", func() {
+				It("errors with usage error and does not try to authenticate", func() {
+					Flags = []string{"--sso", "--mfa-code", "123456", "-a", "api.example.com"}
+
+					execution := testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+					Expect(execution).To(BeFalse())
+
+					Expect(authRepo.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
 		})
 	})
 
@@ -844,4 +952,137 @@ var _ = Describe("Login Command", func() {
 			})
 		})
 	})
+
+	Describe("--check", func() {
+		var succeeded bool
+
+		BeforeEach(func() {
+			Config = testconfig.NewRepositoryWithDefaults()
+			Config.SetRefreshToken("my-refresh-token")
+			Flags = []string{"--check"}
+
+			orgRepo.FindByNameReturns(models.Organization{
+				OrganizationFields: models.OrganizationFields{Name: "my-org", GUID: "my-org-guid"},
+			}, nil)
+			spaceRepo.FindByNameInOrgReturns(models.Space{
+				SpaceFields: models.SpaceFields{Name: "my-space", GUID: "my-space-guid"},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			succeeded = testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+		})
+
+		It("verifies the targeted org and space without changing the session", func() {
+			Expect(succeeded).To(BeTrue())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Checking login..."},
+				[]string{"OK"},
+			))
+
+			Expect(orgRepo.FindByNameArgsForCall(0)).To(Equal("my-org"))
+			spaceName, orgGUID := spaceRepo.FindByNameInOrgArgsForCall(0)
+			Expect(spaceName).To(Equal("my-space"))
+			Expect(orgGUID).To(Equal("my-org-guid"))
+
+			Expect(authRepo.AuthenticateCallCount()).To(Equal(0))
+			Expect(endpointRepo.GetCCInfoCallCount()).To(Equal(0))
+			Expect(Config.RefreshToken()).To(Equal("my-refresh-token"))
+			Expect(Config.SpaceFields().GUID).To(Equal("my-space-guid"))
+			Expect(ui.ShowConfigurationCalled).To(BeFalse())
+		})
+
+		Context("when no org is targeted", func() {
+			BeforeEach(func() {
+				Config.SetOrganizationFields(models.OrganizationFields{})
+				Config.SetSpaceFields(models.SpaceFields{})
+			})
+
+			It("verifies the tokens with a request to the cloud controller", func() {
+				Expect(succeeded).To(BeTrue())
+				Expect(orgRepo.ListOrgsCallCount()).To(Equal(1))
+				Expect(orgRepo.FindByNameCallCount()).To(Equal(0))
+			})
+
+			Context("when the tokens are no longer valid", func() {
+				BeforeEach(func() {
+					orgRepo.ListOrgsReturns(nil, errors.NewInvalidTokenError("token expired"))
+				})
+
+				It("fails", func() {
+					Expect(succeeded).To(BeFalse())
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}))
+				})
+			})
+		})
+
+		Context("when the user is not logged in", func() {
+			BeforeEach(func() {
+				Config.SetAccessToken("")
+			})
+
+			It("fails", func() {
+				Expect(succeeded).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Not logged in."},
+				))
+				Expect(orgRepo.FindByNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the targeted org no longer exists", func() {
+			BeforeEach(func() {
+				orgRepo.FindByNameReturns(models.Organization{}, errors.NewModelNotFoundError("Organization", "my-org"))
+			})
+
+			It("fails", func() {
+				Expect(succeeded).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Error finding org", "my-org"},
+				))
+				Expect(spaceRepo.FindByNameInOrgCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the targeted org has been recreated", func() {
+			BeforeEach(func() {
+				orgRepo.FindByNameReturns(models.Organization{
+					OrganizationFields: models.OrganizationFields{Name: "my-org", GUID: "some-other-org-guid"},
+				}, nil)
+			})
+
+			It("fails", func() {
+				Expect(succeeded).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Error finding org", "my-org"}))
+			})
+		})
+
+		Context("when the targeted space no longer exists", func() {
+			BeforeEach(func() {
+				spaceRepo.FindByNameInOrgReturns(models.Space{}, errors.NewModelNotFoundError("Space", "my-space"))
+			})
+
+			It("fails", func() {
+				Expect(succeeded).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Error finding space", "my-space"},
+				))
+			})
+		})
+
+		Context("when other flags are provided", func() {
+			BeforeEach(func() {
+				Flags = []string{"--check", "-o", "some-org"}
+			})
+
+			It("fails with a usage error", func() {
+				Expect(succeeded).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect usage", "--check"}))
+				Expect(orgRepo.FindByNameCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
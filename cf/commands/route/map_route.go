@@ -3,17 +3,22 @@ package route
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+// wildcardHost is the hostname of a route matching every host of its domain.
+const wildcardHost = "*"
+
 type MapRoute struct {
 	ui           terminal.UI
 	config       coreconfig.Reader
@@ -55,6 +60,7 @@ func (cmd *MapRoute) MetaData() commandregistry.CommandMetadata {
 			"CF_NAME map-route my-app example.com --hostname myhost            # myhost.example.com",
 			"CF_NAME map-route my-app example.com --hostname myhost --path foo # myhost.example.com/foo",
 			"CF_NAME map-route my-app example.com --port 50000                 # example.com:50000",
+			"CF_NAME map-route my-app example.com --hostname \"*\"               # *.example.com",
 		},
 		Flags: fs,
 	}
@@ -76,6 +82,11 @@ func (cmd *MapRoute) Requirements(requirementsFactory requirements.Factory, fc f
 		return nil, fmt.Errorf("Cannot specify random-port together with port, hostname and/or path.")
 	}
 
+	if hostname := fc.String("hostname"); strings.Contains(hostname, wildcardHost) && hostname != wildcardHost {
+		cmd.ui.Failed(T("Invalid hostname. A wildcard hostname must be exactly '*'."))
+		return nil, fmt.Errorf("Invalid wildcard hostname %s", hostname)
+	}
+
 	appName := fc.Args()[0]
 	domainName := fc.Args()[1]
 
@@ -134,6 +145,9 @@ func (cmd *MapRoute) Execute(c flags.FlagContext) error {
 	port := c.Int("port")
 	randomPort := c.Bool("random-port")
 	route, err := cmd.routeCreator.CreateRoute(hostName, path, port, randomPort, domain, cmd.config.SpaceFields())
+	if httpErr, ok := err.(cferrors.HTTPError); ok && httpErr.ErrorCode() == cferrors.NotAuthorized && hostName == wildcardHost {
+		err = errors.New(fmt.Sprintf(T("You are not authorized to create the wildcard route %s.\nWildcard routes on shared domains can only be created by an admin."), domain.URLForHostAndPath(hostName, path, 0)))
+	}
 	if err != nil {
 		return errors.New(T("Error resolving route:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
 	}
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/commands/route"
 	"code.cloudfoundry.org/cli/cf/commands/route/routefakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
@@ -113,6 +114,7 @@ var _ = Describe("MapRoute", func() {
 
 		It("contains an example", func() {
 			Expect(usage).To(ContainElement("   cf map-route my-app example.com --port 50000                 # example.com:50000"))
+			Expect(usage).To(ContainElement(`   cf map-route my-app example.com --hostname "*"               # *.example.com`))
 		})
 
 		It("contains the options", func() {
@@ -292,6 +294,21 @@ var _ = Describe("MapRoute", func() {
 				})
 			})
 
+			Context("when the hostname only partially is a wildcard", func() {
+				BeforeEach(func() {
+					flagContext.Parse("app-name", "example.com", "--hostname", "some-*")
+				})
+
+				It("fails", func() {
+					_, err := cmd.Requirements(factory, flagContext)
+					Expect(err).To(HaveOccurred())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Invalid hostname. A wildcard hostname must be exactly '*'."},
+					))
+				})
+			})
+
 			Context("when passing port with a path", func() {
 				BeforeEach(func() {
 					flagContext.Parse("app-name", "example.com", "--port", "8080", "--path", "something-else")
@@ -437,6 +454,24 @@ var _ = Describe("MapRoute", func() {
 			})
 		})
 
+		Context("when the user is not authorized to create a wildcard route", func() {
+			BeforeEach(func() {
+				err := flagContext.Parse("app-name", "domain-name", "--hostname", "*")
+				Expect(err).NotTo(HaveOccurred())
+				cmd.Requirements(factory, flagContext)
+
+				fakeRouteCreator, ok := fakeCreateRouteCmd.(*routefakes.OldFakeRouteCreator)
+				Expect(ok).To(BeTrue())
+				fakeRouteCreator.CreateRouteReturns(models.Route{}, cferrors.NewHTTPError(403, cferrors.NotAuthorized, "You are not authorized to perform the requested action"))
+			})
+
+			It("explains that wildcard routes require an admin", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("You are not authorized to create the wildcard route *.fake-domain-name."))
+				Expect(err.Error()).To(ContainSubstring("Wildcard routes on shared domains can only be created by an admin."))
+			})
+		})
+
 		Context("when creating the route succeeds", func() {
 			BeforeEach(func() {
 				fakeRouteCreator, ok := fakeCreateRouteCmd.(*routefakes.OldFakeRouteCreator)
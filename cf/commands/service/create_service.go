@@ -3,6 +3,8 @@ package service
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/actors/servicebuilder"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -22,6 +24,7 @@ type CreateService struct {
 	config         coreconfig.Reader
 	serviceRepo    api.ServiceRepository
 	serviceBuilder servicebuilder.ServiceBuilder
+	paramsActor    actors.ServiceParametersActor
 }
 
 func init() {
@@ -32,8 +35,10 @@ func (cmd *CreateService) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["c"] = &flags.StringFlag{ShortName: "c", Usage: T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering.")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("User provided tags")}
+	fs["schema"] = &flags.BoolFlag{Name: "schema", Usage: T("Display the configuration parameters accepted by the service plan instead of creating a service instance")}
 
 	baseUsage := T("CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [-t TAGS]")
+	schemaUsage := T("CF_NAME create-service SERVICE PLAN --schema")
 	paramsUsage := T(`   Optionally provide service-specific configuration parameters in a valid JSON object in-line:
 
    CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{"name":"value","name":"value"}'
@@ -58,6 +63,8 @@ func (cmd *CreateService) MetaData() commandregistry.CommandMetadata {
 		Description: T("Create a service instance"),
 		Usage: []string{
 			baseUsage,
+			"\n   ",
+			schemaUsage,
 			"\n\n",
 			paramsUsage,
 			"\n\n",
@@ -76,13 +83,15 @@ func (cmd *CreateService) MetaData() commandregistry.CommandMetadata {
 			`CF_NAME create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json`,
 			``,
 			`CF_NAME create-service db-service silver mydb -t "list, of, tags"`,
+			``,
+			`CF_NAME create-service db-service silver --schema`,
 		},
 		Flags: fs,
 	}
 }
 
 func (cmd *CreateService) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
-	if len(fc.Args()) != 3 {
+	if len(fc.Args()) != 3 && !(fc.Bool("schema") && len(fc.Args()) == 2) {
 		cmd.ui.Failed(T("Incorrect Usage. Requires service, service plan, service instance as arguments\n\n") + commandregistry.Commands.CommandUsage("create-service"))
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 3)
 	}
@@ -100,12 +109,18 @@ func (cmd *CreateService) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.config = deps.Config
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
 	cmd.serviceBuilder = deps.ServiceBuilder
+	cmd.paramsActor = deps.ServiceParameters
 	return cmd
 }
 
 func (cmd *CreateService) Execute(c flags.FlagContext) error {
 	serviceName := c.Args()[0]
 	planName := c.Args()[1]
+
+	if c.Bool("schema") {
+		return cmd.showSchema(serviceName, planName)
+	}
+
 	serviceInstanceName := c.Args()[2]
 	params := c.String("c")
 	tags := c.String("t")
@@ -147,6 +162,9 @@ func (cmd *CreateService) Execute(c flags.FlagContext) error {
 	case *errors.ModelAlreadyExistsError:
 		cmd.ui.Ok()
 		cmd.ui.Warn(err.Error())
+	case actors.InvalidServiceParametersError:
+		return fmt.Errorf("%s\n\n%s", err.Error(), fmt.Sprintf(T("TIP: Use '%s' to display the parameters accepted by the service plan."),
+			terminal.CommandColor(fmt.Sprintf("%s create-service %s %s --schema", cf.Name, serviceName, planName))))
 	default:
 		return err
 	}
@@ -164,10 +182,69 @@ func (cmd CreateService) CreateService(serviceName, planName, serviceInstanceNam
 		return plan, apiErr
 	}
 
+	if params != nil {
+		schemas, err := cmd.paramsActor.GetPlanSchemas(plan.GUID)
+		if err != nil {
+			return plan, err
+		}
+
+		err = cmd.paramsActor.ValidateParameters(schemas.CreateParameters, params)
+		if err != nil {
+			return plan, err
+		}
+	}
+
 	apiErr = cmd.serviceRepo.CreateServiceInstance(serviceInstanceName, plan.GUID, params, tags)
 	return plan, apiErr
 }
 
+func (cmd CreateService) showSchema(serviceName, planName string) error {
+	cmd.ui.Say(fmt.Sprintf(T("Getting parameters schema for plan %s of service %s as %s..."),
+		terminal.EntityNameColor(planName),
+		terminal.EntityNameColor(serviceName),
+		terminal.EntityNameColor(cmd.config.Username()),
+	))
+
+	offerings, err := cmd.serviceBuilder.GetServicesByNameForSpaceWithPlans(cmd.config.SpaceFields().GUID, serviceName)
+	if err != nil {
+		return err
+	}
+
+	plan, err := findPlanFromOfferings(offerings, planName)
+	if err != nil {
+		return err
+	}
+
+	schemas, err := cmd.paramsActor.GetPlanSchemas(plan.GUID)
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	return printParametersSchema(schemas.CreateParameters, cmd.ui)
+}
+
+// printParametersSchema displays the parameters declared by a plan's
+// parameters schema.
+func printParametersSchema(schema map[string]interface{}, ui terminal.UI) error {
+	parameters := json.SchemaParameters(schema)
+	if len(parameters) == 0 {
+		ui.Say(T("The service plan does not publish a parameters schema."))
+		return nil
+	}
+
+	table := ui.Table([]string{T("parameter"), T("type"), T("required"), T("description")})
+	for _, parameter := range parameters {
+		required := ""
+		if parameter.Required {
+			required = T("yes")
+		}
+		table.Add(parameter.Name, parameter.Type, required, parameter.Description)
+	}
+	return table.Print()
+}
+
 func findPlanFromOfferings(offerings models.ServiceOfferings, name string) (plan models.ServicePlanFields, err error) {
 	for _, offering := range offerings {
 		for _, plan := range offering.Plans {
@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"os"
 
+	"code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
 	"code.cloudfoundry.org/cli/cf/actors/servicebuilder/servicebuilderfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
@@ -32,6 +34,7 @@ var _ = Describe("create-service command", func() {
 		requirementsFactory *requirementsfakes.FakeFactory
 		serviceRepo         *apifakes.FakeServiceRepository
 		serviceBuilder      *servicebuilderfakes.FakeServiceBuilder
+		paramsActor         *actorsfakes.FakeServiceParametersActor
 
 		offering1 models.ServiceOffering
 		offering2 models.ServiceOffering
@@ -43,6 +46,7 @@ var _ = Describe("create-service command", func() {
 		deps.Config = config
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
 		deps.ServiceBuilder = serviceBuilder
+		deps.ServiceParameters = paramsActor
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("create-service").SetDependency(deps, pluginCall))
 	}
 
@@ -54,6 +58,7 @@ var _ = Describe("create-service command", func() {
 		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
 		serviceRepo = new(apifakes.FakeServiceRepository)
 		serviceBuilder = new(servicebuilderfakes.FakeServiceBuilder)
+		paramsActor = new(actorsfakes.FakeServiceParametersActor)
 
 		offering1 = models.ServiceOffering{}
 		offering1.Label = "cleardb"
@@ -91,6 +96,15 @@ var _ = Describe("create-service command", func() {
 			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Failing{Message: "not targeted"})
 			Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})).To(BeFalse())
 		})
+
+		It("fails with usage when the service instance is missing", func() {
+			Expect(callCreateService([]string{"cleardb", "spark"})).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage"}))
+		})
+
+		It("does not require the service instance with --schema", func() {
+			Expect(callCreateService([]string{"cleardb", "spark", "--schema"})).To(BeTrue())
+		})
 	})
 
 	It("successfully creates a service", func() {
@@ -198,6 +212,97 @@ var _ = Describe("create-service command", func() {
 		})
 	})
 
+	Context("when validating the params against the plan's schema", func() {
+		var schema map[string]interface{}
+
+		BeforeEach(func() {
+			schema = map[string]interface{}{"type": "object"}
+			paramsActor.GetPlanSchemasReturns(models.ServicePlanSchemas{CreateParameters: schema}, nil)
+		})
+
+		It("validates the params against the create schema of the plan", func() {
+			Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "-c", `{"foo": "bar"}`})).To(BeTrue())
+
+			Expect(paramsActor.GetPlanSchemasArgsForCall(0)).To(Equal("cleardb-spark-guid"))
+			validatedSchema, validatedParams := paramsActor.ValidateParametersArgsForCall(0)
+			Expect(validatedSchema).To(Equal(schema))
+			Expect(validatedParams).To(Equal(map[string]interface{}{"foo": "bar"}))
+			Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(1))
+		})
+
+		It("does not fetch the schema when no params are provided", func() {
+			Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})).To(BeTrue())
+			Expect(paramsActor.GetPlanSchemasCallCount()).To(Equal(0))
+		})
+
+		Context("when the params do not match the schema", func() {
+			BeforeEach(func() {
+				paramsActor.ValidateParametersReturns(actors.InvalidServiceParametersError{
+					Errors: []string{"foo: expected integer, got string"},
+				})
+			})
+
+			It("fails without creating the service instance", func() {
+				Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "-c", `{"foo": "bar"}`})).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"does not match the parameters schema"},
+					[]string{"foo: expected integer, got string"},
+					[]string{"TIP", "cf create-service cleardb spark --schema"},
+				))
+				Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when fetching the schema fails", func() {
+			BeforeEach(func() {
+				paramsActor.GetPlanSchemasReturns(models.ServicePlanSchemas{}, errors.New("schema-error"))
+			})
+
+			It("fails without creating the service instance", func() {
+				Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "-c", `{"foo": "bar"}`})).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}, []string{"schema-error"}))
+				Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when passing --schema", func() {
+		It("displays the parameters of the plan's create schema", func() {
+			paramsActor.GetPlanSchemasReturns(models.ServicePlanSchemas{
+				CreateParameters: map[string]interface{}{
+					"required": []interface{}{"ram_gb"},
+					"properties": map[string]interface{}{
+						"ram_gb": map[string]interface{}{"type": "integer", "description": "Memory in GB"},
+					},
+				},
+			}, nil)
+
+			Expect(callCreateService([]string{"cleardb", "spark", "--schema"})).To(BeTrue())
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Getting parameters schema for plan", "spark", "cleardb", "my-user"},
+				[]string{"OK"},
+				[]string{"parameter", "type", "required", "description"},
+				[]string{"ram_gb", "integer", "yes", "Memory in GB"},
+			))
+			Expect(paramsActor.GetPlanSchemasArgsForCall(0)).To(Equal("cleardb-spark-guid"))
+			Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(0))
+		})
+
+		It("says so when the plan does not publish a schema", func() {
+			Expect(callCreateService([]string{"cleardb", "spark", "--schema"})).To(BeTrue())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"The service plan does not publish a parameters schema."}))
+		})
+
+		It("fails when the plan does not exist", func() {
+			Expect(callCreateService([]string{"cleardb", "bogus", "--schema"})).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}, []string{"Could not find plan with name bogus"}))
+			Expect(paramsActor.GetPlanSchemasCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when service creation is asynchronous", func() {
 		var serviceInstance models.ServiceInstance
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/actors/planbuilder"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -24,6 +25,7 @@ type UpdateService struct {
 	config      coreconfig.Reader
 	serviceRepo api.ServiceRepository
 	planBuilder planbuilder.PlanBuilder
+	paramsActor actors.ServiceParametersActor
 }
 
 func init() {
@@ -32,6 +34,7 @@ func init() {
 
 func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
 	baseUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS]")
+	schemaUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] --schema")
 	paramsUsage := T(`   Optionally provide service-specific configuration parameters in a valid JSON object in-line.
    CF_NAME update-service -c '{"name":"value","name":"value"}'
 
@@ -52,12 +55,15 @@ func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
 	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Change service plan for a service instance")}
 	fs["c"] = &flags.StringFlag{ShortName: "c", Usage: T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering.")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("User provided tags")}
+	fs["schema"] = &flags.BoolFlag{Name: "schema", Usage: T("Display the configuration parameters accepted by the service plan instead of updating the service instance")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "update-service",
 		Description: T("Update a service instance"),
 		Usage: []string{
 			baseUsage,
+			"\n   ",
+			schemaUsage,
 			"\n\n",
 			paramsUsage,
 			"\n\n",
@@ -68,6 +74,7 @@ func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
 			`CF_NAME update-service mydb -c '{"ram_gb":4}'`,
 			`CF_NAME update-service mydb -c ~/workspace/tmp/instance_config.json`,
 			`CF_NAME update-service mydb -t "list,of, tags"`,
+			`CF_NAME update-service mydb -p gold --schema`,
 		},
 		Flags: fs,
 	}
@@ -96,6 +103,7 @@ func (cmd *UpdateService) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.config = deps.Config
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
 	cmd.planBuilder = deps.PlanBuilder
+	cmd.paramsActor = deps.ServiceParameters
 	return cmd
 }
 
@@ -106,6 +114,10 @@ func (cmd *UpdateService) Execute(c flags.FlagContext) error {
 	tagsSet := c.IsSet("t")
 	tagsList := c.String("t")
 
+	if c.Bool("schema") {
+		return cmd.showSchema(c.Args()[0], planName)
+	}
+
 	if planName == "" && params == "" && tagsSet == false {
 		cmd.ui.Ok()
 		cmd.ui.Say(T("No changes were made"))
@@ -133,6 +145,13 @@ func (cmd *UpdateService) Execute(c flags.FlagContext) error {
 		}
 	}
 
+	if paramsMap != nil {
+		err = cmd.validateParameters(serviceInstance, plan, paramsMap)
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.printUpdatingServiceInstanceMessage(serviceInstanceName)
 
 	err = cmd.serviceRepo.UpdateServiceInstance(serviceInstance.GUID, plan.GUID, paramsMap, tags)
@@ -163,6 +182,70 @@ func (cmd *UpdateService) findPlan(serviceInstance models.ServiceInstance, planN
 	return
 }
 
+// targetPlanGUID returns the plan the service instance will have after the
+// update: the new plan when one is given and the current plan otherwise.
+func targetPlanGUID(serviceInstance models.ServiceInstance, newPlan models.ServicePlanFields) string {
+	if newPlan.GUID != "" {
+		return newPlan.GUID
+	}
+	return serviceInstance.ServicePlan.GUID
+}
+
+func (cmd *UpdateService) validateParameters(serviceInstance models.ServiceInstance, newPlan models.ServicePlanFields, params map[string]interface{}) error {
+	planGUID := targetPlanGUID(serviceInstance, newPlan)
+	if planGUID == "" {
+		return nil
+	}
+
+	schemas, err := cmd.paramsActor.GetPlanSchemas(planGUID)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.paramsActor.ValidateParameters(schemas.UpdateParameters, params)
+	if _, ok := err.(actors.InvalidServiceParametersError); ok {
+		schemaCommand := fmt.Sprintf("%s update-service %s --schema", cf.Name, serviceInstance.Name)
+		if newPlan.GUID != "" {
+			schemaCommand = fmt.Sprintf("%s update-service %s -p %s --schema", cf.Name, serviceInstance.Name, newPlan.Name)
+		}
+		return fmt.Errorf("%s\n\n%s", err.Error(), fmt.Sprintf(T("TIP: Use '%s' to display the parameters accepted by the service plan."),
+			terminal.CommandColor(schemaCommand)))
+	}
+	return err
+}
+
+func (cmd *UpdateService) showSchema(serviceInstanceName string, planName string) error {
+	cmd.ui.Say(fmt.Sprintf(T("Getting parameters schema for service instance %s as %s..."),
+		terminal.EntityNameColor(serviceInstanceName),
+		terminal.EntityNameColor(cmd.config.Username()),
+	))
+
+	serviceInstance, err := cmd.serviceRepo.FindInstanceByName(serviceInstanceName)
+	if err != nil {
+		return err
+	}
+
+	var plan models.ServicePlanFields
+	if planName != "" {
+		plan, err = cmd.findPlan(serviceInstance, planName)
+		if err != nil {
+			return err
+		}
+	}
+
+	var schemas models.ServicePlanSchemas
+	if planGUID := targetPlanGUID(serviceInstance, plan); planGUID != "" {
+		schemas, err = cmd.paramsActor.GetPlanSchemas(planGUID)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	return printParametersSchema(schemas.UpdateParameters, cmd.ui)
+}
+
 func (cmd *UpdateService) printUpdatingServiceInstanceMessage(serviceInstanceName string) {
 	cmd.ui.Say(T("Updating service instance {{.ServiceName}} as {{.UserName}}...",
 		map[string]interface{}{
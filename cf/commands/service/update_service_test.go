@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"os"
 
+	"code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
 	planbuilderfakes "code.cloudfoundry.org/cli/cf/actors/planbuilder/planbuilderfakes"
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -30,6 +32,7 @@ var _ = Describe("update-service command", func() {
 		requirementsFactory *requirementsfakes.FakeFactory
 		serviceRepo         *apifakes.FakeServiceRepository
 		planBuilder         *planbuilderfakes.FakePlanBuilder
+		paramsActor         *actorsfakes.FakeServiceParametersActor
 		offering1           models.ServiceOffering
 		deps                commandregistry.Dependency
 	)
@@ -39,6 +42,7 @@ var _ = Describe("update-service command", func() {
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
 		deps.Config = config
 		deps.PlanBuilder = planBuilder
+		deps.ServiceParameters = paramsActor
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("update-service").SetDependency(deps, pluginCall))
 	}
 
@@ -54,6 +58,7 @@ var _ = Describe("update-service command", func() {
 
 		serviceRepo = new(apifakes.FakeServiceRepository)
 		planBuilder = new(planbuilderfakes.FakePlanBuilder)
+		paramsActor = new(actorsfakes.FakeServiceParametersActor)
 
 		offering1 = models.ServiceOffering{}
 		offering1.Label = "cleardb"
@@ -490,4 +495,101 @@ var _ = Describe("update-service command", func() {
 		})
 
 	})
+
+	Describe("validating params against the plan's schema", func() {
+		var schema map[string]interface{}
+
+		BeforeEach(func() {
+			serviceInstance := models.ServiceInstance{
+				ServiceInstanceFields: models.ServiceInstanceFields{
+					Name: "my-service-instance",
+					GUID: "my-service-instance-guid",
+				},
+				ServicePlan: models.ServicePlanFields{
+					Name: "spark",
+					GUID: "murkydb-spark-guid",
+				},
+				ServiceOffering: models.ServiceOfferingFields{
+					Label: "murkydb",
+					GUID:  "murkydb-guid",
+				},
+			}
+			serviceRepo.FindInstanceByNameReturns(serviceInstance, nil)
+			planBuilder.GetPlansForServiceForOrgReturns([]models.ServicePlanFields{
+				{Name: "spark", GUID: "murkydb-spark-guid"},
+				{Name: "flare", GUID: "murkydb-flare-guid"},
+			}, nil)
+
+			schema = map[string]interface{}{"type": "object"}
+			paramsActor.GetPlanSchemasReturns(models.ServicePlanSchemas{UpdateParameters: schema}, nil)
+		})
+
+		It("validates the params against the update schema of the current plan", func() {
+			Expect(callUpdateService([]string{"-c", `{"foo": "bar"}`, "my-service-instance"})).To(BeTrue())
+
+			Expect(paramsActor.GetPlanSchemasArgsForCall(0)).To(Equal("murkydb-spark-guid"))
+			validatedSchema, validatedParams := paramsActor.ValidateParametersArgsForCall(0)
+			Expect(validatedSchema).To(Equal(schema))
+			Expect(validatedParams).To(Equal(map[string]interface{}{"foo": "bar"}))
+			Expect(serviceRepo.UpdateServiceInstanceCallCount()).To(Equal(1))
+		})
+
+		It("validates the params against the update schema of the new plan", func() {
+			Expect(callUpdateService([]string{"-p", "flare", "-c", `{"foo": "bar"}`, "my-service-instance"})).To(BeTrue())
+			Expect(paramsActor.GetPlanSchemasArgsForCall(0)).To(Equal("murkydb-flare-guid"))
+		})
+
+		It("does not fetch the schema when no params are provided", func() {
+			Expect(callUpdateService([]string{"-p", "flare", "my-service-instance"})).To(BeTrue())
+			Expect(paramsActor.GetPlanSchemasCallCount()).To(Equal(0))
+		})
+
+		Context("when the params do not match the schema", func() {
+			BeforeEach(func() {
+				paramsActor.ValidateParametersReturns(actors.InvalidServiceParametersError{
+					Errors: []string{"foo: expected integer, got string"},
+				})
+			})
+
+			It("fails without updating the service instance", func() {
+				Expect(callUpdateService([]string{"-p", "flare", "-c", `{"foo": "bar"}`, "my-service-instance"})).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"foo: expected integer, got string"},
+					[]string{"TIP", "cf update-service my-service-instance -p flare --schema"},
+				))
+				Expect(serviceRepo.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when passing --schema", func() {
+			BeforeEach(func() {
+				paramsActor.GetPlanSchemasReturns(models.ServicePlanSchemas{
+					UpdateParameters: map[string]interface{}{
+						"properties": map[string]interface{}{
+							"ram_gb": map[string]interface{}{"type": "integer"},
+						},
+					},
+				}, nil)
+			})
+
+			It("displays the parameters of the update schema without updating the service instance", func() {
+				Expect(callUpdateService([]string{"my-service-instance", "--schema"})).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Getting parameters schema for service instance", "my-service-instance", "my-user"},
+					[]string{"OK"},
+					[]string{"ram_gb", "integer"},
+				))
+				Expect(paramsActor.GetPlanSchemasArgsForCall(0)).To(Equal("murkydb-spark-guid"))
+				Expect(serviceRepo.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+
+			It("uses the new plan when one is given", func() {
+				Expect(callUpdateService([]string{"my-service-instance", "-p", "flare", "--schema"})).To(BeTrue())
+				Expect(paramsActor.GetPlanSchemasArgsForCall(0)).To(Equal("murkydb-flare-guid"))
+			})
+		})
+	})
 })
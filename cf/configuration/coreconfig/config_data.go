@@ -41,6 +41,28 @@ type Data struct {
 	PluginRepos              []models.PluginRepo
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
+	DialTimeout              int                      `json:",omitempty"`
+	StagingTimeout           int                      `json:",omitempty"`
+	StartupTimeout           int                      `json:",omitempty"`
+	OutputFormat             string                   `json:",omitempty"`
+	TargetSessions           map[string]TargetSession `json:",omitempty"`
+	AutoTargetRules          []AutoTargetRule         `json:",omitempty"`
+	Telemetry                string                   `json:",omitempty"`
+	TelemetryEndpoint        string                   `json:",omitempty"`
+}
+
+// TargetSession holds the tokens of an API target other than the current one.
+type TargetSession struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// AutoTargetRule maps a directory or a git remote to an org and space.
+type AutoTargetRule struct {
+	Path         string `json:",omitempty"`
+	GitRemote    string `json:",omitempty"`
+	Organization string
+	Space        string `json:",omitempty"`
 }
 
 func NewData() *Data {
@@ -207,6 +207,31 @@ var _ = Describe("V3 Config files", func() {
 			Expect(actualData).To(Equal(expectedData))
 		})
 
+		It("keeps the tokens stored for other targets", func() {
+			actualData := coreconfig.NewData()
+			err := actualData.JSONUnmarshalV3([]byte(`{
+				"ConfigVersion": 3,
+				"TargetSessions": {
+					"https://api.other.com": {
+						"AccessToken": "the-other-access-token",
+						"RefreshToken": "the-other-refresh-token"
+					}
+				}
+			}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actualData.TargetSessions).To(Equal(map[string]coreconfig.TargetSession{
+				"https://api.other.com": {
+					AccessToken:  "the-other-access-token",
+					RefreshToken: "the-other-refresh-token",
+				},
+			}))
+
+			jsonData, err := actualData.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(jsonData)).To(ContainSubstring(`"the-other-refresh-token"`))
+		})
+
 		It("returns an empty Data object for non-V3 JSON", func() {
 			actualData := coreconfig.NewData()
 			err := actualData.JSONUnmarshalV3([]byte(exampleV2JSON))
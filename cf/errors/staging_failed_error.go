@@ -0,0 +1,16 @@
+package errors
+
+// StagingFailedError is returned when the Cloud Controller reports that an
+// app failed to stage. Reason is the staging failure reason of the app.
+type StagingFailedError struct {
+	Reason  string
+	Message string
+}
+
+func NewStagingFailedError(reason string, message string) error {
+	return &StagingFailedError{Reason: reason, Message: message}
+}
+
+func (err *StagingFailedError) Error() string {
+	return err.Message
+}
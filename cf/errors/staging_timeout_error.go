@@ -0,0 +1,15 @@
+package errors
+
+// StagingTimeoutError is returned when an app does not finish staging within
+// the staging timeout.
+type StagingTimeoutError struct {
+	Message string
+}
+
+func NewStagingTimeoutError(message string) error {
+	return &StagingTimeoutError{Message: message}
+}
+
+func (err *StagingTimeoutError) Error() string {
+	return err.Message
+}
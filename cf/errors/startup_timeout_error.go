@@ -0,0 +1,15 @@
+package errors
+
+// StartupTimeoutError is returned when no instance of an app is running
+// within the startup timeout.
+type StartupTimeoutError struct {
+	Message string
+}
+
+func NewStartupTimeoutError(message string) error {
+	return &StartupTimeoutError{Message: message}
+}
+
+func (err *StartupTimeoutError) Error() string {
+	return err.Message
+}
@@ -11,6 +11,14 @@ type ServicePlanFields struct {
 	OrgNames            []string
 }
 
+// ServicePlanSchemas holds the JSON schemas a broker publishes for the
+// configuration parameters accepted when creating or updating an instance of
+// a plan. A nil schema means the broker did not publish one.
+type ServicePlanSchemas struct {
+	CreateParameters map[string]interface{}
+	UpdateParameters map[string]interface{}
+}
+
 type ServicePlan struct {
 	ServicePlanFields
 	ServiceOffering ServiceOfferingFields
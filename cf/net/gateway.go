@@ -21,6 +21,7 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/util/tracing"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -420,6 +421,9 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 
 	httpClient.DumpRequest(request)
 
+	span := tracing.StartClientSpan("HTTP " + request.Method)
+	span.SetAttribute("http.method", request.Method)
+	span.SetAttribute("http.url", request.URL.Scheme+"://"+request.URL.Host+request.URL.Path)
 	for i := 0; i < 3; i++ {
 		response, err = httpClient.Do(request)
 		if response == nil && err != nil {
@@ -428,6 +432,11 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 			break
 		}
 	}
+	if response != nil {
+		span.SetAttribute("http.status_code", response.StatusCode)
+	}
+	span.SetError(err)
+	span.End()
 
 	if err != nil {
 		return response, err
@@ -0,0 +1,44 @@
+package command
+
+// autoTargetWarningSkippedCommands set or display the target themselves, so
+// they do not warn about a target that does not match the auto-target rules.
+var autoTargetWarningSkippedCommands = map[string]bool{
+	"api":    true,
+	"auth":   true,
+	"login":  true,
+	"logout": true,
+	"target": true,
+}
+
+// WarnIfAutoTargetMismatch displays a warning when an auto-target rule
+// matches the provided directory and the targeted org and space are not the
+// ones of the rule. The target is not changed: 'target --auto' does that.
+func WarnIfAutoTargetMismatch(config Config, ui UI, commandName string, dir string) {
+	if IsOfflineCommand(commandName) || autoTargetWarningSkippedCommands[commandName] || config.Target() == "" {
+		return
+	}
+
+	rule, ok := config.AutoTargetRule(dir)
+	if !ok {
+		return
+	}
+
+	if config.TargetedOrganization().Name == rule.Organization &&
+		(rule.Space == "" || config.TargetedSpace().Name == rule.Space) {
+		return
+	}
+
+	if rule.Space == "" {
+		ui.DisplayWarning("The auto-target rule for this directory targets org {{.Organization}}. Use '{{.BinaryName}} target --auto' to target it.", map[string]interface{}{
+			"Organization": rule.Organization,
+			"BinaryName":   config.BinaryName(),
+		})
+		return
+	}
+
+	ui.DisplayWarning("The auto-target rule for this directory targets org {{.Organization}} and space {{.Space}}. Use '{{.BinaryName}} target --auto' to target them.", map[string]interface{}{
+		"Organization": rule.Organization,
+		"Space":        rule.Space,
+		"BinaryName":   config.BinaryName(),
+	})
+}
@@ -0,0 +1,102 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("WarnIfAutoTargetMismatch", func() {
+	var (
+		testUI      *ui.UI
+		fakeConfig  *commandfakes.FakeConfig
+		commandName string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		commandName = "push"
+	})
+
+	JustBeforeEach(func() {
+		WarnIfAutoTargetMismatch(fakeConfig, testUI, commandName, "/some/dir")
+	})
+
+	Context("when no rule matches the directory", func() {
+		It("does not display a warning", func() {
+			Expect(fakeConfig.AutoTargetRuleArgsForCall(0)).To(Equal("/some/dir"))
+			Expect(testUI.Err).ToNot(Say("auto-target"))
+		})
+	})
+
+	Context("when the rule matches the current target", func() {
+		BeforeEach(func() {
+			fakeConfig.AutoTargetRuleReturns(configv3.AutoTargetRule{Organization: "some-org", Space: "some-space"}, true)
+		})
+
+		It("does not display a warning", func() {
+			Expect(testUI.Err).ToNot(Say("auto-target"))
+		})
+	})
+
+	Context("when the rule targets another space", func() {
+		BeforeEach(func() {
+			fakeConfig.AutoTargetRuleReturns(configv3.AutoTargetRule{Organization: "some-org", Space: "other-space"}, true)
+		})
+
+		It("displays a warning", func() {
+			Expect(testUI.Err).To(Say(`The auto-target rule for this directory targets org some-org and space other-space\. Use 'faceman target --auto' to target them\.`))
+		})
+
+		Context("when the command sets the target itself", func() {
+			BeforeEach(func() {
+				commandName = "target"
+			})
+
+			It("does not display a warning", func() {
+				Expect(fakeConfig.AutoTargetRuleCallCount()).To(Equal(0))
+				Expect(testUI.Err).ToNot(Say("auto-target"))
+			})
+		})
+
+		Context("when the command is offline", func() {
+			BeforeEach(func() {
+				commandName = "help"
+			})
+
+			It("does not display a warning", func() {
+				Expect(fakeConfig.AutoTargetRuleCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when no API is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetReturns("")
+			})
+
+			It("does not display a warning", func() {
+				Expect(fakeConfig.AutoTargetRuleCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the rule targets another org without a space", func() {
+		BeforeEach(func() {
+			fakeConfig.AutoTargetRuleReturns(configv3.AutoTargetRule{Organization: "other-org"}, true)
+		})
+
+		It("displays a warning", func() {
+			Expect(testUI.Err).To(Say(`The auto-target rule for this directory targets org other-org\. Use 'faceman target --auto' to target it\.`))
+		})
+	})
+})
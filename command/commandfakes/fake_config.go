@@ -19,6 +19,20 @@ type FakeConfig struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AccessTokenExpiresAtStub        func() time.Time
+	accessTokenExpiresAtMutex       sync.RWMutex
+	accessTokenExpiresAtArgsForCall []struct{}
+	accessTokenExpiresAtReturns     struct {
+		result1 time.Time
+	}
+	accessTokenExpiresAtReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	AddPluginStub        func(configv3.Plugin)
+	addPluginMutex       sync.RWMutex
+	addPluginArgsForCall []struct {
+		arg1 configv3.Plugin
+	}
 	APIVersionStub        func() string
 	aPIVersionMutex       sync.RWMutex
 	aPIVersionArgsForCall []struct{}
@@ -28,6 +42,28 @@ type FakeConfig struct {
 	aPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AutoscalerEndpointStub        func() string
+	autoscalerEndpointMutex       sync.RWMutex
+	autoscalerEndpointArgsForCall []struct{}
+	autoscalerEndpointReturns     struct {
+		result1 string
+	}
+	autoscalerEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AutoTargetRuleStub        func(dir string) (configv3.AutoTargetRule, bool)
+	autoTargetRuleMutex       sync.RWMutex
+	autoTargetRuleArgsForCall []struct {
+		dir string
+	}
+	autoTargetRuleReturns struct {
+		result1 configv3.AutoTargetRule
+		result2 bool
+	}
+	autoTargetRuleReturnsOnCall map[int]struct {
+		result1 configv3.AutoTargetRule
+		result2 bool
+	}
 	BinaryNameStub        func() string
 	binaryNameMutex       sync.RWMutex
 	binaryNameArgsForCall []struct{}
@@ -55,6 +91,15 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	CredHubEndpointStub        func() string
+	credHubEndpointMutex       sync.RWMutex
+	credHubEndpointArgsForCall []struct{}
+	credHubEndpointReturns     struct {
+		result1 string
+	}
+	credHubEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct{}
@@ -75,6 +120,24 @@ type FakeConfig struct {
 	dialTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	DopplerEndpointStub        func() string
+	dopplerEndpointMutex       sync.RWMutex
+	dopplerEndpointArgsForCall []struct{}
+	dopplerEndpointReturns     struct {
+		result1 string
+	}
+	dopplerEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	EffectiveSettingsStub        func() []configv3.EffectiveSetting
+	effectiveSettingsMutex       sync.RWMutex
+	effectiveSettingsArgsForCall []struct{}
+	effectiveSettingsReturns     struct {
+		result1 []configv3.EffectiveSetting
+	}
+	effectiveSettingsReturnsOnCall map[int]struct {
+		result1 []configv3.EffectiveSetting
+	}
 	ExperimentalStub        func() bool
 	experimentalMutex       sync.RWMutex
 	experimentalArgsForCall []struct{}
@@ -115,6 +178,24 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsTTYStub        func() bool
+	isTTYMutex       sync.RWMutex
+	isTTYArgsForCall []struct{}
+	isTTYReturns     struct {
+		result1 bool
+	}
+	isTTYReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	LastPluginUpdateCheckStub        func() time.Time
+	lastPluginUpdateCheckMutex       sync.RWMutex
+	lastPluginUpdateCheckArgsForCall []struct{}
+	lastPluginUpdateCheckReturns     struct {
+		result1 time.Time
+	}
+	lastPluginUpdateCheckReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct{}
@@ -124,6 +205,15 @@ type FakeConfig struct {
 	localeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LogCacheEndpointStub        func() string
+	logCacheEndpointMutex       sync.RWMutex
+	logCacheEndpointArgsForCall []struct{}
+	logCacheEndpointReturns     struct {
+		result1 string
+	}
+	logCacheEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
 	MinCLIVersionStub        func() string
 	minCLIVersionMutex       sync.RWMutex
 	minCLIVersionArgsForCall []struct{}
@@ -142,6 +232,15 @@ type FakeConfig struct {
 	overallPollingTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	ParityLogFileStub        func() string
+	parityLogFileMutex       sync.RWMutex
+	parityLogFileArgsForCall []struct{}
+	parityLogFileReturns     struct {
+		result1 string
+	}
+	parityLogFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct{}
@@ -151,6 +250,15 @@ type FakeConfig struct {
 	pluginHomeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	OutputFormatStub        func() string
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct{}
+	outputFormatReturns     struct {
+		result1 string
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct{}
@@ -169,6 +277,15 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginUpdateCheckStub        func() bool
+	pluginUpdateCheckMutex       sync.RWMutex
+	pluginUpdateCheckArgsForCall []struct{}
+	pluginUpdateCheckReturns     struct {
+		result1 bool
+	}
+	pluginUpdateCheckReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	PollingIntervalStub        func() time.Duration
 	pollingIntervalMutex       sync.RWMutex
 	pollingIntervalArgsForCall []struct{}
@@ -178,6 +295,15 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	RefactoredCommandsStub        func() []string
+	refactoredCommandsMutex       sync.RWMutex
+	refactoredCommandsArgsForCall []struct{}
+	refactoredCommandsReturns     struct {
+		result1 []string
+	}
+	refactoredCommandsReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -192,22 +318,72 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	ResponseCacheDirStub        func() string
+	responseCacheDirMutex       sync.RWMutex
+	responseCacheDirArgsForCall []struct{}
+	responseCacheDirReturns     struct {
+		result1 string
+	}
+	responseCacheDirReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SaveTargetSessionStub        func()
+	saveTargetSessionMutex       sync.RWMutex
+	saveTargetSessionArgsForCall []struct{}
+	ScheduledTasksStub           func() ([]configv3.ScheduledTask, error)
+	scheduledTasksMutex          sync.RWMutex
+	scheduledTasksArgsForCall    []struct{}
+	scheduledTasksReturns        struct {
+		result1 []configv3.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []configv3.ScheduledTask
+		result2 error
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
 		token string
 	}
+	SetLastPluginUpdateCheckStub        func(lastCheck time.Time)
+	setLastPluginUpdateCheckMutex       sync.RWMutex
+	setLastPluginUpdateCheckArgsForCall []struct {
+		lastCheck time.Time
+	}
 	SetOrganizationInformationStub        func(guid string, name string)
 	setOrganizationInformationMutex       sync.RWMutex
 	setOrganizationInformationArgsForCall []struct {
 		guid string
 		name string
 	}
+	SetPluginUpdateCheckStub        func(enabled bool)
+	setPluginUpdateCheckMutex       sync.RWMutex
+	setPluginUpdateCheckArgsForCall []struct {
+		enabled bool
+	}
 	SetRefreshTokenStub        func(token string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
 		token string
 	}
+	SetSettingStub        func(name string, value string) error
+	setSettingMutex       sync.RWMutex
+	setSettingArgsForCall []struct {
+		name  string
+		value string
+	}
+	setSettingReturns struct {
+		result1 error
+	}
+	setSettingReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetTelemetryStub        func(enabled bool)
+	setTelemetryMutex       sync.RWMutex
+	setTelemetryArgsForCall []struct {
+		enabled bool
+	}
 	SetSpaceInformationStub        func(guid string, name string, allowSSH bool)
 	setSpaceInformationMutex       sync.RWMutex
 	setSpaceInformationArgsForCall []struct {
@@ -234,6 +410,19 @@ type FakeConfig struct {
 		refreshToken   string
 		sshOAuthClient string
 	}
+	SettingStub        func(name string) (string, error)
+	settingMutex       sync.RWMutex
+	settingArgsForCall []struct {
+		name string
+	}
+	settingReturns struct {
+		result1 string
+		result2 error
+	}
+	settingReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	SkipSSLValidationStub        func() bool
 	skipSSLValidationMutex       sync.RWMutex
 	skipSSLValidationArgsForCall []struct{}
@@ -261,6 +450,46 @@ type FakeConfig struct {
 	startupTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	TakeTargetSessionStub        func(api string) (string, string)
+	takeTargetSessionMutex       sync.RWMutex
+	takeTargetSessionArgsForCall []struct {
+		api string
+	}
+	takeTargetSessionReturns struct {
+		result1 string
+		result2 string
+	}
+	takeTargetSessionReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+	}
+	TelemetryEnabledStub        func() bool
+	telemetryEnabledMutex       sync.RWMutex
+	telemetryEnabledArgsForCall []struct{}
+	telemetryEnabledReturns     struct {
+		result1 bool
+	}
+	telemetryEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	TelemetryEndpointStub        func() string
+	telemetryEndpointMutex       sync.RWMutex
+	telemetryEndpointArgsForCall []struct{}
+	telemetryEndpointReturns     struct {
+		result1 string
+	}
+	telemetryEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	TelemetryUndecidedStub        func() bool
+	telemetryUndecidedMutex       sync.RWMutex
+	telemetryUndecidedArgsForCall []struct{}
+	telemetryUndecidedReturns     struct {
+		result1 bool
+	}
+	telemetryUndecidedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	TargetedOrganizationStub        func() configv3.Organization
 	targetedOrganizationMutex       sync.RWMutex
 	targetedOrganizationArgsForCall []struct{}
@@ -288,6 +517,15 @@ type FakeConfig struct {
 	targetReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UAAEndpointStub        func() string
+	uAAEndpointMutex       sync.RWMutex
+	uAAEndpointArgsForCall []struct{}
+	uAAEndpointReturns     struct {
+		result1 string
+	}
+	uAAEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAAOAuthClientSecretStub        func() string
 	uAAOAuthClientSecretMutex       sync.RWMutex
 	uAAOAuthClientSecretArgsForCall []struct{}
@@ -332,6 +570,17 @@ type FakeConfig struct {
 	writePluginConfigReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteScheduledTasksStub        func(tasks []configv3.ScheduledTask) error
+	writeScheduledTasksMutex       sync.RWMutex
+	writeScheduledTasksArgsForCall []struct {
+		tasks []configv3.ScheduledTask
+	}
+	writeScheduledTasksReturns struct {
+		result1 error
+	}
+	writeScheduledTasksReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -376,6 +625,70 @@ func (fake *FakeConfig) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) AccessTokenExpiresAt() time.Time {
+	fake.accessTokenExpiresAtMutex.Lock()
+	ret, specificReturn := fake.accessTokenExpiresAtReturnsOnCall[len(fake.accessTokenExpiresAtArgsForCall)]
+	fake.accessTokenExpiresAtArgsForCall = append(fake.accessTokenExpiresAtArgsForCall, struct{}{})
+	fake.recordInvocation("AccessTokenExpiresAt", []interface{}{})
+	fake.accessTokenExpiresAtMutex.Unlock()
+	if fake.AccessTokenExpiresAtStub != nil {
+		return fake.AccessTokenExpiresAtStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.accessTokenExpiresAtReturns.result1
+}
+
+func (fake *FakeConfig) AccessTokenExpiresAtCallCount() int {
+	fake.accessTokenExpiresAtMutex.RLock()
+	defer fake.accessTokenExpiresAtMutex.RUnlock()
+	return len(fake.accessTokenExpiresAtArgsForCall)
+}
+
+func (fake *FakeConfig) AccessTokenExpiresAtReturns(result1 time.Time) {
+	fake.AccessTokenExpiresAtStub = nil
+	fake.accessTokenExpiresAtReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeConfig) AccessTokenExpiresAtReturnsOnCall(i int, result1 time.Time) {
+	fake.AccessTokenExpiresAtStub = nil
+	if fake.accessTokenExpiresAtReturnsOnCall == nil {
+		fake.accessTokenExpiresAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.accessTokenExpiresAtReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeConfig) AddPlugin(arg1 configv3.Plugin) {
+	fake.addPluginMutex.Lock()
+	fake.addPluginArgsForCall = append(fake.addPluginArgsForCall, struct {
+		arg1 configv3.Plugin
+	}{arg1})
+	fake.recordInvocation("AddPlugin", []interface{}{arg1})
+	fake.addPluginMutex.Unlock()
+	if fake.AddPluginStub != nil {
+		fake.AddPluginStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) AddPluginCallCount() int {
+	fake.addPluginMutex.RLock()
+	defer fake.addPluginMutex.RUnlock()
+	return len(fake.addPluginArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginArgsForCall(i int) configv3.Plugin {
+	fake.addPluginMutex.RLock()
+	defer fake.addPluginMutex.RUnlock()
+	return fake.addPluginArgsForCall[i].arg1
+}
+
 func (fake *FakeConfig) APIVersion() string {
 	fake.aPIVersionMutex.Lock()
 	ret, specificReturn := fake.aPIVersionReturnsOnCall[len(fake.aPIVersionArgsForCall)]
@@ -416,6 +729,97 @@ func (fake *FakeConfig) APIVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) AutoscalerEndpoint() string {
+	fake.autoscalerEndpointMutex.Lock()
+	ret, specificReturn := fake.autoscalerEndpointReturnsOnCall[len(fake.autoscalerEndpointArgsForCall)]
+	fake.autoscalerEndpointArgsForCall = append(fake.autoscalerEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("AutoscalerEndpoint", []interface{}{})
+	fake.autoscalerEndpointMutex.Unlock()
+	if fake.AutoscalerEndpointStub != nil {
+		return fake.AutoscalerEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.autoscalerEndpointReturns.result1
+}
+
+func (fake *FakeConfig) AutoscalerEndpointCallCount() int {
+	fake.autoscalerEndpointMutex.RLock()
+	defer fake.autoscalerEndpointMutex.RUnlock()
+	return len(fake.autoscalerEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) AutoscalerEndpointReturns(result1 string) {
+	fake.AutoscalerEndpointStub = nil
+	fake.autoscalerEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) AutoscalerEndpointReturnsOnCall(i int, result1 string) {
+	fake.AutoscalerEndpointStub = nil
+	if fake.autoscalerEndpointReturnsOnCall == nil {
+		fake.autoscalerEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.autoscalerEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) AutoTargetRule(dir string) (configv3.AutoTargetRule, bool) {
+	fake.autoTargetRuleMutex.Lock()
+	ret, specificReturn := fake.autoTargetRuleReturnsOnCall[len(fake.autoTargetRuleArgsForCall)]
+	fake.autoTargetRuleArgsForCall = append(fake.autoTargetRuleArgsForCall, struct {
+		dir string
+	}{dir})
+	fake.recordInvocation("AutoTargetRule", []interface{}{dir})
+	fake.autoTargetRuleMutex.Unlock()
+	if fake.AutoTargetRuleStub != nil {
+		return fake.AutoTargetRuleStub(dir)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.autoTargetRuleReturns.result1, fake.autoTargetRuleReturns.result2
+}
+
+func (fake *FakeConfig) AutoTargetRuleCallCount() int {
+	fake.autoTargetRuleMutex.RLock()
+	defer fake.autoTargetRuleMutex.RUnlock()
+	return len(fake.autoTargetRuleArgsForCall)
+}
+
+func (fake *FakeConfig) AutoTargetRuleArgsForCall(i int) string {
+	fake.autoTargetRuleMutex.RLock()
+	defer fake.autoTargetRuleMutex.RUnlock()
+	return fake.autoTargetRuleArgsForCall[i].dir
+}
+
+func (fake *FakeConfig) AutoTargetRuleReturns(result1 configv3.AutoTargetRule, result2 bool) {
+	fake.AutoTargetRuleStub = nil
+	fake.autoTargetRuleReturns = struct {
+		result1 configv3.AutoTargetRule
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) AutoTargetRuleReturnsOnCall(i int, result1 configv3.AutoTargetRule, result2 bool) {
+	fake.AutoTargetRuleStub = nil
+	if fake.autoTargetRuleReturnsOnCall == nil {
+		fake.autoTargetRuleReturnsOnCall = make(map[int]struct {
+			result1 configv3.AutoTargetRule
+			result2 bool
+		})
+	}
+	fake.autoTargetRuleReturnsOnCall[i] = struct {
+		result1 configv3.AutoTargetRule
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) BinaryName() string {
 	fake.binaryNameMutex.Lock()
 	ret, specificReturn := fake.binaryNameReturnsOnCall[len(fake.binaryNameArgsForCall)]
@@ -536,6 +940,46 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) CredHubEndpoint() string {
+	fake.credHubEndpointMutex.Lock()
+	ret, specificReturn := fake.credHubEndpointReturnsOnCall[len(fake.credHubEndpointArgsForCall)]
+	fake.credHubEndpointArgsForCall = append(fake.credHubEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("CredHubEndpoint", []interface{}{})
+	fake.credHubEndpointMutex.Unlock()
+	if fake.CredHubEndpointStub != nil {
+		return fake.CredHubEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.credHubEndpointReturns.result1
+}
+
+func (fake *FakeConfig) CredHubEndpointCallCount() int {
+	fake.credHubEndpointMutex.RLock()
+	defer fake.credHubEndpointMutex.RUnlock()
+	return len(fake.credHubEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) CredHubEndpointReturns(result1 string) {
+	fake.CredHubEndpointStub = nil
+	fake.credHubEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CredHubEndpointReturnsOnCall(i int, result1 string) {
+	fake.CredHubEndpointStub = nil
+	if fake.credHubEndpointReturnsOnCall == nil {
+		fake.credHubEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.credHubEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -619,6 +1063,86 @@ func (fake *FakeConfig) DialTimeoutReturnsOnCall(i int, result1 time.Duration) {
 	}{result1}
 }
 
+func (fake *FakeConfig) DopplerEndpoint() string {
+	fake.dopplerEndpointMutex.Lock()
+	ret, specificReturn := fake.dopplerEndpointReturnsOnCall[len(fake.dopplerEndpointArgsForCall)]
+	fake.dopplerEndpointArgsForCall = append(fake.dopplerEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("DopplerEndpoint", []interface{}{})
+	fake.dopplerEndpointMutex.Unlock()
+	if fake.DopplerEndpointStub != nil {
+		return fake.DopplerEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.dopplerEndpointReturns.result1
+}
+
+func (fake *FakeConfig) DopplerEndpointCallCount() int {
+	fake.dopplerEndpointMutex.RLock()
+	defer fake.dopplerEndpointMutex.RUnlock()
+	return len(fake.dopplerEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) DopplerEndpointReturns(result1 string) {
+	fake.DopplerEndpointStub = nil
+	fake.dopplerEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) DopplerEndpointReturnsOnCall(i int, result1 string) {
+	fake.DopplerEndpointStub = nil
+	if fake.dopplerEndpointReturnsOnCall == nil {
+		fake.dopplerEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.dopplerEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) EffectiveSettings() []configv3.EffectiveSetting {
+	fake.effectiveSettingsMutex.Lock()
+	ret, specificReturn := fake.effectiveSettingsReturnsOnCall[len(fake.effectiveSettingsArgsForCall)]
+	fake.effectiveSettingsArgsForCall = append(fake.effectiveSettingsArgsForCall, struct{}{})
+	fake.recordInvocation("EffectiveSettings", []interface{}{})
+	fake.effectiveSettingsMutex.Unlock()
+	if fake.EffectiveSettingsStub != nil {
+		return fake.EffectiveSettingsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.effectiveSettingsReturns.result1
+}
+
+func (fake *FakeConfig) EffectiveSettingsCallCount() int {
+	fake.effectiveSettingsMutex.RLock()
+	defer fake.effectiveSettingsMutex.RUnlock()
+	return len(fake.effectiveSettingsArgsForCall)
+}
+
+func (fake *FakeConfig) EffectiveSettingsReturns(result1 []configv3.EffectiveSetting) {
+	fake.EffectiveSettingsStub = nil
+	fake.effectiveSettingsReturns = struct {
+		result1 []configv3.EffectiveSetting
+	}{result1}
+}
+
+func (fake *FakeConfig) EffectiveSettingsReturnsOnCall(i int, result1 []configv3.EffectiveSetting) {
+	fake.EffectiveSettingsStub = nil
+	if fake.effectiveSettingsReturnsOnCall == nil {
+		fake.effectiveSettingsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.EffectiveSetting
+		})
+	}
+	fake.effectiveSettingsReturnsOnCall[i] = struct {
+		result1 []configv3.EffectiveSetting
+	}{result1}
+}
+
 func (fake *FakeConfig) Experimental() bool {
 	fake.experimentalMutex.Lock()
 	ret, specificReturn := fake.experimentalReturnsOnCall[len(fake.experimentalArgsForCall)]
@@ -790,6 +1314,86 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) IsTTY() bool {
+	fake.isTTYMutex.Lock()
+	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
+	fake.isTTYArgsForCall = append(fake.isTTYArgsForCall, struct{}{})
+	fake.recordInvocation("IsTTY", []interface{}{})
+	fake.isTTYMutex.Unlock()
+	if fake.IsTTYStub != nil {
+		return fake.IsTTYStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isTTYReturns.result1
+}
+
+func (fake *FakeConfig) IsTTYCallCount() int {
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
+	return len(fake.isTTYArgsForCall)
+}
+
+func (fake *FakeConfig) IsTTYReturns(result1 bool) {
+	fake.IsTTYStub = nil
+	fake.isTTYReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) IsTTYReturnsOnCall(i int, result1 bool) {
+	fake.IsTTYStub = nil
+	if fake.isTTYReturnsOnCall == nil {
+		fake.isTTYReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isTTYReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) LastPluginUpdateCheck() time.Time {
+	fake.lastPluginUpdateCheckMutex.Lock()
+	ret, specificReturn := fake.lastPluginUpdateCheckReturnsOnCall[len(fake.lastPluginUpdateCheckArgsForCall)]
+	fake.lastPluginUpdateCheckArgsForCall = append(fake.lastPluginUpdateCheckArgsForCall, struct{}{})
+	fake.recordInvocation("LastPluginUpdateCheck", []interface{}{})
+	fake.lastPluginUpdateCheckMutex.Unlock()
+	if fake.LastPluginUpdateCheckStub != nil {
+		return fake.LastPluginUpdateCheckStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.lastPluginUpdateCheckReturns.result1
+}
+
+func (fake *FakeConfig) LastPluginUpdateCheckCallCount() int {
+	fake.lastPluginUpdateCheckMutex.RLock()
+	defer fake.lastPluginUpdateCheckMutex.RUnlock()
+	return len(fake.lastPluginUpdateCheckArgsForCall)
+}
+
+func (fake *FakeConfig) LastPluginUpdateCheckReturns(result1 time.Time) {
+	fake.LastPluginUpdateCheckStub = nil
+	fake.lastPluginUpdateCheckReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeConfig) LastPluginUpdateCheckReturnsOnCall(i int, result1 time.Time) {
+	fake.LastPluginUpdateCheckStub = nil
+	if fake.lastPluginUpdateCheckReturnsOnCall == nil {
+		fake.lastPluginUpdateCheckReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastPluginUpdateCheckReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeConfig) Locale() string {
 	fake.localeMutex.Lock()
 	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
@@ -830,13 +1434,53 @@ func (fake *FakeConfig) LocaleReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeConfig) MinCLIVersion() string {
-	fake.minCLIVersionMutex.Lock()
-	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
-	fake.minCLIVersionArgsForCall = append(fake.minCLIVersionArgsForCall, struct{}{})
-	fake.recordInvocation("MinCLIVersion", []interface{}{})
-	fake.minCLIVersionMutex.Unlock()
-	if fake.MinCLIVersionStub != nil {
+func (fake *FakeConfig) LogCacheEndpoint() string {
+	fake.logCacheEndpointMutex.Lock()
+	ret, specificReturn := fake.logCacheEndpointReturnsOnCall[len(fake.logCacheEndpointArgsForCall)]
+	fake.logCacheEndpointArgsForCall = append(fake.logCacheEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("LogCacheEndpoint", []interface{}{})
+	fake.logCacheEndpointMutex.Unlock()
+	if fake.LogCacheEndpointStub != nil {
+		return fake.LogCacheEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.logCacheEndpointReturns.result1
+}
+
+func (fake *FakeConfig) LogCacheEndpointCallCount() int {
+	fake.logCacheEndpointMutex.RLock()
+	defer fake.logCacheEndpointMutex.RUnlock()
+	return len(fake.logCacheEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) LogCacheEndpointReturns(result1 string) {
+	fake.LogCacheEndpointStub = nil
+	fake.logCacheEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) LogCacheEndpointReturnsOnCall(i int, result1 string) {
+	fake.LogCacheEndpointStub = nil
+	if fake.logCacheEndpointReturnsOnCall == nil {
+		fake.logCacheEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.logCacheEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) MinCLIVersion() string {
+	fake.minCLIVersionMutex.Lock()
+	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
+	fake.minCLIVersionArgsForCall = append(fake.minCLIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("MinCLIVersion", []interface{}{})
+	fake.minCLIVersionMutex.Unlock()
+	if fake.MinCLIVersionStub != nil {
 		return fake.MinCLIVersionStub()
 	}
 	if specificReturn {
@@ -910,6 +1554,46 @@ func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.D
 	}{result1}
 }
 
+func (fake *FakeConfig) ParityLogFile() string {
+	fake.parityLogFileMutex.Lock()
+	ret, specificReturn := fake.parityLogFileReturnsOnCall[len(fake.parityLogFileArgsForCall)]
+	fake.parityLogFileArgsForCall = append(fake.parityLogFileArgsForCall, struct{}{})
+	fake.recordInvocation("ParityLogFile", []interface{}{})
+	fake.parityLogFileMutex.Unlock()
+	if fake.ParityLogFileStub != nil {
+		return fake.ParityLogFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.parityLogFileReturns.result1
+}
+
+func (fake *FakeConfig) ParityLogFileCallCount() int {
+	fake.parityLogFileMutex.RLock()
+	defer fake.parityLogFileMutex.RUnlock()
+	return len(fake.parityLogFileArgsForCall)
+}
+
+func (fake *FakeConfig) ParityLogFileReturns(result1 string) {
+	fake.ParityLogFileStub = nil
+	fake.parityLogFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ParityLogFileReturnsOnCall(i int, result1 string) {
+	fake.ParityLogFileStub = nil
+	if fake.parityLogFileReturnsOnCall == nil {
+		fake.parityLogFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.parityLogFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginHome() string {
 	fake.pluginHomeMutex.Lock()
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
@@ -950,6 +1634,46 @@ func (fake *FakeConfig) PluginHomeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() string {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct{}{})
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.outputFormatReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 string) {
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 string) {
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -1030,6 +1754,46 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginUpdateCheck() bool {
+	fake.pluginUpdateCheckMutex.Lock()
+	ret, specificReturn := fake.pluginUpdateCheckReturnsOnCall[len(fake.pluginUpdateCheckArgsForCall)]
+	fake.pluginUpdateCheckArgsForCall = append(fake.pluginUpdateCheckArgsForCall, struct{}{})
+	fake.recordInvocation("PluginUpdateCheck", []interface{}{})
+	fake.pluginUpdateCheckMutex.Unlock()
+	if fake.PluginUpdateCheckStub != nil {
+		return fake.PluginUpdateCheckStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pluginUpdateCheckReturns.result1
+}
+
+func (fake *FakeConfig) PluginUpdateCheckCallCount() int {
+	fake.pluginUpdateCheckMutex.RLock()
+	defer fake.pluginUpdateCheckMutex.RUnlock()
+	return len(fake.pluginUpdateCheckArgsForCall)
+}
+
+func (fake *FakeConfig) PluginUpdateCheckReturns(result1 bool) {
+	fake.PluginUpdateCheckStub = nil
+	fake.pluginUpdateCheckReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginUpdateCheckReturnsOnCall(i int, result1 bool) {
+	fake.PluginUpdateCheckStub = nil
+	if fake.pluginUpdateCheckReturnsOnCall == nil {
+		fake.pluginUpdateCheckReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.pluginUpdateCheckReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) PollingInterval() time.Duration {
 	fake.pollingIntervalMutex.Lock()
 	ret, specificReturn := fake.pollingIntervalReturnsOnCall[len(fake.pollingIntervalArgsForCall)]
@@ -1070,6 +1834,46 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) RefactoredCommands() []string {
+	fake.refactoredCommandsMutex.Lock()
+	ret, specificReturn := fake.refactoredCommandsReturnsOnCall[len(fake.refactoredCommandsArgsForCall)]
+	fake.refactoredCommandsArgsForCall = append(fake.refactoredCommandsArgsForCall, struct{}{})
+	fake.recordInvocation("RefactoredCommands", []interface{}{})
+	fake.refactoredCommandsMutex.Unlock()
+	if fake.RefactoredCommandsStub != nil {
+		return fake.RefactoredCommandsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.refactoredCommandsReturns.result1
+}
+
+func (fake *FakeConfig) RefactoredCommandsCallCount() int {
+	fake.refactoredCommandsMutex.RLock()
+	defer fake.refactoredCommandsMutex.RUnlock()
+	return len(fake.refactoredCommandsArgsForCall)
+}
+
+func (fake *FakeConfig) RefactoredCommandsReturns(result1 []string) {
+	fake.RefactoredCommandsStub = nil
+	fake.refactoredCommandsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) RefactoredCommandsReturnsOnCall(i int, result1 []string) {
+	fake.RefactoredCommandsStub = nil
+	if fake.refactoredCommandsReturnsOnCall == nil {
+		fake.refactoredCommandsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.refactoredCommandsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -1134,6 +1938,105 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) ResponseCacheDir() string {
+	fake.responseCacheDirMutex.Lock()
+	ret, specificReturn := fake.responseCacheDirReturnsOnCall[len(fake.responseCacheDirArgsForCall)]
+	fake.responseCacheDirArgsForCall = append(fake.responseCacheDirArgsForCall, struct{}{})
+	fake.recordInvocation("ResponseCacheDir", []interface{}{})
+	fake.responseCacheDirMutex.Unlock()
+	if fake.ResponseCacheDirStub != nil {
+		return fake.ResponseCacheDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.responseCacheDirReturns.result1
+}
+
+func (fake *FakeConfig) ResponseCacheDirCallCount() int {
+	fake.responseCacheDirMutex.RLock()
+	defer fake.responseCacheDirMutex.RUnlock()
+	return len(fake.responseCacheDirArgsForCall)
+}
+
+func (fake *FakeConfig) ResponseCacheDirReturns(result1 string) {
+	fake.ResponseCacheDirStub = nil
+	fake.responseCacheDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheDirReturnsOnCall(i int, result1 string) {
+	fake.ResponseCacheDirStub = nil
+	if fake.responseCacheDirReturnsOnCall == nil {
+		fake.responseCacheDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.responseCacheDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SaveTargetSession() {
+	fake.saveTargetSessionMutex.Lock()
+	fake.saveTargetSessionArgsForCall = append(fake.saveTargetSessionArgsForCall, struct{}{})
+	fake.recordInvocation("SaveTargetSession", []interface{}{})
+	fake.saveTargetSessionMutex.Unlock()
+	if fake.SaveTargetSessionStub != nil {
+		fake.SaveTargetSessionStub()
+	}
+}
+
+func (fake *FakeConfig) SaveTargetSessionCallCount() int {
+	fake.saveTargetSessionMutex.RLock()
+	defer fake.saveTargetSessionMutex.RUnlock()
+	return len(fake.saveTargetSessionArgsForCall)
+}
+
+func (fake *FakeConfig) ScheduledTasks() ([]configv3.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct{}{})
+	fake.recordInvocation("ScheduledTasks", []interface{}{})
+	fake.scheduledTasksMutex.Unlock()
+	if fake.ScheduledTasksStub != nil {
+		return fake.ScheduledTasksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.scheduledTasksReturns.result1, fake.scheduledTasksReturns.result2
+}
+
+func (fake *FakeConfig) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeConfig) ScheduledTasksReturns(result1 []configv3.ScheduledTask, result2 error) {
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []configv3.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) ScheduledTasksReturnsOnCall(i int, result1 []configv3.ScheduledTask, result2 error) {
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []configv3.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []configv3.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1158,6 +2061,30 @@ func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
 	return fake.setAccessTokenArgsForCall[i].token
 }
 
+func (fake *FakeConfig) SetLastPluginUpdateCheck(lastCheck time.Time) {
+	fake.setLastPluginUpdateCheckMutex.Lock()
+	fake.setLastPluginUpdateCheckArgsForCall = append(fake.setLastPluginUpdateCheckArgsForCall, struct {
+		lastCheck time.Time
+	}{lastCheck})
+	fake.recordInvocation("SetLastPluginUpdateCheck", []interface{}{lastCheck})
+	fake.setLastPluginUpdateCheckMutex.Unlock()
+	if fake.SetLastPluginUpdateCheckStub != nil {
+		fake.SetLastPluginUpdateCheckStub(lastCheck)
+	}
+}
+
+func (fake *FakeConfig) SetLastPluginUpdateCheckCallCount() int {
+	fake.setLastPluginUpdateCheckMutex.RLock()
+	defer fake.setLastPluginUpdateCheckMutex.RUnlock()
+	return len(fake.setLastPluginUpdateCheckArgsForCall)
+}
+
+func (fake *FakeConfig) SetLastPluginUpdateCheckArgsForCall(i int) time.Time {
+	fake.setLastPluginUpdateCheckMutex.RLock()
+	defer fake.setLastPluginUpdateCheckMutex.RUnlock()
+	return fake.setLastPluginUpdateCheckArgsForCall[i].lastCheck
+}
+
 func (fake *FakeConfig) SetOrganizationInformation(guid string, name string) {
 	fake.setOrganizationInformationMutex.Lock()
 	fake.setOrganizationInformationArgsForCall = append(fake.setOrganizationInformationArgsForCall, struct {
@@ -1183,6 +2110,30 @@ func (fake *FakeConfig) SetOrganizationInformationArgsForCall(i int) (string, st
 	return fake.setOrganizationInformationArgsForCall[i].guid, fake.setOrganizationInformationArgsForCall[i].name
 }
 
+func (fake *FakeConfig) SetPluginUpdateCheck(enabled bool) {
+	fake.setPluginUpdateCheckMutex.Lock()
+	fake.setPluginUpdateCheckArgsForCall = append(fake.setPluginUpdateCheckArgsForCall, struct {
+		enabled bool
+	}{enabled})
+	fake.recordInvocation("SetPluginUpdateCheck", []interface{}{enabled})
+	fake.setPluginUpdateCheckMutex.Unlock()
+	if fake.SetPluginUpdateCheckStub != nil {
+		fake.SetPluginUpdateCheckStub(enabled)
+	}
+}
+
+func (fake *FakeConfig) SetPluginUpdateCheckCallCount() int {
+	fake.setPluginUpdateCheckMutex.RLock()
+	defer fake.setPluginUpdateCheckMutex.RUnlock()
+	return len(fake.setPluginUpdateCheckArgsForCall)
+}
+
+func (fake *FakeConfig) SetPluginUpdateCheckArgsForCall(i int) bool {
+	fake.setPluginUpdateCheckMutex.RLock()
+	defer fake.setPluginUpdateCheckMutex.RUnlock()
+	return fake.setPluginUpdateCheckArgsForCall[i].enabled
+}
+
 func (fake *FakeConfig) SetRefreshToken(token string) {
 	fake.setRefreshTokenMutex.Lock()
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
@@ -1207,6 +2158,79 @@ func (fake *FakeConfig) SetRefreshTokenArgsForCall(i int) string {
 	return fake.setRefreshTokenArgsForCall[i].token
 }
 
+func (fake *FakeConfig) SetSetting(name string, value string) error {
+	fake.setSettingMutex.Lock()
+	ret, specificReturn := fake.setSettingReturnsOnCall[len(fake.setSettingArgsForCall)]
+	fake.setSettingArgsForCall = append(fake.setSettingArgsForCall, struct {
+		name  string
+		value string
+	}{name, value})
+	fake.recordInvocation("SetSetting", []interface{}{name, value})
+	fake.setSettingMutex.Unlock()
+	if fake.SetSettingStub != nil {
+		return fake.SetSettingStub(name, value)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setSettingReturns.result1
+}
+
+func (fake *FakeConfig) SetSettingCallCount() int {
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	return len(fake.setSettingArgsForCall)
+}
+
+func (fake *FakeConfig) SetSettingArgsForCall(i int) (string, string) {
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	return fake.setSettingArgsForCall[i].name, fake.setSettingArgsForCall[i].value
+}
+
+func (fake *FakeConfig) SetSettingReturns(result1 error) {
+	fake.SetSettingStub = nil
+	fake.setSettingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SetSettingReturnsOnCall(i int, result1 error) {
+	fake.SetSettingStub = nil
+	if fake.setSettingReturnsOnCall == nil {
+		fake.setSettingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setSettingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SetTelemetry(enabled bool) {
+	fake.setTelemetryMutex.Lock()
+	fake.setTelemetryArgsForCall = append(fake.setTelemetryArgsForCall, struct {
+		enabled bool
+	}{enabled})
+	fake.recordInvocation("SetTelemetry", []interface{}{enabled})
+	fake.setTelemetryMutex.Unlock()
+	if fake.SetTelemetryStub != nil {
+		fake.SetTelemetryStub(enabled)
+	}
+}
+
+func (fake *FakeConfig) SetTelemetryCallCount() int {
+	fake.setTelemetryMutex.RLock()
+	defer fake.setTelemetryMutex.RUnlock()
+	return len(fake.setTelemetryArgsForCall)
+}
+
+func (fake *FakeConfig) SetTelemetryArgsForCall(i int) bool {
+	fake.setTelemetryMutex.RLock()
+	defer fake.setTelemetryMutex.RUnlock()
+	return fake.setTelemetryArgsForCall[i].enabled
+}
+
 func (fake *FakeConfig) SetSpaceInformation(guid string, name string, allowSSH bool) {
 	fake.setSpaceInformationMutex.Lock()
 	fake.setSpaceInformationArgsForCall = append(fake.setSpaceInformationArgsForCall, struct {
@@ -1290,6 +2314,57 @@ func (fake *FakeConfig) SetTokenInformationArgsForCall(i int) (string, string, s
 	return fake.setTokenInformationArgsForCall[i].accessToken, fake.setTokenInformationArgsForCall[i].refreshToken, fake.setTokenInformationArgsForCall[i].sshOAuthClient
 }
 
+func (fake *FakeConfig) Setting(name string) (string, error) {
+	fake.settingMutex.Lock()
+	ret, specificReturn := fake.settingReturnsOnCall[len(fake.settingArgsForCall)]
+	fake.settingArgsForCall = append(fake.settingArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("Setting", []interface{}{name})
+	fake.settingMutex.Unlock()
+	if fake.SettingStub != nil {
+		return fake.SettingStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.settingReturns.result1, fake.settingReturns.result2
+}
+
+func (fake *FakeConfig) SettingCallCount() int {
+	fake.settingMutex.RLock()
+	defer fake.settingMutex.RUnlock()
+	return len(fake.settingArgsForCall)
+}
+
+func (fake *FakeConfig) SettingArgsForCall(i int) string {
+	fake.settingMutex.RLock()
+	defer fake.settingMutex.RUnlock()
+	return fake.settingArgsForCall[i].name
+}
+
+func (fake *FakeConfig) SettingReturns(result1 string, result2 error) {
+	fake.SettingStub = nil
+	fake.settingReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) SettingReturnsOnCall(i int, result1 string, result2 error) {
+	fake.SettingStub = nil
+	if fake.settingReturnsOnCall == nil {
+		fake.settingReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.settingReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) SkipSSLValidation() bool {
 	fake.skipSSLValidationMutex.Lock()
 	ret, specificReturn := fake.skipSSLValidationReturnsOnCall[len(fake.skipSSLValidationArgsForCall)]
@@ -1410,6 +2485,177 @@ func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration
 	}{result1}
 }
 
+func (fake *FakeConfig) TakeTargetSession(api string) (string, string) {
+	fake.takeTargetSessionMutex.Lock()
+	ret, specificReturn := fake.takeTargetSessionReturnsOnCall[len(fake.takeTargetSessionArgsForCall)]
+	fake.takeTargetSessionArgsForCall = append(fake.takeTargetSessionArgsForCall, struct {
+		api string
+	}{api})
+	fake.recordInvocation("TakeTargetSession", []interface{}{api})
+	fake.takeTargetSessionMutex.Unlock()
+	if fake.TakeTargetSessionStub != nil {
+		return fake.TakeTargetSessionStub(api)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.takeTargetSessionReturns.result1, fake.takeTargetSessionReturns.result2
+}
+
+func (fake *FakeConfig) TakeTargetSessionCallCount() int {
+	fake.takeTargetSessionMutex.RLock()
+	defer fake.takeTargetSessionMutex.RUnlock()
+	return len(fake.takeTargetSessionArgsForCall)
+}
+
+func (fake *FakeConfig) TakeTargetSessionArgsForCall(i int) string {
+	fake.takeTargetSessionMutex.RLock()
+	defer fake.takeTargetSessionMutex.RUnlock()
+	return fake.takeTargetSessionArgsForCall[i].api
+}
+
+func (fake *FakeConfig) TakeTargetSessionReturns(result1 string, result2 string) {
+	fake.TakeTargetSessionStub = nil
+	fake.takeTargetSessionReturns = struct {
+		result1 string
+		result2 string
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) TakeTargetSessionReturnsOnCall(i int, result1 string, result2 string) {
+	fake.TakeTargetSessionStub = nil
+	if fake.takeTargetSessionReturnsOnCall == nil {
+		fake.takeTargetSessionReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+		})
+	}
+	fake.takeTargetSessionReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) TelemetryEnabled() bool {
+	fake.telemetryEnabledMutex.Lock()
+	ret, specificReturn := fake.telemetryEnabledReturnsOnCall[len(fake.telemetryEnabledArgsForCall)]
+	fake.telemetryEnabledArgsForCall = append(fake.telemetryEnabledArgsForCall, struct{}{})
+	fake.recordInvocation("TelemetryEnabled", []interface{}{})
+	fake.telemetryEnabledMutex.Unlock()
+	if fake.TelemetryEnabledStub != nil {
+		return fake.TelemetryEnabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.telemetryEnabledReturns.result1
+}
+
+func (fake *FakeConfig) TelemetryEnabledCallCount() int {
+	fake.telemetryEnabledMutex.RLock()
+	defer fake.telemetryEnabledMutex.RUnlock()
+	return len(fake.telemetryEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) TelemetryEnabledReturns(result1 bool) {
+	fake.TelemetryEnabledStub = nil
+	fake.telemetryEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TelemetryEnabledReturnsOnCall(i int, result1 bool) {
+	fake.TelemetryEnabledStub = nil
+	if fake.telemetryEnabledReturnsOnCall == nil {
+		fake.telemetryEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.telemetryEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TelemetryEndpoint() string {
+	fake.telemetryEndpointMutex.Lock()
+	ret, specificReturn := fake.telemetryEndpointReturnsOnCall[len(fake.telemetryEndpointArgsForCall)]
+	fake.telemetryEndpointArgsForCall = append(fake.telemetryEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("TelemetryEndpoint", []interface{}{})
+	fake.telemetryEndpointMutex.Unlock()
+	if fake.TelemetryEndpointStub != nil {
+		return fake.TelemetryEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.telemetryEndpointReturns.result1
+}
+
+func (fake *FakeConfig) TelemetryEndpointCallCount() int {
+	fake.telemetryEndpointMutex.RLock()
+	defer fake.telemetryEndpointMutex.RUnlock()
+	return len(fake.telemetryEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) TelemetryEndpointReturns(result1 string) {
+	fake.TelemetryEndpointStub = nil
+	fake.telemetryEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TelemetryEndpointReturnsOnCall(i int, result1 string) {
+	fake.TelemetryEndpointStub = nil
+	if fake.telemetryEndpointReturnsOnCall == nil {
+		fake.telemetryEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.telemetryEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TelemetryUndecided() bool {
+	fake.telemetryUndecidedMutex.Lock()
+	ret, specificReturn := fake.telemetryUndecidedReturnsOnCall[len(fake.telemetryUndecidedArgsForCall)]
+	fake.telemetryUndecidedArgsForCall = append(fake.telemetryUndecidedArgsForCall, struct{}{})
+	fake.recordInvocation("TelemetryUndecided", []interface{}{})
+	fake.telemetryUndecidedMutex.Unlock()
+	if fake.TelemetryUndecidedStub != nil {
+		return fake.TelemetryUndecidedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.telemetryUndecidedReturns.result1
+}
+
+func (fake *FakeConfig) TelemetryUndecidedCallCount() int {
+	fake.telemetryUndecidedMutex.RLock()
+	defer fake.telemetryUndecidedMutex.RUnlock()
+	return len(fake.telemetryUndecidedArgsForCall)
+}
+
+func (fake *FakeConfig) TelemetryUndecidedReturns(result1 bool) {
+	fake.TelemetryUndecidedStub = nil
+	fake.telemetryUndecidedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TelemetryUndecidedReturnsOnCall(i int, result1 bool) {
+	fake.TelemetryUndecidedStub = nil
+	if fake.telemetryUndecidedReturnsOnCall == nil {
+		fake.telemetryUndecidedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.telemetryUndecidedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) TargetedOrganization() configv3.Organization {
 	fake.targetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.targetedOrganizationReturnsOnCall[len(fake.targetedOrganizationArgsForCall)]
@@ -1530,6 +2776,46 @@ func (fake *FakeConfig) TargetReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) UAAEndpoint() string {
+	fake.uAAEndpointMutex.Lock()
+	ret, specificReturn := fake.uAAEndpointReturnsOnCall[len(fake.uAAEndpointArgsForCall)]
+	fake.uAAEndpointArgsForCall = append(fake.uAAEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("UAAEndpoint", []interface{}{})
+	fake.uAAEndpointMutex.Unlock()
+	if fake.UAAEndpointStub != nil {
+		return fake.UAAEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uAAEndpointReturns.result1
+}
+
+func (fake *FakeConfig) UAAEndpointCallCount() int {
+	fake.uAAEndpointMutex.RLock()
+	defer fake.uAAEndpointMutex.RUnlock()
+	return len(fake.uAAEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) UAAEndpointReturns(result1 string) {
+	fake.UAAEndpointStub = nil
+	fake.uAAEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAEndpointReturnsOnCall(i int, result1 string) {
+	fake.UAAEndpointStub = nil
+	if fake.uAAEndpointReturnsOnCall == nil {
+		fake.uAAEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uAAEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAOAuthClientSecret() string {
 	fake.uAAOAuthClientSecretMutex.Lock()
 	ret, specificReturn := fake.uAAOAuthClientSecretReturnsOnCall[len(fake.uAAOAuthClientSecretArgsForCall)]
@@ -1725,23 +3011,90 @@ func (fake *FakeConfig) WritePluginConfigReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeConfig) WriteScheduledTasks(tasks []configv3.ScheduledTask) error {
+	var tasksCopy []configv3.ScheduledTask
+	if tasks != nil {
+		tasksCopy = make([]configv3.ScheduledTask, len(tasks))
+		copy(tasksCopy, tasks)
+	}
+	fake.writeScheduledTasksMutex.Lock()
+	ret, specificReturn := fake.writeScheduledTasksReturnsOnCall[len(fake.writeScheduledTasksArgsForCall)]
+	fake.writeScheduledTasksArgsForCall = append(fake.writeScheduledTasksArgsForCall, struct {
+		tasks []configv3.ScheduledTask
+	}{tasksCopy})
+	fake.recordInvocation("WriteScheduledTasks", []interface{}{tasksCopy})
+	fake.writeScheduledTasksMutex.Unlock()
+	if fake.WriteScheduledTasksStub != nil {
+		return fake.WriteScheduledTasksStub(tasks)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.writeScheduledTasksReturns.result1
+}
+
+func (fake *FakeConfig) WriteScheduledTasksCallCount() int {
+	fake.writeScheduledTasksMutex.RLock()
+	defer fake.writeScheduledTasksMutex.RUnlock()
+	return len(fake.writeScheduledTasksArgsForCall)
+}
+
+func (fake *FakeConfig) WriteScheduledTasksArgsForCall(i int) []configv3.ScheduledTask {
+	fake.writeScheduledTasksMutex.RLock()
+	defer fake.writeScheduledTasksMutex.RUnlock()
+	return fake.writeScheduledTasksArgsForCall[i].tasks
+}
+
+func (fake *FakeConfig) WriteScheduledTasksReturns(result1 error) {
+	fake.WriteScheduledTasksStub = nil
+	fake.writeScheduledTasksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) WriteScheduledTasksReturnsOnCall(i int, result1 error) {
+	fake.WriteScheduledTasksStub = nil
+	if fake.writeScheduledTasksReturnsOnCall == nil {
+		fake.writeScheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeScheduledTasksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
 	defer fake.accessTokenMutex.RUnlock()
+	fake.accessTokenExpiresAtMutex.RLock()
+	defer fake.accessTokenExpiresAtMutex.RUnlock()
+	fake.addPluginMutex.RLock()
+	defer fake.addPluginMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
+	fake.autoscalerEndpointMutex.RLock()
+	defer fake.autoscalerEndpointMutex.RUnlock()
+	fake.autoTargetRuleMutex.RLock()
+	defer fake.autoTargetRuleMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.credHubEndpointMutex.RLock()
+	defer fake.credHubEndpointMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
+	fake.dopplerEndpointMutex.RLock()
+	defer fake.dopplerEndpointMutex.RUnlock()
+	fake.effectiveSettingsMutex.RLock()
+	defer fake.effectiveSettingsMutex.RUnlock()
 	fake.experimentalMutex.RLock()
 	defer fake.experimentalMutex.RUnlock()
 	fake.getPluginMutex.RLock()
@@ -1750,48 +3103,88 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
+	fake.lastPluginUpdateCheckMutex.RLock()
+	defer fake.lastPluginUpdateCheckMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
+	fake.logCacheEndpointMutex.RLock()
+	defer fake.logCacheEndpointMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
+	fake.parityLogFileMutex.RLock()
+	defer fake.parityLogFileMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
+	fake.pluginUpdateCheckMutex.RLock()
+	defer fake.pluginUpdateCheckMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.refactoredCommandsMutex.RLock()
+	defer fake.refactoredCommandsMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.responseCacheDirMutex.RLock()
+	defer fake.responseCacheDirMutex.RUnlock()
+	fake.saveTargetSessionMutex.RLock()
+	defer fake.saveTargetSessionMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
+	fake.setLastPluginUpdateCheckMutex.RLock()
+	defer fake.setLastPluginUpdateCheckMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
+	fake.setPluginUpdateCheckMutex.RLock()
+	defer fake.setPluginUpdateCheckMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	fake.setTelemetryMutex.RLock()
+	defer fake.setTelemetryMutex.RUnlock()
 	fake.setSpaceInformationMutex.RLock()
 	defer fake.setSpaceInformationMutex.RUnlock()
 	fake.setTargetInformationMutex.RLock()
 	defer fake.setTargetInformationMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
 	defer fake.setTokenInformationMutex.RUnlock()
+	fake.settingMutex.RLock()
+	defer fake.settingMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
 	fake.stagingTimeoutMutex.RLock()
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
 	defer fake.startupTimeoutMutex.RUnlock()
+	fake.takeTargetSessionMutex.RLock()
+	defer fake.takeTargetSessionMutex.RUnlock()
+	fake.telemetryEnabledMutex.RLock()
+	defer fake.telemetryEnabledMutex.RUnlock()
+	fake.telemetryEndpointMutex.RLock()
+	defer fake.telemetryEndpointMutex.RUnlock()
+	fake.telemetryUndecidedMutex.RLock()
+	defer fake.telemetryUndecidedMutex.RUnlock()
 	fake.targetedOrganizationMutex.RLock()
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
+	fake.uAAEndpointMutex.RLock()
+	defer fake.uAAEndpointMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
 	defer fake.uAAOAuthClientSecretMutex.RUnlock()
 	fake.uAAOAuthClientMutex.RLock()
@@ -1804,6 +3197,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.verboseMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
+	fake.writeScheduledTasksMutex.RLock()
+	defer fake.writeScheduledTasksMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -10,18 +10,31 @@ var Commands commandList
 
 type commandList struct {
 	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	Timings          bool `long:"timings" description:"Print how long each phase of the command took"`
 
-	V2Push v2.V2PushCommand `command:"v2-push" alias:"p" description:"Push a new app or sync changes to an existing app"`
+	Diff         v2.DiffCommand         `command:"diff" description:"**EXPERIMENTAL** Show the changes pushing a manifest would make to the deployed apps"`
+	ExportSpace  v2.ExportSpaceCommand  `command:"export-space" description:"**EXPERIMENTAL** Write the apps, services and bindings of the targeted space to a file"`
+	ImportSpace  v2.ImportSpaceCommand  `command:"import-space" description:"**EXPERIMENTAL** Recreate the apps, services and bindings written by export-space in the targeted space"`
+	PushAll      v2.PushAllCommand      `command:"push-all" description:"**EXPERIMENTAL** Push every app described by the manifests in a directory"`
+	ScheduleTask v3.ScheduleTaskCommand `command:"schedule-task" description:"**EXPERIMENTAL** Schedule a one-off task to run on an app at a later time"`
+	TaskRunner   v3.TaskRunnerCommand   `command:"task-runner" description:"**EXPERIMENTAL** Run the tasks scheduled with schedule-task once their time has come"`
+	Top          v2.TopCommand          `command:"top" description:"**EXPERIMENTAL** Show the resource usage of the apps in the targeted space, ranked by CPU or memory"`
+	V2Push       v2.V2PushCommand       `command:"v2-push" alias:"p" description:"Push a new app or sync changes to an existing app"`
 
 	V3CreateApp     v3.V3CreateAppCommand     `command:"v3-create-app" description:"**EXPERIMENTAL** Create a V3 App"`
 	V3CreatePackage v3.V3CreatePackageCommand `command:"v3-create-package" description:"**EXPERIMENTAL** Uploads a V3 Package"`
 
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
+	AddUserToGroup                     v2.AddUserToGroupCommand                     `command:"add-user-to-group" description:"Add a user to a UAA group, granting the scope named after the group"`
 	AllowSpaceSSH                      v2.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	App                                v2.AppCommand                                `command:"app" description:"Display health and status for app"`
+	ApplyManifest                      v3.ApplyManifestCommand                      `command:"apply-manifest" description:"Apply the manifest to the apps in the targeted space"`
+	AttachAutoscalingPolicy            v2.AttachAutoscalingPolicyCommand            `command:"attach-autoscaling-policy" description:"Attach a scaling policy to an app bound to the App Autoscaler"`
 	Auth                               v2.AuthCommand                               `command:"auth" description:"Authenticate user non-interactively"`
+	AutoscalingHistory                 v2.AutoscalingHistoryCommand                 `command:"autoscaling-history" description:"Show the scaling events of an app bound to the App Autoscaler"`
+	AutoscalingMetrics                 v2.AutoscalingMetricsCommand                 `command:"autoscaling-metrics" description:"Show the metrics the App Autoscaler collected for an app"`
 	BindRouteService                   v2.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
 	BindRunningSecurityGroup           v2.BindRunningSecurityGroupCommand           `command:"bind-running-security-group" description:"Bind a security group to the list of security groups to be used for running applications"`
 	BindSecurityGroup                  v2.BindSecurityGroupCommand                  `command:"bind-security-group" description:"Bind a security group to a particular space, or all existing spaces of an org"`
@@ -29,7 +42,9 @@ type commandList struct {
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
 	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
-	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
+	CleanSpace                         v3.CleanSpaceCommand                         `command:"clean-space" description:"Delete old packages and droplets of the apps in the targeted space"`
+	Config                             v2.ConfigCommand                             `command:"config" description:"Read and write default values in the config"`
+	ContinueDeployment                 v3.ContinueDeploymentCommand                 `command:"continue-deployment" description:"Replace the remaining instances of an app after its canary deployment paused"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
 	CreateBuildpack                    v2.CreateBuildpackCommand                    `command:"create-buildpack" description:"Create a buildpack"`
@@ -71,6 +86,7 @@ type commandList struct {
 	DisableServiceAccess               v2.DisableServiceAccessCommand               `command:"disable-service-access" description:"Disable access to a service or service plan for one or all orgs"`
 	DisableSSH                         v2.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
 	DisallowSpaceSSH                   v2.DisallowSpaceSSHCommand                   `command:"disallow-space-ssh" description:"Disallow SSH access for the space"`
+	Doctor                             v2.DoctorCommand                             `command:"doctor" description:"Diagnose problems with the connection to the targeted API"`
 	Domains                            v2.DomainsCommand                            `command:"domains" description:"List domains in the target org"`
 	EnableFeatureFlag                  v2.EnableFeatureFlagCommand                  `command:"enable-feature-flag" description:"Enable the use of a feature so that users have access to and can use the feature"`
 	EnableOrgIsolation                 v3.EnableOrgIsolationCommand                 `command:"enable-org-isolation" description:"Entitle an organization to an isolation segment"`
@@ -85,13 +101,18 @@ type commandList struct {
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	InstallPlugin                      plugin.InstallPluginCommand                  `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v3.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
+	Job                                v2.JobCommand                                `command:"job" description:"Show or wait for a Cloud Controller asynchronous job"`
+	JobStatus                          v2.JobStatusCommand                          `command:"job-status" description:"Show the status of a Cloud Controller asynchronous job"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
 	Login                              v2.LoginCommand                              `command:"login" alias:"l" description:"Log user in"`
 	Logout                             v2.LogoutCommand                             `command:"logout" alias:"lo" description:"Log user out"`
 	Logs                               v2.LogsCommand                               `command:"logs" description:"Tail or show recent logs for an app"`
 	MapRoute                           v2.MapRouteCommand                           `command:"map-route" description:"Add a url route to an app"`
 	Marketplace                        v2.MarketplaceCommand                        `command:"marketplace" alias:"m" description:"List available offerings in the marketplace"`
+	MigrateStack                       v2.MigrateStackCommand                       `command:"migrate-stack" description:"Move an app to a different stack and restage it, reverting to the previous stack if the restage fails"`
 	MigrateServiceInstances            v2.MigrateServiceInstancesCommand            `command:"migrate-service-instances" description:"Migrate service instances from one service plan to another"`
+	MoveRoute                          v2.MoveRouteCommand                          `command:"move-route" description:"Move a route to another space"`
+	Nozzle                             v2.NozzleCommand                             `command:"nozzle" description:"Stream envelopes from the firehose"`
 	OauthToken                         v2.OauthTokenCommand                         `command:"oauth-token" description:"Retrieve and display the OAuth token for the current session"`
 	Orgs                               v2.OrgsCommand                               `command:"orgs" alias:"o" description:"List all orgs"`
 	OrgUsers                           v2.OrgUsersCommand                           `command:"org-users" description:"Show org users by role"`
@@ -104,6 +125,7 @@ type commandList struct {
 	Quotas                             v2.QuotasCommand                             `command:"quotas" description:"List available usage quotas"`
 	Quota                              v2.QuotaCommand                              `command:"quota" description:"Show quota info"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
+	RemoveUserFromGroup                v2.RemoveUserFromGroupCommand                `command:"remove-user-from-group" description:"Remove a user from a UAA group"`
 	RenameBuildpack                    v2.RenameBuildpackCommand                    `command:"rename-buildpack" description:"Rename a buildpack"`
 	RenameOrg                          v2.RenameOrgCommand                          `command:"rename-org" description:"Rename an org"`
 	RenameServiceBroker                v2.RenameServiceBrokerCommand                `command:"rename-service-broker" description:"Rename a service broker"`
@@ -115,6 +137,7 @@ type commandList struct {
 	Restage                            v2.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.)"`
 	RestartAppInstance                 v2.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate the running application Instance at the given index and instantiate a new instance of the application with the same index"`
 	Restart                            v2.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again. This may cause downtime."`
+	Revision                           v3.RevisionCommand                           `command:"revision" description:"Show the droplet, process commands and environment variables captured in an app revision"`
 	RouterGroups                       v2.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Routes                             v2.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
 	RunningEnvironmentVariableGroup    v2.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
@@ -158,6 +181,8 @@ type commandList struct {
 	Target                             v2.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
 	Tasks                              v3.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v3.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
+	Tree                               v2.TreeCommand                               `command:"tree" description:"Display the orgs, spaces, apps and service instances visible to the user as a hierarchy"`
+	UAAGroups                          v2.UAAGroupsCommand                          `command:"uaa-groups" description:"List UAA groups and their member counts"`
 	UnbindRouteService                 v2.UnbindRouteServiceCommand                 `command:"unbind-route-service" alias:"urs" description:"Unbind a service instance from an HTTP route"`
 	UnbindRunningSecurityGroup         v2.UnbindRunningSecurityGroupCommand         `command:"unbind-running-security-group" description:"Unbind a security group from the set of security groups for running applications"`
 	UnbindSecurityGroup                v2.UnbindSecurityGroupCommand                `command:"unbind-security-group" description:"Unbind a security group from a space"`
@@ -171,6 +196,7 @@ type commandList struct {
 	UnsetSpaceRole                     v2.UnsetSpaceRoleCommand                     `command:"unset-space-role" description:"Remove a space role from a user"`
 	UnsharePrivateDomain               v2.UnsharePrivateDomainCommand               `command:"unshare-private-domain" description:"Unshare a private domain with an org"`
 	UpdateBuildpack                    v2.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
+	UpdatePlugins                      plugin.UpdatePluginsCommand                  `command:"update-plugins" description:"Update installed plugins to the latest versions in the registered repositories"`
 	UpdateQuota                        v2.UpdateQuotaCommand                        `command:"update-quota" description:"Update an existing resource quota"`
 	UpdateSecurityGroup                v2.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateServiceAuthToken             v2.UpdateServiceAuthTokenCommand             `command:"update-service-auth-token" description:"Update a service auth token"`
@@ -178,5 +204,8 @@ type commandList struct {
 	UpdateService                      v2.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpdateSpaceQuota                   v2.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v2.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UpgradeService                     v3.UpgradeServiceCommand                     `command:"upgrade-service" description:"Upgrade a service instance to the latest version of its plan"`
+	ValidateManifest                   v2.ValidateManifestCommand                   `command:"validate-manifest" description:"Check a manifest for errors without targeting an API"`
+	WatchCrashes                       v2.WatchCrashesCommand                       `command:"watch-crashes" description:"Run a local script every time an app instance crashes"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
@@ -300,6 +300,9 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
+		{"CF_METRICS_FILE=path/to/cf.prom", cmd.UI.TranslateText("Write push and restage metrics to a Prometheus textfile")},
+		{"CF_METRICS_PUSHGATEWAY=URL", cmd.UI.TranslateText("Send push and restage metrics to a Prometheus Pushgateway")},
+		{"CF_OTLP_ENDPOINT=URL", cmd.UI.TranslateText("Export traces of CLI operations to an OpenTelemetry collector")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
@@ -311,6 +314,7 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--timings", cmd.UI.TranslateText("Print how long each phase of the command took")},
 	}
 }
 
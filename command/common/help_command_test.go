@@ -413,6 +413,7 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("Global options:"))
 			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
 			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
+			Expect(testUI.Out).To(Say("  --timings                          Print how long each phase of the command took"))
 
 			Expect(testUI.Out).To(Say("These are commonly used commands. Use 'cf help -a' to see all, with descriptions."))
 			Expect(testUI.Out).To(Say("See 'cf help <command>' to read about a specific command."))
@@ -589,6 +590,9 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
+				Expect(testUI.Out).To(Say("   CF_METRICS_FILE=path/to/cf.prom    Write push and restage metrics to a Prometheus textfile"))
+				Expect(testUI.Out).To(Say("   CF_METRICS_PUSHGATEWAY=URL         Send push and restage metrics to a Prometheus Pushgateway"))
+				Expect(testUI.Out).To(Say("   CF_OTLP_ENDPOINT=URL               Export traces of CLI operations to an OpenTelemetry collector"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
@@ -597,6 +601,7 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say("   --timings                          Print how long each phase of the command took"))
 			})
 
 			Context("when there are multiple installed plugins", func() {
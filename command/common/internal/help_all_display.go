@@ -13,20 +13,21 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"apps", "app"},
 			{"push", "scale", "delete", "rename"},
-			{"start", "stop", "restart", "restage", "restart-app-instance"},
+			{"start", "stop", "restart", "restage", "restart-app-instance", "continue-deployment"},
 			{"run-task", "tasks", "terminate-task"},
-			{"events", "files", "logs"},
+			{"events", "files", "logs", "revision", "watch-crashes"},
 			{"env", "set-env", "unset-env"},
-			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"stacks", "stack", "migrate-stack"},
+			{"copy-source", "create-app-manifest", "apply-manifest", "validate-manifest"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"attach-autoscaling-policy", "autoscaling-history", "autoscaling-metrics"},
 		},
 	},
 	{
 		CategoryName: "SERVICES:",
 		CommandList: [][]string{
 			{"marketplace", "services", "service"},
-			{"create-service", "update-service", "delete-service", "rename-service"},
+			{"create-service", "update-service", "upgrade-service", "delete-service", "rename-service"},
 			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
 			{"bind-service", "unbind-service"},
 			{"bind-route-service", "unbind-route-service"},
@@ -36,7 +37,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ORGS:",
 		CommandList: [][]string{
-			{"orgs", "org"},
+			{"orgs", "org", "tree"},
 			{"create-org", "delete-org", "rename-org"},
 		},
 	},
@@ -46,6 +47,7 @@ var HelpCategoryList = []HelpCategory{
 			{"spaces", "space"},
 			{"create-space", "delete-space", "rename-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
+			{"clean-space"},
 		},
 	},
 	{
@@ -58,7 +60,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ROUTES:",
 		CommandList: [][]string{
-			{"routes", "create-route", "check-route", "map-route", "unmap-route", "delete-route", "delete-orphaned-routes"},
+			{"routes", "create-route", "check-route", "map-route", "unmap-route", "delete-route", "delete-orphaned-routes", "move-route"},
 		},
 	},
 	{
@@ -73,6 +75,7 @@ var HelpCategoryList = []HelpCategory{
 			{"create-user", "delete-user"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
+			{"uaa-groups", "add-user-to-group", "remove-user-from-group"},
 		},
 	},
 	{
@@ -130,6 +133,8 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
 			{"curl", "config", "oauth-token", "ssh-code"},
+			{"job", "job-status"},
+			{"nozzle", "doctor"},
 		},
 	},
 	{
@@ -141,7 +146,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
-			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"plugins", "install-plugin", "uninstall-plugin", "update-plugins"},
 		},
 	},
 }
@@ -11,41 +11,69 @@ import (
 // Config a way of getting basic CF configuration
 type Config interface {
 	AccessToken() string
+	AccessTokenExpiresAt() time.Time
+	AddPlugin(configv3.Plugin)
 	APIVersion() string
+	AutoscalerEndpoint() string
+	AutoTargetRule(dir string) (configv3.AutoTargetRule, bool)
 	BinaryName() string
 	BinaryVersion() string
 	ColorEnabled() configv3.ColorSetting
+	CredHubEndpoint() string
 	CurrentUser() (configv3.User, error)
 	DialTimeout() time.Duration
+	DopplerEndpoint() string
+	EffectiveSettings() []configv3.EffectiveSetting
 	Experimental() bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	IsTTY() bool
+	LastPluginUpdateCheck() time.Time
 	Locale() string
+	LogCacheEndpoint() string
 	MinCLIVersion() string
 	OverallPollingTimeout() time.Duration
+	ParityLogFile() string
 	PluginHome() string
+	OutputFormat() string
 	Plugins() []configv3.Plugin
 	PluginRepositories() []configv3.PluginRepository
+	PluginUpdateCheck() bool
 	PollingInterval() time.Duration
+	RefactoredCommands() []string
 	RefreshToken() string
 	RemovePlugin(string)
+	ResponseCacheDir() string
+	SaveTargetSession()
+	ScheduledTasks() ([]configv3.ScheduledTask, error)
 	SetAccessToken(token string)
+	SetLastPluginUpdateCheck(lastCheck time.Time)
 	SetOrganizationInformation(guid string, name string)
+	SetPluginUpdateCheck(enabled bool)
 	SetRefreshToken(token string)
+	SetSetting(name string, value string) error
+	SetTelemetry(enabled bool)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	Setting(name string) (string, error)
 	SkipSSLValidation() bool
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
+	TakeTargetSession(api string) (string, string)
+	TelemetryEnabled() bool
+	TelemetryEndpoint() string
+	TelemetryUndecided() bool
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
 	Target() string
+	UAAEndpoint() string
 	UAAOAuthClientSecret() string
 	UAAOAuthClient() string
 	UnsetOrganizationInformation()
 	UnsetSpaceInformation()
 	Verbose() (bool, []string)
 	WritePluginConfig() error
+	WriteScheduledTasks(tasks []configv3.ScheduledTask) error
 }
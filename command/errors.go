@@ -191,17 +191,59 @@ func (e ThreeRequiredArgumentsError) Translate(translate func(string, ...interfa
 	})
 }
 
+type ArgumentCombinationError struct {
+	Arg1 string
+	Arg2 string
+}
+
+func (e ArgumentCombinationError) Error() string {
+	return "Incorrect Usage: '{{.Arg1}}' and '{{.Arg2}}' cannot be used together."
+}
+
+func (e ArgumentCombinationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Arg1": e.Arg1,
+		"Arg2": e.Arg2,
+	})
+}
+
 type MinimumAPIVersionNotMetError struct {
+	Command        string
 	CurrentVersion string
 	MinimumVersion string
 }
 
 func (e MinimumAPIVersionNotMetError) Error() string {
-	return "This command requires CF API version {{.MinimumVersion}}. Your target is {{.CurrentVersion}}."
+	if e.Command == "" {
+		return "This command requires CF API version {{.MinimumVersion}}. Your target is {{.CurrentVersion}}."
+	}
+	return "{{.Command}} requires CF API version {{.MinimumVersion}} or higher. Your target is {{.CurrentVersion}}."
 }
 
 func (e MinimumAPIVersionNotMetError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), map[string]interface{}{
+		"Command":        e.Command,
+		"CurrentVersion": e.CurrentVersion,
+		"MinimumVersion": e.MinimumVersion,
+	})
+}
+
+type MinimumUAAAPIVersionNotMetError struct {
+	Command        string
+	CurrentVersion string
+	MinimumVersion string
+}
+
+func (e MinimumUAAAPIVersionNotMetError) Error() string {
+	if e.Command == "" {
+		return "This command requires UAA API version {{.MinimumVersion}} or higher. Your target is {{.CurrentVersion}}."
+	}
+	return "{{.Command}} requires UAA API version {{.MinimumVersion}} or higher. Your target is {{.CurrentVersion}}."
+}
+
+func (e MinimumUAAAPIVersionNotMetError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command":        e.Command,
 		"CurrentVersion": e.CurrentVersion,
 		"MinimumVersion": e.MinimumVersion,
 	})
@@ -52,8 +52,12 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("ParseArgumentError", ParseArgumentError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
+		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 
 		// Version errors.
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
+		Entry("MinimumAPIVersionNotMetError with a command", MinimumAPIVersionNotMetError{Command: "Option '--some-flag'"}),
+		Entry("MinimumUAAAPIVersionNotMetError", MinimumUAAAPIVersionNotMetError{}),
+		Entry("MinimumUAAAPIVersionNotMetError with a command", MinimumUAAAPIVersionNotMetError{Command: "Option '--some-flag'"}),
 	)
 })
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type AppState struct {
+	State string
+}
+
+func (_ AppState) Complete(prefix string) []flags.Completion {
+	return completions([]string{"crashed", "started", "stopped"}, prefix, false)
+}
+
+func (a *AppState) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "crashed", "started", "stopped":
+		a.State = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STATE must be "started", "stopped", or "crashed"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AppState", func() {
+	var appState AppState
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := appState.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'started' and 'stopped' when passed 's'", "s",
+				[]flags.Completion{{Item: "started"}, {Item: "stopped"}}),
+			Entry("returns 'crashed' when passed 'C'", "C",
+				[]flags.Completion{{Item: "crashed"}}),
+			Entry("completes to every state when passed nothing", "",
+				[]flags.Completion{{Item: "crashed"}, {Item: "started"}, {Item: "stopped"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			appState = AppState{}
+		})
+
+		DescribeTable("downcases and sets state",
+			func(settingState string, expectedState string) {
+				err := appState.UnmarshalFlag(settingState)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(appState.State).To(Equal(expectedState))
+			},
+			Entry("sets 'started' when passed 'started'", "started", "started"),
+			Entry("sets 'stopped' when passed 'STOPPED'", "STOPPED", "stopped"),
+			Entry("sets 'crashed' when passed 'Crashed'", "Crashed", "crashed"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := appState.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `STATE must be "started", "stopped", or "crashed"`,
+				}))
+				Expect(appState.State).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -4,6 +4,12 @@ type AppName struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
+// OptionalAppName is not required so that cf env --effective can be run
+// without an app; the command checks for the app name itself.
+type OptionalAppName struct {
+	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
+}
+
 type Buildpack struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
@@ -32,6 +38,14 @@ type ServiceInstance struct {
 	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance name"`
 }
 
+type OptionalServiceInstance struct {
+	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" description:"The service instance name"`
+}
+
+type JobGUID struct {
+	JobGUID string `positional-arg-name:"JOB_GUID" required:"true" description:"The job GUID"`
+}
+
 type Organization struct {
 	Organization string `positional-arg-name:"ORG" required:"true" description:"The organization"`
 }
@@ -80,9 +94,11 @@ type APITarget struct {
 	URL string `positional-arg-name:"URL" description:"API URL to target"`
 }
 
+// Authentication is optional because cf auth --assertion takes no username or
+// password; the legacy command validates the arguments.
 type Authentication struct {
-	Username string `positional-arg-name:"USERNAME" required:"true" description:"The username"`
-	Password string `positional-arg-name:"PASSWORD" required:"true" description:"The password"`
+	Username string `positional-arg-name:"USERNAME" description:"The username"`
+	Password string `positional-arg-name:"PASSWORD" description:"The password"`
 }
 
 type CreateUser struct {
@@ -115,6 +131,11 @@ type HostDomain struct {
 	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
 }
 
+type DomainHost struct {
+	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
+	Host   string `positional-arg-name:"HOST" required:"true" description:"The hostname"`
+}
+
 type OrgDomain struct {
 	Organization string `positional-arg-name:"ORG" required:"true" description:"The organization"`
 	Domain       string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
@@ -161,7 +182,9 @@ type CopySourceArgs struct {
 type CreateServiceArgs struct {
 	ServiceOffering string `positional-arg-name:"SERVICE" required:"true" description:"The service offering"`
 	ServicePlan     string `positional-arg-name:"SERVICE_PLAN" required:"true" description:"The service plan that the service instance will use"`
-	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
+	// ServiceInstance is not required so that --schema can be given only the
+	// service and plan; the command checks the number of arguments itself.
+	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" description:"The service instance"`
 }
 
 type RenameServiceArgs struct {
@@ -204,6 +227,11 @@ type SetSpaceQuotaArgs struct {
 	SpaceQuota string `positional-arg-name:"SPACE_QUOTA" required:"true" description:"The space quota"`
 }
 
+type MigrateStackArgs struct {
+	AppName   string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	StackName string `positional-arg-name:"STACK_NAME" required:"true" description:"The name of the stack to move the app to"`
+}
+
 type SetHealthCheckArgs struct {
 	AppName     string          `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	HealthCheck HealthCheckType `positional-arg-name:"HEALTH_CHECK_TYPE" required:"true" description:"Set to 'port' or 'none'"`
@@ -266,7 +294,7 @@ type MigrateServiceInstancesArgs struct {
 
 type SecurityGroupArgs struct {
 	SecurityGroup   string                 `positional-arg-name:"SECURITY_GROUP" required:"true" description:"The security group"`
-	PathToJsonRules PathWithExistenceCheck `positional-arg-name:"PATH_TO_JSON_RULES_FILE" required:"true" description:"Path to file of JSON describing security group rules"`
+	PathToRulesFile PathWithExistenceCheck `positional-arg-name:"PATH_TO_RULES_FILE" required:"true" description:"Path to a JSON, YAML or CSV file describing security group rules"`
 }
 
 type AddPluginRepoArgs struct {
@@ -275,8 +303,7 @@ type AddPluginRepoArgs struct {
 }
 
 type InstallPluginArgs struct {
-	LocalPath Path   `positional-arg-name:"LOCAL_PATH/TO/PLUGIN" description:"The local path to the plugin, if the plugin exists locally"`
-	URL       string `positional-arg-name:"URL" description:"The URL to the plugin, if the plugin exists online"`
+	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, the URL to the plugin, or the name of the plugin in the registered repository"`
 }
 
 type RunTaskArgs struct {
@@ -306,3 +333,28 @@ type SpaceIsolationArgs struct {
 type ResetSpaceIsolationArgs struct {
 	SpaceName string `positional-arg-name:"SPACE_NAME" required:"true" description:"The space name"`
 }
+
+type ImportSpaceArgs struct {
+	PathToSpaceFile PathWithExistenceCheck `positional-arg-name:"SPACE_FILE" required:"true" description:"Path to a file written by export-space"`
+}
+
+type AttachAutoscalingPolicyArgs struct {
+	AppName      string                 `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	PathToPolicy PathWithExistenceCheck `positional-arg-name:"PATH_TO_POLICY_FILE" required:"true" description:"Path to a JSON file describing the scaling policy"`
+}
+
+type AutoscalingMetricsArgs struct {
+	AppName    string            `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	MetricType AutoscalingMetric `positional-arg-name:"METRIC_TYPE" required:"true" description:"The metric type"`
+}
+
+type UAAGroupMemberArgs struct {
+	Username  string `positional-arg-name:"USERNAME" required:"true" description:"The username"`
+	GroupName string `positional-arg-name:"GROUP" required:"true" description:"The UAA group name"`
+}
+
+type ConfigArgs struct {
+	Action  string `positional-arg-name:"ACTION" description:"get, set, unset or list"`
+	Setting string `positional-arg-name:"SETTING" description:"The name of the setting"`
+	Value   string `positional-arg-name:"VALUE" description:"The value of the setting"`
+}
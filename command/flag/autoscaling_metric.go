@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type AutoscalingMetric struct {
+	Type string
+}
+
+func (_ AutoscalingMetric) Complete(prefix string) []flags.Completion {
+	return completions([]string{"cpu", "memoryused", "memoryutil", "responsetime", "throughput"}, prefix, false)
+}
+
+func (m *AutoscalingMetric) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "cpu", "memoryused", "memoryutil", "responsetime", "throughput":
+		m.Type = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `METRIC_TYPE must be "memoryused", "memoryutil", "responsetime", "throughput", or "cpu"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AutoscalingMetric", func() {
+	var metric AutoscalingMetric
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := metric.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'memoryused' and 'memoryutil' when passed 'm'", "m",
+				[]flags.Completion{{Item: "memoryused"}, {Item: "memoryutil"}}),
+			Entry("completes to 'throughput' when passed 'TH'", "TH",
+				[]flags.Completion{{Item: "throughput"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			metric = AutoscalingMetric{}
+		})
+
+		DescribeTable("downcases and sets type",
+			func(settingType string, expectedType string) {
+				err := metric.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metric.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'memoryused' when passed 'memoryused'", "memoryused", "memoryused"),
+			Entry("sets 'responsetime' when passed 'ResponseTime'", "ResponseTime", "responsetime"),
+			Entry("sets 'cpu' when passed 'CPU'", "CPU", "cpu"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := metric.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `METRIC_TYPE must be "memoryused", "memoryutil", "responsetime", "throughput", or "cpu"`,
+				}))
+				Expect(metric.Type).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type DeploymentStrategy struct {
+	Name string
+}
+
+func (_ DeploymentStrategy) Complete(prefix string) []flags.Completion {
+	return completions([]string{"canary", "rolling"}, prefix, false)
+}
+
+func (d *DeploymentStrategy) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "canary", "rolling":
+		d.Name = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STRATEGY must be "rolling" or "canary"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeploymentStrategy", func() {
+	var strategy DeploymentStrategy
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := strategy.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'rolling' when passed 'r'", "r",
+				[]flags.Completion{{Item: "rolling"}}),
+			Entry("completes to 'rolling' when passed 'RO'", "RO",
+				[]flags.Completion{{Item: "rolling"}}),
+			Entry("completes to 'canary' when passed 'c'", "c",
+				[]flags.Completion{{Item: "canary"}}),
+			Entry("completes to 'canary' and 'rolling' when passed nothing", "",
+				[]flags.Completion{{Item: "canary"}, {Item: "rolling"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			strategy = DeploymentStrategy{}
+		})
+
+		DescribeTable("downcases and sets name",
+			func(settingName string, expectedName string) {
+				err := strategy.UnmarshalFlag(settingName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(strategy.Name).To(Equal(expectedName))
+			},
+			Entry("sets 'rolling' when passed 'rolling'", "rolling", "rolling"),
+			Entry("sets 'rolling' when passed 'Rolling'", "Rolling", "rolling"),
+			Entry("sets 'canary' when passed 'canary'", "canary", "canary"),
+			Entry("sets 'canary' when passed 'CANARY'", "CANARY", "canary"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := strategy.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `STRATEGY must be "rolling" or "canary"`,
+				}))
+				Expect(strategy.Name).To(BeEmpty())
+			})
+		})
+	})
+})
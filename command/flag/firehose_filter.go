@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type FirehoseFilter struct {
+	Type string
+}
+
+func (_ FirehoseFilter) Complete(prefix string) []flags.Completion {
+	return completions([]string{"logs", "metrics"}, prefix, false)
+}
+
+func (f *FirehoseFilter) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "logs", "metrics":
+		f.Type = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `FILTER must be "logs" or "metrics"`,
+		}
+	}
+	return nil
+}
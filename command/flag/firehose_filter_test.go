@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FirehoseFilter", func() {
+	var firehoseFilter FirehoseFilter
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := firehoseFilter.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'logs' when passed 'l'", "l",
+				[]flags.Completion{{Item: "logs"}}),
+			Entry("returns 'metrics' when passed 'M'", "M",
+				[]flags.Completion{{Item: "metrics"}}),
+			Entry("completes to every filter when passed nothing", "",
+				[]flags.Completion{{Item: "logs"}, {Item: "metrics"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			firehoseFilter = FirehoseFilter{}
+		})
+
+		DescribeTable("downcases and sets type",
+			func(settingType string, expectedType string) {
+				err := firehoseFilter.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(firehoseFilter.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'logs' when passed 'logs'", "logs", "logs"),
+			Entry("sets 'metrics' when passed 'Metrics'", "Metrics", "metrics"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := firehoseFilter.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `FILTER must be "logs" or "metrics"`,
+				}))
+				Expect(firehoseFilter.Type).To(BeEmpty())
+			})
+		})
+	})
+})
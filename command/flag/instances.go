@@ -0,0 +1,23 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Instances is a non-negative instance count that remembers whether it was
+// provided.
+type Instances struct {
+	types.NullInt
+}
+
+func (i *Instances) UnmarshalFlag(val string) error {
+	err := i.ParseStringValue(val)
+	if err != nil || i.Value < 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '-i' (expected int >= 0)",
+		}
+	}
+	return nil
+}
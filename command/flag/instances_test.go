@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Instances", func() {
+	var instances Instances
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			instances = Instances{}
+		})
+
+		Context("when the value is a non-negative integer", func() {
+			It("sets the instance count", func() {
+				err := instances.UnmarshalFlag("0")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(instances.NullInt).To(Equal(types.NullInt{IsSet: true, Value: 0}))
+			})
+		})
+
+		Context("when the value is negative", func() {
+			It("returns an error", func() {
+				err := instances.UnmarshalFlag("-1")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '-i' (expected int >= 0)",
+				}))
+			})
+		})
+
+		Context("when the value is not an integer", func() {
+			It("returns an error", func() {
+				err := instances.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '-i' (expected int >= 0)",
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogWindow is how far back recent logs are read, given as a positive
+// duration like 10m or 2h.
+type LogWindow struct {
+	Duration time.Duration
+}
+
+func (w *LogWindow) UnmarshalFlag(val string) error {
+	duration, err := time.ParseDuration(val)
+	if err != nil || duration <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `DURATION must be a positive duration like 30s, 10m or 2h`,
+		}
+	}
+
+	w.Duration = duration
+	return nil
+}
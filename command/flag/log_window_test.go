@@ -0,0 +1,41 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogWindow", func() {
+	var logWindow LogWindow
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			logWindow = LogWindow{}
+		})
+
+		It("accepts durations", func() {
+			err := logWindow.UnmarshalFlag("10m")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(logWindow.Duration).To(Equal(10 * time.Minute))
+		})
+
+		DescribeTable("errors on invalid durations",
+			func(val string) {
+				err := logWindow.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `DURATION must be a positive duration like 30s, 10m or 2h`,
+				}))
+			},
+			Entry("garbage", "yesterday"),
+			Entry("without unit", "10"),
+			Entry("zero", "0s"),
+			Entry("negative", "-5m"),
+		)
+	})
+})
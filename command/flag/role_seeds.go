@@ -0,0 +1,78 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// RoleSeed is a user, identified by username, and the role to give them.
+type RoleSeed struct {
+	Username string
+	Role     string
+}
+
+// OrgRoleSeeds is a comma separated list of USERNAME:ROLE pairs, where ROLE
+// is an organization role.
+type OrgRoleSeeds struct {
+	RoleSeeds []RoleSeed
+}
+
+func (o *OrgRoleSeeds) UnmarshalFlag(val string) error {
+	roleSeeds, err := parseRoleSeeds(val, func(role string) (string, error) {
+		var orgRole OrgRole
+		err := orgRole.UnmarshalFlag(role)
+		return orgRole.Role, err
+	})
+	if err != nil {
+		return err
+	}
+
+	o.RoleSeeds = roleSeeds
+	return nil
+}
+
+// SpaceRoleSeeds is a comma separated list of USERNAME:ROLE pairs, where ROLE
+// is a space role.
+type SpaceRoleSeeds struct {
+	RoleSeeds []RoleSeed
+}
+
+func (s *SpaceRoleSeeds) UnmarshalFlag(val string) error {
+	roleSeeds, err := parseRoleSeeds(val, func(role string) (string, error) {
+		var spaceRole SpaceRole
+		err := spaceRole.UnmarshalFlag(role)
+		return spaceRole.Role, err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.RoleSeeds = roleSeeds
+	return nil
+}
+
+func parseRoleSeeds(val string, parseRole func(string) (string, error)) ([]RoleSeed, error) {
+	var roleSeeds []RoleSeed
+	for _, pair := range strings.Split(val, ",") {
+		separator := strings.LastIndex(pair, ":")
+		if separator <= 0 || separator == len(pair)-1 {
+			return nil, &flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "--seed-roles must be a comma separated list of USERNAME:ROLE pairs",
+			}
+		}
+
+		role, err := parseRole(pair[separator+1:])
+		if err != nil {
+			return nil, err
+		}
+
+		roleSeeds = append(roleSeeds, RoleSeed{
+			Username: pair[:separator],
+			Role:     role,
+		})
+	}
+
+	return roleSeeds, nil
+}
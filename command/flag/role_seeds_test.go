@@ -0,0 +1,82 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RoleSeeds", func() {
+	Describe("OrgRoleSeeds", func() {
+		var orgRoleSeeds OrgRoleSeeds
+
+		BeforeEach(func() {
+			orgRoleSeeds = OrgRoleSeeds{}
+		})
+
+		Describe("UnmarshalFlag", func() {
+			It("accepts a list of users and org roles", func() {
+				err := orgRoleSeeds.UnmarshalFlag("user-1:orgmanager,user@example.com:BillingManager")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgRoleSeeds.RoleSeeds).To(Equal([]RoleSeed{
+					{Username: "user-1", Role: "OrgManager"},
+					{Username: "user@example.com", Role: "BillingManager"},
+				}))
+			})
+
+			It("errors on space roles", func() {
+				err := orgRoleSeeds.UnmarshalFlag("user-1:SpaceDeveloper")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `ROLE must be "OrgManager", "BillingManager" and "OrgAuditor"`,
+				}))
+				Expect(orgRoleSeeds.RoleSeeds).To(BeEmpty())
+			})
+
+			It("errors on pairs without a role", func() {
+				err := orgRoleSeeds.UnmarshalFlag("user-1:OrgManager,user-2")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "--seed-roles must be a comma separated list of USERNAME:ROLE pairs",
+				}))
+				Expect(orgRoleSeeds.RoleSeeds).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("SpaceRoleSeeds", func() {
+		var spaceRoleSeeds SpaceRoleSeeds
+
+		BeforeEach(func() {
+			spaceRoleSeeds = SpaceRoleSeeds{}
+		})
+
+		Describe("UnmarshalFlag", func() {
+			It("accepts a list of users and space roles", func() {
+				err := spaceRoleSeeds.UnmarshalFlag("user-1:SpaceDeveloper,user-2:spaceauditor")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spaceRoleSeeds.RoleSeeds).To(Equal([]RoleSeed{
+					{Username: "user-1", Role: "SpaceDeveloper"},
+					{Username: "user-2", Role: "SpaceAuditor"},
+				}))
+			})
+
+			It("errors on org roles", func() {
+				err := spaceRoleSeeds.UnmarshalFlag("user-1:OrgManager")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `ROLE must be "SpaceManager", "SpaceDeveloper" and "SpaceAuditor"`,
+				}))
+			})
+
+			It("errors on pairs without a username", func() {
+				err := spaceRoleSeeds.UnmarshalFlag(":SpaceDeveloper")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "--seed-roles must be a comma separated list of USERNAME:ROLE pairs",
+				}))
+			})
+		})
+	})
+})
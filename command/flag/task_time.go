@@ -0,0 +1,42 @@
+package flag
+
+import (
+	"strings"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// TaskTime is the time at which a scheduled task runs. It is given as an
+// RFC3339 timestamp, as a local HH:MM time, meaning its next occurrence, or as
+// a +DURATION offset from now.
+type TaskTime struct {
+	Time time.Time
+}
+
+func (t *TaskTime) UnmarshalFlag(val string) error {
+	now := time.Now()
+
+	if strings.HasPrefix(val, "+") {
+		offset, err := time.ParseDuration(strings.TrimPrefix(val, "+"))
+		if err == nil && offset > 0 {
+			t.Time = now.Add(offset)
+			return nil
+		}
+	} else if timestamp, err := time.Parse(time.RFC3339, val); err == nil {
+		t.Time = timestamp
+		return nil
+	} else if clock, err := time.ParseInLocation("15:04", val, now.Location()); err == nil {
+		next := time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), 0, 0, now.Location())
+		if !next.After(now) {
+			next = next.AddDate(0, 0, 1)
+		}
+		t.Time = next
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `TIME must be an RFC3339 timestamp like 2017-06-01T15:04:00Z, a local time like 15:04, or an offset like +30m`,
+	}
+}
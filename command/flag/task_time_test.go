@@ -0,0 +1,64 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskTime", func() {
+	var taskTime TaskTime
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			taskTime = TaskTime{}
+		})
+
+		It("accepts RFC3339 timestamps", func() {
+			err := taskTime.UnmarshalFlag("2017-06-01T15:04:00Z")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(taskTime.Time).To(Equal(time.Date(2017, 6, 1, 15, 4, 0, 0, time.UTC)))
+		})
+
+		It("accepts offsets from now", func() {
+			err := taskTime.UnmarshalFlag("+90m")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(taskTime.Time).To(BeTemporally("~", time.Now().Add(90*time.Minute), time.Second))
+		})
+
+		It("accepts local times as their next occurrence", func() {
+			inAnHour := time.Now().Add(time.Hour)
+
+			err := taskTime.UnmarshalFlag(inAnHour.Format("15:04"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(taskTime.Time.After(time.Now())).To(BeTrue())
+			Expect(taskTime.Time).To(BeTemporally("~", inAnHour, time.Minute))
+		})
+
+		It("moves local times that have passed today to tomorrow", func() {
+			anHourAgo := time.Now().Add(-time.Hour)
+
+			err := taskTime.UnmarshalFlag(anHourAgo.Format("15:04"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(taskTime.Time).To(BeTemporally("~", anHourAgo.AddDate(0, 0, 1), time.Minute))
+		})
+
+		DescribeTable("errors on invalid times",
+			func(val string) {
+				err := taskTime.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TIME must be an RFC3339 timestamp like 2017-06-01T15:04:00Z, a local time like 15:04, or an offset like +30m`,
+				}))
+			},
+			Entry("garbage", "tomorrow"),
+			Entry("negative offset", "+-5m"),
+			Entry("offset without unit", "+5"),
+			Entry("out of range clock", "25:00"),
+		)
+	})
+})
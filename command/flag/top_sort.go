@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type TopSort struct {
+	Field string
+}
+
+func (_ TopSort) Complete(prefix string) []flags.Completion {
+	return completions([]string{"cpu", "memory"}, prefix, false)
+}
+
+func (t *TopSort) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "cpu", "memory":
+		t.Field = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SORT must be "cpu" or "memory"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TopSort", func() {
+	var topSort TopSort
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := topSort.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'cpu' when passed 'c'", "c",
+				[]flags.Completion{{Item: "cpu"}}),
+			Entry("returns 'memory' when passed 'M'", "M",
+				[]flags.Completion{{Item: "memory"}}),
+			Entry("completes to every field when passed nothing", "",
+				[]flags.Completion{{Item: "cpu"}, {Item: "memory"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			topSort = TopSort{}
+		})
+
+		DescribeTable("downcases and sets field",
+			func(settingField string, expectedField string) {
+				err := topSort.UnmarshalFlag(settingField)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(topSort.Field).To(Equal(expectedField))
+			},
+			Entry("sets 'cpu' when passed 'cpu'", "cpu", "cpu"),
+			Entry("sets 'memory' when passed 'MEMORY'", "MEMORY", "memory"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := topSort.UnmarshalFlag("disk")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SORT must be "cpu" or "memory"`,
+				}))
+				Expect(topSort.Field).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/exitcode"
+)
+
+// interruptGracePeriod is how long an interrupted command has to stop before
+// the CLI exits anyway.
+const interruptGracePeriod = 5 * time.Second
+
+var (
+	interruptOnce    sync.Once
+	interruptContext context.Context
+)
+
+// InterruptContext returns the context of the running command. It is
+// cancelled when the CLI receives an interrupt or a termination signal, so
+// that requests, polling and the goroutines streaming their results stop
+// cleanly. Signals are only caught once this is first called, so commands
+// that never call it keep the default behaviour. A second signal, or the end
+// of the grace period, exits immediately.
+func InterruptContext() context.Context {
+	interruptOnce.Do(func() {
+		var cancel context.CancelFunc
+		interruptContext, cancel = context.WithCancel(context.Background())
+
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-signals
+			signal.Stop(signals)
+			cancel()
+
+			time.Sleep(interruptGracePeriod)
+			os.Exit(exitcode.Interrupted)
+		}()
+	})
+	return interruptContext
+}
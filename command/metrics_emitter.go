@@ -0,0 +1,170 @@
+package command
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/tracing"
+)
+
+// metricsCommands are the commands that MetricsEmitter emits metrics for.
+var metricsCommands = map[string]bool{
+	"push":    true,
+	"restage": true,
+}
+
+// MetricsEmitter is a tracing exporter that writes the duration and outcome
+// of push and restage runs in the Prometheus text format, so CI systems can
+// track deploy performance. The metrics are written to a textfile for the
+// node exporter, pushed to a Pushgateway, or both. Each run replaces the
+// metrics of the previous run.
+type MetricsEmitter struct {
+	File           string
+	PushgatewayURL string
+	HTTPClient     *http.Client
+}
+
+// NewMetricsEmitter returns a pointer to a MetricsEmitter. Either destination
+// can be empty.
+func NewMetricsEmitter(file string, pushgatewayURL string) *MetricsEmitter {
+	return &MetricsEmitter{
+		File:           file,
+		PushgatewayURL: strings.TrimSuffix(pushgatewayURL, "/"),
+		HTTPClient:     &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Export writes the metrics of the command span. Runs of other commands are
+// ignored.
+func (emitter MetricsEmitter) Export(spans []tracing.Span) error {
+	var root *tracing.Span
+	for i, span := range spans {
+		if name, ok := span.Attributes["cf.command"].(string); ok && span.ParentSpanID == "" && metricsCommands[name] {
+			root = &spans[i]
+			break
+		}
+	}
+	if root == nil {
+		return nil
+	}
+
+	commandName := root.Attributes["cf.command"].(string)
+	metrics := emitter.format(commandName, *root, spans)
+
+	if emitter.File != "" {
+		err := writeFileAtomically(emitter.File, metrics)
+		if err != nil {
+			return err
+		}
+	}
+
+	if emitter.PushgatewayURL != "" {
+		return emitter.push(commandName, metrics)
+	}
+
+	return nil
+}
+
+func (emitter MetricsEmitter) format(commandName string, root tracing.Span, spans []tracing.Span) []byte {
+	success := 1
+	if root.Error != "" {
+		success = 0
+	}
+
+	phases := map[string]time.Duration{}
+	for _, span := range spans {
+		if span.Kind == tracing.SpanKindInternal && span.SpanID != root.SpanID && span.Name != "parse flags" {
+			phases[span.Name] += span.EndTime.Sub(span.StartTime)
+		}
+	}
+	phaseNames := make([]string, 0, len(phases))
+	for name := range phases {
+		phaseNames = append(phaseNames, name)
+	}
+	sort.Strings(phaseNames)
+
+	labels := fmt.Sprintf(`command=%q`, commandName)
+
+	var buffer bytes.Buffer
+	writeGauge(&buffer, "cf_cli_command_duration_seconds", "How long the command took.",
+		fmt.Sprintf("{%s} %s", labels, formatSeconds(root.EndTime.Sub(root.StartTime))))
+	writeGauge(&buffer, "cf_cli_command_success", "Whether the command succeeded (1) or failed (0).",
+		fmt.Sprintf("{%s} %d", labels, success))
+	writeGauge(&buffer, "cf_cli_command_last_run_timestamp_seconds", "When the command finished, as a Unix timestamp.",
+		fmt.Sprintf("{%s} %d", labels, root.EndTime.Unix()))
+
+	if len(phaseNames) > 0 {
+		var samples []string
+		for _, name := range phaseNames {
+			samples = append(samples, fmt.Sprintf("{%s,phase=%q} %s", labels, name, formatSeconds(phases[name])))
+		}
+		writeGauge(&buffer, "cf_cli_phase_duration_seconds", "How long each phase of the command took.", samples...)
+	}
+
+	return buffer.Bytes()
+}
+
+// push replaces the metrics of the command on the Pushgateway.
+func (emitter MetricsEmitter) push(commandName string, metrics []byte) error {
+	url := fmt.Sprintf("%s/metrics/job/cf_cli/command/%s", emitter.PushgatewayURL, commandName)
+	request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(metrics))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "text/plain; version=0.0.4")
+
+	response, err := emitter.HTTPClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("pushing metrics to %s failed with status %s", url, response.Status)
+	}
+	return nil
+}
+
+func writeGauge(buffer *bytes.Buffer, name string, help string, samples ...string) {
+	fmt.Fprintf(buffer, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(buffer, "# TYPE %s gauge\n", name)
+	for _, sample := range samples {
+		fmt.Fprintf(buffer, "%s%s\n", name, sample)
+	}
+}
+
+func formatSeconds(duration time.Duration) string {
+	return strconv.FormatFloat(duration.Seconds(), 'f', 3, 64)
+}
+
+// writeFileAtomically writes to a temporary file in the same directory and
+// renames it, so the node exporter never reads a partially written file.
+func writeFileAtomically(path string, contents []byte) error {
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(contents)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempFile.Name(), 0644)
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
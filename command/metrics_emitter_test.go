@@ -0,0 +1,133 @@
+package command_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tracing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("MetricsEmitter", func() {
+	var (
+		tempDir     string
+		metricsFile string
+		spans       []tracing.Span
+	)
+
+	const expectedMetrics = `# HELP cf_cli_command_duration_seconds How long the command took.
+# TYPE cf_cli_command_duration_seconds gauge
+cf_cli_command_duration_seconds{command="push"} 12.500
+# HELP cf_cli_command_success Whether the command succeeded (1) or failed (0).
+# TYPE cf_cli_command_success gauge
+cf_cli_command_success{command="push"} 1
+# HELP cf_cli_command_last_run_timestamp_seconds When the command finished, as a Unix timestamp.
+# TYPE cf_cli_command_last_run_timestamp_seconds gauge
+cf_cli_command_last_run_timestamp_seconds{command="push"} 1012
+# HELP cf_cli_phase_duration_seconds How long each phase of the command took.
+# TYPE cf_cli_phase_duration_seconds gauge
+cf_cli_phase_duration_seconds{command="push",phase="stage"} 6.000
+cf_cli_phase_duration_seconds{command="push",phase="upload bits"} 1.250
+`
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "metrics-emitter-test")
+		Expect(err).ToNot(HaveOccurred())
+		metricsFile = filepath.Join(tempDir, "cf.prom")
+
+		start := time.Unix(1000, 0)
+		spans = []tracing.Span{
+			{SpanID: "request", ParentSpanID: "command", Kind: tracing.SpanKindClient, StartTime: start, EndTime: start.Add(time.Second)},
+			{SpanID: "upload", ParentSpanID: "command", Name: "upload bits", Kind: tracing.SpanKindInternal, StartTime: start, EndTime: start.Add(1250 * time.Millisecond)},
+			{SpanID: "stage", ParentSpanID: "command", Name: "stage", Kind: tracing.SpanKindInternal, StartTime: start, EndTime: start.Add(6 * time.Second)},
+			{SpanID: "parse", Name: "parse flags", Kind: tracing.SpanKindInternal, StartTime: start, EndTime: start.Add(time.Millisecond)},
+			{
+				SpanID:     "command",
+				Name:       "cf push",
+				Kind:       tracing.SpanKindInternal,
+				StartTime:  start,
+				EndTime:    start.Add(12500 * time.Millisecond),
+				Attributes: map[string]interface{}{"cf.command": "push"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	Context("when a textfile is configured", func() {
+		It("writes the metrics of the run to the file", func() {
+			Expect(NewMetricsEmitter(metricsFile, "").Export(spans)).To(Succeed())
+
+			contents, err := ioutil.ReadFile(metricsFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal(expectedMetrics))
+		})
+
+		Context("when the command failed", func() {
+			BeforeEach(func() {
+				spans[4].Error = "exit status 1"
+			})
+
+			It("reports the failure", func() {
+				Expect(NewMetricsEmitter(metricsFile, "").Export(spans)).To(Succeed())
+
+				contents, err := ioutil.ReadFile(metricsFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(ContainSubstring(`cf_cli_command_success{command="push"} 0`))
+			})
+		})
+
+		Context("when the command is not push or restage", func() {
+			BeforeEach(func() {
+				spans[4].Attributes["cf.command"] = "apps"
+			})
+
+			It("does not write any metrics", func() {
+				Expect(NewMetricsEmitter(metricsFile, "").Export(spans)).To(Succeed())
+				Expect(metricsFile).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+
+	Context("when a Pushgateway is configured", func() {
+		var server *Server
+
+		BeforeEach(func() {
+			server = NewServer()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("replaces the metrics of the command on the Pushgateway", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/metrics/job/cf_cli/command/push"),
+					VerifyBody([]byte(expectedMetrics)),
+					RespondWith(http.StatusAccepted, nil),
+				),
+			)
+
+			Expect(NewMetricsEmitter("", server.URL()+"/").Export(spans)).To(Succeed())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("returns an error when the push is rejected", func() {
+			server.AppendHandlers(RespondWith(http.StatusBadRequest, nil))
+
+			err := NewMetricsEmitter("", server.URL()).Export(spans)
+			Expect(err).To(MatchError(ContainSubstring("400 Bad Request")))
+		})
+	})
+})
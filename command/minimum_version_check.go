@@ -5,27 +5,59 @@ import (
 	"github.com/blang/semver"
 )
 
-func MinimumAPIVersionCheck(current string, minimum string) error {
+// MinimumAPIVersionCheck returns a MinimumAPIVersionNotMetError when current
+// is lower than minimum. An optional customCommand replaces "This command" in
+// the error, e.g. to name the flag that requires the newer API.
+func MinimumAPIVersionCheck(current string, minimum string, customCommand ...string) error {
+	isBelow, err := isBelowMinimumVersion(current, minimum)
+	if err != nil || !isBelow {
+		return err
+	}
+
+	return MinimumAPIVersionNotMetError{
+		Command:        firstOrEmpty(customCommand),
+		CurrentVersion: current,
+		MinimumVersion: minimum,
+	}
+}
+
+// MinimumUAAAPIVersionCheck returns a MinimumUAAAPIVersionNotMetError when
+// current is lower than minimum. See MinimumAPIVersionCheck for
+// customCommand.
+func MinimumUAAAPIVersionCheck(current string, minimum string, customCommand ...string) error {
+	isBelow, err := isBelowMinimumVersion(current, minimum)
+	if err != nil || !isBelow {
+		return err
+	}
+
+	return MinimumUAAAPIVersionNotMetError{
+		Command:        firstOrEmpty(customCommand),
+		CurrentVersion: current,
+		MinimumVersion: minimum,
+	}
+}
+
+func isBelowMinimumVersion(current string, minimum string) (bool, error) {
 	if current == version.DefaultVersion || minimum == "" {
-		return nil
+		return false, nil
 	}
 
 	currentSemvar, err := semver.Make(current)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	minimumSemvar, err := semver.Make(minimum)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	if currentSemvar.Compare(minimumSemvar) == -1 {
-		return MinimumAPIVersionNotMetError{
-			CurrentVersion: current,
-			MinimumVersion: minimum,
-		}
-	}
+	return currentSemvar.Compare(minimumSemvar) == -1, nil
+}
 
-	return nil
+func firstOrEmpty(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
 }
@@ -43,4 +43,44 @@ var _ = Describe("Minimum Version Check", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	Context("a custom command is provided", func() {
+		It("names the command in the error", func() {
+			err := MinimumAPIVersionCheck("2.60.0", "2.68.0", "Option '--health-check-type=http'")
+			Expect(err).To(MatchError(MinimumAPIVersionNotMetError{
+				Command:        "Option '--health-check-type=http'",
+				CurrentVersion: "2.60.0",
+				MinimumVersion: "2.68.0",
+			}))
+		})
+	})
+})
+
+var _ = Describe("Minimum UAA Version Check", func() {
+	minimumVersion := "1.0.0"
+
+	Context("current version is greater than min", func() {
+		It("does not return an error", func() {
+			err := MinimumUAAAPIVersionCheck("1.0.1", minimumVersion)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("current version is less than min", func() {
+		It("returns a MinimumUAAAPIVersionNotMetError", func() {
+			err := MinimumUAAAPIVersionCheck("0.9.0", minimumVersion, "Option '--origin'")
+			Expect(err).To(MatchError(MinimumUAAAPIVersionNotMetError{
+				Command:        "Option '--origin'",
+				CurrentVersion: "0.9.0",
+				MinimumVersion: minimumVersion,
+			}))
+		})
+	})
+
+	Context("minimum version is empty", func() {
+		It("does not return an error", func() {
+			err := MinimumUAAAPIVersionCheck("2.0.0", "")
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,17 @@
+package command
+
+// offlineCommands are the commands that only use local state. They do not
+// create API clients or check the target, and nothing else that needs the
+// network runs with them, so they work on air-gapped machines.
+var offlineCommands = map[string]bool{
+	"help":              true,
+	"version":           true,
+	"validate-manifest": true,
+}
+
+// IsOfflineCommand returns true if the provided command works without network
+// access. Shell completion does not appear here because go-flags completes
+// arguments before any command is set up.
+func IsOfflineCommand(commandName string) bool {
+	return offlineCommands[commandName]
+}
@@ -0,0 +1,22 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Offline commands", func() {
+	DescribeTable("IsOfflineCommand",
+		func(commandName string, expected bool) {
+			Expect(IsOfflineCommand(commandName)).To(Equal(expected))
+		},
+
+		Entry("help", "help", true),
+		Entry("version", "version", true),
+		Entry("validate-manifest", "validate-manifest", true),
+		Entry("commands that need an API", "push", false),
+	)
+})
@@ -0,0 +1,10 @@
+package command
+
+import "code.cloudfoundry.org/cli/util/configv3"
+
+// JSONOutputRequested returns true when a command that supports JSON output
+// should display it: when its --json flag is set or when the output-format
+// setting is json.
+func JSONOutputRequested(config Config, jsonFlag bool) bool {
+	return jsonFlag || config.OutputFormat() == configv3.OutputFormatJSON
+}
@@ -0,0 +1,25 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JSONOutputRequested", func() {
+	DescribeTable("returns whether JSON should be displayed",
+		func(outputFormat string, jsonFlag bool, expected bool) {
+			fakeConfig := new(commandfakes.FakeConfig)
+			fakeConfig.OutputFormatReturns(outputFormat)
+			Expect(JSONOutputRequested(fakeConfig, jsonFlag)).To(Equal(expected))
+		},
+
+		Entry("--json flag", configv3.OutputFormatTable, true, true),
+		Entry("json output-format setting", configv3.OutputFormatJSON, false, true),
+		Entry("neither", configv3.OutputFormatTable, false, false),
+	)
+})
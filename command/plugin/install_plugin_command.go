@@ -1,26 +1,197 @@
 package plugin
 
 import (
+	"io/ioutil"
 	"os"
+	"runtime"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+//go:generate counterfeiter . InstallPluginActor
+
+type InstallPluginActor interface {
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string) (string, int64, error)
+	FileExists(path string) bool
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginInfoFromRepository(pluginName string, repositoryName string, platform string) (pluginaction.PluginInfo, error)
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	ValidateFileChecksum(path string, checksum string) bool
+}
+
 type InstallPluginCommand struct {
 	OptionalArgs         flag.InstallPluginArgs `positional-args:"yes"`
 	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
 	RegisteredRepository string                 `short:"r" description:"Name of a registered repository where the specified plugin is located"`
 	usage                interface{}            `usage:"CF_NAME install-plugin (LOCAL-PATH/TO/PLUGIN | URL | -r REPO_NAME PLUGIN_NAME) [-f]\n\n   Prompts for confirmation unless '-f' is provided.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
 	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  InstallPluginActor
 }
 
-func (_ InstallPluginCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui))
 	return nil
 }
 
-func (_ InstallPluginCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd InstallPluginCommand) Execute(_ []string) error {
+	tempPluginDir, err := ioutil.TempDir("", "cf-plugin")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	var pluginPath string
+	switch {
+	case cmd.RegisteredRepository != "":
+		pluginPath, err = cmd.getPluginFromRepository(tempPluginDir)
+	case isURL(string(cmd.OptionalArgs.PluginNameOrLocation)):
+		pluginPath, err = cmd.getPluginFromURL(tempPluginDir)
+	default:
+		pluginPath, err = cmd.getPluginFromLocalPath()
+	}
+	if err != nil || pluginPath == "" {
+		return err
+	}
+
+	plugin, err := cmd.Actor.GetAndValidatePlugin(shared.NewPluginMetadataRetriever(cmd.Config, cmd.UI), shared.NativeCommandList{}, pluginPath)
+	if err != nil {
+		if alreadyInstalledErr, ok := err.(pluginaction.PluginAlreadyInstalledError); ok {
+			return shared.PluginAlreadyInstalledError{
+				BinaryName: cmd.Config.BinaryName(),
+				Name:       alreadyInstalledErr.Name,
+				Version:    alreadyInstalledErr.Version,
+			}
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Installing plugin {{.Name}}...", map[string]interface{}{
+		"Name": plugin.Name,
+	})
+
+	err = cmd.Actor.InstallPluginFromPath(pluginPath, plugin)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Plugin {{.Name}} {{.Version}} successfully installed.", map[string]interface{}{
+		"Name":    plugin.Name,
+		"Version": plugin.Version.String(),
+	})
 	return nil
 }
+
+func (cmd InstallPluginCommand) getPluginFromLocalPath() (string, error) {
+	pluginPath := string(cmd.OptionalArgs.PluginNameOrLocation)
+	if !cmd.Actor.FileExists(pluginPath) {
+		return "", shared.FileNotFoundError{Path: pluginPath}
+	}
+
+	confirmed, err := cmd.promptForInstall(pluginPath)
+	if err != nil || !confirmed {
+		return "", err
+	}
+
+	return pluginPath, nil
+}
+
+func (cmd InstallPluginCommand) getPluginFromURL(tempPluginDir string) (string, error) {
+	pluginURL := string(cmd.OptionalArgs.PluginNameOrLocation)
+	confirmed, err := cmd.promptForInstall(pluginURL)
+	if err != nil || !confirmed {
+		return "", err
+	}
+
+	cmd.UI.DisplayText("Starting download of plugin binary from URL...")
+	return cmd.downloadPlugin(pluginURL, tempPluginDir)
+}
+
+func (cmd InstallPluginCommand) getPluginFromRepository(tempPluginDir string) (string, error) {
+	pluginName := string(cmd.OptionalArgs.PluginNameOrLocation)
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepositoryName}} for plugin {{.PluginName}}...", map[string]interface{}{
+		"RepositoryName": cmd.RegisteredRepository,
+		"PluginName":     pluginName,
+	})
+
+	platform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	pluginInfo, err := cmd.Actor.GetPluginInfoFromRepository(pluginName, cmd.RegisteredRepository, platform)
+	if err != nil {
+		return "", shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Plugin {{.PluginName}} {{.PluginVersion}} found in: {{.RepositoryName}}", map[string]interface{}{
+		"PluginName":     pluginInfo.Name,
+		"PluginVersion":  pluginInfo.Version,
+		"RepositoryName": cmd.RegisteredRepository,
+	})
+
+	confirmed, err := cmd.promptForInstall(pluginInfo.Name)
+	if err != nil || !confirmed {
+		return "", err
+	}
+
+	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": cmd.RegisteredRepository,
+	})
+	pluginPath, err := cmd.downloadPlugin(pluginInfo.URL, tempPluginDir)
+	if err != nil {
+		return "", err
+	}
+
+	if !cmd.Actor.ValidateFileChecksum(pluginPath, pluginInfo.Checksum) {
+		return "", shared.InvalidChecksumError{}
+	}
+
+	return pluginPath, nil
+}
+
+func (cmd InstallPluginCommand) downloadPlugin(pluginURL string, tempPluginDir string) (string, error) {
+	pluginPath, size, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginURL, tempPluginDir)
+	if err != nil {
+		return "", shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("{{.Bytes}} bytes downloaded...", map[string]interface{}{
+		"Bytes": size,
+	})
+	return pluginPath, nil
+}
+
+// promptForInstall asks the user to confirm the installation unless -f was
+// provided. It returns false when the user declines.
+func (cmd InstallPluginCommand) promptForInstall(pluginSource string) (bool, error) {
+	cmd.UI.DisplayText("Attention: Plugins are binaries written by potentially untrusted authors.")
+	cmd.UI.DisplayText("Install and use plugins at your own risk.")
+
+	if cmd.Force {
+		return true, nil
+	}
+
+	confirmed, err := cmd.UI.DisplayBoolPrompt(false, "Do you want to install the plugin {{.Plugin}}?", map[string]interface{}{
+		"Plugin": pluginSource,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !confirmed {
+		cmd.UI.DisplayText("Plugin installation cancelled.")
+	}
+	return confirmed, nil
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
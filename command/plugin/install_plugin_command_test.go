@@ -0,0 +1,261 @@
+package plugin_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("install-plugin command", func() {
+	var (
+		cmd        InstallPluginCommand
+		testUI     *ui.UI
+		input      *Buffer
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeInstallPluginActor
+		newPlugin  configv3.Plugin
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor = new(pluginfakes.FakeInstallPluginActor)
+
+		cmd = InstallPluginCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		newPlugin = configv3.Plugin{
+			Name:    "some-plugin",
+			Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+		}
+		fakeActor.GetAndValidatePluginReturns(newPlugin, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when installing from a local path", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNameOrLocation = flag.Path("some-path/some-plugin")
+		})
+
+		Context("when the file does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.FileExistsReturns(false)
+			})
+
+			It("returns a FileNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.FileNotFoundError{Path: "some-path/some-plugin"}))
+				Expect(fakeActor.FileExistsArgsForCall(0)).To(Equal("some-path/some-plugin"))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the file exists", func() {
+			BeforeEach(func() {
+				fakeActor.FileExistsReturns(true)
+			})
+
+			Context("when the user confirms the installation", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("installs the plugin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
+					Expect(testUI.Out).To(Say("Do you want to install the plugin some-path/some-plugin\\? \\[yN\\]:"))
+					Expect(testUI.Out).To(Say("Installing plugin some-plugin\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Plugin some-plugin 1\\.2\\.3 successfully installed\\."))
+
+					Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
+					_, _, path := fakeActor.GetAndValidatePluginArgsForCall(0)
+					Expect(path).To(Equal("some-path/some-plugin"))
+
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+					path, plugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+					Expect(path).To(Equal("some-path/some-plugin"))
+					Expect(plugin).To(Equal(newPlugin))
+				})
+
+				Context("when the plugin is already installed", func() {
+					BeforeEach(func() {
+						fakeActor.GetAndValidatePluginReturns(configv3.Plugin{}, pluginaction.PluginAlreadyInstalledError{Name: "some-plugin", Version: "1.0.0"})
+					})
+
+					It("returns a PluginAlreadyInstalledError", func() {
+						Expect(executeErr).To(MatchError(shared.PluginAlreadyInstalledError{BinaryName: "faceman", Name: "some-plugin", Version: "1.0.0"}))
+						Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the plugin is invalid", func() {
+					BeforeEach(func() {
+						fakeActor.GetAndValidatePluginReturns(configv3.Plugin{}, pluginaction.PluginInvalidError{})
+					})
+
+					It("returns a PluginInvalidError", func() {
+						Expect(executeErr).To(MatchError(shared.PluginInvalidError{}))
+					})
+				})
+
+				Context("when installing the plugin errors", func() {
+					BeforeEach(func() {
+						fakeActor.InstallPluginFromPathReturns(errors.New("install-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("install-error"))
+					})
+				})
+			})
+
+			Context("when the user declines the installation", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("cancels the installation", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Plugin installation cancelled\\."))
+					Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when -f is provided", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+				})
+
+				It("installs the plugin without prompting", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Do you want to install the plugin"))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Context("when installing from a URL", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNameOrLocation = flag.Path("https://example.com/some-plugin")
+			cmd.Force = true
+			fakeActor.DownloadExecutableBinaryFromURLReturns("some-temp-dir/some-plugin", 4, nil)
+		})
+
+		It("downloads and installs the plugin", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Starting download of plugin binary from URL\\.\\.\\."))
+			Expect(testUI.Out).To(Say("4 bytes downloaded\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Plugin some-plugin 1\\.2\\.3 successfully installed\\."))
+
+			url, _ := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+			Expect(url).To(Equal("https://example.com/some-plugin"))
+
+			path, _ := fakeActor.InstallPluginFromPathArgsForCall(0)
+			Expect(path).To(Equal("some-temp-dir/some-plugin"))
+			Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(0))
+		})
+
+		Context("when the download errors", func() {
+			BeforeEach(func() {
+				fakeActor.DownloadExecutableBinaryFromURLReturns("", 0, errors.New("download-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("download-error"))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when installing from a repository", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNameOrLocation = flag.Path("some-plugin")
+			cmd.RegisteredRepository = "some-repo"
+			cmd.Force = true
+			fakeActor.GetPlatformStringReturns("some-platform")
+			fakeActor.GetPluginInfoFromRepositoryReturns(pluginaction.PluginInfo{
+				Name:     "some-plugin",
+				Version:  "1.2.3",
+				URL:      "https://some-repo.com/some-plugin",
+				Checksum: "some-checksum",
+			}, nil)
+			fakeActor.DownloadExecutableBinaryFromURLReturns("some-temp-dir/some-plugin", 4, nil)
+		})
+
+		Context("when the checksum matches", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileChecksumReturns(true)
+			})
+
+			It("downloads and installs the plugin for the current platform", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Searching some-repo for plugin some-plugin\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Plugin some-plugin 1\\.2\\.3 found in: some-repo"))
+				Expect(testUI.Out).To(Say("Starting download of plugin binary from repository some-repo\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Plugin some-plugin 1\\.2\\.3 successfully installed\\."))
+
+				pluginName, repositoryName, platform := fakeActor.GetPluginInfoFromRepositoryArgsForCall(0)
+				Expect(pluginName).To(Equal("some-plugin"))
+				Expect(repositoryName).To(Equal("some-repo"))
+				Expect(platform).To(Equal("some-platform"))
+
+				url, _ := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+				Expect(url).To(Equal("https://some-repo.com/some-plugin"))
+
+				path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+				Expect(path).To(Equal("some-temp-dir/some-plugin"))
+				Expect(checksum).To(Equal("some-checksum"))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the checksum does not match", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileChecksumReturns(false)
+			})
+
+			It("returns an InvalidChecksumError", func() {
+				Expect(executeErr).To(MatchError(shared.InvalidChecksumError{}))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the plugin is not in the repository", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginInfoFromRepositoryReturns(pluginaction.PluginInfo{}, pluginaction.PluginNotFoundInRepositoryError{PluginName: "some-plugin", RepositoryName: "some-repo"})
+			})
+
+			It("returns a PluginNotFoundInRepositoryError", func() {
+				Expect(executeErr).To(MatchError(shared.PluginNotFoundInRepositoryError{PluginName: "some-plugin", RepositoryName: "some-repo"}))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,498 @@
+// This file was generated by counterfeiter
+package pluginfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type FakeInstallPluginActor struct {
+	DownloadExecutableBinaryFromURLStub        func(url string, tempPluginDir string) (string, int64, error)
+	downloadExecutableBinaryFromURLMutex       sync.RWMutex
+	downloadExecutableBinaryFromURLArgsForCall []struct {
+		url           string
+		tempPluginDir string
+	}
+	downloadExecutableBinaryFromURLReturns struct {
+		result1 string
+		result2 int64
+		result3 error
+	}
+	downloadExecutableBinaryFromURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 int64
+		result3 error
+	}
+	FileExistsStub        func(path string) bool
+	fileExistsMutex       sync.RWMutex
+	fileExistsArgsForCall []struct {
+		path string
+	}
+	fileExistsReturns struct {
+		result1 bool
+	}
+	fileExistsReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	GetAndValidatePluginStub        func(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	getAndValidatePluginMutex       sync.RWMutex
+	getAndValidatePluginArgsForCall []struct {
+		metadata pluginaction.PluginMetadata
+		commands pluginaction.CommandList
+		path     string
+	}
+	getAndValidatePluginReturns struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	getAndValidatePluginReturnsOnCall map[int]struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	GetPlatformStringStub        func(runtimeGOOS string, runtimeGOARCH string) string
+	getPlatformStringMutex       sync.RWMutex
+	getPlatformStringArgsForCall []struct {
+		runtimeGOOS   string
+		runtimeGOARCH string
+	}
+	getPlatformStringReturns struct {
+		result1 string
+	}
+	getPlatformStringReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetPluginInfoFromRepositoryStub        func(pluginName string, repositoryName string, platform string) (pluginaction.PluginInfo, error)
+	getPluginInfoFromRepositoryMutex       sync.RWMutex
+	getPluginInfoFromRepositoryArgsForCall []struct {
+		pluginName     string
+		repositoryName string
+		platform       string
+	}
+	getPluginInfoFromRepositoryReturns struct {
+		result1 pluginaction.PluginInfo
+		result2 error
+	}
+	getPluginInfoFromRepositoryReturnsOnCall map[int]struct {
+		result1 pluginaction.PluginInfo
+		result2 error
+	}
+	InstallPluginFromPathStub        func(path string, plugin configv3.Plugin) error
+	installPluginFromPathMutex       sync.RWMutex
+	installPluginFromPathArgsForCall []struct {
+		path   string
+		plugin configv3.Plugin
+	}
+	installPluginFromPathReturns struct {
+		result1 error
+	}
+	installPluginFromPathReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateFileChecksumStub        func(path string, checksum string) bool
+	validateFileChecksumMutex       sync.RWMutex
+	validateFileChecksumArgsForCall []struct {
+		path     string
+		checksum string
+	}
+	validateFileChecksumReturns struct {
+		result1 bool
+	}
+	validateFileChecksumReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInstallPluginActor) DownloadExecutableBinaryFromURL(url string, tempPluginDir string) (string, int64, error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	ret, specificReturn := fake.downloadExecutableBinaryFromURLReturnsOnCall[len(fake.downloadExecutableBinaryFromURLArgsForCall)]
+	fake.downloadExecutableBinaryFromURLArgsForCall = append(fake.downloadExecutableBinaryFromURLArgsForCall, struct {
+		url           string
+		tempPluginDir string
+	}{url, tempPluginDir})
+	fake.recordInvocation("DownloadExecutableBinaryFromURL", []interface{}{url, tempPluginDir})
+	fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	if fake.DownloadExecutableBinaryFromURLStub != nil {
+		return fake.DownloadExecutableBinaryFromURLStub(url, tempPluginDir)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.downloadExecutableBinaryFromURLReturns.result1, fake.downloadExecutableBinaryFromURLReturns.result2, fake.downloadExecutableBinaryFromURLReturns.result3
+}
+
+func (fake *FakeInstallPluginActor) DownloadExecutableBinaryFromURLCallCount() int {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	return len(fake.downloadExecutableBinaryFromURLArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) DownloadExecutableBinaryFromURLArgsForCall(i int) (string, string) {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	return fake.downloadExecutableBinaryFromURLArgsForCall[i].url, fake.downloadExecutableBinaryFromURLArgsForCall[i].tempPluginDir
+}
+
+func (fake *FakeInstallPluginActor) DownloadExecutableBinaryFromURLReturns(result1 string, result2 int64, result3 error) {
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	fake.downloadExecutableBinaryFromURLReturns = struct {
+		result1 string
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInstallPluginActor) DownloadExecutableBinaryFromURLReturnsOnCall(i int, result1 string, result2 int64, result3 error) {
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	if fake.downloadExecutableBinaryFromURLReturnsOnCall == nil {
+		fake.downloadExecutableBinaryFromURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 int64
+			result3 error
+		})
+	}
+	fake.downloadExecutableBinaryFromURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInstallPluginActor) FileExists(path string) bool {
+	fake.fileExistsMutex.Lock()
+	ret, specificReturn := fake.fileExistsReturnsOnCall[len(fake.fileExistsArgsForCall)]
+	fake.fileExistsArgsForCall = append(fake.fileExistsArgsForCall, struct {
+		path string
+	}{path})
+	fake.recordInvocation("FileExists", []interface{}{path})
+	fake.fileExistsMutex.Unlock()
+	if fake.FileExistsStub != nil {
+		return fake.FileExistsStub(path)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.fileExistsReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) FileExistsCallCount() int {
+	fake.fileExistsMutex.RLock()
+	defer fake.fileExistsMutex.RUnlock()
+	return len(fake.fileExistsArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) FileExistsArgsForCall(i int) string {
+	fake.fileExistsMutex.RLock()
+	defer fake.fileExistsMutex.RUnlock()
+	return fake.fileExistsArgsForCall[i].path
+}
+
+func (fake *FakeInstallPluginActor) FileExistsReturns(result1 bool) {
+	fake.FileExistsStub = nil
+	fake.fileExistsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) FileExistsReturnsOnCall(i int, result1 bool) {
+	fake.FileExistsStub = nil
+	if fake.fileExistsReturnsOnCall == nil {
+		fake.fileExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.fileExistsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error) {
+	fake.getAndValidatePluginMutex.Lock()
+	ret, specificReturn := fake.getAndValidatePluginReturnsOnCall[len(fake.getAndValidatePluginArgsForCall)]
+	fake.getAndValidatePluginArgsForCall = append(fake.getAndValidatePluginArgsForCall, struct {
+		metadata pluginaction.PluginMetadata
+		commands pluginaction.CommandList
+		path     string
+	}{metadata, commands, path})
+	fake.recordInvocation("GetAndValidatePlugin", []interface{}{metadata, commands, path})
+	fake.getAndValidatePluginMutex.Unlock()
+	if fake.GetAndValidatePluginStub != nil {
+		return fake.GetAndValidatePluginStub(metadata, commands, path)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAndValidatePluginReturns.result1, fake.getAndValidatePluginReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) GetAndValidatePluginCallCount() int {
+	fake.getAndValidatePluginMutex.RLock()
+	defer fake.getAndValidatePluginMutex.RUnlock()
+	return len(fake.getAndValidatePluginArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) GetAndValidatePluginArgsForCall(i int) (pluginaction.PluginMetadata, pluginaction.CommandList, string) {
+	fake.getAndValidatePluginMutex.RLock()
+	defer fake.getAndValidatePluginMutex.RUnlock()
+	return fake.getAndValidatePluginArgsForCall[i].metadata, fake.getAndValidatePluginArgsForCall[i].commands, fake.getAndValidatePluginArgsForCall[i].path
+}
+
+func (fake *FakeInstallPluginActor) GetAndValidatePluginReturns(result1 configv3.Plugin, result2 error) {
+	fake.GetAndValidatePluginStub = nil
+	fake.getAndValidatePluginReturns = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) GetAndValidatePluginReturnsOnCall(i int, result1 configv3.Plugin, result2 error) {
+	fake.GetAndValidatePluginStub = nil
+	if fake.getAndValidatePluginReturnsOnCall == nil {
+		fake.getAndValidatePluginReturnsOnCall = make(map[int]struct {
+			result1 configv3.Plugin
+			result2 error
+		})
+	}
+	fake.getAndValidatePluginReturnsOnCall[i] = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string {
+	fake.getPlatformStringMutex.Lock()
+	ret, specificReturn := fake.getPlatformStringReturnsOnCall[len(fake.getPlatformStringArgsForCall)]
+	fake.getPlatformStringArgsForCall = append(fake.getPlatformStringArgsForCall, struct {
+		runtimeGOOS   string
+		runtimeGOARCH string
+	}{runtimeGOOS, runtimeGOARCH})
+	fake.recordInvocation("GetPlatformString", []interface{}{runtimeGOOS, runtimeGOARCH})
+	fake.getPlatformStringMutex.Unlock()
+	if fake.GetPlatformStringStub != nil {
+		return fake.GetPlatformStringStub(runtimeGOOS, runtimeGOARCH)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getPlatformStringReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) GetPlatformStringCallCount() int {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	return len(fake.getPlatformStringArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) GetPlatformStringArgsForCall(i int) (string, string) {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	return fake.getPlatformStringArgsForCall[i].runtimeGOOS, fake.getPlatformStringArgsForCall[i].runtimeGOARCH
+}
+
+func (fake *FakeInstallPluginActor) GetPlatformStringReturns(result1 string) {
+	fake.GetPlatformStringStub = nil
+	fake.getPlatformStringReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) GetPlatformStringReturnsOnCall(i int, result1 string) {
+	fake.GetPlatformStringStub = nil
+	if fake.getPlatformStringReturnsOnCall == nil {
+		fake.getPlatformStringReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getPlatformStringReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) GetPluginInfoFromRepository(pluginName string, repositoryName string, platform string) (pluginaction.PluginInfo, error) {
+	fake.getPluginInfoFromRepositoryMutex.Lock()
+	ret, specificReturn := fake.getPluginInfoFromRepositoryReturnsOnCall[len(fake.getPluginInfoFromRepositoryArgsForCall)]
+	fake.getPluginInfoFromRepositoryArgsForCall = append(fake.getPluginInfoFromRepositoryArgsForCall, struct {
+		pluginName     string
+		repositoryName string
+		platform       string
+	}{pluginName, repositoryName, platform})
+	fake.recordInvocation("GetPluginInfoFromRepository", []interface{}{pluginName, repositoryName, platform})
+	fake.getPluginInfoFromRepositoryMutex.Unlock()
+	if fake.GetPluginInfoFromRepositoryStub != nil {
+		return fake.GetPluginInfoFromRepositoryStub(pluginName, repositoryName, platform)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getPluginInfoFromRepositoryReturns.result1, fake.getPluginInfoFromRepositoryReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) GetPluginInfoFromRepositoryCallCount() int {
+	fake.getPluginInfoFromRepositoryMutex.RLock()
+	defer fake.getPluginInfoFromRepositoryMutex.RUnlock()
+	return len(fake.getPluginInfoFromRepositoryArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) GetPluginInfoFromRepositoryArgsForCall(i int) (string, string, string) {
+	fake.getPluginInfoFromRepositoryMutex.RLock()
+	defer fake.getPluginInfoFromRepositoryMutex.RUnlock()
+	return fake.getPluginInfoFromRepositoryArgsForCall[i].pluginName, fake.getPluginInfoFromRepositoryArgsForCall[i].repositoryName, fake.getPluginInfoFromRepositoryArgsForCall[i].platform
+}
+
+func (fake *FakeInstallPluginActor) GetPluginInfoFromRepositoryReturns(result1 pluginaction.PluginInfo, result2 error) {
+	fake.GetPluginInfoFromRepositoryStub = nil
+	fake.getPluginInfoFromRepositoryReturns = struct {
+		result1 pluginaction.PluginInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) GetPluginInfoFromRepositoryReturnsOnCall(i int, result1 pluginaction.PluginInfo, result2 error) {
+	fake.GetPluginInfoFromRepositoryStub = nil
+	if fake.getPluginInfoFromRepositoryReturnsOnCall == nil {
+		fake.getPluginInfoFromRepositoryReturnsOnCall = make(map[int]struct {
+			result1 pluginaction.PluginInfo
+			result2 error
+		})
+	}
+	fake.getPluginInfoFromRepositoryReturnsOnCall[i] = struct {
+		result1 pluginaction.PluginInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) InstallPluginFromPath(path string, plugin configv3.Plugin) error {
+	fake.installPluginFromPathMutex.Lock()
+	ret, specificReturn := fake.installPluginFromPathReturnsOnCall[len(fake.installPluginFromPathArgsForCall)]
+	fake.installPluginFromPathArgsForCall = append(fake.installPluginFromPathArgsForCall, struct {
+		path   string
+		plugin configv3.Plugin
+	}{path, plugin})
+	fake.recordInvocation("InstallPluginFromPath", []interface{}{path, plugin})
+	fake.installPluginFromPathMutex.Unlock()
+	if fake.InstallPluginFromPathStub != nil {
+		return fake.InstallPluginFromPathStub(path, plugin)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.installPluginFromPathReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) InstallPluginFromPathCallCount() int {
+	fake.installPluginFromPathMutex.RLock()
+	defer fake.installPluginFromPathMutex.RUnlock()
+	return len(fake.installPluginFromPathArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) InstallPluginFromPathArgsForCall(i int) (string, configv3.Plugin) {
+	fake.installPluginFromPathMutex.RLock()
+	defer fake.installPluginFromPathMutex.RUnlock()
+	return fake.installPluginFromPathArgsForCall[i].path, fake.installPluginFromPathArgsForCall[i].plugin
+}
+
+func (fake *FakeInstallPluginActor) InstallPluginFromPathReturns(result1 error) {
+	fake.InstallPluginFromPathStub = nil
+	fake.installPluginFromPathReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) InstallPluginFromPathReturnsOnCall(i int, result1 error) {
+	fake.InstallPluginFromPathStub = nil
+	if fake.installPluginFromPathReturnsOnCall == nil {
+		fake.installPluginFromPathReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.installPluginFromPathReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileChecksum(path string, checksum string) bool {
+	fake.validateFileChecksumMutex.Lock()
+	ret, specificReturn := fake.validateFileChecksumReturnsOnCall[len(fake.validateFileChecksumArgsForCall)]
+	fake.validateFileChecksumArgsForCall = append(fake.validateFileChecksumArgsForCall, struct {
+		path     string
+		checksum string
+	}{path, checksum})
+	fake.recordInvocation("ValidateFileChecksum", []interface{}{path, checksum})
+	fake.validateFileChecksumMutex.Unlock()
+	if fake.ValidateFileChecksumStub != nil {
+		return fake.ValidateFileChecksumStub(path, checksum)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.validateFileChecksumReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileChecksumCallCount() int {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	return len(fake.validateFileChecksumArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileChecksumArgsForCall(i int) (string, string) {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	return fake.validateFileChecksumArgsForCall[i].path, fake.validateFileChecksumArgsForCall[i].checksum
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileChecksumReturns(result1 bool) {
+	fake.ValidateFileChecksumStub = nil
+	fake.validateFileChecksumReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, result1 bool) {
+	fake.ValidateFileChecksumStub = nil
+	if fake.validateFileChecksumReturnsOnCall == nil {
+		fake.validateFileChecksumReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileChecksumReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	fake.fileExistsMutex.RLock()
+	defer fake.fileExistsMutex.RUnlock()
+	fake.getAndValidatePluginMutex.RLock()
+	defer fake.getAndValidatePluginMutex.RUnlock()
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	fake.getPluginInfoFromRepositoryMutex.RLock()
+	defer fake.getPluginInfoFromRepositoryMutex.RUnlock()
+	fake.installPluginFromPathMutex.RLock()
+	defer fake.installPluginFromPathMutex.RUnlock()
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeInstallPluginActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ plugin.InstallPluginActor = new(FakeInstallPluginActor)
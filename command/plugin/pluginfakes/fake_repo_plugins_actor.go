@@ -0,0 +1,167 @@
+// This file was generated by counterfeiter
+package pluginfakes
+
+import (
+	"sync"
+
+	pluginapi "code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type FakeRepoPluginsActor struct {
+	GetPluginRepositoryStub        func(repositoryName string) (configv3.PluginRepository, error)
+	getPluginRepositoryMutex       sync.RWMutex
+	getPluginRepositoryArgsForCall []struct {
+		repositoryName string
+	}
+	getPluginRepositoryReturns struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}
+	getPluginRepositoryReturnsOnCall map[int]struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}
+	GetRepositoryPluginsStub        func(repository configv3.PluginRepository) ([]pluginapi.Plugin, error)
+	getRepositoryPluginsMutex       sync.RWMutex
+	getRepositoryPluginsArgsForCall []struct {
+		repository configv3.PluginRepository
+	}
+	getRepositoryPluginsReturns struct {
+		result1 []pluginapi.Plugin
+		result2 error
+	}
+	getRepositoryPluginsReturnsOnCall map[int]struct {
+		result1 []pluginapi.Plugin
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepoPluginsActor) GetPluginRepository(repositoryName string) (configv3.PluginRepository, error) {
+	fake.getPluginRepositoryMutex.Lock()
+	ret, specificReturn := fake.getPluginRepositoryReturnsOnCall[len(fake.getPluginRepositoryArgsForCall)]
+	fake.getPluginRepositoryArgsForCall = append(fake.getPluginRepositoryArgsForCall, struct {
+		repositoryName string
+	}{repositoryName})
+	fake.recordInvocation("GetPluginRepository", []interface{}{repositoryName})
+	fake.getPluginRepositoryMutex.Unlock()
+	if fake.GetPluginRepositoryStub != nil {
+		return fake.GetPluginRepositoryStub(repositoryName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getPluginRepositoryReturns.result1, fake.getPluginRepositoryReturns.result2
+}
+
+func (fake *FakeRepoPluginsActor) GetPluginRepositoryCallCount() int {
+	fake.getPluginRepositoryMutex.RLock()
+	defer fake.getPluginRepositoryMutex.RUnlock()
+	return len(fake.getPluginRepositoryArgsForCall)
+}
+
+func (fake *FakeRepoPluginsActor) GetPluginRepositoryArgsForCall(i int) string {
+	fake.getPluginRepositoryMutex.RLock()
+	defer fake.getPluginRepositoryMutex.RUnlock()
+	return fake.getPluginRepositoryArgsForCall[i].repositoryName
+}
+
+func (fake *FakeRepoPluginsActor) GetPluginRepositoryReturns(result1 configv3.PluginRepository, result2 error) {
+	fake.GetPluginRepositoryStub = nil
+	fake.getPluginRepositoryReturns = struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepoPluginsActor) GetPluginRepositoryReturnsOnCall(i int, result1 configv3.PluginRepository, result2 error) {
+	fake.GetPluginRepositoryStub = nil
+	if fake.getPluginRepositoryReturnsOnCall == nil {
+		fake.getPluginRepositoryReturnsOnCall = make(map[int]struct {
+			result1 configv3.PluginRepository
+			result2 error
+		})
+	}
+	fake.getPluginRepositoryReturnsOnCall[i] = struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepoPluginsActor) GetRepositoryPlugins(repository configv3.PluginRepository) ([]pluginapi.Plugin, error) {
+	fake.getRepositoryPluginsMutex.Lock()
+	ret, specificReturn := fake.getRepositoryPluginsReturnsOnCall[len(fake.getRepositoryPluginsArgsForCall)]
+	fake.getRepositoryPluginsArgsForCall = append(fake.getRepositoryPluginsArgsForCall, struct {
+		repository configv3.PluginRepository
+	}{repository})
+	fake.recordInvocation("GetRepositoryPlugins", []interface{}{repository})
+	fake.getRepositoryPluginsMutex.Unlock()
+	if fake.GetRepositoryPluginsStub != nil {
+		return fake.GetRepositoryPluginsStub(repository)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRepositoryPluginsReturns.result1, fake.getRepositoryPluginsReturns.result2
+}
+
+func (fake *FakeRepoPluginsActor) GetRepositoryPluginsCallCount() int {
+	fake.getRepositoryPluginsMutex.RLock()
+	defer fake.getRepositoryPluginsMutex.RUnlock()
+	return len(fake.getRepositoryPluginsArgsForCall)
+}
+
+func (fake *FakeRepoPluginsActor) GetRepositoryPluginsArgsForCall(i int) configv3.PluginRepository {
+	fake.getRepositoryPluginsMutex.RLock()
+	defer fake.getRepositoryPluginsMutex.RUnlock()
+	return fake.getRepositoryPluginsArgsForCall[i].repository
+}
+
+func (fake *FakeRepoPluginsActor) GetRepositoryPluginsReturns(result1 []pluginapi.Plugin, result2 error) {
+	fake.GetRepositoryPluginsStub = nil
+	fake.getRepositoryPluginsReturns = struct {
+		result1 []pluginapi.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepoPluginsActor) GetRepositoryPluginsReturnsOnCall(i int, result1 []pluginapi.Plugin, result2 error) {
+	fake.GetRepositoryPluginsStub = nil
+	if fake.getRepositoryPluginsReturnsOnCall == nil {
+		fake.getRepositoryPluginsReturnsOnCall = make(map[int]struct {
+			result1 []pluginapi.Plugin
+			result2 error
+		})
+	}
+	fake.getRepositoryPluginsReturnsOnCall[i] = struct {
+		result1 []pluginapi.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepoPluginsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getPluginRepositoryMutex.RLock()
+	defer fake.getPluginRepositoryMutex.RUnlock()
+	fake.getRepositoryPluginsMutex.RLock()
+	defer fake.getRepositoryPluginsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRepoPluginsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ plugin.RepoPluginsActor = new(FakeRepoPluginsActor)
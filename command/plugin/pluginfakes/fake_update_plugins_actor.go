@@ -0,0 +1,497 @@
+// This file was generated by counterfeiter
+package pluginfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type FakeUpdatePluginsActor struct {
+	DownloadExecutableBinaryFromURLStub        func(url string, tempPluginDir string) (string, int64, error)
+	downloadExecutableBinaryFromURLMutex       sync.RWMutex
+	downloadExecutableBinaryFromURLArgsForCall []struct {
+		url           string
+		tempPluginDir string
+	}
+	downloadExecutableBinaryFromURLReturns struct {
+		result1 string
+		result2 int64
+		result3 error
+	}
+	downloadExecutableBinaryFromURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 int64
+		result3 error
+	}
+	GetAndValidatePluginUpdateStub        func(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string, pluginName string) (configv3.Plugin, error)
+	getAndValidatePluginUpdateMutex       sync.RWMutex
+	getAndValidatePluginUpdateArgsForCall []struct {
+		metadata   pluginaction.PluginMetadata
+		commands   pluginaction.CommandList
+		path       string
+		pluginName string
+	}
+	getAndValidatePluginUpdateReturns struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	getAndValidatePluginUpdateReturnsOnCall map[int]struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	GetOutdatedPluginsStub        func() ([]pluginaction.OutdatedPlugin, error)
+	getOutdatedPluginsMutex       sync.RWMutex
+	getOutdatedPluginsArgsForCall []struct{}
+	getOutdatedPluginsReturns     struct {
+		result1 []pluginaction.OutdatedPlugin
+		result2 error
+	}
+	getOutdatedPluginsReturnsOnCall map[int]struct {
+		result1 []pluginaction.OutdatedPlugin
+		result2 error
+	}
+	GetPlatformStringStub        func(runtimeGOOS string, runtimeGOARCH string) string
+	getPlatformStringMutex       sync.RWMutex
+	getPlatformStringArgsForCall []struct {
+		runtimeGOOS   string
+		runtimeGOARCH string
+	}
+	getPlatformStringReturns struct {
+		result1 string
+	}
+	getPlatformStringReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetPluginInfoFromRepositoryStub        func(pluginName string, repositoryName string, platform string) (pluginaction.PluginInfo, error)
+	getPluginInfoFromRepositoryMutex       sync.RWMutex
+	getPluginInfoFromRepositoryArgsForCall []struct {
+		pluginName     string
+		repositoryName string
+		platform       string
+	}
+	getPluginInfoFromRepositoryReturns struct {
+		result1 pluginaction.PluginInfo
+		result2 error
+	}
+	getPluginInfoFromRepositoryReturnsOnCall map[int]struct {
+		result1 pluginaction.PluginInfo
+		result2 error
+	}
+	UpdatePluginFromPathStub        func(uninstaller pluginaction.PluginUninstaller, path string, plugin configv3.Plugin) error
+	updatePluginFromPathMutex       sync.RWMutex
+	updatePluginFromPathArgsForCall []struct {
+		uninstaller pluginaction.PluginUninstaller
+		path        string
+		plugin      configv3.Plugin
+	}
+	updatePluginFromPathReturns struct {
+		result1 error
+	}
+	updatePluginFromPathReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateFileChecksumStub        func(path string, checksum string) bool
+	validateFileChecksumMutex       sync.RWMutex
+	validateFileChecksumArgsForCall []struct {
+		path     string
+		checksum string
+	}
+	validateFileChecksumReturns struct {
+		result1 bool
+	}
+	validateFileChecksumReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURL(url string, tempPluginDir string) (string, int64, error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	ret, specificReturn := fake.downloadExecutableBinaryFromURLReturnsOnCall[len(fake.downloadExecutableBinaryFromURLArgsForCall)]
+	fake.downloadExecutableBinaryFromURLArgsForCall = append(fake.downloadExecutableBinaryFromURLArgsForCall, struct {
+		url           string
+		tempPluginDir string
+	}{url, tempPluginDir})
+	fake.recordInvocation("DownloadExecutableBinaryFromURL", []interface{}{url, tempPluginDir})
+	fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	if fake.DownloadExecutableBinaryFromURLStub != nil {
+		return fake.DownloadExecutableBinaryFromURLStub(url, tempPluginDir)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.downloadExecutableBinaryFromURLReturns.result1, fake.downloadExecutableBinaryFromURLReturns.result2, fake.downloadExecutableBinaryFromURLReturns.result3
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURLCallCount() int {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	return len(fake.downloadExecutableBinaryFromURLArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURLArgsForCall(i int) (string, string) {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	return fake.downloadExecutableBinaryFromURLArgsForCall[i].url, fake.downloadExecutableBinaryFromURLArgsForCall[i].tempPluginDir
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURLReturns(result1 string, result2 int64, result3 error) {
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	fake.downloadExecutableBinaryFromURLReturns = struct {
+		result1 string
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdatePluginsActor) DownloadExecutableBinaryFromURLReturnsOnCall(i int, result1 string, result2 int64, result3 error) {
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	if fake.downloadExecutableBinaryFromURLReturnsOnCall == nil {
+		fake.downloadExecutableBinaryFromURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 int64
+			result3 error
+		})
+	}
+	fake.downloadExecutableBinaryFromURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginUpdate(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string, pluginName string) (configv3.Plugin, error) {
+	fake.getAndValidatePluginUpdateMutex.Lock()
+	ret, specificReturn := fake.getAndValidatePluginUpdateReturnsOnCall[len(fake.getAndValidatePluginUpdateArgsForCall)]
+	fake.getAndValidatePluginUpdateArgsForCall = append(fake.getAndValidatePluginUpdateArgsForCall, struct {
+		metadata   pluginaction.PluginMetadata
+		commands   pluginaction.CommandList
+		path       string
+		pluginName string
+	}{metadata, commands, path, pluginName})
+	fake.recordInvocation("GetAndValidatePluginUpdate", []interface{}{metadata, commands, path, pluginName})
+	fake.getAndValidatePluginUpdateMutex.Unlock()
+	if fake.GetAndValidatePluginUpdateStub != nil {
+		return fake.GetAndValidatePluginUpdateStub(metadata, commands, path, pluginName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAndValidatePluginUpdateReturns.result1, fake.getAndValidatePluginUpdateReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginUpdateCallCount() int {
+	fake.getAndValidatePluginUpdateMutex.RLock()
+	defer fake.getAndValidatePluginUpdateMutex.RUnlock()
+	return len(fake.getAndValidatePluginUpdateArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginUpdateArgsForCall(i int) (pluginaction.PluginMetadata, pluginaction.CommandList, string, string) {
+	fake.getAndValidatePluginUpdateMutex.RLock()
+	defer fake.getAndValidatePluginUpdateMutex.RUnlock()
+	return fake.getAndValidatePluginUpdateArgsForCall[i].metadata, fake.getAndValidatePluginUpdateArgsForCall[i].commands, fake.getAndValidatePluginUpdateArgsForCall[i].path, fake.getAndValidatePluginUpdateArgsForCall[i].pluginName
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginUpdateReturns(result1 configv3.Plugin, result2 error) {
+	fake.GetAndValidatePluginUpdateStub = nil
+	fake.getAndValidatePluginUpdateReturns = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetAndValidatePluginUpdateReturnsOnCall(i int, result1 configv3.Plugin, result2 error) {
+	fake.GetAndValidatePluginUpdateStub = nil
+	if fake.getAndValidatePluginUpdateReturnsOnCall == nil {
+		fake.getAndValidatePluginUpdateReturnsOnCall = make(map[int]struct {
+			result1 configv3.Plugin
+			result2 error
+		})
+	}
+	fake.getAndValidatePluginUpdateReturnsOnCall[i] = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error) {
+	fake.getOutdatedPluginsMutex.Lock()
+	ret, specificReturn := fake.getOutdatedPluginsReturnsOnCall[len(fake.getOutdatedPluginsArgsForCall)]
+	fake.getOutdatedPluginsArgsForCall = append(fake.getOutdatedPluginsArgsForCall, struct{}{})
+	fake.recordInvocation("GetOutdatedPlugins", []interface{}{})
+	fake.getOutdatedPluginsMutex.Unlock()
+	if fake.GetOutdatedPluginsStub != nil {
+		return fake.GetOutdatedPluginsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOutdatedPluginsReturns.result1, fake.getOutdatedPluginsReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) GetOutdatedPluginsCallCount() int {
+	fake.getOutdatedPluginsMutex.RLock()
+	defer fake.getOutdatedPluginsMutex.RUnlock()
+	return len(fake.getOutdatedPluginsArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) GetOutdatedPluginsReturns(result1 []pluginaction.OutdatedPlugin, result2 error) {
+	fake.GetOutdatedPluginsStub = nil
+	fake.getOutdatedPluginsReturns = struct {
+		result1 []pluginaction.OutdatedPlugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetOutdatedPluginsReturnsOnCall(i int, result1 []pluginaction.OutdatedPlugin, result2 error) {
+	fake.GetOutdatedPluginsStub = nil
+	if fake.getOutdatedPluginsReturnsOnCall == nil {
+		fake.getOutdatedPluginsReturnsOnCall = make(map[int]struct {
+			result1 []pluginaction.OutdatedPlugin
+			result2 error
+		})
+	}
+	fake.getOutdatedPluginsReturnsOnCall[i] = struct {
+		result1 []pluginaction.OutdatedPlugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string {
+	fake.getPlatformStringMutex.Lock()
+	ret, specificReturn := fake.getPlatformStringReturnsOnCall[len(fake.getPlatformStringArgsForCall)]
+	fake.getPlatformStringArgsForCall = append(fake.getPlatformStringArgsForCall, struct {
+		runtimeGOOS   string
+		runtimeGOARCH string
+	}{runtimeGOOS, runtimeGOARCH})
+	fake.recordInvocation("GetPlatformString", []interface{}{runtimeGOOS, runtimeGOARCH})
+	fake.getPlatformStringMutex.Unlock()
+	if fake.GetPlatformStringStub != nil {
+		return fake.GetPlatformStringStub(runtimeGOOS, runtimeGOARCH)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getPlatformStringReturns.result1
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformStringCallCount() int {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	return len(fake.getPlatformStringArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformStringArgsForCall(i int) (string, string) {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	return fake.getPlatformStringArgsForCall[i].runtimeGOOS, fake.getPlatformStringArgsForCall[i].runtimeGOARCH
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformStringReturns(result1 string) {
+	fake.GetPlatformStringStub = nil
+	fake.getPlatformStringReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPlatformStringReturnsOnCall(i int, result1 string) {
+	fake.GetPlatformStringStub = nil
+	if fake.getPlatformStringReturnsOnCall == nil {
+		fake.getPlatformStringReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getPlatformStringReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepository(pluginName string, repositoryName string, platform string) (pluginaction.PluginInfo, error) {
+	fake.getPluginInfoFromRepositoryMutex.Lock()
+	ret, specificReturn := fake.getPluginInfoFromRepositoryReturnsOnCall[len(fake.getPluginInfoFromRepositoryArgsForCall)]
+	fake.getPluginInfoFromRepositoryArgsForCall = append(fake.getPluginInfoFromRepositoryArgsForCall, struct {
+		pluginName     string
+		repositoryName string
+		platform       string
+	}{pluginName, repositoryName, platform})
+	fake.recordInvocation("GetPluginInfoFromRepository", []interface{}{pluginName, repositoryName, platform})
+	fake.getPluginInfoFromRepositoryMutex.Unlock()
+	if fake.GetPluginInfoFromRepositoryStub != nil {
+		return fake.GetPluginInfoFromRepositoryStub(pluginName, repositoryName, platform)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getPluginInfoFromRepositoryReturns.result1, fake.getPluginInfoFromRepositoryReturns.result2
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoryCallCount() int {
+	fake.getPluginInfoFromRepositoryMutex.RLock()
+	defer fake.getPluginInfoFromRepositoryMutex.RUnlock()
+	return len(fake.getPluginInfoFromRepositoryArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoryArgsForCall(i int) (string, string, string) {
+	fake.getPluginInfoFromRepositoryMutex.RLock()
+	defer fake.getPluginInfoFromRepositoryMutex.RUnlock()
+	return fake.getPluginInfoFromRepositoryArgsForCall[i].pluginName, fake.getPluginInfoFromRepositoryArgsForCall[i].repositoryName, fake.getPluginInfoFromRepositoryArgsForCall[i].platform
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoryReturns(result1 pluginaction.PluginInfo, result2 error) {
+	fake.GetPluginInfoFromRepositoryStub = nil
+	fake.getPluginInfoFromRepositoryReturns = struct {
+		result1 pluginaction.PluginInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) GetPluginInfoFromRepositoryReturnsOnCall(i int, result1 pluginaction.PluginInfo, result2 error) {
+	fake.GetPluginInfoFromRepositoryStub = nil
+	if fake.getPluginInfoFromRepositoryReturnsOnCall == nil {
+		fake.getPluginInfoFromRepositoryReturnsOnCall = make(map[int]struct {
+			result1 pluginaction.PluginInfo
+			result2 error
+		})
+	}
+	fake.getPluginInfoFromRepositoryReturnsOnCall[i] = struct {
+		result1 pluginaction.PluginInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdatePluginsActor) UpdatePluginFromPath(uninstaller pluginaction.PluginUninstaller, path string, plugin configv3.Plugin) error {
+	fake.updatePluginFromPathMutex.Lock()
+	ret, specificReturn := fake.updatePluginFromPathReturnsOnCall[len(fake.updatePluginFromPathArgsForCall)]
+	fake.updatePluginFromPathArgsForCall = append(fake.updatePluginFromPathArgsForCall, struct {
+		uninstaller pluginaction.PluginUninstaller
+		path        string
+		plugin      configv3.Plugin
+	}{uninstaller, path, plugin})
+	fake.recordInvocation("UpdatePluginFromPath", []interface{}{uninstaller, path, plugin})
+	fake.updatePluginFromPathMutex.Unlock()
+	if fake.UpdatePluginFromPathStub != nil {
+		return fake.UpdatePluginFromPathStub(uninstaller, path, plugin)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updatePluginFromPathReturns.result1
+}
+
+func (fake *FakeUpdatePluginsActor) UpdatePluginFromPathCallCount() int {
+	fake.updatePluginFromPathMutex.RLock()
+	defer fake.updatePluginFromPathMutex.RUnlock()
+	return len(fake.updatePluginFromPathArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) UpdatePluginFromPathArgsForCall(i int) (pluginaction.PluginUninstaller, string, configv3.Plugin) {
+	fake.updatePluginFromPathMutex.RLock()
+	defer fake.updatePluginFromPathMutex.RUnlock()
+	return fake.updatePluginFromPathArgsForCall[i].uninstaller, fake.updatePluginFromPathArgsForCall[i].path, fake.updatePluginFromPathArgsForCall[i].plugin
+}
+
+func (fake *FakeUpdatePluginsActor) UpdatePluginFromPathReturns(result1 error) {
+	fake.UpdatePluginFromPathStub = nil
+	fake.updatePluginFromPathReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) UpdatePluginFromPathReturnsOnCall(i int, result1 error) {
+	fake.UpdatePluginFromPathStub = nil
+	if fake.updatePluginFromPathReturnsOnCall == nil {
+		fake.updatePluginFromPathReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updatePluginFromPathReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksum(path string, checksum string) bool {
+	fake.validateFileChecksumMutex.Lock()
+	ret, specificReturn := fake.validateFileChecksumReturnsOnCall[len(fake.validateFileChecksumArgsForCall)]
+	fake.validateFileChecksumArgsForCall = append(fake.validateFileChecksumArgsForCall, struct {
+		path     string
+		checksum string
+	}{path, checksum})
+	fake.recordInvocation("ValidateFileChecksum", []interface{}{path, checksum})
+	fake.validateFileChecksumMutex.Unlock()
+	if fake.ValidateFileChecksumStub != nil {
+		return fake.ValidateFileChecksumStub(path, checksum)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.validateFileChecksumReturns.result1
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksumCallCount() int {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	return len(fake.validateFileChecksumArgsForCall)
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksumArgsForCall(i int) (string, string) {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	return fake.validateFileChecksumArgsForCall[i].path, fake.validateFileChecksumArgsForCall[i].checksum
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksumReturns(result1 bool) {
+	fake.ValidateFileChecksumStub = nil
+	fake.validateFileChecksumReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) ValidateFileChecksumReturnsOnCall(i int, result1 bool) {
+	fake.ValidateFileChecksumStub = nil
+	if fake.validateFileChecksumReturnsOnCall == nil {
+		fake.validateFileChecksumReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileChecksumReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpdatePluginsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	fake.getAndValidatePluginUpdateMutex.RLock()
+	defer fake.getAndValidatePluginUpdateMutex.RUnlock()
+	fake.getOutdatedPluginsMutex.RLock()
+	defer fake.getOutdatedPluginsMutex.RUnlock()
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	fake.getPluginInfoFromRepositoryMutex.RLock()
+	defer fake.getPluginInfoFromRepositoryMutex.RUnlock()
+	fake.updatePluginFromPathMutex.RLock()
+	defer fake.updatePluginFromPathMutex.RUnlock()
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeUpdatePluginsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ plugin.UpdatePluginsActor = new(FakeUpdatePluginsActor)
@@ -1,23 +1,86 @@
 package plugin
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+//go:generate counterfeiter . RepoPluginsActor
+
+type RepoPluginsActor interface {
+	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
+	GetRepositoryPlugins(repository configv3.PluginRepository) ([]plugin.Plugin, error)
+}
+
 type RepoPluginsCommand struct {
 	RegisteredRepository string      `short:"r" description:"Name of a registered repository"`
 	usage                interface{} `usage:"CF_NAME repo-plugins [-r REPO_NAME]\n\nEXAMPLES:\n   CF_NAME repo-plugins -r PrivateRepo"`
 	relatedCommands      interface{} `related_commands:"add-plugin-repo, delete-plugin-repo, install-plugin"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  RepoPluginsActor
 }
 
-func (_ RepoPluginsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RepoPluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui))
 	return nil
 }
 
-func (_ RepoPluginsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RepoPluginsCommand) Execute(_ []string) error {
+	var repositories []configv3.PluginRepository
+	if cmd.RegisteredRepository != "" {
+		repository, err := cmd.Actor.GetPluginRepository(cmd.RegisteredRepository)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		repositories = append(repositories, repository)
+		cmd.UI.DisplayTextWithFlavor("Getting plugins from repository '{{.RepositoryName}}'...", map[string]interface{}{
+			"RepositoryName": repository.Name,
+		})
+	} else {
+		repositories = cmd.Config.PluginRepositories()
+		if len(repositories) == 0 {
+			return shared.NoPluginRepositoriesError{}
+		}
+		cmd.UI.DisplayText("Getting plugins from all repositories...")
+	}
+
+	var repositoryErrors []error
+	for _, repository := range repositories {
+		plugins, err := cmd.Actor.GetRepositoryPlugins(repository)
+		if err != nil {
+			repositoryErrors = append(repositoryErrors, shared.HandleError(err))
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Repository: {{.RepositoryName}}", map[string]interface{}{
+			"RepositoryName": repository.Name,
+		})
+
+		table := [][]string{{"name", "version", "description"}}
+		for _, plugin := range plugins {
+			table = append(table, []string{plugin.Name, plugin.Version, plugin.Description})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, 3)
+	}
+
+	if len(repositoryErrors) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Logged errors:")
+		for _, err := range repositoryErrors {
+			cmd.UI.DisplayError(err)
+		}
+		if len(repositoryErrors) == len(repositories) {
+			return repositoryErrors[0]
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,126 @@
+package plugin_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("repo-plugins command", func() {
+	var (
+		cmd        RepoPluginsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeRepoPluginsActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeRepoPluginsActor)
+
+		cmd = RepoPluginsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when -r is provided", func() {
+		BeforeEach(func() {
+			cmd.RegisteredRepository = "some-repo"
+		})
+
+		Context("when the repository is not registered", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{}, pluginaction.RepositoryNotRegisteredError{Name: "some-repo"})
+			})
+
+			It("returns a RepositoryNotRegisteredError", func() {
+				Expect(executeErr).To(MatchError(shared.RepositoryNotRegisteredError{Name: "some-repo"}))
+			})
+		})
+
+		Context("when the repository is registered", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: "some-repo", URL: "https://some-repo.com"}, nil)
+				fakeActor.GetRepositoryPluginsReturns([]plugin.Plugin{
+					{Name: "plugin-1", Version: "1.0.0", Description: "does things"},
+				}, nil)
+			})
+
+			It("lists the plugins in the repository", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting plugins from repository 'some-repo'\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Repository: some-repo"))
+				Expect(testUI.Out).To(Say("name\\s+version\\s+description"))
+				Expect(testUI.Out).To(Say("plugin-1\\s+1\\.0\\.0\\s+does things"))
+
+				Expect(fakeActor.GetPluginRepositoryArgsForCall(0)).To(Equal("some-repo"))
+				Expect(fakeActor.GetRepositoryPluginsArgsForCall(0)).To(Equal(configv3.PluginRepository{Name: "some-repo", URL: "https://some-repo.com"}))
+			})
+		})
+	})
+
+	Context("when -r is not provided", func() {
+		Context("when no repositories are registered", func() {
+			It("returns a NoPluginRepositoriesError", func() {
+				Expect(executeErr).To(MatchError(shared.NoPluginRepositoriesError{}))
+			})
+		})
+
+		Context("when repositories are registered", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+					{Name: "repo-1", URL: "https://repo-1.com"},
+					{Name: "repo-2", URL: "https://repo-2.com"},
+				})
+			})
+
+			Context("when one of the repositories errors", func() {
+				BeforeEach(func() {
+					fakeActor.GetRepositoryPluginsStub = func(repository configv3.PluginRepository) ([]plugin.Plugin, error) {
+						if repository.Name == "repo-1" {
+							return []plugin.Plugin{{Name: "plugin-1", Version: "1.0.0"}}, nil
+						}
+						return nil, pluginaction.GettingPluginRepositoryError{Name: "repo-2", Message: "404"}
+					}
+				})
+
+				It("lists the available plugins and logs the errors", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Getting plugins from all repositories\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Repository: repo-1"))
+					Expect(testUI.Out).To(Say("plugin-1\\s+1\\.0\\.0"))
+					Expect(testUI.Out).To(Say("Logged errors:"))
+					Expect(testUI.Err).To(Say("Could not get plugin repository 'repo-2': 404"))
+				})
+			})
+
+			Context("when all of the repositories error", func() {
+				BeforeEach(func() {
+					fakeActor.GetRepositoryPluginsReturns(nil, pluginaction.GettingPluginRepositoryError{Name: "repo-1", Message: "404"})
+				})
+
+				It("returns the first error", func() {
+					Expect(executeErr).To(MatchError(shared.GettingPluginRepositoryError{Name: "repo-1", Message: "404"}))
+				})
+			})
+		})
+	})
+})
@@ -1,5 +1,7 @@
 package shared
 
+import "strings"
+
 type PluginNotFoundError struct {
 	Name string
 }
@@ -38,3 +40,153 @@ func (e GettingPluginRepositoryError) Error() string {
 func (e GettingPluginRepositoryError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), map[string]interface{}{"RepositoryName": e.Name, "ErrorMessage": e.Message})
 }
+
+// RepositoryNotRegisteredError is returned when the requested plugin
+// repository is not registered.
+type RepositoryNotRegisteredError struct {
+	Name string
+}
+
+func (e RepositoryNotRegisteredError) Error() string {
+	return "Plugin repository {{.RepositoryName}} not found"
+}
+
+func (e RepositoryNotRegisteredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"RepositoryName": e.Name})
+}
+
+// PluginNotFoundInRepositoryError is returned when the requested plugin is
+// not listed in the plugin repository.
+type PluginNotFoundInRepositoryError struct {
+	PluginName     string
+	RepositoryName string
+}
+
+func (e PluginNotFoundInRepositoryError) Error() string {
+	return "Plugin {{.PluginName}} not found in repository {{.RepositoryName}}"
+}
+
+func (e PluginNotFoundInRepositoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":     e.PluginName,
+		"RepositoryName": e.RepositoryName,
+	})
+}
+
+// NoCompatibleBinaryError is returned when the plugin repository has no binary
+// of the requested plugin for the current platform.
+type NoCompatibleBinaryError struct {
+	Platform string
+}
+
+func (e NoCompatibleBinaryError) Error() string {
+	return "Plugin requested has no binary available for your platform ({{.Platform}})."
+}
+
+func (e NoCompatibleBinaryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"Platform": e.Platform})
+}
+
+// InvalidChecksumError is returned when a downloaded plugin binary does not
+// match the checksum advertised by the plugin repository.
+type InvalidChecksumError struct{}
+
+func (e InvalidChecksumError) Error() string {
+	return "Downloaded plugin binary's checksum does not match repo metadata.\nPlease try again or contact the plugin author."
+}
+
+func (e InvalidChecksumError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+// PluginInvalidError is returned when a binary is not a valid plugin.
+type PluginInvalidError struct{}
+
+func (e PluginInvalidError) Error() string {
+	return "File is not a valid cf CLI plugin binary."
+}
+
+func (e PluginInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+// PluginAlreadyInstalledError is returned when a plugin with the same name is
+// already installed.
+type PluginAlreadyInstalledError struct {
+	BinaryName string
+	Name       string
+	Version    string
+}
+
+func (e PluginAlreadyInstalledError) Error() string {
+	return "Plugin {{.Name}} {{.Version}} could not be installed. A plugin with that name is already installed.\nTIP: Use '{{.BinaryName}} uninstall-plugin {{.Name}}' to remove it before installing a new version."
+}
+
+func (e PluginAlreadyInstalledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BinaryName": e.BinaryName,
+		"Name":       e.Name,
+		"Version":    e.Version,
+	})
+}
+
+// PluginCommandsConflictError is returned when a plugin command name or alias
+// is already used by a native command or an installed plugin.
+type PluginCommandsConflictError struct {
+	PluginName     string
+	PluginVersion  string
+	CommandNames   []string
+	CommandAliases []string
+}
+
+func (e PluginCommandsConflictError) Error() string {
+	switch {
+	case len(e.CommandNames) > 0 && len(e.CommandAliases) > 0:
+		return "Plugin {{.PluginName}} v{{.PluginVersion}} could not be installed as it contains commands with names and aliases that are already used: {{.CommandNamesAndAliases}}."
+	case len(e.CommandNames) > 0:
+		return "Plugin {{.PluginName}} v{{.PluginVersion}} could not be installed as it contains commands with names that are already used: {{.CommandNamesAndAliases}}."
+	default:
+		return "Plugin {{.PluginName}} v{{.PluginVersion}} could not be installed as it contains commands with aliases that are already used: {{.CommandNamesAndAliases}}."
+	}
+}
+
+func (e PluginCommandsConflictError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":             e.PluginName,
+		"PluginVersion":          e.PluginVersion,
+		"CommandNamesAndAliases": strings.Join(append(e.CommandNames, e.CommandAliases...), ", "),
+	})
+}
+
+// PluginBinaryConflictError is returned when the binary of a plugin would
+// replace the binary of another installed plugin.
+type PluginBinaryConflictError struct {
+	PluginName            string
+	ConflictingPluginName string
+	Path                  string
+}
+
+func (e PluginBinaryConflictError) Error() string {
+	return "Plugin {{.PluginName}} could not be installed as its binary {{.Path}} is used by plugin {{.ConflictingPluginName}}."
+}
+
+func (e PluginBinaryConflictError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":            e.PluginName,
+		"ConflictingPluginName": e.ConflictingPluginName,
+		"Path":                  e.Path,
+	})
+}
+
+// FileNotFoundError is returned when a local plugin binary does not exist.
+type FileNotFoundError struct {
+	Path string
+}
+
+func (e FileNotFoundError) Error() string {
+	return "File not found locally, make sure the file exists at given path {{.FilePath}}"
+}
+
+func (e FileNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"FilePath": e.Path})
+}
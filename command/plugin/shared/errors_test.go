@@ -37,5 +37,15 @@ var _ = Describe("Translatable Errors", func() {
 
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
+		Entry("GettingPluginRepositoryError", GettingPluginRepositoryError{}),
+		Entry("RepositoryNotRegisteredError", RepositoryNotRegisteredError{}),
+		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
+		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
+		Entry("InvalidChecksumError", InvalidChecksumError{}),
+		Entry("PluginInvalidError", PluginInvalidError{}),
+		Entry("PluginAlreadyInstalledError", PluginAlreadyInstalledError{}),
+		Entry("PluginCommandsConflictError", PluginCommandsConflictError{}),
+		Entry("PluginBinaryConflictError", PluginBinaryConflictError{}),
+		Entry("FileNotFoundError", FileNotFoundError{}),
 	)
 })
@@ -8,6 +8,27 @@ func HandleError(err error) error {
 		return PluginNotFoundError{Name: e.Name}
 	case pluginaction.GettingPluginRepositoryError:
 		return GettingPluginRepositoryError{Name: e.Name, Message: e.Message}
+	case pluginaction.RepositoryNotRegisteredError:
+		return RepositoryNotRegisteredError{Name: e.Name}
+	case pluginaction.PluginNotFoundInRepositoryError:
+		return PluginNotFoundInRepositoryError{PluginName: e.PluginName, RepositoryName: e.RepositoryName}
+	case pluginaction.NoCompatibleBinaryError:
+		return NoCompatibleBinaryError{Platform: e.Platform}
+	case pluginaction.PluginInvalidError:
+		return PluginInvalidError{}
+	case pluginaction.PluginBinaryConflictError:
+		return PluginBinaryConflictError{
+			PluginName:            e.PluginName,
+			ConflictingPluginName: e.ConflictingPluginName,
+			Path:                  e.Path,
+		}
+	case pluginaction.PluginCommandsConflictError:
+		return PluginCommandsConflictError{
+			PluginName:     e.PluginName,
+			PluginVersion:  e.PluginVersion,
+			CommandNames:   e.CommandNames,
+			CommandAliases: e.CommandAliases,
+		}
 	}
 	return err
 }
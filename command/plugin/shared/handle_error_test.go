@@ -25,6 +25,24 @@ var _ = Describe("HandleError", func() {
 		Entry("pluginaction.GettingPluginRepositoryError -> GettingPluginRepositoryError",
 			pluginaction.GettingPluginRepositoryError{Name: "some-repo", Message: "404"},
 			GettingPluginRepositoryError{Name: "some-repo", Message: "404"}),
+		Entry("pluginaction.RepositoryNotRegisteredError -> RepositoryNotRegisteredError",
+			pluginaction.RepositoryNotRegisteredError{Name: "some-repo"},
+			RepositoryNotRegisteredError{Name: "some-repo"}),
+		Entry("pluginaction.PluginNotFoundInRepositoryError -> PluginNotFoundInRepositoryError",
+			pluginaction.PluginNotFoundInRepositoryError{PluginName: "some-plugin", RepositoryName: "some-repo"},
+			PluginNotFoundInRepositoryError{PluginName: "some-plugin", RepositoryName: "some-repo"}),
+		Entry("pluginaction.NoCompatibleBinaryError -> NoCompatibleBinaryError",
+			pluginaction.NoCompatibleBinaryError{Platform: "linux64"},
+			NoCompatibleBinaryError{Platform: "linux64"}),
+		Entry("pluginaction.PluginInvalidError -> PluginInvalidError",
+			pluginaction.PluginInvalidError{},
+			PluginInvalidError{}),
+		Entry("pluginaction.PluginBinaryConflictError -> PluginBinaryConflictError",
+			pluginaction.PluginBinaryConflictError{PluginName: "some-plugin", ConflictingPluginName: "other-plugin", Path: "some-path"},
+			PluginBinaryConflictError{PluginName: "some-plugin", ConflictingPluginName: "other-plugin", Path: "some-path"}),
+		Entry("pluginaction.PluginCommandsConflictError -> PluginCommandsConflictError",
+			pluginaction.PluginCommandsConflictError{PluginName: "some-plugin", PluginVersion: "1.1.1", CommandNames: []string{"some-command"}},
+			PluginCommandsConflictError{PluginName: "some-plugin", PluginVersion: "1.1.1", CommandNames: []string{"some-command"}}),
 
 		Entry("default case -> original error",
 			err,
@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/trace"
 	"code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type Config interface {
@@ -64,3 +65,72 @@ func (p PluginUninstaller) Uninstall(location string) error {
 
 	return pluginInvocation.Run()
 }
+
+type PluginMetadataRetriever struct {
+	config Config
+	ui     UI
+}
+
+func NewPluginMetadataRetriever(config Config, ui UI) *PluginMetadataRetriever {
+	return &PluginMetadataRetriever{
+		config: config,
+		ui:     ui,
+	}
+}
+
+// GetMetadata runs the plugin binary at the provided path so that it
+// advertises its metadata to the RPC service, and converts that metadata to a
+// configv3.Plugin.
+func (p PluginMetadataRetriever) GetMetadata(pluginPath string) (configv3.Plugin, error) {
+	rpcService, err := NewRPCService(p.config, p.ui)
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+
+	err = rpcService.Start()
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+	defer rpcService.Stop()
+
+	pluginInvocation := exec.Command(pluginPath, rpcService.Port(), "SendMetadata")
+	err = pluginInvocation.Run()
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+
+	rpcCmd := rpcService.RpcCmd
+	rpcCmd.MetadataMutex.RLock()
+	defer rpcCmd.MetadataMutex.RUnlock()
+
+	metadata := rpcCmd.PluginMetadata
+	plugin := configv3.Plugin{
+		Name: metadata.Name,
+		Version: configv3.PluginVersion{
+			Major: metadata.Version.Major,
+			Minor: metadata.Version.Minor,
+			Build: metadata.Version.Build,
+		},
+	}
+	for _, command := range metadata.Commands {
+		plugin.Commands = append(plugin.Commands, configv3.PluginCommand{
+			Name:     command.Name,
+			Alias:    command.Alias,
+			HelpText: command.HelpText,
+			UsageDetails: configv3.PluginUsageDetails{
+				Usage:   command.UsageDetails.Usage,
+				Options: command.UsageDetails.Options,
+			},
+		})
+	}
+
+	return plugin, nil
+}
+
+// NativeCommandList reports whether a name is used by a command or alias
+// built into the CLI.
+type NativeCommandList struct{}
+
+func (NativeCommandList) HasCommand(name string) bool {
+	return name == "help" || commandregistry.Commands.CommandExists(name)
+}
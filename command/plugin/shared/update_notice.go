@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// DisplayPluginUpdateNotice warns the user about installed plugins that have
+// newer versions in the registered plugin repositories. The check only runs
+// when it has been enabled with 'update-plugins --enable-startup-check' and at
+// most once every pluginaction.PluginUpdateCheckInterval. Errors are ignored
+// so that the notice never causes a command to fail.
+func DisplayPluginUpdateNotice(config command.Config, ui command.UI) {
+	actor := pluginaction.NewActor(config, NewClient(config, ui))
+
+	now := time.Now()
+	if !actor.ShouldCheckForPluginUpdates(now) {
+		return
+	}
+
+	outdatedPlugins, err := actor.CheckForPluginUpdates(now)
+	if err != nil || len(outdatedPlugins) == 0 {
+		return
+	}
+
+	pluginNames := make([]string, len(outdatedPlugins))
+	for i, plugin := range outdatedPlugins {
+		pluginNames[i] = plugin.Name
+	}
+
+	ui.DisplayWarning("Newer versions of the following plugins are available: {{.PluginNames}}\nTIP: Use '{{.BinaryName}} update-plugins' to update them.", map[string]interface{}{
+		"PluginNames": strings.Join(pluginNames, ", "),
+		"BinaryName":  config.BinaryName(),
+	})
+}
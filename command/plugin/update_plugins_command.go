@@ -0,0 +1,159 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . UpdatePluginsActor
+
+type UpdatePluginsActor interface {
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string) (string, int64, error)
+	GetAndValidatePluginUpdate(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string, pluginName string) (configv3.Plugin, error)
+	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginInfoFromRepository(pluginName string, repositoryName string, platform string) (pluginaction.PluginInfo, error)
+	UpdatePluginFromPath(uninstaller pluginaction.PluginUninstaller, path string, plugin configv3.Plugin) error
+	ValidateFileChecksum(path string, checksum string) bool
+}
+
+type UpdatePluginsCommand struct {
+	Force               bool        `short:"f" description:"Force update of plugins without confirmation"`
+	EnableStartupCheck  bool        `long:"enable-startup-check" description:"Check the plugin repositories for plugin updates once a day when running a command"`
+	DisableStartupCheck bool        `long:"disable-startup-check" description:"Stop checking the plugin repositories for plugin updates when running a command"`
+	usage               interface{} `usage:"CF_NAME update-plugins [-f]\n   CF_NAME update-plugins (--enable-startup-check | --disable-startup-check)\n\n   Prompts for confirmation unless '-f' is provided."`
+	relatedCommands     interface{} `related_commands:"install-plugin, plugins, repo-plugins"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  UpdatePluginsActor
+}
+
+func (cmd *UpdatePluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui))
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) Execute(_ []string) error {
+	if cmd.EnableStartupCheck && cmd.DisableStartupCheck {
+		return command.ArgumentCombinationError{
+			Arg1: "--enable-startup-check",
+			Arg2: "--disable-startup-check",
+		}
+	}
+
+	if cmd.EnableStartupCheck || cmd.DisableStartupCheck {
+		cmd.Config.SetPluginUpdateCheck(cmd.EnableStartupCheck)
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	repos := cmd.Config.PluginRepositories()
+	if len(repos) == 0 {
+		return shared.NoPluginRepositoriesError{}
+	}
+	repoNames := make([]string, len(repos))
+	for i := range repos {
+		repoNames[i] = repos[i].Name
+	}
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepoNames}} for newer versions of installed plugins...",
+		map[string]interface{}{
+			"RepoNames": strings.Join(repoNames, ", "),
+		})
+
+	outdatedPlugins, err := cmd.Actor.GetOutdatedPlugins()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(outdatedPlugins) == 0 {
+		cmd.UI.DisplayText("All installed plugins are up to date.")
+		return nil
+	}
+
+	table := [][]string{{"plugin", "version", "latest version", "repository"}}
+	for _, plugin := range outdatedPlugins {
+		table = append(table, []string{plugin.Name, plugin.CurrentVersion, plugin.LatestVersion, plugin.RepositoryName})
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	if !cmd.Force {
+		confirmed, promptErr := cmd.UI.DisplayBoolPrompt(false, "Do you want to update these plugins?")
+		if promptErr != nil {
+			return promptErr
+		}
+		if !confirmed {
+			cmd.UI.DisplayText("Plugin update cancelled.")
+			return nil
+		}
+	}
+
+	tempPluginDir, err := ioutil.TempDir("", "cf-plugin")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	platform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	for _, outdatedPlugin := range outdatedPlugins {
+		err = cmd.updatePlugin(outdatedPlugin, platform, tempPluginDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) updatePlugin(outdatedPlugin pluginaction.OutdatedPlugin, platform string, tempPluginDir string) error {
+	cmd.UI.DisplayTextWithFlavor("Updating plugin {{.Name}} from {{.CurrentVersion}} to {{.LatestVersion}}...", map[string]interface{}{
+		"Name":           outdatedPlugin.Name,
+		"CurrentVersion": outdatedPlugin.CurrentVersion,
+		"LatestVersion":  outdatedPlugin.LatestVersion,
+	})
+
+	pluginInfo, err := cmd.Actor.GetPluginInfoFromRepository(outdatedPlugin.Name, outdatedPlugin.RepositoryName, platform)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	pluginPath, size, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayText("{{.Bytes}} bytes downloaded...", map[string]interface{}{
+		"Bytes": size,
+	})
+
+	if !cmd.Actor.ValidateFileChecksum(pluginPath, pluginInfo.Checksum) {
+		return shared.InvalidChecksumError{}
+	}
+
+	plugin, err := cmd.Actor.GetAndValidatePluginUpdate(shared.NewPluginMetadataRetriever(cmd.Config, cmd.UI), shared.NativeCommandList{}, pluginPath, outdatedPlugin.Name)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.Actor.UpdatePluginFromPath(shared.NewPluginUninstaller(cmd.Config, cmd.UI), pluginPath, plugin)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Plugin {{.Name}} {{.Version}} successfully updated.", map[string]interface{}{
+		"Name":    plugin.Name,
+		"Version": plugin.Version.String(),
+	})
+	return nil
+}
@@ -0,0 +1,217 @@
+package plugin_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-plugins command", func() {
+	var (
+		cmd        UpdatePluginsCommand
+		testUI     *ui.UI
+		input      *Buffer
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeUpdatePluginsActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeUpdatePluginsActor)
+
+		cmd = UpdatePluginsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --enable-startup-check is provided", func() {
+		BeforeEach(func() {
+			cmd.EnableStartupCheck = true
+		})
+
+		It("enables the startup check", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeConfig.SetPluginUpdateCheckCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetPluginUpdateCheckArgsForCall(0)).To(BeTrue())
+			Expect(fakeActor.GetOutdatedPluginsCallCount()).To(Equal(0))
+		})
+
+		Context("when --disable-startup-check is also provided", func() {
+			BeforeEach(func() {
+				cmd.DisableStartupCheck = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+					Arg1: "--enable-startup-check",
+					Arg2: "--disable-startup-check",
+				}))
+			})
+		})
+	})
+
+	Context("when --disable-startup-check is provided", func() {
+		BeforeEach(func() {
+			cmd.DisableStartupCheck = true
+		})
+
+		It("disables the startup check", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.SetPluginUpdateCheckArgsForCall(0)).To(BeFalse())
+		})
+	})
+
+	Context("when there are no plugin repositories", func() {
+		It("returns a NoPluginRepositoriesError", func() {
+			Expect(executeErr).To(MatchError(shared.NoPluginRepositoriesError{}))
+		})
+	})
+
+	Context("when there are plugin repositories", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "repo-1", URL: "https://repo-1.com"},
+				{Name: "repo-2", URL: "https://repo-2.com"},
+			})
+		})
+
+		Context("when getting the outdated plugins errors", func() {
+			BeforeEach(func() {
+				fakeActor.GetOutdatedPluginsReturns(nil, pluginaction.GettingPluginRepositoryError{Name: "repo-1", Message: "404"})
+			})
+
+			It("returns a GettingPluginRepositoryError", func() {
+				Expect(executeErr).To(MatchError(shared.GettingPluginRepositoryError{Name: "repo-1", Message: "404"}))
+			})
+		})
+
+		Context("when all plugins are up to date", func() {
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Searching repo-1, repo-2 for newer versions of installed plugins\\.\\.\\."))
+				Expect(testUI.Out).To(Say("All installed plugins are up to date\\."))
+			})
+		})
+
+		Context("when there are outdated plugins", func() {
+			BeforeEach(func() {
+				fakeActor.GetOutdatedPluginsReturns([]pluginaction.OutdatedPlugin{
+					{Name: "some-plugin", CurrentVersion: "1.0.0", LatestVersion: "2.0.0", RepositoryName: "repo-2"},
+				}, nil)
+				fakeActor.GetPlatformStringReturns("some-platform")
+				fakeActor.GetPluginInfoFromRepositoryReturns(pluginaction.PluginInfo{
+					Name:     "some-plugin",
+					Version:  "2.0.0",
+					URL:      "https://repo-2.com/some-plugin",
+					Checksum: "some-checksum",
+				}, nil)
+				fakeActor.DownloadExecutableBinaryFromURLReturns("some-temp-dir/some-plugin", 4, nil)
+				fakeActor.ValidateFileChecksumReturns(true)
+				fakeActor.GetAndValidatePluginUpdateReturns(configv3.Plugin{
+					Name:    "some-plugin",
+					Version: configv3.PluginVersion{Major: 2},
+				}, nil)
+			})
+
+			Context("when the user confirms the update", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("updates the plugins", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("plugin\\s+version\\s+latest version\\s+repository"))
+					Expect(testUI.Out).To(Say("some-plugin\\s+1\\.0\\.0\\s+2\\.0\\.0\\s+repo-2"))
+					Expect(testUI.Out).To(Say("Do you want to update these plugins\\? \\[yN\\]:"))
+					Expect(testUI.Out).To(Say("Updating plugin some-plugin from 1\\.0\\.0 to 2\\.0\\.0\\.\\.\\."))
+					Expect(testUI.Out).To(Say("4 bytes downloaded\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Plugin some-plugin 2\\.0\\.0 successfully updated\\."))
+
+					pluginName, repositoryName, platform := fakeActor.GetPluginInfoFromRepositoryArgsForCall(0)
+					Expect(pluginName).To(Equal("some-plugin"))
+					Expect(repositoryName).To(Equal("repo-2"))
+					Expect(platform).To(Equal("some-platform"))
+
+					path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+					Expect(path).To(Equal("some-temp-dir/some-plugin"))
+					Expect(checksum).To(Equal("some-checksum"))
+
+					_, _, path, pluginName = fakeActor.GetAndValidatePluginUpdateArgsForCall(0)
+					Expect(path).To(Equal("some-temp-dir/some-plugin"))
+					Expect(pluginName).To(Equal("some-plugin"))
+
+					Expect(fakeActor.UpdatePluginFromPathCallCount()).To(Equal(1))
+				})
+
+				Context("when the checksum does not match", func() {
+					BeforeEach(func() {
+						fakeActor.ValidateFileChecksumReturns(false)
+					})
+
+					It("returns an InvalidChecksumError", func() {
+						Expect(executeErr).To(MatchError(shared.InvalidChecksumError{}))
+						Expect(fakeActor.UpdatePluginFromPathCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when updating the plugin errors", func() {
+					BeforeEach(func() {
+						fakeActor.UpdatePluginFromPathReturns(errors.New("update-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("update-error"))
+					})
+				})
+			})
+
+			Context("when the user declines the update", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not update the plugins", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Plugin update cancelled\\."))
+					Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when -f is provided", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+				})
+
+				It("updates the plugins without prompting", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Do you want to update these plugins"))
+					Expect(fakeActor.UpdatePluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+})
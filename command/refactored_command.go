@@ -0,0 +1,145 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// UseRefactoredCommand returns true if the refactored implementation of the
+// provided command should run instead of the legacy one. A command is
+// refactored when it, or "all", is listed in config.RefactoredCommands() or
+// when experimental mode is enabled. Listing "none" always selects the legacy
+// implementation. Commands do not create their clients in Setup when the
+// legacy implementation runs, since it creates its own.
+func UseRefactoredCommand(config Config, commandName string) bool {
+	refactored := config.Experimental()
+	for _, name := range config.RefactoredCommands() {
+		switch name {
+		case "none":
+			return false
+		case "all", commandName:
+			refactored = true
+		}
+	}
+	return refactored
+}
+
+// UsesLegacyImplementation returns true if the provided command runs its
+// legacy implementation. That is the case when none of refactoredOptions, the
+// options that only the refactored implementation supports, is provided and
+// UseRefactoredCommand does not select the refactored implementation.
+func UsesLegacyImplementation(config Config, commandName string, refactoredOptions ...bool) bool {
+	for _, provided := range refactoredOptions {
+		if provided {
+			return false
+		}
+	}
+	return !UseRefactoredCommand(config, commandName)
+}
+
+// parityCommands are the read-only commands that have both a legacy and a
+// refactored implementation, selected with UseRefactoredCommand. Only they
+// are run a second time to log parity, since running any other command again
+// would change state or compare an implementation with itself.
+var parityCommands = map[string]bool{
+	"apps":    true,
+	"domains": true,
+	"env":     true,
+	"events":  true,
+	"routes":  true,
+}
+
+// IsParityCommand returns true if the provided command has a legacy
+// implementation to compare with and is safe to run again to check parity.
+func IsParityCommand(commandName string) bool {
+	return parityCommands[commandName]
+}
+
+// LegacyParityEnv returns a copy of environ that forces the legacy
+// implementation of every command and disables parity logging. It is used to
+// run the legacy implementation of a command when checking parity.
+func LegacyParityEnv(environ []string) []string {
+	var env []string
+	for _, variable := range environ {
+		if strings.HasPrefix(variable, "CF_REFACTORED_COMMANDS=") ||
+			strings.HasPrefix(variable, "CF_PARITY_LOG=") ||
+			strings.HasPrefix(variable, "CF_CLI_EXPERIMENTAL=") {
+			continue
+		}
+		env = append(env, variable)
+	}
+	return append(env, "CF_REFACTORED_COMMANDS=none")
+}
+
+var colorCodes = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// WriteParityDiff writes the lines that differ between the output of the
+// legacy and the refactored implementations of a command to w. Lines only in
+// the legacy output are prefixed with "-" and lines only in the refactored
+// output are prefixed with "+". Color codes are ignored.
+func WriteParityDiff(w io.Writer, commandLine string, legacyOutput string, refactoredOutput string) error {
+	legacyLines := splitLines(colorCodes.ReplaceAllString(legacyOutput, ""))
+	refactoredLines := splitLines(colorCodes.ReplaceAllString(refactoredOutput, ""))
+
+	diff := diffLines(legacyLines, refactoredLines)
+	if len(diff) == 0 {
+		_, err := fmt.Fprintf(w, "=== %s: no differences\n", commandLine)
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "=== %s: legacy (-) and refactored (+) output differ\n%s\n", commandLine, strings.Join(diff, "\n"))
+	return err
+}
+
+func splitLines(output string) []string {
+	output = strings.TrimRight(strings.Replace(output, "\r\n", "\n", -1), "\n")
+	if output == "" {
+		return nil
+	}
+	return strings.Split(output, "\n")
+}
+
+// diffLines returns the lines that are not part of the longest common
+// subsequence of a and b, in order.
+func diffLines(a []string, b []string) []string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var diff []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, "-"+a[i])
+			i++
+		default:
+			diff = append(diff, "+"+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		diff = append(diff, "-"+a[i])
+	}
+	for ; j < len(b); j++ {
+		diff = append(diff, "+"+b[j])
+	}
+	return diff
+}
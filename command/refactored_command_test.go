@@ -0,0 +1,96 @@
+package command_test
+
+import (
+	"bytes"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Refactored commands", func() {
+	DescribeTable("UseRefactoredCommand",
+		func(experimental bool, refactoredCommands []string, expected bool) {
+			fakeConfig := new(commandfakes.FakeConfig)
+			fakeConfig.ExperimentalReturns(experimental)
+			fakeConfig.RefactoredCommandsReturns(refactoredCommands)
+
+			Expect(UseRefactoredCommand(fakeConfig, "some-command")).To(Equal(expected))
+		},
+
+		Entry("defaults to the legacy implementation", false, nil, false),
+		Entry("uses the refactored implementation when the command is listed", false, []string{"other-command", "some-command"}, true),
+		Entry("uses the legacy implementation when only other commands are listed", false, []string{"other-command"}, false),
+		Entry("uses the refactored implementation when all commands are enabled", false, []string{"all"}, true),
+		Entry("uses the refactored implementation in experimental mode", true, nil, true),
+		Entry("uses the legacy implementation when none is listed", true, []string{"some-command", "none"}, false),
+	)
+
+	DescribeTable("UsesLegacyImplementation",
+		func(refactoredCommands []string, refactoredOptions []bool, expected bool) {
+			fakeConfig := new(commandfakes.FakeConfig)
+			fakeConfig.RefactoredCommandsReturns(refactoredCommands)
+
+			Expect(UsesLegacyImplementation(fakeConfig, "some-command", refactoredOptions...)).To(Equal(expected))
+		},
+
+		Entry("uses the legacy implementation by default", nil, nil, true),
+		Entry("uses the legacy implementation when no refactored option is provided", nil, []bool{false, false}, true),
+		Entry("uses the refactored implementation when a refactored option is provided", nil, []bool{false, true}, false),
+		Entry("uses the refactored implementation when the command is listed", []string{"some-command"}, []bool{false}, false),
+		Entry("uses the refactored implementation for refactored options even when none is listed", []string{"none"}, []bool{true}, false),
+	)
+
+	DescribeTable("IsParityCommand",
+		func(commandName string, expected bool) {
+			Expect(IsParityCommand(commandName)).To(Equal(expected))
+		},
+
+		Entry("apps has a legacy implementation", "apps", true),
+		Entry("env has a legacy implementation", "env", true),
+		Entry("orgs has no legacy implementation", "orgs", false),
+		Entry("services has no refactored implementation", "services", false),
+		Entry("set-env changes the API", "set-env", false),
+		Entry("target can change the target", "target", false),
+		Entry("unknown commands are not logged", "some-command", false),
+	)
+
+	Describe("LegacyParityEnv", func() {
+		It("forces the legacy implementation and disables parity logging", func() {
+			env := LegacyParityEnv([]string{
+				"HOME=/some/home",
+				"CF_REFACTORED_COMMANDS=all",
+				"CF_PARITY_LOG=/some/parity.log",
+				"CF_CLI_EXPERIMENTAL=true",
+			})
+			Expect(env).To(Equal([]string{"HOME=/some/home", "CF_REFACTORED_COMMANDS=none"}))
+		})
+	})
+
+	Describe("WriteParityDiff", func() {
+		var buffer *bytes.Buffer
+
+		BeforeEach(func() {
+			buffer = new(bytes.Buffer)
+		})
+
+		Context("when the outputs match", func() {
+			It("logs that there are no differences, ignoring colors", func() {
+				err := WriteParityDiff(buffer, "cf orgs", "Getting orgs...\nOK\n", "Getting orgs...\n\x1b[32;1mOK\x1b[0m\n")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buffer.String()).To(Equal("=== cf orgs: no differences\n"))
+			})
+		})
+
+		Context("when the outputs differ", func() {
+			It("logs the differing lines", func() {
+				err := WriteParityDiff(buffer, "cf orgs", "Getting orgs as admin...\nname\norg-1\n", "Getting orgs...\nname\norg-1\norg-2\n")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buffer.String()).To(Equal("=== cf orgs: legacy (-) and refactored (+) output differ\n-Getting orgs as admin...\n+Getting orgs...\n+org-2\n"))
+			})
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package command
+
+// AskForTelemetryConsent asks the user once whether anonymous usage statistics
+// can be sent to the configured telemetry endpoint, and stores the answer in
+// the config. It only asks on a terminal, so scripts are never blocked, and
+// after the command has run, so the answer applies from the next command on.
+// Statistics are never sent without an explicit yes.
+func AskForTelemetryConsent(config Config, ui UI, commandName string) {
+	if IsOfflineCommand(commandName) || !config.IsTTY() || !config.TelemetryUndecided() {
+		return
+	}
+
+	ui.DisplayNewline()
+	ui.DisplayText("Usage statistics help the CLI maintainers decide what to work on. They contain the names of the commands you run, how long they take and the types of the errors they return, but no arguments, names or URLs.")
+	enabled, err := ui.DisplayBoolPrompt(false, "Send anonymous usage statistics to {{.Endpoint}}?", map[string]interface{}{
+		"Endpoint": config.TelemetryEndpoint(),
+	})
+	if err != nil {
+		return
+	}
+
+	config.SetTelemetry(enabled)
+	ui.DisplayText("You can change this with '{{.Command}}'.", map[string]interface{}{
+		"Command": config.BinaryName() + " config set telemetry (true | false)",
+	})
+}
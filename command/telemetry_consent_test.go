@@ -0,0 +1,93 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("AskForTelemetryConsent", func() {
+	var (
+		input       *Buffer
+		testUI      *ui.UI
+		fakeConfig  *commandfakes.FakeConfig
+		commandName string
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.IsTTYReturns(true)
+		fakeConfig.TelemetryUndecidedReturns(true)
+		fakeConfig.TelemetryEndpointReturns("https://telemetry.example.com")
+		commandName = "apps"
+	})
+
+	JustBeforeEach(func() {
+		AskForTelemetryConsent(fakeConfig, testUI, commandName)
+	})
+
+	Context("when the user agrees", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("enables telemetry", func() {
+			Expect(testUI.Out).To(Say(`Send anonymous usage statistics to https://telemetry\.example\.com\?`))
+			Expect(testUI.Out).To(Say(`You can change this with 'faceman config set telemetry \(true \| false\)'\.`))
+			Expect(fakeConfig.SetTelemetryCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetTelemetryArgsForCall(0)).To(BeTrue())
+		})
+	})
+
+	Context("when the user accepts the default", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("disables telemetry", func() {
+			Expect(fakeConfig.SetTelemetryCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetTelemetryArgsForCall(0)).To(BeFalse())
+		})
+	})
+
+	Context("when the user has already decided", func() {
+		BeforeEach(func() {
+			fakeConfig.TelemetryUndecidedReturns(false)
+		})
+
+		It("does not ask", func() {
+			Expect(testUI.Out).ToNot(Say("usage statistics"))
+			Expect(fakeConfig.SetTelemetryCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the output is not a terminal", func() {
+		BeforeEach(func() {
+			fakeConfig.IsTTYReturns(false)
+		})
+
+		It("does not ask", func() {
+			Expect(testUI.Out).ToNot(Say("usage statistics"))
+			Expect(fakeConfig.SetTelemetryCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the command is offline", func() {
+		BeforeEach(func() {
+			commandName = "version"
+		})
+
+		It("does not ask", func() {
+			Expect(fakeConfig.SetTelemetryCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,202 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/tracing"
+)
+
+const (
+	// TelemetryBatchSize is the number of command runs that are batched before
+	// they are sent.
+	TelemetryBatchSize = 20
+
+	// TelemetrySendWait is how long the CLI waits at exit for a batch that is
+	// still being sent.
+	TelemetrySendWait = 500 * time.Millisecond
+
+	// maxQueuedTelemetryEvents bounds the batch file when the endpoint cannot
+	// be reached. The oldest events are dropped first.
+	maxQueuedTelemetryEvents = 100
+)
+
+// TelemetryEvent is the anonymous record of a command run. It contains no
+// arguments, flag values, names or URLs.
+type TelemetryEvent struct {
+	Command    string `json:"command"`
+	DurationMS int64  `json:"duration_ms"`
+	ErrorClass string `json:"error_class,omitempty"`
+	CLIVersion string `json:"cli_version"`
+	OS         string `json:"os"`
+}
+
+// TelemetryReporter is a tracing exporter that batches the command name,
+// duration and error class of every command run in a file. A full batch is
+// sent to the telemetry endpoint in the background while the next command
+// runs. Telemetry must never get in the way of a command, so failing to batch
+// or send the events is not reported, and a batch that is still being sent
+// when the command is done is left for the next command to send.
+type TelemetryReporter struct {
+	Endpoint   string
+	QueueFile  string
+	CLIVersion string
+	BatchSize  int
+	SendWait   time.Duration
+	HTTPClient *http.Client
+
+	// sending receives the number of events of the background send once it
+	// succeeded, or 0 when it failed.
+	sending chan int
+}
+
+// NewTelemetryReporter returns a pointer to a TelemetryReporter that sends
+// batches of TelemetryBatchSize events.
+func NewTelemetryReporter(endpoint string, queueFile string, cliVersion string) *TelemetryReporter {
+	return &TelemetryReporter{
+		Endpoint:   endpoint,
+		QueueFile:  queueFile,
+		CLIVersion: cliVersion,
+		BatchSize:  TelemetryBatchSize,
+		SendWait:   TelemetrySendWait,
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Start sends the batch in the background when it is full, so that the
+// command does not wait for the telemetry endpoint.
+func (reporter *TelemetryReporter) Start() {
+	events := reporter.queuedEvents()
+	if len(events) < reporter.BatchSize {
+		return
+	}
+
+	sending := make(chan int, 1)
+	reporter.sending = sending
+	go func() {
+		if reporter.send(events) != nil {
+			sending <- 0
+			return
+		}
+		sending <- len(events)
+	}()
+}
+
+// Export adds the command span to the batch, without the events that the
+// background send delivered.
+func (reporter *TelemetryReporter) Export(spans []tracing.Span) error {
+	var root *tracing.Span
+	for i, span := range spans {
+		if _, ok := span.Attributes["cf.command"].(string); ok && span.ParentSpanID == "" {
+			root = &spans[i]
+			break
+		}
+	}
+	if root == nil {
+		return nil
+	}
+
+	events := reporter.queuedEvents()
+	if sent := reporter.sentEvents(); sent <= len(events) {
+		events = events[sent:]
+	}
+
+	errorClass, _ := root.Attributes["cf.error_class"].(string)
+	events = append(events, TelemetryEvent{
+		Command:    root.Attributes["cf.command"].(string),
+		DurationMS: int64(root.EndTime.Sub(root.StartTime) / time.Millisecond),
+		ErrorClass: errorClass,
+		CLIVersion: reporter.CLIVersion,
+		OS:         runtime.GOOS,
+	})
+
+	if len(events) > maxQueuedTelemetryEvents {
+		events = events[len(events)-maxQueuedTelemetryEvents:]
+	}
+
+	reporter.queueEvents(events)
+	return nil
+}
+
+// sentEvents returns the number of events that the background send delivered.
+// It waits at most SendWait for the send to finish.
+func (reporter *TelemetryReporter) sentEvents() int {
+	if reporter.sending == nil {
+		return 0
+	}
+	defer func() { reporter.sending = nil }()
+
+	select {
+	case sent := <-reporter.sending:
+		return sent
+	case <-time.After(reporter.SendWait):
+		return 0
+	}
+}
+
+func (reporter *TelemetryReporter) queuedEvents() []TelemetryEvent {
+	contents, err := ioutil.ReadFile(reporter.QueueFile)
+	if err != nil {
+		return nil
+	}
+
+	var events []TelemetryEvent
+	if json.Unmarshal(contents, &events) != nil {
+		return nil
+	}
+	return events
+}
+
+func (reporter *TelemetryReporter) queueEvents(events []TelemetryEvent) {
+	if len(events) == 0 {
+		_ = os.Remove(reporter.QueueFile)
+		return
+	}
+
+	contents, err := json.Marshal(events)
+	if err != nil {
+		return
+	}
+	_ = ioutil.WriteFile(reporter.QueueFile, contents, 0600)
+}
+
+func (reporter *TelemetryReporter) send(events []TelemetryEvent) error {
+	body, err := json.Marshal(map[string]interface{}{"events": events})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, reporter.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := reporter.HTTPClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("sending telemetry to %s failed with status %s", reporter.Endpoint, response.Status)
+	}
+	return nil
+}
+
+// ErrorClass returns the type of the error without its message, which can
+// contain app, org or user names, so that it can be part of a TelemetryEvent.
+func ErrorClass(err error) string {
+	if err == nil {
+		return ""
+	}
+	return strings.TrimPrefix(reflect.TypeOf(err).String(), "*")
+}
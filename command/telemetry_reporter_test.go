@@ -0,0 +1,180 @@
+package command_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tracing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("TelemetryReporter", func() {
+	var (
+		tempDir   string
+		queueFile string
+		server    *Server
+		reporter  *TelemetryReporter
+		spans     []tracing.Span
+	)
+
+	queuedEvents := func() []TelemetryEvent {
+		contents, err := ioutil.ReadFile(queueFile)
+		Expect(err).ToNot(HaveOccurred())
+
+		var events []TelemetryEvent
+		Expect(json.Unmarshal(contents, &events)).To(Succeed())
+		return events
+	}
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "telemetry-reporter-test")
+		Expect(err).ToNot(HaveOccurred())
+		queueFile = filepath.Join(tempDir, "telemetry.json")
+
+		server = NewServer()
+		reporter = NewTelemetryReporter(server.URL()+"/events", queueFile, "6.32.0")
+		reporter.BatchSize = 2
+
+		start := time.Unix(1000, 0)
+		spans = []tracing.Span{
+			{SpanID: "request", ParentSpanID: "command", Kind: tracing.SpanKindClient, StartTime: start, EndTime: start.Add(time.Second)},
+			{
+				SpanID:     "command",
+				Name:       "cf app",
+				Kind:       tracing.SpanKindInternal,
+				StartTime:  start,
+				EndTime:    start.Add(1500 * time.Millisecond),
+				Attributes: map[string]interface{}{"cf.command": "app", "cf.error_class": "command.ApplicationNotFoundError"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	It("batches the anonymous events without sending them at exit", func() {
+		Expect(reporter.Export(spans)).To(Succeed())
+		Expect(queuedEvents()).To(Equal([]TelemetryEvent{{
+			Command:    "app",
+			DurationMS: 1500,
+			ErrorClass: "command.ApplicationNotFoundError",
+			CLIVersion: "6.32.0",
+			OS:         runtime.GOOS,
+		}}))
+
+		Expect(reporter.Export(spans)).To(Succeed())
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+		Expect(queuedEvents()).To(HaveLen(2))
+	})
+
+	It("does not send the batch before it is full", func() {
+		Expect(reporter.Export(spans)).To(Succeed())
+
+		reporter = NewTelemetryReporter(server.URL()+"/events", queueFile, "6.32.0")
+		reporter.BatchSize = 2
+		reporter.Start()
+		Expect(reporter.Export(spans)).To(Succeed())
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+		Expect(queuedEvents()).To(HaveLen(2))
+	})
+
+	It("sends the full batch in the background and keeps only the new event", func() {
+		Expect(reporter.Export(spans)).To(Succeed())
+		Expect(reporter.Export(spans)).To(Succeed())
+
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/events"),
+				VerifyContentType("application/json"),
+				func(_ http.ResponseWriter, request *http.Request) {
+					var body struct {
+						Events []TelemetryEvent `json:"events"`
+					}
+					Expect(json.NewDecoder(request.Body).Decode(&body)).To(Succeed())
+					Expect(body.Events).To(HaveLen(2))
+				},
+				RespondWith(http.StatusAccepted, nil),
+			),
+		)
+
+		reporter.Start()
+		Expect(reporter.Export(spans)).To(Succeed())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(queuedEvents()).To(HaveLen(1))
+	})
+
+	Context("when the endpoint rejects the batch", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(RespondWith(http.StatusInternalServerError, nil))
+		})
+
+		It("keeps the events for the next batch without failing", func() {
+			Expect(reporter.Export(spans)).To(Succeed())
+			Expect(reporter.Export(spans)).To(Succeed())
+
+			reporter.Start()
+			Expect(reporter.Export(spans)).To(Succeed())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+			Expect(queuedEvents()).To(HaveLen(3))
+		})
+	})
+
+	Context("when the endpoint is slow", func() {
+		var release chan struct{}
+
+		BeforeEach(func() {
+			release = make(chan struct{})
+			server.AppendHandlers(func(http.ResponseWriter, *http.Request) {
+				<-release
+			})
+			reporter.SendWait = 10 * time.Millisecond
+		})
+
+		AfterEach(func() {
+			close(release)
+		})
+
+		It("does not wait for the batch to be sent and keeps the events", func() {
+			Expect(reporter.Export(spans)).To(Succeed())
+			Expect(reporter.Export(spans)).To(Succeed())
+
+			reporter.Start()
+			exportStart := time.Now()
+			Expect(reporter.Export(spans)).To(Succeed())
+			Expect(time.Since(exportStart)).To(BeNumerically("<", time.Second))
+			Expect(queuedEvents()).To(HaveLen(3))
+		})
+	})
+
+	Context("when there is no command span", func() {
+		BeforeEach(func() {
+			spans = spans[:1]
+		})
+
+		It("does not record anything", func() {
+			Expect(reporter.Export(spans)).To(Succeed())
+			Expect(queueFile).ToNot(BeAnExistingFile())
+		})
+	})
+})
+
+var _ = Describe("ErrorClass", func() {
+	It("returns the type of the error without its message", func() {
+		Expect(ErrorClass(ApplicationNotFoundError{Name: "some-app"})).To(Equal("command.ApplicationNotFoundError"))
+		Expect(ErrorClass(errors.New("some-app failed"))).To(Equal("errors.errorString"))
+		Expect(ErrorClass(nil)).To(BeEmpty())
+	})
+})
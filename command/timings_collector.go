@@ -0,0 +1,90 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/tracing"
+)
+
+var guidRegexp = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+// TimingsCollector is a tracing exporter that displays how long each phase of
+// a command took when the --timings flag is provided. Phases are listed in the
+// order they started, followed by the API requests grouped by endpoint, the
+// slowest first.
+type TimingsCollector struct {
+	Writer io.Writer
+}
+
+// NewTimingsCollector returns a pointer to a TimingsCollector that writes the
+// summary to the provided writer.
+func NewTimingsCollector(writer io.Writer) *TimingsCollector {
+	return &TimingsCollector{Writer: writer}
+}
+
+type timing struct {
+	name  string
+	count int
+	total time.Duration
+}
+
+// Export writes the summary table of the spans.
+func (collector TimingsCollector) Export(spans []tracing.Span) error {
+	sorted := make([]tracing.Span, len(spans))
+	copy(sorted, spans)
+	sort.SliceStable(sorted, func(i int, j int) bool {
+		return sorted[i].StartTime.Before(sorted[j].StartTime)
+	})
+
+	var phases, requests []*timing
+	byName := map[string]*timing{}
+	for _, span := range sorted {
+		name := span.Name
+		list := &phases
+		if span.Kind == tracing.SpanKindClient {
+			name = endpointName(span)
+			list = &requests
+		}
+
+		t, ok := byName[name]
+		if !ok {
+			t = &timing{name: name}
+			byName[name] = t
+			*list = append(*list, t)
+		}
+		t.count++
+		t.total += span.EndTime.Sub(span.StartTime)
+	}
+
+	sort.SliceStable(requests, func(i int, j int) bool {
+		return requests[i].total > requests[j].total
+	})
+
+	writer := tabwriter.NewWriter(collector.Writer, 0, 4, 3, ' ', 0)
+	fmt.Fprintln(writer)
+	fmt.Fprintln(writer, "timings\tcount\ttotal")
+	for _, t := range append(phases, requests...) {
+		fmt.Fprintf(writer, "%s\t%d\t%s\n", t.name, t.count, t.total.Round(time.Millisecond))
+	}
+	return writer.Flush()
+}
+
+// endpointName returns the method and path of the request with GUIDs replaced,
+// so requests to the same endpoint are grouped together.
+func endpointName(span tracing.Span) string {
+	method, _ := span.Attributes["http.method"].(string)
+	rawURL, _ := span.Attributes["http.url"].(string)
+
+	path := rawURL
+	if parsedURL, err := url.Parse(rawURL); err == nil {
+		path = parsedURL.Path
+	}
+
+	return method + " " + guidRegexp.ReplaceAllString(path, ":guid")
+}
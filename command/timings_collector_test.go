@@ -0,0 +1,61 @@
+package command_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tracing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("TimingsCollector", func() {
+	var (
+		buffer    *Buffer
+		collector *TimingsCollector
+	)
+
+	BeforeEach(func() {
+		buffer = NewBuffer()
+		collector = NewTimingsCollector(buffer)
+	})
+
+	span := func(name string, kind tracing.SpanKind, start int, duration time.Duration, attributes map[string]interface{}) tracing.Span {
+		startTime := time.Unix(int64(start), 0)
+		return tracing.Span{
+			Name:       name,
+			Kind:       kind,
+			StartTime:  startTime,
+			EndTime:    startTime.Add(duration),
+			Attributes: attributes,
+		}
+	}
+
+	request := func(start int, duration time.Duration, method string, url string) tracing.Span {
+		return span("HTTP "+method, tracing.SpanKindClient, start, duration, map[string]interface{}{
+			"http.method": method,
+			"http.url":    url,
+		})
+	}
+
+	It("displays the phases in order followed by the requests grouped by endpoint", func() {
+		err := collector.Export([]tracing.Span{
+			request(2, 100*time.Millisecond, "GET", "https://api.example.com/v2/apps/2a8ca0ac-4b5f-4b0a-9c3e-1f6a0e0d5c11"),
+			span("cf push", tracing.SpanKindInternal, 1, 3*time.Second, nil),
+			span("upload bits", tracing.SpanKindInternal, 3, 1500*time.Millisecond, nil),
+			request(4, 300*time.Millisecond, "PUT", "https://api.example.com/v2/apps/2a8ca0ac-4b5f-4b0a-9c3e-1f6a0e0d5c11/bits?async=true"),
+			span("parse flags", tracing.SpanKindInternal, 0, 12*time.Millisecond, nil),
+			request(5, 50*time.Millisecond, "GET", "https://api.example.com/v2/apps/5b1e0f0e-8b1f-4c5e-a6a3-0d1c2b3a4f55"),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(buffer).To(Say(`timings\s+count\s+total`))
+		Expect(buffer).To(Say(`parse flags\s+1\s+12ms`))
+		Expect(buffer).To(Say(`cf push\s+1\s+3s`))
+		Expect(buffer).To(Say(`upload bits\s+1\s+1.5s`))
+		Expect(buffer).To(Say(`PUT /v2/apps/:guid/bits\s+1\s+300ms`))
+		Expect(buffer).To(Say(`GET /v2/apps/:guid\s+2\s+150ms`))
+	})
+})
@@ -9,7 +9,10 @@ import (
 
 // UI is the interface to STDOUT
 type UI interface {
+	ClearScreen()
 	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
+	DisplayDiffAddition(text string)
+	DisplayDiffRemoval(text string)
 	DisplayError(err error)
 	DisplayHeader(text string)
 	DisplayInstancesTableForApp(table [][]string)
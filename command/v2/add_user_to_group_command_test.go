@@ -0,0 +1,111 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("add-user-to-group Command", func() {
+	var (
+		cmd             AddUserToGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAddUserToGroupActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAddUserToGroupActor)
+
+		cmd = AddUserToGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "some-target-user"
+		cmd.RequiredArgs.GroupName = "cloud_controller.admin"
+		cmd.Origin = "ldap"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --zone-id and --zone-subdomain are provided", func() {
+		BeforeEach(func() {
+			cmd.ZoneID = "some-zone-id"
+			cmd.ZoneSubdomain = "some-subdomain"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--zone-id", Arg2: "--zone-subdomain"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.AddUserToUAAGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.AddUserToUAAGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	It("adds the user to the group", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Adding user some-target-user to UAA group cloud_controller\.admin as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		username, origin, groupName := fakeActor.AddUserToUAAGroupArgsForCall(0)
+		Expect(username).To(Equal("some-target-user"))
+		Expect(origin).To(Equal("ldap"))
+		Expect(groupName).To(Equal("cloud_controller.admin"))
+	})
+
+	Context("when the user is already a member of the group", func() {
+		BeforeEach(func() {
+			fakeActor.AddUserToUAAGroupReturns(v2action.UAAUserAlreadyInGroupError{Username: "some-target-user", GroupName: "cloud_controller.admin"})
+		})
+
+		It("displays that the user is already a member", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`User some-target-user is already a member of UAA group cloud_controller\.admin\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when the group does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.AddUserToUAAGroupReturns(v2action.UAAGroupNotFoundError{Name: "cloud_controller.admin"})
+		})
+
+		It("returns a UAAGroupNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.UAAGroupNotFoundError{Name: "cloud_controller.admin"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -60,10 +60,13 @@ func (cmd *ApiCommand) Execute(args []string) error {
 		return nil
 	}
 
-	cmd.UI.DisplayKeyValueTable("", [][]string{
+	table := [][]string{
 		{cmd.UI.TranslateText("api endpoint:"), cmd.Config.Target()},
 		{cmd.UI.TranslateText("api version:"), cmd.Config.APIVersion()},
-	}, 3)
+	}
+	cmd.UI.DisplayKeyValueTable("", appendEndpointRows(cmd.UI, cmd.Config, table), 3)
+
+	shared.WarnIfCLIVersionBelowMinimum(cmd.Config, cmd.UI)
 
 	user, err := cmd.Config.CurrentUser()
 	if user.Name == "" {
@@ -106,6 +109,18 @@ func (cmd *ApiCommand) setAPI() error {
 	return nil
 }
 
+// appendEndpointRows adds the UAA and Doppler endpoints advertised by the
+// targeted Cloud Controller to table, skipping any that are not set.
+func appendEndpointRows(ui command.UI, config command.Config, table [][]string) [][]string {
+	if uaa := config.UAAEndpoint(); uaa != "" {
+		table = append(table, []string{ui.TranslateText("uaa endpoint:"), uaa})
+	}
+	if doppler := config.DopplerEndpoint(); doppler != "" {
+		table = append(table, []string{ui.TranslateText("doppler endpoint:"), doppler})
+	}
+	return table
+}
+
 func processURL(apiURL string) string {
 	if !strings.HasPrefix(apiURL, "http") {
 		return fmt.Sprintf("https://%s", apiURL)
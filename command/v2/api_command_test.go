@@ -70,6 +70,45 @@ var _ = Describe("api Command", func() {
 				Expect(testUI.Out).To(Say("api endpoint:\\s+some-api-target"))
 				Expect(testUI.Out).To(Say("api version:\\s+some-version"))
 			})
+
+			Context("when the UAA and Doppler endpoints are set", func() {
+				BeforeEach(func() {
+					fakeConfig.UAAEndpointReturns("https://uaa.some-domain.com")
+					fakeConfig.DopplerEndpointReturns("wss://doppler.some-domain.com:443")
+				})
+
+				It("outputs the endpoints", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("api endpoint:\\s+some-api-target"))
+					Expect(testUI.Out).To(Say("api version:\\s+some-version"))
+					Expect(testUI.Out).To(Say("uaa endpoint:\\s+https://uaa.some-domain.com"))
+					Expect(testUI.Out).To(Say("doppler endpoint:\\s+wss://doppler.some-domain.com:443"))
+				})
+			})
+
+			Context("when the CLI version is below the minimum supported version", func() {
+				BeforeEach(func() {
+					fakeConfig.BinaryVersionReturns("1.0.0")
+					fakeConfig.MinCLIVersionReturns("2.0.0")
+				})
+
+				It("displays a warning to upgrade the CLI", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Cloud Foundry API version some-version requires CLI version 2.0.0. You are currently on version 1.0.0. To upgrade your CLI, please visit: https://github.com/cloudfoundry/cli#downloads"))
+				})
+			})
+
+			Context("when the CLI version meets the minimum supported version", func() {
+				BeforeEach(func() {
+					fakeConfig.BinaryVersionReturns("2.0.0")
+					fakeConfig.MinCLIVersionReturns("2.0.0")
+				})
+
+				It("does not display a warning", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Err).ToNot(Say("requires CLI version"))
+				})
+			})
 		})
 
 		Context("when passed a --unset", func() {
@@ -1,11 +1,18 @@
 package v2
 
 import (
+	"os"
+	"os/signal"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
 //go:generate counterfeiter . AppActor
@@ -15,16 +22,25 @@ type AppActor interface {
 	GetApplicationSummaryByNameAndSpace(name string, spaceGUID string) (v2action.ApplicationSummary, v2action.Warnings, error)
 }
 
+//go:generate counterfeiter . AppActorV3
+
+type AppActorV3 interface {
+	GetApplicationProcessesByNameAndSpace(appName string, spaceGUID string) ([]v3action.Process, v3action.Warnings, error)
+}
+
 type AppCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
 	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME"`
+	Watch           bool         `long:"watch" description:"Refresh the app's health and status until interrupted with Ctrl-C"`
+	Interval        int          `long:"interval" default:"5" description:"Number of seconds between refreshes when watching"`
+	usage           interface{}  `usage:"CF_NAME app APP_NAME [--guid | --watch [--interval SECONDS]]"`
 	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       AppActor
+	ActorV3     AppActorV3
 }
 
 func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
@@ -38,10 +54,33 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
 
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
 	return nil
 }
 
 func (cmd AppCommand) Execute(args []string) error {
+	if cmd.GUID && cmd.Watch {
+		return command.ArgumentCombinationError{
+			Arg1: "--guid",
+			Arg2: "--watch",
+		}
+	}
+
+	if cmd.Watch && cmd.Interval < 1 {
+		return command.ParseArgumentError{
+			ArgumentName: "--interval",
+			ExpectedType: "a positive integer",
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -51,6 +90,10 @@ func (cmd AppCommand) Execute(args []string) error {
 		return cmd.displayAppGUID()
 	}
 
+	if cmd.Watch {
+		return cmd.watchAppSummary()
+	}
+
 	return cmd.displayAppSummary()
 }
 
@@ -65,6 +108,33 @@ func (cmd AppCommand) displayAppGUID() error {
 	return nil
 }
 
+// watchAppSummary redraws the app summary every Interval seconds until the
+// process is interrupted or a refresh fails.
+func (cmd AppCommand) watchAppSummary() error {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	for {
+		cmd.UI.ClearScreen()
+		err := cmd.displayAppSummary()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Refreshing every {{.Interval}} seconds. Press Ctrl-C to stop.", map[string]interface{}{
+			"Interval": cmd.Interval,
+		})
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(time.Duration(cmd.Interval) * time.Second):
+		}
+	}
+}
+
 func (cmd AppCommand) displayAppSummary() error {
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
@@ -89,5 +159,56 @@ func (cmd AppCommand) displayAppSummary() error {
 
 	shared.DisplayAppSummary(cmd.UI, appSummary, false)
 
+	if cmd.ActorV3 != nil {
+		return cmd.displayProcessHealthChecks()
+	}
+
 	return nil
 }
+
+// displayProcessHealthChecks displays the health check and readiness health
+// check of each of the app's processes.
+func (cmd AppCommand) displayProcessHealthChecks() error {
+	processes, warnings, err := cmd.ActorV3.GetApplicationProcessesByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	if len(processes) == 0 {
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("health check"),
+			cmd.UI.TranslateText("readiness check"),
+		},
+	}
+	for _, process := range processes {
+		table = append(table, []string{
+			process.Type,
+			cmd.formatProcessHealthCheck(process.HealthCheck),
+			cmd.formatProcessHealthCheck(process.ReadinessHealthCheck),
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
+
+func (cmd AppCommand) formatProcessHealthCheck(healthCheck ccv3.ProcessHealthCheck) string {
+	formatted := healthCheck.Type
+	if healthCheck.Type == "http" && healthCheck.Endpoint != "" {
+		formatted += " " + healthCheck.Endpoint
+	}
+	if healthCheck.Interval > 0 {
+		formatted += " " + cmd.UI.TranslateText("(every {{.Interval}}s)", map[string]interface{}{
+			"Interval": healthCheck.Interval,
+		})
+	}
+	return formatted
+}
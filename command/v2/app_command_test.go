@@ -6,7 +6,9 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/v2"
@@ -326,6 +328,62 @@ var _ = Describe("App Command", func() {
 				})
 			})
 
+			Context("when the v3 API is available", func() {
+				var fakeActorV3 *v2fakes.FakeAppActorV3
+
+				BeforeEach(func() {
+					fakeActorV3 = new(v2fakes.FakeAppActorV3)
+					cmd.ActorV3 = fakeActorV3
+
+					fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v2action.ApplicationSummary{
+						Application: v2action.Application{Name: "some-app"},
+					}, nil, nil)
+				})
+
+				Context("when getting the processes succeeds", func() {
+					BeforeEach(func() {
+						fakeActorV3.GetApplicationProcessesByNameAndSpaceReturns([]v3action.Process{
+							{
+								Type:                 "web",
+								HealthCheck:          ccv3.ProcessHealthCheck{Type: "http", Endpoint: "/health"},
+								ReadinessHealthCheck: ccv3.ProcessHealthCheck{Type: "http", Endpoint: "/ready", Interval: 5},
+							},
+							{
+								Type:        "worker",
+								HealthCheck: ccv3.ProcessHealthCheck{Type: "process"},
+							},
+						}, v3action.Warnings{"processes-warning"}, nil)
+					})
+
+					It("displays the health checks of each process", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("There are no running instances of this app"))
+						Expect(testUI.Out).To(Say(`process\s+health check\s+readiness check`))
+						Expect(testUI.Out).To(Say(`web\s+http /health\s+http /ready \(every 5s\)`))
+						Expect(testUI.Out).To(Say(`worker\s+process`))
+						Expect(testUI.Err).To(Say("processes-warning"))
+
+						appName, spaceGUID := fakeActorV3.GetApplicationProcessesByNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("some-app"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+					})
+				})
+
+				Context("when getting the processes fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("get processes error")
+						fakeActorV3.GetApplicationProcessesByNameAndSpaceReturns(nil, v3action.Warnings{"processes-warning"}, expectedErr)
+					})
+
+					It("displays the warnings and returns the error", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Err).To(Say("processes-warning"))
+					})
+				})
+			})
+
 			Context("when an error is encountered getting app summary", func() {
 				Context("when the error is not translatable", func() {
 					var expectedErr error
@@ -357,5 +415,71 @@ var _ = Describe("App Command", func() {
 				})
 			})
 		})
+
+		Context("when the --watch flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Watch = true
+				cmd.Interval = 1
+			})
+
+			Context("when the --guid flag is also provided", func() {
+				BeforeEach(func() {
+					cmd.GUID = true
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+						Arg1: "--guid",
+						Arg2: "--watch",
+					}))
+					Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the interval is not positive", func() {
+				BeforeEach(func() {
+					cmd.Interval = 0
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(command.ParseArgumentError{
+						ArgumentName: "--interval",
+						ExpectedType: "a positive integer",
+					}))
+					Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a refresh fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get app summary error")
+					fakeActor.GetApplicationSummaryByNameAndSpaceStub = func(string, string) (v2action.ApplicationSummary, v2action.Warnings, error) {
+						if fakeActor.GetApplicationSummaryByNameAndSpaceCallCount() > 1 {
+							return v2action.ApplicationSummary{}, v2action.Warnings{"refresh-warning"}, expectedErr
+						}
+						return v2action.ApplicationSummary{
+							Application: v2action.Application{
+								Name:  "some-app",
+								State: ccv2.ApplicationStarted,
+							},
+						}, v2action.Warnings{"summary-warning"}, nil
+					}
+				})
+
+				It("redraws the app summary until the refresh fails and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(2))
+
+					Expect(testUI.Out).To(Say("Showing health and status for app some-app in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say(`requested state:\s+started`))
+					Expect(testUI.Out).To(Say("Refreshing every 1 seconds. Press Ctrl-C to stop."))
+					Expect(testUI.Out).To(Say("Showing health and status for app some-app in org some-org / space some-space as some-user..."))
+					Expect(testUI.Err).To(Say("summary-warning"))
+					Expect(testUI.Err).To(Say("refresh-warning"))
+				})
+			})
+		})
 	})
 })
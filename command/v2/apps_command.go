@@ -1,22 +1,200 @@
 package v2
 
 import (
+	"fmt"
 	"os"
+	"regexp"
+	"strings"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"github.com/cloudfoundry/bytefmt"
 )
 
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	GetApplicationSummariesBySpace(spaceGUID string, filter v2action.ApplicationSummaryFilter) ([]v2action.ApplicationSummary, v2action.Warnings, error)
+	GetStack(guid string) (v2action.Stack, v2action.Warnings, error)
+}
+
 type AppsCommand struct {
-	usage           interface{} `usage:"CF_NAME apps"`
-	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+	State           flag.AppState `long:"state" description:"Only show apps in this state: started, stopped, or crashed (started apps with a crashed instance)"`
+	Buildpack       string        `long:"buildpack" description:"Only show apps whose buildpack contains this text"`
+	Name            string        `long:"name" description:"Only show apps whose name matches this regular expression"`
+	Wide            bool          `long:"wide" description:"Also show the GUID, stack, buildpack, droplet state and creation and update times of the apps"`
+	usage           interface{}   `usage:"CF_NAME apps [--state STATE] [--buildpack BUILDPACK] [--name NAME_REGEX] [--wide]"`
+	relatedCommands interface{}   `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
 }
 
-func (_ AppsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if command.UsesLegacyImplementation(cmd.Config, "apps", cmd.filtered(), cmd.Wide) {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ AppsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd AppsCommand) Execute(args []string) error {
+	if command.UsesLegacyImplementation(cmd.Config, "apps", cmd.filtered(), cmd.Wide) {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	filter := v2action.ApplicationSummaryFilter{
+		State:     cmd.State.State,
+		Buildpack: cmd.Buildpack,
+	}
+	if cmd.Name != "" {
+		namePattern, err := regexp.Compile(cmd.Name)
+		if err != nil {
+			return command.ParseArgumentError{
+				ArgumentName: "--name",
+				ExpectedType: "a valid regular expression",
+			}
+		}
+		filter.NamePattern = namePattern
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	summaries, warnings, err := cmd.Actor.GetApplicationSummariesBySpace(cmd.Config.TargetedSpace().GUID, filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return nil
+	}
+
+	header := []string{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("requested state"),
+		cmd.UI.TranslateText("instances"),
+		cmd.UI.TranslateText("memory"),
+		cmd.UI.TranslateText("disk"),
+		cmd.UI.TranslateText("urls"),
+	}
+	if cmd.Wide {
+		header = append(header,
+			cmd.UI.TranslateText("guid"),
+			cmd.UI.TranslateText("stack"),
+			cmd.UI.TranslateText("buildpack"),
+			cmd.UI.TranslateText("droplet state"),
+			cmd.UI.TranslateText("created"),
+			cmd.UI.TranslateText("updated"),
+		)
+	}
+	table := [][]string{header}
+	stackNames := map[string]string{}
+
+	for _, summary := range summaries {
+		var urls []string
+		for _, route := range summary.Routes {
+			urls = append(urls, route.String())
+		}
+
+		row := []string{
+			summary.Name,
+			strings.ToLower(string(summary.State)),
+			fmt.Sprintf("%d/%d", summary.StartingOrRunningInstanceCount(), summary.Instances),
+			bytefmt.ByteSize(uint64(summary.Memory) * bytefmt.MEGABYTE),
+			bytefmt.ByteSize(uint64(summary.DiskQuota) * bytefmt.MEGABYTE),
+			strings.Join(urls, ", "),
+		}
+		if cmd.Wide {
+			wideRow, err := cmd.wideColumns(summary, stackNames)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+			row = append(row, wideRow...)
+		}
+		table = append(table, row)
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
+
+// wideColumns returns the additional --wide columns of the app. Stack names
+// are cached in stackNames so that every stack is only retrieved once.
+func (cmd AppsCommand) wideColumns(summary v2action.ApplicationSummary, stackNames map[string]string) ([]string, error) {
+	stackName, ok := stackNames[summary.StackGUID]
+	if !ok && summary.StackGUID != "" {
+		stack, warnings, err := cmd.Actor.GetStack(summary.StackGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+		stackName = stack.Name
+		stackNames[summary.StackGUID] = stackName
+	}
+
+	buildpack := summary.Buildpack
+	if buildpack == "" {
+		buildpack = summary.DetectedBuildpack
+	}
+
+	return []string{
+		summary.GUID,
+		stackName,
+		buildpack,
+		strings.ToLower(string(summary.PackageState)),
+		formatAppTimestamp(summary.CreatedAt),
+		formatAppTimestamp(summary.UpdatedAt),
+	}, nil
+}
+
+// formatAppTimestamp formats t in RFC3339, or returns an empty string when t
+// is not set.
+func formatAppTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// filtered returns true if any of the filter flags were provided.
+func (cmd AppsCommand) filtered() bool {
+	return cmd.State.State != "" || cmd.Buildpack != "" || cmd.Name != ""
+}
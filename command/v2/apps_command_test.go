@@ -0,0 +1,223 @@
+package v2_test
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the name filter is not a valid regular expression", func() {
+		BeforeEach(func() {
+			cmd.Name = "web-("
+			fakeConfig.ExperimentalReturns(false)
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--name",
+				ExpectedType: "a valid regular expression",
+			}))
+			Expect(fakeActor.GetApplicationSummariesBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the apps fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.GetApplicationSummariesBySpaceReturns(nil, v2action.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("displays the warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
+		Context("when no apps match", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationSummariesBySpaceReturns(nil, v2action.Warnings{"some-warning"}, nil)
+			})
+
+			It("displays that no apps were found", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Getting apps in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("No apps found"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
+		Context("when apps match", func() {
+			BeforeEach(func() {
+				cmd.State = flag.AppState{State: "crashed"}
+				cmd.Buildpack = "java"
+				cmd.Name = "^api"
+				fakeConfig.ExperimentalReturns(false)
+
+				fakeActor.GetApplicationSummariesBySpaceReturns([]v2action.ApplicationSummary{
+					{
+						Application: v2action.Application{
+							Name:      "api",
+							State:     ccv2.ApplicationStarted,
+							Instances: 2,
+							Memory:    1024,
+							DiskQuota: 512,
+						},
+						RunningInstances: []v2action.ApplicationInstanceWithStats{
+							{State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning)},
+							{State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceCrashed)},
+						},
+						Routes: []v2action.Route{
+							{Host: "api", Domain: v2action.Domain{Name: "example.com"}},
+							{Host: "api", Domain: v2action.Domain{Name: "example.com"}, Path: "/v2"},
+						},
+					},
+				}, v2action.Warnings{"some-warning"}, nil)
+			})
+
+			It("passes the filters to the actor and displays the apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Getting apps in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`name\s+requested state\s+instances\s+memory\s+disk\s+urls`))
+				Expect(testUI.Out).To(Say(`api\s+started\s+1/2\s+1G\s+512M\s+api.example.com, api.example.com/v2`))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.GetApplicationSummariesBySpaceCallCount()).To(Equal(1))
+				spaceGUID, filter := fakeActor.GetApplicationSummariesBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(filter).To(Equal(v2action.ApplicationSummaryFilter{
+					State:       "crashed",
+					Buildpack:   "java",
+					NamePattern: regexp.MustCompile("^api"),
+				}))
+			})
+		})
+
+		Context("when --wide is provided", func() {
+			BeforeEach(func() {
+				cmd.Wide = true
+				fakeConfig.ExperimentalReturns(false)
+
+				fakeActor.GetApplicationSummariesBySpaceReturns([]v2action.ApplicationSummary{
+					{
+						Application: v2action.Application{
+							GUID:         "api-guid",
+							Name:         "api",
+							Buildpack:    "java_buildpack",
+							CreatedAt:    time.Date(2017, 4, 1, 10, 0, 0, 0, time.UTC),
+							UpdatedAt:    time.Date(2017, 4, 2, 11, 30, 0, 0, time.UTC),
+							PackageState: ccv2.ApplicationPackageStaged,
+							StackGUID:    "stack-guid",
+							State:        ccv2.ApplicationStarted,
+						},
+					},
+					{
+						Application: v2action.Application{
+							GUID:              "worker-guid",
+							Name:              "worker",
+							DetectedBuildpack: "ruby_buildpack",
+							CreatedAt:         time.Date(2017, 4, 3, 10, 0, 0, 0, time.UTC),
+							PackageState:      ccv2.ApplicationPackagePending,
+							StackGUID:         "stack-guid",
+							State:             ccv2.ApplicationStopped,
+						},
+					},
+				}, nil, nil)
+				fakeActor.GetStackReturns(v2action.Stack{Name: "cflinuxfs2"}, v2action.Warnings{"stack-warning"}, nil)
+			})
+
+			It("displays the additional columns and retrieves every stack once", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`name\s+requested state\s+instances\s+memory\s+disk\s+urls\s+guid\s+stack\s+buildpack\s+droplet state\s+created\s+updated`))
+				Expect(testUI.Out).To(Say(`api\s+started\s+0/0\s+0\s+0\s+api-guid\s+cflinuxfs2\s+java_buildpack\s+staged\s+2017-04-01T10:00:00Z\s+2017-04-02T11:30:00Z`))
+				Expect(testUI.Out).To(Say(`worker\s+stopped\s+0/0\s+0\s+0\s+worker-guid\s+cflinuxfs2\s+ruby_buildpack\s+pending\s+2017-04-03T10:00:00Z\s*\n`))
+				Expect(testUI.Err).To(Say("stack-warning"))
+
+				Expect(fakeActor.GetStackCallCount()).To(Equal(1))
+				Expect(fakeActor.GetStackArgsForCall(0)).To(Equal("stack-guid"))
+			})
+
+			Context("when getting a stack fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetStackReturns(v2action.Stack{}, nil, errors.New("stack-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("stack-error"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,97 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . AttachAutoscalingPolicyActor
+
+type AttachAutoscalingPolicyActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . AttachAutoscalingPolicyAutoscalerActor
+
+type AttachAutoscalingPolicyAutoscalerActor interface {
+	AttachApplicationPolicy(appGUID string, policyPath string) error
+}
+
+type AttachAutoscalingPolicyCommand struct {
+	RequiredArgs    flag.AttachAutoscalingPolicyArgs `positional-args:"yes"`
+	usage           interface{}                      `usage:"CF_NAME attach-autoscaling-policy APP_NAME PATH_TO_POLICY_FILE\n\n   The App Autoscaler API is derived from the targeted API, or set with CF_AUTOSCALER_API.\n\nEXAMPLES:\n   CF_NAME attach-autoscaling-policy my-app ./policy.json"`
+	relatedCommands interface{}                      `related_commands:"autoscaling-history, autoscaling-metrics, bind-service"`
+
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	Actor           AttachAutoscalingPolicyActor
+	AutoscalerActor AttachAutoscalingPolicyAutoscalerActor
+}
+
+func (cmd *AttachAutoscalingPolicyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	autoscalerClient, err := shared.NewAutoscalerClient(config, ui, uaaClient)
+	if err != nil {
+		return err
+	}
+	cmd.AutoscalerActor = autoscaleraction.NewActor(autoscalerClient)
+
+	return nil
+}
+
+func (cmd AttachAutoscalingPolicyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Attaching scaling policy for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.AutoscalerActor.AttachApplicationPolicy(app.GUID, string(cmd.RequiredArgs.PathToPolicy))
+	if err != nil {
+		return handleAutoscalerError(err, app.Name)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// handleAutoscalerError converts errors of the App Autoscaler actor, naming
+// the application the App Autoscaler does not know about.
+func handleAutoscalerError(err error, appName string) error {
+	if _, ok := err.(autoscaleraction.ApplicationNotAutoscaledError); ok {
+		return shared.ApplicationNotAutoscaledError{AppName: appName}
+	}
+	return shared.HandleError(err)
+}
@@ -0,0 +1,149 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("attach-autoscaling-policy Command", func() {
+	var (
+		cmd                 AttachAutoscalingPolicyCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v2fakes.FakeAttachAutoscalingPolicyActor
+		fakeAutoscalerActor *v2fakes.FakeAttachAutoscalingPolicyAutoscalerActor
+		binaryName          string
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAttachAutoscalingPolicyActor)
+		fakeAutoscalerActor = new(v2fakes.FakeAttachAutoscalingPolicyAutoscalerActor)
+
+		cmd = AttachAutoscalingPolicyCommand{
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			AutoscalerActor: fakeAutoscalerActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.PathToPolicy = flag.PathWithExistenceCheck("some-policy.json")
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"get-app-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{},
+				v2action.Warnings{"get-app-warning"},
+				v2action.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeAutoscalerActor.AttachApplicationPolicyCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the policy is attached", func() {
+		It("attaches the policy file to the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Attaching scaling policy for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			appGUID, policyPath := fakeAutoscalerActor.AttachApplicationPolicyArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(policyPath).To(Equal("some-policy.json"))
+		})
+	})
+
+	Context("when the app is not bound to the App Autoscaler", func() {
+		BeforeEach(func() {
+			fakeAutoscalerActor.AttachApplicationPolicyReturns(autoscaleraction.ApplicationNotAutoscaledError{AppGUID: "some-app-guid"})
+		})
+
+		It("returns an ApplicationNotAutoscaledError", func() {
+			Expect(executeErr).To(MatchError(shared.ApplicationNotAutoscaledError{AppName: "some-app"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when the policy is invalid", func() {
+		BeforeEach(func() {
+			fakeAutoscalerActor.AttachApplicationPolicyReturns(autoscaleraction.InvalidPolicyError{Path: "some-policy.json", Message: "some-violation"})
+		})
+
+		It("returns an InvalidAutoscalingPolicyError", func() {
+			Expect(executeErr).To(MatchError(shared.InvalidAutoscalingPolicyError{Path: "some-policy.json", Message: "some-violation"}))
+		})
+	})
+
+	Context("when attaching the policy fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("attach error")
+			fakeAutoscalerActor.AttachApplicationPolicyReturns(expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
@@ -10,7 +10,8 @@ import (
 
 type AuthCommand struct {
 	RequiredArgs    flag.Authentication `positional-args:"yes"`
-	usage           interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
+	Assertion       string              `long:"assertion" description:"JWT issued by an identity provider trusted by UAA, such as the OIDC token of a CI job"`
+	usage           interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth --assertion TOKEN\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth --assertion \"$CI_JOB_JWT\" (exchange a token issued by the CI system for a session)"`
 	relatedCommands interface{}         `related_commands:"api, login, target"`
 }
 
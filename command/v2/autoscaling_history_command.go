@@ -0,0 +1,138 @@
+package v2
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/autoscaler"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . AutoscalingHistoryActor
+
+type AutoscalingHistoryActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . AutoscalingHistoryAutoscalerActor
+
+type AutoscalingHistoryAutoscalerActor interface {
+	GetApplicationScalingHistory(appGUID string, ascending bool) ([]autoscaleraction.ScalingHistory, error)
+}
+
+type AutoscalingHistoryCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Ascending       bool         `long:"asc" description:"Display the oldest scaling events first"`
+	usage           interface{}  `usage:"CF_NAME autoscaling-history APP_NAME [--asc]"`
+	relatedCommands interface{}  `related_commands:"attach-autoscaling-policy, autoscaling-metrics, events"`
+
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	Actor           AutoscalingHistoryActor
+	AutoscalerActor AutoscalingHistoryAutoscalerActor
+}
+
+func (cmd *AutoscalingHistoryCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	autoscalerClient, err := shared.NewAutoscalerClient(config, ui, uaaClient)
+	if err != nil {
+		return err
+	}
+	cmd.AutoscalerActor = autoscaleraction.NewActor(autoscalerClient)
+
+	return nil
+}
+
+func (cmd AutoscalingHistoryCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting scaling history for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	histories, err := cmd.AutoscalerActor.GetApplicationScalingHistory(app.GUID, cmd.Ascending)
+	if err != nil {
+		return handleAutoscalerError(err, app.Name)
+	}
+
+	if len(histories) == 0 {
+		cmd.UI.DisplayText("No scaling events for app {{.AppName}}", map[string]interface{}{
+			"AppName": app.Name,
+		})
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("scaling type"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("reason"),
+			cmd.UI.TranslateText("error"),
+		},
+	}
+	for _, history := range histories {
+		table = append(table, []string{
+			history.Timestamp.Local().Format(eventTimestampFormat),
+			cmd.scalingType(history.ScalingType),
+			cmd.scalingStatus(history.Status),
+			strconv.Itoa(history.OldInstances) + "->" + strconv.Itoa(history.NewInstances),
+			history.Reason,
+			history.Error,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
+
+func (cmd AutoscalingHistoryCommand) scalingType(scalingType autoscaler.ScalingType) string {
+	if scalingType == autoscaler.ScalingTypeSchedule {
+		return cmd.UI.TranslateText("scheduled")
+	}
+	return cmd.UI.TranslateText("dynamic")
+}
+
+func (cmd AutoscalingHistoryCommand) scalingStatus(status autoscaler.ScalingStatus) string {
+	switch status {
+	case autoscaler.ScalingStatusFailed:
+		return cmd.UI.TranslateText("failed")
+	case autoscaler.ScalingStatusIgnored:
+		return cmd.UI.TranslateText("ignored")
+	default:
+		return cmd.UI.TranslateText("succeeded")
+	}
+}
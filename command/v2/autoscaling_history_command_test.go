@@ -0,0 +1,136 @@
+package v2_test
+
+import (
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/autoscaler"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("autoscaling-history Command", func() {
+	var (
+		cmd                 AutoscalingHistoryCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v2fakes.FakeAutoscalingHistoryActor
+		fakeAutoscalerActor *v2fakes.FakeAutoscalingHistoryAutoscalerActor
+		binaryName          string
+		executeErr          error
+		timestamp           time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAutoscalingHistoryActor)
+		fakeAutoscalerActor = new(v2fakes.FakeAutoscalingHistoryAutoscalerActor)
+
+		cmd = AutoscalingHistoryCommand{
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			AutoscalerActor: fakeAutoscalerActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"get-app-warning"},
+			nil,
+		)
+
+		timestamp = time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the app has been scaled", func() {
+		BeforeEach(func() {
+			cmd.Ascending = true
+			fakeAutoscalerActor.GetApplicationScalingHistoryReturns([]autoscaleraction.ScalingHistory{
+				{
+					Timestamp:    timestamp,
+					ScalingType:  autoscaler.ScalingTypeDynamic,
+					Status:       autoscaler.ScalingStatusSucceeded,
+					OldInstances: 1,
+					NewInstances: 2,
+					Reason:       "+1 instance(s) because memoryused > 500MB",
+				},
+				{
+					Timestamp:    timestamp,
+					ScalingType:  autoscaler.ScalingTypeSchedule,
+					Status:       autoscaler.ScalingStatusFailed,
+					OldInstances: 2,
+					NewInstances: 4,
+					Reason:       "schedule starts",
+					Error:        "quota exceeded",
+				},
+			}, nil)
+		})
+
+		It("displays the scaling events", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting scaling history for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`time\s+scaling type\s+status\s+instances\s+reason\s+error`))
+			Expect(testUI.Out).To(Say(`%s\s+dynamic\s+succeeded\s+1->2\s+\+1 instance\(s\) because memoryused > 500MB`, regexp.QuoteMeta(timestamp.Local().Format("2006-01-02T15:04:05.00-0700"))))
+			Expect(testUI.Out).To(Say(`scheduled\s+failed\s+2->4\s+schedule starts\s+quota exceeded`))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			appGUID, ascending := fakeAutoscalerActor.GetApplicationScalingHistoryArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(ascending).To(BeTrue())
+		})
+	})
+
+	Context("when the app has not been scaled", func() {
+		It("says there are no scaling events", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No scaling events for app some-app"))
+		})
+	})
+
+	Context("when the app is not bound to the App Autoscaler", func() {
+		BeforeEach(func() {
+			fakeAutoscalerActor.GetApplicationScalingHistoryReturns(nil, autoscaleraction.ApplicationNotAutoscaledError{AppGUID: "some-app-guid"})
+		})
+
+		It("returns an ApplicationNotAutoscaledError", func() {
+			Expect(executeErr).To(MatchError(shared.ApplicationNotAutoscaledError{AppName: "some-app"}))
+		})
+	})
+})
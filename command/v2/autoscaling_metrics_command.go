@@ -0,0 +1,113 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . AutoscalingMetricsActor
+
+type AutoscalingMetricsActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . AutoscalingMetricsAutoscalerActor
+
+type AutoscalingMetricsAutoscalerActor interface {
+	GetApplicationMetrics(appGUID string, metricType string, ascending bool) ([]autoscaleraction.Metric, error)
+}
+
+type AutoscalingMetricsCommand struct {
+	RequiredArgs    flag.AutoscalingMetricsArgs `positional-args:"yes"`
+	Ascending       bool                        `long:"asc" description:"Display the oldest samples first"`
+	usage           interface{}                 `usage:"CF_NAME autoscaling-metrics APP_NAME METRIC_TYPE [--asc]\n\n   METRIC_TYPE is one of memoryused, memoryutil, responsetime, throughput or cpu. Samples are aggregated across all instances of the app.\n\nEXAMPLES:\n   CF_NAME autoscaling-metrics my-app memoryused"`
+	relatedCommands interface{}                 `related_commands:"attach-autoscaling-policy, autoscaling-history"`
+
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	Actor           AutoscalingMetricsActor
+	AutoscalerActor AutoscalingMetricsAutoscalerActor
+}
+
+func (cmd *AutoscalingMetricsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	autoscalerClient, err := shared.NewAutoscalerClient(config, ui, uaaClient)
+	if err != nil {
+		return err
+	}
+	cmd.AutoscalerActor = autoscaleraction.NewActor(autoscalerClient)
+
+	return nil
+}
+
+func (cmd AutoscalingMetricsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting {{.MetricType}} metrics for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"MetricType": cmd.RequiredArgs.MetricType.Type,
+		"AppName":    cmd.RequiredArgs.AppName,
+		"OrgName":    cmd.Config.TargetedOrganization().Name,
+		"SpaceName":  cmd.Config.TargetedSpace().Name,
+		"Username":   user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	metrics, err := cmd.AutoscalerActor.GetApplicationMetrics(app.GUID, cmd.RequiredArgs.MetricType.Type, cmd.Ascending)
+	if err != nil {
+		return handleAutoscalerError(err, app.Name)
+	}
+
+	if len(metrics) == 0 {
+		cmd.UI.DisplayText("No {{.MetricType}} metrics for app {{.AppName}}", map[string]interface{}{
+			"MetricType": cmd.RequiredArgs.MetricType.Type,
+			"AppName":    app.Name,
+		})
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("metric"),
+			cmd.UI.TranslateText("value"),
+		},
+	}
+	for _, metric := range metrics {
+		table = append(table, []string{
+			metric.Timestamp.Local().Format(eventTimestampFormat),
+			metric.Name,
+			metric.Value + metric.Unit,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package v2_test
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("autoscaling-metrics Command", func() {
+	var (
+		cmd                 AutoscalingMetricsCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v2fakes.FakeAutoscalingMetricsActor
+		fakeAutoscalerActor *v2fakes.FakeAutoscalingMetricsAutoscalerActor
+		binaryName          string
+		executeErr          error
+		timestamp           time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAutoscalingMetricsActor)
+		fakeAutoscalerActor = new(v2fakes.FakeAutoscalingMetricsAutoscalerActor)
+
+		cmd = AutoscalingMetricsCommand{
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			AutoscalerActor: fakeAutoscalerActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.MetricType.Type = "memoryused"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"get-app-warning"},
+			nil,
+		)
+
+		timestamp = time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the metric has samples", func() {
+		BeforeEach(func() {
+			fakeAutoscalerActor.GetApplicationMetricsReturns([]autoscaleraction.Metric{
+				{Name: "memoryused", Value: "250", Unit: "MB", Timestamp: timestamp},
+			}, nil)
+		})
+
+		It("displays the samples", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting memoryused metrics for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`time\s+metric\s+value`))
+			Expect(testUI.Out).To(Say(`%s\s+memoryused\s+250MB`, regexp.QuoteMeta(timestamp.Local().Format("2006-01-02T15:04:05.00-0700"))))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			appGUID, metricType, ascending := fakeAutoscalerActor.GetApplicationMetricsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(metricType).To(Equal("memoryused"))
+			Expect(ascending).To(BeFalse())
+		})
+	})
+
+	Context("when the metric has no samples", func() {
+		It("says there are no metrics", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No memoryused metrics for app some-app"))
+		})
+	})
+
+	Context("when getting the metrics fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("metrics error")
+			fakeAutoscalerActor.GetApplicationMetricsReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
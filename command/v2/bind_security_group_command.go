@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 //go:generate counterfeiter . BindSecurityGroupActor
@@ -16,11 +17,14 @@ type BindSecurityGroupActor interface {
 	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
 	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
 	BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string) (v2action.Warnings, error)
+	BindSecurityGroupToSpaces(securityGroupGUID string, spaces []v2action.Space) []v2action.SecurityGroupSpaceBinding
 }
 
 type BindSecurityGroupCommand struct {
 	RequiredArgs    flag.BindSecurityGroupArgs `positional-args:"yes"`
-	usage           interface{}                `usage:"CF_NAME bind-security-group SECURITY_GROUP ORG [SPACE]\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
+	Spaces          []string                   `long:"space" description:"Space to bind the security group to; can be given more than once"`
+	AllSpaces       bool                       `long:"all-spaces" description:"Bind the security group to every space in the org (default when no space is given)"`
+	usage           interface{}                `usage:"CF_NAME bind-security-group SECURITY_GROUP ORG [SPACE] [--space SPACE]... [--all-spaces]\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
 	relatedCommands interface{}                `related_commands:"apps, bind-running-security-group, bind-staging-security-group, restart, security-groups"`
 
 	UI          command.UI
@@ -44,6 +48,15 @@ func (cmd *BindSecurityGroupCommand) Setup(config command.Config, ui command.UI)
 }
 
 func (cmd BindSecurityGroupCommand) Execute(args []string) error {
+	spaceNames := cmd.spaceNames()
+	if cmd.AllSpaces && len(spaceNames) > 0 {
+		spaceArg := "--space"
+		if cmd.RequiredArgs.SpaceName != "" {
+			spaceArg = "SPACE"
+		}
+		return command.ArgumentCombinationError{Arg1: "--all-spaces", Arg2: spaceArg}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
 	if err != nil {
 		return shared.HandleError(err)
@@ -67,14 +80,16 @@ func (cmd BindSecurityGroupCommand) Execute(args []string) error {
 	}
 
 	spacesToBind := []v2action.Space{}
-	if cmd.RequiredArgs.SpaceName != "" {
-		var space v2action.Space
-		space, warnings, err = cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.RequiredArgs.SpaceName)
-		cmd.UI.DisplayWarnings(warnings)
-		if err != nil {
-			return shared.HandleError(err)
+	if len(spaceNames) > 0 {
+		for _, spaceName := range spaceNames {
+			var space v2action.Space
+			space, warnings, err = cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+			spacesToBind = append(spacesToBind, space)
 		}
-		spacesToBind = append(spacesToBind, space)
 	} else {
 		var spaces []v2action.Space
 		spaces, warnings, err = cmd.Actor.GetOrganizationSpaces(org.GUID)
@@ -85,23 +100,80 @@ func (cmd BindSecurityGroupCommand) Execute(args []string) error {
 		spacesToBind = append(spacesToBind, spaces...)
 	}
 
-	for _, space := range spacesToBind {
-		cmd.UI.DisplayTextWithFlavor("Assigning security group {{.security_group}} to space {{.space}} in org {{.organization}} as {{.username}}...", map[string]interface{}{
-			"security_group": securityGroup.Name,
-			"space":          space.Name,
-			"organization":   org.Name,
-			"username":       user.Name,
-		})
-
-		warnings, err = cmd.Actor.BindSecurityGroupToSpace(securityGroup.GUID, space.GUID)
-		cmd.UI.DisplayWarnings(warnings)
+	if len(spacesToBind) == 1 {
+		err = cmd.bindToSpace(securityGroup, org, spacesToBind[0], user)
 		if err != nil {
-			return shared.HandleError(err)
+			return err
 		}
+	} else if len(spacesToBind) > 1 {
+		err = cmd.bindToSpaces(securityGroup, org, spacesToBind, user)
+		if err != nil {
+			return err
+		}
+	}
 
+	cmd.UI.DisplayText("TIP: Changes will not apply to existing running applications until they are restarted.")
+	return nil
+}
+
+// spaceNames returns the SPACE argument followed by every --space value,
+// without duplicates.
+func (cmd BindSecurityGroupCommand) spaceNames() []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, name := range append([]string{cmd.RequiredArgs.SpaceName}, cmd.Spaces...) {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+func (cmd BindSecurityGroupCommand) displayAssigning(securityGroup v2action.SecurityGroup, org v2action.Organization, space v2action.Space, user configv3.User) {
+	cmd.UI.DisplayTextWithFlavor("Assigning security group {{.security_group}} to space {{.space}} in org {{.organization}} as {{.username}}...", map[string]interface{}{
+		"security_group": securityGroup.Name,
+		"space":          space.Name,
+		"organization":   org.Name,
+		"username":       user.Name,
+	})
+}
+
+func (cmd BindSecurityGroupCommand) bindToSpace(securityGroup v2action.SecurityGroup, org v2action.Organization, space v2action.Space, user configv3.User) error {
+	cmd.displayAssigning(securityGroup, org, space, user)
+
+	warnings, err := cmd.Actor.BindSecurityGroupToSpace(securityGroup.GUID, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// bindToSpaces binds the security group to all spaces at once and reports
+// the outcome for each space in order. A failure for one space does not stop
+// the others from being bound.
+func (cmd BindSecurityGroupCommand) bindToSpaces(securityGroup v2action.SecurityGroup, org v2action.Organization, spaces []v2action.Space, user configv3.User) error {
+	var failedSpaces []string
+	for _, binding := range cmd.Actor.BindSecurityGroupToSpaces(securityGroup.GUID, spaces) {
+		cmd.displayAssigning(securityGroup, org, binding.Space, user)
+		cmd.UI.DisplayWarnings(binding.Warnings)
+		if binding.Err != nil {
+			cmd.UI.DisplayError(shared.HandleError(binding.Err))
+			failedSpaces = append(failedSpaces, binding.Space.Name)
+			continue
+		}
 		cmd.UI.DisplayOK()
 	}
 
-	cmd.UI.DisplayText("TIP: Changes will not apply to existing running applications until they are restarted.")
+	if len(failedSpaces) > 0 {
+		return shared.SecurityGroupBindingFailedError{
+			SecurityGroupName: securityGroup.Name,
+			SpaceNames:        failedSpaces,
+		}
+	}
 	return nil
 }
@@ -299,9 +299,16 @@ var _ = Describe("bind-security-group Command", func() {
 
 			Context("when no errors are encountered binding the security group to the spaces", func() {
 				BeforeEach(func() {
-					fakeActor.BindSecurityGroupToSpaceReturns(
-						v2action.Warnings{"bind security group to space warning"},
-						nil)
+					fakeActor.BindSecurityGroupToSpacesStub = func(securityGroupGUID string, spaces []v2action.Space) []v2action.SecurityGroupSpaceBinding {
+						var bindings []v2action.SecurityGroupSpaceBinding
+						for _, space := range spaces {
+							bindings = append(bindings, v2action.SecurityGroupSpaceBinding{
+								Space:    space,
+								Warnings: v2action.Warnings{"bind security group to space warning"},
+							})
+						}
+						return bindings
+					}
 				})
 
 				It("binds the security group to each space and displays all warnings", func() {
@@ -319,13 +326,14 @@ var _ = Describe("bind-security-group Command", func() {
 					Expect(testUI.Err).To(Say("bind security group to space warning"))
 					Expect(testUI.Err).To(Say("bind security group to space warning"))
 
-					Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(2))
-					securityGroupGUID, spaceGUID := fakeActor.BindSecurityGroupToSpaceArgsForCall(0)
+					Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(0))
+					Expect(fakeActor.BindSecurityGroupToSpacesCallCount()).To(Equal(1))
+					securityGroupGUID, spaces := fakeActor.BindSecurityGroupToSpacesArgsForCall(0)
 					Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
-					Expect(spaceGUID).To(Equal("some-space-guid-1"))
-					securityGroupGUID, spaceGUID = fakeActor.BindSecurityGroupToSpaceArgsForCall(1)
-					Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
-					Expect(spaceGUID).To(Equal("some-space-guid-2"))
+					Expect(spaces).To(Equal([]v2action.Space{
+						{GUID: "some-space-guid-1", Name: "some-space-1"},
+						{GUID: "some-space-guid-2", Name: "some-space-2"},
+					}))
 				})
 			})
 
@@ -334,20 +342,35 @@ var _ = Describe("bind-security-group Command", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("bind security group to space error")
-					fakeActor.BindSecurityGroupToSpaceReturns(
-						v2action.Warnings{"bind security group to space warning"},
-						expectedErr)
+					fakeActor.BindSecurityGroupToSpacesReturns([]v2action.SecurityGroupSpaceBinding{
+						{
+							Space:    v2action.Space{GUID: "some-space-guid-1", Name: "some-space-1"},
+							Warnings: v2action.Warnings{"bind security group to space warning"},
+							Err:      expectedErr,
+						},
+						{
+							Space: v2action.Space{GUID: "some-space-guid-2", Name: "some-space-2"},
+						},
+					})
 				})
 
-				It("returns the error and displays all warnings", func() {
-					Expect(executeErr).To(MatchError(expectedErr))
+				It("reports the outcome for each space and returns a SecurityGroupBindingFailedError", func() {
+					Expect(executeErr).To(MatchError(shared.SecurityGroupBindingFailedError{
+						SecurityGroupName: "some-security-group",
+						SpaceNames:        []string{"some-space-1"},
+					}))
 
-					Consistently(testUI.Out).ShouldNot(Say("OK"))
+					Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-1 in org some-org as some-user..."))
+					Expect(testUI.Out).To(Say("FAILED"))
+					Expect(testUI.Out).To(Say("Assigning security group some-security-group to space some-space-2 in org some-org as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).ToNot(Say("TIP"))
 
 					Expect(testUI.Err).To(Say("get security group warning"))
 					Expect(testUI.Err).To(Say("get org warning"))
 					Expect(testUI.Err).To(Say("get org spaces warning"))
 					Expect(testUI.Err).To(Say("bind security group to space warning"))
+					Expect(testUI.Err).To(Say("bind security group to space error"))
 				})
 			})
 		})
@@ -371,4 +394,94 @@ var _ = Describe("bind-security-group Command", func() {
 			})
 		})
 	})
+
+	Context("when spaces are provided with --space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.SpaceName = "some-space-1"
+			cmd.Spaces = []string{"some-space-2", "some-space-1"}
+			fakeActor.GetSpaceByOrganizationAndNameStub = func(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error) {
+				return v2action.Space{GUID: spaceName + "-guid", Name: spaceName}, v2action.Warnings{"get space warning"}, nil
+			}
+		})
+
+		It("binds the security group to each named space once", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(2))
+			Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+
+			Expect(fakeActor.BindSecurityGroupToSpacesCallCount()).To(Equal(1))
+			_, spaces := fakeActor.BindSecurityGroupToSpacesArgsForCall(0)
+			Expect(spaces).To(Equal([]v2action.Space{
+				{GUID: "some-space-1-guid", Name: "some-space-1"},
+				{GUID: "some-space-2-guid", Name: "some-space-2"},
+			}))
+
+			Expect(testUI.Err).To(Say("get space warning"))
+			Expect(testUI.Err).To(Say("get space warning"))
+		})
+
+		Context("when one of the spaces does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByOrganizationAndNameStub = func(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error) {
+					if spaceName == "some-space-2" {
+						return v2action.Space{}, nil, v2action.SpaceNotFoundError{Name: spaceName}
+					}
+					return v2action.Space{GUID: spaceName + "-guid", Name: spaceName}, nil, nil
+				}
+			})
+
+			It("returns a SpaceNotFoundError without binding any space", func() {
+				Expect(executeErr).To(MatchError(shared.SpaceNotFoundError{Name: "some-space-2"}))
+				Expect(fakeActor.BindSecurityGroupToSpacesCallCount()).To(Equal(0))
+				Expect(fakeActor.BindSecurityGroupToSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --all-spaces is also provided", func() {
+			BeforeEach(func() {
+				cmd.AllSpaces = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+					Arg1: "--all-spaces",
+					Arg2: "SPACE",
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when --all-spaces is provided", func() {
+		BeforeEach(func() {
+			cmd.AllSpaces = true
+			fakeActor.GetOrganizationSpacesReturns(
+				[]v2action.Space{
+					{GUID: "some-space-guid-1", Name: "some-space-1"},
+					{GUID: "some-space-guid-2", Name: "some-space-2"},
+				},
+				nil,
+				nil)
+		})
+
+		It("binds the security group to every space in the org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
+			Expect(fakeActor.BindSecurityGroupToSpacesCallCount()).To(Equal(1))
+		})
+
+		Context("when --space is also provided", func() {
+			BeforeEach(func() {
+				cmd.Spaces = []string{"some-space-1"}
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+					Arg1: "--all-spaces",
+					Arg2: "--space",
+				}))
+			})
+		})
+	})
 })
@@ -3,24 +3,131 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type ConfigCommand struct {
+	OptionalArgs flag.ConfigArgs   `positional-args:"yes"`
 	AsyncTimeout int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
 	Color        flag.Color        `long:"color" description:"Enable or disable color"`
 	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
 	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]\n   CF_NAME config list\n   CF_NAME config get SETTING\n   CF_NAME config set SETTING VALUE\n   CF_NAME config unset SETTING\n\nSETTINGS:\n   color               true or false (CF_COLOR)\n   locale              LOCALE or CLEAR\n   trace               true, false or path/to/file (CF_TRACE)\n   async-timeout       Timeout for async HTTP requests, in minutes\n   dial-timeout        Timeout for connecting to the API, in seconds (CF_DIAL_TIMEOUT)\n   staging-timeout     Max wait time for app staging, in minutes (CF_STAGING_TIMEOUT)\n   startup-timeout     Max wait time for app instance startup, in minutes (CF_STARTUP_TIMEOUT)\n   output-format       table or json, used by the commands that have a --json flag\n   telemetry           true or false, whether anonymous usage statistics are sent\n   telemetry-endpoint  URL that usage statistics are sent to (CF_TELEMETRY_ENDPOINT)\n\n   The environment variables in parentheses take precedence over the settings.\n\nEXAMPLES:\n   CF_NAME config set staging-timeout 30\n   CF_NAME config get output-format"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd ConfigCommand) Execute(args []string) error {
+	switch cmd.OptionalArgs.Action {
+	case "":
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	case "list":
+		return cmd.listSettings()
+	case "get":
+		if cmd.OptionalArgs.Setting == "" {
+			return command.RequiredArgumentError{ArgumentName: "SETTING"}
+		}
+		return cmd.getSetting()
+	case "set":
+		if cmd.OptionalArgs.Setting == "" {
+			return command.RequiredArgumentError{ArgumentName: "SETTING"}
+		}
+		if cmd.OptionalArgs.Value == "" {
+			return command.RequiredArgumentError{ArgumentName: "VALUE"}
+		}
+		return cmd.setSetting()
+	case "unset":
+		if cmd.OptionalArgs.Setting == "" {
+			return command.RequiredArgumentError{ArgumentName: "SETTING"}
+		}
+		return cmd.unsetSetting()
+	default:
+		return command.ParseArgumentError{ArgumentName: "ACTION", ExpectedType: "get, set, unset or list"}
+	}
+}
+
+func (cmd ConfigCommand) listSettings() error {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("setting"),
+			cmd.UI.TranslateText("value"),
+		},
+	}
+	for _, name := range configv3.SettingNames() {
+		value, err := cmd.Config.Setting(name)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		table = append(table, []string{name, value})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
 }
 
-func (_ ConfigCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd ConfigCommand) getSetting() error {
+	value, err := cmd.Config.Setting(cmd.OptionalArgs.Setting)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("{{.Value}}", map[string]interface{}{
+		"Value": value,
+	})
+	return nil
+}
+
+func (cmd ConfigCommand) setSetting() error {
+	value := cmd.OptionalArgs.Value
+	if cmd.OptionalArgs.Setting == configv3.SettingLocale {
+		var locale flag.Locale
+		err := locale.UnmarshalFlag(value)
+		if err != nil {
+			return err
+		}
+
+		value = locale.Locale
+		if value == "CLEAR" {
+			value = ""
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting {{.Setting}} to {{.Value}}...", map[string]interface{}{
+		"Setting": cmd.OptionalArgs.Setting,
+		"Value":   cmd.OptionalArgs.Value,
+	})
+
+	err := cmd.Config.SetSetting(cmd.OptionalArgs.Setting, value)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
 
-func (_ ConfigCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd ConfigCommand) unsetSetting() error {
+	cmd.UI.DisplayTextWithFlavor("Unsetting {{.Setting}}...", map[string]interface{}{
+		"Setting": cmd.OptionalArgs.Setting,
+	})
+
+	err := cmd.Config.SetSetting(cmd.OptionalArgs.Setting, "")
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
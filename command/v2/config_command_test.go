@@ -0,0 +1,197 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("config Command", func() {
+	var (
+		cmd        ConfigCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ConfigCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("list", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "list"
+			fakeConfig.SettingStub = func(name string) (string, error) {
+				switch name {
+				case configv3.SettingStagingTimeout:
+					return "30", nil
+				case configv3.SettingOutputFormat:
+					return "json", nil
+				}
+				return "", nil
+			}
+		})
+
+		It("displays every setting with its value", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`setting\s+value`))
+			Expect(testUI.Out).To(Say(`color\s*\n`))
+			Expect(testUI.Out).To(Say(`staging-timeout\s+30`))
+			Expect(testUI.Out).To(Say(`output-format\s+json`))
+			Expect(fakeConfig.SettingCallCount()).To(Equal(len(configv3.SettingNames())))
+		})
+	})
+
+	Describe("get", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "get"
+			cmd.OptionalArgs.Setting = "trace"
+			fakeConfig.SettingReturns("/tmp/trace.log", nil)
+		})
+
+		It("displays the value of the setting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`^/tmp/trace\.log\n`))
+			Expect(fakeConfig.SettingArgsForCall(0)).To(Equal("trace"))
+		})
+
+		Context("when the setting does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.SettingReturns("", configv3.UnknownSettingError{Name: "trace"})
+			})
+
+			It("returns an UnknownSettingError", func() {
+				Expect(executeErr).To(MatchError(shared.UnknownSettingError{Name: "trace", Settings: configv3.SettingNames()}))
+			})
+		})
+
+		Context("when no setting is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.Setting = ""
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "SETTING"}))
+			})
+		})
+	})
+
+	Describe("set", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "set"
+			cmd.OptionalArgs.Setting = "staging-timeout"
+			cmd.OptionalArgs.Value = "30"
+		})
+
+		It("stores the setting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Setting staging-timeout to 30\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			name, value := fakeConfig.SetSettingArgsForCall(0)
+			Expect(name).To(Equal("staging-timeout"))
+			Expect(value).To(Equal("30"))
+		})
+
+		Context("when the value is invalid", func() {
+			BeforeEach(func() {
+				fakeConfig.SetSettingReturns(configv3.InvalidSettingValueError{Name: "staging-timeout", Value: "30", Expected: "a non-negative integer"})
+			})
+
+			It("returns an InvalidSettingValueError", func() {
+				Expect(executeErr).To(MatchError(shared.InvalidSettingValueError{Name: "staging-timeout", Value: "30", Expected: "a non-negative integer"}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		Context("when no value is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.Value = ""
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "VALUE"}))
+				Expect(fakeConfig.SetSettingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when setting the locale", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.Setting = "locale"
+				cmd.OptionalArgs.Value = "fr_fr"
+			})
+
+			It("stores the supported locale", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, value := fakeConfig.SetSettingArgsForCall(0)
+				Expect(value).To(Equal("fr-FR"))
+			})
+
+			Context("when the locale is CLEAR", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "CLEAR"
+				})
+
+				It("clears the locale", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, value := fakeConfig.SetSettingArgsForCall(0)
+					Expect(value).To(BeEmpty())
+				})
+			})
+
+			Context("when the locale is not supported", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "xx-XX"
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError(ContainSubstring("LOCALE must be")))
+					Expect(fakeConfig.SetSettingCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	Describe("unset", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "unset"
+			cmd.OptionalArgs.Setting = "output-format"
+		})
+
+		It("restores the default of the setting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Unsetting output-format\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			name, value := fakeConfig.SetSettingArgsForCall(0)
+			Expect(name).To(Equal("output-format"))
+			Expect(value).To(BeEmpty())
+		})
+	})
+
+	Context("when the action is unknown", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "delete"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{ArgumentName: "ACTION", ExpectedType: "get, set, unset or list"}))
+		})
+	})
+})
@@ -1,25 +1,155 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . CreateOrgActor
+
+type CreateOrgActor interface {
+	AssignOrganizationRoleByUsername(orgGUID string, username string, role string) (v2action.Warnings, error)
+	CreateOrganizationWithRoles(orgName string, quotaName string, roleSeeds []v2action.RoleSeed) (v2action.Organization, v2action.Warnings, error)
+	GetFeatureFlag(name string) (v2action.FeatureFlag, v2action.Warnings, error)
+}
+
 type CreateOrgCommand struct {
 	RequiredArgs    flag.Organization `positional-args:"yes"`
-	Quota           string            `short:"q" description:"Quota to assign to the newly created org (excluding this option results in assignment of default quota)"`
-	usage           interface{}       `usage:"CF_NAME create-org ORG"`
+	Quota           string            `short:"q" long:"quota" description:"Quota to assign to the newly created org (excluding this option results in assignment of default quota)"`
+	SeedRoles       flag.OrgRoleSeeds `long:"seed-roles" description:"Comma separated list of USERNAME:ROLE pairs to assign in the newly created org. If any of these roles cannot be assigned, the org is deleted again"`
+	usage           interface{}       `usage:"CF_NAME create-org ORG [-q QUOTA] [--seed-roles USERNAME:ROLE,...]\n\nEXAMPLES:\n   CF_NAME create-org my-org -q small --seed-roles alice:OrgManager,bob:OrgAuditor"`
 	relatedCommands interface{}       `related_commands:"create-space, orgs, quotas, set-org-role"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateOrgActor
 }
 
-func (_ CreateOrgCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateOrgCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ CreateOrgCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateOrgCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	orgName := cmd.RequiredArgs.Organization
+	cmd.UI.DisplayTextWithFlavor("Creating org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  orgName,
+		"Username": user.Name,
+	})
+
+	roleSeeds := convertRoleSeeds(cmd.SeedRoles.RoleSeeds)
+	org, warnings, err := cmd.Actor.CreateOrganizationWithRoles(orgName, cmd.Quota, roleSeeds)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(v2action.OrganizationNameTakenError); ok {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayWarning("Org {{.OrgName}} already exists", map[string]interface{}{
+			"OrgName": orgName,
+		})
+		return nil
+	}
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	// As with the legacy implementation, the creator manages the new org when
+	// roles can be set by username. This is not part of the seeding, so the
+	// org is kept when it fails.
+	if setRolesByUsernameEnabled(cmd.UI, cmd.Actor) {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Assigning role {{.Role}} to user {{.Username}} in org {{.OrgName}}...", map[string]interface{}{
+			"Role":     "OrgManager",
+			"Username": user.Name,
+			"OrgName":  orgName,
+		})
+		warnings, err = cmd.Actor.AssignOrganizationRoleByUsername(org.GUID, user.Name, "OrgManager")
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		cmd.UI.DisplayOK()
+	}
+
+	if len(roleSeeds) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Assigned roles in org {{.OrgName}}:", map[string]interface{}{
+			"OrgName": orgName,
+		})
+		displayRoleSeedsTable(cmd.UI, roleSeeds)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} target -o \"{{.OrgName}}\"' to target new org", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"OrgName":    orgName,
+	})
+
 	return nil
 }
+
+// featureFlagActor reads the feature flags of the Cloud Controller.
+type featureFlagActor interface {
+	GetFeatureFlag(name string) (v2action.FeatureFlag, v2action.Warnings, error)
+}
+
+// setRolesByUsernameEnabled returns whether roles can be given by username.
+// When the feature flag cannot be read, a warning is displayed and false is
+// returned, as with the legacy implementation.
+func setRolesByUsernameEnabled(ui command.UI, actor featureFlagActor) bool {
+	featureFlag, warnings, err := actor.GetFeatureFlag(v2action.SetRolesByUsernameFeatureFlag)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		ui.DisplayWarning("Warning: accessing feature flag '{{.FeatureFlag}}' - {{.Error}}\nSkip assigning roles to the creator", map[string]interface{}{
+			"FeatureFlag": v2action.SetRolesByUsernameFeatureFlag,
+			"Error":       err.Error(),
+		})
+		return false
+	}
+	return featureFlag.Enabled
+}
+
+func convertRoleSeeds(flagRoleSeeds []flag.RoleSeed) []v2action.RoleSeed {
+	var roleSeeds []v2action.RoleSeed
+	for _, roleSeed := range flagRoleSeeds {
+		roleSeeds = append(roleSeeds, v2action.RoleSeed{
+			Username: roleSeed.Username,
+			Role:     roleSeed.Role,
+		})
+	}
+	return roleSeeds
+}
+
+func displayRoleSeedsTable(ui command.UI, roleSeeds []v2action.RoleSeed) {
+	table := [][]string{{
+		ui.TranslateText("user"),
+		ui.TranslateText("role"),
+	}}
+	for _, roleSeed := range roleSeeds {
+		table = append(table, []string{roleSeed.Username, roleSeed.Role})
+	}
+	ui.DisplayTableWithHeader("", table, 3)
+}
@@ -0,0 +1,226 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-org Command", func() {
+	var (
+		cmd             CreateOrgCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateOrgActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateOrgActor)
+
+		cmd = CreateOrgCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Organization = "some-org"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the org is created", func() {
+		BeforeEach(func() {
+			cmd.Quota = "some-quota"
+			cmd.SeedRoles = flag.OrgRoleSeeds{RoleSeeds: []flag.RoleSeed{
+				{Username: "user-1", Role: "OrgAuditor"},
+			}}
+			fakeActor.CreateOrganizationWithRolesReturns(
+				v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+				v2action.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		It("creates the org with the quota and the seeded roles", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating org some-org as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Assigned roles in org some-org:"))
+			Expect(testUI.Out).To(Say("user\\s+role"))
+			Expect(testUI.Out).To(Say("user-1\\s+OrgAuditor"))
+			Expect(testUI.Out).To(Say("TIP: Use 'faceman target -o \"some-org\"' to target new org"))
+			Expect(testUI.Err).To(Say("create-warning"))
+
+			Expect(fakeActor.CreateOrganizationWithRolesCallCount()).To(Equal(1))
+			orgName, quotaName, roleSeeds := fakeActor.CreateOrganizationWithRolesArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(quotaName).To(Equal("some-quota"))
+			Expect(roleSeeds).To(Equal([]v2action.RoleSeed{
+				{Username: "user-1", Role: "OrgAuditor"},
+			}))
+		})
+
+		Context("when roles can be set by username", func() {
+			BeforeEach(func() {
+				fakeActor.GetFeatureFlagReturns(v2action.FeatureFlag{Name: "set_roles_by_username", Enabled: true}, v2action.Warnings{"flag-warning"}, nil)
+				fakeActor.AssignOrganizationRoleByUsernameReturns(v2action.Warnings{"assign-warning"}, nil)
+			})
+
+			It("makes the creator manage the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Assigning role OrgManager to user some-user in org some-org..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("flag-warning"))
+				Expect(testUI.Err).To(Say("assign-warning"))
+
+				Expect(fakeActor.GetFeatureFlagArgsForCall(0)).To(Equal("set_roles_by_username"))
+				Expect(fakeActor.AssignOrganizationRoleByUsernameCallCount()).To(Equal(1))
+				orgGUID, username, role := fakeActor.AssignOrganizationRoleByUsernameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(username).To(Equal("some-user"))
+				Expect(role).To(Equal("OrgManager"))
+			})
+
+			Context("when assigning the role fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("assign-error")
+					fakeActor.AssignOrganizationRoleByUsernameReturns(nil, expectedErr)
+				})
+
+				It("returns the error and keeps the org", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Out).To(Say("OK"))
+				})
+			})
+		})
+
+		Context("when roles cannot be set by username", func() {
+			BeforeEach(func() {
+				fakeActor.GetFeatureFlagReturns(v2action.FeatureFlag{Name: "set_roles_by_username"}, nil, nil)
+			})
+
+			It("does not assign the creator a role", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Assigning role"))
+				Expect(fakeActor.AssignOrganizationRoleByUsernameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the feature flag cannot be read", func() {
+			BeforeEach(func() {
+				fakeActor.GetFeatureFlagReturns(v2action.FeatureFlag{}, nil, errors.New("flag-error"))
+			})
+
+			It("warns and does not assign the creator a role", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Warning: accessing feature flag 'set_roles_by_username' - flag-error"))
+				Expect(testUI.Err).To(Say("Skip assigning roles to the creator"))
+				Expect(fakeActor.AssignOrganizationRoleByUsernameCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the org already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateOrganizationWithRolesReturns(
+				v2action.Organization{},
+				v2action.Warnings{"create-warning"},
+				v2action.OrganizationNameTakenError{Name: "some-org"},
+			)
+		})
+
+		It("displays OK and warns that the org exists", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Err).To(Say("Org some-org already exists"))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+	})
+
+	Context("when seeding the roles fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateOrganizationWithRolesReturns(
+				v2action.Organization{},
+				v2action.Warnings{"create-warning"},
+				v2action.RoleSeedingError{Username: "user-1", Role: "OrgAuditor", Err: errors.New("user not found")},
+			)
+		})
+
+		It("returns a RoleSeedingError", func() {
+			Expect(executeErr).To(MatchError(shared.RoleSeedingError{Username: "user-1", Role: "OrgAuditor", Message: "user not found"}))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when the quota does not exist", func() {
+		BeforeEach(func() {
+			cmd.Quota = "some-quota"
+			fakeActor.CreateOrganizationWithRolesReturns(
+				v2action.Organization{},
+				nil,
+				v2action.OrganizationQuotaNotFoundError{Name: "some-quota"},
+			)
+		})
+
+		It("returns an OrganizationQuotaNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.OrganizationQuotaNotFoundError{Name: "some-quota"}))
+		})
+	})
+})
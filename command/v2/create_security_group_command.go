@@ -2,23 +2,105 @@ package v2
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . CreateSecurityGroupActor
+
+type CreateSecurityGroupActor interface {
+	CreateSecurityGroup(name string, rules []v2action.SecurityGroupRuleDefinition) (v2action.Warnings, error)
+	ReadSecurityGroupRulesFile(path string) ([]v2action.SecurityGroupRuleDefinition, error)
+}
+
 type CreateSecurityGroupCommand struct {
 	RequiredArgs    flag.SecurityGroupArgs `positional-args:"yes"`
-	usage           interface{}            `usage:"CF_NAME create-security-group SECURITY_GROUP PATH_TO_JSON_RULES_FILE\n\n   The provided path can be an absolute or relative path to a file.  The file should have\n   a single array with JSON objects inside describing the rules.  The JSON Base Object is\n   omitted and only the square brackets and associated child object are required in the file.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]"`
+	usage           interface{}            `usage:"CF_NAME create-security-group SECURITY_GROUP PATH_TO_RULES_FILE\n\n   The provided path can be an absolute or relative path to a file.  The file should have\n   a single array with JSON objects inside describing the rules.  The JSON Base Object is\n   omitted and only the square brackets and associated child object are required in the file.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]\n\n   Files ending in .yml or .yaml may instead contain a YAML list of rules with the same keys.\n   Files ending in .csv may instead contain one rule per row, with a header row naming the\n   columns protocol, destination, ports, type, code, description and log."`
 	relatedCommands interface{}            `related_commands:"bind-security-group, bind-running-security-group, bind-staging-security-group, security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateSecurityGroupActor
 }
 
-func (_ CreateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	// Reading the rules file does not need any client.
+	if command.UsesLegacyImplementation(cmd.Config, "create-security-group", !isJSONRulesFile(string(cmd.RequiredArgs.PathToRulesFile))) {
+		cmd.Actor = v2action.NewActor(nil, nil)
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ CreateSecurityGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateSecurityGroupCommand) Execute(args []string) error {
+	// The rules are validated up front so that malformed rules are reported
+	// precisely instead of being rejected by the Cloud Controller.
+	rules, err := cmd.Actor.ReadSecurityGroupRulesFile(string(cmd.RequiredArgs.PathToRulesFile))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if command.UsesLegacyImplementation(cmd.Config, "create-security-group", !isJSONRulesFile(string(cmd.RequiredArgs.PathToRulesFile))) {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating security group {{.SecurityGroupName}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+		"Username":          user.Name,
+	})
+
+	warnings, err := cmd.Actor.CreateSecurityGroup(cmd.RequiredArgs.SecurityGroup, rules)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(v2action.SecurityGroupNameTakenError); ok {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayWarning("Security group {{.SecurityGroupName}} already exists", map[string]interface{}{
+			"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+		})
+		return nil
+	}
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
+
+// isJSONRulesFile returns true when the rules file is parsed as JSON, which is
+// the only format the legacy security group commands understand.
+func isJSONRulesFile(path string) bool {
+	extension := strings.ToLower(filepath.Ext(path))
+	_, ok := v2action.SecurityGroupRulesParsers[extension]
+	return !ok || extension == ".json"
+}
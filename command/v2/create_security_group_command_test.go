@@ -0,0 +1,141 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-security-group Command", func() {
+	var (
+		cmd             CreateSecurityGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateSecurityGroupActor
+		binaryName      string
+		rules           []v2action.SecurityGroupRuleDefinition
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateSecurityGroupActor)
+
+		cmd = CreateSecurityGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			RequiredArgs: flag.SecurityGroupArgs{
+				SecurityGroup:   "some-security-group",
+				PathToRulesFile: "some-rules.yml",
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		rules = []v2action.SecurityGroupRuleDefinition{{Protocol: "all", Destination: "10.0.0.1"}}
+		fakeActor.ReadSecurityGroupRulesFileReturns(rules, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when a rule in the rules file is invalid", func() {
+		BeforeEach(func() {
+			fakeActor.ReadSecurityGroupRulesFileReturns(nil, v2action.InvalidSecurityGroupRuleError{
+				RuleNumber: 2,
+				Reason:     "ports are required for tcp rules",
+			})
+		})
+
+		It("returns an InvalidSecurityGroupRuleError without creating the security group", func() {
+			Expect(executeErr).To(MatchError(shared.InvalidSecurityGroupRuleError{
+				RuleNumber: 2,
+				Reason:     "ports are required for tcp rules",
+			}))
+
+			Expect(fakeActor.ReadSecurityGroupRulesFileCallCount()).To(Equal(1))
+			Expect(fakeActor.ReadSecurityGroupRulesFileArgsForCall(0)).To(Equal("some-rules.yml"))
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when creating the security group fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.CreateSecurityGroupReturns(v2action.Warnings{"some-warning"}, expectedErr)
+		})
+
+		It("displays the warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	Context("when the security group already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSecurityGroupReturns(v2action.Warnings{"some-warning"}, v2action.SecurityGroupNameTakenError{Name: "some-security-group"})
+		})
+
+		It("displays OK and warns that the security group exists", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Err).To(Say("Security group some-security-group already exists"))
+		})
+	})
+
+	Context("when the security group is created", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSecurityGroupReturns(v2action.Warnings{"some-warning"}, nil)
+		})
+
+		It("creates the security group with the rules from the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Creating security group some-security-group as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(1))
+			name, createdRules := fakeActor.CreateSecurityGroupArgsForCall(0)
+			Expect(name).To(Equal("some-security-group"))
+			Expect(createdRules).To(Equal(rules))
+		})
+	})
+})
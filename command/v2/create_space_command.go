@@ -1,26 +1,134 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . CreateSpaceActor
+
+type CreateSpaceActor interface {
+	AssignSpaceRoleByUsername(orgGUID string, spaceGUID string, username string, role string) (v2action.Warnings, error)
+	CreateSpaceWithRoles(spaceName string, orgGUID string, spaceQuotaName string, roleSeeds []v2action.RoleSeed) (v2action.Space, v2action.Warnings, error)
+	GetFeatureFlag(name string) (v2action.FeatureFlag, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+}
+
 type CreateSpaceCommand struct {
-	RequiredArgs    flag.Space  `positional-args:"yes"`
-	Organization    string      `short:"o" description:"Organization"`
-	Quota           string      `short:"q" description:"Quota to assign to the newly created space"`
-	usage           interface{} `usage:"CF_NAME create-space SPACE [-o ORG] [-q SPACE_QUOTA]"`
-	relatedCommands interface{} `related_commands:"set-space-isolation-segment, space-quotas, spaces, target"`
+	RequiredArgs    flag.Space          `positional-args:"yes"`
+	Organization    string              `short:"o" description:"Organization"`
+	Quota           string              `short:"q" long:"quota" description:"Quota to assign to the newly created space"`
+	SeedRoles       flag.SpaceRoleSeeds `long:"seed-roles" description:"Comma separated list of USERNAME:ROLE pairs to assign in the newly created space. If any of these roles cannot be assigned, the space is deleted again"`
+	usage           interface{}         `usage:"CF_NAME create-space SPACE [-o ORG] [-q SPACE_QUOTA] [--seed-roles USERNAME:ROLE,...]\n\nEXAMPLES:\n   CF_NAME create-space my-space -o my-org --seed-roles alice:SpaceDeveloper,bob:SpaceAuditor"`
+	relatedCommands interface{}         `related_commands:"set-space-isolation-segment, space-quotas, spaces, target"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateSpaceActor
 }
 
-func (_ CreateSpaceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ CreateSpaceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd CreateSpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, cmd.Organization == "", false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	spaceName := cmd.RequiredArgs.Space
+	orgName := cmd.Organization
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": spaceName,
+		"OrgName":   orgName,
+		"Username":  user.Name,
+	})
+
+	if cmd.Organization != "" {
+		org, warnings, orgErr := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if orgErr != nil {
+			return shared.HandleError(orgErr)
+		}
+		orgGUID = org.GUID
+	}
+
+	roleSeeds := convertRoleSeeds(cmd.SeedRoles.RoleSeeds)
+	space, warnings, err := cmd.Actor.CreateSpaceWithRoles(spaceName, orgGUID, cmd.Quota, roleSeeds)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(v2action.SpaceNameTakenError); ok {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayWarning("Space {{.SpaceName}} already exists", map[string]interface{}{
+			"SpaceName": spaceName,
+		})
+		return nil
+	}
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	// As with the legacy implementation, the creator manages and develops in
+	// the new space when roles can be set by username. This is not part of the
+	// seeding, so the space is kept when it fails.
+	if setRolesByUsernameEnabled(cmd.UI, cmd.Actor) {
+		for _, role := range []string{"SpaceManager", "SpaceDeveloper"} {
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("Assigning role {{.Role}} to user {{.Username}} in org {{.OrgName}} / space {{.SpaceName}}...", map[string]interface{}{
+				"Role":      role,
+				"Username":  user.Name,
+				"OrgName":   orgName,
+				"SpaceName": spaceName,
+			})
+			warnings, err = cmd.Actor.AssignSpaceRoleByUsername(orgGUID, space.GUID, user.Name, role)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+			cmd.UI.DisplayOK()
+		}
+	}
+
+	if len(roleSeeds) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Assigned roles in space {{.SpaceName}}:", map[string]interface{}{
+			"SpaceName": spaceName,
+		})
+		displayRoleSeedsTable(cmd.UI, roleSeeds)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} target -o \"{{.OrgName}}\" -s \"{{.SpaceName}}\"' to target new space", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"OrgName":    orgName,
+		"SpaceName":  spaceName,
+	})
+
 	return nil
 }
@@ -0,0 +1,227 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-space Command", func() {
+	var (
+		cmd             CreateSpaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateSpaceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateSpaceActor)
+
+		cmd = CreateSpaceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Space = "some-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "targeted-org-guid", Name: "targeted-org"})
+		fakeActor.CreateSpaceWithRolesReturns(
+			v2action.Space{GUID: "some-space-guid", Name: "some-space"},
+			v2action.Warnings{"create-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoTargetedOrganizationError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NoTargetedOrganizationError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when no org is provided", func() {
+		BeforeEach(func() {
+			cmd.Quota = "some-space-quota"
+			cmd.SeedRoles = flag.SpaceRoleSeeds{RoleSeeds: []flag.RoleSeed{
+				{Username: "user-1", Role: "SpaceAuditor"},
+			}}
+		})
+
+		It("creates the space in the targeted org with the seeded roles", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating space some-space in org targeted-org as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Assigned roles in space some-space:"))
+			Expect(testUI.Out).To(Say("user-1\\s+SpaceAuditor"))
+			Expect(testUI.Out).To(Say("TIP: Use 'faceman target -o \"targeted-org\" -s \"some-space\"' to target new space"))
+			Expect(testUI.Err).To(Say("create-warning"))
+
+			Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+			spaceName, orgGUID, spaceQuotaName, roleSeeds := fakeActor.CreateSpaceWithRolesArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("targeted-org-guid"))
+			Expect(spaceQuotaName).To(Equal("some-space-quota"))
+			Expect(roleSeeds).To(Equal([]v2action.RoleSeed{
+				{Username: "user-1", Role: "SpaceAuditor"},
+			}))
+		})
+
+		Context("when roles can be set by username", func() {
+			BeforeEach(func() {
+				fakeActor.GetFeatureFlagReturns(v2action.FeatureFlag{Name: "set_roles_by_username", Enabled: true}, nil, nil)
+				fakeActor.AssignSpaceRoleByUsernameReturns(v2action.Warnings{"assign-warning"}, nil)
+			})
+
+			It("makes the creator manage and develop in the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Assigning role SpaceManager to user some-user in org targeted-org / space some-space..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Assigning role SpaceDeveloper to user some-user in org targeted-org / space some-space..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("assign-warning"))
+
+				Expect(fakeActor.AssignSpaceRoleByUsernameCallCount()).To(Equal(2))
+				orgGUID, spaceGUID, username, role := fakeActor.AssignSpaceRoleByUsernameArgsForCall(0)
+				Expect(orgGUID).To(Equal("targeted-org-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(username).To(Equal("some-user"))
+				Expect(role).To(Equal("SpaceManager"))
+				_, _, _, role = fakeActor.AssignSpaceRoleByUsernameArgsForCall(1)
+				Expect(role).To(Equal("SpaceDeveloper"))
+			})
+
+			Context("when assigning a role fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("assign-error")
+					fakeActor.AssignSpaceRoleByUsernameReturns(nil, expectedErr)
+				})
+
+				It("returns the error and keeps the space", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(fakeActor.AssignSpaceRoleByUsernameCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when roles cannot be set by username", func() {
+			BeforeEach(func() {
+				fakeActor.GetFeatureFlagReturns(v2action.FeatureFlag{Name: "set_roles_by_username"}, nil, nil)
+			})
+
+			It("does not assign the creator any role", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Assigning role"))
+				Expect(fakeActor.AssignSpaceRoleByUsernameCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when an org is provided", func() {
+		BeforeEach(func() {
+			cmd.Organization = "some-org"
+			fakeActor.GetOrganizationByNameReturns(
+				v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+				v2action.Warnings{"org-warning"},
+				nil,
+			)
+		})
+
+		It("does not require a targeted org", func() {
+			_, checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+		})
+
+		It("creates the space in that org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Creating space some-space in org some-org as some-user..."))
+			Expect(testUI.Err).To(Say("org-warning"))
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			_, orgGUID, _, _ := fakeActor.CreateSpaceWithRolesArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(
+					v2action.Organization{},
+					v2action.Warnings{"org-warning"},
+					v2action.OrganizationNotFoundError{Name: "some-org"},
+				)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(fakeActor.CreateSpaceWithRolesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the space already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSpaceWithRolesReturns(
+				v2action.Space{},
+				v2action.Warnings{"create-warning"},
+				v2action.SpaceNameTakenError{Name: "some-space"},
+			)
+		})
+
+		It("displays OK and warns that the space exists", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("Space some-space already exists"))
+		})
+	})
+
+	Context("when seeding the roles fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSpaceWithRolesReturns(
+				v2action.Space{},
+				v2action.Warnings{"create-warning"},
+				v2action.RoleSeedingError{Username: "user-1", Role: "SpaceAuditor", Err: errors.New("user not found")},
+			)
+		})
+
+		It("returns a RoleSeedingError", func() {
+			Expect(executeErr).To(MatchError(shared.RoleSeedingError{Username: "user-1", Role: "SpaceAuditor", Message: "user not found"}))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+})
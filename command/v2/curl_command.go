@@ -15,7 +15,8 @@ type CurlCommand struct {
 	HTTPData              flag.PathWithAt `short:"d" description:"HTTP data to include in the request body, or '@' followed by a file name to read the data from"`
 	IncludeReponseHeaders bool            `short:"i" description:"Include response headers in the output"`
 	OutputFile            flag.Path       `long:"output" description:"Write curl body to FILE instead of stdout"`
-	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file"`
+	Target                string          `long:"target" description:"Platform API to send the request to: cc, uaa, routing, networking or log-cache (Default: cc)"`
+	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--target TARGET]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   PATH is relative to the Cloud Controller unless --target names another\n   platform API. The networking and Log Cache URLs are discovered from the\n   Cloud Controller.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file\n   CF_NAME curl \"/Users\" --target uaa"`
 }
 
 func (_ CurlCommand) Setup(config command.Config, ui command.UI) error {
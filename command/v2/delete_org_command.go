@@ -11,14 +11,16 @@ import (
 //go:generate counterfeiter . DeleteOrganizationActor
 
 type DeleteOrganizationActor interface {
-	DeleteOrganization(orgName string) (v2action.Warnings, error)
+	DeleteOrganizationAsync(orgName string) (v2action.Job, v2action.Warnings, error)
+	PollJob(job v2action.Job) (v2action.Warnings, error)
 	ClearOrganizationAndSpace(config v2action.Config)
 }
 
 type DeleteOrgCommand struct {
 	RequiredArgs flag.Organization `positional-args:"yes"`
 	Force        bool              `short:"f" description:"Force deletion without confirmation"`
-	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f]"`
+	NoWait       bool              `long:"no-wait" description:"Exit once the deletion job has been queued, without waiting for it to complete"`
+	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f] [--no-wait]"`
 
 	Config      command.Config
 	UI          command.UI
@@ -70,7 +72,7 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		"Username": user.Name,
 	})
 
-	warnings, err := cmd.Actor.DeleteOrganization(cmd.RequiredArgs.Organization)
+	job, warnings, err := cmd.Actor.DeleteOrganizationAsync(cmd.RequiredArgs.Organization)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		switch err.(type) {
@@ -81,6 +83,16 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		default:
 			return shared.HandleError(err)
 		}
+	} else if !cmd.NoWait {
+		cmd.UI.DisplayText("Waiting for deletion job {{.JobGUID}} to complete...", map[string]interface{}{
+			"JobGUID": job.GUID,
+		})
+
+		pollWarnings, pollErr := cmd.Actor.PollJob(job)
+		cmd.UI.DisplayWarnings(pollWarnings)
+		if pollErr != nil {
+			return shared.HandleError(pollErr)
+		}
 	}
 
 	if cmd.Config.TargetedOrganization().Name == cmd.RequiredArgs.Organization {
@@ -89,5 +101,16 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayOK()
 
+	if err == nil && cmd.NoWait {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Deletion job {{.JobGUID}} has been queued.", map[string]interface{}{
+			"JobGUID": job.GUID,
+		})
+		cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} job-status {{.JobGUID}}' to check the status of the deletion.", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+			"JobGUID":    job.GUID,
+		})
+	}
+
 	return nil
 }
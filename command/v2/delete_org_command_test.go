@@ -5,9 +5,11 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -99,7 +101,7 @@ var _ = Describe("delete-org Command", func() {
 
 					Context("when no errors are encountered", func() {
 						BeforeEach(func() {
-							fakeActor.DeleteOrganizationReturns(v2action.Warnings{"warning-1", "warning-2"}, nil)
+							fakeActor.DeleteOrganizationAsyncReturns(v2action.Job{}, v2action.Warnings{"warning-1", "warning-2"}, nil)
 						})
 
 						It("does not prompt for user confirmation, displays warnings, and deletes the org", func() {
@@ -108,8 +110,8 @@ var _ = Describe("delete-org Command", func() {
 							Expect(testUI.Out).ToNot(Say("Really delete the org some-org, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers\\? \\[yN\\]:"))
 							Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-							orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+							Expect(fakeActor.DeleteOrganizationAsyncCallCount()).To(Equal(1))
+							orgName := fakeActor.DeleteOrganizationAsyncArgsForCall(0)
 							Expect(orgName).To(Equal("some-org"))
 
 							Expect(testUI.Err).To(Say("warning-1"))
@@ -118,10 +120,78 @@ var _ = Describe("delete-org Command", func() {
 						})
 					})
 
+					Context("when the deletion job is queued", func() {
+						BeforeEach(func() {
+							fakeActor.DeleteOrganizationAsyncReturns(
+								v2action.Job{GUID: "some-job-guid"},
+								v2action.Warnings{"warning-1"},
+								nil,
+							)
+						})
+
+						Context("when the '--no-wait' flag is not provided", func() {
+							Context("when the job completes", func() {
+								BeforeEach(func() {
+									fakeActor.PollJobReturns(v2action.Warnings{"polling-warning"}, nil)
+								})
+
+								It("waits for the deletion job to complete", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(testUI.Out).To(Say("Waiting for deletion job some-job-guid to complete\\.\\.\\."))
+									Expect(testUI.Out).To(Say("OK"))
+									Expect(testUI.Out).ToNot(Say("job-status"))
+									Expect(testUI.Err).To(Say("warning-1"))
+									Expect(testUI.Err).To(Say("polling-warning"))
+
+									Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+									Expect(fakeActor.PollJobArgsForCall(0)).To(Equal(v2action.Job{GUID: "some-job-guid"}))
+								})
+							})
+
+							Context("when the job fails", func() {
+								BeforeEach(func() {
+									fakeActor.PollJobReturns(
+										v2action.Warnings{"polling-warning"},
+										ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"},
+									)
+									fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+								})
+
+								It("returns the error, displays all warnings, and does not clear the target", func() {
+									Expect(executeErr).To(MatchError(shared.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"}))
+
+									Expect(testUI.Out).ToNot(Say("OK"))
+									Expect(testUI.Err).To(Say("warning-1"))
+									Expect(testUI.Err).To(Say("polling-warning"))
+									Expect(fakeActor.ClearOrganizationAndSpaceCallCount()).To(Equal(0))
+								})
+							})
+						})
+
+						Context("when the '--no-wait' flag is provided", func() {
+							BeforeEach(func() {
+								cmd.NoWait = true
+							})
+
+							It("does not wait for the job and displays the job GUID", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).ToNot(Say("Waiting for deletion job"))
+								Expect(testUI.Out).To(Say("OK"))
+								Expect(testUI.Out).To(Say("Deletion job some-job-guid has been queued\\."))
+								Expect(testUI.Out).To(Say("TIP: Use 'faceman job-status some-job-guid' to check the status of the deletion\\."))
+
+								Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					Context("when an error is encountered deleting the org", func() {
 						Context("when the organization does not exist", func() {
 							BeforeEach(func() {
-								fakeActor.DeleteOrganizationReturns(
+								fakeActor.DeleteOrganizationAsyncReturns(
+									v2action.Job{},
 									v2action.Warnings{"warning-1", "warning-2"},
 									v2action.OrganizationNotFoundError{
 										Name: "some-org",
@@ -134,8 +204,8 @@ var _ = Describe("delete-org Command", func() {
 
 								Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-								Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-								orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+								Expect(fakeActor.DeleteOrganizationAsyncCallCount()).To(Equal(1))
+								orgName := fakeActor.DeleteOrganizationAsyncArgsForCall(0)
 								Expect(orgName).To(Equal("some-org"))
 
 								Expect(testUI.Err).To(Say("warning-1"))
@@ -151,7 +221,7 @@ var _ = Describe("delete-org Command", func() {
 
 							BeforeEach(func() {
 								returnedErr = errors.New("some error")
-								fakeActor.DeleteOrganizationReturns(v2action.Warnings{"warning-1", "warning-2"}, returnedErr)
+								fakeActor.DeleteOrganizationAsyncReturns(v2action.Job{}, v2action.Warnings{"warning-1", "warning-2"}, returnedErr)
 							})
 
 							It("returns the error, displays all warnings, and does not delete the org", func() {
@@ -159,8 +229,8 @@ var _ = Describe("delete-org Command", func() {
 
 								Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-								Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-								orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+								Expect(fakeActor.DeleteOrganizationAsyncCallCount()).To(Equal(1))
+								orgName := fakeActor.DeleteOrganizationAsyncArgsForCall(0)
 								Expect(orgName).To(Equal("some-org"))
 
 								Expect(testUI.Err).To(Say("warning-1"))
@@ -182,7 +252,7 @@ var _ = Describe("delete-org Command", func() {
 
 							Expect(testUI.Out).To(Say("Delete cancelled"))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.DeleteOrganizationAsyncCallCount()).To(Equal(0))
 						})
 					})
 
@@ -196,7 +266,7 @@ var _ = Describe("delete-org Command", func() {
 
 							Expect(testUI.Out).To(Say("Delete cancelled"))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.DeleteOrganizationAsyncCallCount()).To(Equal(0))
 						})
 					})
 
@@ -211,8 +281,8 @@ var _ = Describe("delete-org Command", func() {
 							Expect(testUI.Out).To(Say("Really delete the org some-org, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers\\? \\[yN\\]:"))
 							Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-							orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+							Expect(fakeActor.DeleteOrganizationAsyncCallCount()).To(Equal(1))
+							orgName := fakeActor.DeleteOrganizationAsyncArgsForCall(0)
 							Expect(orgName).To(Equal("some-org"))
 
 							Expect(testUI.Out).To(Say("OK"))
@@ -231,7 +301,7 @@ var _ = Describe("delete-org Command", func() {
 							Expect(testUI.Out).To(Say("invalid input \\(not y, n, yes, or no\\)"))
 							Expect(testUI.Out).To(Say("Really delete the org some-org, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers\\? \\[yN\\]:"))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.DeleteOrganizationAsyncCallCount()).To(Equal(0))
 						})
 					})
 
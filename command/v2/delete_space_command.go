@@ -1,25 +1,129 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . DeleteSpaceActor
+
+type DeleteSpaceActor interface {
+	DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (v2action.Job, v2action.Warnings, error)
+	PollJob(job v2action.Job) (v2action.Warnings, error)
+}
+
 type DeleteSpaceCommand struct {
 	RequiredArgs flag.Space  `positional-args:"yes"`
 	Force        bool        `short:"f" description:"Force deletion without confirmation"`
 	Org          string      `short:"o" description:"Delete space within specified org"`
-	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f]"`
+	NoWait       bool        `long:"no-wait" description:"Exit once the deletion job has been queued, without waiting for it to complete"`
+	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f] [--no-wait]"`
+
+	Config      command.Config
+	UI          command.UI
+	SharedActor command.SharedActor
+	Actor       DeleteSpaceActor
 }
 
-func (_ DeleteSpaceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ DeleteSpaceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd *DeleteSpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, cmd.Org == "", false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	orgName := cmd.Org
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		deleteSpace, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the space {{.SpaceName}}?", map[string]interface{}{
+			"SpaceName": cmd.RequiredArgs.Space,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteSpace {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": cmd.RequiredArgs.Space,
+		"OrgName":   orgName,
+		"Username":  user.Name,
+	})
+
+	job, warnings, err := cmd.Actor.DeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		switch err.(type) {
+		case v2action.SpaceNotFoundError:
+			cmd.UI.DisplayText("Space {{.SpaceName}} does not exist.", map[string]interface{}{
+				"SpaceName": cmd.RequiredArgs.Space,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		default:
+			return shared.HandleError(err)
+		}
+	}
+
+	if !cmd.NoWait {
+		cmd.UI.DisplayText("Waiting for deletion job {{.JobGUID}} to complete...", map[string]interface{}{
+			"JobGUID": job.GUID,
+		})
+
+		pollWarnings, pollErr := cmd.Actor.PollJob(job)
+		cmd.UI.DisplayWarnings(pollWarnings)
+		if pollErr != nil {
+			return shared.HandleError(pollErr)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	if cmd.NoWait {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Deletion job {{.JobGUID}} has been queued.", map[string]interface{}{
+			"JobGUID": job.GUID,
+		})
+		cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} job-status {{.JobGUID}}' to check the status of the deletion.", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+			"JobGUID":    job.GUID,
+		})
+	}
+
+	if cmd.Config.TargetedOrganization().Name == orgName && cmd.Config.TargetedSpace().Name == cmd.RequiredArgs.Space {
+		cmd.Config.UnsetSpaceInformation()
+		cmd.UI.DisplayText("TIP: No space targeted, use '{{.BinaryName}} target -s' to target a space.", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+		})
+	}
+
 	return nil
 }
@@ -0,0 +1,253 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-space Command", func() {
+	var (
+		cmd             DeleteSpaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteSpaceActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteSpaceActor)
+
+		cmd = DeleteSpaceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Space = "some-space"
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoTargetedOrganizationError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NoTargetedOrganizationError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the '-o' flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Org = "some-org"
+			cmd.Force = true
+		})
+
+		It("does not require a targeted org and deletes the space in the provided org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("Deleting space some-space in org some-org as some-user\\.\\.\\."))
+			spaceName, orgName := fakeActor.DeleteSpaceByNameAndOrganizationNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgName).To(Equal("some-org"))
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the '-f' flag is not provided", func() {
+		Context("when the user inputs yes", func() {
+			BeforeEach(func() {
+				input.Write([]byte("y\n"))
+			})
+
+			It("deletes the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Really delete the space some-space\\? \\[yN\\]:"))
+				Expect(testUI.Out).To(Say("Deleting space some-space in org targeted-org as some-user\\.\\.\\."))
+				Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the user inputs no", func() {
+			BeforeEach(func() {
+				input.Write([]byte("n\n"))
+			})
+
+			It("does not delete the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when displaying the prompt returns an error", func() {
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("EOF"))
+			})
+		})
+	})
+
+	Context("when the '-f' flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		Context("when the deletion job is queued", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(
+					v2action.Job{GUID: "some-job-guid"},
+					v2action.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			Context("when the job completes", func() {
+				BeforeEach(func() {
+					fakeActor.PollJobReturns(v2action.Warnings{"polling-warning"}, nil)
+				})
+
+				It("waits for the deletion job to complete", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Really delete"))
+					Expect(testUI.Out).To(Say("Deleting space some-space in org targeted-org as some-user\\.\\.\\."))
+					Expect(testUI.Out).To(Say("Waiting for deletion job some-job-guid to complete\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("polling-warning"))
+
+					Expect(fakeActor.PollJobArgsForCall(0)).To(Equal(v2action.Job{GUID: "some-job-guid"}))
+					Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the job fails", func() {
+				BeforeEach(func() {
+					fakeActor.PollJobReturns(
+						v2action.Warnings{"polling-warning"},
+						ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"},
+					)
+				})
+
+				It("returns the error and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(shared.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"}))
+					Expect(testUI.Out).ToNot(Say("OK"))
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("polling-warning"))
+				})
+			})
+
+			Context("when the '--no-wait' flag is provided", func() {
+				BeforeEach(func() {
+					cmd.NoWait = true
+				})
+
+				It("does not wait for the job and displays the job GUID", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Deletion job some-job-guid has been queued\\."))
+					Expect(testUI.Out).To(Say("TIP: Use 'faceman job-status some-job-guid' to check the status of the deletion\\."))
+					Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the deleted space is the targeted space", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+				})
+
+				It("untargets the space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+					Expect(testUI.Out).To(Say("TIP: No space targeted, use 'faceman target -s' to target a space\\."))
+				})
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(
+					v2action.Job{},
+					v2action.Warnings{"warning-1"},
+					v2action.SpaceNotFoundError{Name: "some-space"},
+				)
+			})
+
+			It("displays that the space does not exist", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Space some-space does not exist\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(
+					v2action.Job{},
+					v2action.Warnings{"warning-1"},
+					v2action.OrganizationNotFoundError{Name: "targeted-org"},
+				)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.OrganizationNotFoundError{Name: "targeted-org"}))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+})
@@ -0,0 +1,122 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	log "github.com/Sirupsen/logrus"
+)
+
+//go:generate counterfeiter . DiffActor
+
+type DiffActor interface {
+	ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	ReadManifest(pathToManifest string) ([]manifest.Application, error)
+}
+
+type DiffCommand struct {
+	PathToManifest  flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to manifest"`
+	usage           interface{}                 `usage:"CF_NAME diff -f MANIFEST_PATH\n\n   Shows the buildpack, scaling, environment variable and route changes that pushing the manifest would make, without pushing."`
+	relatedCommands interface{}                 `related_commands:"app, create-app-manifest, v2-push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DiffActor
+}
+
+func (cmd *DiffCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	v3Actor, err := newPushV3Actor(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pushaction.NewActor(v2action.NewActor(ccClient, uaaClient), v3Actor)
+	return nil
+}
+
+func (cmd DiffCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	apps, err := cmd.Actor.ReadManifest(string(cmd.PathToManifest))
+	if err != nil {
+		log.Errorln("reading manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing manifest {{.ManifestPath}} to apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ManifestPath": cmd.PathToManifest,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    cmd.Config.TargetedSpace().Name,
+		"Username":     user.Name,
+	})
+
+	appConfigs, warnings, err := cmd.Actor.ConvertToApplicationConfig(
+		cmd.Config.TargetedOrganization().GUID,
+		cmd.Config.TargetedSpace().GUID,
+		apps,
+		false,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("converting manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	for _, appConfig := range appConfigs {
+		cmd.UI.DisplayNewline()
+		cmd.displayChanges(appConfig)
+	}
+
+	return nil
+}
+
+func (cmd DiffCommand) displayChanges(appConfig pushaction.ApplicationConfig) {
+	appName := appConfig.DesiredApplication.Name
+	cmd.UI.DisplayText("app: {{.AppName}}", map[string]interface{}{
+		"AppName": appName,
+	})
+
+	if appConfig.CurrentApplication.GUID == "" {
+		cmd.UI.DisplayText("App {{.AppName}} does not exist and would be created.", map[string]interface{}{
+			"AppName": appName,
+		})
+	}
+
+	changes := appConfig.Changes()
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("No differences")
+		return
+	}
+
+	for _, change := range changes {
+		field := cmd.UI.TranslateText(change.Field)
+		if change.Current != "" {
+			cmd.UI.DisplayDiffRemoval(field + ": " + change.Current)
+		}
+		if change.Desired != "" {
+			cmd.UI.DisplayDiffAddition(field + ": " + change.Desired)
+		}
+	}
+}
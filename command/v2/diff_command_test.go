@@ -0,0 +1,171 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff Command", func() {
+	var (
+		cmd             DiffCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDiffActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDiffActor)
+
+		cmd = DiffCommand{
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+			PathToManifest: "some-manifest.yml",
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		var apps []manifest.Application
+
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			apps = []manifest.Application{{Name: "some-app"}, {Name: "new-app"}}
+			fakeActor.ReadManifestReturns(apps, nil)
+		})
+
+		Context("when reading the manifest fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-manifest-error")
+				fakeActor.ReadManifestReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal("some-manifest.yml"))
+				Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when converting to app configs fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-convert-error")
+				fakeActor.ConvertToApplicationConfigReturns(nil, pushaction.Warnings{"some-config-warning"}, expectedErr)
+			})
+
+			It("displays the warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("some-config-warning"))
+			})
+		})
+
+		Context("when the apps can be converted to app configs", func() {
+			BeforeEach(func() {
+				domain := v2action.Domain{Name: "example.com"}
+				existingApp := v2action.Application{
+					GUID:                 "some-app-guid",
+					Name:                 "some-app",
+					Memory:               256,
+					EnvironmentVariables: map[string]string{"SOME_VAR": "old-value"},
+				}
+				desiredApp := existingApp
+				desiredApp.Memory = 1024
+				desiredApp.EnvironmentVariables = map[string]string{"SOME_VAR": "new-value"}
+
+				fakeActor.ConvertToApplicationConfigReturns([]pushaction.ApplicationConfig{
+					{
+						CurrentApplication: existingApp,
+						DesiredApplication: desiredApp,
+						CurrentRoutes:      []v2action.Route{{Host: "some-app", Domain: domain}},
+						DesiredRoutes:      []v2action.Route{{Host: "some-app", Domain: domain}, {Host: "some-other-host", Domain: domain}},
+					},
+					{
+						DesiredApplication: v2action.Application{Name: "new-app"},
+						DesiredRoutes:      []v2action.Route{{Host: "new-app", Domain: domain}},
+					},
+					{
+						CurrentApplication: v2action.Application{GUID: "unchanged-app-guid", Name: "unchanged-app"},
+						DesiredApplication: v2action.Application{GUID: "unchanged-app-guid", Name: "unchanged-app"},
+					},
+				}, pushaction.Warnings{"some-config-warning"}, nil)
+			})
+
+			It("displays the changes for every app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+				Expect(testUI.Out).To(Say("Comparing manifest some-manifest.yml to apps in org some-org / space some-space as some-user..."))
+
+				Expect(testUI.Out).To(Say("app: some-app"))
+				Expect(testUI.Out).To(Say("- memory: 256M"))
+				Expect(testUI.Out).To(Say("\\+ memory: 1G"))
+				Expect(testUI.Out).To(Say("- env SOME_VAR: old-value"))
+				Expect(testUI.Out).To(Say("\\+ env SOME_VAR: new-value"))
+				Expect(testUI.Out).To(Say("\\+ route: some-other-host.example.com"))
+
+				Expect(testUI.Out).To(Say("app: new-app"))
+				Expect(testUI.Out).To(Say("App new-app does not exist and would be created."))
+				Expect(testUI.Out).To(Say("\\+ route: new-app.example.com"))
+
+				Expect(testUI.Out).To(Say("app: unchanged-app"))
+				Expect(testUI.Out).To(Say("No differences"))
+
+				Expect(testUI.Err).To(Say("some-config-warning"))
+
+				orgGUID, spaceGUID, convertedApps, reset := fakeActor.ConvertToApplicationConfigArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(convertedApps).To(Equal(apps))
+				Expect(reset).To(BeFalse())
+			})
+		})
+	})
+})
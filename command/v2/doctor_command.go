@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/diagnosticsaction"
+	"code.cloudfoundry.org/cli/api/uaa"
+	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . DoctorActor
+
+type DoctorActor interface {
+	RunChecks(config diagnosticsaction.Config) []diagnosticsaction.Check
+}
+
+type DoctorCommand struct {
+	usage           interface{} `usage:"CF_NAME doctor\n\n   Checks the connection to the targeted API, the TLS certificate, the UAA tokens, doppler connectivity, CLI version compatibility and the proxy configuration."`
+	relatedCommands interface{} `related_commands:"api, login, version"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  DoctorActor
+}
+
+func (cmd *DoctorCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	// The checks target the API themselves, so that a failure to reach it is
+	// reported as a diagnostic instead of an error.
+	ccClient, _, err := shared.NewClients(config, ui, false)
+	if err != nil {
+		return err
+	}
+
+	uaaClient := uaa.NewClient(uaa.Config{
+		AppName:           config.BinaryName(),
+		AppVersion:        config.BinaryVersion(),
+		ClientID:          config.UAAOAuthClient(),
+		ClientSecret:      config.UAAOAuthClientSecret(),
+		DialTimeout:       config.DialTimeout(),
+		SkipSSLValidation: config.SkipSSLValidation(),
+		URL:               config.UAAEndpoint(),
+	})
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+
+	cmd.Actor = diagnosticsaction.NewActor(ccClient, uaaClient, diagnosticsaction.NewNetworkClient(config.DialTimeout()))
+
+	return nil
+}
+
+func (cmd DoctorCommand) Execute(args []string) error {
+	if cmd.Config.Target() == "" {
+		cmd.UI.DisplayTextWithFlavor("Running diagnostics...")
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Running diagnostics against {{.API}}...", map[string]interface{}{
+			"API": cmd.Config.Target(),
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	checks := cmd.Actor.RunChecks(cmd.Config)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("check"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+	var advice []diagnosticsaction.Check
+	failed := 0
+	for _, check := range checks {
+		table = append(table, []string{check.Name, string(check.Status), check.Details})
+		if check.Advice != "" {
+			advice = append(advice, check)
+		}
+		if check.Status == diagnosticsaction.CheckFailed {
+			failed++
+		}
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if len(advice) > 0 {
+		cmd.UI.DisplayNewline()
+		for _, check := range advice {
+			cmd.UI.DisplayText("{{.Check}}: {{.Advice}}", map[string]interface{}{
+				"Check":  check.Name,
+				"Advice": check.Advice,
+			})
+		}
+	}
+
+	if failed > 0 {
+		return shared.DiagnosticsFailedError{FailedChecks: failed}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,96 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/diagnosticsaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("doctor Command", func() {
+	var (
+		cmd        DoctorCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeDoctorActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeDoctorActor)
+
+		cmd = DoctorCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		fakeConfig.TargetReturns("https://api.some-domain.com")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("runs the checks with the config", func() {
+		Expect(fakeActor.RunChecksCallCount()).To(Equal(1))
+		Expect(fakeActor.RunChecksArgsForCall(0)).To(Equal(fakeConfig))
+		Expect(testUI.Out).To(Say("Running diagnostics against https://api.some-domain.com\\.\\.\\."))
+	})
+
+	Context("when no API is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("")
+		})
+
+		It("still runs the checks", func() {
+			Expect(testUI.Out).To(Say("Running diagnostics\\.\\.\\."))
+			Expect(fakeActor.RunChecksCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when every check passes", func() {
+		BeforeEach(func() {
+			fakeActor.RunChecksReturns([]diagnosticsaction.Check{
+				{Name: "API reachability", Status: diagnosticsaction.CheckPassed, Details: "api is reachable"},
+				{Name: "TLS certificate", Status: diagnosticsaction.CheckWarning, Details: "expires soon", Advice: "renew it"},
+			})
+		})
+
+		It("displays the checks and the advice", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("check\\s+status\\s+details"))
+			Expect(testUI.Out).To(Say("API reachability\\s+ok\\s+api is reachable"))
+			Expect(testUI.Out).To(Say("TLS certificate\\s+warning\\s+expires soon"))
+			Expect(testUI.Out).To(Say("TLS certificate: renew it"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when checks fail", func() {
+		BeforeEach(func() {
+			fakeActor.RunChecksReturns([]diagnosticsaction.Check{
+				{Name: "API reachability", Status: diagnosticsaction.CheckFailed, Details: "connection refused", Advice: "check the URL"},
+				{Name: "UAA token", Status: diagnosticsaction.CheckFailed, Details: "not logged in", Advice: "log in"},
+				{Name: "Proxy configuration", Status: diagnosticsaction.CheckPassed, Details: "no proxy"},
+			})
+		})
+
+		It("displays the advice and returns a DiagnosticsFailedError", func() {
+			Expect(executeErr).To(MatchError(shared.DiagnosticsFailedError{FailedChecks: 2}))
+
+			Expect(testUI.Out).To(Say("API reachability\\s+failed\\s+connection refused"))
+			Expect(testUI.Out).To(Say("API reachability: check the URL"))
+			Expect(testUI.Out).To(Say("UAA token: log in"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -1,22 +1,139 @@
 package v2
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
+	"strconv"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . DomainsActor
+
+type DomainsActor interface {
+	GetOrganizationDomainSummaries(orgGUID string) ([]v2action.DomainSummary, v2action.Warnings, error)
+}
+
 type DomainsCommand struct {
-	usage           interface{} `usage:"CF_NAME domains"`
+	JSON            bool        `long:"json" description:"Output the domains as JSON"`
+	usage           interface{} `usage:"CF_NAME domains [--json]\n\n   The routes column counts the routes of the targeted org that use each domain."`
 	relatedCommands interface{} `related_commands:"router-groups, create-route, routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DomainsActor
+}
+
+type domainJSON struct {
+	Name            string `json:"name"`
+	GUID            string `json:"guid"`
+	Type            string `json:"type"`
+	RouterGroupGUID string `json:"router_group_guid,omitempty"`
+	RouterGroupType string `json:"router_group_type,omitempty"`
+	Routes          int    `json:"routes"`
 }
 
-func (_ DomainsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DomainsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if command.UsesLegacyImplementation(cmd.Config, "domains", command.JSONOutputRequested(cmd.Config, cmd.JSON)) {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ DomainsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd DomainsCommand) Execute(args []string) error {
+	cmd.JSON = command.JSONOutputRequested(cmd.Config, cmd.JSON)
+
+	if command.UsesLegacyImplementation(cmd.Config, "domains", command.JSONOutputRequested(cmd.Config, cmd.JSON)) {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	if !cmd.JSON {
+		user, err := cmd.Config.CurrentUser()
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Getting domains in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  org.Name,
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	summaries, warnings, err := cmd.Actor.GetOrganizationDomainSummaries(org.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.JSON {
+		domains := make([]domainJSON, 0, len(summaries))
+		for _, summary := range summaries {
+			domains = append(domains, domainJSON{
+				Name:            summary.Name,
+				GUID:            summary.GUID,
+				Type:            string(summary.Type),
+				RouterGroupGUID: summary.RouterGroupGUID,
+				RouterGroupType: summary.RouterGroupType,
+				Routes:          summary.Routes,
+			})
+		}
+
+		output, err := json.MarshalIndent(domains, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+		return err
+	}
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No domains found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("router group"),
+			cmd.UI.TranslateText("routes"),
+		},
+	}
+	for _, summary := range summaries {
+		table = append(table, []string{
+			summary.Name,
+			cmd.UI.TranslateText(string(summary.Type)),
+			summary.RouterGroupType,
+			strconv.Itoa(summary.Routes),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
@@ -0,0 +1,153 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("domains Command", func() {
+	var (
+		cmd             DomainsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDomainsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDomainsActor)
+
+		cmd = DomainsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+
+		fakeActor.GetOrganizationDomainSummariesReturns([]v2action.DomainSummary{
+			{Domain: v2action.Domain{GUID: "shared-guid", Name: "shared.com"}, Type: v2action.SharedDomainType, Routes: 3},
+			{Domain: v2action.Domain{GUID: "tcp-guid", Name: "tcp.shared.com", RouterGroupGUID: "router-group-guid", RouterGroupType: "tcp"}, Type: v2action.SharedDomainType, Routes: 1},
+			{Domain: v2action.Domain{GUID: "internal-guid", Name: "apps.internal", Internal: true}, Type: v2action.InternalDomainType},
+			{Domain: v2action.Domain{GUID: "private-guid", Name: "private.com"}, Type: v2action.PrivateDomainType, Routes: 2},
+		}, v2action.Warnings{"domains-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("displays the domains with their type, router group and route count", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say("Getting domains in org some-org as some-user..."))
+		Expect(testUI.Out).To(Say(`name\s+type\s+router group\s+routes`))
+		Expect(testUI.Out).To(Say(`shared\.com\s+shared\s+3`))
+		Expect(testUI.Out).To(Say(`tcp\.shared\.com\s+shared\s+tcp\s+1`))
+		Expect(testUI.Out).To(Say(`apps\.internal\s+internal\s+0`))
+		Expect(testUI.Out).To(Say(`private\.com\s+private\s+2`))
+		Expect(testUI.Err).To(Say("domains-warning"))
+
+		Expect(fakeActor.GetOrganizationDomainSummariesArgsForCall(0)).To(Equal("some-org-guid"))
+	})
+
+	Context("when there are no domains", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationDomainSummariesReturns(nil, nil, nil)
+		})
+
+		It("displays that no domains were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No domains found"))
+		})
+	})
+
+	Context("when the --json flag is provided", func() {
+		BeforeEach(func() {
+			cmd.JSON = true
+			fakeConfig.ExperimentalReturns(false)
+		})
+
+		It("displays the domains as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("domains-warning"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+				{"name": "shared.com", "guid": "shared-guid", "type": "shared", "routes": 3},
+				{"name": "tcp.shared.com", "guid": "tcp-guid", "type": "shared", "router_group_guid": "router-group-guid", "router_group_type": "tcp", "routes": 1},
+				{"name": "apps.internal", "guid": "internal-guid", "type": "internal", "routes": 0},
+				{"name": "private.com", "guid": "private-guid", "type": "private", "routes": 2}
+			]`))
+		})
+
+		Context("when there are no domains", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationDomainSummariesReturns(nil, nil, nil)
+			})
+
+			It("displays an empty JSON list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[]`))
+			})
+		})
+	})
+
+	Context("when the output-format setting is json", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns("json")
+		})
+
+		It("displays the domains as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting domains"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring(`"name": "shared.com"`))
+		})
+	})
+
+	Context("when getting the domains fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("domains-error")
+			fakeActor.GetOrganizationDomainSummariesReturns(nil, v2action.Warnings{"domains-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("domains-warning"))
+		})
+	})
+})
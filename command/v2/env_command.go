@@ -1,24 +1,242 @@
 package v2
 
 import (
+	"encoding/json"
 	"os"
+	"sort"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/credhubaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
+//go:generate counterfeiter . EnvActor
+
+type EnvActor interface {
+	GetEnvironmentByApplicationNameAndSpace(appName string, spaceGUID string) (v3action.Environment, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . EnvCredHubActor
+
+type EnvCredHubActor interface {
+	InterpolateServiceCredentials(vcapServices map[string]interface{}) (map[string]interface{}, []string, error)
+}
+
 type EnvCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME env APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, set-env, unset-env, running-environment-variable-group, staging-environment-variable-group"`
+	RequiredArgs       flag.OptionalAppName `positional-args:"yes"`
+	InterpolateCredHub bool                 `long:"interpolate-credhub" description:"Replace the CredHub references in VCAP_SERVICES with the credentials they refer to, when you are allowed to read them"`
+	Effective          bool                 `long:"effective" description:"Display the CF_* environment variables used by the CLI with their values and where the values come from (env, config or default)"`
+	usage              interface{}          `usage:"CF_NAME env APP_NAME [--interpolate-credhub]\n   CF_NAME env --effective\n\n   The CredHub API is derived from the targeted API, or set with CF_CREDHUB_API."`
+	relatedCommands    interface{}          `related_commands:"app, apps, set-env, unset-env, running-environment-variable-group, staging-environment-variable-group"`
+
+	UI           command.UI
+	Config       command.Config
+	SharedActor  command.SharedActor
+	Actor        EnvActor
+	CredHubActor EnvCredHubActor
 }
 
-func (_ EnvCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only creates the clients when the refactored implementation is needed,
+// which is when CredHub references are interpolated. --effective does not
+// need any client.
+func (cmd *EnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if cmd.Effective || command.UsesLegacyImplementation(config, "env", cmd.InterpolateCredHub) {
+		return nil
+	}
+
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); !ok {
+			return err
+		}
+		return nil
+	}
+	cmd.Actor = v3action.NewActor(ccClientV3, config)
+
+	if !cmd.InterpolateCredHub {
+		return nil
+	}
+
+	_, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	credhubClient, err := shared.NewCredHubClient(config, ui, uaaClient)
+	if err != nil {
+		return err
+	}
+	cmd.CredHubActor = credhubaction.NewActor(credhubClient)
+
 	return nil
 }
 
-func (_ EnvCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd EnvCommand) Execute(args []string) error {
+	if cmd.Effective {
+		switch {
+		case cmd.RequiredArgs.AppName != "":
+			return command.ArgumentCombinationError{Arg1: "--effective", Arg2: "APP_NAME"}
+		case cmd.InterpolateCredHub:
+			return command.ArgumentCombinationError{Arg1: "--effective", Arg2: "--interpolate-credhub"}
+		}
+		cmd.displayEffectiveSettings()
+		return nil
+	}
+
+	if cmd.RequiredArgs.AppName == "" {
+		return command.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+
+	if cmd.Actor == nil {
+		if cmd.InterpolateCredHub {
+			return sharedV3.V3APIDoesNotExistError{Message: "Option '--interpolate-credhub' requires the CF V3 API."}
+		}
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting env variables for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	environment, warnings, err := cmd.Actor.GetEnvironmentByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	if cmd.InterpolateCredHub {
+		err = cmd.interpolateCredHub(environment.System)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	err = cmd.displaySystemProvided(environment)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	cmd.displayVariables("User-Provided:", "No user-defined env variables have been set", environment.EnvironmentVariables)
+	cmd.UI.DisplayNewline()
+	cmd.displayVariables("Running Environment Variable Groups:", "No running env variables have been set", environment.RunningGroup)
+	cmd.UI.DisplayNewline()
+	cmd.displayVariables("Staging Environment Variable Groups:", "No staging env variables have been set", environment.StagingGroup)
+
+	return nil
+}
+
+// interpolateCredHub replaces the CredHub references in the VCAP_SERVICES of
+// the system-provided environment, warning about the ones that could not be
+// read.
+func (cmd EnvCommand) interpolateCredHub(system map[string]interface{}) error {
+	vcapServices, ok := system["VCAP_SERVICES"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	interpolated, unresolved, err := cmd.CredHubActor.InterpolateServiceCredentials(vcapServices)
+	if err != nil {
+		return err
+	}
+	system["VCAP_SERVICES"] = interpolated
+
+	for _, ref := range unresolved {
+		cmd.UI.DisplayWarning("Unable to read credentials {{.Ref}} from CredHub; showing the reference instead.", map[string]interface{}{
+			"Ref": ref,
+		})
+	}
+
+	return nil
+}
+
+func (cmd EnvCommand) displaySystemProvided(environment v3action.Environment) error {
+	vcapServices, _ := environment.System["VCAP_SERVICES"].(map[string]interface{})
+	vcapApplication, _ := environment.Application["VCAP_APPLICATION"].(map[string]interface{})
+	if len(vcapServices) == 0 && len(vcapApplication) == 0 {
+		cmd.UI.DisplayText("No system-provided env variables have been set")
+		return nil
+	}
+
+	cmd.UI.DisplayHeader("System-Provided:")
+	for _, group := range []map[string]interface{}{environment.System, environment.Application} {
+		if len(group) == 0 {
+			continue
+		}
+		jsonBytes, err := json.MarshalIndent(group, "", " ")
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayText(string(jsonBytes))
+		cmd.UI.DisplayNewline()
+	}
+
 	return nil
 }
+
+func (cmd EnvCommand) displayVariables(header string, empty string, variables map[string]interface{}) {
+	if len(variables) == 0 {
+		cmd.UI.DisplayText(empty)
+		return
+	}
+
+	keys := make([]string, 0, len(variables))
+	for key := range variables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	cmd.UI.DisplayHeader(header)
+	for _, key := range keys {
+		cmd.UI.DisplayText("{{.Key}}: {{.Value}}", map[string]interface{}{
+			"Key":   key,
+			"Value": variables[key],
+		})
+	}
+}
+
+// displayEffectiveSettings displays the CF_* environment variables that the
+// CLI reads, with the value that it uses and where the value comes from.
+func (cmd EnvCommand) displayEffectiveSettings() {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("variable"),
+			cmd.UI.TranslateText("value"),
+			cmd.UI.TranslateText("source"),
+		},
+	}
+	for _, setting := range cmd.Config.EffectiveSettings() {
+		table = append(table, []string{
+			setting.EnvironmentVariable,
+			setting.Value,
+			cmd.UI.TranslateText(setting.Source),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
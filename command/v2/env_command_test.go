@@ -0,0 +1,230 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("env Command", func() {
+	var (
+		cmd              EnvCommand
+		testUI           *ui.UI
+		fakeConfig       *commandfakes.FakeConfig
+		fakeSharedActor  *commandfakes.FakeSharedActor
+		fakeActor        *v2fakes.FakeEnvActor
+		fakeCredHubActor *v2fakes.FakeEnvCredHubActor
+		binaryName       string
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeEnvActor)
+		fakeCredHubActor = new(v2fakes.FakeEnvCredHubActor)
+
+		cmd = EnvCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			CredHubActor: fakeCredHubActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetEnvironmentByApplicationNameAndSpaceStub = func(string, string) (v3action.Environment, v3action.Warnings, error) {
+			return v3action.Environment{
+				EnvironmentVariables: map[string]interface{}{"SOME_VAR": "some-value"},
+				RunningGroup:         map[string]interface{}{"RUNNING_VAR": "running-value"},
+				System: map[string]interface{}{
+					"VCAP_SERVICES": map[string]interface{}{
+						"some-service": []interface{}{
+							map[string]interface{}{"credentials": map[string]interface{}{"credhub-ref": "/c/some-ref"}},
+						},
+					},
+				},
+				Application: map[string]interface{}{
+					"VCAP_APPLICATION": map[string]interface{}{"application_name": "some-app"},
+				},
+			}, v3action.Warnings{"get-env-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when interpolating CredHub references without the V3 API", func() {
+		BeforeEach(func() {
+			cmd.Actor = nil
+			cmd.InterpolateCredHub = true
+		})
+
+		It("returns a V3APIDoesNotExistError", func() {
+			Expect(executeErr).To(MatchError(sharedV3.V3APIDoesNotExistError{Message: "Option '--interpolate-credhub' requires the CF V3 API."}))
+		})
+	})
+
+	Context("when no app name is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+
+	Context("when --effective is provided", func() {
+		BeforeEach(func() {
+			cmd.Effective = true
+			cmd.RequiredArgs.AppName = ""
+			fakeConfig.EffectiveSettingsReturns([]configv3.EffectiveSetting{
+				{EnvironmentVariable: "CF_COLOR", Value: "true", Source: configv3.SettingSourceConfig},
+				{EnvironmentVariable: "CF_TRACE", Value: "/some/file", Source: configv3.SettingSourceEnvironment},
+			})
+		})
+
+		It("displays the CF_* environment variables and their sources", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`variable\s+value\s+source`))
+			Expect(testUI.Out).To(Say(`CF_COLOR\s+true\s+config`))
+			Expect(testUI.Out).To(Say(`CF_TRACE\s+/some/file\s+env`))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetEnvironmentByApplicationNameAndSpaceCallCount()).To(Equal(0))
+		})
+
+		Context("when an app name is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppName = "some-app"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--effective", Arg2: "APP_NAME"}))
+			})
+		})
+
+		Context("when --interpolate-credhub is provided", func() {
+			BeforeEach(func() {
+				cmd.InterpolateCredHub = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--effective", Arg2: "--interpolate-credhub"}))
+			})
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentByApplicationNameAndSpaceStub = nil
+			fakeActor.GetEnvironmentByApplicationNameAndSpaceReturns(
+				v3action.Environment{},
+				v3action.Warnings{"get-env-warning"},
+				v3action.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-env-warning"))
+		})
+	})
+
+	Context("when CredHub references are not interpolated", func() {
+		It("displays the environment with the references", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting env variables for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("System-Provided:"))
+			Expect(testUI.Out).To(Say(`"credhub-ref": "/c/some-ref"`))
+			Expect(testUI.Out).To(Say(`"application_name": "some-app"`))
+			Expect(testUI.Out).To(Say("User-Provided:"))
+			Expect(testUI.Out).To(Say("SOME_VAR: some-value"))
+			Expect(testUI.Out).To(Say("Running Environment Variable Groups:"))
+			Expect(testUI.Out).To(Say("RUNNING_VAR: running-value"))
+			Expect(testUI.Out).To(Say("No staging env variables have been set"))
+			Expect(testUI.Err).To(Say("get-env-warning"))
+
+			appName, spaceGUID := fakeActor.GetEnvironmentByApplicationNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeCredHubActor.InterpolateServiceCredentialsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when CredHub references are interpolated", func() {
+		BeforeEach(func() {
+			cmd.InterpolateCredHub = true
+			fakeCredHubActor.InterpolateServiceCredentialsReturns(
+				map[string]interface{}{
+					"some-service": []interface{}{
+						map[string]interface{}{"credentials": map[string]interface{}{"password": "secret-password"}},
+					},
+				},
+				[]string{"/c/hidden-ref"},
+				nil,
+			)
+		})
+
+		It("displays the credentials and warns about the unreadable references", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			vcapServices := fakeCredHubActor.InterpolateServiceCredentialsArgsForCall(0)
+			Expect(vcapServices).To(HaveKey("some-service"))
+
+			Expect(testUI.Out).To(Say("System-Provided:"))
+			Expect(testUI.Out).To(Say(`"password": "secret-password"`))
+			Expect(testUI.Err).To(Say(`Unable to read credentials /c/hidden-ref from CredHub; showing the reference instead\.`))
+		})
+
+		Context("when interpolating fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("credhub error")
+				fakeCredHubActor.InterpolateServiceCredentialsReturns(nil, nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
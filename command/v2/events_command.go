@@ -3,21 +3,162 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+const eventTimestampFormat = "2006-01-02T15:04:05.00-0700"
+
+//go:generate counterfeiter . EventsActor
+
+type EventsActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetRecentApplicationEvents(appGUID string) ([]v2action.Event, v2action.Warnings, error)
+	GetStreamingApplicationEvents(appGUID string, previousEvents []v2action.Event, config v2action.Config) (<-chan v2action.Event, <-chan string, <-chan error)
+}
+
 type EventsCommand struct {
 	RequiredArgs flag.AppName `positional-args:"yes"`
-	usage        interface{}  `usage:"CF_NAME events APP_NAME"`
+	Follow       bool         `long:"follow" short:"f" description:"Display the recent events oldest first, then keep displaying new events as they are recorded"`
+	usage        interface{}  `usage:"CF_NAME events APP_NAME [--follow]"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EventsActor
 }
 
-func (_ EventsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *EventsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if command.UsesLegacyImplementation(cmd.Config, "events", cmd.Follow) {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ EventsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd EventsCommand) Execute(args []string) error {
+	if command.UsesLegacyImplementation(cmd.Config, "events", cmd.Follow) {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   app.Name,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	events, warnings, err := cmd.Actor.GetRecentApplicationEvents(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Follow {
+		for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+			events[i], events[j] = events[j], events[i]
+		}
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("event"),
+			cmd.UI.TranslateText("actor"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+	for _, event := range events {
+		table = append(table, eventRow(event))
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if !cmd.Follow {
+		if len(events) == 0 {
+			cmd.UI.DisplayText("No events for app {{.AppName}}", map[string]interface{}{
+				"AppName": app.Name,
+			})
+		}
+		return nil
+	}
+
+	return cmd.followEvents(app.GUID, events)
+}
+
+// followEvents displays new events of the app as they are recorded, until
+// polling for them fails.
+func (cmd EventsCommand) followEvents(appGUID string, previousEvents []v2action.Event) error {
+	events, warnings, errs := cmd.Actor.GetStreamingApplicationEvents(appGUID, previousEvents, cmd.Config)
+
+	for events != nil || warnings != nil || errs != nil {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			row := eventRow(event)
+			cmd.UI.DisplayText("{{.Time}}   {{.Event}}   {{.Actor}}   {{.Description}}", map[string]interface{}{
+				"Time":        row[0],
+				"Event":       row[1],
+				"Actor":       row[2],
+				"Description": row[3],
+			})
+		case warning, ok := <-warnings:
+			if !ok {
+				warnings = nil
+				continue
+			}
+			cmd.UI.DisplayWarning(warning)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			return shared.HandleError(err)
+		}
+	}
+
 	return nil
 }
+
+func eventRow(event v2action.Event) []string {
+	return []string{
+		event.Timestamp.Local().Format(eventTimestampFormat),
+		event.Type,
+		event.Actor,
+		event.Description,
+	}
+}
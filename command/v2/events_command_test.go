@@ -0,0 +1,229 @@
+package v2_test
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("events Command", func() {
+	var (
+		cmd             EventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeEventsActor
+		binaryName      string
+		executeErr      error
+		recentEvents    []v2action.Event
+		streamedEvents  []v2action.Event
+		streamedErr     error
+	)
+
+	formatTime := func(t time.Time) string {
+		return regexp.QuoteMeta(t.Local().Format("2006-01-02T15:04:05.00-0700"))
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeEventsActor)
+
+		cmd = EventsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"get-app-warning"},
+			nil,
+		)
+
+		recentEvents = []v2action.Event{
+			{
+				GUID:        "event-guid-2",
+				Type:        "audit.app.update",
+				Actor:       "some-user",
+				Timestamp:   time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC),
+				Description: "instances: 2",
+			},
+			{
+				GUID:      "event-guid-1",
+				Type:      "audit.app.create",
+				Actor:     "some-user",
+				Timestamp: time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC),
+			},
+		}
+		fakeActor.GetRecentApplicationEventsStub = func(string) ([]v2action.Event, v2action.Warnings, error) {
+			events := make([]v2action.Event, len(recentEvents))
+			copy(events, recentEvents)
+			return events, v2action.Warnings{"get-events-warning"}, nil
+		}
+
+		streamedEvents = nil
+		streamedErr = nil
+		fakeActor.GetStreamingApplicationEventsStub = func(string, []v2action.Event, v2action.Config) (<-chan v2action.Event, <-chan string, <-chan error) {
+			events := make(chan v2action.Event)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				for _, event := range streamedEvents {
+					events <- event
+				}
+				warnings <- "stream-warning"
+				if streamedErr != nil {
+					errs <- streamedErr
+				}
+				close(events)
+				close(warnings)
+				close(errs)
+			}()
+
+			return events, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{},
+				v2action.Warnings{"get-app-warning"},
+				v2action.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.GetRecentApplicationEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the events fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.GetRecentApplicationEventsStub = nil
+			fakeActor.GetRecentApplicationEventsReturns(nil, v2action.Warnings{"get-events-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+		})
+	})
+
+	Context("when --follow is not provided", func() {
+		It("displays the recent events newest first", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting events for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+description`))
+			Expect(testUI.Out).To(Say(`%s\s+audit.app.update\s+some-user\s+instances: 2`, formatTime(recentEvents[0].Timestamp)))
+			Expect(testUI.Out).To(Say(`%s\s+audit.app.create\s+some-user`, formatTime(recentEvents[1].Timestamp)))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+
+			Expect(fakeActor.GetRecentApplicationEventsArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeActor.GetStreamingApplicationEventsCallCount()).To(Equal(0))
+		})
+
+		Context("when the app has no events", func() {
+			BeforeEach(func() {
+				recentEvents = nil
+			})
+
+			It("displays a message", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No events for app some-app"))
+			})
+		})
+	})
+
+	Context("when --follow is provided", func() {
+		BeforeEach(func() {
+			cmd.Follow = true
+			fakeConfig.ExperimentalReturns(false)
+
+			streamedEvents = []v2action.Event{
+				{
+					GUID:      "event-guid-3",
+					Type:      "audit.app.stop",
+					Actor:     "another-user",
+					Timestamp: time.Date(2017, 6, 1, 10, 7, 0, 0, time.UTC),
+				},
+			}
+		})
+
+		It("displays the recent events oldest first followed by new events", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+description`))
+			Expect(testUI.Out).To(Say(`%s\s+audit.app.create\s+some-user`, formatTime(recentEvents[1].Timestamp)))
+			Expect(testUI.Out).To(Say(`%s\s+audit.app.update\s+some-user\s+instances: 2`, formatTime(recentEvents[0].Timestamp)))
+			Expect(testUI.Out).To(Say(`%s\s+audit.app.stop\s+another-user`, formatTime(streamedEvents[0].Timestamp)))
+			Expect(testUI.Err).To(Say("stream-warning"))
+
+			Expect(fakeActor.GetStreamingApplicationEventsCallCount()).To(Equal(1))
+			appGUID, previousEvents, config := fakeActor.GetStreamingApplicationEventsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(previousEvents).To(Equal([]v2action.Event{recentEvents[1], recentEvents[0]}))
+			Expect(config).To(Equal(fakeConfig))
+		})
+
+		Context("when polling for events fails", func() {
+			BeforeEach(func() {
+				streamedErr = errors.New("poll-error")
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(streamedErr))
+			})
+		})
+	})
+})
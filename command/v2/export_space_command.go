@@ -0,0 +1,88 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	log "github.com/Sirupsen/logrus"
+)
+
+//go:generate counterfeiter . ExportSpaceActor
+
+type ExportSpaceActor interface {
+	ExportSpace(orgGUID string, spaceGUID string) (manifest.Space, pushaction.Warnings, error)
+	WriteSpaceFile(pathToSpace string, space manifest.Space) error
+}
+
+type ExportSpaceCommand struct {
+	OutputFile      flag.Path   `short:"o" required:"true" description:"Path of the file to write the space to"`
+	usage           interface{} `usage:"CF_NAME export-space -o SPACE_FILE\n\n   Writes the apps of the targeted space, in manifest form, along with its service instances and the bindings between them. App bits and the credentials of user provided service instances are not exported."`
+	relatedCommands interface{} `related_commands:"create-app-manifest, import-space"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportSpaceActor
+}
+
+func (cmd *ExportSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	v3Actor, err := newPushV3Actor(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pushaction.NewActor(v2action.NewActor(ccClient, uaaClient), v3Actor)
+	return nil
+}
+
+func (cmd ExportSpaceCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Exporting space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"Username":  user.Name,
+	})
+
+	space, warnings, err := cmd.Actor.ExportSpace(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("exporting space:", err)
+		return shared.HandleError(err)
+	}
+
+	err = cmd.Actor.WriteSpaceFile(string(cmd.OutputFile), space)
+	if err != nil {
+		log.Errorln("writing space file:", err)
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Space exported to {{.Path}}", map[string]interface{}{
+		"Path": cmd.OutputFile,
+	})
+
+	return nil
+}
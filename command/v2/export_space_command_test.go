@@ -0,0 +1,136 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-space Command", func() {
+	var (
+		cmd             ExportSpaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeExportSpaceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeExportSpaceActor)
+
+		cmd = ExportSpaceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			OutputFile:  "space.yml",
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when exporting the space fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-export-error")
+				fakeActor.ExportSpaceReturns(manifest.Space{}, pushaction.Warnings{"some-export-warning"}, expectedErr)
+			})
+
+			It("displays the warnings and returns the error without writing the file", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("some-export-warning"))
+				Expect(fakeActor.WriteSpaceFileCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space is exported", func() {
+			var space manifest.Space
+
+			BeforeEach(func() {
+				space = manifest.Space{
+					Applications: []manifest.Application{{Name: "some-app"}},
+					Services:     []manifest.Service{{Name: "some-db", Service: "some-service", Plan: "some-plan"}},
+				}
+				fakeActor.ExportSpaceReturns(space, pushaction.Warnings{"some-export-warning"}, nil)
+			})
+
+			Context("when writing the file fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-write-error")
+					fakeActor.WriteSpaceFileReturns(expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+
+			Context("when the file is written", func() {
+				It("writes the space to the output file", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+					Expect(testUI.Out).To(Say("Exporting space some-space in org some-org as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Space exported to space.yml"))
+					Expect(testUI.Err).To(Say("some-export-warning"))
+
+					orgGUID, spaceGUID := fakeActor.ExportSpaceArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+
+					Expect(fakeActor.WriteSpaceFileCallCount()).To(Equal(1))
+					path, writtenSpace := fakeActor.WriteSpaceFileArgsForCall(0)
+					Expect(path).To(Equal("space.yml"))
+					Expect(writtenSpace).To(Equal(space))
+				})
+			})
+		})
+	})
+})
@@ -1,25 +1,118 @@
 package v2
 
 import (
-	"os"
+	"fmt"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
 )
 
+//go:generate counterfeiter . FilesActor
+
+type FilesActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetSSHAuthenticationForApplicationInstance(app v2action.Application, instanceIndex int, accessToken string) (v2action.SSHAuthentication, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . FilesSecureShell
+
+type FilesSecureShell interface {
+	Run(options clissh.Options, command string) ([]byte, error)
+}
+
 type FilesCommand struct {
 	RequiredArgs    flag.FilesArgs `positional-args:"yes"`
 	Instance        int            `short:"i" description:"Instance"`
-	usage           interface{}    `usage:"CF_NAME files APP_NAME [PATH] [-i INSTANCE]\n\nTIP:\n   To list and inspect files of an app running on the Diego backend, use 'CF_NAME ssh'"`
+	usage           interface{}    `usage:"CF_NAME files APP_NAME [PATH] [-i INSTANCE]\n\nTIP:\n   Directories are listed and files are printed. PATH is relative to the app's home directory unless it starts with '/'.\n   The app must be started and have SSH enabled; use 'CF_NAME ssh' for an interactive session"`
 	relatedCommands interface{}    `related_commands:"ssh"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       FilesActor
+	SSH         FilesSecureShell
 }
 
-func (_ FilesCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *FilesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+	cmd.SSH = clissh.SecureShell{DialTimeout: config.DialTimeout()}
+
 	return nil
 }
 
-func (_ FilesCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd FilesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting files for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	// The access token is read after the first Cloud Controller request so
+	// that a token refreshed by that request is used for the passcode.
+	auth, warnings, err := cmd.Actor.GetSSHAuthenticationForApplicationInstance(app, cmd.Instance, cmd.Config.AccessToken())
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	path := cmd.RequiredArgs.Path
+	if path == "" {
+		path = "."
+	}
+
+	output, err := cmd.SSH.Run(clissh.Options{
+		Endpoint:           auth.Endpoint,
+		HostKeyFingerprint: auth.HostKeyFingerprint,
+		Username:           auth.Username,
+		Passcode:           auth.Passcode,
+	}, filesRemoteCommand(path))
+	if commandErr, ok := err.(clissh.CommandError); ok {
+		return shared.ApplicationFilesError{Path: path, Message: commandErr.Stderr}
+	}
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	_, err = fmt.Fprint(cmd.UI.Writer(), string(output))
+	return err
+}
+
+// filesRemoteCommand lists path when it is a directory and prints it
+// otherwise.
+func filesRemoteCommand(path string) string {
+	quotedPath := "'" + strings.Replace(path, "'", `'\''`, -1) + "'"
+	return fmt.Sprintf("if [ -d %[1]s ]; then ls -la %[1]s; else cat %[1]s; fi", quotedPath)
 }
@@ -0,0 +1,209 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("files Command", func() {
+	var (
+		cmd             FilesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeFilesActor
+		fakeSSH         *v2fakes.FakeFilesSecureShell
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeFilesActor)
+		fakeSSH = new(v2fakes.FakeFilesSecureShell)
+
+		cmd = FilesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			SSH:         fakeSSH,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.AccessTokenReturns("bearer some-access-token")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"app-warning"},
+			nil,
+		)
+		fakeActor.GetSSHAuthenticationForApplicationInstanceReturns(
+			v2action.SSHAuthentication{
+				Endpoint:           "ssh.example.com:2222",
+				HostKeyFingerprint: "some-fingerprint",
+				Username:           "cf:some-app-guid/0",
+				Passcode:           "some-passcode",
+			},
+			v2action.Warnings{"ssh-warning"},
+			nil,
+		)
+		fakeSSH.RunReturns([]byte("total 4\ndrwxr-xr-x 2 vcap vcap 4096 app\n"), nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when no path is provided", func() {
+		It("lists the instance's home directory over SSH", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting files for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("total 4\ndrwxr-xr-x 2 vcap vcap 4096 app"))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("ssh-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			app, instanceIndex, accessToken := fakeActor.GetSSHAuthenticationForApplicationInstanceArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(instanceIndex).To(Equal(0))
+			Expect(accessToken).To(Equal("bearer some-access-token"))
+
+			Expect(fakeSSH.RunCallCount()).To(Equal(1))
+			options, remoteCommand := fakeSSH.RunArgsForCall(0)
+			Expect(options).To(Equal(clissh.Options{
+				Endpoint:           "ssh.example.com:2222",
+				HostKeyFingerprint: "some-fingerprint",
+				Username:           "cf:some-app-guid/0",
+				Passcode:           "some-passcode",
+			}))
+			Expect(remoteCommand).To(Equal("if [ -d '.' ]; then ls -la '.'; else cat '.'; fi"))
+		})
+	})
+
+	Context("when a path and an instance are provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Path = "app/it's.txt"
+			cmd.Instance = 2
+		})
+
+		It("quotes the path and uses the instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, instanceIndex, _ := fakeActor.GetSSHAuthenticationForApplicationInstanceArgsForCall(0)
+			Expect(instanceIndex).To(Equal(2))
+
+			_, remoteCommand := fakeSSH.RunArgsForCall(0)
+			Expect(remoteCommand).To(Equal(`if [ -d 'app/it'\''s.txt' ]; then ls -la 'app/it'\''s.txt'; else cat 'app/it'\''s.txt'; fi`))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{},
+				v2action.Warnings{"app-warning"},
+				v2action.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeSSH.RunCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app is not started", func() {
+		BeforeEach(func() {
+			fakeActor.GetSSHAuthenticationForApplicationInstanceReturns(
+				v2action.SSHAuthentication{},
+				nil,
+				v2action.ApplicationNotStartedError{Name: "some-app"},
+			)
+		})
+
+		It("returns an ApplicationNotStartedError", func() {
+			Expect(executeErr).To(MatchError(shared.ApplicationNotStartedError{Name: "some-app"}))
+			Expect(fakeSSH.RunCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the instance does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetSSHAuthenticationForApplicationInstanceReturns(
+				v2action.SSHAuthentication{},
+				nil,
+				v2action.ApplicationInstanceNotFoundError{Name: "some-app", InstanceIndex: 3},
+			)
+		})
+
+		It("returns an ApplicationInstanceNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.ApplicationInstanceNotFoundError{Name: "some-app", InstanceIndex: 3}))
+		})
+	})
+
+	Context("when the remote command fails", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Path = "missing"
+			fakeSSH.RunReturns(nil, clissh.CommandError{ExitStatus: 1, Stderr: "cat: missing: No such file or directory"})
+		})
+
+		It("returns an ApplicationFilesError", func() {
+			Expect(executeErr).To(MatchError(shared.ApplicationFilesError{Path: "missing", Message: "cat: missing: No such file or directory"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when connecting fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("ssh: handshake failed")
+			fakeSSH.RunReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
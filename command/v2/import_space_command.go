@@ -0,0 +1,131 @@
+package v2
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	log "github.com/Sirupsen/logrus"
+)
+
+//go:generate counterfeiter . ImportSpaceActor
+
+type ImportSpaceActor interface {
+	Apply(ctx context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	BindServicesToApplication(appName string, spaceGUID string, serviceInstanceNames []string) (pushaction.Warnings, error)
+	ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	CreateSpaceServices(spaceGUID string, services []manifest.Service) (pushaction.Warnings, error)
+	ReadSpaceFile(pathToSpace string) (manifest.Space, error)
+}
+
+type ImportSpaceCommand struct {
+	RequiredArgs    flag.ImportSpaceArgs `positional-args:"yes"`
+	usage           interface{}          `usage:"CF_NAME import-space SPACE_FILE\n\n   Creates the service instances, apps, routes and service bindings described by a file written by export-space in the targeted space. Existing service instances and apps are updated rather than recreated. App bits are not included; push them afterwards."`
+	relatedCommands interface{}          `related_commands:"export-space, push, update-user-provided-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ImportSpaceActor
+}
+
+func (cmd *ImportSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewInterruptibleClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	v3Actor, err := newPushV3Actor(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pushaction.NewActor(v2action.NewActor(ccClient, uaaClient), v3Actor)
+	return nil
+}
+
+func (cmd ImportSpaceCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space, err := cmd.Actor.ReadSpaceFile(string(cmd.RequiredArgs.PathToSpaceFile))
+	if err != nil {
+		log.Errorln("reading space file:", err)
+		return shared.HandleError(err)
+	}
+
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	cmd.UI.DisplayTextWithFlavor("Importing {{.Path}} into org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Path":      cmd.RequiredArgs.PathToSpaceFile,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	cmd.UI.DisplayText("Creating service instances...")
+	warnings, err := cmd.Actor.CreateSpaceServices(spaceGUID, space.Services)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("creating services:", err)
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Getting app info...")
+	appConfigs, warnings, err := cmd.Actor.ConvertToApplicationConfig(orgGUID, spaceGUID, space.Applications, false)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("converting manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	pushCmd := V2PushCommand{UI: cmd.UI, Config: cmd.Config}
+	for i, appConfig := range appConfigs {
+		log.Infoln("starting create/update:", appConfig.DesiredApplication.Name)
+		ctx, stopApply := context.WithCancel(command.InterruptContext())
+		eventStream, warningsStream, errorStream := cmd.Actor.Apply(ctx, appConfig)
+		err = pushCmd.processApplyStreams(appConfig, eventStream, warningsStream, errorStream)
+		stopApply()
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		app := space.Applications[i]
+		warnings, err = cmd.Actor.BindServicesToApplication(app.Name, spaceGUID, app.Services)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			log.Errorln("binding services:", err)
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	for _, service := range space.Services {
+		if service.UserProvided {
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("TIP: Credentials are not exported. Use 'cf update-user-provided-service {{.ServiceName}}' to set them.", map[string]interface{}{
+				"ServiceName": service.Name,
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("import-space Command", func() {
+	var (
+		cmd             ImportSpaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeImportSpaceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeImportSpaceActor)
+
+		cmd = ImportSpaceCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			RequiredArgs: flag.ImportSpaceArgs{PathToSpaceFile: "space.yml"},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		var space manifest.Space
+
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			space = manifest.Space{
+				Applications: []manifest.Application{
+					{Name: "some-app", Services: []string{"some-db", "some-ups"}},
+					{Name: "other-app"},
+				},
+				Services: []manifest.Service{
+					{Name: "some-db", Service: "some-service", Plan: "some-plan"},
+					{Name: "some-ups", UserProvided: true},
+				},
+			}
+			fakeActor.ReadSpaceFileReturns(space, nil)
+		})
+
+		Context("when the space file cannot be read", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-read-error")
+				fakeActor.ReadSpaceFileReturns(manifest.Space{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeActor.ReadSpaceFileArgsForCall(0)).To(Equal("space.yml"))
+				Expect(fakeActor.CreateSpaceServicesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the services fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-services-error")
+				fakeActor.CreateSpaceServicesReturns(pushaction.Warnings{"some-services-warning"}, expectedErr)
+			})
+
+			It("displays the warnings and returns the error without pushing apps", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("some-services-warning"))
+				Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the services are created", func() {
+			BeforeEach(func() {
+				fakeActor.CreateSpaceServicesReturns(pushaction.Warnings{"some-services-warning"}, nil)
+				fakeActor.ConvertToApplicationConfigReturns([]pushaction.ApplicationConfig{
+					{DesiredApplication: v2action.Application{Name: "some-app"}},
+					{DesiredApplication: v2action.Application{Name: "other-app"}},
+				}, pushaction.Warnings{"some-config-warning"}, nil)
+
+				fakeActor.ApplyStub = func(context.Context, pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+					eventStream := make(chan pushaction.Event)
+					warningsStream := make(chan pushaction.Warnings)
+					errorStream := make(chan error)
+
+					go func() {
+						defer GinkgoRecover()
+
+						Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.ApplicationCreated}))
+						Eventually(warningsStream).Should(BeSent(pushaction.Warnings{"some-apply-warning"}))
+						Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.Complete}))
+						close(eventStream)
+						close(warningsStream)
+						close(errorStream)
+					}()
+
+					return eventStream, warningsStream, errorStream
+				}
+			})
+
+			Context("when binding the services fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-bind-error")
+					fakeActor.BindServicesToApplicationReturns(pushaction.Warnings{"some-bind-warning"}, expectedErr)
+				})
+
+				It("displays the warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("some-bind-warning"))
+					Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the services are bound", func() {
+				BeforeEach(func() {
+					fakeActor.BindServicesToApplicationReturns(pushaction.Warnings{"some-bind-warning"}, nil)
+				})
+
+				It("creates the services, pushes the apps and binds their services", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+					Expect(testUI.Out).To(Say("Importing space.yml into org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("Creating service instances..."))
+					Expect(testUI.Out).To(Say("Getting app info..."))
+					Expect(testUI.Out).To(Say("Creating app some-app in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("Creating app other-app in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say(`TIP: Credentials are not exported. Use 'cf update-user-provided-service some-ups' to set them.`))
+					Expect(testUI.Err).To(Say("some-services-warning"))
+					Expect(testUI.Err).To(Say("some-config-warning"))
+					Expect(testUI.Err).To(Say("some-apply-warning"))
+					Expect(testUI.Err).To(Say("some-bind-warning"))
+
+					spaceGUID, services := fakeActor.CreateSpaceServicesArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(services).To(Equal(space.Services))
+
+					orgGUID, spaceGUID, apps, reset := fakeActor.ConvertToApplicationConfigArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(apps).To(Equal(space.Applications))
+					Expect(reset).To(BeFalse())
+
+					Expect(fakeActor.ApplyCallCount()).To(Equal(2))
+					Expect(fakeActor.BindServicesToApplicationCallCount()).To(Equal(2))
+					appName, spaceGUID, serviceNames := fakeActor.BindServicesToApplicationArgsForCall(0)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(serviceNames).To(Equal([]string{"some-db", "some-ups"}))
+					appName, _, serviceNames = fakeActor.BindServicesToApplicationArgsForCall(1)
+					Expect(appName).To(Equal("other-app"))
+					Expect(serviceNames).To(BeEmpty())
+				})
+			})
+		})
+	})
+})
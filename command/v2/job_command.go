@@ -0,0 +1,162 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . JobActor
+
+type JobActor interface {
+	GetJob(guid string) (v2action.Job, v2action.Warnings, error)
+	PollJob(job v2action.Job) (v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . JobActorV3
+
+type JobActorV3 interface {
+	GetJob(guid string) (v3action.Job, v3action.Warnings, error)
+	PollJob(job v3action.Job) (v3action.Job, v3action.Warnings, error)
+}
+
+type JobCommand struct {
+	RequiredArgs    flag.JobGUID `positional-args:"yes"`
+	Wait            bool         `long:"wait" description:"Wait for the job to complete, exiting with an error if the job fails"`
+	usage           interface{}  `usage:"CF_NAME job JOB_GUID [--wait]\n\nEXAMPLES:\n   CF_NAME delete-org my-org -f --no-wait\n   CF_NAME job 90c2d1e6-4ab7-4f2b-8a6d-43c7e4b8d2f1 --wait"`
+	relatedCommands interface{}  `related_commands:"delete-org, delete-space, job-status"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       JobActor
+	ActorV3     JobActorV3
+}
+
+func (cmd *JobCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
+	return nil
+}
+
+func (cmd JobCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting job {{.JobGUID}} as {{.Username}}...", map[string]interface{}{
+		"JobGUID":  cmd.RequiredArgs.JobGUID,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	job, warnings, err := cmd.Actor.GetJob(cmd.RequiredArgs.JobGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+		return cmd.displayV2Job(job)
+	case v2action.JobNotFoundError:
+		if cmd.ActorV3 == nil {
+			return shared.HandleError(err)
+		}
+		return cmd.displayV3Job()
+	default:
+		return shared.HandleError(err)
+	}
+}
+
+// displayV2Job displays a job that was found on the V2 API, first waiting
+// for it to finish when --wait is provided.
+func (cmd JobCommand) displayV2Job(job v2action.Job) error {
+	if cmd.Wait {
+		cmd.displayWaiting()
+
+		warnings, err := cmd.Actor.PollJob(job)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		job.Status = ccv2.JobStatusFinished
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("guid:"), job.GUID},
+		{cmd.UI.TranslateText("status:"), string(job.Status)},
+	}
+	if job.Error != "" {
+		table = append(table, []string{cmd.UI.TranslateText("error:"), job.Error})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	return nil
+}
+
+// displayV3Job looks up and displays a job on the V3 API, first waiting for
+// it to complete when --wait is provided.
+func (cmd JobCommand) displayV3Job() error {
+	job, warnings, err := cmd.ActorV3.GetJob(cmd.RequiredArgs.JobGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v3action.JobNotFoundError); ok {
+			return shared.JobNotFoundError{JobGUID: cmd.RequiredArgs.JobGUID}
+		}
+		return shared.HandleError(err)
+	}
+
+	if cmd.Wait {
+		cmd.displayWaiting()
+
+		job, warnings, err = cmd.ActorV3.PollJob(job)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("guid:"), job.GUID},
+		{cmd.UI.TranslateText("operation:"), job.Operation},
+		{cmd.UI.TranslateText("state:"), string(job.State)},
+	}
+	if message := job.ErrorMessage(); message != "" {
+		table = append(table, []string{cmd.UI.TranslateText("errors:"), message})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	return nil
+}
+
+func (cmd JobCommand) displayWaiting() {
+	cmd.UI.DisplayText("Waiting for job {{.JobGUID}} to complete...", map[string]interface{}{
+		"JobGUID": cmd.RequiredArgs.JobGUID,
+	})
+	cmd.UI.DisplayNewline()
+}
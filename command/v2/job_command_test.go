@@ -0,0 +1,243 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("job Command", func() {
+	var (
+		cmd             JobCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeJobActor
+		fakeActorV3     *v2fakes.FakeJobActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeJobActor)
+		fakeActorV3 = new(v2fakes.FakeJobActorV3)
+
+		cmd = JobCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+
+		cmd.RequiredArgs.JobGUID = "some-job-guid"
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the job is a V2 job", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(
+				v2action.Job{GUID: "some-job-guid", Status: ccv2.JobStatusRunning},
+				v2action.Warnings{"get-job-warning"},
+				nil,
+			)
+		})
+
+		It("displays the job and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting job some-job-guid as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("guid:\\s+some-job-guid"))
+			Expect(testUI.Out).To(Say("status:\\s+running"))
+			Expect(testUI.Err).To(Say("get-job-warning"))
+
+			Expect(fakeActor.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+			Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+			Expect(fakeActorV3.GetJobCallCount()).To(Equal(0))
+		})
+
+		Context("when --wait is provided", func() {
+			BeforeEach(func() {
+				cmd.Wait = true
+			})
+
+			Context("when the job finishes", func() {
+				BeforeEach(func() {
+					fakeActor.PollJobReturns(v2action.Warnings{"poll-job-warning"}, nil)
+				})
+
+				It("waits for the job and displays it as finished", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Waiting for job some-job-guid to complete\\.\\.\\."))
+					Expect(testUI.Out).To(Say("status:\\s+finished"))
+					Expect(testUI.Err).To(Say("get-job-warning"))
+					Expect(testUI.Err).To(Say("poll-job-warning"))
+
+					Expect(fakeActor.PollJobCallCount()).To(Equal(1))
+					Expect(fakeActor.PollJobArgsForCall(0)).To(Equal(v2action.Job{GUID: "some-job-guid", Status: ccv2.JobStatusRunning}))
+				})
+			})
+
+			Context("when the job fails", func() {
+				BeforeEach(func() {
+					fakeActor.PollJobReturns(
+						v2action.Warnings{"poll-job-warning"},
+						ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"},
+					)
+				})
+
+				It("returns a JobFailedError", func() {
+					Expect(executeErr).To(MatchError(shared.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"}))
+					Expect(testUI.Err).To(Say("poll-job-warning"))
+				})
+			})
+		})
+	})
+
+	Context("when the job is not a V2 job", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(
+				v2action.Job{},
+				v2action.Warnings{"get-job-warning"},
+				v2action.JobNotFoundError{GUID: "some-job-guid"},
+			)
+		})
+
+		Context("when the V3 API is not available", func() {
+			BeforeEach(func() {
+				cmd.ActorV3 = nil
+			})
+
+			It("returns a JobNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.JobNotFoundError{JobGUID: "some-job-guid"}))
+				Expect(testUI.Err).To(Say("get-job-warning"))
+			})
+		})
+
+		Context("when the job is a V3 job", func() {
+			BeforeEach(func() {
+				fakeActorV3.GetJobReturns(
+					v3action.Job{
+						GUID:      "some-job-guid",
+						Operation: "app.delete",
+						State:     ccv3.JobStateFailed,
+						Errors:    []ccv3.JobError{{Detail: "some-error"}},
+					},
+					v3action.Warnings{"get-job-warning-v3"},
+					nil,
+				)
+			})
+
+			It("displays the job and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("guid:\\s+some-job-guid"))
+				Expect(testUI.Out).To(Say("operation:\\s+app\\.delete"))
+				Expect(testUI.Out).To(Say("state:\\s+FAILED"))
+				Expect(testUI.Out).To(Say("errors:\\s+some-error"))
+				Expect(testUI.Err).To(Say("get-job-warning"))
+				Expect(testUI.Err).To(Say("get-job-warning-v3"))
+
+				Expect(fakeActorV3.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+			})
+
+			Context("when --wait is provided", func() {
+				BeforeEach(func() {
+					cmd.Wait = true
+					fakeActorV3.PollJobReturns(
+						v3action.Job{GUID: "some-job-guid", Operation: "app.delete", State: ccv3.JobStateComplete},
+						v3action.Warnings{"poll-job-warning"},
+						nil,
+					)
+				})
+
+				It("waits for the job and displays its final state", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Waiting for job some-job-guid to complete\\.\\.\\."))
+					Expect(testUI.Out).To(Say("state:\\s+COMPLETE"))
+					Expect(testUI.Out).ToNot(Say("errors:"))
+					Expect(testUI.Err).To(Say("poll-job-warning"))
+
+					Expect(fakeActorV3.PollJobCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the job does not exist on the V3 API", func() {
+			BeforeEach(func() {
+				fakeActorV3.GetJobReturns(v3action.Job{}, nil, v3action.JobNotFoundError{GUID: "some-job-guid"})
+			})
+
+			It("returns a JobNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.JobNotFoundError{JobGUID: "some-job-guid"}))
+			})
+		})
+	})
+
+	Context("when getting the V2 job fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.GetJobReturns(v2action.Job{}, nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeActorV3.GetJobCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . JobStatusActor
+
+type JobStatusActor interface {
+	GetJob(guid string) (v2action.Job, v2action.Warnings, error)
+}
+
+type JobStatusCommand struct {
+	RequiredArgs    flag.JobGUID `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME job-status JOB_GUID"`
+	relatedCommands interface{}  `related_commands:"delete-org, delete-space"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       JobStatusActor
+}
+
+func (cmd *JobStatusCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd JobStatusCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting status of job {{.JobGUID}} as {{.Username}}...", map[string]interface{}{
+		"JobGUID":  cmd.RequiredArgs.JobGUID,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	job, warnings, err := cmd.Actor.GetJob(cmd.RequiredArgs.JobGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("guid:"), job.GUID},
+		{cmd.UI.TranslateText("status:"), string(job.Status)},
+	}
+	if job.Error != "" {
+		table = append(table, []string{cmd.UI.TranslateText("error:"), job.Error})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	return nil
+}
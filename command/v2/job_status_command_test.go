@@ -0,0 +1,131 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("job-status Command", func() {
+	var (
+		cmd             JobStatusCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeJobStatusActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeJobStatusActor)
+
+		cmd = JobStatusCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.JobGUID = "some-job-guid"
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the job is running", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(
+				v2action.Job{GUID: "some-job-guid", Status: ccv2.JobStatusRunning},
+				v2action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("displays the job status and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting status of job some-job-guid as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("guid:\\s+some-job-guid"))
+			Expect(testUI.Out).To(Say("status:\\s+running"))
+			Expect(testUI.Out).ToNot(Say("error:"))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+		})
+	})
+
+	Context("when the job has failed", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(
+				v2action.Job{GUID: "some-job-guid", Status: ccv2.JobStatusFailed, Error: "some job error"},
+				nil,
+				nil,
+			)
+		})
+
+		It("displays the job error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("status:\\s+failed"))
+			Expect(testUI.Out).To(Say("error:\\s+some job error"))
+		})
+	})
+
+	Context("when the job does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(v2action.Job{}, v2action.Warnings{"warning-1"}, v2action.JobNotFoundError{GUID: "some-job-guid"})
+		})
+
+		It("returns a JobNotFoundError and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(shared.JobNotFoundError{JobGUID: "some-job-guid"}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
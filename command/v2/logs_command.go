@@ -14,20 +14,24 @@ import (
 
 type LogsActor interface {
 	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetRecentLogsForApplicationByNameAndSpaceWithinWindow(appName string, spaceGUID string, window v2action.RecentLogsWindow, noaaClient v2action.NOAAClient, logCacheClient v2action.LogCacheClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
-
-	UI          command.UI
-	Config      command.Config
-	SharedActor command.SharedActor
-	Actor       LogsActor
-	NOAAClient  *consumer.Consumer
+	RequiredArgs    flag.AppName   `positional-args:"yes"`
+	Recent          bool           `long:"recent" description:"Dump recent logs instead of tailing"`
+	Lines           int            `long:"lines" description:"Dump this many of the most recent log lines (implies --recent)"`
+	Since           flag.LogWindow `long:"since" description:"Only dump logs emitted within this duration, like 10m (implies --recent)"`
+	usage           interface{}    `usage:"CF_NAME logs APP_NAME [--recent] [--lines NUMBER] [--since DURATION]\n\n   With --lines or --since, the recent logs are also read from Log Cache, which holds more than the Doppler recent log buffer. Log Cache is derived from the targeted API, or set with CF_LOG_CACHE_API.\n\nEXAMPLES:\n   CF_NAME logs my-app --recent --lines 500\n   CF_NAME logs my-app --since 10m"`
+	relatedCommands interface{}    `related_commands:"app, apps, ssh"`
+
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          LogsActor
+	NOAAClient     *consumer.Consumer
+	LogCacheClient v2action.LogCacheClient
 }
 
 func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
@@ -43,10 +47,23 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
+	// Only set when the endpoint is known, so that a missing Log Cache is a
+	// nil interface rather than a nil client.
+	if logCacheClient := shared.NewLogCacheClient(config, ui, uaaClient); logCacheClient != nil {
+		cmd.LogCacheClient = logCacheClient
+	}
+
 	return nil
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
+	if cmd.Lines < 0 {
+		return command.ParseArgumentError{
+			ArgumentName: "--lines",
+			ExpectedType: "a positive integer",
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -66,6 +83,10 @@ func (cmd LogsCommand) Execute(args []string) error {
 		})
 	cmd.UI.DisplayNewline()
 
+	if cmd.Lines > 0 || cmd.Since.Duration > 0 {
+		return cmd.displayRecentLogsWithinWindow()
+	}
+
 	if cmd.Recent {
 		return cmd.displayRecentLogs()
 	}
@@ -89,6 +110,27 @@ func (cmd LogsCommand) displayRecentLogs() error {
 	return err
 }
 
+func (cmd LogsCommand) displayRecentLogsWithinWindow() error {
+	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpaceWithinWindow(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		v2action.RecentLogsWindow{
+			Lines: cmd.Lines,
+			Since: cmd.Since.Duration,
+		},
+		cmd.NOAAClient,
+		cmd.LogCacheClient,
+		cmd.Config,
+	)
+
+	for _, message := range messages {
+		cmd.UI.DisplayLogMessage(message, true)
+	}
+
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
 func (cmd LogsCommand) streamLogs() error {
 	messages, logErrs, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
 		cmd.RequiredArgs.AppName,
@@ -6,8 +6,10 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -26,6 +28,7 @@ var _ = Describe("logs command", func() {
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v2fakes.FakeLogsActor
 		noaaClient      *consumer.Consumer
+		fakeLogCache    *v2actionfakes.FakeLogCacheClient
 		binaryName      string
 		executeErr      error
 	)
@@ -36,13 +39,15 @@ var _ = Describe("logs command", func() {
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v2fakes.FakeLogsActor)
 		noaaClient = new(consumer.Consumer)
+		fakeLogCache = new(v2actionfakes.FakeLogCacheClient)
 
 		cmd = LogsCommand{
-			UI:          testUI,
-			Config:      fakeConfig,
-			SharedActor: fakeSharedActor,
-			Actor:       fakeActor,
-			NOAAClient:  noaaClient,
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+			NOAAClient:     noaaClient,
+			LogCacheClient: fakeLogCache,
 		}
 
 		binaryName = "faceman"
@@ -149,6 +154,63 @@ var _ = Describe("logs command", func() {
 			})
 		})
 
+		Context("when --lines or --since are provided", func() {
+			BeforeEach(func() {
+				cmd.Lines = 500
+				cmd.Since = flag.LogWindow{Duration: 10 * time.Minute}
+				fakeActor.GetRecentLogsForApplicationByNameAndSpaceWithinWindowReturns(
+					[]v2action.LogMessage{
+						*v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "app", "1"),
+					},
+					v2action.Warnings{"some-warning"},
+					nil)
+			})
+
+			It("displays the recent logs within the window", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("i am message 1"))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceWithinWindowCallCount()).To(Equal(1))
+				appName, spaceGUID, window, client, logCacheClient, config := fakeActor.GetRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(window).To(Equal(v2action.RecentLogsWindow{Lines: 500, Since: 10 * time.Minute}))
+				Expect(client).To(Equal(noaaClient))
+				Expect(logCacheClient).To(Equal(fakeLogCache))
+				Expect(config).To(Equal(fakeConfig))
+			})
+
+			Context("when the actor returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-error")
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceWithinWindowReturns(nil, v2action.Warnings{"some-warning"}, expectedErr)
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("some-warning"))
+				})
+			})
+		})
+
+		Context("when --lines is negative", func() {
+			BeforeEach(func() {
+				cmd.Lines = -1
+			})
+
+			It("returns a ParseArgumentError", func() {
+				Expect(executeErr).To(MatchError(command.ParseArgumentError{
+					ArgumentName: "--lines",
+					ExpectedType: "a positive integer",
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when the --recent flag is not provided", func() {
 			BeforeEach(func() {
 				cmd.Recent = false
@@ -1,23 +1,303 @@
 package v2
 
 import (
-	"os"
+	"fmt"
+	"sort"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . MarketplaceActor
+
+type MarketplaceActor interface {
+	GetServiceSummaries(orgGUID string, spaceGUID string) ([]v2action.ServiceSummary, v2action.Warnings, error)
+	GetServiceSummaryByName(name string, orgGUID string, spaceGUID string) (v2action.ServiceSummary, v2action.Warnings, error)
+}
+
 type MarketplaceCommand struct {
 	ServicePlanInfo string      `short:"s" description:"Show plan details for a particular service offering"`
-	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE]"`
+	ExtendedInfo    string      `short:"e" description:"Show plan details, costs and broker catalog metadata for a particular service offering"`
+	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE | -e SERVICE]"`
 	relatedCommands interface{} `related_commands:"create-service, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       MarketplaceActor
 }
 
-func (_ MarketplaceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *MarketplaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ MarketplaceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd MarketplaceCommand) Execute(args []string) error {
+	if cmd.ServicePlanInfo != "" && cmd.ExtendedInfo != "" {
+		return command.ArgumentCombinationError{
+			Arg1: "-s",
+			Arg2: "-e",
+		}
+	}
+
+	var orgGUID, spaceGUID string
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	switch err.(type) {
+	case nil:
+		user, userErr := cmd.Config.CurrentUser()
+		if userErr != nil {
+			return userErr
+		}
+		orgGUID = cmd.Config.TargetedOrganization().GUID
+		if orgGUID != "" {
+			spaceGUID = cmd.Config.TargetedSpace().GUID
+		}
+		cmd.displayGettingMessageAsUser(user.Name, spaceGUID != "")
+	case sharedaction.NotLoggedInError:
+		cmd.displayGettingMessage()
+	default:
+		return shared.HandleError(err)
+	}
+
+	switch {
+	case cmd.ServicePlanInfo != "":
+		return cmd.displayServicePlans(cmd.ServicePlanInfo, orgGUID, spaceGUID)
+	case cmd.ExtendedInfo != "":
+		return cmd.displayServiceDetails(cmd.ExtendedInfo, orgGUID, spaceGUID)
+	default:
+		return cmd.displayServices(orgGUID, spaceGUID)
+	}
+}
+
+func (cmd MarketplaceCommand) displayGettingMessageAsUser(username string, spaceTargeted bool) {
+	if serviceName := cmd.serviceName(); serviceName != "" {
+		cmd.UI.DisplayTextWithFlavor("Getting service plan information for service {{.ServiceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"ServiceName": serviceName,
+			"CurrentUser": username,
+		})
+		return
+	}
+
+	if !spaceTargeted {
+		cmd.UI.DisplayTextWithFlavor("Getting all services from marketplace as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": username,
+		})
+		return
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting services from marketplace in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": username,
+	})
+}
+
+func (cmd MarketplaceCommand) displayGettingMessage() {
+	if serviceName := cmd.serviceName(); serviceName != "" {
+		cmd.UI.DisplayTextWithFlavor("Getting service plan information for service {{.ServiceName}}...", map[string]interface{}{
+			"ServiceName": serviceName,
+		})
+		return
+	}
+
+	cmd.UI.DisplayText("Getting all services from marketplace...")
+}
+
+func (cmd MarketplaceCommand) serviceName() string {
+	if cmd.ServicePlanInfo != "" {
+		return cmd.ServicePlanInfo
+	}
+	return cmd.ExtendedInfo
+}
+
+func (cmd MarketplaceCommand) displayServices(orgGUID string, spaceGUID string) error {
+	summaries, warnings, err := cmd.Actor.GetServiceSummaries(orgGUID, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No service offerings found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("service"),
+			cmd.UI.TranslateText("plans"),
+			cmd.UI.TranslateText("description"),
+			cmd.UI.TranslateText("broker"),
+		},
+	}
+
+	var paidPlanExists bool
+	for _, summary := range summaries {
+		var planNames []string
+		for _, plan := range summary.Plans {
+			if plan.Free {
+				planNames = append(planNames, plan.Name)
+			} else {
+				paidPlanExists = true
+				planNames = append(planNames, plan.Name+"*")
+			}
+		}
+
+		table = append(table, []string{
+			summary.Label,
+			strings.Join(planNames, ", "),
+			summary.Description,
+			summary.BrokerName,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if paidPlanExists {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("* These service plans have an associated cost. Creating a service instance will incur this cost.")
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} marketplace -s SERVICE' to view descriptions of individual plans of a given service.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+	})
+
+	return nil
+}
+
+func (cmd MarketplaceCommand) displayServicePlans(serviceName string, orgGUID string, spaceGUID string) error {
+	summary, found, err := cmd.getServiceSummary(serviceName, orgGUID, spaceGUID)
+	if err != nil || !found {
+		return err
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("service plan"),
+			cmd.UI.TranslateText("description"),
+			cmd.UI.TranslateText("free or paid"),
+			cmd.UI.TranslateText("costs"),
+		},
+	}
+
+	for _, plan := range summary.Plans {
+		table = append(table, []string{
+			plan.Name,
+			plan.Description,
+			cmd.freeOrPaid(plan),
+			formatPlanCosts(plan.Costs),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
+
+func (cmd MarketplaceCommand) displayServiceDetails(serviceName string, orgGUID string, spaceGUID string) error {
+	summary, found, err := cmd.getServiceSummary(serviceName, orgGUID, spaceGUID)
+	if err != nil || !found {
+		return err
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("service:"), summary.Label},
+		{cmd.UI.TranslateText("display name:"), summary.DisplayName},
+		{cmd.UI.TranslateText("description:"), summary.Description},
+		{cmd.UI.TranslateText("long description:"), summary.LongDescription},
+		{cmd.UI.TranslateText("broker:"), summary.BrokerName},
+		{cmd.UI.TranslateText("documentation:"), summary.DocumentationURL},
+		{cmd.UI.TranslateText("support:"), summary.SupportURL},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("service plan"),
+			cmd.UI.TranslateText("display name"),
+			cmd.UI.TranslateText("description"),
+			cmd.UI.TranslateText("free or paid"),
+			cmd.UI.TranslateText("costs"),
+			cmd.UI.TranslateText("features"),
+		},
+	}
+
+	for _, plan := range summary.Plans {
+		table = append(table, []string{
+			plan.Name,
+			plan.DisplayName,
+			plan.Description,
+			cmd.freeOrPaid(plan),
+			formatPlanCosts(plan.Costs),
+			strings.Join(plan.Bullets, ", "),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
+
+// getServiceSummary returns the summary of the named service. found is false
+// when the service does not exist, in which case a message has already been
+// displayed.
+func (cmd MarketplaceCommand) getServiceSummary(serviceName string, orgGUID string, spaceGUID string) (v2action.ServiceSummary, bool, error) {
+	summary, warnings, err := cmd.Actor.GetServiceSummaryByName(serviceName, orgGUID, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.ServiceNotFoundError); !ok {
+			return v2action.ServiceSummary{}, false, shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if err != nil {
+		cmd.UI.DisplayText("Service offering not found")
+		return v2action.ServiceSummary{}, false, nil
+	}
+
+	return summary, true, nil
+}
+
+func (cmd MarketplaceCommand) freeOrPaid(plan v2action.ServicePlanSummary) string {
+	if plan.Free {
+		return cmd.UI.TranslateText("free")
+	}
+	return cmd.UI.TranslateText("paid")
+}
+
+// formatPlanCosts renders costs as "USD 10.00/MONTHLY", one entry per
+// currency, ordered by currency code.
+func formatPlanCosts(costs []v2action.ServicePlanCost) string {
+	var formatted []string
+	for _, cost := range costs {
+		var currencies []string
+		for currency := range cost.Amount {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+
+		for _, currency := range currencies {
+			formatted = append(formatted, fmt.Sprintf("%s %.2f/%s", strings.ToUpper(currency), cost.Amount[currency], cost.Unit))
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,351 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("marketplace Command", func() {
+	var (
+		cmd             MarketplaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeMarketplaceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeMarketplaceActor)
+
+		cmd = MarketplaceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both -s and -e are provided", func() {
+		BeforeEach(func() {
+			cmd.ServicePlanInfo = "some-service"
+			cmd.ExtendedInfo = "some-service"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Arg1: "-s",
+				Arg2: "-e",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	It("only requires the user to be logged in", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		config, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(config).To(Equal(fakeConfig))
+		Expect(targetedOrganizationRequired).To(Equal(false))
+		Expect(targetedSpaceRequired).To(Equal(false))
+	})
+
+	Context("when the user is logged in but no space is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeActor.GetServiceSummariesReturns(
+				[]v2action.ServiceSummary{
+					{Service: v2action.Service{Label: "some-service", Description: "some description"}},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("lists the services visible to the targeted organization", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting all services from marketplace as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("some-service\\s+some description"))
+
+			Expect(fakeActor.GetServiceSummariesCallCount()).To(Equal(1))
+			orgGUID, spaceGUID := fakeActor.GetServiceSummariesArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceGUID).To(BeEmpty())
+		})
+
+		Context("when no organization is targeted either", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedOrganizationReturns(configv3.Organization{})
+				fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "stale-space-guid"})
+			})
+
+			It("lists all services in the marketplace", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				orgGUID, spaceGUID := fakeActor.GetServiceSummariesArgsForCall(0)
+				Expect(orgGUID).To(BeEmpty())
+				Expect(spaceGUID).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("when the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+			fakeActor.GetServiceSummariesReturns(
+				[]v2action.ServiceSummary{
+					{Service: v2action.Service{Label: "some-service", Description: "some description"}},
+				},
+				v2action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("lists all services in the marketplace", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting all services from marketplace\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("some-service\\s+some description"))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.GetServiceSummariesCallCount()).To(Equal(1))
+			orgGUID, spaceGUID := fakeActor.GetServiceSummariesArgsForCall(0)
+			Expect(orgGUID).To(BeEmpty())
+			Expect(spaceGUID).To(BeEmpty())
+		})
+	})
+
+	Context("when a space is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		})
+
+		Context("when getting the current user fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some current user error")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when no flags are provided", func() {
+			Context("when there are services", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceSummariesReturns(
+						[]v2action.ServiceSummary{
+							{
+								Service:    v2action.Service{Label: "service-1", Description: "description 1"},
+								BrokerName: "broker-1",
+								Plans: []v2action.ServicePlanSummary{
+									{ServicePlan: v2action.ServicePlan{Name: "free-plan", Free: true}},
+									{ServicePlan: v2action.ServicePlan{Name: "paid-plan"}},
+								},
+							},
+							{
+								Service:    v2action.Service{Label: "service-2", Description: "description 2"},
+								BrokerName: "broker-2",
+								Plans: []v2action.ServicePlanSummary{
+									{ServicePlan: v2action.ServicePlan{Name: "other-plan", Free: true}},
+								},
+							},
+						},
+						v2action.Warnings{"warning-1", "warning-2"},
+						nil,
+					)
+				})
+
+				It("displays the services, their plans, and their brokers", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Getting services from marketplace in org some-org / space some-space as some-user\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("service\\s+plans\\s+description\\s+broker"))
+					Expect(testUI.Out).To(Say("service-1\\s+free-plan, paid-plan\\*\\s+description 1\\s+broker-1"))
+					Expect(testUI.Out).To(Say("service-2\\s+other-plan\\s+description 2\\s+broker-2"))
+					Expect(testUI.Out).To(Say("\\* These service plans have an associated cost\\. Creating a service instance will incur this cost\\."))
+					Expect(testUI.Out).To(Say("TIP: Use 'faceman marketplace -s SERVICE' to view descriptions of individual plans of a given service\\."))
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+
+					orgGUID, spaceGUID := fakeActor.GetServiceSummariesArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when there are no services", func() {
+				It("displays a message that no services were found", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("No service offerings found"))
+				})
+			})
+
+			Context("when getting the services fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some error")
+					fakeActor.GetServiceSummariesReturns(nil, v2action.Warnings{"warning-1"}, expectedErr)
+				})
+
+				It("returns the error and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("warning-1"))
+				})
+			})
+		})
+
+		Context("when the -s flag is provided", func() {
+			BeforeEach(func() {
+				cmd.ServicePlanInfo = "some-service"
+			})
+
+			Context("when the service exists", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceSummaryByNameReturns(
+						v2action.ServiceSummary{
+							Service: v2action.Service{Label: "some-service"},
+							Plans: []v2action.ServicePlanSummary{
+								{ServicePlan: v2action.ServicePlan{Name: "free-plan", Description: "free description", Free: true}},
+								{
+									ServicePlan: v2action.ServicePlan{Name: "paid-plan", Description: "paid description"},
+									Costs: []v2action.ServicePlanCost{
+										{Amount: map[string]float64{"usd": 10, "eur": 9.5}, Unit: "MONTHLY"},
+									},
+								},
+							},
+						},
+						v2action.Warnings{"warning-1"},
+						nil,
+					)
+				})
+
+				It("displays the plans of the service", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Getting service plan information for service some-service as some-user\\.\\.\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("service plan\\s+description\\s+free or paid\\s+costs"))
+					Expect(testUI.Out).To(Say("free-plan\\s+free description\\s+free"))
+					Expect(testUI.Out).To(Say("paid-plan\\s+paid description\\s+paid\\s+EUR 9\\.50/MONTHLY, USD 10\\.00/MONTHLY"))
+					Expect(testUI.Err).To(Say("warning-1"))
+
+					name, orgGUID, spaceGUID := fakeActor.GetServiceSummaryByNameArgsForCall(0)
+					Expect(name).To(Equal("some-service"))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when the service does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceSummaryByNameReturns(
+						v2action.ServiceSummary{},
+						v2action.Warnings{"warning-1"},
+						v2action.ServiceNotFoundError{Name: "some-service"},
+					)
+				})
+
+				It("displays a message that the service was not found", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Service offering not found"))
+					Expect(testUI.Err).To(Say("warning-1"))
+				})
+			})
+
+			Context("when getting the service fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some error")
+					fakeActor.GetServiceSummaryByNameReturns(v2action.ServiceSummary{}, v2action.Warnings{"warning-1"}, expectedErr)
+				})
+
+				It("returns the error and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Out).ToNot(Say("OK"))
+					Expect(testUI.Err).To(Say("warning-1"))
+				})
+			})
+		})
+
+		Context("when the -e flag is provided", func() {
+			BeforeEach(func() {
+				cmd.ExtendedInfo = "some-service"
+				fakeActor.GetServiceSummaryByNameReturns(
+					v2action.ServiceSummary{
+						Service:          v2action.Service{Label: "some-service", Description: "some description"},
+						BrokerName:       "some-broker",
+						DisplayName:      "Some Service",
+						LongDescription:  "some long description",
+						DocumentationURL: "https://docs.example.com",
+						SupportURL:       "https://support.example.com",
+						Plans: []v2action.ServicePlanSummary{
+							{
+								ServicePlan: v2action.ServicePlan{Name: "paid-plan", Description: "paid description"},
+								DisplayName: "Paid Plan",
+								Bullets:     []string{"bullet 1", "bullet 2"},
+								Costs: []v2action.ServicePlanCost{
+									{Amount: map[string]float64{"usd": 1.5}, Unit: "DAILY"},
+								},
+							},
+						},
+					},
+					v2action.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("displays the service details and the extended plan information", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting service plan information for service some-service as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("service:\\s+some-service"))
+				Expect(testUI.Out).To(Say("display name:\\s+Some Service"))
+				Expect(testUI.Out).To(Say("description:\\s+some description"))
+				Expect(testUI.Out).To(Say("long description:\\s+some long description"))
+				Expect(testUI.Out).To(Say("broker:\\s+some-broker"))
+				Expect(testUI.Out).To(Say("documentation:\\s+https://docs.example.com"))
+				Expect(testUI.Out).To(Say("support:\\s+https://support.example.com"))
+				Expect(testUI.Out).To(Say("service plan\\s+display name\\s+description\\s+free or paid\\s+costs\\s+features"))
+				Expect(testUI.Out).To(Say("paid-plan\\s+Paid Plan\\s+paid description\\s+paid\\s+USD 1\\.50/DAILY\\s+bullet 1, bullet 2"))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+})
@@ -0,0 +1,153 @@
+package v2
+
+import (
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . MigrateStackActor
+
+type MigrateStackActor interface {
+	RestageActor
+	GetStack(guid string) (v2action.Stack, v2action.Warnings, error)
+	GetStackByName(stackName string) (v2action.Stack, v2action.Warnings, error)
+	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+}
+
+type MigrateStackCommand struct {
+	RequiredArgs        flag.MigrateStackArgs `positional-args:"yes"`
+	usage               interface{}           `usage:"CF_NAME migrate-stack APP_NAME STACK_NAME\n\n   Changes the stack of the app and restages it. If the restage fails, the app is moved back to its previous stack and restaged again.\n\nEXAMPLES:\n   CF_NAME migrate-stack my-app cflinuxfs2"`
+	relatedCommands     interface{}           `related_commands:"app, restage, stack, stacks"`
+	envCFStagingTimeout interface{}           `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}           `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       MigrateStackActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *MigrateStackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewInterruptibleClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd MigrateStackCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	oldStack, warnings, err := cmd.Actor.GetStack(app.StackGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	newStack, warnings, err := cmd.Actor.GetStackByName(cmd.RequiredArgs.StackName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if oldStack.GUID == newStack.GUID {
+		cmd.UI.DisplayText("App {{.AppName}} is already on stack {{.StackName}}.", map[string]interface{}{
+			"AppName":   app.Name,
+			"StackName": newStack.Name,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Migrating app {{.AppName}} from stack {{.OldStack}} to {{.NewStack}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     app.Name,
+			"OldStack":    oldStack.Name,
+			"NewStack":    newStack.Name,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	migrateErr := cmd.changeStackAndRestage(app, newStack)
+	if migrateErr != nil {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithFlavor("Migration failed, reverting app {{.AppName}} to stack {{.StackName}}...",
+			map[string]interface{}{
+				"AppName":   app.Name,
+				"StackName": oldStack.Name,
+			})
+
+		err = cmd.changeStackAndRestage(app, oldStack)
+		if err != nil {
+			return err
+		}
+
+		return migrateErr
+	}
+
+	cmd.UI.DisplayNewline()
+
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(app.Name, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
+	return nil
+}
+
+// changeStackAndRestage sets the stack of the app and restages it, streaming
+// the staging logs and startup progress.
+func (cmd MigrateStackCommand) changeStackAndRestage(app v2action.Application, stack v2action.Stack) error {
+	_, warnings, err := cmd.Actor.UpdateApplication(v2action.Application{
+		GUID:      app.GUID,
+		StackGUID: stack.GUID,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	messages, logErrs, appStarting, apiWarnings, errs := cmd.Actor.RestageApplication(command.InterruptContext(), app, cmd.NOAAClient, cmd.Config)
+	cmd.UI.DisplayNewline()
+	err = shared.PollStartWithProgress(cmd.UI, cmd.Config, cmd.Actor, app.GUID, messages, logErrs, appStarting, apiWarnings, errs)
+	if err != nil {
+		if _, ok := err.(shared.UnsuccessfulStartError); ok {
+			shared.DisplayCrashDiagnostics(cmd.UI, cmd.Actor, app.GUID, cmd.NOAAClient)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("migrate-stack Command", func() {
+	var (
+		cmd             MigrateStackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeMigrateStackActor
+		binaryName      string
+		executeErr      error
+		restageErrs     [][]error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeMigrateStackActor)
+
+		cmd = MigrateStackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.StackName = "new-stack"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		// restageErrs holds the errors returned by each successive restage.
+		restageErrs = nil
+
+		fakeActor.RestageApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appStart := make(chan bool)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			var currentErrs []error
+			if call := fakeActor.RestageApplicationCallCount() - 1; call < len(restageErrs) {
+				currentErrs = restageErrs[call]
+			}
+
+			go func() {
+				for _, err := range currentErrs {
+					errs <- err
+				}
+				close(messages)
+				close(logErrs)
+				close(appStart)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, appStart, warnings, errs
+		}
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", StackGUID: "old-stack-guid"},
+			v2action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeActor.GetStackReturns(
+			v2action.Stack{GUID: "old-stack-guid", Name: "old-stack"},
+			v2action.Warnings{"get-stack-warning"},
+			nil,
+		)
+		fakeActor.GetStackByNameReturns(
+			v2action.Stack{GUID: "new-stack-guid", Name: "new-stack"},
+			v2action.Warnings{"get-stack-by-name-warning"},
+			nil,
+		)
+		fakeActor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-warning"}, nil)
+		fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+			v2action.ApplicationSummary{Application: v2action.Application{Name: "some-app"}},
+			nil,
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the stack does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackByNameReturns(
+				v2action.Stack{},
+				v2action.Warnings{"get-stack-by-name-warning"},
+				v2action.StackNotFoundError{Name: "new-stack"},
+			)
+		})
+
+		It("returns a StackNotFoundError without changing the app", func() {
+			Expect(executeErr).To(MatchError(shared.StackNotFoundError{Name: "new-stack"}))
+			Expect(testUI.Err).To(Say("get-stack-by-name-warning"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app is already on the stack", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackByNameReturns(v2action.Stack{GUID: "old-stack-guid", Name: "old-stack"}, nil, nil)
+		})
+
+		It("displays a message and does not restage the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("App some-app is already on stack old-stack."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the restage succeeds", func() {
+		It("changes the stack, restages the app and displays the summary", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Migrating app some-app from stack old-stack to new-stack in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("name:\\s+some-app"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-stack-warning"))
+			Expect(testUI.Err).To(Say("get-stack-by-name-warning"))
+			Expect(testUI.Err).To(Say("update-warning"))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+				GUID:      "some-app-guid",
+				StackGUID: "new-stack-guid",
+			}))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the restage fails", func() {
+		BeforeEach(func() {
+			restageErrs = [][]error{{v2action.StagingTimeoutError{Name: "some-app", Timeout: 0}}}
+		})
+
+		It("reverts the app to the previous stack, restages it and returns the error", func() {
+			Expect(executeErr).To(MatchError(shared.StagingTimeoutError{AppName: "some-app", Timeout: 0}))
+			Expect(testUI.Out).To(Say("Migration failed, reverting app some-app to stack old-stack..."))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+			Expect(fakeActor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+				GUID:      "some-app-guid",
+				StackGUID: "old-stack-guid",
+			}))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(2))
+			Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+		})
+
+		Context("when reverting the stack fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("revert error")
+				fakeActor.UpdateApplicationStub = func(app v2action.Application) (v2action.Application, v2action.Warnings, error) {
+					if fakeActor.UpdateApplicationCallCount() == 2 {
+						return v2action.Application{}, nil, expectedErr
+					}
+					return v2action.Application{}, nil, nil
+				}
+			})
+
+			It("returns the revert error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
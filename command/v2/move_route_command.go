@@ -0,0 +1,166 @@
+package v2
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . MoveRouteActor
+
+type MoveRouteActor interface {
+	GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
+	GetRouteByHostAndDomain(host string, domainGUID string) (v2action.Route, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	MoveRoute(route v2action.Route, spaceGUID string) (v2action.Route, []v2action.Application, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . MoveRouteActorV3
+
+type MoveRouteActorV3 interface {
+	CloudControllerAPIVersion() string
+	TransferRouteOwnership(routeGUID string, spaceGUID string) (v3action.Warnings, error)
+}
+
+type MoveRouteCommand struct {
+	RequiredArgs    flag.DomainHost `positional-args:"yes"`
+	ToSpace         string          `long:"to-space" required:"true" description:"Space to move the route to"`
+	usage           interface{}     `usage:"CF_NAME move-route DOMAIN HOST --to-space SPACE\n\n   When the Cloud Controller cannot transfer the ownership of the route, the route is\n   unmapped from its apps, deleted and created again in SPACE. If any step fails, the\n   route and its mappings are restored.\n\nEXAMPLES:\n   CF_NAME move-route example.com myhost --to-space production"`
+	relatedCommands interface{}     `related_commands:"map-route, routes, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       MoveRouteActor
+	ActorV3     MoveRouteActorV3
+}
+
+func (cmd *MoveRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
+	return nil
+}
+
+func (cmd MoveRouteCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	domain, err := cmd.findDomain(org.GUID)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	route, warnings, err := cmd.Actor.GetRouteByHostAndDomain(cmd.RequiredArgs.Host, domain.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.ToSpace)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Moving route {{.Route}} to space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"Route":     route.String(),
+		"SpaceName": space.Name,
+		"OrgName":   org.Name,
+		"Username":  user.Name,
+	})
+
+	if route.SpaceGUID == space.GUID {
+		cmd.UI.DisplayText("Route {{.Route}} is already in space {{.SpaceName}}.", map[string]interface{}{
+			"Route":     route.String(),
+			"SpaceName": space.Name,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if cmd.canTransferRoute() {
+		v3Warnings, transferErr := cmd.ActorV3.TransferRouteOwnership(route.GUID, space.GUID)
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if transferErr != nil {
+			return shared.HandleError(transferErr)
+		}
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	_, unmappedApps, warnings, err := cmd.Actor.MoveRoute(route, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+
+	if len(unmappedApps) > 0 {
+		var appNames []string
+		for _, app := range unmappedApps {
+			appNames = append(appNames, app.Name)
+		}
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("The route was unmapped from the following apps: {{.AppNames}}", map[string]interface{}{
+			"AppNames": strings.Join(appNames, ", "),
+		})
+	}
+
+	return nil
+}
+
+func (cmd MoveRouteCommand) findDomain(orgGUID string) (v2action.Domain, error) {
+	domains, warnings, err := cmd.Actor.GetOrganizationDomains(orgGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.Domain{}, err
+	}
+
+	for _, domain := range domains {
+		if domain.Name == cmd.RequiredArgs.Domain {
+			return domain, nil
+		}
+	}
+	return v2action.Domain{}, v2action.DomainNotFoundError{Name: cmd.RequiredArgs.Domain}
+}
+
+// canTransferRoute returns true when the Cloud Controller can move the route
+// to another space without recreating it.
+func (cmd MoveRouteCommand) canTransferRoute() bool {
+	if cmd.ActorV3 == nil {
+		return false
+	}
+	return command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionTransferRouteOwnershipV3) == nil
+}
@@ -0,0 +1,196 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("move-route Command", func() {
+	var (
+		cmd             MoveRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeMoveRouteActor
+		fakeActorV3     *v2fakes.FakeMoveRouteActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeMoveRouteActor)
+		fakeActorV3 = new(v2fakes.FakeMoveRouteActorV3)
+
+		cmd = MoveRouteCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+		cmd.RequiredArgs.Domain = "example.com"
+		cmd.RequiredArgs.Host = "some-host"
+		cmd.ToSpace = "dest-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+
+		fakeActor.GetOrganizationDomainsReturns([]v2action.Domain{
+			{GUID: "other-domain-guid", Name: "other.com"},
+			{GUID: "some-domain-guid", Name: "example.com"},
+		}, v2action.Warnings{"domains-warning"}, nil)
+		fakeActor.GetRouteByHostAndDomainReturns(v2action.Route{
+			GUID:      "some-route-guid",
+			Host:      "some-host",
+			Domain:    v2action.Domain{GUID: "some-domain-guid", Name: "example.com"},
+			SpaceGUID: "source-space-guid",
+		}, v2action.Warnings{"route-warning"}, nil)
+		fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "dest-space-guid", Name: "dest-space"}, v2action.Warnings{"space-warning"}, nil)
+		fakeActorV3.CloudControllerAPIVersionReturns("3.0.0")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the domain does not exist", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Domain = "missing.com"
+		})
+
+		It("returns a domain not found error", func() {
+			Expect(executeErr).To(MatchError(v2action.DomainNotFoundError{Name: "missing.com"}))
+			Expect(testUI.Err).To(Say("domains-warning"))
+			Expect(fakeActor.GetRouteByHostAndDomainCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the route is already in the destination space", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "source-space-guid", Name: "dest-space"}, nil, nil)
+		})
+
+		It("does not move the route", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Route some-host\.example\.com is already in space dest-space\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.MoveRouteCallCount()).To(Equal(0))
+			Expect(fakeActorV3.TransferRouteOwnershipCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the API can transfer the route ownership", func() {
+		BeforeEach(func() {
+			fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionTransferRouteOwnershipV3)
+			fakeActorV3.TransferRouteOwnershipReturns(v3action.Warnings{"transfer-warning"}, nil)
+		})
+
+		It("transfers the route to the destination space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Moving route some-host\.example\.com to space dest-space in org some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("domains-warning"))
+			Expect(testUI.Err).To(Say("route-warning"))
+			Expect(testUI.Err).To(Say("space-warning"))
+			Expect(testUI.Err).To(Say("transfer-warning"))
+
+			host, domainGUID := fakeActor.GetRouteByHostAndDomainArgsForCall(0)
+			Expect(host).To(Equal("some-host"))
+			Expect(domainGUID).To(Equal("some-domain-guid"))
+
+			orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("dest-space"))
+
+			routeGUID, spaceGUID := fakeActorV3.TransferRouteOwnershipArgsForCall(0)
+			Expect(routeGUID).To(Equal("some-route-guid"))
+			Expect(spaceGUID).To(Equal("dest-space-guid"))
+			Expect(fakeActor.MoveRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the API cannot transfer the route ownership", func() {
+		BeforeEach(func() {
+			fakeActor.MoveRouteReturns(
+				v2action.Route{GUID: "new-route-guid"},
+				[]v2action.Application{{Name: "app-1"}, {Name: "app-2"}},
+				v2action.Warnings{"move-warning"},
+				nil,
+			)
+		})
+
+		It("recreates the route in the destination space and displays the unmapped apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("The route was unmapped from the following apps: app-1, app-2"))
+			Expect(testUI.Err).To(Say("move-warning"))
+
+			route, spaceGUID := fakeActor.MoveRouteArgsForCall(0)
+			Expect(route.GUID).To(Equal("some-route-guid"))
+			Expect(spaceGUID).To(Equal("dest-space-guid"))
+			Expect(fakeActorV3.TransferRouteOwnershipCallCount()).To(Equal(0))
+		})
+
+		Context("when the v3 API is not available", func() {
+			BeforeEach(func() {
+				cmd.ActorV3 = nil
+			})
+
+			It("recreates the route in the destination space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.MoveRouteCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when moving the route fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = v2action.MoveRouteRollbackError{
+					Route:       "some-host.example.com",
+					Err:         errors.New("create-error"),
+					RollbackErr: errors.New("restore-error"),
+				}
+				fakeActor.MoveRouteReturns(v2action.Route{}, nil, v2action.Warnings{"move-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays the warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("move-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
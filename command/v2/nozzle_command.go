@@ -0,0 +1,124 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudfoundry/noaa/consumer"
+	"github.com/cloudfoundry/sonde-go/events"
+	uuid "github.com/nu7hatch/gouuid"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . NozzleActor
+
+type NozzleActor interface {
+	GetStreamingFirehoseEnvelopes(subscriptionID string, filter v2action.FirehoseFilter, client v2action.NOAAClient) (<-chan *events.Envelope, <-chan error)
+}
+
+type NozzleCommand struct {
+	Filter          flag.FirehoseFilter `long:"filter" description:"Only display envelopes of this kind: (logs, metrics)"`
+	SubscriptionID  string              `long:"subscription-id" description:"Subscription ID to connect with. Running several nozzles with the same ID splits the firehose between them (default: a random ID)"`
+	JSON            bool                `long:"json" description:"Display each envelope as a line of JSON"`
+	usage           interface{}         `usage:"CF_NAME nozzle [--filter (logs | metrics)] [--subscription-id ID] [--json]\n\n   Streams envelopes from the firehose. Requires admin permissions.\n\nEXAMPLES:\n   CF_NAME nozzle --filter metrics\n   CF_NAME nozzle --subscription-id my-nozzle --json"`
+	relatedCommands interface{}         `related_commands:"logs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       NozzleActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *NozzleCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd NozzleCommand) Execute(args []string) error {
+	cmd.JSON = command.JSONOutputRequested(cmd.Config, cmd.JSON)
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	subscriptionID := cmd.SubscriptionID
+	if subscriptionID == "" {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		subscriptionID = "cf-cli-nozzle-" + id.String()
+	}
+
+	// JSON output is meant to be piped, so only the envelopes are displayed.
+	if !cmd.JSON {
+		cmd.UI.DisplayTextWithFlavor("Connecting to the firehose with subscription ID {{.SubscriptionID}} as {{.Username}}...", map[string]interface{}{
+			"SubscriptionID": subscriptionID,
+			"Username":       user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	envelopes, errs := cmd.Actor.GetStreamingFirehoseEnvelopes(subscriptionID, v2action.FirehoseFilter(cmd.Filter.Type), cmd.NOAAClient)
+
+	for envelopes != nil || errs != nil {
+		select {
+		case envelope, ok := <-envelopes:
+			if !ok {
+				envelopes = nil
+				continue
+			}
+
+			err = cmd.displayEnvelope(envelope)
+			if err != nil {
+				return err
+			}
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+
+			return shared.HandleError(err)
+		}
+	}
+
+	return nil
+}
+
+func (cmd NozzleCommand) displayEnvelope(envelope *events.Envelope) error {
+	if !cmd.JSON {
+		cmd.UI.DisplayText(envelope.String())
+		return nil
+	}
+
+	output, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return nil
+}
@@ -0,0 +1,137 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/sonde-go/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("nozzle Command", func() {
+	var (
+		cmd             NozzleCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeNozzleActor
+		binaryName      string
+		executeErr      error
+		streamErr       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeNozzleActor)
+
+		cmd = NozzleCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		streamErr = nil
+		fakeActor.GetStreamingFirehoseEnvelopesStub = func(string, v2action.FirehoseFilter, v2action.NOAAClient) (<-chan *events.Envelope, <-chan error) {
+			envelopes := make(chan *events.Envelope)
+			errs := make(chan error)
+
+			go func() {
+				origin := "some-origin"
+				eventType := events.Envelope_ValueMetric
+				envelopes <- &events.Envelope{Origin: &origin, EventType: &eventType}
+				if streamErr != nil {
+					errs <- streamErr
+				}
+				close(envelopes)
+				close(errs)
+			}()
+
+			return envelopes, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the subscription ID and filter are provided", func() {
+		BeforeEach(func() {
+			cmd.SubscriptionID = "some-subscription-id"
+			cmd.Filter.Type = "metrics"
+		})
+
+		It("streams the envelopes with the subscription ID and filter", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Connecting to the firehose with subscription ID some-subscription-id as some-user..."))
+			Expect(testUI.Out).To(Say(`origin:"some-origin" eventType:ValueMetric`))
+
+			subscriptionID, filter, _ := fakeActor.GetStreamingFirehoseEnvelopesArgsForCall(0)
+			Expect(subscriptionID).To(Equal("some-subscription-id"))
+			Expect(filter).To(Equal(v2action.FirehoseFilterMetrics))
+		})
+	})
+
+	Context("when the subscription ID is not provided", func() {
+		It("generates a subscription ID", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			subscriptionID, filter, _ := fakeActor.GetStreamingFirehoseEnvelopesArgsForCall(0)
+			Expect(subscriptionID).To(HavePrefix("cf-cli-nozzle-"))
+			Expect(filter).To(Equal(v2action.FirehoseFilterNone))
+		})
+	})
+
+	Context("when --json is provided", func() {
+		BeforeEach(func() {
+			cmd.JSON = true
+		})
+
+		It("only displays the envelopes as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Connecting to the firehose"))
+			Expect(testUI.Out).To(Say(`{"origin":"some-origin","eventType":6}`))
+		})
+	})
+
+	Context("when streaming the firehose fails", func() {
+		BeforeEach(func() {
+			streamErr = errors.New("some-error")
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(streamErr))
+		})
+	})
+})
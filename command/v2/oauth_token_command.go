@@ -2,21 +2,94 @@ package v2
 
 import (
 	"os"
+	"strings"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . OauthTokenActor
+
+type OauthTokenActor interface {
+	DecodeAccessToken(accessToken string) (v2action.AccessTokenClaims, error)
+	RefreshAccessToken(config v2action.Config) (string, error)
+}
+
 type OauthTokenCommand struct {
-	usage           interface{} `usage:"CF_NAME oauth-token"`
+	Decode          bool        `long:"decode" description:"Display the user, scopes and expiry encoded in the token instead of the token itself"`
+	usage           interface{} `usage:"CF_NAME oauth-token [--decode]"`
 	relatedCommands interface{} `related_commands:"curl"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OauthTokenActor
 }
 
-func (_ OauthTokenCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *OauthTokenCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if command.UsesLegacyImplementation(cmd.Config, "oauth-token", cmd.Decode) {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ OauthTokenCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd OauthTokenCommand) Execute(args []string) error {
+	if command.UsesLegacyImplementation(cmd.Config, "oauth-token", cmd.Decode) {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	accessToken, err := cmd.Actor.RefreshAccessToken(cmd.Config)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Decode {
+		cmd.UI.DisplayText(accessToken)
+		return nil
+	}
+
+	claims, err := cmd.Actor.DecodeAccessToken(accessToken)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("user:"), claims.UserName},
+		{cmd.UI.TranslateText("user id:"), claims.UserID},
+		{cmd.UI.TranslateText("client id:"), claims.ClientID},
+		{cmd.UI.TranslateText("issuer:"), claims.Issuer},
+		{cmd.UI.TranslateText("scopes:"), strings.Join(claims.Scopes, ", ")},
+		{cmd.UI.TranslateText("issued at:"), formatTokenTime(claims.IssuedAt)},
+		{cmd.UI.TranslateText("expires at:"), formatTokenTime(claims.ExpiresAt)},
+	}, 3)
+
 	return nil
 }
+
+func formatTokenTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
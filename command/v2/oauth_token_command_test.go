@@ -0,0 +1,154 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("oauth-token Command", func() {
+	var (
+		cmd             OauthTokenCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeOauthTokenActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeOauthTokenActor)
+
+		cmd = OauthTokenCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		Context("when refreshing the token fails", func() {
+			BeforeEach(func() {
+				fakeActor.RefreshAccessTokenReturns("", uaa.InvalidAuthTokenError{})
+			})
+
+			It("returns an InvalidRefreshTokenError", func() {
+				Expect(executeErr).To(MatchError(shared.InvalidRefreshTokenError{}))
+			})
+		})
+
+		Context("when refreshing the token succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.RefreshAccessTokenReturns("bearer some-access-token", nil)
+			})
+
+			It("displays only the refreshed token", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("^bearer some-access-token\n$"))
+
+				Expect(fakeActor.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeActor.RefreshAccessTokenArgsForCall(0)).To(Equal(fakeConfig))
+				Expect(fakeActor.DecodeAccessTokenCallCount()).To(Equal(0))
+			})
+
+			Context("when --decode is provided", func() {
+				BeforeEach(func() {
+					cmd.Decode = true
+					fakeConfig.ExperimentalReturns(false)
+				})
+
+				Context("when the token can be decoded", func() {
+					BeforeEach(func() {
+						fakeActor.DecodeAccessTokenReturns(v2action.AccessTokenClaims{
+							UserName:  "some-user",
+							UserID:    "some-user-guid",
+							ClientID:  "cf",
+							Issuer:    "https://uaa.example.com/oauth/token",
+							Scopes:    []string{"cloud_controller.read", "openid"},
+							IssuedAt:  time.Unix(1473284577, 0),
+							ExpiresAt: time.Unix(1473285177, 0),
+						}, nil)
+					})
+
+					It("displays the token's claims", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("bearer some-access-token"))
+						Expect(testUI.Out).To(Say("user:\\s+some-user"))
+						Expect(testUI.Out).To(Say("user id:\\s+some-user-guid"))
+						Expect(testUI.Out).To(Say("client id:\\s+cf"))
+						Expect(testUI.Out).To(Say("issuer:\\s+https://uaa.example.com/oauth/token"))
+						Expect(testUI.Out).To(Say("scopes:\\s+cloud_controller.read, openid"))
+						Expect(testUI.Out).To(Say("issued at:\\s+2016-09-07T21:42:57Z"))
+						Expect(testUI.Out).To(Say("expires at:\\s+2016-09-07T21:52:57Z"))
+
+						Expect(fakeActor.DecodeAccessTokenArgsForCall(0)).To(Equal("bearer some-access-token"))
+					})
+				})
+
+				Context("when the token cannot be decoded", func() {
+					BeforeEach(func() {
+						fakeActor.DecodeAccessTokenReturns(v2action.AccessTokenClaims{}, v2action.InvalidAccessTokenError{})
+					})
+
+					It("returns an InvalidAccessTokenError", func() {
+						Expect(executeErr).To(MatchError(shared.InvalidAccessTokenError{}))
+					})
+				})
+
+				Context("when decoding returns an unknown error", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("decode error")
+						fakeActor.DecodeAccessTokenReturns(v2action.AccessTokenClaims{}, expectedErr)
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+					})
+				})
+			})
+		})
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
@@ -24,6 +25,7 @@ type OrgActor interface {
 
 type OrgActorV3 interface {
 	GetIsolationSegmentsByOrganization(orgName string) ([]v3action.IsolationSegment, v3action.Warnings, error)
+	GetOrganizationDefaultIsolationSegment(orgGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
 
@@ -116,7 +118,7 @@ func (cmd OrgCommand) displayOrgSummary() error {
 	}
 
 	if cmd.ActorV3 != nil {
-		apiCheck := command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), "3.11.0")
+		apiCheck := command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionIsolationSegmentV3)
 		if apiCheck == nil {
 			isolationSegments, v3Warnings, err := cmd.ActorV3.GetIsolationSegmentsByOrganization(orgSummary.GUID)
 			cmd.UI.DisplayWarnings(v3Warnings)
@@ -131,6 +133,16 @@ func (cmd OrgCommand) displayOrgSummary() error {
 
 			sort.Strings(isolationSegmentNames)
 			table = append(table, []string{cmd.UI.TranslateText("isolation segments:"), strings.Join(isolationSegmentNames, ", ")})
+
+			defaultIsolationSegmentName := ""
+			defaultIsolationSegment, v3Warnings, err := cmd.ActorV3.GetOrganizationDefaultIsolationSegment(orgSummary.GUID)
+			cmd.UI.DisplayWarnings(v3Warnings)
+			if err == nil {
+				defaultIsolationSegmentName = defaultIsolationSegment.Name
+			} else if _, ok := err.(v3action.NoRelationshipError); !ok {
+				return shared.HandleError(err)
+			}
+			table = append(table, []string{cmd.UI.TranslateText("default isolation segment:"), defaultIsolationSegmentName})
 		}
 	}
 
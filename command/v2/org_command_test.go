@@ -193,6 +193,10 @@ var _ = Describe("org Command", func() {
 						},
 						v3action.Warnings{"warning-3", "warning-4"},
 						nil)
+					fakeActorV3.GetOrganizationDefaultIsolationSegmentReturns(
+						v3action.IsolationSegment{Name: "isolation-segment-2"},
+						v3action.Warnings{"warning-5"},
+						nil)
 					fakeActorV3.CloudControllerAPIVersionReturns("3.12.0")
 				})
 
@@ -204,6 +208,7 @@ var _ = Describe("org Command", func() {
 					Expect(testUI.Err).To(Say("warning-2"))
 					Expect(testUI.Err).To(Say("warning-3"))
 					Expect(testUI.Err).To(Say("warning-4"))
+					Expect(testUI.Err).To(Say("warning-5"))
 
 					Expect(testUI.Out).To(Say("name:\\s+%s", cmd.RequiredArgs.Organization))
 
@@ -215,6 +220,8 @@ var _ = Describe("org Command", func() {
 
 					Expect(testUI.Out).To(Say("isolation segments:\\s+isolation-segment-1, isolation-segment-2"))
 
+					Expect(testUI.Out).To(Say("default isolation segment:\\s+isolation-segment-2"))
+
 					Expect(fakeConfig.CurrentUserCallCount()).To(Equal(1))
 
 					Expect(fakeActor.GetOrganizationSummaryByNameCallCount()).To(Equal(1))
@@ -224,6 +231,40 @@ var _ = Describe("org Command", func() {
 					Expect(fakeActorV3.GetIsolationSegmentsByOrganizationCallCount()).To(Equal(1))
 					orgGuid := fakeActorV3.GetIsolationSegmentsByOrganizationArgsForCall(0)
 					Expect(orgGuid).To(Equal("some-org-guid"))
+
+					Expect(fakeActorV3.GetOrganizationDefaultIsolationSegmentCallCount()).To(Equal(1))
+					Expect(fakeActorV3.GetOrganizationDefaultIsolationSegmentArgsForCall(0)).To(Equal("some-org-guid"))
+				})
+
+				Context("when the org does not have a default isolation segment", func() {
+					BeforeEach(func() {
+						fakeActorV3.GetOrganizationDefaultIsolationSegmentReturns(
+							v3action.IsolationSegment{},
+							nil,
+							v3action.NoRelationshipError{})
+					})
+
+					It("leaves the default isolation segment empty", func() {
+						Expect(executeErr).To(BeNil())
+						Expect(testUI.Out).To(Say("(?m)default isolation segment:\\s*$"))
+					})
+				})
+
+				Context("when getting the default isolation segment returns an error", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("get default iso seg error")
+						fakeActorV3.GetOrganizationDefaultIsolationSegmentReturns(
+							v3action.IsolationSegment{},
+							v3action.Warnings{"get default iso seg warning"},
+							expectedErr)
+					})
+
+					It("returns the error and all warnings", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Err).To(Say("get default iso seg warning"))
+					})
 				})
 			})
 
@@ -1,21 +1,242 @@
 package v2
 
 import (
-	"os"
+	"encoding/json"
+	"fmt"
+	"strconv"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . OrgsActor
+
+type OrgsActor interface {
+	GetOrganizationsWithQuotas(handlePage func([]v2action.OrganizationWithQuota)) (v2action.Warnings, error)
+	GetOrganizationsUsage() ([]v2action.OrganizationUsage, v2action.Warnings, error)
+}
+
 type OrgsCommand struct {
-	usage interface{} `usage:"CF_NAME orgs"`
+	JSON  bool        `long:"json" description:"Output the orgs as JSON"`
+	Usage bool        `long:"usage" description:"Show the memory, app instances, routes and service instances each org uses against its quota"`
+	usage interface{} `usage:"CF_NAME orgs [--usage] [--json]\n\n   Memory and app instances are counted for started apps only. Limits of -1 in the JSON output are unlimited."`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OrgsActor
+}
+
+type orgJSON struct {
+	Name  string `json:"name"`
+	GUID  string `json:"guid"`
+	Quota string `json:"quota"`
+}
+
+type orgUsageJSON struct {
+	Name                 string `json:"name"`
+	GUID                 string `json:"guid"`
+	Quota                string `json:"quota"`
+	MemoryUsed           int    `json:"memory_used_mb"`
+	MemoryLimit          int    `json:"memory_limit_mb"`
+	Instances            int    `json:"instances"`
+	InstanceLimit        int    `json:"instance_limit"`
+	Routes               int    `json:"routes"`
+	RouteLimit           int    `json:"route_limit"`
+	ServiceInstances     int    `json:"service_instances"`
+	ServiceInstanceLimit int    `json:"service_instance_limit"`
 }
 
-func (_ OrgsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *OrgsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ OrgsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd OrgsCommand) Execute(args []string) error {
+	cmd.JSON = command.JSONOutputRequested(cmd.Config, cmd.JSON)
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Usage {
+		return cmd.displayOrgsUsage()
+	}
+
+	if cmd.JSON {
+		return cmd.displayOrgsJSON()
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	displayer := shared.PaginatedTableDisplayer{
+		UI:     cmd.UI,
+		Header: []string{"name", "quota"},
+	}
+	warnings, err := cmd.Actor.GetOrganizationsWithQuotas(func(orgs []v2action.OrganizationWithQuota) {
+		rows := make([][]string, 0, len(orgs))
+		for _, org := range orgs {
+			rows = append(rows, []string{org.Name, org.QuotaName})
+		}
+		displayer.DisplayPage(rows)
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !displayer.DisplayedRows() {
+		cmd.UI.DisplayText("No orgs found")
+	}
+
 	return nil
 }
+
+func (cmd OrgsCommand) displayOrgsJSON() error {
+	orgs := []orgJSON{}
+	warnings, err := cmd.Actor.GetOrganizationsWithQuotas(func(page []v2action.OrganizationWithQuota) {
+		for _, org := range page {
+			orgs = append(orgs, orgJSON{Name: org.Name, GUID: org.GUID, Quota: org.QuotaName})
+		}
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	output, err := json.MarshalIndent(orgs, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
+
+func (cmd OrgsCommand) displayOrgsUsage() error {
+	if !cmd.JSON {
+		user, err := cmd.Config.CurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Getting orgs usage as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	usages, warnings, err := cmd.Actor.GetOrganizationsUsage()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.JSON {
+		orgs := make([]orgUsageJSON, 0, len(usages))
+		for _, usage := range usages {
+			orgs = append(orgs, orgUsageJSON{
+				Name:                 usage.Name,
+				GUID:                 usage.GUID,
+				Quota:                usage.Quota.Name,
+				MemoryUsed:           usage.MemoryUsed,
+				MemoryLimit:          usage.Quota.MemoryLimit,
+				Instances:            usage.Instances,
+				InstanceLimit:        usage.Quota.AppInstanceLimit,
+				Routes:               usage.Routes,
+				RouteLimit:           usage.Quota.TotalRoutes,
+				ServiceInstances:     usage.ServiceInstances,
+				ServiceInstanceLimit: usage.Quota.TotalServices,
+			})
+		}
+
+		output, err := json.MarshalIndent(orgs, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+		return err
+	}
+
+	if len(usages) == 0 {
+		cmd.UI.DisplayText("No orgs found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("quota"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("routes"),
+			cmd.UI.TranslateText("service instances"),
+		},
+	}
+	for _, usage := range usages {
+		hasQuota := usage.Quota.GUID != ""
+		table = append(table, []string{
+			usage.Name,
+			usage.Quota.Name,
+			cmd.usageCell(formatMegabytes(usage.MemoryUsed), cmd.memoryLimit(hasQuota, usage.Quota.MemoryLimit)),
+			cmd.usageCell(strconv.Itoa(usage.Instances), cmd.countLimit(hasQuota, usage.Quota.AppInstanceLimit)),
+			cmd.usageCell(strconv.Itoa(usage.Routes), cmd.countLimit(hasQuota, usage.Quota.TotalRoutes)),
+			cmd.usageCell(strconv.Itoa(usage.ServiceInstances), cmd.countLimit(hasQuota, usage.Quota.TotalServices)),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
+
+// usageCell formats a used amount against its limit. Only the used amount is
+// shown when the limit is unknown.
+func (cmd OrgsCommand) usageCell(used string, limit string) string {
+	if limit == "" {
+		return used
+	}
+	return used + " / " + limit
+}
+
+func (cmd OrgsCommand) memoryLimit(hasQuota bool, limit int) string {
+	switch {
+	case !hasQuota:
+		return ""
+	case limit < 0:
+		return cmd.UI.TranslateText("unlimited")
+	default:
+		return formatMegabytes(limit)
+	}
+}
+
+func (cmd OrgsCommand) countLimit(hasQuota bool, limit int) string {
+	switch {
+	case !hasQuota:
+		return ""
+	case limit < 0:
+		return cmd.UI.TranslateText("unlimited")
+	default:
+		return strconv.Itoa(limit)
+	}
+}
@@ -0,0 +1,286 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("orgs Command", func() {
+	var (
+		cmd             OrgsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeOrgsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeOrgsActor)
+
+		cmd = OrgsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			config, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(targetedOrganizationRequired).To(Equal(false))
+			Expect(targetedSpaceRequired).To(Equal(false))
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when there are orgs spread across multiple pages", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationsWithQuotasStub = func(handlePage func([]v2action.OrganizationWithQuota)) (v2action.Warnings, error) {
+				handlePage([]v2action.OrganizationWithQuota{
+					{Organization: v2action.Organization{GUID: "org-guid-1", Name: "org-1"}, QuotaName: "quota-1"},
+					{Organization: v2action.Organization{GUID: "org-guid-2", Name: "org-2"}, QuotaName: "quota-2"},
+				})
+				handlePage([]v2action.OrganizationWithQuota{
+					{Organization: v2action.Organization{GUID: "org-guid-3", Name: "org-3"}, QuotaName: "quota-1"},
+				})
+				return v2action.Warnings{"warning-1", "warning-2"}, nil
+			}
+		})
+
+		It("displays the orgs and their quotas with a single header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting orgs as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("name\\s+quota"))
+			Expect(testUI.Out).To(Say("org-1\\s+quota-1"))
+			Expect(testUI.Out).To(Say("org-2\\s+quota-2"))
+			Expect(testUI.Out).To(Say("org-3\\s+quota-1"))
+			Expect(testUI.Out).ToNot(Say("name\\s+quota"))
+			Expect(testUI.Out).ToNot(Say("No orgs found"))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+
+		Context("when a later page has shorter names than the first", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsWithQuotasStub = func(handlePage func([]v2action.OrganizationWithQuota)) (v2action.Warnings, error) {
+					handlePage([]v2action.OrganizationWithQuota{
+						{Organization: v2action.Organization{GUID: "org-guid-1", Name: "some-long-org-1"}, QuotaName: "quota-1"},
+					})
+					handlePage([]v2action.OrganizationWithQuota{
+						{Organization: v2action.Organization{GUID: "org-guid-2", Name: "org-2"}, QuotaName: "quota-2"},
+					})
+					return nil, nil
+				}
+			})
+
+			It("keeps the column widths of the first page", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("some-long-org-1   quota-1\n"))
+				Expect(testUI.Out).To(Say("org-2             quota-2\n"))
+			})
+		})
+
+		Context("when the --json flag is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("displays the orgs as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting orgs"))
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{"name": "org-1", "guid": "org-guid-1", "quota": "quota-1"},
+					{"name": "org-2", "guid": "org-guid-2", "quota": "quota-2"},
+					{"name": "org-3", "guid": "org-guid-3", "quota": "quota-1"}
+				]`))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+			})
+		})
+	})
+
+	Context("when there are no orgs", func() {
+		It("displays a message that no orgs were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("name\\s+quota"))
+			Expect(testUI.Out).To(Say("No orgs found"))
+		})
+
+		Context("when the --json flag is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("displays an empty JSON list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[]`))
+			})
+		})
+	})
+
+	Context("when getting the orgs fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some orgs error")
+			fakeActor.GetOrganizationsWithQuotasReturns(v2action.Warnings{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	Context("when the --usage flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Usage = true
+			fakeActor.GetOrganizationsUsageReturns([]v2action.OrganizationUsage{
+				{
+					Organization: v2action.Organization{GUID: "org-guid-1", Name: "org-1"},
+					Quota: v2action.OrganizationQuota{
+						GUID:             "quota-guid",
+						Name:             "some-quota",
+						MemoryLimit:      10240,
+						AppInstanceLimit: -1,
+						TotalRoutes:      100,
+						TotalServices:    20,
+					},
+					MemoryUsed:       640,
+					Instances:        3,
+					Routes:           2,
+					ServiceInstances: 1,
+				},
+				{
+					Organization: v2action.Organization{GUID: "org-guid-2", Name: "org-2"},
+					MemoryUsed:   1024,
+					Instances:    1,
+				},
+			}, v2action.Warnings{"usage-warning"}, nil)
+		})
+
+		It("displays the usage of each org against its quota", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting orgs usage as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("name\\s+quota\\s+memory\\s+instances\\s+routes\\s+service instances"))
+			Expect(testUI.Out).To(Say("org-1\\s+some-quota\\s+640M / 10G\\s+3 / unlimited\\s+2 / 100\\s+1 / 20"))
+			Expect(testUI.Out).To(Say("org-2\\s+1G\\s+1\\s+0\\s+0"))
+			Expect(testUI.Err).To(Say("usage-warning"))
+			Expect(fakeActor.GetOrganizationsWithQuotasCallCount()).To(Equal(0))
+		})
+
+		Context("when the memory of the quota is unlimited", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsUsageReturns([]v2action.OrganizationUsage{
+					{
+						Organization: v2action.Organization{GUID: "org-guid-1", Name: "org-1"},
+						Quota: v2action.OrganizationQuota{
+							GUID:             "quota-guid",
+							Name:             "some-quota",
+							MemoryLimit:      -1,
+							AppInstanceLimit: 10,
+						},
+						MemoryUsed: 640,
+						Instances:  3,
+					},
+				}, nil, nil)
+			})
+
+			It("displays the memory limit as unlimited", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("org-1\\s+some-quota\\s+640M / unlimited\\s+3 / 10"))
+			})
+		})
+
+		Context("when the --json flag is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("displays the usage as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{
+						"name": "org-1", "guid": "org-guid-1", "quota": "some-quota",
+						"memory_used_mb": 640, "memory_limit_mb": 10240,
+						"instances": 3, "instance_limit": -1,
+						"routes": 2, "route_limit": 100,
+						"service_instances": 1, "service_instance_limit": 20
+					},
+					{
+						"name": "org-2", "guid": "org-guid-2", "quota": "",
+						"memory_used_mb": 1024, "memory_limit_mb": 0,
+						"instances": 1, "instance_limit": 0,
+						"routes": 0, "route_limit": 0,
+						"service_instances": 0, "service_instance_limit": 0
+					}
+				]`))
+				Expect(testUI.Err).To(Say("usage-warning"))
+			})
+		})
+
+		Context("when getting the usage fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("usage-error")
+				fakeActor.GetOrganizationsUsageReturns(nil, v2action.Warnings{"usage-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("usage-warning"))
+			})
+		})
+	})
+})
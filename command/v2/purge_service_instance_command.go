@@ -1,25 +1,85 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . PurgeServiceInstanceActor
+
+type PurgeServiceInstanceActor interface {
+	PurgeServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.Warnings, error)
+}
+
 type PurgeServiceInstanceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
 	usage           interface{}          `usage:"CF_NAME purge-service-instance SERVICE_INSTANCE\n\nWARNING: This operation assumes that the service broker responsible for this service instance is no longer available or is not responding with a 200 or 410, and the service instance has been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service instance will be removed from Cloud Foundry, including service bindings and service keys."`
 	relatedCommands interface{}          `related_commands:"delete-service, services, service-brokers"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       PurgeServiceInstanceActor
 }
 
-func (_ PurgeServiceInstanceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *PurgeServiceInstanceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ PurgeServiceInstanceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd PurgeServiceInstanceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Force {
+		cmd.UI.DisplayText("WARNING: This operation assumes that the service broker responsible for this service instance is no longer available or is not responding with a 200 or 410, and the service instance has been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service instance will be removed from Cloud Foundry, including service bindings and service keys.")
+		cmd.UI.DisplayNewline()
+
+		purge, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really purge service instance {{.InstanceName}} from Cloud Foundry?", map[string]interface{}{
+			"InstanceName": cmd.RequiredArgs.ServiceInstance,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !purge {
+			cmd.UI.DisplayText("Purge service instance cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayText("Purging service {{.InstanceName}}...", map[string]interface{}{
+		"InstanceName": cmd.RequiredArgs.ServiceInstance,
+	})
+
+	warnings, err := cmd.Actor.PurgeServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.ServiceInstanceNotFoundError); ok {
+			cmd.UI.DisplayWarning("Service instance {{.InstanceName}} not found", map[string]interface{}{
+				"InstanceName": cmd.RequiredArgs.ServiceInstance,
+			})
+		} else {
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
@@ -0,0 +1,168 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("purge-service-instance Command", func() {
+	var (
+		cmd             PurgeServiceInstanceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakePurgeServiceInstanceActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakePurgeServiceInstanceActor)
+
+		cmd = PurgeServiceInstanceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in and targeting a space", func() {
+		Context("when the '-f' flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			Context("when the purge succeeds", func() {
+				BeforeEach(func() {
+					fakeActor.PurgeServiceInstanceByNameAndSpaceReturns(v2action.Warnings{"warning-1", "warning-2"}, nil)
+				})
+
+				It("purges the service instance without prompting and displays all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Really purge service instance"))
+					Expect(testUI.Out).To(Say("Purging service some-service-instance..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+
+					Expect(fakeActor.PurgeServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+					name, spaceGUID := fakeActor.PurgeServiceInstanceByNameAndSpaceArgsForCall(0)
+					Expect(name).To(Equal("some-service-instance"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when the service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.PurgeServiceInstanceByNameAndSpaceReturns(
+						v2action.Warnings{"warning-1"},
+						v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+				})
+
+				It("displays a warning and returns no error", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("Service instance some-service-instance not found"))
+					Expect(testUI.Out).To(Say("OK"))
+				})
+			})
+
+			Context("when purging returns another error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some error")
+					fakeActor.PurgeServiceInstanceByNameAndSpaceReturns(v2action.Warnings{"warning-1"}, expectedErr)
+				})
+
+				It("returns the error and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("warning-1"))
+				})
+			})
+		})
+
+		Context("when the '-f' flag is not provided", func() {
+			Context("when the user inputs yes", func() {
+				BeforeEach(func() {
+					input.Write([]byte("y\n"))
+				})
+
+				It("displays the warning, prompts for confirmation and purges the service instance", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("WARNING: This operation assumes that the service broker responsible for this service instance is no longer available"))
+					Expect(testUI.Out).To(Say("Really purge service instance some-service-instance from Cloud Foundry\\? \\[yN\\]:"))
+					Expect(testUI.Out).To(Say("Purging service some-service-instance..."))
+					Expect(testUI.Out).To(Say("OK"))
+
+					Expect(fakeActor.PurgeServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the user inputs no", func() {
+				BeforeEach(func() {
+					input.Write([]byte("n\n"))
+				})
+
+				It("does not purge the service instance", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Purge service instance cancelled"))
+					Expect(fakeActor.PurgeServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when displaying the prompt returns an error", func() {
+				// if nothing is written to input, display bool prompt returns EOF
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("EOF"))
+					Expect(fakeActor.PurgeServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,181 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("purge-service-offering Command", func() {
+	var (
+		cmd             PurgeServiceOfferingCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakePurgeServiceOfferingActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakePurgeServiceOfferingActor)
+
+		cmd = PurgeServiceOfferingCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.Service = "some-service"
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		Context("when the '-f' flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+				cmd.Provider = "some-provider"
+			})
+
+			Context("when the purge succeeds", func() {
+				BeforeEach(func() {
+					fakeActor.PurgeServiceOfferingReturns(v2action.Warnings{"warning-1", "warning-2"}, nil)
+				})
+
+				It("purges the service offering without prompting and displays all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Really purge service offering"))
+					Expect(testUI.Out).To(Say("Purging service some-service..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+
+					Expect(fakeActor.PurgeServiceOfferingCallCount()).To(Equal(1))
+					name, provider := fakeActor.PurgeServiceOfferingArgsForCall(0)
+					Expect(name).To(Equal("some-service"))
+					Expect(provider).To(Equal("some-provider"))
+				})
+			})
+
+			Context("when the service offering does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.PurgeServiceOfferingReturns(
+						v2action.Warnings{"warning-1"},
+						v2action.ServiceNotFoundError{Name: "some-service", Provider: "some-provider"})
+				})
+
+				It("displays a warning with a tip and returns no error", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("Service offering does not exist"))
+					Expect(testUI.Err).To(Say("TIP: If you are trying to purge a v1 service offering, you must set the -p flag."))
+					Expect(testUI.Out).To(Say("OK"))
+				})
+			})
+
+			Context("when purging returns another error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some error")
+					fakeActor.PurgeServiceOfferingReturns(v2action.Warnings{"warning-1"}, expectedErr)
+				})
+
+				It("returns the error and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("warning-1"))
+				})
+			})
+		})
+
+		Context("when the '-f' flag is not provided", func() {
+			Context("when the user inputs yes", func() {
+				BeforeEach(func() {
+					input.Write([]byte("y\n"))
+				})
+
+				It("displays the warning, prompts for confirmation and purges the service offering", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("WARNING: This operation assumes that the service broker responsible for this service offering is no longer available"))
+					Expect(testUI.Out).To(Say("Really purge service offering some-service from Cloud Foundry\\? \\[yN\\]:"))
+					Expect(testUI.Out).To(Say("Purging service some-service..."))
+					Expect(testUI.Out).To(Say("OK"))
+
+					Expect(fakeActor.PurgeServiceOfferingCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the user inputs no", func() {
+				BeforeEach(func() {
+					input.Write([]byte("n\n"))
+				})
+
+				It("does not purge the service offering", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Purge service offering cancelled"))
+					Expect(fakeActor.PurgeServiceOfferingCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the user chooses the default", func() {
+				BeforeEach(func() {
+					input.Write([]byte("\n"))
+				})
+
+				It("does not purge the service offering", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Purge service offering cancelled"))
+					Expect(fakeActor.PurgeServiceOfferingCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when displaying the prompt returns an error", func() {
+				// if nothing is written to input, display bool prompt returns EOF
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("EOF"))
+					Expect(fakeActor.PurgeServiceOfferingCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,128 @@
+package v2
+
+import (
+	"context"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	log "github.com/Sirupsen/logrus"
+)
+
+//go:generate counterfeiter . PushAllActor
+
+type PushAllActor interface {
+	Apply(ctx context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
+	ReadManifestDirectory(dir string) ([]manifest.Application, error)
+	ScheduleApplications(apps []manifest.Application) ([]manifest.Application, error)
+}
+
+type PushAllCommand struct {
+	ManifestDirectory flag.PathWithExistenceCheck `short:"d" required:"true" description:"Directory containing the manifests of the apps to push"`
+	FailFast          bool                        `long:"fail-fast" description:"Stop at the first app that fails instead of pushing the remaining apps"`
+	PruneServices     bool                        `long:"prune-services" description:"Unbind the service instances of the apps that are not in their manifest"`
+	Reset             bool                        `long:"reset" description:"Replace the environment variables, services and number of instances of existing apps with the ones in their manifest instead of merging them; implies --prune-services"`
+	usage             interface{}                 `usage:"CF_NAME push-all -d MANIFEST_DIRECTORY [--fail-fast] [--prune-services] [--reset]\n\n   Every .yml and .yaml file in the directory is read as a manifest. Apps are pushed after the apps listed in their 'depends_on' key."`
+	relatedCommands   interface{}                 `related_commands:"apps, v2-push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       PushAllActor
+}
+
+func (cmd *PushAllCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewInterruptibleClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	v3Actor, err := newPushV3Actor(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pushaction.NewActor(v2action.NewActor(ccClient, uaaClient), v3Actor)
+	return nil
+}
+
+func (cmd PushAllCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	log.Infoln("reading manifests from:", cmd.ManifestDirectory)
+	apps, err := cmd.Actor.ReadManifestDirectory(string(cmd.ManifestDirectory))
+	if err != nil {
+		log.Errorln("reading manifests:", err)
+		return shared.HandleError(err)
+	}
+
+	log.Info("merging manifest and command flags")
+	apps, err = cmd.Actor.MergeAndValidateSettingsAndManifests(pushaction.CommandLineSettings{}, apps)
+	if err != nil {
+		log.Errorln("merging manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	if cmd.PruneServices {
+		err = validatePruneServices(apps)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info("scheduling applications")
+	apps, err = cmd.Actor.ScheduleApplications(apps)
+	if err != nil {
+		log.Errorln("scheduling applications:", err)
+		return shared.HandleError(err)
+	}
+
+	var appNames []string
+	for _, app := range apps {
+		appNames = append(appNames, app.Name)
+	}
+	cmd.UI.DisplayText("Pushing apps in order: {{.AppNames}}", map[string]interface{}{
+		"AppNames": strings.Join(appNames, ", "),
+	})
+
+	cmd.UI.DisplayText("Getting app info...")
+
+	log.Info("converting manifests to ApplicationConfigs")
+	appConfigs, warnings, err := cmd.Actor.ConvertToApplicationConfig(
+		cmd.Config.TargetedOrganization().GUID,
+		cmd.Config.TargetedSpace().GUID,
+		apps,
+		cmd.Reset,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("converting manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	for i := range appConfigs {
+		appConfigs[i].PruneServices = appConfigs[i].PruneServices || cmd.PruneServices
+	}
+
+	pushCmd := V2PushCommand{UI: cmd.UI, Config: cmd.Config}
+	return pushCmd.pushApps(appConfigs, cmd.FailFast, func(ctx context.Context, appConfig pushaction.ApplicationConfig) error {
+		applyCtx, stopApply := context.WithCancel(ctx)
+		defer stopApply()
+		eventStream, warningsStream, errorStream := cmd.Actor.Apply(applyCtx, appConfig)
+		return pushCmd.processApplyStreams(appConfig, eventStream, warningsStream, errorStream)
+	})
+}
@@ -0,0 +1,295 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/exitcode"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("push-all Command", func() {
+	var (
+		cmd             PushAllCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakePushAllActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakePushAllActor)
+
+		cmd = PushAllCommand{
+			UI:                testUI,
+			Config:            fakeConfig,
+			SharedActor:       fakeSharedActor,
+			Actor:             fakeActor,
+			ManifestDirectory: "./manifests",
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		var apps []manifest.Application
+
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			apps = []manifest.Application{
+				{Name: "frontend", DependsOn: []string{"api"}},
+				{Name: "api"},
+			}
+			fakeActor.ReadManifestDirectoryReturns(apps, nil)
+			fakeActor.MergeAndValidateSettingsAndManifestsStub = func(_ pushaction.CommandLineSettings, manifestApps []manifest.Application) ([]manifest.Application, error) {
+				return manifestApps, nil
+			}
+		})
+
+		Context("when the directory has no manifests", func() {
+			BeforeEach(func() {
+				fakeActor.ReadManifestDirectoryReturns(nil, pushaction.NoManifestsFoundError{Directory: "./manifests"})
+			})
+
+			It("returns a NoManifestsFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.NoManifestsFoundError{Directory: "./manifests"}))
+				Expect(fakeActor.ReadManifestDirectoryArgsForCall(0)).To(Equal("./manifests"))
+			})
+		})
+
+		Context("when the manifests are invalid", func() {
+			BeforeEach(func() {
+				fakeActor.MergeAndValidateSettingsAndManifestsStub = nil
+				fakeActor.MergeAndValidateSettingsAndManifestsReturns(nil, pushaction.MissingApplicationNameError{})
+			})
+
+			It("returns the error without scheduling or pushing anything", func() {
+				Expect(executeErr).To(MatchError(shared.MissingApplicationNameError{}))
+
+				Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
+				settings, manifestApps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+				Expect(settings).To(Equal(pushaction.CommandLineSettings{}))
+				Expect(manifestApps).To(Equal(apps))
+				Expect(fakeActor.ScheduleApplicationsCallCount()).To(Equal(0))
+				Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --prune-services is provided and an app does not list its services", func() {
+			BeforeEach(func() {
+				cmd.PruneServices = true
+				apps[1].Services = []string{"some-service"}
+			})
+
+			It("returns a PruneServicesWithoutServicesError without pushing anything", func() {
+				Expect(executeErr).To(MatchError(shared.PruneServicesWithoutServicesError{AppName: "frontend"}))
+				Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the dependencies cannot be scheduled", func() {
+			BeforeEach(func() {
+				fakeActor.ScheduleApplicationsReturns(nil, pushaction.DependencyCycleError{Names: []string{"frontend", "api"}})
+			})
+
+			It("returns a DependencyCycleError without pushing anything", func() {
+				Expect(executeErr).To(MatchError(shared.DependencyCycleError{Names: []string{"frontend", "api"}}))
+				Expect(fakeActor.ScheduleApplicationsArgsForCall(0)).To(Equal(apps))
+				Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the apps are scheduled", func() {
+			var orderedApps []manifest.Application
+
+			BeforeEach(func() {
+				orderedApps = []manifest.Application{apps[1], apps[0]}
+				fakeActor.ScheduleApplicationsReturns(orderedApps, nil)
+			})
+
+			Context("when converting to app configs fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-convert-error")
+					fakeActor.ConvertToApplicationConfigReturns(nil, pushaction.Warnings{"some-config-warning"}, expectedErr)
+				})
+
+				It("displays the warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("some-config-warning"))
+				})
+			})
+
+			Context("when the apps can be converted to app configs", func() {
+				BeforeEach(func() {
+					fakeActor.ConvertToApplicationConfigReturns([]pushaction.ApplicationConfig{
+						{DesiredApplication: v2action.Application{Name: "api"}},
+						{DesiredApplication: v2action.Application{Name: "frontend"}},
+					}, pushaction.Warnings{"some-config-warning"}, nil)
+
+					fakeActor.ApplyStub = func(context.Context, pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+						eventStream := make(chan pushaction.Event)
+						warningsStream := make(chan pushaction.Warnings)
+						errorStream := make(chan error)
+
+						go func() {
+							defer GinkgoRecover()
+
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.ApplicationCreated}))
+							Eventually(warningsStream).Should(BeSent(pushaction.Warnings{"some-apply-warning"}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.Complete}))
+							close(eventStream)
+							close(warningsStream)
+							close(errorStream)
+						}()
+
+						return eventStream, warningsStream, errorStream
+					}
+				})
+
+				It("pushes the apps in dependency order", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+					Expect(testUI.Out).To(Say("Pushing apps in order: api, frontend"))
+					Expect(testUI.Out).To(Say("Getting app info..."))
+					Expect(testUI.Out).To(Say("Creating app api in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("Creating app frontend in org some-org / space some-space as some-user..."))
+					Expect(testUI.Err).To(Say("some-config-warning"))
+					Expect(testUI.Err).To(Say("some-apply-warning"))
+
+					Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(1))
+					orgGUID, spaceGUID, convertedApps, reset := fakeActor.ConvertToApplicationConfigArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(convertedApps).To(Equal(orderedApps))
+					Expect(reset).To(BeFalse())
+
+					Expect(fakeActor.ApplyCallCount()).To(Equal(2))
+					_, firstConfig := fakeActor.ApplyArgsForCall(0)
+					Expect(firstConfig.DesiredApplication.Name).To(Equal("api"))
+					_, secondConfig := fakeActor.ApplyArgsForCall(1)
+					Expect(secondConfig.DesiredApplication.Name).To(Equal("frontend"))
+				})
+
+				Context("when --reset is provided", func() {
+					BeforeEach(func() {
+						cmd.Reset = true
+					})
+
+					It("converts the manifests with reset", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, _, _, reset := fakeActor.ConvertToApplicationConfigArgsForCall(0)
+						Expect(reset).To(BeTrue())
+					})
+				})
+
+				Context("when --prune-services is provided and the apps list their services", func() {
+					BeforeEach(func() {
+						cmd.PruneServices = true
+						for i := range apps {
+							apps[i].Services = []string{"some-service"}
+						}
+					})
+
+					It("prunes the services of every app", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.ApplyCallCount()).To(Equal(2))
+						_, firstConfig := fakeActor.ApplyArgsForCall(0)
+						Expect(firstConfig.PruneServices).To(BeTrue())
+						_, secondConfig := fakeActor.ApplyArgsForCall(1)
+						Expect(secondConfig.PruneServices).To(BeTrue())
+					})
+				})
+
+				Context("when an app fails to push", func() {
+					BeforeEach(func() {
+						fakeActor.ApplyStub = func(_ context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+							eventStream := make(chan pushaction.Event)
+							warningsStream := make(chan pushaction.Warnings)
+							errorStream := make(chan error, 1)
+
+							go func() {
+								if config.DesiredApplication.Name == "api" {
+									errorStream <- errors.New("api failed")
+								} else {
+									eventStream <- pushaction.Event{Type: pushaction.Complete}
+								}
+								close(eventStream)
+								close(warningsStream)
+								close(errorStream)
+							}()
+							return eventStream, warningsStream, errorStream
+						}
+					})
+
+					It("pushes the remaining apps and displays a summary", func() {
+						Expect(executeErr).To(MatchError(shared.AppsPushFailedError{AppNames: []string{"api"}, Total: 2, Code: exitcode.Failed}))
+						Expect(fakeActor.ApplyCallCount()).To(Equal(2))
+
+						Expect(testUI.Err).To(Say("api failed"))
+						Expect(testUI.Out).To(Say(`app\s+status\s+reason`))
+						Expect(testUI.Out).To(Say(`api\s+failed\s+api failed`))
+						Expect(testUI.Out).To(Say(`frontend\s+pushed`))
+					})
+
+					Context("when --fail-fast is provided", func() {
+						BeforeEach(func() {
+							cmd.FailFast = true
+						})
+
+						It("stops at the first app that fails", func() {
+							Expect(executeErr).To(MatchError("api failed"))
+							Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+							Expect(testUI.Out).ToNot(Say("status"))
+						})
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . RemoveUserFromGroupActor
+
+type RemoveUserFromGroupActor interface {
+	RemoveUserFromUAAGroup(username string, origin string, groupName string) error
+}
+
+type RemoveUserFromGroupCommand struct {
+	RequiredArgs    flag.UAAGroupMemberArgs `positional-args:"yes"`
+	Origin          string                  `long:"origin" description:"Origin of the user, required when users from several identity providers share the username"`
+	ZoneID          string                  `long:"zone-id" description:"ID of the UAA identity zone of the user and group"`
+	ZoneSubdomain   string                  `long:"zone-subdomain" description:"Subdomain of the UAA identity zone of the user and group"`
+	usage           interface{}             `usage:"CF_NAME remove-user-from-group USERNAME GROUP [--origin ORIGIN] [--zone-id ZONE_ID | --zone-subdomain SUBDOMAIN]\n\nEXAMPLES:\n   CF_NAME remove-user-from-group j.smith@example.com cloud_controller.admin"`
+	relatedCommands interface{}             `related_commands:"add-user-to-group, uaa-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RemoveUserFromGroupActor
+}
+
+func (cmd *RemoveUserFromGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	uaaClient.TargetIdentityZone(uaa.IdentityZone{ID: cmd.ZoneID, Subdomain: cmd.ZoneSubdomain})
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd RemoveUserFromGroupCommand) Execute(args []string) error {
+	if cmd.ZoneID != "" && cmd.ZoneSubdomain != "" {
+		return command.ArgumentCombinationError{Arg1: "--zone-id", Arg2: "--zone-subdomain"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Removing user {{.TargetUser}} from UAA group {{.GroupName}} as {{.Username}}...", map[string]interface{}{
+		"TargetUser": cmd.RequiredArgs.Username,
+		"GroupName":  cmd.RequiredArgs.GroupName,
+		"Username":   user.Name,
+	})
+
+	err = cmd.Actor.RemoveUserFromUAAGroup(cmd.RequiredArgs.Username, cmd.Origin, cmd.RequiredArgs.GroupName)
+	if err != nil {
+		if _, ok := err.(v2action.UAAUserNotInGroupError); !ok {
+			return shared.HandleError(err)
+		}
+		cmd.UI.DisplayText("User {{.TargetUser}} is not a member of UAA group {{.GroupName}}.", map[string]interface{}{
+			"TargetUser": cmd.RequiredArgs.Username,
+			"GroupName":  cmd.RequiredArgs.GroupName,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
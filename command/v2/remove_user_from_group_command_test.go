@@ -0,0 +1,109 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("remove-user-from-group Command", func() {
+	var (
+		cmd             RemoveUserFromGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRemoveUserFromGroupActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRemoveUserFromGroupActor)
+
+		cmd = RemoveUserFromGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "some-target-user"
+		cmd.RequiredArgs.GroupName = "cloud_controller.admin"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --zone-id and --zone-subdomain are provided", func() {
+		BeforeEach(func() {
+			cmd.ZoneID = "some-zone-id"
+			cmd.ZoneSubdomain = "some-subdomain"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--zone-id", Arg2: "--zone-subdomain"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.RemoveUserFromUAAGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.RemoveUserFromUAAGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	It("removes the user from the group", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Removing user some-target-user from UAA group cloud_controller\.admin as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		username, origin, groupName := fakeActor.RemoveUserFromUAAGroupArgsForCall(0)
+		Expect(username).To(Equal("some-target-user"))
+		Expect(origin).To(BeEmpty())
+		Expect(groupName).To(Equal("cloud_controller.admin"))
+	})
+
+	Context("when the user is not a member of the group", func() {
+		BeforeEach(func() {
+			fakeActor.RemoveUserFromUAAGroupReturns(v2action.UAAUserNotInGroupError{Username: "some-target-user", GroupName: "cloud_controller.admin"})
+		})
+
+		It("displays that the user is not a member", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`User some-target-user is not a member of UAA group cloud_controller\.admin\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when several users share the username", func() {
+		BeforeEach(func() {
+			fakeActor.RemoveUserFromUAAGroupReturns(v2action.MultipleUAAUsersFoundError{Username: "some-target-user", Origins: []string{"uaa", "ldap"}})
+		})
+
+		It("returns a MultipleUAAUsersFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.MultipleUAAUsersFoundError{Username: "some-target-user", Origins: []string{"uaa", "ldap"}}))
+		})
+	})
+})
@@ -0,0 +1,137 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename Command", func() {
+	var (
+		cmd             RenameCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRenameActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRenameActor)
+
+		cmd = RenameCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.OldAppName = "some-app"
+		cmd.RequiredArgs.NewAppName = "new-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when the app is renamed", func() {
+		BeforeEach(func() {
+			fakeActor.RenameApplicationByNameAndSpaceReturns(
+				v2action.Application{GUID: "some-app-guid", Name: "new-app"},
+				v2action.Warnings{"rename-warning"},
+				nil,
+			)
+		})
+
+		It("renames the app in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Renaming app some-app to new-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("rename-warning"))
+
+			Expect(fakeActor.RenameApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			oldName, newName, spaceGUID := fakeActor.RenameApplicationByNameAndSpaceArgsForCall(0)
+			Expect(oldName).To(Equal("some-app"))
+			Expect(newName).To(Equal("new-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when the new name is taken", func() {
+		BeforeEach(func() {
+			fakeActor.RenameApplicationByNameAndSpaceReturns(
+				v2action.Application{},
+				v2action.Warnings{"rename-warning"},
+				v2action.ApplicationNameTakenError{Name: "new-app"},
+			)
+		})
+
+		It("returns an ApplicationNameTakenError", func() {
+			Expect(executeErr).To(MatchError(shared.ApplicationNameTakenError{Name: "new-app"}))
+			Expect(testUI.Err).To(Say("rename-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.RenameApplicationByNameAndSpaceReturns(
+				v2action.Application{},
+				nil,
+				v2action.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+		})
+	})
+})
@@ -1,23 +1,71 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . RenameOrgActor
+
+type RenameOrgActor interface {
+	RenameOrganization(oldName string, newName string) (v2action.Organization, v2action.Warnings, error)
+}
+
 type RenameOrgCommand struct {
 	RequiredArgs flag.RenameOrgArgs `positional-args:"yes"`
 	usage        interface{}        `usage:"CF_NAME rename-org ORG NEW_ORG"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RenameOrgActor
 }
 
-func (_ RenameOrgCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RenameOrgCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ RenameOrgCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RenameOrgCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Renaming org {{.OrgName}} to {{.NewName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.RequiredArgs.OldOrgName,
+		"NewName":  cmd.RequiredArgs.NewOrgName,
+		"Username": user.Name,
+	})
+
+	org, warnings, err := cmd.Actor.RenameOrganization(cmd.RequiredArgs.OldOrgName, cmd.RequiredArgs.NewOrgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Config.TargetedOrganization().GUID == org.GUID {
+		cmd.Config.SetOrganizationInformation(org.GUID, org.Name)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
@@ -0,0 +1,138 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename-org Command", func() {
+	var (
+		cmd             RenameOrgCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRenameOrgActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRenameOrgActor)
+
+		cmd = RenameOrgCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.OldOrgName = "some-org"
+		cmd.RequiredArgs.NewOrgName = "new-org"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.RenameOrganizationReturns(
+			v2action.Organization{GUID: "some-org-guid", Name: "new-org"},
+			v2action.Warnings{"rename-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the org is renamed", func() {
+		It("renames the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Renaming org some-org to new-org as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("rename-warning"))
+
+			oldName, newName := fakeActor.RenameOrganizationArgsForCall(0)
+			Expect(oldName).To(Equal("some-org"))
+			Expect(newName).To(Equal("new-org"))
+		})
+
+		Context("when the renamed org is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			})
+
+			It("updates the targeted org's name", func() {
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+				orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(orgName).To(Equal("new-org"))
+			})
+		})
+
+		Context("when a different org is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "other-org-guid", Name: "other-org"})
+			})
+
+			It("leaves the target alone", func() {
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the new name is taken", func() {
+		BeforeEach(func() {
+			fakeActor.RenameOrganizationReturns(
+				v2action.Organization{},
+				v2action.Warnings{"rename-warning"},
+				v2action.OrganizationNameTakenError{Name: "new-org"},
+			)
+		})
+
+		It("returns an OrganizationNameTakenError", func() {
+			Expect(executeErr).To(MatchError(shared.OrganizationNameTakenError{Name: "new-org"}))
+			Expect(testUI.Err).To(Say("rename-warning"))
+			Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the org does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.RenameOrganizationReturns(
+				v2action.Organization{},
+				nil,
+				v2action.OrganizationNotFoundError{Name: "some-org"},
+			)
+		})
+
+		It("returns an OrganizationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.OrganizationNotFoundError{Name: "some-org"}))
+		})
+	})
+})
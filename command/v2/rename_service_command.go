@@ -1,24 +1,70 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . RenameServiceActor
+
+type RenameServiceActor interface {
+	RenameServiceInstanceByNameAndSpace(oldName string, newName string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+}
+
 type RenameServiceCommand struct {
 	RequiredArgs    flag.RenameServiceArgs `positional-args:"yes"`
 	usage           interface{}            `usage:"CF_NAME rename-service SERVICE_INSTANCE NEW_SERVICE_INSTANCE"`
 	relatedCommands interface{}            `related_commands:"services, update-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RenameServiceActor
 }
 
-func (_ RenameServiceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RenameServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ RenameServiceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RenameServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Renaming service {{.ServiceInstanceName}} to {{.NewName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"NewName":             cmd.RequiredArgs.NewServiceInstanceName,
+		"OrgName":             cmd.Config.TargetedOrganization().Name,
+		"SpaceName":           cmd.Config.TargetedSpace().Name,
+		"Username":            user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.RenameServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.NewServiceInstanceName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
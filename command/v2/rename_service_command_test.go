@@ -0,0 +1,120 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename-service Command", func() {
+	var (
+		cmd             RenameServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRenameServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRenameServiceActor)
+
+		cmd = RenameServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.RequiredArgs.NewServiceInstanceName = "new-service-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoTargetedSpaceError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NoTargetedSpaceError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the service instance is renamed", func() {
+		BeforeEach(func() {
+			fakeActor.RenameServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{GUID: "some-service-instance-guid", Name: "new-service-instance"},
+				v2action.Warnings{"rename-warning"},
+				nil,
+			)
+		})
+
+		It("renames the service instance in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Renaming service some-service-instance to new-service-instance in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("rename-warning"))
+
+			oldName, newName, spaceGUID := fakeActor.RenameServiceInstanceByNameAndSpaceArgsForCall(0)
+			Expect(oldName).To(Equal("some-service-instance"))
+			Expect(newName).To(Equal("new-service-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when the new name is taken", func() {
+		BeforeEach(func() {
+			fakeActor.RenameServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{},
+				v2action.Warnings{"rename-warning"},
+				v2action.ServiceInstanceNameTakenError{Name: "new-service-instance"},
+			)
+		})
+
+		It("returns a ServiceInstanceNameTakenError", func() {
+			Expect(executeErr).To(MatchError(shared.ServiceInstanceNameTakenError{Name: "new-service-instance"}))
+			Expect(testUI.Err).To(Say("rename-warning"))
+		})
+	})
+
+	Context("when the service instance does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.RenameServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{},
+				nil,
+				v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"},
+			)
+		})
+
+		It("returns a ServiceInstanceNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+		})
+	})
+})
@@ -1,23 +1,72 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . RenameSpaceActor
+
+type RenameSpaceActor interface {
+	RenameSpaceByOrganizationAndName(orgGUID string, oldName string, newName string) (v2action.Space, v2action.Warnings, error)
+}
+
 type RenameSpaceCommand struct {
 	RequiredArgs flag.RenameSpaceArgs `positional-args:"yes"`
 	usage        interface{}          `usage:"CF_NAME rename-space SPACE NEW_SPACE"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RenameSpaceActor
 }
 
-func (_ RenameSpaceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RenameSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ RenameSpaceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RenameSpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Renaming space {{.SpaceName}} to {{.NewName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": cmd.RequiredArgs.OldSpaceName,
+		"NewName":   cmd.RequiredArgs.NewSpaceName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"Username":  user.Name,
+	})
+
+	space, warnings, err := cmd.Actor.RenameSpaceByOrganizationAndName(cmd.Config.TargetedOrganization().GUID, cmd.RequiredArgs.OldSpaceName, cmd.RequiredArgs.NewSpaceName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if targetedSpace := cmd.Config.TargetedSpace(); targetedSpace.GUID == space.GUID {
+		cmd.Config.SetSpaceInformation(space.GUID, space.Name, targetedSpace.AllowSSH)
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
@@ -0,0 +1,140 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename-space Command", func() {
+	var (
+		cmd             RenameSpaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRenameSpaceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRenameSpaceActor)
+
+		cmd = RenameSpaceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.OldSpaceName = "some-space"
+		cmd.RequiredArgs.NewSpaceName = "new-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeActor.RenameSpaceByOrganizationAndNameReturns(
+			v2action.Space{GUID: "some-space-guid", Name: "new-space"},
+			v2action.Warnings{"rename-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoTargetedOrganizationError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NoTargetedOrganizationError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the space is renamed", func() {
+		It("renames the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Renaming space some-space to new-space in org some-org as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("rename-warning"))
+
+			orgGUID, oldName, newName := fakeActor.RenameSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(oldName).To(Equal("some-space"))
+			Expect(newName).To(Equal("new-space"))
+		})
+
+		Context("when the renamed space is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space", AllowSSH: true})
+			})
+
+			It("updates the targeted space's name", func() {
+				Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+				spaceGUID, spaceName, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(spaceName).To(Equal("new-space"))
+				Expect(allowSSH).To(BeTrue())
+			})
+		})
+
+		Context("when a different space is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "other-space-guid", Name: "other-space"})
+			})
+
+			It("leaves the target alone", func() {
+				Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the new name is taken", func() {
+		BeforeEach(func() {
+			fakeActor.RenameSpaceByOrganizationAndNameReturns(
+				v2action.Space{},
+				v2action.Warnings{"rename-warning"},
+				v2action.SpaceNameTakenError{Name: "new-space"},
+			)
+		})
+
+		It("returns a SpaceNameTakenError", func() {
+			Expect(executeErr).To(MatchError(shared.SpaceNameTakenError{Name: "new-space"}))
+			Expect(testUI.Err).To(Say("rename-warning"))
+		})
+	})
+
+	Context("when the space does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.RenameSpaceByOrganizationAndNameReturns(
+				v2action.Space{},
+				nil,
+				v2action.SpaceNotFoundError{Name: "some-space"},
+			)
+		})
+
+		It("returns a SpaceNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.SpaceNotFoundError{Name: "some-space"}))
+		})
+	})
+})
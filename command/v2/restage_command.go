@@ -1,26 +1,111 @@
 package v2
 
 import (
+	"context"
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . RestageActor
+
+type RestageActor interface {
+	AppActor
+	GetApplicationCrashDiagnostics(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error)
+	GetApplicationStartupProgress(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error)
+	RestageApplication(ctx context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+}
+
 type RestageCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
 	usage               interface{}  `usage:"CF_NAME restage APP_NAME"`
 	relatedCommands     interface{}  `related_commands:"restart"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RestageActor
+	NOAAClient  *consumer.Consumer
 }
 
-func (_ RestageCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RestageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if command.UsesLegacyImplementation(cmd.Config, "restage") {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewInterruptibleClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
 	return nil
 }
 
-func (_ RestageCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RestageCommand) Execute(args []string) error {
+	if command.UsesLegacyImplementation(cmd.Config, "restage") {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Restaging app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	messages, logErrs, appStarting, apiWarnings, errs := cmd.Actor.RestageApplication(command.InterruptContext(), app, cmd.NOAAClient, cmd.Config)
+	cmd.UI.DisplayNewline()
+	err = shared.PollStartWithProgress(cmd.UI, cmd.Config, cmd.Actor, app.GUID, messages, logErrs, appStarting, apiWarnings, errs)
+	if err != nil {
+		if _, ok := err.(shared.UnsuccessfulStartError); ok {
+			shared.DisplayCrashDiagnostics(cmd.UI, cmd.Actor, app.GUID, cmd.NOAAClient)
+		}
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
 	return nil
 }
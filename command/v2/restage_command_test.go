@@ -0,0 +1,225 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Restage Command", func() {
+	var (
+		cmd             RestageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRestageActor
+		binaryName      string
+		executeErr      error
+		restageErrs     []error
+		restageWarnings []string
+		appStarting     bool
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRestageActor)
+
+		cmd = RestageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+
+		restageErrs = nil
+		restageWarnings = nil
+		appStarting = false
+
+		fakeActor.RestageApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appStart := make(chan bool)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				if appStarting {
+					appStart <- true
+				}
+				for _, warning := range restageWarnings {
+					warnings <- warning
+				}
+				for _, err := range restageErrs {
+					errs <- err
+				}
+				close(messages)
+				close(logErrs)
+				close(appStart)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, appStart, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.HasTargetedOrganizationReturns(true)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.HasTargetedSpaceReturns(true)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space"})
+			fakeConfig.CurrentUserReturns(
+				configv3.User{Name: "some-user"},
+				nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("getting current user error")
+				fakeConfig.CurrentUserReturns(
+					configv3.User{},
+					expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		It("displays flavor text", func() {
+			Expect(testUI.Out).To(Say("Restaging app some-app in org some-org / space some-space as some-user..."))
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v2action.Application{},
+					v2action.Warnings{"warning-1", "warning-2"},
+					v2action.ApplicationNotFoundError{Name: "some-app"},
+				)
+			})
+
+			It("returns an ApplicationNotFoundError and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v2action.Application{GUID: "some-app-guid", State: ccv2.ApplicationStarted},
+					v2action.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("restages the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+				_, app, _, config := fakeActor.RestageApplicationArgsForCall(0)
+				Expect(app.GUID).To(Equal("some-app-guid"))
+				Expect(config).To(Equal(fakeConfig))
+			})
+
+			Context("when the restage succeeds", func() {
+				BeforeEach(func() {
+					appStarting = true
+					restageWarnings = []string{"restage-warning"}
+					fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+						v2action.ApplicationSummary{
+							Application: v2action.Application{Name: "some-app"},
+						},
+						v2action.Warnings{"summary-warning"},
+						nil,
+					)
+				})
+
+				It("displays the restage progress and the app summary", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Waiting for app to start..."))
+					Expect(testUI.Out).To(Say("name:\\s+some-app"))
+					Expect(testUI.Err).To(Say("restage-warning"))
+					Expect(testUI.Err).To(Say("summary-warning"))
+				})
+			})
+
+			Context("when an instance crashes", func() {
+				BeforeEach(func() {
+					restageErrs = []error{v2action.ApplicationInstanceCrashedError{Name: "some-app"}}
+				})
+
+				It("returns an UnsuccessfulStartError after displaying the crash diagnostics", func() {
+					Expect(executeErr).To(MatchError(shared.UnsuccessfulStartError{AppName: "some-app", BinaryName: binaryName}))
+					Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+
+					Expect(fakeActor.GetApplicationCrashDiagnosticsCallCount()).To(Equal(1))
+					appGUID, _ := fakeActor.GetApplicationCrashDiagnosticsArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+				})
+			})
+
+			Context("when staging times out", func() {
+				BeforeEach(func() {
+					restageErrs = []error{v2action.StagingTimeoutError{Name: "some-app", Timeout: 0}}
+				})
+
+				It("returns a StagingTimeoutError", func() {
+					Expect(executeErr).To(MatchError(shared.StagingTimeoutError{AppName: "some-app", Timeout: 0}))
+				})
+			})
+		})
+	})
+})
@@ -1,26 +1,215 @@
 package v2
 
 import (
+	"context"
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
+//go:generate counterfeiter . RestartActor
+
+type RestartActor interface {
+	AppActor
+	GetApplicationCrashDiagnostics(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error)
+	GetApplicationStartupProgress(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error)
+	RestartApplication(ctx context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+}
+
+//go:generate counterfeiter . RestartActorV3
+
+type RestartActorV3 interface {
+	CreateDeploymentByApplicationNameAndSpace(appName string, spaceGUID string, strategy string, maxInFlight int) (v3action.Deployment, v3action.Warnings, error)
+	PollDeployment(deployment v3action.Deployment) (v3action.Deployment, v3action.Warnings, error)
+}
+
 type RestartCommand struct {
-	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME restart APP_NAME"`
-	relatedCommands     interface{}  `related_commands:"restage, restart-app-instance"`
-	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	RequiredArgs        flag.AppName            `positional-args:"yes"`
+	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy. 'rolling' replaces the instances from the app's current droplet a few at a time instead of stopping the app; 'canary' starts one new instance and pauses until 'continue-deployment' is run"`
+	MaxInFlight         int                     `long:"max-in-flight" description:"Maximum number of instances replaced at the same time with '--strategy'"`
+	usage               interface{}             `usage:"CF_NAME restart APP_NAME [--strategy (rolling | canary) [--max-in-flight NUM]]"`
+	relatedCommands     interface{}             `related_commands:"continue-deployment, restage, restart-app-instance"`
+	envCFStagingTimeout interface{}             `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RestartActor
+	ActorV3     RestartActorV3
+	NOAAClient  *consumer.Consumer
 }
 
-func (_ RestartCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RestartCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if command.UsesLegacyImplementation(cmd.Config, "restart", cmd.Strategy.Name != "") {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewInterruptibleClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
 	return nil
 }
 
-func (_ RestartCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RestartCommand) Execute(args []string) error {
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	if command.UsesLegacyImplementation(cmd.Config, "restart", cmd.Strategy.Name != "") {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Strategy.Name != "" {
+		return cmd.restartWithDeployment(user.Name)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if app.Started() {
+		cmd.UI.DisplayText("Stopping app...")
+	}
+
+	messages, logErrs, appStarting, apiWarnings, errs := cmd.Actor.RestartApplication(command.InterruptContext(), app, cmd.NOAAClient, cmd.Config)
+	cmd.UI.DisplayNewline()
+	err = shared.PollStartWithProgress(cmd.UI, cmd.Config, cmd.Actor, app.GUID, messages, logErrs, appStarting, apiWarnings, errs)
+	if err != nil {
+		if _, ok := err.(shared.UnsuccessfulStartError); ok {
+			shared.DisplayCrashDiagnostics(cmd.UI, cmd.Actor, app.GUID, cmd.NOAAClient)
+		}
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
+	return nil
+}
+
+func (cmd RestartCommand) validateFlags() error {
+	if cmd.MaxInFlight < 0 {
+		return command.ParseArgumentError{
+			ArgumentName: "--max-in-flight",
+			ExpectedType: "a positive integer",
+		}
+	}
+	if cmd.MaxInFlight != 0 && cmd.Strategy.Name == "" {
+		return command.RequiredArgumentError{ArgumentName: "--strategy"}
+	}
+	return nil
+}
+
+// restartWithDeployment deploys the current droplet of the app with a V3
+// deployment, so instances are replaced without the app being stopped. Canary
+// deployments stop after the first instance until they are continued.
+func (cmd RestartCommand) restartWithDeployment(username string) error {
+	if cmd.ActorV3 == nil {
+		return sharedV3.V3APIDoesNotExistError{Message: "Option '--strategy' requires the CF V3 API."}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} with strategy {{.Strategy}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"Strategy":    cmd.Strategy.Name,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": username,
+		})
+
+	deployment, warnings, err := cmd.ActorV3.CreateDeploymentByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.Strategy.Name, cmd.MaxInFlight)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	if cmd.Strategy.Name == "canary" {
+		cmd.UI.DisplayText("Waiting for the canary instance to start...")
+	} else {
+		cmd.UI.DisplayText("Waiting for the deployment to replace all instances...")
+	}
+
+	deployment, warnings, err = cmd.ActorV3.PollDeployment(deployment)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	if deployment.Paused() {
+		cmd.UI.DisplayText("Canary instance is running. Use '{{.BinaryName}} continue-deployment {{.AppName}}' to replace the remaining instances.",
+			map[string]interface{}{
+				"BinaryName": cmd.Config.BinaryName(),
+				"AppName":    cmd.RequiredArgs.AppName,
+			})
+		cmd.UI.DisplayNewline()
+	}
+
+	appSummary, v2Warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(v2Warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
 	return nil
 }
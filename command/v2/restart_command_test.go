@@ -0,0 +1,407 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Restart Command", func() {
+	var (
+		cmd             RestartCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRestartActor
+		binaryName      string
+		executeErr      error
+		restartErrs     []error
+		restartWarnings []string
+		appStarting     bool
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRestartActor)
+
+		cmd = RestartCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+
+		restartErrs = nil
+		restartWarnings = nil
+		appStarting = false
+
+		fakeActor.RestartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appStart := make(chan bool)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				if appStarting {
+					appStart <- true
+				}
+				for _, warning := range restartWarnings {
+					warnings <- warning
+				}
+				for _, err := range restartErrs {
+					errs <- err
+				}
+				close(messages)
+				close(logErrs)
+				close(appStart)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, appStart, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.HasTargetedOrganizationReturns(true)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.HasTargetedSpaceReturns(true)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space"})
+			fakeConfig.CurrentUserReturns(
+				configv3.User{Name: "some-user"},
+				nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("getting current user error")
+				fakeConfig.CurrentUserReturns(
+					configv3.User{},
+					expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		It("displays flavor text", func() {
+			Expect(testUI.Out).To(Say("Restarting app some-app in org some-org / space some-space as some-user..."))
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v2action.Application{},
+					v2action.Warnings{"warning-1", "warning-2"},
+					v2action.ApplicationNotFoundError{Name: "some-app"},
+				)
+			})
+
+			It("returns an ApplicationNotFoundError and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+				Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app exists", func() {
+			Context("when the app is started", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v2action.Application{GUID: "some-app-guid", State: ccv2.ApplicationStarted},
+						v2action.Warnings{"warning-1", "warning-2"},
+						nil,
+					)
+				})
+
+				It("stops the app before restarting it", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Stopping app..."))
+					Expect(fakeActor.RestartApplicationCallCount()).To(Equal(1))
+					_, app, _, config := fakeActor.RestartApplicationArgsForCall(0)
+					Expect(app.GUID).To(Equal("some-app-guid"))
+					Expect(config).To(Equal(fakeConfig))
+				})
+			})
+
+			Context("when the app is stopped", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v2action.Application{GUID: "some-app-guid", State: ccv2.ApplicationStopped},
+						nil,
+						nil,
+					)
+				})
+
+				It("does not display the stopping message", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Stopping app..."))
+					Expect(fakeActor.RestartApplicationCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the restart succeeds", func() {
+				BeforeEach(func() {
+					appStarting = true
+					restartWarnings = []string{"restart-warning"}
+					fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+						v2action.ApplicationSummary{
+							Application: v2action.Application{Name: "some-app"},
+						},
+						v2action.Warnings{"summary-warning"},
+						nil,
+					)
+				})
+
+				It("displays the restart progress and the app summary", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Waiting for app to start..."))
+					Expect(testUI.Out).To(Say("name:\\s+some-app"))
+					Expect(testUI.Err).To(Say("restart-warning"))
+					Expect(testUI.Err).To(Say("summary-warning"))
+				})
+			})
+
+			Context("when an instance crashes", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+					restartErrs = []error{v2action.ApplicationInstanceCrashedError{Name: "some-app"}}
+				})
+
+				It("returns an UnsuccessfulStartError after displaying the crash diagnostics", func() {
+					Expect(executeErr).To(MatchError(shared.UnsuccessfulStartError{AppName: "some-app", BinaryName: binaryName}))
+					Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+
+					Expect(fakeActor.GetApplicationCrashDiagnosticsCallCount()).To(Equal(1))
+					appGUID, _ := fakeActor.GetApplicationCrashDiagnosticsArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+				})
+			})
+
+			Context("when staging times out", func() {
+				BeforeEach(func() {
+					restartErrs = []error{v2action.StagingTimeoutError{Name: "some-app", Timeout: 0}}
+				})
+
+				It("returns a StagingTimeoutError", func() {
+					Expect(executeErr).To(MatchError(shared.StagingTimeoutError{AppName: "some-app", Timeout: 0}))
+				})
+			})
+		})
+
+		Context("when --strategy rolling is provided", func() {
+			var fakeActorV3 *v2fakes.FakeRestartActorV3
+
+			BeforeEach(func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: "rolling"}
+				cmd.MaxInFlight = 2
+			})
+
+			Context("when the V3 API is not available", func() {
+				It("returns a V3APIDoesNotExistError", func() {
+					Expect(executeErr).To(MatchError(sharedV3.V3APIDoesNotExistError{Message: "Option '--strategy' requires the CF V3 API."}))
+				})
+			})
+
+			Context("when the V3 API is available", func() {
+				BeforeEach(func() {
+					fakeActorV3 = new(v2fakes.FakeRestartActorV3)
+					cmd.ActorV3 = fakeActorV3
+				})
+
+				Context("when the deployment succeeds", func() {
+					BeforeEach(func() {
+						fakeActorV3.CreateDeploymentByApplicationNameAndSpaceReturns(
+							v3action.Deployment{GUID: "some-deployment-guid"},
+							v3action.Warnings{"create-deployment-warning"},
+							nil,
+						)
+						fakeActorV3.PollDeploymentReturns(
+							v3action.Deployment{GUID: "some-deployment-guid", StatusValue: ccv3.DeploymentStatusValueFinalized},
+							v3action.Warnings{"poll-deployment-warning"},
+							nil,
+						)
+						fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+							v2action.ApplicationSummary{
+								Application: v2action.Application{Name: "some-app"},
+							},
+							v2action.Warnings{"summary-warning"},
+							nil,
+						)
+					})
+
+					It("deploys the current droplet without stopping the app and displays the app summary", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Restarting app some-app with strategy rolling in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say("Waiting for the deployment to replace all instances..."))
+						Expect(testUI.Out).To(Say("name:\\s+some-app"))
+						Expect(testUI.Err).To(Say("create-deployment-warning"))
+						Expect(testUI.Err).To(Say("poll-deployment-warning"))
+						Expect(testUI.Err).To(Say("summary-warning"))
+
+						Expect(fakeActorV3.CreateDeploymentByApplicationNameAndSpaceCallCount()).To(Equal(1))
+						appName, spaceGUID, strategy, maxInFlight := fakeActorV3.CreateDeploymentByApplicationNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("some-app"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(strategy).To(Equal("rolling"))
+						Expect(maxInFlight).To(Equal(2))
+
+						Expect(fakeActorV3.PollDeploymentCallCount()).To(Equal(1))
+						Expect(fakeActorV3.PollDeploymentArgsForCall(0)).To(Equal(v3action.Deployment{GUID: "some-deployment-guid"}))
+
+						Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+						Expect(testUI.Out).ToNot(Say("continue-deployment"))
+					})
+				})
+
+				Context("when the canary strategy is used", func() {
+					BeforeEach(func() {
+						cmd.Strategy = flag.DeploymentStrategy{Name: "canary"}
+						fakeActorV3.PollDeploymentReturns(
+							v3action.Deployment{
+								GUID:         "some-deployment-guid",
+								StatusValue:  ccv3.DeploymentStatusValueActive,
+								StatusReason: ccv3.DeploymentStatusReasonPaused,
+							},
+							nil,
+							nil,
+						)
+						fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+							v2action.ApplicationSummary{
+								Application: v2action.Application{Name: "some-app"},
+							},
+							nil,
+							nil,
+						)
+					})
+
+					It("waits for the canary instance and explains how to continue the deployment", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, _, strategy, _ := fakeActorV3.CreateDeploymentByApplicationNameAndSpaceArgsForCall(0)
+						Expect(strategy).To(Equal("canary"))
+
+						Expect(testUI.Out).To(Say("Restarting app some-app with strategy canary"))
+						Expect(testUI.Out).To(Say("Waiting for the canary instance to start..."))
+						Expect(testUI.Out).To(Say("Canary instance is running. Use 'faceman continue-deployment some-app' to replace the remaining instances."))
+						Expect(testUI.Out).To(Say("name:\\s+some-app"))
+					})
+				})
+
+				Context("when the app has no droplet", func() {
+					BeforeEach(func() {
+						fakeActorV3.CreateDeploymentByApplicationNameAndSpaceReturns(
+							v3action.Deployment{},
+							v3action.Warnings{"create-deployment-warning"},
+							v3action.ApplicationNotStagedError{AppName: "some-app"},
+						)
+					})
+
+					It("returns an ApplicationNotStagedError", func() {
+						Expect(executeErr).To(MatchError(sharedV3.ApplicationNotStagedError{AppName: "some-app"}))
+						Expect(testUI.Err).To(Say("create-deployment-warning"))
+						Expect(fakeActorV3.PollDeploymentCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the deployment is canceled", func() {
+					BeforeEach(func() {
+						fakeActorV3.PollDeploymentReturns(
+							v3action.Deployment{},
+							v3action.Warnings{"poll-deployment-warning"},
+							v3action.DeploymentNotDeployedError{Reason: "canceled"},
+						)
+					})
+
+					It("returns a DeploymentNotDeployedError", func() {
+						Expect(executeErr).To(MatchError(sharedV3.DeploymentNotDeployedError{Reason: "canceled"}))
+						Expect(testUI.Err).To(Say("poll-deployment-warning"))
+						Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+	})
+
+	Context("when --max-in-flight is provided without --strategy", func() {
+		BeforeEach(func() {
+			cmd.MaxInFlight = 2
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "--strategy"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --max-in-flight is negative", func() {
+		BeforeEach(func() {
+			cmd.Strategy = flag.DeploymentStrategy{Name: "rolling"}
+			cmd.MaxInFlight = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--max-in-flight",
+				ExpectedType: "a positive integer",
+			}))
+		})
+	})
+})
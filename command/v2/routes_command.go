@@ -2,22 +2,131 @@ package v2
 
 import (
 	"os"
+	"strconv"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . RoutesActor
+
+type RoutesActor interface {
+	GetSpaceRouteSummaries(spaceGUID string) ([]v2action.RouteSummary, v2action.Warnings, error)
+	GetOrganizationRouteSummaries(orgGUID string) ([]v2action.RouteSummary, v2action.Warnings, error)
+}
+
 type RoutesCommand struct {
 	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
-	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
-	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
+	Orphaned        bool        `long:"orphaned" description:"Only list the routes that are not mapped to any app"`
+	usage           interface{} `usage:"CF_NAME routes [--orglevel] [--orphaned]"`
+	relatedCommands interface{} `related_commands:"check-route, delete-orphaned-routes, domains, map-route, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RoutesActor
 }
 
-func (_ RoutesCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if command.UsesLegacyImplementation(cmd.Config, "routes", cmd.Orphaned) {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ RoutesCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RoutesCommand) Execute(args []string) error {
+	if command.UsesLegacyImplementation(cmd.Config, "routes", cmd.Orphaned) {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	var (
+		summaries []v2action.RouteSummary
+		warnings  v2action.Warnings
+	)
+	if cmd.OrgLevel {
+		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.OrgName}} as {{.Username}} ...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+		summaries, warnings, err = cmd.Actor.GetOrganizationRouteSummaries(cmd.Config.TargetedOrganization().GUID)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} ...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		summaries, warnings, err = cmd.Actor.GetSpaceRouteSummaries(cmd.Config.TargetedSpace().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("host"),
+			cmd.UI.TranslateText("domain"),
+			cmd.UI.TranslateText("port"),
+			cmd.UI.TranslateText("path"),
+			cmd.UI.TranslateText("apps"),
+		},
+	}
+	for _, summary := range summaries {
+		if cmd.Orphaned && !summary.Orphaned() {
+			continue
+		}
+
+		var port string
+		if summary.Port != 0 {
+			port = strconv.Itoa(summary.Port)
+		}
+		table = append(table, []string{
+			summary.SpaceName,
+			summary.Host,
+			summary.Domain.Name,
+			port,
+			summary.Path,
+			strings.Join(summary.AppNames, ","),
+		})
+	}
+
+	if len(table) == 1 {
+		if cmd.Orphaned {
+			cmd.UI.DisplayText("No orphaned routes found")
+		} else {
+			cmd.UI.DisplayText("No routes found")
+		}
+		return nil
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
 	return nil
 }
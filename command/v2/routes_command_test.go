@@ -0,0 +1,169 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("routes Command", func() {
+	var (
+		cmd             RoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRoutesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRoutesActor)
+
+		cmd = RoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		domain := v2action.Domain{Name: "example.com"}
+		fakeActor.GetSpaceRouteSummariesReturns([]v2action.RouteSummary{
+			{
+				Route:     v2action.Route{Host: "api", Domain: domain, Path: "/v2"},
+				SpaceName: "some-space",
+				AppNames:  []string{"api", "api-venerable"},
+			},
+			{
+				Route:     v2action.Route{Domain: v2action.Domain{Name: "tcp.example.com"}, Port: 1024},
+				SpaceName: "some-space",
+			},
+		}, v2action.Warnings{"routes-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when listing the routes of the space", func() {
+		It("displays the routes with their apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting routes for org some-org / space some-space as some-user ..."))
+			Expect(testUI.Out).To(Say(`space\s+host\s+domain\s+port\s+path\s+apps`))
+			Expect(testUI.Out).To(Say(`some-space\s+api\s+example.com\s+/v2\s+api,api-venerable`))
+			Expect(testUI.Out).To(Say(`some-space\s+tcp.example.com\s+1024`))
+			Expect(testUI.Err).To(Say("routes-warning"))
+
+			Expect(fakeActor.GetSpaceRouteSummariesArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetOrganizationRouteSummariesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --orglevel is provided", func() {
+		BeforeEach(func() {
+			cmd.OrgLevel = true
+			fakeActor.GetOrganizationRouteSummariesReturns([]v2action.RouteSummary{
+				{
+					Route:     v2action.Route{Host: "www", Domain: v2action.Domain{Name: "example.com"}},
+					SpaceName: "other-space",
+					AppNames:  []string{"www"},
+				},
+			}, nil, nil)
+		})
+
+		It("displays the routes of the organization", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting routes for org some-org as some-user ..."))
+			Expect(testUI.Out).To(Say(`other-space\s+www\s+example.com\s+www`))
+
+			Expect(fakeActor.GetOrganizationRouteSummariesArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeActor.GetSpaceRouteSummariesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --orphaned is provided", func() {
+		BeforeEach(func() {
+			cmd.Orphaned = true
+			fakeConfig.ExperimentalReturns(false)
+		})
+
+		It("only displays the routes without apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`some-space\s+tcp.example.com\s+1024`))
+			Expect(testUI.Out).ToNot(Say("api"))
+		})
+
+		Context("when every route is mapped to an app", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRouteSummariesReturns([]v2action.RouteSummary{
+					{Route: v2action.Route{Host: "api"}, AppNames: []string{"api"}},
+				}, nil, nil)
+			})
+
+			It("displays that no orphaned routes were found", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No orphaned routes found"))
+				Expect(testUI.Out).ToNot(Say("space"))
+			})
+		})
+	})
+
+	Context("when there are no routes", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceRouteSummariesReturns(nil, nil, nil)
+		})
+
+		It("displays that no routes were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No routes found"))
+		})
+	})
+
+	Context("when getting the routes fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("routes-error")
+			fakeActor.GetSpaceRouteSummariesReturns(nil, v2action.Warnings{"routes-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("routes-warning"))
+		})
+	})
+})
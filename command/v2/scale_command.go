@@ -1,28 +1,221 @@
 package v2
 
 import (
+	"context"
 	"os"
+	"strconv"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"github.com/cloudfoundry/bytefmt"
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . ScaleActor
+
+type ScaleActor interface {
+	AppActor
+	GetApplicationCrashDiagnostics(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error)
+	GetApplicationStartupProgress(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error)
+	RestartApplication(ctx context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	ScaleApplication(appGUID string, scale v2action.ApplicationScale) (v2action.Application, v2action.Warnings, error)
+}
+
 type ScaleCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	ForceRestart    bool         `short:"f" description:"Force restart of app without prompt"`
-	NumInstances    int          `short:"i" description:"Number of instances"`
-	DiskLimit       string       `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	MemoryLimit     string       `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	usage           interface{}  `usage:"CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"`
-	relatedCommands interface{}  `related_commands:"push"`
+	RequiredArgs        flag.AppName   `positional-args:"yes"`
+	ForceRestart        bool           `short:"f" description:"Force restart of app without prompt"`
+	NumInstances        flag.Instances `short:"i" description:"Number of instances"`
+	DiskLimit           flag.Megabytes `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	MemoryLimit         flag.Megabytes `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	ProcessType         string         `long:"process" default:"web" description:"App process to scale"`
+	NoRestart           bool           `long:"no-restart" description:"Save memory and disk changes without restarting the app; they take effect on the next restart"`
+	usage               interface{}    `usage:"CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [--process PROCESS] [-f | --no-restart]\n\n   Changing memory or disk restarts the app. Prompts for confirmation unless '-f' or '--no-restart' is provided."`
+	relatedCommands     interface{}    `related_commands:"push, restart"`
+	envCFStagingTimeout interface{}    `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}    `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ScaleActor
+	NOAAClient  *consumer.Consumer
 }
 
-func (_ ScaleCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *ScaleCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if command.UsesLegacyImplementation(cmd.Config, "scale") {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewInterruptibleClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd ScaleCommand) Execute(args []string) error {
+	if command.UsesLegacyImplementation(cmd.Config, "scale") {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	if cmd.ForceRestart && cmd.NoRestart {
+		return command.ArgumentCombinationError{
+			Arg1: "-f",
+			Arg2: "--no-restart",
+		}
+	}
+
+	if cmd.ProcessType != "" && cmd.ProcessType != "web" {
+		return shared.ProcessTypeNotSupportedError{ProcessType: cmd.ProcessType}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.NumInstances.IsSet && cmd.MemoryLimit.Size == 0 && cmd.DiskLimit.Size == 0 {
+		return cmd.showCurrentScale(user.Name)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	restartRequired := app.Started() && (cmd.MemoryLimit.Size != 0 || cmd.DiskLimit.Size != 0)
+	if restartRequired && !cmd.ForceRestart && !cmd.NoRestart {
+		confirmed, promptErr := cmd.UI.DisplayBoolPrompt(false, "This will cause the app to restart. Are you sure you want to scale {{.AppName}}?", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+		if !confirmed {
+			cmd.UI.DisplayText("Scaling cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Scaling app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	scaledApp, warnings, err := cmd.Actor.ScaleApplication(app.GUID, v2action.ApplicationScale{
+		Instances: cmd.NumInstances.NullInt,
+		Memory:    int(cmd.MemoryLimit.Size),
+		DiskQuota: int(cmd.DiskLimit.Size),
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.displayScaleChanges(app, scaledApp)
+
+	if !restartRequired {
+		return nil
+	}
+
+	if cmd.NoRestart {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: Memory and disk changes take effect when the app is restarted. Use '{{.BinaryName}} restart {{.AppName}}' to apply them.", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+			"AppName":    cmd.RequiredArgs.AppName,
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Stopping app...")
+
+	messages, logErrs, appStarting, apiWarnings, errs := cmd.Actor.RestartApplication(command.InterruptContext(), app, cmd.NOAAClient, cmd.Config)
+	cmd.UI.DisplayNewline()
+	err = shared.PollStartWithProgress(cmd.UI, cmd.Config, cmd.Actor, app.GUID, messages, logErrs, appStarting, apiWarnings, errs)
+	if err != nil {
+		if _, ok := err.(shared.UnsuccessfulStartError); ok {
+			shared.DisplayCrashDiagnostics(cmd.UI, cmd.Actor, app.GUID, cmd.NOAAClient)
+		}
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
 	return nil
 }
 
-func (_ ScaleCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd ScaleCommand) showCurrentScale(userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Showing current scale of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": userName,
+		})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("memory:"), formatMegabytes(app.Memory)},
+		{cmd.UI.TranslateText("disk:"), formatMegabytes(app.DiskQuota)},
+		{cmd.UI.TranslateText("instances:"), strconv.Itoa(app.Instances)},
+	}, 3)
+
 	return nil
 }
+
+// displayScaleChanges shows the instances, memory and disk of the app before
+// and after scaling.
+func (cmd ScaleCommand) displayScaleChanges(before v2action.Application, after v2action.Application) {
+	cmd.UI.DisplayTableWithHeader("", [][]string{
+		{"", cmd.UI.TranslateText("before"), cmd.UI.TranslateText("after")},
+		{cmd.UI.TranslateText("instances:"), strconv.Itoa(before.Instances), strconv.Itoa(after.Instances)},
+		{cmd.UI.TranslateText("memory:"), formatMegabytes(before.Memory), formatMegabytes(after.Memory)},
+		{cmd.UI.TranslateText("disk:"), formatMegabytes(before.DiskQuota), formatMegabytes(after.DiskQuota)},
+	}, 3)
+}
+
+func formatMegabytes(megabytes int) string {
+	return bytefmt.ByteSize(uint64(megabytes) * bytefmt.MEGABYTE)
+}
@@ -0,0 +1,411 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Scale Command", func() {
+	var (
+		cmd             ScaleCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeScaleActor
+		binaryName      string
+		executeErr      error
+		restartErrs     []error
+		appStarting     bool
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeScaleActor)
+
+		cmd = ScaleCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProcessType: "web",
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.ExperimentalReturns(true)
+
+		restartErrs = nil
+		appStarting = false
+
+		fakeActor.RestartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appStart := make(chan bool)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				if appStarting {
+					appStart <- true
+				}
+				for _, err := range restartErrs {
+					errs <- err
+				}
+				close(messages)
+				close(logErrs)
+				close(appStart)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, appStart, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both -f and --no-restart are provided", func() {
+		BeforeEach(func() {
+			cmd.ForceRestart = true
+			cmd.NoRestart = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Arg1: "-f",
+				Arg2: "--no-restart",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a process other than web is provided", func() {
+		BeforeEach(func() {
+			cmd.ProcessType = "worker"
+		})
+
+		It("returns a ProcessTypeNotSupportedError", func() {
+			Expect(executeErr).To(MatchError(shared.ProcessTypeNotSupportedError{ProcessType: "worker"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space"})
+			fakeConfig.CurrentUserReturns(
+				configv3.User{Name: "some-user"},
+				nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("getting current user error")
+				fakeConfig.CurrentUserReturns(
+					configv3.User{},
+					expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when no scaling values are provided", func() {
+			Context("when the app exists", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v2action.Application{
+							GUID:      "some-app-guid",
+							Instances: 3,
+							Memory:    256,
+							DiskQuota: 1024,
+						},
+						v2action.Warnings{"get-app-warning"},
+						nil,
+					)
+				})
+
+				It("displays the current scale of the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Showing current scale of app some-app in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("memory:\\s+256M"))
+					Expect(testUI.Out).To(Say("disk:\\s+1G"))
+					Expect(testUI.Out).To(Say("instances:\\s+3"))
+					Expect(testUI.Err).To(Say("get-app-warning"))
+
+					Expect(fakeActor.ScaleApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the app does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v2action.Application{},
+						v2action.Warnings{"get-app-warning"},
+						v2action.ApplicationNotFoundError{Name: "some-app"},
+					)
+				})
+
+				It("returns an ApplicationNotFoundError and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+					Expect(testUI.Err).To(Say("get-app-warning"))
+				})
+			})
+		})
+
+		Context("when scaling values are provided", func() {
+			Context("when the app does not exist", func() {
+				BeforeEach(func() {
+					cmd.NumInstances.NullInt = types.NullInt{IsSet: true, Value: 3}
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v2action.Application{},
+						v2action.Warnings{"get-app-warning"},
+						v2action.ApplicationNotFoundError{Name: "some-app"},
+					)
+				})
+
+				It("returns an ApplicationNotFoundError and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+					Expect(testUI.Err).To(Say("get-app-warning"))
+					Expect(fakeActor.ScaleApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when only the instance count is provided", func() {
+				BeforeEach(func() {
+					cmd.NumInstances.NullInt = types.NullInt{IsSet: true, Value: 0}
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v2action.Application{GUID: "some-app-guid", State: ccv2.ApplicationStarted, Instances: 2, Memory: 256, DiskQuota: 1024},
+						nil,
+						nil,
+					)
+					fakeActor.ScaleApplicationReturns(
+						v2action.Application{GUID: "some-app-guid", State: ccv2.ApplicationStarted, Instances: 0, Memory: 256, DiskQuota: 1024},
+						v2action.Warnings{"scale-warning"},
+						nil,
+					)
+				})
+
+				It("scales the app without prompting or restarting", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Are you sure"))
+					Expect(testUI.Out).To(Say("Scaling app some-app in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("before\\s+after"))
+					Expect(testUI.Out).To(Say("instances:\\s+2\\s+0"))
+					Expect(testUI.Out).To(Say("memory:\\s+256M\\s+256M"))
+					Expect(testUI.Out).To(Say("disk:\\s+1G\\s+1G"))
+					Expect(testUI.Err).To(Say("scale-warning"))
+
+					Expect(fakeActor.ScaleApplicationCallCount()).To(Equal(1))
+					appGUID, scale := fakeActor.ScaleApplicationArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(scale).To(Equal(v2action.ApplicationScale{
+						Instances: types.NullInt{IsSet: true, Value: 0},
+					}))
+
+					Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when memory or disk is provided", func() {
+				BeforeEach(func() {
+					cmd.MemoryLimit.Size = 512
+					cmd.DiskLimit.Size = 2048
+					fakeActor.ScaleApplicationReturns(
+						v2action.Application{GUID: "some-app-guid", Instances: 1, Memory: 512, DiskQuota: 2048},
+						nil,
+						nil,
+					)
+				})
+
+				Context("when the app is stopped", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationByNameAndSpaceReturns(
+							v2action.Application{GUID: "some-app-guid", State: ccv2.ApplicationStopped, Instances: 1, Memory: 256, DiskQuota: 1024},
+							nil,
+							nil,
+						)
+					})
+
+					It("scales the app without prompting or restarting", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Are you sure"))
+						Expect(testUI.Out).To(Say("memory:\\s+256M\\s+512M"))
+						Expect(testUI.Out).To(Say("disk:\\s+1G\\s+2G"))
+
+						_, scale := fakeActor.ScaleApplicationArgsForCall(0)
+						Expect(scale).To(Equal(v2action.ApplicationScale{Memory: 512, DiskQuota: 2048}))
+						Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the app is started", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationByNameAndSpaceReturns(
+							v2action.Application{GUID: "some-app-guid", State: ccv2.ApplicationStarted, Instances: 1, Memory: 256, DiskQuota: 1024},
+							nil,
+							nil,
+						)
+					})
+
+					Context("when the user confirms the prompt", func() {
+						BeforeEach(func() {
+							input.Write([]byte("y\n"))
+							appStarting = true
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+								v2action.ApplicationSummary{
+									Application: v2action.Application{Name: "some-app"},
+								},
+								v2action.Warnings{"summary-warning"},
+								nil,
+							)
+						})
+
+						It("scales and restarts the app", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("This will cause the app to restart. Are you sure you want to scale some-app\\?"))
+							Expect(testUI.Out).To(Say("Scaling app some-app"))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Out).To(Say("memory:\\s+256M\\s+512M"))
+							Expect(testUI.Out).To(Say("Stopping app..."))
+							Expect(testUI.Out).To(Say("Waiting for app to start..."))
+							Expect(testUI.Out).To(Say("name:\\s+some-app"))
+							Expect(testUI.Err).To(Say("summary-warning"))
+
+							Expect(fakeActor.ScaleApplicationCallCount()).To(Equal(1))
+							Expect(fakeActor.RestartApplicationCallCount()).To(Equal(1))
+							_, app, _, config := fakeActor.RestartApplicationArgsForCall(0)
+							Expect(app.GUID).To(Equal("some-app-guid"))
+							Expect(config).To(Equal(fakeConfig))
+						})
+					})
+
+					Context("when the user declines the prompt", func() {
+						BeforeEach(func() {
+							input.Write([]byte("n\n"))
+						})
+
+						It("does not scale the app", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Scaling cancelled"))
+							Expect(fakeActor.ScaleApplicationCallCount()).To(Equal(0))
+							Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when -f is provided", func() {
+						BeforeEach(func() {
+							cmd.ForceRestart = true
+							appStarting = true
+						})
+
+						It("scales and restarts the app without prompting", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).ToNot(Say("Are you sure"))
+							Expect(fakeActor.ScaleApplicationCallCount()).To(Equal(1))
+							Expect(fakeActor.RestartApplicationCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when --no-restart is provided", func() {
+						BeforeEach(func() {
+							cmd.NoRestart = true
+						})
+
+						It("scales the app without prompting or restarting and displays a tip", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).ToNot(Say("Are you sure"))
+							Expect(testUI.Out).To(Say("memory:\\s+256M\\s+512M"))
+							Expect(testUI.Out).To(Say("TIP: Memory and disk changes take effect when the app is restarted. Use 'faceman restart some-app' to apply them."))
+
+							Expect(fakeActor.ScaleApplicationCallCount()).To(Equal(1))
+							Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the restart fails because an instance crashes", func() {
+						BeforeEach(func() {
+							cmd.ForceRestart = true
+							restartErrs = []error{v2action.ApplicationInstanceCrashedError{Name: "some-app"}}
+						})
+
+						It("returns an UnsuccessfulStartError after displaying the crash diagnostics", func() {
+							Expect(executeErr).To(MatchError(shared.UnsuccessfulStartError{AppName: "some-app", BinaryName: binaryName}))
+							Expect(fakeActor.GetApplicationCrashDiagnosticsCallCount()).To(Equal(1))
+							Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+						})
+					})
+				})
+			})
+
+			Context("when scaling returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					cmd.NumInstances.NullInt = types.NullInt{IsSet: true, Value: 3}
+					expectedErr = errors.New("scale error")
+					fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+					fakeActor.ScaleApplicationReturns(v2action.Application{}, v2action.Warnings{"scale-warning"}, expectedErr)
+				})
+
+				It("returns the error and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("scale-warning"))
+				})
+			})
+		})
+	})
+})
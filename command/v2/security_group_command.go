@@ -1,24 +1,127 @@
 package v2
 
 import (
-	"os"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . SecurityGroupActor
+
+type SecurityGroupActor interface {
+	GetSecurityGroupSummary(securityGroupName string) (v2action.SecurityGroupSummary, v2action.Warnings, error)
+}
+
 type SecurityGroupCommand struct {
 	RequiredArgs    flag.SecurityGroup `positional-args:"yes"`
 	usage           interface{}        `usage:"CF_NAME security-group SECURITY_GROUP"`
 	relatedCommands interface{}        `related_commands:"bind-security-group, bind-running-security-group, bind-staging-security-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SecurityGroupActor
 }
 
-func (_ SecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ SecurityGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SecurityGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting info for security group {{.SecurityGroupName}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroupName": cmd.RequiredArgs.ServiceGroup,
+		"Username":          user.Name,
+	})
+
+	summary, warnings, err := cmd.Actor.GetSecurityGroupSummary(cmd.RequiredArgs.ServiceGroup)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	var globalLifecycles []string
+	if summary.RunningDefault {
+		globalLifecycles = append(globalLifecycles, cmd.UI.TranslateText("running"))
+	}
+	if summary.StagingDefault {
+		globalLifecycles = append(globalLifecycles, cmd.UI.TranslateText("staging"))
+	}
+	appliedGlobally := cmd.UI.TranslateText("no")
+	if len(globalLifecycles) > 0 {
+		appliedGlobally = strings.Join(globalLifecycles, ", ")
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), summary.Name},
+		{cmd.UI.TranslateText("applied globally:"), appliedGlobally},
+		{cmd.UI.TranslateText("rules:"), ""},
+	}, 3)
+
+	if len(summary.Rules) == 0 {
+		cmd.UI.DisplayText("   No rules")
+	}
+	for _, rule := range summary.Rules {
+		cmd.UI.DisplayText("   {{.Rule}}", map[string]interface{}{
+			"Rule": formatSecurityGroupRule(v2action.SecurityGroupRuleDefinition{
+				Code:        rule.Code,
+				Description: rule.Description,
+				Destination: rule.Destination,
+				Log:         rule.Log,
+				Ports:       rule.Ports,
+				Protocol:    rule.Protocol,
+				Type:        rule.Type,
+			}),
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	if len(summary.Spaces) == 0 {
+		cmd.UI.DisplayText("No spaces assigned")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("organization"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("lifecycle"),
+		},
+	}
+	for _, space := range summary.Spaces {
+		table = append(table, []string{
+			space.OrganizationName,
+			space.SpaceName,
+			cmd.UI.TranslateText(space.Lifecycle),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
@@ -0,0 +1,158 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("security-group Command", func() {
+	var (
+		cmd             SecurityGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSecurityGroupActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSecurityGroupActor)
+
+		cmd = SecurityGroupCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			RequiredArgs: flag.SecurityGroup{ServiceGroup: "some-security-group"},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when the security group does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecurityGroupSummaryReturns(
+					v2action.SecurityGroupSummary{},
+					v2action.Warnings{"some-warning"},
+					v2action.SecurityGroupNotFoundError{Name: "some-security-group"})
+			})
+
+			It("displays the warnings and returns a SecurityGroupNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.SecurityGroupNotFoundError{Name: "some-security-group"}))
+				Expect(testUI.Out).To(Say("Getting info for security group some-security-group as some-user..."))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
+		Context("when getting the security group fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.GetSecurityGroupSummaryReturns(v2action.SecurityGroupSummary{}, nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when the security group is not bound to any spaces", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecurityGroupSummaryReturns(v2action.SecurityGroupSummary{
+					SecurityGroup: v2action.SecurityGroup{Name: "some-security-group"},
+				}, v2action.Warnings{"some-warning"}, nil)
+			})
+
+			It("displays the security group and that no spaces are assigned", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`name:\s+some-security-group`))
+				Expect(testUI.Out).To(Say(`applied globally:\s+no`))
+				Expect(testUI.Out).To(Say(`rules:`))
+				Expect(testUI.Out).To(Say(`No rules`))
+				Expect(testUI.Out).To(Say("No spaces assigned"))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.GetSecurityGroupSummaryArgsForCall(0)).To(Equal("some-security-group"))
+			})
+		})
+
+		Context("when the security group is bound to spaces", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecurityGroupSummaryReturns(v2action.SecurityGroupSummary{
+					SecurityGroup: v2action.SecurityGroup{
+						Name:           "some-security-group",
+						RunningDefault: true,
+						StagingDefault: true,
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443", Description: "https"},
+							{Protocol: "all", Destination: "0.0.0.0/0"},
+						},
+					},
+					Spaces: []v2action.SecurityGroupSpace{
+						{OrganizationName: "org-a", SpaceName: "space-1", Lifecycle: "running"},
+						{OrganizationName: "org-a", SpaceName: "space-1", Lifecycle: "staging"},
+						{OrganizationName: "org-b", SpaceName: "space-2", Lifecycle: "running"},
+					},
+				}, nil, nil)
+			})
+
+			It("displays the rules, whether it is applied globally and the bound spaces", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`name:\s+some-security-group`))
+				Expect(testUI.Out).To(Say(`applied globally:\s+running, staging`))
+				Expect(testUI.Out).To(Say(`rules:`))
+				Expect(testUI.Out).To(Say(`protocol: tcp, destination: 10.0.0.0/24, ports: 443, description: https`))
+				Expect(testUI.Out).To(Say(`protocol: all, destination: 0.0.0.0/0`))
+				Expect(testUI.Out).To(Say(`organization\s+space\s+lifecycle`))
+				Expect(testUI.Out).To(Say(`org-a\s+space-1\s+running`))
+				Expect(testUI.Out).To(Say(`org-a\s+space-1\s+staging`))
+				Expect(testUI.Out).To(Say(`org-b\s+space-2\s+running`))
+			})
+		})
+	})
+})
@@ -1,25 +1,284 @@
 package v2
 
 import (
-	"os"
+	"encoding/json"
+	"fmt"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . ServiceActor
+
+type ServiceActor interface {
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceInstanceParameters(serviceInstanceGUID string) (v2action.ServiceInstanceParameters, v2action.Warnings, error)
+	GetServiceInstanceSummaryByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstanceSummary, v2action.Warnings, error)
+}
+
 type ServiceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	GUID            bool                 `long:"guid" description:"Retrieve and display the given service's guid.  All other output for the service is suppressed."`
-	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE"`
+	Params          bool                 `long:"params" description:"Retrieve and display the given service's parameters.  All other output for the service is suppressed."`
+	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE [--guid | --params]"`
 	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceActor
+}
+
+func (cmd *ServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd ServiceCommand) Execute(args []string) error {
+	if cmd.GUID && cmd.Params {
+		return command.ArgumentCombinationError{
+			Arg1: "--guid",
+			Arg2: "--params",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	switch {
+	case cmd.GUID:
+		return cmd.displayServiceInstanceGUID()
+	case cmd.Params:
+		return cmd.displayServiceInstanceParameters()
+	default:
+		return cmd.displayServiceInstanceSummary()
+	}
+}
+
+func (cmd ServiceCommand) displayServiceInstanceGUID() error {
+	serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText(serviceInstance.GUID)
+	return nil
 }
 
-func (_ ServiceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd ServiceCommand) displayServiceInstanceParameters() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting parameters for service instance {{.ServiceName}} as {{.UserName}}...", map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.ServiceInstance,
+		"UserName":    user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if serviceInstance.UserProvided() {
+		cmd.UI.DisplayText("This service does not support fetching service instance parameters.")
+		return nil
+	}
+
+	parameters, warnings, err := cmd.Actor.GetServiceInstanceParameters(serviceInstance.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err, ok := err.(v2action.ServiceInstanceParametersFetchNotSupportedError); ok {
+		cmd.UI.DisplayText(err.Message)
+		return nil
+	}
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(parameters) == 0 {
+		cmd.UI.DisplayText("No parameters are set for service instance {{.ServiceName}}.", map[string]interface{}{
+			"ServiceName": cmd.RequiredArgs.ServiceInstance,
+		})
+		return nil
+	}
+
+	output, err := json.MarshalIndent(parameters, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayText(string(output))
 	return nil
 }
 
-func (_ ServiceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd ServiceCommand) displayServiceInstanceSummary() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Showing info of service {{.ServiceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...", map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"UserName":    user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	summary, warnings, err := cmd.Actor.GetServiceInstanceSummaryByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if summary.UserProvided() {
+		cmd.displayUserProvidedServiceInstanceSummary(summary)
+	} else {
+		cmd.displayManagedServiceInstanceSummary(summary)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.displayBoundApplications(summary)
 	return nil
 }
+
+func (cmd ServiceCommand) displayUserProvidedServiceInstanceSummary(summary v2action.ServiceInstanceSummary) {
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), summary.Name},
+		{cmd.UI.TranslateText("service:"), cmd.UI.TranslateText("user-provided")},
+		{cmd.UI.TranslateText("tags:"), strings.Join(summary.Tags, ", ")},
+	}, 3)
+}
+
+func (cmd ServiceCommand) displayManagedServiceInstanceSummary(summary v2action.ServiceInstanceSummary) {
+	table := [][]string{
+		{cmd.UI.TranslateText("name:"), summary.Name},
+	}
+	if summary.ServiceInstanceShareType == v2action.ServiceInstanceIsSharedFrom {
+		table = append(table,
+			[]string{cmd.UI.TranslateText("shared from org/space:"), fmt.Sprintf("%s / %s", summary.ServiceInstanceSharedFrom.OrganizationName, summary.ServiceInstanceSharedFrom.SpaceName)},
+		)
+	}
+	table = append(table, [][]string{
+		{cmd.UI.TranslateText("service:"), summary.Service.Label},
+		{cmd.UI.TranslateText("tags:"), strings.Join(summary.Tags, ", ")},
+		{cmd.UI.TranslateText("plan:"), summary.ServicePlan.Name},
+		{cmd.UI.TranslateText("description:"), summary.Service.Description},
+		{cmd.UI.TranslateText("dashboard:"), summary.DashboardURL},
+	}...)
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	cmd.displaySharedTos(summary)
+	cmd.displayUpgradeStatus(summary)
+	cmd.displayLastOperation(summary)
+}
+
+func (cmd ServiceCommand) displaySharedTos(summary v2action.ServiceInstanceSummary) {
+	switch summary.ServiceInstanceShareType {
+	case v2action.ServiceInstanceIsSharedFrom:
+		return
+	case v2action.ServiceInstanceIsSharedTo:
+		cmd.UI.DisplayText("shared with spaces:")
+		table := [][]string{{
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("bindings"),
+		}}
+		for _, sharedTo := range summary.ServiceInstanceSharedTos {
+			table = append(table, []string{
+				sharedTo.OrganizationName,
+				sharedTo.SpaceName,
+				fmt.Sprint(sharedTo.BoundAppCount),
+			})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, 3)
+	default:
+		cmd.UI.DisplayText("This service is not currently shared.")
+	}
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd ServiceCommand) displayUpgradeStatus(summary v2action.ServiceInstanceSummary) {
+	cmd.UI.DisplayText("Showing upgrade status:")
+	switch {
+	case summary.ServicePlan.MaintenanceInfo.Version == "":
+		cmd.UI.DisplayText("Upgrades are not supported by this broker.")
+	case summary.UpgradeAvailable():
+		cmd.UI.DisplayText("There is an upgrade available for this service.")
+		if description := summary.ServicePlan.MaintenanceInfo.Description; description != "" {
+			cmd.UI.DisplayText("Upgrade description: {{.Description}}", map[string]interface{}{
+				"Description": description,
+			})
+		}
+	default:
+		cmd.UI.DisplayText("There is no upgrade available for this service.")
+	}
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd ServiceCommand) displayLastOperation(summary v2action.ServiceInstanceSummary) {
+	cmd.UI.DisplayText("Showing status of last operation from service {{.ServiceName}}...", map[string]interface{}{
+		"ServiceName": summary.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	lastOperation := summary.LastOperation
+	table := [][]string{
+		{cmd.UI.TranslateText("status:"), cmd.lastOperationStatus(lastOperation.Type, lastOperation.State)},
+		{cmd.UI.TranslateText("message:"), lastOperation.Description},
+	}
+	if lastOperation.CreatedAt != "" {
+		table = append(table, []string{cmd.UI.TranslateText("started:"), lastOperation.CreatedAt})
+	}
+	table = append(table, []string{cmd.UI.TranslateText("updated:"), lastOperation.UpdatedAt})
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+}
+
+func (cmd ServiceCommand) lastOperationStatus(operationType string, state string) string {
+	switch state {
+	case "in progress":
+		return cmd.UI.TranslateText("{{.OperationType}} in progress", map[string]interface{}{"OperationType": operationType})
+	case "failed":
+		return cmd.UI.TranslateText("{{.OperationType}} failed", map[string]interface{}{"OperationType": operationType})
+	case "succeeded":
+		return cmd.UI.TranslateText("{{.OperationType}} succeeded", map[string]interface{}{"OperationType": operationType})
+	default:
+		return ""
+	}
+}
+
+func (cmd ServiceCommand) displayBoundApplications(summary v2action.ServiceInstanceSummary) {
+	if len(summary.BoundApplications) == 0 {
+		cmd.UI.DisplayText("There are no bound apps for this service.")
+		return
+	}
+
+	cmd.UI.DisplayText("bound apps:")
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("binding name"),
+	}}
+	for _, boundApp := range summary.BoundApplications {
+		table = append(table, []string{boundApp.AppName, boundApp.ServiceBindingName})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
@@ -0,0 +1,433 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service Command", func() {
+	var (
+		cmd             ServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServiceActor)
+
+		cmd = ServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --guid and --params are provided", func() {
+		BeforeEach(func() {
+			cmd.GUID = true
+			cmd.Params = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Arg1: "--guid",
+				Arg2: "--params",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the --guid flag is provided", func() {
+		BeforeEach(func() {
+			cmd.GUID = true
+		})
+
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		Context("when the user is logged in, and an org and space are targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedSpaceReturns(configv3.Space{
+					GUID: "some-space-guid",
+					Name: "some-space",
+				})
+			})
+
+			Context("when the service instance exists", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{GUID: "some-service-instance-guid"},
+						v2action.Warnings{"warning-1", "warning-2"},
+						nil)
+				})
+
+				It("displays only the service instance guid and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("^some-service-instance-guid\n$"))
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+
+					Expect(fakeActor.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(1))
+					name, spaceGUID := fakeActor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+					Expect(name).To(Equal("some-service-instance"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+			})
+
+			Context("when the service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{},
+						v2action.Warnings{"warning-1", "warning-2"},
+						v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+				})
+
+				It("returns a ServiceInstanceNotFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(command.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+				})
+			})
+
+			Context("when getting the service instance returns an unknown error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get service instance error")
+					fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+						v2action.ServiceInstance{},
+						nil,
+						expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+		})
+	})
+
+	Context("when the --params flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Params = true
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{GUID: "some-service-instance-guid", Type: ccv2.ManagedService},
+				v2action.Warnings{"instance-warning"},
+				nil)
+		})
+
+		Context("when the service instance has parameters", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceParametersReturns(
+					v2action.ServiceInstanceParameters{"some-key": "some-value"},
+					v2action.Warnings{"parameters-warning"},
+					nil)
+			})
+
+			It("displays the parameters as JSON and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting parameters for service instance some-service-instance as some-user..."))
+				Expect(testUI.Out).To(Say(`"some-key": "some-value"`))
+				Expect(testUI.Err).To(Say("instance-warning"))
+				Expect(testUI.Err).To(Say("parameters-warning"))
+
+				Expect(fakeActor.GetServiceInstanceParametersArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		Context("when the service instance has no parameters", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceParametersReturns(v2action.ServiceInstanceParameters{}, nil, nil)
+			})
+
+			It("displays that no parameters are set", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No parameters are set for service instance some-service-instance."))
+			})
+		})
+
+		Context("when the service broker does not support fetching parameters", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceParametersReturns(
+					nil,
+					v2action.Warnings{"parameters-warning"},
+					v2action.ServiceInstanceParametersFetchNotSupportedError{Message: "This service does not support fetching service instance parameters."})
+			})
+
+			It("displays the message from the Cloud Controller", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("This service does not support fetching service instance parameters."))
+				Expect(testUI.Err).To(Say("parameters-warning"))
+			})
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+					v2action.ServiceInstance{GUID: "some-service-instance-guid", Type: ccv2.UserProvidedService},
+					nil,
+					nil)
+			})
+
+			It("displays that parameters are not supported without fetching them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("This service does not support fetching service instance parameters."))
+				Expect(fakeActor.GetServiceInstanceParametersCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the parameters returns an unknown error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get parameters error")
+				fakeActor.GetServiceInstanceParametersReturns(nil, nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Context("when no flags are provided", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NoTargetedSpaceError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(command.NoTargetedSpaceError{BinaryName: "faceman"}))
+				Expect(fakeActor.GetServiceInstanceSummaryByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service instance is managed", func() {
+			var summary v2action.ServiceInstanceSummary
+
+			BeforeEach(func() {
+				summary = v2action.ServiceInstanceSummary{
+					ServiceInstance: v2action.ServiceInstance{
+						Name:         "some-service-instance",
+						Type:         ccv2.ManagedService,
+						DashboardURL: "http://some-dashboard",
+						Tags:         []string{"tag-1", "tag-2"},
+						LastOperation: ccv2.LastOperation{
+							Type:        "create",
+							State:       "succeeded",
+							Description: "some-description",
+							CreatedAt:   "some-created-at",
+							UpdatedAt:   "some-updated-at",
+						},
+						MaintenanceInfo: ccv2.MaintenanceInfo{Version: "1.0.0"},
+					},
+					ServicePlan: v2action.ServicePlan{
+						Name:            "some-plan",
+						MaintenanceInfo: ccv2.MaintenanceInfo{Version: "1.0.0"},
+					},
+					Service: v2action.Service{
+						Label:       "some-service",
+						Description: "some-service-description",
+					},
+					ServiceInstanceShareType: v2action.ServiceInstanceIsNotShared,
+					BoundApplications: []v2action.BoundApplication{
+						{AppName: "app-1", ServiceBindingName: "binding-1"},
+						{AppName: "app-2"},
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				Expect(fakeActor.GetServiceInstanceSummaryByNameAndSpaceCallCount()).To(Equal(1))
+				name, spaceGUID := fakeActor.GetServiceInstanceSummaryByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("some-service-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+
+			Context("when the service instance is not shared and has no upgrade", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(summary, v2action.Warnings{"summary-warning"}, nil)
+				})
+
+				It("displays the service instance summary and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Showing info of service some-service-instance in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say(`name:\s+some-service-instance`))
+					Expect(testUI.Out).To(Say(`service:\s+some-service`))
+					Expect(testUI.Out).To(Say(`tags:\s+tag-1, tag-2`))
+					Expect(testUI.Out).To(Say(`plan:\s+some-plan`))
+					Expect(testUI.Out).To(Say(`description:\s+some-service-description`))
+					Expect(testUI.Out).To(Say(`dashboard:\s+http://some-dashboard`))
+					Expect(testUI.Out).To(Say("This service is not currently shared."))
+					Expect(testUI.Out).To(Say("Showing upgrade status:"))
+					Expect(testUI.Out).To(Say("There is no upgrade available for this service."))
+					Expect(testUI.Out).To(Say(`Showing status of last operation from service some-service-instance\.\.\.`))
+					Expect(testUI.Out).To(Say(`status:\s+create succeeded`))
+					Expect(testUI.Out).To(Say(`message:\s+some-description`))
+					Expect(testUI.Out).To(Say(`started:\s+some-created-at`))
+					Expect(testUI.Out).To(Say(`updated:\s+some-updated-at`))
+					Expect(testUI.Out).To(Say("bound apps:"))
+					Expect(testUI.Out).To(Say(`name\s+binding name`))
+					Expect(testUI.Out).To(Say(`app-1\s+binding-1`))
+					Expect(testUI.Out).To(Say("app-2"))
+
+					Expect(testUI.Err).To(Say("summary-warning"))
+				})
+			})
+
+			Context("when the service instance was shared from another space", func() {
+				BeforeEach(func() {
+					summary.ServiceInstanceShareType = v2action.ServiceInstanceIsSharedFrom
+					summary.ServiceInstanceSharedFrom = v2action.ServiceInstanceSharedFrom{
+						OrganizationName: "other-org",
+						SpaceName:        "other-space",
+					}
+					fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(summary, nil, nil)
+				})
+
+				It("displays the org and space it was shared from", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`shared from org/space:\s+other-org / other-space`))
+					Expect(testUI.Out).ToNot(Say("This service is not currently shared."))
+				})
+			})
+
+			Context("when the service instance has been shared to other spaces", func() {
+				BeforeEach(func() {
+					summary.ServiceInstanceShareType = v2action.ServiceInstanceIsSharedTo
+					summary.ServiceInstanceSharedTos = []v2action.ServiceInstanceSharedTo{
+						{OrganizationName: "other-org", SpaceName: "other-space", BoundAppCount: 2},
+					}
+					fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(summary, nil, nil)
+				})
+
+				It("displays the spaces it has been shared to", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("shared with spaces:"))
+					Expect(testUI.Out).To(Say(`org\s+space\s+bindings`))
+					Expect(testUI.Out).To(Say(`other-org\s+other-space\s+2`))
+				})
+			})
+
+			Context("when an upgrade is available", func() {
+				BeforeEach(func() {
+					summary.ServicePlan.MaintenanceInfo = ccv2.MaintenanceInfo{Version: "2.0.0", Description: "OS image upgrade"}
+					fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(summary, nil, nil)
+				})
+
+				It("displays the upgrade description", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("There is an upgrade available for this service."))
+					Expect(testUI.Out).To(Say("Upgrade description: OS image upgrade"))
+				})
+			})
+
+			Context("when the broker does not support upgrades", func() {
+				BeforeEach(func() {
+					summary.ServicePlan.MaintenanceInfo = ccv2.MaintenanceInfo{}
+					fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(summary, nil, nil)
+				})
+
+				It("displays that upgrades are not supported", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Upgrades are not supported by this broker."))
+				})
+			})
+		})
+
+		Context("when the service instance is user provided and has no bound apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(
+					v2action.ServiceInstanceSummary{
+						ServiceInstance: v2action.ServiceInstance{
+							Name: "some-service-instance",
+							Type: ccv2.UserProvidedService,
+						},
+					},
+					nil,
+					nil)
+			})
+
+			It("displays the user provided service instance summary", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`name:\s+some-service-instance`))
+				Expect(testUI.Out).To(Say(`service:\s+user-provided`))
+				Expect(testUI.Out).ToNot(Say("Showing status of last operation"))
+				Expect(testUI.Out).To(Say("There are no bound apps for this service."))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceSummaryByNameAndSpaceReturns(
+					v2action.ServiceInstanceSummary{},
+					v2action.Warnings{"summary-warning"},
+					v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+			})
+
+			It("returns a ServiceInstanceNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(command.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(testUI.Err).To(Say("summary-warning"))
+			})
+		})
+	})
+})
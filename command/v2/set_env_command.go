@@ -2,27 +2,107 @@ package v2
 
 import (
 	"os"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
 // WorkAroundPrefix is the flag in hole emoji
 const WorkAroundPrefix = "\U000026f3"
 
+//go:generate counterfeiter . SetEnvActor
+
+type SetEnvActor interface {
+	SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair v3action.EnvironmentVariablePair) (v3action.Warnings, error)
+}
+
 type SetEnvCommand struct {
 	RequiredArgs    flag.SetEnvironmentArgs `positional-args:"yes"`
 	usage           interface{}             `usage:"CF_NAME set-env APP_NAME ENV_VAR_NAME ENV_VAR_VALUE"`
 	relatedCommands interface{}             `related_commands:"apps, env, restart, set-staging-environment-variable-group, set-running-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetEnvActor
 }
 
-func (_ SetEnvCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only creates the V3 client when the refactored implementation is
+// enabled. Without the V3 API, the legacy implementation runs instead.
+func (cmd *SetEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if command.UsesLegacyImplementation(config, "set-env") {
+		return nil
+	}
+
+	ccClient, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); ok {
+			return nil
+		}
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config)
+
 	return nil
 }
 
-func (_ SetEnvCommand) Execute(args []string) error {
-	//TODO: Be sure to sanitize the WorkAroundPrefix
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SetEnvCommand) Execute(args []string) error {
+	if cmd.Actor == nil {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	// Arguments starting with a dash are prefixed so that they are not parsed
+	// as flags; see main.go.
+	name := strings.TrimPrefix(cmd.RequiredArgs.EnvironmentVariableName, WorkAroundPrefix)
+	value := strings.TrimPrefix(string(cmd.RequiredArgs.EnvironmentVariableValue), WorkAroundPrefix)
+
+	cmd.UI.DisplayTextWithFlavor("Setting env variable '{{.EnvVarName}}' to '{{.EnvVarValue}}' for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"EnvVarName":  name,
+			"EnvVarValue": value,
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"Username":    user.Name,
+		})
+
+	warnings, err := cmd.Actor.SetEnvironmentVariableByApplicationNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		v3action.EnvironmentVariablePair{Key: name, Value: value},
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} restage {{.AppName}}' to ensure your env variable changes take effect",
+		map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+			"AppName":    cmd.RequiredArgs.AppName,
+		})
+
 	return nil
 }
@@ -0,0 +1,132 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-env Command", func() {
+	var (
+		cmd             SetEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSetEnvActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSetEnvActor)
+
+		cmd = SetEnvCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			RequiredArgs: flag.SetEnvironmentArgs{
+				AppName:                  "some-app",
+				EnvironmentVariableName:  "some-key",
+				EnvironmentVariableValue: "some-value",
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the variable is set", func() {
+		BeforeEach(func() {
+			fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceReturns(v3action.Warnings{"set-env-warning"}, nil)
+		})
+
+		It("sets only the provided variable and displays a restage tip", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, envPair := fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(envPair).To(Equal(v3action.EnvironmentVariablePair{Key: "some-key", Value: "some-value"}))
+
+			Expect(testUI.Out).To(Say("Setting env variable 'some-key' to 'some-value' for app some-app in org some-org / space some-space as some-user\\.\\.\\."))
+			Expect(testUI.Err).To(Say("set-env-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: Use 'faceman restage some-app' to ensure your env variable changes take effect"))
+		})
+	})
+
+	Context("when the value starts with a dash", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.EnvironmentVariableValue = flag.EnvironmentVariable(WorkAroundPrefix + "-some-value")
+		})
+
+		It("removes the prefix added to parse it", func() {
+			_, _, envPair := fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(0)
+			Expect(envPair.Value).To(Equal("-some-value"))
+			Expect(testUI.Out).To(Say("to '-some-value'"))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceReturns(v3action.Warnings{"get-app-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+		})
+	})
+
+	Context("when setting the variable fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("set-env-error")
+			fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
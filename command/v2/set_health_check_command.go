@@ -3,10 +3,12 @@ package v2
 import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
 //go:generate counterfeiter . SetHealthCheckActor
@@ -14,14 +16,25 @@ type SetHealthCheckActor interface {
 	SetApplicationHealthCheckTypeByNameAndSpace(name string, spaceGUID string, healthCheckType string, httpEndpoint string) (v2action.Application, v2action.Warnings, error)
 }
 
+//go:generate counterfeiter . SetHealthCheckActorV3
+type SetHealthCheckActorV3 interface {
+	SetApplicationProcessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error)
+	SetApplicationProcessReadinessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error)
+}
+
 type SetHealthCheckCommand struct {
-	RequiredArgs flag.SetHealthCheckArgs `positional-args:"yes"`
-	HTTPEndpoint string                  `long:"endpoint" default:"/" description:"Path on the app"`
-	usage        interface{}             `usage:"CF_NAME set-health-check APP_NAME (process | port | http [--endpoint PATH])\n\nTIP: 'none' has been deprecated but is accepted for 'process'.\n\nEXAMPLES:\n   cf set-health-check worker-app process\n   cf set-health-check my-web-app http --endpoint /foo"`
-	UI           command.UI
-	Config       command.Config
-	SharedActor  command.SharedActor
-	Actor        SetHealthCheckActor
+	RequiredArgs      flag.SetHealthCheckArgs `positional-args:"yes"`
+	HTTPEndpoint      string                  `long:"endpoint" default:"/" description:"Path on the app"`
+	InvocationTimeout int                     `long:"invocation-timeout" description:"Time in seconds a single health check may take before it is considered failed"`
+	ProcessType       string                  `long:"process" description:"App process to update (Default: web)"`
+	Readiness         bool                    `long:"readiness" description:"Set the readiness health check, which controls whether instances receive traffic, instead of the liveness health check"`
+	Interval          int                     `long:"interval" description:"Number of seconds between readiness health checks"`
+	usage             interface{}             `usage:"CF_NAME set-health-check APP_NAME (process | port | http [--endpoint PATH]) [--invocation-timeout SECONDS] [--process PROCESS_TYPE]\n   CF_NAME set-health-check APP_NAME (process | port | http [--endpoint PATH]) --readiness [--interval SECONDS] [--process PROCESS_TYPE]\n\nTIP: 'none' has been deprecated but is accepted for 'process'.\n\nEXAMPLES:\n   cf set-health-check worker-app process --process worker\n   cf set-health-check my-web-app http --endpoint /foo --invocation-timeout 5\n   cf set-health-check my-web-app http --endpoint /ready --readiness --interval 5"`
+	UI                command.UI
+	Config            command.Config
+	SharedActor       command.SharedActor
+	Actor             SetHealthCheckActor
+	ActorV3           SetHealthCheckActorV3
 }
 
 func (cmd *SetHealthCheckCommand) Setup(config command.Config, ui command.UI) error {
@@ -35,11 +48,25 @@ func (cmd *SetHealthCheckCommand) Setup(config command.Config, ui command.UI) er
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
 
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
 	return nil
 }
 
 func (cmd *SetHealthCheckCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
 	}
@@ -49,6 +76,14 @@ func (cmd *SetHealthCheckCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.ActorV3 != nil {
+		return cmd.setProcessHealthCheck(user.Name)
+	}
+
+	if cmd.ProcessType != "" || cmd.Readiness || cmd.InvocationTimeout != 0 {
+		return sharedV3.V3APIDoesNotExistError{Message: "Options '--process', '--readiness' and '--invocation-timeout' require the CF V3 API."}
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Updating health check type for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
@@ -69,11 +104,92 @@ func (cmd *SetHealthCheckCommand) Execute(args []string) error {
 	}
 
 	cmd.UI.DisplayOK()
+	cmd.displayRestartTip(app.Started())
+
+	return nil
+}
 
-	if app.Started() {
+func (cmd SetHealthCheckCommand) validateFlags() error {
+	if cmd.InvocationTimeout < 0 {
+		return command.ParseArgumentError{
+			ArgumentName: "--invocation-timeout",
+			ExpectedType: "a positive integer",
+		}
+	}
+	if cmd.Interval < 0 {
+		return command.ParseArgumentError{
+			ArgumentName: "--interval",
+			ExpectedType: "a positive integer",
+		}
+	}
+	if cmd.Interval != 0 && !cmd.Readiness {
+		return command.RequiredArgumentError{ArgumentName: "--readiness"}
+	}
+	if cmd.InvocationTimeout != 0 && cmd.Readiness {
+		return command.ArgumentCombinationError{
+			Arg1: "--invocation-timeout",
+			Arg2: "--readiness",
+		}
+	}
+	return nil
+}
+
+func (cmd SetHealthCheckCommand) displayRestartTip(started bool) {
+	if started {
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("TIP: An app restart is required for the change to take affect.")
 	}
+}
+
+func (cmd *SetHealthCheckCommand) setProcessHealthCheck(username string) error {
+	processType := cmd.ProcessType
+	if processType == "" {
+		processType = "web"
+	}
+
+	healthCheck := v3action.ProcessHealthCheck{Type: cmd.RequiredArgs.HealthCheck.Type}
+	if healthCheck.Type == "none" {
+		healthCheck.Type = "process"
+	}
+	// The default endpoint is only sent for http health checks so that the
+	// actor can reject an endpoint given with any other type.
+	if healthCheck.Type == "http" || cmd.HTTPEndpoint != "/" {
+		healthCheck.Endpoint = cmd.HTTPEndpoint
+	}
+
+	checkName := "health check"
+	setHealthCheck := cmd.ActorV3.SetApplicationProcessHealthCheckByNameAndSpace
+	if cmd.Readiness {
+		checkName = "readiness health check"
+		healthCheck.Interval = cmd.Interval
+		setHealthCheck = cmd.ActorV3.SetApplicationProcessReadinessHealthCheckByNameAndSpace
+	} else {
+		healthCheck.InvocationTimeout = cmd.InvocationTimeout
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating {{.CheckName}} type for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"CheckName":   cmd.UI.TranslateText(checkName),
+			"AppName":     cmd.RequiredArgs.AppName,
+			"ProcessType": processType,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"Username":    username,
+		})
+
+	app, warnings, err := setHealthCheck(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		processType,
+		healthCheck,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.displayRestartTip(app.Started())
 
 	return nil
 }
@@ -5,11 +5,13 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -24,6 +26,7 @@ var _ = Describe("set-health-check Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v2fakes.FakeSetHealthCheckActor
+		fakeActorV3     *v2fakes.FakeSetHealthCheckActorV3
 		binaryName      string
 		executeErr      error
 	)
@@ -33,6 +36,7 @@ var _ = Describe("set-health-check Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v2fakes.FakeSetHealthCheckActor)
+		fakeActorV3 = new(v2fakes.FakeSetHealthCheckActorV3)
 
 		cmd = SetHealthCheckCommand{
 			UI:          testUI,
@@ -133,4 +137,163 @@ var _ = Describe("set-health-check Command", func() {
 		})
 	})
 
+	Context("when --interval is provided without --readiness", func() {
+		BeforeEach(func() {
+			cmd.Interval = 5
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "--readiness"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --invocation-timeout is provided with --readiness", func() {
+		BeforeEach(func() {
+			cmd.InvocationTimeout = 5
+			cmd.Readiness = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--invocation-timeout", Arg2: "--readiness"}))
+		})
+	})
+
+	Context("when --invocation-timeout is negative", func() {
+		BeforeEach(func() {
+			cmd.InvocationTimeout = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{ArgumentName: "--invocation-timeout", ExpectedType: "a positive integer"}))
+		})
+	})
+
+	Context("when the V3 API is not available and a V3 only flag is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = "some-app"
+			cmd.RequiredArgs.HealthCheck.Type = "port"
+			cmd.InvocationTimeout = 5
+		})
+
+		It("returns a V3APIDoesNotExistError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(sharedV3.V3APIDoesNotExistError{}))
+			Expect(fakeActor.SetApplicationHealthCheckTypeByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the V3 API is available", func() {
+		BeforeEach(func() {
+			cmd.ActorV3 = fakeActorV3
+			cmd.RequiredArgs.AppName = "some-app"
+			cmd.HTTPEndpoint = "/"
+		})
+
+		Context("when setting the health check succeeds", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.HealthCheck.Type = "none"
+				cmd.ProcessType = "worker"
+
+				fakeActorV3.SetApplicationProcessHealthCheckByNameAndSpaceReturns(
+					v3action.Application{}, v3action.Warnings{"warning-1"}, nil)
+			})
+
+			It("sets the liveness health check of the process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Updating health check type for app some-app process worker in org some-org / space some-space as some-user..."))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).ToNot(Say("TIP"))
+
+				Expect(fakeActor.SetApplicationHealthCheckTypeByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActorV3.SetApplicationProcessHealthCheckByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, processType, healthCheck := fakeActorV3.SetApplicationProcessHealthCheckByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(processType).To(Equal("worker"))
+				Expect(healthCheck).To(Equal(v3action.ProcessHealthCheck{Type: "process"}))
+			})
+		})
+
+		Context("when an http health check with an invocation timeout is set on a started app", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.HealthCheck.Type = "http"
+				cmd.HTTPEndpoint = "/health"
+				cmd.InvocationTimeout = 3
+
+				fakeActorV3.SetApplicationProcessHealthCheckByNameAndSpaceReturns(
+					v3action.Application{State: "STARTED"}, nil, nil)
+			})
+
+			It("sets the web process health check and displays a tip to restart the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Updating health check type for app some-app process web in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("TIP: An app restart is required for the change to take affect."))
+
+				_, _, processType, healthCheck := fakeActorV3.SetApplicationProcessHealthCheckByNameAndSpaceArgsForCall(0)
+				Expect(processType).To(Equal("web"))
+				Expect(healthCheck).To(Equal(v3action.ProcessHealthCheck{Type: "http", Endpoint: "/health", InvocationTimeout: 3}))
+			})
+		})
+
+		Context("when an endpoint is provided with a health check type other than http", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.HealthCheck.Type = "port"
+				cmd.HTTPEndpoint = "/health"
+
+				fakeActorV3.SetApplicationProcessHealthCheckByNameAndSpaceReturns(
+					v3action.Application{}, nil, v3action.HTTPHealthCheckInvalidError{})
+			})
+
+			It("passes the endpoint to the actor and returns an HTTPHealthCheckInvalidError", func() {
+				Expect(executeErr).To(MatchError(sharedV3.HTTPHealthCheckInvalidError{}))
+
+				_, _, _, healthCheck := fakeActorV3.SetApplicationProcessHealthCheckByNameAndSpaceArgsForCall(0)
+				Expect(healthCheck).To(Equal(v3action.ProcessHealthCheck{Type: "port", Endpoint: "/health"}))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.HealthCheck.Type = "port"
+				cmd.ProcessType = "worker"
+
+				fakeActorV3.SetApplicationProcessHealthCheckByNameAndSpaceReturns(
+					v3action.Application{}, v3action.Warnings{"warning-1"}, v3action.ProcessNotFoundError{ProcessType: "worker"})
+			})
+
+			It("displays warnings and returns a ProcessNotFoundError", func() {
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(executeErr).To(MatchError(sharedV3.ProcessNotFoundError{ProcessType: "worker"}))
+			})
+		})
+
+		Context("when --readiness is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.HealthCheck.Type = "http"
+				cmd.HTTPEndpoint = "/ready"
+				cmd.Readiness = true
+				cmd.Interval = 5
+
+				fakeActorV3.SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturns(
+					v3action.Application{}, v3action.Warnings{"warning-1"}, nil)
+			})
+
+			It("sets the readiness health check of the web process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Updating readiness health check type for app some-app process web in org some-org / space some-space as some-user..."))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActorV3.SetApplicationProcessHealthCheckByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActorV3.SetApplicationProcessReadinessHealthCheckByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, processType, healthCheck := fakeActorV3.SetApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(processType).To(Equal("web"))
+				Expect(healthCheck).To(Equal(v3action.ProcessHealthCheck{Type: "http", Endpoint: "/ready", Interval: 5}))
+			})
+		})
+	})
 })
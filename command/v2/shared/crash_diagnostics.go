@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+//go:generate counterfeiter . CrashDiagnosticsActor
+
+type CrashDiagnosticsActor interface {
+	GetApplicationCrashDiagnostics(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error)
+}
+
+// DisplayCrashDiagnostics displays the failing instance's exit description,
+// the app's recent crash events and its last log lines. Failing to retrieve
+// any of them only results in a warning, so that the original start error is
+// still returned to the user.
+func DisplayCrashDiagnostics(ui command.UI, actor CrashDiagnosticsActor, appGUID string, client v2action.NOAAClient) {
+	diagnostics, warnings, err := actor.GetApplicationCrashDiagnostics(appGUID, client)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		ui.DisplayWarning("Unable to retrieve crash diagnostics: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	if lastCrash, ok := diagnostics.LastCrash(); ok {
+		ui.DisplayNewline()
+		ui.DisplayText("Instance {{.Index}} exited: {{.ExitDescription}}", map[string]interface{}{
+			"Index":           lastCrash.InstanceIndex,
+			"ExitDescription": lastCrash.ExitDescription,
+		})
+
+		ui.DisplayNewline()
+		ui.DisplayText("Recent crash events:")
+		table := [][]string{
+			{
+				ui.TranslateText("time"),
+				ui.TranslateText("instance"),
+				ui.TranslateText("reason"),
+				ui.TranslateText("exit description"),
+			},
+		}
+		for _, event := range diagnostics.CrashEvents {
+			table = append(table, []string{
+				ui.UserFriendlyDate(event.Timestamp),
+				strconv.Itoa(event.InstanceIndex),
+				event.Reason,
+				event.ExitDescription,
+			})
+		}
+		ui.DisplayTableWithHeader("", table, 3)
+	}
+
+	if len(diagnostics.RecentLogs) > 0 {
+		ui.DisplayNewline()
+		ui.DisplayText("Last {{.Count}} log lines:", map[string]interface{}{
+			"Count": len(diagnostics.RecentLogs),
+		})
+		for _, message := range diagnostics.RecentLogs {
+			ui.DisplayLogMessage(message, true)
+		}
+	}
+}
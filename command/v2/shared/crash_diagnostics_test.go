@@ -0,0 +1,100 @@
+package shared_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplayCrashDiagnostics", func() {
+	var (
+		testUI    *ui.UI
+		fakeActor *sharedfakes.FakeCrashDiagnosticsActor
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(sharedfakes.FakeCrashDiagnosticsActor)
+	})
+
+	JustBeforeEach(func() {
+		DisplayCrashDiagnostics(testUI, fakeActor, "some-app-guid", nil)
+	})
+
+	Context("when diagnostics are available", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashDiagnosticsReturns(
+				v2action.CrashDiagnostics{
+					CrashEvents: []v2action.CrashEvent{
+						{
+							Timestamp:       time.Unix(1496311500, 0),
+							InstanceIndex:   1,
+							ExitDescription: "out of memory",
+							Reason:          "CRASHED",
+						},
+						{
+							Timestamp:       time.Unix(1496311200, 0),
+							InstanceIndex:   0,
+							ExitDescription: "APP/PROC/WEB: Exited with status 1",
+							Reason:          "CRASHED",
+						},
+					},
+					RecentLogs: []v2action.LogMessage{
+						*v2action.NewLogMessage("starting server", 1, time.Unix(1496311100, 0), "APP/PROC/WEB", "1"),
+						*v2action.NewLogMessage("panic: boom", 2, time.Unix(1496311110, 0), "APP/PROC/WEB", "1"),
+					},
+				},
+				v2action.Warnings{"diagnostics-warning"},
+				nil,
+			)
+		})
+
+		It("displays the exit description, crash events and recent logs", func() {
+			Expect(fakeActor.GetApplicationCrashDiagnosticsCallCount()).To(Equal(1))
+			appGUID, _ := fakeActor.GetApplicationCrashDiagnosticsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+
+			Expect(testUI.Err).To(Say("diagnostics-warning"))
+			Expect(testUI.Out).To(Say("Instance 1 exited: out of memory"))
+			Expect(testUI.Out).To(Say("Recent crash events:"))
+			Expect(testUI.Out).To(Say("time\\s+instance\\s+reason\\s+exit description"))
+			Expect(testUI.Out).To(Say("1\\s+CRASHED\\s+out of memory"))
+			Expect(testUI.Out).To(Say("0\\s+CRASHED\\s+APP/PROC/WEB: Exited with status 1"))
+			Expect(testUI.Out).To(Say("Last 2 log lines:"))
+			Expect(testUI.Out).To(Say("starting server"))
+			Expect(testUI.Out).To(Say("panic: boom"))
+		})
+	})
+
+	Context("when there are no crash events or logs", func() {
+		It("displays nothing", func() {
+			Expect(testUI.Out).ToNot(Say("Recent crash events:"))
+			Expect(testUI.Out).ToNot(Say("log lines:"))
+		})
+	})
+
+	Context("when retrieving the diagnostics fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashDiagnosticsReturns(
+				v2action.CrashDiagnostics{
+					CrashEvents: []v2action.CrashEvent{{InstanceIndex: 0, ExitDescription: "out of memory", Reason: "CRASHED"}},
+				},
+				nil,
+				errors.New("logs error"),
+			)
+		})
+
+		It("displays a warning along with the diagnostics that were retrieved", func() {
+			Expect(testUI.Err).To(Say("Unable to retrieve crash diagnostics: logs error"))
+			Expect(testUI.Out).To(Say("Instance 0 exited: out of memory"))
+		})
+	})
+})
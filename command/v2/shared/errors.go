@@ -2,7 +2,10 @@ package shared
 
 import (
 	"fmt"
+	"strings"
 	"time"
+
+	"code.cloudfoundry.org/cli/util/exitcode"
 )
 
 type JobFailedError struct {
@@ -36,6 +39,20 @@ func (e JobTimeoutError) Translate(translate func(string, ...interface{}) string
 	})
 }
 
+type JobNotFoundError struct {
+	JobGUID string
+}
+
+func (e JobNotFoundError) Error() string {
+	return "Job ({{.JobGUID}}) not found."
+}
+
+func (e JobNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"JobGUID": e.JobGUID,
+	})
+}
+
 type NoOrganizationTargetedError struct{}
 
 func (e NoOrganizationTargetedError) Error() string {
@@ -46,6 +63,16 @@ func (e NoOrganizationTargetedError) Translate(translate func(string, ...interfa
 	return translate(e.Error())
 }
 
+type NoAutoTargetRuleError struct{}
+
+func (e NoAutoTargetRuleError) Error() string {
+	return "No auto-target rule matches the current directory"
+}
+
+func (e NoAutoTargetRuleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
 type OrganizationNotFoundError struct {
 	Name string
 }
@@ -74,6 +101,54 @@ func (e SecurityGroupNotFoundError) Translate(translate func(string, ...interfac
 	})
 }
 
+type SecurityGroupBindingFailedError struct {
+	SecurityGroupName string
+	SpaceNames        []string
+}
+
+func (e SecurityGroupBindingFailedError) Error() string {
+	return "Security group {{.SecurityGroupName}} could not be assigned to spaces: {{.SpaceNames}}"
+}
+
+func (e SecurityGroupBindingFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SecurityGroupName": e.SecurityGroupName,
+		"SpaceNames":        strings.Join(e.SpaceNames, ", "),
+	})
+}
+
+type InvalidSecurityGroupRulesFileError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidSecurityGroupRulesFileError) Error() string {
+	return "Incorrect format in security group rules file {{.Path}}: {{.Message}}"
+}
+
+func (e InvalidSecurityGroupRulesFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
+
+type InvalidSecurityGroupRuleError struct {
+	RuleNumber int
+	Reason     string
+}
+
+func (e InvalidSecurityGroupRuleError) Error() string {
+	return "Security group rule {{.RuleNumber}} is invalid: {{.Reason}}"
+}
+
+func (e InvalidSecurityGroupRuleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"RuleNumber": e.RuleNumber,
+		"Reason":     e.Reason,
+	})
+}
+
 type SpaceNotFoundError struct {
 	Name string
 }
@@ -88,6 +163,204 @@ func (e SpaceNotFoundError) Translate(translate func(string, ...interface{}) str
 	})
 }
 
+type OrganizationQuotaNotFoundError struct {
+	Name string
+}
+
+func (e OrganizationQuotaNotFoundError) Error() string {
+	return "Quota '{{.Name}}' not found."
+}
+
+func (e OrganizationQuotaNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type SpaceQuotaNotFoundError struct {
+	Name string
+}
+
+func (e SpaceQuotaNotFoundError) Error() string {
+	return "Space quota '{{.Name}}' not found."
+}
+
+func (e SpaceQuotaNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type RoleSeedingError struct {
+	Username string
+	Role     string
+	Message  string
+}
+
+func (e RoleSeedingError) Error() string {
+	return "Assigning role {{.Role}} to user {{.Username}} failed, so nothing was created: {{.Message}}"
+}
+
+func (e RoleSeedingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Role":     e.Role,
+		"Username": e.Username,
+		"Message":  e.Message,
+	})
+}
+
+type ApplicationNameTakenError struct {
+	Name string
+}
+
+func (e ApplicationNameTakenError) Error() string {
+	return "An app named '{{.Name}}' already exists in this space. Choose a different name."
+}
+
+func (e ApplicationNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type OrganizationNameTakenError struct {
+	Name string
+}
+
+func (e OrganizationNameTakenError) Error() string {
+	return "An org named '{{.Name}}' already exists. Choose a different name."
+}
+
+func (e OrganizationNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type SpaceNameTakenError struct {
+	Name string
+}
+
+func (e SpaceNameTakenError) Error() string {
+	return "A space named '{{.Name}}' already exists in this org. Choose a different name."
+}
+
+func (e SpaceNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type ServiceInstanceNameTakenError struct {
+	Name string
+}
+
+func (e ServiceInstanceNameTakenError) Error() string {
+	return "A service instance named '{{.Name}}' already exists in this space. Choose a different name."
+}
+
+func (e ServiceInstanceNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type ApplicationNotStartedError struct {
+	Name string
+}
+
+func (e ApplicationNotStartedError) Error() string {
+	return "App '{{.Name}}' is not started."
+}
+
+func (e ApplicationNotStartedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type ApplicationInstanceNotFoundError struct {
+	Name          string
+	InstanceIndex int
+}
+
+func (e ApplicationInstanceNotFoundError) Error() string {
+	return "Instance {{.InstanceIndex}} of app '{{.Name}}' not found."
+}
+
+func (e ApplicationInstanceNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"InstanceIndex": e.InstanceIndex,
+		"Name":          e.Name,
+	})
+}
+
+type ApplicationFilesError struct {
+	Path    string
+	Message string
+}
+
+func (e ApplicationFilesError) Error() string {
+	return "Unable to read {{.Path}}: {{.Message}}"
+}
+
+func (e ApplicationFilesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
+
+type StackNotFoundError struct {
+	GUID string
+	Name string
+}
+
+func (e StackNotFoundError) Error() string {
+	if e.Name == "" {
+		return "Stack with GUID '{{.GUID}}' not found."
+	}
+	return "Stack '{{.Name}}' not found."
+}
+
+func (e StackNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"GUID": e.GUID,
+		"Name": e.Name,
+	})
+}
+
+type BuildpackNotFoundError struct {
+	AppName     string
+	Buildpack   string
+	Stack       string
+	Suggestions []string
+}
+
+func (e BuildpackNotFoundError) Error() string {
+	message := "Buildpack {{.Buildpack}} of app {{.AppName}} not found."
+	if e.Stack != "" {
+		message = "Buildpack {{.Buildpack}} of app {{.AppName}} not found for stack {{.Stack}}."
+	}
+	if len(e.Suggestions) > 0 {
+		message += "\nDid you mean: {{.Suggestions}}?"
+	}
+	return message
+}
+
+func (e BuildpackNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":     e.AppName,
+		"Buildpack":   e.Buildpack,
+		"Stack":       e.Stack,
+		"Suggestions": strings.Join(e.Suggestions, ", "),
+	})
+}
+
+func (BuildpackNotFoundError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
 type HTTPHealthCheckInvalidError struct {
 }
 
@@ -99,6 +372,17 @@ func (e HTTPHealthCheckInvalidError) Translate(translate func(string, ...interfa
 	return translate(e.Error())
 }
 
+type InvalidAccessTokenError struct {
+}
+
+func (e InvalidAccessTokenError) Error() string {
+	return "The access token could not be decoded. Please log back in to re-authenticate."
+}
+
+func (e InvalidAccessTokenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
 type InvalidRefreshTokenError struct {
 }
 
@@ -187,3 +471,526 @@ func (e StartupTimeoutError) Translate(translate func(string, ...interface{}) st
 		"BinaryName": e.BinaryName,
 	})
 }
+
+type ProcessTypeNotSupportedError struct {
+	ProcessType string
+}
+
+func (e ProcessTypeNotSupportedError) Error() string {
+	return "Process type '{{.ProcessType}}' not found. Only the 'web' process can be scaled on this version of the API."
+}
+
+func (e ProcessTypeNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ProcessType": e.ProcessType,
+	})
+}
+
+type ProcessHealthChecksNotSupportedError struct {
+	AppName string
+}
+
+func (e ProcessHealthChecksNotSupportedError) Error() string {
+	return "App {{.AppName}} sets readiness or process health checks in its manifest, which require CF API version 3.0.0+."
+}
+
+func (e ProcessHealthChecksNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+func (ProcessHealthChecksNotSupportedError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+type InvalidHealthCheckTypeError struct {
+	AppName         string
+	ProcessType     string
+	HealthCheckType string
+}
+
+func (e InvalidHealthCheckTypeError) Error() string {
+	return "App {{.AppName}} sets health check type '{{.HealthCheckType}}' for its {{.ProcessType}} process. Valid types are http, none, port and process."
+}
+
+func (e InvalidHealthCheckTypeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":         e.AppName,
+		"ProcessType":     e.ProcessType,
+		"HealthCheckType": e.HealthCheckType,
+	})
+}
+
+func (InvalidHealthCheckTypeError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+type PushLockNotSupportedError struct{}
+
+func (e PushLockNotSupportedError) Error() string {
+	return "Options '--lock' and '--force-lock' require the CF V3 API."
+}
+
+func (e PushLockNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+// SpaceQuotaExceededError is returned when pushing an app would exceed its
+// space quota. Requested, Used and Limit are already formatted for Resource.
+type SpaceQuotaExceededError struct {
+	AppName   string
+	QuotaName string
+	Resource  string
+	Requested string
+	Used      string
+	Limit     string
+}
+
+func (e SpaceQuotaExceededError) Error() string {
+	return "App {{.AppName}} requests {{.Requested}} of {{.Resource}}, but space quota {{.QuotaName}} already has {{.Used}} of {{.Limit}} in use."
+}
+
+func (e SpaceQuotaExceededError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":   e.AppName,
+		"QuotaName": e.QuotaName,
+		"Resource":  translate(e.Resource),
+		"Requested": e.Requested,
+		"Used":      e.Used,
+		"Limit":     e.Limit,
+	})
+}
+
+type PreStartTaskNotSupportedError struct{}
+
+func (e PreStartTaskNotSupportedError) Error() string {
+	return "Option '--run-task' requires the CF V3 API."
+}
+
+func (e PreStartTaskNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type InvalidWildcardRouteError struct {
+	Route string
+}
+
+func (e InvalidWildcardRouteError) Error() string {
+	return "Route {{.Route}} is invalid: a wildcard host must be exactly '*' and cannot be used with a port."
+}
+
+func (e InvalidWildcardRouteError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Route": e.Route,
+	})
+}
+
+func (InvalidWildcardRouteError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+type WildcardRouteForbiddenError struct {
+	Route string
+}
+
+func (e WildcardRouteForbiddenError) Error() string {
+	return "You are not authorized to create the wildcard route {{.Route}}.\nWildcard routes on shared domains can only be created by an admin."
+}
+
+func (e WildcardRouteForbiddenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Route": e.Route,
+	})
+}
+
+type ServiceInstancesNotFoundError struct {
+	AppName              string
+	ServiceInstanceNames []string
+}
+
+func (e ServiceInstancesNotFoundError) Error() string {
+	return "Service instances {{.ServiceInstances}} of app {{.AppName}} not found in the space.\nCreate them with 'cf create-service' or remove them from the manifest."
+}
+
+func (e ServiceInstancesNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":          e.AppName,
+		"ServiceInstances": strings.Join(e.ServiceInstanceNames, ", "),
+	})
+}
+
+func (ServiceInstancesNotFoundError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+// PruneServicesWithoutServicesError is returned when --prune-services is used
+// for an app whose manifest does not list its services, which would unbind
+// all of them.
+type PruneServicesWithoutServicesError struct {
+	AppName string
+}
+
+func (e PruneServicesWithoutServicesError) Error() string {
+	return "Incorrect Usage: '--prune-services' requires a manifest that lists the services of app {{.AppName}}."
+}
+
+func (e PruneServicesWithoutServicesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+func (PruneServicesWithoutServicesError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+// ResetWithoutManifestError is returned when --reset is used without a
+// manifest, which would reset the settings of the app to nothing.
+type ResetWithoutManifestError struct{}
+
+func (ResetWithoutManifestError) Error() string {
+	return "Incorrect Usage: '--reset' requires a manifest that declares the settings of the app."
+}
+
+func (e ResetWithoutManifestError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (ResetWithoutManifestError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+// AppNotFoundInManifestError is returned when the app name provided to push
+// is not one of the apps of a manifest that lists several apps.
+type AppNotFoundInManifestError struct {
+	AppName      string
+	ManifestPath string
+}
+
+func (e AppNotFoundInManifestError) Error() string {
+	return "Could not find app named '{{.AppName}}' in manifest {{.ManifestPath}}"
+}
+
+func (e AppNotFoundInManifestError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":      e.AppName,
+		"ManifestPath": e.ManifestPath,
+	})
+}
+
+func (AppNotFoundInManifestError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+type UAAGroupNotFoundError struct {
+	Name string
+}
+
+func (e UAAGroupNotFoundError) Error() string {
+	return "UAA group {{.Name}} not found."
+}
+
+func (e UAAGroupNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type UAAUserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (e UAAUserNotFoundError) Error() string {
+	if e.Origin != "" {
+		return "UAA user {{.Username}} with origin {{.Origin}} not found."
+	}
+	return "UAA user {{.Username}} not found."
+}
+
+func (e UAAUserNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Origin":   e.Origin,
+	})
+}
+
+type MultipleUAAUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (e MultipleUAAUsersFoundError) Error() string {
+	return "The username {{.Username}} matches users from the following origins: {{.Origins}}\nUse --origin to choose one."
+}
+
+func (e MultipleUAAUsersFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Origins":  strings.Join(e.Origins, ", "),
+	})
+}
+
+type PreStartTaskFailedError struct {
+	SequenceID int
+	Reason     string
+}
+
+func (e PreStartTaskFailedError) Error() string {
+	return "Task {{.SequenceID}} failed: {{.Reason}}\nThe app was not started with the new droplet."
+}
+
+func (e PreStartTaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SequenceID": e.SequenceID,
+		"Reason":     e.Reason,
+	})
+}
+
+// AppsPushFailedError is returned when some of the apps of the manifests
+// failed to push. Code is the exit code that the failures have in common.
+type AppsPushFailedError struct {
+	AppNames []string
+	Total    int
+	Code     int
+}
+
+func (e AppsPushFailedError) Error() string {
+	return "{{.Failed}} of {{.Total}} apps failed to push: {{.AppNames}}"
+}
+
+func (e AppsPushFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed":   len(e.AppNames),
+		"Total":    e.Total,
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
+
+func (e AppsPushFailedError) ExitCode() int {
+	if e.Code == 0 {
+		return exitcode.Failed
+	}
+	return e.Code
+}
+
+type ApplicationPushLockedError struct {
+	AppName    string
+	Owner      string
+	AcquiredAt time.Time
+}
+
+func (e ApplicationPushLockedError) Error() string {
+	return "App {{.AppName}} is being pushed by {{.Owner}} since {{.AcquiredAt}}. Wait for that push to finish, or use '--force-lock' to take over the lock."
+}
+
+func (e ApplicationPushLockedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"Owner":      e.Owner,
+		"AcquiredAt": e.AcquiredAt.Format(time.RFC1123),
+	})
+}
+
+type NoManifestsFoundError struct {
+	Directory string
+}
+
+func (e NoManifestsFoundError) Error() string {
+	return "No manifest files were found in '{{.Directory}}'. Manifests must end in .yml or .yaml."
+}
+
+func (e NoManifestsFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Directory": e.Directory,
+	})
+}
+
+func (NoManifestsFoundError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+type MissingApplicationNameError struct{}
+
+func (MissingApplicationNameError) Error() string {
+	return "Every application in the manifests must have a name."
+}
+
+func (e MissingApplicationNameError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (MissingApplicationNameError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+type DuplicateApplicationError struct {
+	Name string
+}
+
+func (e DuplicateApplicationError) Error() string {
+	return "App {{.AppName}} is defined in more than one manifest."
+}
+
+func (e DuplicateApplicationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.Name,
+	})
+}
+
+func (DuplicateApplicationError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+type UnknownDependencyError struct {
+	Name      string
+	DependsOn string
+}
+
+func (e UnknownDependencyError) Error() string {
+	return "App {{.AppName}} depends on {{.DependsOn}}, which is not defined in any manifest."
+}
+
+func (e UnknownDependencyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":   e.Name,
+		"DependsOn": e.DependsOn,
+	})
+}
+
+func (UnknownDependencyError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+type DependencyCycleError struct {
+	Names []string
+}
+
+func (e DependencyCycleError) Error() string {
+	return "The depends_on entries of apps {{.AppNames}} form a cycle."
+}
+
+func (e DependencyCycleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.Names, ", "),
+	})
+}
+
+func (DependencyCycleError) ExitCode() int {
+	return exitcode.ValidationFailed
+}
+
+type DiagnosticsFailedError struct {
+	FailedChecks int
+}
+
+func (e DiagnosticsFailedError) Error() string {
+	return "{{.FailedChecks}} diagnostic check(s) failed."
+}
+
+func (e DiagnosticsFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedChecks": e.FailedChecks,
+	})
+}
+
+type AutoscalerAPINotFoundError struct{}
+
+func (AutoscalerAPINotFoundError) Error() string {
+	return "Could not determine the App Autoscaler API from the targeted API. Set CF_AUTOSCALER_API to its URL."
+}
+
+func (e AutoscalerAPINotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type CredHubAPINotFoundError struct{}
+
+func (CredHubAPINotFoundError) Error() string {
+	return "Could not determine the CredHub API from the targeted API. Set CF_CREDHUB_API to its URL."
+}
+
+func (e CredHubAPINotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type ApplicationNotAutoscaledError struct {
+	AppName string
+}
+
+func (e ApplicationNotAutoscaledError) Error() string {
+	return "App {{.AppName}} is not bound to an App Autoscaler service instance."
+}
+
+func (e ApplicationNotAutoscaledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+type InvalidAutoscalingPolicyError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidAutoscalingPolicyError) Error() string {
+	return "Scaling policy {{.Path}} is invalid:\n{{.Message}}"
+}
+
+func (e InvalidAutoscalingPolicyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
+
+type UnknownSettingError struct {
+	Name     string
+	Settings []string
+}
+
+func (e UnknownSettingError) Error() string {
+	return "Unknown setting '{{.Name}}'. The settings are: {{.Settings}}"
+}
+
+func (e UnknownSettingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":     e.Name,
+		"Settings": strings.Join(e.Settings, ", "),
+	})
+}
+
+type InvalidSettingValueError struct {
+	Name     string
+	Value    string
+	Expected string
+}
+
+func (e InvalidSettingValueError) Error() string {
+	return "Invalid value '{{.Value}}' for setting '{{.Name}}': must be {{.Expected}}"
+}
+
+func (e InvalidSettingValueError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":     e.Name,
+		"Value":    e.Value,
+		"Expected": e.Expected,
+	})
+}
+
+// InterruptedError is returned when the command stops because it was
+// cancelled by an interrupt or a termination signal.
+type InterruptedError struct{}
+
+func (e InterruptedError) Error() string {
+	return "The command was interrupted."
+}
+
+func (e InterruptedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+func (InterruptedError) ExitCode() int {
+	return exitcode.Interrupted
+}
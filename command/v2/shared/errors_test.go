@@ -5,6 +5,7 @@ import (
 	"text/template"
 
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/exitcode"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
@@ -39,7 +40,9 @@ var _ = Describe("Translatable Errors", func() {
 		// Actor errors.
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
+		Entry("JobNotFoundError", JobNotFoundError{}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
+		Entry("InvalidAccessTokenError", InvalidAccessTokenError{}),
 		Entry("StagingFailedError", StagingFailedError{}),
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
@@ -47,9 +50,68 @@ var _ = Describe("Translatable Errors", func() {
 
 		// Command errors.
 		Entry("NoOrgTargetedError", NoOrganizationTargetedError{}),
+		Entry("NoAutoTargetRuleError", NoAutoTargetRuleError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
+		Entry("ProcessTypeNotSupportedError", ProcessTypeNotSupportedError{}),
+		Entry("NoManifestsFoundError", NoManifestsFoundError{}),
+		Entry("MissingApplicationNameError", MissingApplicationNameError{}),
+		Entry("DuplicateApplicationError", DuplicateApplicationError{}),
+		Entry("UnknownDependencyError", UnknownDependencyError{}),
+		Entry("DependencyCycleError", DependencyCycleError{}),
+		Entry("InvalidHealthCheckTypeError", InvalidHealthCheckTypeError{}),
+		Entry("PushLockNotSupportedError", PushLockNotSupportedError{}),
+		Entry("PreStartTaskNotSupportedError", PreStartTaskNotSupportedError{}),
+		Entry("SpaceQuotaExceededError", SpaceQuotaExceededError{}),
+		Entry("PreStartTaskFailedError", PreStartTaskFailedError{}),
+		Entry("InvalidWildcardRouteError", InvalidWildcardRouteError{}),
+		Entry("WildcardRouteForbiddenError", WildcardRouteForbiddenError{}),
+		Entry("PruneServicesWithoutServicesError", PruneServicesWithoutServicesError{}),
+		Entry("ResetWithoutManifestError", ResetWithoutManifestError{}),
+		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
+		Entry("BuildpackNotFoundError", BuildpackNotFoundError{Stack: "some-stack", Suggestions: []string{"some-buildpack"}}),
+		Entry("UAAGroupNotFoundError", UAAGroupNotFoundError{}),
+		Entry("UAAUserNotFoundError", UAAUserNotFoundError{}),
+		Entry("MultipleUAAUsersFoundError", MultipleUAAUsersFoundError{}),
+		Entry("ApplicationPushLockedError", ApplicationPushLockedError{}),
+		Entry("AutoscalerAPINotFoundError", AutoscalerAPINotFoundError{}),
+		Entry("CredHubAPINotFoundError", CredHubAPINotFoundError{}),
+		Entry("ApplicationNotAutoscaledError", ApplicationNotAutoscaledError{}),
+		Entry("InvalidAutoscalingPolicyError", InvalidAutoscalingPolicyError{}),
+		Entry("DiagnosticsFailedError", DiagnosticsFailedError{}),
+		Entry("UnknownSettingError", UnknownSettingError{}),
+		Entry("InvalidSettingValueError", InvalidSettingValueError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
+		Entry("SecurityGroupBindingFailedError", SecurityGroupBindingFailedError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("OrganizationQuotaNotFoundError", OrganizationQuotaNotFoundError{}),
+		Entry("SpaceQuotaNotFoundError", SpaceQuotaNotFoundError{}),
+		Entry("RoleSeedingError", RoleSeedingError{}),
+		Entry("ApplicationNameTakenError", ApplicationNameTakenError{}),
+		Entry("OrganizationNameTakenError", OrganizationNameTakenError{}),
+		Entry("SpaceNameTakenError", SpaceNameTakenError{}),
+		Entry("ServiceInstanceNameTakenError", ServiceInstanceNameTakenError{}),
+		Entry("ApplicationNotStartedError", ApplicationNotStartedError{}),
+		Entry("ApplicationInstanceNotFoundError", ApplicationInstanceNotFoundError{}),
+		Entry("ApplicationFilesError", ApplicationFilesError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
+		Entry("InterruptedError", InterruptedError{}),
 	)
+
+	Describe("InterruptedError", func() {
+		It("exits with Interrupted", func() {
+			Expect(exitcode.Of(InterruptedError{})).To(Equal(exitcode.Interrupted))
+		})
+	})
+
+	Describe("AppsPushFailedError", func() {
+		It("exits with the code of the failures", func() {
+			Expect(exitcode.Of(AppsPushFailedError{Code: exitcode.StagingFailed})).To(Equal(exitcode.StagingFailed))
+		})
+
+		Context("when the code is not set", func() {
+			It("exits with Failed", func() {
+				Expect(exitcode.Of(AppsPushFailedError{})).To(Equal(exitcode.Failed))
+			})
+		})
+	})
 })
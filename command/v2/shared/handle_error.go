@@ -1,14 +1,27 @@
 package shared
 
 import (
+	"context"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/httpclient/httperror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"github.com/cloudfoundry/bytefmt"
 )
 
 func HandleError(err error) error {
+	if err == context.Canceled {
+		return InterruptedError{}
+	}
+
 	switch e := err.(type) {
 	case ccerror.APINotFoundError:
 		return command.APINotFoundError{URL: e.URL}
@@ -20,12 +33,26 @@ func HandleError(err error) error {
 		return command.InvalidSSLCertError{API: e.URL}
 
 	case ccerror.JobFailedError:
-		return JobFailedError{JobGUID: e.JobGUID}
+		return JobFailedError{JobGUID: e.JobGUID, Message: e.Message}
 	case ccerror.JobTimeoutError:
 		return JobTimeoutError{JobGUID: e.JobGUID}
 
+	case httperror.RequestError:
+		return command.APIRequestError{Err: e.Err}
+	case httperror.SSLValidationHostnameError:
+		return command.SSLCertErrorError{Message: e.Message}
+	case httperror.UnverifiedServerError:
+		return command.InvalidSSLCertError{API: e.URL}
+
 	case uaa.InvalidAuthTokenError:
 		return InvalidRefreshTokenError{}
+	case uaa.InvalidRefreshTokenError:
+		return InvalidRefreshTokenError{}
+
+	case configv3.UnknownSettingError:
+		return UnknownSettingError{Name: e.Name, Settings: configv3.SettingNames()}
+	case configv3.InvalidSettingValueError:
+		return InvalidSettingValueError{Name: e.Name, Value: e.Value, Expected: e.Expected}
 
 	case sharedaction.NotLoggedInError:
 		return command.NotLoggedInError{BinaryName: e.BinaryName}
@@ -36,17 +63,112 @@ func HandleError(err error) error {
 
 	case v2action.ApplicationNotFoundError:
 		return command.ApplicationNotFoundError{Name: e.Name}
+	case v2action.ApplicationNameTakenError:
+		return ApplicationNameTakenError{Name: e.Name}
+	case v2action.ApplicationNotStartedError:
+		return ApplicationNotStartedError{Name: e.Name}
+	case v2action.ApplicationInstanceNotFoundError:
+		return ApplicationInstanceNotFoundError{Name: e.Name, InstanceIndex: e.InstanceIndex}
+	case v2action.JobNotFoundError:
+		return JobNotFoundError{JobGUID: e.GUID}
 	case v2action.OrganizationNotFoundError:
 		return OrganizationNotFoundError{Name: e.Name}
+	case v2action.OrganizationQuotaNotFoundError:
+		if e.Name != "" {
+			return OrganizationQuotaNotFoundError{Name: e.Name}
+		}
+	case v2action.OrganizationNameTakenError:
+		return OrganizationNameTakenError{Name: e.Name}
+	case v2action.RoleSeedingError:
+		return RoleSeedingError{Username: e.Username, Role: e.Role, Message: e.Err.Error()}
 	case v2action.SecurityGroupNotFoundError:
 		return SecurityGroupNotFoundError{Name: e.Name}
+	case v2action.InvalidSecurityGroupRulesFileError:
+		return InvalidSecurityGroupRulesFileError{Path: e.Path, Message: e.Err.Error()}
+	case v2action.InvalidSecurityGroupRuleError:
+		return InvalidSecurityGroupRuleError{RuleNumber: e.RuleNumber, Reason: e.Reason}
 	case v2action.ServiceInstanceNotFoundError:
 		return command.ServiceInstanceNotFoundError{Name: e.Name}
+	case v2action.ServiceInstanceNameTakenError:
+		return ServiceInstanceNameTakenError{Name: e.Name}
 	case v2action.SpaceNotFoundError:
 		return SpaceNotFoundError{Name: e.Name}
+	case v2action.SpaceNameTakenError:
+		return SpaceNameTakenError{Name: e.Name}
+	case v2action.SpaceQuotaNotFoundError:
+		if e.Name != "" {
+			return SpaceQuotaNotFoundError{Name: e.Name}
+		}
+	case v2action.StackNotFoundError:
+		return StackNotFoundError{GUID: e.GUID, Name: e.Name}
 	case v2action.HTTPHealthCheckInvalidError:
 		return HTTPHealthCheckInvalidError{}
+	case v2action.InvalidAccessTokenError:
+		return InvalidAccessTokenError{}
+	case v2action.UAAGroupNotFoundError:
+		return UAAGroupNotFoundError{Name: e.Name}
+	case v2action.UAAUserNotFoundError:
+		return UAAUserNotFoundError{Username: e.Username, Origin: e.Origin}
+	case v2action.MultipleUAAUsersFoundError:
+		return MultipleUAAUsersFoundError{Username: e.Username, Origins: e.Origins}
+
+	case pushaction.NoManifestsFoundError:
+		return NoManifestsFoundError{Directory: e.Directory}
+	case pushaction.MissingApplicationNameError:
+		return MissingApplicationNameError{}
+	case pushaction.DuplicateApplicationError:
+		return DuplicateApplicationError{Name: e.Name}
+	case pushaction.UnknownDependencyError:
+		return UnknownDependencyError{Name: e.Name, DependsOn: e.DependsOn}
+	case pushaction.DependencyCycleError:
+		return DependencyCycleError{Names: e.Names}
+	case pushaction.ProcessHealthChecksNotSupportedError:
+		return ProcessHealthChecksNotSupportedError{AppName: e.AppName}
+	case pushaction.InvalidHealthCheckTypeError:
+		return InvalidHealthCheckTypeError{AppName: e.AppName, ProcessType: e.ProcessType, HealthCheckType: e.HealthCheckType}
+	case pushaction.PushLockNotSupportedError:
+		return PushLockNotSupportedError{}
+	case pushaction.PreStartTaskNotSupportedError:
+		return PreStartTaskNotSupportedError{}
+	case pushaction.SpaceQuotaExceededError:
+		return newSpaceQuotaExceededError(e)
+	case pushaction.InvalidWildcardRouteError:
+		return InvalidWildcardRouteError{Route: e.Route}
+	case pushaction.WildcardRouteForbiddenError:
+		return WildcardRouteForbiddenError{Route: e.Route}
+	case pushaction.BuildpackNotFoundError:
+		return BuildpackNotFoundError{AppName: e.AppName, Buildpack: e.Buildpack, Stack: e.Stack, Suggestions: e.Suggestions}
+	case pushaction.ServiceInstancesNotFoundError:
+		return ServiceInstancesNotFoundError{AppName: e.AppName, ServiceInstanceNames: e.ServiceInstanceNames}
+
+	case v3action.ApplicationPushLockedError:
+		return ApplicationPushLockedError{AppName: e.AppName, Owner: e.Owner, AcquiredAt: e.AcquiredAt}
+	case v3action.StagingFailedError:
+		return StagingFailedError{Message: e.Reason}
+	case v3action.TaskFailedError:
+		return PreStartTaskFailedError{SequenceID: e.SequenceID, Reason: e.Reason}
+
+	case autoscaleraction.InvalidPolicyError:
+		return InvalidAutoscalingPolicyError{Path: e.Path, Message: e.Message}
 	}
 
 	return err
 }
+
+func newSpaceQuotaExceededError(e pushaction.SpaceQuotaExceededError) SpaceQuotaExceededError {
+	format := strconv.Itoa
+	if e.Resource == pushaction.SpaceQuotaMemory {
+		format = func(megabytes int) string {
+			return bytefmt.ByteSize(uint64(megabytes) * bytefmt.MEGABYTE)
+		}
+	}
+
+	return SpaceQuotaExceededError{
+		AppName:   e.AppName,
+		QuotaName: e.QuotaName,
+		Resource:  string(e.Resource),
+		Requested: format(e.Requested),
+		Used:      format(e.Used),
+		Limit:     format(e.Limit),
+	}
+}
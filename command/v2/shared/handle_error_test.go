@@ -1,14 +1,20 @@
 package shared_test
 
 import (
+	"context"
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/httpclient/httperror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -43,30 +49,86 @@ var _ = Describe("HandleError", func() {
 			v2action.ApplicationNotFoundError{Name: "some-app"},
 			command.ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("v2action.ApplicationNameTakenError -> ApplicationNameTakenError",
+			v2action.ApplicationNameTakenError{Name: "some-app"},
+			ApplicationNameTakenError{Name: "some-app"}),
+
+		Entry("v2action.ApplicationNotStartedError -> ApplicationNotStartedError",
+			v2action.ApplicationNotStartedError{Name: "some-app"},
+			ApplicationNotStartedError{Name: "some-app"}),
+
+		Entry("v2action.ApplicationInstanceNotFoundError -> ApplicationInstanceNotFoundError",
+			v2action.ApplicationInstanceNotFoundError{Name: "some-app", InstanceIndex: 3},
+			ApplicationInstanceNotFoundError{Name: "some-app", InstanceIndex: 3}),
+
+		Entry("v2action.OrganizationNameTakenError -> OrganizationNameTakenError",
+			v2action.OrganizationNameTakenError{Name: "some-org"},
+			OrganizationNameTakenError{Name: "some-org"}),
+
+		Entry("v2action.SpaceNameTakenError -> SpaceNameTakenError",
+			v2action.SpaceNameTakenError{Name: "some-space"},
+			SpaceNameTakenError{Name: "some-space"}),
+
+		Entry("v2action.ServiceInstanceNameTakenError -> ServiceInstanceNameTakenError",
+			v2action.ServiceInstanceNameTakenError{Name: "some-service-instance"},
+			ServiceInstanceNameTakenError{Name: "some-service-instance"}),
+
 		Entry("v2action.SecurityGroupNotFoundError -> SecurityGroupNotFoundError",
 			v2action.SecurityGroupNotFoundError{Name: "some-security-group"},
 			SecurityGroupNotFoundError{Name: "some-security-group"}),
 
+		Entry("v2action.InvalidSecurityGroupRulesFileError -> InvalidSecurityGroupRulesFileError",
+			v2action.InvalidSecurityGroupRulesFileError{Path: "some-path", Err: errors.New("some-json-error")},
+			InvalidSecurityGroupRulesFileError{Path: "some-path", Message: "some-json-error"}),
+
+		Entry("v2action.InvalidSecurityGroupRuleError -> InvalidSecurityGroupRuleError",
+			v2action.InvalidSecurityGroupRuleError{RuleNumber: 2, Reason: "some-reason"},
+			InvalidSecurityGroupRuleError{RuleNumber: 2, Reason: "some-reason"}),
+
 		Entry("v2action.ServiceInstanceNotFoundError -> ServiceInstanceNotFoundError",
 			v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"},
 			command.ServiceInstanceNotFoundError{Name: "some-service-instance"}),
 
 		Entry("ccerror.JobFailedError -> JobFailedError",
-			ccerror.JobFailedError{JobGUID: "some-job-guid"},
-			JobFailedError{JobGUID: "some-job-guid"}),
+			ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"},
+			JobFailedError{JobGUID: "some-job-guid", Message: "some-message"}),
 
 		Entry("ccerror.JobTimeoutError -> JobTimeoutError",
 			ccerror.JobTimeoutError{JobGUID: "some-job-guid"},
 			JobTimeoutError{JobGUID: "some-job-guid"}),
 
+		Entry("v2action.JobNotFoundError -> JobNotFoundError",
+			v2action.JobNotFoundError{GUID: "some-job-guid"},
+			JobNotFoundError{JobGUID: "some-job-guid"}),
+
 		Entry("v2action.OrganizationNotFoundError -> OrgNotFoundError",
 			v2action.OrganizationNotFoundError{Name: "some-org"},
 			OrganizationNotFoundError{Name: "some-org"}),
 
+		Entry("v2action.OrganizationQuotaNotFoundError -> OrganizationQuotaNotFoundError",
+			v2action.OrganizationQuotaNotFoundError{Name: "some-quota"},
+			OrganizationQuotaNotFoundError{Name: "some-quota"}),
+
+		Entry("v2action.OrganizationQuotaNotFoundError without a name -> v2action.OrganizationQuotaNotFoundError",
+			v2action.OrganizationQuotaNotFoundError{GUID: "some-quota-guid"},
+			v2action.OrganizationQuotaNotFoundError{GUID: "some-quota-guid"}),
+
+		Entry("v2action.RoleSeedingError -> RoleSeedingError",
+			v2action.RoleSeedingError{Username: "some-user", Role: "OrgManager", Err: errors.New("some-error")},
+			RoleSeedingError{Username: "some-user", Role: "OrgManager", Message: "some-error"}),
+
 		Entry("v2action.SpaceNotFoundError -> SpaceNotFoundError",
 			v2action.SpaceNotFoundError{Name: "some-space"},
 			SpaceNotFoundError{Name: "some-space"}),
 
+		Entry("v2action.SpaceQuotaNotFoundError -> SpaceQuotaNotFoundError",
+			v2action.SpaceQuotaNotFoundError{Name: "some-space-quota"},
+			SpaceQuotaNotFoundError{Name: "some-space-quota"}),
+
+		Entry("v2action.StackNotFoundError -> StackNotFoundError",
+			v2action.StackNotFoundError{Name: "some-stack"},
+			StackNotFoundError{Name: "some-stack"}),
+
 		Entry("sharedaction.NotLoggedInError -> NotLoggedInError",
 			sharedaction.NotLoggedInError{BinaryName: "faceman"},
 			command.NotLoggedInError{BinaryName: "faceman"}),
@@ -84,11 +146,160 @@ var _ = Describe("HandleError", func() {
 			HTTPHealthCheckInvalidError{},
 		),
 
+		Entry("v2action.InvalidAccessTokenError -> InvalidAccessTokenError",
+			v2action.InvalidAccessTokenError{},
+			InvalidAccessTokenError{},
+		),
+
+		Entry("v2action.UAAGroupNotFoundError -> UAAGroupNotFoundError",
+			v2action.UAAGroupNotFoundError{Name: "some-group"},
+			UAAGroupNotFoundError{Name: "some-group"},
+		),
+
+		Entry("v2action.UAAUserNotFoundError -> UAAUserNotFoundError",
+			v2action.UAAUserNotFoundError{Username: "some-user", Origin: "ldap"},
+			UAAUserNotFoundError{Username: "some-user", Origin: "ldap"},
+		),
+
+		Entry("v2action.MultipleUAAUsersFoundError -> MultipleUAAUsersFoundError",
+			v2action.MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}},
+			MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}},
+		),
+
+		Entry("pushaction.NoManifestsFoundError -> NoManifestsFoundError",
+			pushaction.NoManifestsFoundError{Directory: "some-dir"},
+			NoManifestsFoundError{Directory: "some-dir"},
+		),
+
+		Entry("pushaction.MissingApplicationNameError -> MissingApplicationNameError",
+			pushaction.MissingApplicationNameError{},
+			MissingApplicationNameError{},
+		),
+
+		Entry("pushaction.DuplicateApplicationError -> DuplicateApplicationError",
+			pushaction.DuplicateApplicationError{Name: "some-app"},
+			DuplicateApplicationError{Name: "some-app"},
+		),
+
+		Entry("pushaction.UnknownDependencyError -> UnknownDependencyError",
+			pushaction.UnknownDependencyError{Name: "some-app", DependsOn: "some-other-app"},
+			UnknownDependencyError{Name: "some-app", DependsOn: "some-other-app"},
+		),
+
+		Entry("pushaction.DependencyCycleError -> DependencyCycleError",
+			pushaction.DependencyCycleError{Names: []string{"some-app", "some-other-app"}},
+			DependencyCycleError{Names: []string{"some-app", "some-other-app"}},
+		),
+
+		Entry("pushaction.ProcessHealthChecksNotSupportedError -> ProcessHealthChecksNotSupportedError",
+			pushaction.ProcessHealthChecksNotSupportedError{AppName: "some-app"},
+			ProcessHealthChecksNotSupportedError{AppName: "some-app"},
+		),
+
+		Entry("pushaction.InvalidHealthCheckTypeError -> InvalidHealthCheckTypeError",
+			pushaction.InvalidHealthCheckTypeError{AppName: "some-app", ProcessType: "web", HealthCheckType: "tcp"},
+			InvalidHealthCheckTypeError{AppName: "some-app", ProcessType: "web", HealthCheckType: "tcp"},
+		),
+
+		Entry("pushaction.PushLockNotSupportedError -> PushLockNotSupportedError",
+			pushaction.PushLockNotSupportedError{},
+			PushLockNotSupportedError{},
+		),
+
+		Entry("v3action.ApplicationPushLockedError -> ApplicationPushLockedError",
+			v3action.ApplicationPushLockedError{AppName: "some-app", Owner: "some-owner"},
+			ApplicationPushLockedError{AppName: "some-app", Owner: "some-owner"},
+		),
+
+		Entry("pushaction.PreStartTaskNotSupportedError -> PreStartTaskNotSupportedError",
+			pushaction.PreStartTaskNotSupportedError{},
+			PreStartTaskNotSupportedError{},
+		),
+
+		Entry("pushaction.SpaceQuotaExceededError for memory -> SpaceQuotaExceededError",
+			pushaction.SpaceQuotaExceededError{AppName: "some-app", QuotaName: "some-quota", Resource: pushaction.SpaceQuotaMemory, Requested: 2048, Used: 1536, Limit: 2048},
+			SpaceQuotaExceededError{AppName: "some-app", QuotaName: "some-quota", Resource: "memory", Requested: "2G", Used: "1.5G", Limit: "2G"},
+		),
+
+		Entry("pushaction.SpaceQuotaExceededError for app instances -> SpaceQuotaExceededError",
+			pushaction.SpaceQuotaExceededError{AppName: "some-app", QuotaName: "some-quota", Resource: pushaction.SpaceQuotaInstances, Requested: 3, Used: 8, Limit: 10},
+			SpaceQuotaExceededError{AppName: "some-app", QuotaName: "some-quota", Resource: "app instances", Requested: "3", Used: "8", Limit: "10"},
+		),
+
+		Entry("pushaction.InvalidWildcardRouteError -> InvalidWildcardRouteError",
+			pushaction.InvalidWildcardRouteError{Route: "some-*.example.com"},
+			InvalidWildcardRouteError{Route: "some-*.example.com"},
+		),
+
+		Entry("pushaction.WildcardRouteForbiddenError -> WildcardRouteForbiddenError",
+			pushaction.WildcardRouteForbiddenError{Route: "*.example.com"},
+			WildcardRouteForbiddenError{Route: "*.example.com"},
+		),
+
+		Entry("pushaction.BuildpackNotFoundError -> BuildpackNotFoundError",
+			pushaction.BuildpackNotFoundError{AppName: "some-app", Buildpack: "rubby", Stack: "some-stack", Suggestions: []string{"ruby_buildpack"}},
+			BuildpackNotFoundError{AppName: "some-app", Buildpack: "rubby", Stack: "some-stack", Suggestions: []string{"ruby_buildpack"}},
+		),
+
+		Entry("pushaction.ServiceInstancesNotFoundError -> ServiceInstancesNotFoundError",
+			pushaction.ServiceInstancesNotFoundError{AppName: "some-app", ServiceInstanceNames: []string{"some-service", "other-service"}},
+			ServiceInstancesNotFoundError{AppName: "some-app", ServiceInstanceNames: []string{"some-service", "other-service"}},
+		),
+
+		Entry("v3action.StagingFailedError -> StagingFailedError",
+			v3action.StagingFailedError{BuildGUID: "some-build-guid", Reason: "some reason"},
+			StagingFailedError{Message: "some reason"},
+		),
+
+		Entry("v3action.TaskFailedError -> PreStartTaskFailedError",
+			v3action.TaskFailedError{SequenceID: 3, Reason: "Exited with status 1"},
+			PreStartTaskFailedError{SequenceID: 3, Reason: "Exited with status 1"},
+		),
+
+		Entry("httperror.RequestError -> APIRequestError",
+			httperror.RequestError{Err: err},
+			command.APIRequestError{Err: err},
+		),
+
+		Entry("httperror.SSLValidationHostnameError -> SSLCertErrorError",
+			httperror.SSLValidationHostnameError{Message: "some-message"},
+			command.SSLCertErrorError{Message: "some-message"},
+		),
+
+		Entry("httperror.UnverifiedServerError -> InvalidSSLCertError",
+			httperror.UnverifiedServerError{URL: "some-url"},
+			command.InvalidSSLCertError{API: "some-url"},
+		),
+
+		Entry("autoscaleraction.InvalidPolicyError -> InvalidAutoscalingPolicyError",
+			autoscaleraction.InvalidPolicyError{Path: "some-path", Message: "some-message"},
+			InvalidAutoscalingPolicyError{Path: "some-path", Message: "some-message"},
+		),
+
 		Entry("uaa.InvalidAuthTokenError -> InvalidRefreshTokenError",
 			uaa.InvalidAuthTokenError{},
 			InvalidRefreshTokenError{},
 		),
 
+		Entry("uaa.InvalidRefreshTokenError -> InvalidRefreshTokenError",
+			uaa.InvalidRefreshTokenError{},
+			InvalidRefreshTokenError{},
+		),
+
+		Entry("configv3.UnknownSettingError -> UnknownSettingError",
+			configv3.UnknownSettingError{Name: "some-setting"},
+			UnknownSettingError{Name: "some-setting", Settings: configv3.SettingNames()},
+		),
+
+		Entry("configv3.InvalidSettingValueError -> InvalidSettingValueError",
+			configv3.InvalidSettingValueError{Name: "color", Value: "maybe", Expected: "true or false"},
+			InvalidSettingValueError{Name: "color", Value: "maybe", Expected: "true or false"},
+		),
+
+		Entry("context.Canceled -> InterruptedError",
+			context.Canceled,
+			InterruptedError{}),
+
 		Entry("default case -> original error",
 			err,
 			err),
@@ -0,0 +1,18 @@
+package shared
+
+import "code.cloudfoundry.org/cli/command"
+
+// WarnIfCLIVersionBelowMinimum displays a warning when the targeted Cloud
+// Controller advertises a minimum CLI version newer than the running binary.
+func WarnIfCLIVersionBelowMinimum(config command.Config, ui command.UI) {
+	err := command.MinimumAPIVersionCheck(config.BinaryVersion(), config.MinCLIVersion())
+
+	if _, ok := err.(command.MinimumAPIVersionNotMetError); ok {
+		ui.DisplayWarning("Cloud Foundry API version {{.APIVersion}} requires CLI version {{.MinCLIVersion}}. You are currently on version {{.BinaryVersion}}. To upgrade your CLI, please visit: https://github.com/cloudfoundry/cli#downloads",
+			map[string]interface{}{
+				"APIVersion":    config.APIVersion(),
+				"MinCLIVersion": config.MinCLIVersion(),
+				"BinaryVersion": config.BinaryVersion(),
+			})
+	}
+}
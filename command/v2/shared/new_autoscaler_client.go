@@ -0,0 +1,37 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/api/autoscaler"
+	httpclientWrapper "code.cloudfoundry.org/cli/api/httpclient/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// NewAutoscalerClient creates a new App Autoscaler client that authenticates
+// with the passed in UAA client.
+func NewAutoscalerClient(config command.Config, ui command.UI, uaaClient *uaa.Client) (*autoscaler.Client, error) {
+	if config.AutoscalerEndpoint() == "" {
+		return nil, AutoscalerAPINotFoundError{}
+	}
+
+	verbose, location := config.Verbose()
+
+	autoscalerClient := autoscaler.NewClient(autoscaler.Config{
+		AppName:           config.BinaryName(),
+		AppVersion:        config.BinaryVersion(),
+		DialTimeout:       config.DialTimeout(),
+		SkipSSLValidation: config.SkipSSLValidation(),
+		URL:               config.AutoscalerEndpoint(),
+	})
+
+	if verbose {
+		autoscalerClient.WrapConnection(httpclientWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+	}
+	if location != nil {
+		autoscalerClient.WrapConnection(httpclientWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+	}
+
+	autoscalerClient.WrapConnection(httpclientWrapper.NewUAAAuthentication(uaaClient, config))
+
+	return autoscalerClient, nil
+}
@@ -1,16 +1,31 @@
 package shared
 
 import (
+	"context"
+	"time"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	"code.cloudfoundry.org/cli/api/uaa"
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tracing"
 )
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
 // passed in config.
 func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Client, *uaa.Client, error) {
+	return newClients(config, ui, targetCF, context.Background())
+}
+
+// NewInterruptibleClients is NewClients for the commands that stop cleanly
+// when interrupted: the requests and job polls of the Cloud Controller client
+// are cancelled with command.InterruptContext.
+func NewInterruptibleClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Client, *uaa.Client, error) {
+	return newClients(config, ui, targetCF, command.InterruptContext())
+}
+
+func newClients(config command.Config, ui command.UI, targetCF bool, ctx context.Context) (*ccv2.Client, *uaa.Client, error) {
 	ccWrappers := []ccv2.ConnectionWrapper{}
 
 	verbose, location := config.Verbose()
@@ -26,7 +41,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
+	ccWrappers = append(ccWrappers, ccWrapper.NewRateLimit(3, time.Minute))
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	if tracing.Enabled() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestTracer())
+	}
+	ccWrappers = append(ccWrappers, ccWrapper.NewResponseCache(config.ResponseCacheDir(), time.Hour))
 
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
@@ -34,6 +54,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		JobPollingTimeout:  config.OverallPollingTimeout(),
 		JobPollingInterval: config.PollingInterval(),
 		Wrappers:           ccWrappers,
+		Context:            ctx,
 	})
 
 	if !targetCF {
@@ -74,6 +95,9 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 
 	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config))
 	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	if tracing.Enabled() {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestTracer())
+	}
 
 	authWrapper.SetClient(uaaClient)
 
@@ -0,0 +1,37 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/api/credhub"
+	httpclientWrapper "code.cloudfoundry.org/cli/api/httpclient/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// NewCredHubClient creates a new CredHub client that authenticates
+// with the passed in UAA client.
+func NewCredHubClient(config command.Config, ui command.UI, uaaClient *uaa.Client) (*credhub.Client, error) {
+	if config.CredHubEndpoint() == "" {
+		return nil, CredHubAPINotFoundError{}
+	}
+
+	verbose, location := config.Verbose()
+
+	credhubClient := credhub.NewClient(credhub.Config{
+		AppName:           config.BinaryName(),
+		AppVersion:        config.BinaryVersion(),
+		DialTimeout:       config.DialTimeout(),
+		SkipSSLValidation: config.SkipSSLValidation(),
+		URL:               config.CredHubEndpoint(),
+	})
+
+	if verbose {
+		credhubClient.WrapConnection(httpclientWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+	}
+	if location != nil {
+		credhubClient.WrapConnection(httpclientWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+	}
+
+	credhubClient.WrapConnection(httpclientWrapper.NewUAAAuthentication(uaaClient, config))
+
+	return credhubClient, nil
+}
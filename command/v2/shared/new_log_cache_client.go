@@ -0,0 +1,38 @@
+package shared
+
+import (
+	httpclientWrapper "code.cloudfoundry.org/cli/api/httpclient/wrapper"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// NewLogCacheClient creates a new Log Cache client that authenticates with
+// the passed in UAA client. It returns nil when the Log Cache API cannot be
+// determined from the targeted API.
+func NewLogCacheClient(config command.Config, ui command.UI, uaaClient *uaa.Client) *logcache.Client {
+	if config.LogCacheEndpoint() == "" {
+		return nil
+	}
+
+	verbose, location := config.Verbose()
+
+	logCacheClient := logcache.NewClient(logcache.Config{
+		AppName:           config.BinaryName(),
+		AppVersion:        config.BinaryVersion(),
+		DialTimeout:       config.DialTimeout(),
+		SkipSSLValidation: config.SkipSSLValidation(),
+		URL:               config.LogCacheEndpoint(),
+	})
+
+	if verbose {
+		logCacheClient.WrapConnection(httpclientWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+	}
+	if location != nil {
+		logCacheClient.WrapConnection(httpclientWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+	}
+
+	logCacheClient.WrapConnection(httpclientWrapper.NewUAAAuthentication(uaaClient, config))
+
+	return logCacheClient
+}
@@ -0,0 +1,81 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// PaginatedTableDisplayer displays a table whose rows arrive one page at a
+// time, so that output starts before all pages have been retrieved. The
+// header is displayed along with the first non-empty page.
+//
+// The column widths are computed from the header and the first page and are
+// kept for the following pages, so that the columns line up. A column only
+// widens if a later page contains a longer cell.
+type PaginatedTableDisplayer struct {
+	UI     command.UI
+	Header []string
+
+	displayedRows bool
+	columnWidths  []int
+}
+
+// DisplayPage displays the provided rows, preceded by the header if no rows
+// have been displayed yet.
+func (displayer *PaginatedTableDisplayer) DisplayPage(rows [][]string) {
+	if len(rows) == 0 {
+		return
+	}
+
+	if displayer.displayedRows {
+		displayer.updateColumnWidths(rows)
+		displayer.UI.DisplayNonWrappingTable("", displayer.padRows(rows), 3)
+		return
+	}
+
+	header := make([]string, 0, len(displayer.Header))
+	for _, column := range displayer.Header {
+		header = append(header, displayer.UI.TranslateText(column))
+	}
+	displayer.updateColumnWidths([][]string{header})
+	displayer.updateColumnWidths(rows)
+	displayer.UI.DisplayTableWithHeader("", append([][]string{header}, displayer.padRows(rows)...), 3)
+	displayer.displayedRows = true
+}
+
+// DisplayedRows returns true if any rows have been displayed.
+func (displayer PaginatedTableDisplayer) DisplayedRows() bool {
+	return displayer.displayedRows
+}
+
+func (displayer *PaginatedTableDisplayer) updateColumnWidths(rows [][]string) {
+	for _, row := range rows {
+		for col, cell := range row {
+			if col == len(displayer.columnWidths) {
+				displayer.columnWidths = append(displayer.columnWidths, 0)
+			}
+			if width := runewidth.StringWidth(cell); width > displayer.columnWidths[col] {
+				displayer.columnWidths[col] = width
+			}
+		}
+	}
+}
+
+// padRows pads every cell but the last of each row to the width of its
+// column, so that every page is displayed with the same column widths.
+func (displayer PaginatedTableDisplayer) padRows(rows [][]string) [][]string {
+	padded := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		paddedRow := make([]string, len(row))
+		for col, cell := range row {
+			if col+1 < len(row) {
+				cell += strings.Repeat(" ", displayer.columnWidths[col]-runewidth.StringWidth(cell))
+			}
+			paddedRow[col] = cell
+		}
+		padded = append(padded, paddedRow)
+	}
+	return padded
+}
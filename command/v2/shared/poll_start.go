@@ -1,12 +1,48 @@
 package shared
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 )
 
+//go:generate counterfeiter . StartProgressActor
+
+type StartProgressActor interface {
+	GetApplicationStartupProgress(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error)
+}
+
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
 func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.LogMessage, logErrs <-chan error, appStarting <-chan bool, apiWarnings <-chan string, apiErrs <-chan error) error {
-	var breakAppStart, breakWarnings, breakAPIErrs bool
+	return pollStart(ui, config, messages, logErrs, appStarting, apiWarnings, apiErrs, nil)
+}
+
+// PollStartWithProgress behaves like PollStart and, once the app's instances
+// begin starting, displays how many of them are running every polling
+// interval until the startup timeout elapses. The progress is redrawn in
+// place on a single line.
+func PollStartWithProgress(ui command.UI, config command.Config, actor StartProgressActor, appGUID string, messages <-chan *v2action.LogMessage, logErrs <-chan error, appStarting <-chan bool, apiWarnings <-chan string, apiErrs <-chan error) error {
+	var frame, width int
+	err := pollStart(ui, config, messages, logErrs, appStarting, apiWarnings, apiErrs, func() {
+		width = displayStartupProgress(ui, actor, appGUID, spinnerFrames[frame%len(spinnerFrames)], width)
+		frame++
+	})
+	if width > 0 {
+		ui.DisplayNewline()
+	}
+	return err
+}
+
+func pollStart(ui command.UI, config command.Config, messages <-chan *v2action.LogMessage, logErrs <-chan error, appStarting <-chan bool, apiWarnings <-chan string, apiErrs <-chan error, displayProgress func()) error {
+	var (
+		breakAppStart, breakWarnings, breakAPIErrs bool
+		progressTicks                              <-chan time.Time
+		progressDeadline                           time.Time
+	)
 	for {
 		select {
 		case message, ok := <-messages:
@@ -26,7 +62,21 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 			if appStart {
 				ui.DisplayNewline()
 				ui.DisplayText("Waiting for app to start...")
+
+				if displayProgress != nil && config.PollingInterval() > 0 {
+					ticker := time.NewTicker(config.PollingInterval())
+					defer ticker.Stop()
+					progressTicks = ticker.C
+					progressDeadline = time.Now().Add(config.StartupTimeout())
+				}
 			}
+		case <-progressTicks:
+			if time.Now().After(progressDeadline) {
+				progressTicks = nil
+				break
+			}
+
+			displayProgress()
 		case warning, ok := <-apiWarnings:
 			if !ok {
 				breakWarnings = true
@@ -74,3 +124,43 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 		}
 	}
 }
+
+// displayStartupProgress overwrites the previously displayed progress line,
+// which was previousWidth characters wide, and returns the width of the new
+// one.
+func displayStartupProgress(ui command.UI, actor StartProgressActor, appGUID string, spinner string, previousWidth int) int {
+	progress, warnings, err := actor.GetApplicationStartupProgress(appGUID)
+	ui.DisplayWarnings(warnings)
+	if err != nil || progress.Total == 0 {
+		return previousWidth
+	}
+
+	states := []string{
+		ui.TranslateText("{{.Running}} of {{.Total}} instances running", map[string]interface{}{
+			"Running": progress.Running,
+			"Total":   progress.Total,
+		}),
+	}
+	for _, state := range []struct {
+		count    int
+		template string
+	}{
+		{progress.Starting, "{{.Count}} starting"},
+		{progress.Crashed, "{{.Count}} crashed"},
+		{progress.Down, "{{.Count}} down"},
+	} {
+		if state.count > 0 {
+			states = append(states, ui.TranslateText(state.template, map[string]interface{}{
+				"Count": state.count,
+			}))
+		}
+	}
+
+	line := fmt.Sprintf("%s %s", spinner, strings.Join(states, ", "))
+	padding := ""
+	if previousWidth > len(line) {
+		padding = strings.Repeat(" ", previousWidth-len(line))
+	}
+	fmt.Fprintf(ui.Writer(), "\r%s%s", line, padding)
+	return len(line)
+}
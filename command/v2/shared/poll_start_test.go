@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/shared/sharedfakes"
 
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -171,3 +172,134 @@ var _ = Describe("Poll Start", func() {
 		),
 	)
 })
+
+var _ = Describe("Poll Start With Progress", func() {
+	var (
+		testUI      *ui.UI
+		fakeConfig  *commandfakes.FakeConfig
+		fakeActor   *sharedfakes.FakeStartProgressActor
+		messages    chan *v2action.LogMessage
+		logErrs     chan error
+		appStarting chan bool
+		apiWarnings chan string
+		apiErrs     chan error
+		err         error
+		block       chan bool
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("FiveThirtyEight")
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+		fakeConfig.StartupTimeoutReturns(time.Minute)
+		fakeActor = new(sharedfakes.FakeStartProgressActor)
+
+		messages = make(chan *v2action.LogMessage)
+		logErrs = make(chan error)
+		appStarting = make(chan bool)
+		apiWarnings = make(chan string)
+		apiErrs = make(chan error)
+		block = make(chan bool)
+
+		err = errors.New("This should never occur.")
+	})
+
+	JustBeforeEach(func() {
+		go func() {
+			err = PollStartWithProgress(testUI, fakeConfig, fakeActor, "some-app-guid", messages, logErrs, appStarting, apiWarnings, apiErrs)
+			close(block)
+		}()
+	})
+
+	Context("when the app's instances are starting", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationStartupProgressReturns(
+				v2action.ApplicationStartupProgress{Total: 4, Running: 1, Starting: 1, Crashed: 1, Down: 1},
+				v2action.Warnings{"progress-warning"},
+				nil,
+			)
+		})
+
+		It("displays the number of instances in each state until startup finishes", func() {
+			appStarting <- true
+
+			Eventually(testUI.Out).Should(Say("Waiting for app to start..."))
+			Eventually(testUI.Out).Should(Say(`\r\| 1 of 4 instances running, 1 starting, 1 crashed, 1 down\r/ 1 of 4 instances running, 1 starting, 1 crashed, 1 down`))
+			Eventually(testUI.Err).Should(Say("progress-warning"))
+
+			close(appStarting)
+			close(apiWarnings)
+			close(apiErrs)
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).ToNot(HaveOccurred())
+
+			appGUID := fakeActor.GetApplicationStartupProgressArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+
+		Context("when only some states have instances", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationStartupProgressReturns(
+					v2action.ApplicationStartupProgress{Total: 2, Starting: 2},
+					nil,
+					nil,
+				)
+			})
+
+			It("only displays the states that have instances", func() {
+				appStarting <- true
+
+				Eventually(testUI.Out).Should(Say(`\r\| 0 of 2 instances running, 2 starting\r`))
+
+				close(appStarting)
+				close(apiWarnings)
+				close(apiErrs)
+				Eventually(block).Should(BeClosed())
+				Expect(testUI.Out).To(Say(`2 starting\n$`))
+			})
+		})
+
+		Context("when the startup timeout has elapsed", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(0)
+			})
+
+			It("stops displaying progress", func() {
+				appStarting <- true
+
+				Eventually(testUI.Out).Should(Say("Waiting for app to start..."))
+				Consistently(testUI.Out).ShouldNot(Say("instances running"))
+				Expect(fakeActor.GetApplicationStartupProgressCallCount()).To(Equal(0))
+
+				close(appStarting)
+				close(apiWarnings)
+				close(apiErrs)
+				Eventually(block).Should(BeClosed())
+			})
+		})
+	})
+
+	Context("when the app never starts any instances", func() {
+		It("does not display any progress", func() {
+			close(appStarting)
+			close(apiWarnings)
+			close(apiErrs)
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeActor.GetApplicationStartupProgressCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when startup fails", func() {
+		It("returns the translated error", func() {
+			appStarting <- true
+			apiErrs <- v2action.ApplicationInstanceCrashedError{Name: "some-app"}
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).To(MatchError(UnsuccessfulStartError{AppName: "some-app", BinaryName: "FiveThirtyEight"}))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+// This file was generated by counterfeiter
+package sharedfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type FakeCrashDiagnosticsActor struct {
+	GetApplicationCrashDiagnosticsStub        func(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error)
+	getApplicationCrashDiagnosticsMutex       sync.RWMutex
+	getApplicationCrashDiagnosticsArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+	}
+	getApplicationCrashDiagnosticsReturns struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationCrashDiagnosticsReturnsOnCall map[int]struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCrashDiagnosticsActor) GetApplicationCrashDiagnostics(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error) {
+	fake.getApplicationCrashDiagnosticsMutex.Lock()
+	ret, specificReturn := fake.getApplicationCrashDiagnosticsReturnsOnCall[len(fake.getApplicationCrashDiagnosticsArgsForCall)]
+	fake.getApplicationCrashDiagnosticsArgsForCall = append(fake.getApplicationCrashDiagnosticsArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+	}{appGUID, client})
+	fake.recordInvocation("GetApplicationCrashDiagnostics", []interface{}{appGUID, client})
+	fake.getApplicationCrashDiagnosticsMutex.Unlock()
+	if fake.GetApplicationCrashDiagnosticsStub != nil {
+		return fake.GetApplicationCrashDiagnosticsStub(appGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationCrashDiagnosticsReturns.result1, fake.getApplicationCrashDiagnosticsReturns.result2, fake.getApplicationCrashDiagnosticsReturns.result3
+}
+
+func (fake *FakeCrashDiagnosticsActor) GetApplicationCrashDiagnosticsCallCount() int {
+	fake.getApplicationCrashDiagnosticsMutex.RLock()
+	defer fake.getApplicationCrashDiagnosticsMutex.RUnlock()
+	return len(fake.getApplicationCrashDiagnosticsArgsForCall)
+}
+
+func (fake *FakeCrashDiagnosticsActor) GetApplicationCrashDiagnosticsArgsForCall(i int) (string, v2action.NOAAClient) {
+	fake.getApplicationCrashDiagnosticsMutex.RLock()
+	defer fake.getApplicationCrashDiagnosticsMutex.RUnlock()
+	return fake.getApplicationCrashDiagnosticsArgsForCall[i].appGUID, fake.getApplicationCrashDiagnosticsArgsForCall[i].client
+}
+
+func (fake *FakeCrashDiagnosticsActor) GetApplicationCrashDiagnosticsReturns(result1 v2action.CrashDiagnostics, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationCrashDiagnosticsStub = nil
+	fake.getApplicationCrashDiagnosticsReturns = struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCrashDiagnosticsActor) GetApplicationCrashDiagnosticsReturnsOnCall(i int, result1 v2action.CrashDiagnostics, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationCrashDiagnosticsStub = nil
+	if fake.getApplicationCrashDiagnosticsReturnsOnCall == nil {
+		fake.getApplicationCrashDiagnosticsReturnsOnCall = make(map[int]struct {
+			result1 v2action.CrashDiagnostics
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationCrashDiagnosticsReturnsOnCall[i] = struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCrashDiagnosticsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationCrashDiagnosticsMutex.RLock()
+	defer fake.getApplicationCrashDiagnosticsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCrashDiagnosticsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ shared.CrashDiagnosticsActor = new(FakeCrashDiagnosticsActor)
@@ -0,0 +1,105 @@
+// This file was generated by counterfeiter
+package sharedfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type FakeStartProgressActor struct {
+	GetApplicationStartupProgressStub        func(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error)
+	getApplicationStartupProgressMutex       sync.RWMutex
+	getApplicationStartupProgressArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationStartupProgressReturns struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationStartupProgressReturnsOnCall map[int]struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStartProgressActor) GetApplicationStartupProgress(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error) {
+	fake.getApplicationStartupProgressMutex.Lock()
+	ret, specificReturn := fake.getApplicationStartupProgressReturnsOnCall[len(fake.getApplicationStartupProgressArgsForCall)]
+	fake.getApplicationStartupProgressArgsForCall = append(fake.getApplicationStartupProgressArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationStartupProgress", []interface{}{appGUID})
+	fake.getApplicationStartupProgressMutex.Unlock()
+	if fake.GetApplicationStartupProgressStub != nil {
+		return fake.GetApplicationStartupProgressStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationStartupProgressReturns.result1, fake.getApplicationStartupProgressReturns.result2, fake.getApplicationStartupProgressReturns.result3
+}
+
+func (fake *FakeStartProgressActor) GetApplicationStartupProgressCallCount() int {
+	fake.getApplicationStartupProgressMutex.RLock()
+	defer fake.getApplicationStartupProgressMutex.RUnlock()
+	return len(fake.getApplicationStartupProgressArgsForCall)
+}
+
+func (fake *FakeStartProgressActor) GetApplicationStartupProgressArgsForCall(i int) string {
+	fake.getApplicationStartupProgressMutex.RLock()
+	defer fake.getApplicationStartupProgressMutex.RUnlock()
+	return fake.getApplicationStartupProgressArgsForCall[i].appGUID
+}
+
+func (fake *FakeStartProgressActor) GetApplicationStartupProgressReturns(result1 v2action.ApplicationStartupProgress, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStartupProgressStub = nil
+	fake.getApplicationStartupProgressReturns = struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartProgressActor) GetApplicationStartupProgressReturnsOnCall(i int, result1 v2action.ApplicationStartupProgress, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStartupProgressStub = nil
+	if fake.getApplicationStartupProgressReturnsOnCall == nil {
+		fake.getApplicationStartupProgressReturnsOnCall = make(map[int]struct {
+			result1 v2action.ApplicationStartupProgress
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationStartupProgressReturnsOnCall[i] = struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartProgressActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationStartupProgressMutex.RLock()
+	defer fake.getApplicationStartupProgressMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeStartProgressActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ shared.StartProgressActor = new(FakeStartProgressActor)
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
@@ -25,7 +26,7 @@ type SpaceActor interface {
 
 type SpaceActorV3 interface {
 	CloudControllerAPIVersion() string
-	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.EffectiveIsolationSegment, v3action.Warnings, error)
 }
 
 type SpaceCommand struct {
@@ -104,7 +105,7 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 	})
 	cmd.UI.DisplayNewline()
 
-	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "2.74.0")
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionSpaceStagingSecurityGroupsV2)
 	includeStagingSecurityGroupsRules := err == nil
 
 	spaceSummary, warnings, err := cmd.Actor.GetSpaceSummaryByOrganizationAndName(cmd.Config.TargetedOrganization().GUID, cmd.RequiredArgs.Space, includeStagingSecurityGroupsRules)
@@ -182,7 +183,7 @@ func (cmd SpaceCommand) isolationSegmentRow(spaceSummary v2action.SpaceSummary)
 		return nil, nil
 	}
 
-	apiCheck := command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), "3.11.0")
+	apiCheck := command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionIsolationSegmentV3)
 	if apiCheck != nil {
 		return nil, nil
 	}
@@ -193,6 +194,11 @@ func (cmd SpaceCommand) isolationSegmentRow(spaceSummary v2action.SpaceSummary)
 	cmd.UI.DisplayWarnings(v3Warnings)
 	if err == nil {
 		isolationSegmentName = isolationSegment.Name
+		if isolationSegment.Inherited {
+			isolationSegmentName = cmd.UI.TranslateText("{{.IsolationSegmentName}} (inherited from org default)", map[string]interface{}{
+				"IsolationSegmentName": isolationSegment.Name,
+			})
+		}
 	} else {
 		if _, ok := err.(v3action.NoRelationshipError); !ok {
 			return nil, err
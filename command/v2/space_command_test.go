@@ -191,8 +191,10 @@ var _ = Describe("space Command", func() {
 			Context("when there are no errors", func() {
 				BeforeEach(func() {
 					fakeActorV3.GetEffectiveIsolationSegmentBySpaceReturns(
-						v3action.IsolationSegment{
-							Name: "some-isolation-segment",
+						v3action.EffectiveIsolationSegment{
+							IsolationSegment: v3action.IsolationSegment{
+								Name: "some-isolation-segment",
+							},
 						},
 						v3action.Warnings{"v3-warning-1", "v3-warning-2"},
 						nil,
@@ -227,6 +229,26 @@ var _ = Describe("space Command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(orgDefaultIsolationSegmentGUID).To(Equal("some-org-default-isolation-segment-guid"))
 				})
+
+				Context("when the isolation segment is inherited from the org default", func() {
+					BeforeEach(func() {
+						fakeActorV3.GetEffectiveIsolationSegmentBySpaceReturns(
+							v3action.EffectiveIsolationSegment{
+								IsolationSegment: v3action.IsolationSegment{
+									Name: "some-org-isolation-segment",
+								},
+								Inherited: true,
+							},
+							nil,
+							nil,
+						)
+					})
+
+					It("explains that the isolation segment is inherited", func() {
+						Expect(executeErr).To(BeNil())
+						Expect(testUI.Out).To(Say(`isolation segment:\s+some-org-isolation-segment \(inherited from org default\)`))
+					})
+				})
 			})
 
 			Context("when v3 api version is below 3.11.0 and the v2 api version is no less than 2.74.0", func() {
@@ -328,7 +350,7 @@ var _ = Describe("space Command", func() {
 			BeforeEach(func() {
 				expectedErr = errors.New("get isolation segment error")
 				fakeActorV3.GetEffectiveIsolationSegmentBySpaceReturns(
-					v3action.IsolationSegment{},
+					v3action.EffectiveIsolationSegment{},
 					v3action.Warnings{"v3-warning-1", "v3-warning-2"},
 					expectedErr)
 			})
@@ -344,7 +366,7 @@ var _ = Describe("space Command", func() {
 		Context("a NoRelationshipError", func() {
 			BeforeEach(func() {
 				fakeActorV3.GetEffectiveIsolationSegmentBySpaceReturns(
-					v3action.IsolationSegment{},
+					v3action.EffectiveIsolationSegment{},
 					v3action.Warnings{"v3-warning-1", "v3-warning-2"},
 					v3action.NoRelationshipError{})
 			})
@@ -1,22 +1,115 @@
 package v2
 
 import (
-	"os"
+	"encoding/json"
+	"fmt"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . SpacesActor
+
+type SpacesActor interface {
+	GetOrganizationSpacesWithQuotas(orgGUID string, handlePage func([]v2action.SpaceWithQuota)) (v2action.Warnings, error)
+}
+
 type SpacesCommand struct {
-	usage           interface{} `usage:"CF_NAME spaces"`
+	JSON            bool        `long:"json" description:"Output the spaces as JSON"`
+	usage           interface{} `usage:"CF_NAME spaces [--json]"`
 	relatedCommands interface{} `related_commands:"target"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SpacesActor
+}
+
+type spaceJSON struct {
+	Name  string `json:"name"`
+	GUID  string `json:"guid"`
+	Quota string `json:"quota"`
 }
 
-func (_ SpacesCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SpacesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ SpacesCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SpacesCommand) Execute(args []string) error {
+	cmd.JSON = command.JSONOutputRequested(cmd.Config, cmd.JSON)
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.JSON {
+		return cmd.displaySpacesJSON()
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	displayer := shared.PaginatedTableDisplayer{
+		UI:     cmd.UI,
+		Header: []string{"name", "quota"},
+	}
+	warnings, err := cmd.Actor.GetOrganizationSpacesWithQuotas(cmd.Config.TargetedOrganization().GUID, func(spaces []v2action.SpaceWithQuota) {
+		rows := make([][]string, 0, len(spaces))
+		for _, space := range spaces {
+			rows = append(rows, []string{space.Name, space.QuotaName})
+		}
+		displayer.DisplayPage(rows)
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !displayer.DisplayedRows() {
+		cmd.UI.DisplayText("No spaces found")
+	}
+
 	return nil
 }
+
+func (cmd SpacesCommand) displaySpacesJSON() error {
+	spaces := []spaceJSON{}
+	warnings, err := cmd.Actor.GetOrganizationSpacesWithQuotas(cmd.Config.TargetedOrganization().GUID, func(page []v2action.SpaceWithQuota) {
+		for _, space := range page {
+			spaces = append(spaces, spaceJSON{Name: space.Name, GUID: space.GUID, Quota: space.QuotaName})
+		}
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	output, err := json.MarshalIndent(spaces, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
@@ -0,0 +1,137 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("spaces Command", func() {
+	var (
+		cmd             SpacesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSpacesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSpacesActor)
+
+		cmd = SpacesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoTargetedOrganizationError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NoTargetedOrganizationError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			config, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(targetedOrganizationRequired).To(Equal(true))
+			Expect(targetedSpaceRequired).To(Equal(false))
+		})
+	})
+
+	Context("when there are spaces spread across multiple pages", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationSpacesWithQuotasStub = func(_ string, handlePage func([]v2action.SpaceWithQuota)) (v2action.Warnings, error) {
+				handlePage([]v2action.SpaceWithQuota{
+					{Space: v2action.Space{GUID: "space-guid-1", Name: "space-1"}, QuotaName: "quota-1"},
+					{Space: v2action.Space{GUID: "space-guid-2", Name: "space-2"}},
+				})
+				handlePage([]v2action.SpaceWithQuota{
+					{Space: v2action.Space{GUID: "space-guid-3", Name: "space-3"}, QuotaName: "quota-1"},
+				})
+				return v2action.Warnings{"warning-1", "warning-2"}, nil
+			}
+		})
+
+		It("displays the spaces in the targeted org and their quotas", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting spaces in org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("name\\s+quota"))
+			Expect(testUI.Out).To(Say("space-1\\s+quota-1"))
+			Expect(testUI.Out).To(Say("space-2"))
+			Expect(testUI.Out).To(Say("space-3\\s+quota-1"))
+			Expect(testUI.Out).ToNot(Say("No spaces found"))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+
+			Expect(fakeActor.GetOrganizationSpacesWithQuotasCallCount()).To(Equal(1))
+			orgGUID, _ := fakeActor.GetOrganizationSpacesWithQuotasArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+
+		Context("when the --json flag is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("displays the spaces as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{"name": "space-1", "guid": "space-guid-1", "quota": "quota-1"},
+					{"name": "space-2", "guid": "space-guid-2", "quota": ""},
+					{"name": "space-3", "guid": "space-guid-3", "quota": "quota-1"}
+				]`))
+			})
+		})
+	})
+
+	Context("when there are no spaces", func() {
+		It("displays a message that no spaces were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No spaces found"))
+		})
+	})
+
+	Context("when getting the spaces fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some spaces error")
+			fakeActor.GetOrganizationSpacesWithQuotasReturns(v2action.Warnings{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
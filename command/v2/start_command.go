@@ -1,6 +1,8 @@
 package v2
 
 import (
+	"context"
+
 	"github.com/cloudfoundry/noaa/consumer"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -14,7 +16,9 @@ import (
 
 type StartActor interface {
 	AppActor
-	StartApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	GetApplicationCrashDiagnostics(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error)
+	GetApplicationStartupProgress(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error)
+	StartApplication(ctx context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
 }
 
 type StartCommand struct {
@@ -36,7 +40,7 @@ func (cmd *StartCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
-	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	ccClient, uaaClient, err := shared.NewInterruptibleClients(config, ui, true)
 	if err != nil {
 		return err
 	}
@@ -80,10 +84,13 @@ func (cmd StartCommand) Execute(args []string) error {
 		return nil
 	}
 
-	messages, logErrs, appStarting, apiWarnings, errs := cmd.Actor.StartApplication(app, cmd.NOAAClient, cmd.Config)
+	messages, logErrs, appStarting, apiWarnings, errs := cmd.Actor.StartApplication(command.InterruptContext(), app, cmd.NOAAClient, cmd.Config)
 	cmd.UI.DisplayNewline()
-	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appStarting, apiWarnings, errs)
+	err = shared.PollStartWithProgress(cmd.UI, cmd.Config, cmd.Actor, app.GUID, messages, logErrs, appStarting, apiWarnings, errs)
 	if err != nil {
+		if _, ok := err.(shared.UnsuccessfulStartError); ok {
+			shared.DisplayCrashDiagnostics(cmd.UI, cmd.Actor, app.GUID, cmd.NOAAClient)
+		}
 		return err
 	}
 
@@ -1,6 +1,7 @@
 package v2_test
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -53,7 +54,7 @@ var _ = Describe("Start Command", func() {
 		testUI.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
 		Expect(err).NotTo(HaveOccurred())
 
-		fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+		fakeActor.StartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 			messages := make(chan *v2action.LogMessage)
 			logErrs := make(chan error)
 			appStart := make(chan bool)
@@ -161,14 +162,14 @@ var _ = Describe("Start Command", func() {
 					Expect(testUI.Err).To(Say("warning-2"))
 
 					Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
-					app, _, config := fakeActor.StartApplicationArgsForCall(0)
+					_, app, _, config := fakeActor.StartApplicationArgsForCall(0)
 					Expect(app.GUID).To(Equal("app-guid"))
 					Expect(config).To(Equal(fakeConfig))
 				})
 
 				Context("when passed an appStarting message", func() {
 					BeforeEach(func() {
-						fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+						fakeActor.StartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 							messages := make(chan *v2action.LogMessage)
 							logErrs := make(chan error)
 							appStart := make(chan bool)
@@ -198,9 +199,51 @@ var _ = Describe("Start Command", func() {
 					})
 				})
 
+				Context("when the app's instances take a while to start", func() {
+					BeforeEach(func() {
+						fakeConfig.PollingIntervalReturns(time.Millisecond)
+						fakeConfig.StartupTimeoutReturns(time.Minute)
+						fakeActor.GetApplicationStartupProgressReturns(
+							v2action.ApplicationStartupProgress{Total: 3, Running: 1, Starting: 1, Crashed: 1},
+							nil,
+							nil,
+						)
+						fakeActor.StartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+							messages := make(chan *v2action.LogMessage)
+							logErrs := make(chan error)
+							appStart := make(chan bool)
+							warnings := make(chan string)
+							errs := make(chan error)
+
+							go func() {
+								appStart <- true
+								for fakeActor.GetApplicationStartupProgressCallCount() == 0 {
+									time.Sleep(time.Millisecond)
+								}
+								close(messages)
+								close(logErrs)
+								close(appStart)
+								close(warnings)
+								close(errs)
+							}()
+
+							return messages, logErrs, appStart, warnings, errs
+						}
+					})
+
+					It("displays the startup progress of the app's instances", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("Waiting for app to start..."))
+						Expect(testUI.Out).To(Say("1 of 3 instances running, 1 starting, 1 crashed"))
+
+						appGUID := fakeActor.GetApplicationStartupProgressArgsForCall(0)
+						Expect(appGUID).To(Equal("app-guid"))
+					})
+				})
+
 				Context("when passed a log message", func() {
 					BeforeEach(func() {
-						fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+						fakeActor.StartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 							messages := make(chan *v2action.LogMessage)
 							logErrs := make(chan error)
 							appStart := make(chan bool)
@@ -233,7 +276,7 @@ var _ = Describe("Start Command", func() {
 				Context("when passed an log err", func() {
 					Context("NOAA connection times out/closes", func() {
 						BeforeEach(func() {
-							fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+							fakeActor.StartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 								messages := make(chan *v2action.LogMessage)
 								logErrs := make(chan error)
 								appStart := make(chan bool)
@@ -307,7 +350,7 @@ var _ = Describe("Start Command", func() {
 
 						BeforeEach(func() {
 							expectedErr = errors.New("err log message")
-							fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+							fakeActor.StartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 								messages := make(chan *v2action.LogMessage)
 								logErrs := make(chan error)
 								appStart := make(chan bool)
@@ -337,7 +380,7 @@ var _ = Describe("Start Command", func() {
 				Context("when passed a warning", func() {
 					Context("while NOAA is still logging", func() {
 						BeforeEach(func() {
-							fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+							fakeActor.StartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 								messages := make(chan *v2action.LogMessage)
 								logErrs := make(chan error)
 								appStart := make(chan bool)
@@ -367,7 +410,7 @@ var _ = Describe("Start Command", func() {
 
 					Context("while NOAA is no longer logging", func() {
 						BeforeEach(func() {
-							fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+							fakeActor.StartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 								messages := make(chan *v2action.LogMessage)
 								logErrs := make(chan error)
 								appStart := make(chan bool)
@@ -406,7 +449,7 @@ var _ = Describe("Start Command", func() {
 					var apiErr error
 
 					BeforeEach(func() {
-						fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+						fakeActor.StartApplicationStub = func(_ context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 							messages := make(chan *v2action.LogMessage)
 							logErrs := make(chan error)
 							appStart := make(chan bool)
@@ -453,17 +496,32 @@ var _ = Describe("Start Command", func() {
 
 						It("stops logging and returns StagingTimeoutError", func() {
 							Expect(executeErr).To(MatchError(shared.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond}))
+							Expect(fakeActor.GetApplicationCrashDiagnosticsCallCount()).To(Equal(0))
 						})
 					})
 
 					Context("when the app instance crashes", func() {
 						BeforeEach(func() {
 							apiErr = v2action.ApplicationInstanceCrashedError{Name: "some-app"}
+							fakeActor.GetApplicationCrashDiagnosticsReturns(
+								v2action.CrashDiagnostics{
+									CrashEvents: []v2action.CrashEvent{{InstanceIndex: 0, ExitDescription: "out of memory", Reason: "CRASHED"}},
+								},
+								v2action.Warnings{"diagnostics-warning"},
+								nil,
+							)
 						})
 
 						It("stops logging and returns UnsuccessfulStartError", func() {
 							Expect(executeErr).To(MatchError(shared.UnsuccessfulStartError{AppName: "some-app", BinaryName: "faceman"}))
 						})
+
+						It("displays the crash diagnostics", func() {
+							Expect(fakeActor.GetApplicationCrashDiagnosticsCallCount()).To(Equal(1))
+							Expect(testUI.Err).To(Say("diagnostics-warning"))
+							Expect(testUI.Out).To(Say("Instance 0 exited: out of memory"))
+							Expect(testUI.Out).To(Say("Recent crash events:"))
+						})
 					})
 
 					Context("when the app instance flaps", func() {
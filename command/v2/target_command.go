@@ -2,6 +2,7 @@ package v2
 
 import (
 	"fmt"
+	"os"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -20,7 +21,8 @@ type TargetActor interface {
 type TargetCommand struct {
 	Organization    string      `short:"o" description:"Organization"`
 	Space           string      `short:"s" description:"Space"`
-	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
+	Auto            bool        `long:"auto" description:"Target the org and space of the auto-target rule matching the current directory"`
+	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]\n   CF_NAME target --auto\n\n   Auto-target rules are read from the AutoTargetRules list of the config file. Each rule\n   has a Path, a GitRemote or both, and the Organization and Space to target. The first\n   rule matching the current directory, or the git repository it belongs to, is used."`
 	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
 
 	UI          command.UI
@@ -44,7 +46,11 @@ func (cmd *TargetCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd *TargetCommand) Execute(args []string) error {
-	cmd.notifyCLIUpdateIfNeeded()
+	if cmd.Auto && (cmd.Organization != "" || cmd.Space != "") {
+		return command.ArgumentCombinationError{Arg1: "--auto", Arg2: "-o, -s"}
+	}
+
+	shared.WarnIfCLIVersionBelowMinimum(cmd.Config, cmd.UI)
 
 	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
 	if err != nil {
@@ -53,32 +59,30 @@ func (cmd *TargetCommand) Execute(args []string) error {
 
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
-		cmd.clearTargets()
 		return shared.HandleError(err)
 	}
 
+	if cmd.Auto {
+		err = cmd.applyAutoTargetRule()
+		if err != nil {
+			return err
+		}
+	}
+
 	switch {
 	case cmd.Organization != "" && cmd.Space != "":
 		err = cmd.setOrgAndSpace()
 		if err != nil {
-			cmd.clearTargets()
 			return err
 		}
 	case cmd.Organization != "":
 		err = cmd.setOrg()
 		if err != nil {
-			cmd.clearTargets()
-			return err
-		}
-		err = cmd.autoTargetSpace(cmd.Config.TargetedOrganization().GUID)
-		if err != nil {
-			cmd.clearTargets()
 			return err
 		}
 	case cmd.Space != "":
 		err = cmd.setSpace()
 		if err != nil {
-			cmd.clearTargets()
 			return err
 		}
 	}
@@ -103,19 +107,9 @@ func (cmd *TargetCommand) Execute(args []string) error {
 	return nil
 }
 
-func (cmd *TargetCommand) notifyCLIUpdateIfNeeded() {
-	err := command.MinimumAPIVersionCheck(cmd.Config.BinaryVersion(), cmd.Config.MinCLIVersion())
-
-	if _, ok := err.(command.MinimumAPIVersionNotMetError); ok {
-		cmd.UI.DisplayWarning("Cloud Foundry API version {{.APIVersion}} requires CLI version {{.MinCLIVersion}}. You are currently on version {{.BinaryVersion}}. To upgrade your CLI, please visit: https://github.com/cloudfoundry/cli#downloads",
-			map[string]interface{}{
-				"APIVersion":    cmd.Config.APIVersion(),
-				"MinCLIVersion": cmd.Config.MinCLIVersion(),
-				"BinaryVersion": cmd.Config.BinaryVersion(),
-			})
-	}
-}
-
+// clearTargets unsets the targets that a failed lookup was about to replace.
+// It is only called once the organization is known to exist, so that usage
+// errors and mistyped organization names leave the current target intact.
 func (cmd TargetCommand) clearTargets() {
 	if cmd.Organization != "" {
 		cmd.Config.UnsetOrganizationInformation()
@@ -125,6 +119,24 @@ func (cmd TargetCommand) clearTargets() {
 	}
 }
 
+// applyAutoTargetRule sets the org and space to target from the auto-target
+// rule matching the current directory.
+func (cmd *TargetCommand) applyAutoTargetRule() error {
+	dir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	rule, ok := cmd.Config.AutoTargetRule(dir)
+	if !ok {
+		return shared.NoAutoTargetRuleError{}
+	}
+
+	cmd.Organization = rule.Organization
+	cmd.Space = rule.Space
+	return nil
+}
+
 // setOrgAndSpace sets organization and space
 func (cmd *TargetCommand) setOrgAndSpace() error {
 	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
@@ -136,6 +148,7 @@ func (cmd *TargetCommand) setOrgAndSpace() error {
 	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.Space)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
+		cmd.clearTargets()
 		return shared.HandleError(err)
 	}
 
@@ -145,7 +158,9 @@ func (cmd *TargetCommand) setOrgAndSpace() error {
 	return nil
 }
 
-// setOrg sets organization
+// setOrg sets organization and, if the org only has one space, targets that
+// space. Nothing is persisted until both the org and its spaces have been
+// retrieved.
 func (cmd *TargetCommand) setOrg() error {
 	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
 	cmd.UI.DisplayWarnings(warnings)
@@ -153,21 +168,16 @@ func (cmd *TargetCommand) setOrg() error {
 		return shared.HandleError(err)
 	}
 
-	cmd.Config.SetOrganizationInformation(org.GUID, cmd.Organization)
-	cmd.Config.UnsetSpaceInformation()
-
-	return nil
-}
-
-// autoTargetSpace targets the space if there is only one space in the org
-// and no space arg was provided.
-func (cmd *TargetCommand) autoTargetSpace(orgGUID string) error {
-	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(orgGUID)
+	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(org.GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
+		cmd.clearTargets()
 		return shared.HandleError(err)
 	}
 
+	cmd.Config.SetOrganizationInformation(org.GUID, cmd.Organization)
+	cmd.Config.UnsetSpaceInformation()
+
 	if len(spaces) == 1 {
 		space := spaces[0]
 		cmd.Config.SetSpaceInformation(space.GUID, space.Name, space.AllowSSH)
@@ -185,6 +195,7 @@ func (cmd *TargetCommand) setSpace() error {
 	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(cmd.Config.TargetedOrganization().GUID, cmd.Space)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
+		cmd.clearTargets()
 		return shared.HandleError(err)
 	}
 
@@ -198,8 +209,9 @@ func (cmd *TargetCommand) displayTargetTable(user configv3.User) {
 	table := [][]string{
 		{cmd.UI.TranslateText("api endpoint:"), cmd.Config.Target()},
 		{cmd.UI.TranslateText("api version:"), cmd.Config.APIVersion()},
-		{cmd.UI.TranslateText("user:"), user.Name},
 	}
+	table = appendEndpointRows(cmd.UI, cmd.Config, table)
+	table = append(table, []string{cmd.UI.TranslateText("user:"), user.Name})
 
 	if cmd.Config.HasTargetedOrganization() {
 		table = append(table, []string{
@@ -2,6 +2,7 @@ package v2_test
 
 import (
 	"errors"
+	"os"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -150,6 +151,23 @@ var _ = Describe("target Command", func() {
 						})
 					})
 
+					Context("when the UAA and Doppler endpoints are set", func() {
+						BeforeEach(func() {
+							fakeConfig.UAAEndpointReturns("https://uaa.some-domain.com")
+							fakeConfig.DopplerEndpointReturns("wss://doppler.some-domain.com:443")
+						})
+
+						It("displays the endpoints", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("api endpoint:       some-api-target"))
+							Expect(testUI.Out).To(Say("api version:        1.2.3"))
+							Expect(testUI.Out).To(Say("uaa endpoint:       https://uaa.some-domain.com"))
+							Expect(testUI.Out).To(Say("doppler endpoint:   wss://doppler.some-domain.com:443"))
+							Expect(testUI.Out).To(Say("user:               some-user"))
+						})
+					})
+
 					Context("when an org but no space is targeted", func() {
 						BeforeEach(func() {
 							fakeConfig.HasTargetedOrganizationReturns(true)
@@ -249,12 +267,12 @@ var _ = Describe("target Command", func() {
 					})
 
 					Context("when no org is targeted", func() {
-						It("returns NoOrgTargeted error and clears existing space", func() {
+						It("returns NoOrgTargeted error and keeps the existing space", func() {
 							Expect(executeErr).To(MatchError(shared.NoOrganizationTargetedError{}))
 
 							Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
 							Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(0))
-							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(0))
 						})
 					})
 				})
@@ -272,12 +290,12 @@ var _ = Describe("target Command", func() {
 								v2action.OrganizationNotFoundError{Name: "some-org"})
 						})
 
-						It("displays all warnings,returns an org target error, and clears existing targets", func() {
+						It("displays all warnings,returns an org target error, and keeps the existing targets", func() {
 							Expect(executeErr).To(MatchError(shared.OrganizationNotFoundError{Name: "some-org"}))
 
 							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
-							Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
-							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+							Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(0))
+							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(0))
 						})
 					})
 
@@ -307,7 +325,7 @@ var _ = Describe("target Command", func() {
 									err)
 							})
 
-							It("displays all warnings, returns a get org spaces error and clears existing targets", func() {
+							It("displays all warnings, returns a get org spaces error and clears existing targets without persisting the org", func() {
 								Expect(executeErr).To(MatchError(err))
 
 								Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
@@ -318,14 +336,11 @@ var _ = Describe("target Command", func() {
 								Expect(testUI.Err).To(Say("warning-2"))
 								Expect(testUI.Err).To(Say("warning-3"))
 
-								Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
-								orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
-								Expect(orgGUID).To(Equal("some-org-guid"))
-								Expect(orgName).To(Equal("some-org"))
+								Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
 								Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
 
 								Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
-								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(2))
+								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
 							})
 						})
 
@@ -446,7 +461,7 @@ var _ = Describe("target Command", func() {
 								Expect(testUI.Err).To(Say("warning-3"))
 
 								Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
-								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(2))
+								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
 							})
 						})
 					})
@@ -531,14 +546,79 @@ var _ = Describe("target Command", func() {
 								v2action.OrganizationNotFoundError{Name: "some-org"})
 						})
 
-						It("returns an error and clears existing targets", func() {
+						It("returns an error and keeps the existing targets", func() {
 							Expect(executeErr).To(MatchError(shared.OrganizationNotFoundError{Name: "some-org"}))
 
 							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
 							Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
 
-							Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
-							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+							Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(0))
+							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				Context("when --auto is provided", func() {
+					BeforeEach(func() {
+						cmd.Auto = true
+					})
+
+					Context("when a rule matches the current directory", func() {
+						BeforeEach(func() {
+							fakeConfig.AutoTargetRuleReturns(configv3.AutoTargetRule{
+								Path:         "/some/dir",
+								Organization: "some-org",
+								Space:        "some-space",
+							}, true)
+							fakeActor.GetOrganizationByNameReturns(
+								v2action.Organization{GUID: "some-org-guid"},
+								v2action.Warnings{"warning-1"},
+								nil)
+							fakeActor.GetSpaceByOrganizationAndNameReturns(
+								v2action.Space{GUID: "some-space-guid", Name: "some-space"},
+								v2action.Warnings{"warning-2"},
+								nil)
+						})
+
+						It("targets the org and space of the rule", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Err).To(Say("warning-1"))
+							Expect(testUI.Err).To(Say("warning-2"))
+
+							dir, err := os.Getwd()
+							Expect(err).ToNot(HaveOccurred())
+							Expect(fakeConfig.AutoTargetRuleArgsForCall(0)).To(Equal(dir))
+
+							Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+							orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+							Expect(orgGUID).To(Equal("some-org-guid"))
+							Expect(spaceName).To(Equal("some-space"))
+
+							orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
+							Expect(orgGUID).To(Equal("some-org-guid"))
+							Expect(orgName).To(Equal("some-org"))
+							spaceGUID, spaceName, _ := fakeConfig.SetSpaceInformationArgsForCall(0)
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+							Expect(spaceName).To(Equal("some-space"))
+						})
+					})
+
+					Context("when no rule matches the current directory", func() {
+						It("returns a NoAutoTargetRuleError", func() {
+							Expect(executeErr).To(MatchError(shared.NoAutoTargetRuleError{}))
+							Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+							Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when an org or space is also provided", func() {
+						BeforeEach(func() {
+							cmd.Organization = "some-org"
+						})
+
+						It("returns an ArgumentCombinationError", func() {
+							Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--auto", Arg2: "-o, -s"}))
+							Expect(fakeConfig.AutoTargetRuleCallCount()).To(Equal(0))
 						})
 					})
 				})
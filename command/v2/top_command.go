@@ -0,0 +1,150 @@
+package v2
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"github.com/cloudfoundry/bytefmt"
+	"github.com/cloudfoundry/noaa/consumer"
+)
+
+//go:generate counterfeiter . TopActor
+
+type TopActor interface {
+	GetContainerMetricsBySpace(spaceGUID string, client v2action.NOAAClient) ([]v2action.ApplicationContainerMetrics, v2action.Warnings, error)
+}
+
+type TopCommand struct {
+	Sort            flag.TopSort `long:"sort" description:"Rank apps by this resource: cpu or memory (Default: cpu)"`
+	Interval        int          `long:"interval" default:"5" description:"Number of seconds between refreshes"`
+	usage           interface{}  `usage:"CF_NAME top [--sort (cpu | memory)] [--interval SECONDS]\n\n   Shows the combined CPU, memory and disk usage of the instances of every app in the targeted space, refreshing until interrupted with Ctrl-C."`
+	relatedCommands interface{}  `related_commands:"app, apps, scale"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TopActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *TopCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd TopCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	if cmd.Interval < 1 {
+		return command.ParseArgumentError{
+			ArgumentName: "--interval",
+			ExpectedType: "a positive integer",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	for {
+		cmd.UI.ClearScreen()
+		err = cmd.displayContainerMetrics(user.Name)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(time.Duration(cmd.Interval) * time.Second):
+		}
+	}
+}
+
+func (cmd TopCommand) displayContainerMetrics(username string) error {
+	cmd.UI.DisplayTextWithFlavor("Showing resource usage of apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	})
+	cmd.UI.DisplayNewline()
+
+	allMetrics, warnings, err := cmd.Actor.GetContainerMetricsBySpace(cmd.Config.TargetedSpace().GUID, cmd.NOAAClient)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(allMetrics) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return nil
+	}
+
+	if cmd.Sort.Field == "memory" {
+		sort.SliceStable(allMetrics, func(i int, j int) bool {
+			return allMetrics[i].Memory > allMetrics[j].Memory
+		})
+	} else {
+		sort.SliceStable(allMetrics, func(i int, j int) bool {
+			return allMetrics[i].CPU > allMetrics[j].CPU
+		})
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("cpu"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("disk"),
+		},
+	}
+
+	for _, metrics := range allMetrics {
+		table = append(table, []string{
+			metrics.Name,
+			fmt.Sprintf("%d/%d", metrics.ReportingInstances, metrics.Instances),
+			fmt.Sprintf("%.1f%%", metrics.CPU),
+			fmt.Sprintf("%s of %s", bytefmt.ByteSize(metrics.Memory), bytefmt.ByteSize(uint64(metrics.Application.Memory*metrics.Instances)*bytefmt.MEGABYTE)),
+			bytefmt.ByteSize(metrics.Disk),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Refreshing every {{.Interval}} seconds. Press Ctrl-C to stop.", map[string]interface{}{
+		"Interval": cmd.Interval,
+	})
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("top Command", func() {
+	var (
+		cmd             TopCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeTopActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeTopActor)
+
+		cmd = TopCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Interval:    1,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the interval is not positive", func() {
+		BeforeEach(func() {
+			cmd.Interval = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--interval",
+				ExpectedType: "a positive integer",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the metrics fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.GetContainerMetricsBySpaceReturns(nil, v2action.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("displays the warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				spaceGUID, _ := fakeActor.GetContainerMetricsBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the metrics are retrieved", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-refresh-error")
+				fakeActor.GetContainerMetricsBySpaceStub = func(string, v2action.NOAAClient) ([]v2action.ApplicationContainerMetrics, v2action.Warnings, error) {
+					if fakeActor.GetContainerMetricsBySpaceCallCount() > 1 {
+						return nil, nil, expectedErr
+					}
+					return []v2action.ApplicationContainerMetrics{
+						{
+							Application:        v2action.Application{Name: "api", Instances: 2, Memory: 512},
+							CPU:                12.5,
+							Memory:             768 * 1024 * 1024,
+							Disk:               100 * 1024 * 1024,
+							ReportingInstances: 2,
+						},
+						{
+							Application:        v2action.Application{Name: "web", Instances: 1, Memory: 1024},
+							CPU:                40,
+							Memory:             256 * 1024 * 1024,
+							Disk:               50 * 1024 * 1024,
+							ReportingInstances: 1,
+						},
+					}, v2action.Warnings{"some-warning"}, nil
+				}
+			})
+
+			Context("when sorting by cpu", func() {
+				It("displays the apps ranked by cpu until a refresh fails", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(fakeActor.GetContainerMetricsBySpaceCallCount()).To(Equal(2))
+
+					Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+					Expect(testUI.Out).To(Say("Showing resource usage of apps in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say(`name\s+instances\s+cpu\s+memory\s+disk`))
+					Expect(testUI.Out).To(Say(`web\s+1/1\s+%.1f%%\s+256M of 1G\s+50M`, 40.0))
+					Expect(testUI.Out).To(Say(`api\s+2/2\s+%.1f%%\s+768M of 1G\s+100M`, 12.5))
+					Expect(testUI.Out).To(Say("Refreshing every 1 seconds. Press Ctrl-C to stop."))
+					Expect(testUI.Out).To(Say("Showing resource usage of apps in org some-org / space some-space as some-user..."))
+					Expect(testUI.Err).To(Say("some-warning"))
+				})
+			})
+
+			Context("when sorting by memory", func() {
+				BeforeEach(func() {
+					cmd.Sort = flag.TopSort{Field: "memory"}
+				})
+
+				It("displays the apps ranked by memory", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Out).To(Say(`api\s+2/2\s+%.1f%%\s+768M of 1G\s+100M`, 12.5))
+					Expect(testUI.Out).To(Say(`web\s+1/1\s+%.1f%%\s+256M of 1G\s+50M`, 40.0))
+				})
+			})
+		})
+	})
+})
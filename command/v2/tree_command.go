@@ -0,0 +1,149 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . TreeActor
+
+type TreeActor interface {
+	GetFoundationTree(orgName string) ([]v2action.OrganizationTree, v2action.Warnings, error)
+}
+
+type TreeCommand struct {
+	Organization    string      `short:"o" description:"Only display the given org"`
+	JSON            bool        `long:"json" description:"Output the tree as JSON"`
+	usage           interface{} `usage:"CF_NAME tree [-o ORG] [--json]"`
+	relatedCommands interface{} `related_commands:"apps, orgs, services, spaces"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TreeActor
+}
+
+type treeOrgJSON struct {
+	Name   string          `json:"name"`
+	GUID   string          `json:"guid"`
+	Spaces []treeSpaceJSON `json:"spaces"`
+}
+
+type treeSpaceJSON struct {
+	Name     string         `json:"name"`
+	GUID     string         `json:"guid"`
+	Apps     []treeItemJSON `json:"apps"`
+	Services []treeItemJSON `json:"services"`
+}
+
+type treeItemJSON struct {
+	Name string `json:"name"`
+	GUID string `json:"guid"`
+}
+
+func (cmd *TreeCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd TreeCommand) Execute(args []string) error {
+	cmd.JSON = command.JSONOutputRequested(cmd.Config, cmd.JSON)
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.JSON {
+		return cmd.displayTreeJSON()
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Organization != "" {
+		cmd.UI.DisplayTextWithFlavor("Getting tree of org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Organization,
+			"Username": user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting tree of all orgs as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	trees, warnings, err := cmd.Actor.GetFoundationTree(cmd.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(trees) == 0 {
+		cmd.UI.DisplayText("No orgs found")
+		return nil
+	}
+
+	for _, org := range trees {
+		cmd.UI.DisplayText("org: {{.Name}}", map[string]interface{}{"Name": org.Name})
+		for _, space := range org.Spaces {
+			cmd.UI.DisplayText("  space: {{.Name}}", map[string]interface{}{"Name": space.Name})
+			for _, app := range space.Applications {
+				cmd.UI.DisplayText("    app: {{.Name}}", map[string]interface{}{"Name": app.Name})
+			}
+			for _, serviceInstance := range space.ServiceInstances {
+				cmd.UI.DisplayText("    service: {{.Name}}", map[string]interface{}{"Name": serviceInstance.Name})
+			}
+		}
+	}
+
+	return nil
+}
+
+func (cmd TreeCommand) displayTreeJSON() error {
+	trees, warnings, err := cmd.Actor.GetFoundationTree(cmd.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	orgs := []treeOrgJSON{}
+	for _, org := range trees {
+		orgJSON := treeOrgJSON{Name: org.Name, GUID: org.GUID, Spaces: []treeSpaceJSON{}}
+		for _, space := range org.Spaces {
+			spaceJSON := treeSpaceJSON{Name: space.Name, GUID: space.GUID, Apps: []treeItemJSON{}, Services: []treeItemJSON{}}
+			for _, app := range space.Applications {
+				spaceJSON.Apps = append(spaceJSON.Apps, treeItemJSON{Name: app.Name, GUID: app.GUID})
+			}
+			for _, serviceInstance := range space.ServiceInstances {
+				spaceJSON.Services = append(spaceJSON.Services, treeItemJSON{Name: serviceInstance.Name, GUID: serviceInstance.GUID})
+			}
+			orgJSON.Spaces = append(orgJSON.Spaces, spaceJSON)
+		}
+		orgs = append(orgs, orgJSON)
+	}
+
+	output, err := json.MarshalIndent(orgs, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
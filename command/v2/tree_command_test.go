@@ -0,0 +1,218 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("tree Command", func() {
+	var (
+		cmd             TreeCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeTreeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeTreeActor)
+
+		cmd = TreeCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetFoundationTreeReturns(
+			[]v2action.OrganizationTree{
+				{
+					Organization: v2action.Organization{GUID: "org-guid-1", Name: "org-1"},
+					Spaces: []v2action.SpaceTree{
+						{
+							Space:            v2action.Space{GUID: "space-guid-1", Name: "space-1"},
+							Applications:     []v2action.Application{{GUID: "app-guid-1", Name: "app-1"}},
+							ServiceInstances: []v2action.ServiceInstance{{GUID: "service-instance-guid-1", Name: "service-instance-1"}},
+						},
+						{
+							Space: v2action.Space{GUID: "space-guid-2", Name: "space-2"},
+						},
+					},
+				},
+				{
+					Organization: v2action.Organization{GUID: "org-guid-2", Name: "org-2"},
+				},
+			},
+			v2action.Warnings{"tree-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+
+	Context("when no org is provided", func() {
+		It("displays the tree of every org and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting tree of all orgs as some-user..."))
+			Expect(testUI.Out).To(Say("org: org-1\n"))
+			Expect(testUI.Out).To(Say("  space: space-1\n"))
+			Expect(testUI.Out).To(Say("    app: app-1\n"))
+			Expect(testUI.Out).To(Say("    service: service-instance-1\n"))
+			Expect(testUI.Out).To(Say("  space: space-2\n"))
+			Expect(testUI.Out).To(Say("org: org-2\n"))
+			Expect(testUI.Err).To(Say("tree-warning"))
+
+			Expect(fakeActor.GetFoundationTreeCallCount()).To(Equal(1))
+			Expect(fakeActor.GetFoundationTreeArgsForCall(0)).To(BeEmpty())
+		})
+	})
+
+	Context("when an org is provided", func() {
+		BeforeEach(func() {
+			cmd.Organization = "org-1"
+		})
+
+		It("displays the tree of the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting tree of org org-1 as some-user..."))
+			Expect(fakeActor.GetFoundationTreeArgsForCall(0)).To(Equal("org-1"))
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetFoundationTreeReturns(nil, v2action.Warnings{"tree-warning"}, v2action.OrganizationNotFoundError{Name: "org-1"})
+			})
+
+			It("returns an OrganizationNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(shared.OrganizationNotFoundError{Name: "org-1"}))
+				Expect(testUI.Err).To(Say("tree-warning"))
+			})
+		})
+	})
+
+	Context("when there are no orgs", func() {
+		BeforeEach(func() {
+			fakeActor.GetFoundationTreeReturns(nil, nil, nil)
+		})
+
+		It("displays that no orgs were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No orgs found"))
+		})
+	})
+
+	Context("when the --json flag is provided", func() {
+		BeforeEach(func() {
+			cmd.JSON = true
+		})
+
+		It("displays the tree as JSON without flavor text", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting tree"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+				{
+					"name": "org-1",
+					"guid": "org-guid-1",
+					"spaces": [
+						{
+							"name": "space-1",
+							"guid": "space-guid-1",
+							"apps": [{"name": "app-1", "guid": "app-guid-1"}],
+							"services": [{"name": "service-instance-1", "guid": "service-instance-guid-1"}]
+						},
+						{
+							"name": "space-2",
+							"guid": "space-guid-2",
+							"apps": [],
+							"services": []
+						}
+					]
+				},
+				{
+					"name": "org-2",
+					"guid": "org-guid-2",
+					"spaces": []
+				}
+			]`))
+			Expect(testUI.Err).To(Say("tree-warning"))
+		})
+
+		Context("when there are no orgs", func() {
+			BeforeEach(func() {
+				fakeActor.GetFoundationTreeReturns(nil, nil, nil)
+			})
+
+			It("displays an empty JSON list", func() {
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[]`))
+			})
+		})
+
+		Context("when getting the tree fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("tree error")
+				fakeActor.GetFoundationTreeReturns(nil, v2action.Warnings{"tree-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("tree-warning"))
+			})
+		})
+	})
+})
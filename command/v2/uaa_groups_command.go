@@ -0,0 +1,93 @@
+package v2
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . UAAGroupsActor
+
+type UAAGroupsActor interface {
+	GetUAAGroups() ([]v2action.UAAGroup, error)
+}
+
+type UAAGroupsCommand struct {
+	ZoneID          string      `long:"zone-id" description:"ID of the UAA identity zone to list the groups of"`
+	ZoneSubdomain   string      `long:"zone-subdomain" description:"Subdomain of the UAA identity zone to list the groups of"`
+	usage           interface{} `usage:"CF_NAME uaa-groups [--zone-id ZONE_ID | --zone-subdomain SUBDOMAIN]\n\n   Members of a UAA group are granted the scope named after the group."`
+	relatedCommands interface{} `related_commands:"add-user-to-group, remove-user-from-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UAAGroupsActor
+}
+
+func (cmd *UAAGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	uaaClient.TargetIdentityZone(uaa.IdentityZone{ID: cmd.ZoneID, Subdomain: cmd.ZoneSubdomain})
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd UAAGroupsCommand) Execute(args []string) error {
+	if cmd.ZoneID != "" && cmd.ZoneSubdomain != "" {
+		return command.ArgumentCombinationError{Arg1: "--zone-id", Arg2: "--zone-subdomain"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting UAA groups as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	groups, err := cmd.Actor.GetUAAGroups()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(groups) == 0 {
+		cmd.UI.DisplayText("No UAA groups found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("description"),
+			cmd.UI.TranslateText("members"),
+		},
+	}
+	for _, group := range groups {
+		table = append(table, []string{
+			group.DisplayName,
+			group.Description,
+			strconv.Itoa(len(group.Members)),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
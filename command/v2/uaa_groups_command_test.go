@@ -0,0 +1,120 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("uaa-groups Command", func() {
+	var (
+		cmd             UAAGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUAAGroupsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUAAGroupsActor)
+
+		cmd = UAAGroupsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetUAAGroupsReturns([]v2action.UAAGroup{
+			{
+				DisplayName: "cloud_controller.admin",
+				Description: "Cloud Controller admins",
+				Members:     []uaa.GroupMember{{Value: "user-id-1"}, {Value: "user-id-2"}},
+			},
+			{DisplayName: "scim.read"},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both --zone-id and --zone-subdomain are provided", func() {
+		BeforeEach(func() {
+			cmd.ZoneID = "some-zone-id"
+			cmd.ZoneSubdomain = "some-subdomain"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--zone-id", Arg2: "--zone-subdomain"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetUAAGroupsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("displays the groups with their member counts", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say("Getting UAA groups as some-user..."))
+		Expect(testUI.Out).To(Say(`name\s+description\s+members`))
+		Expect(testUI.Out).To(Say(`cloud_controller\.admin\s+Cloud Controller admins\s+2`))
+		Expect(testUI.Out).To(Say(`scim\.read\s+0`))
+	})
+
+	Context("when there are no groups", func() {
+		BeforeEach(func() {
+			fakeActor.GetUAAGroupsReturns(nil, nil)
+		})
+
+		It("displays that no groups were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No UAA groups found"))
+		})
+	})
+
+	Context("when getting the groups fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("groups-error")
+			fakeActor.GetUAAGroupsReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
@@ -16,11 +16,13 @@ import (
 type UnbindSecurityGroupActor interface {
 	UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string) (v2action.Warnings, error)
 	UnbindSecurityGroupByNameOrganizationNameAndSpaceName(securityGroupName string, orgName string, spaceName string) (v2action.Warnings, error)
+	UnbindSecurityGroupFromAllSpaces(securityGroupName string) ([]v2action.SecurityGroupSpace, v2action.Warnings, error)
 }
 
 type UnbindSecurityGroupCommand struct {
 	RequiredArgs    flag.UnbindSecurityGroupArgs `positional-args:"yes"`
-	usage           interface{}                  `usage:"CF_NAME unbind-security-group SECURITY_GROUP ORG SPACE\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
+	All             bool                         `long:"all" description:"Unbind the security group from every space it is bound to"`
+	usage           interface{}                  `usage:"CF_NAME unbind-security-group SECURITY_GROUP ORG SPACE\n   CF_NAME unbind-security-group SECURITY_GROUP --all\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
 	relatedCommands interface{}                  `related_commands:"apps, restart, security-groups"`
 
 	UI          command.UI
@@ -34,6 +36,10 @@ func (cmd *UnbindSecurityGroupCommand) Setup(config command.Config, ui command.U
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
+	if command.UsesLegacyImplementation(cmd.Config, "unbind-security-group", cmd.All) {
+		return nil
+	}
+
 	ccClient, _, err := shared.NewClients(config, ui, true)
 	if err != nil {
 		return err
@@ -44,7 +50,11 @@ func (cmd *UnbindSecurityGroupCommand) Setup(config command.Config, ui command.U
 }
 
 func (cmd UnbindSecurityGroupCommand) Execute(args []string) error {
-	if cmd.Config.Experimental() == false {
+	if cmd.All {
+		return cmd.unbindFromAllSpaces()
+	}
+
+	if command.UsesLegacyImplementation(cmd.Config, "unbind-security-group", cmd.All) {
 		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
 		return nil
 	}
@@ -108,3 +118,68 @@ func (cmd UnbindSecurityGroupCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd UnbindSecurityGroupCommand) unbindFromAllSpaces() error {
+	if cmd.RequiredArgs.OrganizationName != "" {
+		return command.ArgumentCombinationError{Arg1: "--all", Arg2: "ORG"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Unbinding security group {{.SecurityGroupName}} from all spaces as {{.Username}}...", map[string]interface{}{
+		"SecurityGroupName": cmd.RequiredArgs.SecurityGroupName,
+		"Username":          user.Name,
+	})
+
+	removed, warnings, err := cmd.Actor.UnbindSecurityGroupFromAllSpaces(cmd.RequiredArgs.SecurityGroupName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.displayRemovedBindings(removed)
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(removed) == 0 {
+		cmd.UI.DisplayText("Security group {{.SecurityGroupName}} was not bound to any spaces.", map[string]interface{}{
+			"SecurityGroupName": cmd.RequiredArgs.SecurityGroupName,
+		})
+		return nil
+	}
+
+	cmd.displayRemovedBindings(removed)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Changes will not apply to existing running applications until they are restarted.")
+
+	return nil
+}
+
+func (cmd UnbindSecurityGroupCommand) displayRemovedBindings(removed []v2action.SecurityGroupSpace) {
+	if len(removed) == 0 {
+		return
+	}
+
+	cmd.UI.DisplayText("Removed {{.Count}} space binding(s):", map[string]interface{}{
+		"Count": len(removed),
+	})
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("organization"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+	for _, space := range removed {
+		table = append(table, []string{space.OrganizationName, space.SpaceName})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
@@ -212,4 +212,110 @@ var _ = Describe("unbind-security-group Command", func() {
 
 		})
 	})
+
+	Context("when --all is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.SecurityGroupName = "some-security-group"
+			cmd.All = true
+			fakeConfig.ExperimentalReturns(false)
+		})
+
+		Context("when an org is also provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.OrganizationName = "some-org"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--all", Arg2: "ORG"}))
+				Expect(fakeActor.UnbindSecurityGroupFromAllSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+		})
+
+		Context("when the security group is bound to spaces", func() {
+			BeforeEach(func() {
+				fakeActor.UnbindSecurityGroupFromAllSpacesReturns(
+					[]v2action.SecurityGroupSpace{
+						{OrganizationName: "org-1", SpaceName: "space-1", Lifecycle: "running"},
+						{OrganizationName: "org-2", SpaceName: "space-2", Lifecycle: "running"},
+					},
+					v2action.Warnings{"unbind-warning"},
+					nil)
+			})
+
+			It("unbinds it from every space and summarizes the removed bindings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.UnbindSecurityGroupFromAllSpacesCallCount()).To(Equal(1))
+				Expect(fakeActor.UnbindSecurityGroupFromAllSpacesArgsForCall(0)).To(Equal("some-security-group"))
+
+				Expect(testUI.Out).ToNot(Say("This command is in EXPERIMENTAL stage"))
+				Expect(testUI.Out).To(Say("Unbinding security group some-security-group from all spaces as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Removed 2 space binding\\(s\\):"))
+				Expect(testUI.Out).To(Say("organization\\s+space"))
+				Expect(testUI.Out).To(Say("org-1\\s+space-1"))
+				Expect(testUI.Out).To(Say("org-2\\s+space-2"))
+				Expect(testUI.Out).To(Say("TIP: Changes will not apply to existing running applications until they are restarted."))
+				Expect(testUI.Err).To(Say("unbind-warning"))
+			})
+		})
+
+		Context("when the security group is not bound to any spaces", func() {
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Security group some-security-group was not bound to any spaces."))
+				Expect(testUI.Out).ToNot(Say("TIP"))
+			})
+		})
+
+		Context("when unbinding fails part way", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unbind-error")
+				fakeActor.UnbindSecurityGroupFromAllSpacesReturns(
+					[]v2action.SecurityGroupSpace{
+						{OrganizationName: "org-1", SpaceName: "space-1", Lifecycle: "running"},
+					},
+					v2action.Warnings{"unbind-warning"},
+					expectedErr)
+			})
+
+			It("shows the bindings removed so far and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Out).To(Say("Removed 1 space binding\\(s\\):"))
+				Expect(testUI.Out).To(Say("org-1\\s+space-1"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(testUI.Err).To(Say("unbind-warning"))
+			})
+		})
+
+		Context("when the security group does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.UnbindSecurityGroupFromAllSpacesReturns(
+					nil,
+					nil,
+					v2action.SecurityGroupNotFoundError{Name: "some-security-group"})
+			})
+
+			It("returns a SecurityGroupNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.SecurityGroupNotFoundError{Name: "some-security-group"}))
+			})
+		})
+	})
 })
@@ -1,24 +1,152 @@
 package v2
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . UpdateSecurityGroupActor
+
+type UpdateSecurityGroupActor interface {
+	GetSecurityGroupRulesDiff(name string, rules []v2action.SecurityGroupRuleDefinition) (v2action.SecurityGroupRulesDiff, v2action.Warnings, error)
+	ReadSecurityGroupRulesFile(path string) ([]v2action.SecurityGroupRuleDefinition, error)
+	UpdateSecurityGroupRules(name string, rules []v2action.SecurityGroupRuleDefinition) (v2action.Warnings, error)
+}
+
 type UpdateSecurityGroupCommand struct {
 	RequiredArgs    flag.SecurityGroupArgs `positional-args:"yes"`
-	usage           interface{}            `usage:"CF_NAME update-security-group SECURITY_GROUP PATH_TO_JSON_RULES_FILE\n\n   The provided path can be an absolute or relative path to a file.\n   It should have a single array with JSON objects inside describing the rules.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
+	DryRun          bool                   `long:"dry-run" description:"Show the changes to the rules without applying them"`
+	usage           interface{}            `usage:"CF_NAME update-security-group SECURITY_GROUP PATH_TO_RULES_FILE [--dry-run]\n\n   The provided path can be an absolute or relative path to a file.\n   It should have a single array with JSON objects inside describing the rules.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]\n\n   Files ending in .yml or .yaml may instead contain a YAML list of rules with the same keys.\n   Files ending in .csv may instead contain one rule per row, with a header row naming the\n   columns protocol, destination, ports, type, code, description and log.\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
 	relatedCommands interface{}            `related_commands:"restage, security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateSecurityGroupActor
 }
 
-func (_ UpdateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *UpdateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	// Reading the rules file does not need any client.
+	if command.UsesLegacyImplementation(cmd.Config, "update-security-group", !isJSONRulesFile(string(cmd.RequiredArgs.PathToRulesFile)), cmd.DryRun) {
+		cmd.Actor = v2action.NewActor(nil, nil)
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ UpdateSecurityGroupCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd UpdateSecurityGroupCommand) Execute(args []string) error {
+	rules, err := cmd.Actor.ReadSecurityGroupRulesFile(string(cmd.RequiredArgs.PathToRulesFile))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if command.UsesLegacyImplementation(cmd.Config, "update-security-group", !isJSONRulesFile(string(cmd.RequiredArgs.PathToRulesFile)), cmd.DryRun) {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting changes to security group {{.SecurityGroupName}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+		"Username":          user.Name,
+	})
+
+	diff, warnings, err := cmd.Actor.GetSecurityGroupRulesDiff(cmd.RequiredArgs.SecurityGroup, rules)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	if diff.Empty() {
+		cmd.UI.DisplayText("No changes to rules")
+		return nil
+	}
+	cmd.displayRulesDiff(diff)
+
+	if cmd.DryRun {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Updating security group {{.SecurityGroupName}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+		"Username":          user.Name,
+	})
+
+	warnings, err = cmd.Actor.UpdateSecurityGroupRules(cmd.RequiredArgs.SecurityGroup, rules)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Changes will not apply to existing running applications until they are restarted.")
+
 	return nil
 }
+
+func (cmd UpdateSecurityGroupCommand) displayRulesDiff(diff v2action.SecurityGroupRulesDiff) {
+	for _, rule := range diff.Removed {
+		cmd.UI.DisplayDiffRemoval(formatSecurityGroupRule(rule))
+	}
+	for _, change := range diff.Changed {
+		cmd.UI.DisplayDiffRemoval(formatSecurityGroupRule(change.From))
+		cmd.UI.DisplayDiffAddition(formatSecurityGroupRule(change.To))
+	}
+	for _, rule := range diff.Added {
+		cmd.UI.DisplayDiffAddition(formatSecurityGroupRule(rule))
+	}
+}
+
+func formatSecurityGroupRule(rule v2action.SecurityGroupRuleDefinition) string {
+	fields := []string{
+		fmt.Sprintf("protocol: %s", rule.Protocol),
+		fmt.Sprintf("destination: %s", rule.Destination),
+	}
+	if rule.Ports != "" {
+		fields = append(fields, fmt.Sprintf("ports: %s", rule.Ports))
+	}
+	if rule.Type.IsSet {
+		fields = append(fields, fmt.Sprintf("type: %d", rule.Type.Value))
+	}
+	if rule.Code.IsSet {
+		fields = append(fields, fmt.Sprintf("code: %d", rule.Code.Value))
+	}
+	if rule.Log {
+		fields = append(fields, "log: true")
+	}
+	if rule.Description != "" {
+		fields = append(fields, fmt.Sprintf("description: %s", rule.Description))
+	}
+	return strings.Join(fields, ", ")
+}
@@ -0,0 +1,204 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-security-group Command", func() {
+	var (
+		cmd             UpdateSecurityGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateSecurityGroupActor
+		rules           []v2action.SecurityGroupRuleDefinition
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateSecurityGroupActor)
+
+		cmd = UpdateSecurityGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			RequiredArgs: flag.SecurityGroupArgs{
+				SecurityGroup:   "some-security-group",
+				PathToRulesFile: "some-rules.csv",
+			},
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		rules = []v2action.SecurityGroupRuleDefinition{{Protocol: "all", Destination: "10.0.0.1"}}
+		fakeActor.ReadSecurityGroupRulesFileReturns(rules, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the rules file cannot be parsed", func() {
+		BeforeEach(func() {
+			fakeActor.ReadSecurityGroupRulesFileReturns(nil, v2action.InvalidSecurityGroupRulesFileError{
+				Path: "some-rules.csv",
+				Err:  errors.New(`unknown column "port"`),
+			})
+		})
+
+		It("returns an InvalidSecurityGroupRulesFileError without updating the security group", func() {
+			Expect(executeErr).To(MatchError(shared.InvalidSecurityGroupRulesFileError{
+				Path:    "some-rules.csv",
+				Message: `unknown column "port"`,
+			}))
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the security group does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupRulesDiffReturns(v2action.SecurityGroupRulesDiff{}, v2action.Warnings{"some-warning"}, v2action.SecurityGroupNotFoundError{Name: "some-security-group"})
+		})
+
+		It("displays the warnings and returns a SecurityGroupNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.SecurityGroupNotFoundError{Name: "some-security-group"}))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the rules are unchanged", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupRulesDiffReturns(v2action.SecurityGroupRulesDiff{}, v2action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays that there are no changes without updating the security group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting changes to security group some-security-group as some-user..."))
+			Expect(testUI.Out).To(Say("No changes to rules"))
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the rules change", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupRulesDiffReturns(v2action.SecurityGroupRulesDiff{
+				Removed: []v2action.SecurityGroupRuleDefinition{
+					{
+						Protocol:    "icmp",
+						Destination: "0.0.0.0/0",
+						Type:        types.NullInt{IsSet: true, Value: -1},
+						Code:        types.NullInt{IsSet: true, Value: -1},
+					},
+				},
+				Changed: []v2action.SecurityGroupRuleChange{
+					{
+						From: v2action.SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80"},
+						To:   v2action.SecurityGroupRuleDefinition{Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80,8080", Log: true},
+					},
+				},
+				Added: []v2action.SecurityGroupRuleDefinition{
+					{Protocol: "all", Destination: "10.0.0.1", Description: "some-description"},
+				},
+			}, v2action.Warnings{"diff-warning"}, nil)
+		})
+
+		Context("when --dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the changes without updating the security group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Getting changes to security group some-security-group as some-user..."))
+				Expect(testUI.Out).To(Say(`- protocol: icmp, destination: 0.0.0.0/0, type: -1, code: -1`))
+				Expect(testUI.Out).To(Say(`- protocol: tcp, destination: 10.0.1.0/24, ports: 80\n`))
+				Expect(testUI.Out).To(Say(`\+ protocol: tcp, destination: 10.0.1.0/24, ports: 80,8080, log: true`))
+				Expect(testUI.Out).To(Say(`\+ protocol: all, destination: 10.0.0.1, description: some-description`))
+				Expect(testUI.Out).ToNot(Say("Updating security group"))
+				Expect(testUI.Err).To(Say("diff-warning"))
+
+				name, diffRules := fakeActor.GetSecurityGroupRulesDiffArgsForCall(0)
+				Expect(name).To(Equal("some-security-group"))
+				Expect(diffRules).To(Equal(rules))
+				Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+			})
+
+			Context("when the rules file is a JSON file", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.PathToRulesFile = "some-rules.json"
+				})
+
+				It("displays the changes with the refactored implementation", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.GetSecurityGroupRulesDiffCallCount()).To(Equal(1))
+					Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the rules file is a JSON file and the refactored implementation is enabled", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.PathToRulesFile = "some-rules.json"
+				fakeConfig.RefactoredCommandsReturns([]string{"update-security-group"})
+			})
+
+			It("updates the security group with the refactored implementation", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when updating the security group fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.UpdateSecurityGroupRulesReturns(v2action.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("displays the warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
+		Context("when the security group is updated", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateSecurityGroupRulesReturns(v2action.Warnings{"some-warning"}, nil)
+			})
+
+			It("displays the changes and replaces the rules of the security group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\+ protocol: all, destination: 10.0.0.1, description: some-description`))
+				Expect(testUI.Out).To(Say("Updating security group some-security-group as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("TIP: Changes will not apply to existing running applications until they are restarted."))
+				Expect(testUI.Err).To(Say("diff-warning"))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.ReadSecurityGroupRulesFileArgsForCall(0)).To(Equal("some-rules.csv"))
+				name, updatedRules := fakeActor.UpdateSecurityGroupRulesArgsForCall(0)
+				Expect(name).To(Equal("some-security-group"))
+				Expect(updatedRules).To(Equal(rules))
+			})
+		})
+	})
+})
@@ -1,15 +1,23 @@
 package v2
 
 import (
+	"context"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/exitcode"
+	"code.cloudfoundry.org/cli/util/ui"
 	log "github.com/Sirupsen/logrus"
 	"github.com/cloudfoundry/noaa/consumer"
 )
@@ -17,44 +25,57 @@ import (
 //go:generate counterfeiter . V2PushActor
 
 type V2PushActor interface {
-	Apply(config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
-	ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	AcquirePushLock(config pushaction.ApplicationConfig, owner string, force bool) (pushaction.Warnings, error)
+	Apply(ctx context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	CloudControllerAPIVersion() string
+	ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
+	ReadManifest(pathToManifest string) ([]manifest.Application, error)
+	ReleasePushLock(config pushaction.ApplicationConfig, owner string) (pushaction.Warnings, error)
+	RunPreStartTask(config pushaction.ApplicationConfig, command string) (pushaction.Warnings, error)
 }
 
 type V2PushCommand struct {
 	OptionalArgs         flag.AppName                `positional-args:"yes"`
 	BuildpackName        string                      `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
 	StartupCommand       string                      `short:"c" description:"Startup command, set to null to reset to default start command"`
+	DeleteOrphanedRoutes bool                        `long:"delete-orphaned-routes" description:"Delete the routes unmapped by --prune-routes that are not mapped to any other app; implies --prune-routes"`
 	Domain               string                      `short:"d" description:"Domain (e.g. example.com)"`
 	DockerImage          string                      `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
 	PathToManifest       flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
 	HealthCheckType      flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
 	Hostname             string                      `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
-	NumInstances         int                         `short:"i" description:"Number of instances"`
-	DiskLimit            string                      `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	MemoryLimit          string                      `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NumInstances         flag.Instances              `short:"i" description:"Number of instances"`
+	DiskLimit            flag.Megabytes              `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	FailFast             bool                        `long:"fail-fast" description:"When pushing multiple apps, stop at the first app that fails instead of pushing the remaining apps"`
+	Lock                 bool                        `long:"lock" description:"Lock the app while pushing, so that other pushes using --lock fail instead of changing it at the same time"`
+	ForceLock            bool                        `long:"force-lock" description:"Take over the lock held by another push; implies --lock"`
+	MemoryLimit          flag.Megabytes              `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	NoHostname           bool                        `long:"no-hostname" description:"Map the root domain to this app"`
 	NoManifest           bool                        `long:"no-manifest" description:"Ignore manifest file"`
 	NoRoute              bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
 	NoStart              bool                        `long:"no-start" description:"Do not start an app after pushing"`
 	DirectoryPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	PruneRoutes          bool                        `long:"prune-routes" description:"Unmap the routes of the app that are not in the manifest or flags"`
+	PruneServices        bool                        `long:"prune-services" description:"Unbind the service instances of the app that are not in the manifest; requires a manifest that lists the services of the app"`
 	RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
+	Reset                bool                        `long:"reset" description:"Replace the environment variables, services and number of instances of an existing app with the ones in the manifest instead of merging them; implies --prune-services and requires a manifest"`
 	RoutePath            string                      `long:"route-path" description:"Path for the route"`
+	RunTask              string                      `long:"run-task" description:"Command to run as a task from the newly staged droplet before the app is started, e.g. a database migration. The push is aborted if the task fails"`
 	Stack                string                      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	ApplicationStartTime int                         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
 
-	usage               interface{} `usage:"Push a single app (with or without a manifest):\n   CF_NAME v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-d DOMAIN] [-f MANIFEST_PATH] [--docker-image DOCKER_IMAGE]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [--hostname HOST] [-p PATH] [-s STACK] [-t TIMEOUT] [-u (process | port | http)] [--route-path ROUTE_PATH]\n   [--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n\n   Push multiple apps with a manifest:\n   cf v2-push [-f MANIFEST_PATH]"`
+	usage               interface{} `usage:"Push a single app (with or without a manifest):\n   CF_NAME v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-d DOMAIN] [-f MANIFEST_PATH] [--docker-image DOCKER_IMAGE]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [--hostname HOST] [-p PATH] [-s STACK] [-t TIMEOUT] [-u (process | port | http)] [--route-path ROUTE_PATH]\n   [--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route] [--lock | --force-lock] [--reset]\n   [--run-task COMMAND] [--prune-routes] [--delete-orphaned-routes] [--prune-services]\n\n   Push multiple apps with a manifest:\n   cf v2-push [-f MANIFEST_PATH] [--fail-fast] [--prune-routes] [--delete-orphaned-routes] [--prune-services]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	relatedCommands     interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
-	UI          command.UI
-	Config      command.Config
-	SharedActor command.SharedActor
-	Actor       V2PushActor
-	StartActor  StartActor
-	NOAAClient  *consumer.Consumer
+	UI           command.UI
+	Config       command.Config
+	SharedActor  command.SharedActor
+	Actor        V2PushActor
+	RestartActor RestartActor
+	NOAAClient   *consumer.Consumer
 }
 
 func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
@@ -62,16 +83,34 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
-	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	ccClient, uaaClient, err := shared.NewInterruptibleClients(config, ui, true)
 	if err != nil {
 		return err
 	}
 	v2Actor := v2action.NewActor(ccClient, uaaClient)
-	cmd.StartActor = v2Actor
-	cmd.Actor = pushaction.NewActor(v2Actor)
+	cmd.RestartActor = v2Actor
+	v3Actor, err := newPushV3Actor(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pushaction.NewActor(v2Actor, v3Actor)
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 	return nil
 }
 
+// newPushV3Actor returns the actor used to set process health checks, or nil
+// when the V3 API is not available.
+func newPushV3Actor(config command.Config, ui command.UI) (pushaction.V3Actor, error) {
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return v3action.NewActor(ccClientV3, config), nil
+}
+
 func (cmd V2PushCommand) Execute(args []string) error {
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 
@@ -80,6 +119,13 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.HealthCheckType.Type == "http" {
+		err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionHTTPEndpointHealthCheckV2, "Option '--health-check-type=http'")
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Info("collating flags")
 	cliSettings, err := cmd.GetCommandLineSettings()
 	if err != nil {
@@ -87,9 +133,28 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	//TODO: Read in manifest
+	log.Info("reading manifest")
+	manifests, err := cmd.readManifest(cliSettings)
+	if err != nil {
+		log.Errorln("reading manifest:", err)
+		return shared.HandleError(err)
+	}
+	if cmd.Reset && len(manifests) == 0 {
+		return shared.ResetWithoutManifestError{}
+	}
+
+	if cmd.PruneServices {
+		if len(manifests) == 0 {
+			return shared.PruneServicesWithoutServicesError{AppName: cliSettings.Name}
+		}
+		err = validatePruneServices(manifests)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Info("merging manifest and command flags")
-	manifestApplications, err := cmd.Actor.MergeAndValidateSettingsAndManifests(cliSettings, nil)
+	manifestApplications, err := cmd.Actor.MergeAndValidateSettingsAndManifests(cliSettings, manifests)
 	if err != nil {
 		log.Errorln("merging manifest:", err)
 		return shared.HandleError(err)
@@ -102,6 +167,7 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		cmd.Config.TargetedOrganization().GUID,
 		cmd.Config.TargetedSpace().GUID,
 		manifestApplications,
+		cmd.Reset,
 	)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -109,16 +175,271 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	for i := range appConfigs {
+		appConfigs[i].PruneRoutes = cmd.PruneRoutes || cmd.DeleteOrphanedRoutes
+		appConfigs[i].DeleteOrphanedRoutes = cmd.DeleteOrphanedRoutes
+		appConfigs[i].PruneServices = appConfigs[i].PruneServices || cmd.PruneServices
+	}
+
+	lockOwner, err := cmd.pushLockOwner()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return cmd.pushApps(appConfigs, cmd.FailFast, func(ctx context.Context, appConfig pushaction.ApplicationConfig) error {
+		return cmd.applyWithLock(ctx, appConfig, lockOwner)
+	})
+}
+
+// readManifest returns the applications of the manifest provided with -f, or
+// of the manifest.yml in the current directory when there is one. Nothing is
+// read with --no-manifest. When an app name is provided, only that app of the
+// manifest is returned; the app of a single app manifest takes that name.
+func (cmd V2PushCommand) readManifest(cliSettings pushaction.CommandLineSettings) ([]manifest.Application, error) {
+	if cmd.NoManifest {
+		return nil, nil
+	}
+
+	pathToManifest := string(cmd.PathToManifest)
+	if pathToManifest == "" {
+		pathToManifest = filepath.Join(cliSettings.Path, "manifest.yml")
+		if _, err := os.Stat(pathToManifest); os.IsNotExist(err) {
+			log.Debugln("no manifest found in", cliSettings.Path)
+			return nil, nil
+		}
+	} else if info, err := os.Stat(pathToManifest); err == nil && info.IsDir() {
+		pathToManifest = filepath.Join(pathToManifest, "manifest.yml")
+	}
+
+	apps, err := cmd.Actor.ReadManifest(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	if cliSettings.Name == "" {
+		return apps, nil
+	}
+	for _, app := range apps {
+		if app.Name == cliSettings.Name {
+			return []manifest.Application{app}, nil
+		}
+	}
+	if len(apps) == 1 {
+		apps[0].Name = cliSettings.Name
+		return apps, nil
+	}
+	return nil, shared.AppNotFoundInManifestError{AppName: cliSettings.Name, ManifestPath: pathToManifest}
+}
+
+// pushApps applies the configs in order with apply. When there are several
+// apps, an app that fails does not stop the push of the remaining apps unless
+// failFast is set, and a summary of the results is displayed at the end. The
+// push always stops when it is interrupted.
+func (cmd V2PushCommand) pushApps(appConfigs []pushaction.ApplicationConfig, failFast bool, apply func(ctx context.Context, appConfig pushaction.ApplicationConfig) error) error {
+	ctx := command.InterruptContext()
+
+	var results []appPushResult
 	for _, appConfig := range appConfigs {
 		log.Infoln("starting create/update:", appConfig.DesiredApplication.Name)
-		eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig)
-		err := cmd.processApplyStreams(appConfig, eventStream, warningsStream, errorStream)
+		err := apply(ctx, appConfig)
 		if err != nil {
-			return shared.HandleError(err)
+			if err == context.Canceled || ctx.Err() != nil || failFast || len(appConfigs) == 1 {
+				return shared.HandleError(err)
+			}
+
+			err = shared.HandleError(err)
+			log.Errorln("pushing app:", appConfig.DesiredApplication.Name, err)
+			cmd.UI.DisplayError(err)
+			results = append(results, appPushResult{AppName: appConfig.DesiredApplication.Name, Err: err})
+			continue
 		}
-		//TODO call start / display App
+		results = append(results, appPushResult{AppName: appConfig.DesiredApplication.Name})
 	}
 
+	if len(appConfigs) > 1 {
+		return cmd.displayPushSummary(results)
+	}
+	return nil
+}
+
+// validatePruneServices returns an error when one of the apps does not list
+// its services, since pruning would unbind all of the services of that app.
+func validatePruneServices(apps []manifest.Application) error {
+	for _, app := range apps {
+		if len(app.Services) == 0 {
+			return shared.PruneServicesWithoutServicesError{AppName: app.Name}
+		}
+	}
+	return nil
+}
+
+// appPushResult is the outcome of pushing one of the apps of a manifest.
+type appPushResult struct {
+	AppName string
+	Err     error
+}
+
+// displayPushSummary displays whether each app was pushed, with the reason
+// for the apps that failed, and returns an error naming the failed apps. The
+// error exits with the code of the failures when they all have the same one.
+func (cmd V2PushCommand) displayPushSummary(results []appPushResult) error {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("reason"),
+		},
+	}
+
+	var (
+		failedApps []string
+		code       int
+	)
+	for _, result := range results {
+		if result.Err == nil {
+			table = append(table, []string{result.AppName, cmd.UI.TranslateText("pushed"), ""})
+			continue
+		}
+
+		failedApps = append(failedApps, result.AppName)
+		if resultCode := exitcode.Of(result.Err); code == 0 {
+			code = resultCode
+		} else if code != resultCode {
+			code = exitcode.Failed
+		}
+		reason := strings.SplitN(cmd.translateError(result.Err), "\n", 2)[0]
+		table = append(table, []string{result.AppName, cmd.UI.TranslateText("failed"), reason})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if len(failedApps) > 0 {
+		return shared.AppsPushFailedError{AppNames: failedApps, Total: len(results), Code: code}
+	}
+	return nil
+}
+
+// translateError returns the message that DisplayError would display for the
+// error.
+func (cmd V2PushCommand) translateError(err error) string {
+	translatableError, ok := err.(ui.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatableError.Translate(func(template string, values ...interface{}) string {
+		if len(values) > 0 {
+			if templateValues, ok := values[0].(map[string]interface{}); ok {
+				return cmd.UI.TranslateText(template, templateValues)
+			}
+		}
+		return cmd.UI.TranslateText(template)
+	})
+}
+
+// pushLockOwner returns the owner recorded in the push locks, or an empty
+// string when locking is not requested.
+func (cmd V2PushCommand) pushLockOwner() (string, error) {
+	if !cmd.Lock && !cmd.ForceLock {
+		return "", nil
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return "", err
+	}
+	hostname, _ := os.Hostname()
+	return v3action.PushLockOwner(user.Name, hostname), nil
+}
+
+// applyWithLock applies the config while holding the push lock of the app
+// when an owner is provided. The lock is released even if the push fails.
+func (cmd V2PushCommand) applyWithLock(ctx context.Context, appConfig pushaction.ApplicationConfig, lockOwner string) error {
+	if lockOwner != "" {
+		warnings, err := cmd.Actor.AcquirePushLock(appConfig, lockOwner, cmd.ForceLock)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	applyCtx, stopApply := context.WithCancel(ctx)
+	eventStream, warningsStream, errorStream := cmd.Actor.Apply(applyCtx, appConfig)
+	err := cmd.processApplyStreams(appConfig, eventStream, warningsStream, errorStream)
+	stopApply()
+	if err == nil && cmd.RunTask != "" {
+		err = cmd.runPreStartTask(appConfig)
+	}
+	if err == nil && !cmd.NoStart {
+		err = cmd.startApp(ctx, appConfig)
+	}
+
+	if lockOwner != "" {
+		warnings, releaseErr := cmd.Actor.ReleasePushLock(appConfig, lockOwner)
+		cmd.UI.DisplayWarnings(warnings)
+		if releaseErr != nil {
+			log.Errorln("releasing push lock:", releaseErr)
+			if err == nil {
+				err = releaseErr
+			}
+		}
+	}
+
+	return err
+}
+
+// runPreStartTask runs the --run-task command from a freshly staged droplet of
+// the app.
+func (cmd V2PushCommand) runPreStartTask(appConfig pushaction.ApplicationConfig) error {
+	cmd.UI.DisplayText("Running task {{.Command}} for app {{.AppName}} before starting...", map[string]interface{}{
+		"Command": cmd.RunTask,
+		"AppName": appConfig.DesiredApplication.Name,
+	})
+
+	warnings, err := cmd.Actor.RunPreStartTask(appConfig, cmd.RunTask)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Task completed")
+	return nil
+}
+
+// startApp restarts the app so that it runs with the pushed settings, or
+// starts it when it is stopped. The staging logs and the startup progress are
+// displayed as they are by start, followed by the summary of the app.
+func (cmd V2PushCommand) startApp(ctx context.Context, appConfig pushaction.ApplicationConfig) error {
+	app, warnings, err := cmd.RestartActor.GetApplicationByNameAndSpace(appConfig.DesiredApplication.Name, appConfig.TargetedSpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Starting app {{.AppName}}...", map[string]interface{}{
+		"AppName": app.Name,
+	})
+
+	messages, logErrs, appStarting, apiWarnings, errs := cmd.RestartActor.RestartApplication(ctx, app, cmd.NOAAClient, cmd.Config)
+	err = shared.PollStartWithProgress(cmd.UI, cmd.Config, cmd.RestartActor, app.GUID, messages, logErrs, appStarting, apiWarnings, errs)
+	if err != nil {
+		if _, ok := err.(shared.UnsuccessfulStartError); ok {
+			shared.DisplayCrashDiagnostics(cmd.UI, cmd.RestartActor, app.GUID, cmd.NOAAClient)
+		}
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	appSummary, warnings, err := cmd.RestartActor.GetApplicationSummaryByNameAndSpace(app.Name, appConfig.TargetedSpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
 	return nil
 }
 
@@ -129,57 +450,69 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 	}
 
 	config := pushaction.CommandLineSettings{
-		Name: cmd.OptionalArgs.AppName,
-		Path: pwd,
+		Name:            cmd.OptionalArgs.AppName,
+		Path:            pwd,
+		Buildpack:       cmd.BuildpackName,
+		HealthCheckType: cmd.HealthCheckType.Type,
+		Stack:           cmd.Stack,
+		Instances:       cmd.NumInstances.NullInt,
+		DiskQuota:       int(cmd.DiskLimit.Size),
+		Memory:          int(cmd.MemoryLimit.Size),
 	}
 
 	log.Debugf("%#v", config)
 	return config, nil
 }
 
+// processApplyStreams displays the events and warnings of Apply until all
+// three streams are closed, or returns the first error that is received or
+// that is returned by displaying an event. A closed stream is no longer read
+// from, whatever the order in which the streams are closed. Callers cancel
+// the context that Apply was given once this returns, so that Apply stops
+// when an error is returned before it is done.
 func (cmd V2PushCommand) processApplyStreams(appConfig pushaction.ApplicationConfig, eventStream <-chan pushaction.Event, warningsStream <-chan pushaction.Warnings, errorStream <-chan error) error {
-	var eventClosed, warningsClosed, complete bool
+	var complete bool
 
-	for {
+	for eventStream != nil || warningsStream != nil || errorStream != nil {
 		select {
 		case event, ok := <-eventStream:
 			if !ok {
 				log.Debug("received event stream closed")
-				eventClosed = true
-				break
+				eventStream = nil
+				continue
 			}
-			var err error
-			complete, err = cmd.processEvent(appConfig, event)
+			eventComplete, err := cmd.processEvent(appConfig, event)
 			if err != nil {
 				return err
 			}
+			complete = complete || eventComplete
 		case warnings, ok := <-warningsStream:
 			if !ok {
 				log.Debug("received warnings stream closed")
-				warningsClosed = true
+				warningsStream = nil
+				continue
 			}
 			cmd.UI.DisplayWarnings(warnings)
 		case err, ok := <-errorStream:
 			if !ok {
 				log.Debug("received error stream closed")
-				warningsClosed = true
+				errorStream = nil
+				continue
+			}
+			if err != nil {
+				return err
 			}
-			return err
-		}
-
-		if eventClosed && warningsClosed && complete {
-			log.Debug("breaking apply display loop")
-			break
 		}
 	}
 
+	log.Debugf("apply streams closed, complete: %t", complete)
 	return nil
 }
 
 func (cmd V2PushCommand) processEvent(appConfig pushaction.ApplicationConfig, event pushaction.Event) (bool, error) {
-	log.Infoln("received apply event:", event)
+	log.Infof("received apply event: %#v", event)
 
-	switch event {
+	switch event.Type {
 	case pushaction.ApplicationCreated:
 		user, err := cmd.Config.CurrentUser()
 		if err != nil {
@@ -212,13 +545,31 @@ func (cmd V2PushCommand) processEvent(appConfig pushaction.ApplicationConfig, ev
 			},
 		)
 	case pushaction.RouteCreated:
-		cmd.UI.DisplayText("Creating routes...")
+		cmd.UI.DisplayText("Created route {{.Route}}", map[string]interface{}{
+			"Route": event.Route,
+		})
 	case pushaction.RouteBound:
-		cmd.UI.DisplayText("Binding routes...")
-	case pushaction.UploadingApplication:
-		cmd.UI.DisplayText("Uploading application...")
-	case pushaction.UploadComplete:
-		cmd.UI.DisplayText("Upload complete")
+		cmd.UI.DisplayText("Bound route {{.Route}}", map[string]interface{}{
+			"Route": event.Route,
+		})
+	case pushaction.RouteUnmapped:
+		cmd.UI.DisplayText("Unmapped route {{.Route}}", map[string]interface{}{
+			"Route": event.Route,
+		})
+	case pushaction.RouteDeleted:
+		cmd.UI.DisplayText("Deleted route {{.Route}}", map[string]interface{}{
+			"Route": event.Route,
+		})
+	case pushaction.ServiceBound:
+		cmd.UI.DisplayText("Bound service {{.ServiceInstance}}", map[string]interface{}{
+			"ServiceInstance": event.ServiceInstance,
+		})
+	case pushaction.ServiceUnbound:
+		cmd.UI.DisplayText("Unbound service {{.ServiceInstance}}", map[string]interface{}{
+			"ServiceInstance": event.ServiceInstance,
+		})
+	case pushaction.HealthChecksUpdated:
+		cmd.UI.DisplayText("Updating process health checks...")
 	case pushaction.Complete:
 		return true, nil
 	}
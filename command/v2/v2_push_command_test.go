@@ -1,18 +1,27 @@
 package v2_test
 
 import (
+	"context"
 	"errors"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/exitcode"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -21,13 +30,14 @@ import (
 
 var _ = Describe("v2-push Command", func() {
 	var (
-		cmd             V2PushCommand
-		testUI          *ui.UI
-		fakeConfig      *commandfakes.FakeConfig
-		fakeSharedActor *commandfakes.FakeSharedActor
-		fakeActor       *v2fakes.FakeV2PushActor
-		input           *Buffer
-		binaryName      string
+		cmd              V2PushCommand
+		testUI           *ui.UI
+		fakeConfig       *commandfakes.FakeConfig
+		fakeSharedActor  *commandfakes.FakeSharedActor
+		fakeActor        *v2fakes.FakeV2PushActor
+		fakeRestartActor *v2fakes.FakeRestartActor
+		input            *Buffer
+		binaryName       string
 
 		appName    string
 		executeErr error
@@ -40,12 +50,27 @@ var _ = Describe("v2-push Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v2fakes.FakeV2PushActor)
+		fakeRestartActor = new(v2fakes.FakeRestartActor)
+		fakeRestartActor.RestartApplicationStub = func(context.Context, v2action.Application, v2action.NOAAClient, v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appStarting := make(chan bool)
+			warnings := make(chan string)
+			errs := make(chan error)
+			close(messages)
+			close(logErrs)
+			close(appStarting)
+			close(warnings)
+			close(errs)
+			return messages, logErrs, appStarting, warnings, errs
+		}
 
 		cmd = V2PushCommand{
-			UI:          testUI,
-			Config:      fakeConfig,
-			SharedActor: fakeSharedActor,
-			Actor:       fakeActor,
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			RestartActor: fakeRestartActor,
 		}
 
 		appName = "some-app"
@@ -86,6 +111,179 @@ var _ = Describe("v2-push Command", func() {
 			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 		})
 
+		Context("when the http health check type is requested", func() {
+			BeforeEach(func() {
+				cmd.HealthCheckType = flag.HealthCheckType{Type: "http"}
+			})
+
+			Context("when the CC API version is below the minimum", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns("2.67.0")
+				})
+
+				It("returns a MinimumAPIVersionNotMetError naming the flag", func() {
+					Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+						Command:        "Option '--health-check-type=http'",
+						CurrentVersion: "2.67.0",
+						MinimumVersion: ccversion.MinVersionHTTPEndpointHealthCheckV2,
+					}))
+					Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the CC API version meets the minimum", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionHTTPEndpointHealthCheckV2)
+				})
+
+				It("continues with the push", func() {
+					Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when a manifest is provided", func() {
+			var manifestApps []manifest.Application
+
+			BeforeEach(func() {
+				cmd.PathToManifest = "some-manifest.yml"
+				manifestApps = []manifest.Application{{Name: appName, Path: pwd, Memory: 256}}
+				fakeActor.ReadManifestStub = func(string) ([]manifest.Application, error) {
+					return manifestApps, nil
+				}
+			})
+
+			It("merges the apps of the manifest with the flags", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
+				Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal("some-manifest.yml"))
+
+				Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
+				_, apps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+				Expect(apps).To(Equal([]manifest.Application{{Name: appName, Path: pwd, Memory: 256}}))
+			})
+
+			Context("when the manifest lists several apps", func() {
+				BeforeEach(func() {
+					manifestApps = []manifest.Application{{Name: "other-app"}, {Name: appName}}
+				})
+
+				It("only pushes the app that is named", func() {
+					_, apps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+					Expect(apps).To(Equal([]manifest.Application{{Name: appName}}))
+				})
+
+				Context("when no app name is provided", func() {
+					BeforeEach(func() {
+						cmd.OptionalArgs.AppName = ""
+					})
+
+					It("pushes all of the apps", func() {
+						_, apps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+						Expect(apps).To(Equal(manifestApps))
+					})
+				})
+
+				Context("when the named app is not in the manifest", func() {
+					BeforeEach(func() {
+						manifestApps = []manifest.Application{{Name: "other-app-1"}, {Name: "other-app-2"}}
+					})
+
+					It("returns an AppNotFoundInManifestError", func() {
+						Expect(executeErr).To(MatchError(shared.AppNotFoundInManifestError{AppName: appName, ManifestPath: "some-manifest.yml"}))
+						Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the manifest lists a single app with another name", func() {
+				BeforeEach(func() {
+					manifestApps = []manifest.Application{{Name: "other-app", Memory: 256}}
+				})
+
+				It("pushes that app with the name that is provided", func() {
+					_, apps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+					Expect(apps).To(Equal([]manifest.Application{{Name: appName, Memory: 256}}))
+				})
+			})
+
+			Context("when --reset is provided", func() {
+				BeforeEach(func() {
+					cmd.Reset = true
+					fakeActor.MergeAndValidateSettingsAndManifestsReturns(manifestApps, nil)
+				})
+
+				It("resets the app to the manifest", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(1))
+					_, _, _, reset := fakeActor.ConvertToApplicationConfigArgsForCall(0)
+					Expect(reset).To(BeTrue())
+				})
+			})
+
+			Context("when --no-manifest is provided", func() {
+				BeforeEach(func() {
+					cmd.NoManifest = true
+				})
+
+				It("does not read the manifest", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.ReadManifestCallCount()).To(Equal(0))
+
+					_, apps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+					Expect(apps).To(BeEmpty())
+				})
+			})
+
+			Context("when reading the manifest fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("bad manifest")
+					fakeActor.ReadManifestStub = nil
+					fakeActor.ReadManifestReturns(nil, expectedErr)
+				})
+
+				It("returns the error without pushing", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the current directory contains a manifest.yml", func() {
+			var (
+				tempDir    string
+				currentDir string
+			)
+
+			BeforeEach(func() {
+				var err error
+				currentDir, err = os.Getwd()
+				Expect(err).ToNot(HaveOccurred())
+				tempDir, err = ioutil.TempDir("", "v2-push-command-test")
+				Expect(err).ToNot(HaveOccurred())
+				tempDir, err = filepath.EvalSymlinks(tempDir)
+				Expect(err).ToNot(HaveOccurred())
+				err = ioutil.WriteFile(filepath.Join(tempDir, "manifest.yml"), nil, 0600)
+				Expect(err).ToNot(HaveOccurred())
+				err = os.Chdir(tempDir)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.Chdir(currentDir)).To(Succeed())
+				Expect(os.RemoveAll(tempDir)).To(Succeed())
+			})
+
+			It("reads that manifest", func() {
+				Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
+				Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(filepath.Join(tempDir, "manifest.yml")))
+			})
+		})
+
 		Context("when the push settings are valid", func() {
 			var appManifests []manifest.Application
 
@@ -130,13 +328,16 @@ var _ = Describe("v2-push Command", func() {
 						go func() {
 							defer GinkgoRecover()
 
-							Eventually(eventStream).Should(BeSent(pushaction.ApplicationCreated))
-							Eventually(eventStream).Should(BeSent(pushaction.ApplicationUpdated))
-							Eventually(eventStream).Should(BeSent(pushaction.RouteCreated))
-							Eventually(eventStream).Should(BeSent(pushaction.RouteBound))
-							Eventually(eventStream).Should(BeSent(pushaction.UploadingApplication))
-							Eventually(eventStream).Should(BeSent(pushaction.UploadComplete))
-							Eventually(eventStream).Should(BeSent(pushaction.Complete))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.ApplicationCreated}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.ApplicationUpdated}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.RouteCreated, Route: "some-route.example.com"}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.RouteBound, Route: "some-route.example.com"}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.RouteUnmapped, Route: "stale-route.example.com"}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.RouteDeleted, Route: "stale-route.example.com"}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.ServiceBound, ServiceInstance: "some-service"}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.ServiceUnbound, ServiceInstance: "stale-service"}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.HealthChecksUpdated}))
+							Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.Complete}))
 							Eventually(warningsStream).Should(BeSent(pushaction.Warnings{"apply-1", "apply-2"}))
 							close(eventStream)
 							close(warningsStream)
@@ -161,16 +362,44 @@ var _ = Describe("v2-push Command", func() {
 						}))
 					})
 
+					Context("when app settings are provided by flags", func() {
+						BeforeEach(func() {
+							cmd.BuildpackName = "some-buildpack"
+							cmd.NumInstances = flag.Instances{NullInt: types.NullInt{IsSet: true, Value: 4}}
+							cmd.DiskLimit = flag.Megabytes{Size: 1024}
+							cmd.MemoryLimit = flag.Megabytes{Size: 256}
+							cmd.HealthCheckType = flag.HealthCheckType{Type: "port"}
+							cmd.Stack = "some-stack"
+						})
+
+						It("passes them in the command line settings", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							cmdSettings, _ := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+							Expect(cmdSettings).To(Equal(pushaction.CommandLineSettings{
+								Name:            appName,
+								Path:            pwd,
+								Buildpack:       "some-buildpack",
+								HealthCheckType: "port",
+								Stack:           "some-stack",
+								Instances:       types.NullInt{IsSet: true, Value: 4},
+								DiskQuota:       1024,
+								Memory:          256,
+							}))
+						})
+					})
+
 					It("converts the manifests to app configs and outputs config warnings", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
 
 						Expect(testUI.Err).To(Say("some-config-warnings"))
 
 						Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(1))
-						orgGUID, spaceGUID, manifests := fakeActor.ConvertToApplicationConfigArgsForCall(0)
+						orgGUID, spaceGUID, manifests, reset := fakeActor.ConvertToApplicationConfigArgsForCall(0)
 						Expect(orgGUID).To(Equal("some-org-guid"))
 						Expect(spaceGUID).To(Equal("some-space-guid"))
 						Expect(manifests).To(Equal(appManifests))
+						Expect(reset).To(BeFalse())
 					})
 
 					It("outputs flavor text prior to generating app configuration", func() {
@@ -182,7 +411,52 @@ var _ = Describe("v2-push Command", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
 
 						Expect(fakeActor.ApplyCallCount()).To(Equal(1))
-						Expect(fakeActor.ApplyArgsForCall(0)).To(Equal(appConfigs[0]))
+						ctx, appConfig := fakeActor.ApplyArgsForCall(0)
+						Expect(appConfig).To(Equal(appConfigs[0]))
+						Expect(ctx.Done()).To(BeClosed())
+					})
+
+					Context("when --delete-orphaned-routes is provided", func() {
+						BeforeEach(func() {
+							cmd.DeleteOrphanedRoutes = true
+						})
+
+						It("prunes the routes and deletes the orphaned ones", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							_, appConfig := fakeActor.ApplyArgsForCall(0)
+							Expect(appConfig.PruneRoutes).To(BeTrue())
+							Expect(appConfig.DeleteOrphanedRoutes).To(BeTrue())
+						})
+					})
+
+					Context("when --prune-routes is provided", func() {
+						BeforeEach(func() {
+							cmd.PruneRoutes = true
+						})
+
+						It("prunes the routes without deleting them", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							_, appConfig := fakeActor.ApplyArgsForCall(0)
+							Expect(appConfig.PruneRoutes).To(BeTrue())
+							Expect(appConfig.DeleteOrphanedRoutes).To(BeFalse())
+						})
+					})
+
+					Context("when --prune-services is provided and the manifest lists the services of the app", func() {
+						BeforeEach(func() {
+							cmd.PruneServices = true
+							cmd.PathToManifest = "some-manifest.yml"
+							fakeActor.ReadManifestReturns([]manifest.Application{{Name: appName, Services: []string{"some-service"}}}, nil)
+						})
+
+						It("prunes the services", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							_, appConfig := fakeActor.ApplyArgsForCall(0)
+							Expect(appConfig.PruneServices).To(BeTrue())
+						})
 					})
 
 					It("displays app events and warnings", func() {
@@ -190,23 +464,147 @@ var _ = Describe("v2-push Command", func() {
 
 						Expect(testUI.Out).To(Say("Creating app %s in org %s / space %s as %s...", appName, "some-org", "some-space", "some-user"))
 						Expect(testUI.Out).To(Say("Updating app %s in org %s / space %s as %s...", appName, "some-org", "some-space", "some-user"))
-						Expect(testUI.Out).To(Say("Creating routes..."))
-						Expect(testUI.Out).To(Say("Binding routes..."))
-						Expect(testUI.Out).To(Say("Uploading application..."))
-						Expect(testUI.Out).To(Say("Upload complete"))
+						Expect(testUI.Out).To(Say("Created route some-route.example.com"))
+						Expect(testUI.Out).To(Say("Bound route some-route.example.com"))
+						Expect(testUI.Out).To(Say("Unmapped route stale-route.example.com"))
+						Expect(testUI.Out).To(Say("Deleted route stale-route.example.com"))
+						Expect(testUI.Out).To(Say("Bound service some-service"))
+						Expect(testUI.Out).To(Say("Unbound service stale-service"))
+						Expect(testUI.Out).To(Say("Updating process health checks..."))
 
 						Expect(testUI.Err).To(Say("some-config-warnings"))
 						Expect(testUI.Err).To(Say("apply-1"))
 						Expect(testUI.Err).To(Say("apply-2"))
 					})
 
-					It("displays app staging logs", func() {
-						Skip("will fill in later")
+					It("does not lock the app", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.AcquirePushLockCallCount()).To(Equal(0))
+						Expect(fakeActor.ReleasePushLockCallCount()).To(Equal(0))
+					})
+
+					Context("when --lock is provided", func() {
+						BeforeEach(func() {
+							cmd.Lock = true
+						})
+
+						It("holds the push lock of the app while applying", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.AcquirePushLockCallCount()).To(Equal(1))
+							config, owner, force := fakeActor.AcquirePushLockArgsForCall(0)
+							Expect(config).To(Equal(appConfigs[0]))
+							Expect(owner).To(HavePrefix("some-user."))
+							Expect(force).To(BeFalse())
+
+							Expect(fakeActor.ReleasePushLockCallCount()).To(Equal(1))
+							config, releaseOwner := fakeActor.ReleasePushLockArgsForCall(0)
+							Expect(config).To(Equal(appConfigs[0]))
+							Expect(releaseOwner).To(Equal(owner))
+						})
+					})
+
+					It("does not run a pre-start task", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.RunPreStartTaskCallCount()).To(Equal(0))
+					})
 
+					Context("when --run-task is provided", func() {
+						BeforeEach(func() {
+							cmd.RunTask = "rake db:migrate"
+							fakeActor.RunPreStartTaskReturns(pushaction.Warnings{"task-warning"}, nil)
+						})
+
+						It("runs the task after applying the config", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Running task rake db:migrate for app %s before starting...", appName))
+							Expect(testUI.Out).To(Say("Task completed"))
+							Expect(testUI.Err).To(Say("task-warning"))
+
+							Expect(fakeActor.RunPreStartTaskCallCount()).To(Equal(1))
+							config, command := fakeActor.RunPreStartTaskArgsForCall(0)
+							Expect(config).To(Equal(appConfigs[0]))
+							Expect(command).To(Equal("rake db:migrate"))
+						})
+
+						Context("when the task fails", func() {
+							BeforeEach(func() {
+								cmd.Lock = true
+								fakeActor.RunPreStartTaskReturns(pushaction.Warnings{"task-warning"}, v3action.TaskFailedError{SequenceID: 3, Reason: "Exited with status 1"})
+							})
+
+							It("returns a PreStartTaskFailedError and releases the push lock", func() {
+								Expect(executeErr).To(MatchError(shared.PreStartTaskFailedError{SequenceID: 3, Reason: "Exited with status 1"}))
+								Expect(testUI.Out).ToNot(Say("Task completed"))
+								Expect(testUI.Err).To(Say("task-warning"))
+								Expect(fakeActor.ReleasePushLockCallCount()).To(Equal(1))
+							})
+						})
 					})
 
-					It("displays the app info", func() {
-						Skip("will fill in later")
+					Context("when the app is started", func() {
+						BeforeEach(func() {
+							fakeRestartActor.GetApplicationByNameAndSpaceReturns(
+								v2action.Application{GUID: "some-app-guid", Name: appName},
+								v2action.Warnings{"get-app-warning"},
+								nil)
+							fakeRestartActor.GetApplicationSummaryByNameAndSpaceReturns(
+								v2action.ApplicationSummary{Application: v2action.Application{GUID: "some-app-guid", Name: appName}},
+								v2action.Warnings{"summary-warning"},
+								nil)
+						})
+
+						It("restarts the app after applying the config and displays its summary", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Starting app %s...", appName))
+							Expect(testUI.Out).To(Say(`name:\s+%s`, appName))
+							Expect(testUI.Err).To(Say("get-app-warning"))
+							Expect(testUI.Err).To(Say("summary-warning"))
+
+							Expect(fakeRestartActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+							name, spaceGUID := fakeRestartActor.GetApplicationByNameAndSpaceArgsForCall(0)
+							Expect(name).To(Equal(appName))
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+
+							Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(1))
+							_, app, _, _ := fakeRestartActor.RestartApplicationArgsForCall(0)
+							Expect(app).To(Equal(v2action.Application{GUID: "some-app-guid", Name: appName}))
+
+							Expect(fakeRestartActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(1))
+						})
+
+						Context("when staging fails", func() {
+							BeforeEach(func() {
+								fakeRestartActor.RestartApplicationStub = func(context.Context, v2action.Application, v2action.NOAAClient, v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+									messages := make(chan *v2action.LogMessage)
+									logErrs := make(chan error)
+									appStarting := make(chan bool)
+									warnings := make(chan string)
+									errs := make(chan error, 1)
+									errs <- v2action.StagingFailedError{Reason: "some staging error"}
+									return messages, logErrs, appStarting, warnings, errs
+								}
+							})
+
+							It("returns a StagingFailedError without displaying the summary", func() {
+								Expect(executeErr).To(MatchError(shared.StagingFailedError{Message: "some staging error"}))
+								Expect(fakeRestartActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when --no-start is provided", func() {
+							BeforeEach(func() {
+								cmd.NoStart = true
+							})
+
+							It("does not start the app", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).ToNot(Say("Starting app"))
+								Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+							})
+						})
 					})
 				})
 
@@ -250,6 +648,269 @@ var _ = Describe("v2-push Command", func() {
 						Expect(testUI.Err).To(Say("apply-1"))
 						Expect(testUI.Err).To(Say("apply-2"))
 					})
+
+					Context("when --force-lock is provided", func() {
+						BeforeEach(func() {
+							cmd.ForceLock = true
+							fakeActor.ReleasePushLockReturns(pushaction.Warnings{"release-warning"}, nil)
+						})
+
+						It("forces the lock and releases it after the failed push", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+
+							_, _, force := fakeActor.AcquirePushLockArgsForCall(0)
+							Expect(force).To(BeTrue())
+							Expect(fakeActor.ReleasePushLockCallCount()).To(Equal(1))
+							Expect(testUI.Err).To(Say("release-warning"))
+						})
+					})
+				})
+
+				Context("when the streams close in a different order", func() {
+					var (
+						eventStream    chan pushaction.Event
+						warningsStream chan pushaction.Warnings
+						errorStream    chan error
+					)
+
+					BeforeEach(func() {
+						eventStream = make(chan pushaction.Event)
+						warningsStream = make(chan pushaction.Warnings)
+						errorStream = make(chan error)
+
+						fakeActor.ApplyReturns(eventStream, warningsStream, errorStream)
+					})
+
+					AfterEach(func() {
+						Eventually(eventStream).Should(BeClosed())
+						Eventually(warningsStream).Should(BeClosed())
+						Eventually(errorStream).Should(BeClosed())
+					})
+
+					Context("when the error stream closes first", func() {
+						BeforeEach(func() {
+							go func() {
+								defer GinkgoRecover()
+
+								close(errorStream)
+								Eventually(warningsStream).Should(BeSent(pushaction.Warnings{"late-warning"}))
+								close(warningsStream)
+								Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.Complete}))
+								close(eventStream)
+							}()
+						})
+
+						It("keeps reading the other streams until they are closed", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Err).To(Say("late-warning"))
+						})
+					})
+
+					Context("when the event stream closes first and an error follows", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("late error")
+
+							go func() {
+								defer GinkgoRecover()
+
+								close(eventStream)
+								Eventually(warningsStream).Should(BeSent(pushaction.Warnings{"late-warning"}))
+								Eventually(errorStream).Should(BeSent(expectedErr))
+								close(warningsStream)
+								close(errorStream)
+							}()
+						})
+
+						It("displays the warnings and returns the error", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Err).To(Say("late-warning"))
+						})
+					})
+
+					Context("when the warnings stream closes first", func() {
+						BeforeEach(func() {
+							go func() {
+								defer GinkgoRecover()
+
+								close(warningsStream)
+								Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.RouteCreated, Route: "some-route.example.com"}))
+								Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.Complete}))
+								close(errorStream)
+								close(eventStream)
+							}()
+						})
+
+						It("displays the events and succeeds", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("Created route some-route.example.com"))
+						})
+					})
+
+					Context("when displaying an event fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("no current user")
+							fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+
+							fakeActor.ApplyStub = func(ctx context.Context, _ pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+								go func() {
+									defer GinkgoRecover()
+
+									Eventually(eventStream).Should(BeSent(pushaction.Event{Type: pushaction.ApplicationCreated}))
+									Eventually(ctx.Done()).Should(BeClosed())
+									close(eventStream)
+									close(warningsStream)
+									close(errorStream)
+								}()
+								return eventStream, warningsStream, errorStream
+							}
+						})
+
+						It("returns the error and cancels the apply", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+						})
+					})
+				})
+
+				Context("when the push lock is held by another push", func() {
+					BeforeEach(func() {
+						cmd.Lock = true
+						fakeActor.AcquirePushLockReturns(pushaction.Warnings{"lock-warning"}, v3action.ApplicationPushLockedError{
+							AppName: appName,
+							Owner:   "other-owner",
+						})
+					})
+
+					It("returns an ApplicationPushLockedError without applying", func() {
+						Expect(executeErr).To(MatchError(shared.ApplicationPushLockedError{
+							AppName: appName,
+							Owner:   "other-owner",
+						}))
+						Expect(testUI.Err).To(Say("lock-warning"))
+						Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+						Expect(fakeActor.ReleasePushLockCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when --reset is provided without a manifest", func() {
+				BeforeEach(func() {
+					cmd.Reset = true
+				})
+
+				It("returns a ResetWithoutManifestError without resetting anything", func() {
+					Expect(executeErr).To(MatchError(shared.ResetWithoutManifestError{}))
+					Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(0))
+					Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when --prune-services is provided without a manifest", func() {
+				BeforeEach(func() {
+					cmd.PruneServices = true
+					fakeActor.ConvertToApplicationConfigReturns([]pushaction.ApplicationConfig{
+						{
+							DesiredApplication:     v2action.Application{Name: appName},
+							CurrentServiceBindings: map[string]v2action.ServiceBinding{"some-service": {GUID: "some-binding-guid"}},
+						},
+					}, nil, nil)
+				})
+
+				It("returns a PruneServicesWithoutServicesError without unbinding anything", func() {
+					Expect(executeErr).To(MatchError(shared.PruneServicesWithoutServicesError{AppName: appName}))
+					Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(0))
+					Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when --prune-services is provided and the manifest does not list the services of the app", func() {
+				BeforeEach(func() {
+					cmd.PruneServices = true
+					cmd.PathToManifest = "some-manifest.yml"
+					fakeActor.ReadManifestReturns([]manifest.Application{{Name: appName}}, nil)
+					appManifests[0].Services = []string{"some-service"}
+				})
+
+				It("returns a PruneServicesWithoutServicesError without unbinding anything", func() {
+					Expect(executeErr).To(MatchError(shared.PruneServicesWithoutServicesError{AppName: appName}))
+					Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the settings are converted to multiple configs", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("first app failed")
+					fakeActor.ConvertToApplicationConfigReturns([]pushaction.ApplicationConfig{
+						{DesiredApplication: v2action.Application{Name: "app-1"}},
+						{DesiredApplication: v2action.Application{Name: "app-2"}},
+					}, nil, nil)
+
+					fakeActor.ApplyStub = func(_ context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+						eventStream := make(chan pushaction.Event)
+						warningsStream := make(chan pushaction.Warnings)
+						errorStream := make(chan error, 1)
+
+						go func() {
+							if config.DesiredApplication.Name == "app-1" {
+								errorStream <- expectedErr
+							} else {
+								eventStream <- pushaction.Event{Type: pushaction.Complete}
+							}
+							close(eventStream)
+							close(warningsStream)
+							close(errorStream)
+						}()
+						return eventStream, warningsStream, errorStream
+					}
+				})
+
+				It("pushes the remaining apps and displays a summary", func() {
+					Expect(executeErr).To(MatchError(shared.AppsPushFailedError{AppNames: []string{"app-1"}, Total: 2, Code: exitcode.Failed}))
+					Expect(fakeActor.ApplyCallCount()).To(Equal(2))
+
+					Expect(testUI.Err).To(Say("first app failed"))
+					Expect(testUI.Out).To(Say(`app\s+status\s+reason`))
+					Expect(testUI.Out).To(Say(`app-1\s+failed\s+first app failed`))
+					Expect(testUI.Out).To(Say(`app-2\s+pushed`))
+				})
+
+				Context("when the failure has an exit code", func() {
+					BeforeEach(func() {
+						expectedErr = pushaction.ServiceInstancesNotFoundError{AppName: "app-1", ServiceInstanceNames: []string{"some-service"}}
+					})
+
+					It("exits with the code of the failure", func() {
+						Expect(exitcode.Of(executeErr)).To(Equal(exitcode.ValidationFailed))
+					})
+				})
+
+				Context("when --fail-fast is provided", func() {
+					BeforeEach(func() {
+						cmd.FailFast = true
+					})
+
+					It("stops at the first app that fails", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+						Expect(testUI.Out).ToNot(Say("status"))
+					})
+				})
+
+				Context("when the push is interrupted", func() {
+					BeforeEach(func() {
+						expectedErr = context.Canceled
+					})
+
+					It("stops without pushing the remaining apps", func() {
+						Expect(executeErr).To(MatchError(shared.InterruptedError{}))
+						Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+						Expect(testUI.Out).ToNot(Say("status"))
+					})
 				})
 			})
 
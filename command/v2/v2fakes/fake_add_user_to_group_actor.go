@@ -0,0 +1,98 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeAddUserToGroupActor struct {
+	AddUserToUAAGroupStub        func(username string, origin string, groupName string) error
+	addUserToUAAGroupMutex       sync.RWMutex
+	addUserToUAAGroupArgsForCall []struct {
+		username  string
+		origin    string
+		groupName string
+	}
+	addUserToUAAGroupReturns struct {
+		result1 error
+	}
+	addUserToUAAGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAddUserToGroupActor) AddUserToUAAGroup(username string, origin string, groupName string) error {
+	fake.addUserToUAAGroupMutex.Lock()
+	ret, specificReturn := fake.addUserToUAAGroupReturnsOnCall[len(fake.addUserToUAAGroupArgsForCall)]
+	fake.addUserToUAAGroupArgsForCall = append(fake.addUserToUAAGroupArgsForCall, struct {
+		username  string
+		origin    string
+		groupName string
+	}{username, origin, groupName})
+	fake.recordInvocation("AddUserToUAAGroup", []interface{}{username, origin, groupName})
+	fake.addUserToUAAGroupMutex.Unlock()
+	if fake.AddUserToUAAGroupStub != nil {
+		return fake.AddUserToUAAGroupStub(username, origin, groupName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.addUserToUAAGroupReturns.result1
+}
+
+func (fake *FakeAddUserToGroupActor) AddUserToUAAGroupCallCount() int {
+	fake.addUserToUAAGroupMutex.RLock()
+	defer fake.addUserToUAAGroupMutex.RUnlock()
+	return len(fake.addUserToUAAGroupArgsForCall)
+}
+
+func (fake *FakeAddUserToGroupActor) AddUserToUAAGroupArgsForCall(i int) (string, string, string) {
+	fake.addUserToUAAGroupMutex.RLock()
+	defer fake.addUserToUAAGroupMutex.RUnlock()
+	return fake.addUserToUAAGroupArgsForCall[i].username, fake.addUserToUAAGroupArgsForCall[i].origin, fake.addUserToUAAGroupArgsForCall[i].groupName
+}
+
+func (fake *FakeAddUserToGroupActor) AddUserToUAAGroupReturns(result1 error) {
+	fake.AddUserToUAAGroupStub = nil
+	fake.addUserToUAAGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddUserToGroupActor) AddUserToUAAGroupReturnsOnCall(i int, result1 error) {
+	fake.AddUserToUAAGroupStub = nil
+	if fake.addUserToUAAGroupReturnsOnCall == nil {
+		fake.addUserToUAAGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addUserToUAAGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddUserToGroupActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addUserToUAAGroupMutex.RLock()
+	defer fake.addUserToUAAGroupMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAddUserToGroupActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.AddUserToGroupActor = new(FakeAddUserToGroupActor)
@@ -0,0 +1,107 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeAppActorV3 struct {
+	GetApplicationProcessesByNameAndSpaceStub        func(appName string, spaceGUID string) ([]v3action.Process, v3action.Warnings, error)
+	getApplicationProcessesByNameAndSpaceMutex       sync.RWMutex
+	getApplicationProcessesByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationProcessesByNameAndSpaceReturns struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationProcessesByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAppActorV3) GetApplicationProcessesByNameAndSpace(appName string, spaceGUID string) ([]v3action.Process, v3action.Warnings, error) {
+	fake.getApplicationProcessesByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationProcessesByNameAndSpaceReturnsOnCall[len(fake.getApplicationProcessesByNameAndSpaceArgsForCall)]
+	fake.getApplicationProcessesByNameAndSpaceArgsForCall = append(fake.getApplicationProcessesByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationProcessesByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationProcessesByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationProcessesByNameAndSpaceStub != nil {
+		return fake.GetApplicationProcessesByNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationProcessesByNameAndSpaceReturns.result1, fake.getApplicationProcessesByNameAndSpaceReturns.result2, fake.getApplicationProcessesByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeAppActorV3) GetApplicationProcessesByNameAndSpaceCallCount() int {
+	fake.getApplicationProcessesByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationProcessesByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationProcessesByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeAppActorV3) GetApplicationProcessesByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationProcessesByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationProcessesByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationProcessesByNameAndSpaceArgsForCall[i].appName, fake.getApplicationProcessesByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeAppActorV3) GetApplicationProcessesByNameAndSpaceReturns(result1 []v3action.Process, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationProcessesByNameAndSpaceStub = nil
+	fake.getApplicationProcessesByNameAndSpaceReturns = struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppActorV3) GetApplicationProcessesByNameAndSpaceReturnsOnCall(i int, result1 []v3action.Process, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationProcessesByNameAndSpaceStub = nil
+	if fake.getApplicationProcessesByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationProcessesByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Process
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationProcessesByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationProcessesByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationProcessesByNameAndSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAppActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.AppActorV3 = new(FakeAppActorV3)
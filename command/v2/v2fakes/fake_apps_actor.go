@@ -0,0 +1,178 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeAppsActor struct {
+	GetApplicationSummariesBySpaceStub        func(spaceGUID string, filter v2action.ApplicationSummaryFilter) ([]v2action.ApplicationSummary, v2action.Warnings, error)
+	getApplicationSummariesBySpaceMutex       sync.RWMutex
+	getApplicationSummariesBySpaceArgsForCall []struct {
+		spaceGUID string
+		filter    v2action.ApplicationSummaryFilter
+	}
+	getApplicationSummariesBySpaceReturns struct {
+		result1 []v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationSummariesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStackStub        func(guid string) (v2action.Stack, v2action.Warnings, error)
+	getStackMutex       sync.RWMutex
+	getStackArgsForCall []struct {
+		guid string
+	}
+	getStackReturns struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStackReturnsOnCall map[int]struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAppsActor) GetApplicationSummariesBySpace(spaceGUID string, filter v2action.ApplicationSummaryFilter) ([]v2action.ApplicationSummary, v2action.Warnings, error) {
+	fake.getApplicationSummariesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationSummariesBySpaceReturnsOnCall[len(fake.getApplicationSummariesBySpaceArgsForCall)]
+	fake.getApplicationSummariesBySpaceArgsForCall = append(fake.getApplicationSummariesBySpaceArgsForCall, struct {
+		spaceGUID string
+		filter    v2action.ApplicationSummaryFilter
+	}{spaceGUID, filter})
+	fake.recordInvocation("GetApplicationSummariesBySpace", []interface{}{spaceGUID, filter})
+	fake.getApplicationSummariesBySpaceMutex.Unlock()
+	if fake.GetApplicationSummariesBySpaceStub != nil {
+		return fake.GetApplicationSummariesBySpaceStub(spaceGUID, filter)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationSummariesBySpaceReturns.result1, fake.getApplicationSummariesBySpaceReturns.result2, fake.getApplicationSummariesBySpaceReturns.result3
+}
+
+func (fake *FakeAppsActor) GetApplicationSummariesBySpaceCallCount() int {
+	fake.getApplicationSummariesBySpaceMutex.RLock()
+	defer fake.getApplicationSummariesBySpaceMutex.RUnlock()
+	return len(fake.getApplicationSummariesBySpaceArgsForCall)
+}
+
+func (fake *FakeAppsActor) GetApplicationSummariesBySpaceArgsForCall(i int) (string, v2action.ApplicationSummaryFilter) {
+	fake.getApplicationSummariesBySpaceMutex.RLock()
+	defer fake.getApplicationSummariesBySpaceMutex.RUnlock()
+	return fake.getApplicationSummariesBySpaceArgsForCall[i].spaceGUID, fake.getApplicationSummariesBySpaceArgsForCall[i].filter
+}
+
+func (fake *FakeAppsActor) GetApplicationSummariesBySpaceReturns(result1 []v2action.ApplicationSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationSummariesBySpaceStub = nil
+	fake.getApplicationSummariesBySpaceReturns = struct {
+		result1 []v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetApplicationSummariesBySpaceReturnsOnCall(i int, result1 []v2action.ApplicationSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationSummariesBySpaceStub = nil
+	if fake.getApplicationSummariesBySpaceReturnsOnCall == nil {
+		fake.getApplicationSummariesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ApplicationSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSummariesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetStack(guid string) (v2action.Stack, v2action.Warnings, error) {
+	fake.getStackMutex.Lock()
+	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
+	fake.getStackArgsForCall = append(fake.getStackArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetStack", []interface{}{guid})
+	fake.getStackMutex.Unlock()
+	if fake.GetStackStub != nil {
+		return fake.GetStackStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackReturns.result1, fake.getStackReturns.result2, fake.getStackReturns.result3
+}
+
+func (fake *FakeAppsActor) GetStackCallCount() int {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return len(fake.getStackArgsForCall)
+}
+
+func (fake *FakeAppsActor) GetStackArgsForCall(i int) string {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return fake.getStackArgsForCall[i].guid
+}
+
+func (fake *FakeAppsActor) GetStackReturns(result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	fake.getStackReturns = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetStackReturnsOnCall(i int, result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	if fake.getStackReturnsOnCall == nil {
+		fake.getStackReturnsOnCall = make(map[int]struct {
+			result1 v2action.Stack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackReturnsOnCall[i] = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationSummariesBySpaceMutex.RLock()
+	defer fake.getApplicationSummariesBySpaceMutex.RUnlock()
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAppsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.AppsActor = new(FakeAppsActor)
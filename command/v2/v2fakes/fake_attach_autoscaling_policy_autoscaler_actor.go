@@ -0,0 +1,96 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeAttachAutoscalingPolicyAutoscalerActor struct {
+	AttachApplicationPolicyStub        func(appGUID string, policyPath string) error
+	attachApplicationPolicyMutex       sync.RWMutex
+	attachApplicationPolicyArgsForCall []struct {
+		appGUID    string
+		policyPath string
+	}
+	attachApplicationPolicyReturns struct {
+		result1 error
+	}
+	attachApplicationPolicyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAttachAutoscalingPolicyAutoscalerActor) AttachApplicationPolicy(appGUID string, policyPath string) error {
+	fake.attachApplicationPolicyMutex.Lock()
+	ret, specificReturn := fake.attachApplicationPolicyReturnsOnCall[len(fake.attachApplicationPolicyArgsForCall)]
+	fake.attachApplicationPolicyArgsForCall = append(fake.attachApplicationPolicyArgsForCall, struct {
+		appGUID    string
+		policyPath string
+	}{appGUID, policyPath})
+	fake.recordInvocation("AttachApplicationPolicy", []interface{}{appGUID, policyPath})
+	fake.attachApplicationPolicyMutex.Unlock()
+	if fake.AttachApplicationPolicyStub != nil {
+		return fake.AttachApplicationPolicyStub(appGUID, policyPath)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.attachApplicationPolicyReturns.result1
+}
+
+func (fake *FakeAttachAutoscalingPolicyAutoscalerActor) AttachApplicationPolicyCallCount() int {
+	fake.attachApplicationPolicyMutex.RLock()
+	defer fake.attachApplicationPolicyMutex.RUnlock()
+	return len(fake.attachApplicationPolicyArgsForCall)
+}
+
+func (fake *FakeAttachAutoscalingPolicyAutoscalerActor) AttachApplicationPolicyArgsForCall(i int) (string, string) {
+	fake.attachApplicationPolicyMutex.RLock()
+	defer fake.attachApplicationPolicyMutex.RUnlock()
+	return fake.attachApplicationPolicyArgsForCall[i].appGUID, fake.attachApplicationPolicyArgsForCall[i].policyPath
+}
+
+func (fake *FakeAttachAutoscalingPolicyAutoscalerActor) AttachApplicationPolicyReturns(result1 error) {
+	fake.AttachApplicationPolicyStub = nil
+	fake.attachApplicationPolicyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAttachAutoscalingPolicyAutoscalerActor) AttachApplicationPolicyReturnsOnCall(i int, result1 error) {
+	fake.AttachApplicationPolicyStub = nil
+	if fake.attachApplicationPolicyReturnsOnCall == nil {
+		fake.attachApplicationPolicyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.attachApplicationPolicyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAttachAutoscalingPolicyAutoscalerActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.attachApplicationPolicyMutex.RLock()
+	defer fake.attachApplicationPolicyMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAttachAutoscalingPolicyAutoscalerActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.AttachAutoscalingPolicyAutoscalerActor = new(FakeAttachAutoscalingPolicyAutoscalerActor)
@@ -0,0 +1,102 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeAutoscalingHistoryAutoscalerActor struct {
+	GetApplicationScalingHistoryStub        func(appGUID string, ascending bool) ([]autoscaleraction.ScalingHistory, error)
+	getApplicationScalingHistoryMutex       sync.RWMutex
+	getApplicationScalingHistoryArgsForCall []struct {
+		appGUID   string
+		ascending bool
+	}
+	getApplicationScalingHistoryReturns struct {
+		result1 []autoscaleraction.ScalingHistory
+		result2 error
+	}
+	getApplicationScalingHistoryReturnsOnCall map[int]struct {
+		result1 []autoscaleraction.ScalingHistory
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAutoscalingHistoryAutoscalerActor) GetApplicationScalingHistory(appGUID string, ascending bool) ([]autoscaleraction.ScalingHistory, error) {
+	fake.getApplicationScalingHistoryMutex.Lock()
+	ret, specificReturn := fake.getApplicationScalingHistoryReturnsOnCall[len(fake.getApplicationScalingHistoryArgsForCall)]
+	fake.getApplicationScalingHistoryArgsForCall = append(fake.getApplicationScalingHistoryArgsForCall, struct {
+		appGUID   string
+		ascending bool
+	}{appGUID, ascending})
+	fake.recordInvocation("GetApplicationScalingHistory", []interface{}{appGUID, ascending})
+	fake.getApplicationScalingHistoryMutex.Unlock()
+	if fake.GetApplicationScalingHistoryStub != nil {
+		return fake.GetApplicationScalingHistoryStub(appGUID, ascending)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getApplicationScalingHistoryReturns.result1, fake.getApplicationScalingHistoryReturns.result2
+}
+
+func (fake *FakeAutoscalingHistoryAutoscalerActor) GetApplicationScalingHistoryCallCount() int {
+	fake.getApplicationScalingHistoryMutex.RLock()
+	defer fake.getApplicationScalingHistoryMutex.RUnlock()
+	return len(fake.getApplicationScalingHistoryArgsForCall)
+}
+
+func (fake *FakeAutoscalingHistoryAutoscalerActor) GetApplicationScalingHistoryArgsForCall(i int) (string, bool) {
+	fake.getApplicationScalingHistoryMutex.RLock()
+	defer fake.getApplicationScalingHistoryMutex.RUnlock()
+	return fake.getApplicationScalingHistoryArgsForCall[i].appGUID, fake.getApplicationScalingHistoryArgsForCall[i].ascending
+}
+
+func (fake *FakeAutoscalingHistoryAutoscalerActor) GetApplicationScalingHistoryReturns(result1 []autoscaleraction.ScalingHistory, result2 error) {
+	fake.GetApplicationScalingHistoryStub = nil
+	fake.getApplicationScalingHistoryReturns = struct {
+		result1 []autoscaleraction.ScalingHistory
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAutoscalingHistoryAutoscalerActor) GetApplicationScalingHistoryReturnsOnCall(i int, result1 []autoscaleraction.ScalingHistory, result2 error) {
+	fake.GetApplicationScalingHistoryStub = nil
+	if fake.getApplicationScalingHistoryReturnsOnCall == nil {
+		fake.getApplicationScalingHistoryReturnsOnCall = make(map[int]struct {
+			result1 []autoscaleraction.ScalingHistory
+			result2 error
+		})
+	}
+	fake.getApplicationScalingHistoryReturnsOnCall[i] = struct {
+		result1 []autoscaleraction.ScalingHistory
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAutoscalingHistoryAutoscalerActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationScalingHistoryMutex.RLock()
+	defer fake.getApplicationScalingHistoryMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAutoscalingHistoryAutoscalerActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.AutoscalingHistoryAutoscalerActor = new(FakeAutoscalingHistoryAutoscalerActor)
@@ -0,0 +1,104 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/autoscaleraction"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeAutoscalingMetricsAutoscalerActor struct {
+	GetApplicationMetricsStub        func(appGUID string, metricType string, ascending bool) ([]autoscaleraction.Metric, error)
+	getApplicationMetricsMutex       sync.RWMutex
+	getApplicationMetricsArgsForCall []struct {
+		appGUID    string
+		metricType string
+		ascending  bool
+	}
+	getApplicationMetricsReturns struct {
+		result1 []autoscaleraction.Metric
+		result2 error
+	}
+	getApplicationMetricsReturnsOnCall map[int]struct {
+		result1 []autoscaleraction.Metric
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAutoscalingMetricsAutoscalerActor) GetApplicationMetrics(appGUID string, metricType string, ascending bool) ([]autoscaleraction.Metric, error) {
+	fake.getApplicationMetricsMutex.Lock()
+	ret, specificReturn := fake.getApplicationMetricsReturnsOnCall[len(fake.getApplicationMetricsArgsForCall)]
+	fake.getApplicationMetricsArgsForCall = append(fake.getApplicationMetricsArgsForCall, struct {
+		appGUID    string
+		metricType string
+		ascending  bool
+	}{appGUID, metricType, ascending})
+	fake.recordInvocation("GetApplicationMetrics", []interface{}{appGUID, metricType, ascending})
+	fake.getApplicationMetricsMutex.Unlock()
+	if fake.GetApplicationMetricsStub != nil {
+		return fake.GetApplicationMetricsStub(appGUID, metricType, ascending)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getApplicationMetricsReturns.result1, fake.getApplicationMetricsReturns.result2
+}
+
+func (fake *FakeAutoscalingMetricsAutoscalerActor) GetApplicationMetricsCallCount() int {
+	fake.getApplicationMetricsMutex.RLock()
+	defer fake.getApplicationMetricsMutex.RUnlock()
+	return len(fake.getApplicationMetricsArgsForCall)
+}
+
+func (fake *FakeAutoscalingMetricsAutoscalerActor) GetApplicationMetricsArgsForCall(i int) (string, string, bool) {
+	fake.getApplicationMetricsMutex.RLock()
+	defer fake.getApplicationMetricsMutex.RUnlock()
+	return fake.getApplicationMetricsArgsForCall[i].appGUID, fake.getApplicationMetricsArgsForCall[i].metricType, fake.getApplicationMetricsArgsForCall[i].ascending
+}
+
+func (fake *FakeAutoscalingMetricsAutoscalerActor) GetApplicationMetricsReturns(result1 []autoscaleraction.Metric, result2 error) {
+	fake.GetApplicationMetricsStub = nil
+	fake.getApplicationMetricsReturns = struct {
+		result1 []autoscaleraction.Metric
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAutoscalingMetricsAutoscalerActor) GetApplicationMetricsReturnsOnCall(i int, result1 []autoscaleraction.Metric, result2 error) {
+	fake.GetApplicationMetricsStub = nil
+	if fake.getApplicationMetricsReturnsOnCall == nil {
+		fake.getApplicationMetricsReturnsOnCall = make(map[int]struct {
+			result1 []autoscaleraction.Metric
+			result2 error
+		})
+	}
+	fake.getApplicationMetricsReturnsOnCall[i] = struct {
+		result1 []autoscaleraction.Metric
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAutoscalingMetricsAutoscalerActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationMetricsMutex.RLock()
+	defer fake.getApplicationMetricsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAutoscalingMetricsAutoscalerActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.AutoscalingMetricsAutoscalerActor = new(FakeAutoscalingMetricsAutoscalerActor)
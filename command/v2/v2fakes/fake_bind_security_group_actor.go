@@ -84,6 +84,18 @@ type FakeBindSecurityGroupActor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	BindSecurityGroupToSpacesStub        func(securityGroupGUID string, spaces []v2action.Space) []v2action.SecurityGroupSpaceBinding
+	bindSecurityGroupToSpacesMutex       sync.RWMutex
+	bindSecurityGroupToSpacesArgsForCall []struct {
+		securityGroupGUID string
+		spaces            []v2action.Space
+	}
+	bindSecurityGroupToSpacesReturns struct {
+		result1 []v2action.SecurityGroupSpaceBinding
+	}
+	bindSecurityGroupToSpacesReturnsOnCall map[int]struct {
+		result1 []v2action.SecurityGroupSpaceBinding
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -357,6 +369,60 @@ func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpaceReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpaces(securityGroupGUID string, spaces []v2action.Space) []v2action.SecurityGroupSpaceBinding {
+	var spacesCopy []v2action.Space
+	if spaces != nil {
+		spacesCopy = make([]v2action.Space, len(spaces))
+		copy(spacesCopy, spaces)
+	}
+	fake.bindSecurityGroupToSpacesMutex.Lock()
+	ret, specificReturn := fake.bindSecurityGroupToSpacesReturnsOnCall[len(fake.bindSecurityGroupToSpacesArgsForCall)]
+	fake.bindSecurityGroupToSpacesArgsForCall = append(fake.bindSecurityGroupToSpacesArgsForCall, struct {
+		securityGroupGUID string
+		spaces            []v2action.Space
+	}{securityGroupGUID, spacesCopy})
+	fake.recordInvocation("BindSecurityGroupToSpaces", []interface{}{securityGroupGUID, spacesCopy})
+	fake.bindSecurityGroupToSpacesMutex.Unlock()
+	if fake.BindSecurityGroupToSpacesStub != nil {
+		return fake.BindSecurityGroupToSpacesStub(securityGroupGUID, spaces)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.bindSecurityGroupToSpacesReturns.result1
+}
+
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpacesCallCount() int {
+	fake.bindSecurityGroupToSpacesMutex.RLock()
+	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
+	return len(fake.bindSecurityGroupToSpacesArgsForCall)
+}
+
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpacesArgsForCall(i int) (string, []v2action.Space) {
+	fake.bindSecurityGroupToSpacesMutex.RLock()
+	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
+	return fake.bindSecurityGroupToSpacesArgsForCall[i].securityGroupGUID, fake.bindSecurityGroupToSpacesArgsForCall[i].spaces
+}
+
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpacesReturns(result1 []v2action.SecurityGroupSpaceBinding) {
+	fake.BindSecurityGroupToSpacesStub = nil
+	fake.bindSecurityGroupToSpacesReturns = struct {
+		result1 []v2action.SecurityGroupSpaceBinding
+	}{result1}
+}
+
+func (fake *FakeBindSecurityGroupActor) BindSecurityGroupToSpacesReturnsOnCall(i int, result1 []v2action.SecurityGroupSpaceBinding) {
+	fake.BindSecurityGroupToSpacesStub = nil
+	if fake.bindSecurityGroupToSpacesReturnsOnCall == nil {
+		fake.bindSecurityGroupToSpacesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SecurityGroupSpaceBinding
+		})
+	}
+	fake.bindSecurityGroupToSpacesReturnsOnCall[i] = struct {
+		result1 []v2action.SecurityGroupSpaceBinding
+	}{result1}
+}
+
 func (fake *FakeBindSecurityGroupActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -370,6 +436,8 @@ func (fake *FakeBindSecurityGroupActor) Invocations() map[string][][]interface{}
 	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
 	fake.bindSecurityGroupToSpaceMutex.RLock()
 	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	fake.bindSecurityGroupToSpacesMutex.RLock()
+	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -0,0 +1,104 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeCrashScript struct {
+	RunStub        func(path string, input []byte, output io.Writer) error
+	runMutex       sync.RWMutex
+	runArgsForCall []struct {
+		path   string
+		input  []byte
+		output io.Writer
+	}
+	runReturns struct {
+		result1 error
+	}
+	runReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCrashScript) Run(path string, input []byte, output io.Writer) error {
+	var inputCopy []byte
+	if input != nil {
+		inputCopy = make([]byte, len(input))
+		copy(inputCopy, input)
+	}
+	fake.runMutex.Lock()
+	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
+	fake.runArgsForCall = append(fake.runArgsForCall, struct {
+		path   string
+		input  []byte
+		output io.Writer
+	}{path, inputCopy, output})
+	fake.recordInvocation("Run", []interface{}{path, inputCopy, output})
+	fake.runMutex.Unlock()
+	if fake.RunStub != nil {
+		return fake.RunStub(path, input, output)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.runReturns.result1
+}
+
+func (fake *FakeCrashScript) RunCallCount() int {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return len(fake.runArgsForCall)
+}
+
+func (fake *FakeCrashScript) RunArgsForCall(i int) (string, []byte, io.Writer) {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return fake.runArgsForCall[i].path, fake.runArgsForCall[i].input, fake.runArgsForCall[i].output
+}
+
+func (fake *FakeCrashScript) RunReturns(result1 error) {
+	fake.RunStub = nil
+	fake.runReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCrashScript) RunReturnsOnCall(i int, result1 error) {
+	fake.RunStub = nil
+	if fake.runReturnsOnCall == nil {
+		fake.runReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCrashScript) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCrashScript) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.CrashScript = new(FakeCrashScript)
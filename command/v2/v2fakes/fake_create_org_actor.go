@@ -0,0 +1,255 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeCreateOrgActor struct {
+	AssignOrganizationRoleByUsernameStub        func(orgGUID string, username string, role string) (v2action.Warnings, error)
+	assignOrganizationRoleByUsernameMutex       sync.RWMutex
+	assignOrganizationRoleByUsernameArgsForCall []struct {
+		orgGUID  string
+		username string
+		role     string
+	}
+	assignOrganizationRoleByUsernameReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	assignOrganizationRoleByUsernameReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	CreateOrganizationWithRolesStub        func(orgName string, quotaName string, roleSeeds []v2action.RoleSeed) (v2action.Organization, v2action.Warnings, error)
+	createOrganizationWithRolesMutex       sync.RWMutex
+	createOrganizationWithRolesArgsForCall []struct {
+		orgName   string
+		quotaName string
+		roleSeeds []v2action.RoleSeed
+	}
+	createOrganizationWithRolesReturns struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	createOrganizationWithRolesReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetFeatureFlagStub        func(name string) (v2action.FeatureFlag, v2action.Warnings, error)
+	getFeatureFlagMutex       sync.RWMutex
+	getFeatureFlagArgsForCall []struct {
+		name string
+	}
+	getFeatureFlagReturns struct {
+		result1 v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}
+	getFeatureFlagReturnsOnCall map[int]struct {
+		result1 v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCreateOrgActor) AssignOrganizationRoleByUsername(orgGUID string, username string, role string) (v2action.Warnings, error) {
+	fake.assignOrganizationRoleByUsernameMutex.Lock()
+	ret, specificReturn := fake.assignOrganizationRoleByUsernameReturnsOnCall[len(fake.assignOrganizationRoleByUsernameArgsForCall)]
+	fake.assignOrganizationRoleByUsernameArgsForCall = append(fake.assignOrganizationRoleByUsernameArgsForCall, struct {
+		orgGUID  string
+		username string
+		role     string
+	}{orgGUID, username, role})
+	fake.recordInvocation("AssignOrganizationRoleByUsername", []interface{}{orgGUID, username, role})
+	fake.assignOrganizationRoleByUsernameMutex.Unlock()
+	if fake.AssignOrganizationRoleByUsernameStub != nil {
+		return fake.AssignOrganizationRoleByUsernameStub(orgGUID, username, role)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.assignOrganizationRoleByUsernameReturns.result1, fake.assignOrganizationRoleByUsernameReturns.result2
+}
+
+func (fake *FakeCreateOrgActor) AssignOrganizationRoleByUsernameCallCount() int {
+	fake.assignOrganizationRoleByUsernameMutex.RLock()
+	defer fake.assignOrganizationRoleByUsernameMutex.RUnlock()
+	return len(fake.assignOrganizationRoleByUsernameArgsForCall)
+}
+
+func (fake *FakeCreateOrgActor) AssignOrganizationRoleByUsernameArgsForCall(i int) (string, string, string) {
+	fake.assignOrganizationRoleByUsernameMutex.RLock()
+	defer fake.assignOrganizationRoleByUsernameMutex.RUnlock()
+	return fake.assignOrganizationRoleByUsernameArgsForCall[i].orgGUID, fake.assignOrganizationRoleByUsernameArgsForCall[i].username, fake.assignOrganizationRoleByUsernameArgsForCall[i].role
+}
+
+func (fake *FakeCreateOrgActor) AssignOrganizationRoleByUsernameReturns(result1 v2action.Warnings, result2 error) {
+	fake.AssignOrganizationRoleByUsernameStub = nil
+	fake.assignOrganizationRoleByUsernameReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateOrgActor) AssignOrganizationRoleByUsernameReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.AssignOrganizationRoleByUsernameStub = nil
+	if fake.assignOrganizationRoleByUsernameReturnsOnCall == nil {
+		fake.assignOrganizationRoleByUsernameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.assignOrganizationRoleByUsernameReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateOrgActor) CreateOrganizationWithRoles(orgName string, quotaName string, roleSeeds []v2action.RoleSeed) (v2action.Organization, v2action.Warnings, error) {
+	var roleSeedsCopy []v2action.RoleSeed
+	if roleSeeds != nil {
+		roleSeedsCopy = make([]v2action.RoleSeed, len(roleSeeds))
+		copy(roleSeedsCopy, roleSeeds)
+	}
+	fake.createOrganizationWithRolesMutex.Lock()
+	ret, specificReturn := fake.createOrganizationWithRolesReturnsOnCall[len(fake.createOrganizationWithRolesArgsForCall)]
+	fake.createOrganizationWithRolesArgsForCall = append(fake.createOrganizationWithRolesArgsForCall, struct {
+		orgName   string
+		quotaName string
+		roleSeeds []v2action.RoleSeed
+	}{orgName, quotaName, roleSeedsCopy})
+	fake.recordInvocation("CreateOrganizationWithRoles", []interface{}{orgName, quotaName, roleSeedsCopy})
+	fake.createOrganizationWithRolesMutex.Unlock()
+	if fake.CreateOrganizationWithRolesStub != nil {
+		return fake.CreateOrganizationWithRolesStub(orgName, quotaName, roleSeeds)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationWithRolesReturns.result1, fake.createOrganizationWithRolesReturns.result2, fake.createOrganizationWithRolesReturns.result3
+}
+
+func (fake *FakeCreateOrgActor) CreateOrganizationWithRolesCallCount() int {
+	fake.createOrganizationWithRolesMutex.RLock()
+	defer fake.createOrganizationWithRolesMutex.RUnlock()
+	return len(fake.createOrganizationWithRolesArgsForCall)
+}
+
+func (fake *FakeCreateOrgActor) CreateOrganizationWithRolesArgsForCall(i int) (string, string, []v2action.RoleSeed) {
+	fake.createOrganizationWithRolesMutex.RLock()
+	defer fake.createOrganizationWithRolesMutex.RUnlock()
+	return fake.createOrganizationWithRolesArgsForCall[i].orgName, fake.createOrganizationWithRolesArgsForCall[i].quotaName, fake.createOrganizationWithRolesArgsForCall[i].roleSeeds
+}
+
+func (fake *FakeCreateOrgActor) CreateOrganizationWithRolesReturns(result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.CreateOrganizationWithRolesStub = nil
+	fake.createOrganizationWithRolesReturns = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateOrgActor) CreateOrganizationWithRolesReturnsOnCall(i int, result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.CreateOrganizationWithRolesStub = nil
+	if fake.createOrganizationWithRolesReturnsOnCall == nil {
+		fake.createOrganizationWithRolesReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationWithRolesReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateOrgActor) GetFeatureFlag(name string) (v2action.FeatureFlag, v2action.Warnings, error) {
+	fake.getFeatureFlagMutex.Lock()
+	ret, specificReturn := fake.getFeatureFlagReturnsOnCall[len(fake.getFeatureFlagArgsForCall)]
+	fake.getFeatureFlagArgsForCall = append(fake.getFeatureFlagArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetFeatureFlag", []interface{}{name})
+	fake.getFeatureFlagMutex.Unlock()
+	if fake.GetFeatureFlagStub != nil {
+		return fake.GetFeatureFlagStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getFeatureFlagReturns.result1, fake.getFeatureFlagReturns.result2, fake.getFeatureFlagReturns.result3
+}
+
+func (fake *FakeCreateOrgActor) GetFeatureFlagCallCount() int {
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	return len(fake.getFeatureFlagArgsForCall)
+}
+
+func (fake *FakeCreateOrgActor) GetFeatureFlagArgsForCall(i int) string {
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	return fake.getFeatureFlagArgsForCall[i].name
+}
+
+func (fake *FakeCreateOrgActor) GetFeatureFlagReturns(result1 v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.GetFeatureFlagStub = nil
+	fake.getFeatureFlagReturns = struct {
+		result1 v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateOrgActor) GetFeatureFlagReturnsOnCall(i int, result1 v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.GetFeatureFlagStub = nil
+	if fake.getFeatureFlagReturnsOnCall == nil {
+		fake.getFeatureFlagReturnsOnCall = make(map[int]struct {
+			result1 v2action.FeatureFlag
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getFeatureFlagReturnsOnCall[i] = struct {
+		result1 v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateOrgActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.assignOrganizationRoleByUsernameMutex.RLock()
+	defer fake.assignOrganizationRoleByUsernameMutex.RUnlock()
+	fake.createOrganizationWithRolesMutex.RLock()
+	defer fake.createOrganizationWithRolesMutex.RUnlock()
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCreateOrgActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.CreateOrgActor = new(FakeCreateOrgActor)
@@ -0,0 +1,173 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeCreateSecurityGroupActor struct {
+	CreateSecurityGroupStub        func(name string, rules []v2action.SecurityGroupRuleDefinition) (v2action.Warnings, error)
+	createSecurityGroupMutex       sync.RWMutex
+	createSecurityGroupArgsForCall []struct {
+		name  string
+		rules []v2action.SecurityGroupRuleDefinition
+	}
+	createSecurityGroupReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	createSecurityGroupReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	ReadSecurityGroupRulesFileStub        func(path string) ([]v2action.SecurityGroupRuleDefinition, error)
+	readSecurityGroupRulesFileMutex       sync.RWMutex
+	readSecurityGroupRulesFileArgsForCall []struct {
+		path string
+	}
+	readSecurityGroupRulesFileReturns struct {
+		result1 []v2action.SecurityGroupRuleDefinition
+		result2 error
+	}
+	readSecurityGroupRulesFileReturnsOnCall map[int]struct {
+		result1 []v2action.SecurityGroupRuleDefinition
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCreateSecurityGroupActor) CreateSecurityGroup(name string, rules []v2action.SecurityGroupRuleDefinition) (v2action.Warnings, error) {
+	var rulesCopy []v2action.SecurityGroupRuleDefinition
+	if rules != nil {
+		rulesCopy = make([]v2action.SecurityGroupRuleDefinition, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.createSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.createSecurityGroupReturnsOnCall[len(fake.createSecurityGroupArgsForCall)]
+	fake.createSecurityGroupArgsForCall = append(fake.createSecurityGroupArgsForCall, struct {
+		name  string
+		rules []v2action.SecurityGroupRuleDefinition
+	}{name, rulesCopy})
+	fake.recordInvocation("CreateSecurityGroup", []interface{}{name, rulesCopy})
+	fake.createSecurityGroupMutex.Unlock()
+	if fake.CreateSecurityGroupStub != nil {
+		return fake.CreateSecurityGroupStub(name, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createSecurityGroupReturns.result1, fake.createSecurityGroupReturns.result2
+}
+
+func (fake *FakeCreateSecurityGroupActor) CreateSecurityGroupCallCount() int {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return len(fake.createSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCreateSecurityGroupActor) CreateSecurityGroupArgsForCall(i int) (string, []v2action.SecurityGroupRuleDefinition) {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return fake.createSecurityGroupArgsForCall[i].name, fake.createSecurityGroupArgsForCall[i].rules
+}
+
+func (fake *FakeCreateSecurityGroupActor) CreateSecurityGroupReturns(result1 v2action.Warnings, result2 error) {
+	fake.CreateSecurityGroupStub = nil
+	fake.createSecurityGroupReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateSecurityGroupActor) CreateSecurityGroupReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.CreateSecurityGroupStub = nil
+	if fake.createSecurityGroupReturnsOnCall == nil {
+		fake.createSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.createSecurityGroupReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateSecurityGroupActor) ReadSecurityGroupRulesFile(path string) ([]v2action.SecurityGroupRuleDefinition, error) {
+	fake.readSecurityGroupRulesFileMutex.Lock()
+	ret, specificReturn := fake.readSecurityGroupRulesFileReturnsOnCall[len(fake.readSecurityGroupRulesFileArgsForCall)]
+	fake.readSecurityGroupRulesFileArgsForCall = append(fake.readSecurityGroupRulesFileArgsForCall, struct {
+		path string
+	}{path})
+	fake.recordInvocation("ReadSecurityGroupRulesFile", []interface{}{path})
+	fake.readSecurityGroupRulesFileMutex.Unlock()
+	if fake.ReadSecurityGroupRulesFileStub != nil {
+		return fake.ReadSecurityGroupRulesFileStub(path)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readSecurityGroupRulesFileReturns.result1, fake.readSecurityGroupRulesFileReturns.result2
+}
+
+func (fake *FakeCreateSecurityGroupActor) ReadSecurityGroupRulesFileCallCount() int {
+	fake.readSecurityGroupRulesFileMutex.RLock()
+	defer fake.readSecurityGroupRulesFileMutex.RUnlock()
+	return len(fake.readSecurityGroupRulesFileArgsForCall)
+}
+
+func (fake *FakeCreateSecurityGroupActor) ReadSecurityGroupRulesFileArgsForCall(i int) string {
+	fake.readSecurityGroupRulesFileMutex.RLock()
+	defer fake.readSecurityGroupRulesFileMutex.RUnlock()
+	return fake.readSecurityGroupRulesFileArgsForCall[i].path
+}
+
+func (fake *FakeCreateSecurityGroupActor) ReadSecurityGroupRulesFileReturns(result1 []v2action.SecurityGroupRuleDefinition, result2 error) {
+	fake.ReadSecurityGroupRulesFileStub = nil
+	fake.readSecurityGroupRulesFileReturns = struct {
+		result1 []v2action.SecurityGroupRuleDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateSecurityGroupActor) ReadSecurityGroupRulesFileReturnsOnCall(i int, result1 []v2action.SecurityGroupRuleDefinition, result2 error) {
+	fake.ReadSecurityGroupRulesFileStub = nil
+	if fake.readSecurityGroupRulesFileReturnsOnCall == nil {
+		fake.readSecurityGroupRulesFileReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SecurityGroupRuleDefinition
+			result2 error
+		})
+	}
+	fake.readSecurityGroupRulesFileReturnsOnCall[i] = struct {
+		result1 []v2action.SecurityGroupRuleDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateSecurityGroupActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	fake.readSecurityGroupRulesFileMutex.RLock()
+	defer fake.readSecurityGroupRulesFileMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCreateSecurityGroupActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.CreateSecurityGroupActor = new(FakeCreateSecurityGroupActor)
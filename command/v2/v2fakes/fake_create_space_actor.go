@@ -0,0 +1,330 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeCreateSpaceActor struct {
+	AssignSpaceRoleByUsernameStub        func(orgGUID string, spaceGUID string, username string, role string) (v2action.Warnings, error)
+	assignSpaceRoleByUsernameMutex       sync.RWMutex
+	assignSpaceRoleByUsernameArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		username  string
+		role      string
+	}
+	assignSpaceRoleByUsernameReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	assignSpaceRoleByUsernameReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	CreateSpaceWithRolesStub        func(spaceName string, orgGUID string, spaceQuotaName string, roleSeeds []v2action.RoleSeed) (v2action.Space, v2action.Warnings, error)
+	createSpaceWithRolesMutex       sync.RWMutex
+	createSpaceWithRolesArgsForCall []struct {
+		spaceName      string
+		orgGUID        string
+		spaceQuotaName string
+		roleSeeds      []v2action.RoleSeed
+	}
+	createSpaceWithRolesReturns struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	createSpaceWithRolesReturnsOnCall map[int]struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetFeatureFlagStub        func(name string) (v2action.FeatureFlag, v2action.Warnings, error)
+	getFeatureFlagMutex       sync.RWMutex
+	getFeatureFlagArgsForCall []struct {
+		name string
+	}
+	getFeatureFlagReturns struct {
+		result1 v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}
+	getFeatureFlagReturnsOnCall map[int]struct {
+		result1 v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationByNameStub        func(orgName string) (v2action.Organization, v2action.Warnings, error)
+	getOrganizationByNameMutex       sync.RWMutex
+	getOrganizationByNameArgsForCall []struct {
+		orgName string
+	}
+	getOrganizationByNameReturns struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationByNameReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCreateSpaceActor) AssignSpaceRoleByUsername(orgGUID string, spaceGUID string, username string, role string) (v2action.Warnings, error) {
+	fake.assignSpaceRoleByUsernameMutex.Lock()
+	ret, specificReturn := fake.assignSpaceRoleByUsernameReturnsOnCall[len(fake.assignSpaceRoleByUsernameArgsForCall)]
+	fake.assignSpaceRoleByUsernameArgsForCall = append(fake.assignSpaceRoleByUsernameArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		username  string
+		role      string
+	}{orgGUID, spaceGUID, username, role})
+	fake.recordInvocation("AssignSpaceRoleByUsername", []interface{}{orgGUID, spaceGUID, username, role})
+	fake.assignSpaceRoleByUsernameMutex.Unlock()
+	if fake.AssignSpaceRoleByUsernameStub != nil {
+		return fake.AssignSpaceRoleByUsernameStub(orgGUID, spaceGUID, username, role)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.assignSpaceRoleByUsernameReturns.result1, fake.assignSpaceRoleByUsernameReturns.result2
+}
+
+func (fake *FakeCreateSpaceActor) AssignSpaceRoleByUsernameCallCount() int {
+	fake.assignSpaceRoleByUsernameMutex.RLock()
+	defer fake.assignSpaceRoleByUsernameMutex.RUnlock()
+	return len(fake.assignSpaceRoleByUsernameArgsForCall)
+}
+
+func (fake *FakeCreateSpaceActor) AssignSpaceRoleByUsernameArgsForCall(i int) (string, string, string, string) {
+	fake.assignSpaceRoleByUsernameMutex.RLock()
+	defer fake.assignSpaceRoleByUsernameMutex.RUnlock()
+	return fake.assignSpaceRoleByUsernameArgsForCall[i].orgGUID, fake.assignSpaceRoleByUsernameArgsForCall[i].spaceGUID, fake.assignSpaceRoleByUsernameArgsForCall[i].username, fake.assignSpaceRoleByUsernameArgsForCall[i].role
+}
+
+func (fake *FakeCreateSpaceActor) AssignSpaceRoleByUsernameReturns(result1 v2action.Warnings, result2 error) {
+	fake.AssignSpaceRoleByUsernameStub = nil
+	fake.assignSpaceRoleByUsernameReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateSpaceActor) AssignSpaceRoleByUsernameReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.AssignSpaceRoleByUsernameStub = nil
+	if fake.assignSpaceRoleByUsernameReturnsOnCall == nil {
+		fake.assignSpaceRoleByUsernameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.assignSpaceRoleByUsernameReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateSpaceActor) CreateSpaceWithRoles(spaceName string, orgGUID string, spaceQuotaName string, roleSeeds []v2action.RoleSeed) (v2action.Space, v2action.Warnings, error) {
+	var roleSeedsCopy []v2action.RoleSeed
+	if roleSeeds != nil {
+		roleSeedsCopy = make([]v2action.RoleSeed, len(roleSeeds))
+		copy(roleSeedsCopy, roleSeeds)
+	}
+	fake.createSpaceWithRolesMutex.Lock()
+	ret, specificReturn := fake.createSpaceWithRolesReturnsOnCall[len(fake.createSpaceWithRolesArgsForCall)]
+	fake.createSpaceWithRolesArgsForCall = append(fake.createSpaceWithRolesArgsForCall, struct {
+		spaceName      string
+		orgGUID        string
+		spaceQuotaName string
+		roleSeeds      []v2action.RoleSeed
+	}{spaceName, orgGUID, spaceQuotaName, roleSeedsCopy})
+	fake.recordInvocation("CreateSpaceWithRoles", []interface{}{spaceName, orgGUID, spaceQuotaName, roleSeedsCopy})
+	fake.createSpaceWithRolesMutex.Unlock()
+	if fake.CreateSpaceWithRolesStub != nil {
+		return fake.CreateSpaceWithRolesStub(spaceName, orgGUID, spaceQuotaName, roleSeeds)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceWithRolesReturns.result1, fake.createSpaceWithRolesReturns.result2, fake.createSpaceWithRolesReturns.result3
+}
+
+func (fake *FakeCreateSpaceActor) CreateSpaceWithRolesCallCount() int {
+	fake.createSpaceWithRolesMutex.RLock()
+	defer fake.createSpaceWithRolesMutex.RUnlock()
+	return len(fake.createSpaceWithRolesArgsForCall)
+}
+
+func (fake *FakeCreateSpaceActor) CreateSpaceWithRolesArgsForCall(i int) (string, string, string, []v2action.RoleSeed) {
+	fake.createSpaceWithRolesMutex.RLock()
+	defer fake.createSpaceWithRolesMutex.RUnlock()
+	return fake.createSpaceWithRolesArgsForCall[i].spaceName, fake.createSpaceWithRolesArgsForCall[i].orgGUID, fake.createSpaceWithRolesArgsForCall[i].spaceQuotaName, fake.createSpaceWithRolesArgsForCall[i].roleSeeds
+}
+
+func (fake *FakeCreateSpaceActor) CreateSpaceWithRolesReturns(result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.CreateSpaceWithRolesStub = nil
+	fake.createSpaceWithRolesReturns = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateSpaceActor) CreateSpaceWithRolesReturnsOnCall(i int, result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.CreateSpaceWithRolesStub = nil
+	if fake.createSpaceWithRolesReturnsOnCall == nil {
+		fake.createSpaceWithRolesReturnsOnCall = make(map[int]struct {
+			result1 v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceWithRolesReturnsOnCall[i] = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateSpaceActor) GetFeatureFlag(name string) (v2action.FeatureFlag, v2action.Warnings, error) {
+	fake.getFeatureFlagMutex.Lock()
+	ret, specificReturn := fake.getFeatureFlagReturnsOnCall[len(fake.getFeatureFlagArgsForCall)]
+	fake.getFeatureFlagArgsForCall = append(fake.getFeatureFlagArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetFeatureFlag", []interface{}{name})
+	fake.getFeatureFlagMutex.Unlock()
+	if fake.GetFeatureFlagStub != nil {
+		return fake.GetFeatureFlagStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getFeatureFlagReturns.result1, fake.getFeatureFlagReturns.result2, fake.getFeatureFlagReturns.result3
+}
+
+func (fake *FakeCreateSpaceActor) GetFeatureFlagCallCount() int {
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	return len(fake.getFeatureFlagArgsForCall)
+}
+
+func (fake *FakeCreateSpaceActor) GetFeatureFlagArgsForCall(i int) string {
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	return fake.getFeatureFlagArgsForCall[i].name
+}
+
+func (fake *FakeCreateSpaceActor) GetFeatureFlagReturns(result1 v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.GetFeatureFlagStub = nil
+	fake.getFeatureFlagReturns = struct {
+		result1 v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateSpaceActor) GetFeatureFlagReturnsOnCall(i int, result1 v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.GetFeatureFlagStub = nil
+	if fake.getFeatureFlagReturnsOnCall == nil {
+		fake.getFeatureFlagReturnsOnCall = make(map[int]struct {
+			result1 v2action.FeatureFlag
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getFeatureFlagReturnsOnCall[i] = struct {
+		result1 v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateSpaceActor) GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error) {
+	fake.getOrganizationByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
+	fake.getOrganizationByNameArgsForCall = append(fake.getOrganizationByNameArgsForCall, struct {
+		orgName string
+	}{orgName})
+	fake.recordInvocation("GetOrganizationByName", []interface{}{orgName})
+	fake.getOrganizationByNameMutex.Unlock()
+	if fake.GetOrganizationByNameStub != nil {
+		return fake.GetOrganizationByNameStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationByNameReturns.result1, fake.getOrganizationByNameReturns.result2, fake.getOrganizationByNameReturns.result3
+}
+
+func (fake *FakeCreateSpaceActor) GetOrganizationByNameCallCount() int {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return len(fake.getOrganizationByNameArgsForCall)
+}
+
+func (fake *FakeCreateSpaceActor) GetOrganizationByNameArgsForCall(i int) string {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return fake.getOrganizationByNameArgsForCall[i].orgName
+}
+
+func (fake *FakeCreateSpaceActor) GetOrganizationByNameReturns(result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationByNameStub = nil
+	fake.getOrganizationByNameReturns = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateSpaceActor) GetOrganizationByNameReturnsOnCall(i int, result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationByNameStub = nil
+	if fake.getOrganizationByNameReturnsOnCall == nil {
+		fake.getOrganizationByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationByNameReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateSpaceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.assignSpaceRoleByUsernameMutex.RLock()
+	defer fake.assignSpaceRoleByUsernameMutex.RUnlock()
+	fake.createSpaceWithRolesMutex.RLock()
+	defer fake.createSpaceWithRolesMutex.RUnlock()
+	fake.getFeatureFlagMutex.RLock()
+	defer fake.getFeatureFlagMutex.RUnlock()
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCreateSpaceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.CreateSpaceActor = new(FakeCreateSpaceActor)
@@ -9,16 +9,31 @@ import (
 )
 
 type FakeDeleteOrganizationActor struct {
-	DeleteOrganizationStub        func(orgName string) (v2action.Warnings, error)
-	deleteOrganizationMutex       sync.RWMutex
-	deleteOrganizationArgsForCall []struct {
+	DeleteOrganizationAsyncStub        func(orgName string) (v2action.Job, v2action.Warnings, error)
+	deleteOrganizationAsyncMutex       sync.RWMutex
+	deleteOrganizationAsyncArgsForCall []struct {
 		orgName string
 	}
-	deleteOrganizationReturns struct {
+	deleteOrganizationAsyncReturns struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
+	deleteOrganizationAsyncReturnsOnCall map[int]struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
+	PollJobStub        func(job v2action.Job) (v2action.Warnings, error)
+	pollJobMutex       sync.RWMutex
+	pollJobArgsForCall []struct {
+		job v2action.Job
+	}
+	pollJobReturns struct {
 		result1 v2action.Warnings
 		result2 error
 	}
-	deleteOrganizationReturnsOnCall map[int]struct {
+	pollJobReturnsOnCall map[int]struct {
 		result1 v2action.Warnings
 		result2 error
 	}
@@ -31,52 +46,106 @@ type FakeDeleteOrganizationActor struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganization(orgName string) (v2action.Warnings, error) {
-	fake.deleteOrganizationMutex.Lock()
-	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
-	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationAsync(orgName string) (v2action.Job, v2action.Warnings, error) {
+	fake.deleteOrganizationAsyncMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationAsyncReturnsOnCall[len(fake.deleteOrganizationAsyncArgsForCall)]
+	fake.deleteOrganizationAsyncArgsForCall = append(fake.deleteOrganizationAsyncArgsForCall, struct {
 		orgName string
 	}{orgName})
-	fake.recordInvocation("DeleteOrganization", []interface{}{orgName})
-	fake.deleteOrganizationMutex.Unlock()
-	if fake.DeleteOrganizationStub != nil {
-		return fake.DeleteOrganizationStub(orgName)
+	fake.recordInvocation("DeleteOrganizationAsync", []interface{}{orgName})
+	fake.deleteOrganizationAsyncMutex.Unlock()
+	if fake.DeleteOrganizationAsyncStub != nil {
+		return fake.DeleteOrganizationAsyncStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteOrganizationAsyncReturns.result1, fake.deleteOrganizationAsyncReturns.result2, fake.deleteOrganizationAsyncReturns.result3
+}
+
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationAsyncCallCount() int {
+	fake.deleteOrganizationAsyncMutex.RLock()
+	defer fake.deleteOrganizationAsyncMutex.RUnlock()
+	return len(fake.deleteOrganizationAsyncArgsForCall)
+}
+
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationAsyncArgsForCall(i int) string {
+	fake.deleteOrganizationAsyncMutex.RLock()
+	defer fake.deleteOrganizationAsyncMutex.RUnlock()
+	return fake.deleteOrganizationAsyncArgsForCall[i].orgName
+}
+
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationAsyncReturns(result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.DeleteOrganizationAsyncStub = nil
+	fake.deleteOrganizationAsyncReturns = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteOrganizationActor) DeleteOrganizationAsyncReturnsOnCall(i int, result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.DeleteOrganizationAsyncStub = nil
+	if fake.deleteOrganizationAsyncReturnsOnCall == nil {
+		fake.deleteOrganizationAsyncReturnsOnCall = make(map[int]struct {
+			result1 v2action.Job
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.deleteOrganizationAsyncReturnsOnCall[i] = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteOrganizationActor) PollJob(job v2action.Job) (v2action.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		job v2action.Job
+	}{job})
+	fake.recordInvocation("PollJob", []interface{}{job})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(job)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2
+	return fake.pollJobReturns.result1, fake.pollJobReturns.result2
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationCallCount() int {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return len(fake.deleteOrganizationArgsForCall)
+func (fake *FakeDeleteOrganizationActor) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationArgsForCall(i int) string {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return fake.deleteOrganizationArgsForCall[i].orgName
+func (fake *FakeDeleteOrganizationActor) PollJobArgsForCall(i int) v2action.Job {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return fake.pollJobArgsForCall[i].job
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationReturns(result1 v2action.Warnings, result2 error) {
-	fake.DeleteOrganizationStub = nil
-	fake.deleteOrganizationReturns = struct {
+func (fake *FakeDeleteOrganizationActor) PollJobReturns(result1 v2action.Warnings, result2 error) {
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
-	fake.DeleteOrganizationStub = nil
-	if fake.deleteOrganizationReturnsOnCall == nil {
-		fake.deleteOrganizationReturnsOnCall = make(map[int]struct {
+func (fake *FakeDeleteOrganizationActor) PollJobReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
 			result1 v2action.Warnings
 			result2 error
 		})
 	}
-	fake.deleteOrganizationReturnsOnCall[i] = struct {
+	fake.pollJobReturnsOnCall[i] = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
@@ -109,8 +178,10 @@ func (fake *FakeDeleteOrganizationActor) ClearOrganizationAndSpaceArgsForCall(i
 func (fake *FakeDeleteOrganizationActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.deleteOrganizationAsyncMutex.RLock()
+	defer fake.deleteOrganizationAsyncMutex.RUnlock()
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
 	fake.clearOrganizationAndSpaceMutex.RLock()
 	defer fake.clearOrganizationAndSpaceMutex.RUnlock()
 	return fake.invocations
@@ -0,0 +1,173 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeDeleteSpaceActor struct {
+	DeleteSpaceByNameAndOrganizationNameStub        func(spaceName string, orgName string) (v2action.Job, v2action.Warnings, error)
+	deleteSpaceByNameAndOrganizationNameMutex       sync.RWMutex
+	deleteSpaceByNameAndOrganizationNameArgsForCall []struct {
+		spaceName string
+		orgName   string
+	}
+	deleteSpaceByNameAndOrganizationNameReturns struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
+	deleteSpaceByNameAndOrganizationNameReturnsOnCall map[int]struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
+	PollJobStub        func(job v2action.Job) (v2action.Warnings, error)
+	pollJobMutex       sync.RWMutex
+	pollJobArgsForCall []struct {
+		job v2action.Job
+	}
+	pollJobReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	pollJobReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (v2action.Job, v2action.Warnings, error) {
+	fake.deleteSpaceByNameAndOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceByNameAndOrganizationNameReturnsOnCall[len(fake.deleteSpaceByNameAndOrganizationNameArgsForCall)]
+	fake.deleteSpaceByNameAndOrganizationNameArgsForCall = append(fake.deleteSpaceByNameAndOrganizationNameArgsForCall, struct {
+		spaceName string
+		orgName   string
+	}{spaceName, orgName})
+	fake.recordInvocation("DeleteSpaceByNameAndOrganizationName", []interface{}{spaceName, orgName})
+	fake.deleteSpaceByNameAndOrganizationNameMutex.Unlock()
+	if fake.DeleteSpaceByNameAndOrganizationNameStub != nil {
+		return fake.DeleteSpaceByNameAndOrganizationNameStub(spaceName, orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteSpaceByNameAndOrganizationNameReturns.result1, fake.deleteSpaceByNameAndOrganizationNameReturns.result2, fake.deleteSpaceByNameAndOrganizationNameReturns.result3
+}
+
+func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameCallCount() int {
+	fake.deleteSpaceByNameAndOrganizationNameMutex.RLock()
+	defer fake.deleteSpaceByNameAndOrganizationNameMutex.RUnlock()
+	return len(fake.deleteSpaceByNameAndOrganizationNameArgsForCall)
+}
+
+func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameArgsForCall(i int) (string, string) {
+	fake.deleteSpaceByNameAndOrganizationNameMutex.RLock()
+	defer fake.deleteSpaceByNameAndOrganizationNameMutex.RUnlock()
+	return fake.deleteSpaceByNameAndOrganizationNameArgsForCall[i].spaceName, fake.deleteSpaceByNameAndOrganizationNameArgsForCall[i].orgName
+}
+
+func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameReturns(result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.DeleteSpaceByNameAndOrganizationNameStub = nil
+	fake.deleteSpaceByNameAndOrganizationNameReturns = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameReturnsOnCall(i int, result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.DeleteSpaceByNameAndOrganizationNameStub = nil
+	if fake.deleteSpaceByNameAndOrganizationNameReturnsOnCall == nil {
+		fake.deleteSpaceByNameAndOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Job
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.deleteSpaceByNameAndOrganizationNameReturnsOnCall[i] = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteSpaceActor) PollJob(job v2action.Job) (v2action.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		job v2action.Job
+	}{job})
+	fake.recordInvocation("PollJob", []interface{}{job})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(job)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pollJobReturns.result1, fake.pollJobReturns.result2
+}
+
+func (fake *FakeDeleteSpaceActor) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeDeleteSpaceActor) PollJobArgsForCall(i int) v2action.Job {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return fake.pollJobArgsForCall[i].job
+}
+
+func (fake *FakeDeleteSpaceActor) PollJobReturns(result1 v2action.Warnings, result2 error) {
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeleteSpaceActor) PollJobReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeleteSpaceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteSpaceByNameAndOrganizationNameMutex.RLock()
+	defer fake.deleteSpaceByNameAndOrganizationNameMutex.RUnlock()
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDeleteSpaceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.DeleteSpaceActor = new(FakeDeleteSpaceActor)
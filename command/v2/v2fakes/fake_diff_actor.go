@@ -0,0 +1,183 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeDiffActor struct {
+	ConvertToApplicationConfigStub        func(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	convertToApplicationConfigMutex       sync.RWMutex
+	convertToApplicationConfigArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		apps      []manifest.Application
+		reset     bool
+	}
+	convertToApplicationConfigReturns struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	convertToApplicationConfigReturnsOnCall map[int]struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	ReadManifestStub        func(pathToManifest string) ([]manifest.Application, error)
+	readManifestMutex       sync.RWMutex
+	readManifestArgsForCall []struct {
+		pathToManifest string
+	}
+	readManifestReturns struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	readManifestReturnsOnCall map[int]struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDiffActor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
+	var appsCopy []manifest.Application
+	if apps != nil {
+		appsCopy = make([]manifest.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.convertToApplicationConfigMutex.Lock()
+	ret, specificReturn := fake.convertToApplicationConfigReturnsOnCall[len(fake.convertToApplicationConfigArgsForCall)]
+	fake.convertToApplicationConfigArgsForCall = append(fake.convertToApplicationConfigArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		apps      []manifest.Application
+		reset     bool
+	}{orgGUID, spaceGUID, appsCopy, reset})
+	fake.recordInvocation("ConvertToApplicationConfig", []interface{}{orgGUID, spaceGUID, appsCopy, reset})
+	fake.convertToApplicationConfigMutex.Unlock()
+	if fake.ConvertToApplicationConfigStub != nil {
+		return fake.ConvertToApplicationConfigStub(orgGUID, spaceGUID, apps, reset)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.convertToApplicationConfigReturns.result1, fake.convertToApplicationConfigReturns.result2, fake.convertToApplicationConfigReturns.result3
+}
+
+func (fake *FakeDiffActor) ConvertToApplicationConfigCallCount() int {
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	return len(fake.convertToApplicationConfigArgsForCall)
+}
+
+func (fake *FakeDiffActor) ConvertToApplicationConfigArgsForCall(i int) (string, string, []manifest.Application, bool) {
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	return fake.convertToApplicationConfigArgsForCall[i].orgGUID, fake.convertToApplicationConfigArgsForCall[i].spaceGUID, fake.convertToApplicationConfigArgsForCall[i].apps, fake.convertToApplicationConfigArgsForCall[i].reset
+}
+
+func (fake *FakeDiffActor) ConvertToApplicationConfigReturns(result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigStub = nil
+	fake.convertToApplicationConfigReturns = struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiffActor) ConvertToApplicationConfigReturnsOnCall(i int, result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigStub = nil
+	if fake.convertToApplicationConfigReturnsOnCall == nil {
+		fake.convertToApplicationConfigReturnsOnCall = make(map[int]struct {
+			result1 []pushaction.ApplicationConfig
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.convertToApplicationConfigReturnsOnCall[i] = struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiffActor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
+	fake.readManifestMutex.Lock()
+	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
+	fake.readManifestArgsForCall = append(fake.readManifestArgsForCall, struct {
+		pathToManifest string
+	}{pathToManifest})
+	fake.recordInvocation("ReadManifest", []interface{}{pathToManifest})
+	fake.readManifestMutex.Unlock()
+	if fake.ReadManifestStub != nil {
+		return fake.ReadManifestStub(pathToManifest)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readManifestReturns.result1, fake.readManifestReturns.result2
+}
+
+func (fake *FakeDiffActor) ReadManifestCallCount() int {
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	return len(fake.readManifestArgsForCall)
+}
+
+func (fake *FakeDiffActor) ReadManifestArgsForCall(i int) string {
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	return fake.readManifestArgsForCall[i].pathToManifest
+}
+
+func (fake *FakeDiffActor) ReadManifestReturns(result1 []manifest.Application, result2 error) {
+	fake.ReadManifestStub = nil
+	fake.readManifestReturns = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiffActor) ReadManifestReturnsOnCall(i int, result1 []manifest.Application, result2 error) {
+	fake.ReadManifestStub = nil
+	if fake.readManifestReturnsOnCall == nil {
+		fake.readManifestReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Application
+			result2 error
+		})
+	}
+	fake.readManifestReturnsOnCall[i] = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiffActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDiffActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.DiffActor = new(FakeDiffActor)
@@ -0,0 +1,95 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/diagnosticsaction"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeDoctorActor struct {
+	RunChecksStub        func(config diagnosticsaction.Config) []diagnosticsaction.Check
+	runChecksMutex       sync.RWMutex
+	runChecksArgsForCall []struct {
+		config diagnosticsaction.Config
+	}
+	runChecksReturns struct {
+		result1 []diagnosticsaction.Check
+	}
+	runChecksReturnsOnCall map[int]struct {
+		result1 []diagnosticsaction.Check
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDoctorActor) RunChecks(config diagnosticsaction.Config) []diagnosticsaction.Check {
+	fake.runChecksMutex.Lock()
+	ret, specificReturn := fake.runChecksReturnsOnCall[len(fake.runChecksArgsForCall)]
+	fake.runChecksArgsForCall = append(fake.runChecksArgsForCall, struct {
+		config diagnosticsaction.Config
+	}{config})
+	fake.recordInvocation("RunChecks", []interface{}{config})
+	fake.runChecksMutex.Unlock()
+	if fake.RunChecksStub != nil {
+		return fake.RunChecksStub(config)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.runChecksReturns.result1
+}
+
+func (fake *FakeDoctorActor) RunChecksCallCount() int {
+	fake.runChecksMutex.RLock()
+	defer fake.runChecksMutex.RUnlock()
+	return len(fake.runChecksArgsForCall)
+}
+
+func (fake *FakeDoctorActor) RunChecksArgsForCall(i int) diagnosticsaction.Config {
+	fake.runChecksMutex.RLock()
+	defer fake.runChecksMutex.RUnlock()
+	return fake.runChecksArgsForCall[i].config
+}
+
+func (fake *FakeDoctorActor) RunChecksReturns(result1 []diagnosticsaction.Check) {
+	fake.RunChecksStub = nil
+	fake.runChecksReturns = struct {
+		result1 []diagnosticsaction.Check
+	}{result1}
+}
+
+func (fake *FakeDoctorActor) RunChecksReturnsOnCall(i int, result1 []diagnosticsaction.Check) {
+	fake.RunChecksStub = nil
+	if fake.runChecksReturnsOnCall == nil {
+		fake.runChecksReturnsOnCall = make(map[int]struct {
+			result1 []diagnosticsaction.Check
+		})
+	}
+	fake.runChecksReturnsOnCall[i] = struct {
+		result1 []diagnosticsaction.Check
+	}{result1}
+}
+
+func (fake *FakeDoctorActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runChecksMutex.RLock()
+	defer fake.runChecksMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDoctorActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.DoctorActor = new(FakeDoctorActor)
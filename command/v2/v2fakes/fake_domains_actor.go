@@ -0,0 +1,105 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeDomainsActor struct {
+	GetOrganizationDomainSummariesStub        func(orgGUID string) ([]v2action.DomainSummary, v2action.Warnings, error)
+	getOrganizationDomainSummariesMutex       sync.RWMutex
+	getOrganizationDomainSummariesArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationDomainSummariesReturns struct {
+		result1 []v2action.DomainSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationDomainSummariesReturnsOnCall map[int]struct {
+		result1 []v2action.DomainSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDomainsActor) GetOrganizationDomainSummaries(orgGUID string) ([]v2action.DomainSummary, v2action.Warnings, error) {
+	fake.getOrganizationDomainSummariesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationDomainSummariesReturnsOnCall[len(fake.getOrganizationDomainSummariesArgsForCall)]
+	fake.getOrganizationDomainSummariesArgsForCall = append(fake.getOrganizationDomainSummariesArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationDomainSummaries", []interface{}{orgGUID})
+	fake.getOrganizationDomainSummariesMutex.Unlock()
+	if fake.GetOrganizationDomainSummariesStub != nil {
+		return fake.GetOrganizationDomainSummariesStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationDomainSummariesReturns.result1, fake.getOrganizationDomainSummariesReturns.result2, fake.getOrganizationDomainSummariesReturns.result3
+}
+
+func (fake *FakeDomainsActor) GetOrganizationDomainSummariesCallCount() int {
+	fake.getOrganizationDomainSummariesMutex.RLock()
+	defer fake.getOrganizationDomainSummariesMutex.RUnlock()
+	return len(fake.getOrganizationDomainSummariesArgsForCall)
+}
+
+func (fake *FakeDomainsActor) GetOrganizationDomainSummariesArgsForCall(i int) string {
+	fake.getOrganizationDomainSummariesMutex.RLock()
+	defer fake.getOrganizationDomainSummariesMutex.RUnlock()
+	return fake.getOrganizationDomainSummariesArgsForCall[i].orgGUID
+}
+
+func (fake *FakeDomainsActor) GetOrganizationDomainSummariesReturns(result1 []v2action.DomainSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationDomainSummariesStub = nil
+	fake.getOrganizationDomainSummariesReturns = struct {
+		result1 []v2action.DomainSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDomainsActor) GetOrganizationDomainSummariesReturnsOnCall(i int, result1 []v2action.DomainSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationDomainSummariesStub = nil
+	if fake.getOrganizationDomainSummariesReturnsOnCall == nil {
+		fake.getOrganizationDomainSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.DomainSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationDomainSummariesReturnsOnCall[i] = struct {
+		result1 []v2action.DomainSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDomainsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getOrganizationDomainSummariesMutex.RLock()
+	defer fake.getOrganizationDomainSummariesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDomainsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.DomainsActor = new(FakeDomainsActor)
@@ -0,0 +1,107 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeEnvActor struct {
+	GetEnvironmentByApplicationNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Environment, v3action.Warnings, error)
+	getEnvironmentByApplicationNameAndSpaceMutex       sync.RWMutex
+	getEnvironmentByApplicationNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getEnvironmentByApplicationNameAndSpaceReturns struct {
+		result1 v3action.Environment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getEnvironmentByApplicationNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Environment
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEnvActor) GetEnvironmentByApplicationNameAndSpace(appName string, spaceGUID string) (v3action.Environment, v3action.Warnings, error) {
+	fake.getEnvironmentByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getEnvironmentByApplicationNameAndSpaceReturnsOnCall[len(fake.getEnvironmentByApplicationNameAndSpaceArgsForCall)]
+	fake.getEnvironmentByApplicationNameAndSpaceArgsForCall = append(fake.getEnvironmentByApplicationNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetEnvironmentByApplicationNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getEnvironmentByApplicationNameAndSpaceMutex.Unlock()
+	if fake.GetEnvironmentByApplicationNameAndSpaceStub != nil {
+		return fake.GetEnvironmentByApplicationNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getEnvironmentByApplicationNameAndSpaceReturns.result1, fake.getEnvironmentByApplicationNameAndSpaceReturns.result2, fake.getEnvironmentByApplicationNameAndSpaceReturns.result3
+}
+
+func (fake *FakeEnvActor) GetEnvironmentByApplicationNameAndSpaceCallCount() int {
+	fake.getEnvironmentByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEnvironmentByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.getEnvironmentByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeEnvActor) GetEnvironmentByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getEnvironmentByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEnvironmentByApplicationNameAndSpaceMutex.RUnlock()
+	return fake.getEnvironmentByApplicationNameAndSpaceArgsForCall[i].appName, fake.getEnvironmentByApplicationNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeEnvActor) GetEnvironmentByApplicationNameAndSpaceReturns(result1 v3action.Environment, result2 v3action.Warnings, result3 error) {
+	fake.GetEnvironmentByApplicationNameAndSpaceStub = nil
+	fake.getEnvironmentByApplicationNameAndSpaceReturns = struct {
+		result1 v3action.Environment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEnvActor) GetEnvironmentByApplicationNameAndSpaceReturnsOnCall(i int, result1 v3action.Environment, result2 v3action.Warnings, result3 error) {
+	fake.GetEnvironmentByApplicationNameAndSpaceStub = nil
+	if fake.getEnvironmentByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.getEnvironmentByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Environment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getEnvironmentByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Environment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEnvActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getEnvironmentByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEnvironmentByApplicationNameAndSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeEnvActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.EnvActor = new(FakeEnvActor)
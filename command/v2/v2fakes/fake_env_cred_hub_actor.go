@@ -0,0 +1,104 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeEnvCredHubActor struct {
+	InterpolateServiceCredentialsStub        func(vcapServices map[string]interface{}) (map[string]interface{}, []string, error)
+	interpolateServiceCredentialsMutex       sync.RWMutex
+	interpolateServiceCredentialsArgsForCall []struct {
+		vcapServices map[string]interface{}
+	}
+	interpolateServiceCredentialsReturns struct {
+		result1 map[string]interface{}
+		result2 []string
+		result3 error
+	}
+	interpolateServiceCredentialsReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 []string
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEnvCredHubActor) InterpolateServiceCredentials(vcapServices map[string]interface{}) (map[string]interface{}, []string, error) {
+	fake.interpolateServiceCredentialsMutex.Lock()
+	ret, specificReturn := fake.interpolateServiceCredentialsReturnsOnCall[len(fake.interpolateServiceCredentialsArgsForCall)]
+	fake.interpolateServiceCredentialsArgsForCall = append(fake.interpolateServiceCredentialsArgsForCall, struct {
+		vcapServices map[string]interface{}
+	}{vcapServices})
+	fake.recordInvocation("InterpolateServiceCredentials", []interface{}{vcapServices})
+	fake.interpolateServiceCredentialsMutex.Unlock()
+	if fake.InterpolateServiceCredentialsStub != nil {
+		return fake.InterpolateServiceCredentialsStub(vcapServices)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.interpolateServiceCredentialsReturns.result1, fake.interpolateServiceCredentialsReturns.result2, fake.interpolateServiceCredentialsReturns.result3
+}
+
+func (fake *FakeEnvCredHubActor) InterpolateServiceCredentialsCallCount() int {
+	fake.interpolateServiceCredentialsMutex.RLock()
+	defer fake.interpolateServiceCredentialsMutex.RUnlock()
+	return len(fake.interpolateServiceCredentialsArgsForCall)
+}
+
+func (fake *FakeEnvCredHubActor) InterpolateServiceCredentialsArgsForCall(i int) map[string]interface{} {
+	fake.interpolateServiceCredentialsMutex.RLock()
+	defer fake.interpolateServiceCredentialsMutex.RUnlock()
+	return fake.interpolateServiceCredentialsArgsForCall[i].vcapServices
+}
+
+func (fake *FakeEnvCredHubActor) InterpolateServiceCredentialsReturns(result1 map[string]interface{}, result2 []string, result3 error) {
+	fake.InterpolateServiceCredentialsStub = nil
+	fake.interpolateServiceCredentialsReturns = struct {
+		result1 map[string]interface{}
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEnvCredHubActor) InterpolateServiceCredentialsReturnsOnCall(i int, result1 map[string]interface{}, result2 []string, result3 error) {
+	fake.InterpolateServiceCredentialsStub = nil
+	if fake.interpolateServiceCredentialsReturnsOnCall == nil {
+		fake.interpolateServiceCredentialsReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 []string
+			result3 error
+		})
+	}
+	fake.interpolateServiceCredentialsReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEnvCredHubActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.interpolateServiceCredentialsMutex.RLock()
+	defer fake.interpolateServiceCredentialsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeEnvCredHubActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.EnvCredHubActor = new(FakeEnvCredHubActor)
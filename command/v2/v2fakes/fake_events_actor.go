@@ -0,0 +1,258 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeEventsActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRecentApplicationEventsStub        func(appGUID string) ([]v2action.Event, v2action.Warnings, error)
+	getRecentApplicationEventsMutex       sync.RWMutex
+	getRecentApplicationEventsArgsForCall []struct {
+		appGUID string
+	}
+	getRecentApplicationEventsReturns struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRecentApplicationEventsReturnsOnCall map[int]struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStreamingApplicationEventsStub        func(appGUID string, previousEvents []v2action.Event, config v2action.Config) (<-chan v2action.Event, <-chan string, <-chan error)
+	getStreamingApplicationEventsMutex       sync.RWMutex
+	getStreamingApplicationEventsArgsForCall []struct {
+		appGUID        string
+		previousEvents []v2action.Event
+		config         v2action.Config
+	}
+	getStreamingApplicationEventsReturns struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}
+	getStreamingApplicationEventsReturnsOnCall map[int]struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) GetRecentApplicationEvents(appGUID string) ([]v2action.Event, v2action.Warnings, error) {
+	fake.getRecentApplicationEventsMutex.Lock()
+	ret, specificReturn := fake.getRecentApplicationEventsReturnsOnCall[len(fake.getRecentApplicationEventsArgsForCall)]
+	fake.getRecentApplicationEventsArgsForCall = append(fake.getRecentApplicationEventsArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetRecentApplicationEvents", []interface{}{appGUID})
+	fake.getRecentApplicationEventsMutex.Unlock()
+	if fake.GetRecentApplicationEventsStub != nil {
+		return fake.GetRecentApplicationEventsStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRecentApplicationEventsReturns.result1, fake.getRecentApplicationEventsReturns.result2, fake.getRecentApplicationEventsReturns.result3
+}
+
+func (fake *FakeEventsActor) GetRecentApplicationEventsCallCount() int {
+	fake.getRecentApplicationEventsMutex.RLock()
+	defer fake.getRecentApplicationEventsMutex.RUnlock()
+	return len(fake.getRecentApplicationEventsArgsForCall)
+}
+
+func (fake *FakeEventsActor) GetRecentApplicationEventsArgsForCall(i int) string {
+	fake.getRecentApplicationEventsMutex.RLock()
+	defer fake.getRecentApplicationEventsMutex.RUnlock()
+	return fake.getRecentApplicationEventsArgsForCall[i].appGUID
+}
+
+func (fake *FakeEventsActor) GetRecentApplicationEventsReturns(result1 []v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.GetRecentApplicationEventsStub = nil
+	fake.getRecentApplicationEventsReturns = struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) GetRecentApplicationEventsReturnsOnCall(i int, result1 []v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.GetRecentApplicationEventsStub = nil
+	if fake.getRecentApplicationEventsReturnsOnCall == nil {
+		fake.getRecentApplicationEventsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Event
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentApplicationEventsReturnsOnCall[i] = struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) GetStreamingApplicationEvents(appGUID string, previousEvents []v2action.Event, config v2action.Config) (<-chan v2action.Event, <-chan string, <-chan error) {
+	var previousEventsCopy []v2action.Event
+	if previousEvents != nil {
+		previousEventsCopy = make([]v2action.Event, len(previousEvents))
+		copy(previousEventsCopy, previousEvents)
+	}
+	fake.getStreamingApplicationEventsMutex.Lock()
+	ret, specificReturn := fake.getStreamingApplicationEventsReturnsOnCall[len(fake.getStreamingApplicationEventsArgsForCall)]
+	fake.getStreamingApplicationEventsArgsForCall = append(fake.getStreamingApplicationEventsArgsForCall, struct {
+		appGUID        string
+		previousEvents []v2action.Event
+		config         v2action.Config
+	}{appGUID, previousEventsCopy, config})
+	fake.recordInvocation("GetStreamingApplicationEvents", []interface{}{appGUID, previousEventsCopy, config})
+	fake.getStreamingApplicationEventsMutex.Unlock()
+	if fake.GetStreamingApplicationEventsStub != nil {
+		return fake.GetStreamingApplicationEventsStub(appGUID, previousEvents, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStreamingApplicationEventsReturns.result1, fake.getStreamingApplicationEventsReturns.result2, fake.getStreamingApplicationEventsReturns.result3
+}
+
+func (fake *FakeEventsActor) GetStreamingApplicationEventsCallCount() int {
+	fake.getStreamingApplicationEventsMutex.RLock()
+	defer fake.getStreamingApplicationEventsMutex.RUnlock()
+	return len(fake.getStreamingApplicationEventsArgsForCall)
+}
+
+func (fake *FakeEventsActor) GetStreamingApplicationEventsArgsForCall(i int) (string, []v2action.Event, v2action.Config) {
+	fake.getStreamingApplicationEventsMutex.RLock()
+	defer fake.getStreamingApplicationEventsMutex.RUnlock()
+	return fake.getStreamingApplicationEventsArgsForCall[i].appGUID, fake.getStreamingApplicationEventsArgsForCall[i].previousEvents, fake.getStreamingApplicationEventsArgsForCall[i].config
+}
+
+func (fake *FakeEventsActor) GetStreamingApplicationEventsReturns(result1 <-chan v2action.Event, result2 <-chan string, result3 <-chan error) {
+	fake.GetStreamingApplicationEventsStub = nil
+	fake.getStreamingApplicationEventsReturns = struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) GetStreamingApplicationEventsReturnsOnCall(i int, result1 <-chan v2action.Event, result2 <-chan string, result3 <-chan error) {
+	fake.GetStreamingApplicationEventsStub = nil
+	if fake.getStreamingApplicationEventsReturnsOnCall == nil {
+		fake.getStreamingApplicationEventsReturnsOnCall = make(map[int]struct {
+			result1 <-chan v2action.Event
+			result2 <-chan string
+			result3 <-chan error
+		})
+	}
+	fake.getStreamingApplicationEventsReturnsOnCall[i] = struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentApplicationEventsMutex.RLock()
+	defer fake.getRecentApplicationEventsMutex.RUnlock()
+	fake.getStreamingApplicationEventsMutex.RLock()
+	defer fake.getStreamingApplicationEventsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeEventsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.EventsActor = new(FakeEventsActor)
@@ -0,0 +1,171 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeExportSpaceActor struct {
+	ExportSpaceStub        func(orgGUID string, spaceGUID string) (manifest.Space, pushaction.Warnings, error)
+	exportSpaceMutex       sync.RWMutex
+	exportSpaceArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+	}
+	exportSpaceReturns struct {
+		result1 manifest.Space
+		result2 pushaction.Warnings
+		result3 error
+	}
+	exportSpaceReturnsOnCall map[int]struct {
+		result1 manifest.Space
+		result2 pushaction.Warnings
+		result3 error
+	}
+	WriteSpaceFileStub        func(pathToSpace string, space manifest.Space) error
+	writeSpaceFileMutex       sync.RWMutex
+	writeSpaceFileArgsForCall []struct {
+		pathToSpace string
+		space       manifest.Space
+	}
+	writeSpaceFileReturns struct {
+		result1 error
+	}
+	writeSpaceFileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExportSpaceActor) ExportSpace(orgGUID string, spaceGUID string) (manifest.Space, pushaction.Warnings, error) {
+	fake.exportSpaceMutex.Lock()
+	ret, specificReturn := fake.exportSpaceReturnsOnCall[len(fake.exportSpaceArgsForCall)]
+	fake.exportSpaceArgsForCall = append(fake.exportSpaceArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+	}{orgGUID, spaceGUID})
+	fake.recordInvocation("ExportSpace", []interface{}{orgGUID, spaceGUID})
+	fake.exportSpaceMutex.Unlock()
+	if fake.ExportSpaceStub != nil {
+		return fake.ExportSpaceStub(orgGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.exportSpaceReturns.result1, fake.exportSpaceReturns.result2, fake.exportSpaceReturns.result3
+}
+
+func (fake *FakeExportSpaceActor) ExportSpaceCallCount() int {
+	fake.exportSpaceMutex.RLock()
+	defer fake.exportSpaceMutex.RUnlock()
+	return len(fake.exportSpaceArgsForCall)
+}
+
+func (fake *FakeExportSpaceActor) ExportSpaceArgsForCall(i int) (string, string) {
+	fake.exportSpaceMutex.RLock()
+	defer fake.exportSpaceMutex.RUnlock()
+	return fake.exportSpaceArgsForCall[i].orgGUID, fake.exportSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeExportSpaceActor) ExportSpaceReturns(result1 manifest.Space, result2 pushaction.Warnings, result3 error) {
+	fake.ExportSpaceStub = nil
+	fake.exportSpaceReturns = struct {
+		result1 manifest.Space
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportSpaceActor) ExportSpaceReturnsOnCall(i int, result1 manifest.Space, result2 pushaction.Warnings, result3 error) {
+	fake.ExportSpaceStub = nil
+	if fake.exportSpaceReturnsOnCall == nil {
+		fake.exportSpaceReturnsOnCall = make(map[int]struct {
+			result1 manifest.Space
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.exportSpaceReturnsOnCall[i] = struct {
+		result1 manifest.Space
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportSpaceActor) WriteSpaceFile(pathToSpace string, space manifest.Space) error {
+	fake.writeSpaceFileMutex.Lock()
+	ret, specificReturn := fake.writeSpaceFileReturnsOnCall[len(fake.writeSpaceFileArgsForCall)]
+	fake.writeSpaceFileArgsForCall = append(fake.writeSpaceFileArgsForCall, struct {
+		pathToSpace string
+		space       manifest.Space
+	}{pathToSpace, space})
+	fake.recordInvocation("WriteSpaceFile", []interface{}{pathToSpace, space})
+	fake.writeSpaceFileMutex.Unlock()
+	if fake.WriteSpaceFileStub != nil {
+		return fake.WriteSpaceFileStub(pathToSpace, space)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.writeSpaceFileReturns.result1
+}
+
+func (fake *FakeExportSpaceActor) WriteSpaceFileCallCount() int {
+	fake.writeSpaceFileMutex.RLock()
+	defer fake.writeSpaceFileMutex.RUnlock()
+	return len(fake.writeSpaceFileArgsForCall)
+}
+
+func (fake *FakeExportSpaceActor) WriteSpaceFileArgsForCall(i int) (string, manifest.Space) {
+	fake.writeSpaceFileMutex.RLock()
+	defer fake.writeSpaceFileMutex.RUnlock()
+	return fake.writeSpaceFileArgsForCall[i].pathToSpace, fake.writeSpaceFileArgsForCall[i].space
+}
+
+func (fake *FakeExportSpaceActor) WriteSpaceFileReturns(result1 error) {
+	fake.WriteSpaceFileStub = nil
+	fake.writeSpaceFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeExportSpaceActor) WriteSpaceFileReturnsOnCall(i int, result1 error) {
+	fake.WriteSpaceFileStub = nil
+	if fake.writeSpaceFileReturnsOnCall == nil {
+		fake.writeSpaceFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeSpaceFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeExportSpaceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.exportSpaceMutex.RLock()
+	defer fake.exportSpaceMutex.RUnlock()
+	fake.writeSpaceFileMutex.RLock()
+	defer fake.writeSpaceFileMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeExportSpaceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ExportSpaceActor = new(FakeExportSpaceActor)
@@ -0,0 +1,182 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeFilesActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSSHAuthenticationForApplicationInstanceStub        func(app v2action.Application, instanceIndex int, accessToken string) (v2action.SSHAuthentication, v2action.Warnings, error)
+	getSSHAuthenticationForApplicationInstanceMutex       sync.RWMutex
+	getSSHAuthenticationForApplicationInstanceArgsForCall []struct {
+		app           v2action.Application
+		instanceIndex int
+		accessToken   string
+	}
+	getSSHAuthenticationForApplicationInstanceReturns struct {
+		result1 v2action.SSHAuthentication
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSSHAuthenticationForApplicationInstanceReturnsOnCall map[int]struct {
+		result1 v2action.SSHAuthentication
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFilesActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeFilesActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeFilesActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeFilesActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeFilesActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeFilesActor) GetSSHAuthenticationForApplicationInstance(app v2action.Application, instanceIndex int, accessToken string) (v2action.SSHAuthentication, v2action.Warnings, error) {
+	fake.getSSHAuthenticationForApplicationInstanceMutex.Lock()
+	ret, specificReturn := fake.getSSHAuthenticationForApplicationInstanceReturnsOnCall[len(fake.getSSHAuthenticationForApplicationInstanceArgsForCall)]
+	fake.getSSHAuthenticationForApplicationInstanceArgsForCall = append(fake.getSSHAuthenticationForApplicationInstanceArgsForCall, struct {
+		app           v2action.Application
+		instanceIndex int
+		accessToken   string
+	}{app, instanceIndex, accessToken})
+	fake.recordInvocation("GetSSHAuthenticationForApplicationInstance", []interface{}{app, instanceIndex, accessToken})
+	fake.getSSHAuthenticationForApplicationInstanceMutex.Unlock()
+	if fake.GetSSHAuthenticationForApplicationInstanceStub != nil {
+		return fake.GetSSHAuthenticationForApplicationInstanceStub(app, instanceIndex, accessToken)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSSHAuthenticationForApplicationInstanceReturns.result1, fake.getSSHAuthenticationForApplicationInstanceReturns.result2, fake.getSSHAuthenticationForApplicationInstanceReturns.result3
+}
+
+func (fake *FakeFilesActor) GetSSHAuthenticationForApplicationInstanceCallCount() int {
+	fake.getSSHAuthenticationForApplicationInstanceMutex.RLock()
+	defer fake.getSSHAuthenticationForApplicationInstanceMutex.RUnlock()
+	return len(fake.getSSHAuthenticationForApplicationInstanceArgsForCall)
+}
+
+func (fake *FakeFilesActor) GetSSHAuthenticationForApplicationInstanceArgsForCall(i int) (v2action.Application, int, string) {
+	fake.getSSHAuthenticationForApplicationInstanceMutex.RLock()
+	defer fake.getSSHAuthenticationForApplicationInstanceMutex.RUnlock()
+	return fake.getSSHAuthenticationForApplicationInstanceArgsForCall[i].app, fake.getSSHAuthenticationForApplicationInstanceArgsForCall[i].instanceIndex, fake.getSSHAuthenticationForApplicationInstanceArgsForCall[i].accessToken
+}
+
+func (fake *FakeFilesActor) GetSSHAuthenticationForApplicationInstanceReturns(result1 v2action.SSHAuthentication, result2 v2action.Warnings, result3 error) {
+	fake.GetSSHAuthenticationForApplicationInstanceStub = nil
+	fake.getSSHAuthenticationForApplicationInstanceReturns = struct {
+		result1 v2action.SSHAuthentication
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeFilesActor) GetSSHAuthenticationForApplicationInstanceReturnsOnCall(i int, result1 v2action.SSHAuthentication, result2 v2action.Warnings, result3 error) {
+	fake.GetSSHAuthenticationForApplicationInstanceStub = nil
+	if fake.getSSHAuthenticationForApplicationInstanceReturnsOnCall == nil {
+		fake.getSSHAuthenticationForApplicationInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.SSHAuthentication
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSSHAuthenticationForApplicationInstanceReturnsOnCall[i] = struct {
+		result1 v2action.SSHAuthentication
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeFilesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getSSHAuthenticationForApplicationInstanceMutex.RLock()
+	defer fake.getSSHAuthenticationForApplicationInstanceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeFilesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.FilesActor = new(FakeFilesActor)
@@ -0,0 +1,102 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/clissh"
+)
+
+type FakeFilesSecureShell struct {
+	RunStub        func(options clissh.Options, command string) ([]byte, error)
+	runMutex       sync.RWMutex
+	runArgsForCall []struct {
+		options clissh.Options
+		command string
+	}
+	runReturns struct {
+		result1 []byte
+		result2 error
+	}
+	runReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFilesSecureShell) Run(options clissh.Options, command string) ([]byte, error) {
+	fake.runMutex.Lock()
+	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
+	fake.runArgsForCall = append(fake.runArgsForCall, struct {
+		options clissh.Options
+		command string
+	}{options, command})
+	fake.recordInvocation("Run", []interface{}{options, command})
+	fake.runMutex.Unlock()
+	if fake.RunStub != nil {
+		return fake.RunStub(options, command)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.runReturns.result1, fake.runReturns.result2
+}
+
+func (fake *FakeFilesSecureShell) RunCallCount() int {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return len(fake.runArgsForCall)
+}
+
+func (fake *FakeFilesSecureShell) RunArgsForCall(i int) (clissh.Options, string) {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return fake.runArgsForCall[i].options, fake.runArgsForCall[i].command
+}
+
+func (fake *FakeFilesSecureShell) RunReturns(result1 []byte, result2 error) {
+	fake.RunStub = nil
+	fake.runReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFilesSecureShell) RunReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.RunStub = nil
+	if fake.runReturnsOnCall == nil {
+		fake.runReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.runReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFilesSecureShell) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeFilesSecureShell) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.FilesSecureShell = new(FakeFilesSecureShell)
@@ -0,0 +1,405 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeImportSpaceActor struct {
+	ApplyStub        func(ctx context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	applyMutex       sync.RWMutex
+	applyArgsForCall []struct {
+		ctx    context.Context
+		config pushaction.ApplicationConfig
+	}
+	applyReturns struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}
+	applyReturnsOnCall map[int]struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}
+	BindServicesToApplicationStub        func(appName string, spaceGUID string, serviceInstanceNames []string) (pushaction.Warnings, error)
+	bindServicesToApplicationMutex       sync.RWMutex
+	bindServicesToApplicationArgsForCall []struct {
+		appName              string
+		spaceGUID            string
+		serviceInstanceNames []string
+	}
+	bindServicesToApplicationReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	bindServicesToApplicationReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	ConvertToApplicationConfigStub        func(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	convertToApplicationConfigMutex       sync.RWMutex
+	convertToApplicationConfigArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		apps      []manifest.Application
+		reset     bool
+	}
+	convertToApplicationConfigReturns struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	convertToApplicationConfigReturnsOnCall map[int]struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	CreateSpaceServicesStub        func(spaceGUID string, services []manifest.Service) (pushaction.Warnings, error)
+	createSpaceServicesMutex       sync.RWMutex
+	createSpaceServicesArgsForCall []struct {
+		spaceGUID string
+		services  []manifest.Service
+	}
+	createSpaceServicesReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	createSpaceServicesReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	ReadSpaceFileStub        func(pathToSpace string) (manifest.Space, error)
+	readSpaceFileMutex       sync.RWMutex
+	readSpaceFileArgsForCall []struct {
+		pathToSpace string
+	}
+	readSpaceFileReturns struct {
+		result1 manifest.Space
+		result2 error
+	}
+	readSpaceFileReturnsOnCall map[int]struct {
+		result1 manifest.Space
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImportSpaceActor) Apply(ctx context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+	fake.applyMutex.Lock()
+	ret, specificReturn := fake.applyReturnsOnCall[len(fake.applyArgsForCall)]
+	fake.applyArgsForCall = append(fake.applyArgsForCall, struct {
+		ctx    context.Context
+		config pushaction.ApplicationConfig
+	}{ctx, config})
+	fake.recordInvocation("Apply", []interface{}{ctx, config})
+	fake.applyMutex.Unlock()
+	if fake.ApplyStub != nil {
+		return fake.ApplyStub(ctx, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.applyReturns.result1, fake.applyReturns.result2, fake.applyReturns.result3
+}
+
+func (fake *FakeImportSpaceActor) ApplyCallCount() int {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return len(fake.applyArgsForCall)
+}
+
+func (fake *FakeImportSpaceActor) ApplyArgsForCall(i int) (context.Context, pushaction.ApplicationConfig) {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return fake.applyArgsForCall[i].ctx, fake.applyArgsForCall[i].config
+}
+
+func (fake *FakeImportSpaceActor) ApplyReturns(result1 <-chan pushaction.Event, result2 <-chan pushaction.Warnings, result3 <-chan error) {
+	fake.ApplyStub = nil
+	fake.applyReturns = struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportSpaceActor) ApplyReturnsOnCall(i int, result1 <-chan pushaction.Event, result2 <-chan pushaction.Warnings, result3 <-chan error) {
+	fake.ApplyStub = nil
+	if fake.applyReturnsOnCall == nil {
+		fake.applyReturnsOnCall = make(map[int]struct {
+			result1 <-chan pushaction.Event
+			result2 <-chan pushaction.Warnings
+			result3 <-chan error
+		})
+	}
+	fake.applyReturnsOnCall[i] = struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportSpaceActor) BindServicesToApplication(appName string, spaceGUID string, serviceInstanceNames []string) (pushaction.Warnings, error) {
+	var serviceInstanceNamesCopy []string
+	if serviceInstanceNames != nil {
+		serviceInstanceNamesCopy = make([]string, len(serviceInstanceNames))
+		copy(serviceInstanceNamesCopy, serviceInstanceNames)
+	}
+	fake.bindServicesToApplicationMutex.Lock()
+	ret, specificReturn := fake.bindServicesToApplicationReturnsOnCall[len(fake.bindServicesToApplicationArgsForCall)]
+	fake.bindServicesToApplicationArgsForCall = append(fake.bindServicesToApplicationArgsForCall, struct {
+		appName              string
+		spaceGUID            string
+		serviceInstanceNames []string
+	}{appName, spaceGUID, serviceInstanceNamesCopy})
+	fake.recordInvocation("BindServicesToApplication", []interface{}{appName, spaceGUID, serviceInstanceNamesCopy})
+	fake.bindServicesToApplicationMutex.Unlock()
+	if fake.BindServicesToApplicationStub != nil {
+		return fake.BindServicesToApplicationStub(appName, spaceGUID, serviceInstanceNames)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.bindServicesToApplicationReturns.result1, fake.bindServicesToApplicationReturns.result2
+}
+
+func (fake *FakeImportSpaceActor) BindServicesToApplicationCallCount() int {
+	fake.bindServicesToApplicationMutex.RLock()
+	defer fake.bindServicesToApplicationMutex.RUnlock()
+	return len(fake.bindServicesToApplicationArgsForCall)
+}
+
+func (fake *FakeImportSpaceActor) BindServicesToApplicationArgsForCall(i int) (string, string, []string) {
+	fake.bindServicesToApplicationMutex.RLock()
+	defer fake.bindServicesToApplicationMutex.RUnlock()
+	return fake.bindServicesToApplicationArgsForCall[i].appName, fake.bindServicesToApplicationArgsForCall[i].spaceGUID, fake.bindServicesToApplicationArgsForCall[i].serviceInstanceNames
+}
+
+func (fake *FakeImportSpaceActor) BindServicesToApplicationReturns(result1 pushaction.Warnings, result2 error) {
+	fake.BindServicesToApplicationStub = nil
+	fake.bindServicesToApplicationReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportSpaceActor) BindServicesToApplicationReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.BindServicesToApplicationStub = nil
+	if fake.bindServicesToApplicationReturnsOnCall == nil {
+		fake.bindServicesToApplicationReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.bindServicesToApplicationReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportSpaceActor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
+	var appsCopy []manifest.Application
+	if apps != nil {
+		appsCopy = make([]manifest.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.convertToApplicationConfigMutex.Lock()
+	ret, specificReturn := fake.convertToApplicationConfigReturnsOnCall[len(fake.convertToApplicationConfigArgsForCall)]
+	fake.convertToApplicationConfigArgsForCall = append(fake.convertToApplicationConfigArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		apps      []manifest.Application
+		reset     bool
+	}{orgGUID, spaceGUID, appsCopy, reset})
+	fake.recordInvocation("ConvertToApplicationConfig", []interface{}{orgGUID, spaceGUID, appsCopy, reset})
+	fake.convertToApplicationConfigMutex.Unlock()
+	if fake.ConvertToApplicationConfigStub != nil {
+		return fake.ConvertToApplicationConfigStub(orgGUID, spaceGUID, apps, reset)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.convertToApplicationConfigReturns.result1, fake.convertToApplicationConfigReturns.result2, fake.convertToApplicationConfigReturns.result3
+}
+
+func (fake *FakeImportSpaceActor) ConvertToApplicationConfigCallCount() int {
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	return len(fake.convertToApplicationConfigArgsForCall)
+}
+
+func (fake *FakeImportSpaceActor) ConvertToApplicationConfigArgsForCall(i int) (string, string, []manifest.Application, bool) {
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	return fake.convertToApplicationConfigArgsForCall[i].orgGUID, fake.convertToApplicationConfigArgsForCall[i].spaceGUID, fake.convertToApplicationConfigArgsForCall[i].apps, fake.convertToApplicationConfigArgsForCall[i].reset
+}
+
+func (fake *FakeImportSpaceActor) ConvertToApplicationConfigReturns(result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigStub = nil
+	fake.convertToApplicationConfigReturns = struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportSpaceActor) ConvertToApplicationConfigReturnsOnCall(i int, result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigStub = nil
+	if fake.convertToApplicationConfigReturnsOnCall == nil {
+		fake.convertToApplicationConfigReturnsOnCall = make(map[int]struct {
+			result1 []pushaction.ApplicationConfig
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.convertToApplicationConfigReturnsOnCall[i] = struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportSpaceActor) CreateSpaceServices(spaceGUID string, services []manifest.Service) (pushaction.Warnings, error) {
+	var servicesCopy []manifest.Service
+	if services != nil {
+		servicesCopy = make([]manifest.Service, len(services))
+		copy(servicesCopy, services)
+	}
+	fake.createSpaceServicesMutex.Lock()
+	ret, specificReturn := fake.createSpaceServicesReturnsOnCall[len(fake.createSpaceServicesArgsForCall)]
+	fake.createSpaceServicesArgsForCall = append(fake.createSpaceServicesArgsForCall, struct {
+		spaceGUID string
+		services  []manifest.Service
+	}{spaceGUID, servicesCopy})
+	fake.recordInvocation("CreateSpaceServices", []interface{}{spaceGUID, servicesCopy})
+	fake.createSpaceServicesMutex.Unlock()
+	if fake.CreateSpaceServicesStub != nil {
+		return fake.CreateSpaceServicesStub(spaceGUID, services)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createSpaceServicesReturns.result1, fake.createSpaceServicesReturns.result2
+}
+
+func (fake *FakeImportSpaceActor) CreateSpaceServicesCallCount() int {
+	fake.createSpaceServicesMutex.RLock()
+	defer fake.createSpaceServicesMutex.RUnlock()
+	return len(fake.createSpaceServicesArgsForCall)
+}
+
+func (fake *FakeImportSpaceActor) CreateSpaceServicesArgsForCall(i int) (string, []manifest.Service) {
+	fake.createSpaceServicesMutex.RLock()
+	defer fake.createSpaceServicesMutex.RUnlock()
+	return fake.createSpaceServicesArgsForCall[i].spaceGUID, fake.createSpaceServicesArgsForCall[i].services
+}
+
+func (fake *FakeImportSpaceActor) CreateSpaceServicesReturns(result1 pushaction.Warnings, result2 error) {
+	fake.CreateSpaceServicesStub = nil
+	fake.createSpaceServicesReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportSpaceActor) CreateSpaceServicesReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.CreateSpaceServicesStub = nil
+	if fake.createSpaceServicesReturnsOnCall == nil {
+		fake.createSpaceServicesReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.createSpaceServicesReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportSpaceActor) ReadSpaceFile(pathToSpace string) (manifest.Space, error) {
+	fake.readSpaceFileMutex.Lock()
+	ret, specificReturn := fake.readSpaceFileReturnsOnCall[len(fake.readSpaceFileArgsForCall)]
+	fake.readSpaceFileArgsForCall = append(fake.readSpaceFileArgsForCall, struct {
+		pathToSpace string
+	}{pathToSpace})
+	fake.recordInvocation("ReadSpaceFile", []interface{}{pathToSpace})
+	fake.readSpaceFileMutex.Unlock()
+	if fake.ReadSpaceFileStub != nil {
+		return fake.ReadSpaceFileStub(pathToSpace)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readSpaceFileReturns.result1, fake.readSpaceFileReturns.result2
+}
+
+func (fake *FakeImportSpaceActor) ReadSpaceFileCallCount() int {
+	fake.readSpaceFileMutex.RLock()
+	defer fake.readSpaceFileMutex.RUnlock()
+	return len(fake.readSpaceFileArgsForCall)
+}
+
+func (fake *FakeImportSpaceActor) ReadSpaceFileArgsForCall(i int) string {
+	fake.readSpaceFileMutex.RLock()
+	defer fake.readSpaceFileMutex.RUnlock()
+	return fake.readSpaceFileArgsForCall[i].pathToSpace
+}
+
+func (fake *FakeImportSpaceActor) ReadSpaceFileReturns(result1 manifest.Space, result2 error) {
+	fake.ReadSpaceFileStub = nil
+	fake.readSpaceFileReturns = struct {
+		result1 manifest.Space
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportSpaceActor) ReadSpaceFileReturnsOnCall(i int, result1 manifest.Space, result2 error) {
+	fake.ReadSpaceFileStub = nil
+	if fake.readSpaceFileReturnsOnCall == nil {
+		fake.readSpaceFileReturnsOnCall = make(map[int]struct {
+			result1 manifest.Space
+			result2 error
+		})
+	}
+	fake.readSpaceFileReturnsOnCall[i] = struct {
+		result1 manifest.Space
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportSpaceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	fake.bindServicesToApplicationMutex.RLock()
+	defer fake.bindServicesToApplicationMutex.RUnlock()
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	fake.createSpaceServicesMutex.RLock()
+	defer fake.createSpaceServicesMutex.RUnlock()
+	fake.readSpaceFileMutex.RLock()
+	defer fake.readSpaceFileMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeImportSpaceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ImportSpaceActor = new(FakeImportSpaceActor)
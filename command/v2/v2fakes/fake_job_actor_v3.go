@@ -0,0 +1,176 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeJobActorV3 struct {
+	GetJobStub        func(guid string) (v3action.Job, v3action.Warnings, error)
+	getJobMutex       sync.RWMutex
+	getJobArgsForCall []struct {
+		guid string
+	}
+	getJobReturns struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}
+	getJobReturnsOnCall map[int]struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}
+	PollJobStub        func(job v3action.Job) (v3action.Job, v3action.Warnings, error)
+	pollJobMutex       sync.RWMutex
+	pollJobArgsForCall []struct {
+		job v3action.Job
+	}
+	pollJobReturns struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}
+	pollJobReturnsOnCall map[int]struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeJobActorV3) GetJob(guid string) (v3action.Job, v3action.Warnings, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetJob", []interface{}{guid})
+	fake.getJobMutex.Unlock()
+	if fake.GetJobStub != nil {
+		return fake.GetJobStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getJobReturns.result1, fake.getJobReturns.result2, fake.getJobReturns.result3
+}
+
+func (fake *FakeJobActorV3) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
+}
+
+func (fake *FakeJobActorV3) GetJobArgsForCall(i int) string {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return fake.getJobArgsForCall[i].guid
+}
+
+func (fake *FakeJobActorV3) GetJobReturns(result1 v3action.Job, result2 v3action.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJobActorV3) GetJobReturnsOnCall(i int, result1 v3action.Job, result2 v3action.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 v3action.Job
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJobActorV3) PollJob(job v3action.Job) (v3action.Job, v3action.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		job v3action.Job
+	}{job})
+	fake.recordInvocation("PollJob", []interface{}{job})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(job)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.pollJobReturns.result1, fake.pollJobReturns.result2, fake.pollJobReturns.result3
+}
+
+func (fake *FakeJobActorV3) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeJobActorV3) PollJobArgsForCall(i int) v3action.Job {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return fake.pollJobArgsForCall[i].job
+}
+
+func (fake *FakeJobActorV3) PollJobReturns(result1 v3action.Job, result2 v3action.Warnings, result3 error) {
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJobActorV3) PollJobReturnsOnCall(i int, result1 v3action.Job, result2 v3action.Warnings, result3 error) {
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 v3action.Job
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJobActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeJobActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.JobActorV3 = new(FakeJobActorV3)
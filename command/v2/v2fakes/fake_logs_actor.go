@@ -27,6 +27,26 @@ type FakeLogsActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetRecentLogsForApplicationByNameAndSpaceWithinWindowStub        func(appName string, spaceGUID string, window v2action.RecentLogsWindow, noaaClient v2action.NOAAClient, logCacheClient v2action.LogCacheClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
+	getRecentLogsForApplicationByNameAndSpaceWithinWindowMutex       sync.RWMutex
+	getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall []struct {
+		appName        string
+		spaceGUID      string
+		window         v2action.RecentLogsWindow
+		noaaClient     v2action.NOAAClient
+		logCacheClient v2action.LogCacheClient
+		config         v2action.Config
+	}
+	getRecentLogsForApplicationByNameAndSpaceWithinWindowReturns struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRecentLogsForApplicationByNameAndSpaceWithinWindowReturnsOnCall map[int]struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetStreamingLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -108,6 +128,65 @@ func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceWithinWindow(appName string, spaceGUID string, window v2action.RecentLogsWindow, noaaClient v2action.NOAAClient, logCacheClient v2action.LogCacheClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall)]
+	fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall, struct {
+		appName        string
+		spaceGUID      string
+		window         v2action.RecentLogsWindow
+		noaaClient     v2action.NOAAClient
+		logCacheClient v2action.LogCacheClient
+		config         v2action.Config
+	}{appName, spaceGUID, window, noaaClient, logCacheClient, config})
+	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpaceWithinWindow", []interface{}{appName, spaceGUID, window, noaaClient, logCacheClient, config})
+	fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowMutex.Unlock()
+	if fake.GetRecentLogsForApplicationByNameAndSpaceWithinWindowStub != nil {
+		return fake.GetRecentLogsForApplicationByNameAndSpaceWithinWindowStub(appName, spaceGUID, window, noaaClient, logCacheClient, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowReturns.result1, fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowReturns.result2, fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowReturns.result3
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceWithinWindowCallCount() int {
+	fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall(i int) (string, string, v2action.RecentLogsWindow, v2action.NOAAClient, v2action.LogCacheClient, v2action.Config) {
+	fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowMutex.RUnlock()
+	return fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall[i].appName, fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall[i].spaceGUID, fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall[i].window, fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall[i].noaaClient, fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall[i].logCacheClient, fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowArgsForCall[i].config
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceWithinWindowReturns(result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.GetRecentLogsForApplicationByNameAndSpaceWithinWindowStub = nil
+	fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowReturns = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceWithinWindowReturnsOnCall(i int, result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.GetRecentLogsForApplicationByNameAndSpaceWithinWindowStub = nil
+	if fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowReturnsOnCall = make(map[int]struct {
+			result1 []v2action.LogMessage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowReturnsOnCall[i] = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -173,6 +252,8 @@ func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceWithinWindowMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	return fake.invocations
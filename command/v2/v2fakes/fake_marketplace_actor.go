@@ -0,0 +1,182 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeMarketplaceActor struct {
+	GetServiceSummariesStub        func(orgGUID string, spaceGUID string) ([]v2action.ServiceSummary, v2action.Warnings, error)
+	getServiceSummariesMutex       sync.RWMutex
+	getServiceSummariesArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+	}
+	getServiceSummariesReturns struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceSummariesReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceSummaryByNameStub        func(name string, orgGUID string, spaceGUID string) (v2action.ServiceSummary, v2action.Warnings, error)
+	getServiceSummaryByNameMutex       sync.RWMutex
+	getServiceSummaryByNameArgsForCall []struct {
+		name      string
+		orgGUID   string
+		spaceGUID string
+	}
+	getServiceSummaryByNameReturns struct {
+		result1 v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceSummaryByNameReturnsOnCall map[int]struct {
+		result1 v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummaries(orgGUID string, spaceGUID string) ([]v2action.ServiceSummary, v2action.Warnings, error) {
+	fake.getServiceSummariesMutex.Lock()
+	ret, specificReturn := fake.getServiceSummariesReturnsOnCall[len(fake.getServiceSummariesArgsForCall)]
+	fake.getServiceSummariesArgsForCall = append(fake.getServiceSummariesArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+	}{orgGUID, spaceGUID})
+	fake.recordInvocation("GetServiceSummaries", []interface{}{orgGUID, spaceGUID})
+	fake.getServiceSummariesMutex.Unlock()
+	if fake.GetServiceSummariesStub != nil {
+		return fake.GetServiceSummariesStub(orgGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceSummariesReturns.result1, fake.getServiceSummariesReturns.result2, fake.getServiceSummariesReturns.result3
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummariesCallCount() int {
+	fake.getServiceSummariesMutex.RLock()
+	defer fake.getServiceSummariesMutex.RUnlock()
+	return len(fake.getServiceSummariesArgsForCall)
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummariesArgsForCall(i int) (string, string) {
+	fake.getServiceSummariesMutex.RLock()
+	defer fake.getServiceSummariesMutex.RUnlock()
+	return fake.getServiceSummariesArgsForCall[i].orgGUID, fake.getServiceSummariesArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummariesReturns(result1 []v2action.ServiceSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceSummariesStub = nil
+	fake.getServiceSummariesReturns = struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummariesReturnsOnCall(i int, result1 []v2action.ServiceSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceSummariesStub = nil
+	if fake.getServiceSummariesReturnsOnCall == nil {
+		fake.getServiceSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceSummariesReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummaryByName(name string, orgGUID string, spaceGUID string) (v2action.ServiceSummary, v2action.Warnings, error) {
+	fake.getServiceSummaryByNameMutex.Lock()
+	ret, specificReturn := fake.getServiceSummaryByNameReturnsOnCall[len(fake.getServiceSummaryByNameArgsForCall)]
+	fake.getServiceSummaryByNameArgsForCall = append(fake.getServiceSummaryByNameArgsForCall, struct {
+		name      string
+		orgGUID   string
+		spaceGUID string
+	}{name, orgGUID, spaceGUID})
+	fake.recordInvocation("GetServiceSummaryByName", []interface{}{name, orgGUID, spaceGUID})
+	fake.getServiceSummaryByNameMutex.Unlock()
+	if fake.GetServiceSummaryByNameStub != nil {
+		return fake.GetServiceSummaryByNameStub(name, orgGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceSummaryByNameReturns.result1, fake.getServiceSummaryByNameReturns.result2, fake.getServiceSummaryByNameReturns.result3
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummaryByNameCallCount() int {
+	fake.getServiceSummaryByNameMutex.RLock()
+	defer fake.getServiceSummaryByNameMutex.RUnlock()
+	return len(fake.getServiceSummaryByNameArgsForCall)
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummaryByNameArgsForCall(i int) (string, string, string) {
+	fake.getServiceSummaryByNameMutex.RLock()
+	defer fake.getServiceSummaryByNameMutex.RUnlock()
+	return fake.getServiceSummaryByNameArgsForCall[i].name, fake.getServiceSummaryByNameArgsForCall[i].orgGUID, fake.getServiceSummaryByNameArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummaryByNameReturns(result1 v2action.ServiceSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceSummaryByNameStub = nil
+	fake.getServiceSummaryByNameReturns = struct {
+		result1 v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMarketplaceActor) GetServiceSummaryByNameReturnsOnCall(i int, result1 v2action.ServiceSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceSummaryByNameStub = nil
+	if fake.getServiceSummaryByNameReturnsOnCall == nil {
+		fake.getServiceSummaryByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceSummaryByNameReturnsOnCall[i] = struct {
+		result1 v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMarketplaceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getServiceSummariesMutex.RLock()
+	defer fake.getServiceSummariesMutex.RUnlock()
+	fake.getServiceSummaryByNameMutex.RLock()
+	defer fake.getServiceSummaryByNameMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeMarketplaceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.MarketplaceActor = new(FakeMarketplaceActor)
@@ -0,0 +1,625 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeMigrateStackActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationSummaryByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.ApplicationSummary, v2action.Warnings, error)
+	getApplicationSummaryByNameAndSpaceMutex       sync.RWMutex
+	getApplicationSummaryByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationSummaryByNameAndSpaceReturns struct {
+		result1 v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationSummaryByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationCrashDiagnosticsStub        func(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error)
+	getApplicationCrashDiagnosticsMutex       sync.RWMutex
+	getApplicationCrashDiagnosticsArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+	}
+	getApplicationCrashDiagnosticsReturns struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationCrashDiagnosticsReturnsOnCall map[int]struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationStartupProgressStub        func(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error)
+	getApplicationStartupProgressMutex       sync.RWMutex
+	getApplicationStartupProgressArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationStartupProgressReturns struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationStartupProgressReturnsOnCall map[int]struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}
+	RestageApplicationStub        func(ctx context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	restageApplicationMutex       sync.RWMutex
+	restageApplicationArgsForCall []struct {
+		ctx    context.Context
+		app    v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}
+	restageApplicationReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}
+	restageApplicationReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}
+	GetStackStub        func(guid string) (v2action.Stack, v2action.Warnings, error)
+	getStackMutex       sync.RWMutex
+	getStackArgsForCall []struct {
+		guid string
+	}
+	getStackReturns struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStackReturnsOnCall map[int]struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStackByNameStub        func(stackName string) (v2action.Stack, v2action.Warnings, error)
+	getStackByNameMutex       sync.RWMutex
+	getStackByNameArgsForCall []struct {
+		stackName string
+	}
+	getStackByNameReturns struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStackByNameReturnsOnCall map[int]struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	UpdateApplicationStub        func(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+	updateApplicationMutex       sync.RWMutex
+	updateApplicationArgsForCall []struct {
+		application v2action.Application
+	}
+	updateApplicationReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	updateApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationSummaryByNameAndSpace(name string, spaceGUID string) (v2action.ApplicationSummary, v2action.Warnings, error) {
+	fake.getApplicationSummaryByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationSummaryByNameAndSpaceReturnsOnCall[len(fake.getApplicationSummaryByNameAndSpaceArgsForCall)]
+	fake.getApplicationSummaryByNameAndSpaceArgsForCall = append(fake.getApplicationSummaryByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationSummaryByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationSummaryByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationSummaryByNameAndSpaceStub != nil {
+		return fake.GetApplicationSummaryByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationSummaryByNameAndSpaceReturns.result1, fake.getApplicationSummaryByNameAndSpaceReturns.result2, fake.getApplicationSummaryByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationSummaryByNameAndSpaceCallCount() int {
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationSummaryByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationSummaryByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationSummaryByNameAndSpaceArgsForCall[i].name, fake.getApplicationSummaryByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationSummaryByNameAndSpaceReturns(result1 v2action.ApplicationSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationSummaryByNameAndSpaceStub = nil
+	fake.getApplicationSummaryByNameAndSpaceReturns = struct {
+		result1 v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i int, result1 v2action.ApplicationSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationSummaryByNameAndSpaceStub = nil
+	if fake.getApplicationSummaryByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationSummaryByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ApplicationSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSummaryByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationCrashDiagnostics(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error) {
+	fake.getApplicationCrashDiagnosticsMutex.Lock()
+	ret, specificReturn := fake.getApplicationCrashDiagnosticsReturnsOnCall[len(fake.getApplicationCrashDiagnosticsArgsForCall)]
+	fake.getApplicationCrashDiagnosticsArgsForCall = append(fake.getApplicationCrashDiagnosticsArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+	}{appGUID, client})
+	fake.recordInvocation("GetApplicationCrashDiagnostics", []interface{}{appGUID, client})
+	fake.getApplicationCrashDiagnosticsMutex.Unlock()
+	if fake.GetApplicationCrashDiagnosticsStub != nil {
+		return fake.GetApplicationCrashDiagnosticsStub(appGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationCrashDiagnosticsReturns.result1, fake.getApplicationCrashDiagnosticsReturns.result2, fake.getApplicationCrashDiagnosticsReturns.result3
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationCrashDiagnosticsCallCount() int {
+	fake.getApplicationCrashDiagnosticsMutex.RLock()
+	defer fake.getApplicationCrashDiagnosticsMutex.RUnlock()
+	return len(fake.getApplicationCrashDiagnosticsArgsForCall)
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationCrashDiagnosticsArgsForCall(i int) (string, v2action.NOAAClient) {
+	fake.getApplicationCrashDiagnosticsMutex.RLock()
+	defer fake.getApplicationCrashDiagnosticsMutex.RUnlock()
+	return fake.getApplicationCrashDiagnosticsArgsForCall[i].appGUID, fake.getApplicationCrashDiagnosticsArgsForCall[i].client
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationCrashDiagnosticsReturns(result1 v2action.CrashDiagnostics, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationCrashDiagnosticsStub = nil
+	fake.getApplicationCrashDiagnosticsReturns = struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationCrashDiagnosticsReturnsOnCall(i int, result1 v2action.CrashDiagnostics, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationCrashDiagnosticsStub = nil
+	if fake.getApplicationCrashDiagnosticsReturnsOnCall == nil {
+		fake.getApplicationCrashDiagnosticsReturnsOnCall = make(map[int]struct {
+			result1 v2action.CrashDiagnostics
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationCrashDiagnosticsReturnsOnCall[i] = struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationStartupProgress(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error) {
+	fake.getApplicationStartupProgressMutex.Lock()
+	ret, specificReturn := fake.getApplicationStartupProgressReturnsOnCall[len(fake.getApplicationStartupProgressArgsForCall)]
+	fake.getApplicationStartupProgressArgsForCall = append(fake.getApplicationStartupProgressArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationStartupProgress", []interface{}{appGUID})
+	fake.getApplicationStartupProgressMutex.Unlock()
+	if fake.GetApplicationStartupProgressStub != nil {
+		return fake.GetApplicationStartupProgressStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationStartupProgressReturns.result1, fake.getApplicationStartupProgressReturns.result2, fake.getApplicationStartupProgressReturns.result3
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationStartupProgressCallCount() int {
+	fake.getApplicationStartupProgressMutex.RLock()
+	defer fake.getApplicationStartupProgressMutex.RUnlock()
+	return len(fake.getApplicationStartupProgressArgsForCall)
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationStartupProgressArgsForCall(i int) string {
+	fake.getApplicationStartupProgressMutex.RLock()
+	defer fake.getApplicationStartupProgressMutex.RUnlock()
+	return fake.getApplicationStartupProgressArgsForCall[i].appGUID
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationStartupProgressReturns(result1 v2action.ApplicationStartupProgress, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStartupProgressStub = nil
+	fake.getApplicationStartupProgressReturns = struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetApplicationStartupProgressReturnsOnCall(i int, result1 v2action.ApplicationStartupProgress, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStartupProgressStub = nil
+	if fake.getApplicationStartupProgressReturnsOnCall == nil {
+		fake.getApplicationStartupProgressReturnsOnCall = make(map[int]struct {
+			result1 v2action.ApplicationStartupProgress
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationStartupProgressReturnsOnCall[i] = struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) RestageApplication(ctx context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+	fake.restageApplicationMutex.Lock()
+	ret, specificReturn := fake.restageApplicationReturnsOnCall[len(fake.restageApplicationArgsForCall)]
+	fake.restageApplicationArgsForCall = append(fake.restageApplicationArgsForCall, struct {
+		ctx    context.Context
+		app    v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}{ctx, app, client, config})
+	fake.recordInvocation("RestageApplication", []interface{}{ctx, app, client, config})
+	fake.restageApplicationMutex.Unlock()
+	if fake.RestageApplicationStub != nil {
+		return fake.RestageApplicationStub(ctx, app, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fake.restageApplicationReturns.result1, fake.restageApplicationReturns.result2, fake.restageApplicationReturns.result3, fake.restageApplicationReturns.result4, fake.restageApplicationReturns.result5
+}
+
+func (fake *FakeMigrateStackActor) RestageApplicationCallCount() int {
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	return len(fake.restageApplicationArgsForCall)
+}
+
+func (fake *FakeMigrateStackActor) RestageApplicationArgsForCall(i int) (context.Context, v2action.Application, v2action.NOAAClient, v2action.Config) {
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	return fake.restageApplicationArgsForCall[i].ctx, fake.restageApplicationArgsForCall[i].app, fake.restageApplicationArgsForCall[i].client, fake.restageApplicationArgsForCall[i].config
+}
+
+func (fake *FakeMigrateStackActor) RestageApplicationReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan bool, result4 <-chan string, result5 <-chan error) {
+	fake.RestageApplicationStub = nil
+	fake.restageApplicationReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeMigrateStackActor) RestageApplicationReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan bool, result4 <-chan string, result5 <-chan error) {
+	fake.RestageApplicationStub = nil
+	if fake.restageApplicationReturnsOnCall == nil {
+		fake.restageApplicationReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 <-chan bool
+			result4 <-chan string
+			result5 <-chan error
+		})
+	}
+	fake.restageApplicationReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeMigrateStackActor) GetStack(guid string) (v2action.Stack, v2action.Warnings, error) {
+	fake.getStackMutex.Lock()
+	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
+	fake.getStackArgsForCall = append(fake.getStackArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetStack", []interface{}{guid})
+	fake.getStackMutex.Unlock()
+	if fake.GetStackStub != nil {
+		return fake.GetStackStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackReturns.result1, fake.getStackReturns.result2, fake.getStackReturns.result3
+}
+
+func (fake *FakeMigrateStackActor) GetStackCallCount() int {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return len(fake.getStackArgsForCall)
+}
+
+func (fake *FakeMigrateStackActor) GetStackArgsForCall(i int) string {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return fake.getStackArgsForCall[i].guid
+}
+
+func (fake *FakeMigrateStackActor) GetStackReturns(result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	fake.getStackReturns = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetStackReturnsOnCall(i int, result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	if fake.getStackReturnsOnCall == nil {
+		fake.getStackReturnsOnCall = make(map[int]struct {
+			result1 v2action.Stack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackReturnsOnCall[i] = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetStackByName(stackName string) (v2action.Stack, v2action.Warnings, error) {
+	fake.getStackByNameMutex.Lock()
+	ret, specificReturn := fake.getStackByNameReturnsOnCall[len(fake.getStackByNameArgsForCall)]
+	fake.getStackByNameArgsForCall = append(fake.getStackByNameArgsForCall, struct {
+		stackName string
+	}{stackName})
+	fake.recordInvocation("GetStackByName", []interface{}{stackName})
+	fake.getStackByNameMutex.Unlock()
+	if fake.GetStackByNameStub != nil {
+		return fake.GetStackByNameStub(stackName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackByNameReturns.result1, fake.getStackByNameReturns.result2, fake.getStackByNameReturns.result3
+}
+
+func (fake *FakeMigrateStackActor) GetStackByNameCallCount() int {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return len(fake.getStackByNameArgsForCall)
+}
+
+func (fake *FakeMigrateStackActor) GetStackByNameArgsForCall(i int) string {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return fake.getStackByNameArgsForCall[i].stackName
+}
+
+func (fake *FakeMigrateStackActor) GetStackByNameReturns(result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	fake.getStackByNameReturns = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) GetStackByNameReturnsOnCall(i int, result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	if fake.getStackByNameReturnsOnCall == nil {
+		fake.getStackByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Stack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackByNameReturnsOnCall[i] = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
+	fake.updateApplicationMutex.Lock()
+	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
+	fake.updateApplicationArgsForCall = append(fake.updateApplicationArgsForCall, struct {
+		application v2action.Application
+	}{application})
+	fake.recordInvocation("UpdateApplication", []interface{}{application})
+	fake.updateApplicationMutex.Unlock()
+	if fake.UpdateApplicationStub != nil {
+		return fake.UpdateApplicationStub(application)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationReturns.result1, fake.updateApplicationReturns.result2, fake.updateApplicationReturns.result3
+}
+
+func (fake *FakeMigrateStackActor) UpdateApplicationCallCount() int {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return len(fake.updateApplicationArgsForCall)
+}
+
+func (fake *FakeMigrateStackActor) UpdateApplicationArgsForCall(i int) v2action.Application {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return fake.updateApplicationArgsForCall[i].application
+}
+
+func (fake *FakeMigrateStackActor) UpdateApplicationReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	fake.updateApplicationReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) UpdateApplicationReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	if fake.updateApplicationReturnsOnCall == nil {
+		fake.updateApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMigrateStackActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationCrashDiagnosticsMutex.RLock()
+	defer fake.getApplicationCrashDiagnosticsMutex.RUnlock()
+	fake.getApplicationStartupProgressMutex.RLock()
+	defer fake.getApplicationStartupProgressMutex.RUnlock()
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeMigrateStackActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.MigrateStackActor = new(FakeMigrateStackActor)
@@ -0,0 +1,329 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeMoveRouteActor struct {
+	GetOrganizationDomainsStub        func(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
+	getOrganizationDomainsMutex       sync.RWMutex
+	getOrganizationDomainsArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationDomainsReturns struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationDomainsReturnsOnCall map[int]struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRouteByHostAndDomainStub        func(host string, domainGUID string) (v2action.Route, v2action.Warnings, error)
+	getRouteByHostAndDomainMutex       sync.RWMutex
+	getRouteByHostAndDomainArgsForCall []struct {
+		host       string
+		domainGUID string
+	}
+	getRouteByHostAndDomainReturns struct {
+		result1 v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRouteByHostAndDomainReturnsOnCall map[int]struct {
+		result1 v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceByOrganizationAndNameStub        func(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	getSpaceByOrganizationAndNameMutex       sync.RWMutex
+	getSpaceByOrganizationAndNameArgsForCall []struct {
+		orgGUID   string
+		spaceName string
+	}
+	getSpaceByOrganizationAndNameReturns struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceByOrganizationAndNameReturnsOnCall map[int]struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	MoveRouteStub        func(route v2action.Route, spaceGUID string) (v2action.Route, []v2action.Application, v2action.Warnings, error)
+	moveRouteMutex       sync.RWMutex
+	moveRouteArgsForCall []struct {
+		route     v2action.Route
+		spaceGUID string
+	}
+	moveRouteReturns struct {
+		result1 v2action.Route
+		result2 []v2action.Application
+		result3 v2action.Warnings
+		result4 error
+	}
+	moveRouteReturnsOnCall map[int]struct {
+		result1 v2action.Route
+		result2 []v2action.Application
+		result3 v2action.Warnings
+		result4 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMoveRouteActor) GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error) {
+	fake.getOrganizationDomainsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationDomainsReturnsOnCall[len(fake.getOrganizationDomainsArgsForCall)]
+	fake.getOrganizationDomainsArgsForCall = append(fake.getOrganizationDomainsArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationDomains", []interface{}{orgGUID})
+	fake.getOrganizationDomainsMutex.Unlock()
+	if fake.GetOrganizationDomainsStub != nil {
+		return fake.GetOrganizationDomainsStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationDomainsReturns.result1, fake.getOrganizationDomainsReturns.result2, fake.getOrganizationDomainsReturns.result3
+}
+
+func (fake *FakeMoveRouteActor) GetOrganizationDomainsCallCount() int {
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	return len(fake.getOrganizationDomainsArgsForCall)
+}
+
+func (fake *FakeMoveRouteActor) GetOrganizationDomainsArgsForCall(i int) string {
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	return fake.getOrganizationDomainsArgsForCall[i].orgGUID
+}
+
+func (fake *FakeMoveRouteActor) GetOrganizationDomainsReturns(result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationDomainsStub = nil
+	fake.getOrganizationDomainsReturns = struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMoveRouteActor) GetOrganizationDomainsReturnsOnCall(i int, result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationDomainsStub = nil
+	if fake.getOrganizationDomainsReturnsOnCall == nil {
+		fake.getOrganizationDomainsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Domain
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationDomainsReturnsOnCall[i] = struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMoveRouteActor) GetRouteByHostAndDomain(host string, domainGUID string) (v2action.Route, v2action.Warnings, error) {
+	fake.getRouteByHostAndDomainMutex.Lock()
+	ret, specificReturn := fake.getRouteByHostAndDomainReturnsOnCall[len(fake.getRouteByHostAndDomainArgsForCall)]
+	fake.getRouteByHostAndDomainArgsForCall = append(fake.getRouteByHostAndDomainArgsForCall, struct {
+		host       string
+		domainGUID string
+	}{host, domainGUID})
+	fake.recordInvocation("GetRouteByHostAndDomain", []interface{}{host, domainGUID})
+	fake.getRouteByHostAndDomainMutex.Unlock()
+	if fake.GetRouteByHostAndDomainStub != nil {
+		return fake.GetRouteByHostAndDomainStub(host, domainGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteByHostAndDomainReturns.result1, fake.getRouteByHostAndDomainReturns.result2, fake.getRouteByHostAndDomainReturns.result3
+}
+
+func (fake *FakeMoveRouteActor) GetRouteByHostAndDomainCallCount() int {
+	fake.getRouteByHostAndDomainMutex.RLock()
+	defer fake.getRouteByHostAndDomainMutex.RUnlock()
+	return len(fake.getRouteByHostAndDomainArgsForCall)
+}
+
+func (fake *FakeMoveRouteActor) GetRouteByHostAndDomainArgsForCall(i int) (string, string) {
+	fake.getRouteByHostAndDomainMutex.RLock()
+	defer fake.getRouteByHostAndDomainMutex.RUnlock()
+	return fake.getRouteByHostAndDomainArgsForCall[i].host, fake.getRouteByHostAndDomainArgsForCall[i].domainGUID
+}
+
+func (fake *FakeMoveRouteActor) GetRouteByHostAndDomainReturns(result1 v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteByHostAndDomainStub = nil
+	fake.getRouteByHostAndDomainReturns = struct {
+		result1 v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMoveRouteActor) GetRouteByHostAndDomainReturnsOnCall(i int, result1 v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteByHostAndDomainStub = nil
+	if fake.getRouteByHostAndDomainReturnsOnCall == nil {
+		fake.getRouteByHostAndDomainReturnsOnCall = make(map[int]struct {
+			result1 v2action.Route
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteByHostAndDomainReturnsOnCall[i] = struct {
+		result1 v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMoveRouteActor) GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error) {
+	fake.getSpaceByOrganizationAndNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceByOrganizationAndNameReturnsOnCall[len(fake.getSpaceByOrganizationAndNameArgsForCall)]
+	fake.getSpaceByOrganizationAndNameArgsForCall = append(fake.getSpaceByOrganizationAndNameArgsForCall, struct {
+		orgGUID   string
+		spaceName string
+	}{orgGUID, spaceName})
+	fake.recordInvocation("GetSpaceByOrganizationAndName", []interface{}{orgGUID, spaceName})
+	fake.getSpaceByOrganizationAndNameMutex.Unlock()
+	if fake.GetSpaceByOrganizationAndNameStub != nil {
+		return fake.GetSpaceByOrganizationAndNameStub(orgGUID, spaceName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceByOrganizationAndNameReturns.result1, fake.getSpaceByOrganizationAndNameReturns.result2, fake.getSpaceByOrganizationAndNameReturns.result3
+}
+
+func (fake *FakeMoveRouteActor) GetSpaceByOrganizationAndNameCallCount() int {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	return len(fake.getSpaceByOrganizationAndNameArgsForCall)
+}
+
+func (fake *FakeMoveRouteActor) GetSpaceByOrganizationAndNameArgsForCall(i int) (string, string) {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	return fake.getSpaceByOrganizationAndNameArgsForCall[i].orgGUID, fake.getSpaceByOrganizationAndNameArgsForCall[i].spaceName
+}
+
+func (fake *FakeMoveRouteActor) GetSpaceByOrganizationAndNameReturns(result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	fake.getSpaceByOrganizationAndNameReturns = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMoveRouteActor) GetSpaceByOrganizationAndNameReturnsOnCall(i int, result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	if fake.getSpaceByOrganizationAndNameReturnsOnCall == nil {
+		fake.getSpaceByOrganizationAndNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceByOrganizationAndNameReturnsOnCall[i] = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMoveRouteActor) MoveRoute(route v2action.Route, spaceGUID string) (v2action.Route, []v2action.Application, v2action.Warnings, error) {
+	fake.moveRouteMutex.Lock()
+	ret, specificReturn := fake.moveRouteReturnsOnCall[len(fake.moveRouteArgsForCall)]
+	fake.moveRouteArgsForCall = append(fake.moveRouteArgsForCall, struct {
+		route     v2action.Route
+		spaceGUID string
+	}{route, spaceGUID})
+	fake.recordInvocation("MoveRoute", []interface{}{route, spaceGUID})
+	fake.moveRouteMutex.Unlock()
+	if fake.MoveRouteStub != nil {
+		return fake.MoveRouteStub(route, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.moveRouteReturns.result1, fake.moveRouteReturns.result2, fake.moveRouteReturns.result3, fake.moveRouteReturns.result4
+}
+
+func (fake *FakeMoveRouteActor) MoveRouteCallCount() int {
+	fake.moveRouteMutex.RLock()
+	defer fake.moveRouteMutex.RUnlock()
+	return len(fake.moveRouteArgsForCall)
+}
+
+func (fake *FakeMoveRouteActor) MoveRouteArgsForCall(i int) (v2action.Route, string) {
+	fake.moveRouteMutex.RLock()
+	defer fake.moveRouteMutex.RUnlock()
+	return fake.moveRouteArgsForCall[i].route, fake.moveRouteArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeMoveRouteActor) MoveRouteReturns(result1 v2action.Route, result2 []v2action.Application, result3 v2action.Warnings, result4 error) {
+	fake.MoveRouteStub = nil
+	fake.moveRouteReturns = struct {
+		result1 v2action.Route
+		result2 []v2action.Application
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeMoveRouteActor) MoveRouteReturnsOnCall(i int, result1 v2action.Route, result2 []v2action.Application, result3 v2action.Warnings, result4 error) {
+	fake.MoveRouteStub = nil
+	if fake.moveRouteReturnsOnCall == nil {
+		fake.moveRouteReturnsOnCall = make(map[int]struct {
+			result1 v2action.Route
+			result2 []v2action.Application
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.moveRouteReturnsOnCall[i] = struct {
+		result1 v2action.Route
+		result2 []v2action.Application
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeMoveRouteActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	fake.getRouteByHostAndDomainMutex.RLock()
+	defer fake.getRouteByHostAndDomainMutex.RUnlock()
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	fake.moveRouteMutex.RLock()
+	defer fake.moveRouteMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeMoveRouteActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.MoveRouteActor = new(FakeMoveRouteActor)
@@ -0,0 +1,153 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeMoveRouteActorV3 struct {
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	TransferRouteOwnershipStub        func(routeGUID string, spaceGUID string) (v3action.Warnings, error)
+	transferRouteOwnershipMutex       sync.RWMutex
+	transferRouteOwnershipArgsForCall []struct {
+		routeGUID string
+		spaceGUID string
+	}
+	transferRouteOwnershipReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	transferRouteOwnershipReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMoveRouteActorV3) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeMoveRouteActorV3) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeMoveRouteActorV3) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeMoveRouteActorV3) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeMoveRouteActorV3) TransferRouteOwnership(routeGUID string, spaceGUID string) (v3action.Warnings, error) {
+	fake.transferRouteOwnershipMutex.Lock()
+	ret, specificReturn := fake.transferRouteOwnershipReturnsOnCall[len(fake.transferRouteOwnershipArgsForCall)]
+	fake.transferRouteOwnershipArgsForCall = append(fake.transferRouteOwnershipArgsForCall, struct {
+		routeGUID string
+		spaceGUID string
+	}{routeGUID, spaceGUID})
+	fake.recordInvocation("TransferRouteOwnership", []interface{}{routeGUID, spaceGUID})
+	fake.transferRouteOwnershipMutex.Unlock()
+	if fake.TransferRouteOwnershipStub != nil {
+		return fake.TransferRouteOwnershipStub(routeGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.transferRouteOwnershipReturns.result1, fake.transferRouteOwnershipReturns.result2
+}
+
+func (fake *FakeMoveRouteActorV3) TransferRouteOwnershipCallCount() int {
+	fake.transferRouteOwnershipMutex.RLock()
+	defer fake.transferRouteOwnershipMutex.RUnlock()
+	return len(fake.transferRouteOwnershipArgsForCall)
+}
+
+func (fake *FakeMoveRouteActorV3) TransferRouteOwnershipArgsForCall(i int) (string, string) {
+	fake.transferRouteOwnershipMutex.RLock()
+	defer fake.transferRouteOwnershipMutex.RUnlock()
+	return fake.transferRouteOwnershipArgsForCall[i].routeGUID, fake.transferRouteOwnershipArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeMoveRouteActorV3) TransferRouteOwnershipReturns(result1 v3action.Warnings, result2 error) {
+	fake.TransferRouteOwnershipStub = nil
+	fake.transferRouteOwnershipReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMoveRouteActorV3) TransferRouteOwnershipReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.TransferRouteOwnershipStub = nil
+	if fake.transferRouteOwnershipReturnsOnCall == nil {
+		fake.transferRouteOwnershipReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.transferRouteOwnershipReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMoveRouteActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.transferRouteOwnershipMutex.RLock()
+	defer fake.transferRouteOwnershipMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeMoveRouteActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.MoveRouteActorV3 = new(FakeMoveRouteActorV3)
@@ -0,0 +1,105 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+type FakeNozzleActor struct {
+	GetStreamingFirehoseEnvelopesStub        func(subscriptionID string, filter v2action.FirehoseFilter, client v2action.NOAAClient) (<-chan *events.Envelope, <-chan error)
+	getStreamingFirehoseEnvelopesMutex       sync.RWMutex
+	getStreamingFirehoseEnvelopesArgsForCall []struct {
+		subscriptionID string
+		filter         v2action.FirehoseFilter
+		client         v2action.NOAAClient
+	}
+	getStreamingFirehoseEnvelopesReturns struct {
+		result1 <-chan *events.Envelope
+		result2 <-chan error
+	}
+	getStreamingFirehoseEnvelopesReturnsOnCall map[int]struct {
+		result1 <-chan *events.Envelope
+		result2 <-chan error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNozzleActor) GetStreamingFirehoseEnvelopes(subscriptionID string, filter v2action.FirehoseFilter, client v2action.NOAAClient) (<-chan *events.Envelope, <-chan error) {
+	fake.getStreamingFirehoseEnvelopesMutex.Lock()
+	ret, specificReturn := fake.getStreamingFirehoseEnvelopesReturnsOnCall[len(fake.getStreamingFirehoseEnvelopesArgsForCall)]
+	fake.getStreamingFirehoseEnvelopesArgsForCall = append(fake.getStreamingFirehoseEnvelopesArgsForCall, struct {
+		subscriptionID string
+		filter         v2action.FirehoseFilter
+		client         v2action.NOAAClient
+	}{subscriptionID, filter, client})
+	fake.recordInvocation("GetStreamingFirehoseEnvelopes", []interface{}{subscriptionID, filter, client})
+	fake.getStreamingFirehoseEnvelopesMutex.Unlock()
+	if fake.GetStreamingFirehoseEnvelopesStub != nil {
+		return fake.GetStreamingFirehoseEnvelopesStub(subscriptionID, filter, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getStreamingFirehoseEnvelopesReturns.result1, fake.getStreamingFirehoseEnvelopesReturns.result2
+}
+
+func (fake *FakeNozzleActor) GetStreamingFirehoseEnvelopesCallCount() int {
+	fake.getStreamingFirehoseEnvelopesMutex.RLock()
+	defer fake.getStreamingFirehoseEnvelopesMutex.RUnlock()
+	return len(fake.getStreamingFirehoseEnvelopesArgsForCall)
+}
+
+func (fake *FakeNozzleActor) GetStreamingFirehoseEnvelopesArgsForCall(i int) (string, v2action.FirehoseFilter, v2action.NOAAClient) {
+	fake.getStreamingFirehoseEnvelopesMutex.RLock()
+	defer fake.getStreamingFirehoseEnvelopesMutex.RUnlock()
+	return fake.getStreamingFirehoseEnvelopesArgsForCall[i].subscriptionID, fake.getStreamingFirehoseEnvelopesArgsForCall[i].filter, fake.getStreamingFirehoseEnvelopesArgsForCall[i].client
+}
+
+func (fake *FakeNozzleActor) GetStreamingFirehoseEnvelopesReturns(result1 <-chan *events.Envelope, result2 <-chan error) {
+	fake.GetStreamingFirehoseEnvelopesStub = nil
+	fake.getStreamingFirehoseEnvelopesReturns = struct {
+		result1 <-chan *events.Envelope
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeNozzleActor) GetStreamingFirehoseEnvelopesReturnsOnCall(i int, result1 <-chan *events.Envelope, result2 <-chan error) {
+	fake.GetStreamingFirehoseEnvelopesStub = nil
+	if fake.getStreamingFirehoseEnvelopesReturnsOnCall == nil {
+		fake.getStreamingFirehoseEnvelopesReturnsOnCall = make(map[int]struct {
+			result1 <-chan *events.Envelope
+			result2 <-chan error
+		})
+	}
+	fake.getStreamingFirehoseEnvelopesReturnsOnCall[i] = struct {
+		result1 <-chan *events.Envelope
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeNozzleActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getStreamingFirehoseEnvelopesMutex.RLock()
+	defer fake.getStreamingFirehoseEnvelopesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeNozzleActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.NozzleActor = new(FakeNozzleActor)
@@ -24,6 +24,21 @@ type FakeOrgActorV3 struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetOrganizationDefaultIsolationSegmentStub        func(orgGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getOrganizationDefaultIsolationSegmentMutex       sync.RWMutex
+	getOrganizationDefaultIsolationSegmentArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationDefaultIsolationSegmentReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getOrganizationDefaultIsolationSegmentReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -91,6 +106,60 @@ func (fake *FakeOrgActorV3) GetIsolationSegmentsByOrganizationReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeOrgActorV3) GetOrganizationDefaultIsolationSegment(orgGUID string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getOrganizationDefaultIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.getOrganizationDefaultIsolationSegmentReturnsOnCall[len(fake.getOrganizationDefaultIsolationSegmentArgsForCall)]
+	fake.getOrganizationDefaultIsolationSegmentArgsForCall = append(fake.getOrganizationDefaultIsolationSegmentArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationDefaultIsolationSegment", []interface{}{orgGUID})
+	fake.getOrganizationDefaultIsolationSegmentMutex.Unlock()
+	if fake.GetOrganizationDefaultIsolationSegmentStub != nil {
+		return fake.GetOrganizationDefaultIsolationSegmentStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationDefaultIsolationSegmentReturns.result1, fake.getOrganizationDefaultIsolationSegmentReturns.result2, fake.getOrganizationDefaultIsolationSegmentReturns.result3
+}
+
+func (fake *FakeOrgActorV3) GetOrganizationDefaultIsolationSegmentCallCount() int {
+	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	return len(fake.getOrganizationDefaultIsolationSegmentArgsForCall)
+}
+
+func (fake *FakeOrgActorV3) GetOrganizationDefaultIsolationSegmentArgsForCall(i int) string {
+	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	return fake.getOrganizationDefaultIsolationSegmentArgsForCall[i].orgGUID
+}
+
+func (fake *FakeOrgActorV3) GetOrganizationDefaultIsolationSegmentReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetOrganizationDefaultIsolationSegmentStub = nil
+	fake.getOrganizationDefaultIsolationSegmentReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOrgActorV3) GetOrganizationDefaultIsolationSegmentReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetOrganizationDefaultIsolationSegmentStub = nil
+	if fake.getOrganizationDefaultIsolationSegmentReturnsOnCall == nil {
+		fake.getOrganizationDefaultIsolationSegmentReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationDefaultIsolationSegmentReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeOrgActorV3) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -136,6 +205,8 @@ func (fake *FakeOrgActorV3) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getIsolationSegmentsByOrganizationMutex.RLock()
 	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	return fake.invocations
@@ -0,0 +1,161 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeOrgsActor struct {
+	GetOrganizationsWithQuotasStub        func(handlePage func([]v2action.OrganizationWithQuota)) (v2action.Warnings, error)
+	getOrganizationsWithQuotasMutex       sync.RWMutex
+	getOrganizationsWithQuotasArgsForCall []struct {
+		handlePage func([]v2action.OrganizationWithQuota)
+	}
+	getOrganizationsWithQuotasReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	getOrganizationsWithQuotasReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	GetOrganizationsUsageStub        func() ([]v2action.OrganizationUsage, v2action.Warnings, error)
+	getOrganizationsUsageMutex       sync.RWMutex
+	getOrganizationsUsageArgsForCall []struct{}
+	getOrganizationsUsageReturns     struct {
+		result1 []v2action.OrganizationUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationsUsageReturnsOnCall map[int]struct {
+		result1 []v2action.OrganizationUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOrgsActor) GetOrganizationsWithQuotas(handlePage func([]v2action.OrganizationWithQuota)) (v2action.Warnings, error) {
+	fake.getOrganizationsWithQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsWithQuotasReturnsOnCall[len(fake.getOrganizationsWithQuotasArgsForCall)]
+	fake.getOrganizationsWithQuotasArgsForCall = append(fake.getOrganizationsWithQuotasArgsForCall, struct {
+		handlePage func([]v2action.OrganizationWithQuota)
+	}{handlePage})
+	fake.recordInvocation("GetOrganizationsWithQuotas", []interface{}{handlePage})
+	fake.getOrganizationsWithQuotasMutex.Unlock()
+	if fake.GetOrganizationsWithQuotasStub != nil {
+		return fake.GetOrganizationsWithQuotasStub(handlePage)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrganizationsWithQuotasReturns.result1, fake.getOrganizationsWithQuotasReturns.result2
+}
+
+func (fake *FakeOrgsActor) GetOrganizationsWithQuotasCallCount() int {
+	fake.getOrganizationsWithQuotasMutex.RLock()
+	defer fake.getOrganizationsWithQuotasMutex.RUnlock()
+	return len(fake.getOrganizationsWithQuotasArgsForCall)
+}
+
+func (fake *FakeOrgsActor) GetOrganizationsWithQuotasArgsForCall(i int) func([]v2action.OrganizationWithQuota) {
+	fake.getOrganizationsWithQuotasMutex.RLock()
+	defer fake.getOrganizationsWithQuotasMutex.RUnlock()
+	return fake.getOrganizationsWithQuotasArgsForCall[i].handlePage
+}
+
+func (fake *FakeOrgsActor) GetOrganizationsWithQuotasReturns(result1 v2action.Warnings, result2 error) {
+	fake.GetOrganizationsWithQuotasStub = nil
+	fake.getOrganizationsWithQuotasReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgsActor) GetOrganizationsWithQuotasReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.GetOrganizationsWithQuotasStub = nil
+	if fake.getOrganizationsWithQuotasReturnsOnCall == nil {
+		fake.getOrganizationsWithQuotasReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.getOrganizationsWithQuotasReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgsActor) GetOrganizationsUsage() ([]v2action.OrganizationUsage, v2action.Warnings, error) {
+	fake.getOrganizationsUsageMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsUsageReturnsOnCall[len(fake.getOrganizationsUsageArgsForCall)]
+	fake.getOrganizationsUsageArgsForCall = append(fake.getOrganizationsUsageArgsForCall, struct{}{})
+	fake.recordInvocation("GetOrganizationsUsage", []interface{}{})
+	fake.getOrganizationsUsageMutex.Unlock()
+	if fake.GetOrganizationsUsageStub != nil {
+		return fake.GetOrganizationsUsageStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationsUsageReturns.result1, fake.getOrganizationsUsageReturns.result2, fake.getOrganizationsUsageReturns.result3
+}
+
+func (fake *FakeOrgsActor) GetOrganizationsUsageCallCount() int {
+	fake.getOrganizationsUsageMutex.RLock()
+	defer fake.getOrganizationsUsageMutex.RUnlock()
+	return len(fake.getOrganizationsUsageArgsForCall)
+}
+
+func (fake *FakeOrgsActor) GetOrganizationsUsageReturns(result1 []v2action.OrganizationUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationsUsageStub = nil
+	fake.getOrganizationsUsageReturns = struct {
+		result1 []v2action.OrganizationUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOrgsActor) GetOrganizationsUsageReturnsOnCall(i int, result1 []v2action.OrganizationUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationsUsageStub = nil
+	if fake.getOrganizationsUsageReturnsOnCall == nil {
+		fake.getOrganizationsUsageReturnsOnCall = make(map[int]struct {
+			result1 []v2action.OrganizationUsage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationsUsageReturnsOnCall[i] = struct {
+		result1 []v2action.OrganizationUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOrgsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getOrganizationsWithQuotasMutex.RLock()
+	defer fake.getOrganizationsWithQuotasMutex.RUnlock()
+	fake.getOrganizationsUsageMutex.RLock()
+	defer fake.getOrganizationsUsageMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeOrgsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.OrgsActor = new(FakeOrgsActor)
@@ -0,0 +1,102 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakePurgeServiceInstanceActor struct {
+	PurgeServiceInstanceByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Warnings, error)
+	purgeServiceInstanceByNameAndSpaceMutex       sync.RWMutex
+	purgeServiceInstanceByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	purgeServiceInstanceByNameAndSpaceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	purgeServiceInstanceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePurgeServiceInstanceActor) PurgeServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.Warnings, error) {
+	fake.purgeServiceInstanceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.purgeServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.purgeServiceInstanceByNameAndSpaceArgsForCall)]
+	fake.purgeServiceInstanceByNameAndSpaceArgsForCall = append(fake.purgeServiceInstanceByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("PurgeServiceInstanceByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.purgeServiceInstanceByNameAndSpaceMutex.Unlock()
+	if fake.PurgeServiceInstanceByNameAndSpaceStub != nil {
+		return fake.PurgeServiceInstanceByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.purgeServiceInstanceByNameAndSpaceReturns.result1, fake.purgeServiceInstanceByNameAndSpaceReturns.result2
+}
+
+func (fake *FakePurgeServiceInstanceActor) PurgeServiceInstanceByNameAndSpaceCallCount() int {
+	fake.purgeServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.purgeServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return len(fake.purgeServiceInstanceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakePurgeServiceInstanceActor) PurgeServiceInstanceByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.purgeServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.purgeServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return fake.purgeServiceInstanceByNameAndSpaceArgsForCall[i].name, fake.purgeServiceInstanceByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakePurgeServiceInstanceActor) PurgeServiceInstanceByNameAndSpaceReturns(result1 v2action.Warnings, result2 error) {
+	fake.PurgeServiceInstanceByNameAndSpaceStub = nil
+	fake.purgeServiceInstanceByNameAndSpaceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePurgeServiceInstanceActor) PurgeServiceInstanceByNameAndSpaceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.PurgeServiceInstanceByNameAndSpaceStub = nil
+	if fake.purgeServiceInstanceByNameAndSpaceReturnsOnCall == nil {
+		fake.purgeServiceInstanceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.purgeServiceInstanceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePurgeServiceInstanceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.purgeServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.purgeServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePurgeServiceInstanceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.PurgeServiceInstanceActor = new(FakePurgeServiceInstanceActor)
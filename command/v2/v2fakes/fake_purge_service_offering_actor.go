@@ -0,0 +1,102 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakePurgeServiceOfferingActor struct {
+	PurgeServiceOfferingStub        func(name string, provider string) (v2action.Warnings, error)
+	purgeServiceOfferingMutex       sync.RWMutex
+	purgeServiceOfferingArgsForCall []struct {
+		name     string
+		provider string
+	}
+	purgeServiceOfferingReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	purgeServiceOfferingReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePurgeServiceOfferingActor) PurgeServiceOffering(name string, provider string) (v2action.Warnings, error) {
+	fake.purgeServiceOfferingMutex.Lock()
+	ret, specificReturn := fake.purgeServiceOfferingReturnsOnCall[len(fake.purgeServiceOfferingArgsForCall)]
+	fake.purgeServiceOfferingArgsForCall = append(fake.purgeServiceOfferingArgsForCall, struct {
+		name     string
+		provider string
+	}{name, provider})
+	fake.recordInvocation("PurgeServiceOffering", []interface{}{name, provider})
+	fake.purgeServiceOfferingMutex.Unlock()
+	if fake.PurgeServiceOfferingStub != nil {
+		return fake.PurgeServiceOfferingStub(name, provider)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.purgeServiceOfferingReturns.result1, fake.purgeServiceOfferingReturns.result2
+}
+
+func (fake *FakePurgeServiceOfferingActor) PurgeServiceOfferingCallCount() int {
+	fake.purgeServiceOfferingMutex.RLock()
+	defer fake.purgeServiceOfferingMutex.RUnlock()
+	return len(fake.purgeServiceOfferingArgsForCall)
+}
+
+func (fake *FakePurgeServiceOfferingActor) PurgeServiceOfferingArgsForCall(i int) (string, string) {
+	fake.purgeServiceOfferingMutex.RLock()
+	defer fake.purgeServiceOfferingMutex.RUnlock()
+	return fake.purgeServiceOfferingArgsForCall[i].name, fake.purgeServiceOfferingArgsForCall[i].provider
+}
+
+func (fake *FakePurgeServiceOfferingActor) PurgeServiceOfferingReturns(result1 v2action.Warnings, result2 error) {
+	fake.PurgeServiceOfferingStub = nil
+	fake.purgeServiceOfferingReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePurgeServiceOfferingActor) PurgeServiceOfferingReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.PurgeServiceOfferingStub = nil
+	if fake.purgeServiceOfferingReturnsOnCall == nil {
+		fake.purgeServiceOfferingReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.purgeServiceOfferingReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePurgeServiceOfferingActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.purgeServiceOfferingMutex.RLock()
+	defer fake.purgeServiceOfferingMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePurgeServiceOfferingActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.PurgeServiceOfferingActor = new(FakePurgeServiceOfferingActor)
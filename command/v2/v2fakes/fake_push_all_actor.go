@@ -0,0 +1,401 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakePushAllActor struct {
+	ApplyStub        func(ctx context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	applyMutex       sync.RWMutex
+	applyArgsForCall []struct {
+		ctx    context.Context
+		config pushaction.ApplicationConfig
+	}
+	applyReturns struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}
+	applyReturnsOnCall map[int]struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}
+	ConvertToApplicationConfigStub        func(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	convertToApplicationConfigMutex       sync.RWMutex
+	convertToApplicationConfigArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		apps      []manifest.Application
+		reset     bool
+	}
+	convertToApplicationConfigReturns struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	convertToApplicationConfigReturnsOnCall map[int]struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	MergeAndValidateSettingsAndManifestsStub        func(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
+	mergeAndValidateSettingsAndManifestsMutex       sync.RWMutex
+	mergeAndValidateSettingsAndManifestsArgsForCall []struct {
+		cmdSettings pushaction.CommandLineSettings
+		apps        []manifest.Application
+	}
+	mergeAndValidateSettingsAndManifestsReturns struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	mergeAndValidateSettingsAndManifestsReturnsOnCall map[int]struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	ReadManifestDirectoryStub        func(dir string) ([]manifest.Application, error)
+	readManifestDirectoryMutex       sync.RWMutex
+	readManifestDirectoryArgsForCall []struct {
+		dir string
+	}
+	readManifestDirectoryReturns struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	readManifestDirectoryReturnsOnCall map[int]struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	ScheduleApplicationsStub        func(apps []manifest.Application) ([]manifest.Application, error)
+	scheduleApplicationsMutex       sync.RWMutex
+	scheduleApplicationsArgsForCall []struct {
+		apps []manifest.Application
+	}
+	scheduleApplicationsReturns struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	scheduleApplicationsReturnsOnCall map[int]struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePushAllActor) Apply(ctx context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+	fake.applyMutex.Lock()
+	ret, specificReturn := fake.applyReturnsOnCall[len(fake.applyArgsForCall)]
+	fake.applyArgsForCall = append(fake.applyArgsForCall, struct {
+		ctx    context.Context
+		config pushaction.ApplicationConfig
+	}{ctx, config})
+	fake.recordInvocation("Apply", []interface{}{ctx, config})
+	fake.applyMutex.Unlock()
+	if fake.ApplyStub != nil {
+		return fake.ApplyStub(ctx, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.applyReturns.result1, fake.applyReturns.result2, fake.applyReturns.result3
+}
+
+func (fake *FakePushAllActor) ApplyCallCount() int {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return len(fake.applyArgsForCall)
+}
+
+func (fake *FakePushAllActor) ApplyArgsForCall(i int) (context.Context, pushaction.ApplicationConfig) {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return fake.applyArgsForCall[i].ctx, fake.applyArgsForCall[i].config
+}
+
+func (fake *FakePushAllActor) ApplyReturns(result1 <-chan pushaction.Event, result2 <-chan pushaction.Warnings, result3 <-chan error) {
+	fake.ApplyStub = nil
+	fake.applyReturns = struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushAllActor) ApplyReturnsOnCall(i int, result1 <-chan pushaction.Event, result2 <-chan pushaction.Warnings, result3 <-chan error) {
+	fake.ApplyStub = nil
+	if fake.applyReturnsOnCall == nil {
+		fake.applyReturnsOnCall = make(map[int]struct {
+			result1 <-chan pushaction.Event
+			result2 <-chan pushaction.Warnings
+			result3 <-chan error
+		})
+	}
+	fake.applyReturnsOnCall[i] = struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushAllActor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
+	var appsCopy []manifest.Application
+	if apps != nil {
+		appsCopy = make([]manifest.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.convertToApplicationConfigMutex.Lock()
+	ret, specificReturn := fake.convertToApplicationConfigReturnsOnCall[len(fake.convertToApplicationConfigArgsForCall)]
+	fake.convertToApplicationConfigArgsForCall = append(fake.convertToApplicationConfigArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		apps      []manifest.Application
+		reset     bool
+	}{orgGUID, spaceGUID, appsCopy, reset})
+	fake.recordInvocation("ConvertToApplicationConfig", []interface{}{orgGUID, spaceGUID, appsCopy, reset})
+	fake.convertToApplicationConfigMutex.Unlock()
+	if fake.ConvertToApplicationConfigStub != nil {
+		return fake.ConvertToApplicationConfigStub(orgGUID, spaceGUID, apps, reset)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.convertToApplicationConfigReturns.result1, fake.convertToApplicationConfigReturns.result2, fake.convertToApplicationConfigReturns.result3
+}
+
+func (fake *FakePushAllActor) ConvertToApplicationConfigCallCount() int {
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	return len(fake.convertToApplicationConfigArgsForCall)
+}
+
+func (fake *FakePushAllActor) ConvertToApplicationConfigArgsForCall(i int) (string, string, []manifest.Application, bool) {
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	return fake.convertToApplicationConfigArgsForCall[i].orgGUID, fake.convertToApplicationConfigArgsForCall[i].spaceGUID, fake.convertToApplicationConfigArgsForCall[i].apps, fake.convertToApplicationConfigArgsForCall[i].reset
+}
+
+func (fake *FakePushAllActor) ConvertToApplicationConfigReturns(result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigStub = nil
+	fake.convertToApplicationConfigReturns = struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushAllActor) ConvertToApplicationConfigReturnsOnCall(i int, result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigStub = nil
+	if fake.convertToApplicationConfigReturnsOnCall == nil {
+		fake.convertToApplicationConfigReturnsOnCall = make(map[int]struct {
+			result1 []pushaction.ApplicationConfig
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.convertToApplicationConfigReturnsOnCall[i] = struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushAllActor) MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
+	var appsCopy []manifest.Application
+	if apps != nil {
+		appsCopy = make([]manifest.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.mergeAndValidateSettingsAndManifestsMutex.Lock()
+	ret, specificReturn := fake.mergeAndValidateSettingsAndManifestsReturnsOnCall[len(fake.mergeAndValidateSettingsAndManifestsArgsForCall)]
+	fake.mergeAndValidateSettingsAndManifestsArgsForCall = append(fake.mergeAndValidateSettingsAndManifestsArgsForCall, struct {
+		cmdSettings pushaction.CommandLineSettings
+		apps        []manifest.Application
+	}{cmdSettings, appsCopy})
+	fake.recordInvocation("MergeAndValidateSettingsAndManifests", []interface{}{cmdSettings, appsCopy})
+	fake.mergeAndValidateSettingsAndManifestsMutex.Unlock()
+	if fake.MergeAndValidateSettingsAndManifestsStub != nil {
+		return fake.MergeAndValidateSettingsAndManifestsStub(cmdSettings, apps)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.mergeAndValidateSettingsAndManifestsReturns.result1, fake.mergeAndValidateSettingsAndManifestsReturns.result2
+}
+
+func (fake *FakePushAllActor) MergeAndValidateSettingsAndManifestsCallCount() int {
+	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
+	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	return len(fake.mergeAndValidateSettingsAndManifestsArgsForCall)
+}
+
+func (fake *FakePushAllActor) MergeAndValidateSettingsAndManifestsArgsForCall(i int) (pushaction.CommandLineSettings, []manifest.Application) {
+	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
+	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	return fake.mergeAndValidateSettingsAndManifestsArgsForCall[i].cmdSettings, fake.mergeAndValidateSettingsAndManifestsArgsForCall[i].apps
+}
+
+func (fake *FakePushAllActor) MergeAndValidateSettingsAndManifestsReturns(result1 []manifest.Application, result2 error) {
+	fake.MergeAndValidateSettingsAndManifestsStub = nil
+	fake.mergeAndValidateSettingsAndManifestsReturns = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushAllActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i int, result1 []manifest.Application, result2 error) {
+	fake.MergeAndValidateSettingsAndManifestsStub = nil
+	if fake.mergeAndValidateSettingsAndManifestsReturnsOnCall == nil {
+		fake.mergeAndValidateSettingsAndManifestsReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Application
+			result2 error
+		})
+	}
+	fake.mergeAndValidateSettingsAndManifestsReturnsOnCall[i] = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushAllActor) ReadManifestDirectory(dir string) ([]manifest.Application, error) {
+	fake.readManifestDirectoryMutex.Lock()
+	ret, specificReturn := fake.readManifestDirectoryReturnsOnCall[len(fake.readManifestDirectoryArgsForCall)]
+	fake.readManifestDirectoryArgsForCall = append(fake.readManifestDirectoryArgsForCall, struct {
+		dir string
+	}{dir})
+	fake.recordInvocation("ReadManifestDirectory", []interface{}{dir})
+	fake.readManifestDirectoryMutex.Unlock()
+	if fake.ReadManifestDirectoryStub != nil {
+		return fake.ReadManifestDirectoryStub(dir)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readManifestDirectoryReturns.result1, fake.readManifestDirectoryReturns.result2
+}
+
+func (fake *FakePushAllActor) ReadManifestDirectoryCallCount() int {
+	fake.readManifestDirectoryMutex.RLock()
+	defer fake.readManifestDirectoryMutex.RUnlock()
+	return len(fake.readManifestDirectoryArgsForCall)
+}
+
+func (fake *FakePushAllActor) ReadManifestDirectoryArgsForCall(i int) string {
+	fake.readManifestDirectoryMutex.RLock()
+	defer fake.readManifestDirectoryMutex.RUnlock()
+	return fake.readManifestDirectoryArgsForCall[i].dir
+}
+
+func (fake *FakePushAllActor) ReadManifestDirectoryReturns(result1 []manifest.Application, result2 error) {
+	fake.ReadManifestDirectoryStub = nil
+	fake.readManifestDirectoryReturns = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushAllActor) ReadManifestDirectoryReturnsOnCall(i int, result1 []manifest.Application, result2 error) {
+	fake.ReadManifestDirectoryStub = nil
+	if fake.readManifestDirectoryReturnsOnCall == nil {
+		fake.readManifestDirectoryReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Application
+			result2 error
+		})
+	}
+	fake.readManifestDirectoryReturnsOnCall[i] = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushAllActor) ScheduleApplications(apps []manifest.Application) ([]manifest.Application, error) {
+	var appsCopy []manifest.Application
+	if apps != nil {
+		appsCopy = make([]manifest.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.scheduleApplicationsMutex.Lock()
+	ret, specificReturn := fake.scheduleApplicationsReturnsOnCall[len(fake.scheduleApplicationsArgsForCall)]
+	fake.scheduleApplicationsArgsForCall = append(fake.scheduleApplicationsArgsForCall, struct {
+		apps []manifest.Application
+	}{appsCopy})
+	fake.recordInvocation("ScheduleApplications", []interface{}{appsCopy})
+	fake.scheduleApplicationsMutex.Unlock()
+	if fake.ScheduleApplicationsStub != nil {
+		return fake.ScheduleApplicationsStub(apps)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.scheduleApplicationsReturns.result1, fake.scheduleApplicationsReturns.result2
+}
+
+func (fake *FakePushAllActor) ScheduleApplicationsCallCount() int {
+	fake.scheduleApplicationsMutex.RLock()
+	defer fake.scheduleApplicationsMutex.RUnlock()
+	return len(fake.scheduleApplicationsArgsForCall)
+}
+
+func (fake *FakePushAllActor) ScheduleApplicationsArgsForCall(i int) []manifest.Application {
+	fake.scheduleApplicationsMutex.RLock()
+	defer fake.scheduleApplicationsMutex.RUnlock()
+	return fake.scheduleApplicationsArgsForCall[i].apps
+}
+
+func (fake *FakePushAllActor) ScheduleApplicationsReturns(result1 []manifest.Application, result2 error) {
+	fake.ScheduleApplicationsStub = nil
+	fake.scheduleApplicationsReturns = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushAllActor) ScheduleApplicationsReturnsOnCall(i int, result1 []manifest.Application, result2 error) {
+	fake.ScheduleApplicationsStub = nil
+	if fake.scheduleApplicationsReturnsOnCall == nil {
+		fake.scheduleApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Application
+			result2 error
+		})
+	}
+	fake.scheduleApplicationsReturnsOnCall[i] = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushAllActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
+	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	fake.readManifestDirectoryMutex.RLock()
+	defer fake.readManifestDirectoryMutex.RUnlock()
+	fake.scheduleApplicationsMutex.RLock()
+	defer fake.scheduleApplicationsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePushAllActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.PushAllActor = new(FakePushAllActor)
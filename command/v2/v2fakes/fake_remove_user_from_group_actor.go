@@ -0,0 +1,98 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeRemoveUserFromGroupActor struct {
+	RemoveUserFromUAAGroupStub        func(username string, origin string, groupName string) error
+	removeUserFromUAAGroupMutex       sync.RWMutex
+	removeUserFromUAAGroupArgsForCall []struct {
+		username  string
+		origin    string
+		groupName string
+	}
+	removeUserFromUAAGroupReturns struct {
+		result1 error
+	}
+	removeUserFromUAAGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRemoveUserFromGroupActor) RemoveUserFromUAAGroup(username string, origin string, groupName string) error {
+	fake.removeUserFromUAAGroupMutex.Lock()
+	ret, specificReturn := fake.removeUserFromUAAGroupReturnsOnCall[len(fake.removeUserFromUAAGroupArgsForCall)]
+	fake.removeUserFromUAAGroupArgsForCall = append(fake.removeUserFromUAAGroupArgsForCall, struct {
+		username  string
+		origin    string
+		groupName string
+	}{username, origin, groupName})
+	fake.recordInvocation("RemoveUserFromUAAGroup", []interface{}{username, origin, groupName})
+	fake.removeUserFromUAAGroupMutex.Unlock()
+	if fake.RemoveUserFromUAAGroupStub != nil {
+		return fake.RemoveUserFromUAAGroupStub(username, origin, groupName)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removeUserFromUAAGroupReturns.result1
+}
+
+func (fake *FakeRemoveUserFromGroupActor) RemoveUserFromUAAGroupCallCount() int {
+	fake.removeUserFromUAAGroupMutex.RLock()
+	defer fake.removeUserFromUAAGroupMutex.RUnlock()
+	return len(fake.removeUserFromUAAGroupArgsForCall)
+}
+
+func (fake *FakeRemoveUserFromGroupActor) RemoveUserFromUAAGroupArgsForCall(i int) (string, string, string) {
+	fake.removeUserFromUAAGroupMutex.RLock()
+	defer fake.removeUserFromUAAGroupMutex.RUnlock()
+	return fake.removeUserFromUAAGroupArgsForCall[i].username, fake.removeUserFromUAAGroupArgsForCall[i].origin, fake.removeUserFromUAAGroupArgsForCall[i].groupName
+}
+
+func (fake *FakeRemoveUserFromGroupActor) RemoveUserFromUAAGroupReturns(result1 error) {
+	fake.RemoveUserFromUAAGroupStub = nil
+	fake.removeUserFromUAAGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRemoveUserFromGroupActor) RemoveUserFromUAAGroupReturnsOnCall(i int, result1 error) {
+	fake.RemoveUserFromUAAGroupStub = nil
+	if fake.removeUserFromUAAGroupReturnsOnCall == nil {
+		fake.removeUserFromUAAGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeUserFromUAAGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRemoveUserFromGroupActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.removeUserFromUAAGroupMutex.RLock()
+	defer fake.removeUserFromUAAGroupMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRemoveUserFromGroupActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.RemoveUserFromGroupActor = new(FakeRemoveUserFromGroupActor)
@@ -0,0 +1,109 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeRenameActor struct {
+	RenameApplicationByNameAndSpaceStub        func(oldName string, newName string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	renameApplicationByNameAndSpaceMutex       sync.RWMutex
+	renameApplicationByNameAndSpaceArgsForCall []struct {
+		oldName   string
+		newName   string
+		spaceGUID string
+	}
+	renameApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	renameApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRenameActor) RenameApplicationByNameAndSpace(oldName string, newName string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.renameApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.renameApplicationByNameAndSpaceReturnsOnCall[len(fake.renameApplicationByNameAndSpaceArgsForCall)]
+	fake.renameApplicationByNameAndSpaceArgsForCall = append(fake.renameApplicationByNameAndSpaceArgsForCall, struct {
+		oldName   string
+		newName   string
+		spaceGUID string
+	}{oldName, newName, spaceGUID})
+	fake.recordInvocation("RenameApplicationByNameAndSpace", []interface{}{oldName, newName, spaceGUID})
+	fake.renameApplicationByNameAndSpaceMutex.Unlock()
+	if fake.RenameApplicationByNameAndSpaceStub != nil {
+		return fake.RenameApplicationByNameAndSpaceStub(oldName, newName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.renameApplicationByNameAndSpaceReturns.result1, fake.renameApplicationByNameAndSpaceReturns.result2, fake.renameApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeRenameActor) RenameApplicationByNameAndSpaceCallCount() int {
+	fake.renameApplicationByNameAndSpaceMutex.RLock()
+	defer fake.renameApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.renameApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeRenameActor) RenameApplicationByNameAndSpaceArgsForCall(i int) (string, string, string) {
+	fake.renameApplicationByNameAndSpaceMutex.RLock()
+	defer fake.renameApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.renameApplicationByNameAndSpaceArgsForCall[i].oldName, fake.renameApplicationByNameAndSpaceArgsForCall[i].newName, fake.renameApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeRenameActor) RenameApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.RenameApplicationByNameAndSpaceStub = nil
+	fake.renameApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRenameActor) RenameApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.RenameApplicationByNameAndSpaceStub = nil
+	if fake.renameApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.renameApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.renameApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRenameActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.renameApplicationByNameAndSpaceMutex.RLock()
+	defer fake.renameApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRenameActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.RenameActor = new(FakeRenameActor)
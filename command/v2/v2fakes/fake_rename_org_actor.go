@@ -0,0 +1,107 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeRenameOrgActor struct {
+	RenameOrganizationStub        func(oldName string, newName string) (v2action.Organization, v2action.Warnings, error)
+	renameOrganizationMutex       sync.RWMutex
+	renameOrganizationArgsForCall []struct {
+		oldName string
+		newName string
+	}
+	renameOrganizationReturns struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	renameOrganizationReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRenameOrgActor) RenameOrganization(oldName string, newName string) (v2action.Organization, v2action.Warnings, error) {
+	fake.renameOrganizationMutex.Lock()
+	ret, specificReturn := fake.renameOrganizationReturnsOnCall[len(fake.renameOrganizationArgsForCall)]
+	fake.renameOrganizationArgsForCall = append(fake.renameOrganizationArgsForCall, struct {
+		oldName string
+		newName string
+	}{oldName, newName})
+	fake.recordInvocation("RenameOrganization", []interface{}{oldName, newName})
+	fake.renameOrganizationMutex.Unlock()
+	if fake.RenameOrganizationStub != nil {
+		return fake.RenameOrganizationStub(oldName, newName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.renameOrganizationReturns.result1, fake.renameOrganizationReturns.result2, fake.renameOrganizationReturns.result3
+}
+
+func (fake *FakeRenameOrgActor) RenameOrganizationCallCount() int {
+	fake.renameOrganizationMutex.RLock()
+	defer fake.renameOrganizationMutex.RUnlock()
+	return len(fake.renameOrganizationArgsForCall)
+}
+
+func (fake *FakeRenameOrgActor) RenameOrganizationArgsForCall(i int) (string, string) {
+	fake.renameOrganizationMutex.RLock()
+	defer fake.renameOrganizationMutex.RUnlock()
+	return fake.renameOrganizationArgsForCall[i].oldName, fake.renameOrganizationArgsForCall[i].newName
+}
+
+func (fake *FakeRenameOrgActor) RenameOrganizationReturns(result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.RenameOrganizationStub = nil
+	fake.renameOrganizationReturns = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRenameOrgActor) RenameOrganizationReturnsOnCall(i int, result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.RenameOrganizationStub = nil
+	if fake.renameOrganizationReturnsOnCall == nil {
+		fake.renameOrganizationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.renameOrganizationReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRenameOrgActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.renameOrganizationMutex.RLock()
+	defer fake.renameOrganizationMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRenameOrgActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.RenameOrgActor = new(FakeRenameOrgActor)
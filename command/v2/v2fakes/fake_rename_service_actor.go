@@ -0,0 +1,109 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeRenameServiceActor struct {
+	RenameServiceInstanceByNameAndSpaceStub        func(oldName string, newName string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	renameServiceInstanceByNameAndSpaceMutex       sync.RWMutex
+	renameServiceInstanceByNameAndSpaceArgsForCall []struct {
+		oldName   string
+		newName   string
+		spaceGUID string
+	}
+	renameServiceInstanceByNameAndSpaceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	renameServiceInstanceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceByNameAndSpace(oldName string, newName string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.renameServiceInstanceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.renameServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.renameServiceInstanceByNameAndSpaceArgsForCall)]
+	fake.renameServiceInstanceByNameAndSpaceArgsForCall = append(fake.renameServiceInstanceByNameAndSpaceArgsForCall, struct {
+		oldName   string
+		newName   string
+		spaceGUID string
+	}{oldName, newName, spaceGUID})
+	fake.recordInvocation("RenameServiceInstanceByNameAndSpace", []interface{}{oldName, newName, spaceGUID})
+	fake.renameServiceInstanceByNameAndSpaceMutex.Unlock()
+	if fake.RenameServiceInstanceByNameAndSpaceStub != nil {
+		return fake.RenameServiceInstanceByNameAndSpaceStub(oldName, newName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.renameServiceInstanceByNameAndSpaceReturns.result1, fake.renameServiceInstanceByNameAndSpaceReturns.result2, fake.renameServiceInstanceByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceByNameAndSpaceCallCount() int {
+	fake.renameServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.renameServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return len(fake.renameServiceInstanceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceByNameAndSpaceArgsForCall(i int) (string, string, string) {
+	fake.renameServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.renameServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return fake.renameServiceInstanceByNameAndSpaceArgsForCall[i].oldName, fake.renameServiceInstanceByNameAndSpaceArgsForCall[i].newName, fake.renameServiceInstanceByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceByNameAndSpaceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.RenameServiceInstanceByNameAndSpaceStub = nil
+	fake.renameServiceInstanceByNameAndSpaceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRenameServiceActor) RenameServiceInstanceByNameAndSpaceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.RenameServiceInstanceByNameAndSpaceStub = nil
+	if fake.renameServiceInstanceByNameAndSpaceReturnsOnCall == nil {
+		fake.renameServiceInstanceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.renameServiceInstanceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRenameServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.renameServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.renameServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRenameServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.RenameServiceActor = new(FakeRenameServiceActor)
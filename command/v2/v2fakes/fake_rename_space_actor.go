@@ -0,0 +1,109 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeRenameSpaceActor struct {
+	RenameSpaceByOrganizationAndNameStub        func(orgGUID string, oldName string, newName string) (v2action.Space, v2action.Warnings, error)
+	renameSpaceByOrganizationAndNameMutex       sync.RWMutex
+	renameSpaceByOrganizationAndNameArgsForCall []struct {
+		orgGUID string
+		oldName string
+		newName string
+	}
+	renameSpaceByOrganizationAndNameReturns struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	renameSpaceByOrganizationAndNameReturnsOnCall map[int]struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRenameSpaceActor) RenameSpaceByOrganizationAndName(orgGUID string, oldName string, newName string) (v2action.Space, v2action.Warnings, error) {
+	fake.renameSpaceByOrganizationAndNameMutex.Lock()
+	ret, specificReturn := fake.renameSpaceByOrganizationAndNameReturnsOnCall[len(fake.renameSpaceByOrganizationAndNameArgsForCall)]
+	fake.renameSpaceByOrganizationAndNameArgsForCall = append(fake.renameSpaceByOrganizationAndNameArgsForCall, struct {
+		orgGUID string
+		oldName string
+		newName string
+	}{orgGUID, oldName, newName})
+	fake.recordInvocation("RenameSpaceByOrganizationAndName", []interface{}{orgGUID, oldName, newName})
+	fake.renameSpaceByOrganizationAndNameMutex.Unlock()
+	if fake.RenameSpaceByOrganizationAndNameStub != nil {
+		return fake.RenameSpaceByOrganizationAndNameStub(orgGUID, oldName, newName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.renameSpaceByOrganizationAndNameReturns.result1, fake.renameSpaceByOrganizationAndNameReturns.result2, fake.renameSpaceByOrganizationAndNameReturns.result3
+}
+
+func (fake *FakeRenameSpaceActor) RenameSpaceByOrganizationAndNameCallCount() int {
+	fake.renameSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.renameSpaceByOrganizationAndNameMutex.RUnlock()
+	return len(fake.renameSpaceByOrganizationAndNameArgsForCall)
+}
+
+func (fake *FakeRenameSpaceActor) RenameSpaceByOrganizationAndNameArgsForCall(i int) (string, string, string) {
+	fake.renameSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.renameSpaceByOrganizationAndNameMutex.RUnlock()
+	return fake.renameSpaceByOrganizationAndNameArgsForCall[i].orgGUID, fake.renameSpaceByOrganizationAndNameArgsForCall[i].oldName, fake.renameSpaceByOrganizationAndNameArgsForCall[i].newName
+}
+
+func (fake *FakeRenameSpaceActor) RenameSpaceByOrganizationAndNameReturns(result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.RenameSpaceByOrganizationAndNameStub = nil
+	fake.renameSpaceByOrganizationAndNameReturns = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRenameSpaceActor) RenameSpaceByOrganizationAndNameReturnsOnCall(i int, result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.RenameSpaceByOrganizationAndNameStub = nil
+	if fake.renameSpaceByOrganizationAndNameReturnsOnCall == nil {
+		fake.renameSpaceByOrganizationAndNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.renameSpaceByOrganizationAndNameReturnsOnCall[i] = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRenameSpaceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.renameSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.renameSpaceByOrganizationAndNameMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRenameSpaceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.RenameSpaceActor = new(FakeRenameSpaceActor)
@@ -0,0 +1,182 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeRestartActorV3 struct {
+	CreateDeploymentByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, strategy string, maxInFlight int) (v3action.Deployment, v3action.Warnings, error)
+	createDeploymentByApplicationNameAndSpaceMutex       sync.RWMutex
+	createDeploymentByApplicationNameAndSpaceArgsForCall []struct {
+		appName     string
+		spaceGUID   string
+		strategy    string
+		maxInFlight int
+	}
+	createDeploymentByApplicationNameAndSpaceReturns struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	createDeploymentByApplicationNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	PollDeploymentStub        func(deployment v3action.Deployment) (v3action.Deployment, v3action.Warnings, error)
+	pollDeploymentMutex       sync.RWMutex
+	pollDeploymentArgsForCall []struct {
+		deployment v3action.Deployment
+	}
+	pollDeploymentReturns struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	pollDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRestartActorV3) CreateDeploymentByApplicationNameAndSpace(appName string, spaceGUID string, strategy string, maxInFlight int) (v3action.Deployment, v3action.Warnings, error) {
+	fake.createDeploymentByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.createDeploymentByApplicationNameAndSpaceReturnsOnCall[len(fake.createDeploymentByApplicationNameAndSpaceArgsForCall)]
+	fake.createDeploymentByApplicationNameAndSpaceArgsForCall = append(fake.createDeploymentByApplicationNameAndSpaceArgsForCall, struct {
+		appName     string
+		spaceGUID   string
+		strategy    string
+		maxInFlight int
+	}{appName, spaceGUID, strategy, maxInFlight})
+	fake.recordInvocation("CreateDeploymentByApplicationNameAndSpace", []interface{}{appName, spaceGUID, strategy, maxInFlight})
+	fake.createDeploymentByApplicationNameAndSpaceMutex.Unlock()
+	if fake.CreateDeploymentByApplicationNameAndSpaceStub != nil {
+		return fake.CreateDeploymentByApplicationNameAndSpaceStub(appName, spaceGUID, strategy, maxInFlight)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createDeploymentByApplicationNameAndSpaceReturns.result1, fake.createDeploymentByApplicationNameAndSpaceReturns.result2, fake.createDeploymentByApplicationNameAndSpaceReturns.result3
+}
+
+func (fake *FakeRestartActorV3) CreateDeploymentByApplicationNameAndSpaceCallCount() int {
+	fake.createDeploymentByApplicationNameAndSpaceMutex.RLock()
+	defer fake.createDeploymentByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.createDeploymentByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeRestartActorV3) CreateDeploymentByApplicationNameAndSpaceArgsForCall(i int) (string, string, string, int) {
+	fake.createDeploymentByApplicationNameAndSpaceMutex.RLock()
+	defer fake.createDeploymentByApplicationNameAndSpaceMutex.RUnlock()
+	return fake.createDeploymentByApplicationNameAndSpaceArgsForCall[i].appName, fake.createDeploymentByApplicationNameAndSpaceArgsForCall[i].spaceGUID, fake.createDeploymentByApplicationNameAndSpaceArgsForCall[i].strategy, fake.createDeploymentByApplicationNameAndSpaceArgsForCall[i].maxInFlight
+}
+
+func (fake *FakeRestartActorV3) CreateDeploymentByApplicationNameAndSpaceReturns(result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentByApplicationNameAndSpaceStub = nil
+	fake.createDeploymentByApplicationNameAndSpaceReturns = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRestartActorV3) CreateDeploymentByApplicationNameAndSpaceReturnsOnCall(i int, result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentByApplicationNameAndSpaceStub = nil
+	if fake.createDeploymentByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.createDeploymentByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Deployment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.createDeploymentByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRestartActorV3) PollDeployment(deployment v3action.Deployment) (v3action.Deployment, v3action.Warnings, error) {
+	fake.pollDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentReturnsOnCall[len(fake.pollDeploymentArgsForCall)]
+	fake.pollDeploymentArgsForCall = append(fake.pollDeploymentArgsForCall, struct {
+		deployment v3action.Deployment
+	}{deployment})
+	fake.recordInvocation("PollDeployment", []interface{}{deployment})
+	fake.pollDeploymentMutex.Unlock()
+	if fake.PollDeploymentStub != nil {
+		return fake.PollDeploymentStub(deployment)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.pollDeploymentReturns.result1, fake.pollDeploymentReturns.result2, fake.pollDeploymentReturns.result3
+}
+
+func (fake *FakeRestartActorV3) PollDeploymentCallCount() int {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return len(fake.pollDeploymentArgsForCall)
+}
+
+func (fake *FakeRestartActorV3) PollDeploymentArgsForCall(i int) v3action.Deployment {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return fake.pollDeploymentArgsForCall[i].deployment
+}
+
+func (fake *FakeRestartActorV3) PollDeploymentReturns(result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.PollDeploymentStub = nil
+	fake.pollDeploymentReturns = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRestartActorV3) PollDeploymentReturnsOnCall(i int, result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.PollDeploymentStub = nil
+	if fake.pollDeploymentReturnsOnCall == nil {
+		fake.pollDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Deployment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.pollDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRestartActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createDeploymentByApplicationNameAndSpaceMutex.RLock()
+	defer fake.createDeploymentByApplicationNameAndSpaceMutex.RUnlock()
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRestartActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.RestartActorV3 = new(FakeRestartActorV3)
@@ -0,0 +1,176 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeRoutesActor struct {
+	GetSpaceRouteSummariesStub        func(spaceGUID string) ([]v2action.RouteSummary, v2action.Warnings, error)
+	getSpaceRouteSummariesMutex       sync.RWMutex
+	getSpaceRouteSummariesArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceRouteSummariesReturns struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceRouteSummariesReturnsOnCall map[int]struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationRouteSummariesStub        func(orgGUID string) ([]v2action.RouteSummary, v2action.Warnings, error)
+	getOrganizationRouteSummariesMutex       sync.RWMutex
+	getOrganizationRouteSummariesArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationRouteSummariesReturns struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationRouteSummariesReturnsOnCall map[int]struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummaries(spaceGUID string) ([]v2action.RouteSummary, v2action.Warnings, error) {
+	fake.getSpaceRouteSummariesMutex.Lock()
+	ret, specificReturn := fake.getSpaceRouteSummariesReturnsOnCall[len(fake.getSpaceRouteSummariesArgsForCall)]
+	fake.getSpaceRouteSummariesArgsForCall = append(fake.getSpaceRouteSummariesArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceRouteSummaries", []interface{}{spaceGUID})
+	fake.getSpaceRouteSummariesMutex.Unlock()
+	if fake.GetSpaceRouteSummariesStub != nil {
+		return fake.GetSpaceRouteSummariesStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceRouteSummariesReturns.result1, fake.getSpaceRouteSummariesReturns.result2, fake.getSpaceRouteSummariesReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummariesCallCount() int {
+	fake.getSpaceRouteSummariesMutex.RLock()
+	defer fake.getSpaceRouteSummariesMutex.RUnlock()
+	return len(fake.getSpaceRouteSummariesArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummariesArgsForCall(i int) string {
+	fake.getSpaceRouteSummariesMutex.RLock()
+	defer fake.getSpaceRouteSummariesMutex.RUnlock()
+	return fake.getSpaceRouteSummariesArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummariesReturns(result1 []v2action.RouteSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRouteSummariesStub = nil
+	fake.getSpaceRouteSummariesReturns = struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummariesReturnsOnCall(i int, result1 []v2action.RouteSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRouteSummariesStub = nil
+	if fake.getSpaceRouteSummariesReturnsOnCall == nil {
+		fake.getSpaceRouteSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.RouteSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRouteSummariesReturnsOnCall[i] = struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummaries(orgGUID string) ([]v2action.RouteSummary, v2action.Warnings, error) {
+	fake.getOrganizationRouteSummariesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationRouteSummariesReturnsOnCall[len(fake.getOrganizationRouteSummariesArgsForCall)]
+	fake.getOrganizationRouteSummariesArgsForCall = append(fake.getOrganizationRouteSummariesArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationRouteSummaries", []interface{}{orgGUID})
+	fake.getOrganizationRouteSummariesMutex.Unlock()
+	if fake.GetOrganizationRouteSummariesStub != nil {
+		return fake.GetOrganizationRouteSummariesStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationRouteSummariesReturns.result1, fake.getOrganizationRouteSummariesReturns.result2, fake.getOrganizationRouteSummariesReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummariesCallCount() int {
+	fake.getOrganizationRouteSummariesMutex.RLock()
+	defer fake.getOrganizationRouteSummariesMutex.RUnlock()
+	return len(fake.getOrganizationRouteSummariesArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummariesArgsForCall(i int) string {
+	fake.getOrganizationRouteSummariesMutex.RLock()
+	defer fake.getOrganizationRouteSummariesMutex.RUnlock()
+	return fake.getOrganizationRouteSummariesArgsForCall[i].orgGUID
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummariesReturns(result1 []v2action.RouteSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationRouteSummariesStub = nil
+	fake.getOrganizationRouteSummariesReturns = struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummariesReturnsOnCall(i int, result1 []v2action.RouteSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationRouteSummariesStub = nil
+	if fake.getOrganizationRouteSummariesReturnsOnCall == nil {
+		fake.getOrganizationRouteSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.RouteSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationRouteSummariesReturnsOnCall[i] = struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getSpaceRouteSummariesMutex.RLock()
+	defer fake.getSpaceRouteSummariesMutex.RUnlock()
+	fake.getOrganizationRouteSummariesMutex.RLock()
+	defer fake.getOrganizationRouteSummariesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRoutesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.RoutesActor = new(FakeRoutesActor)
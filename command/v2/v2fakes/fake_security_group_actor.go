@@ -0,0 +1,105 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeSecurityGroupActor struct {
+	GetSecurityGroupSummaryStub        func(securityGroupName string) (v2action.SecurityGroupSummary, v2action.Warnings, error)
+	getSecurityGroupSummaryMutex       sync.RWMutex
+	getSecurityGroupSummaryArgsForCall []struct {
+		securityGroupName string
+	}
+	getSecurityGroupSummaryReturns struct {
+		result1 v2action.SecurityGroupSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSecurityGroupSummaryReturnsOnCall map[int]struct {
+		result1 v2action.SecurityGroupSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSecurityGroupActor) GetSecurityGroupSummary(securityGroupName string) (v2action.SecurityGroupSummary, v2action.Warnings, error) {
+	fake.getSecurityGroupSummaryMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupSummaryReturnsOnCall[len(fake.getSecurityGroupSummaryArgsForCall)]
+	fake.getSecurityGroupSummaryArgsForCall = append(fake.getSecurityGroupSummaryArgsForCall, struct {
+		securityGroupName string
+	}{securityGroupName})
+	fake.recordInvocation("GetSecurityGroupSummary", []interface{}{securityGroupName})
+	fake.getSecurityGroupSummaryMutex.Unlock()
+	if fake.GetSecurityGroupSummaryStub != nil {
+		return fake.GetSecurityGroupSummaryStub(securityGroupName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecurityGroupSummaryReturns.result1, fake.getSecurityGroupSummaryReturns.result2, fake.getSecurityGroupSummaryReturns.result3
+}
+
+func (fake *FakeSecurityGroupActor) GetSecurityGroupSummaryCallCount() int {
+	fake.getSecurityGroupSummaryMutex.RLock()
+	defer fake.getSecurityGroupSummaryMutex.RUnlock()
+	return len(fake.getSecurityGroupSummaryArgsForCall)
+}
+
+func (fake *FakeSecurityGroupActor) GetSecurityGroupSummaryArgsForCall(i int) string {
+	fake.getSecurityGroupSummaryMutex.RLock()
+	defer fake.getSecurityGroupSummaryMutex.RUnlock()
+	return fake.getSecurityGroupSummaryArgsForCall[i].securityGroupName
+}
+
+func (fake *FakeSecurityGroupActor) GetSecurityGroupSummaryReturns(result1 v2action.SecurityGroupSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupSummaryStub = nil
+	fake.getSecurityGroupSummaryReturns = struct {
+		result1 v2action.SecurityGroupSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSecurityGroupActor) GetSecurityGroupSummaryReturnsOnCall(i int, result1 v2action.SecurityGroupSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupSummaryStub = nil
+	if fake.getSecurityGroupSummaryReturnsOnCall == nil {
+		fake.getSecurityGroupSummaryReturnsOnCall = make(map[int]struct {
+			result1 v2action.SecurityGroupSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecurityGroupSummaryReturnsOnCall[i] = struct {
+		result1 v2action.SecurityGroupSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSecurityGroupActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getSecurityGroupSummaryMutex.RLock()
+	defer fake.getSecurityGroupSummaryMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSecurityGroupActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.SecurityGroupActor = new(FakeSecurityGroupActor)
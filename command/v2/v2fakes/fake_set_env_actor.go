@@ -0,0 +1,104 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeSetEnvActor struct {
+	SetEnvironmentVariableByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, envPair v3action.EnvironmentVariablePair) (v3action.Warnings, error)
+	setEnvironmentVariableByApplicationNameAndSpaceMutex       sync.RWMutex
+	setEnvironmentVariableByApplicationNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+		envPair   v3action.EnvironmentVariablePair
+	}
+	setEnvironmentVariableByApplicationNameAndSpaceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	setEnvironmentVariableByApplicationNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSetEnvActor) SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair v3action.EnvironmentVariablePair) (v3action.Warnings, error) {
+	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.setEnvironmentVariableByApplicationNameAndSpaceReturnsOnCall[len(fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall)]
+	fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall = append(fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+		envPair   v3action.EnvironmentVariablePair
+	}{appName, spaceGUID, envPair})
+	fake.recordInvocation("SetEnvironmentVariableByApplicationNameAndSpace", []interface{}{appName, spaceGUID, envPair})
+	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.Unlock()
+	if fake.SetEnvironmentVariableByApplicationNameAndSpaceStub != nil {
+		return fake.SetEnvironmentVariableByApplicationNameAndSpaceStub(appName, spaceGUID, envPair)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setEnvironmentVariableByApplicationNameAndSpaceReturns.result1, fake.setEnvironmentVariableByApplicationNameAndSpaceReturns.result2
+}
+
+func (fake *FakeSetEnvActor) SetEnvironmentVariableByApplicationNameAndSpaceCallCount() int {
+	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RLock()
+	defer fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeSetEnvActor) SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(i int) (string, string, v3action.EnvironmentVariablePair) {
+	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RLock()
+	defer fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RUnlock()
+	return fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall[i].appName, fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall[i].spaceGUID, fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall[i].envPair
+}
+
+func (fake *FakeSetEnvActor) SetEnvironmentVariableByApplicationNameAndSpaceReturns(result1 v3action.Warnings, result2 error) {
+	fake.SetEnvironmentVariableByApplicationNameAndSpaceStub = nil
+	fake.setEnvironmentVariableByApplicationNameAndSpaceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetEnvActor) SetEnvironmentVariableByApplicationNameAndSpaceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.SetEnvironmentVariableByApplicationNameAndSpaceStub = nil
+	if fake.setEnvironmentVariableByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.setEnvironmentVariableByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.setEnvironmentVariableByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetEnvActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RLock()
+	defer fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSetEnvActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.SetEnvActor = new(FakeSetEnvActor)
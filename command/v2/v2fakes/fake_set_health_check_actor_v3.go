@@ -0,0 +1,188 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeSetHealthCheckActorV3 struct {
+	SetApplicationProcessHealthCheckByNameAndSpaceStub        func(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error)
+	setApplicationProcessHealthCheckByNameAndSpaceMutex       sync.RWMutex
+	setApplicationProcessHealthCheckByNameAndSpaceArgsForCall []struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		healthCheck v3action.ProcessHealthCheck
+	}
+	setApplicationProcessHealthCheckByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub        func(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error)
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex       sync.RWMutex
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall []struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		healthCheck v3action.ProcessHealthCheck
+	}
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error) {
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall[len(fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall)]
+	fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall = append(fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall, struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		healthCheck v3action.ProcessHealthCheck
+	}{appName, spaceGUID, processType, healthCheck})
+	fake.recordInvocation("SetApplicationProcessHealthCheckByNameAndSpace", []interface{}{appName, spaceGUID, processType, healthCheck})
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.Unlock()
+	if fake.SetApplicationProcessHealthCheckByNameAndSpaceStub != nil {
+		return fake.SetApplicationProcessHealthCheckByNameAndSpaceStub(appName, spaceGUID, processType, healthCheck)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.setApplicationProcessHealthCheckByNameAndSpaceReturns.result1, fake.setApplicationProcessHealthCheckByNameAndSpaceReturns.result2, fake.setApplicationProcessHealthCheckByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessHealthCheckByNameAndSpaceCallCount() int {
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return len(fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessHealthCheckByNameAndSpaceArgsForCall(i int) (string, string, string, v3action.ProcessHealthCheck) {
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall[i].appName, fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall[i].spaceGUID, fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall[i].processType, fake.setApplicationProcessHealthCheckByNameAndSpaceArgsForCall[i].healthCheck
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessHealthCheckByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.SetApplicationProcessHealthCheckByNameAndSpaceStub = nil
+	fake.setApplicationProcessHealthCheckByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.SetApplicationProcessHealthCheckByNameAndSpaceStub = nil
+	if fake.setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall == nil {
+		fake.setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.setApplicationProcessHealthCheckByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessReadinessHealthCheckByNameAndSpace(appName string, spaceGUID string, processType string, healthCheck v3action.ProcessHealthCheck) (v3action.Application, v3action.Warnings, error) {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall[len(fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall)]
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall = append(fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall, struct {
+		appName     string
+		spaceGUID   string
+		processType string
+		healthCheck v3action.ProcessHealthCheck
+	}{appName, spaceGUID, processType, healthCheck})
+	fake.recordInvocation("SetApplicationProcessReadinessHealthCheckByNameAndSpace", []interface{}{appName, spaceGUID, processType, healthCheck})
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Unlock()
+	if fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub != nil {
+		return fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub(appName, spaceGUID, processType, healthCheck)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns.result1, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns.result2, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessReadinessHealthCheckByNameAndSpaceCallCount() int {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return len(fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall(i int) (string, string, string, v3action.ProcessHealthCheck) {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall[i].appName, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall[i].spaceGUID, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall[i].processType, fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall[i].healthCheck
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub = nil
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetHealthCheckActorV3) SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub = nil
+	if fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall == nil {
+		fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetHealthCheckActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessHealthCheckByNameAndSpaceMutex.RUnlock()
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSetHealthCheckActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.SetHealthCheckActorV3 = new(FakeSetHealthCheckActorV3)
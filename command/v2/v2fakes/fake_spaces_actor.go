@@ -0,0 +1,102 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeSpacesActor struct {
+	GetOrganizationSpacesWithQuotasStub        func(orgGUID string, handlePage func([]v2action.SpaceWithQuota)) (v2action.Warnings, error)
+	getOrganizationSpacesWithQuotasMutex       sync.RWMutex
+	getOrganizationSpacesWithQuotasArgsForCall []struct {
+		orgGUID    string
+		handlePage func([]v2action.SpaceWithQuota)
+	}
+	getOrganizationSpacesWithQuotasReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	getOrganizationSpacesWithQuotasReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSpacesActor) GetOrganizationSpacesWithQuotas(orgGUID string, handlePage func([]v2action.SpaceWithQuota)) (v2action.Warnings, error) {
+	fake.getOrganizationSpacesWithQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpacesWithQuotasReturnsOnCall[len(fake.getOrganizationSpacesWithQuotasArgsForCall)]
+	fake.getOrganizationSpacesWithQuotasArgsForCall = append(fake.getOrganizationSpacesWithQuotasArgsForCall, struct {
+		orgGUID    string
+		handlePage func([]v2action.SpaceWithQuota)
+	}{orgGUID, handlePage})
+	fake.recordInvocation("GetOrganizationSpacesWithQuotas", []interface{}{orgGUID, handlePage})
+	fake.getOrganizationSpacesWithQuotasMutex.Unlock()
+	if fake.GetOrganizationSpacesWithQuotasStub != nil {
+		return fake.GetOrganizationSpacesWithQuotasStub(orgGUID, handlePage)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrganizationSpacesWithQuotasReturns.result1, fake.getOrganizationSpacesWithQuotasReturns.result2
+}
+
+func (fake *FakeSpacesActor) GetOrganizationSpacesWithQuotasCallCount() int {
+	fake.getOrganizationSpacesWithQuotasMutex.RLock()
+	defer fake.getOrganizationSpacesWithQuotasMutex.RUnlock()
+	return len(fake.getOrganizationSpacesWithQuotasArgsForCall)
+}
+
+func (fake *FakeSpacesActor) GetOrganizationSpacesWithQuotasArgsForCall(i int) (string, func([]v2action.SpaceWithQuota)) {
+	fake.getOrganizationSpacesWithQuotasMutex.RLock()
+	defer fake.getOrganizationSpacesWithQuotasMutex.RUnlock()
+	return fake.getOrganizationSpacesWithQuotasArgsForCall[i].orgGUID, fake.getOrganizationSpacesWithQuotasArgsForCall[i].handlePage
+}
+
+func (fake *FakeSpacesActor) GetOrganizationSpacesWithQuotasReturns(result1 v2action.Warnings, result2 error) {
+	fake.GetOrganizationSpacesWithQuotasStub = nil
+	fake.getOrganizationSpacesWithQuotasReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSpacesActor) GetOrganizationSpacesWithQuotasReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.GetOrganizationSpacesWithQuotasStub = nil
+	if fake.getOrganizationSpacesWithQuotasReturnsOnCall == nil {
+		fake.getOrganizationSpacesWithQuotasReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.getOrganizationSpacesWithQuotasReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSpacesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getOrganizationSpacesWithQuotasMutex.RLock()
+	defer fake.getOrganizationSpacesWithQuotasMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSpacesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.SpacesActor = new(FakeSpacesActor)
@@ -2,6 +2,7 @@
 package v2fakes
 
 import (
+	"context"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -41,9 +42,41 @@ type FakeStartActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
-	StartApplicationStub        func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	GetApplicationCrashDiagnosticsStub        func(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error)
+	getApplicationCrashDiagnosticsMutex       sync.RWMutex
+	getApplicationCrashDiagnosticsArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+	}
+	getApplicationCrashDiagnosticsReturns struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationCrashDiagnosticsReturnsOnCall map[int]struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationStartupProgressStub        func(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error)
+	getApplicationStartupProgressMutex       sync.RWMutex
+	getApplicationStartupProgressArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationStartupProgressReturns struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationStartupProgressReturnsOnCall map[int]struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}
+	StartApplicationStub        func(ctx context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
 	startApplicationMutex       sync.RWMutex
 	startApplicationArgsForCall []struct {
+		ctx    context.Context
 		app    v2action.Application
 		client v2action.NOAAClient
 		config v2action.Config
@@ -176,18 +209,128 @@ func (fake *FakeStartActor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
-func (fake *FakeStartActor) StartApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+func (fake *FakeStartActor) GetApplicationCrashDiagnostics(appGUID string, client v2action.NOAAClient) (v2action.CrashDiagnostics, v2action.Warnings, error) {
+	fake.getApplicationCrashDiagnosticsMutex.Lock()
+	ret, specificReturn := fake.getApplicationCrashDiagnosticsReturnsOnCall[len(fake.getApplicationCrashDiagnosticsArgsForCall)]
+	fake.getApplicationCrashDiagnosticsArgsForCall = append(fake.getApplicationCrashDiagnosticsArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+	}{appGUID, client})
+	fake.recordInvocation("GetApplicationCrashDiagnostics", []interface{}{appGUID, client})
+	fake.getApplicationCrashDiagnosticsMutex.Unlock()
+	if fake.GetApplicationCrashDiagnosticsStub != nil {
+		return fake.GetApplicationCrashDiagnosticsStub(appGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationCrashDiagnosticsReturns.result1, fake.getApplicationCrashDiagnosticsReturns.result2, fake.getApplicationCrashDiagnosticsReturns.result3
+}
+
+func (fake *FakeStartActor) GetApplicationCrashDiagnosticsCallCount() int {
+	fake.getApplicationCrashDiagnosticsMutex.RLock()
+	defer fake.getApplicationCrashDiagnosticsMutex.RUnlock()
+	return len(fake.getApplicationCrashDiagnosticsArgsForCall)
+}
+
+func (fake *FakeStartActor) GetApplicationCrashDiagnosticsArgsForCall(i int) (string, v2action.NOAAClient) {
+	fake.getApplicationCrashDiagnosticsMutex.RLock()
+	defer fake.getApplicationCrashDiagnosticsMutex.RUnlock()
+	return fake.getApplicationCrashDiagnosticsArgsForCall[i].appGUID, fake.getApplicationCrashDiagnosticsArgsForCall[i].client
+}
+
+func (fake *FakeStartActor) GetApplicationCrashDiagnosticsReturns(result1 v2action.CrashDiagnostics, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationCrashDiagnosticsStub = nil
+	fake.getApplicationCrashDiagnosticsReturns = struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetApplicationCrashDiagnosticsReturnsOnCall(i int, result1 v2action.CrashDiagnostics, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationCrashDiagnosticsStub = nil
+	if fake.getApplicationCrashDiagnosticsReturnsOnCall == nil {
+		fake.getApplicationCrashDiagnosticsReturnsOnCall = make(map[int]struct {
+			result1 v2action.CrashDiagnostics
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationCrashDiagnosticsReturnsOnCall[i] = struct {
+		result1 v2action.CrashDiagnostics
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetApplicationStartupProgress(appGUID string) (v2action.ApplicationStartupProgress, v2action.Warnings, error) {
+	fake.getApplicationStartupProgressMutex.Lock()
+	ret, specificReturn := fake.getApplicationStartupProgressReturnsOnCall[len(fake.getApplicationStartupProgressArgsForCall)]
+	fake.getApplicationStartupProgressArgsForCall = append(fake.getApplicationStartupProgressArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationStartupProgress", []interface{}{appGUID})
+	fake.getApplicationStartupProgressMutex.Unlock()
+	if fake.GetApplicationStartupProgressStub != nil {
+		return fake.GetApplicationStartupProgressStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationStartupProgressReturns.result1, fake.getApplicationStartupProgressReturns.result2, fake.getApplicationStartupProgressReturns.result3
+}
+
+func (fake *FakeStartActor) GetApplicationStartupProgressCallCount() int {
+	fake.getApplicationStartupProgressMutex.RLock()
+	defer fake.getApplicationStartupProgressMutex.RUnlock()
+	return len(fake.getApplicationStartupProgressArgsForCall)
+}
+
+func (fake *FakeStartActor) GetApplicationStartupProgressArgsForCall(i int) string {
+	fake.getApplicationStartupProgressMutex.RLock()
+	defer fake.getApplicationStartupProgressMutex.RUnlock()
+	return fake.getApplicationStartupProgressArgsForCall[i].appGUID
+}
+
+func (fake *FakeStartActor) GetApplicationStartupProgressReturns(result1 v2action.ApplicationStartupProgress, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStartupProgressStub = nil
+	fake.getApplicationStartupProgressReturns = struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetApplicationStartupProgressReturnsOnCall(i int, result1 v2action.ApplicationStartupProgress, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStartupProgressStub = nil
+	if fake.getApplicationStartupProgressReturnsOnCall == nil {
+		fake.getApplicationStartupProgressReturnsOnCall = make(map[int]struct {
+			result1 v2action.ApplicationStartupProgress
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationStartupProgressReturnsOnCall[i] = struct {
+		result1 v2action.ApplicationStartupProgress
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) StartApplication(ctx context.Context, app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 	fake.startApplicationMutex.Lock()
 	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
 	fake.startApplicationArgsForCall = append(fake.startApplicationArgsForCall, struct {
+		ctx    context.Context
 		app    v2action.Application
 		client v2action.NOAAClient
 		config v2action.Config
-	}{app, client, config})
-	fake.recordInvocation("StartApplication", []interface{}{app, client, config})
+	}{ctx, app, client, config})
+	fake.recordInvocation("StartApplication", []interface{}{ctx, app, client, config})
 	fake.startApplicationMutex.Unlock()
 	if fake.StartApplicationStub != nil {
-		return fake.StartApplicationStub(app, client, config)
+		return fake.StartApplicationStub(ctx, app, client, config)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
@@ -201,10 +344,10 @@ func (fake *FakeStartActor) StartApplicationCallCount() int {
 	return len(fake.startApplicationArgsForCall)
 }
 
-func (fake *FakeStartActor) StartApplicationArgsForCall(i int) (v2action.Application, v2action.NOAAClient, v2action.Config) {
+func (fake *FakeStartActor) StartApplicationArgsForCall(i int) (context.Context, v2action.Application, v2action.NOAAClient, v2action.Config) {
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
-	return fake.startApplicationArgsForCall[i].app, fake.startApplicationArgsForCall[i].client, fake.startApplicationArgsForCall[i].config
+	return fake.startApplicationArgsForCall[i].ctx, fake.startApplicationArgsForCall[i].app, fake.startApplicationArgsForCall[i].client, fake.startApplicationArgsForCall[i].config
 }
 
 func (fake *FakeStartActor) StartApplicationReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan bool, result4 <-chan string, result5 <-chan error) {
@@ -245,6 +388,10 @@ func (fake *FakeStartActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationCrashDiagnosticsMutex.RLock()
+	defer fake.getApplicationCrashDiagnosticsMutex.RUnlock()
+	fake.getApplicationStartupProgressMutex.RLock()
+	defer fake.getApplicationStartupProgressMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
 	return fake.invocations
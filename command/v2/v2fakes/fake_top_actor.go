@@ -0,0 +1,107 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeTopActor struct {
+	GetContainerMetricsBySpaceStub        func(spaceGUID string, client v2action.NOAAClient) ([]v2action.ApplicationContainerMetrics, v2action.Warnings, error)
+	getContainerMetricsBySpaceMutex       sync.RWMutex
+	getContainerMetricsBySpaceArgsForCall []struct {
+		spaceGUID string
+		client    v2action.NOAAClient
+	}
+	getContainerMetricsBySpaceReturns struct {
+		result1 []v2action.ApplicationContainerMetrics
+		result2 v2action.Warnings
+		result3 error
+	}
+	getContainerMetricsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ApplicationContainerMetrics
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTopActor) GetContainerMetricsBySpace(spaceGUID string, client v2action.NOAAClient) ([]v2action.ApplicationContainerMetrics, v2action.Warnings, error) {
+	fake.getContainerMetricsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getContainerMetricsBySpaceReturnsOnCall[len(fake.getContainerMetricsBySpaceArgsForCall)]
+	fake.getContainerMetricsBySpaceArgsForCall = append(fake.getContainerMetricsBySpaceArgsForCall, struct {
+		spaceGUID string
+		client    v2action.NOAAClient
+	}{spaceGUID, client})
+	fake.recordInvocation("GetContainerMetricsBySpace", []interface{}{spaceGUID, client})
+	fake.getContainerMetricsBySpaceMutex.Unlock()
+	if fake.GetContainerMetricsBySpaceStub != nil {
+		return fake.GetContainerMetricsBySpaceStub(spaceGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getContainerMetricsBySpaceReturns.result1, fake.getContainerMetricsBySpaceReturns.result2, fake.getContainerMetricsBySpaceReturns.result3
+}
+
+func (fake *FakeTopActor) GetContainerMetricsBySpaceCallCount() int {
+	fake.getContainerMetricsBySpaceMutex.RLock()
+	defer fake.getContainerMetricsBySpaceMutex.RUnlock()
+	return len(fake.getContainerMetricsBySpaceArgsForCall)
+}
+
+func (fake *FakeTopActor) GetContainerMetricsBySpaceArgsForCall(i int) (string, v2action.NOAAClient) {
+	fake.getContainerMetricsBySpaceMutex.RLock()
+	defer fake.getContainerMetricsBySpaceMutex.RUnlock()
+	return fake.getContainerMetricsBySpaceArgsForCall[i].spaceGUID, fake.getContainerMetricsBySpaceArgsForCall[i].client
+}
+
+func (fake *FakeTopActor) GetContainerMetricsBySpaceReturns(result1 []v2action.ApplicationContainerMetrics, result2 v2action.Warnings, result3 error) {
+	fake.GetContainerMetricsBySpaceStub = nil
+	fake.getContainerMetricsBySpaceReturns = struct {
+		result1 []v2action.ApplicationContainerMetrics
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTopActor) GetContainerMetricsBySpaceReturnsOnCall(i int, result1 []v2action.ApplicationContainerMetrics, result2 v2action.Warnings, result3 error) {
+	fake.GetContainerMetricsBySpaceStub = nil
+	if fake.getContainerMetricsBySpaceReturnsOnCall == nil {
+		fake.getContainerMetricsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ApplicationContainerMetrics
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getContainerMetricsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.ApplicationContainerMetrics
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTopActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getContainerMetricsBySpaceMutex.RLock()
+	defer fake.getContainerMetricsBySpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeTopActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.TopActor = new(FakeTopActor)
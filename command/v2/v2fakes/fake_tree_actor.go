@@ -0,0 +1,105 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeTreeActor struct {
+	GetFoundationTreeStub        func(orgName string) ([]v2action.OrganizationTree, v2action.Warnings, error)
+	getFoundationTreeMutex       sync.RWMutex
+	getFoundationTreeArgsForCall []struct {
+		orgName string
+	}
+	getFoundationTreeReturns struct {
+		result1 []v2action.OrganizationTree
+		result2 v2action.Warnings
+		result3 error
+	}
+	getFoundationTreeReturnsOnCall map[int]struct {
+		result1 []v2action.OrganizationTree
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTreeActor) GetFoundationTree(orgName string) ([]v2action.OrganizationTree, v2action.Warnings, error) {
+	fake.getFoundationTreeMutex.Lock()
+	ret, specificReturn := fake.getFoundationTreeReturnsOnCall[len(fake.getFoundationTreeArgsForCall)]
+	fake.getFoundationTreeArgsForCall = append(fake.getFoundationTreeArgsForCall, struct {
+		orgName string
+	}{orgName})
+	fake.recordInvocation("GetFoundationTree", []interface{}{orgName})
+	fake.getFoundationTreeMutex.Unlock()
+	if fake.GetFoundationTreeStub != nil {
+		return fake.GetFoundationTreeStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getFoundationTreeReturns.result1, fake.getFoundationTreeReturns.result2, fake.getFoundationTreeReturns.result3
+}
+
+func (fake *FakeTreeActor) GetFoundationTreeCallCount() int {
+	fake.getFoundationTreeMutex.RLock()
+	defer fake.getFoundationTreeMutex.RUnlock()
+	return len(fake.getFoundationTreeArgsForCall)
+}
+
+func (fake *FakeTreeActor) GetFoundationTreeArgsForCall(i int) string {
+	fake.getFoundationTreeMutex.RLock()
+	defer fake.getFoundationTreeMutex.RUnlock()
+	return fake.getFoundationTreeArgsForCall[i].orgName
+}
+
+func (fake *FakeTreeActor) GetFoundationTreeReturns(result1 []v2action.OrganizationTree, result2 v2action.Warnings, result3 error) {
+	fake.GetFoundationTreeStub = nil
+	fake.getFoundationTreeReturns = struct {
+		result1 []v2action.OrganizationTree
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTreeActor) GetFoundationTreeReturnsOnCall(i int, result1 []v2action.OrganizationTree, result2 v2action.Warnings, result3 error) {
+	fake.GetFoundationTreeStub = nil
+	if fake.getFoundationTreeReturnsOnCall == nil {
+		fake.getFoundationTreeReturnsOnCall = make(map[int]struct {
+			result1 []v2action.OrganizationTree
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getFoundationTreeReturnsOnCall[i] = struct {
+		result1 []v2action.OrganizationTree
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTreeActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getFoundationTreeMutex.RLock()
+	defer fake.getFoundationTreeMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeTreeActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.TreeActor = new(FakeTreeActor)
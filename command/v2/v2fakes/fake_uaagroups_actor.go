@@ -0,0 +1,90 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeUAAGroupsActor struct {
+	GetUAAGroupsStub        func() ([]v2action.UAAGroup, error)
+	getUAAGroupsMutex       sync.RWMutex
+	getUAAGroupsArgsForCall []struct{}
+	getUAAGroupsReturns     struct {
+		result1 []v2action.UAAGroup
+		result2 error
+	}
+	getUAAGroupsReturnsOnCall map[int]struct {
+		result1 []v2action.UAAGroup
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUAAGroupsActor) GetUAAGroups() ([]v2action.UAAGroup, error) {
+	fake.getUAAGroupsMutex.Lock()
+	ret, specificReturn := fake.getUAAGroupsReturnsOnCall[len(fake.getUAAGroupsArgsForCall)]
+	fake.getUAAGroupsArgsForCall = append(fake.getUAAGroupsArgsForCall, struct{}{})
+	fake.recordInvocation("GetUAAGroups", []interface{}{})
+	fake.getUAAGroupsMutex.Unlock()
+	if fake.GetUAAGroupsStub != nil {
+		return fake.GetUAAGroupsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getUAAGroupsReturns.result1, fake.getUAAGroupsReturns.result2
+}
+
+func (fake *FakeUAAGroupsActor) GetUAAGroupsCallCount() int {
+	fake.getUAAGroupsMutex.RLock()
+	defer fake.getUAAGroupsMutex.RUnlock()
+	return len(fake.getUAAGroupsArgsForCall)
+}
+
+func (fake *FakeUAAGroupsActor) GetUAAGroupsReturns(result1 []v2action.UAAGroup, result2 error) {
+	fake.GetUAAGroupsStub = nil
+	fake.getUAAGroupsReturns = struct {
+		result1 []v2action.UAAGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAGroupsActor) GetUAAGroupsReturnsOnCall(i int, result1 []v2action.UAAGroup, result2 error) {
+	fake.GetUAAGroupsStub = nil
+	if fake.getUAAGroupsReturnsOnCall == nil {
+		fake.getUAAGroupsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.UAAGroup
+			result2 error
+		})
+	}
+	fake.getUAAGroupsReturnsOnCall[i] = struct {
+		result1 []v2action.UAAGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAGroupsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getUAAGroupsMutex.RLock()
+	defer fake.getUAAGroupsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeUAAGroupsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.UAAGroupsActor = new(FakeUAAGroupsActor)
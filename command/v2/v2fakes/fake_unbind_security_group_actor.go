@@ -38,6 +38,21 @@ type FakeUnbindSecurityGroupActor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	UnbindSecurityGroupFromAllSpacesStub        func(securityGroupName string) ([]v2action.SecurityGroupSpace, v2action.Warnings, error)
+	unbindSecurityGroupFromAllSpacesMutex       sync.RWMutex
+	unbindSecurityGroupFromAllSpacesArgsForCall []struct {
+		securityGroupName string
+	}
+	unbindSecurityGroupFromAllSpacesReturns struct {
+		result1 []v2action.SecurityGroupSpace
+		result2 v2action.Warnings
+		result3 error
+	}
+	unbindSecurityGroupFromAllSpacesReturnsOnCall map[int]struct {
+		result1 []v2action.SecurityGroupSpace
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -147,6 +162,60 @@ func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupByNameOrganizationN
 	}{result1, result2}
 }
 
+func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupFromAllSpaces(securityGroupName string) ([]v2action.SecurityGroupSpace, v2action.Warnings, error) {
+	fake.unbindSecurityGroupFromAllSpacesMutex.Lock()
+	ret, specificReturn := fake.unbindSecurityGroupFromAllSpacesReturnsOnCall[len(fake.unbindSecurityGroupFromAllSpacesArgsForCall)]
+	fake.unbindSecurityGroupFromAllSpacesArgsForCall = append(fake.unbindSecurityGroupFromAllSpacesArgsForCall, struct {
+		securityGroupName string
+	}{securityGroupName})
+	fake.recordInvocation("UnbindSecurityGroupFromAllSpaces", []interface{}{securityGroupName})
+	fake.unbindSecurityGroupFromAllSpacesMutex.Unlock()
+	if fake.UnbindSecurityGroupFromAllSpacesStub != nil {
+		return fake.UnbindSecurityGroupFromAllSpacesStub(securityGroupName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.unbindSecurityGroupFromAllSpacesReturns.result1, fake.unbindSecurityGroupFromAllSpacesReturns.result2, fake.unbindSecurityGroupFromAllSpacesReturns.result3
+}
+
+func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupFromAllSpacesCallCount() int {
+	fake.unbindSecurityGroupFromAllSpacesMutex.RLock()
+	defer fake.unbindSecurityGroupFromAllSpacesMutex.RUnlock()
+	return len(fake.unbindSecurityGroupFromAllSpacesArgsForCall)
+}
+
+func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupFromAllSpacesArgsForCall(i int) string {
+	fake.unbindSecurityGroupFromAllSpacesMutex.RLock()
+	defer fake.unbindSecurityGroupFromAllSpacesMutex.RUnlock()
+	return fake.unbindSecurityGroupFromAllSpacesArgsForCall[i].securityGroupName
+}
+
+func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupFromAllSpacesReturns(result1 []v2action.SecurityGroupSpace, result2 v2action.Warnings, result3 error) {
+	fake.UnbindSecurityGroupFromAllSpacesStub = nil
+	fake.unbindSecurityGroupFromAllSpacesReturns = struct {
+		result1 []v2action.SecurityGroupSpace
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUnbindSecurityGroupActor) UnbindSecurityGroupFromAllSpacesReturnsOnCall(i int, result1 []v2action.SecurityGroupSpace, result2 v2action.Warnings, result3 error) {
+	fake.UnbindSecurityGroupFromAllSpacesStub = nil
+	if fake.unbindSecurityGroupFromAllSpacesReturnsOnCall == nil {
+		fake.unbindSecurityGroupFromAllSpacesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SecurityGroupSpace
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.unbindSecurityGroupFromAllSpacesReturnsOnCall[i] = struct {
+		result1 []v2action.SecurityGroupSpace
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeUnbindSecurityGroupActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -154,6 +223,8 @@ func (fake *FakeUnbindSecurityGroupActor) Invocations() map[string][][]interface
 	defer fake.unbindSecurityGroupByNameAndSpaceMutex.RUnlock()
 	fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameMutex.RLock()
 	defer fake.unbindSecurityGroupByNameOrganizationNameAndSpaceNameMutex.RUnlock()
+	fake.unbindSecurityGroupFromAllSpacesMutex.RLock()
+	defer fake.unbindSecurityGroupFromAllSpacesMutex.RUnlock()
 	return fake.invocations
 }
 
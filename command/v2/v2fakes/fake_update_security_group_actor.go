@@ -0,0 +1,251 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeUpdateSecurityGroupActor struct {
+	GetSecurityGroupRulesDiffStub        func(name string, rules []v2action.SecurityGroupRuleDefinition) (v2action.SecurityGroupRulesDiff, v2action.Warnings, error)
+	getSecurityGroupRulesDiffMutex       sync.RWMutex
+	getSecurityGroupRulesDiffArgsForCall []struct {
+		name  string
+		rules []v2action.SecurityGroupRuleDefinition
+	}
+	getSecurityGroupRulesDiffReturns struct {
+		result1 v2action.SecurityGroupRulesDiff
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSecurityGroupRulesDiffReturnsOnCall map[int]struct {
+		result1 v2action.SecurityGroupRulesDiff
+		result2 v2action.Warnings
+		result3 error
+	}
+	ReadSecurityGroupRulesFileStub        func(path string) ([]v2action.SecurityGroupRuleDefinition, error)
+	readSecurityGroupRulesFileMutex       sync.RWMutex
+	readSecurityGroupRulesFileArgsForCall []struct {
+		path string
+	}
+	readSecurityGroupRulesFileReturns struct {
+		result1 []v2action.SecurityGroupRuleDefinition
+		result2 error
+	}
+	readSecurityGroupRulesFileReturnsOnCall map[int]struct {
+		result1 []v2action.SecurityGroupRuleDefinition
+		result2 error
+	}
+	UpdateSecurityGroupRulesStub        func(name string, rules []v2action.SecurityGroupRuleDefinition) (v2action.Warnings, error)
+	updateSecurityGroupRulesMutex       sync.RWMutex
+	updateSecurityGroupRulesArgsForCall []struct {
+		name  string
+		rules []v2action.SecurityGroupRuleDefinition
+	}
+	updateSecurityGroupRulesReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	updateSecurityGroupRulesReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUpdateSecurityGroupActor) GetSecurityGroupRulesDiff(name string, rules []v2action.SecurityGroupRuleDefinition) (v2action.SecurityGroupRulesDiff, v2action.Warnings, error) {
+	var rulesCopy []v2action.SecurityGroupRuleDefinition
+	if rules != nil {
+		rulesCopy = make([]v2action.SecurityGroupRuleDefinition, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.getSecurityGroupRulesDiffMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupRulesDiffReturnsOnCall[len(fake.getSecurityGroupRulesDiffArgsForCall)]
+	fake.getSecurityGroupRulesDiffArgsForCall = append(fake.getSecurityGroupRulesDiffArgsForCall, struct {
+		name  string
+		rules []v2action.SecurityGroupRuleDefinition
+	}{name, rulesCopy})
+	fake.recordInvocation("GetSecurityGroupRulesDiff", []interface{}{name, rulesCopy})
+	fake.getSecurityGroupRulesDiffMutex.Unlock()
+	if fake.GetSecurityGroupRulesDiffStub != nil {
+		return fake.GetSecurityGroupRulesDiffStub(name, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecurityGroupRulesDiffReturns.result1, fake.getSecurityGroupRulesDiffReturns.result2, fake.getSecurityGroupRulesDiffReturns.result3
+}
+
+func (fake *FakeUpdateSecurityGroupActor) GetSecurityGroupRulesDiffCallCount() int {
+	fake.getSecurityGroupRulesDiffMutex.RLock()
+	defer fake.getSecurityGroupRulesDiffMutex.RUnlock()
+	return len(fake.getSecurityGroupRulesDiffArgsForCall)
+}
+
+func (fake *FakeUpdateSecurityGroupActor) GetSecurityGroupRulesDiffArgsForCall(i int) (string, []v2action.SecurityGroupRuleDefinition) {
+	fake.getSecurityGroupRulesDiffMutex.RLock()
+	defer fake.getSecurityGroupRulesDiffMutex.RUnlock()
+	return fake.getSecurityGroupRulesDiffArgsForCall[i].name, fake.getSecurityGroupRulesDiffArgsForCall[i].rules
+}
+
+func (fake *FakeUpdateSecurityGroupActor) GetSecurityGroupRulesDiffReturns(result1 v2action.SecurityGroupRulesDiff, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupRulesDiffStub = nil
+	fake.getSecurityGroupRulesDiffReturns = struct {
+		result1 v2action.SecurityGroupRulesDiff
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdateSecurityGroupActor) GetSecurityGroupRulesDiffReturnsOnCall(i int, result1 v2action.SecurityGroupRulesDiff, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupRulesDiffStub = nil
+	if fake.getSecurityGroupRulesDiffReturnsOnCall == nil {
+		fake.getSecurityGroupRulesDiffReturnsOnCall = make(map[int]struct {
+			result1 v2action.SecurityGroupRulesDiff
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecurityGroupRulesDiffReturnsOnCall[i] = struct {
+		result1 v2action.SecurityGroupRulesDiff
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpdateSecurityGroupActor) ReadSecurityGroupRulesFile(path string) ([]v2action.SecurityGroupRuleDefinition, error) {
+	fake.readSecurityGroupRulesFileMutex.Lock()
+	ret, specificReturn := fake.readSecurityGroupRulesFileReturnsOnCall[len(fake.readSecurityGroupRulesFileArgsForCall)]
+	fake.readSecurityGroupRulesFileArgsForCall = append(fake.readSecurityGroupRulesFileArgsForCall, struct {
+		path string
+	}{path})
+	fake.recordInvocation("ReadSecurityGroupRulesFile", []interface{}{path})
+	fake.readSecurityGroupRulesFileMutex.Unlock()
+	if fake.ReadSecurityGroupRulesFileStub != nil {
+		return fake.ReadSecurityGroupRulesFileStub(path)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readSecurityGroupRulesFileReturns.result1, fake.readSecurityGroupRulesFileReturns.result2
+}
+
+func (fake *FakeUpdateSecurityGroupActor) ReadSecurityGroupRulesFileCallCount() int {
+	fake.readSecurityGroupRulesFileMutex.RLock()
+	defer fake.readSecurityGroupRulesFileMutex.RUnlock()
+	return len(fake.readSecurityGroupRulesFileArgsForCall)
+}
+
+func (fake *FakeUpdateSecurityGroupActor) ReadSecurityGroupRulesFileArgsForCall(i int) string {
+	fake.readSecurityGroupRulesFileMutex.RLock()
+	defer fake.readSecurityGroupRulesFileMutex.RUnlock()
+	return fake.readSecurityGroupRulesFileArgsForCall[i].path
+}
+
+func (fake *FakeUpdateSecurityGroupActor) ReadSecurityGroupRulesFileReturns(result1 []v2action.SecurityGroupRuleDefinition, result2 error) {
+	fake.ReadSecurityGroupRulesFileStub = nil
+	fake.readSecurityGroupRulesFileReturns = struct {
+		result1 []v2action.SecurityGroupRuleDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdateSecurityGroupActor) ReadSecurityGroupRulesFileReturnsOnCall(i int, result1 []v2action.SecurityGroupRuleDefinition, result2 error) {
+	fake.ReadSecurityGroupRulesFileStub = nil
+	if fake.readSecurityGroupRulesFileReturnsOnCall == nil {
+		fake.readSecurityGroupRulesFileReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SecurityGroupRuleDefinition
+			result2 error
+		})
+	}
+	fake.readSecurityGroupRulesFileReturnsOnCall[i] = struct {
+		result1 []v2action.SecurityGroupRuleDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdateSecurityGroupActor) UpdateSecurityGroupRules(name string, rules []v2action.SecurityGroupRuleDefinition) (v2action.Warnings, error) {
+	var rulesCopy []v2action.SecurityGroupRuleDefinition
+	if rules != nil {
+		rulesCopy = make([]v2action.SecurityGroupRuleDefinition, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.updateSecurityGroupRulesMutex.Lock()
+	ret, specificReturn := fake.updateSecurityGroupRulesReturnsOnCall[len(fake.updateSecurityGroupRulesArgsForCall)]
+	fake.updateSecurityGroupRulesArgsForCall = append(fake.updateSecurityGroupRulesArgsForCall, struct {
+		name  string
+		rules []v2action.SecurityGroupRuleDefinition
+	}{name, rulesCopy})
+	fake.recordInvocation("UpdateSecurityGroupRules", []interface{}{name, rulesCopy})
+	fake.updateSecurityGroupRulesMutex.Unlock()
+	if fake.UpdateSecurityGroupRulesStub != nil {
+		return fake.UpdateSecurityGroupRulesStub(name, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSecurityGroupRulesReturns.result1, fake.updateSecurityGroupRulesReturns.result2
+}
+
+func (fake *FakeUpdateSecurityGroupActor) UpdateSecurityGroupRulesCallCount() int {
+	fake.updateSecurityGroupRulesMutex.RLock()
+	defer fake.updateSecurityGroupRulesMutex.RUnlock()
+	return len(fake.updateSecurityGroupRulesArgsForCall)
+}
+
+func (fake *FakeUpdateSecurityGroupActor) UpdateSecurityGroupRulesArgsForCall(i int) (string, []v2action.SecurityGroupRuleDefinition) {
+	fake.updateSecurityGroupRulesMutex.RLock()
+	defer fake.updateSecurityGroupRulesMutex.RUnlock()
+	return fake.updateSecurityGroupRulesArgsForCall[i].name, fake.updateSecurityGroupRulesArgsForCall[i].rules
+}
+
+func (fake *FakeUpdateSecurityGroupActor) UpdateSecurityGroupRulesReturns(result1 v2action.Warnings, result2 error) {
+	fake.UpdateSecurityGroupRulesStub = nil
+	fake.updateSecurityGroupRulesReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdateSecurityGroupActor) UpdateSecurityGroupRulesReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UpdateSecurityGroupRulesStub = nil
+	if fake.updateSecurityGroupRulesReturnsOnCall == nil {
+		fake.updateSecurityGroupRulesReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.updateSecurityGroupRulesReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpdateSecurityGroupActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getSecurityGroupRulesDiffMutex.RLock()
+	defer fake.getSecurityGroupRulesDiffMutex.RUnlock()
+	fake.readSecurityGroupRulesFileMutex.RLock()
+	defer fake.readSecurityGroupRulesFileMutex.RUnlock()
+	fake.updateSecurityGroupRulesMutex.RLock()
+	defer fake.updateSecurityGroupRulesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeUpdateSecurityGroupActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.UpdateSecurityGroupActor = new(FakeUpdateSecurityGroupActor)
@@ -2,6 +2,7 @@
 package v2fakes
 
 import (
+	"context"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
@@ -10,9 +11,25 @@ import (
 )
 
 type FakeV2PushActor struct {
-	ApplyStub        func(config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	AcquirePushLockStub        func(config pushaction.ApplicationConfig, owner string, force bool) (pushaction.Warnings, error)
+	acquirePushLockMutex       sync.RWMutex
+	acquirePushLockArgsForCall []struct {
+		config pushaction.ApplicationConfig
+		owner  string
+		force  bool
+	}
+	acquirePushLockReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	acquirePushLockReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	ApplyStub        func(ctx context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
 	applyMutex       sync.RWMutex
 	applyArgsForCall []struct {
+		ctx    context.Context
 		config pushaction.ApplicationConfig
 	}
 	applyReturns struct {
@@ -25,12 +42,22 @@ type FakeV2PushActor struct {
 		result2 <-chan pushaction.Warnings
 		result3 <-chan error
 	}
-	ConvertToApplicationConfigStub        func(spaceGUID string, orgGUID string, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ConvertToApplicationConfigStub        func(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	convertToApplicationConfigMutex       sync.RWMutex
 	convertToApplicationConfigArgsForCall []struct {
-		spaceGUID string
 		orgGUID   string
+		spaceGUID string
 		apps      []manifest.Application
+		reset     bool
 	}
 	convertToApplicationConfigReturns struct {
 		result1 []pushaction.ApplicationConfig
@@ -56,20 +83,115 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
+	ReadManifestStub        func(pathToManifest string) ([]manifest.Application, error)
+	readManifestMutex       sync.RWMutex
+	readManifestArgsForCall []struct {
+		pathToManifest string
+	}
+	readManifestReturns struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	readManifestReturnsOnCall map[int]struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	ReleasePushLockStub        func(config pushaction.ApplicationConfig, owner string) (pushaction.Warnings, error)
+	releasePushLockMutex       sync.RWMutex
+	releasePushLockArgsForCall []struct {
+		config pushaction.ApplicationConfig
+		owner  string
+	}
+	releasePushLockReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	releasePushLockReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	RunPreStartTaskStub        func(config pushaction.ApplicationConfig, command string) (pushaction.Warnings, error)
+	runPreStartTaskMutex       sync.RWMutex
+	runPreStartTaskArgsForCall []struct {
+		config  pushaction.ApplicationConfig
+		command string
+	}
+	runPreStartTaskReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	runPreStartTaskReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeV2PushActor) Apply(config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+func (fake *FakeV2PushActor) AcquirePushLock(config pushaction.ApplicationConfig, owner string, force bool) (pushaction.Warnings, error) {
+	fake.acquirePushLockMutex.Lock()
+	ret, specificReturn := fake.acquirePushLockReturnsOnCall[len(fake.acquirePushLockArgsForCall)]
+	fake.acquirePushLockArgsForCall = append(fake.acquirePushLockArgsForCall, struct {
+		config pushaction.ApplicationConfig
+		owner  string
+		force  bool
+	}{config, owner, force})
+	fake.recordInvocation("AcquirePushLock", []interface{}{config, owner, force})
+	fake.acquirePushLockMutex.Unlock()
+	if fake.AcquirePushLockStub != nil {
+		return fake.AcquirePushLockStub(config, owner, force)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.acquirePushLockReturns.result1, fake.acquirePushLockReturns.result2
+}
+
+func (fake *FakeV2PushActor) AcquirePushLockCallCount() int {
+	fake.acquirePushLockMutex.RLock()
+	defer fake.acquirePushLockMutex.RUnlock()
+	return len(fake.acquirePushLockArgsForCall)
+}
+
+func (fake *FakeV2PushActor) AcquirePushLockArgsForCall(i int) (pushaction.ApplicationConfig, string, bool) {
+	fake.acquirePushLockMutex.RLock()
+	defer fake.acquirePushLockMutex.RUnlock()
+	return fake.acquirePushLockArgsForCall[i].config, fake.acquirePushLockArgsForCall[i].owner, fake.acquirePushLockArgsForCall[i].force
+}
+
+func (fake *FakeV2PushActor) AcquirePushLockReturns(result1 pushaction.Warnings, result2 error) {
+	fake.AcquirePushLockStub = nil
+	fake.acquirePushLockReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) AcquirePushLockReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.AcquirePushLockStub = nil
+	if fake.acquirePushLockReturnsOnCall == nil {
+		fake.acquirePushLockReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.acquirePushLockReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) Apply(ctx context.Context, config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
 	fake.applyMutex.Lock()
 	ret, specificReturn := fake.applyReturnsOnCall[len(fake.applyArgsForCall)]
 	fake.applyArgsForCall = append(fake.applyArgsForCall, struct {
+		ctx    context.Context
 		config pushaction.ApplicationConfig
-	}{config})
-	fake.recordInvocation("Apply", []interface{}{config})
+	}{ctx, config})
+	fake.recordInvocation("Apply", []interface{}{ctx, config})
 	fake.applyMutex.Unlock()
 	if fake.ApplyStub != nil {
-		return fake.ApplyStub(config)
+		return fake.ApplyStub(ctx, config)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -83,10 +205,10 @@ func (fake *FakeV2PushActor) ApplyCallCount() int {
 	return len(fake.applyArgsForCall)
 }
 
-func (fake *FakeV2PushActor) ApplyArgsForCall(i int) pushaction.ApplicationConfig {
+func (fake *FakeV2PushActor) ApplyArgsForCall(i int) (context.Context, pushaction.ApplicationConfig) {
 	fake.applyMutex.RLock()
 	defer fake.applyMutex.RUnlock()
-	return fake.applyArgsForCall[i].config
+	return fake.applyArgsForCall[i].ctx, fake.applyArgsForCall[i].config
 }
 
 func (fake *FakeV2PushActor) ApplyReturns(result1 <-chan pushaction.Event, result2 <-chan pushaction.Warnings, result3 <-chan error) {
@@ -114,7 +236,47 @@ func (fake *FakeV2PushActor) ApplyReturnsOnCall(i int, result1 <-chan pushaction
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2PushActor) ConvertToApplicationConfig(spaceGUID string, orgGUID string, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
+func (fake *FakeV2PushActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeV2PushActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeV2PushActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeV2PushActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeV2PushActor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application, reset bool) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
 	var appsCopy []manifest.Application
 	if apps != nil {
 		appsCopy = make([]manifest.Application, len(apps))
@@ -123,14 +285,15 @@ func (fake *FakeV2PushActor) ConvertToApplicationConfig(spaceGUID string, orgGUI
 	fake.convertToApplicationConfigMutex.Lock()
 	ret, specificReturn := fake.convertToApplicationConfigReturnsOnCall[len(fake.convertToApplicationConfigArgsForCall)]
 	fake.convertToApplicationConfigArgsForCall = append(fake.convertToApplicationConfigArgsForCall, struct {
-		spaceGUID string
 		orgGUID   string
+		spaceGUID string
 		apps      []manifest.Application
-	}{spaceGUID, orgGUID, appsCopy})
-	fake.recordInvocation("ConvertToApplicationConfig", []interface{}{spaceGUID, orgGUID, appsCopy})
+		reset     bool
+	}{orgGUID, spaceGUID, appsCopy, reset})
+	fake.recordInvocation("ConvertToApplicationConfig", []interface{}{orgGUID, spaceGUID, appsCopy, reset})
 	fake.convertToApplicationConfigMutex.Unlock()
 	if fake.ConvertToApplicationConfigStub != nil {
-		return fake.ConvertToApplicationConfigStub(spaceGUID, orgGUID, apps)
+		return fake.ConvertToApplicationConfigStub(orgGUID, spaceGUID, apps, reset)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -144,10 +307,10 @@ func (fake *FakeV2PushActor) ConvertToApplicationConfigCallCount() int {
 	return len(fake.convertToApplicationConfigArgsForCall)
 }
 
-func (fake *FakeV2PushActor) ConvertToApplicationConfigArgsForCall(i int) (string, string, []manifest.Application) {
+func (fake *FakeV2PushActor) ConvertToApplicationConfigArgsForCall(i int) (string, string, []manifest.Application, bool) {
 	fake.convertToApplicationConfigMutex.RLock()
 	defer fake.convertToApplicationConfigMutex.RUnlock()
-	return fake.convertToApplicationConfigArgsForCall[i].spaceGUID, fake.convertToApplicationConfigArgsForCall[i].orgGUID, fake.convertToApplicationConfigArgsForCall[i].apps
+	return fake.convertToApplicationConfigArgsForCall[i].orgGUID, fake.convertToApplicationConfigArgsForCall[i].spaceGUID, fake.convertToApplicationConfigArgsForCall[i].apps, fake.convertToApplicationConfigArgsForCall[i].reset
 }
 
 func (fake *FakeV2PushActor) ConvertToApplicationConfigReturns(result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
@@ -232,15 +395,180 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeV2PushActor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
+	fake.readManifestMutex.Lock()
+	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
+	fake.readManifestArgsForCall = append(fake.readManifestArgsForCall, struct {
+		pathToManifest string
+	}{pathToManifest})
+	fake.recordInvocation("ReadManifest", []interface{}{pathToManifest})
+	fake.readManifestMutex.Unlock()
+	if fake.ReadManifestStub != nil {
+		return fake.ReadManifestStub(pathToManifest)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.readManifestReturns.result1, fake.readManifestReturns.result2
+}
+
+func (fake *FakeV2PushActor) ReadManifestCallCount() int {
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	return len(fake.readManifestArgsForCall)
+}
+
+func (fake *FakeV2PushActor) ReadManifestArgsForCall(i int) string {
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	return fake.readManifestArgsForCall[i].pathToManifest
+}
+
+func (fake *FakeV2PushActor) ReadManifestReturns(result1 []manifest.Application, result2 error) {
+	fake.ReadManifestStub = nil
+	fake.readManifestReturns = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) ReadManifestReturnsOnCall(i int, result1 []manifest.Application, result2 error) {
+	fake.ReadManifestStub = nil
+	if fake.readManifestReturnsOnCall == nil {
+		fake.readManifestReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Application
+			result2 error
+		})
+	}
+	fake.readManifestReturnsOnCall[i] = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) ReleasePushLock(config pushaction.ApplicationConfig, owner string) (pushaction.Warnings, error) {
+	fake.releasePushLockMutex.Lock()
+	ret, specificReturn := fake.releasePushLockReturnsOnCall[len(fake.releasePushLockArgsForCall)]
+	fake.releasePushLockArgsForCall = append(fake.releasePushLockArgsForCall, struct {
+		config pushaction.ApplicationConfig
+		owner  string
+	}{config, owner})
+	fake.recordInvocation("ReleasePushLock", []interface{}{config, owner})
+	fake.releasePushLockMutex.Unlock()
+	if fake.ReleasePushLockStub != nil {
+		return fake.ReleasePushLockStub(config, owner)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.releasePushLockReturns.result1, fake.releasePushLockReturns.result2
+}
+
+func (fake *FakeV2PushActor) ReleasePushLockCallCount() int {
+	fake.releasePushLockMutex.RLock()
+	defer fake.releasePushLockMutex.RUnlock()
+	return len(fake.releasePushLockArgsForCall)
+}
+
+func (fake *FakeV2PushActor) ReleasePushLockArgsForCall(i int) (pushaction.ApplicationConfig, string) {
+	fake.releasePushLockMutex.RLock()
+	defer fake.releasePushLockMutex.RUnlock()
+	return fake.releasePushLockArgsForCall[i].config, fake.releasePushLockArgsForCall[i].owner
+}
+
+func (fake *FakeV2PushActor) ReleasePushLockReturns(result1 pushaction.Warnings, result2 error) {
+	fake.ReleasePushLockStub = nil
+	fake.releasePushLockReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) ReleasePushLockReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.ReleasePushLockStub = nil
+	if fake.releasePushLockReturnsOnCall == nil {
+		fake.releasePushLockReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.releasePushLockReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) RunPreStartTask(config pushaction.ApplicationConfig, command string) (pushaction.Warnings, error) {
+	fake.runPreStartTaskMutex.Lock()
+	ret, specificReturn := fake.runPreStartTaskReturnsOnCall[len(fake.runPreStartTaskArgsForCall)]
+	fake.runPreStartTaskArgsForCall = append(fake.runPreStartTaskArgsForCall, struct {
+		config  pushaction.ApplicationConfig
+		command string
+	}{config, command})
+	fake.recordInvocation("RunPreStartTask", []interface{}{config, command})
+	fake.runPreStartTaskMutex.Unlock()
+	if fake.RunPreStartTaskStub != nil {
+		return fake.RunPreStartTaskStub(config, command)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.runPreStartTaskReturns.result1, fake.runPreStartTaskReturns.result2
+}
+
+func (fake *FakeV2PushActor) RunPreStartTaskCallCount() int {
+	fake.runPreStartTaskMutex.RLock()
+	defer fake.runPreStartTaskMutex.RUnlock()
+	return len(fake.runPreStartTaskArgsForCall)
+}
+
+func (fake *FakeV2PushActor) RunPreStartTaskArgsForCall(i int) (pushaction.ApplicationConfig, string) {
+	fake.runPreStartTaskMutex.RLock()
+	defer fake.runPreStartTaskMutex.RUnlock()
+	return fake.runPreStartTaskArgsForCall[i].config, fake.runPreStartTaskArgsForCall[i].command
+}
+
+func (fake *FakeV2PushActor) RunPreStartTaskReturns(result1 pushaction.Warnings, result2 error) {
+	fake.RunPreStartTaskStub = nil
+	fake.runPreStartTaskReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) RunPreStartTaskReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.RunPreStartTaskStub = nil
+	if fake.runPreStartTaskReturnsOnCall == nil {
+		fake.runPreStartTaskReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.runPreStartTaskReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acquirePushLockMutex.RLock()
+	defer fake.acquirePushLockMutex.RUnlock()
 	fake.applyMutex.RLock()
 	defer fake.applyMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.convertToApplicationConfigMutex.RLock()
 	defer fake.convertToApplicationConfigMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	fake.releasePushLockMutex.RLock()
+	defer fake.releasePushLockMutex.RUnlock()
+	fake.runPreStartTaskMutex.RLock()
+	defer fake.runPreStartTaskMutex.RUnlock()
 	return fake.invocations
 }
 
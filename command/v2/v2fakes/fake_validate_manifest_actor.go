@@ -0,0 +1,100 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeValidateManifestActor struct {
+	ValidateManifestStub        func(pathToManifest string) ([]manifest.Application, error)
+	validateManifestMutex       sync.RWMutex
+	validateManifestArgsForCall []struct {
+		pathToManifest string
+	}
+	validateManifestReturns struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	validateManifestReturnsOnCall map[int]struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeValidateManifestActor) ValidateManifest(pathToManifest string) ([]manifest.Application, error) {
+	fake.validateManifestMutex.Lock()
+	ret, specificReturn := fake.validateManifestReturnsOnCall[len(fake.validateManifestArgsForCall)]
+	fake.validateManifestArgsForCall = append(fake.validateManifestArgsForCall, struct {
+		pathToManifest string
+	}{pathToManifest})
+	fake.recordInvocation("ValidateManifest", []interface{}{pathToManifest})
+	fake.validateManifestMutex.Unlock()
+	if fake.ValidateManifestStub != nil {
+		return fake.ValidateManifestStub(pathToManifest)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.validateManifestReturns.result1, fake.validateManifestReturns.result2
+}
+
+func (fake *FakeValidateManifestActor) ValidateManifestCallCount() int {
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	return len(fake.validateManifestArgsForCall)
+}
+
+func (fake *FakeValidateManifestActor) ValidateManifestArgsForCall(i int) string {
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	return fake.validateManifestArgsForCall[i].pathToManifest
+}
+
+func (fake *FakeValidateManifestActor) ValidateManifestReturns(result1 []manifest.Application, result2 error) {
+	fake.ValidateManifestStub = nil
+	fake.validateManifestReturns = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeValidateManifestActor) ValidateManifestReturnsOnCall(i int, result1 []manifest.Application, result2 error) {
+	fake.ValidateManifestStub = nil
+	if fake.validateManifestReturnsOnCall == nil {
+		fake.validateManifestReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Application
+			result2 error
+		})
+	}
+	fake.validateManifestReturnsOnCall[i] = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeValidateManifestActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeValidateManifestActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ValidateManifestActor = new(FakeValidateManifestActor)
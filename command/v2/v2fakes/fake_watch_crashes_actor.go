@@ -0,0 +1,187 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeWatchCrashesActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStreamingApplicationEventsStub        func(appGUID string, previousEvents []v2action.Event, config v2action.Config) (<-chan v2action.Event, <-chan string, <-chan error)
+	getStreamingApplicationEventsMutex       sync.RWMutex
+	getStreamingApplicationEventsArgsForCall []struct {
+		appGUID        string
+		previousEvents []v2action.Event
+		config         v2action.Config
+	}
+	getStreamingApplicationEventsReturns struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}
+	getStreamingApplicationEventsReturnsOnCall map[int]struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWatchCrashesActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeWatchCrashesActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeWatchCrashesActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeWatchCrashesActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWatchCrashesActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWatchCrashesActor) GetStreamingApplicationEvents(appGUID string, previousEvents []v2action.Event, config v2action.Config) (<-chan v2action.Event, <-chan string, <-chan error) {
+	var previousEventsCopy []v2action.Event
+	if previousEvents != nil {
+		previousEventsCopy = make([]v2action.Event, len(previousEvents))
+		copy(previousEventsCopy, previousEvents)
+	}
+	fake.getStreamingApplicationEventsMutex.Lock()
+	ret, specificReturn := fake.getStreamingApplicationEventsReturnsOnCall[len(fake.getStreamingApplicationEventsArgsForCall)]
+	fake.getStreamingApplicationEventsArgsForCall = append(fake.getStreamingApplicationEventsArgsForCall, struct {
+		appGUID        string
+		previousEvents []v2action.Event
+		config         v2action.Config
+	}{appGUID, previousEventsCopy, config})
+	fake.recordInvocation("GetStreamingApplicationEvents", []interface{}{appGUID, previousEventsCopy, config})
+	fake.getStreamingApplicationEventsMutex.Unlock()
+	if fake.GetStreamingApplicationEventsStub != nil {
+		return fake.GetStreamingApplicationEventsStub(appGUID, previousEvents, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStreamingApplicationEventsReturns.result1, fake.getStreamingApplicationEventsReturns.result2, fake.getStreamingApplicationEventsReturns.result3
+}
+
+func (fake *FakeWatchCrashesActor) GetStreamingApplicationEventsCallCount() int {
+	fake.getStreamingApplicationEventsMutex.RLock()
+	defer fake.getStreamingApplicationEventsMutex.RUnlock()
+	return len(fake.getStreamingApplicationEventsArgsForCall)
+}
+
+func (fake *FakeWatchCrashesActor) GetStreamingApplicationEventsArgsForCall(i int) (string, []v2action.Event, v2action.Config) {
+	fake.getStreamingApplicationEventsMutex.RLock()
+	defer fake.getStreamingApplicationEventsMutex.RUnlock()
+	return fake.getStreamingApplicationEventsArgsForCall[i].appGUID, fake.getStreamingApplicationEventsArgsForCall[i].previousEvents, fake.getStreamingApplicationEventsArgsForCall[i].config
+}
+
+func (fake *FakeWatchCrashesActor) GetStreamingApplicationEventsReturns(result1 <-chan v2action.Event, result2 <-chan string, result3 <-chan error) {
+	fake.GetStreamingApplicationEventsStub = nil
+	fake.getStreamingApplicationEventsReturns = struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWatchCrashesActor) GetStreamingApplicationEventsReturnsOnCall(i int, result1 <-chan v2action.Event, result2 <-chan string, result3 <-chan error) {
+	fake.GetStreamingApplicationEventsStub = nil
+	if fake.getStreamingApplicationEventsReturnsOnCall == nil {
+		fake.getStreamingApplicationEventsReturnsOnCall = make(map[int]struct {
+			result1 <-chan v2action.Event
+			result2 <-chan string
+			result3 <-chan error
+		})
+	}
+	fake.getStreamingApplicationEventsReturnsOnCall[i] = struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWatchCrashesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingApplicationEventsMutex.RLock()
+	defer fake.getStreamingApplicationEventsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeWatchCrashesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.WatchCrashesActor = new(FakeWatchCrashesActor)
@@ -0,0 +1,62 @@
+package v2
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	log "github.com/Sirupsen/logrus"
+)
+
+//go:generate counterfeiter . ValidateManifestActor
+
+type ValidateManifestActor interface {
+	ValidateManifest(pathToManifest string) ([]manifest.Application, error)
+}
+
+type ValidateManifestCommand struct {
+	PathToManifest  flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to manifest"`
+	usage           interface{}                 `usage:"CF_NAME validate-manifest -f MANIFEST_PATH\n\n   Checks the manifest for errors that can be found without an API, such as invalid YAML, duplicate app names, dependency cycles and invalid health check types. No API needs to be targeted."`
+	relatedCommands interface{}                 `related_commands:"diff, push, push-all"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  ValidateManifestActor
+}
+
+// Setup does not create any clients, so the manifest can be validated
+// without network access.
+func (cmd *ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pushaction.NewActor(nil, nil)
+	return nil
+}
+
+func (cmd ValidateManifestCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Validating manifest {{.ManifestPath}}...", map[string]interface{}{
+		"ManifestPath": cmd.PathToManifest,
+	})
+	cmd.UI.DisplayNewline()
+
+	apps, err := cmd.Actor.ValidateManifest(string(cmd.PathToManifest))
+	if err != nil {
+		log.Errorln("validating manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	names := make([]string, 0, len(apps))
+	for _, app := range apps {
+		names = append(names, app.Name)
+	}
+	cmd.UI.DisplayText("Apps: {{.AppNames}}", map[string]interface{}{
+		"AppNames": strings.Join(names, ", "),
+	})
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
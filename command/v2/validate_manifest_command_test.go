@@ -0,0 +1,89 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-manifest Command", func() {
+	var (
+		cmd        ValidateManifestCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeValidateManifestActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeValidateManifestActor)
+
+		cmd = ValidateManifestCommand{
+			UI:             testUI,
+			Config:         fakeConfig,
+			Actor:          fakeActor,
+			PathToManifest: "some-manifest.yml",
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the manifest is valid", func() {
+		BeforeEach(func() {
+			fakeActor.ValidateManifestReturns([]manifest.Application{{Name: "app-1"}, {Name: "app-2"}}, nil)
+		})
+
+		It("displays the apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ValidateManifestCallCount()).To(Equal(1))
+			Expect(fakeActor.ValidateManifestArgsForCall(0)).To(Equal("some-manifest.yml"))
+
+			Expect(testUI.Out).To(Say("Validating manifest some-manifest.yml\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Apps: app-1, app-2"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		It("does not check the target", func() {
+			Expect(fakeConfig.TargetCallCount()).To(Equal(0))
+			Expect(fakeConfig.CurrentUserCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the manifest is invalid", func() {
+		BeforeEach(func() {
+			fakeActor.ValidateManifestReturns(nil, pushaction.DuplicateApplicationError{Name: "app-1"})
+		})
+
+		It("returns the translated error", func() {
+			Expect(executeErr).To(MatchError(shared.DuplicateApplicationError{Name: "app-1"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	Context("when the manifest cannot be read", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("read error")
+			fakeActor.ValidateManifestReturns(nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
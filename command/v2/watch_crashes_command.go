@@ -0,0 +1,171 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . WatchCrashesActor
+
+type WatchCrashesActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetStreamingApplicationEvents(appGUID string, previousEvents []v2action.Event, config v2action.Config) (<-chan v2action.Event, <-chan string, <-chan error)
+}
+
+//go:generate counterfeiter . CrashScript
+
+// CrashScript runs a local script with the provided input on its stdin.
+type CrashScript interface {
+	Run(path string, input []byte, output io.Writer) error
+}
+
+type WatchCrashesCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Exec            string       `long:"exec" required:"true" description:"Local script to run for every crash, with the crash event as JSON on stdin"`
+	usage           interface{}  `usage:"CF_NAME watch-crashes APP_NAME --exec SCRIPT\n\n   The script is run once for every instance crash recorded while watching. A failing script is reported and watching continues.\n\nEXAMPLES:\n   CF_NAME watch-crashes my-app --exec ./notify.sh"`
+	relatedCommands interface{}  `related_commands:"events, logs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       WatchCrashesActor
+	Script      CrashScript
+}
+
+// crashEvent is the JSON document a crash script receives on stdin.
+type crashEvent struct {
+	App       string                 `json:"app"`
+	AppGUID   string                 `json:"app_guid"`
+	Org       string                 `json:"org"`
+	Space     string                 `json:"space"`
+	EventGUID string                 `json:"event_guid"`
+	Type      string                 `json:"type"`
+	Timestamp time.Time              `json:"timestamp"`
+	Actor     string                 `json:"actor"`
+	Metadata  map[string]interface{} `json:"metadata"`
+}
+
+func (cmd *WatchCrashesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+	cmd.Script = localScript{}
+
+	return nil
+}
+
+func (cmd WatchCrashesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Watching app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} for crashes...", map[string]interface{}{
+		"AppName":   app.Name,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	events, eventWarnings, errs := cmd.Actor.GetStreamingApplicationEvents(app.GUID, nil, cmd.Config)
+
+	for events != nil || eventWarnings != nil || errs != nil {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			if event.Type != string(ccv2.ApplicationCrashEvent) {
+				continue
+			}
+			cmd.handleCrash(app, event)
+		case warning, ok := <-eventWarnings:
+			if !ok {
+				eventWarnings = nil
+				continue
+			}
+			cmd.UI.DisplayWarning(warning)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			return shared.HandleError(err)
+		}
+	}
+
+	return nil
+}
+
+// handleCrash displays the crash and runs the script for it. Script failures
+// are displayed as warnings so that later crashes are still handled.
+func (cmd WatchCrashesCommand) handleCrash(app v2action.Application, event v2action.Event) {
+	row := eventRow(event)
+	cmd.UI.DisplayText("{{.Time}}   {{.Event}}   {{.Description}}", map[string]interface{}{
+		"Time":        row[0],
+		"Event":       row[1],
+		"Description": row[3],
+	})
+
+	input, err := json.Marshal(crashEvent{
+		App:       app.Name,
+		AppGUID:   app.GUID,
+		Org:       cmd.Config.TargetedOrganization().Name,
+		Space:     cmd.Config.TargetedSpace().Name,
+		EventGUID: event.GUID,
+		Type:      event.Type,
+		Timestamp: event.Timestamp,
+		Actor:     event.Actor,
+		Metadata:  event.Metadata,
+	})
+	if err == nil {
+		err = cmd.Script.Run(cmd.Exec, input, cmd.UI.Writer())
+	}
+	if err != nil {
+		cmd.UI.DisplayWarning("Running {{.Script}} failed: {{.Error}}", map[string]interface{}{
+			"Script": cmd.Exec,
+			"Error":  err.Error(),
+		})
+	}
+}
+
+// localScript runs scripts on the machine running the CLI.
+type localScript struct{}
+
+func (localScript) Run(path string, input []byte, output io.Writer) error {
+	script := exec.Command(path)
+	script.Stdin = bytes.NewReader(input)
+	script.Stdout = output
+	script.Stderr = os.Stderr
+	return script.Run()
+}
@@ -0,0 +1,184 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("watch-crashes Command", func() {
+	var (
+		cmd             WatchCrashesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeWatchCrashesActor
+		fakeScript      *v2fakes.FakeCrashScript
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeWatchCrashesActor)
+		fakeScript = new(v2fakes.FakeCrashScript)
+
+		cmd = WatchCrashesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Script:      fakeScript,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Exec = "./notify.sh"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"app-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{},
+				v2action.Warnings{"app-warning"},
+				v2action.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.GetStreamingApplicationEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when events are recorded", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("polling failed")
+			fakeActor.GetStreamingApplicationEventsStub = func(appGUID string, previousEvents []v2action.Event, config v2action.Config) (<-chan v2action.Event, <-chan string, <-chan error) {
+				events := make(chan v2action.Event)
+				warnings := make(chan string)
+				errs := make(chan error)
+
+				go func() {
+					events <- v2action.Event{
+						GUID:      "update-guid",
+						Type:      "audit.app.update",
+						Timestamp: time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC),
+					}
+					events <- v2action.Event{
+						GUID:        "crash-guid",
+						Type:        "app.crash",
+						Actor:       "some-app-guid",
+						Timestamp:   time.Date(2017, 6, 1, 10, 5, 0, 0, time.UTC),
+						Description: "index: 1, exit_description: out of memory",
+						Metadata: map[string]interface{}{
+							"index":            float64(1),
+							"exit_description": "out of memory",
+						},
+					}
+					warnings <- "events-warning"
+					errs <- expectedErr
+					close(events)
+					close(warnings)
+					close(errs)
+				}()
+
+				return events, warnings, errs
+			}
+		})
+
+		It("runs the script with the crash event as JSON on stdin", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+
+			Expect(testUI.Out).To(Say("Watching app some-app in org some-org / space some-space as some-user for crashes..."))
+			Expect(testUI.Out).To(Say("app.crash   index: 1, exit_description: out of memory"))
+			Expect(testUI.Out).ToNot(Say("audit.app.update"))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("events-warning"))
+
+			appGUID, previousEvents, _ := fakeActor.GetStreamingApplicationEventsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(previousEvents).To(BeEmpty())
+
+			Expect(fakeScript.RunCallCount()).To(Equal(1))
+			path, input, output := fakeScript.RunArgsForCall(0)
+			Expect(path).To(Equal("./notify.sh"))
+			Expect(output).To(Equal(io.Writer(testUI.Out)))
+
+			var crash map[string]interface{}
+			Expect(json.Unmarshal(input, &crash)).To(Succeed())
+			Expect(crash).To(Equal(map[string]interface{}{
+				"app":        "some-app",
+				"app_guid":   "some-app-guid",
+				"org":        "some-org",
+				"space":      "some-space",
+				"event_guid": "crash-guid",
+				"type":       "app.crash",
+				"timestamp":  "2017-06-01T10:05:00Z",
+				"actor":      "some-app-guid",
+				"metadata": map[string]interface{}{
+					"index":            float64(1),
+					"exit_description": "out of memory",
+				},
+			}))
+		})
+
+		Context("when the script fails", func() {
+			BeforeEach(func() {
+				fakeScript.RunReturns(errors.New("exit status 1"))
+			})
+
+			It("displays a warning and keeps watching", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("Running ./notify.sh failed: exit status 1"))
+				Expect(testUI.Err).To(Say("events-warning"))
+			})
+		})
+	})
+})
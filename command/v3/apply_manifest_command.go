@@ -0,0 +1,148 @@
+package v3
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . ApplyManifestActor
+
+type ApplyManifestActor interface {
+	ApplyApplicationManifest(spaceGUID string, rawManifest []byte) (v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+	GetSpaceManifestDiff(spaceGUID string, rawManifest []byte) ([]v3action.ApplicationManifestDiff, v3action.Warnings, error)
+}
+
+type ApplyManifestCommand struct {
+	PathToManifest  flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to app manifest"`
+	Diff            bool                        `long:"diff" description:"Show the changes the manifest would make and ask for confirmation before applying it"`
+	usage           interface{}                 `usage:"CF_NAME apply-manifest -f MANIFEST_PATH [--diff]\n\n   Applies the manifest to the apps in the targeted space without pushing new app bits.\n\nEXAMPLES:\n   CF_NAME apply-manifest -f manifest.yml --diff"`
+	relatedCommands interface{}                 `related_commands:"create-app-manifest, diff, push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyManifestActor
+}
+
+func (cmd *ApplyManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd ApplyManifestCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionApplyManifestV3)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Diff {
+		err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionManifestDiffV3, "Option '--diff'")
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	rawManifest, err := ioutil.ReadFile(string(cmd.PathToManifest))
+	if err != nil {
+		return err
+	}
+
+	templateValues := map[string]interface{}{
+		"ManifestPath": cmd.PathToManifest,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    cmd.Config.TargetedSpace().Name,
+		"Username":     user.Name,
+	}
+
+	if cmd.Diff {
+		cmd.UI.DisplayTextWithFlavor("Comparing manifest {{.ManifestPath}} to apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+
+		appDiffs, warnings, diffErr := cmd.Actor.GetSpaceManifestDiff(cmd.Config.TargetedSpace().GUID, rawManifest)
+		cmd.UI.DisplayWarnings(warnings)
+		if diffErr != nil {
+			return shared.HandleError(diffErr)
+		}
+
+		if len(appDiffs) == 0 {
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("No changes to apply.")
+			return nil
+		}
+
+		for _, appDiff := range appDiffs {
+			cmd.UI.DisplayNewline()
+			cmd.displayChanges(appDiff)
+		}
+
+		cmd.UI.DisplayNewline()
+		apply, promptErr := cmd.UI.DisplayBoolPrompt(false, "Apply these changes?")
+		if promptErr != nil {
+			return promptErr
+		}
+		if !apply {
+			cmd.UI.DisplayText("Manifest not applied.")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying manifest {{.ManifestPath}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+
+	warnings, err := cmd.Actor.ApplyApplicationManifest(cmd.Config.TargetedSpace().GUID, rawManifest)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ApplyManifestCommand) displayChanges(appDiff v3action.ApplicationManifestDiff) {
+	if appDiff.AppName == "" {
+		cmd.UI.DisplayText("manifest:")
+	} else {
+		cmd.UI.DisplayText("app: {{.AppName}}", map[string]interface{}{
+			"AppName": appDiff.AppName,
+		})
+	}
+
+	for _, change := range appDiff.Changes {
+		switch change.Op {
+		case ccv3.ManifestDiffAdd:
+			cmd.UI.DisplayDiffAddition(change.Field + ": " + change.Value)
+		case ccv3.ManifestDiffRemove:
+			cmd.UI.DisplayDiffRemoval(change.Field + ": " + change.Was)
+		default:
+			cmd.UI.DisplayDiffRemoval(change.Field + ": " + change.Was)
+			cmd.UI.DisplayDiffAddition(change.Field + ": " + change.Value)
+		}
+	}
+}
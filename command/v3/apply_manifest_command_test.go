@@ -0,0 +1,236 @@
+package v3_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-manifest Command", func() {
+	var (
+		cmd             v3.ApplyManifestCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeApplyManifestActor
+		binaryName      string
+		manifestPath    string
+		rawManifest     []byte
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeApplyManifestActor)
+
+		rawManifest = []byte("applications:\n- name: app-1\n")
+		manifestFile, err := ioutil.TempFile("", "apply-manifest-command-test")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = manifestFile.Write(rawManifest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifestFile.Close()).To(Succeed())
+		manifestPath = manifestFile.Name()
+
+		cmd = v3.ApplyManifestCommand{
+			PathToManifest: flag.PathWithExistenceCheck(manifestPath),
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.76.0")
+		fakeActor.ApplyApplicationManifestReturns(v3action.Warnings{"apply-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(manifestPath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.26.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.26.0",
+				MinimumVersion: "3.27.0",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when --diff is not provided", func() {
+		It("applies the manifest without showing a diff", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Applying manifest %s in org some-org / space some-space as some-user...", manifestPath))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+
+			Expect(fakeActor.GetSpaceManifestDiffCallCount()).To(Equal(0))
+			spaceGUID, manifest := fakeActor.ApplyApplicationManifestArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(manifest).To(Equal(rawManifest))
+		})
+
+		Context("when applying the manifest fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyApplicationManifestReturns(
+					v3action.Warnings{"apply-warning"},
+					ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "bad route"},
+				)
+			})
+
+			It("returns a JobFailedError", func() {
+				Expect(executeErr).To(MatchError(shared.JobFailedError{JobGUID: "some-job-guid", Message: "bad route"}))
+				Expect(testUI.Err).To(Say("apply-warning"))
+			})
+		})
+	})
+
+	Context("when --diff is provided", func() {
+		BeforeEach(func() {
+			cmd.Diff = true
+			fakeActor.GetSpaceManifestDiffReturns(
+				[]v3action.ApplicationManifestDiff{
+					{
+						AppName: "app-1",
+						Changes: []v3action.ManifestChange{
+							{Op: ccv3.ManifestDiffReplace, Field: "instances", Was: "1", Value: "3"},
+							{Op: ccv3.ManifestDiffAdd, Field: "env/FOO", Value: "bar"},
+							{Op: ccv3.ManifestDiffRemove, Field: "routes", Was: `[{"route":"app-1.example.com"}]`},
+						},
+					},
+				},
+				v3action.Warnings{"diff-warning"},
+				nil,
+			)
+		})
+
+		Context("when the API version is below the diff minimum", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns("3.75.0")
+			})
+
+			It("returns a MinimumAPIVersionNotMetError for the option", func() {
+				Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+					Command:        "Option '--diff'",
+					CurrentVersion: "3.75.0",
+					MinimumVersion: "3.76.0",
+				}))
+			})
+		})
+
+		It("displays the changes grouped by app", func() {
+			Expect(testUI.Out).To(Say("Comparing manifest %s to apps in org some-org / space some-space as some-user...", manifestPath))
+			Expect(testUI.Out).To(Say("app: app-1"))
+			Expect(testUI.Out).To(Say(`- instances: 1`))
+			Expect(testUI.Out).To(Say(`\+ instances: 3`))
+			Expect(testUI.Out).To(Say(`\+ env/FOO: bar`))
+			Expect(testUI.Out).To(Say(`- routes: \[{"route":"app-1.example.com"}\]`))
+			Expect(testUI.Out).To(Say(`Apply these changes\? \[yN\]`))
+			Expect(testUI.Err).To(Say("diff-warning"))
+
+			spaceGUID, manifest := fakeActor.GetSpaceManifestDiffArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(manifest).To(Equal(rawManifest))
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				input.Write([]byte("y\n"))
+			})
+
+			It("applies the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Applying manifest"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeActor.ApplyApplicationManifestCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the user declines", func() {
+			BeforeEach(func() {
+				input.Write([]byte("n\n"))
+			})
+
+			It("does not apply the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Manifest not applied."))
+				Expect(fakeActor.ApplyApplicationManifestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when there are no changes", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceManifestDiffReturns(nil, nil, nil)
+			})
+
+			It("does not prompt or apply the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No changes to apply."))
+				Expect(testUI.Out).ToNot(Say("Apply these changes"))
+				Expect(fakeActor.ApplyApplicationManifestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the diff fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("diff failed")
+				fakeActor.GetSpaceManifestDiffReturns(nil, v3action.Warnings{"diff-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("diff-warning"))
+				Expect(fakeActor.ApplyApplicationManifestCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
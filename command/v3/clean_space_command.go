@@ -0,0 +1,156 @@
+package v3
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . CleanSpaceActor
+
+type CleanSpaceActor interface {
+	CloudControllerAPIVersion() string
+	DeleteStaleArtifacts(staleArtifacts v3action.StaleArtifacts) (v3action.Warnings, error)
+	GetStaleArtifactsBySpace(spaceGUID string, keep int) ([]v3action.StaleArtifacts, v3action.Warnings, error)
+}
+
+type CleanSpaceCommand struct {
+	Keep            int         `long:"keep" default:"2" description:"Number of most recent packages and droplets to keep for each app"`
+	DryRun          bool        `long:"dry-run" description:"List the packages and droplets that would be deleted without deleting them"`
+	usage           interface{} `usage:"CF_NAME clean-space [--keep NUMBER] [--dry-run]\n\n   Deletes all but the most recent packages and droplets of every app in the targeted space. The droplet an app is currently running is always kept.\n\nEXAMPLES:\n   CF_NAME clean-space --keep 2 --dry-run"`
+	relatedCommands interface{} `related_commands:"apps, restage, space-quota"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CleanSpaceActor
+}
+
+func (cmd *CleanSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd CleanSpaceCommand) Execute(args []string) error {
+	if cmd.Keep < 1 {
+		return command.ParseArgumentError{ArgumentName: "--keep", ExpectedType: "a positive integer"}
+	}
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	template := "Deleting old packages and droplets in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}..."
+	if cmd.DryRun {
+		template = "Getting old packages and droplets in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	allStaleArtifacts, warnings, err := cmd.Actor.GetStaleArtifactsBySpace(cmd.Config.TargetedSpace().GUID, cmd.Keep)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(allStaleArtifacts) == 0 {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("No packages or droplets to delete.")
+		return nil
+	}
+
+	if cmd.DryRun {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+		cmd.displayStaleArtifacts(allStaleArtifacts)
+		return nil
+	}
+
+	for _, staleArtifacts := range allStaleArtifacts {
+		cmd.UI.DisplayText("Deleting {{.PackageCount}} package(s) and {{.DropletCount}} droplet(s) of app {{.AppName}}...", map[string]interface{}{
+			"PackageCount": len(staleArtifacts.Packages),
+			"DropletCount": len(staleArtifacts.Droplets),
+			"AppName":      staleArtifacts.Application.Name,
+		})
+
+		warnings, err = cmd.Actor.DeleteStaleArtifacts(staleArtifacts)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd CleanSpaceCommand) displayStaleArtifacts(allStaleArtifacts []v3action.StaleArtifacts) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("guid"),
+			cmd.UI.TranslateText("created"),
+		},
+	}
+
+	for _, staleArtifacts := range allStaleArtifacts {
+		for _, pkg := range staleArtifacts.Packages {
+			table = append(table, []string{
+				staleArtifacts.Application.Name,
+				cmd.UI.TranslateText("package"),
+				pkg.GUID,
+				formatCreatedAt(pkg.CreatedAt),
+			})
+		}
+		for _, droplet := range staleArtifacts.Droplets {
+			table = append(table, []string{
+				staleArtifacts.Application.Name,
+				cmd.UI.TranslateText("droplet"),
+				droplet.GUID,
+				formatCreatedAt(droplet.CreatedAt),
+			})
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
+
+// formatCreatedAt displays an RFC3339 timestamp in the same format as the
+// tasks command, falling back to the raw value if it cannot be parsed.
+func formatCreatedAt(createdAt string) string {
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return createdAt
+	}
+	return t.Format(time.RFC1123)
+}
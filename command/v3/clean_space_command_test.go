@@ -0,0 +1,180 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("clean-space Command", func() {
+	var (
+		cmd             v3.CleanSpaceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeCleanSpaceActor
+		binaryName      string
+		executeErr      error
+		staleArtifacts  []v3action.StaleArtifacts
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeCleanSpaceActor)
+
+		cmd = v3.CleanSpaceCommand{
+			Keep:        2,
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+
+		staleArtifacts = []v3action.StaleArtifacts{
+			{
+				Application: v3action.Application{Name: "app-1"},
+				Packages:    []v3action.Package{{GUID: "package-guid-1", CreatedAt: "2017-08-01T19:00:00Z"}},
+				Droplets:    []v3action.Droplet{{GUID: "droplet-guid-1", CreatedAt: "2017-08-02T19:00:00Z"}},
+			},
+			{
+				Application: v3action.Application{Name: "app-2"},
+				Droplets:    []v3action.Droplet{{GUID: "droplet-guid-2", CreatedAt: "2017-08-03T19:00:00Z"}},
+			},
+		}
+		fakeActor.GetStaleArtifactsBySpaceReturns(staleArtifacts, v3action.Warnings{"get-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --keep is less than 1", func() {
+		BeforeEach(func() {
+			cmd.Keep = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{ArgumentName: "--keep", ExpectedType: "a positive integer"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when there is nothing to delete", func() {
+		BeforeEach(func() {
+			fakeActor.GetStaleArtifactsBySpaceReturns(nil, v3action.Warnings{"get-warning"}, nil)
+		})
+
+		It("displays a message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("No packages or droplets to delete."))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(fakeActor.DeleteStaleArtifactsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --dry-run is provided", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		It("displays the packages and droplets without deleting them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting old packages and droplets in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`app\s+type\s+guid\s+created`))
+			Expect(testUI.Out).To(Say(`app-1\s+package\s+package-guid-1\s+Tue, 01 Aug 2017 19:00:00 UTC`))
+			Expect(testUI.Out).To(Say(`app-1\s+droplet\s+droplet-guid-1\s+Wed, 02 Aug 2017 19:00:00 UTC`))
+			Expect(testUI.Out).To(Say(`app-2\s+droplet\s+droplet-guid-2\s+Thu, 03 Aug 2017 19:00:00 UTC`))
+
+			Expect(fakeActor.GetStaleArtifactsBySpaceCallCount()).To(Equal(1))
+			spaceGUID, keep := fakeActor.GetStaleArtifactsBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(keep).To(Equal(2))
+			Expect(fakeActor.DeleteStaleArtifactsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when deleting", func() {
+		Context("when the deletes succeed", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteStaleArtifactsReturns(v3action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the stale packages and droplets of each app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Deleting old packages and droplets in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say(`Deleting 1 package\(s\) and 1 droplet\(s\) of app app-1\.\.\.`))
+				Expect(testUI.Out).To(Say(`Deleting 0 package\(s\) and 1 droplet\(s\) of app app-2\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+
+				Expect(fakeActor.DeleteStaleArtifactsCallCount()).To(Equal(2))
+				Expect(fakeActor.DeleteStaleArtifactsArgsForCall(0)).To(Equal(staleArtifacts[0]))
+				Expect(fakeActor.DeleteStaleArtifactsArgsForCall(1)).To(Equal(staleArtifacts[1]))
+			})
+		})
+
+		Context("when a delete fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeActor.DeleteStaleArtifactsReturns(v3action.Warnings{"delete-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("delete-warning"))
+				Expect(fakeActor.DeleteStaleArtifactsCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . ContinueDeploymentActor
+
+type ContinueDeploymentActor interface {
+	CloudControllerAPIVersion() string
+	ContinueDeploymentByApplicationNameAndSpace(appName string, spaceGUID string) (v3action.Deployment, v3action.Warnings, error)
+	PollDeployment(deployment v3action.Deployment) (v3action.Deployment, v3action.Warnings, error)
+}
+
+type ContinueDeploymentCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME continue-deployment APP_NAME\n\n   Replaces the remaining instances of an app whose canary deployment is paused.\n\nEXAMPLES:\n   CF_NAME restart my-app --strategy canary\n   CF_NAME continue-deployment my-app"`
+	relatedCommands interface{}  `related_commands:"app, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ContinueDeploymentActor
+}
+
+func (cmd *ContinueDeploymentCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd ContinueDeploymentCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Continuing deployment for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	deployment, warnings, err := cmd.Actor.ContinueDeploymentByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for the deployment to replace all instances...")
+
+	_, warnings, err = cmd.Actor.PollDeployment(deployment)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("continue-deployment Command", func() {
+	var (
+		cmd             v3.ContinueDeploymentCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeContinueDeploymentActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeContinueDeploymentActor)
+
+		cmd = v3.ContinueDeploymentCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the deployment is continued", func() {
+		BeforeEach(func() {
+			fakeActor.ContinueDeploymentByApplicationNameAndSpaceReturns(
+				v3action.Deployment{GUID: "some-deployment-guid"},
+				v3action.Warnings{"continue-warning"},
+				nil,
+			)
+			fakeActor.PollDeploymentReturns(
+				v3action.Deployment{GUID: "some-deployment-guid"},
+				v3action.Warnings{"poll-warning"},
+				nil,
+			)
+		})
+
+		It("waits for the remaining instances to be replaced", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Continuing deployment for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("Waiting for the deployment to replace all instances..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("continue-warning"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+
+			appName, spaceGUID := fakeActor.ContinueDeploymentByApplicationNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.PollDeploymentArgsForCall(0)).To(Equal(v3action.Deployment{GUID: "some-deployment-guid"}))
+		})
+	})
+
+	Context("when the app has no paused deployment", func() {
+		BeforeEach(func() {
+			fakeActor.ContinueDeploymentByApplicationNameAndSpaceReturns(
+				v3action.Deployment{},
+				v3action.Warnings{"continue-warning"},
+				v3action.DeploymentNotPausedError{AppName: "some-app"},
+			)
+		})
+
+		It("returns a DeploymentNotPausedError", func() {
+			Expect(executeErr).To(MatchError(shared.DeploymentNotPausedError{AppName: "some-app"}))
+			Expect(testUI.Err).To(Say("continue-warning"))
+			Expect(fakeActor.PollDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when polling the deployment fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("poll error")
+			fakeActor.PollDeploymentReturns(v3action.Deployment{}, v3action.Warnings{"poll-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("poll-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -0,0 +1,133 @@
+package v3
+
+import (
+	"sort"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . RevisionActor
+
+type RevisionActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationRevisionByVersion(appName string, spaceGUID string, version int) (v3action.Revision, v3action.Warnings, error)
+	GetRevisionEnvironmentVariables(revision v3action.Revision) (map[string]string, v3action.Warnings, error)
+}
+
+type RevisionCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Version         int          `long:"version" required:"true" description:"The version of the revision to display"`
+	usage           interface{}  `usage:"CF_NAME revision APP_NAME --version VERSION\n\n   Displays the droplet, process commands and environment variables captured in a revision of an app.\n\nEXAMPLES:\n   CF_NAME revision my-app --version 3"`
+	relatedCommands interface{}  `related_commands:"app, env"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RevisionActor
+}
+
+func (cmd *RevisionCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd RevisionCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting revision {{.Version}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"Version":     cmd.Version,
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	revision, warnings, err := cmd.Actor.GetApplicationRevisionByVersion(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.Version)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	envVars, warnings, err := cmd.Actor.GetRevisionEnvironmentVariables(revision)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("version:"), strconv.Itoa(revision.Version)},
+		{cmd.UI.TranslateText("description:"), revision.Description},
+		{cmd.UI.TranslateText("created:"), revision.CreatedAt},
+		{cmd.UI.TranslateText("droplet guid:"), revision.DropletGUID},
+	}, 3)
+
+	cmd.UI.DisplayNewline()
+	if len(revision.ProcessCommands) == 0 {
+		cmd.UI.DisplayText("No process commands.")
+	} else {
+		table := [][]string{
+			{
+				cmd.UI.TranslateText("process type"),
+				cmd.UI.TranslateText("command"),
+			},
+		}
+		for _, processType := range sortedKeys(revision.ProcessCommands) {
+			table = append(table, []string{processType, revision.ProcessCommands[processType]})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, 3)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(envVars) == 0 {
+		cmd.UI.DisplayText("No user-provided environment variables.")
+	} else {
+		cmd.UI.DisplayText("User-provided environment variables:")
+		for _, name := range sortedKeys(envVars) {
+			cmd.UI.DisplayText("{{.Name}}: {{.Value}}", map[string]interface{}{
+				"Name":  name,
+				"Value": envVars[name],
+			})
+		}
+	}
+
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,170 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("revision Command", func() {
+	var (
+		cmd             v3.RevisionCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeRevisionActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeRevisionActor)
+
+		cmd = v3.RevisionCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Version = 3
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+
+		fakeActor.GetApplicationRevisionByVersionReturns(
+			v3action.Revision{
+				GUID:        "some-revision-guid",
+				Version:     3,
+				Description: "New droplet deployed.",
+				CreatedAt:   "2018-06-01T12:00:00Z",
+				DropletGUID: "some-droplet-guid",
+				ProcessCommands: map[string]string{
+					"worker": "bundle exec sidekiq",
+					"web":    "bundle exec rackup",
+				},
+			},
+			v3action.Warnings{"revision-warning"},
+			nil,
+		)
+		fakeActor.GetRevisionEnvironmentVariablesReturns(
+			map[string]string{"ZEBRA": "stripes", "DATABASE_URL": "postgres://db"},
+			v3action.Warnings{"env-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("displays the revision", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Getting revision 3 of app some-app in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say(`version:\s+3`))
+		Expect(testUI.Out).To(Say(`description:\s+New droplet deployed.`))
+		Expect(testUI.Out).To(Say(`created:\s+2018-06-01T12:00:00Z`))
+		Expect(testUI.Out).To(Say(`droplet guid:\s+some-droplet-guid`))
+		Expect(testUI.Out).To(Say(`process type\s+command`))
+		Expect(testUI.Out).To(Say(`web\s+bundle exec rackup`))
+		Expect(testUI.Out).To(Say(`worker\s+bundle exec sidekiq`))
+		Expect(testUI.Out).To(Say("User-provided environment variables:"))
+		Expect(testUI.Out).To(Say("DATABASE_URL: postgres://db"))
+		Expect(testUI.Out).To(Say("ZEBRA: stripes"))
+		Expect(testUI.Err).To(Say("revision-warning"))
+		Expect(testUI.Err).To(Say("env-warning"))
+
+		appName, spaceGUID, version := fakeActor.GetApplicationRevisionByVersionArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(version).To(Equal(3))
+		Expect(fakeActor.GetRevisionEnvironmentVariablesArgsForCall(0).GUID).To(Equal("some-revision-guid"))
+	})
+
+	Context("when the revision has no environment variables", func() {
+		BeforeEach(func() {
+			fakeActor.GetRevisionEnvironmentVariablesReturns(map[string]string{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No user-provided environment variables."))
+		})
+	})
+
+	Context("when the revision does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationRevisionByVersionReturns(
+				v3action.Revision{},
+				v3action.Warnings{"revision-warning"},
+				v3action.RevisionNotFoundError{AppName: "some-app", Version: 3},
+			)
+		})
+
+		It("returns a RevisionNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.RevisionNotFoundError{AppName: "some-app", Version: 3}))
+			Expect(testUI.Err).To(Say("revision-warning"))
+			Expect(fakeActor.GetRevisionEnvironmentVariablesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the environment variables fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("env failed")
+			fakeActor.GetRevisionEnvironmentVariablesReturns(nil, v3action.Warnings{"env-warning"}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("env-warning"))
+		})
+	})
+})
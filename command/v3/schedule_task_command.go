@@ -0,0 +1,129 @@
+package v3
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+//go:generate counterfeiter . ScheduleTaskActor
+
+type ScheduleTaskActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type ScheduleTaskCommand struct {
+	RequiredArgs    flag.RunTaskArgs `positional-args:"yes"`
+	At              flag.TaskTime    `long:"at" required:"true" description:"When to run the task: an RFC3339 timestamp, a local time (HH:MM) or an offset from now (+DURATION)"`
+	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
+	usage           interface{}      `usage:"CF_NAME schedule-task APP_NAME COMMAND --at TIME [-k DISK] [-m MEMORY] [--name TASK_NAME]\n\n   Scheduled tasks are kept on this machine and only run while 'CF_NAME task-runner --daemon' is running.\n\nEXAMPLES:\n   CF_NAME schedule-task my-app \"bundle exec rake db:migrate\" --at 02:30 --name migrate\n   CF_NAME schedule-task my-app \"bin/cleanup\" --at +2h"`
+	relatedCommands interface{}      `related_commands:"run-task, task-runner, tasks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ScheduleTaskActor
+}
+
+func (cmd *ScheduleTaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd ScheduleTaskCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.At.Time.After(time.Now()) {
+		return shared.ScheduledTimeInPastError{Time: cmd.At.Time.Format(time.RFC3339)}
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Scheduling task for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	scheduledTasks, err := cmd.Config.ScheduledTasks()
+	if err != nil {
+		return err
+	}
+
+	scheduledTasks = append(scheduledTasks, configv3.ScheduledTask{
+		ID:         id.String(),
+		AppGUID:    application.GUID,
+		AppName:    application.Name,
+		OrgName:    cmd.Config.TargetedOrganization().Name,
+		SpaceName:  space.Name,
+		Name:       cmd.Name,
+		Command:    cmd.RequiredArgs.Command,
+		DiskInMB:   cmd.Disk.Size,
+		MemoryInMB: cmd.Memory.Size,
+		RunAt:      cmd.At.Time,
+	})
+
+	err = cmd.Config.WriteScheduledTasks(scheduledTasks)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("command:"), cmd.RequiredArgs.Command},
+		{cmd.UI.TranslateText("run at:"), cmd.At.Time.Local().Format(time.RFC1123Z)},
+	}, 3)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: The task only runs while '{{.BinaryName}} task-runner --daemon' is running on this machine.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+	})
+
+	return nil
+}
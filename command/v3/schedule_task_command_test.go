@@ -0,0 +1,168 @@
+package v3_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("schedule-task Command", func() {
+	var (
+		cmd             v3.ScheduleTaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeScheduleTaskActor
+		binaryName      string
+		runAt           time.Time
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeScheduleTaskActor)
+
+		cmd = v3.ScheduleTaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		runAt = time.Now().Add(time.Hour)
+		cmd.RequiredArgs.AppName = "some-app-name"
+		cmd.RequiredArgs.Command = "some command"
+		cmd.At.Time = runAt
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v3action.Application{GUID: "some-app-guid", Name: "some-app-name"},
+			v3action.Warnings{"get-app-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	Context("when the time has already passed", func() {
+		BeforeEach(func() {
+			cmd.At.Time = time.Date(2017, 6, 1, 15, 4, 0, 0, time.UTC)
+		})
+
+		It("returns a ScheduledTimeInPastError", func() {
+			Expect(executeErr).To(MatchError(shared.ScheduledTimeInPastError{Time: "2017-06-01T15:04:00Z"}))
+			Expect(fakeConfig.WriteScheduledTasksCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v3action.Application{},
+				v3action.Warnings{"get-app-warning"},
+				v3action.ApplicationNotFoundError{Name: "some-app-name"},
+			)
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app-name"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeConfig.WriteScheduledTasksCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the task is scheduled", func() {
+		BeforeEach(func() {
+			cmd.Name = "some-task"
+			cmd.Memory.Size = 256
+			cmd.Disk.Size = 512
+			fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{{ID: "existing-id"}}, nil)
+		})
+
+		It("adds the task to the schedule", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Scheduling task for app some-app-name in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("command:\\s+some command"))
+			Expect(testUI.Out).To(Say("run at:"))
+			Expect(testUI.Out).To(Say("TIP: The task only runs while 'faceman task-runner --daemon' is running on this machine."))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app-name"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeConfig.WriteScheduledTasksCallCount()).To(Equal(1))
+			scheduledTasks := fakeConfig.WriteScheduledTasksArgsForCall(0)
+			Expect(scheduledTasks).To(HaveLen(2))
+			Expect(scheduledTasks[0].ID).To(Equal("existing-id"))
+
+			scheduledTask := scheduledTasks[1]
+			Expect(scheduledTask.ID).ToNot(BeEmpty())
+			scheduledTask.ID = ""
+			Expect(scheduledTask).To(Equal(configv3.ScheduledTask{
+				AppGUID:    "some-app-guid",
+				AppName:    "some-app-name",
+				OrgName:    "some-org",
+				SpaceName:  "some-space",
+				Name:       "some-task",
+				Command:    "some command",
+				DiskInMB:   512,
+				MemoryInMB: 256,
+				RunAt:      runAt,
+			}))
+		})
+	})
+})
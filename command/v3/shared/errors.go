@@ -55,3 +55,191 @@ func (e OrganizationNotFoundError) Translate(translate func(string, ...interface
 		"Name": e.Name,
 	})
 }
+
+type ProcessNotFoundError struct {
+	ProcessType string
+}
+
+func (e ProcessNotFoundError) Error() string {
+	return "Process {{.ProcessType}} not found"
+}
+
+func (e ProcessNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ProcessType": e.ProcessType,
+	})
+}
+
+type HTTPHealthCheckInvalidError struct {
+}
+
+func (e HTTPHealthCheckInvalidError) Error() string {
+	return "Health check type must be 'http' to set a health check HTTP endpoint."
+}
+
+func (e HTTPHealthCheckInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type InvocationTimeoutInvalidError struct {
+}
+
+func (e InvocationTimeoutInvalidError) Error() string {
+	return "Health check type must be 'http' or 'port' to set an invocation timeout."
+}
+
+func (e InvocationTimeoutInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type ApplicationNotStagedError struct {
+	AppName string
+}
+
+func (e ApplicationNotStagedError) Error() string {
+	return "App {{.AppName}} has no droplet to deploy. Stage the app before using '--strategy'."
+}
+
+func (e ApplicationNotStagedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+type DeploymentNotDeployedError struct {
+	Reason string
+}
+
+func (e DeploymentNotDeployedError) Error() string {
+	return "The deployment was {{.Reason}} before all instances were replaced."
+}
+
+func (e DeploymentNotDeployedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Reason": e.Reason,
+	})
+}
+
+type DeploymentNotFoundError struct {
+	AppName string
+}
+
+func (e DeploymentNotFoundError) Error() string {
+	return "App {{.AppName}} has no active deployment."
+}
+
+func (e DeploymentNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+type RevisionNotFoundError struct {
+	AppName string
+	Version int
+}
+
+func (e RevisionNotFoundError) Error() string {
+	return "Revision {{.Version}} of app {{.AppName}} not found."
+}
+
+func (e RevisionNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Version": e.Version,
+	})
+}
+
+type DeploymentNotPausedError struct {
+	AppName string
+}
+
+func (e DeploymentNotPausedError) Error() string {
+	return "The deployment of app {{.AppName}} is not paused. Only canary deployments can be continued."
+}
+
+func (e DeploymentNotPausedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+type DeploymentTimeoutError struct {
+}
+
+func (e DeploymentTimeoutError) Error() string {
+	return "Timed out waiting for the deployment to finish."
+}
+
+func (e DeploymentTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type ServiceInstanceUpToDateError struct {
+	Name string
+}
+
+func (e ServiceInstanceUpToDateError) Error() string {
+	return "No upgrade is available for service instance {{.Name}}."
+}
+
+func (e ServiceInstanceUpToDateError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type ScheduledTimeInPastError struct {
+	Time string
+}
+
+func (e ScheduledTimeInPastError) Error() string {
+	return "The scheduled time {{.Time}} has already passed."
+}
+
+func (e ScheduledTimeInPastError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Time": e.Time,
+	})
+}
+
+type JobFailedError struct {
+	JobGUID string
+	Message string
+}
+
+func (e JobFailedError) Error() string {
+	return "Job ({{.JobGUID}}) failed: {{.Message}}"
+}
+
+func (e JobFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+		"JobGUID": e.JobGUID,
+	})
+}
+
+type JobTimeoutError struct {
+	JobGUID string
+}
+
+func (e JobTimeoutError) Error() string {
+	return "Job ({{.JobGUID}}) polling timeout has been reached. The operation may still be running on the CF instance. Your CF operator may have more information."
+}
+
+func (e JobTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"JobGUID": e.JobGUID,
+	})
+}
+
+type InvalidRefreshTokenError struct {
+}
+
+func (e InvalidRefreshTokenError) Error() string {
+	return "The token expired, was revoked, or the token ID is incorrect. Please log back in to re-authenticate."
+}
+
+func (e InvalidRefreshTokenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -41,5 +41,16 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
+		Entry("ApplicationNotStagedError", ApplicationNotStagedError{}),
+		Entry("DeploymentNotDeployedError", DeploymentNotDeployedError{}),
+		Entry("DeploymentNotFoundError", DeploymentNotFoundError{}),
+		Entry("DeploymentNotPausedError", DeploymentNotPausedError{}),
+		Entry("DeploymentTimeoutError", DeploymentTimeoutError{}),
+		Entry("ServiceInstanceUpToDateError", ServiceInstanceUpToDateError{}),
+		Entry("ScheduledTimeInPastError", ScheduledTimeInPastError{}),
+		Entry("RevisionNotFoundError", RevisionNotFoundError{}),
+		Entry("JobFailedError", JobFailedError{}),
+		Entry("JobTimeoutError", JobTimeoutError{}),
+		Entry("InvalidRefreshTokenError", InvalidRefreshTokenError{}),
 	)
 })
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 )
 
@@ -13,6 +14,10 @@ func HandleError(err error) error {
 	switch e := err.(type) {
 	case ccerror.APINotFoundError:
 		return command.APINotFoundError{URL: e.URL}
+	case ccerror.JobFailedError:
+		return JobFailedError{JobGUID: e.JobGUID, Message: e.Message}
+	case ccerror.JobTimeoutError:
+		return JobTimeoutError{JobGUID: e.JobGUID}
 	case ccerror.RequestError:
 		return command.APIRequestError{Err: e.Err}
 	case ccerror.SSLValidationHostnameError:
@@ -25,6 +30,11 @@ func HandleError(err error) error {
 	case ccerror.UnverifiedServerError:
 		return command.InvalidSSLCertError{API: e.URL}
 
+	case uaa.InvalidAuthTokenError:
+		return InvalidRefreshTokenError{}
+	case uaa.InvalidRefreshTokenError:
+		return InvalidRefreshTokenError{}
+
 	case sharedaction.NotLoggedInError:
 		return command.NotLoggedInError{BinaryName: e.BinaryName}
 	case sharedaction.NoTargetedOrganizationError:
@@ -40,6 +50,28 @@ func HandleError(err error) error {
 		return OrganizationNotFoundError{Name: e.Name}
 	case v3action.IsolationSegmentNotFoundError:
 		return IsolationSegmentNotFoundError{Name: e.Name}
+	case v3action.ProcessNotFoundError:
+		return ProcessNotFoundError{ProcessType: e.ProcessType}
+	case v3action.HTTPHealthCheckInvalidError:
+		return HTTPHealthCheckInvalidError{}
+	case v3action.InvocationTimeoutInvalidError:
+		return InvocationTimeoutInvalidError{}
+	case v3action.ApplicationNotStagedError:
+		return ApplicationNotStagedError{AppName: e.AppName}
+	case v3action.DeploymentNotDeployedError:
+		return DeploymentNotDeployedError{Reason: e.Reason}
+	case v3action.DeploymentNotFoundError:
+		return DeploymentNotFoundError{AppName: e.AppName}
+	case v3action.DeploymentNotPausedError:
+		return DeploymentNotPausedError{AppName: e.AppName}
+	case v3action.DeploymentTimeoutError:
+		return DeploymentTimeoutError{}
+	case v3action.RevisionNotFoundError:
+		return RevisionNotFoundError{AppName: e.AppName, Version: e.Version}
+	case v3action.ServiceInstanceNotFoundError:
+		return command.ServiceInstanceNotFoundError{Name: e.Name}
+	case v3action.ServiceInstanceUpToDateError:
+		return ServiceInstanceUpToDateError{Name: e.Name}
 	}
 
 	return err
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	. "code.cloudfoundry.org/cli/command/v3/shared"
 	. "github.com/onsi/ginkgo"
@@ -35,6 +36,14 @@ var _ = Describe("HandleError", func() {
 			ccerror.SSLValidationHostnameError{Message: "some-message"},
 			command.SSLCertErrorError{Message: "some-message"}),
 
+		Entry("uaa.InvalidAuthTokenError -> InvalidRefreshTokenError",
+			uaa.InvalidAuthTokenError{},
+			InvalidRefreshTokenError{}),
+
+		Entry("uaa.InvalidRefreshTokenError -> InvalidRefreshTokenError",
+			uaa.InvalidRefreshTokenError{},
+			InvalidRefreshTokenError{}),
+
 		Entry("ccerror.UnprocessableEntityError with droplet message -> RunTaskError",
 			ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: Task must have a droplet. Specify droplet or assign current droplet to app."},
 			RunTaskError{Message: "App is not staged."}),
@@ -48,6 +57,14 @@ var _ = Describe("HandleError", func() {
 			unprocessableEntityError,
 			unprocessableEntityError),
 
+		Entry("ccerror.JobFailedError -> JobFailedError",
+			ccerror.JobFailedError{JobGUID: "some-job-guid", Message: "some-message"},
+			JobFailedError{JobGUID: "some-job-guid", Message: "some-message"}),
+
+		Entry("ccerror.JobTimeoutError -> JobTimeoutError",
+			ccerror.JobTimeoutError{JobGUID: "some-job-guid"},
+			JobTimeoutError{JobGUID: "some-job-guid"}),
+
 		Entry("ccerror.APINotFoundError -> APINotFoundError",
 			ccerror.APINotFoundError{URL: "some-url"},
 			command.APINotFoundError{URL: "some-url"}),
@@ -76,6 +93,50 @@ var _ = Describe("HandleError", func() {
 			v3action.OrganizationNotFoundError{Name: "some-org"},
 			OrganizationNotFoundError{Name: "some-org"}),
 
+		Entry("v3action.ProcessNotFoundError -> ProcessNotFoundError",
+			v3action.ProcessNotFoundError{ProcessType: "some-process"},
+			ProcessNotFoundError{ProcessType: "some-process"}),
+
+		Entry("v3action.HTTPHealthCheckInvalidError -> HTTPHealthCheckInvalidError",
+			v3action.HTTPHealthCheckInvalidError{},
+			HTTPHealthCheckInvalidError{}),
+
+		Entry("v3action.InvocationTimeoutInvalidError -> InvocationTimeoutInvalidError",
+			v3action.InvocationTimeoutInvalidError{},
+			InvocationTimeoutInvalidError{}),
+
+		Entry("v3action.ApplicationNotStagedError -> ApplicationNotStagedError",
+			v3action.ApplicationNotStagedError{AppName: "some-app"},
+			ApplicationNotStagedError{AppName: "some-app"}),
+
+		Entry("v3action.DeploymentNotDeployedError -> DeploymentNotDeployedError",
+			v3action.DeploymentNotDeployedError{DeploymentGUID: "some-deployment-guid", Reason: "canceled"},
+			DeploymentNotDeployedError{Reason: "canceled"}),
+
+		Entry("v3action.DeploymentNotFoundError -> DeploymentNotFoundError",
+			v3action.DeploymentNotFoundError{AppName: "some-app"},
+			DeploymentNotFoundError{AppName: "some-app"}),
+
+		Entry("v3action.DeploymentNotPausedError -> DeploymentNotPausedError",
+			v3action.DeploymentNotPausedError{AppName: "some-app"},
+			DeploymentNotPausedError{AppName: "some-app"}),
+
+		Entry("v3action.DeploymentTimeoutError -> DeploymentTimeoutError",
+			v3action.DeploymentTimeoutError{DeploymentGUID: "some-deployment-guid"},
+			DeploymentTimeoutError{}),
+
+		Entry("v3action.RevisionNotFoundError -> RevisionNotFoundError",
+			v3action.RevisionNotFoundError{AppName: "some-app", Version: 3},
+			RevisionNotFoundError{AppName: "some-app", Version: 3}),
+
+		Entry("v3action.ServiceInstanceNotFoundError -> ServiceInstanceNotFoundError",
+			v3action.ServiceInstanceNotFoundError{Name: "some-service-instance"},
+			command.ServiceInstanceNotFoundError{Name: "some-service-instance"}),
+
+		Entry("v3action.ServiceInstanceUpToDateError -> ServiceInstanceUpToDateError",
+			v3action.ServiceInstanceUpToDateError{Name: "some-service-instance"},
+			ServiceInstanceUpToDateError{Name: "some-service-instance"}),
+
 		Entry("default case -> original error",
 			err,
 			err),
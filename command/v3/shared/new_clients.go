@@ -2,6 +2,7 @@ package shared
 
 import (
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tracing"
 )
 
 // NewClients creates a new V3 Cloud Controller client and UAA client using the
@@ -27,7 +29,12 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
+	ccWrappers = append(ccWrappers, ccWrapper.NewRateLimit(3, time.Minute))
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	if tracing.Enabled() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestTracer())
+	}
+	ccWrappers = append(ccWrappers, ccWrapper.NewResponseCache(config.ResponseCacheDir(), time.Hour))
 
 	ccClient := ccv3.NewClient(ccv3.Config{
 		AppName:    config.BinaryName(),
@@ -77,6 +84,9 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 
 	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config))
 	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	if tracing.Enabled() {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestTracer())
+	}
 
 	authWrapper.SetClient(uaaClient)
 
@@ -0,0 +1,163 @@
+package v3
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . TaskRunnerActor
+
+type TaskRunnerActor interface {
+	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type TaskRunnerCommand struct {
+	Daemon          bool        `long:"daemon" description:"Keep running and check the schedule until interrupted with Ctrl-C"`
+	Interval        int         `long:"interval" default:"60" description:"Number of seconds between schedule checks when running as a daemon"`
+	usage           interface{} `usage:"CF_NAME task-runner [--daemon [--interval SECONDS]]\n\n   Runs the tasks scheduled with 'CF_NAME schedule-task' whose time has come. Without --daemon, the schedule is checked once."`
+	relatedCommands interface{} `related_commands:"run-task, schedule-task, tasks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TaskRunnerActor
+}
+
+func (cmd *TaskRunnerCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd TaskRunnerCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	if cmd.Daemon && cmd.Interval < 1 {
+		return command.ParseArgumentError{
+			ArgumentName: "--interval",
+			ExpectedType: "a positive integer",
+		}
+	}
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Daemon {
+		return cmd.runDueTasks()
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	cmd.UI.DisplayText("Checking the task schedule every {{.Interval}} seconds. Press Ctrl-C to stop.", map[string]interface{}{
+		"Interval": cmd.Interval,
+	})
+
+	for {
+		err = cmd.runDueTasks()
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(time.Duration(cmd.Interval) * time.Second):
+		}
+	}
+}
+
+// runDueTasks runs every scheduled task whose time has passed and removes it
+// from the schedule, whether or not it could be run. A task that fails to run
+// is reported without stopping the other tasks.
+func (cmd TaskRunnerCommand) runDueTasks() error {
+	scheduledTasks, err := cmd.Config.ScheduledTasks()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	ran := map[string]bool{}
+	for _, scheduledTask := range scheduledTasks {
+		if scheduledTask.RunAt.After(now) {
+			continue
+		}
+
+		cmd.runScheduledTask(scheduledTask)
+		ran[scheduledTask.ID] = true
+	}
+
+	if len(ran) == 0 {
+		return nil
+	}
+
+	// Read the schedule again so tasks scheduled while these were running are
+	// kept.
+	scheduledTasks, err = cmd.Config.ScheduledTasks()
+	if err != nil {
+		return err
+	}
+
+	var remainingTasks []configv3.ScheduledTask
+	for _, scheduledTask := range scheduledTasks {
+		if !ran[scheduledTask.ID] {
+			remainingTasks = append(remainingTasks, scheduledTask)
+		}
+	}
+
+	return cmd.Config.WriteScheduledTasks(remainingTasks)
+}
+
+func (cmd TaskRunnerCommand) runScheduledTask(scheduledTask configv3.ScheduledTask) {
+	cmd.UI.DisplayTextWithFlavor("Running scheduled task for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}}...", map[string]interface{}{
+		"AppName":   scheduledTask.AppName,
+		"OrgName":   scheduledTask.OrgName,
+		"SpaceName": scheduledTask.SpaceName,
+	})
+
+	task, warnings, err := cmd.Actor.RunTask(scheduledTask.AppGUID, v3action.Task{
+		Name:       scheduledTask.Name,
+		Command:    scheduledTask.Command,
+		DiskInMB:   scheduledTask.DiskInMB,
+		MemoryInMB: scheduledTask.MemoryInMB,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.UI.DisplayError(shared.HandleError(err))
+		cmd.UI.DisplayNewline()
+		return
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("task name:"), task.Name},
+		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
+	}, 3)
+	cmd.UI.DisplayNewline()
+}
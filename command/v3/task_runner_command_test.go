@@ -0,0 +1,217 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task-runner Command", func() {
+	var (
+		cmd             v3.TaskRunnerCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeTaskRunnerActor
+		binaryName      string
+		dueTask         configv3.ScheduledTask
+		laterTask       configv3.ScheduledTask
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeTaskRunnerActor)
+
+		cmd = v3.TaskRunnerCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Interval:    60,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+
+		dueTask = configv3.ScheduledTask{
+			ID:         "due-id",
+			AppGUID:    "some-app-guid",
+			AppName:    "some-app",
+			OrgName:    "some-org",
+			SpaceName:  "some-space",
+			Name:       "some-task",
+			Command:    "some command",
+			MemoryInMB: 256,
+			RunAt:      time.Now().Add(-time.Minute),
+		}
+		laterTask = configv3.ScheduledTask{
+			ID:      "later-id",
+			AppGUID: "other-app-guid",
+			Command: "other command",
+			RunAt:   time.Now().Add(time.Hour),
+		}
+		fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{dueTask, laterTask}, nil)
+		fakeActor.RunTaskReturns(
+			v3action.Task{Name: "some-task", SequenceID: 3},
+			v3action.Warnings{"run-task-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when not running as a daemon", func() {
+		It("runs the due tasks once and removes them from the schedule", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Running scheduled task for app some-app in org some-org / space some-space..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("task name:\\s+some-task"))
+			Expect(testUI.Out).To(Say("task id:\\s+3"))
+			Expect(testUI.Err).To(Say("run-task-warning"))
+
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
+			appGUID, task := fakeActor.RunTaskArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(task).To(Equal(v3action.Task{
+				Name:       "some-task",
+				Command:    "some command",
+				MemoryInMB: 256,
+			}))
+
+			Expect(fakeConfig.ScheduledTasksCallCount()).To(Equal(2))
+			Expect(fakeConfig.WriteScheduledTasksCallCount()).To(Equal(1))
+			Expect(fakeConfig.WriteScheduledTasksArgsForCall(0)).To(Equal([]configv3.ScheduledTask{laterTask}))
+		})
+
+		Context("when no task is due", func() {
+			BeforeEach(func() {
+				fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{laterTask}, nil)
+			})
+
+			It("leaves the schedule alone", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+				Expect(fakeConfig.WriteScheduledTasksCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a task fails to run", func() {
+			BeforeEach(func() {
+				fakeActor.RunTaskReturns(
+					v3action.Task{},
+					v3action.Warnings{"run-task-warning"},
+					v3action.TaskWorkersUnavailableError{Message: "some-message"},
+				)
+			})
+
+			It("reports the failure and still removes the task from the schedule", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("run-task-warning"))
+				Expect(testUI.Err).To(Say("Error running task: Task workers are unavailable."))
+				Expect(testUI.Out).To(Say("FAILED"))
+				Expect(fakeConfig.WriteScheduledTasksArgsForCall(0)).To(Equal([]configv3.ScheduledTask{laterTask}))
+			})
+		})
+
+		Context("when reading the schedule fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some schedule error")
+				fakeConfig.ScheduledTasksReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when running as a daemon", func() {
+		BeforeEach(func() {
+			cmd.Daemon = true
+		})
+
+		Context("when the interval is not positive", func() {
+			BeforeEach(func() {
+				cmd.Interval = 0
+			})
+
+			It("returns a ParseArgumentError", func() {
+				Expect(executeErr).To(MatchError(command.ParseArgumentError{
+					ArgumentName: "--interval",
+					ExpectedType: "a positive integer",
+				}))
+				Expect(fakeConfig.ScheduledTasksCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when reading the schedule fails on a later check", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				cmd.Interval = 1
+				expectedErr = errors.New("some schedule error")
+				fakeConfig.ScheduledTasksStub = func() ([]configv3.ScheduledTask, error) {
+					if fakeConfig.ScheduledTasksCallCount() > 1 {
+						return nil, expectedErr
+					}
+					return []configv3.ScheduledTask{laterTask}, nil
+				}
+			})
+
+			It("checks the schedule until the check fails and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Out).To(Say("Checking the task schedule every 1 seconds. Press Ctrl-C to stop."))
+				Expect(fakeConfig.ScheduledTasksCallCount()).To(Equal(2))
+			})
+		})
+	})
+})
@@ -0,0 +1,166 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . UpgradeServiceActor
+
+type UpgradeServiceActor interface {
+	CloudControllerAPIVersion() string
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v3action.ServiceInstance, v3action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v3action.ServiceInstance, v3action.Warnings, error)
+	PollJob(job v3action.Job) (v3action.Job, v3action.Warnings, error)
+	UpgradeServiceInstance(serviceInstance v3action.ServiceInstance) (v3action.Job, v3action.Warnings, error)
+}
+
+type UpgradeServiceCommand struct {
+	OptionalArgs    flag.OptionalServiceInstance `positional-args:"yes"`
+	All             bool                         `long:"all" description:"Upgrade every service instance in the targeted space that has an upgrade available"`
+	usage           interface{}                  `usage:"CF_NAME upgrade-service SERVICE_INSTANCE\n   CF_NAME upgrade-service --all\n\n   Upgrades a service instance to the latest version of its plan, as advertised by the service broker.\n\nEXAMPLES:\n   CF_NAME upgrade-service mydb\n   CF_NAME upgrade-service --all"`
+	relatedCommands interface{}                  `related_commands:"service, services, update-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpgradeServiceActor
+}
+
+func (cmd *UpgradeServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd UpgradeServiceCommand) Execute(args []string) error {
+	serviceInstanceName := cmd.OptionalArgs.ServiceInstance
+	if cmd.All && serviceInstanceName != "" {
+		return command.ArgumentCombinationError{
+			Arg1: "SERVICE_INSTANCE",
+			Arg2: "--all",
+		}
+	}
+	if !cmd.All && serviceInstanceName == "" {
+		return command.RequiredArgumentError{ArgumentName: "SERVICE_INSTANCE"}
+	}
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.All {
+		return cmd.upgradeAllServiceInstances(user.Name)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Upgrading service instance {{.ServiceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"ServiceName": serviceInstanceName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.upgradeServiceInstance(serviceInstance)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd UpgradeServiceCommand) upgradeAllServiceInstances(userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Upgrading all service instances in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": userName,
+		})
+
+	serviceInstances, warnings, err := cmd.Actor.GetServiceInstancesBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	var upgraded int
+	for _, serviceInstance := range serviceInstances {
+		if !serviceInstance.UpgradeAvailable {
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithFlavor("Upgrading service instance {{.ServiceName}}...", map[string]interface{}{
+			"ServiceName": serviceInstance.Name,
+		})
+
+		err = cmd.upgradeServiceInstance(serviceInstance)
+		if err != nil {
+			return err
+		}
+		upgraded++
+	}
+
+	cmd.UI.DisplayNewline()
+	if upgraded == 0 {
+		cmd.UI.DisplayText("No service instances in this space have an upgrade available.")
+		return nil
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd UpgradeServiceCommand) upgradeServiceInstance(serviceInstance v3action.ServiceInstance) error {
+	job, warnings, err := cmd.Actor.UpgradeServiceInstance(serviceInstance)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if job.GUID == "" {
+		return nil
+	}
+
+	cmd.UI.DisplayText("Waiting for the service broker to finish the upgrade...")
+
+	_, warnings, err = cmd.Actor.PollJob(job)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("upgrade-service Command", func() {
+	var (
+		cmd             v3.UpgradeServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUpgradeServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUpgradeServiceActor)
+
+		cmd = v3.UpgradeServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.OptionalArgs.ServiceInstance = "some-service-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when both a service instance and --all are provided", func() {
+		BeforeEach(func() {
+			cmd.All = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Arg1: "SERVICE_INSTANCE",
+				Arg2: "--all",
+			}))
+		})
+	})
+
+	Context("when neither a service instance nor --all is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.ServiceInstance = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "SERVICE_INSTANCE"}))
+		})
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when upgrading a single service instance", func() {
+		var serviceInstance v3action.ServiceInstance
+
+		BeforeEach(func() {
+			serviceInstance = v3action.ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance", UpgradeAvailable: true}
+			fakeActor.GetServiceInstanceByNameAndSpaceReturns(serviceInstance, v3action.Warnings{"get-warning"}, nil)
+		})
+
+		Context("when the upgrade is asynchronous", func() {
+			BeforeEach(func() {
+				fakeActor.UpgradeServiceInstanceReturns(v3action.Job{GUID: "some-job-guid"}, v3action.Warnings{"upgrade-warning"}, nil)
+				fakeActor.PollJobReturns(v3action.Job{GUID: "some-job-guid"}, v3action.Warnings{"poll-warning"}, nil)
+			})
+
+			It("upgrades the instance and waits for the job to complete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Upgrading service instance some-service-instance in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("Waiting for the service broker to finish the upgrade..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(testUI.Err).To(Say("upgrade-warning"))
+				Expect(testUI.Err).To(Say("poll-warning"))
+
+				name, spaceGUID := fakeActor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+				Expect(name).To(Equal("some-service-instance"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(fakeActor.UpgradeServiceInstanceArgsForCall(0)).To(Equal(serviceInstance))
+				Expect(fakeActor.PollJobArgsForCall(0)).To(Equal(v3action.Job{GUID: "some-job-guid"}))
+			})
+		})
+
+		Context("when the upgrade completes without a job", func() {
+			BeforeEach(func() {
+				fakeActor.UpgradeServiceInstanceReturns(v3action.Job{}, nil, nil)
+			})
+
+			It("does not poll", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service instance cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+					v3action.ServiceInstance{},
+					v3action.Warnings{"get-warning"},
+					v3action.ServiceInstanceNotFoundError{Name: "some-service-instance"},
+				)
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(command.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(fakeActor.UpgradeServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service instance is already up to date", func() {
+			BeforeEach(func() {
+				fakeActor.UpgradeServiceInstanceReturns(
+					v3action.Job{},
+					v3action.Warnings{"upgrade-warning"},
+					v3action.ServiceInstanceUpToDateError{Name: "some-service-instance"},
+				)
+			})
+
+			It("returns a ServiceInstanceUpToDateError", func() {
+				Expect(executeErr).To(MatchError(shared.ServiceInstanceUpToDateError{Name: "some-service-instance"}))
+				Expect(testUI.Err).To(Say("upgrade-warning"))
+				Expect(fakeActor.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when polling the job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("poll error")
+				fakeActor.UpgradeServiceInstanceReturns(v3action.Job{GUID: "some-job-guid"}, nil, nil)
+				fakeActor.PollJobReturns(v3action.Job{}, v3action.Warnings{"poll-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("poll-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	Context("when --all is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.ServiceInstance = ""
+			cmd.All = true
+		})
+
+		Context("when some service instances have an upgrade available", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstancesBySpaceReturns(
+					[]v3action.ServiceInstance{
+						{GUID: "instance-guid-1", Name: "instance-1", UpgradeAvailable: true},
+						{GUID: "instance-guid-2", Name: "instance-2"},
+						{GUID: "instance-guid-3", Name: "instance-3", UpgradeAvailable: true},
+					},
+					v3action.Warnings{"list-warning"},
+					nil,
+				)
+				fakeActor.UpgradeServiceInstanceReturns(v3action.Job{GUID: "some-job-guid"}, v3action.Warnings{"upgrade-warning"}, nil)
+			})
+
+			It("upgrades only those service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Upgrading all service instances in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("Upgrading service instance instance-1..."))
+				Expect(testUI.Out).To(Say("Upgrading service instance instance-3..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("list-warning"))
+
+				Expect(fakeActor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeActor.UpgradeServiceInstanceCallCount()).To(Equal(2))
+				Expect(fakeActor.UpgradeServiceInstanceArgsForCall(0).Name).To(Equal("instance-1"))
+				Expect(fakeActor.UpgradeServiceInstanceArgsForCall(1).Name).To(Equal("instance-3"))
+				Expect(fakeActor.PollJobCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when no service instances have an upgrade available", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstancesBySpaceReturns(
+					[]v3action.ServiceInstance{{GUID: "instance-guid-1", Name: "instance-1"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("displays that there is nothing to upgrade", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No service instances in this space have an upgrade available."))
+				Expect(fakeActor.UpgradeServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when listing the service instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("list error")
+				fakeActor.GetServiceInstancesBySpaceReturns(nil, v3action.Warnings{"list-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("list-warning"))
+			})
+		})
+	})
+})
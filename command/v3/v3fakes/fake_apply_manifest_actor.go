@@ -0,0 +1,236 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeApplyManifestActor struct {
+	ApplyApplicationManifestStub        func(spaceGUID string, rawManifest []byte) (v3action.Warnings, error)
+	applyApplicationManifestMutex       sync.RWMutex
+	applyApplicationManifestArgsForCall []struct {
+		spaceGUID   string
+		rawManifest []byte
+	}
+	applyApplicationManifestReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	applyApplicationManifestReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetSpaceManifestDiffStub        func(spaceGUID string, rawManifest []byte) ([]v3action.ApplicationManifestDiff, v3action.Warnings, error)
+	getSpaceManifestDiffMutex       sync.RWMutex
+	getSpaceManifestDiffArgsForCall []struct {
+		spaceGUID   string
+		rawManifest []byte
+	}
+	getSpaceManifestDiffReturns struct {
+		result1 []v3action.ApplicationManifestDiff
+		result2 v3action.Warnings
+		result3 error
+	}
+	getSpaceManifestDiffReturnsOnCall map[int]struct {
+		result1 []v3action.ApplicationManifestDiff
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeApplyManifestActor) ApplyApplicationManifest(spaceGUID string, rawManifest []byte) (v3action.Warnings, error) {
+	var rawManifestCopy []byte
+	if rawManifest != nil {
+		rawManifestCopy = make([]byte, len(rawManifest))
+		copy(rawManifestCopy, rawManifest)
+	}
+	fake.applyApplicationManifestMutex.Lock()
+	ret, specificReturn := fake.applyApplicationManifestReturnsOnCall[len(fake.applyApplicationManifestArgsForCall)]
+	fake.applyApplicationManifestArgsForCall = append(fake.applyApplicationManifestArgsForCall, struct {
+		spaceGUID   string
+		rawManifest []byte
+	}{spaceGUID, rawManifestCopy})
+	fake.recordInvocation("ApplyApplicationManifest", []interface{}{spaceGUID, rawManifestCopy})
+	fake.applyApplicationManifestMutex.Unlock()
+	if fake.ApplyApplicationManifestStub != nil {
+		return fake.ApplyApplicationManifestStub(spaceGUID, rawManifest)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.applyApplicationManifestReturns.result1, fake.applyApplicationManifestReturns.result2
+}
+
+func (fake *FakeApplyManifestActor) ApplyApplicationManifestCallCount() int {
+	fake.applyApplicationManifestMutex.RLock()
+	defer fake.applyApplicationManifestMutex.RUnlock()
+	return len(fake.applyApplicationManifestArgsForCall)
+}
+
+func (fake *FakeApplyManifestActor) ApplyApplicationManifestArgsForCall(i int) (string, []byte) {
+	fake.applyApplicationManifestMutex.RLock()
+	defer fake.applyApplicationManifestMutex.RUnlock()
+	return fake.applyApplicationManifestArgsForCall[i].spaceGUID, fake.applyApplicationManifestArgsForCall[i].rawManifest
+}
+
+func (fake *FakeApplyManifestActor) ApplyApplicationManifestReturns(result1 v3action.Warnings, result2 error) {
+	fake.ApplyApplicationManifestStub = nil
+	fake.applyApplicationManifestReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApplyManifestActor) ApplyApplicationManifestReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.ApplyApplicationManifestStub = nil
+	if fake.applyApplicationManifestReturnsOnCall == nil {
+		fake.applyApplicationManifestReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.applyApplicationManifestReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApplyManifestActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeApplyManifestActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeApplyManifestActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeApplyManifestActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeApplyManifestActor) GetSpaceManifestDiff(spaceGUID string, rawManifest []byte) ([]v3action.ApplicationManifestDiff, v3action.Warnings, error) {
+	var rawManifestCopy []byte
+	if rawManifest != nil {
+		rawManifestCopy = make([]byte, len(rawManifest))
+		copy(rawManifestCopy, rawManifest)
+	}
+	fake.getSpaceManifestDiffMutex.Lock()
+	ret, specificReturn := fake.getSpaceManifestDiffReturnsOnCall[len(fake.getSpaceManifestDiffArgsForCall)]
+	fake.getSpaceManifestDiffArgsForCall = append(fake.getSpaceManifestDiffArgsForCall, struct {
+		spaceGUID   string
+		rawManifest []byte
+	}{spaceGUID, rawManifestCopy})
+	fake.recordInvocation("GetSpaceManifestDiff", []interface{}{spaceGUID, rawManifestCopy})
+	fake.getSpaceManifestDiffMutex.Unlock()
+	if fake.GetSpaceManifestDiffStub != nil {
+		return fake.GetSpaceManifestDiffStub(spaceGUID, rawManifest)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceManifestDiffReturns.result1, fake.getSpaceManifestDiffReturns.result2, fake.getSpaceManifestDiffReturns.result3
+}
+
+func (fake *FakeApplyManifestActor) GetSpaceManifestDiffCallCount() int {
+	fake.getSpaceManifestDiffMutex.RLock()
+	defer fake.getSpaceManifestDiffMutex.RUnlock()
+	return len(fake.getSpaceManifestDiffArgsForCall)
+}
+
+func (fake *FakeApplyManifestActor) GetSpaceManifestDiffArgsForCall(i int) (string, []byte) {
+	fake.getSpaceManifestDiffMutex.RLock()
+	defer fake.getSpaceManifestDiffMutex.RUnlock()
+	return fake.getSpaceManifestDiffArgsForCall[i].spaceGUID, fake.getSpaceManifestDiffArgsForCall[i].rawManifest
+}
+
+func (fake *FakeApplyManifestActor) GetSpaceManifestDiffReturns(result1 []v3action.ApplicationManifestDiff, result2 v3action.Warnings, result3 error) {
+	fake.GetSpaceManifestDiffStub = nil
+	fake.getSpaceManifestDiffReturns = struct {
+		result1 []v3action.ApplicationManifestDiff
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyManifestActor) GetSpaceManifestDiffReturnsOnCall(i int, result1 []v3action.ApplicationManifestDiff, result2 v3action.Warnings, result3 error) {
+	fake.GetSpaceManifestDiffStub = nil
+	if fake.getSpaceManifestDiffReturnsOnCall == nil {
+		fake.getSpaceManifestDiffReturnsOnCall = make(map[int]struct {
+			result1 []v3action.ApplicationManifestDiff
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceManifestDiffReturnsOnCall[i] = struct {
+		result1 []v3action.ApplicationManifestDiff
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyManifestActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.applyApplicationManifestMutex.RLock()
+	defer fake.applyApplicationManifestMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.getSpaceManifestDiffMutex.RLock()
+	defer fake.getSpaceManifestDiffMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeApplyManifestActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.ApplyManifestActor = new(FakeApplyManifestActor)
@@ -0,0 +1,224 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeCleanSpaceActor struct {
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	DeleteStaleArtifactsStub        func(staleArtifacts v3action.StaleArtifacts) (v3action.Warnings, error)
+	deleteStaleArtifactsMutex       sync.RWMutex
+	deleteStaleArtifactsArgsForCall []struct {
+		staleArtifacts v3action.StaleArtifacts
+	}
+	deleteStaleArtifactsReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	deleteStaleArtifactsReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	GetStaleArtifactsBySpaceStub        func(spaceGUID string, keep int) ([]v3action.StaleArtifacts, v3action.Warnings, error)
+	getStaleArtifactsBySpaceMutex       sync.RWMutex
+	getStaleArtifactsBySpaceArgsForCall []struct {
+		spaceGUID string
+		keep      int
+	}
+	getStaleArtifactsBySpaceReturns struct {
+		result1 []v3action.StaleArtifacts
+		result2 v3action.Warnings
+		result3 error
+	}
+	getStaleArtifactsBySpaceReturnsOnCall map[int]struct {
+		result1 []v3action.StaleArtifacts
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCleanSpaceActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeCleanSpaceActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeCleanSpaceActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCleanSpaceActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeCleanSpaceActor) DeleteStaleArtifacts(staleArtifacts v3action.StaleArtifacts) (v3action.Warnings, error) {
+	fake.deleteStaleArtifactsMutex.Lock()
+	ret, specificReturn := fake.deleteStaleArtifactsReturnsOnCall[len(fake.deleteStaleArtifactsArgsForCall)]
+	fake.deleteStaleArtifactsArgsForCall = append(fake.deleteStaleArtifactsArgsForCall, struct {
+		staleArtifacts v3action.StaleArtifacts
+	}{staleArtifacts})
+	fake.recordInvocation("DeleteStaleArtifacts", []interface{}{staleArtifacts})
+	fake.deleteStaleArtifactsMutex.Unlock()
+	if fake.DeleteStaleArtifactsStub != nil {
+		return fake.DeleteStaleArtifactsStub(staleArtifacts)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteStaleArtifactsReturns.result1, fake.deleteStaleArtifactsReturns.result2
+}
+
+func (fake *FakeCleanSpaceActor) DeleteStaleArtifactsCallCount() int {
+	fake.deleteStaleArtifactsMutex.RLock()
+	defer fake.deleteStaleArtifactsMutex.RUnlock()
+	return len(fake.deleteStaleArtifactsArgsForCall)
+}
+
+func (fake *FakeCleanSpaceActor) DeleteStaleArtifactsArgsForCall(i int) v3action.StaleArtifacts {
+	fake.deleteStaleArtifactsMutex.RLock()
+	defer fake.deleteStaleArtifactsMutex.RUnlock()
+	return fake.deleteStaleArtifactsArgsForCall[i].staleArtifacts
+}
+
+func (fake *FakeCleanSpaceActor) DeleteStaleArtifactsReturns(result1 v3action.Warnings, result2 error) {
+	fake.DeleteStaleArtifactsStub = nil
+	fake.deleteStaleArtifactsReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanSpaceActor) DeleteStaleArtifactsReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.DeleteStaleArtifactsStub = nil
+	if fake.deleteStaleArtifactsReturnsOnCall == nil {
+		fake.deleteStaleArtifactsReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteStaleArtifactsReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanSpaceActor) GetStaleArtifactsBySpace(spaceGUID string, keep int) ([]v3action.StaleArtifacts, v3action.Warnings, error) {
+	fake.getStaleArtifactsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getStaleArtifactsBySpaceReturnsOnCall[len(fake.getStaleArtifactsBySpaceArgsForCall)]
+	fake.getStaleArtifactsBySpaceArgsForCall = append(fake.getStaleArtifactsBySpaceArgsForCall, struct {
+		spaceGUID string
+		keep      int
+	}{spaceGUID, keep})
+	fake.recordInvocation("GetStaleArtifactsBySpace", []interface{}{spaceGUID, keep})
+	fake.getStaleArtifactsBySpaceMutex.Unlock()
+	if fake.GetStaleArtifactsBySpaceStub != nil {
+		return fake.GetStaleArtifactsBySpaceStub(spaceGUID, keep)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStaleArtifactsBySpaceReturns.result1, fake.getStaleArtifactsBySpaceReturns.result2, fake.getStaleArtifactsBySpaceReturns.result3
+}
+
+func (fake *FakeCleanSpaceActor) GetStaleArtifactsBySpaceCallCount() int {
+	fake.getStaleArtifactsBySpaceMutex.RLock()
+	defer fake.getStaleArtifactsBySpaceMutex.RUnlock()
+	return len(fake.getStaleArtifactsBySpaceArgsForCall)
+}
+
+func (fake *FakeCleanSpaceActor) GetStaleArtifactsBySpaceArgsForCall(i int) (string, int) {
+	fake.getStaleArtifactsBySpaceMutex.RLock()
+	defer fake.getStaleArtifactsBySpaceMutex.RUnlock()
+	return fake.getStaleArtifactsBySpaceArgsForCall[i].spaceGUID, fake.getStaleArtifactsBySpaceArgsForCall[i].keep
+}
+
+func (fake *FakeCleanSpaceActor) GetStaleArtifactsBySpaceReturns(result1 []v3action.StaleArtifacts, result2 v3action.Warnings, result3 error) {
+	fake.GetStaleArtifactsBySpaceStub = nil
+	fake.getStaleArtifactsBySpaceReturns = struct {
+		result1 []v3action.StaleArtifacts
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanSpaceActor) GetStaleArtifactsBySpaceReturnsOnCall(i int, result1 []v3action.StaleArtifacts, result2 v3action.Warnings, result3 error) {
+	fake.GetStaleArtifactsBySpaceStub = nil
+	if fake.getStaleArtifactsBySpaceReturnsOnCall == nil {
+		fake.getStaleArtifactsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v3action.StaleArtifacts
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getStaleArtifactsBySpaceReturnsOnCall[i] = struct {
+		result1 []v3action.StaleArtifacts
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanSpaceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.deleteStaleArtifactsMutex.RLock()
+	defer fake.deleteStaleArtifactsMutex.RUnlock()
+	fake.getStaleArtifactsBySpaceMutex.RLock()
+	defer fake.getStaleArtifactsBySpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCleanSpaceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.CleanSpaceActor = new(FakeCleanSpaceActor)
@@ -0,0 +1,229 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeContinueDeploymentActor struct {
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ContinueDeploymentByApplicationNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Deployment, v3action.Warnings, error)
+	continueDeploymentByApplicationNameAndSpaceMutex       sync.RWMutex
+	continueDeploymentByApplicationNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	continueDeploymentByApplicationNameAndSpaceReturns struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	continueDeploymentByApplicationNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	PollDeploymentStub        func(deployment v3action.Deployment) (v3action.Deployment, v3action.Warnings, error)
+	pollDeploymentMutex       sync.RWMutex
+	pollDeploymentArgsForCall []struct {
+		deployment v3action.Deployment
+	}
+	pollDeploymentReturns struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	pollDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeContinueDeploymentActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeContinueDeploymentActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeContinueDeploymentActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeContinueDeploymentActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeContinueDeploymentActor) ContinueDeploymentByApplicationNameAndSpace(appName string, spaceGUID string) (v3action.Deployment, v3action.Warnings, error) {
+	fake.continueDeploymentByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentByApplicationNameAndSpaceReturnsOnCall[len(fake.continueDeploymentByApplicationNameAndSpaceArgsForCall)]
+	fake.continueDeploymentByApplicationNameAndSpaceArgsForCall = append(fake.continueDeploymentByApplicationNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("ContinueDeploymentByApplicationNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.continueDeploymentByApplicationNameAndSpaceMutex.Unlock()
+	if fake.ContinueDeploymentByApplicationNameAndSpaceStub != nil {
+		return fake.ContinueDeploymentByApplicationNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.continueDeploymentByApplicationNameAndSpaceReturns.result1, fake.continueDeploymentByApplicationNameAndSpaceReturns.result2, fake.continueDeploymentByApplicationNameAndSpaceReturns.result3
+}
+
+func (fake *FakeContinueDeploymentActor) ContinueDeploymentByApplicationNameAndSpaceCallCount() int {
+	fake.continueDeploymentByApplicationNameAndSpaceMutex.RLock()
+	defer fake.continueDeploymentByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.continueDeploymentByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeContinueDeploymentActor) ContinueDeploymentByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.continueDeploymentByApplicationNameAndSpaceMutex.RLock()
+	defer fake.continueDeploymentByApplicationNameAndSpaceMutex.RUnlock()
+	return fake.continueDeploymentByApplicationNameAndSpaceArgsForCall[i].appName, fake.continueDeploymentByApplicationNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeContinueDeploymentActor) ContinueDeploymentByApplicationNameAndSpaceReturns(result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.ContinueDeploymentByApplicationNameAndSpaceStub = nil
+	fake.continueDeploymentByApplicationNameAndSpaceReturns = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContinueDeploymentActor) ContinueDeploymentByApplicationNameAndSpaceReturnsOnCall(i int, result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.ContinueDeploymentByApplicationNameAndSpaceStub = nil
+	if fake.continueDeploymentByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.continueDeploymentByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Deployment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.continueDeploymentByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContinueDeploymentActor) PollDeployment(deployment v3action.Deployment) (v3action.Deployment, v3action.Warnings, error) {
+	fake.pollDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentReturnsOnCall[len(fake.pollDeploymentArgsForCall)]
+	fake.pollDeploymentArgsForCall = append(fake.pollDeploymentArgsForCall, struct {
+		deployment v3action.Deployment
+	}{deployment})
+	fake.recordInvocation("PollDeployment", []interface{}{deployment})
+	fake.pollDeploymentMutex.Unlock()
+	if fake.PollDeploymentStub != nil {
+		return fake.PollDeploymentStub(deployment)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.pollDeploymentReturns.result1, fake.pollDeploymentReturns.result2, fake.pollDeploymentReturns.result3
+}
+
+func (fake *FakeContinueDeploymentActor) PollDeploymentCallCount() int {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return len(fake.pollDeploymentArgsForCall)
+}
+
+func (fake *FakeContinueDeploymentActor) PollDeploymentArgsForCall(i int) v3action.Deployment {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return fake.pollDeploymentArgsForCall[i].deployment
+}
+
+func (fake *FakeContinueDeploymentActor) PollDeploymentReturns(result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.PollDeploymentStub = nil
+	fake.pollDeploymentReturns = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContinueDeploymentActor) PollDeploymentReturnsOnCall(i int, result1 v3action.Deployment, result2 v3action.Warnings, result3 error) {
+	fake.PollDeploymentStub = nil
+	if fake.pollDeploymentReturnsOnCall == nil {
+		fake.pollDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Deployment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.pollDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Deployment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContinueDeploymentActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.continueDeploymentByApplicationNameAndSpaceMutex.RLock()
+	defer fake.continueDeploymentByApplicationNameAndSpaceMutex.RUnlock()
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeContinueDeploymentActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.ContinueDeploymentActor = new(FakeContinueDeploymentActor)
@@ -0,0 +1,231 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeRevisionActor struct {
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetApplicationRevisionByVersionStub        func(appName string, spaceGUID string, version int) (v3action.Revision, v3action.Warnings, error)
+	getApplicationRevisionByVersionMutex       sync.RWMutex
+	getApplicationRevisionByVersionArgsForCall []struct {
+		appName   string
+		spaceGUID string
+		version   int
+	}
+	getApplicationRevisionByVersionReturns struct {
+		result1 v3action.Revision
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationRevisionByVersionReturnsOnCall map[int]struct {
+		result1 v3action.Revision
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetRevisionEnvironmentVariablesStub        func(revision v3action.Revision) (map[string]string, v3action.Warnings, error)
+	getRevisionEnvironmentVariablesMutex       sync.RWMutex
+	getRevisionEnvironmentVariablesArgsForCall []struct {
+		revision v3action.Revision
+	}
+	getRevisionEnvironmentVariablesReturns struct {
+		result1 map[string]string
+		result2 v3action.Warnings
+		result3 error
+	}
+	getRevisionEnvironmentVariablesReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRevisionActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeRevisionActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeRevisionActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRevisionActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRevisionActor) GetApplicationRevisionByVersion(appName string, spaceGUID string, version int) (v3action.Revision, v3action.Warnings, error) {
+	fake.getApplicationRevisionByVersionMutex.Lock()
+	ret, specificReturn := fake.getApplicationRevisionByVersionReturnsOnCall[len(fake.getApplicationRevisionByVersionArgsForCall)]
+	fake.getApplicationRevisionByVersionArgsForCall = append(fake.getApplicationRevisionByVersionArgsForCall, struct {
+		appName   string
+		spaceGUID string
+		version   int
+	}{appName, spaceGUID, version})
+	fake.recordInvocation("GetApplicationRevisionByVersion", []interface{}{appName, spaceGUID, version})
+	fake.getApplicationRevisionByVersionMutex.Unlock()
+	if fake.GetApplicationRevisionByVersionStub != nil {
+		return fake.GetApplicationRevisionByVersionStub(appName, spaceGUID, version)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationRevisionByVersionReturns.result1, fake.getApplicationRevisionByVersionReturns.result2, fake.getApplicationRevisionByVersionReturns.result3
+}
+
+func (fake *FakeRevisionActor) GetApplicationRevisionByVersionCallCount() int {
+	fake.getApplicationRevisionByVersionMutex.RLock()
+	defer fake.getApplicationRevisionByVersionMutex.RUnlock()
+	return len(fake.getApplicationRevisionByVersionArgsForCall)
+}
+
+func (fake *FakeRevisionActor) GetApplicationRevisionByVersionArgsForCall(i int) (string, string, int) {
+	fake.getApplicationRevisionByVersionMutex.RLock()
+	defer fake.getApplicationRevisionByVersionMutex.RUnlock()
+	return fake.getApplicationRevisionByVersionArgsForCall[i].appName, fake.getApplicationRevisionByVersionArgsForCall[i].spaceGUID, fake.getApplicationRevisionByVersionArgsForCall[i].version
+}
+
+func (fake *FakeRevisionActor) GetApplicationRevisionByVersionReturns(result1 v3action.Revision, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationRevisionByVersionStub = nil
+	fake.getApplicationRevisionByVersionReturns = struct {
+		result1 v3action.Revision
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRevisionActor) GetApplicationRevisionByVersionReturnsOnCall(i int, result1 v3action.Revision, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationRevisionByVersionStub = nil
+	if fake.getApplicationRevisionByVersionReturnsOnCall == nil {
+		fake.getApplicationRevisionByVersionReturnsOnCall = make(map[int]struct {
+			result1 v3action.Revision
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRevisionByVersionReturnsOnCall[i] = struct {
+		result1 v3action.Revision
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRevisionActor) GetRevisionEnvironmentVariables(revision v3action.Revision) (map[string]string, v3action.Warnings, error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	ret, specificReturn := fake.getRevisionEnvironmentVariablesReturnsOnCall[len(fake.getRevisionEnvironmentVariablesArgsForCall)]
+	fake.getRevisionEnvironmentVariablesArgsForCall = append(fake.getRevisionEnvironmentVariablesArgsForCall, struct {
+		revision v3action.Revision
+	}{revision})
+	fake.recordInvocation("GetRevisionEnvironmentVariables", []interface{}{revision})
+	fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	if fake.GetRevisionEnvironmentVariablesStub != nil {
+		return fake.GetRevisionEnvironmentVariablesStub(revision)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRevisionEnvironmentVariablesReturns.result1, fake.getRevisionEnvironmentVariablesReturns.result2, fake.getRevisionEnvironmentVariablesReturns.result3
+}
+
+func (fake *FakeRevisionActor) GetRevisionEnvironmentVariablesCallCount() int {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	return len(fake.getRevisionEnvironmentVariablesArgsForCall)
+}
+
+func (fake *FakeRevisionActor) GetRevisionEnvironmentVariablesArgsForCall(i int) v3action.Revision {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	return fake.getRevisionEnvironmentVariablesArgsForCall[i].revision
+}
+
+func (fake *FakeRevisionActor) GetRevisionEnvironmentVariablesReturns(result1 map[string]string, result2 v3action.Warnings, result3 error) {
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	fake.getRevisionEnvironmentVariablesReturns = struct {
+		result1 map[string]string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRevisionActor) GetRevisionEnvironmentVariablesReturnsOnCall(i int, result1 map[string]string, result2 v3action.Warnings, result3 error) {
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	if fake.getRevisionEnvironmentVariablesReturnsOnCall == nil {
+		fake.getRevisionEnvironmentVariablesReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getRevisionEnvironmentVariablesReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRevisionActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.getApplicationRevisionByVersionMutex.RLock()
+	defer fake.getApplicationRevisionByVersionMutex.RUnlock()
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRevisionActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.RevisionActor = new(FakeRevisionActor)
@@ -0,0 +1,158 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeTaskRunnerActor struct {
+	RunTaskStub        func(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+	runTaskMutex       sync.RWMutex
+	runTaskArgsForCall []struct {
+		appGUID string
+		task    v3action.Task
+	}
+	runTaskReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	runTaskReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTaskRunnerActor) RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error) {
+	fake.runTaskMutex.Lock()
+	ret, specificReturn := fake.runTaskReturnsOnCall[len(fake.runTaskArgsForCall)]
+	fake.runTaskArgsForCall = append(fake.runTaskArgsForCall, struct {
+		appGUID string
+		task    v3action.Task
+	}{appGUID, task})
+	fake.recordInvocation("RunTask", []interface{}{appGUID, task})
+	fake.runTaskMutex.Unlock()
+	if fake.RunTaskStub != nil {
+		return fake.RunTaskStub(appGUID, task)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.runTaskReturns.result1, fake.runTaskReturns.result2, fake.runTaskReturns.result3
+}
+
+func (fake *FakeTaskRunnerActor) RunTaskCallCount() int {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return len(fake.runTaskArgsForCall)
+}
+
+func (fake *FakeTaskRunnerActor) RunTaskArgsForCall(i int) (string, v3action.Task) {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return fake.runTaskArgsForCall[i].appGUID, fake.runTaskArgsForCall[i].task
+}
+
+func (fake *FakeTaskRunnerActor) RunTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskStub = nil
+	fake.runTaskReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskRunnerActor) RunTaskReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskStub = nil
+	if fake.runTaskReturnsOnCall == nil {
+		fake.runTaskReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.runTaskReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskRunnerActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeTaskRunnerActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeTaskRunnerActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeTaskRunnerActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeTaskRunnerActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeTaskRunnerActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.TaskRunnerActor = new(FakeTaskRunnerActor)
@@ -0,0 +1,371 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeUpgradeServiceActor struct {
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetServiceInstanceByNameAndSpaceStub        func(name string, spaceGUID string) (v3action.ServiceInstance, v3action.Warnings, error)
+	getServiceInstanceByNameAndSpaceMutex       sync.RWMutex
+	getServiceInstanceByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getServiceInstanceByNameAndSpaceReturns struct {
+		result1 v3action.ServiceInstance
+		result2 v3action.Warnings
+		result3 error
+	}
+	getServiceInstanceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.ServiceInstance
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetServiceInstancesBySpaceStub        func(spaceGUID string) ([]v3action.ServiceInstance, v3action.Warnings, error)
+	getServiceInstancesBySpaceMutex       sync.RWMutex
+	getServiceInstancesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getServiceInstancesBySpaceReturns struct {
+		result1 []v3action.ServiceInstance
+		result2 v3action.Warnings
+		result3 error
+	}
+	getServiceInstancesBySpaceReturnsOnCall map[int]struct {
+		result1 []v3action.ServiceInstance
+		result2 v3action.Warnings
+		result3 error
+	}
+	PollJobStub        func(job v3action.Job) (v3action.Job, v3action.Warnings, error)
+	pollJobMutex       sync.RWMutex
+	pollJobArgsForCall []struct {
+		job v3action.Job
+	}
+	pollJobReturns struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}
+	pollJobReturnsOnCall map[int]struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}
+	UpgradeServiceInstanceStub        func(serviceInstance v3action.ServiceInstance) (v3action.Job, v3action.Warnings, error)
+	upgradeServiceInstanceMutex       sync.RWMutex
+	upgradeServiceInstanceArgsForCall []struct {
+		serviceInstance v3action.ServiceInstance
+	}
+	upgradeServiceInstanceReturns struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}
+	upgradeServiceInstanceReturnsOnCall map[int]struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUpgradeServiceActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeUpgradeServiceActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeUpgradeServiceActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpgradeServiceActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v3action.ServiceInstance, v3action.Warnings, error) {
+	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceByNameAndSpaceArgsForCall)]
+	fake.getServiceInstanceByNameAndSpaceArgsForCall = append(fake.getServiceInstanceByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetServiceInstanceByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getServiceInstanceByNameAndSpaceMutex.Unlock()
+	if fake.GetServiceInstanceByNameAndSpaceStub != nil {
+		return fake.GetServiceInstanceByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceByNameAndSpaceReturns.result1, fake.getServiceInstanceByNameAndSpaceReturns.result2, fake.getServiceInstanceByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstanceByNameAndSpaceCallCount() int {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return len(fake.getServiceInstanceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstanceByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return fake.getServiceInstanceByNameAndSpaceArgsForCall[i].name, fake.getServiceInstanceByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstanceByNameAndSpaceReturns(result1 v3action.ServiceInstance, result2 v3action.Warnings, result3 error) {
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	fake.getServiceInstanceByNameAndSpaceReturns = struct {
+		result1 v3action.ServiceInstance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstanceByNameAndSpaceReturnsOnCall(i int, result1 v3action.ServiceInstance, result2 v3action.Warnings, result3 error) {
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	if fake.getServiceInstanceByNameAndSpaceReturnsOnCall == nil {
+		fake.getServiceInstanceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.ServiceInstance
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.ServiceInstance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstancesBySpace(spaceGUID string) ([]v3action.ServiceInstance, v3action.Warnings, error) {
+	fake.getServiceInstancesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesBySpaceReturnsOnCall[len(fake.getServiceInstancesBySpaceArgsForCall)]
+	fake.getServiceInstancesBySpaceArgsForCall = append(fake.getServiceInstancesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetServiceInstancesBySpace", []interface{}{spaceGUID})
+	fake.getServiceInstancesBySpaceMutex.Unlock()
+	if fake.GetServiceInstancesBySpaceStub != nil {
+		return fake.GetServiceInstancesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstancesBySpaceReturns.result1, fake.getServiceInstancesBySpaceReturns.result2, fake.getServiceInstancesBySpaceReturns.result3
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstancesBySpaceCallCount() int {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return len(fake.getServiceInstancesBySpaceArgsForCall)
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstancesBySpaceArgsForCall(i int) string {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return fake.getServiceInstancesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstancesBySpaceReturns(result1 []v3action.ServiceInstance, result2 v3action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	fake.getServiceInstancesBySpaceReturns = struct {
+		result1 []v3action.ServiceInstance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradeServiceActor) GetServiceInstancesBySpaceReturnsOnCall(i int, result1 []v3action.ServiceInstance, result2 v3action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	if fake.getServiceInstancesBySpaceReturnsOnCall == nil {
+		fake.getServiceInstancesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v3action.ServiceInstance
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesBySpaceReturnsOnCall[i] = struct {
+		result1 []v3action.ServiceInstance
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradeServiceActor) PollJob(job v3action.Job) (v3action.Job, v3action.Warnings, error) {
+	fake.pollJobMutex.Lock()
+	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
+	fake.pollJobArgsForCall = append(fake.pollJobArgsForCall, struct {
+		job v3action.Job
+	}{job})
+	fake.recordInvocation("PollJob", []interface{}{job})
+	fake.pollJobMutex.Unlock()
+	if fake.PollJobStub != nil {
+		return fake.PollJobStub(job)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.pollJobReturns.result1, fake.pollJobReturns.result2, fake.pollJobReturns.result3
+}
+
+func (fake *FakeUpgradeServiceActor) PollJobCallCount() int {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return len(fake.pollJobArgsForCall)
+}
+
+func (fake *FakeUpgradeServiceActor) PollJobArgsForCall(i int) v3action.Job {
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	return fake.pollJobArgsForCall[i].job
+}
+
+func (fake *FakeUpgradeServiceActor) PollJobReturns(result1 v3action.Job, result2 v3action.Warnings, result3 error) {
+	fake.PollJobStub = nil
+	fake.pollJobReturns = struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradeServiceActor) PollJobReturnsOnCall(i int, result1 v3action.Job, result2 v3action.Warnings, result3 error) {
+	fake.PollJobStub = nil
+	if fake.pollJobReturnsOnCall == nil {
+		fake.pollJobReturnsOnCall = make(map[int]struct {
+			result1 v3action.Job
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.pollJobReturnsOnCall[i] = struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradeServiceActor) UpgradeServiceInstance(serviceInstance v3action.ServiceInstance) (v3action.Job, v3action.Warnings, error) {
+	fake.upgradeServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.upgradeServiceInstanceReturnsOnCall[len(fake.upgradeServiceInstanceArgsForCall)]
+	fake.upgradeServiceInstanceArgsForCall = append(fake.upgradeServiceInstanceArgsForCall, struct {
+		serviceInstance v3action.ServiceInstance
+	}{serviceInstance})
+	fake.recordInvocation("UpgradeServiceInstance", []interface{}{serviceInstance})
+	fake.upgradeServiceInstanceMutex.Unlock()
+	if fake.UpgradeServiceInstanceStub != nil {
+		return fake.UpgradeServiceInstanceStub(serviceInstance)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.upgradeServiceInstanceReturns.result1, fake.upgradeServiceInstanceReturns.result2, fake.upgradeServiceInstanceReturns.result3
+}
+
+func (fake *FakeUpgradeServiceActor) UpgradeServiceInstanceCallCount() int {
+	fake.upgradeServiceInstanceMutex.RLock()
+	defer fake.upgradeServiceInstanceMutex.RUnlock()
+	return len(fake.upgradeServiceInstanceArgsForCall)
+}
+
+func (fake *FakeUpgradeServiceActor) UpgradeServiceInstanceArgsForCall(i int) v3action.ServiceInstance {
+	fake.upgradeServiceInstanceMutex.RLock()
+	defer fake.upgradeServiceInstanceMutex.RUnlock()
+	return fake.upgradeServiceInstanceArgsForCall[i].serviceInstance
+}
+
+func (fake *FakeUpgradeServiceActor) UpgradeServiceInstanceReturns(result1 v3action.Job, result2 v3action.Warnings, result3 error) {
+	fake.UpgradeServiceInstanceStub = nil
+	fake.upgradeServiceInstanceReturns = struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradeServiceActor) UpgradeServiceInstanceReturnsOnCall(i int, result1 v3action.Job, result2 v3action.Warnings, result3 error) {
+	fake.UpgradeServiceInstanceStub = nil
+	if fake.upgradeServiceInstanceReturnsOnCall == nil {
+		fake.upgradeServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Job
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.upgradeServiceInstanceReturnsOnCall[i] = struct {
+		result1 v3action.Job
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradeServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	fake.pollJobMutex.RLock()
+	defer fake.pollJobMutex.RUnlock()
+	fake.upgradeServiceInstanceMutex.RLock()
+	defer fake.upgradeServiceInstanceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeUpgradeServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.UpgradeServiceActor = new(FakeUpgradeServiceActor)
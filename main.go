@@ -1,18 +1,27 @@
 package main
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/exitcode"
 	"code.cloudfoundry.org/cli/util/panichandler"
+	"code.cloudfoundry.org/cli/util/tracing"
 	"code.cloudfoundry.org/cli/util/ui"
 	log "github.com/Sirupsen/logrus"
 	"github.com/jessevdk/go-flags"
@@ -25,6 +34,17 @@ type UI interface {
 var ErrFailed = errors.New("command failed")
 var ParseErr = errors.New("incorrect type for arg")
 
+// ErrFailedWithCode is returned instead of ErrFailed when the command failed
+// with an exit code other than 1.
+type ErrFailedWithCode int
+
+func (ErrFailedWithCode) Error() string {
+	return ErrFailed.Error()
+}
+
+// startTime is when the process started, used to time flag parsing.
+var startTime = time.Now()
+
 func main() {
 	defer panichandler.HandlePanic()
 	parse(os.Args[1:])
@@ -111,6 +131,8 @@ func parse(args []string) {
 		}
 	} else if err == ErrFailed {
 		os.Exit(1)
+	} else if code, ok := err.(ErrFailedWithCode); ok {
+		os.Exit(int(code))
 	} else if err == ParseErr {
 		fmt.Println()
 		parse([]string{"help", args[0]})
@@ -135,6 +157,8 @@ func isOption(s string) bool {
 }
 
 func executionWrapper(cmd flags.Commander, args []string) error {
+	flagsParsedAt := time.Now()
+
 	cfConfig, err := configv3.LoadConfig(configv3.FlagOverride{
 		Verbose: common.Commands.VerboseOrVersion,
 	})
@@ -149,7 +173,33 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		}
 	}()
 
+	var exporters tracing.Exporters
+	if endpoint := cfConfig.OTLPEndpoint(); endpoint != "" {
+		exporters = append(exporters, tracing.NewOTLPExporter(endpoint, "cf", cfConfig.BinaryVersion()))
+	}
+	if cfConfig.MetricsFile() != "" || cfConfig.MetricsPushgatewayURL() != "" {
+		exporters = append(exporters, command.NewMetricsEmitter(cfConfig.MetricsFile(), cfConfig.MetricsPushgatewayURL()))
+	}
+	if cfConfig.TelemetryEnabled() {
+		telemetryReporter := command.NewTelemetryReporter(cfConfig.TelemetryEndpoint(), configv3.TelemetryQueueFilePath(), cfConfig.BinaryVersion())
+		telemetryReporter.Start()
+		exporters = append(exporters, telemetryReporter)
+	}
+	if common.Commands.Timings {
+		exporters = append(exporters, command.NewTimingsCollector(os.Stderr))
+	}
+	if len(exporters) > 0 {
+		tracing.Enable(exporters)
+		tracing.RecordSpan("parse flags", startTime, flagsParsedAt)
+		defer flushTraces()
+	}
+
 	if extendedCmd, ok := cmd.(command.ExtendedCommander); ok {
+		name := commandName(cmd)
+		span := tracing.StartSpan("cf " + name)
+		span.SetAttribute("cf.command", name)
+		defer span.End()
+
 		commandUI, err := ui.NewUI(cfConfig)
 		if err != nil {
 			return err
@@ -158,16 +208,87 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
 
+		if parityLog := cfConfig.ParityLogFile(); parityLog != "" {
+			if command.UseRefactoredCommand(cfConfig, name) && command.IsParityCommand(name) {
+				var output bytes.Buffer
+				commandUI.Out = io.MultiWriter(commandUI.Out, &output)
+				commandUI.Err = io.MultiWriter(commandUI.Err, &output)
+				defer logParity(parityLog, &output)
+			}
+		}
+
 		err = extendedCmd.Setup(cfConfig, commandUI)
 		if err != nil {
 			return handleError(err, commandUI)
 		}
-		return handleError(extendedCmd.Execute(args), commandUI)
+
+		if dir, dirErr := os.Getwd(); dirErr == nil {
+			command.WarnIfAutoTargetMismatch(cfConfig, commandUI, name, dir)
+		}
+
+		err = extendedCmd.Execute(args)
+		span.SetError(err)
+		if err != nil {
+			span.SetAttribute("cf.error_class", command.ErrorClass(err))
+		}
+		if err == nil {
+			command.AskForTelemetryConsent(cfConfig, commandUI, name)
+		}
+		// Offline commands must not contact the plugin repositories.
+		if _, isUpdatePlugins := cmd.(*plugin.UpdatePluginsCommand); err == nil && !isUpdatePlugins && !command.IsOfflineCommand(name) {
+			shared.DisplayPluginUpdateNotice(cfConfig, commandUI)
+		}
+		return handleError(err, commandUI)
 	}
 
 	return fmt.Errorf("command does not conform to ExtendedCommander")
 }
 
+// flushTraces exports the recorded spans. Failing to export does not fail the
+// command.
+func flushTraces() {
+	err := tracing.Flush()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error exporting traces: %s\n", err.Error())
+	}
+}
+
+// commandName returns the name of the provided command as registered in
+// common.Commands.
+func commandName(cmd flags.Commander) string {
+	commands := reflect.ValueOf(&common.Commands).Elem()
+	for i := 0; i < commands.NumField(); i++ {
+		field := commands.Field(i)
+		if field.CanAddr() && field.Addr().CanInterface() && field.Addr().Interface() == cmd {
+			return commands.Type().Field(i).Tag.Get("command")
+		}
+	}
+	return ""
+}
+
+// logParity runs the legacy implementation of the current command in a child
+// process and appends the differences between its output and the output of the
+// refactored implementation to the parity log. Since the command is run
+// twice, only the read-only commands with a legacy implementation are logged.
+func logParity(parityLog string, refactoredOutput *bytes.Buffer) {
+	legacyCmd := exec.Command(os.Args[0], os.Args[1:]...)
+	legacyCmd.Env = command.LegacyParityEnv(os.Environ())
+	legacyOutput, _ := legacyCmd.CombinedOutput()
+
+	logFile, err := os.OpenFile(parityLog, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing parity log: %s\n", err.Error())
+		return
+	}
+	defer logFile.Close()
+
+	commandLine := strings.Join(append([]string{filepath.Base(os.Args[0])}, os.Args[1:]...), " ")
+	err = command.WriteParityDiff(logFile, commandLine, string(legacyOutput), refactoredOutput.String())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing parity log: %s\n", err.Error())
+	}
+}
+
 func handleError(err error, commandUI UI) error {
 	if err == nil {
 		return nil
@@ -183,6 +304,9 @@ func handleError(err error, commandUI UI) error {
 	if _, isThreeRequiredArgumentsError := err.(command.ThreeRequiredArgumentsError); isThreeRequiredArgumentsError {
 		return ParseErr
 	}
+	if code := exitcode.Of(err); code != exitcode.Failed {
+		return ErrFailedWithCode(code)
+	}
 
 	return ErrFailed
 }
@@ -381,3 +381,43 @@ func (c *cliConnection) GetService(serviceInstance string) (plugin_models.GetSer
 
 	return result, err
 }
+
+func (c *cliConnection) GetAppsV1(request plugin_models.GetAppsV1Request) (plugin_models.GetAppsV1Response, error) {
+	var result plugin_models.GetAppsV1Response
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetAppsV1", request, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetServicesV1(request plugin_models.GetServicesV1Request) (plugin_models.GetServicesV1Response, error) {
+	var result plugin_models.GetServicesV1Response
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetServicesV1", request, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) RunTaskV1(request plugin_models.RunTaskV1Request) (plugin_models.RunTaskV1Response, error) {
+	var result plugin_models.RunTaskV1Response
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.RunTaskV1", request, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetConfigV1() (plugin_models.GetConfigV1Response, error) {
+	var result plugin_models.GetConfigV1Response
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetConfigV1", "", &result)
+	})
+
+	return result, err
+}
@@ -0,0 +1,22 @@
+package plugin_models
+
+// GetAppsV1Request is version 1 of the request sent by GetAppsV1. When
+// SpaceGuid is empty, the apps in the targeted space are returned.
+type GetAppsV1Request struct {
+	SpaceGuid string
+}
+
+// GetAppsV1Response is version 1 of the response returned by GetAppsV1.
+type GetAppsV1Response struct {
+	Apps     []GetAppsV1App
+	Warnings []string
+}
+
+type GetAppsV1App struct {
+	Guid      string
+	Name      string
+	State     string
+	Instances int
+	Memory    int
+	DiskQuota int
+}
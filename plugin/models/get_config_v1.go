@@ -0,0 +1,38 @@
+package plugin_models
+
+// GetConfigV1Response is version 1 of the read-only view of the CLI
+// configuration returned by GetConfigV1. Credentials are never exposed:
+// AccessToken and RefreshToken are replaced with RedactedValue when set and
+// are empty otherwise.
+type GetConfigV1Response struct {
+	ApiEndpoint           string
+	ApiVersion            string
+	AuthorizationEndpoint string
+	DopplerEndpoint       string
+	UaaEndpoint           string
+	RoutingApiEndpoint    string
+	MinCliVersion         string
+	IsSSLDisabled         bool
+	IsLoggedIn            bool
+	Username              string
+	UserGuid              string
+	UserEmail             string
+	Organization          GetConfigV1Organization
+	Space                 GetConfigV1Space
+	AccessToken           string
+	RefreshToken          string
+}
+
+type GetConfigV1Organization struct {
+	Guid string
+	Name string
+}
+
+type GetConfigV1Space struct {
+	Guid     string
+	Name     string
+	AllowSSH bool
+}
+
+// RedactedValue replaces credentials in GetConfigV1Response.
+const RedactedValue = "[PRIVATE DATA HIDDEN]"
@@ -0,0 +1,21 @@
+package plugin_models
+
+// GetServicesV1Request is version 1 of the request sent by GetServicesV1.
+// When SpaceGuid is empty, the service instances in the targeted space are
+// returned.
+type GetServicesV1Request struct {
+	SpaceGuid string
+}
+
+// GetServicesV1Response is version 1 of the response returned by
+// GetServicesV1.
+type GetServicesV1Response struct {
+	Services []GetServicesV1ServiceInstance
+	Warnings []string
+}
+
+type GetServicesV1ServiceInstance struct {
+	Guid           string
+	Name           string
+	IsUserProvided bool
+}
@@ -0,0 +1,25 @@
+package plugin_models
+
+// RunTaskV1Request is version 1 of the request sent by RunTaskV1. Name,
+// MemoryInMB and DiskInMB are optional.
+type RunTaskV1Request struct {
+	AppGuid    string
+	Command    string
+	Name       string
+	MemoryInMB uint64
+	DiskInMB   uint64
+}
+
+// RunTaskV1Response is version 1 of the response returned by RunTaskV1.
+type RunTaskV1Response struct {
+	Task     RunTaskV1Task
+	Warnings []string
+}
+
+type RunTaskV1Task struct {
+	Guid       string
+	Name       string
+	SequenceId int
+	State      string
+	Command    string
+}
@@ -42,6 +42,10 @@ type CliConnection interface {
 	GetService(string) (plugin_models.GetService_Model, error)
 	GetOrg(string) (plugin_models.GetOrg_Model, error)
 	GetSpace(string) (plugin_models.GetSpace_Model, error)
+	GetAppsV1(plugin_models.GetAppsV1Request) (plugin_models.GetAppsV1Response, error)
+	GetServicesV1(plugin_models.GetServicesV1Request) (plugin_models.GetServicesV1Response, error)
+	RunTaskV1(plugin_models.RunTaskV1Request) (plugin_models.RunTaskV1Response, error)
+	GetConfigV1() (plugin_models.GetConfigV1Response, error)
 }
 
 type VersionType struct {
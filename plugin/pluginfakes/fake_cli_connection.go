@@ -18,6 +18,10 @@ type FakeCliConnection struct {
 		result1 []string
 		result2 error
 	}
+	cliCommandWithoutTerminalOutputReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	CliCommandStub        func(args ...string) ([]string, error)
 	cliCommandMutex       sync.RWMutex
 	cliCommandArgsForCall []struct {
@@ -27,6 +31,10 @@ type FakeCliConnection struct {
 		result1 []string
 		result2 error
 	}
+	cliCommandReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	GetCurrentOrgStub        func() (plugin_models.Organization, error)
 	getCurrentOrgMutex       sync.RWMutex
 	getCurrentOrgArgsForCall []struct{}
@@ -34,6 +42,10 @@ type FakeCliConnection struct {
 		result1 plugin_models.Organization
 		result2 error
 	}
+	getCurrentOrgReturnsOnCall map[int]struct {
+		result1 plugin_models.Organization
+		result2 error
+	}
 	GetCurrentSpaceStub        func() (plugin_models.Space, error)
 	getCurrentSpaceMutex       sync.RWMutex
 	getCurrentSpaceArgsForCall []struct{}
@@ -41,6 +53,10 @@ type FakeCliConnection struct {
 		result1 plugin_models.Space
 		result2 error
 	}
+	getCurrentSpaceReturnsOnCall map[int]struct {
+		result1 plugin_models.Space
+		result2 error
+	}
 	UsernameStub        func() (string, error)
 	usernameMutex       sync.RWMutex
 	usernameArgsForCall []struct{}
@@ -48,6 +64,10 @@ type FakeCliConnection struct {
 		result1 string
 		result2 error
 	}
+	usernameReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	UserGuidStub        func() (string, error)
 	userGuidMutex       sync.RWMutex
 	userGuidArgsForCall []struct{}
@@ -55,6 +75,10 @@ type FakeCliConnection struct {
 		result1 string
 		result2 error
 	}
+	userGuidReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	UserEmailStub        func() (string, error)
 	userEmailMutex       sync.RWMutex
 	userEmailArgsForCall []struct{}
@@ -62,6 +86,10 @@ type FakeCliConnection struct {
 		result1 string
 		result2 error
 	}
+	userEmailReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	IsLoggedInStub        func() (bool, error)
 	isLoggedInMutex       sync.RWMutex
 	isLoggedInArgsForCall []struct{}
@@ -69,6 +97,10 @@ type FakeCliConnection struct {
 		result1 bool
 		result2 error
 	}
+	isLoggedInReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	IsSSLDisabledStub        func() (bool, error)
 	isSSLDisabledMutex       sync.RWMutex
 	isSSLDisabledArgsForCall []struct{}
@@ -76,6 +108,10 @@ type FakeCliConnection struct {
 		result1 bool
 		result2 error
 	}
+	isSSLDisabledReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	HasOrganizationStub        func() (bool, error)
 	hasOrganizationMutex       sync.RWMutex
 	hasOrganizationArgsForCall []struct{}
@@ -83,6 +119,10 @@ type FakeCliConnection struct {
 		result1 bool
 		result2 error
 	}
+	hasOrganizationReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	HasSpaceStub        func() (bool, error)
 	hasSpaceMutex       sync.RWMutex
 	hasSpaceArgsForCall []struct{}
@@ -90,6 +130,10 @@ type FakeCliConnection struct {
 		result1 bool
 		result2 error
 	}
+	hasSpaceReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ApiEndpointStub        func() (string, error)
 	apiEndpointMutex       sync.RWMutex
 	apiEndpointArgsForCall []struct{}
@@ -97,6 +141,10 @@ type FakeCliConnection struct {
 		result1 string
 		result2 error
 	}
+	apiEndpointReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	ApiVersionStub        func() (string, error)
 	apiVersionMutex       sync.RWMutex
 	apiVersionArgsForCall []struct{}
@@ -104,6 +152,10 @@ type FakeCliConnection struct {
 		result1 string
 		result2 error
 	}
+	apiVersionReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	HasAPIEndpointStub        func() (bool, error)
 	hasAPIEndpointMutex       sync.RWMutex
 	hasAPIEndpointArgsForCall []struct{}
@@ -111,6 +163,10 @@ type FakeCliConnection struct {
 		result1 bool
 		result2 error
 	}
+	hasAPIEndpointReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	LoggregatorEndpointStub        func() (string, error)
 	loggregatorEndpointMutex       sync.RWMutex
 	loggregatorEndpointArgsForCall []struct{}
@@ -118,6 +174,10 @@ type FakeCliConnection struct {
 		result1 string
 		result2 error
 	}
+	loggregatorEndpointReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	DopplerEndpointStub        func() (string, error)
 	dopplerEndpointMutex       sync.RWMutex
 	dopplerEndpointArgsForCall []struct{}
@@ -125,6 +185,10 @@ type FakeCliConnection struct {
 		result1 string
 		result2 error
 	}
+	dopplerEndpointReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	AccessTokenStub        func() (string, error)
 	accessTokenMutex       sync.RWMutex
 	accessTokenArgsForCall []struct{}
@@ -132,6 +196,10 @@ type FakeCliConnection struct {
 		result1 string
 		result2 error
 	}
+	accessTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetAppStub        func(string) (plugin_models.GetAppModel, error)
 	getAppMutex       sync.RWMutex
 	getAppArgsForCall []struct {
@@ -141,6 +209,10 @@ type FakeCliConnection struct {
 		result1 plugin_models.GetAppModel
 		result2 error
 	}
+	getAppReturnsOnCall map[int]struct {
+		result1 plugin_models.GetAppModel
+		result2 error
+	}
 	GetAppsStub        func() ([]plugin_models.GetAppsModel, error)
 	getAppsMutex       sync.RWMutex
 	getAppsArgsForCall []struct{}
@@ -148,6 +220,10 @@ type FakeCliConnection struct {
 		result1 []plugin_models.GetAppsModel
 		result2 error
 	}
+	getAppsReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetAppsModel
+		result2 error
+	}
 	GetOrgsStub        func() ([]plugin_models.GetOrgs_Model, error)
 	getOrgsMutex       sync.RWMutex
 	getOrgsArgsForCall []struct{}
@@ -155,6 +231,10 @@ type FakeCliConnection struct {
 		result1 []plugin_models.GetOrgs_Model
 		result2 error
 	}
+	getOrgsReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetOrgs_Model
+		result2 error
+	}
 	GetSpacesStub        func() ([]plugin_models.GetSpaces_Model, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct{}
@@ -162,6 +242,10 @@ type FakeCliConnection struct {
 		result1 []plugin_models.GetSpaces_Model
 		result2 error
 	}
+	getSpacesReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetSpaces_Model
+		result2 error
+	}
 	GetOrgUsersStub        func(string, ...string) ([]plugin_models.GetOrgUsers_Model, error)
 	getOrgUsersMutex       sync.RWMutex
 	getOrgUsersArgsForCall []struct {
@@ -172,6 +256,10 @@ type FakeCliConnection struct {
 		result1 []plugin_models.GetOrgUsers_Model
 		result2 error
 	}
+	getOrgUsersReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetOrgUsers_Model
+		result2 error
+	}
 	GetSpaceUsersStub        func(string, string) ([]plugin_models.GetSpaceUsers_Model, error)
 	getSpaceUsersMutex       sync.RWMutex
 	getSpaceUsersArgsForCall []struct {
@@ -182,6 +270,10 @@ type FakeCliConnection struct {
 		result1 []plugin_models.GetSpaceUsers_Model
 		result2 error
 	}
+	getSpaceUsersReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetSpaceUsers_Model
+		result2 error
+	}
 	GetServicesStub        func() ([]plugin_models.GetServices_Model, error)
 	getServicesMutex       sync.RWMutex
 	getServicesArgsForCall []struct{}
@@ -189,6 +281,10 @@ type FakeCliConnection struct {
 		result1 []plugin_models.GetServices_Model
 		result2 error
 	}
+	getServicesReturnsOnCall map[int]struct {
+		result1 []plugin_models.GetServices_Model
+		result2 error
+	}
 	GetServiceStub        func(string) (plugin_models.GetService_Model, error)
 	getServiceMutex       sync.RWMutex
 	getServiceArgsForCall []struct {
@@ -198,6 +294,10 @@ type FakeCliConnection struct {
 		result1 plugin_models.GetService_Model
 		result2 error
 	}
+	getServiceReturnsOnCall map[int]struct {
+		result1 plugin_models.GetService_Model
+		result2 error
+	}
 	GetOrgStub        func(string) (plugin_models.GetOrg_Model, error)
 	getOrgMutex       sync.RWMutex
 	getOrgArgsForCall []struct {
@@ -207,6 +307,10 @@ type FakeCliConnection struct {
 		result1 plugin_models.GetOrg_Model
 		result2 error
 	}
+	getOrgReturnsOnCall map[int]struct {
+		result1 plugin_models.GetOrg_Model
+		result2 error
+	}
 	GetSpaceStub        func(string) (plugin_models.GetSpace_Model, error)
 	getSpaceMutex       sync.RWMutex
 	getSpaceArgsForCall []struct {
@@ -216,12 +320,67 @@ type FakeCliConnection struct {
 		result1 plugin_models.GetSpace_Model
 		result2 error
 	}
+	getSpaceReturnsOnCall map[int]struct {
+		result1 plugin_models.GetSpace_Model
+		result2 error
+	}
+	GetAppsV1Stub        func(plugin_models.GetAppsV1Request) (plugin_models.GetAppsV1Response, error)
+	getAppsV1Mutex       sync.RWMutex
+	getAppsV1ArgsForCall []struct {
+		arg1 plugin_models.GetAppsV1Request
+	}
+	getAppsV1Returns struct {
+		result1 plugin_models.GetAppsV1Response
+		result2 error
+	}
+	getAppsV1ReturnsOnCall map[int]struct {
+		result1 plugin_models.GetAppsV1Response
+		result2 error
+	}
+	GetServicesV1Stub        func(plugin_models.GetServicesV1Request) (plugin_models.GetServicesV1Response, error)
+	getServicesV1Mutex       sync.RWMutex
+	getServicesV1ArgsForCall []struct {
+		arg1 plugin_models.GetServicesV1Request
+	}
+	getServicesV1Returns struct {
+		result1 plugin_models.GetServicesV1Response
+		result2 error
+	}
+	getServicesV1ReturnsOnCall map[int]struct {
+		result1 plugin_models.GetServicesV1Response
+		result2 error
+	}
+	RunTaskV1Stub        func(plugin_models.RunTaskV1Request) (plugin_models.RunTaskV1Response, error)
+	runTaskV1Mutex       sync.RWMutex
+	runTaskV1ArgsForCall []struct {
+		arg1 plugin_models.RunTaskV1Request
+	}
+	runTaskV1Returns struct {
+		result1 plugin_models.RunTaskV1Response
+		result2 error
+	}
+	runTaskV1ReturnsOnCall map[int]struct {
+		result1 plugin_models.RunTaskV1Response
+		result2 error
+	}
+	GetConfigV1Stub        func() (plugin_models.GetConfigV1Response, error)
+	getConfigV1Mutex       sync.RWMutex
+	getConfigV1ArgsForCall []struct{}
+	getConfigV1Returns     struct {
+		result1 plugin_models.GetConfigV1Response
+		result2 error
+	}
+	getConfigV1ReturnsOnCall map[int]struct {
+		result1 plugin_models.GetConfigV1Response
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakeCliConnection) CliCommandWithoutTerminalOutput(args ...string) ([]string, error) {
 	fake.cliCommandWithoutTerminalOutputMutex.Lock()
+	ret, specificReturn := fake.cliCommandWithoutTerminalOutputReturnsOnCall[len(fake.cliCommandWithoutTerminalOutputArgsForCall)]
 	fake.cliCommandWithoutTerminalOutputArgsForCall = append(fake.cliCommandWithoutTerminalOutputArgsForCall, struct {
 		args []string
 	}{args})
@@ -229,9 +388,11 @@ func (fake *FakeCliConnection) CliCommandWithoutTerminalOutput(args ...string) (
 	fake.cliCommandWithoutTerminalOutputMutex.Unlock()
 	if fake.CliCommandWithoutTerminalOutputStub != nil {
 		return fake.CliCommandWithoutTerminalOutputStub(args...)
-	} else {
-		return fake.cliCommandWithoutTerminalOutputReturns.result1, fake.cliCommandWithoutTerminalOutputReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cliCommandWithoutTerminalOutputReturns.result1, fake.cliCommandWithoutTerminalOutputReturns.result2
 }
 
 func (fake *FakeCliConnection) CliCommandWithoutTerminalOutputCallCount() int {
@@ -254,8 +415,23 @@ func (fake *FakeCliConnection) CliCommandWithoutTerminalOutputReturns(result1 []
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) CliCommandWithoutTerminalOutputReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.CliCommandWithoutTerminalOutputStub = nil
+	if fake.cliCommandWithoutTerminalOutputReturnsOnCall == nil {
+		fake.cliCommandWithoutTerminalOutputReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.cliCommandWithoutTerminalOutputReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) CliCommand(args ...string) ([]string, error) {
 	fake.cliCommandMutex.Lock()
+	ret, specificReturn := fake.cliCommandReturnsOnCall[len(fake.cliCommandArgsForCall)]
 	fake.cliCommandArgsForCall = append(fake.cliCommandArgsForCall, struct {
 		args []string
 	}{args})
@@ -263,9 +439,11 @@ func (fake *FakeCliConnection) CliCommand(args ...string) ([]string, error) {
 	fake.cliCommandMutex.Unlock()
 	if fake.CliCommandStub != nil {
 		return fake.CliCommandStub(args...)
-	} else {
-		return fake.cliCommandReturns.result1, fake.cliCommandReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cliCommandReturns.result1, fake.cliCommandReturns.result2
 }
 
 func (fake *FakeCliConnection) CliCommandCallCount() int {
@@ -288,16 +466,33 @@ func (fake *FakeCliConnection) CliCommandReturns(result1 []string, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) CliCommandReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.CliCommandStub = nil
+	if fake.cliCommandReturnsOnCall == nil {
+		fake.cliCommandReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.cliCommandReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetCurrentOrg() (plugin_models.Organization, error) {
 	fake.getCurrentOrgMutex.Lock()
+	ret, specificReturn := fake.getCurrentOrgReturnsOnCall[len(fake.getCurrentOrgArgsForCall)]
 	fake.getCurrentOrgArgsForCall = append(fake.getCurrentOrgArgsForCall, struct{}{})
 	fake.recordInvocation("GetCurrentOrg", []interface{}{})
 	fake.getCurrentOrgMutex.Unlock()
 	if fake.GetCurrentOrgStub != nil {
 		return fake.GetCurrentOrgStub()
-	} else {
-		return fake.getCurrentOrgReturns.result1, fake.getCurrentOrgReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getCurrentOrgReturns.result1, fake.getCurrentOrgReturns.result2
 }
 
 func (fake *FakeCliConnection) GetCurrentOrgCallCount() int {
@@ -314,16 +509,33 @@ func (fake *FakeCliConnection) GetCurrentOrgReturns(result1 plugin_models.Organi
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetCurrentOrgReturnsOnCall(i int, result1 plugin_models.Organization, result2 error) {
+	fake.GetCurrentOrgStub = nil
+	if fake.getCurrentOrgReturnsOnCall == nil {
+		fake.getCurrentOrgReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.Organization
+			result2 error
+		})
+	}
+	fake.getCurrentOrgReturnsOnCall[i] = struct {
+		result1 plugin_models.Organization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetCurrentSpace() (plugin_models.Space, error) {
 	fake.getCurrentSpaceMutex.Lock()
+	ret, specificReturn := fake.getCurrentSpaceReturnsOnCall[len(fake.getCurrentSpaceArgsForCall)]
 	fake.getCurrentSpaceArgsForCall = append(fake.getCurrentSpaceArgsForCall, struct{}{})
 	fake.recordInvocation("GetCurrentSpace", []interface{}{})
 	fake.getCurrentSpaceMutex.Unlock()
 	if fake.GetCurrentSpaceStub != nil {
 		return fake.GetCurrentSpaceStub()
-	} else {
-		return fake.getCurrentSpaceReturns.result1, fake.getCurrentSpaceReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getCurrentSpaceReturns.result1, fake.getCurrentSpaceReturns.result2
 }
 
 func (fake *FakeCliConnection) GetCurrentSpaceCallCount() int {
@@ -340,16 +552,33 @@ func (fake *FakeCliConnection) GetCurrentSpaceReturns(result1 plugin_models.Spac
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetCurrentSpaceReturnsOnCall(i int, result1 plugin_models.Space, result2 error) {
+	fake.GetCurrentSpaceStub = nil
+	if fake.getCurrentSpaceReturnsOnCall == nil {
+		fake.getCurrentSpaceReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.Space
+			result2 error
+		})
+	}
+	fake.getCurrentSpaceReturnsOnCall[i] = struct {
+		result1 plugin_models.Space
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) Username() (string, error) {
 	fake.usernameMutex.Lock()
+	ret, specificReturn := fake.usernameReturnsOnCall[len(fake.usernameArgsForCall)]
 	fake.usernameArgsForCall = append(fake.usernameArgsForCall, struct{}{})
 	fake.recordInvocation("Username", []interface{}{})
 	fake.usernameMutex.Unlock()
 	if fake.UsernameStub != nil {
 		return fake.UsernameStub()
-	} else {
-		return fake.usernameReturns.result1, fake.usernameReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.usernameReturns.result1, fake.usernameReturns.result2
 }
 
 func (fake *FakeCliConnection) UsernameCallCount() int {
@@ -366,16 +595,33 @@ func (fake *FakeCliConnection) UsernameReturns(result1 string, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) UsernameReturnsOnCall(i int, result1 string, result2 error) {
+	fake.UsernameStub = nil
+	if fake.usernameReturnsOnCall == nil {
+		fake.usernameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.usernameReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) UserGuid() (string, error) {
 	fake.userGuidMutex.Lock()
+	ret, specificReturn := fake.userGuidReturnsOnCall[len(fake.userGuidArgsForCall)]
 	fake.userGuidArgsForCall = append(fake.userGuidArgsForCall, struct{}{})
 	fake.recordInvocation("UserGuid", []interface{}{})
 	fake.userGuidMutex.Unlock()
 	if fake.UserGuidStub != nil {
 		return fake.UserGuidStub()
-	} else {
-		return fake.userGuidReturns.result1, fake.userGuidReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.userGuidReturns.result1, fake.userGuidReturns.result2
 }
 
 func (fake *FakeCliConnection) UserGuidCallCount() int {
@@ -392,16 +638,33 @@ func (fake *FakeCliConnection) UserGuidReturns(result1 string, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) UserGuidReturnsOnCall(i int, result1 string, result2 error) {
+	fake.UserGuidStub = nil
+	if fake.userGuidReturnsOnCall == nil {
+		fake.userGuidReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.userGuidReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) UserEmail() (string, error) {
 	fake.userEmailMutex.Lock()
+	ret, specificReturn := fake.userEmailReturnsOnCall[len(fake.userEmailArgsForCall)]
 	fake.userEmailArgsForCall = append(fake.userEmailArgsForCall, struct{}{})
 	fake.recordInvocation("UserEmail", []interface{}{})
 	fake.userEmailMutex.Unlock()
 	if fake.UserEmailStub != nil {
 		return fake.UserEmailStub()
-	} else {
-		return fake.userEmailReturns.result1, fake.userEmailReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.userEmailReturns.result1, fake.userEmailReturns.result2
 }
 
 func (fake *FakeCliConnection) UserEmailCallCount() int {
@@ -418,16 +681,33 @@ func (fake *FakeCliConnection) UserEmailReturns(result1 string, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) UserEmailReturnsOnCall(i int, result1 string, result2 error) {
+	fake.UserEmailStub = nil
+	if fake.userEmailReturnsOnCall == nil {
+		fake.userEmailReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.userEmailReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) IsLoggedIn() (bool, error) {
 	fake.isLoggedInMutex.Lock()
+	ret, specificReturn := fake.isLoggedInReturnsOnCall[len(fake.isLoggedInArgsForCall)]
 	fake.isLoggedInArgsForCall = append(fake.isLoggedInArgsForCall, struct{}{})
 	fake.recordInvocation("IsLoggedIn", []interface{}{})
 	fake.isLoggedInMutex.Unlock()
 	if fake.IsLoggedInStub != nil {
 		return fake.IsLoggedInStub()
-	} else {
-		return fake.isLoggedInReturns.result1, fake.isLoggedInReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.isLoggedInReturns.result1, fake.isLoggedInReturns.result2
 }
 
 func (fake *FakeCliConnection) IsLoggedInCallCount() int {
@@ -444,16 +724,33 @@ func (fake *FakeCliConnection) IsLoggedInReturns(result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) IsLoggedInReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.IsLoggedInStub = nil
+	if fake.isLoggedInReturnsOnCall == nil {
+		fake.isLoggedInReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isLoggedInReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) IsSSLDisabled() (bool, error) {
 	fake.isSSLDisabledMutex.Lock()
+	ret, specificReturn := fake.isSSLDisabledReturnsOnCall[len(fake.isSSLDisabledArgsForCall)]
 	fake.isSSLDisabledArgsForCall = append(fake.isSSLDisabledArgsForCall, struct{}{})
 	fake.recordInvocation("IsSSLDisabled", []interface{}{})
 	fake.isSSLDisabledMutex.Unlock()
 	if fake.IsSSLDisabledStub != nil {
 		return fake.IsSSLDisabledStub()
-	} else {
-		return fake.isSSLDisabledReturns.result1, fake.isSSLDisabledReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.isSSLDisabledReturns.result1, fake.isSSLDisabledReturns.result2
 }
 
 func (fake *FakeCliConnection) IsSSLDisabledCallCount() int {
@@ -470,16 +767,33 @@ func (fake *FakeCliConnection) IsSSLDisabledReturns(result1 bool, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) IsSSLDisabledReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.IsSSLDisabledStub = nil
+	if fake.isSSLDisabledReturnsOnCall == nil {
+		fake.isSSLDisabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isSSLDisabledReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) HasOrganization() (bool, error) {
 	fake.hasOrganizationMutex.Lock()
+	ret, specificReturn := fake.hasOrganizationReturnsOnCall[len(fake.hasOrganizationArgsForCall)]
 	fake.hasOrganizationArgsForCall = append(fake.hasOrganizationArgsForCall, struct{}{})
 	fake.recordInvocation("HasOrganization", []interface{}{})
 	fake.hasOrganizationMutex.Unlock()
 	if fake.HasOrganizationStub != nil {
 		return fake.HasOrganizationStub()
-	} else {
-		return fake.hasOrganizationReturns.result1, fake.hasOrganizationReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.hasOrganizationReturns.result1, fake.hasOrganizationReturns.result2
 }
 
 func (fake *FakeCliConnection) HasOrganizationCallCount() int {
@@ -496,16 +810,33 @@ func (fake *FakeCliConnection) HasOrganizationReturns(result1 bool, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) HasOrganizationReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.HasOrganizationStub = nil
+	if fake.hasOrganizationReturnsOnCall == nil {
+		fake.hasOrganizationReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasOrganizationReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) HasSpace() (bool, error) {
 	fake.hasSpaceMutex.Lock()
+	ret, specificReturn := fake.hasSpaceReturnsOnCall[len(fake.hasSpaceArgsForCall)]
 	fake.hasSpaceArgsForCall = append(fake.hasSpaceArgsForCall, struct{}{})
 	fake.recordInvocation("HasSpace", []interface{}{})
 	fake.hasSpaceMutex.Unlock()
 	if fake.HasSpaceStub != nil {
 		return fake.HasSpaceStub()
-	} else {
-		return fake.hasSpaceReturns.result1, fake.hasSpaceReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.hasSpaceReturns.result1, fake.hasSpaceReturns.result2
 }
 
 func (fake *FakeCliConnection) HasSpaceCallCount() int {
@@ -522,16 +853,33 @@ func (fake *FakeCliConnection) HasSpaceReturns(result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) HasSpaceReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.HasSpaceStub = nil
+	if fake.hasSpaceReturnsOnCall == nil {
+		fake.hasSpaceReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasSpaceReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) ApiEndpoint() (string, error) {
 	fake.apiEndpointMutex.Lock()
+	ret, specificReturn := fake.apiEndpointReturnsOnCall[len(fake.apiEndpointArgsForCall)]
 	fake.apiEndpointArgsForCall = append(fake.apiEndpointArgsForCall, struct{}{})
 	fake.recordInvocation("ApiEndpoint", []interface{}{})
 	fake.apiEndpointMutex.Unlock()
 	if fake.ApiEndpointStub != nil {
 		return fake.ApiEndpointStub()
-	} else {
-		return fake.apiEndpointReturns.result1, fake.apiEndpointReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.apiEndpointReturns.result1, fake.apiEndpointReturns.result2
 }
 
 func (fake *FakeCliConnection) ApiEndpointCallCount() int {
@@ -548,16 +896,33 @@ func (fake *FakeCliConnection) ApiEndpointReturns(result1 string, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) ApiEndpointReturnsOnCall(i int, result1 string, result2 error) {
+	fake.ApiEndpointStub = nil
+	if fake.apiEndpointReturnsOnCall == nil {
+		fake.apiEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.apiEndpointReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) ApiVersion() (string, error) {
 	fake.apiVersionMutex.Lock()
+	ret, specificReturn := fake.apiVersionReturnsOnCall[len(fake.apiVersionArgsForCall)]
 	fake.apiVersionArgsForCall = append(fake.apiVersionArgsForCall, struct{}{})
 	fake.recordInvocation("ApiVersion", []interface{}{})
 	fake.apiVersionMutex.Unlock()
 	if fake.ApiVersionStub != nil {
 		return fake.ApiVersionStub()
-	} else {
-		return fake.apiVersionReturns.result1, fake.apiVersionReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.apiVersionReturns.result1, fake.apiVersionReturns.result2
 }
 
 func (fake *FakeCliConnection) ApiVersionCallCount() int {
@@ -574,16 +939,33 @@ func (fake *FakeCliConnection) ApiVersionReturns(result1 string, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) ApiVersionReturnsOnCall(i int, result1 string, result2 error) {
+	fake.ApiVersionStub = nil
+	if fake.apiVersionReturnsOnCall == nil {
+		fake.apiVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.apiVersionReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) HasAPIEndpoint() (bool, error) {
 	fake.hasAPIEndpointMutex.Lock()
+	ret, specificReturn := fake.hasAPIEndpointReturnsOnCall[len(fake.hasAPIEndpointArgsForCall)]
 	fake.hasAPIEndpointArgsForCall = append(fake.hasAPIEndpointArgsForCall, struct{}{})
 	fake.recordInvocation("HasAPIEndpoint", []interface{}{})
 	fake.hasAPIEndpointMutex.Unlock()
 	if fake.HasAPIEndpointStub != nil {
 		return fake.HasAPIEndpointStub()
-	} else {
-		return fake.hasAPIEndpointReturns.result1, fake.hasAPIEndpointReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.hasAPIEndpointReturns.result1, fake.hasAPIEndpointReturns.result2
 }
 
 func (fake *FakeCliConnection) HasAPIEndpointCallCount() int {
@@ -600,16 +982,33 @@ func (fake *FakeCliConnection) HasAPIEndpointReturns(result1 bool, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) HasAPIEndpointReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.HasAPIEndpointStub = nil
+	if fake.hasAPIEndpointReturnsOnCall == nil {
+		fake.hasAPIEndpointReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasAPIEndpointReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) LoggregatorEndpoint() (string, error) {
 	fake.loggregatorEndpointMutex.Lock()
+	ret, specificReturn := fake.loggregatorEndpointReturnsOnCall[len(fake.loggregatorEndpointArgsForCall)]
 	fake.loggregatorEndpointArgsForCall = append(fake.loggregatorEndpointArgsForCall, struct{}{})
 	fake.recordInvocation("LoggregatorEndpoint", []interface{}{})
 	fake.loggregatorEndpointMutex.Unlock()
 	if fake.LoggregatorEndpointStub != nil {
 		return fake.LoggregatorEndpointStub()
-	} else {
-		return fake.loggregatorEndpointReturns.result1, fake.loggregatorEndpointReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.loggregatorEndpointReturns.result1, fake.loggregatorEndpointReturns.result2
 }
 
 func (fake *FakeCliConnection) LoggregatorEndpointCallCount() int {
@@ -626,16 +1025,33 @@ func (fake *FakeCliConnection) LoggregatorEndpointReturns(result1 string, result
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) LoggregatorEndpointReturnsOnCall(i int, result1 string, result2 error) {
+	fake.LoggregatorEndpointStub = nil
+	if fake.loggregatorEndpointReturnsOnCall == nil {
+		fake.loggregatorEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.loggregatorEndpointReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) DopplerEndpoint() (string, error) {
 	fake.dopplerEndpointMutex.Lock()
+	ret, specificReturn := fake.dopplerEndpointReturnsOnCall[len(fake.dopplerEndpointArgsForCall)]
 	fake.dopplerEndpointArgsForCall = append(fake.dopplerEndpointArgsForCall, struct{}{})
 	fake.recordInvocation("DopplerEndpoint", []interface{}{})
 	fake.dopplerEndpointMutex.Unlock()
 	if fake.DopplerEndpointStub != nil {
 		return fake.DopplerEndpointStub()
-	} else {
-		return fake.dopplerEndpointReturns.result1, fake.dopplerEndpointReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.dopplerEndpointReturns.result1, fake.dopplerEndpointReturns.result2
 }
 
 func (fake *FakeCliConnection) DopplerEndpointCallCount() int {
@@ -652,16 +1068,33 @@ func (fake *FakeCliConnection) DopplerEndpointReturns(result1 string, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) DopplerEndpointReturnsOnCall(i int, result1 string, result2 error) {
+	fake.DopplerEndpointStub = nil
+	if fake.dopplerEndpointReturnsOnCall == nil {
+		fake.dopplerEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.dopplerEndpointReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) AccessToken() (string, error) {
 	fake.accessTokenMutex.Lock()
+	ret, specificReturn := fake.accessTokenReturnsOnCall[len(fake.accessTokenArgsForCall)]
 	fake.accessTokenArgsForCall = append(fake.accessTokenArgsForCall, struct{}{})
 	fake.recordInvocation("AccessToken", []interface{}{})
 	fake.accessTokenMutex.Unlock()
 	if fake.AccessTokenStub != nil {
 		return fake.AccessTokenStub()
-	} else {
-		return fake.accessTokenReturns.result1, fake.accessTokenReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.accessTokenReturns.result1, fake.accessTokenReturns.result2
 }
 
 func (fake *FakeCliConnection) AccessTokenCallCount() int {
@@ -678,8 +1111,23 @@ func (fake *FakeCliConnection) AccessTokenReturns(result1 string, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) AccessTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.AccessTokenStub = nil
+	if fake.accessTokenReturnsOnCall == nil {
+		fake.accessTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.accessTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetApp(arg1 string) (plugin_models.GetAppModel, error) {
 	fake.getAppMutex.Lock()
+	ret, specificReturn := fake.getAppReturnsOnCall[len(fake.getAppArgsForCall)]
 	fake.getAppArgsForCall = append(fake.getAppArgsForCall, struct {
 		arg1 string
 	}{arg1})
@@ -687,9 +1135,11 @@ func (fake *FakeCliConnection) GetApp(arg1 string) (plugin_models.GetAppModel, e
 	fake.getAppMutex.Unlock()
 	if fake.GetAppStub != nil {
 		return fake.GetAppStub(arg1)
-	} else {
-		return fake.getAppReturns.result1, fake.getAppReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAppReturns.result1, fake.getAppReturns.result2
 }
 
 func (fake *FakeCliConnection) GetAppCallCount() int {
@@ -712,16 +1162,33 @@ func (fake *FakeCliConnection) GetAppReturns(result1 plugin_models.GetAppModel,
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetAppReturnsOnCall(i int, result1 plugin_models.GetAppModel, result2 error) {
+	fake.GetAppStub = nil
+	if fake.getAppReturnsOnCall == nil {
+		fake.getAppReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetAppModel
+			result2 error
+		})
+	}
+	fake.getAppReturnsOnCall[i] = struct {
+		result1 plugin_models.GetAppModel
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetApps() ([]plugin_models.GetAppsModel, error) {
 	fake.getAppsMutex.Lock()
+	ret, specificReturn := fake.getAppsReturnsOnCall[len(fake.getAppsArgsForCall)]
 	fake.getAppsArgsForCall = append(fake.getAppsArgsForCall, struct{}{})
 	fake.recordInvocation("GetApps", []interface{}{})
 	fake.getAppsMutex.Unlock()
 	if fake.GetAppsStub != nil {
 		return fake.GetAppsStub()
-	} else {
-		return fake.getAppsReturns.result1, fake.getAppsReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAppsReturns.result1, fake.getAppsReturns.result2
 }
 
 func (fake *FakeCliConnection) GetAppsCallCount() int {
@@ -738,16 +1205,33 @@ func (fake *FakeCliConnection) GetAppsReturns(result1 []plugin_models.GetAppsMod
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetAppsReturnsOnCall(i int, result1 []plugin_models.GetAppsModel, result2 error) {
+	fake.GetAppsStub = nil
+	if fake.getAppsReturnsOnCall == nil {
+		fake.getAppsReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetAppsModel
+			result2 error
+		})
+	}
+	fake.getAppsReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetAppsModel
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetOrgs() ([]plugin_models.GetOrgs_Model, error) {
 	fake.getOrgsMutex.Lock()
+	ret, specificReturn := fake.getOrgsReturnsOnCall[len(fake.getOrgsArgsForCall)]
 	fake.getOrgsArgsForCall = append(fake.getOrgsArgsForCall, struct{}{})
 	fake.recordInvocation("GetOrgs", []interface{}{})
 	fake.getOrgsMutex.Unlock()
 	if fake.GetOrgsStub != nil {
 		return fake.GetOrgsStub()
-	} else {
-		return fake.getOrgsReturns.result1, fake.getOrgsReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrgsReturns.result1, fake.getOrgsReturns.result2
 }
 
 func (fake *FakeCliConnection) GetOrgsCallCount() int {
@@ -764,16 +1248,33 @@ func (fake *FakeCliConnection) GetOrgsReturns(result1 []plugin_models.GetOrgs_Mo
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetOrgsReturnsOnCall(i int, result1 []plugin_models.GetOrgs_Model, result2 error) {
+	fake.GetOrgsStub = nil
+	if fake.getOrgsReturnsOnCall == nil {
+		fake.getOrgsReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetOrgs_Model
+			result2 error
+		})
+	}
+	fake.getOrgsReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetOrgs_Model
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetSpaces() ([]plugin_models.GetSpaces_Model, error) {
 	fake.getSpacesMutex.Lock()
+	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
 	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct{}{})
 	fake.recordInvocation("GetSpaces", []interface{}{})
 	fake.getSpacesMutex.Unlock()
 	if fake.GetSpacesStub != nil {
 		return fake.GetSpacesStub()
-	} else {
-		return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2
 }
 
 func (fake *FakeCliConnection) GetSpacesCallCount() int {
@@ -790,8 +1291,23 @@ func (fake *FakeCliConnection) GetSpacesReturns(result1 []plugin_models.GetSpace
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetSpacesReturnsOnCall(i int, result1 []plugin_models.GetSpaces_Model, result2 error) {
+	fake.GetSpacesStub = nil
+	if fake.getSpacesReturnsOnCall == nil {
+		fake.getSpacesReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetSpaces_Model
+			result2 error
+		})
+	}
+	fake.getSpacesReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetSpaces_Model
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetOrgUsers(arg1 string, arg2 ...string) ([]plugin_models.GetOrgUsers_Model, error) {
 	fake.getOrgUsersMutex.Lock()
+	ret, specificReturn := fake.getOrgUsersReturnsOnCall[len(fake.getOrgUsersArgsForCall)]
 	fake.getOrgUsersArgsForCall = append(fake.getOrgUsersArgsForCall, struct {
 		arg1 string
 		arg2 []string
@@ -800,9 +1316,11 @@ func (fake *FakeCliConnection) GetOrgUsers(arg1 string, arg2 ...string) ([]plugi
 	fake.getOrgUsersMutex.Unlock()
 	if fake.GetOrgUsersStub != nil {
 		return fake.GetOrgUsersStub(arg1, arg2...)
-	} else {
-		return fake.getOrgUsersReturns.result1, fake.getOrgUsersReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrgUsersReturns.result1, fake.getOrgUsersReturns.result2
 }
 
 func (fake *FakeCliConnection) GetOrgUsersCallCount() int {
@@ -825,8 +1343,23 @@ func (fake *FakeCliConnection) GetOrgUsersReturns(result1 []plugin_models.GetOrg
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetOrgUsersReturnsOnCall(i int, result1 []plugin_models.GetOrgUsers_Model, result2 error) {
+	fake.GetOrgUsersStub = nil
+	if fake.getOrgUsersReturnsOnCall == nil {
+		fake.getOrgUsersReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetOrgUsers_Model
+			result2 error
+		})
+	}
+	fake.getOrgUsersReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetOrgUsers_Model
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetSpaceUsers(arg1 string, arg2 string) ([]plugin_models.GetSpaceUsers_Model, error) {
 	fake.getSpaceUsersMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersReturnsOnCall[len(fake.getSpaceUsersArgsForCall)]
 	fake.getSpaceUsersArgsForCall = append(fake.getSpaceUsersArgsForCall, struct {
 		arg1 string
 		arg2 string
@@ -835,9 +1368,11 @@ func (fake *FakeCliConnection) GetSpaceUsers(arg1 string, arg2 string) ([]plugin
 	fake.getSpaceUsersMutex.Unlock()
 	if fake.GetSpaceUsersStub != nil {
 		return fake.GetSpaceUsersStub(arg1, arg2)
-	} else {
-		return fake.getSpaceUsersReturns.result1, fake.getSpaceUsersReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSpaceUsersReturns.result1, fake.getSpaceUsersReturns.result2
 }
 
 func (fake *FakeCliConnection) GetSpaceUsersCallCount() int {
@@ -860,16 +1395,33 @@ func (fake *FakeCliConnection) GetSpaceUsersReturns(result1 []plugin_models.GetS
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetSpaceUsersReturnsOnCall(i int, result1 []plugin_models.GetSpaceUsers_Model, result2 error) {
+	fake.GetSpaceUsersStub = nil
+	if fake.getSpaceUsersReturnsOnCall == nil {
+		fake.getSpaceUsersReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetSpaceUsers_Model
+			result2 error
+		})
+	}
+	fake.getSpaceUsersReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetSpaceUsers_Model
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetServices() ([]plugin_models.GetServices_Model, error) {
 	fake.getServicesMutex.Lock()
+	ret, specificReturn := fake.getServicesReturnsOnCall[len(fake.getServicesArgsForCall)]
 	fake.getServicesArgsForCall = append(fake.getServicesArgsForCall, struct{}{})
 	fake.recordInvocation("GetServices", []interface{}{})
 	fake.getServicesMutex.Unlock()
 	if fake.GetServicesStub != nil {
 		return fake.GetServicesStub()
-	} else {
-		return fake.getServicesReturns.result1, fake.getServicesReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getServicesReturns.result1, fake.getServicesReturns.result2
 }
 
 func (fake *FakeCliConnection) GetServicesCallCount() int {
@@ -886,8 +1438,23 @@ func (fake *FakeCliConnection) GetServicesReturns(result1 []plugin_models.GetSer
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetServicesReturnsOnCall(i int, result1 []plugin_models.GetServices_Model, result2 error) {
+	fake.GetServicesStub = nil
+	if fake.getServicesReturnsOnCall == nil {
+		fake.getServicesReturnsOnCall = make(map[int]struct {
+			result1 []plugin_models.GetServices_Model
+			result2 error
+		})
+	}
+	fake.getServicesReturnsOnCall[i] = struct {
+		result1 []plugin_models.GetServices_Model
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetService(arg1 string) (plugin_models.GetService_Model, error) {
 	fake.getServiceMutex.Lock()
+	ret, specificReturn := fake.getServiceReturnsOnCall[len(fake.getServiceArgsForCall)]
 	fake.getServiceArgsForCall = append(fake.getServiceArgsForCall, struct {
 		arg1 string
 	}{arg1})
@@ -895,9 +1462,11 @@ func (fake *FakeCliConnection) GetService(arg1 string) (plugin_models.GetService
 	fake.getServiceMutex.Unlock()
 	if fake.GetServiceStub != nil {
 		return fake.GetServiceStub(arg1)
-	} else {
-		return fake.getServiceReturns.result1, fake.getServiceReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getServiceReturns.result1, fake.getServiceReturns.result2
 }
 
 func (fake *FakeCliConnection) GetServiceCallCount() int {
@@ -920,8 +1489,23 @@ func (fake *FakeCliConnection) GetServiceReturns(result1 plugin_models.GetServic
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetServiceReturnsOnCall(i int, result1 plugin_models.GetService_Model, result2 error) {
+	fake.GetServiceStub = nil
+	if fake.getServiceReturnsOnCall == nil {
+		fake.getServiceReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetService_Model
+			result2 error
+		})
+	}
+	fake.getServiceReturnsOnCall[i] = struct {
+		result1 plugin_models.GetService_Model
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetOrg(arg1 string) (plugin_models.GetOrg_Model, error) {
 	fake.getOrgMutex.Lock()
+	ret, specificReturn := fake.getOrgReturnsOnCall[len(fake.getOrgArgsForCall)]
 	fake.getOrgArgsForCall = append(fake.getOrgArgsForCall, struct {
 		arg1 string
 	}{arg1})
@@ -929,9 +1513,11 @@ func (fake *FakeCliConnection) GetOrg(arg1 string) (plugin_models.GetOrg_Model,
 	fake.getOrgMutex.Unlock()
 	if fake.GetOrgStub != nil {
 		return fake.GetOrgStub(arg1)
-	} else {
-		return fake.getOrgReturns.result1, fake.getOrgReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrgReturns.result1, fake.getOrgReturns.result2
 }
 
 func (fake *FakeCliConnection) GetOrgCallCount() int {
@@ -954,8 +1540,23 @@ func (fake *FakeCliConnection) GetOrgReturns(result1 plugin_models.GetOrg_Model,
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetOrgReturnsOnCall(i int, result1 plugin_models.GetOrg_Model, result2 error) {
+	fake.GetOrgStub = nil
+	if fake.getOrgReturnsOnCall == nil {
+		fake.getOrgReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetOrg_Model
+			result2 error
+		})
+	}
+	fake.getOrgReturnsOnCall[i] = struct {
+		result1 plugin_models.GetOrg_Model
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) GetSpace(arg1 string) (plugin_models.GetSpace_Model, error) {
 	fake.getSpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceReturnsOnCall[len(fake.getSpaceArgsForCall)]
 	fake.getSpaceArgsForCall = append(fake.getSpaceArgsForCall, struct {
 		arg1 string
 	}{arg1})
@@ -963,9 +1564,11 @@ func (fake *FakeCliConnection) GetSpace(arg1 string) (plugin_models.GetSpace_Mod
 	fake.getSpaceMutex.Unlock()
 	if fake.GetSpaceStub != nil {
 		return fake.GetSpaceStub(arg1)
-	} else {
-		return fake.getSpaceReturns.result1, fake.getSpaceReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSpaceReturns.result1, fake.getSpaceReturns.result2
 }
 
 func (fake *FakeCliConnection) GetSpaceCallCount() int {
@@ -988,6 +1591,216 @@ func (fake *FakeCliConnection) GetSpaceReturns(result1 plugin_models.GetSpace_Mo
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetSpaceReturnsOnCall(i int, result1 plugin_models.GetSpace_Model, result2 error) {
+	fake.GetSpaceStub = nil
+	if fake.getSpaceReturnsOnCall == nil {
+		fake.getSpaceReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetSpace_Model
+			result2 error
+		})
+	}
+	fake.getSpaceReturnsOnCall[i] = struct {
+		result1 plugin_models.GetSpace_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppsV1(arg1 plugin_models.GetAppsV1Request) (plugin_models.GetAppsV1Response, error) {
+	fake.getAppsV1Mutex.Lock()
+	ret, specificReturn := fake.getAppsV1ReturnsOnCall[len(fake.getAppsV1ArgsForCall)]
+	fake.getAppsV1ArgsForCall = append(fake.getAppsV1ArgsForCall, struct {
+		arg1 plugin_models.GetAppsV1Request
+	}{arg1})
+	fake.recordInvocation("GetAppsV1", []interface{}{arg1})
+	fake.getAppsV1Mutex.Unlock()
+	if fake.GetAppsV1Stub != nil {
+		return fake.GetAppsV1Stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAppsV1Returns.result1, fake.getAppsV1Returns.result2
+}
+
+func (fake *FakeCliConnection) GetAppsV1CallCount() int {
+	fake.getAppsV1Mutex.RLock()
+	defer fake.getAppsV1Mutex.RUnlock()
+	return len(fake.getAppsV1ArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetAppsV1ArgsForCall(i int) plugin_models.GetAppsV1Request {
+	fake.getAppsV1Mutex.RLock()
+	defer fake.getAppsV1Mutex.RUnlock()
+	return fake.getAppsV1ArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) GetAppsV1Returns(result1 plugin_models.GetAppsV1Response, result2 error) {
+	fake.GetAppsV1Stub = nil
+	fake.getAppsV1Returns = struct {
+		result1 plugin_models.GetAppsV1Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppsV1ReturnsOnCall(i int, result1 plugin_models.GetAppsV1Response, result2 error) {
+	fake.GetAppsV1Stub = nil
+	if fake.getAppsV1ReturnsOnCall == nil {
+		fake.getAppsV1ReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetAppsV1Response
+			result2 error
+		})
+	}
+	fake.getAppsV1ReturnsOnCall[i] = struct {
+		result1 plugin_models.GetAppsV1Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetServicesV1(arg1 plugin_models.GetServicesV1Request) (plugin_models.GetServicesV1Response, error) {
+	fake.getServicesV1Mutex.Lock()
+	ret, specificReturn := fake.getServicesV1ReturnsOnCall[len(fake.getServicesV1ArgsForCall)]
+	fake.getServicesV1ArgsForCall = append(fake.getServicesV1ArgsForCall, struct {
+		arg1 plugin_models.GetServicesV1Request
+	}{arg1})
+	fake.recordInvocation("GetServicesV1", []interface{}{arg1})
+	fake.getServicesV1Mutex.Unlock()
+	if fake.GetServicesV1Stub != nil {
+		return fake.GetServicesV1Stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getServicesV1Returns.result1, fake.getServicesV1Returns.result2
+}
+
+func (fake *FakeCliConnection) GetServicesV1CallCount() int {
+	fake.getServicesV1Mutex.RLock()
+	defer fake.getServicesV1Mutex.RUnlock()
+	return len(fake.getServicesV1ArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetServicesV1ArgsForCall(i int) plugin_models.GetServicesV1Request {
+	fake.getServicesV1Mutex.RLock()
+	defer fake.getServicesV1Mutex.RUnlock()
+	return fake.getServicesV1ArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) GetServicesV1Returns(result1 plugin_models.GetServicesV1Response, result2 error) {
+	fake.GetServicesV1Stub = nil
+	fake.getServicesV1Returns = struct {
+		result1 plugin_models.GetServicesV1Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetServicesV1ReturnsOnCall(i int, result1 plugin_models.GetServicesV1Response, result2 error) {
+	fake.GetServicesV1Stub = nil
+	if fake.getServicesV1ReturnsOnCall == nil {
+		fake.getServicesV1ReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetServicesV1Response
+			result2 error
+		})
+	}
+	fake.getServicesV1ReturnsOnCall[i] = struct {
+		result1 plugin_models.GetServicesV1Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) RunTaskV1(arg1 plugin_models.RunTaskV1Request) (plugin_models.RunTaskV1Response, error) {
+	fake.runTaskV1Mutex.Lock()
+	ret, specificReturn := fake.runTaskV1ReturnsOnCall[len(fake.runTaskV1ArgsForCall)]
+	fake.runTaskV1ArgsForCall = append(fake.runTaskV1ArgsForCall, struct {
+		arg1 plugin_models.RunTaskV1Request
+	}{arg1})
+	fake.recordInvocation("RunTaskV1", []interface{}{arg1})
+	fake.runTaskV1Mutex.Unlock()
+	if fake.RunTaskV1Stub != nil {
+		return fake.RunTaskV1Stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.runTaskV1Returns.result1, fake.runTaskV1Returns.result2
+}
+
+func (fake *FakeCliConnection) RunTaskV1CallCount() int {
+	fake.runTaskV1Mutex.RLock()
+	defer fake.runTaskV1Mutex.RUnlock()
+	return len(fake.runTaskV1ArgsForCall)
+}
+
+func (fake *FakeCliConnection) RunTaskV1ArgsForCall(i int) plugin_models.RunTaskV1Request {
+	fake.runTaskV1Mutex.RLock()
+	defer fake.runTaskV1Mutex.RUnlock()
+	return fake.runTaskV1ArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) RunTaskV1Returns(result1 plugin_models.RunTaskV1Response, result2 error) {
+	fake.RunTaskV1Stub = nil
+	fake.runTaskV1Returns = struct {
+		result1 plugin_models.RunTaskV1Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) RunTaskV1ReturnsOnCall(i int, result1 plugin_models.RunTaskV1Response, result2 error) {
+	fake.RunTaskV1Stub = nil
+	if fake.runTaskV1ReturnsOnCall == nil {
+		fake.runTaskV1ReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.RunTaskV1Response
+			result2 error
+		})
+	}
+	fake.runTaskV1ReturnsOnCall[i] = struct {
+		result1 plugin_models.RunTaskV1Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetConfigV1() (plugin_models.GetConfigV1Response, error) {
+	fake.getConfigV1Mutex.Lock()
+	ret, specificReturn := fake.getConfigV1ReturnsOnCall[len(fake.getConfigV1ArgsForCall)]
+	fake.getConfigV1ArgsForCall = append(fake.getConfigV1ArgsForCall, struct{}{})
+	fake.recordInvocation("GetConfigV1", []interface{}{})
+	fake.getConfigV1Mutex.Unlock()
+	if fake.GetConfigV1Stub != nil {
+		return fake.GetConfigV1Stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getConfigV1Returns.result1, fake.getConfigV1Returns.result2
+}
+
+func (fake *FakeCliConnection) GetConfigV1CallCount() int {
+	fake.getConfigV1Mutex.RLock()
+	defer fake.getConfigV1Mutex.RUnlock()
+	return len(fake.getConfigV1ArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetConfigV1Returns(result1 plugin_models.GetConfigV1Response, result2 error) {
+	fake.GetConfigV1Stub = nil
+	fake.getConfigV1Returns = struct {
+		result1 plugin_models.GetConfigV1Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetConfigV1ReturnsOnCall(i int, result1 plugin_models.GetConfigV1Response, result2 error) {
+	fake.GetConfigV1Stub = nil
+	if fake.getConfigV1ReturnsOnCall == nil {
+		fake.getConfigV1ReturnsOnCall = make(map[int]struct {
+			result1 plugin_models.GetConfigV1Response
+			result2 error
+		})
+	}
+	fake.getConfigV1ReturnsOnCall[i] = struct {
+		result1 plugin_models.GetConfigV1Response
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1045,6 +1858,14 @@ func (fake *FakeCliConnection) Invocations() map[string][][]interface{} {
 	defer fake.getOrgMutex.RUnlock()
 	fake.getSpaceMutex.RLock()
 	defer fake.getSpaceMutex.RUnlock()
+	fake.getAppsV1Mutex.RLock()
+	defer fake.getAppsV1Mutex.RUnlock()
+	fake.getServicesV1Mutex.RLock()
+	defer fake.getServicesV1Mutex.RUnlock()
+	fake.runTaskV1Mutex.RLock()
+	defer fake.runTaskV1Mutex.RUnlock()
+	fake.getConfigV1Mutex.RLock()
+	defer fake.getConfigV1Mutex.RUnlock()
 	return fake.invocations
 }
 
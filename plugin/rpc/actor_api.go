@@ -0,0 +1,159 @@
+package rpc
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . V2Actor
+
+// V2Actor is the subset of the V2 actor exposed to plugins.
+type V2Actor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . V3Actor
+
+// V3Actor is the subset of the V3 actor exposed to plugins.
+type V3Actor interface {
+	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . ActorFactory
+
+// ActorFactory creates the actors backing the versioned plugin API methods.
+type ActorFactory interface {
+	NewV2Actor() (V2Actor, error)
+	NewV3Actor() (V3Actor, error)
+}
+
+// configActorFactory creates actors from the configuration on disk, the same
+// way the refactored commands do.
+type configActorFactory struct{}
+
+func (configActorFactory) NewV2Actor() (V2Actor, error) {
+	config, commandUI, err := loadActorConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, commandUI, true)
+	if err != nil {
+		return nil, err
+	}
+	return v2action.NewActor(ccClient, uaaClient), nil
+}
+
+func (configActorFactory) NewV3Actor() (V3Actor, error) {
+	config, commandUI, err := loadActorConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	ccClient, err := sharedV3.NewClients(config, commandUI, true)
+	if err != nil {
+		return nil, err
+	}
+	return v3action.NewActor(ccClient, config), nil
+}
+
+func loadActorConfig() (*configv3.Config, *ui.UI, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return config, commandUI, nil
+}
+
+func (cmd *CliRpcCmd) targetedSpaceGUID(spaceGUID string) string {
+	if spaceGUID == "" {
+		return cmd.cliConfig.SpaceFields().GUID
+	}
+	return spaceGUID
+}
+
+func (cmd *CliRpcCmd) GetAppsV1(request plugin_models.GetAppsV1Request, retVal *plugin_models.GetAppsV1Response) error {
+	actor, err := cmd.ActorFactory.NewV2Actor()
+	if err != nil {
+		return err
+	}
+
+	apps, warnings, err := actor.GetApplicationsBySpace(cmd.targetedSpaceGUID(request.SpaceGuid))
+	retVal.Warnings = warnings
+	if err != nil {
+		return err
+	}
+
+	for _, app := range apps {
+		retVal.Apps = append(retVal.Apps, plugin_models.GetAppsV1App{
+			Guid:      app.GUID,
+			Name:      app.Name,
+			State:     string(app.State),
+			Instances: app.Instances,
+			Memory:    app.Memory,
+			DiskQuota: app.DiskQuota,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetServicesV1(request plugin_models.GetServicesV1Request, retVal *plugin_models.GetServicesV1Response) error {
+	actor, err := cmd.ActorFactory.NewV2Actor()
+	if err != nil {
+		return err
+	}
+
+	serviceInstances, warnings, err := actor.GetServiceInstancesBySpace(cmd.targetedSpaceGUID(request.SpaceGuid))
+	retVal.Warnings = warnings
+	if err != nil {
+		return err
+	}
+
+	for _, serviceInstance := range serviceInstances {
+		retVal.Services = append(retVal.Services, plugin_models.GetServicesV1ServiceInstance{
+			Guid:           serviceInstance.GUID,
+			Name:           serviceInstance.Name,
+			IsUserProvided: serviceInstance.Type == ccv2.UserProvidedService,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcCmd) RunTaskV1(request plugin_models.RunTaskV1Request, retVal *plugin_models.RunTaskV1Response) error {
+	actor, err := cmd.ActorFactory.NewV3Actor()
+	if err != nil {
+		return err
+	}
+
+	task, warnings, err := actor.RunTask(request.AppGuid, v3action.Task{
+		Command:    request.Command,
+		Name:       request.Name,
+		MemoryInMB: request.MemoryInMB,
+		DiskInMB:   request.DiskInMB,
+	})
+	retVal.Warnings = warnings
+	if err != nil {
+		return err
+	}
+
+	retVal.Task = plugin_models.RunTaskV1Task{
+		Guid:       task.GUID,
+		Name:       task.Name,
+		SequenceId: task.SequenceID,
+		State:      task.State,
+		Command:    task.Command,
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/plugin/models"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Versioned Plugin API", func() {
+	var (
+		err              error
+		client           *rpc.Client
+		rpcService       *CliRpcService
+		fakeActorFactory *rpcfakes.FakeActorFactory
+		fakeV2Actor      *rpcfakes.FakeV2Actor
+		fakeV3Actor      *rpcfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		fakeV2Actor = new(rpcfakes.FakeV2Actor)
+		fakeV3Actor = new(rpcfakes.FakeV3Actor)
+		fakeActorFactory = new(rpcfakes.FakeActorFactory)
+		fakeActorFactory.NewV2ActorReturns(fakeV2Actor, nil)
+		fakeActorFactory.NewV3ActorReturns(fakeV3Actor, nil)
+
+		rpcService, err = NewRpcService(nil, nil, testconfig.NewRepositoryWithDefaults(), api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+		rpcService.RpcCmd.ActorFactory = fakeActorFactory
+
+		err = rpcService.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		pingCli(rpcService.Port())
+
+		client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		client.Close()
+		rpcService.Stop()
+
+		//give time for server to stop
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	Describe(".GetAppsV1", func() {
+		Context("when the apps can be retrieved", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationsBySpaceReturns(
+					[]v2action.Application{
+						{GUID: "app-guid-1", Name: "app-1", State: ccv2.ApplicationStarted, Instances: 2, Memory: 256, DiskQuota: 1024},
+						{GUID: "app-guid-2", Name: "app-2", State: ccv2.ApplicationStopped},
+					},
+					v2action.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns the apps in the targeted space and all warnings", func() {
+				var result plugin_models.GetAppsV1Response
+				err = client.Call("CliRpcCmd.GetAppsV1", plugin_models.GetAppsV1Request{}, &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal(plugin_models.GetAppsV1Response{
+					Apps: []plugin_models.GetAppsV1App{
+						{Guid: "app-guid-1", Name: "app-1", State: "STARTED", Instances: 2, Memory: 256, DiskQuota: 1024},
+						{Guid: "app-guid-2", Name: "app-2", State: "STOPPED"},
+					},
+					Warnings: []string{"get-apps-warning"},
+				}))
+
+				Expect(fakeV2Actor.GetApplicationsBySpaceCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("my-space-guid"))
+			})
+
+			It("uses the space GUID from the request when provided", func() {
+				var result plugin_models.GetAppsV1Response
+				err = client.Call("CliRpcCmd.GetAppsV1", plugin_models.GetAppsV1Request{SpaceGuid: "some-space-guid"}, &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeV2Actor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the actor cannot be created", func() {
+			BeforeEach(func() {
+				fakeActorFactory.NewV2ActorReturns(nil, errors.New("no api set"))
+			})
+
+			It("returns the error", func() {
+				var result plugin_models.GetAppsV1Response
+				err = client.Call("CliRpcCmd.GetAppsV1", plugin_models.GetAppsV1Request{}, &result)
+				Expect(err).To(MatchError("no api set"))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationsBySpaceReturns(nil, nil, errors.New("get-apps-error"))
+			})
+
+			It("returns the error", func() {
+				var result plugin_models.GetAppsV1Response
+				err = client.Call("CliRpcCmd.GetAppsV1", plugin_models.GetAppsV1Request{}, &result)
+				Expect(err).To(MatchError("get-apps-error"))
+			})
+		})
+	})
+
+	Describe(".GetServicesV1", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetServiceInstancesBySpaceReturns(
+				[]v2action.ServiceInstance{
+					{GUID: "instance-guid-1", Name: "instance-1", Type: ccv2.ManagedService},
+					{GUID: "instance-guid-2", Name: "instance-2", Type: ccv2.UserProvidedService},
+				},
+				v2action.Warnings{"get-instances-warning"},
+				nil,
+			)
+		})
+
+		It("returns the service instances in the targeted space and all warnings", func() {
+			var result plugin_models.GetServicesV1Response
+			err = client.Call("CliRpcCmd.GetServicesV1", plugin_models.GetServicesV1Request{}, &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal(plugin_models.GetServicesV1Response{
+				Services: []plugin_models.GetServicesV1ServiceInstance{
+					{Guid: "instance-guid-1", Name: "instance-1"},
+					{Guid: "instance-guid-2", Name: "instance-2", IsUserProvided: true},
+				},
+				Warnings: []string{"get-instances-warning"},
+			}))
+
+			Expect(fakeV2Actor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal("my-space-guid"))
+		})
+	})
+
+	Describe(".RunTaskV1", func() {
+		Context("when the task can be run", func() {
+			BeforeEach(func() {
+				fakeV3Actor.RunTaskReturns(
+					v3action.Task{GUID: "task-guid", Name: "some-task", SequenceID: 3, State: "RUNNING", Command: "some command"},
+					v3action.Warnings{"run-task-warning"},
+					nil,
+				)
+			})
+
+			It("runs the task and returns it with all warnings", func() {
+				var result plugin_models.RunTaskV1Response
+				err = client.Call("CliRpcCmd.RunTaskV1", plugin_models.RunTaskV1Request{
+					AppGuid:    "some-app-guid",
+					Command:    "some command",
+					Name:       "some-task",
+					MemoryInMB: 128,
+					DiskInMB:   512,
+				}, &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal(plugin_models.RunTaskV1Response{
+					Task: plugin_models.RunTaskV1Task{
+						Guid:       "task-guid",
+						Name:       "some-task",
+						SequenceId: 3,
+						State:      "RUNNING",
+						Command:    "some command",
+					},
+					Warnings: []string{"run-task-warning"},
+				}))
+
+				Expect(fakeV3Actor.RunTaskCallCount()).To(Equal(1))
+				appGUID, task := fakeV3Actor.RunTaskArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(task).To(Equal(v3action.Task{
+					Command:    "some command",
+					Name:       "some-task",
+					MemoryInMB: 128,
+					DiskInMB:   512,
+				}))
+			})
+		})
+
+		Context("when the V3 actor cannot be created", func() {
+			BeforeEach(func() {
+				fakeActorFactory.NewV3ActorReturns(nil, errors.New("v3 not available"))
+			})
+
+			It("returns the error", func() {
+				var result plugin_models.RunTaskV1Response
+				err = client.Call("CliRpcCmd.RunTaskV1", plugin_models.RunTaskV1Request{AppGuid: "some-app-guid"}, &result)
+				Expect(err).To(MatchError("v3 not available"))
+			})
+		})
+	})
+})
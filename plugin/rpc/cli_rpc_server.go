@@ -47,6 +47,10 @@ type CliRpcCmd struct {
 	outputBucket         *bytes.Buffer
 	logger               trace.Printer
 	stdout               io.Writer
+
+	// ActorFactory creates the actors used by the versioned plugin API
+	// methods, such as GetAppsV1.
+	ActorFactory ActorFactory
 }
 
 //go:generate counterfeiter . TerminalOutputSwitch
@@ -84,6 +88,7 @@ func NewRpcService(
 			logger:               logger,
 			outputBucket:         &bytes.Buffer{},
 			stdout:               w,
+			ActorFactory:         configActorFactory{},
 		},
 	}
 
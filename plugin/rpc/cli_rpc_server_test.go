@@ -692,6 +692,43 @@ var _ = Describe("Server", func() {
 				})
 			})
 
+			Context(".GetConfigV1", func() {
+				BeforeEach(func() {
+					config.SetAPIVersion("v1.1.1")
+					config.SetAPIEndpoint("www.fake-domain.com")
+					config.SetDopplerEndpoint("doppler-endpoint-sample")
+					config.SetOrganizationFields(models.OrganizationFields{GUID: "org-guid", Name: "org-name"})
+					config.SetSpaceFields(models.SpaceFields{GUID: "space-guid", Name: "space-name", AllowSSH: true})
+					config.SetAccessToken("bearer some-access-token")
+					config.SetRefreshToken("")
+
+					rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+					err := rpcService.Start()
+					Expect(err).ToNot(HaveOccurred())
+
+					pingCli(rpcService.Port())
+				})
+
+				It("returns the config with the tokens redacted", func() {
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+
+					var result plugin_models.GetConfigV1Response
+					err = client.Call("CliRpcCmd.GetConfigV1", "", &result)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(result.ApiEndpoint).To(Equal("www.fake-domain.com"))
+					Expect(result.ApiVersion).To(Equal("v1.1.1"))
+					Expect(result.DopplerEndpoint).To(Equal("doppler-endpoint-sample"))
+					Expect(result.Organization).To(Equal(plugin_models.GetConfigV1Organization{Guid: "org-guid", Name: "org-name"}))
+					Expect(result.Space).To(Equal(plugin_models.GetConfigV1Space{Guid: "space-guid", Name: "space-name", AllowSSH: true}))
+					Expect(result.Username).To(Equal(config.Username()))
+					Expect(result.IsLoggedIn).To(Equal(config.IsLoggedIn()))
+					Expect(result.AccessToken).To(Equal("[PRIVATE DATA HIDDEN]"))
+					Expect(result.RefreshToken).To(BeEmpty())
+				})
+			})
+
 			Context(".AccessToken", func() {
 				var authRepo *authenticationfakes.FakeRepository
 
@@ -0,0 +1,45 @@
+package rpc
+
+import "code.cloudfoundry.org/cli/plugin/models"
+
+// GetConfigV1 returns a read-only view of the CLI configuration so that
+// plugins do not have to parse the config file themselves. Tokens are
+// redacted.
+func (cmd *CliRpcCmd) GetConfigV1(_ string, retVal *plugin_models.GetConfigV1Response) error {
+	org := cmd.cliConfig.OrganizationFields()
+	space := cmd.cliConfig.SpaceFields()
+
+	*retVal = plugin_models.GetConfigV1Response{
+		ApiEndpoint:           cmd.cliConfig.APIEndpoint(),
+		ApiVersion:            cmd.cliConfig.APIVersion(),
+		AuthorizationEndpoint: cmd.cliConfig.AuthenticationEndpoint(),
+		DopplerEndpoint:       cmd.cliConfig.DopplerEndpoint(),
+		UaaEndpoint:           cmd.cliConfig.UaaEndpoint(),
+		RoutingApiEndpoint:    cmd.cliConfig.RoutingAPIEndpoint(),
+		MinCliVersion:         cmd.cliConfig.MinCLIVersion(),
+		IsSSLDisabled:         cmd.cliConfig.IsSSLDisabled(),
+		IsLoggedIn:            cmd.cliConfig.IsLoggedIn(),
+		Username:              cmd.cliConfig.Username(),
+		UserGuid:              cmd.cliConfig.UserGUID(),
+		UserEmail:             cmd.cliConfig.UserEmail(),
+		Organization: plugin_models.GetConfigV1Organization{
+			Guid: org.GUID,
+			Name: org.Name,
+		},
+		Space: plugin_models.GetConfigV1Space{
+			Guid:     space.GUID,
+			Name:     space.Name,
+			AllowSSH: space.AllowSSH,
+		},
+		AccessToken:  redact(cmd.cliConfig.AccessToken()),
+		RefreshToken: redact(cmd.cliConfig.RefreshToken()),
+	}
+	return nil
+}
+
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	return plugin_models.RedactedValue
+}
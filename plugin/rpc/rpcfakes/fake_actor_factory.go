@@ -0,0 +1,145 @@
+// This file was generated by counterfeiter
+package rpcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/plugin/rpc"
+)
+
+type FakeActorFactory struct {
+	NewV2ActorStub        func() (rpc.V2Actor, error)
+	newV2ActorMutex       sync.RWMutex
+	newV2ActorArgsForCall []struct{}
+	newV2ActorReturns     struct {
+		result1 rpc.V2Actor
+		result2 error
+	}
+	newV2ActorReturnsOnCall map[int]struct {
+		result1 rpc.V2Actor
+		result2 error
+	}
+	NewV3ActorStub        func() (rpc.V3Actor, error)
+	newV3ActorMutex       sync.RWMutex
+	newV3ActorArgsForCall []struct{}
+	newV3ActorReturns     struct {
+		result1 rpc.V3Actor
+		result2 error
+	}
+	newV3ActorReturnsOnCall map[int]struct {
+		result1 rpc.V3Actor
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeActorFactory) NewV2Actor() (rpc.V2Actor, error) {
+	fake.newV2ActorMutex.Lock()
+	ret, specificReturn := fake.newV2ActorReturnsOnCall[len(fake.newV2ActorArgsForCall)]
+	fake.newV2ActorArgsForCall = append(fake.newV2ActorArgsForCall, struct{}{})
+	fake.recordInvocation("NewV2Actor", []interface{}{})
+	fake.newV2ActorMutex.Unlock()
+	if fake.NewV2ActorStub != nil {
+		return fake.NewV2ActorStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.newV2ActorReturns.result1, fake.newV2ActorReturns.result2
+}
+
+func (fake *FakeActorFactory) NewV2ActorCallCount() int {
+	fake.newV2ActorMutex.RLock()
+	defer fake.newV2ActorMutex.RUnlock()
+	return len(fake.newV2ActorArgsForCall)
+}
+
+func (fake *FakeActorFactory) NewV2ActorReturns(result1 rpc.V2Actor, result2 error) {
+	fake.NewV2ActorStub = nil
+	fake.newV2ActorReturns = struct {
+		result1 rpc.V2Actor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActorFactory) NewV2ActorReturnsOnCall(i int, result1 rpc.V2Actor, result2 error) {
+	fake.NewV2ActorStub = nil
+	if fake.newV2ActorReturnsOnCall == nil {
+		fake.newV2ActorReturnsOnCall = make(map[int]struct {
+			result1 rpc.V2Actor
+			result2 error
+		})
+	}
+	fake.newV2ActorReturnsOnCall[i] = struct {
+		result1 rpc.V2Actor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActorFactory) NewV3Actor() (rpc.V3Actor, error) {
+	fake.newV3ActorMutex.Lock()
+	ret, specificReturn := fake.newV3ActorReturnsOnCall[len(fake.newV3ActorArgsForCall)]
+	fake.newV3ActorArgsForCall = append(fake.newV3ActorArgsForCall, struct{}{})
+	fake.recordInvocation("NewV3Actor", []interface{}{})
+	fake.newV3ActorMutex.Unlock()
+	if fake.NewV3ActorStub != nil {
+		return fake.NewV3ActorStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.newV3ActorReturns.result1, fake.newV3ActorReturns.result2
+}
+
+func (fake *FakeActorFactory) NewV3ActorCallCount() int {
+	fake.newV3ActorMutex.RLock()
+	defer fake.newV3ActorMutex.RUnlock()
+	return len(fake.newV3ActorArgsForCall)
+}
+
+func (fake *FakeActorFactory) NewV3ActorReturns(result1 rpc.V3Actor, result2 error) {
+	fake.NewV3ActorStub = nil
+	fake.newV3ActorReturns = struct {
+		result1 rpc.V3Actor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActorFactory) NewV3ActorReturnsOnCall(i int, result1 rpc.V3Actor, result2 error) {
+	fake.NewV3ActorStub = nil
+	if fake.newV3ActorReturnsOnCall == nil {
+		fake.newV3ActorReturnsOnCall = make(map[int]struct {
+			result1 rpc.V3Actor
+			result2 error
+		})
+	}
+	fake.newV3ActorReturnsOnCall[i] = struct {
+		result1 rpc.V3Actor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActorFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.newV2ActorMutex.RLock()
+	defer fake.newV2ActorMutex.RUnlock()
+	fake.newV3ActorMutex.RLock()
+	defer fake.newV3ActorMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeActorFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rpc.ActorFactory = new(FakeActorFactory)
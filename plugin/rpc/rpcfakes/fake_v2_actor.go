@@ -0,0 +1,176 @@
+// This file was generated by counterfeiter
+package rpcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/plugin/rpc"
+)
+
+type FakeV2Actor struct {
+	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceInstancesBySpaceStub        func(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	getServiceInstancesBySpaceMutex       sync.RWMutex
+	getServiceInstancesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getServiceInstancesBySpaceReturns struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceInstancesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsBySpaceReturns.result1, fake.getApplicationsBySpaceReturns.result2, fake.getApplicationsBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.getServiceInstancesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesBySpaceReturnsOnCall[len(fake.getServiceInstancesBySpaceArgsForCall)]
+	fake.getServiceInstancesBySpaceArgsForCall = append(fake.getServiceInstancesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetServiceInstancesBySpace", []interface{}{spaceGUID})
+	fake.getServiceInstancesBySpaceMutex.Unlock()
+	if fake.GetServiceInstancesBySpaceStub != nil {
+		return fake.GetServiceInstancesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstancesBySpaceReturns.result1, fake.getServiceInstancesBySpaceReturns.result2, fake.getServiceInstancesBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceCallCount() int {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return len(fake.getServiceInstancesBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceArgsForCall(i int) string {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return fake.getServiceInstancesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceReturns(result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	fake.getServiceInstancesBySpaceReturns = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceReturnsOnCall(i int, result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	if fake.getServiceInstancesBySpaceReturnsOnCall == nil {
+		fake.getServiceInstancesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV2Actor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rpc.V2Actor = new(FakeV2Actor)
@@ -0,0 +1,107 @@
+// This file was generated by counterfeiter
+package rpcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/plugin/rpc"
+)
+
+type FakeV3Actor struct {
+	RunTaskStub        func(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+	runTaskMutex       sync.RWMutex
+	runTaskArgsForCall []struct {
+		appGUID string
+		task    v3action.Task
+	}
+	runTaskReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	runTaskReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3Actor) RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error) {
+	fake.runTaskMutex.Lock()
+	ret, specificReturn := fake.runTaskReturnsOnCall[len(fake.runTaskArgsForCall)]
+	fake.runTaskArgsForCall = append(fake.runTaskArgsForCall, struct {
+		appGUID string
+		task    v3action.Task
+	}{appGUID, task})
+	fake.recordInvocation("RunTask", []interface{}{appGUID, task})
+	fake.runTaskMutex.Unlock()
+	if fake.RunTaskStub != nil {
+		return fake.RunTaskStub(appGUID, task)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.runTaskReturns.result1, fake.runTaskReturns.result2, fake.runTaskReturns.result3
+}
+
+func (fake *FakeV3Actor) RunTaskCallCount() int {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return len(fake.runTaskArgsForCall)
+}
+
+func (fake *FakeV3Actor) RunTaskArgsForCall(i int) (string, v3action.Task) {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return fake.runTaskArgsForCall[i].appGUID, fake.runTaskArgsForCall[i].task
+}
+
+func (fake *FakeV3Actor) RunTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskStub = nil
+	fake.runTaskReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) RunTaskReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskStub = nil
+	if fake.runTaskReturnsOnCall == nil {
+		fake.runTaskReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.runTaskReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV3Actor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rpc.V3Actor = new(FakeV3Actor)
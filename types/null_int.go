@@ -0,0 +1,80 @@
+// Package types contains value types shared between the command, actor and
+// API layers.
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// NullInt is an int that can distinguish between being unset and being set to
+// zero.
+type NullInt struct {
+	IsSet bool
+	Value int
+}
+
+// ParseStringValue sets the value from its string representation. An empty
+// string unsets the value.
+func (n *NullInt) ParseStringValue(val string) error {
+	if val == "" {
+		n.IsSet = false
+		n.Value = 0
+		return nil
+	}
+
+	value, err := strconv.Atoi(val)
+	if err != nil {
+		return err
+	}
+
+	n.IsSet = true
+	n.Value = value
+	return nil
+}
+
+// UnmarshalJSON sets the value from a JSON number. A JSON null unsets the
+// value.
+func (n *NullInt) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.IsSet = false
+		n.Value = 0
+		return nil
+	}
+
+	var value int
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	n.IsSet = true
+	n.Value = value
+	return nil
+}
+
+// UnmarshalYAML sets the value from a YAML integer. A YAML null unsets the
+// value.
+func (n *NullInt) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var raw interface{}
+	err := unmarshal(&raw)
+	if err != nil {
+		return err
+	}
+
+	if raw == nil {
+		n.IsSet = false
+		n.Value = 0
+		return nil
+	}
+
+	var value int
+	err = unmarshal(&value)
+	if err != nil {
+		return err
+	}
+
+	n.IsSet = true
+	n.Value = value
+	return nil
+}
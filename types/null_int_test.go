@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var _ = Describe("NullInt", func() {
+	var nullInt NullInt
+
+	BeforeEach(func() {
+		nullInt = NullInt{IsSet: true, Value: 7}
+	})
+
+	Describe("ParseStringValue", func() {
+		Context("when the value is empty", func() {
+			It("unsets the value", func() {
+				err := nullInt.ParseStringValue("")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullInt).To(Equal(NullInt{}))
+			})
+		})
+
+		Context("when the value is an integer", func() {
+			It("sets the value", func() {
+				err := nullInt.ParseStringValue("0")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullInt).To(Equal(NullInt{IsSet: true, Value: 0}))
+			})
+		})
+
+		Context("when the value is not an integer", func() {
+			It("returns an error", func() {
+				err := nullInt.ParseStringValue("banana")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("UnmarshalJSON", func() {
+		Context("when the value is null", func() {
+			It("unsets the value", func() {
+				err := nullInt.UnmarshalJSON([]byte("null"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullInt).To(Equal(NullInt{}))
+			})
+		})
+
+		Context("when the value is a number", func() {
+			It("sets the value", func() {
+				err := nullInt.UnmarshalJSON([]byte("-1"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullInt).To(Equal(NullInt{IsSet: true, Value: -1}))
+			})
+		})
+
+		Context("when the value is not a number", func() {
+			It("returns an error", func() {
+				err := nullInt.UnmarshalJSON([]byte(`"banana"`))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("UnmarshalYAML", func() {
+		Context("when the value is null", func() {
+			It("unsets the value", func() {
+				err := nullInt.UnmarshalYAML(func(value interface{}) error {
+					return yaml.Unmarshal([]byte("~"), value)
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullInt).To(Equal(NullInt{}))
+			})
+		})
+
+		Context("when the value is an integer", func() {
+			It("sets the value", func() {
+				err := yaml.Unmarshal([]byte("0"), &nullInt)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullInt).To(Equal(NullInt{IsSet: true, Value: 0}))
+			})
+		})
+
+		Context("when the value is not an integer", func() {
+			It("returns an error", func() {
+				err := yaml.Unmarshal([]byte("banana"), &nullInt)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,137 @@
+// Package clissh runs commands inside application instances through the
+// Cloud Foundry SSH proxy.
+package clissh
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	md5FingerprintLength          = 47 // inclusive of space between bytes
+	hexSha1FingerprintLength      = 59 // inclusive of space between bytes
+	base64Sha256FingerprintLength = 43
+)
+
+// Options are the connection details of a single application instance.
+type Options struct {
+	// Endpoint is the host:port of the SSH proxy.
+	Endpoint string
+
+	// HostKeyFingerprint is the expected MD5, SHA1 or SHA256 fingerprint of
+	// the SSH proxy's host key.
+	HostKeyFingerprint string
+
+	// Username identifies the application instance, in the form
+	// cf:APP_GUID/INDEX.
+	Username string
+
+	// Passcode is the one time code used as the password.
+	Passcode string
+}
+
+// CommandError is returned when the remote command exits with a non-zero
+// status.
+type CommandError struct {
+	ExitStatus int
+	Stderr     string
+}
+
+func (e CommandError) Error() string {
+	return fmt.Sprintf("Remote command exited with status %d: %s", e.ExitStatus, e.Stderr)
+}
+
+// SecureShell opens SSH sessions with application instances.
+type SecureShell struct {
+	// DialTimeout is the timeout for establishing the TCP connection. If not
+	// set, it is infinite.
+	DialTimeout time.Duration
+}
+
+// Run executes the command in the application instance described by the
+// options and returns its standard output.
+func (secureShell SecureShell) Run(options Options, command string) ([]byte, error) {
+	client, err := ssh.Dial("tcp", options.Endpoint, &ssh.ClientConfig{
+		User: options.Username,
+		Auth: []ssh.AuthMethod{
+			ssh.Password(options.Passcode),
+		},
+		HostKeyCallback: fingerprintCallback(options.HostKeyFingerprint),
+		Timeout:         secureShell.DialTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	var stdout, stderr bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+
+	err = session.Run(command)
+	if exitErr, ok := err.(*ssh.ExitError); ok {
+		return stdout.Bytes(), CommandError{
+			ExitStatus: exitErr.ExitStatus(),
+			Stderr:     strings.TrimSpace(stderr.String()),
+		}
+	}
+
+	return stdout.Bytes(), err
+}
+
+type hostKeyCallback func(hostname string, remote net.Addr, key ssh.PublicKey) error
+
+func fingerprintCallback(expectedFingerprint string) hostKeyCallback {
+	return func(_ string, _ net.Addr, key ssh.PublicKey) error {
+		var fingerprint string
+
+		switch len(expectedFingerprint) {
+		case base64Sha256FingerprintLength:
+			fingerprint = base64Sha256Fingerprint(key)
+		case hexSha1FingerprintLength:
+			fingerprint = hexSha1Fingerprint(key)
+		case md5FingerprintLength:
+			fingerprint = md5Fingerprint(key)
+		case 0:
+			return fmt.Errorf("Unable to verify identity of host.\n\nThe fingerprint of the received key was %q.", md5Fingerprint(key))
+		default:
+			return errors.New("Unsupported host key fingerprint format")
+		}
+
+		if fingerprint != expectedFingerprint {
+			return fmt.Errorf("Host key verification failed.\n\nThe fingerprint of the received key was %q.", fingerprint)
+		}
+		return nil
+	}
+}
+
+func md5Fingerprint(key ssh.PublicKey) string {
+	sum := md5.Sum(key.Marshal())
+	return strings.Replace(fmt.Sprintf("% x", sum), " ", ":", -1)
+}
+
+func hexSha1Fingerprint(key ssh.PublicKey) string {
+	sum := sha1.Sum(key.Marshal())
+	return strings.Replace(fmt.Sprintf("% x", sum), " ", ":", -1)
+}
+
+func base64Sha256Fingerprint(key ssh.PublicKey) string {
+	sum := sha256.Sum256(key.Marshal())
+	return base64.RawStdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,13 @@
+package clissh_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestClissh(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CLI SSH Suite")
+}
@@ -0,0 +1,163 @@
+package clissh_test
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ = Describe("SecureShell", func() {
+	var (
+		listener     net.Listener
+		hostKey      ssh.Signer
+		options      Options
+		command      string
+		receivedUser string
+		receivedCmd  string
+		stdout       []byte
+		executeErr   error
+	)
+
+	BeforeEach(func() {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		hostKey, err = ssh.NewSignerFromKey(privateKey)
+		Expect(err).ToNot(HaveOccurred())
+
+		serverConfig := &ssh.ServerConfig{
+			PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+				receivedUser = conn.User()
+				if string(password) != "some-passcode" {
+					return nil, fmt.Errorf("invalid passcode")
+				}
+				return nil, nil
+			},
+		}
+		serverConfig.AddHostKey(hostKey)
+
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		go serveCommands(listener, serverConfig, &receivedCmd)
+
+		sum := md5.Sum(hostKey.PublicKey().Marshal())
+		options = Options{
+			Endpoint:           listener.Addr().String(),
+			HostKeyFingerprint: strings.Replace(fmt.Sprintf("% x", sum), " ", ":", -1),
+			Username:           "cf:some-app-guid/0",
+			Passcode:           "some-passcode",
+		}
+		command = "succeed"
+	})
+
+	AfterEach(func() {
+		listener.Close()
+	})
+
+	JustBeforeEach(func() {
+		stdout, executeErr = SecureShell{}.Run(options, command)
+	})
+
+	It("runs the command as the instance user and returns its output", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(string(stdout)).To(Equal("some-output\n"))
+		Expect(receivedUser).To(Equal("cf:some-app-guid/0"))
+		Expect(receivedCmd).To(Equal("succeed"))
+	})
+
+	Context("when the command fails", func() {
+		BeforeEach(func() {
+			command = "fail"
+		})
+
+		It("returns a CommandError with the exit status and stderr", func() {
+			Expect(executeErr).To(MatchError(CommandError{ExitStatus: 2, Stderr: "some-error"}))
+		})
+	})
+
+	Context("when the host key fingerprint does not match", func() {
+		BeforeEach(func() {
+			options.HostKeyFingerprint = "00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00"
+		})
+
+		It("returns a host key verification error", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(executeErr.Error()).To(ContainSubstring("Host key verification failed"))
+		})
+	})
+
+	Context("when no host key fingerprint is provided", func() {
+		BeforeEach(func() {
+			options.HostKeyFingerprint = ""
+		})
+
+		It("refuses to connect", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(executeErr.Error()).To(ContainSubstring("Unable to verify identity of host"))
+		})
+	})
+
+	Context("when the passcode is rejected", func() {
+		BeforeEach(func() {
+			options.Passcode = "wrong-passcode"
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(HaveOccurred())
+		})
+	})
+})
+
+// serveCommands accepts a single connection and answers exec requests: the
+// command "succeed" writes to stdout and exits 0, anything else writes to
+// stderr and exits 2.
+func serveCommands(listener net.Listener, config *ssh.ServerConfig, receivedCmd *string) {
+	defer GinkgoRecover()
+
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	_, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+
+		for request := range channelRequests {
+			if request.Type != "exec" {
+				request.Reply(false, nil)
+				continue
+			}
+			request.Reply(true, nil)
+
+			// The payload is the command as an SSH string.
+			*receivedCmd = string(request.Payload[4:])
+			status := make([]byte, 4)
+			if *receivedCmd == "succeed" {
+				fmt.Fprintln(channel, "some-output")
+			} else {
+				fmt.Fprintln(channel.Stderr(), "some-error")
+				binary.BigEndian.PutUint32(status, 2)
+			}
+			channel.SendRequest("exit-status", false, status)
+			channel.Close()
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package configv3
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AutoTargetRule maps a directory or a git remote to the org and space that
+// should be targeted when working in it. A rule with both Path and GitRemote
+// only matches when both do.
+type AutoTargetRule struct {
+	Path         string `json:"Path,omitempty"`
+	GitRemote    string `json:"GitRemote,omitempty"`
+	Organization string `json:"Organization"`
+	Space        string `json:"Space,omitempty"`
+}
+
+// AutoTargetRule returns the first auto-target rule that matches the provided
+// directory. A rule matches when the directory is its Path or inside it, and
+// when one of the remotes of the git repository containing the directory is
+// its GitRemote.
+func (config *Config) AutoTargetRule(dir string) (AutoTargetRule, bool) {
+	var remotes []string
+	remotesRead := false
+
+	for _, rule := range config.ConfigFile.AutoTargetRules {
+		if rule.Path == "" && rule.GitRemote == "" {
+			continue
+		}
+
+		if rule.Path != "" && !isInDirectory(dir, rule.Path) {
+			continue
+		}
+
+		if rule.GitRemote != "" {
+			if !remotesRead {
+				remotes = gitRemotes(dir)
+				remotesRead = true
+			}
+			if !containsRemote(remotes, rule.GitRemote) {
+				continue
+			}
+		}
+
+		return rule, true
+	}
+
+	return AutoTargetRule{}, false
+}
+
+func isInDirectory(dir string, parent string) bool {
+	rel, err := filepath.Rel(filepath.Clean(parent), filepath.Clean(dir))
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func containsRemote(remotes []string, remote string) bool {
+	for _, url := range remotes {
+		if strings.TrimSuffix(url, ".git") == strings.TrimSuffix(remote, ".git") {
+			return true
+		}
+	}
+	return false
+}
+
+// gitRemotes returns the remote URLs of the git repository containing dir, or
+// nothing when dir is not in a git repository.
+func gitRemotes(dir string) []string {
+	configPath, ok := gitConfigPath(dir)
+	if !ok {
+		return nil
+	}
+
+	file, err := os.Open(configPath)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var (
+		remotes  []string
+		inRemote bool
+	)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			inRemote = strings.HasPrefix(line, "[remote ")
+			continue
+		}
+
+		if !inRemote {
+			continue
+		}
+
+		keyValue := strings.SplitN(line, "=", 2)
+		if len(keyValue) == 2 && strings.TrimSpace(keyValue[0]) == "url" {
+			remotes = append(remotes, strings.TrimSpace(keyValue[1]))
+		}
+	}
+
+	return remotes
+}
+
+// gitConfigPath walks up from dir to the git repository containing it and
+// returns the location of its config file. Worktrees and submodules, whose
+// .git is a file pointing at the git directory, are followed.
+func gitConfigPath(dir string) (string, bool) {
+	dir = filepath.Clean(dir)
+	for {
+		gitPath := filepath.Join(dir, ".git")
+		info, err := os.Stat(gitPath)
+		if err == nil {
+			if info.IsDir() {
+				return filepath.Join(gitPath, "config"), true
+			}
+			return linkedGitConfigPath(dir, gitPath)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+func linkedGitConfigPath(dir string, gitFile string) (string, bool) {
+	contents, err := ioutil.ReadFile(gitFile)
+	if err != nil {
+		return "", false
+	}
+
+	gitDir := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(string(contents)), "gitdir:"))
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(dir, gitDir)
+	}
+
+	// Worktrees share the config of the main repository.
+	if commonDir, err := ioutil.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		common := strings.TrimSpace(string(commonDir))
+		if !filepath.IsAbs(common) {
+			common = filepath.Join(gitDir, common)
+		}
+		gitDir = common
+	}
+
+	return filepath.Join(gitDir, "config"), true
+}
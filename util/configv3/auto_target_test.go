@@ -0,0 +1,136 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AutoTargetRule", func() {
+	var (
+		workspace string
+		repoDir   string
+		config    Config
+	)
+
+	BeforeEach(func() {
+		var err error
+		workspace, err = ioutil.TempDir("", "cli-auto-target")
+		Expect(err).ToNot(HaveOccurred())
+
+		repoDir = filepath.Join(workspace, "some-repo")
+		Expect(os.MkdirAll(filepath.Join(repoDir, ".git"), 0700)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(repoDir, "src", "app"), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(repoDir, ".git", "config"), []byte(`[core]
+	bare = false
+[remote "origin"]
+	url = git@github.com:some-org/some-repo.git
+	fetch = +refs/heads/*:refs/remotes/origin/*
+[branch "master"]
+	remote = origin
+`), 0600)).To(Succeed())
+
+		config = Config{}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(workspace)).To(Succeed())
+	})
+
+	Context("when a rule has a path", func() {
+		BeforeEach(func() {
+			config.ConfigFile.AutoTargetRules = []AutoTargetRule{
+				{Path: filepath.Join(workspace, "other-repo"), Organization: "other-org"},
+				{Path: repoDir, Organization: "some-org", Space: "some-space"},
+			}
+		})
+
+		It("matches the directory and the directories inside it", func() {
+			rule, ok := config.AutoTargetRule(repoDir)
+			Expect(ok).To(BeTrue())
+			Expect(rule.Organization).To(Equal("some-org"))
+
+			rule, ok = config.AutoTargetRule(filepath.Join(repoDir, "src", "app"))
+			Expect(ok).To(BeTrue())
+			Expect(rule.Space).To(Equal("some-space"))
+		})
+
+		It("does not match directories outside it", func() {
+			_, ok := config.AutoTargetRule(workspace)
+			Expect(ok).To(BeFalse())
+
+			_, ok = config.AutoTargetRule(repoDir + "-other")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("when a rule has a git remote", func() {
+		BeforeEach(func() {
+			config.ConfigFile.AutoTargetRules = []AutoTargetRule{
+				{GitRemote: "git@github.com:some-org/other-repo.git", Organization: "other-org"},
+				{GitRemote: "git@github.com:some-org/some-repo", Organization: "some-org", Space: "some-space"},
+			}
+		})
+
+		It("matches the directories of the repository with that remote", func() {
+			rule, ok := config.AutoTargetRule(filepath.Join(repoDir, "src", "app"))
+			Expect(ok).To(BeTrue())
+			Expect(rule.Organization).To(Equal("some-org"))
+		})
+
+		It("does not match directories outside a git repository", func() {
+			_, ok := config.AutoTargetRule(workspace)
+			Expect(ok).To(BeFalse())
+		})
+
+		Context("when the directory is in a worktree", func() {
+			var worktreeDir string
+
+			BeforeEach(func() {
+				gitDir := filepath.Join(repoDir, ".git", "worktrees", "feature")
+				Expect(os.MkdirAll(gitDir, 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(gitDir, "commondir"), []byte("../..\n"), 0600)).To(Succeed())
+
+				worktreeDir = filepath.Join(workspace, "feature")
+				Expect(os.MkdirAll(worktreeDir, 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(worktreeDir, ".git"), []byte("gitdir: "+gitDir+"\n"), 0600)).To(Succeed())
+			})
+
+			It("uses the remotes of the main repository", func() {
+				rule, ok := config.AutoTargetRule(worktreeDir)
+				Expect(ok).To(BeTrue())
+				Expect(rule.Organization).To(Equal("some-org"))
+			})
+		})
+	})
+
+	Context("when a rule has a path and a git remote", func() {
+		BeforeEach(func() {
+			config.ConfigFile.AutoTargetRules = []AutoTargetRule{
+				{Path: repoDir, GitRemote: "git@github.com:some-org/other-repo.git", Organization: "other-org"},
+			}
+		})
+
+		It("only matches when both match", func() {
+			_, ok := config.AutoTargetRule(repoDir)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("when a rule has neither a path nor a git remote", func() {
+		BeforeEach(func() {
+			config.ConfigFile.AutoTargetRules = []AutoTargetRule{
+				{Organization: "some-org"},
+			}
+		})
+
+		It("never matches", func() {
+			_, ok := config.AutoTargetRule(repoDir)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
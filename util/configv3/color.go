@@ -26,18 +26,15 @@ type ColorSetting int
 //   2. The 'ColorEnabled' value in the .cf/config.json if set
 //   3. Defaults to ColorEnabled if nothing is set
 func (config *Config) ColorEnabled() ColorSetting {
-	if config.ENV.CFColor != "" {
-		val, err := strconv.ParseBool(config.ENV.CFColor)
-		if err == nil {
-			return config.boolToColorSetting(val)
-		}
+	setting, source := ColorEnabled, SettingSourceDefault
+	if val, err := strconv.ParseBool(config.ENV.CFColor); err == nil {
+		setting, source = config.boolToColorSetting(val), SettingSourceEnvironment
+	} else if val, err := strconv.ParseBool(config.ConfigFile.ColorEnabled); err == nil {
+		setting, source = config.boolToColorSetting(val), SettingSourceConfig
 	}
 
-	val, err := strconv.ParseBool(config.ConfigFile.ColorEnabled)
-	if err != nil {
-		return ColorEnabled
-	}
-	return config.boolToColorSetting(val)
+	config.resolveSetting("CF_COLOR", source, colorSettingName(setting))
+	return setting
 }
 
 func (config *Config) boolToColorSetting(val bool) ColorSetting {
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"math"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/crypto/ssh/terminal"
@@ -97,19 +99,29 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
+		BinaryName:           filepath.Base(os.Args[0]),
+		CFColor:              os.Getenv("CF_COLOR"),
+		CFHome:               os.Getenv("CF_HOME"),
+		CFPluginHome:         os.Getenv("CF_PLUGIN_HOME"),
+		CFStagingTimeout:     os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:     os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:              os.Getenv("CF_TRACE"),
+		HTTPSProxy:           os.Getenv("https_proxy"),
+		Lang:                 os.Getenv("LANG"),
+		LCAll:                os.Getenv("LC_ALL"),
+		Experimental:         os.Getenv("CF_CLI_EXPERIMENTAL"),
+		CFDialTimeout:        os.Getenv("CF_DIAL_TIMEOUT"),
+		ForceTTY:             os.Getenv("FORCE_TTY"),
+		CFLogLevel:           os.Getenv("CF_LOG_LEVEL"),
+		CFRefactoredCommands: os.Getenv("CF_REFACTORED_COMMANDS"),
+		CFParityLog:          os.Getenv("CF_PARITY_LOG"),
+		CFOTLPEndpoint:       os.Getenv("CF_OTLP_ENDPOINT"),
+		CFMetricsFile:        os.Getenv("CF_METRICS_FILE"),
+		CFMetricsPushgateway: os.Getenv("CF_METRICS_PUSHGATEWAY"),
+		CFAutoscalerAPI:      os.Getenv("CF_AUTOSCALER_API"),
+		CFCredHubAPI:         os.Getenv("CF_CREDHUB_API"),
+		CFLogCacheAPI:        os.Getenv("CF_LOG_CACHE_API"),
+		CFTelemetryEndpoint:  os.Getenv("CF_TELEMETRY_ENDPOINT"),
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -158,19 +170,39 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
-// directory.
+// directory. The config is written to a temporary file that then replaces
+// config.json, so an interrupted write never leaves a partially written
+// config (and with it a lost refresh token) behind.
 func WriteConfig(c *Config) error {
 	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	err = os.MkdirAll(filepath.Join(homeDirectory(), ".cf"), 0700)
+	cfDir := filepath.Join(homeDirectory(), ".cf")
+	err = os.MkdirAll(cfDir, 0700)
 	if err != nil {
 		return err
 	}
 
-	return ioutil.WriteFile(ConfigFilePath(), rawConfig, 0600)
+	tempConfigFile, err := ioutil.TempFile(cfDir, "temp-config")
+	if err != nil {
+		return err
+	}
+	tempConfigFilePath := tempConfigFile.Name()
+
+	_, err = tempConfigFile.Write(rawConfig)
+	if closeErr := tempConfigFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempConfigFilePath, ConfigFilePath())
+	}
+	if err != nil {
+		_ = os.Remove(tempConfigFilePath)
+	}
+
+	return err
 }
 
 // Config combines the settings taken from the .cf/config.json, os.ENV, and the
@@ -189,32 +221,54 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	// effectiveSettings are the CF_* settings that the getters last resolved.
+	effectiveSettings      map[string]EffectiveSetting
+	effectiveSettingsMutex sync.Mutex
 }
 
 // CFConfig represents .cf/config.json
 type CFConfig struct {
-	ConfigVersion            int                `json:"ConfigVersion"`
-	Target                   string             `json:"Target"`
-	APIVersion               string             `json:"APIVersion"`
-	AuthorizationEndpoint    string             `json:"AuthorizationEndpoint"`
-	DopplerEndpoint          string             `json:"DopplerEndPoint"`
-	UAAEndpoint              string             `json:"UaaEndpoint"`
-	RoutingEndpoint          string             `json:"RoutingAPIEndpoint"`
-	AccessToken              string             `json:"AccessToken"`
-	SSHOAuthClient           string             `json:"SSHOAuthClient"`
-	UAAOAuthClient           string             `json:"UAAOAuthClient"`
-	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
-	RefreshToken             string             `json:"RefreshToken"`
-	TargetedOrganization     Organization       `json:"OrganizationFields"`
-	TargetedSpace            Space              `json:"SpaceFields"`
-	SkipSSLValidation        bool               `json:"SSLDisabled"`
-	AsyncTimeout             int                `json:"AsyncTimeout"`
-	Trace                    string             `json:"Trace"`
-	ColorEnabled             string             `json:"ColorEnabled"`
-	Locale                   string             `json:"Locale"`
-	PluginRepositories       []PluginRepository `json:"PluginRepos"`
-	MinCLIVersion            string             `json:"MinCLIVersion"`
-	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	ConfigVersion            int                      `json:"ConfigVersion"`
+	Target                   string                   `json:"Target"`
+	APIVersion               string                   `json:"APIVersion"`
+	AuthorizationEndpoint    string                   `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string                   `json:"DopplerEndPoint"`
+	UAAEndpoint              string                   `json:"UaaEndpoint"`
+	RoutingEndpoint          string                   `json:"RoutingAPIEndpoint"`
+	AccessToken              string                   `json:"AccessToken"`
+	SSHOAuthClient           string                   `json:"SSHOAuthClient"`
+	UAAOAuthClient           string                   `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string                   `json:"UAAOAuthClientSecret"`
+	RefreshToken             string                   `json:"RefreshToken"`
+	TargetedOrganization     Organization             `json:"OrganizationFields"`
+	TargetedSpace            Space                    `json:"SpaceFields"`
+	SkipSSLValidation        bool                     `json:"SSLDisabled"`
+	AsyncTimeout             int                      `json:"AsyncTimeout"`
+	DialTimeout              int                      `json:"DialTimeout,omitempty"`
+	StagingTimeout           int                      `json:"StagingTimeout,omitempty"`
+	StartupTimeout           int                      `json:"StartupTimeout,omitempty"`
+	OutputFormat             string                   `json:"OutputFormat,omitempty"`
+	Trace                    string                   `json:"Trace"`
+	ColorEnabled             string                   `json:"ColorEnabled"`
+	Locale                   string                   `json:"Locale"`
+	PluginRepositories       []PluginRepository       `json:"PluginRepos"`
+	PluginUpdateCheck        bool                     `json:"PluginUpdateCheck"`
+	RefactoredCommands       []string                 `json:"RefactoredCommands,omitempty"`
+	LastPluginUpdateCheck    time.Time                `json:"LastPluginUpdateCheck"`
+	MinCLIVersion            string                   `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string                   `json:"MinRecommendedCLIVersion"`
+	TargetSessions           map[string]TargetSession `json:"TargetSessions,omitempty"`
+	AutoTargetRules          []AutoTargetRule         `json:"AutoTargetRules,omitempty"`
+	Telemetry                string                   `json:"Telemetry,omitempty"`
+	TelemetryEndpoint        string                   `json:"TelemetryEndpoint,omitempty"`
+}
+
+// TargetSession contains the tokens of an API target that is not currently
+// targeted, so that they can be restored when it is targeted again
+type TargetSession struct {
+	AccessToken  string `json:"AccessToken"`
+	RefreshToken string `json:"RefreshToken"`
 }
 
 // Organization contains basic information about the targeted organization
@@ -246,20 +300,29 @@ type Space struct {
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFHome           string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
-	Experimental     string
-	CFDialTimeout    string
-	ForceTTY         string
-	CFLogLevel       string
+	BinaryName           string
+	CFColor              string
+	CFHome               string
+	CFPluginHome         string
+	CFStagingTimeout     string
+	CFStartupTimeout     string
+	CFTrace              string
+	HTTPSProxy           string
+	Lang                 string
+	LCAll                string
+	Experimental         string
+	CFDialTimeout        string
+	ForceTTY             string
+	CFLogLevel           string
+	CFRefactoredCommands string
+	CFParityLog          string
+	CFOTLPEndpoint       string
+	CFMetricsFile        string
+	CFMetricsPushgateway string
+	CFAutoscalerAPI      string
+	CFCredHubAPI         string
+	CFLogCacheAPI        string
+	CFTelemetryEndpoint  string
 }
 
 // FlagOverride represents all the global flags passed to the CF CLI
@@ -330,6 +393,16 @@ func (config *Config) MinCLIVersion() string {
 	return config.ConfigFile.MinCLIVersion
 }
 
+// UAAEndpoint returns the UAA endpoint advertised by the CC
+func (config *Config) UAAEndpoint() string {
+	return config.ConfigFile.UAAEndpoint
+}
+
+// DopplerEndpoint returns the Doppler endpoint advertised by the CC
+func (config *Config) DopplerEndpoint() string {
+	return config.ConfigFile.DopplerEndpoint
+}
+
 // TargetedOrganization returns the currently targeted organization
 func (config *Config) TargetedOrganization() Organization {
 	return config.ConfigFile.TargetedOrganization
@@ -343,31 +416,35 @@ func (config *Config) TargetedSpace() Space {
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
-//   2. Defaults to the DefaultStagingTimeout
+//   2. The config file's StagingTimeout value (integer) is > 0
+//   3. Defaults to the DefaultStagingTimeout
 func (config *Config) StagingTimeout() time.Duration {
-	if config.ENV.CFStagingTimeout != "" {
-		val, err := strconv.ParseInt(config.ENV.CFStagingTimeout, 10, 64)
-		if err == nil {
-			return time.Duration(val) * time.Minute
-		}
+	timeout, source := DefaultStagingTimeout, SettingSourceDefault
+	if val, err := strconv.ParseInt(config.ENV.CFStagingTimeout, 10, 64); err == nil {
+		timeout, source = time.Duration(val)*time.Minute, SettingSourceEnvironment
+	} else if config.ConfigFile.StagingTimeout > 0 {
+		timeout, source = time.Duration(config.ConfigFile.StagingTimeout)*time.Minute, SettingSourceConfig
 	}
 
-	return DefaultStagingTimeout
+	config.resolveSetting("CF_STAGING_TIMEOUT", source, timeout.String())
+	return timeout
 }
 
 // StartupTimeout returns the max time an application should take to start. The
 // time is based off of:
 //   1. The $CF_STARTUP_TIMEOUT environment variable if set
-//   2. Defaults to the DefaultStartupTimeout
+//   2. The config file's StartupTimeout value (integer) is > 0
+//   3. Defaults to the DefaultStartupTimeout
 func (config *Config) StartupTimeout() time.Duration {
-	if config.ENV.CFStartupTimeout != "" {
-		val, err := strconv.ParseInt(config.ENV.CFStartupTimeout, 10, 64)
-		if err == nil {
-			return time.Duration(val) * time.Minute
-		}
+	timeout, source := DefaultStartupTimeout, SettingSourceDefault
+	if val, err := strconv.ParseInt(config.ENV.CFStartupTimeout, 10, 64); err == nil {
+		timeout, source = time.Duration(val)*time.Minute, SettingSourceEnvironment
+	} else if config.ConfigFile.StartupTimeout > 0 {
+		timeout, source = time.Duration(config.ConfigFile.StartupTimeout)*time.Minute, SettingSourceConfig
 	}
 
-	return DefaultStartupTimeout
+	config.resolveSetting("CF_STARTUP_TIMEOUT", source, timeout.String())
+	return timeout
 }
 
 // HTTPSProxy returns the proxy url that the CLI should use. The url is based
@@ -392,14 +469,123 @@ func (config *Config) BinaryName() string {
 //   1. The $CF_CLI_EXPERIMENTAL environment variable if set
 //   2. Defaults to false
 func (config *Config) Experimental() bool {
-	if config.ENV.Experimental != "" {
-		envVal, err := strconv.ParseBool(config.ENV.Experimental)
-		if err == nil {
-			return envVal
+	experimental, source := false, SettingSourceDefault
+	if envVal, err := strconv.ParseBool(config.ENV.Experimental); err == nil {
+		experimental, source = envVal, SettingSourceEnvironment
+	}
+
+	config.resolveSetting("CF_CLI_EXPERIMENTAL", source, strconv.FormatBool(experimental))
+	return experimental
+}
+
+// RefactoredCommands returns the names of the commands that should run their
+// refactored implementation instead of the legacy one. This is based off of:
+//   1. The comma separated $CF_REFACTORED_COMMANDS environment variable if set
+//   2. The config file's RefactoredCommands value
+// The special name "all" enables every refactored implementation and "none"
+// disables them.
+func (config *Config) RefactoredCommands() []string {
+	if config.ENV.CFRefactoredCommands == "" {
+		source := SettingSourceDefault
+		if len(config.ConfigFile.RefactoredCommands) > 0 {
+			source = SettingSourceConfig
+		}
+		config.resolveSetting("CF_REFACTORED_COMMANDS", source, strings.Join(config.ConfigFile.RefactoredCommands, ","))
+		return config.ConfigFile.RefactoredCommands
+	}
+
+	var names []string
+	for _, name := range strings.Split(config.ENV.CFRefactoredCommands, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
 		}
 	}
+	config.resolveSetting("CF_REFACTORED_COMMANDS", SettingSourceEnvironment, strings.Join(names, ","))
+	return names
+}
+
+// ParityLogFile returns the file that differences between the output of the
+// legacy and the refactored implementations of a command are logged to. This
+// is based off of the $CF_PARITY_LOG environment variable; empty means parity
+// logging is disabled.
+func (config *Config) ParityLogFile() string {
+	return config.resolveSetting("CF_PARITY_LOG", envOrDefault(config.ENV.CFParityLog), config.ENV.CFParityLog)
+}
+
+// OTLPEndpoint returns the URL of the OpenTelemetry collector that traces of
+// the CLI operations are exported to. This is based off of the
+// $CF_OTLP_ENDPOINT environment variable; empty means tracing is disabled.
+func (config *Config) OTLPEndpoint() string {
+	return config.resolveSetting("CF_OTLP_ENDPOINT", envOrDefault(config.ENV.CFOTLPEndpoint), config.ENV.CFOTLPEndpoint)
+}
+
+// MetricsFile returns the textfile that metrics of push and restage runs are
+// written to. This is based off of the $CF_METRICS_FILE environment variable;
+// empty means no metrics file is written.
+func (config *Config) MetricsFile() string {
+	return config.resolveSetting("CF_METRICS_FILE", envOrDefault(config.ENV.CFMetricsFile), config.ENV.CFMetricsFile)
+}
 
-	return false
+// MetricsPushgatewayURL returns the URL of the Prometheus Pushgateway that
+// metrics of push and restage runs are pushed to. This is based off of the
+// $CF_METRICS_PUSHGATEWAY environment variable; empty means metrics are not
+// pushed.
+func (config *Config) MetricsPushgatewayURL() string {
+	return config.resolveSetting("CF_METRICS_PUSHGATEWAY", envOrDefault(config.ENV.CFMetricsPushgateway), config.ENV.CFMetricsPushgateway)
+}
+
+// ResponseCacheDir returns the directory that the Cloud Controller discovery
+// documents are cached in.
+func (config *Config) ResponseCacheDir() string {
+	return filepath.Join(filepath.Dir(ConfigFilePath()), "cache")
+}
+
+// AutoscalerEndpoint returns the URL of the App Autoscaler API. This is based
+// off of:
+//   1. The $CF_AUTOSCALER_API environment variable if set
+//   2. The targeted API with its 'api' host name prefix replaced by
+//      'autoscaler', the default route of the App Autoscaler
+func (config *Config) AutoscalerEndpoint() string {
+	if config.ENV.CFAutoscalerAPI != "" {
+		return config.resolveSetting("CF_AUTOSCALER_API", SettingSourceEnvironment, strings.TrimSuffix(config.ENV.CFAutoscalerAPI, "/"))
+	}
+	return config.resolveSetting("CF_AUTOSCALER_API", SettingSourceDefault, config.siblingEndpoint("autoscaler"))
+}
+
+// CredHubEndpoint returns the URL of the CredHub API. This is based off of:
+//   1. The $CF_CREDHUB_API environment variable if set
+//   2. The targeted API with its 'api' host name prefix replaced by
+//      'credhub'
+func (config *Config) CredHubEndpoint() string {
+	if config.ENV.CFCredHubAPI != "" {
+		return config.resolveSetting("CF_CREDHUB_API", SettingSourceEnvironment, strings.TrimSuffix(config.ENV.CFCredHubAPI, "/"))
+	}
+	return config.resolveSetting("CF_CREDHUB_API", SettingSourceDefault, config.siblingEndpoint("credhub"))
+}
+
+// LogCacheEndpoint returns the URL of the Log Cache API. This is based off
+// of:
+//   1. The $CF_LOG_CACHE_API environment variable if set
+//   2. The targeted API with its 'api' host name prefix replaced by
+//      'log-cache'
+func (config *Config) LogCacheEndpoint() string {
+	if config.ENV.CFLogCacheAPI != "" {
+		return config.resolveSetting("CF_LOG_CACHE_API", SettingSourceEnvironment, strings.TrimSuffix(config.ENV.CFLogCacheAPI, "/"))
+	}
+	return config.resolveSetting("CF_LOG_CACHE_API", SettingSourceDefault, config.siblingEndpoint("log-cache"))
+}
+
+// siblingEndpoint returns the URL of a component routed on the same system
+// domain as the targeted API, or nothing when the API host name does not
+// start with 'api.'.
+func (config *Config) siblingEndpoint(hostPrefix string) string {
+	target, err := url.Parse(config.Target())
+	if err != nil || !strings.HasPrefix(target.Host, "api.") {
+		return ""
+	}
+	target.Host = hostPrefix + "." + strings.TrimPrefix(target.Host, "api.")
+	target.Path = ""
+	return target.String()
 }
 
 // Verbose returns true if verbose should be displayed to terminal and a
@@ -409,6 +595,15 @@ func (config *Config) Experimental() bool {
 //   - The '-v/--verbose' global flag
 //   - Defaults to false
 func (config *Config) Verbose() (bool, []string) {
+	switch {
+	case config.ENV.CFTrace != "":
+		config.resolveSetting("CF_TRACE", SettingSourceEnvironment, config.ENV.CFTrace)
+	case config.ConfigFile.Trace != "":
+		config.resolveSetting("CF_TRACE", SettingSourceConfig, config.ConfigFile.Trace)
+	default:
+		config.resolveSetting("CF_TRACE", SettingSourceDefault, "false")
+	}
+
 	var (
 		verbose     bool
 		envOverride bool
@@ -456,6 +651,16 @@ func (config *Config) IsTTY() bool {
 //   - The $CF_LOG_LEVEL and an int/warn/info/etc...
 //   - Defaults to PANIC/0 (ie no logging)
 func (config *Config) LogLevel() int {
+	level, source := config.logLevel(), SettingSourceDefault
+	if level != 0 || config.ENV.CFLogLevel == "0" {
+		source = SettingSourceEnvironment
+	}
+
+	config.resolveSetting("CF_LOG_LEVEL", source, strconv.Itoa(level))
+	return level
+}
+
+func (config *Config) logLevel() int {
 	if config.ENV.CFLogLevel != "" {
 		envVal, err := strconv.ParseInt(config.ENV.CFLogLevel, 10, 32)
 		if err == nil {
@@ -488,16 +693,18 @@ func (config *Config) TerminalWidth() int {
 
 // DialTimeout returns the timeout to use when dialing. This is based off of:
 //   1. The $CF_DIAL_TIMEOUT environment variable if set
-//   2. Defaults to 5 seconds
+//   2. The config file's DialTimeout value (integer, in seconds) is > 0
+//   3. Defaults to 5 seconds
 func (config *Config) DialTimeout() time.Duration {
-	if config.ENV.CFDialTimeout != "" {
-		envVal, err := strconv.ParseInt(config.ENV.CFDialTimeout, 10, 64)
-		if err == nil {
-			return time.Duration(envVal) * time.Second
-		}
+	timeout, source := DefaultDialTimeout, SettingSourceDefault
+	if envVal, err := strconv.ParseInt(config.ENV.CFDialTimeout, 10, 64); err == nil {
+		timeout, source = time.Duration(envVal)*time.Second, SettingSourceEnvironment
+	} else if config.ConfigFile.DialTimeout > 0 {
+		timeout, source = time.Duration(config.ConfigFile.DialTimeout)*time.Second, SettingSourceConfig
 	}
 
-	return DefaultDialTimeout
+	config.resolveSetting("CF_DIAL_TIMEOUT", source, timeout.String())
+	return timeout
 }
 
 func (config *Config) BinaryVersion() string {
@@ -551,6 +758,35 @@ func (config *Config) SetTokenInformation(accessToken string, refreshToken strin
 	config.ConfigFile.SSHOAuthClient = sshOAuthClient
 }
 
+// SaveTargetSession stores the tokens of the current target so that they can
+// be restored after targeting another API. Nothing is stored when there is no
+// target or the user is not logged in.
+func (config *Config) SaveTargetSession() {
+	if config.ConfigFile.Target == "" || config.ConfigFile.RefreshToken == "" {
+		return
+	}
+
+	if config.ConfigFile.TargetSessions == nil {
+		config.ConfigFile.TargetSessions = map[string]TargetSession{}
+	}
+	config.ConfigFile.TargetSessions[config.ConfigFile.Target] = TargetSession{
+		AccessToken:  config.ConfigFile.AccessToken,
+		RefreshToken: config.ConfigFile.RefreshToken,
+	}
+}
+
+// TakeTargetSession removes the stored tokens for the provided API target and
+// returns them. Empty tokens are returned when none are stored.
+func (config *Config) TakeTargetSession(api string) (string, string) {
+	session, ok := config.ConfigFile.TargetSessions[api]
+	if !ok {
+		return "", ""
+	}
+
+	delete(config.ConfigFile.TargetSessions, api)
+	return session.AccessToken, session.RefreshToken
+}
+
 // SetAccessToken sets the current access token
 func (config *Config) SetAccessToken(accessToken string) {
 	config.ConfigFile.AccessToken = accessToken
@@ -323,6 +323,152 @@ var _ = Describe("Config", func() {
 			Entry("uses default value of false if an invalid environment value is set", "something-invalid", false),
 		)
 
+		Describe("RefactoredCommands", func() {
+			BeforeEach(func() {
+				rawConfig := `{ "RefactoredCommands": ["target", "api"] }`
+				setConfig(homeDir, rawConfig)
+			})
+
+			AfterEach(func() {
+				os.Unsetenv("CF_REFACTORED_COMMANDS")
+			})
+
+			It("returns the commands in the config file", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.RefactoredCommands()).To(Equal([]string{"target", "api"}))
+			})
+
+			Context("when $CF_REFACTORED_COMMANDS is set", func() {
+				BeforeEach(func() {
+					os.Setenv("CF_REFACTORED_COMMANDS", "unbind-security-group, orgs,")
+				})
+
+				It("returns the commands in the environment variable", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.RefactoredCommands()).To(Equal([]string{"unbind-security-group", "orgs"}))
+				})
+			})
+		})
+
+		Describe("ParityLogFile", func() {
+			AfterEach(func() {
+				os.Unsetenv("CF_PARITY_LOG")
+			})
+
+			It("returns the value of $CF_PARITY_LOG", func() {
+				os.Setenv("CF_PARITY_LOG", "/some/parity.log")
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.ParityLogFile()).To(Equal("/some/parity.log"))
+			})
+		})
+
+		Describe("OTLPEndpoint", func() {
+			AfterEach(func() {
+				os.Unsetenv("CF_OTLP_ENDPOINT")
+			})
+
+			It("returns the value of $CF_OTLP_ENDPOINT", func() {
+				os.Setenv("CF_OTLP_ENDPOINT", "http://localhost:4318")
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.OTLPEndpoint()).To(Equal("http://localhost:4318"))
+			})
+		})
+
+		Describe("Metrics destinations", func() {
+			AfterEach(func() {
+				os.Unsetenv("CF_METRICS_FILE")
+				os.Unsetenv("CF_METRICS_PUSHGATEWAY")
+			})
+
+			It("returns the values of $CF_METRICS_FILE and $CF_METRICS_PUSHGATEWAY", func() {
+				os.Setenv("CF_METRICS_FILE", "/some/cf.prom")
+				os.Setenv("CF_METRICS_PUSHGATEWAY", "http://localhost:9091")
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.MetricsFile()).To(Equal("/some/cf.prom"))
+				Expect(config.MetricsPushgatewayURL()).To(Equal("http://localhost:9091"))
+			})
+		})
+
+		Describe("AutoscalerEndpoint", func() {
+			AfterEach(func() {
+				os.Unsetenv("CF_AUTOSCALER_API")
+			})
+
+			It("derives the endpoint from the targeted API", func() {
+				rawConfig := `{"Target": "https://api.sys.example.com"}`
+				setConfig(homeDir, rawConfig)
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AutoscalerEndpoint()).To(Equal("https://autoscaler.sys.example.com"))
+			})
+
+			It("returns nothing when the API host name does not start with 'api.'", func() {
+				rawConfig := `{"Target": "https://cf.example.com"}`
+				setConfig(homeDir, rawConfig)
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AutoscalerEndpoint()).To(BeEmpty())
+			})
+
+			It("returns the value of $CF_AUTOSCALER_API when set", func() {
+				os.Setenv("CF_AUTOSCALER_API", "https://autoscaler.example.com/")
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AutoscalerEndpoint()).To(Equal("https://autoscaler.example.com"))
+			})
+		})
+
+		Describe("CredHubEndpoint", func() {
+			AfterEach(func() {
+				os.Unsetenv("CF_CREDHUB_API")
+			})
+
+			It("derives the endpoint from the targeted API", func() {
+				rawConfig := `{"Target": "https://api.sys.example.com"}`
+				setConfig(homeDir, rawConfig)
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CredHubEndpoint()).To(Equal("https://credhub.sys.example.com"))
+			})
+
+			It("returns the value of $CF_CREDHUB_API when set", func() {
+				os.Setenv("CF_CREDHUB_API", "https://credhub.example.com:8844/")
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CredHubEndpoint()).To(Equal("https://credhub.example.com:8844"))
+			})
+		})
+
+		Describe("LogCacheEndpoint", func() {
+			AfterEach(func() {
+				os.Unsetenv("CF_LOG_CACHE_API")
+			})
+
+			It("derives the endpoint from the targeted API", func() {
+				rawConfig := `{"Target": "https://api.sys.example.com"}`
+				setConfig(homeDir, rawConfig)
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.LogCacheEndpoint()).To(Equal("https://log-cache.sys.example.com"))
+			})
+
+			It("returns the value of $CF_LOG_CACHE_API when set", func() {
+				os.Setenv("CF_LOG_CACHE_API", "https://log-cache.example.com/")
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.LogCacheEndpoint()).To(Equal("https://log-cache.example.com"))
+			})
+		})
+
 		Describe("BinaryName", func() {
 			It("returns the name used to invoke", func() {
 				config, err := LoadConfig()
@@ -400,6 +546,30 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("UAAEndpoint", func() {
+			It("returns the UAA endpoint", func() {
+				config := Config{
+					ConfigFile: CFConfig{
+						UAAEndpoint: "https://uaa.some-domain.com",
+					},
+				}
+
+				Expect(config.UAAEndpoint()).To(Equal("https://uaa.some-domain.com"))
+			})
+		})
+
+		Describe("DopplerEndpoint", func() {
+			It("returns the Doppler endpoint", func() {
+				config := Config{
+					ConfigFile: CFConfig{
+						DopplerEndpoint: "wss://doppler.some-domain.com:443",
+					},
+				}
+
+				Expect(config.DopplerEndpoint()).To(Equal("wss://doppler.some-domain.com:443"))
+			})
+		})
+
 		Describe("TargetedOrganization", func() {
 			It("returns the organization", func() {
 				organization := Organization{
@@ -502,6 +672,17 @@ var _ = Describe("Config", func() {
 			It("returns the dial timeout", func() {
 				Expect(config.DialTimeout()).To(Equal(1234 * time.Second))
 			})
+
+			Context("when only the config file sets the dial timeout", func() {
+				BeforeEach(func() {
+					config.ENV.CFDialTimeout = ""
+					config.ConfigFile.DialTimeout = 30
+				})
+
+				It("returns the dial timeout of the config file", func() {
+					Expect(config.DialTimeout()).To(Equal(30 * time.Second))
+				})
+			})
 		})
 
 		Describe("BinaryVersion", func() {
@@ -560,6 +741,28 @@ var _ = Describe("Config", func() {
 				Expect(writtenCFConfig.Target).To(Equal(config.ConfigFile.Target))
 				Expect(writtenCFConfig.ColorEnabled).To(Equal(config.ConfigFile.ColorEnabled))
 			})
+
+			It("replaces an existing config without leaving temporary files behind", func() {
+				err := WriteConfig(config)
+				Expect(err).ToNot(HaveOccurred())
+
+				config.ConfigFile.Target = "bar.com"
+				err = WriteConfig(config)
+				Expect(err).ToNot(HaveOccurred())
+
+				file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+
+				var writtenCFConfig CFConfig
+				err = json.Unmarshal(file, &writtenCFConfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(writtenCFConfig.Target).To(Equal("bar.com"))
+
+				files, err := ioutil.ReadDir(filepath.Join(homeDir, ".cf"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+				Expect(files[0].Name()).To(Equal("config.json"))
+			})
 		})
 
 		Context("when an error is encountered", func() {
@@ -574,10 +777,14 @@ var _ = Describe("Config", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 
-			It("returns the error", func() {
+			It("returns the error and removes the temporary file", func() {
 				err := WriteConfig(config)
-				_, ok := err.(*os.PathError)
+				_, ok := err.(*os.LinkError)
 				Expect(ok).To(BeTrue())
+
+				files, err := ioutil.ReadDir(filepath.Join(homeDir, ".cf"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(files).To(HaveLen(1))
 			})
 		})
 	})
@@ -637,6 +844,86 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SaveTargetSession", func() {
+			var config Config
+
+			BeforeEach(func() {
+				config = Config{
+					ConfigFile: CFConfig{
+						Target:       "https://api.foo.com",
+						AccessToken:  "I am the access token",
+						RefreshToken: "I am the refresh token",
+					},
+				}
+			})
+
+			It("stores the tokens of the current target", func() {
+				config.SaveTargetSession()
+				Expect(config.ConfigFile.TargetSessions).To(Equal(map[string]TargetSession{
+					"https://api.foo.com": {
+						AccessToken:  "I am the access token",
+						RefreshToken: "I am the refresh token",
+					},
+				}))
+			})
+
+			Context("when the user is not logged in", func() {
+				BeforeEach(func() {
+					config.ConfigFile.AccessToken = ""
+					config.ConfigFile.RefreshToken = ""
+				})
+
+				It("does not store anything", func() {
+					config.SaveTargetSession()
+					Expect(config.ConfigFile.TargetSessions).To(BeEmpty())
+				})
+			})
+
+			Context("when there is no target", func() {
+				BeforeEach(func() {
+					config.ConfigFile.Target = ""
+				})
+
+				It("does not store anything", func() {
+					config.SaveTargetSession()
+					Expect(config.ConfigFile.TargetSessions).To(BeEmpty())
+				})
+			})
+		})
+
+		Describe("TakeTargetSession", func() {
+			var config Config
+
+			BeforeEach(func() {
+				config = Config{
+					ConfigFile: CFConfig{
+						TargetSessions: map[string]TargetSession{
+							"https://api.foo.com": {
+								AccessToken:  "I am the access token",
+								RefreshToken: "I am the refresh token",
+							},
+						},
+					},
+				}
+			})
+
+			It("returns and removes the stored tokens of the target", func() {
+				accessToken, refreshToken := config.TakeTargetSession("https://api.foo.com")
+				Expect(accessToken).To(Equal("I am the access token"))
+				Expect(refreshToken).To(Equal("I am the refresh token"))
+				Expect(config.ConfigFile.TargetSessions).To(BeEmpty())
+			})
+
+			Context("when no tokens are stored for the target", func() {
+				It("returns empty tokens", func() {
+					accessToken, refreshToken := config.TakeTargetSession("https://api.bar.com")
+					Expect(accessToken).To(BeEmpty())
+					Expect(refreshToken).To(BeEmpty())
+					Expect(config.ConfigFile.TargetSessions).To(HaveLen(1))
+				})
+			})
+		})
+
 		Describe("SetAccessToken", func() {
 			It("sets the authentication token information", func() {
 				var config Config
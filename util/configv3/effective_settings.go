@@ -0,0 +1,103 @@
+package configv3
+
+import "sort"
+
+// Sources of the value of an EffectiveSetting.
+const (
+	SettingSourceEnvironment = "env"
+	SettingSourceConfig      = "config"
+	SettingSourceDefault     = "default"
+)
+
+// EffectiveSetting is the value that the CLI uses for a CF_* environment
+// variable once the environment, the config file and the defaults have been
+// taken into account.
+type EffectiveSetting struct {
+	EnvironmentVariable string
+	Value               string
+	Source              string
+}
+
+// settingGetters call the getter of every CF_* environment variable read by
+// LoadConfig, so that each records its effective setting.
+var settingGetters = []func(config *Config){
+	func(config *Config) { config.AutoscalerEndpoint() },
+	func(config *Config) { config.ColorEnabled() },
+	func(config *Config) { config.CredHubEndpoint() },
+	func(config *Config) { config.DialTimeout() },
+	func(config *Config) { config.Experimental() },
+	func(config *Config) { config.LogCacheEndpoint() },
+	func(config *Config) { config.LogLevel() },
+	func(config *Config) { config.MetricsFile() },
+	func(config *Config) { config.MetricsPushgatewayURL() },
+	func(config *Config) { config.OTLPEndpoint() },
+	func(config *Config) { config.ParityLogFile() },
+	func(config *Config) { config.PluginHome() },
+	func(config *Config) { config.RefactoredCommands() },
+	func(config *Config) { config.StagingTimeout() },
+	func(config *Config) { config.StartupTimeout() },
+	func(config *Config) { config.TelemetryEndpoint() },
+	func(config *Config) { config.Verbose() },
+	// CF_HOME is read before the config is loaded.
+	func(config *Config) {
+		config.resolveSetting("CF_HOME", envOrDefault(config.ENV.CFHome), homeDirectory())
+	},
+}
+
+// EffectiveSettings returns the value and the source of every CF_* environment
+// variable that the CLI reads, sorted by name. The values are the ones that
+// the getters of the settings last returned.
+func (config *Config) EffectiveSettings() []EffectiveSetting {
+	for _, get := range settingGetters {
+		get(config)
+	}
+
+	config.effectiveSettingsMutex.Lock()
+	defer config.effectiveSettingsMutex.Unlock()
+
+	effective := make([]EffectiveSetting, 0, len(config.effectiveSettings))
+	for _, setting := range config.effectiveSettings {
+		effective = append(effective, setting)
+	}
+	sort.Slice(effective, func(i int, j int) bool {
+		return effective[i].EnvironmentVariable < effective[j].EnvironmentVariable
+	})
+	return effective
+}
+
+// resolveSetting records value, taken from source, as the effective setting of
+// the environment variable and returns it. The getters of the CF_* settings
+// resolve their value through it, so that EffectiveSettings reports what they
+// return.
+func (config *Config) resolveSetting(environmentVariable string, source string, value string) string {
+	config.effectiveSettingsMutex.Lock()
+	defer config.effectiveSettingsMutex.Unlock()
+
+	if config.effectiveSettings == nil {
+		config.effectiveSettings = map[string]EffectiveSetting{}
+	}
+	config.effectiveSettings[environmentVariable] = EffectiveSetting{
+		EnvironmentVariable: environmentVariable,
+		Value:               value,
+		Source:              source,
+	}
+	return value
+}
+
+func envOrDefault(envValue string) string {
+	if envValue != "" {
+		return SettingSourceEnvironment
+	}
+	return SettingSourceDefault
+}
+
+func colorSettingName(setting ColorSetting) string {
+	switch setting {
+	case ColorDisabled:
+		return "false"
+	case ColorAuto:
+		return "auto"
+	default:
+		return "true"
+	}
+}
@@ -0,0 +1,109 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EffectiveSettings", func() {
+	var (
+		config    Config
+		effective map[string]EffectiveSetting
+	)
+
+	BeforeEach(func() {
+		config = Config{}
+	})
+
+	JustBeforeEach(func() {
+		effective = map[string]EffectiveSetting{}
+		for _, setting := range config.EffectiveSettings() {
+			effective[setting.EnvironmentVariable] = setting
+		}
+	})
+
+	It("lists the CF_* environment variables in order", func() {
+		settings := config.EffectiveSettings()
+		Expect(settings).To(HaveLen(18))
+		Expect(settings[0].EnvironmentVariable).To(Equal("CF_AUTOSCALER_API"))
+		Expect(settings[len(settings)-1].EnvironmentVariable).To(Equal("CF_TRACE"))
+	})
+
+	Context("when nothing is set", func() {
+		It("uses the defaults", func() {
+			Expect(effective["CF_STAGING_TIMEOUT"]).To(Equal(EffectiveSetting{
+				EnvironmentVariable: "CF_STAGING_TIMEOUT",
+				Value:               DefaultStagingTimeout.String(),
+				Source:              SettingSourceDefault,
+			}))
+			Expect(effective["CF_TRACE"].Value).To(Equal("false"))
+			Expect(effective["CF_COLOR"].Value).To(Equal("true"))
+			Expect(effective["CF_CLI_EXPERIMENTAL"].Source).To(Equal(SettingSourceDefault))
+		})
+	})
+
+	Context("when the config file sets a value", func() {
+		BeforeEach(func() {
+			config.ConfigFile.StagingTimeout = 30
+			config.ConfigFile.ColorEnabled = "false"
+			config.ConfigFile.Trace = "/tmp/trace.log"
+			config.ConfigFile.RefactoredCommands = []string{"domains"}
+		})
+
+		It("reports the config file as the source", func() {
+			Expect(effective["CF_STAGING_TIMEOUT"].Value).To(Equal("30m0s"))
+			Expect(effective["CF_STAGING_TIMEOUT"].Source).To(Equal(SettingSourceConfig))
+			Expect(effective["CF_COLOR"].Value).To(Equal("false"))
+			Expect(effective["CF_COLOR"].Source).To(Equal(SettingSourceConfig))
+			Expect(effective["CF_TRACE"].Value).To(Equal("/tmp/trace.log"))
+			Expect(effective["CF_TRACE"].Source).To(Equal(SettingSourceConfig))
+			Expect(effective["CF_REFACTORED_COMMANDS"].Value).To(Equal("domains"))
+			Expect(effective["CF_REFACTORED_COMMANDS"].Source).To(Equal(SettingSourceConfig))
+		})
+
+		Context("when the environment overrides it", func() {
+			BeforeEach(func() {
+				config.ENV.CFStagingTimeout = "5"
+				config.ENV.CFColor = "true"
+				config.ENV.CFTrace = "true"
+			})
+
+			It("reports the environment as the source", func() {
+				Expect(effective["CF_STAGING_TIMEOUT"].Value).To(Equal("5m0s"))
+				Expect(effective["CF_STAGING_TIMEOUT"].Source).To(Equal(SettingSourceEnvironment))
+				Expect(effective["CF_COLOR"].Value).To(Equal("true"))
+				Expect(effective["CF_COLOR"].Source).To(Equal(SettingSourceEnvironment))
+				Expect(effective["CF_TRACE"].Source).To(Equal(SettingSourceEnvironment))
+			})
+		})
+	})
+
+	Context("when an environment variable has an invalid value", func() {
+		BeforeEach(func() {
+			config.ENV.CFDialTimeout = "soon"
+			config.ENV.CFLogLevel = "loud"
+		})
+
+		It("reports the value that is used instead", func() {
+			Expect(effective["CF_DIAL_TIMEOUT"].Value).To(Equal(DefaultDialTimeout.String()))
+			Expect(effective["CF_DIAL_TIMEOUT"].Source).To(Equal(SettingSourceDefault))
+			Expect(effective["CF_LOG_LEVEL"].Value).To(Equal("0"))
+			Expect(effective["CF_LOG_LEVEL"].Source).To(Equal(SettingSourceDefault))
+		})
+	})
+
+	Context("when an API endpoint is derived from the target", func() {
+		BeforeEach(func() {
+			config.ConfigFile.Target = "https://api.example.com"
+			config.ENV.CFCredHubAPI = "https://credhub.internal"
+		})
+
+		It("reports the derived endpoint as the default", func() {
+			Expect(effective["CF_AUTOSCALER_API"].Value).To(Equal("https://autoscaler.example.com"))
+			Expect(effective["CF_AUTOSCALER_API"].Source).To(Equal(SettingSourceDefault))
+			Expect(effective["CF_CREDHUB_API"].Value).To(Equal("https://credhub.internal"))
+			Expect(effective["CF_CREDHUB_API"].Source).To(Equal(SettingSourceEnvironment))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package configv3
 import (
 	"sort"
 	"strings"
+	"time"
 )
 
 const (
@@ -28,3 +29,27 @@ func (config *Config) PluginRepositories() []PluginRepository {
 	})
 	return repos
 }
+
+// PluginUpdateCheck returns true if the CLI should check the plugin
+// repositories for newer versions of installed plugins on startup.
+func (config *Config) PluginUpdateCheck() bool {
+	return config.ConfigFile.PluginUpdateCheck
+}
+
+// SetPluginUpdateCheck enables or disables the startup check for plugin
+// updates.
+func (config *Config) SetPluginUpdateCheck(enabled bool) {
+	config.ConfigFile.PluginUpdateCheck = enabled
+}
+
+// LastPluginUpdateCheck returns the last time the CLI checked the plugin
+// repositories for newer versions of installed plugins.
+func (config *Config) LastPluginUpdateCheck() time.Time {
+	return config.ConfigFile.LastPluginUpdateCheck
+}
+
+// SetLastPluginUpdateCheck sets the last time the CLI checked the plugin
+// repositories for newer versions of installed plugins.
+func (config *Config) SetLastPluginUpdateCheck(lastCheck time.Time) {
+	config.ConfigFile.LastPluginUpdateCheck = lastCheck
+}
@@ -1,6 +1,8 @@
 package configv3_test
 
 import (
+	"time"
+
 	. "code.cloudfoundry.org/cli/util/configv3"
 
 	. "github.com/onsi/ginkgo"
@@ -27,4 +29,26 @@ var _ = Describe("PluginRepository", func() {
 			}))
 		})
 	})
+
+	Describe("PluginUpdateCheck", func() {
+		It("is disabled by default and can be toggled", func() {
+			config := Config{}
+			Expect(config.PluginUpdateCheck()).To(BeFalse())
+
+			config.SetPluginUpdateCheck(true)
+			Expect(config.PluginUpdateCheck()).To(BeTrue())
+			Expect(config.ConfigFile.PluginUpdateCheck).To(BeTrue())
+		})
+	})
+
+	Describe("LastPluginUpdateCheck", func() {
+		It("returns the time set with SetLastPluginUpdateCheck", func() {
+			config := Config{}
+			Expect(config.LastPluginUpdateCheck()).To(BeZero())
+
+			now := time.Now()
+			config.SetLastPluginUpdateCheck(now)
+			Expect(config.LastPluginUpdateCheck()).To(Equal(now))
+		})
+	})
 })
@@ -101,10 +101,19 @@ func (c PluginCommand) CommandName() string {
 //   2. Defaults to the home directory (outlined in LoadConfig)/.cf/plugins
 func (config *Config) PluginHome() string {
 	if config.ENV.CFPluginHome != "" {
-		return filepath.Join(config.ENV.CFPluginHome, ".cf", "plugins")
+		return filepath.Join(config.resolveSetting("CF_PLUGIN_HOME", SettingSourceEnvironment, config.ENV.CFPluginHome), ".cf", "plugins")
 	}
 
-	return filepath.Join(homeDirectory(), ".cf", "plugins")
+	return filepath.Join(config.resolveSetting("CF_PLUGIN_HOME", SettingSourceDefault, homeDirectory()), ".cf", "plugins")
+}
+
+// AddPlugin adds the specified plugin to PluginsConfig, replacing any plugin
+// with the same name.
+func (config *Config) AddPlugin(plugin Plugin) {
+	if config.pluginsConfig.Plugins == nil {
+		config.pluginsConfig.Plugins = map[string]Plugin{}
+	}
+	config.pluginsConfig.Plugins[plugin.Name] = plugin
 }
 
 // RemovePlugin removes the specified plugin from PluginsConfig idempotently
@@ -218,6 +218,56 @@ var _ = Describe("PluginsConfig", func() {
 	})
 
 	Describe("Config", func() {
+		Describe("AddPlugin", func() {
+			var config *Config
+
+			Context("when no plugins are installed", func() {
+				BeforeEach(func() {
+					var err error
+					config, err = LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("adds the plugin to the config", func() {
+					config.AddPlugin(Plugin{Name: "plugin-1", Location: "some-location"})
+
+					plugin, exist := config.GetPlugin("plugin-1")
+					Expect(exist).To(BeTrue())
+					Expect(plugin).To(Equal(Plugin{Name: "plugin-1", Location: "some-location"}))
+				})
+			})
+
+			Context("when a plugin with the same name is installed", func() {
+				BeforeEach(func() {
+					rawConfig := `
+					{
+						"Plugins": {
+							"plugin-1": {
+								"Location": "old-location"
+							},
+							"plugin-2": {}
+						}
+					}`
+
+					pluginsPath := filepath.Join(homeDir, ".cf", "plugins")
+					setPluginConfig(pluginsPath, rawConfig)
+
+					var err error
+					config, err = LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("replaces the plugin", func() {
+					config.AddPlugin(Plugin{Name: "plugin-1", Location: "new-location"})
+
+					Expect(config.Plugins()).To(Equal([]Plugin{
+						{Name: "plugin-1", Location: "new-location"},
+						{Name: "plugin-2"},
+					}))
+				})
+			})
+		})
+
 		Describe("RemovePlugin", func() {
 			var (
 				config *Config
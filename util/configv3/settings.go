@@ -0,0 +1,218 @@
+package configv3
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Names of the settings that can be read and written with Setting and
+// SetSetting.
+const (
+	SettingColor             = "color"
+	SettingLocale            = "locale"
+	SettingTrace             = "trace"
+	SettingAsyncTimeout      = "async-timeout"
+	SettingDialTimeout       = "dial-timeout"
+	SettingStagingTimeout    = "staging-timeout"
+	SettingStartupTimeout    = "startup-timeout"
+	SettingOutputFormat      = "output-format"
+	SettingTelemetry         = "telemetry"
+	SettingTelemetryEndpoint = "telemetry-endpoint"
+)
+
+const (
+	// OutputFormatTable displays command output as text and tables.
+	OutputFormatTable = "table"
+
+	// OutputFormatJSON displays command output as JSON for the commands that
+	// support it.
+	OutputFormatJSON = "json"
+)
+
+// UnknownSettingError is returned when a setting does not exist.
+type UnknownSettingError struct {
+	Name string
+}
+
+func (e UnknownSettingError) Error() string {
+	return fmt.Sprintf("Unknown setting '%s'", e.Name)
+}
+
+// InvalidSettingValueError is returned when a value cannot be stored in a
+// setting.
+type InvalidSettingValueError struct {
+	Name     string
+	Value    string
+	Expected string
+}
+
+func (e InvalidSettingValueError) Error() string {
+	return fmt.Sprintf("Invalid value '%s' for setting '%s': must be %s", e.Value, e.Name, e.Expected)
+}
+
+type setting struct {
+	name string
+	get  func(*CFConfig) string
+	set  func(*CFConfig, string) error
+}
+
+// settings are in the order that they are listed in.
+var settings = []setting{
+	{
+		name: SettingColor,
+		get:  func(file *CFConfig) string { return file.ColorEnabled },
+		set: func(file *CFConfig, value string) error {
+			if value != "" && value != "true" && value != "false" {
+				return InvalidSettingValueError{Name: SettingColor, Value: value, Expected: "true or false"}
+			}
+			file.ColorEnabled = value
+			return nil
+		},
+	},
+	{
+		name: SettingLocale,
+		get:  func(file *CFConfig) string { return file.Locale },
+		set: func(file *CFConfig, value string) error {
+			file.Locale = value
+			return nil
+		},
+	},
+	{
+		name: SettingTrace,
+		get:  func(file *CFConfig) string { return file.Trace },
+		set: func(file *CFConfig, value string) error {
+			file.Trace = value
+			return nil
+		},
+	},
+	{
+		name: SettingAsyncTimeout,
+		get:  func(file *CFConfig) string { return formatTimeout(file.AsyncTimeout) },
+		set: func(file *CFConfig, value string) error {
+			return parseTimeoutSetting(SettingAsyncTimeout, value, &file.AsyncTimeout)
+		},
+	},
+	{
+		name: SettingDialTimeout,
+		get:  func(file *CFConfig) string { return formatTimeout(file.DialTimeout) },
+		set: func(file *CFConfig, value string) error {
+			return parseTimeoutSetting(SettingDialTimeout, value, &file.DialTimeout)
+		},
+	},
+	{
+		name: SettingStagingTimeout,
+		get:  func(file *CFConfig) string { return formatTimeout(file.StagingTimeout) },
+		set: func(file *CFConfig, value string) error {
+			return parseTimeoutSetting(SettingStagingTimeout, value, &file.StagingTimeout)
+		},
+	},
+	{
+		name: SettingStartupTimeout,
+		get:  func(file *CFConfig) string { return formatTimeout(file.StartupTimeout) },
+		set: func(file *CFConfig, value string) error {
+			return parseTimeoutSetting(SettingStartupTimeout, value, &file.StartupTimeout)
+		},
+	},
+	{
+		name: SettingOutputFormat,
+		get:  func(file *CFConfig) string { return file.OutputFormat },
+		set: func(file *CFConfig, value string) error {
+			value = strings.ToLower(value)
+			if value != "" && value != OutputFormatTable && value != OutputFormatJSON {
+				return InvalidSettingValueError{Name: SettingOutputFormat, Value: value, Expected: "table or json"}
+			}
+			file.OutputFormat = value
+			return nil
+		},
+	},
+	{
+		name: SettingTelemetry,
+		get:  func(file *CFConfig) string { return file.Telemetry },
+		set: func(file *CFConfig, value string) error {
+			if value != "" && value != "true" && value != "false" {
+				return InvalidSettingValueError{Name: SettingTelemetry, Value: value, Expected: "true or false"}
+			}
+			file.Telemetry = value
+			return nil
+		},
+	},
+	{
+		name: SettingTelemetryEndpoint,
+		get:  func(file *CFConfig) string { return file.TelemetryEndpoint },
+		set: func(file *CFConfig, value string) error {
+			file.TelemetryEndpoint = value
+			return nil
+		},
+	},
+}
+
+// SettingNames returns the names of all the settings.
+func SettingNames() []string {
+	names := make([]string, len(settings))
+	for i, s := range settings {
+		names[i] = s.name
+	}
+	return names
+}
+
+// Setting returns the value of the setting stored in the config file. The
+// empty string means that the setting is not set and its default is used.
+func (config *Config) Setting(name string) (string, error) {
+	s, err := findSetting(name)
+	if err != nil {
+		return "", err
+	}
+	return s.get(&config.ConfigFile), nil
+}
+
+// SetSetting stores the value of the setting in the config file. Setting the
+// empty string restores the default.
+func (config *Config) SetSetting(name string, value string) error {
+	s, err := findSetting(name)
+	if err != nil {
+		return err
+	}
+	return s.set(&config.ConfigFile, value)
+}
+
+// OutputFormat returns the format that commands supporting several formats
+// display their output in. The format is based off of:
+//  1. The config file's OutputFormat value if set
+//  2. Defaults to OutputFormatTable
+func (config *Config) OutputFormat() string {
+	if config.ConfigFile.OutputFormat != "" {
+		return config.ConfigFile.OutputFormat
+	}
+	return OutputFormatTable
+}
+
+func findSetting(name string) (setting, error) {
+	for _, s := range settings {
+		if s.name == name {
+			return s, nil
+		}
+	}
+	return setting{}, UnknownSettingError{Name: name}
+}
+
+func formatTimeout(timeout int) string {
+	if timeout == 0 {
+		return ""
+	}
+	return strconv.Itoa(timeout)
+}
+
+func parseTimeoutSetting(name string, value string, timeout *int) error {
+	if value == "" {
+		*timeout = 0
+		return nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		return InvalidSettingValueError{Name: name, Value: value, Expected: "a non-negative integer"}
+	}
+	*timeout = parsed
+	return nil
+}
@@ -0,0 +1,123 @@
+package configv3_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Settings", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = new(Config)
+	})
+
+	Describe("SettingNames", func() {
+		It("returns the settings in the order they are listed in", func() {
+			Expect(SettingNames()).To(Equal([]string{
+				"color",
+				"locale",
+				"trace",
+				"async-timeout",
+				"dial-timeout",
+				"staging-timeout",
+				"startup-timeout",
+				"output-format",
+				"telemetry",
+				"telemetry-endpoint",
+			}))
+		})
+	})
+
+	Describe("SetSetting and Setting", func() {
+		It("stores the settings in the config file", func() {
+			Expect(config.SetSetting("color", "false")).To(Succeed())
+			Expect(config.SetSetting("locale", "fr-FR")).To(Succeed())
+			Expect(config.SetSetting("trace", "/tmp/trace.log")).To(Succeed())
+			Expect(config.SetSetting("async-timeout", "15")).To(Succeed())
+			Expect(config.SetSetting("dial-timeout", "30")).To(Succeed())
+			Expect(config.SetSetting("staging-timeout", "20")).To(Succeed())
+			Expect(config.SetSetting("startup-timeout", "10")).To(Succeed())
+			Expect(config.SetSetting("output-format", "JSON")).To(Succeed())
+			Expect(config.SetSetting("telemetry", "true")).To(Succeed())
+			Expect(config.SetSetting("telemetry-endpoint", "https://telemetry.example.com")).To(Succeed())
+
+			Expect(config.ConfigFile.ColorEnabled).To(Equal("false"))
+			Expect(config.ConfigFile.Locale).To(Equal("fr-FR"))
+			Expect(config.ConfigFile.Trace).To(Equal("/tmp/trace.log"))
+			Expect(config.ConfigFile.AsyncTimeout).To(Equal(15))
+			Expect(config.ConfigFile.DialTimeout).To(Equal(30))
+			Expect(config.ConfigFile.StagingTimeout).To(Equal(20))
+			Expect(config.ConfigFile.StartupTimeout).To(Equal(10))
+			Expect(config.ConfigFile.OutputFormat).To(Equal("json"))
+			Expect(config.ConfigFile.Telemetry).To(Equal("true"))
+			Expect(config.ConfigFile.TelemetryEndpoint).To(Equal("https://telemetry.example.com"))
+
+			value, err := config.Setting("staging-timeout")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal("20"))
+
+			value, err = config.Setting("output-format")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal("json"))
+		})
+
+		It("is used by the accessors when the environment does not override it", func() {
+			Expect(config.SetSetting("staging-timeout", "20")).To(Succeed())
+			Expect(config.SetSetting("startup-timeout", "10")).To(Succeed())
+			Expect(config.SetSetting("output-format", "json")).To(Succeed())
+
+			Expect(config.StagingTimeout()).To(Equal(20 * time.Minute))
+			Expect(config.StartupTimeout()).To(Equal(10 * time.Minute))
+			Expect(config.OutputFormat()).To(Equal(OutputFormatJSON))
+
+			config.ENV.CFStagingTimeout = "5"
+			Expect(config.StagingTimeout()).To(Equal(5 * time.Minute))
+		})
+
+		Context("when the value is empty", func() {
+			BeforeEach(func() {
+				Expect(config.SetSetting("async-timeout", "15")).To(Succeed())
+				Expect(config.SetSetting("output-format", "json")).To(Succeed())
+			})
+
+			It("restores the default", func() {
+				Expect(config.SetSetting("async-timeout", "")).To(Succeed())
+				Expect(config.SetSetting("output-format", "")).To(Succeed())
+
+				value, err := config.Setting("async-timeout")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(BeEmpty())
+				Expect(config.OverallPollingTimeout()).To(Equal(DefaultOverallPollingTimeout))
+				Expect(config.OutputFormat()).To(Equal(OutputFormatTable))
+			})
+		})
+
+		Context("when the setting does not exist", func() {
+			It("returns an UnknownSettingError", func() {
+				_, err := config.Setting("some-setting")
+				Expect(err).To(MatchError(UnknownSettingError{Name: "some-setting"}))
+
+				err = config.SetSetting("some-setting", "some-value")
+				Expect(err).To(MatchError(UnknownSettingError{Name: "some-setting"}))
+			})
+		})
+
+		Context("when the value is invalid", func() {
+			It("returns an InvalidSettingValueError", func() {
+				err := config.SetSetting("color", "maybe")
+				Expect(err).To(MatchError(InvalidSettingValueError{Name: "color", Value: "maybe", Expected: "true or false"}))
+
+				err = config.SetSetting("startup-timeout", "-1")
+				Expect(err).To(MatchError(InvalidSettingValueError{Name: "startup-timeout", Value: "-1", Expected: "a non-negative integer"}))
+
+				err = config.SetSetting("output-format", "yaml")
+				Expect(err).To(MatchError(InvalidSettingValueError{Name: "output-format", Value: "yaml", Expected: "table or json"}))
+				Expect(config.ConfigFile.OutputFormat).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package configv3
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TaskSchedule represents the tasks scheduled with schedule-task that have not
+// been run yet.
+type TaskSchedule struct {
+	Tasks []ScheduledTask `json:"Tasks"`
+}
+
+// ScheduledTask is a task that task-runner runs on an app once RunAt has
+// passed. The org and space names are only kept for display.
+type ScheduledTask struct {
+	ID         string    `json:"ID"`
+	AppGUID    string    `json:"AppGUID"`
+	AppName    string    `json:"AppName"`
+	OrgName    string    `json:"OrgName"`
+	SpaceName  string    `json:"SpaceName"`
+	Name       string    `json:"Name"`
+	Command    string    `json:"Command"`
+	DiskInMB   uint64    `json:"DiskInMB"`
+	MemoryInMB uint64    `json:"MemoryInMB"`
+	RunAt      time.Time `json:"RunAt"`
+}
+
+// TaskScheduleFilePath returns the location of the task schedule file, next
+// to the config file.
+func TaskScheduleFilePath() string {
+	return filepath.Join(filepath.Dir(ConfigFilePath()), "task_schedule.json")
+}
+
+// ScheduledTasks reads the scheduled tasks from the task schedule file. The
+// file is read on every call so that a running task-runner sees tasks
+// scheduled by other cf processes.
+func (config *Config) ScheduledTasks() ([]ScheduledTask, error) {
+	rawSchedule, err := ioutil.ReadFile(TaskScheduleFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule TaskSchedule
+	err = json.Unmarshal(rawSchedule, &schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule.Tasks, nil
+}
+
+// WriteScheduledTasks replaces the contents of the task schedule file with
+// the provided tasks. The tasks are written to a temporary file that then
+// replaces task_schedule.json, so a cf process reading the schedule never
+// sees a partially written file.
+func (config *Config) WriteScheduledTasks(tasks []ScheduledTask) error {
+	rawSchedule, err := json.MarshalIndent(TaskSchedule{Tasks: tasks}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	scheduleDir := filepath.Dir(TaskScheduleFilePath())
+	err = os.MkdirAll(scheduleDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempScheduleFile, err := ioutil.TempFile(scheduleDir, "temp-task-schedule")
+	if err != nil {
+		return err
+	}
+	tempScheduleFilePath := tempScheduleFile.Name()
+
+	_, err = tempScheduleFile.Write(rawSchedule)
+	if closeErr := tempScheduleFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempScheduleFilePath, TaskScheduleFilePath())
+	}
+	if err != nil {
+		_ = os.Remove(tempScheduleFilePath)
+	}
+
+	return err
+}
@@ -0,0 +1,116 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskSchedule", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("ScheduledTasks", func() {
+		Context("when the task schedule file does not exist", func() {
+			It("returns no tasks", func() {
+				tasks, err := config.ScheduledTasks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tasks).To(BeEmpty())
+			})
+		})
+
+		Context("when the task schedule file is invalid", func() {
+			BeforeEach(func() {
+				err := os.MkdirAll(filepath.Join(homeDir, ".cf"), 0700)
+				Expect(err).ToNot(HaveOccurred())
+				err = ioutil.WriteFile(filepath.Join(homeDir, ".cf", "task_schedule.json"), []byte("not-json"), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				_, err := config.ScheduledTasks()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("WriteScheduledTasks", func() {
+		It("writes the tasks to .cf/task_schedule.json so they can be read back", func() {
+			runAt := time.Date(2017, 6, 1, 15, 4, 0, 0, time.UTC)
+			tasks := []ScheduledTask{{
+				ID:         "some-id",
+				AppGUID:    "some-app-guid",
+				AppName:    "some-app",
+				OrgName:    "some-org",
+				SpaceName:  "some-space",
+				Name:       "some-task",
+				Command:    "some-command",
+				MemoryInMB: 256,
+				RunAt:      runAt,
+			}}
+
+			err := config.WriteScheduledTasks(tasks)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filepath.Join(homeDir, ".cf", "task_schedule.json")).To(BeAnExistingFile())
+
+			readTasks, err := config.ScheduledTasks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readTasks).To(HaveLen(1))
+			Expect(readTasks[0].RunAt.Equal(runAt)).To(BeTrue())
+			readTasks[0].RunAt = runAt
+			Expect(readTasks).To(Equal(tasks))
+		})
+
+		It("replaces an existing schedule without leaving temporary files behind", func() {
+			err := config.WriteScheduledTasks([]ScheduledTask{{ID: "some-id"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = config.WriteScheduledTasks([]ScheduledTask{{ID: "some-other-id"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			readTasks, err := config.ScheduledTasks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readTasks).To(Equal([]ScheduledTask{{ID: "some-other-id"}}))
+
+			temporaryFiles, err := filepath.Glob(filepath.Join(homeDir, ".cf", "temp-task-schedule*"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(temporaryFiles).To(BeEmpty())
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				err := os.MkdirAll(filepath.Join(homeDir, ".cf", "task_schedule.json"), 0700)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the error and removes the temporary file", func() {
+				err := config.WriteScheduledTasks([]ScheduledTask{{ID: "some-id"}})
+				_, ok := err.(*os.LinkError)
+				Expect(ok).To(BeTrue())
+
+				temporaryFiles, err := filepath.Glob(filepath.Join(homeDir, ".cf", "temp-task-schedule*"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(temporaryFiles).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,47 @@
+package configv3
+
+import "path/filepath"
+
+// TelemetryEndpoint returns the URL that anonymous usage statistics are sent
+// to. This is based off of:
+//   1. The $CF_TELEMETRY_ENDPOINT environment variable if set
+//   2. The config file's TelemetryEndpoint value if set
+//   3. Defaults to the empty string, in which case no statistics are sent
+func (config *Config) TelemetryEndpoint() string {
+	switch {
+	case config.ENV.CFTelemetryEndpoint != "":
+		return config.resolveSetting("CF_TELEMETRY_ENDPOINT", SettingSourceEnvironment, config.ENV.CFTelemetryEndpoint)
+	case config.ConfigFile.TelemetryEndpoint != "":
+		return config.resolveSetting("CF_TELEMETRY_ENDPOINT", SettingSourceConfig, config.ConfigFile.TelemetryEndpoint)
+	default:
+		return config.resolveSetting("CF_TELEMETRY_ENDPOINT", SettingSourceDefault, "")
+	}
+}
+
+// TelemetryEnabled returns true when the user has agreed to send anonymous
+// usage statistics and there is an endpoint to send them to.
+func (config *Config) TelemetryEnabled() bool {
+	return config.ConfigFile.Telemetry == "true" && config.TelemetryEndpoint() != ""
+}
+
+// TelemetryUndecided returns true when statistics could be sent but the user
+// has neither agreed nor refused to send them.
+func (config *Config) TelemetryUndecided() bool {
+	return config.ConfigFile.Telemetry == "" && config.TelemetryEndpoint() != ""
+}
+
+// SetTelemetry records whether the user agrees to send anonymous usage
+// statistics.
+func (config *Config) SetTelemetry(enabled bool) {
+	if enabled {
+		config.ConfigFile.Telemetry = "true"
+	} else {
+		config.ConfigFile.Telemetry = "false"
+	}
+}
+
+// TelemetryQueueFilePath returns the file that usage statistics are batched in
+// until they are sent.
+func TelemetryQueueFilePath() string {
+	return filepath.Join(filepath.Dir(ConfigFilePath()), "telemetry.json")
+}
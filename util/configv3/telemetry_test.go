@@ -0,0 +1,58 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Telemetry", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = new(Config)
+	})
+
+	Context("when there is no endpoint", func() {
+		BeforeEach(func() {
+			config.ConfigFile.Telemetry = "true"
+		})
+
+		It("is disabled and does not need a decision", func() {
+			Expect(config.TelemetryEndpoint()).To(BeEmpty())
+			Expect(config.TelemetryEnabled()).To(BeFalse())
+			Expect(config.TelemetryUndecided()).To(BeFalse())
+		})
+	})
+
+	Context("when there is an endpoint", func() {
+		BeforeEach(func() {
+			config.ConfigFile.TelemetryEndpoint = "https://config.example.com"
+		})
+
+		It("needs a decision before it is enabled", func() {
+			Expect(config.TelemetryEnabled()).To(BeFalse())
+			Expect(config.TelemetryUndecided()).To(BeTrue())
+
+			config.SetTelemetry(true)
+			Expect(config.ConfigFile.Telemetry).To(Equal("true"))
+			Expect(config.TelemetryEnabled()).To(BeTrue())
+			Expect(config.TelemetryUndecided()).To(BeFalse())
+
+			config.SetTelemetry(false)
+			Expect(config.ConfigFile.Telemetry).To(Equal("false"))
+			Expect(config.TelemetryEnabled()).To(BeFalse())
+			Expect(config.TelemetryUndecided()).To(BeFalse())
+		})
+
+		Context("when $CF_TELEMETRY_ENDPOINT is set", func() {
+			BeforeEach(func() {
+				config.ENV.CFTelemetryEndpoint = "https://env.example.com"
+			})
+
+			It("takes precedence over the config file", func() {
+				Expect(config.TelemetryEndpoint()).To(Equal("https://env.example.com"))
+			})
+		})
+	})
+})
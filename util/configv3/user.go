@@ -1,6 +1,11 @@
 package configv3
 
-import "github.com/SermoDigital/jose/jws"
+import (
+	"strings"
+	"time"
+
+	"github.com/SermoDigital/jose/jws"
+)
 
 // User represents the user information provided by the JWT access token
 type User struct {
@@ -13,6 +18,24 @@ func (config *Config) CurrentUser() (User, error) {
 	return decodeUserFromJWT(config.ConfigFile.AccessToken)
 }
 
+// AccessTokenExpiresAt returns the expiry time decoded from the JWT access
+// token in .cf/config.json. The zero time is returned when there is no access
+// token or its expiry cannot be decoded.
+func (config *Config) AccessTokenExpiresAt() time.Time {
+	fields := strings.Fields(config.ConfigFile.AccessToken)
+	if len(fields) == 0 {
+		return time.Time{}
+	}
+
+	token, err := jws.ParseJWT([]byte(fields[len(fields)-1]))
+	if err != nil {
+		return time.Time{}
+	}
+
+	expiresAt, _ := token.Claims().Expiration()
+	return expiresAt
+}
+
 func decodeUserFromJWT(accessToken string) (User, error) {
 	if accessToken == "" {
 		return User{}, nil
@@ -0,0 +1,61 @@
+// Package exitcode defines the exit codes with which push reports why it
+// failed, so that scripts can tell a configuration that has to be fixed from
+// a failure that is worth retrying.
+package exitcode
+
+const (
+	// Failed is the exit code of every failure without a more specific code.
+	Failed = 1
+	// ValidationFailed means that the manifest or the flags are invalid.
+	ValidationFailed = 3
+	// UploadFailed means that the application files could not be uploaded.
+	UploadFailed = 4
+	// StagingFailed means that the application failed to stage or did not
+	// stage in time.
+	StagingFailed = 5
+	// StartupTimeout means that no instance of the application started in
+	// time.
+	StartupTimeout = 6
+	// Interrupted means that the command was stopped by an interrupt or a
+	// termination signal.
+	Interrupted = 130
+)
+
+// Coder is implemented by errors that exit with a specific code.
+type Coder interface {
+	ExitCode() int
+}
+
+// Error is an error that exits with Code.
+type Error struct {
+	Err  error
+	Code int
+}
+
+func (e Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e Error) ExitCode() int {
+	return e.Code
+}
+
+// Wrap returns err with the exit code, or nil when err is nil.
+func Wrap(err error, code int) error {
+	if err == nil {
+		return nil
+	}
+	return Error{Err: err, Code: code}
+}
+
+// Of returns the exit code of err: 0 when it is nil, its own code when it is
+// a Coder and Failed otherwise.
+func Of(err error) int {
+	if err == nil {
+		return 0
+	}
+	if coder, ok := err.(Coder); ok {
+		return coder.ExitCode()
+	}
+	return Failed
+}
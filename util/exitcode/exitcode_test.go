@@ -0,0 +1,36 @@
+package exitcode_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/util/exitcode"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("exit codes", func() {
+	Describe("Of", func() {
+		It("returns 0 for no error", func() {
+			Expect(Of(nil)).To(Equal(0))
+		})
+
+		It("returns Failed for an error without a code", func() {
+			Expect(Of(errors.New("some-error"))).To(Equal(Failed))
+		})
+
+		It("returns the code of a wrapped error", func() {
+			Expect(Of(Wrap(errors.New("some-error"), StagingFailed))).To(Equal(StagingFailed))
+		})
+	})
+
+	Describe("Wrap", func() {
+		It("keeps the message of the error", func() {
+			Expect(Wrap(errors.New("some-error"), UploadFailed)).To(MatchError("some-error"))
+		})
+
+		It("returns nil for no error", func() {
+			Expect(Wrap(nil, UploadFailed)).To(BeNil())
+		})
+	})
+})
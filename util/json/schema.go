@@ -0,0 +1,243 @@
+package json
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ValidateAgainstSchema validates value against a JSON schema and returns a
+// message for every violation found. Only the keywords brokers commonly use
+// to describe configuration parameters are checked: type, enum, properties,
+// required, additionalProperties, items, minimum, maximum, minLength,
+// maxLength, pattern, minItems and maxItems. Unsupported keywords are
+// ignored.
+func ValidateAgainstSchema(schema map[string]interface{}, value interface{}) []string {
+	var errs []string
+	validate(schema, value, "", &errs)
+	return errs
+}
+
+func validate(schema map[string]interface{}, value interface{}, path string, errs *[]string) {
+	addError := func(format string, args ...interface{}) {
+		*errs = append(*errs, fmt.Sprintf("%s: %s", displayPath(path), fmt.Sprintf(format, args...)))
+	}
+
+	if types := schemaTypes(schema["type"]); len(types) > 0 && !matchesAnyType(value, types) {
+		addError("expected %s, got %s", strings.Join(types, " or "), jsonType(value))
+		return
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		addError("must be one of %s", formatValues(enum))
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		validateObject(schema, typedValue, path, errs, addError)
+	case []interface{}:
+		if minItems, ok := schema["minItems"].(float64); ok && float64(len(typedValue)) < minItems {
+			addError("must contain at least %v items", minItems)
+		}
+		if maxItems, ok := schema["maxItems"].(float64); ok && float64(len(typedValue)) > maxItems {
+			addError("must contain at most %v items", maxItems)
+		}
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range typedValue {
+				validate(items, item, fmt.Sprintf("%s[%d]", path, i), errs)
+			}
+		}
+	case string:
+		length := float64(len([]rune(typedValue)))
+		if minLength, ok := schema["minLength"].(float64); ok && length < minLength {
+			addError("must be at least %v characters long", minLength)
+		}
+		if maxLength, ok := schema["maxLength"].(float64); ok && length > maxLength {
+			addError("must be at most %v characters long", maxLength)
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if matcher, err := regexp.Compile(pattern); err == nil && !matcher.MatchString(typedValue) {
+				addError("must match pattern %s", pattern)
+			}
+		}
+	case float64:
+		if minimum, ok := schema["minimum"].(float64); ok && typedValue < minimum {
+			addError("must be at least %v", minimum)
+		}
+		if maximum, ok := schema["maximum"].(float64); ok && typedValue > maximum {
+			addError("must be at most %v", maximum)
+		}
+	}
+}
+
+func validateObject(schema map[string]interface{}, object map[string]interface{}, path string, errs *[]string, addError func(string, ...interface{})) {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, present := object[name]; !present {
+					addError("missing required property %s", name)
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertyPath := name
+		if path != "" {
+			propertyPath = path + "." + name
+		}
+
+		if propertySchema, ok := properties[name].(map[string]interface{}); ok {
+			validate(propertySchema, object[name], propertyPath, errs)
+			continue
+		}
+
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				addError("unknown property %s", name)
+			}
+		case map[string]interface{}:
+			validate(additional, object[name], propertyPath, errs)
+		}
+	}
+}
+
+func schemaTypes(rawType interface{}) []string {
+	switch typedType := rawType.(type) {
+	case string:
+		return []string{typedType}
+	case []interface{}:
+		var types []string
+		for _, t := range typedType {
+			if t, ok := t.(string); ok {
+				types = append(types, t)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func matchesAnyType(value interface{}, types []string) bool {
+	actual := jsonType(value)
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonType(value interface{}) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if typedValue == math.Trunc(typedValue) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatValues(values []interface{}) string {
+	formatted := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			formatted = append(formatted, fmt.Sprintf("%q", s))
+		} else {
+			formatted = append(formatted, fmt.Sprintf("%v", v))
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "parameters"
+	}
+	return path
+}
+
+// SchemaParameter describes a single property declared by a JSON schema.
+type SchemaParameter struct {
+	Name        string
+	Type        string
+	Required    bool
+	Description string
+}
+
+// SchemaParameters lists the properties declared by an object schema, sorted
+// by name. Properties of nested object schemas are listed with their full
+// dotted name after their parent.
+func SchemaParameters(schema map[string]interface{}) []SchemaParameter {
+	var parameters []SchemaParameter
+	collectParameters(schema, "", &parameters)
+	return parameters
+}
+
+func collectParameters(schema map[string]interface{}, prefix string, parameters *[]SchemaParameter) {
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	required := map[string]bool{}
+	if requiredNames, ok := schema["required"].([]interface{}); ok {
+		for _, name := range requiredNames {
+			if name, ok := name.(string); ok {
+				required[name] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertySchema, ok := properties[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		description, _ := propertySchema["description"].(string)
+		*parameters = append(*parameters, SchemaParameter{
+			Name:        prefix + name,
+			Type:        strings.Join(schemaTypes(propertySchema["type"]), ", "),
+			Required:    required[name],
+			Description: description,
+		})
+
+		collectParameters(propertySchema, prefix+name+".", parameters)
+	}
+}
@@ -0,0 +1,97 @@
+package json_test
+
+import (
+	"code.cloudfoundry.org/cli/util/json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JSON Schema", func() {
+	var schema map[string]interface{}
+
+	BeforeEach(func() {
+		schema = map[string]interface{}{
+			"type":                 "object",
+			"required":             []interface{}{"plan_size"},
+			"additionalProperties": false,
+			"properties": map[string]interface{}{
+				"plan_size": map[string]interface{}{
+					"type":        "string",
+					"description": "Size of the database",
+					"enum":        []interface{}{"small", "large"},
+				},
+				"cluster_nodes": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"count": map[string]interface{}{
+							"type":    "integer",
+							"minimum": float64(1),
+							"maximum": float64(5),
+						},
+					},
+				},
+				"tags": map[string]interface{}{
+					"type":     "array",
+					"maxItems": float64(2),
+					"items": map[string]interface{}{
+						"type":      "string",
+						"minLength": float64(2),
+					},
+				},
+			},
+		}
+	})
+
+	Describe("ValidateAgainstSchema", func() {
+		It("returns no errors for valid parameters", func() {
+			errs := json.ValidateAgainstSchema(schema, map[string]interface{}{
+				"plan_size":     "small",
+				"cluster_nodes": map[string]interface{}{"count": float64(3)},
+				"tags":          []interface{}{"ab", "cd"},
+			})
+			Expect(errs).To(BeEmpty())
+		})
+
+		It("returns an error for every violation", func() {
+			errs := json.ValidateAgainstSchema(schema, map[string]interface{}{
+				"cluster_nodes": map[string]interface{}{"count": float64(7)},
+				"tags":          []interface{}{"a", "bc", "de"},
+				"unknown":       true,
+			})
+			Expect(errs).To(Equal([]string{
+				"parameters: missing required property plan_size",
+				"cluster_nodes.count: must be at most 5",
+				"tags: must contain at most 2 items",
+				"tags[0]: must be at least 2 characters long",
+				"parameters: unknown property unknown",
+			}))
+		})
+
+		It("checks types and enums", func() {
+			errs := json.ValidateAgainstSchema(schema, map[string]interface{}{
+				"plan_size":     "medium",
+				"cluster_nodes": map[string]interface{}{"count": 2.5},
+			})
+			Expect(errs).To(Equal([]string{
+				"cluster_nodes.count: expected integer, got number",
+				`plan_size: must be one of "small", "large"`,
+			}))
+		})
+
+		It("ignores unsupported keywords", func() {
+			errs := json.ValidateAgainstSchema(map[string]interface{}{"format": "email"}, "not-an-email")
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	Describe("SchemaParameters", func() {
+		It("lists the declared properties including nested ones", func() {
+			Expect(json.SchemaParameters(schema)).To(Equal([]json.SchemaParameter{
+				{Name: "cluster_nodes", Type: "object"},
+				{Name: "cluster_nodes.count", Type: "integer"},
+				{Name: "plan_size", Type: "string", Required: true, Description: "Size of the database"},
+				{Name: "tags", Type: "array"},
+			}))
+		})
+	})
+})
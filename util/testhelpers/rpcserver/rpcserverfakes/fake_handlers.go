@@ -19,6 +19,9 @@ type FakeHandlers struct {
 	isMinCliVersionReturns struct {
 		result1 error
 	}
+	isMinCliVersionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetPluginMetadataStub        func(pluginMetadata plugin.PluginMetadata, retVal *bool) error
 	setPluginMetadataMutex       sync.RWMutex
 	setPluginMetadataArgsForCall []struct {
@@ -28,6 +31,9 @@ type FakeHandlers struct {
 	setPluginMetadataReturns struct {
 		result1 error
 	}
+	setPluginMetadataReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisableTerminalOutputStub        func(disable bool, retVal *bool) error
 	disableTerminalOutputMutex       sync.RWMutex
 	disableTerminalOutputArgsForCall []struct {
@@ -37,6 +43,9 @@ type FakeHandlers struct {
 	disableTerminalOutputReturns struct {
 		result1 error
 	}
+	disableTerminalOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CallCoreCommandStub        func(args []string, retVal *bool) error
 	callCoreCommandMutex       sync.RWMutex
 	callCoreCommandArgsForCall []struct {
@@ -46,6 +55,9 @@ type FakeHandlers struct {
 	callCoreCommandReturns struct {
 		result1 error
 	}
+	callCoreCommandReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetOutputAndResetStub        func(args bool, retVal *[]string) error
 	getOutputAndResetMutex       sync.RWMutex
 	getOutputAndResetArgsForCall []struct {
@@ -55,6 +67,9 @@ type FakeHandlers struct {
 	getOutputAndResetReturns struct {
 		result1 error
 	}
+	getOutputAndResetReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetCurrentOrgStub        func(args string, retVal *plugin_models.Organization) error
 	getCurrentOrgMutex       sync.RWMutex
 	getCurrentOrgArgsForCall []struct {
@@ -64,6 +79,9 @@ type FakeHandlers struct {
 	getCurrentOrgReturns struct {
 		result1 error
 	}
+	getCurrentOrgReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetCurrentSpaceStub        func(args string, retVal *plugin_models.Space) error
 	getCurrentSpaceMutex       sync.RWMutex
 	getCurrentSpaceArgsForCall []struct {
@@ -73,6 +91,9 @@ type FakeHandlers struct {
 	getCurrentSpaceReturns struct {
 		result1 error
 	}
+	getCurrentSpaceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UsernameStub        func(args string, retVal *string) error
 	usernameMutex       sync.RWMutex
 	usernameArgsForCall []struct {
@@ -82,6 +103,9 @@ type FakeHandlers struct {
 	usernameReturns struct {
 		result1 error
 	}
+	usernameReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UserGuidStub        func(args string, retVal *string) error
 	userGuidMutex       sync.RWMutex
 	userGuidArgsForCall []struct {
@@ -91,6 +115,9 @@ type FakeHandlers struct {
 	userGuidReturns struct {
 		result1 error
 	}
+	userGuidReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UserEmailStub        func(args string, retVal *string) error
 	userEmailMutex       sync.RWMutex
 	userEmailArgsForCall []struct {
@@ -100,6 +127,9 @@ type FakeHandlers struct {
 	userEmailReturns struct {
 		result1 error
 	}
+	userEmailReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IsLoggedInStub        func(args string, retVal *bool) error
 	isLoggedInMutex       sync.RWMutex
 	isLoggedInArgsForCall []struct {
@@ -109,6 +139,9 @@ type FakeHandlers struct {
 	isLoggedInReturns struct {
 		result1 error
 	}
+	isLoggedInReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IsSSLDisabledStub        func(args string, retVal *bool) error
 	isSSLDisabledMutex       sync.RWMutex
 	isSSLDisabledArgsForCall []struct {
@@ -118,6 +151,9 @@ type FakeHandlers struct {
 	isSSLDisabledReturns struct {
 		result1 error
 	}
+	isSSLDisabledReturnsOnCall map[int]struct {
+		result1 error
+	}
 	HasOrganizationStub        func(args string, retVal *bool) error
 	hasOrganizationMutex       sync.RWMutex
 	hasOrganizationArgsForCall []struct {
@@ -127,6 +163,9 @@ type FakeHandlers struct {
 	hasOrganizationReturns struct {
 		result1 error
 	}
+	hasOrganizationReturnsOnCall map[int]struct {
+		result1 error
+	}
 	HasSpaceStub        func(args string, retVal *bool) error
 	hasSpaceMutex       sync.RWMutex
 	hasSpaceArgsForCall []struct {
@@ -136,6 +175,9 @@ type FakeHandlers struct {
 	hasSpaceReturns struct {
 		result1 error
 	}
+	hasSpaceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ApiEndpointStub        func(args string, retVal *string) error
 	apiEndpointMutex       sync.RWMutex
 	apiEndpointArgsForCall []struct {
@@ -145,6 +187,9 @@ type FakeHandlers struct {
 	apiEndpointReturns struct {
 		result1 error
 	}
+	apiEndpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	HasAPIEndpointStub        func(args string, retVal *bool) error
 	hasAPIEndpointMutex       sync.RWMutex
 	hasAPIEndpointArgsForCall []struct {
@@ -154,6 +199,9 @@ type FakeHandlers struct {
 	hasAPIEndpointReturns struct {
 		result1 error
 	}
+	hasAPIEndpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ApiVersionStub        func(args string, retVal *string) error
 	apiVersionMutex       sync.RWMutex
 	apiVersionArgsForCall []struct {
@@ -163,6 +211,9 @@ type FakeHandlers struct {
 	apiVersionReturns struct {
 		result1 error
 	}
+	apiVersionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	LoggregatorEndpointStub        func(args string, retVal *string) error
 	loggregatorEndpointMutex       sync.RWMutex
 	loggregatorEndpointArgsForCall []struct {
@@ -172,6 +223,9 @@ type FakeHandlers struct {
 	loggregatorEndpointReturns struct {
 		result1 error
 	}
+	loggregatorEndpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DopplerEndpointStub        func(args string, retVal *string) error
 	dopplerEndpointMutex       sync.RWMutex
 	dopplerEndpointArgsForCall []struct {
@@ -181,6 +235,9 @@ type FakeHandlers struct {
 	dopplerEndpointReturns struct {
 		result1 error
 	}
+	dopplerEndpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	AccessTokenStub        func(args string, retVal *string) error
 	accessTokenMutex       sync.RWMutex
 	accessTokenArgsForCall []struct {
@@ -190,6 +247,9 @@ type FakeHandlers struct {
 	accessTokenReturns struct {
 		result1 error
 	}
+	accessTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetAppStub        func(appName string, retVal *plugin_models.GetAppModel) error
 	getAppMutex       sync.RWMutex
 	getAppArgsForCall []struct {
@@ -199,6 +259,9 @@ type FakeHandlers struct {
 	getAppReturns struct {
 		result1 error
 	}
+	getAppReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetAppsStub        func(args string, retVal *[]plugin_models.GetAppsModel) error
 	getAppsMutex       sync.RWMutex
 	getAppsArgsForCall []struct {
@@ -208,6 +271,9 @@ type FakeHandlers struct {
 	getAppsReturns struct {
 		result1 error
 	}
+	getAppsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetOrgsStub        func(args string, retVal *[]plugin_models.GetOrgs_Model) error
 	getOrgsMutex       sync.RWMutex
 	getOrgsArgsForCall []struct {
@@ -217,6 +283,9 @@ type FakeHandlers struct {
 	getOrgsReturns struct {
 		result1 error
 	}
+	getOrgsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetSpacesStub        func(args string, retVal *[]plugin_models.GetSpaces_Model) error
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -226,6 +295,9 @@ type FakeHandlers struct {
 	getSpacesReturns struct {
 		result1 error
 	}
+	getSpacesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetServicesStub        func(args string, retVal *[]plugin_models.GetServices_Model) error
 	getServicesMutex       sync.RWMutex
 	getServicesArgsForCall []struct {
@@ -235,6 +307,9 @@ type FakeHandlers struct {
 	getServicesReturns struct {
 		result1 error
 	}
+	getServicesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetOrgUsersStub        func(args []string, retVal *[]plugin_models.GetOrgUsers_Model) error
 	getOrgUsersMutex       sync.RWMutex
 	getOrgUsersArgsForCall []struct {
@@ -244,6 +319,9 @@ type FakeHandlers struct {
 	getOrgUsersReturns struct {
 		result1 error
 	}
+	getOrgUsersReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetSpaceUsersStub        func(args []string, retVal *[]plugin_models.GetSpaceUsers_Model) error
 	getSpaceUsersMutex       sync.RWMutex
 	getSpaceUsersArgsForCall []struct {
@@ -253,6 +331,9 @@ type FakeHandlers struct {
 	getSpaceUsersReturns struct {
 		result1 error
 	}
+	getSpaceUsersReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetOrgStub        func(orgName string, retVal *plugin_models.GetOrg_Model) error
 	getOrgMutex       sync.RWMutex
 	getOrgArgsForCall []struct {
@@ -262,6 +343,9 @@ type FakeHandlers struct {
 	getOrgReturns struct {
 		result1 error
 	}
+	getOrgReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetSpaceStub        func(spaceName string, retVal *plugin_models.GetSpace_Model) error
 	getSpaceMutex       sync.RWMutex
 	getSpaceArgsForCall []struct {
@@ -271,6 +355,9 @@ type FakeHandlers struct {
 	getSpaceReturns struct {
 		result1 error
 	}
+	getSpaceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetServiceStub        func(serviceInstance string, retVal *plugin_models.GetService_Model) error
 	getServiceMutex       sync.RWMutex
 	getServiceArgsForCall []struct {
@@ -280,12 +367,64 @@ type FakeHandlers struct {
 	getServiceReturns struct {
 		result1 error
 	}
+	getServiceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAppsV1Stub        func(request plugin_models.GetAppsV1Request, retVal *plugin_models.GetAppsV1Response) error
+	getAppsV1Mutex       sync.RWMutex
+	getAppsV1ArgsForCall []struct {
+		request plugin_models.GetAppsV1Request
+		retVal  *plugin_models.GetAppsV1Response
+	}
+	getAppsV1Returns struct {
+		result1 error
+	}
+	getAppsV1ReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetServicesV1Stub        func(request plugin_models.GetServicesV1Request, retVal *plugin_models.GetServicesV1Response) error
+	getServicesV1Mutex       sync.RWMutex
+	getServicesV1ArgsForCall []struct {
+		request plugin_models.GetServicesV1Request
+		retVal  *plugin_models.GetServicesV1Response
+	}
+	getServicesV1Returns struct {
+		result1 error
+	}
+	getServicesV1ReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunTaskV1Stub        func(request plugin_models.RunTaskV1Request, retVal *plugin_models.RunTaskV1Response) error
+	runTaskV1Mutex       sync.RWMutex
+	runTaskV1ArgsForCall []struct {
+		request plugin_models.RunTaskV1Request
+		retVal  *plugin_models.RunTaskV1Response
+	}
+	runTaskV1Returns struct {
+		result1 error
+	}
+	runTaskV1ReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetConfigV1Stub        func(args string, retVal *plugin_models.GetConfigV1Response) error
+	getConfigV1Mutex       sync.RWMutex
+	getConfigV1ArgsForCall []struct {
+		args   string
+		retVal *plugin_models.GetConfigV1Response
+	}
+	getConfigV1Returns struct {
+		result1 error
+	}
+	getConfigV1ReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakeHandlers) IsMinCliVersion(args string, retVal *bool) error {
 	fake.isMinCliVersionMutex.Lock()
+	ret, specificReturn := fake.isMinCliVersionReturnsOnCall[len(fake.isMinCliVersionArgsForCall)]
 	fake.isMinCliVersionArgsForCall = append(fake.isMinCliVersionArgsForCall, struct {
 		args   string
 		retVal *bool
@@ -294,9 +433,11 @@ func (fake *FakeHandlers) IsMinCliVersion(args string, retVal *bool) error {
 	fake.isMinCliVersionMutex.Unlock()
 	if fake.IsMinCliVersionStub != nil {
 		return fake.IsMinCliVersionStub(args, retVal)
-	} else {
-		return fake.isMinCliVersionReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isMinCliVersionReturns.result1
 }
 
 func (fake *FakeHandlers) IsMinCliVersionCallCount() int {
@@ -318,8 +459,21 @@ func (fake *FakeHandlers) IsMinCliVersionReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) IsMinCliVersionReturnsOnCall(i int, result1 error) {
+	fake.IsMinCliVersionStub = nil
+	if fake.isMinCliVersionReturnsOnCall == nil {
+		fake.isMinCliVersionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.isMinCliVersionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) SetPluginMetadata(pluginMetadata plugin.PluginMetadata, retVal *bool) error {
 	fake.setPluginMetadataMutex.Lock()
+	ret, specificReturn := fake.setPluginMetadataReturnsOnCall[len(fake.setPluginMetadataArgsForCall)]
 	fake.setPluginMetadataArgsForCall = append(fake.setPluginMetadataArgsForCall, struct {
 		pluginMetadata plugin.PluginMetadata
 		retVal         *bool
@@ -328,9 +482,11 @@ func (fake *FakeHandlers) SetPluginMetadata(pluginMetadata plugin.PluginMetadata
 	fake.setPluginMetadataMutex.Unlock()
 	if fake.SetPluginMetadataStub != nil {
 		return fake.SetPluginMetadataStub(pluginMetadata, retVal)
-	} else {
-		return fake.setPluginMetadataReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setPluginMetadataReturns.result1
 }
 
 func (fake *FakeHandlers) SetPluginMetadataCallCount() int {
@@ -352,8 +508,21 @@ func (fake *FakeHandlers) SetPluginMetadataReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) SetPluginMetadataReturnsOnCall(i int, result1 error) {
+	fake.SetPluginMetadataStub = nil
+	if fake.setPluginMetadataReturnsOnCall == nil {
+		fake.setPluginMetadataReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setPluginMetadataReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) DisableTerminalOutput(disable bool, retVal *bool) error {
 	fake.disableTerminalOutputMutex.Lock()
+	ret, specificReturn := fake.disableTerminalOutputReturnsOnCall[len(fake.disableTerminalOutputArgsForCall)]
 	fake.disableTerminalOutputArgsForCall = append(fake.disableTerminalOutputArgsForCall, struct {
 		disable bool
 		retVal  *bool
@@ -362,9 +531,11 @@ func (fake *FakeHandlers) DisableTerminalOutput(disable bool, retVal *bool) erro
 	fake.disableTerminalOutputMutex.Unlock()
 	if fake.DisableTerminalOutputStub != nil {
 		return fake.DisableTerminalOutputStub(disable, retVal)
-	} else {
-		return fake.disableTerminalOutputReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.disableTerminalOutputReturns.result1
 }
 
 func (fake *FakeHandlers) DisableTerminalOutputCallCount() int {
@@ -386,6 +557,18 @@ func (fake *FakeHandlers) DisableTerminalOutputReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) DisableTerminalOutputReturnsOnCall(i int, result1 error) {
+	fake.DisableTerminalOutputStub = nil
+	if fake.disableTerminalOutputReturnsOnCall == nil {
+		fake.disableTerminalOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableTerminalOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) CallCoreCommand(args []string, retVal *bool) error {
 	var argsCopy []string
 	if args != nil {
@@ -393,6 +576,7 @@ func (fake *FakeHandlers) CallCoreCommand(args []string, retVal *bool) error {
 		copy(argsCopy, args)
 	}
 	fake.callCoreCommandMutex.Lock()
+	ret, specificReturn := fake.callCoreCommandReturnsOnCall[len(fake.callCoreCommandArgsForCall)]
 	fake.callCoreCommandArgsForCall = append(fake.callCoreCommandArgsForCall, struct {
 		args   []string
 		retVal *bool
@@ -401,9 +585,11 @@ func (fake *FakeHandlers) CallCoreCommand(args []string, retVal *bool) error {
 	fake.callCoreCommandMutex.Unlock()
 	if fake.CallCoreCommandStub != nil {
 		return fake.CallCoreCommandStub(args, retVal)
-	} else {
-		return fake.callCoreCommandReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.callCoreCommandReturns.result1
 }
 
 func (fake *FakeHandlers) CallCoreCommandCallCount() int {
@@ -425,8 +611,21 @@ func (fake *FakeHandlers) CallCoreCommandReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) CallCoreCommandReturnsOnCall(i int, result1 error) {
+	fake.CallCoreCommandStub = nil
+	if fake.callCoreCommandReturnsOnCall == nil {
+		fake.callCoreCommandReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.callCoreCommandReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetOutputAndReset(args bool, retVal *[]string) error {
 	fake.getOutputAndResetMutex.Lock()
+	ret, specificReturn := fake.getOutputAndResetReturnsOnCall[len(fake.getOutputAndResetArgsForCall)]
 	fake.getOutputAndResetArgsForCall = append(fake.getOutputAndResetArgsForCall, struct {
 		args   bool
 		retVal *[]string
@@ -435,9 +634,11 @@ func (fake *FakeHandlers) GetOutputAndReset(args bool, retVal *[]string) error {
 	fake.getOutputAndResetMutex.Unlock()
 	if fake.GetOutputAndResetStub != nil {
 		return fake.GetOutputAndResetStub(args, retVal)
-	} else {
-		return fake.getOutputAndResetReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getOutputAndResetReturns.result1
 }
 
 func (fake *FakeHandlers) GetOutputAndResetCallCount() int {
@@ -459,8 +660,21 @@ func (fake *FakeHandlers) GetOutputAndResetReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetOutputAndResetReturnsOnCall(i int, result1 error) {
+	fake.GetOutputAndResetStub = nil
+	if fake.getOutputAndResetReturnsOnCall == nil {
+		fake.getOutputAndResetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getOutputAndResetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetCurrentOrg(args string, retVal *plugin_models.Organization) error {
 	fake.getCurrentOrgMutex.Lock()
+	ret, specificReturn := fake.getCurrentOrgReturnsOnCall[len(fake.getCurrentOrgArgsForCall)]
 	fake.getCurrentOrgArgsForCall = append(fake.getCurrentOrgArgsForCall, struct {
 		args   string
 		retVal *plugin_models.Organization
@@ -469,9 +683,11 @@ func (fake *FakeHandlers) GetCurrentOrg(args string, retVal *plugin_models.Organ
 	fake.getCurrentOrgMutex.Unlock()
 	if fake.GetCurrentOrgStub != nil {
 		return fake.GetCurrentOrgStub(args, retVal)
-	} else {
-		return fake.getCurrentOrgReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getCurrentOrgReturns.result1
 }
 
 func (fake *FakeHandlers) GetCurrentOrgCallCount() int {
@@ -493,8 +709,21 @@ func (fake *FakeHandlers) GetCurrentOrgReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetCurrentOrgReturnsOnCall(i int, result1 error) {
+	fake.GetCurrentOrgStub = nil
+	if fake.getCurrentOrgReturnsOnCall == nil {
+		fake.getCurrentOrgReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getCurrentOrgReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetCurrentSpace(args string, retVal *plugin_models.Space) error {
 	fake.getCurrentSpaceMutex.Lock()
+	ret, specificReturn := fake.getCurrentSpaceReturnsOnCall[len(fake.getCurrentSpaceArgsForCall)]
 	fake.getCurrentSpaceArgsForCall = append(fake.getCurrentSpaceArgsForCall, struct {
 		args   string
 		retVal *plugin_models.Space
@@ -503,9 +732,11 @@ func (fake *FakeHandlers) GetCurrentSpace(args string, retVal *plugin_models.Spa
 	fake.getCurrentSpaceMutex.Unlock()
 	if fake.GetCurrentSpaceStub != nil {
 		return fake.GetCurrentSpaceStub(args, retVal)
-	} else {
-		return fake.getCurrentSpaceReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getCurrentSpaceReturns.result1
 }
 
 func (fake *FakeHandlers) GetCurrentSpaceCallCount() int {
@@ -527,8 +758,21 @@ func (fake *FakeHandlers) GetCurrentSpaceReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetCurrentSpaceReturnsOnCall(i int, result1 error) {
+	fake.GetCurrentSpaceStub = nil
+	if fake.getCurrentSpaceReturnsOnCall == nil {
+		fake.getCurrentSpaceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getCurrentSpaceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) Username(args string, retVal *string) error {
 	fake.usernameMutex.Lock()
+	ret, specificReturn := fake.usernameReturnsOnCall[len(fake.usernameArgsForCall)]
 	fake.usernameArgsForCall = append(fake.usernameArgsForCall, struct {
 		args   string
 		retVal *string
@@ -537,9 +781,11 @@ func (fake *FakeHandlers) Username(args string, retVal *string) error {
 	fake.usernameMutex.Unlock()
 	if fake.UsernameStub != nil {
 		return fake.UsernameStub(args, retVal)
-	} else {
-		return fake.usernameReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.usernameReturns.result1
 }
 
 func (fake *FakeHandlers) UsernameCallCount() int {
@@ -561,8 +807,21 @@ func (fake *FakeHandlers) UsernameReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) UsernameReturnsOnCall(i int, result1 error) {
+	fake.UsernameStub = nil
+	if fake.usernameReturnsOnCall == nil {
+		fake.usernameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.usernameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) UserGuid(args string, retVal *string) error {
 	fake.userGuidMutex.Lock()
+	ret, specificReturn := fake.userGuidReturnsOnCall[len(fake.userGuidArgsForCall)]
 	fake.userGuidArgsForCall = append(fake.userGuidArgsForCall, struct {
 		args   string
 		retVal *string
@@ -571,9 +830,11 @@ func (fake *FakeHandlers) UserGuid(args string, retVal *string) error {
 	fake.userGuidMutex.Unlock()
 	if fake.UserGuidStub != nil {
 		return fake.UserGuidStub(args, retVal)
-	} else {
-		return fake.userGuidReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.userGuidReturns.result1
 }
 
 func (fake *FakeHandlers) UserGuidCallCount() int {
@@ -595,8 +856,21 @@ func (fake *FakeHandlers) UserGuidReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) UserGuidReturnsOnCall(i int, result1 error) {
+	fake.UserGuidStub = nil
+	if fake.userGuidReturnsOnCall == nil {
+		fake.userGuidReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.userGuidReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) UserEmail(args string, retVal *string) error {
 	fake.userEmailMutex.Lock()
+	ret, specificReturn := fake.userEmailReturnsOnCall[len(fake.userEmailArgsForCall)]
 	fake.userEmailArgsForCall = append(fake.userEmailArgsForCall, struct {
 		args   string
 		retVal *string
@@ -605,9 +879,11 @@ func (fake *FakeHandlers) UserEmail(args string, retVal *string) error {
 	fake.userEmailMutex.Unlock()
 	if fake.UserEmailStub != nil {
 		return fake.UserEmailStub(args, retVal)
-	} else {
-		return fake.userEmailReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.userEmailReturns.result1
 }
 
 func (fake *FakeHandlers) UserEmailCallCount() int {
@@ -629,8 +905,21 @@ func (fake *FakeHandlers) UserEmailReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) UserEmailReturnsOnCall(i int, result1 error) {
+	fake.UserEmailStub = nil
+	if fake.userEmailReturnsOnCall == nil {
+		fake.userEmailReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.userEmailReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) IsLoggedIn(args string, retVal *bool) error {
 	fake.isLoggedInMutex.Lock()
+	ret, specificReturn := fake.isLoggedInReturnsOnCall[len(fake.isLoggedInArgsForCall)]
 	fake.isLoggedInArgsForCall = append(fake.isLoggedInArgsForCall, struct {
 		args   string
 		retVal *bool
@@ -639,9 +928,11 @@ func (fake *FakeHandlers) IsLoggedIn(args string, retVal *bool) error {
 	fake.isLoggedInMutex.Unlock()
 	if fake.IsLoggedInStub != nil {
 		return fake.IsLoggedInStub(args, retVal)
-	} else {
-		return fake.isLoggedInReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isLoggedInReturns.result1
 }
 
 func (fake *FakeHandlers) IsLoggedInCallCount() int {
@@ -663,8 +954,21 @@ func (fake *FakeHandlers) IsLoggedInReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) IsLoggedInReturnsOnCall(i int, result1 error) {
+	fake.IsLoggedInStub = nil
+	if fake.isLoggedInReturnsOnCall == nil {
+		fake.isLoggedInReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.isLoggedInReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) IsSSLDisabled(args string, retVal *bool) error {
 	fake.isSSLDisabledMutex.Lock()
+	ret, specificReturn := fake.isSSLDisabledReturnsOnCall[len(fake.isSSLDisabledArgsForCall)]
 	fake.isSSLDisabledArgsForCall = append(fake.isSSLDisabledArgsForCall, struct {
 		args   string
 		retVal *bool
@@ -673,9 +977,11 @@ func (fake *FakeHandlers) IsSSLDisabled(args string, retVal *bool) error {
 	fake.isSSLDisabledMutex.Unlock()
 	if fake.IsSSLDisabledStub != nil {
 		return fake.IsSSLDisabledStub(args, retVal)
-	} else {
-		return fake.isSSLDisabledReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isSSLDisabledReturns.result1
 }
 
 func (fake *FakeHandlers) IsSSLDisabledCallCount() int {
@@ -697,8 +1003,21 @@ func (fake *FakeHandlers) IsSSLDisabledReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) IsSSLDisabledReturnsOnCall(i int, result1 error) {
+	fake.IsSSLDisabledStub = nil
+	if fake.isSSLDisabledReturnsOnCall == nil {
+		fake.isSSLDisabledReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.isSSLDisabledReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) HasOrganization(args string, retVal *bool) error {
 	fake.hasOrganizationMutex.Lock()
+	ret, specificReturn := fake.hasOrganizationReturnsOnCall[len(fake.hasOrganizationArgsForCall)]
 	fake.hasOrganizationArgsForCall = append(fake.hasOrganizationArgsForCall, struct {
 		args   string
 		retVal *bool
@@ -707,9 +1026,11 @@ func (fake *FakeHandlers) HasOrganization(args string, retVal *bool) error {
 	fake.hasOrganizationMutex.Unlock()
 	if fake.HasOrganizationStub != nil {
 		return fake.HasOrganizationStub(args, retVal)
-	} else {
-		return fake.hasOrganizationReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.hasOrganizationReturns.result1
 }
 
 func (fake *FakeHandlers) HasOrganizationCallCount() int {
@@ -731,8 +1052,21 @@ func (fake *FakeHandlers) HasOrganizationReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) HasOrganizationReturnsOnCall(i int, result1 error) {
+	fake.HasOrganizationStub = nil
+	if fake.hasOrganizationReturnsOnCall == nil {
+		fake.hasOrganizationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.hasOrganizationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) HasSpace(args string, retVal *bool) error {
 	fake.hasSpaceMutex.Lock()
+	ret, specificReturn := fake.hasSpaceReturnsOnCall[len(fake.hasSpaceArgsForCall)]
 	fake.hasSpaceArgsForCall = append(fake.hasSpaceArgsForCall, struct {
 		args   string
 		retVal *bool
@@ -741,9 +1075,11 @@ func (fake *FakeHandlers) HasSpace(args string, retVal *bool) error {
 	fake.hasSpaceMutex.Unlock()
 	if fake.HasSpaceStub != nil {
 		return fake.HasSpaceStub(args, retVal)
-	} else {
-		return fake.hasSpaceReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.hasSpaceReturns.result1
 }
 
 func (fake *FakeHandlers) HasSpaceCallCount() int {
@@ -765,8 +1101,21 @@ func (fake *FakeHandlers) HasSpaceReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) HasSpaceReturnsOnCall(i int, result1 error) {
+	fake.HasSpaceStub = nil
+	if fake.hasSpaceReturnsOnCall == nil {
+		fake.hasSpaceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.hasSpaceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) ApiEndpoint(args string, retVal *string) error {
 	fake.apiEndpointMutex.Lock()
+	ret, specificReturn := fake.apiEndpointReturnsOnCall[len(fake.apiEndpointArgsForCall)]
 	fake.apiEndpointArgsForCall = append(fake.apiEndpointArgsForCall, struct {
 		args   string
 		retVal *string
@@ -775,9 +1124,11 @@ func (fake *FakeHandlers) ApiEndpoint(args string, retVal *string) error {
 	fake.apiEndpointMutex.Unlock()
 	if fake.ApiEndpointStub != nil {
 		return fake.ApiEndpointStub(args, retVal)
-	} else {
-		return fake.apiEndpointReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.apiEndpointReturns.result1
 }
 
 func (fake *FakeHandlers) ApiEndpointCallCount() int {
@@ -799,8 +1150,21 @@ func (fake *FakeHandlers) ApiEndpointReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) ApiEndpointReturnsOnCall(i int, result1 error) {
+	fake.ApiEndpointStub = nil
+	if fake.apiEndpointReturnsOnCall == nil {
+		fake.apiEndpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.apiEndpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) HasAPIEndpoint(args string, retVal *bool) error {
 	fake.hasAPIEndpointMutex.Lock()
+	ret, specificReturn := fake.hasAPIEndpointReturnsOnCall[len(fake.hasAPIEndpointArgsForCall)]
 	fake.hasAPIEndpointArgsForCall = append(fake.hasAPIEndpointArgsForCall, struct {
 		args   string
 		retVal *bool
@@ -809,9 +1173,11 @@ func (fake *FakeHandlers) HasAPIEndpoint(args string, retVal *bool) error {
 	fake.hasAPIEndpointMutex.Unlock()
 	if fake.HasAPIEndpointStub != nil {
 		return fake.HasAPIEndpointStub(args, retVal)
-	} else {
-		return fake.hasAPIEndpointReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.hasAPIEndpointReturns.result1
 }
 
 func (fake *FakeHandlers) HasAPIEndpointCallCount() int {
@@ -833,8 +1199,21 @@ func (fake *FakeHandlers) HasAPIEndpointReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) HasAPIEndpointReturnsOnCall(i int, result1 error) {
+	fake.HasAPIEndpointStub = nil
+	if fake.hasAPIEndpointReturnsOnCall == nil {
+		fake.hasAPIEndpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.hasAPIEndpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) ApiVersion(args string, retVal *string) error {
 	fake.apiVersionMutex.Lock()
+	ret, specificReturn := fake.apiVersionReturnsOnCall[len(fake.apiVersionArgsForCall)]
 	fake.apiVersionArgsForCall = append(fake.apiVersionArgsForCall, struct {
 		args   string
 		retVal *string
@@ -843,9 +1222,11 @@ func (fake *FakeHandlers) ApiVersion(args string, retVal *string) error {
 	fake.apiVersionMutex.Unlock()
 	if fake.ApiVersionStub != nil {
 		return fake.ApiVersionStub(args, retVal)
-	} else {
-		return fake.apiVersionReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.apiVersionReturns.result1
 }
 
 func (fake *FakeHandlers) ApiVersionCallCount() int {
@@ -867,8 +1248,21 @@ func (fake *FakeHandlers) ApiVersionReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) ApiVersionReturnsOnCall(i int, result1 error) {
+	fake.ApiVersionStub = nil
+	if fake.apiVersionReturnsOnCall == nil {
+		fake.apiVersionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.apiVersionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) LoggregatorEndpoint(args string, retVal *string) error {
 	fake.loggregatorEndpointMutex.Lock()
+	ret, specificReturn := fake.loggregatorEndpointReturnsOnCall[len(fake.loggregatorEndpointArgsForCall)]
 	fake.loggregatorEndpointArgsForCall = append(fake.loggregatorEndpointArgsForCall, struct {
 		args   string
 		retVal *string
@@ -877,9 +1271,11 @@ func (fake *FakeHandlers) LoggregatorEndpoint(args string, retVal *string) error
 	fake.loggregatorEndpointMutex.Unlock()
 	if fake.LoggregatorEndpointStub != nil {
 		return fake.LoggregatorEndpointStub(args, retVal)
-	} else {
-		return fake.loggregatorEndpointReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.loggregatorEndpointReturns.result1
 }
 
 func (fake *FakeHandlers) LoggregatorEndpointCallCount() int {
@@ -901,8 +1297,21 @@ func (fake *FakeHandlers) LoggregatorEndpointReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) LoggregatorEndpointReturnsOnCall(i int, result1 error) {
+	fake.LoggregatorEndpointStub = nil
+	if fake.loggregatorEndpointReturnsOnCall == nil {
+		fake.loggregatorEndpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.loggregatorEndpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) DopplerEndpoint(args string, retVal *string) error {
 	fake.dopplerEndpointMutex.Lock()
+	ret, specificReturn := fake.dopplerEndpointReturnsOnCall[len(fake.dopplerEndpointArgsForCall)]
 	fake.dopplerEndpointArgsForCall = append(fake.dopplerEndpointArgsForCall, struct {
 		args   string
 		retVal *string
@@ -911,9 +1320,11 @@ func (fake *FakeHandlers) DopplerEndpoint(args string, retVal *string) error {
 	fake.dopplerEndpointMutex.Unlock()
 	if fake.DopplerEndpointStub != nil {
 		return fake.DopplerEndpointStub(args, retVal)
-	} else {
-		return fake.dopplerEndpointReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.dopplerEndpointReturns.result1
 }
 
 func (fake *FakeHandlers) DopplerEndpointCallCount() int {
@@ -935,8 +1346,21 @@ func (fake *FakeHandlers) DopplerEndpointReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) DopplerEndpointReturnsOnCall(i int, result1 error) {
+	fake.DopplerEndpointStub = nil
+	if fake.dopplerEndpointReturnsOnCall == nil {
+		fake.dopplerEndpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dopplerEndpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) AccessToken(args string, retVal *string) error {
 	fake.accessTokenMutex.Lock()
+	ret, specificReturn := fake.accessTokenReturnsOnCall[len(fake.accessTokenArgsForCall)]
 	fake.accessTokenArgsForCall = append(fake.accessTokenArgsForCall, struct {
 		args   string
 		retVal *string
@@ -945,9 +1369,11 @@ func (fake *FakeHandlers) AccessToken(args string, retVal *string) error {
 	fake.accessTokenMutex.Unlock()
 	if fake.AccessTokenStub != nil {
 		return fake.AccessTokenStub(args, retVal)
-	} else {
-		return fake.accessTokenReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.accessTokenReturns.result1
 }
 
 func (fake *FakeHandlers) AccessTokenCallCount() int {
@@ -969,8 +1395,21 @@ func (fake *FakeHandlers) AccessTokenReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) AccessTokenReturnsOnCall(i int, result1 error) {
+	fake.AccessTokenStub = nil
+	if fake.accessTokenReturnsOnCall == nil {
+		fake.accessTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accessTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetApp(appName string, retVal *plugin_models.GetAppModel) error {
 	fake.getAppMutex.Lock()
+	ret, specificReturn := fake.getAppReturnsOnCall[len(fake.getAppArgsForCall)]
 	fake.getAppArgsForCall = append(fake.getAppArgsForCall, struct {
 		appName string
 		retVal  *plugin_models.GetAppModel
@@ -979,9 +1418,11 @@ func (fake *FakeHandlers) GetApp(appName string, retVal *plugin_models.GetAppMod
 	fake.getAppMutex.Unlock()
 	if fake.GetAppStub != nil {
 		return fake.GetAppStub(appName, retVal)
-	} else {
-		return fake.getAppReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getAppReturns.result1
 }
 
 func (fake *FakeHandlers) GetAppCallCount() int {
@@ -1003,8 +1444,21 @@ func (fake *FakeHandlers) GetAppReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetAppReturnsOnCall(i int, result1 error) {
+	fake.GetAppStub = nil
+	if fake.getAppReturnsOnCall == nil {
+		fake.getAppReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getAppReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetApps(args string, retVal *[]plugin_models.GetAppsModel) error {
 	fake.getAppsMutex.Lock()
+	ret, specificReturn := fake.getAppsReturnsOnCall[len(fake.getAppsArgsForCall)]
 	fake.getAppsArgsForCall = append(fake.getAppsArgsForCall, struct {
 		args   string
 		retVal *[]plugin_models.GetAppsModel
@@ -1013,9 +1467,11 @@ func (fake *FakeHandlers) GetApps(args string, retVal *[]plugin_models.GetAppsMo
 	fake.getAppsMutex.Unlock()
 	if fake.GetAppsStub != nil {
 		return fake.GetAppsStub(args, retVal)
-	} else {
-		return fake.getAppsReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getAppsReturns.result1
 }
 
 func (fake *FakeHandlers) GetAppsCallCount() int {
@@ -1037,8 +1493,21 @@ func (fake *FakeHandlers) GetAppsReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetAppsReturnsOnCall(i int, result1 error) {
+	fake.GetAppsStub = nil
+	if fake.getAppsReturnsOnCall == nil {
+		fake.getAppsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getAppsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetOrgs(args string, retVal *[]plugin_models.GetOrgs_Model) error {
 	fake.getOrgsMutex.Lock()
+	ret, specificReturn := fake.getOrgsReturnsOnCall[len(fake.getOrgsArgsForCall)]
 	fake.getOrgsArgsForCall = append(fake.getOrgsArgsForCall, struct {
 		args   string
 		retVal *[]plugin_models.GetOrgs_Model
@@ -1047,9 +1516,11 @@ func (fake *FakeHandlers) GetOrgs(args string, retVal *[]plugin_models.GetOrgs_M
 	fake.getOrgsMutex.Unlock()
 	if fake.GetOrgsStub != nil {
 		return fake.GetOrgsStub(args, retVal)
-	} else {
-		return fake.getOrgsReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getOrgsReturns.result1
 }
 
 func (fake *FakeHandlers) GetOrgsCallCount() int {
@@ -1071,8 +1542,21 @@ func (fake *FakeHandlers) GetOrgsReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetOrgsReturnsOnCall(i int, result1 error) {
+	fake.GetOrgsStub = nil
+	if fake.getOrgsReturnsOnCall == nil {
+		fake.getOrgsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getOrgsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetSpaces(args string, retVal *[]plugin_models.GetSpaces_Model) error {
 	fake.getSpacesMutex.Lock()
+	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
 	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct {
 		args   string
 		retVal *[]plugin_models.GetSpaces_Model
@@ -1081,9 +1565,11 @@ func (fake *FakeHandlers) GetSpaces(args string, retVal *[]plugin_models.GetSpac
 	fake.getSpacesMutex.Unlock()
 	if fake.GetSpacesStub != nil {
 		return fake.GetSpacesStub(args, retVal)
-	} else {
-		return fake.getSpacesReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getSpacesReturns.result1
 }
 
 func (fake *FakeHandlers) GetSpacesCallCount() int {
@@ -1105,8 +1591,21 @@ func (fake *FakeHandlers) GetSpacesReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetSpacesReturnsOnCall(i int, result1 error) {
+	fake.GetSpacesStub = nil
+	if fake.getSpacesReturnsOnCall == nil {
+		fake.getSpacesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getSpacesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetServices(args string, retVal *[]plugin_models.GetServices_Model) error {
 	fake.getServicesMutex.Lock()
+	ret, specificReturn := fake.getServicesReturnsOnCall[len(fake.getServicesArgsForCall)]
 	fake.getServicesArgsForCall = append(fake.getServicesArgsForCall, struct {
 		args   string
 		retVal *[]plugin_models.GetServices_Model
@@ -1115,9 +1614,11 @@ func (fake *FakeHandlers) GetServices(args string, retVal *[]plugin_models.GetSe
 	fake.getServicesMutex.Unlock()
 	if fake.GetServicesStub != nil {
 		return fake.GetServicesStub(args, retVal)
-	} else {
-		return fake.getServicesReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getServicesReturns.result1
 }
 
 func (fake *FakeHandlers) GetServicesCallCount() int {
@@ -1139,6 +1640,18 @@ func (fake *FakeHandlers) GetServicesReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetServicesReturnsOnCall(i int, result1 error) {
+	fake.GetServicesStub = nil
+	if fake.getServicesReturnsOnCall == nil {
+		fake.getServicesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getServicesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetOrgUsers(args []string, retVal *[]plugin_models.GetOrgUsers_Model) error {
 	var argsCopy []string
 	if args != nil {
@@ -1146,6 +1659,7 @@ func (fake *FakeHandlers) GetOrgUsers(args []string, retVal *[]plugin_models.Get
 		copy(argsCopy, args)
 	}
 	fake.getOrgUsersMutex.Lock()
+	ret, specificReturn := fake.getOrgUsersReturnsOnCall[len(fake.getOrgUsersArgsForCall)]
 	fake.getOrgUsersArgsForCall = append(fake.getOrgUsersArgsForCall, struct {
 		args   []string
 		retVal *[]plugin_models.GetOrgUsers_Model
@@ -1154,9 +1668,11 @@ func (fake *FakeHandlers) GetOrgUsers(args []string, retVal *[]plugin_models.Get
 	fake.getOrgUsersMutex.Unlock()
 	if fake.GetOrgUsersStub != nil {
 		return fake.GetOrgUsersStub(args, retVal)
-	} else {
-		return fake.getOrgUsersReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getOrgUsersReturns.result1
 }
 
 func (fake *FakeHandlers) GetOrgUsersCallCount() int {
@@ -1178,6 +1694,18 @@ func (fake *FakeHandlers) GetOrgUsersReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetOrgUsersReturnsOnCall(i int, result1 error) {
+	fake.GetOrgUsersStub = nil
+	if fake.getOrgUsersReturnsOnCall == nil {
+		fake.getOrgUsersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getOrgUsersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetSpaceUsers(args []string, retVal *[]plugin_models.GetSpaceUsers_Model) error {
 	var argsCopy []string
 	if args != nil {
@@ -1185,6 +1713,7 @@ func (fake *FakeHandlers) GetSpaceUsers(args []string, retVal *[]plugin_models.G
 		copy(argsCopy, args)
 	}
 	fake.getSpaceUsersMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersReturnsOnCall[len(fake.getSpaceUsersArgsForCall)]
 	fake.getSpaceUsersArgsForCall = append(fake.getSpaceUsersArgsForCall, struct {
 		args   []string
 		retVal *[]plugin_models.GetSpaceUsers_Model
@@ -1193,9 +1722,11 @@ func (fake *FakeHandlers) GetSpaceUsers(args []string, retVal *[]plugin_models.G
 	fake.getSpaceUsersMutex.Unlock()
 	if fake.GetSpaceUsersStub != nil {
 		return fake.GetSpaceUsersStub(args, retVal)
-	} else {
-		return fake.getSpaceUsersReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getSpaceUsersReturns.result1
 }
 
 func (fake *FakeHandlers) GetSpaceUsersCallCount() int {
@@ -1217,8 +1748,21 @@ func (fake *FakeHandlers) GetSpaceUsersReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetSpaceUsersReturnsOnCall(i int, result1 error) {
+	fake.GetSpaceUsersStub = nil
+	if fake.getSpaceUsersReturnsOnCall == nil {
+		fake.getSpaceUsersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getSpaceUsersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetOrg(orgName string, retVal *plugin_models.GetOrg_Model) error {
 	fake.getOrgMutex.Lock()
+	ret, specificReturn := fake.getOrgReturnsOnCall[len(fake.getOrgArgsForCall)]
 	fake.getOrgArgsForCall = append(fake.getOrgArgsForCall, struct {
 		orgName string
 		retVal  *plugin_models.GetOrg_Model
@@ -1227,9 +1771,11 @@ func (fake *FakeHandlers) GetOrg(orgName string, retVal *plugin_models.GetOrg_Mo
 	fake.getOrgMutex.Unlock()
 	if fake.GetOrgStub != nil {
 		return fake.GetOrgStub(orgName, retVal)
-	} else {
-		return fake.getOrgReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getOrgReturns.result1
 }
 
 func (fake *FakeHandlers) GetOrgCallCount() int {
@@ -1251,8 +1797,21 @@ func (fake *FakeHandlers) GetOrgReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetOrgReturnsOnCall(i int, result1 error) {
+	fake.GetOrgStub = nil
+	if fake.getOrgReturnsOnCall == nil {
+		fake.getOrgReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getOrgReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetSpace(spaceName string, retVal *plugin_models.GetSpace_Model) error {
 	fake.getSpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceReturnsOnCall[len(fake.getSpaceArgsForCall)]
 	fake.getSpaceArgsForCall = append(fake.getSpaceArgsForCall, struct {
 		spaceName string
 		retVal    *plugin_models.GetSpace_Model
@@ -1261,9 +1820,11 @@ func (fake *FakeHandlers) GetSpace(spaceName string, retVal *plugin_models.GetSp
 	fake.getSpaceMutex.Unlock()
 	if fake.GetSpaceStub != nil {
 		return fake.GetSpaceStub(spaceName, retVal)
-	} else {
-		return fake.getSpaceReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getSpaceReturns.result1
 }
 
 func (fake *FakeHandlers) GetSpaceCallCount() int {
@@ -1285,8 +1846,21 @@ func (fake *FakeHandlers) GetSpaceReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetSpaceReturnsOnCall(i int, result1 error) {
+	fake.GetSpaceStub = nil
+	if fake.getSpaceReturnsOnCall == nil {
+		fake.getSpaceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getSpaceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) GetService(serviceInstance string, retVal *plugin_models.GetService_Model) error {
 	fake.getServiceMutex.Lock()
+	ret, specificReturn := fake.getServiceReturnsOnCall[len(fake.getServiceArgsForCall)]
 	fake.getServiceArgsForCall = append(fake.getServiceArgsForCall, struct {
 		serviceInstance string
 		retVal          *plugin_models.GetService_Model
@@ -1295,9 +1869,11 @@ func (fake *FakeHandlers) GetService(serviceInstance string, retVal *plugin_mode
 	fake.getServiceMutex.Unlock()
 	if fake.GetServiceStub != nil {
 		return fake.GetServiceStub(serviceInstance, retVal)
-	} else {
-		return fake.getServiceReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getServiceReturns.result1
 }
 
 func (fake *FakeHandlers) GetServiceCallCount() int {
@@ -1319,6 +1895,214 @@ func (fake *FakeHandlers) GetServiceReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetServiceReturnsOnCall(i int, result1 error) {
+	fake.GetServiceStub = nil
+	if fake.getServiceReturnsOnCall == nil {
+		fake.getServiceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getServiceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetAppsV1(request plugin_models.GetAppsV1Request, retVal *plugin_models.GetAppsV1Response) error {
+	fake.getAppsV1Mutex.Lock()
+	ret, specificReturn := fake.getAppsV1ReturnsOnCall[len(fake.getAppsV1ArgsForCall)]
+	fake.getAppsV1ArgsForCall = append(fake.getAppsV1ArgsForCall, struct {
+		request plugin_models.GetAppsV1Request
+		retVal  *plugin_models.GetAppsV1Response
+	}{request, retVal})
+	fake.recordInvocation("GetAppsV1", []interface{}{request, retVal})
+	fake.getAppsV1Mutex.Unlock()
+	if fake.GetAppsV1Stub != nil {
+		return fake.GetAppsV1Stub(request, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getAppsV1Returns.result1
+}
+
+func (fake *FakeHandlers) GetAppsV1CallCount() int {
+	fake.getAppsV1Mutex.RLock()
+	defer fake.getAppsV1Mutex.RUnlock()
+	return len(fake.getAppsV1ArgsForCall)
+}
+
+func (fake *FakeHandlers) GetAppsV1ArgsForCall(i int) (plugin_models.GetAppsV1Request, *plugin_models.GetAppsV1Response) {
+	fake.getAppsV1Mutex.RLock()
+	defer fake.getAppsV1Mutex.RUnlock()
+	return fake.getAppsV1ArgsForCall[i].request, fake.getAppsV1ArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetAppsV1Returns(result1 error) {
+	fake.GetAppsV1Stub = nil
+	fake.getAppsV1Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetAppsV1ReturnsOnCall(i int, result1 error) {
+	fake.GetAppsV1Stub = nil
+	if fake.getAppsV1ReturnsOnCall == nil {
+		fake.getAppsV1ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getAppsV1ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetServicesV1(request plugin_models.GetServicesV1Request, retVal *plugin_models.GetServicesV1Response) error {
+	fake.getServicesV1Mutex.Lock()
+	ret, specificReturn := fake.getServicesV1ReturnsOnCall[len(fake.getServicesV1ArgsForCall)]
+	fake.getServicesV1ArgsForCall = append(fake.getServicesV1ArgsForCall, struct {
+		request plugin_models.GetServicesV1Request
+		retVal  *plugin_models.GetServicesV1Response
+	}{request, retVal})
+	fake.recordInvocation("GetServicesV1", []interface{}{request, retVal})
+	fake.getServicesV1Mutex.Unlock()
+	if fake.GetServicesV1Stub != nil {
+		return fake.GetServicesV1Stub(request, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getServicesV1Returns.result1
+}
+
+func (fake *FakeHandlers) GetServicesV1CallCount() int {
+	fake.getServicesV1Mutex.RLock()
+	defer fake.getServicesV1Mutex.RUnlock()
+	return len(fake.getServicesV1ArgsForCall)
+}
+
+func (fake *FakeHandlers) GetServicesV1ArgsForCall(i int) (plugin_models.GetServicesV1Request, *plugin_models.GetServicesV1Response) {
+	fake.getServicesV1Mutex.RLock()
+	defer fake.getServicesV1Mutex.RUnlock()
+	return fake.getServicesV1ArgsForCall[i].request, fake.getServicesV1ArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetServicesV1Returns(result1 error) {
+	fake.GetServicesV1Stub = nil
+	fake.getServicesV1Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetServicesV1ReturnsOnCall(i int, result1 error) {
+	fake.GetServicesV1Stub = nil
+	if fake.getServicesV1ReturnsOnCall == nil {
+		fake.getServicesV1ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getServicesV1ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) RunTaskV1(request plugin_models.RunTaskV1Request, retVal *plugin_models.RunTaskV1Response) error {
+	fake.runTaskV1Mutex.Lock()
+	ret, specificReturn := fake.runTaskV1ReturnsOnCall[len(fake.runTaskV1ArgsForCall)]
+	fake.runTaskV1ArgsForCall = append(fake.runTaskV1ArgsForCall, struct {
+		request plugin_models.RunTaskV1Request
+		retVal  *plugin_models.RunTaskV1Response
+	}{request, retVal})
+	fake.recordInvocation("RunTaskV1", []interface{}{request, retVal})
+	fake.runTaskV1Mutex.Unlock()
+	if fake.RunTaskV1Stub != nil {
+		return fake.RunTaskV1Stub(request, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.runTaskV1Returns.result1
+}
+
+func (fake *FakeHandlers) RunTaskV1CallCount() int {
+	fake.runTaskV1Mutex.RLock()
+	defer fake.runTaskV1Mutex.RUnlock()
+	return len(fake.runTaskV1ArgsForCall)
+}
+
+func (fake *FakeHandlers) RunTaskV1ArgsForCall(i int) (plugin_models.RunTaskV1Request, *plugin_models.RunTaskV1Response) {
+	fake.runTaskV1Mutex.RLock()
+	defer fake.runTaskV1Mutex.RUnlock()
+	return fake.runTaskV1ArgsForCall[i].request, fake.runTaskV1ArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) RunTaskV1Returns(result1 error) {
+	fake.RunTaskV1Stub = nil
+	fake.runTaskV1Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) RunTaskV1ReturnsOnCall(i int, result1 error) {
+	fake.RunTaskV1Stub = nil
+	if fake.runTaskV1ReturnsOnCall == nil {
+		fake.runTaskV1ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runTaskV1ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetConfigV1(args string, retVal *plugin_models.GetConfigV1Response) error {
+	fake.getConfigV1Mutex.Lock()
+	ret, specificReturn := fake.getConfigV1ReturnsOnCall[len(fake.getConfigV1ArgsForCall)]
+	fake.getConfigV1ArgsForCall = append(fake.getConfigV1ArgsForCall, struct {
+		args   string
+		retVal *plugin_models.GetConfigV1Response
+	}{args, retVal})
+	fake.recordInvocation("GetConfigV1", []interface{}{args, retVal})
+	fake.getConfigV1Mutex.Unlock()
+	if fake.GetConfigV1Stub != nil {
+		return fake.GetConfigV1Stub(args, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getConfigV1Returns.result1
+}
+
+func (fake *FakeHandlers) GetConfigV1CallCount() int {
+	fake.getConfigV1Mutex.RLock()
+	defer fake.getConfigV1Mutex.RUnlock()
+	return len(fake.getConfigV1ArgsForCall)
+}
+
+func (fake *FakeHandlers) GetConfigV1ArgsForCall(i int) (string, *plugin_models.GetConfigV1Response) {
+	fake.getConfigV1Mutex.RLock()
+	defer fake.getConfigV1Mutex.RUnlock()
+	return fake.getConfigV1ArgsForCall[i].args, fake.getConfigV1ArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetConfigV1Returns(result1 error) {
+	fake.GetConfigV1Stub = nil
+	fake.getConfigV1Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetConfigV1ReturnsOnCall(i int, result1 error) {
+	fake.GetConfigV1Stub = nil
+	if fake.getConfigV1ReturnsOnCall == nil {
+		fake.getConfigV1ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getConfigV1ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1382,6 +2166,14 @@ func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	defer fake.getSpaceMutex.RUnlock()
 	fake.getServiceMutex.RLock()
 	defer fake.getServiceMutex.RUnlock()
+	fake.getAppsV1Mutex.RLock()
+	defer fake.getAppsV1Mutex.RUnlock()
+	fake.getServicesV1Mutex.RLock()
+	defer fake.getServicesV1Mutex.RUnlock()
+	fake.runTaskV1Mutex.RLock()
+	defer fake.runTaskV1Mutex.RUnlock()
+	fake.getConfigV1Mutex.RLock()
+	defer fake.getConfigV1Mutex.RUnlock()
 	return fake.invocations
 }
 
@@ -46,6 +46,10 @@ type Handlers interface {
 	GetOrg(orgName string, retVal *plugin_models.GetOrg_Model) error
 	GetSpace(spaceName string, retVal *plugin_models.GetSpace_Model) error
 	GetService(serviceInstance string, retVal *plugin_models.GetService_Model) error
+	GetAppsV1(request plugin_models.GetAppsV1Request, retVal *plugin_models.GetAppsV1Response) error
+	GetServicesV1(request plugin_models.GetServicesV1Request, retVal *plugin_models.GetServicesV1Response) error
+	RunTaskV1(request plugin_models.RunTaskV1Request, retVal *plugin_models.RunTaskV1Response) error
+	GetConfigV1(args string, retVal *plugin_models.GetConfigV1Response) error
 }
 
 type TestServer struct {
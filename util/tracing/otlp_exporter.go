@@ -0,0 +1,169 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// otlpStatusCodeError is the OTLP status code of a failed span.
+const otlpStatusCodeError = 2
+
+// OTLPExporter sends spans to an OpenTelemetry collector using the OTLP/HTTP
+// protocol with JSON encoding.
+type OTLPExporter struct {
+	URL            string
+	ServiceName    string
+	ServiceVersion string
+	HTTPClient     *http.Client
+}
+
+// NewOTLPExporter returns a pointer to an OTLPExporter. The endpoint is the
+// base URL of the collector; spans are posted to its /v1/traces path unless
+// the endpoint already ends with it.
+func NewOTLPExporter(endpoint string, serviceName string, serviceVersion string) *OTLPExporter {
+	url := strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(url, "/v1/traces") {
+		url += "/v1/traces"
+	}
+
+	return &OTLPExporter{
+		URL:            url,
+		ServiceName:    serviceName,
+		ServiceVersion: serviceVersion,
+		HTTPClient:     &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Export posts the spans to the collector.
+func (exporter OTLPExporter) Export(spans []Span) error {
+	body, err := json.Marshal(exporter.request(spans))
+	if err != nil {
+		return err
+	}
+
+	response, err := exporter.HTTPClient.Post(exporter.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("exporting traces to %s failed with status %s", exporter.URL, response.Status)
+	}
+	return nil
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              SpanKind        `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            *otlpStatus     `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+func (exporter OTLPExporter) request(spans []Span) otlpRequest {
+	otlpSpans := make([]otlpSpan, 0, len(spans))
+	for _, span := range spans {
+		otlpSpans = append(otlpSpans, toOTLPSpan(span))
+	}
+
+	return otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: toOTLPAttributes(map[string]interface{}{
+					"service.name":    exporter.ServiceName,
+					"service.version": exporter.ServiceVersion,
+				}),
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "code.cloudfoundry.org/cli"},
+				Spans: otlpSpans,
+			}},
+		}},
+	}
+}
+
+func toOTLPSpan(span Span) otlpSpan {
+	otlp := otlpSpan{
+		TraceID:           span.TraceID,
+		SpanID:            span.SpanID,
+		ParentSpanID:      span.ParentSpanID,
+		Name:              span.Name,
+		Kind:              span.Kind,
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+		Attributes:        toOTLPAttributes(span.Attributes),
+	}
+	if span.Error != "" {
+		otlp.Status = &otlpStatus{Code: otlpStatusCodeError, Message: span.Error}
+	}
+	return otlp
+}
+
+// toOTLPAttributes converts the attributes sorted by key, so the output is
+// stable.
+func toOTLPAttributes(attributes map[string]interface{}) []otlpAttribute {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	otlpAttributes := make([]otlpAttribute, 0, len(keys))
+	for _, key := range keys {
+		var value map[string]interface{}
+		switch typedValue := attributes[key].(type) {
+		case int:
+			// OTLP JSON encodes 64 bit integers as strings.
+			value = map[string]interface{}{"intValue": strconv.Itoa(typedValue)}
+		case bool:
+			value = map[string]interface{}{"boolValue": typedValue}
+		default:
+			value = map[string]interface{}{"stringValue": fmt.Sprint(typedValue)}
+		}
+		otlpAttributes = append(otlpAttributes, otlpAttribute{Key: key, Value: value})
+	}
+	return otlpAttributes
+}
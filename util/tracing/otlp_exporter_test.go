@@ -0,0 +1,107 @@
+package tracing_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/tracing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("OTLPExporter", func() {
+	var (
+		server   *Server
+		exporter *OTLPExporter
+		spans    []Span
+	)
+
+	BeforeEach(func() {
+		server = NewServer()
+		exporter = NewOTLPExporter(server.URL()+"/", "cf", "6.30.0")
+
+		start := time.Unix(0, 1000)
+		spans = []Span{
+			{
+				TraceID:      "0123456789abcdef0123456789abcdef",
+				SpanID:       "0123456789abcdef",
+				ParentSpanID: "fedcba9876543210",
+				Name:         "GET /v2/apps",
+				Kind:         SpanKindClient,
+				StartTime:    start,
+				EndTime:      start.Add(time.Microsecond),
+				Attributes: map[string]interface{}{
+					"http.method":      "GET",
+					"http.status_code": 404,
+				},
+				Error: errors.New("some-error").Error(),
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("posts to the traces path of the endpoint", func() {
+		Expect(NewOTLPExporter("http://collector:4318", "cf", "").URL).To(Equal("http://collector:4318/v1/traces"))
+		Expect(NewOTLPExporter("http://collector:4318/v1/traces", "cf", "").URL).To(Equal("http://collector:4318/v1/traces"))
+	})
+
+	Context("when the collector accepts the spans", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v1/traces"),
+					VerifyContentType("application/json"),
+					VerifyJSON(`{
+						"resourceSpans": [{
+							"resource": {
+								"attributes": [
+									{"key": "service.name", "value": {"stringValue": "cf"}},
+									{"key": "service.version", "value": {"stringValue": "6.30.0"}}
+								]
+							},
+							"scopeSpans": [{
+								"scope": {"name": "code.cloudfoundry.org/cli"},
+								"spans": [{
+									"traceId": "0123456789abcdef0123456789abcdef",
+									"spanId": "0123456789abcdef",
+									"parentSpanId": "fedcba9876543210",
+									"name": "GET /v2/apps",
+									"kind": 3,
+									"startTimeUnixNano": "1000",
+									"endTimeUnixNano": "2000",
+									"attributes": [
+										{"key": "http.method", "value": {"stringValue": "GET"}},
+										{"key": "http.status_code", "value": {"intValue": "404"}}
+									],
+									"status": {"code": 2, "message": "some-error"}
+								}]
+							}]
+						}]
+					}`),
+					RespondWith(http.StatusOK, `{}`),
+				),
+			)
+		})
+
+		It("sends the spans as OTLP JSON", func() {
+			Expect(exporter.Export(spans)).To(Succeed())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("when the collector rejects the spans", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(RespondWith(http.StatusBadRequest, ``))
+		})
+
+		It("returns an error", func() {
+			err := exporter.Export(spans)
+			Expect(err).To(MatchError(ContainSubstring("400 Bad Request")))
+		})
+	})
+})
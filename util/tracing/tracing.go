@@ -0,0 +1,284 @@
+// Package tracing records spans of CLI operations, such as command execution,
+// HTTP requests and push phases, and exports them to an OpenTelemetry
+// collector. Tracing is disabled until Enable is called, in which case every
+// function in this package is a no-op.
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// SpanKind describes the relationship between a span and the remote side of
+// the operation it records.
+type SpanKind int
+
+const (
+	// SpanKindInternal is a span of an operation within the CLI.
+	SpanKindInternal SpanKind = 1
+	// SpanKindClient is a span of a request made by the CLI to a server.
+	SpanKindClient SpanKind = 3
+)
+
+//go:generate counterfeiter . Exporter
+
+// Exporter sends finished spans to a tracing backend.
+type Exporter interface {
+	Export(spans []Span) error
+}
+
+// Exporters sends finished spans to every exporter in the list.
+type Exporters []Exporter
+
+// Export sends the spans to each exporter in turn, returning the first error.
+func (exporters Exporters) Export(spans []Span) error {
+	var firstErr error
+	for _, exporter := range exporters {
+		err := exporter.Export(spans)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Span is a single timed operation of a trace.
+type Span struct {
+	TraceID      string
+	SpanID       string
+	ParentSpanID string
+	Name         string
+	Kind         SpanKind
+	StartTime    time.Time
+	EndTime      time.Time
+	Attributes   map[string]interface{}
+	Error        string
+
+	tracer *Tracer
+}
+
+// SetAttribute records a string, int or bool attribute on the span.
+func (span *Span) SetAttribute(key string, value interface{}) {
+	if span == nil {
+		return
+	}
+
+	span.tracer.mutex.Lock()
+	defer span.tracer.mutex.Unlock()
+	span.Attributes[key] = value
+}
+
+// SetError marks the span as failed with the provided error. A nil error is
+// ignored.
+func (span *Span) SetError(err error) {
+	if span == nil || err == nil {
+		return
+	}
+
+	span.tracer.mutex.Lock()
+	defer span.tracer.mutex.Unlock()
+	span.Error = err.Error()
+}
+
+// End finishes the span. Ending a span more than once has no effect.
+func (span *Span) End() {
+	if span == nil {
+		return
+	}
+
+	span.tracer.end(span)
+}
+
+// Tracer records the spans of a single trace. Spans started while another
+// span is open become children of the most recently started open span.
+type Tracer struct {
+	exporter Exporter
+	traceID  string
+
+	mutex    sync.Mutex
+	open     []*Span
+	finished []Span
+}
+
+// NewTracer returns a pointer to a Tracer that exports to the provided
+// exporter.
+func NewTracer(exporter Exporter) *Tracer {
+	return &Tracer{
+		exporter: exporter,
+		traceID:  randomID(16),
+	}
+}
+
+// StartSpan starts a span of an operation within the CLI.
+func (tracer *Tracer) StartSpan(name string) *Span {
+	return tracer.start(name, SpanKindInternal)
+}
+
+// StartClientSpan starts a span of a request to a server.
+func (tracer *Tracer) StartClientSpan(name string) *Span {
+	return tracer.start(name, SpanKindClient)
+}
+
+// RecordSpan records an already finished operation within the CLI, such as
+// one that happened before tracing was enabled.
+func (tracer *Tracer) RecordSpan(name string, startTime time.Time, endTime time.Time) {
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+
+	span := Span{
+		TraceID:    tracer.traceID,
+		SpanID:     randomID(8),
+		Name:       name,
+		Kind:       SpanKindInternal,
+		StartTime:  startTime,
+		EndTime:    endTime,
+		Attributes: map[string]interface{}{},
+	}
+	if len(tracer.open) > 0 {
+		span.ParentSpanID = tracer.open[len(tracer.open)-1].SpanID
+	}
+	tracer.finished = append(tracer.finished, span)
+}
+
+// SetRootError marks the outermost open span as failed.
+func (tracer *Tracer) SetRootError(err error) {
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+
+	if len(tracer.open) > 0 && err != nil {
+		tracer.open[0].Error = err.Error()
+	}
+}
+
+// Flush ends all open spans and exports every finished span that has not been
+// exported yet.
+func (tracer *Tracer) Flush() error {
+	tracer.mutex.Lock()
+	for i := len(tracer.open) - 1; i >= 0; i-- {
+		tracer.finish(tracer.open[i])
+	}
+	tracer.open = nil
+	spans := tracer.finished
+	tracer.finished = nil
+	tracer.mutex.Unlock()
+
+	if len(spans) == 0 {
+		return nil
+	}
+	return tracer.exporter.Export(spans)
+}
+
+func (tracer *Tracer) start(name string, kind SpanKind) *Span {
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+
+	span := &Span{
+		TraceID:    tracer.traceID,
+		SpanID:     randomID(8),
+		Name:       name,
+		Kind:       kind,
+		StartTime:  time.Now(),
+		Attributes: map[string]interface{}{},
+		tracer:     tracer,
+	}
+	if len(tracer.open) > 0 {
+		span.ParentSpanID = tracer.open[len(tracer.open)-1].SpanID
+	}
+	tracer.open = append(tracer.open, span)
+
+	return span
+}
+
+func (tracer *Tracer) end(span *Span) {
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+
+	for i, openSpan := range tracer.open {
+		if openSpan == span {
+			tracer.open = append(tracer.open[:i], tracer.open[i+1:]...)
+			tracer.finish(span)
+			return
+		}
+	}
+}
+
+// finish must be called with the mutex held.
+func (tracer *Tracer) finish(span *Span) {
+	span.EndTime = time.Now()
+	finished := *span
+	finished.tracer = nil
+	tracer.finished = append(tracer.finished, finished)
+}
+
+func randomID(size int) string {
+	id := make([]byte, size)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+var defaultTracer *Tracer
+
+// Enable turns on tracing for the rest of the process, exporting to the
+// provided exporter.
+func Enable(exporter Exporter) {
+	defaultTracer = NewTracer(exporter)
+}
+
+// Disable turns tracing off and discards any spans that have not been
+// exported.
+func Disable() {
+	defaultTracer = nil
+}
+
+// Enabled returns true if tracing has been enabled.
+func Enabled() bool {
+	return defaultTracer != nil
+}
+
+// StartSpan starts a span of an operation within the CLI. It returns nil when
+// tracing is disabled; all Span methods are safe to call on nil.
+func StartSpan(name string) *Span {
+	if defaultTracer == nil {
+		return nil
+	}
+	return defaultTracer.StartSpan(name)
+}
+
+// StartClientSpan starts a span of a request to a server. It returns nil when
+// tracing is disabled.
+func StartClientSpan(name string) *Span {
+	if defaultTracer == nil {
+		return nil
+	}
+	return defaultTracer.StartClientSpan(name)
+}
+
+// RecordSpan records an already finished operation within the CLI. It does
+// nothing when tracing is disabled.
+func RecordSpan(name string, startTime time.Time, endTime time.Time) {
+	if defaultTracer == nil {
+		return
+	}
+	defaultTracer.RecordSpan(name, startTime, endTime)
+}
+
+// SetRootError marks the outermost open span, usually the span of the
+// command, as failed. It is meant for failures detected where that span is not
+// available.
+func SetRootError(err error) {
+	if defaultTracer == nil {
+		return
+	}
+	defaultTracer.SetRootError(err)
+}
+
+// Flush ends all open spans and exports the finished ones. It must be called
+// before the process exits, since os.Exit does not run deferred calls.
+func Flush() error {
+	if defaultTracer == nil {
+		return nil
+	}
+	return defaultTracer.Flush()
+}
@@ -0,0 +1,154 @@
+package tracing_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/tracing"
+	"code.cloudfoundry.org/cli/util/tracing/tracingfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracer", func() {
+	var (
+		tracer       *Tracer
+		fakeExporter *tracingfakes.FakeExporter
+	)
+
+	BeforeEach(func() {
+		fakeExporter = new(tracingfakes.FakeExporter)
+		tracer = NewTracer(fakeExporter)
+	})
+
+	exportedSpans := func() []Span {
+		Expect(tracer.Flush()).To(Succeed())
+		Expect(fakeExporter.ExportCallCount()).To(Equal(1))
+		return fakeExporter.ExportArgsForCall(0)
+	}
+
+	It("nests spans under the most recently started open span", func() {
+		command := tracer.StartSpan("command")
+		request := tracer.StartClientSpan("request")
+		request.End()
+		phase := tracer.StartSpan("phase")
+		phase.End()
+		command.End()
+
+		spans := exportedSpans()
+		Expect(spans).To(HaveLen(3))
+
+		Expect(spans[0].Name).To(Equal("request"))
+		Expect(spans[0].Kind).To(Equal(SpanKindClient))
+		Expect(spans[0].ParentSpanID).To(Equal(spans[2].SpanID))
+
+		Expect(spans[1].Name).To(Equal("phase"))
+		Expect(spans[1].ParentSpanID).To(Equal(spans[2].SpanID))
+
+		Expect(spans[2].Name).To(Equal("command"))
+		Expect(spans[2].Kind).To(Equal(SpanKindInternal))
+		Expect(spans[2].ParentSpanID).To(BeEmpty())
+
+		for _, span := range spans {
+			Expect(span.TraceID).To(HaveLen(32))
+			Expect(span.TraceID).To(Equal(spans[0].TraceID))
+			Expect(span.SpanID).To(HaveLen(16))
+			Expect(span.EndTime).ToNot(BeTemporally("<", span.StartTime))
+		}
+	})
+
+	It("records attributes and errors", func() {
+		span := tracer.StartSpan("some-span")
+		span.SetAttribute("some-key", "some-value")
+		span.SetError(nil)
+		span.SetError(errors.New("some-error"))
+		span.End()
+		span.End()
+
+		spans := exportedSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Attributes).To(Equal(map[string]interface{}{"some-key": "some-value"}))
+		Expect(spans[0].Error).To(Equal("some-error"))
+	})
+
+	Describe("RecordSpan", func() {
+		It("records a finished span with the provided times", func() {
+			command := tracer.StartSpan("command")
+			startTime := time.Unix(100, 0)
+			endTime := time.Unix(101, 0)
+			tracer.RecordSpan("parse flags", startTime, endTime)
+			command.End()
+
+			spans := exportedSpans()
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].Name).To(Equal("parse flags"))
+			Expect(spans[0].StartTime).To(Equal(startTime))
+			Expect(spans[0].EndTime).To(Equal(endTime))
+			Expect(spans[0].ParentSpanID).To(Equal(spans[1].SpanID))
+		})
+	})
+
+	Describe("SetRootError", func() {
+		It("marks the outermost open span as failed", func() {
+			tracer.StartSpan("command")
+			tracer.StartSpan("phase")
+			tracer.SetRootError(errors.New("exit status 1"))
+
+			spans := exportedSpans()
+			Expect(spans[0].Name).To(Equal("phase"))
+			Expect(spans[0].Error).To(BeEmpty())
+			Expect(spans[1].Name).To(Equal("command"))
+			Expect(spans[1].Error).To(Equal("exit status 1"))
+		})
+	})
+
+	Describe("Flush", func() {
+		It("ends the open spans", func() {
+			tracer.StartSpan("command")
+			Expect(exportedSpans()).To(HaveLen(1))
+		})
+
+		It("does not export when there are no spans", func() {
+			Expect(tracer.Flush()).To(Succeed())
+			Expect(fakeExporter.ExportCallCount()).To(Equal(0))
+		})
+
+		It("returns export errors", func() {
+			expectedErr := errors.New("some-error")
+			fakeExporter.ExportReturns(expectedErr)
+			tracer.StartSpan("command").End()
+			Expect(tracer.Flush()).To(MatchError(expectedErr))
+		})
+	})
+
+	Describe("Exporters", func() {
+		It("exports to every exporter and returns the first error", func() {
+			otherExporter := new(tracingfakes.FakeExporter)
+			expectedErr := errors.New("some-error")
+			fakeExporter.ExportReturns(expectedErr)
+
+			spans := []Span{{Name: "some-span"}}
+			err := Exporters{fakeExporter, otherExporter}.Export(spans)
+			Expect(err).To(MatchError(expectedErr))
+			Expect(fakeExporter.ExportArgsForCall(0)).To(Equal(spans))
+			Expect(otherExporter.ExportArgsForCall(0)).To(Equal(spans))
+		})
+	})
+
+	Context("when tracing is disabled", func() {
+		BeforeEach(func() {
+			Disable()
+		})
+
+		It("returns nil spans that are safe to use", func() {
+			Expect(Enabled()).To(BeFalse())
+
+			span := StartSpan("some-span")
+			Expect(span).To(BeNil())
+			span.SetAttribute("some-key", "some-value")
+			span.SetError(errors.New("some-error"))
+			span.End()
+			Expect(Flush()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,99 @@
+// This file was generated by counterfeiter
+package tracingfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/tracing"
+)
+
+type FakeExporter struct {
+	ExportStub        func(spans []tracing.Span) error
+	exportMutex       sync.RWMutex
+	exportArgsForCall []struct {
+		spans []tracing.Span
+	}
+	exportReturns struct {
+		result1 error
+	}
+	exportReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExporter) Export(spans []tracing.Span) error {
+	var spansCopy []tracing.Span
+	if spans != nil {
+		spansCopy = make([]tracing.Span, len(spans))
+		copy(spansCopy, spans)
+	}
+	fake.exportMutex.Lock()
+	ret, specificReturn := fake.exportReturnsOnCall[len(fake.exportArgsForCall)]
+	fake.exportArgsForCall = append(fake.exportArgsForCall, struct {
+		spans []tracing.Span
+	}{spansCopy})
+	fake.recordInvocation("Export", []interface{}{spansCopy})
+	fake.exportMutex.Unlock()
+	if fake.ExportStub != nil {
+		return fake.ExportStub(spans)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.exportReturns.result1
+}
+
+func (fake *FakeExporter) ExportCallCount() int {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return len(fake.exportArgsForCall)
+}
+
+func (fake *FakeExporter) ExportArgsForCall(i int) []tracing.Span {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return fake.exportArgsForCall[i].spans
+}
+
+func (fake *FakeExporter) ExportReturns(result1 error) {
+	fake.ExportStub = nil
+	fake.exportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeExporter) ExportReturnsOnCall(i int, result1 error) {
+	fake.ExportStub = nil
+	if fake.exportReturnsOnCall == nil {
+		fake.exportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.exportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeExporter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeExporter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ tracing.Exporter = new(FakeExporter)
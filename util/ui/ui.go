@@ -162,6 +162,19 @@ func (ui *UI) DisplayNewline() {
 	fmt.Fprintf(ui.Out, "\n")
 }
 
+// ClearScreen moves the cursor to the top left corner of the terminal and
+// clears it. Nothing is output when UI.Out is not a TTY.
+func (ui *UI) ClearScreen() {
+	if !ui.IsTTY {
+		return
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprint(ui.Out, "\x1b[H\x1b[2J")
+}
+
 // DisplayBoolPrompt outputs the prompt and waits for user input. It only
 // allows for a boolean response. A default boolean response can be set with
 // defaultResponse.
@@ -332,6 +345,24 @@ func (ui *UI) DisplayHeader(text string) {
 	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText(text), color.New(color.Bold)))
 }
 
+// DisplayDiffAddition outputs the text prefixed with "+ " in green to ui.Out.
+// The text is not translated.
+func (ui *UI) DisplayDiffAddition(text string) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor("+ "+text, color.New(color.FgGreen)))
+}
+
+// DisplayDiffRemoval outputs the text prefixed with "- " in red to ui.Out.
+// The text is not translated.
+func (ui *UI) DisplayDiffRemoval(text string) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor("- "+text, color.New(color.FgRed)))
+}
+
 // DisplayTextWithFlavor translates the template, bolds and adds cyan color to
 // templateValues, substitutes templateValues into the template, and outputs
 // the result to ui.Out. Only the first map in templateValues is used.
@@ -311,6 +311,40 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("ClearScreen", func() {
+		Context("when the UI is a TTY", func() {
+			BeforeEach(func() {
+				ui.IsTTY = true
+			})
+
+			It("clears the terminal", func() {
+				ui.ClearScreen()
+				Expect(ui.Out).To(Say("\x1b\\[H\x1b\\[2J"))
+			})
+		})
+
+		Context("when the UI is not a TTY", func() {
+			It("outputs nothing", func() {
+				ui.ClearScreen()
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("DisplayDiffAddition", func() {
+		It("displays the text prefixed with + in green to ui.Out", func() {
+			ui.DisplayDiffAddition("memory: 1G")
+			Expect(ui.Out).To(Say("\x1b\\[32m\\+ memory: 1G\x1b\\[0m"))
+		})
+	})
+
+	Describe("DisplayDiffRemoval", func() {
+		It("displays the text prefixed with - in red to ui.Out", func() {
+			ui.DisplayDiffRemoval("memory: 256M")
+			Expect(ui.Out).To(Say("\x1b\\[31m- memory: 256M\x1b\\[0m"))
+		})
+	})
+
 	Describe("DisplayTextWithFlavor", func() {
 		It("displays the template to ui.Out", func() {
 			ui.DisplayTextWithFlavor("some-template")